@@ -24,8 +24,14 @@ type ApplicationConfig struct {
 	MatchedResources   []v2action.Resource
 	UnmatchedResources []v2action.Resource
 	Archive            bool
+	NoResourceMatching bool
 	Path               string
 
+	// Detach stops Apply from waiting for the Cloud Controller to process
+	// the uploaded bits. The processing job is returned in UploadJobGUID.
+	Detach        bool
+	UploadJobGUID string
+
 	TargetedSpaceGUID string
 }
 
@@ -37,6 +43,48 @@ func (config ApplicationConfig) UpdatingApplication() bool {
 	return !config.CreatingApplication()
 }
 
+// HasDestructiveChanges returns true when updating an existing app would
+// reduce or overwrite its current settings, such as scaling it down,
+// changing its stack or docker image, or replacing the value of an
+// environment variable.
+func (config ApplicationConfig) HasDestructiveChanges() bool {
+	if config.CreatingApplication() {
+		return false
+	}
+
+	current := config.CurrentApplication
+	desired := config.DesiredApplication
+
+	if current.Instances.IsSet && desired.Instances.IsSet && desired.Instances.Value < current.Instances.Value {
+		log.Debugf("scaling instances down from %d to %d", current.Instances.Value, desired.Instances.Value)
+		return true
+	}
+
+	if desired.Memory < current.Memory || desired.DiskQuota < current.DiskQuota {
+		log.Debug("reducing memory or disk quota")
+		return true
+	}
+
+	if current.StackGUID != "" && desired.StackGUID != current.StackGUID {
+		log.Debugf("changing stack from %s to %s", current.StackGUID, desired.StackGUID)
+		return true
+	}
+
+	if current.DockerImage != "" && desired.DockerImage != current.DockerImage {
+		log.Debugf("changing docker image from %s to %s", current.DockerImage, desired.DockerImage)
+		return true
+	}
+
+	for key, value := range current.EnvironmentVariables {
+		if newValue, ok := desired.EnvironmentVariables[key]; !ok || newValue != value {
+			log.Debugln("overwriting environment variable:", key)
+			return true
+		}
+	}
+
+	return false
+}
+
 func (actor Actor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]ApplicationConfig, Warnings, error) {
 	var configs []ApplicationConfig
 	var warnings Warnings
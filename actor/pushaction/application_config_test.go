@@ -14,6 +14,7 @@ import (
 	"code.cloudfoundry.org/cli/types"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -60,6 +61,66 @@ var _ = Describe("Application Config", func() {
 				})
 			})
 		})
+
+		Describe("HasDestructiveChanges", func() {
+			var config ApplicationConfig
+
+			BeforeEach(func() {
+				app := Application{
+					Application: v2action.Application{
+						GUID:                 "some-app-guid",
+						DiskQuota:            1024,
+						DockerImage:          "some-docker-image",
+						EnvironmentVariables: map[string]string{"FOO": "bar"},
+						Instances:            types.NullInt{Value: 2, IsSet: true},
+						Memory:               256,
+						StackGUID:            "some-stack-guid",
+					},
+				}
+				config = ApplicationConfig{
+					CurrentApplication: app,
+					DesiredApplication: app,
+				}
+			})
+
+			Context("when the app did not exist", func() {
+				It("returns false", func() {
+					Expect(ApplicationConfig{}.HasDestructiveChanges()).To(BeFalse())
+				})
+			})
+
+			Context("when nothing changes", func() {
+				It("returns false", func() {
+					Expect(config.HasDestructiveChanges()).To(BeFalse())
+				})
+			})
+
+			Context("when settings are only added or increased", func() {
+				BeforeEach(func() {
+					config.DesiredApplication.DiskQuota = 2048
+					config.DesiredApplication.EnvironmentVariables = map[string]string{"FOO": "bar", "BAZ": "qux"}
+					config.DesiredApplication.Instances = types.NullInt{Value: 3, IsSet: true}
+					config.DesiredApplication.Memory = 512
+				})
+
+				It("returns false", func() {
+					Expect(config.HasDestructiveChanges()).To(BeFalse())
+				})
+			})
+
+			DescribeTable("when settings are reduced or overwritten",
+				func(modify func(*Application)) {
+					modify(&config.DesiredApplication)
+					Expect(config.HasDestructiveChanges()).To(BeTrue())
+				},
+				Entry("instances are reduced", func(app *Application) { app.Instances = types.NullInt{Value: 1, IsSet: true} }),
+				Entry("memory is reduced", func(app *Application) { app.Memory = 128 }),
+				Entry("disk quota is reduced", func(app *Application) { app.DiskQuota = 512 }),
+				Entry("the stack changes", func(app *Application) { app.StackGUID = "some-other-stack-guid" }),
+				Entry("the docker image changes", func(app *Application) { app.DockerImage = "some-other-docker-image" }),
+				Entry("an environment variable is overwritten", func(app *Application) { app.EnvironmentVariables = map[string]string{"FOO": "baz"} }),
+			)
+		})
 	})
 
 	Describe("ConvertToApplicationConfigs", func() {
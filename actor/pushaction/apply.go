@@ -87,9 +87,14 @@ func (actor Actor) Apply(config ApplicationConfig, progressBar ProgressBar) (<-c
 		}
 
 		if config.DesiredApplication.DockerImage == "" {
-			eventStream <- ResourceMatching
-			config, warnings = actor.SetMatchedResources(config)
-			warningsStream <- warnings
+			if config.NoResourceMatching {
+				log.Debug("skipping resource matching")
+				config.UnmatchedResources = config.AllResources
+			} else {
+				eventStream <- ResourceMatching
+				config, warnings = actor.SetMatchedResources(config)
+				warningsStream <- warnings
+			}
 
 			archivePath, err := actor.CreateArchive(config)
 			if err != nil {
@@ -100,7 +105,7 @@ func (actor Actor) Apply(config ApplicationConfig, progressBar ProgressBar) (<-c
 			defer os.Remove(archivePath)
 
 			for count := 0; count < PushRetries; count++ {
-				warnings, err = actor.UploadPackage(config, archivePath, progressBar, eventStream)
+				config, warnings, err = actor.UploadPackage(config, archivePath, progressBar, eventStream)
 				warningsStream <- warnings
 				if _, ok := err.(ccerror.PipeSeekError); !ok {
 					break
@@ -0,0 +1,50 @@
+package pushaction
+
+import (
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ApplyPlan describes the changes Apply would make for a given
+// ApplicationConfig without making them.
+type ApplyPlan struct {
+	AppName             string
+	CreatingApplication bool
+	RoutesToCreate      []string
+	RoutesToMap         []string
+	ServicesToBind      []string
+	UploadingFiles      bool
+}
+
+// PlanApply walks the same steps as Apply against the provided config, but
+// only records what would be created, mapped, bound and uploaded. It does
+// not make any API calls.
+func (actor Actor) PlanApply(config ApplicationConfig) ApplyPlan {
+	log.Infoln("planning apply for:", config.DesiredApplication.Name)
+
+	plan := ApplyPlan{
+		AppName:             config.DesiredApplication.Name,
+		CreatingApplication: config.CreatingApplication(),
+		UploadingFiles:      config.DesiredApplication.DockerImage == "",
+	}
+
+	for _, route := range config.DesiredRoutes {
+		if route.GUID == "" {
+			plan.RoutesToCreate = append(plan.RoutesToCreate, route.String())
+		}
+		if !actor.routeInListByGUID(route, config.CurrentRoutes) {
+			plan.RoutesToMap = append(plan.RoutesToMap, route.String())
+		}
+	}
+
+	for serviceInstanceName := range config.DesiredServices {
+		if _, ok := config.CurrentServices[serviceInstanceName]; !ok {
+			plan.ServicesToBind = append(plan.ServicesToBind, serviceInstanceName)
+		}
+	}
+	sort.Strings(plan.ServicesToBind)
+
+	log.Debugf("apply plan: %#v", plan)
+	return plan
+}
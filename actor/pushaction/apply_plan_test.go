@@ -0,0 +1,104 @@
+package pushaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Apply Plan", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		actor = NewActor(fakeV2Actor)
+	})
+
+	Describe("PlanApply", func() {
+		var (
+			config ApplicationConfig
+			plan   ApplyPlan
+		)
+
+		BeforeEach(func() {
+			config = ApplicationConfig{}
+			config.DesiredApplication.Name = "some-app"
+		})
+
+		JustBeforeEach(func() {
+			plan = actor.PlanApply(config)
+		})
+
+		It("does not make any API calls", func() {
+			Expect(fakeV2Actor.Invocations()).To(BeEmpty())
+		})
+
+		Context("when the app does not exist", func() {
+			It("plans to create the app and upload its files", func() {
+				Expect(plan).To(Equal(ApplyPlan{
+					AppName:             "some-app",
+					CreatingApplication: true,
+					UploadingFiles:      true,
+				}))
+			})
+		})
+
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				config.CurrentApplication.GUID = "some-app-guid"
+				config.DesiredApplication.GUID = "some-app-guid"
+			})
+
+			It("plans to update the app", func() {
+				Expect(plan.CreatingApplication).To(BeFalse())
+			})
+		})
+
+		Context("when the app is a docker app", func() {
+			BeforeEach(func() {
+				config.DesiredApplication.DockerImage = "some-docker-image"
+			})
+
+			It("does not plan to upload files", func() {
+				Expect(plan.UploadingFiles).To(BeFalse())
+			})
+		})
+
+		Context("when there are routes", func() {
+			BeforeEach(func() {
+				existingRoute := v2action.Route{GUID: "existing-route-guid", Host: "existing", Domain: v2action.Domain{Name: "some-domain.com"}}
+				unmappedRoute := v2action.Route{GUID: "unmapped-route-guid", Host: "unmapped", Domain: v2action.Domain{Name: "some-domain.com"}}
+				newRoute := v2action.Route{Host: "new", Domain: v2action.Domain{Name: "some-domain.com"}}
+
+				config.CurrentRoutes = []v2action.Route{existingRoute}
+				config.DesiredRoutes = []v2action.Route{existingRoute, unmappedRoute, newRoute}
+			})
+
+			It("plans to create the new routes and map the routes not bound to the app", func() {
+				Expect(plan.RoutesToCreate).To(Equal([]string{"new.some-domain.com"}))
+				Expect(plan.RoutesToMap).To(Equal([]string{"unmapped.some-domain.com", "new.some-domain.com"}))
+			})
+		})
+
+		Context("when there are services", func() {
+			BeforeEach(func() {
+				config.CurrentServices = map[string]v2action.ServiceInstance{"service_instance_1": {GUID: "instance_1_guid"}}
+				config.DesiredServices = map[string]v2action.ServiceInstance{
+					"service_instance_1": {GUID: "instance_1_guid"},
+					"service_instance_3": {GUID: "instance_3_guid"},
+					"service_instance_2": {GUID: "instance_2_guid"},
+				}
+			})
+
+			It("plans to bind the services not bound to the app, sorted by name", func() {
+				Expect(plan.ServicesToBind).To(Equal([]string{"service_instance_2", "service_instance_3"}))
+			})
+		})
+	})
+})
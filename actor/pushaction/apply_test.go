@@ -252,6 +252,33 @@ var _ = Describe("Apply", func() {
 						})
 					})
 
+					Context("when resource matching is disabled", func() {
+						BeforeEach(func() {
+							config.NoResourceMatching = true
+							config.AllResources = []v2action.Resource{
+								{Filename: "file-1", Size: 11, SHA1: "some-sha-1"},
+							}
+
+							tmpfile, err := ioutil.TempFile("", "fake-archive")
+							Expect(err).ToNot(HaveOccurred())
+							Expect(tmpfile.Close()).ToNot(HaveOccurred())
+							fakeV2Actor.ZipDirectoryResourcesReturns(tmpfile.Name(), nil)
+						})
+
+						It("skips resource matching and archives all resources", func() {
+							Eventually(eventStream).Should(Receive(Equal(CreatingArchive)))
+							Eventually(eventStream).Should(Receive(Equal(UploadComplete)))
+							Eventually(warningsStream).Should(Receive())
+							Eventually(configStream).Should(Receive())
+							Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+							Expect(fakeV2Actor.ResourceMatchCallCount()).To(Equal(0))
+							Expect(fakeV2Actor.ZipDirectoryResourcesCallCount()).To(Equal(1))
+							_, resources := fakeV2Actor.ZipDirectoryResourcesArgsForCall(0)
+							Expect(resources).To(Equal(config.AllResources))
+						})
+					})
+
 					Context("when a docker image is provided", func() {
 						BeforeEach(func() {
 							config.DesiredApplication.DockerImage = "some-docker-image-path"
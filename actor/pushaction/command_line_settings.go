@@ -2,7 +2,9 @@ package pushaction
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/types"
@@ -113,8 +115,27 @@ func (settings CommandLineSettings) String() string {
 }
 
 func (settings CommandLineSettings) absoluteProvidedAppPath() string {
-	if !filepath.IsAbs(settings.ProvidedAppPath) {
-		return filepath.Join(settings.CurrentDirectory, settings.ProvidedAppPath)
+	providedPath := settings.ProvidedAppPath
+	if filepath.IsAbs(providedPath) {
+		return providedPath
 	}
-	return settings.ProvidedAppPath
+
+	currentVolume := filepath.VolumeName(settings.CurrentDirectory)
+
+	// On Windows, a drive-relative path (C:some-path) is relative to the
+	// current directory of that drive, and a rooted path (\some-path) is
+	// relative to the root of the current drive.
+	if volume := filepath.VolumeName(providedPath); volume != "" {
+		if !strings.EqualFold(volume, currentVolume) {
+			if absPath, err := filepath.Abs(providedPath); err == nil {
+				return absPath
+			}
+			return providedPath
+		}
+		providedPath = providedPath[len(volume):]
+	} else if providedPath != "" && os.IsPathSeparator(providedPath[0]) {
+		return currentVolume + filepath.Clean(providedPath)
+	}
+
+	return filepath.Join(settings.CurrentDirectory, providedPath)
 }
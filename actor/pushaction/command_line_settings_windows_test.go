@@ -26,7 +26,20 @@ var _ = Describe("CommandLineSettings with provided path", func() {
 
 			Entry("path = provided path; provided path is absolute", "C:\\some\\path", "C:\\some\\path"),
 			Entry("path = full path to provided path; provided path is relative", ".\\some-path", "C:\\some\\current-directory\\some-path"),
+			Entry("path = full path to provided path; provided path is relative to the current drive", "C:some-path", "C:\\some\\current-directory\\some-path"),
+			Entry("path = full path to provided path; provided path is relative to the current drive root", "\\some-path", "C:\\some-path"),
+			Entry("path = provided path; provided path is a UNC path", "\\\\some-server\\some-share\\some-path", "\\\\some-server\\some-share\\some-path"),
 		)
+
+		It("resolves a path relative to another drive against that drive", func() {
+			settings := CommandLineSettings{
+				CurrentDirectory: currentDirectory,
+				ProvidedAppPath:  "Z:some-path",
+			}
+
+			Expect(settings.ApplicationPath()).To(HavePrefix("Z:\\"))
+			Expect(settings.ApplicationPath()).To(HaveSuffix("\\some-path"))
+		})
 	})
 
 	Describe("OverrideManifestSettings", func() {
@@ -15,6 +15,7 @@ const (
 	ResourceMatching     Event = "resource matching"
 	UploadingApplication Event = "uploading application"
 	UploadComplete       Event = "upload complete"
+	UploadDetached       Event = "upload detached"
 	RetryUpload          Event = "retry upload"
 	Complete             Event = "complete"
 )
@@ -68,9 +68,13 @@ func (app Application) String() string {
 
 func (app *Application) UnmarshalYAML(unmarshaller func(interface{}) error) error {
 	var manifestApp struct {
-		Buildpack               string            `yaml:"buildpack"`
-		Command                 string            `yaml:"command"`
-		DiskQuota               string            `yaml:"disk_quota"`
+		Buildpack string `yaml:"buildpack"`
+		Command   string `yaml:"command"`
+		DiskQuota string `yaml:"disk_quota"`
+		Docker    struct {
+			Image    string `yaml:"image"`
+			Username string `yaml:"username"`
+		} `yaml:"docker"`
 		EnvironmentVariables    map[string]string `yaml:"env"`
 		HealthCheckHTTPEndpoint string            `yaml:"health-check-http-endpoint"`
 		HealthCheckType         string            `yaml:"health-check-type"`
@@ -91,6 +95,8 @@ func (app *Application) UnmarshalYAML(unmarshaller func(interface{}) error) erro
 		return err
 	}
 
+	app.DockerImage = manifestApp.Docker.Image
+	app.DockerUsername = manifestApp.Docker.Username
 	app.HealthCheckHTTPEndpoint = manifestApp.HealthCheckHTTPEndpoint
 	app.HealthCheckType = manifestApp.HealthCheckType
 	app.Name = manifestApp.Name
@@ -76,6 +76,10 @@ applications:
 - name: "app-4"
   buildpack: null
   command: null
+- name: "app-5"
+  docker:
+    image: "some-docker-image"
+    username: "some-docker-username"
 `
 		})
 
@@ -138,6 +142,11 @@ applications:
 						Value: "",
 					},
 				},
+				Application{
+					Name:           "app-5",
+					DockerImage:    "some-docker-image",
+					DockerUsername: "some-docker-username",
+				},
 			))
 		})
 	})
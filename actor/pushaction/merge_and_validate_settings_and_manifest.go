@@ -3,6 +3,7 @@ package pushaction
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	log "github.com/sirupsen/logrus"
@@ -28,6 +29,26 @@ func (CommandLineOptionsWithMultipleAppsError) Error() string {
 	return "cannot use command line flag with multiple apps"
 }
 
+// PropertyCombinationError is returned when an application is configured with
+// properties that cannot be used together, such as a docker image and an app
+// path.
+type PropertyCombinationError struct {
+	AppName    string
+	Properties []string
+}
+
+func (e PropertyCombinationError) Error() string {
+	return fmt.Sprintf("application %s cannot use the combination of properties: %s", e.AppName, strings.Join(e.Properties, ", "))
+}
+
+// DockerPasswordNotSetError is returned when a docker username is provided
+// without a password.
+type DockerPasswordNotSetError struct{}
+
+func (DockerPasswordNotSetError) Error() string {
+	return "docker password not set"
+}
+
 type AppNotFoundInManifestError struct {
 	Name string
 }
@@ -109,6 +130,19 @@ func (Actor) validatePremergedSettings(settings CommandLineSettings, apps []mani
 			return CommandLineOptionsWithMultipleAppsError{}
 		}
 	}
+
+	for _, app := range apps {
+		dockerSet := settings.DockerImage != "" || app.DockerImage != ""
+		pathSet := settings.ProvidedAppPath != "" || app.Path != ""
+		if dockerSet && pathSet {
+			log.WithField("app", app.Name).Error("cannot use docker and path together")
+			return PropertyCombinationError{
+				AppName:    app.Name,
+				Properties: []string{"docker", "path"},
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -118,6 +152,10 @@ func (Actor) validateMergedSettings(apps []manifest.Application) error {
 			log.WithField("index", i).Error("does not contain an app name")
 			return MissingNameError{}
 		}
+		if app.DockerUsername != "" && app.DockerPassword == "" {
+			log.WithField("app", app.Name).Error("docker username provided without a password")
+			return DockerPasswordNotSetError{}
+		}
 		_, err := os.Stat(app.Path)
 		if os.IsNotExist(err) {
 			log.WithField("path", app.Path).Error("app path does not exist")
@@ -206,5 +206,21 @@ var _ = Describe("MergeAndValidateSettingsAndManifest", func() {
 		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{Memory: 4}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
 		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{ProvidedAppPath: "some-path"}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
 		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{StackName: "some-stackname"}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
+		Entry("PropertyCombinationError",
+			CommandLineSettings{DockerImage: "some-docker-image"},
+			[]manifest.Application{{Name: "some-name-1", Path: "some-path"}},
+			PropertyCombinationError{AppName: "some-name-1", Properties: []string{"docker", "path"}}),
+		Entry("PropertyCombinationError",
+			CommandLineSettings{ProvidedAppPath: "some-path"},
+			[]manifest.Application{{Name: "some-name-1", DockerImage: "some-docker-image"}},
+			PropertyCombinationError{AppName: "some-name-1", Properties: []string{"docker", "path"}}),
+		Entry("PropertyCombinationError",
+			CommandLineSettings{},
+			[]manifest.Application{{Name: "some-name-1", DockerImage: "some-docker-image", Path: "some-path"}},
+			PropertyCombinationError{AppName: "some-name-1", Properties: []string{"docker", "path"}}),
+		Entry("DockerPasswordNotSetError",
+			CommandLineSettings{},
+			[]manifest.Application{{Name: "some-name-1", DockerImage: "some-docker-image", DockerUsername: "some-username"}},
+			DockerPasswordNotSetError{}),
 	)
 })
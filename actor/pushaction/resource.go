@@ -41,19 +41,22 @@ func (actor Actor) SetMatchedResources(config ApplicationConfig) (ApplicationCon
 	return config, Warnings(warnings)
 }
 
-func (actor Actor) UploadPackage(config ApplicationConfig, archivePath string, progressbar ProgressBar, eventStream chan<- Event) (Warnings, error) {
+// UploadPackage uploads the application bits in the archive and waits for
+// the Cloud Controller to process them. When config.Detach is set it returns
+// once the bits are uploaded, with the processing job in config.UploadJobGUID.
+func (actor Actor) UploadPackage(config ApplicationConfig, archivePath string, progressbar ProgressBar, eventStream chan<- Event) (ApplicationConfig, Warnings, error) {
 	log.Info("uploading archive")
 	archive, err := os.Open(archivePath)
 	if err != nil {
 		log.WithField("archivePath", archivePath).Errorln("opening temp archive:", err)
-		return nil, err
+		return config, nil, err
 	}
 	defer archive.Close()
 
 	archiveInfo, err := archive.Stat()
 	if err != nil {
 		log.WithField("archivePath", archivePath).Errorln("stat temp archive:", err)
-		return nil, err
+		return config, nil, err
 	}
 
 	log.WithFields(log.Fields{
@@ -71,11 +74,19 @@ func (actor Actor) UploadPackage(config ApplicationConfig, archivePath string, p
 
 	if err != nil {
 		log.WithField("archivePath", archivePath).Errorln("streaming archive:", err)
-		return allWarnings, err
+		return config, allWarnings, err
 	}
+
+	if config.Detach {
+		log.WithField("jobGUID", job.GUID).Info("detaching from upload job")
+		eventStream <- UploadDetached
+		config.UploadJobGUID = job.GUID
+		return config, allWarnings, nil
+	}
+
 	eventStream <- UploadComplete
 	warnings, err = actor.V2Actor.PollJob(job)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 
-	return allWarnings, err
+	return config, allWarnings, err
 }
@@ -176,6 +176,7 @@ var _ = Describe("Resources", func() {
 	Describe("UploadPackage", func() {
 		var (
 			config          ApplicationConfig
+			updatedConfig   ApplicationConfig
 			archivePath     string
 			fakeProgressBar *pushactionfakes.FakeProgressBar
 			eventStream     chan Event
@@ -208,7 +209,7 @@ var _ = Describe("Resources", func() {
 		})
 
 		JustBeforeEach(func() {
-			warnings, executeErr = actor.UploadPackage(config, archivePath, fakeProgressBar, eventStream)
+			updatedConfig, warnings, executeErr = actor.UploadPackage(config, archivePath, fakeProgressBar, eventStream)
 		})
 
 		Context("when the archive can be accessed properly", func() {
@@ -290,6 +291,27 @@ var _ = Describe("Resources", func() {
 				})
 			})
 
+			Context("when the push is detached", func() {
+				BeforeEach(func() {
+					config.Detach = true
+					fakeV2Actor.UploadApplicationPackageReturns(v2action.Job{GUID: "some-job-guid"}, v2action.Warnings{"upload-warning"}, nil)
+
+					go func() {
+						defer GinkgoRecover()
+
+						Eventually(eventStream).Should(Receive(Equal(UploadingApplication)))
+						Eventually(eventStream).Should(Receive(Equal(UploadDetached)))
+					}()
+				})
+
+				It("returns the upload job without polling it", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("upload-warning"))
+					Expect(updatedConfig.UploadJobGUID).To(Equal("some-job-guid"))
+					Expect(fakeV2Actor.PollJobCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when the upload errors", func() {
 				var (
 					expectedErr error
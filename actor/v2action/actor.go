@@ -10,7 +10,11 @@ type Actor struct {
 	Config                Config
 	UAAClient             UAAClient
 
+	// RouterClient is only set by commands that talk to the Routing API.
+	RouterClient RouterClient
+
 	domainCache map[string]Domain
+	nameCache   *nameCache
 }
 
 // NewActor returns a new actor.
@@ -20,5 +24,6 @@ func NewActor(ccClient CloudControllerClient, uaaClient UAAClient, config Config
 		Config:                config,
 		UAAClient:             uaaClient,
 		domainCache:           map[string]Domain{},
+		nameCache:             newNameCache(),
 	}
 }
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/interrupt"
 )
 
 type ApplicationStateChange string
@@ -148,6 +149,14 @@ func (application Application) StagingFailedMessage() string {
 	return application.StagingFailedReason
 }
 
+// StagingFailedPlacement returns true when staging failed because no cell had
+// the resources or placement tags to run the staging task. These failures are
+// often transient and the staging can be retried.
+func (application Application) StagingFailedPlacement() bool {
+	return application.StagingFailedReason == "InsufficientResources" ||
+		application.StagingFailedReason == "NoCompatibleCell"
+}
+
 // StagingFailedNoAppDetected returns true when the staging failed due to a
 // NoAppDetectedError.
 func (application Application) StagingFailedNoAppDetected() bool {
@@ -233,29 +242,81 @@ func (actor Actor) GetApplicationByNameAndSpace(name string, spaceGUID string) (
 		}
 	}
 
+	actor.nameCache.saveApplicationGUID(spaceGUID, name, app[0].GUID)
 	return Application(app[0]), Warnings(warnings), nil
 }
 
-// GetApplicationsBySpace returns all applications in a space.
-func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warnings, error) {
-	ccv2Apps, warnings, err := actor.CloudControllerClient.GetApplications(
-		ccv2.Query{
-			Filter:   ccv2.SpaceGUIDFilter,
-			Operator: ccv2.EqualOperator,
-			Values:   []string{spaceGUID},
-		},
-	)
+// ApplicationFilter narrows the applications returned by
+// GetApplicationsBySpace. Empty fields do not filter.
+type ApplicationFilter struct {
+	// Names matches applications with any of the given names.
+	Names []string
+
+	// State matches applications in the given state.
+	State ccv2.ApplicationState
+}
+
+// GetApplicationsBySpace returns all applications in a space that match every
+// provided filter.
+func (actor Actor) GetApplicationsBySpace(spaceGUID string, filters ...ApplicationFilter) ([]Application, Warnings, error) {
+	ccv2Apps, warnings, err := actor.CloudControllerClient.GetApplications(applicationQueries(spaceGUID, filters)...)
 
 	if err != nil {
 		return []Application{}, Warnings(warnings), err
 	}
 
+	return convertApplications(ccv2Apps), Warnings(warnings), nil
+}
+
+// GetApplicationsBySpacePaged calls handlePage with each page of
+// applications in a space that match every provided filter as it is
+// retrieved. Returning an error from handlePage stops the pagination and
+// returns that error.
+func (actor Actor) GetApplicationsBySpacePaged(spaceGUID string, handlePage func([]Application) error, filters ...ApplicationFilter) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.GetApplicationsPaged(func(ccv2Apps []ccv2.Application) error {
+		return handlePage(convertApplications(ccv2Apps))
+	}, applicationQueries(spaceGUID, filters)...)
+
+	return Warnings(warnings), err
+}
+
+func spaceGUIDQuery(spaceGUID string) ccv2.Query {
+	return ccv2.Query{
+		Filter:   ccv2.SpaceGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Values:   []string{spaceGUID},
+	}
+}
+
+// applicationQueries converts the filters into Cloud Controller queries so
+// that only matching applications are returned.
+func applicationQueries(spaceGUID string, filters []ApplicationFilter) []ccv2.Query {
+	queries := []ccv2.Query{spaceGUIDQuery(spaceGUID)}
+	for _, filter := range filters {
+		if len(filter.Names) > 0 {
+			queries = append(queries, ccv2.Query{
+				Filter:   ccv2.NameFilter,
+				Operator: ccv2.InOperator,
+				Values:   filter.Names,
+			})
+		}
+		if filter.State != "" {
+			queries = append(queries, ccv2.Query{
+				Filter:   ccv2.StateFilter,
+				Operator: ccv2.EqualOperator,
+				Values:   []string{string(filter.State)},
+			})
+		}
+	}
+	return queries
+}
+
+func convertApplications(ccv2Apps []ccv2.Application) []Application {
 	apps := make([]Application, len(ccv2Apps))
 	for i, ccv2App := range ccv2Apps {
 		apps[i] = Application(ccv2App)
 	}
-
-	return apps, Warnings(warnings), nil
+	return apps
 }
 
 // GetRouteApplications returns a list of apps associated with the provided
@@ -432,11 +493,39 @@ func (actor Actor) RestageApplication(app Application, client NOAAClient, config
 
 // UpdateApplication updates an application.
 func (actor Actor) UpdateApplication(application Application) (Application, Warnings, error) {
+	if application.Name != "" {
+		actor.nameCache.forgetApplication(application.GUID)
+	}
+
 	app, warnings, err := actor.CloudControllerClient.UpdateApplication(ccv2.Application(application))
 	return Application(app), Warnings(warnings), err
 }
 
 func (actor Actor) pollStaging(app Application, config Config, allWarnings chan<- string) error {
+	for attempt := 1; ; attempt++ {
+		currentApplication, err := actor.waitForStaging(app, config, allWarnings)
+		switch {
+		case err != nil:
+			return err
+		case !currentApplication.StagingFailed():
+			return nil
+		case !currentApplication.StagingFailedPlacement() || attempt > config.StagingRetries():
+			if currentApplication.StagingFailedNoAppDetected() {
+				return StagingFailedNoAppDetectedError{Reason: currentApplication.StagingFailedMessage()}
+			}
+			return StagingFailedError{Reason: currentApplication.StagingFailedMessage()}
+		}
+
+		err = actor.retryStaging(currentApplication, attempt, config, allWarnings)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// waitForStaging polls the application until staging has completed or
+// failed, and returns the application in that state.
+func (actor Actor) waitForStaging(app Application, config Config, allWarnings chan<- string) (Application, error) {
 	timeout := time.Now().Add(config.StagingTimeout())
 	for time.Now().Before(timeout) {
 		currentApplication, warnings, err := actor.GetApplication(app.GUID)
@@ -446,18 +535,36 @@ func (actor Actor) pollStaging(app Application, config Config, allWarnings chan<
 
 		switch {
 		case err != nil:
-			return err
-		case currentApplication.StagingCompleted():
-			return nil
-		case currentApplication.StagingFailed():
-			if currentApplication.StagingFailedNoAppDetected() {
-				return StagingFailedNoAppDetectedError{Reason: currentApplication.StagingFailedMessage()}
-			}
-			return StagingFailedError{Reason: currentApplication.StagingFailedMessage()}
+			return Application{}, err
+		case currentApplication.StagingCompleted(), currentApplication.StagingFailed():
+			return currentApplication, nil
+		}
+
+		err = interrupt.Sleep(config.PollingInterval())
+		if err != nil {
+			return Application{}, err
 		}
-		time.Sleep(config.PollingInterval())
 	}
-	return StagingTimeoutError{Name: app.Name, Timeout: config.StagingTimeout()}
+	return Application{}, StagingTimeoutError{Name: app.Name, Timeout: config.StagingTimeout()}
+}
+
+// retryStaging reports why staging could not be placed, waits for a backoff
+// that doubles with every attempt and then restages the application.
+func (actor Actor) retryStaging(app Application, attempt int, config Config, allWarnings chan<- string) error {
+	backoff := config.PollingInterval() << uint(attempt-1)
+	allWarnings <- fmt.Sprintf("Staging could not be placed on a cell: %s. Retrying in %s (retry %d of %d)...", app.StagingFailedMessage(), backoff, attempt, config.StagingRetries())
+	err := interrupt.Sleep(backoff)
+	if err != nil {
+		return err
+	}
+
+	_, warnings, err := actor.CloudControllerClient.RestageApplication(ccv2.Application{
+		GUID: app.GUID,
+	})
+	for _, warning := range warnings {
+		allWarnings <- warning
+	}
+	return err
 }
 
 func (actor Actor) pollStartup(app Application, config Config, allWarnings chan<- string) error {
@@ -481,7 +588,11 @@ func (actor Actor) pollStartup(app Application, config Config, allWarnings chan<
 				return ApplicationInstanceFlappingError{Name: app.Name}
 			}
 		}
-		time.Sleep(config.PollingInterval())
+
+		err = interrupt.Sleep(config.PollingInterval())
+		if err != nil {
+			return err
+		}
 	}
 
 	return StartupTimeoutError{Name: app.Name}
@@ -0,0 +1,61 @@
+package v2action
+
+import "sync"
+
+// ApplicationConfiguration is the configuration of an application that can
+// be compared between spaces.
+type ApplicationConfiguration struct {
+	ApplicationSummary
+	ServiceInstances []ServiceInstance
+}
+
+// GetApplicationConfigurationByNameAndSpace returns the summary and bound
+// service instances of the named application in the given space.
+func (actor Actor) GetApplicationConfigurationByNameAndSpace(name string, spaceGUID string) (ApplicationConfiguration, Warnings, error) {
+	summary, allWarnings, err := actor.GetApplicationSummaryByNameAndSpace(name, spaceGUID)
+	if err != nil {
+		return ApplicationConfiguration{}, allWarnings, err
+	}
+
+	serviceInstances, warnings, err := actor.GetServiceInstancesByApplication(summary.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ApplicationConfiguration{}, allWarnings, err
+	}
+
+	return ApplicationConfiguration{
+		ApplicationSummary: summary,
+		ServiceInstances:   serviceInstances,
+	}, allWarnings, nil
+}
+
+// GetApplicationConfigurationsByNameAndSpaces returns the configuration of
+// the named application in both spaces. The spaces are fetched in parallel;
+// the warnings from both are returned, and an error from the first space
+// takes precedence over one from the other space.
+func (actor Actor) GetApplicationConfigurationsByNameAndSpaces(name string, spaceGUID string, otherSpaceGUID string) (ApplicationConfiguration, ApplicationConfiguration, Warnings, error) {
+	var (
+		configs     [2]ApplicationConfiguration
+		errs        [2]error
+		allWarnings [2]Warnings
+		wg          sync.WaitGroup
+	)
+
+	for i, guid := range []string{spaceGUID, otherSpaceGUID} {
+		wg.Add(1)
+		go func(i int, guid string) {
+			defer wg.Done()
+			configs[i], allWarnings[i], errs[i] = actor.GetApplicationConfigurationByNameAndSpace(name, guid)
+		}(i, guid)
+	}
+	wg.Wait()
+
+	warnings := append(allWarnings[0], allWarnings[1]...)
+	for _, err := range errs {
+		if err != nil {
+			return ApplicationConfiguration{}, ApplicationConfiguration{}, warnings, err
+		}
+	}
+
+	return configs[0], configs[1], warnings, nil
+}
@@ -0,0 +1,125 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application Configuration Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+		fakeCloudControllerClient.GetApplicationsStub = func(queries ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+			spaceGUID := queries[1].Values[0]
+			return []ccv2.Application{
+					{
+						GUID:      spaceGUID + "-app-guid",
+						Name:      "some-app",
+						Memory:    256,
+						SpaceGUID: spaceGUID,
+					},
+				},
+				ccv2.Warnings{spaceGUID + "-app-warning"},
+				nil
+		}
+		fakeCloudControllerClient.GetApplicationRoutesStub = func(appGUID string, _ ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+			return nil, ccv2.Warnings{appGUID + "-routes-warning"}, nil
+		}
+		fakeCloudControllerClient.GetStackReturns(ccv2.Stack{Name: "some-stack"}, nil, nil)
+		fakeCloudControllerClient.GetServiceBindingsStub = func(queries ...ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
+			appGUID := queries[0].Values[0]
+			return []ccv2.ServiceBinding{{ServiceInstanceGUID: appGUID + "-instance-guid"}}, ccv2.Warnings{appGUID + "-bindings-warning"}, nil
+		}
+		fakeCloudControllerClient.GetServiceInstanceStub = func(guid string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
+			return ccv2.ServiceInstance{GUID: guid, Name: guid + "-name"}, nil, nil
+		}
+	})
+
+	Describe("GetApplicationConfigurationByNameAndSpace", func() {
+		It("returns the application summary with its service instances", func() {
+			config, warnings, err := actor.GetApplicationConfigurationByNameAndSpace("some-app", "some-space-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"some-space-guid-app-warning",
+				"some-space-guid-app-guid-routes-warning",
+				"some-space-guid-app-guid-bindings-warning",
+			))
+
+			Expect(config.Name).To(Equal("some-app"))
+			Expect(config.Memory).To(BeEquivalentTo(256))
+			Expect(config.Stack.Name).To(Equal("some-stack"))
+			Expect(config.ServiceInstances).To(ConsistOf(ServiceInstance{
+				GUID: "some-space-guid-app-guid-instance-guid",
+				Name: "some-space-guid-app-guid-instance-guid-name",
+			}))
+		})
+
+		Context("when getting the service instances fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("bindings error")
+				fakeCloudControllerClient.GetServiceBindingsStub = nil
+				fakeCloudControllerClient.GetServiceBindingsReturns(nil, ccv2.Warnings{"bindings-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetApplicationConfigurationByNameAndSpace("some-app", "some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("bindings-warning"))
+			})
+		})
+	})
+
+	Describe("GetApplicationConfigurationsByNameAndSpaces", func() {
+		It("returns the configuration of the application in both spaces", func() {
+			config, otherConfig, warnings, err := actor.GetApplicationConfigurationsByNameAndSpaces("some-app", "space-1", "space-2")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.GUID).To(Equal("space-1-app-guid"))
+			Expect(config.ServiceInstances).To(HaveLen(1))
+			Expect(otherConfig.GUID).To(Equal("space-2-app-guid"))
+			Expect(otherConfig.ServiceInstances).To(HaveLen(1))
+
+			Expect(warnings).To(ConsistOf(
+				"space-1-app-warning",
+				"space-1-app-guid-routes-warning",
+				"space-1-app-guid-bindings-warning",
+				"space-2-app-warning",
+				"space-2-app-guid-routes-warning",
+				"space-2-app-guid-bindings-warning",
+			))
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(2))
+		})
+
+		Context("when the application does not exist in the other space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsStub = func(queries ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+					spaceGUID := queries[1].Values[0]
+					if spaceGUID == "space-2" {
+						return nil, ccv2.Warnings{"space-2-app-warning"}, nil
+					}
+					return []ccv2.Application{{GUID: "space-1-app-guid", Name: "some-app"}}, ccv2.Warnings{"space-1-app-warning"}, nil
+				}
+			})
+
+			It("returns an ApplicationNotFoundError and the warnings from both spaces", func() {
+				_, _, warnings, err := actor.GetApplicationConfigurationsByNameAndSpaces("some-app", "space-1", "space-2")
+				Expect(err).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ContainElement("space-1-app-warning"))
+				Expect(warnings).To(ContainElement("space-2-app-warning"))
+			})
+		})
+	})
+})
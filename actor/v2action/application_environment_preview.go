@@ -0,0 +1,258 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// UserProvidedServiceLabel is the VCAP_SERVICES label of user provided service
+// instances.
+const UserProvidedServiceLabel = "user-provided"
+
+// VCAPService is an entry of the VCAP_SERVICES environment variable.
+type VCAPService struct {
+	Name           string                   `json:"name"`
+	InstanceName   string                   `json:"instance_name"`
+	BindingName    *string                  `json:"binding_name"`
+	Credentials    map[string]interface{}   `json:"credentials"`
+	SyslogDrainURL *string                  `json:"syslog_drain_url"`
+	VolumeMounts   []map[string]interface{} `json:"volume_mounts"`
+	Label          string                   `json:"label"`
+	Plan           string                   `json:"plan,omitempty"`
+	Tags           []string                 `json:"tags"`
+}
+
+// VCAPServices is the VCAP_SERVICES environment variable, mapping service
+// labels to the bound service instances.
+type VCAPServices map[string][]VCAPService
+
+// VCAPApplication is the part of the VCAP_APPLICATION environment variable
+// that is known before the application is started.
+type VCAPApplication struct {
+	ApplicationID   string                `json:"application_id"`
+	ApplicationName string                `json:"application_name"`
+	ApplicationURIs []string              `json:"application_uris"`
+	Limits          VCAPApplicationLimits `json:"limits"`
+	Name            string                `json:"name"`
+	SpaceID         string                `json:"space_id"`
+	URIs            []string              `json:"uris"`
+}
+
+// VCAPApplicationLimits are the per instance limits of VCAP_APPLICATION, in
+// megabytes.
+type VCAPApplicationLimits struct {
+	Disk uint64 `json:"disk"`
+	Mem  uint64 `json:"mem"`
+}
+
+// ApplicationEnvironmentPreview compares the service environment of an
+// application with the one it would have after binding and unbinding service
+// instances. It is computed from the binding data, so it reflects what the
+// next restage uses rather than the running instances.
+type ApplicationEnvironmentPreview struct {
+	CurrentVCAPServices VCAPServices
+	PlannedVCAPServices VCAPServices
+	VCAPApplication     VCAPApplication
+
+	// PendingCredentials are the names of the managed service instances to be
+	// bound. Their credentials are created by the service broker on bind, so
+	// they are empty in PlannedVCAPServices.
+	PendingCredentials []string
+}
+
+// GetApplicationEnvironmentPreview returns the current service environment
+// of the application, and the one it would have after binding the service
+// instances in bind and unbinding the ones in unbind. Instances that are
+// already bound, or not bound, are left as they are.
+func (actor Actor) GetApplicationEnvironmentPreview(appName string, spaceGUID string, bind []string, unbind []string) (ApplicationEnvironmentPreview, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return ApplicationEnvironmentPreview{}, allWarnings, err
+	}
+
+	instances, warnings, err := actor.GetServiceInstancesBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ApplicationEnvironmentPreview{}, allWarnings, err
+	}
+
+	instancesByGUID := map[string]ServiceInstance{}
+	instancesByName := map[string]ServiceInstance{}
+	for _, instance := range instances {
+		instancesByGUID[instance.GUID] = instance
+		instancesByName[instance.Name] = instance
+	}
+
+	for _, name := range append(append([]string{}, bind...), unbind...) {
+		if _, found := instancesByName[name]; !found {
+			return ApplicationEnvironmentPreview{}, allWarnings, ServiceInstanceNotFoundError{Name: name}
+		}
+	}
+
+	bindings, ccWarnings, err := actor.CloudControllerClient.GetServiceBindings(ccv2.Query{
+		Filter:   ccv2.AppGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Values:   []string{app.GUID},
+	})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return ApplicationEnvironmentPreview{}, allWarnings, err
+	}
+
+	unbinding := map[string]bool{}
+	for _, name := range unbind {
+		unbinding[name] = true
+	}
+
+	labels := serviceLabeler{actor: actor, plans: map[string]ccv2.ServicePlan{}, services: map[string]ccv2.Service{}}
+	preview := ApplicationEnvironmentPreview{
+		CurrentVCAPServices: VCAPServices{},
+		PlannedVCAPServices: VCAPServices{},
+	}
+
+	bound := map[string]bool{}
+	for _, binding := range bindings {
+		instance, found := instancesByGUID[binding.ServiceInstanceGUID]
+		if !found {
+			continue
+		}
+		bound[instance.Name] = true
+
+		entry, warnings, err := labels.vcapService(instance, ServiceBinding(binding))
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ApplicationEnvironmentPreview{}, allWarnings, err
+		}
+
+		preview.CurrentVCAPServices.add(entry)
+		if !unbinding[instance.Name] {
+			preview.PlannedVCAPServices.add(entry)
+		}
+	}
+
+	for _, name := range bind {
+		instance := instancesByName[name]
+		if bound[name] || unbinding[name] {
+			continue
+		}
+		bound[name] = true
+
+		binding := ServiceBinding{Credentials: map[string]interface{}{}}
+		if ccv2.ServiceInstance(instance).UserProvided() {
+			binding.Credentials = instance.Credentials
+		} else {
+			preview.PendingCredentials = append(preview.PendingCredentials, name)
+		}
+
+		entry, warnings, err := labels.vcapService(instance, binding)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ApplicationEnvironmentPreview{}, allWarnings, err
+		}
+		preview.PlannedVCAPServices.add(entry)
+	}
+
+	routes, warnings, err := actor.GetApplicationRoutes(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ApplicationEnvironmentPreview{}, allWarnings, err
+	}
+
+	uris := []string{}
+	for _, route := range routes {
+		uris = append(uris, route.String())
+	}
+
+	preview.VCAPApplication = VCAPApplication{
+		ApplicationID:   app.GUID,
+		ApplicationName: app.Name,
+		ApplicationURIs: uris,
+		Limits: VCAPApplicationLimits{
+			Disk: app.DiskQuota,
+			Mem:  app.Memory,
+		},
+		Name:    app.Name,
+		SpaceID: spaceGUID,
+		URIs:    uris,
+	}
+
+	return preview, allWarnings, nil
+}
+
+func (services VCAPServices) add(entry VCAPService) {
+	entries := append(services[entry.Label], entry)
+	sort.Slice(entries, func(i int, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+	services[entry.Label] = entries
+}
+
+// serviceLabeler looks up the service and plan names of managed service
+// instances, fetching each plan and service once.
+type serviceLabeler struct {
+	actor    Actor
+	plans    map[string]ccv2.ServicePlan
+	services map[string]ccv2.Service
+}
+
+func (labeler serviceLabeler) vcapService(instance ServiceInstance, binding ServiceBinding) (VCAPService, Warnings, error) {
+	entry := VCAPService{
+		Name:         instance.Name,
+		InstanceName: instance.Name,
+		Credentials:  binding.Credentials,
+		VolumeMounts: binding.VolumeMounts,
+		Label:        UserProvidedServiceLabel,
+		Tags:         instance.Tags,
+	}
+	if binding.Name != "" {
+		entry.Name = binding.Name
+		entry.BindingName = &binding.Name
+	}
+	if binding.SyslogDrainURL != "" {
+		entry.SyslogDrainURL = &binding.SyslogDrainURL
+	}
+	if entry.Credentials == nil {
+		entry.Credentials = map[string]interface{}{}
+	}
+	if entry.VolumeMounts == nil {
+		entry.VolumeMounts = []map[string]interface{}{}
+	}
+	if entry.Tags == nil {
+		entry.Tags = []string{}
+	}
+
+	if ccv2.ServiceInstance(instance).UserProvided() {
+		return entry, nil, nil
+	}
+
+	var allWarnings Warnings
+	plan, found := labeler.plans[instance.ServicePlanGUID]
+	if !found {
+		var warnings ccv2.Warnings
+		var err error
+		plan, warnings, err = labeler.actor.CloudControllerClient.GetServicePlan(instance.ServicePlanGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return VCAPService{}, allWarnings, err
+		}
+		labeler.plans[instance.ServicePlanGUID] = plan
+	}
+
+	service, found := labeler.services[plan.ServiceGUID]
+	if !found {
+		var warnings ccv2.Warnings
+		var err error
+		service, warnings, err = labeler.actor.CloudControllerClient.GetService(plan.ServiceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return VCAPService{}, allWarnings, err
+		}
+		labeler.services[plan.ServiceGUID] = service
+	}
+
+	entry.Label = service.Label
+	entry.Plan = plan.Name
+	entry.Tags = append(append([]string{}, service.Tags...), instance.Tags...)
+	return entry, allWarnings, nil
+}
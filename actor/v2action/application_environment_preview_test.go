@@ -0,0 +1,179 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application Environment Preview Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetApplicationEnvironmentPreview", func() {
+		var (
+			bind   []string
+			unbind []string
+
+			preview    ApplicationEnvironmentPreview
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			bind = nil
+			unbind = nil
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app", Memory: 256, DiskQuota: 1024}},
+				ccv2.Warnings{"get-app-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{
+					{GUID: "db-guid", Name: "db", ServicePlanGUID: "plan-guid", Tags: []string{"instance-tag"}, Type: ccv2.ManagedService},
+					{GUID: "cache-guid", Name: "cache", ServicePlanGUID: "plan-guid", Type: ccv2.ManagedService},
+					{GUID: "ups-guid", Name: "ups", Credentials: map[string]interface{}{"url": "https://ups"}, Type: ccv2.UserProvidedService},
+				},
+				ccv2.Warnings{"get-instances-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceBindingsReturns(
+				[]ccv2.ServiceBinding{
+					{ServiceInstanceGUID: "db-guid", Name: "primary", Credentials: map[string]interface{}{"password": "secret"}},
+				},
+				ccv2.Warnings{"get-bindings-warning"},
+				nil)
+			fakeCloudControllerClient.GetServicePlanReturns(
+				ccv2.ServicePlan{GUID: "plan-guid", Name: "small", ServiceGUID: "service-guid"},
+				ccv2.Warnings{"get-plan-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceReturns(
+				ccv2.Service{GUID: "service-guid", Label: "p-mysql", Tags: []string{"mysql"}},
+				ccv2.Warnings{"get-service-warning"},
+				nil)
+			fakeCloudControllerClient.GetApplicationRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			preview, warnings, executeErr = actor.GetApplicationEnvironmentPreview("some-app", "some-space-guid", bind, unbind)
+		})
+
+		Context("when there are no changes", func() {
+			It("returns the same current and planned services", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-instances-warning", "get-bindings-warning", "get-plan-warning", "get-service-warning", "get-routes-warning"))
+
+				bindingName := "primary"
+				Expect(preview.CurrentVCAPServices).To(Equal(VCAPServices{
+					"p-mysql": {
+						{
+							Name:         "primary",
+							InstanceName: "db",
+							BindingName:  &bindingName,
+							Credentials:  map[string]interface{}{"password": "secret"},
+							VolumeMounts: []map[string]interface{}{},
+							Label:        "p-mysql",
+							Plan:         "small",
+							Tags:         []string{"mysql", "instance-tag"},
+						},
+					},
+				}))
+				Expect(preview.PlannedVCAPServices).To(Equal(preview.CurrentVCAPServices))
+				Expect(preview.PendingCredentials).To(BeEmpty())
+
+				Expect(fakeCloudControllerClient.GetServiceBindingsArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.AppGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Values:   []string{"some-app-guid"},
+				}))
+			})
+
+			It("returns the application part of VCAP_APPLICATION", func() {
+				Expect(preview.VCAPApplication).To(Equal(VCAPApplication{
+					ApplicationID:   "some-app-guid",
+					ApplicationName: "some-app",
+					ApplicationURIs: []string{},
+					Limits:          VCAPApplicationLimits{Disk: 1024, Mem: 256},
+					Name:            "some-app",
+					SpaceID:         "some-space-guid",
+					URIs:            []string{},
+				}))
+			})
+		})
+
+		Context("when binding and unbinding service instances", func() {
+			BeforeEach(func() {
+				bind = []string{"cache", "ups", "db"}
+				unbind = []string{"db"}
+			})
+
+			It("computes the planned services from the binding data", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(preview.CurrentVCAPServices).To(HaveKey("p-mysql"))
+				Expect(preview.PlannedVCAPServices).To(Equal(VCAPServices{
+					"p-mysql": {
+						{
+							Name:         "cache",
+							InstanceName: "cache",
+							Credentials:  map[string]interface{}{},
+							VolumeMounts: []map[string]interface{}{},
+							Label:        "p-mysql",
+							Plan:         "small",
+							Tags:         []string{"mysql"},
+						},
+					},
+					"user-provided": {
+						{
+							Name:         "ups",
+							InstanceName: "ups",
+							Credentials:  map[string]interface{}{"url": "https://ups"},
+							VolumeMounts: []map[string]interface{}{},
+							Label:        "user-provided",
+							Tags:         []string{},
+						},
+					},
+				}))
+				Expect(preview.PendingCredentials).To(ConsistOf("cache"))
+			})
+
+			It("looks up each plan and service once", func() {
+				Expect(fakeCloudControllerClient.GetServicePlanCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServiceCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when a service instance does not exist", func() {
+			BeforeEach(func() {
+				bind = []string{"missing"}
+			})
+
+			It("returns a ServiceInstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ServiceInstanceNotFoundError{Name: "missing"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-instances-warning"))
+			})
+		})
+
+		Context("when getting the service plan fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlanReturns(ccv2.ServicePlan{}, ccv2.Warnings{"get-plan-warning"}, errors.New("plan-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("plan-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-instances-warning", "get-bindings-warning", "get-plan-warning"))
+			})
+		})
+	})
+})
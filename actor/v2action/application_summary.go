@@ -69,3 +69,69 @@ func (actor Actor) GetApplicationSummaryByNameAndSpace(name string, spaceGUID st
 
 	return applicationSummary, allWarnings, nil
 }
+
+// ApplicationWithInstancesAndRoutes is an application along with the number
+// of its running instances and the routes mapped to it.
+type ApplicationWithInstancesAndRoutes struct {
+	Application
+	RunningInstances int
+	Routes           Routes
+}
+
+// GetApplicationsWithInstancesAndRoutesBySpacePaged calls handlePage with each
+// page of applications in a space that match every provided filter, along
+// with their running instance counts and routes, as the page is retrieved. Returning an error from handlePage
+// stops the pagination and returns that error.
+func (actor Actor) GetApplicationsWithInstancesAndRoutesBySpacePaged(spaceGUID string, handlePage func([]ApplicationWithInstancesAndRoutes) error, filters ...ApplicationFilter) (Warnings, error) {
+	var allWarnings Warnings
+
+	warnings, err := actor.GetApplicationsBySpacePaged(spaceGUID, func(apps []Application) error {
+		appsWithInstancesAndRoutes := make([]ApplicationWithInstancesAndRoutes, 0, len(apps))
+		for _, app := range apps {
+			appWithInstancesAndRoutes, warnings, err := actor.getApplicationInstancesAndRoutes(app)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return err
+			}
+			appsWithInstancesAndRoutes = append(appsWithInstancesAndRoutes, appWithInstancesAndRoutes)
+		}
+
+		return handlePage(appsWithInstancesAndRoutes)
+	}, filters...)
+
+	return append(warnings, allWarnings...), err
+}
+
+func (actor Actor) getApplicationInstancesAndRoutes(app Application) (ApplicationWithInstancesAndRoutes, Warnings, error) {
+	var allWarnings Warnings
+	appWithInstancesAndRoutes := ApplicationWithInstancesAndRoutes{Application: app}
+
+	// cloud controller calls the instance reporter only when the desired
+	// application state is STARTED
+	if app.Started() {
+		instances, warnings, err := actor.GetApplicationInstancesByApplication(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+
+		switch err.(type) {
+		case nil:
+			for _, instance := range instances {
+				if instance.Running() {
+					appWithInstancesAndRoutes.RunningInstances++
+				}
+			}
+		case ApplicationInstancesNotFoundError:
+			// no instances are running
+		default:
+			return ApplicationWithInstancesAndRoutes{}, allWarnings, err
+		}
+	}
+
+	routes, warnings, err := actor.GetApplicationRoutes(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ApplicationWithInstancesAndRoutes{}, allWarnings, err
+	}
+	appWithInstancesAndRoutes.Routes = routes
+
+	return appWithInstancesAndRoutes, allWarnings, nil
+}
@@ -238,4 +238,94 @@ var _ = Describe("Application Summary Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationsWithInstancesAndRoutesBySpacePaged", func() {
+		var (
+			actor                     *Actor
+			fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+
+			pages      [][]ApplicationWithInstancesAndRoutes
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil, nil)
+			pages = nil
+
+			fakeCloudControllerClient.GetApplicationsPagedStub = func(handlePage func([]ccv2.Application) error, _ ...ccv2.Query) (ccv2.Warnings, error) {
+				for _, page := range [][]ccv2.Application{
+					{{GUID: "started-app-guid", Name: "started-app", State: ccv2.ApplicationStarted}},
+					{{GUID: "stopped-app-guid", Name: "stopped-app", State: ccv2.ApplicationStopped}},
+				} {
+					if err := handlePage(page); err != nil {
+						return ccv2.Warnings{"get-apps-warning"}, err
+					}
+				}
+				return ccv2.Warnings{"get-apps-warning"}, nil
+			}
+			fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(
+				map[int]ccv2.ApplicationInstance{
+					0: {ID: 0, State: ccv2.ApplicationInstanceRunning},
+					1: {ID: 1, State: ccv2.ApplicationInstanceCrashed},
+					2: {ID: 2, State: ccv2.ApplicationInstanceRunning},
+				},
+				ccv2.Warnings{"get-instances-warning"},
+				nil)
+			fakeCloudControllerClient.GetApplicationRoutesReturns(
+				[]ccv2.Route{{GUID: "route-guid", Host: "host"}},
+				ccv2.Warnings{"get-routes-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.GetApplicationsWithInstancesAndRoutesBySpacePaged("some-space-guid", func(page []ApplicationWithInstancesAndRoutes) error {
+				pages = append(pages, page)
+				return nil
+			})
+		})
+
+		It("calls handlePage with each page of applications, their running instance counts and routes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-apps-warning", "get-instances-warning", "get-routes-warning", "get-routes-warning"))
+
+			Expect(pages).To(HaveLen(2))
+			Expect(pages[0]).To(ConsistOf(ApplicationWithInstancesAndRoutes{
+				Application:      Application{GUID: "started-app-guid", Name: "started-app", State: ccv2.ApplicationStarted},
+				RunningInstances: 2,
+				Routes:           Routes{{GUID: "route-guid", Host: "host"}},
+			}))
+			Expect(pages[1]).To(ConsistOf(ApplicationWithInstancesAndRoutes{
+				Application: Application{GUID: "stopped-app-guid", Name: "stopped-app", State: ccv2.ApplicationStopped},
+				Routes:      Routes{{GUID: "route-guid", Host: "host"}},
+			}))
+
+			Expect(fakeCloudControllerClient.GetApplicationInstancesByApplicationCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationInstancesByApplicationArgsForCall(0)).To(Equal("started-app-guid"))
+		})
+
+		Context("when the instances have not been reported yet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(nil, nil, ccerror.NotStagedError{})
+			})
+
+			It("counts no running instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(pages[0][0].RunningInstances).To(Equal(0))
+			})
+		})
+
+		Context("when getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, errors.New("routes-error"))
+			})
+
+			It("stops and returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("routes-error"))
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-instances-warning", "get-routes-warning"))
+				Expect(pages).To(BeEmpty())
+			})
+		})
+	})
 })
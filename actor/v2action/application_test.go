@@ -169,6 +169,29 @@ var _ = Describe("Application Actions", func() {
 			})
 		})
 
+		Describe("StagingFailedPlacement", func() {
+			Context("when staging the application fails due to insufficient resources", func() {
+				It("returns true", func() {
+					app.StagingFailedReason = "InsufficientResources"
+					Expect(app.StagingFailedPlacement()).To(BeTrue())
+				})
+			})
+
+			Context("when staging the application fails due to no compatible cell", func() {
+				It("returns true", func() {
+					app.StagingFailedReason = "NoCompatibleCell"
+					Expect(app.StagingFailedPlacement()).To(BeTrue())
+				})
+			})
+
+			Context("when staging the application fails due to any other reason", func() {
+				It("returns false", func() {
+					app.StagingFailedReason = "NoAppDetectedError"
+					Expect(app.StagingFailedPlacement()).To(BeFalse())
+				})
+			})
+		})
+
 		Describe("StagingFailedNoAppDetected", func() {
 			Context("when staging the application fails due to a no app detected error", func() {
 				It("returns true", func() {
@@ -410,6 +433,56 @@ var _ = Describe("Application Actions", func() {
 			})
 		})
 
+		Context("when filters are provided", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{
+						{
+							GUID:  "some-app-guid-1",
+							Name:  "some-app-1",
+							State: ccv2.ApplicationStarted,
+						},
+						{
+							GUID:  "some-app-guid-2",
+							Name:  "some-app-2",
+							State: ccv2.ApplicationStopped,
+						},
+					},
+					ccv2.Warnings{"warning-1", "warning-2"},
+					nil,
+				)
+			})
+
+			It("queries the applications by name and state", func() {
+				apps, warnings, err := actor.GetApplicationsBySpace("some-space-guid", ApplicationFilter{
+					Names: []string{"some-app-1", "some-app-2"},
+					State: ccv2.ApplicationStarted,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(apps).To(HaveLen(2))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf([]ccv2.Query{
+					ccv2.Query{
+						Filter:   ccv2.SpaceGUIDFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"some-space-guid"},
+					},
+					ccv2.Query{
+						Filter:   ccv2.NameFilter,
+						Operator: ccv2.InOperator,
+						Values:   []string{"some-app-1", "some-app-2"},
+					},
+					ccv2.Query{
+						Filter:   ccv2.StateFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"STARTED"},
+					},
+				}))
+			})
+		})
+
 		Context("when the cloud controller client returns an error", func() {
 			var expectedError error
 
@@ -429,6 +502,83 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationsBySpacePaged", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsPagedStub = func(handlePage func([]ccv2.Application) error, _ ...ccv2.Query) (ccv2.Warnings, error) {
+				for _, page := range [][]ccv2.Application{
+					{{GUID: "some-app-guid-1", Name: "some-app-1"}},
+					{{GUID: "some-app-guid-2", Name: "some-app-2"}},
+				} {
+					if err := handlePage(page); err != nil {
+						return ccv2.Warnings{"warning-1"}, err
+					}
+				}
+				return ccv2.Warnings{"warning-1", "warning-2"}, nil
+			}
+		})
+
+		It("calls handlePage with each page of applications in the space", func() {
+			var pages [][]Application
+			warnings, err := actor.GetApplicationsBySpacePaged("some-space-guid", func(page []Application) error {
+				pages = append(pages, page)
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			Expect(pages).To(Equal([][]Application{
+				{{GUID: "some-app-guid-1", Name: "some-app-1"}},
+				{{GUID: "some-app-guid-2", Name: "some-app-2"}},
+			}))
+
+			Expect(fakeCloudControllerClient.GetApplicationsPagedCallCount()).To(Equal(1))
+			_, queries := fakeCloudControllerClient.GetApplicationsPagedArgsForCall(0)
+			Expect(queries).To(ConsistOf(ccv2.Query{
+				Filter:   ccv2.SpaceGUIDFilter,
+				Operator: ccv2.EqualOperator,
+				Values:   []string{"some-space-guid"},
+			}))
+		})
+
+		Context("when filters are provided", func() {
+			It("sends them to the Cloud Controller as queries", func() {
+				_, err := actor.GetApplicationsBySpacePaged("some-space-guid", func([]Application) error {
+					return nil
+				}, ApplicationFilter{Names: []string{"some-app-1"}}, ApplicationFilter{State: ccv2.ApplicationStopped})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, queries := fakeCloudControllerClient.GetApplicationsPagedArgsForCall(0)
+				Expect(queries).To(ConsistOf(
+					ccv2.Query{
+						Filter:   ccv2.SpaceGUIDFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"some-space-guid"},
+					},
+					ccv2.Query{
+						Filter:   ccv2.NameFilter,
+						Operator: ccv2.InOperator,
+						Values:   []string{"some-app-1"},
+					},
+					ccv2.Query{
+						Filter:   ccv2.StateFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"STOPPED"},
+					},
+				))
+			})
+		})
+
+		Context("when handlePage returns an error", func() {
+			It("returns the error and warnings", func() {
+				expectedErr := errors.New("stop")
+				warnings, err := actor.GetApplicationsBySpacePaged("some-space-guid", func([]Application) error {
+					return expectedErr
+				})
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("GetRouteApplications", func() {
 		Context("when the CC client returns no errors", func() {
 			BeforeEach(func() {
@@ -817,6 +967,95 @@ var _ = Describe("Application Actions", func() {
 					})
 				})
 
+				Context("when the application fails to stage because it could not be placed", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.RestageApplicationReturns(ccv2.Application{GUID: "some-app-guid",
+							Instances: types.NullInt{Value: 2, IsSet: true},
+							Name:      "some-app",
+						}, ccv2.Warnings{"state-warning"}, nil)
+						fakeCloudControllerClient.GetApplicationStub = func(appGUID string) (ccv2.Application, ccv2.Warnings, error) {
+							return ccv2.Application{
+								GUID:                     "some-app-guid",
+								Name:                     "some-app",
+								Instances:                types.NullInt{Value: 2, IsSet: true},
+								PackageState:             ccv2.ApplicationPackageFailed,
+								StagingFailedReason:      "InsufficientResources",
+								StagingFailedDescription: "Insufficient resources: memory",
+							}, ccv2.Warnings{"app-warnings-1"}, nil
+						}
+					})
+
+					Context("when staging retries are not configured", func() {
+						It("sends a StagingFailedError without retrying", func() {
+							Eventually(appState).Should(Receive(Equal(ApplicationStateStaging)))
+							Eventually(warnings).Should(Receive(Equal("state-warning")))
+							Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
+							Eventually(errs).Should(Receive(MatchError(StagingFailedError{Reason: "Insufficient resources: memory"})))
+
+							Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(0))
+							Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when staging retries are configured", func() {
+						BeforeEach(func() {
+							fakeConfig.StagingRetriesReturns(2)
+						})
+
+						Context("when a retry stages successfully", func() {
+							BeforeEach(func() {
+								failedStub := fakeCloudControllerClient.GetApplicationStub
+								fakeCloudControllerClient.GetApplicationStub = func(appGUID string) (ccv2.Application, ccv2.Warnings, error) {
+									if fakeCloudControllerClient.GetApplicationCallCount() == 1 {
+										return failedStub(appGUID)
+									}
+									return ccv2.Application{
+										GUID:         "some-app-guid",
+										Name:         "some-app",
+										Instances:    types.NullInt{Value: 2, IsSet: true},
+										PackageState: ccv2.ApplicationPackageStaged,
+									}, ccv2.Warnings{"app-warnings-2"}, nil
+								}
+							})
+
+							It("reports the placement failure, restages and starts the application", func() {
+								Eventually(appState).Should(Receive(Equal(ApplicationStateStaging)))
+								Eventually(warnings).Should(Receive(Equal("state-warning")))
+								Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
+								Eventually(warnings).Should(Receive(Equal("Staging could not be placed on a cell: Insufficient resources: memory. Retrying in 0s (retry 1 of 2)...")))
+								Eventually(warnings).Should(Receive(Equal("state-warning")))
+								Eventually(warnings).Should(Receive(Equal("app-warnings-2")))
+								Eventually(appState).Should(Receive(Equal(ApplicationStateStarting)))
+								Eventually(warnings).Should(Receive(Equal("app-instance-warnings-1")))
+								Eventually(warnings).Should(Receive(Equal("app-instance-warnings-2")))
+								Eventually(errs).Should(BeClosed())
+
+								restagedApp := fakeCloudControllerClient.RestageApplicationArgsForCall(fakeCloudControllerClient.RestageApplicationCallCount() - 1)
+								Expect(restagedApp).To(Equal(ccv2.Application{GUID: "some-app-guid"}))
+								Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(2))
+							})
+						})
+
+						Context("when every retry fails to be placed", func() {
+							It("retries the configured number of times and sends a StagingFailedError", func() {
+								Eventually(appState).Should(Receive(Equal(ApplicationStateStaging)))
+								Eventually(warnings).Should(Receive(Equal("state-warning")))
+								Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
+								Eventually(warnings).Should(Receive(Equal("Staging could not be placed on a cell: Insufficient resources: memory. Retrying in 0s (retry 1 of 2)...")))
+								Eventually(warnings).Should(Receive(Equal("state-warning")))
+								Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
+								Eventually(warnings).Should(Receive(Equal("Staging could not be placed on a cell: Insufficient resources: memory. Retrying in 0s (retry 2 of 2)...")))
+								Eventually(warnings).Should(Receive(Equal("state-warning")))
+								Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
+								Eventually(errs).Should(Receive(MatchError(StagingFailedError{Reason: "Insufficient resources: memory"})))
+
+								Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(3))
+								Expect(fakeCloudControllerClient.GetApplicationInstancesByApplicationCallCount()).To(Equal(0))
+							})
+						})
+					})
+				})
+
 				Context("when the application takes too long to stage", func() {
 					BeforeEach(func() {
 						fakeConfig.StagingTimeoutReturns(0)
@@ -1,17 +1,38 @@
 package v2action
 
-import "fmt"
+import (
+	"fmt"
 
-// Authenticate authenticates the user in UAA and sets the returned tokens in
-// the config.
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+// PasswordGrantTypeLogoutRequiredError is returned when a user tries to
+// authenticate with their username and password while a client credentials
+// (service account) session is in effect.
+type PasswordGrantTypeLogoutRequiredError struct{}
+
+func (e PasswordGrantTypeLogoutRequiredError) Error() string {
+	return "Service account currently logged in. Logout to log in as a user."
+}
+
+// Authenticate authenticates the user or client in UAA and sets the returned
+// tokens in the config.
 //
-// It unsets the currently targeted org and space whether authentication
-// succeeds or not.
-func (actor Actor) Authenticate(config Config, username string, password string) error {
+// When origin is provided, the user is authenticated against that identity
+// provider. The client credentials and passcode grant types are persisted in
+// the config; the password grant is the default and is stored as empty. It
+// unsets the currently targeted org and space whether authentication succeeds
+// or not.
+func (actor Actor) Authenticate(config Config, ID string, secret string, origin string, grantType uaa.GrantType) error {
+	isUserGrant := grantType == uaa.GrantTypePassword || grantType == uaa.GrantTypePasscode
+	if isUserGrant && config.UAAGrantType() == string(uaa.GrantTypeClientCredentials) {
+		return PasswordGrantTypeLogoutRequiredError{}
+	}
+
 	config.UnsetOrganizationInformation()
 	config.UnsetSpaceInformation()
 
-	accessToken, refreshToken, err := actor.UAAClient.Authenticate(username, password)
+	accessToken, refreshToken, err := actor.UAAClient.Authenticate(ID, secret, origin, grantType)
 	if err != nil {
 		config.SetTokenInformation("", "", "")
 		return err
@@ -19,5 +40,22 @@ func (actor Actor) Authenticate(config Config, username string, password string)
 
 	accessToken = fmt.Sprintf("bearer %s", accessToken)
 	config.SetTokenInformation(accessToken, refreshToken, "")
+
+	switch grantType {
+	case uaa.GrantTypeClientCredentials:
+		config.SetUAAClientCredentials(ID, secret)
+		config.SetUAAGrantType(string(grantType))
+	case uaa.GrantTypePasscode:
+		config.SetUAAGrantType(string(grantType))
+	default:
+		config.SetUAAGrantType("")
+	}
+
 	return nil
 }
+
+// PasscodeURL returns the URL of the page where users obtain a one-time
+// passcode to log in with.
+func (actor Actor) PasscodeURL() string {
+	return fmt.Sprintf("%s/passcode", actor.UAAClient.LoginLink())
+}
@@ -5,6 +5,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -23,10 +24,19 @@ var _ = Describe("Auth Actions", func() {
 	})
 
 	Describe("Authenticate", func() {
-		var actualErr error
+		var (
+			origin    string
+			grantType uaa.GrantType
+			actualErr error
+		)
+
+		BeforeEach(func() {
+			origin = ""
+			grantType = uaa.GrantTypePassword
+		})
 
 		JustBeforeEach(func() {
-			actualErr = actor.Authenticate(fakeConfig, "some-username", "some-password")
+			actualErr = actor.Authenticate(fakeConfig, "some-username", "some-password", origin, grantType)
 		})
 
 		Context("when no API errors occur", func() {
@@ -42,9 +52,11 @@ var _ = Describe("Auth Actions", func() {
 				Expect(actualErr).NotTo(HaveOccurred())
 
 				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(1))
-				username, password := fakeUAAClient.AuthenticateArgsForCall(0)
-				Expect(username).To(Equal("some-username"))
-				Expect(password).To(Equal("some-password"))
+				ID, secret, passedOrigin, passedGrantType := fakeUAAClient.AuthenticateArgsForCall(0)
+				Expect(ID).To(Equal("some-username"))
+				Expect(secret).To(Equal("some-password"))
+				Expect(passedOrigin).To(BeEmpty())
+				Expect(passedGrantType).To(Equal(uaa.GrantTypePassword))
 
 				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
 				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
@@ -54,6 +66,84 @@ var _ = Describe("Auth Actions", func() {
 
 				Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
 				Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(BeEmpty())
+				Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(0))
+			})
+
+			Context("when an origin is provided", func() {
+				BeforeEach(func() {
+					origin = "some-origin"
+				})
+
+				It("authenticates the user against the origin", func() {
+					Expect(actualErr).NotTo(HaveOccurred())
+
+					_, _, passedOrigin, _ := fakeUAAClient.AuthenticateArgsForCall(0)
+					Expect(passedOrigin).To(Equal("some-origin"))
+				})
+			})
+
+			Context("when the grant type is client credentials", func() {
+				BeforeEach(func() {
+					grantType = uaa.GrantTypeClientCredentials
+				})
+
+				It("stores the grant type and the client credentials", func() {
+					Expect(actualErr).NotTo(HaveOccurred())
+
+					_, _, _, passedGrantType := fakeUAAClient.AuthenticateArgsForCall(0)
+					Expect(passedGrantType).To(Equal(uaa.GrantTypeClientCredentials))
+
+					Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+					Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal("client_credentials"))
+
+					Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(1))
+					client, clientSecret := fakeConfig.SetUAAClientCredentialsArgsForCall(0)
+					Expect(client).To(Equal("some-username"))
+					Expect(clientSecret).To(Equal("some-password"))
+				})
+			})
+
+			Context("when the grant type is passcode", func() {
+				BeforeEach(func() {
+					grantType = uaa.GrantTypePasscode
+				})
+
+				It("stores the grant type but no client credentials", func() {
+					Expect(actualErr).NotTo(HaveOccurred())
+
+					_, _, _, passedGrantType := fakeUAAClient.AuthenticateArgsForCall(0)
+					Expect(passedGrantType).To(Equal(uaa.GrantTypePasscode))
+
+					Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+					Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal("passcode"))
+					Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when a client credentials session is in effect and the password grant is requested", func() {
+			BeforeEach(func() {
+				fakeConfig.UAAGrantTypeReturns("client_credentials")
+			})
+
+			It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+				Expect(actualErr).To(MatchError(PasswordGrantTypeLogoutRequiredError{}))
+				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(0))
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(0))
+			})
+
+			Context("when the passcode grant is requested", func() {
+				BeforeEach(func() {
+					grantType = uaa.GrantTypePasscode
+				})
+
+				It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+					Expect(actualErr).To(MatchError(PasswordGrantTypeLogoutRequiredError{}))
+					Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(0))
+				})
 			})
 		})
 
@@ -73,9 +163,9 @@ var _ = Describe("Auth Actions", func() {
 				Expect(actualErr).To(MatchError(expectedErr))
 
 				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(1))
-				username, password := fakeUAAClient.AuthenticateArgsForCall(0)
-				Expect(username).To(Equal("some-username"))
-				Expect(password).To(Equal("some-password"))
+				ID, secret, _, _ := fakeUAAClient.AuthenticateArgsForCall(0)
+				Expect(ID).To(Equal("some-username"))
+				Expect(secret).To(Equal("some-password"))
 
 				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
 				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
@@ -85,7 +175,18 @@ var _ = Describe("Auth Actions", func() {
 
 				Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
 				Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(0))
 			})
 		})
 	})
+
+	Describe("PasscodeURL", func() {
+		BeforeEach(func() {
+			fakeUAAClient.LoginLinkReturns("https://login.example.com")
+		})
+
+		It("returns the passcode page of the login server", func() {
+			Expect(actor.PasscodeURL()).To(Equal("https://login.example.com/passcode"))
+		})
+	})
 })
@@ -0,0 +1,406 @@
+package v2action
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util"
+)
+
+type Buildpack ccv2.Buildpack
+
+// BuildpackNotFoundError is returned when a requested buildpack is not found.
+type BuildpackNotFoundError struct {
+	BuildpackName string
+	StackName     string
+}
+
+func (e BuildpackNotFoundError) Error() string {
+	if e.StackName == "" {
+		return fmt.Sprintf("Buildpack '%s' not found.", e.BuildpackName)
+	}
+	return fmt.Sprintf("Buildpack '%s' with stack '%s' not found.", e.BuildpackName, e.StackName)
+}
+
+// BuildpackNameTakenError is returned when creating a buildpack with a name
+// that is already used by a buildpack without a stack.
+type BuildpackNameTakenError struct {
+	Name string
+}
+
+func (e BuildpackNameTakenError) Error() string {
+	return fmt.Sprintf("Buildpack '%s' already exists.", e.Name)
+}
+
+// BuildpackAlreadyExistsForStackError is returned when the stack of a
+// buildpack's bits is already used by another buildpack with the same name.
+type BuildpackAlreadyExistsForStackError struct {
+	Message string
+}
+
+func (e BuildpackAlreadyExistsForStackError) Error() string {
+	return e.Message
+}
+
+// BuildpackStackChangeError is returned when assigning a stack to a buildpack
+// that is already associated with a stack.
+type BuildpackStackChangeError struct {
+	BuildpackName string
+}
+
+func (e BuildpackStackChangeError) Error() string {
+	return fmt.Sprintf("Buildpack '%s' is already associated with a stack.", e.BuildpackName)
+}
+
+// BuildpackZipInvalidError is returned when a buildpack archive does not
+// contain a buildpack.
+type BuildpackZipInvalidError struct {
+	Path string
+}
+
+func (e BuildpackZipInvalidError) Error() string {
+	return fmt.Sprintf("Archive '%s' does not contain a buildpack.", e.Path)
+}
+
+// MultipleBuildpacksFoundError is returned when more than one buildpack
+// matches a name and no stack is provided to tell them apart.
+type MultipleBuildpacksFoundError struct {
+	BuildpackName string
+}
+
+func (e MultipleBuildpacksFoundError) Error() string {
+	return fmt.Sprintf("Multiple buildpacks named '%s' found.", e.BuildpackName)
+}
+
+//go:generate counterfeiter . Downloader
+
+// Downloader downloads files into its save path.
+type Downloader interface {
+	DownloadFile(url string) (int64, string, error)
+	SavePath() string
+}
+
+//go:generate counterfeiter . SimpleProgressBar
+
+// SimpleProgressBar displays the progress of reading a file of a known size.
+type SimpleProgressBar interface {
+	NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader
+}
+
+// CreateBuildpack creates a buildpack with the provided name and position
+// without any bits.
+func (actor Actor) CreateBuildpack(name string, position int, enabled bool) (Buildpack, Warnings, error) {
+	buildpack, warnings, err := actor.CloudControllerClient.CreateBuildpack(ccv2.Buildpack{
+		Name:     name,
+		Position: types.NullInt{IsSet: true, Value: position},
+		Enabled:  types.NullBool{IsSet: true, Value: enabled},
+	})
+	if _, ok := err.(ccerror.BuildpackNameTakenError); ok {
+		return Buildpack{}, Warnings(warnings), BuildpackNameTakenError{Name: name}
+	}
+
+	return Buildpack(buildpack), Warnings(warnings), err
+}
+
+// PrepareBuildpackBits turns the provided buildpack path, which can be a
+// directory, a zip file or the URL of a zip file, into a zip file inside
+// tmpDirPath that has the buildpack at its root. The path of the zip file is
+// returned.
+func (actor Actor) PrepareBuildpackBits(inputPath string, tmpDirPath string, downloader Downloader) (string, error) {
+	if util.IsHTTPScheme(inputPath) {
+		_, filename, err := downloader.DownloadFile(inputPath)
+		if err != nil {
+			return "", err
+		}
+		return actor.normalizeBuildpackArchive(filepath.Join(downloader.SavePath(), filename), tmpDirPath)
+	}
+
+	info, err := os.Stat(inputPath)
+	if err != nil {
+		return "", err
+	}
+
+	if info.IsDir() {
+		return actor.zipBuildpackDirectory(inputPath, tmpDirPath)
+	}
+
+	return actor.normalizeBuildpackArchive(inputPath, tmpDirPath)
+}
+
+// UploadBuildpack uploads the zip file at pathToBuildpackBits to the
+// buildpack with the provided GUID, displaying the progress with the
+// provided progress bar.
+func (actor Actor) UploadBuildpack(guid string, pathToBuildpackBits string, progressBar SimpleProgressBar) (Warnings, error) {
+	file, err := os.Open(pathToBuildpackBits)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	reader := progressBar.NewProgressBarWrapper(file, info.Size())
+	warnings, err := actor.CloudControllerClient.UploadBuildpack(guid, filepath.Base(pathToBuildpackBits), reader, info.Size())
+	if e, ok := err.(ccerror.BuildpackAlreadyExistsForStackError); ok {
+		return Warnings(warnings), BuildpackAlreadyExistsForStackError{Message: e.Message}
+	}
+
+	return Warnings(warnings), err
+}
+
+// UpdateBuildpackByNameAndStack updates the set position, locked and enabled
+// properties of the buildpack with the provided name and stack, and assigns
+// newStack to it when newStack is provided. An empty stack selects the
+// buildpack without a stack when several buildpacks share the name. The
+// buildpack's GUID is returned.
+func (actor Actor) UpdateBuildpackByNameAndStack(name string, stack string, position types.NullInt, locked types.NullBool, enabled types.NullBool, newStack string) (string, Warnings, error) {
+	buildpack, allWarnings, err := actor.getBuildpack(name, stack)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	if newStack != "" && buildpack.Stack != "" {
+		return "", allWarnings, BuildpackStackChangeError{BuildpackName: name}
+	}
+
+	if !position.IsSet && !locked.IsSet && !enabled.IsSet && newStack == "" {
+		return buildpack.GUID, allWarnings, nil
+	}
+
+	_, warnings, err := actor.CloudControllerClient.UpdateBuildpack(ccv2.Buildpack{
+		GUID:     buildpack.GUID,
+		Position: position,
+		Locked:   locked,
+		Enabled:  enabled,
+		Stack:    newStack,
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if e, ok := err.(ccerror.BuildpackAlreadyExistsForStackError); ok {
+		return "", allWarnings, BuildpackAlreadyExistsForStackError{Message: e.Message}
+	}
+
+	return buildpack.GUID, allWarnings, err
+}
+
+// DeleteBuildpackByNameAndStack deletes the buildpack with the provided name
+// and stack, and waits for the deletion to complete. An empty stack selects
+// the buildpack without a stack when several buildpacks share the name.
+func (actor Actor) DeleteBuildpackByNameAndStack(name string, stack string) (Warnings, error) {
+	buildpack, allWarnings, err := actor.getBuildpack(name, stack)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	job, warnings, err := actor.CloudControllerClient.DeleteBuildpack(buildpack.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.PollJob(job)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+func (actor Actor) getBuildpack(name string, stack string) (Buildpack, Warnings, error) {
+	queries := []ccv2.Query{{
+		Filter:   ccv2.NameFilter,
+		Operator: ccv2.EqualOperator,
+		Values:   []string{name},
+	}}
+	if stack != "" {
+		queries = append(queries, ccv2.Query{
+			Filter:   ccv2.StackFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{stack},
+		})
+	}
+
+	buildpacks, warnings, err := actor.CloudControllerClient.GetBuildpacks(queries...)
+	if err != nil {
+		return Buildpack{}, Warnings(warnings), err
+	}
+
+	switch len(buildpacks) {
+	case 0:
+		return Buildpack{}, Warnings(warnings), BuildpackNotFoundError{BuildpackName: name, StackName: stack}
+	case 1:
+		return Buildpack(buildpacks[0]), Warnings(warnings), nil
+	}
+
+	for _, buildpack := range buildpacks {
+		if buildpack.Stack == "" {
+			return Buildpack(buildpack), Warnings(warnings), nil
+		}
+	}
+	return Buildpack{}, Warnings(warnings), MultipleBuildpacksFoundError{BuildpackName: name}
+}
+
+// normalizeBuildpackArchive copies the buildpack in the archive at
+// archivePath into a new zip file of the same name inside tmpDirPath,
+// removing any directories that the buildpack is nested in.
+func (Actor) normalizeBuildpackArchive(archivePath string, tmpDirPath string) (string, error) {
+	reader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	parentPath, found := findBuildpackPath(reader.File)
+	if !found {
+		return "", BuildpackZipInvalidError{Path: archivePath}
+	}
+
+	outputDir, err := ioutil.TempDir(tmpDirPath, "buildpack-")
+	if err != nil {
+		return "", err
+	}
+
+	outputPath := filepath.Join(outputDir, filepath.Base(archivePath))
+	outputFile, err := os.Create(outputPath)
+	if err != nil {
+		return "", err
+	}
+	defer outputFile.Close()
+
+	prefix := ""
+	if parentPath != "" {
+		prefix = parentPath + "/"
+	}
+
+	writer := zip.NewWriter(outputFile)
+	for _, file := range reader.File {
+		if !strings.HasPrefix(file.Name, prefix) {
+			continue
+		}
+
+		relativeName := strings.TrimPrefix(file.Name, prefix)
+		if relativeName == "" {
+			continue
+		}
+
+		err = copyZipEntry(file, relativeName, writer)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return outputPath, writer.Close()
+}
+
+// zipBuildpackDirectory zips the contents of sourceDir into a zip file named
+// after the directory inside tmpDirPath. On Windows, the filemode for user is
+// forced to be readable and executable.
+func (Actor) zipBuildpackDirectory(sourceDir string, tmpDirPath string) (string, error) {
+	absSourceDir, err := filepath.Abs(sourceDir)
+	if err != nil {
+		return "", err
+	}
+
+	outputPath := filepath.Join(tmpDirPath, filepath.Base(absSourceDir)+".zip")
+	outputFile, err := os.Create(outputPath)
+	if err != nil {
+		return "", err
+	}
+	defer outputFile.Close()
+
+	writer := zip.NewWriter(outputFile)
+	err = filepath.Walk(absSourceDir, func(fullPath string, info os.FileInfo, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+
+		relativePath, err := filepath.Rel(absSourceDir, fullPath)
+		if err != nil || relativePath == "." {
+			return err
+		}
+
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relativePath)
+		header.SetMode(fixMode(info.Mode()))
+
+		if info.IsDir() {
+			header.Name += "/"
+			_, err = writer.CreateHeader(header)
+			return err
+		}
+
+		header.Method = zip.Deflate
+		destFileWriter, err := writer.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		srcFile, err := os.Open(fullPath)
+		if err != nil {
+			return err
+		}
+		defer srcFile.Close()
+
+		_, err = io.Copy(destFileWriter, srcFile)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return outputPath, writer.Close()
+}
+
+// findBuildpackPath returns the directory in the archive that contains the
+// buildpack's bin/compile script.
+func findBuildpackPath(files []*zip.File) (string, bool) {
+	for _, file := range files {
+		if strings.HasSuffix(file.Name, "bin/compile") {
+			parentPath := path.Join(file.Name, "..", "..")
+			if parentPath == "." {
+				parentPath = ""
+			}
+			return parentPath, true
+		}
+	}
+	return "", false
+}
+
+func copyZipEntry(file *zip.File, name string, writer *zip.Writer) error {
+	header, err := zip.FileInfoHeader(file.FileInfo())
+	if err != nil {
+		return err
+	}
+	header.Name = name
+
+	if file.FileInfo().IsDir() {
+		_, err = writer.CreateHeader(header)
+		return err
+	}
+
+	header.Method = zip.Deflate
+	destFileWriter, err := writer.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	srcFile, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer srcFile.Close()
+
+	_, err = io.Copy(destFileWriter, srcFile)
+	return err
+}
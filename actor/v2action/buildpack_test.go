@@ -0,0 +1,516 @@
+package v2action_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Buildpack Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("CreateBuildpack", func() {
+		var (
+			buildpack  Buildpack
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			buildpack, warnings, executeErr = actor.CreateBuildpack("some-buildpack", 3, false)
+		})
+
+		Context("when creating the buildpack succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateBuildpackReturns(ccv2.Buildpack{GUID: "some-buildpack-guid", Name: "some-buildpack"}, ccv2.Warnings{"create-warning"}, nil)
+			})
+
+			It("creates the buildpack and returns it with warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(buildpack).To(Equal(Buildpack{GUID: "some-buildpack-guid", Name: "some-buildpack"}))
+
+				Expect(fakeCloudControllerClient.CreateBuildpackCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CreateBuildpackArgsForCall(0)).To(Equal(ccv2.Buildpack{
+					Name:     "some-buildpack",
+					Position: types.NullInt{IsSet: true, Value: 3},
+					Enabled:  types.NullBool{IsSet: true, Value: false},
+				}))
+			})
+		})
+
+		Context("when the buildpack name is taken", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateBuildpackReturns(ccv2.Buildpack{}, ccv2.Warnings{"create-warning"}, ccerror.BuildpackNameTakenError{Message: "taken"})
+			})
+
+			It("returns a BuildpackNameTakenError and warnings", func() {
+				Expect(executeErr).To(MatchError(BuildpackNameTakenError{Name: "some-buildpack"}))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+
+		Context("when creating the buildpack fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create failed")
+				fakeCloudControllerClient.CreateBuildpackReturns(ccv2.Buildpack{}, ccv2.Warnings{"create-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+
+	Describe("PrepareBuildpackBits", func() {
+		var (
+			tmpDirPath     string
+			fakeDownloader *v2actionfakes.FakeDownloader
+			inputPath      string
+			bitsPath       string
+			executeErr     error
+		)
+
+		writeZip := func(zipPath string, files map[string]string) {
+			zipFile, err := os.Create(zipPath)
+			Expect(err).ToNot(HaveOccurred())
+			defer zipFile.Close()
+
+			writer := zip.NewWriter(zipFile)
+			for name, contents := range files {
+				fileWriter, err := writer.Create(name)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = fileWriter.Write([]byte(contents))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			Expect(writer.Close()).To(Succeed())
+		}
+
+		readZip := func(zipPath string) map[string]string {
+			reader, err := zip.OpenReader(zipPath)
+			Expect(err).ToNot(HaveOccurred())
+			defer reader.Close()
+
+			files := map[string]string{}
+			for _, file := range reader.File {
+				if file.FileInfo().IsDir() {
+					files[file.Name] = ""
+					continue
+				}
+				fileReader, err := file.Open()
+				Expect(err).ToNot(HaveOccurred())
+				contents, err := ioutil.ReadAll(fileReader)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fileReader.Close()).To(Succeed())
+				files[file.Name] = string(contents)
+			}
+			return files
+		}
+
+		BeforeEach(func() {
+			var err error
+			tmpDirPath, err = ioutil.TempDir("", "buildpack-actions-test")
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeDownloader = new(v2actionfakes.FakeDownloader)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDirPath)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			bitsPath, executeErr = actor.PrepareBuildpackBits(inputPath, tmpDirPath, fakeDownloader)
+		})
+
+		Context("when the input path is a directory", func() {
+			BeforeEach(func() {
+				inputPath = filepath.Join(tmpDirPath, "some-buildpack")
+				Expect(os.MkdirAll(filepath.Join(inputPath, "bin"), 0755)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(inputPath, "bin", "compile"), []byte("compile-script"), 0755)).To(Succeed())
+			})
+
+			It("zips the directory into a zip file named after it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(bitsPath).To(Equal(filepath.Join(tmpDirPath, "some-buildpack.zip")))
+				Expect(readZip(bitsPath)).To(Equal(map[string]string{
+					"bin/":        "",
+					"bin/compile": "compile-script",
+				}))
+			})
+		})
+
+		Context("when the input path is a zip file with a nested buildpack", func() {
+			BeforeEach(func() {
+				inputPath = filepath.Join(tmpDirPath, "some-buildpack.zip")
+				writeZip(inputPath, map[string]string{
+					"some-buildpack-master/bin/compile": "compile-script",
+					"some-buildpack-master/README":      "readme",
+				})
+			})
+
+			It("moves the buildpack to the root of a new zip file with the same name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(bitsPath).ToNot(Equal(inputPath))
+				Expect(filepath.Base(bitsPath)).To(Equal("some-buildpack.zip"))
+				Expect(readZip(bitsPath)).To(Equal(map[string]string{
+					"bin/compile": "compile-script",
+					"README":      "readme",
+				}))
+			})
+		})
+
+		Context("when the input path is a zip file without a buildpack", func() {
+			BeforeEach(func() {
+				inputPath = filepath.Join(tmpDirPath, "some-buildpack.zip")
+				writeZip(inputPath, map[string]string{"README": "readme"})
+			})
+
+			It("returns a BuildpackZipInvalidError", func() {
+				Expect(executeErr).To(MatchError(BuildpackZipInvalidError{Path: inputPath}))
+			})
+		})
+
+		Context("when the input path is a URL", func() {
+			BeforeEach(func() {
+				inputPath = "https://example.com/some-buildpack.zip"
+				fakeDownloader.SavePathReturns(tmpDirPath)
+				fakeDownloader.DownloadFileStub = func(string) (int64, string, error) {
+					writeZip(filepath.Join(tmpDirPath, "some-buildpack.zip"), map[string]string{"bin/compile": "compile-script"})
+					return 0, "some-buildpack.zip", nil
+				}
+			})
+
+			It("downloads and normalizes the zip file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeDownloader.DownloadFileCallCount()).To(Equal(1))
+				Expect(fakeDownloader.DownloadFileArgsForCall(0)).To(Equal("https://example.com/some-buildpack.zip"))
+
+				Expect(filepath.Base(bitsPath)).To(Equal("some-buildpack.zip"))
+				Expect(readZip(bitsPath)).To(Equal(map[string]string{"bin/compile": "compile-script"}))
+			})
+
+			Context("when the download fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("download failed")
+					fakeDownloader.DownloadFileStub = nil
+					fakeDownloader.DownloadFileReturns(0, "", expectedErr)
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+				})
+			})
+		})
+	})
+
+	Describe("UploadBuildpack", func() {
+		var (
+			bitsPath            string
+			fakeProgressBar     *v2actionfakes.FakeSimpleProgressBar
+			progressBarReader   io.Reader
+			warnings            Warnings
+			executeErr          error
+			expectedBitsContent []byte
+		)
+
+		BeforeEach(func() {
+			expectedBitsContent = []byte("some-bits")
+			tmpFile, err := ioutil.TempFile("", "buildpack-upload-test")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = tmpFile.Write(expectedBitsContent)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tmpFile.Close()).To(Succeed())
+			bitsPath = tmpFile.Name()
+
+			fakeProgressBar = new(v2actionfakes.FakeSimpleProgressBar)
+			progressBarReader = bytes.NewReader(expectedBitsContent)
+			fakeProgressBar.NewProgressBarWrapperReturns(progressBarReader)
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(bitsPath)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UploadBuildpack("some-buildpack-guid", bitsPath, fakeProgressBar)
+		})
+
+		Context("when the upload succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UploadBuildpackReturns(ccv2.Warnings{"upload-warning"}, nil)
+			})
+
+			It("uploads the bits through the progress bar", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("upload-warning"))
+
+				Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(1))
+				_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+				Expect(size).To(Equal(int64(len(expectedBitsContent))))
+
+				Expect(fakeCloudControllerClient.UploadBuildpackCallCount()).To(Equal(1))
+				guid, fileName, reader, length := fakeCloudControllerClient.UploadBuildpackArgsForCall(0)
+				Expect(guid).To(Equal("some-buildpack-guid"))
+				Expect(fileName).To(Equal(filepath.Base(bitsPath)))
+				Expect(reader).To(Equal(progressBarReader))
+				Expect(length).To(Equal(int64(len(expectedBitsContent))))
+			})
+		})
+
+		Context("when the buildpack already exists for the stack", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UploadBuildpackReturns(ccv2.Warnings{"upload-warning"}, ccerror.BuildpackAlreadyExistsForStackError{Message: "already exists"})
+			})
+
+			It("returns a BuildpackAlreadyExistsForStackError and warnings", func() {
+				Expect(executeErr).To(MatchError(BuildpackAlreadyExistsForStackError{Message: "already exists"}))
+				Expect(warnings).To(ConsistOf("upload-warning"))
+			})
+		})
+	})
+
+	Describe("UpdateBuildpackByNameAndStack", func() {
+		var (
+			stack      string
+			position   types.NullInt
+			locked     types.NullBool
+			enabled    types.NullBool
+			newStack   string
+			guid       string
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			stack = ""
+			position = types.NullInt{IsSet: true, Value: 2}
+			locked = types.NullBool{}
+			enabled = types.NullBool{IsSet: true, Value: true}
+			newStack = ""
+		})
+
+		JustBeforeEach(func() {
+			guid, warnings, executeErr = actor.UpdateBuildpackByNameAndStack("some-buildpack", stack, position, locked, enabled, newStack)
+		})
+
+		Context("when the buildpack exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns([]ccv2.Buildpack{{GUID: "some-buildpack-guid", Name: "some-buildpack"}}, ccv2.Warnings{"get-warning"}, nil)
+				fakeCloudControllerClient.UpdateBuildpackReturns(ccv2.Buildpack{}, ccv2.Warnings{"update-warning"}, nil)
+			})
+
+			It("updates the set properties and returns the GUID", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+				Expect(guid).To(Equal("some-buildpack-guid"))
+
+				Expect(fakeCloudControllerClient.GetBuildpacksCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetBuildpacksArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.NameFilter,
+					Operator: ccv2.EqualOperator,
+					Values:   []string{"some-buildpack"},
+				}))
+
+				Expect(fakeCloudControllerClient.UpdateBuildpackCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateBuildpackArgsForCall(0)).To(Equal(ccv2.Buildpack{
+					GUID:     "some-buildpack-guid",
+					Position: types.NullInt{IsSet: true, Value: 2},
+					Enabled:  types.NullBool{IsSet: true, Value: true},
+				}))
+			})
+
+			Context("when nothing is set", func() {
+				BeforeEach(func() {
+					position = types.NullInt{}
+					enabled = types.NullBool{}
+				})
+
+				It("does not update the buildpack", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(guid).To(Equal("some-buildpack-guid"))
+					Expect(fakeCloudControllerClient.UpdateBuildpackCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when a stack is provided", func() {
+				BeforeEach(func() {
+					stack = "some-stack"
+				})
+
+				It("filters the buildpacks by stack", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetBuildpacksArgsForCall(0)).To(ConsistOf(
+						ccv2.Query{
+							Filter:   ccv2.NameFilter,
+							Operator: ccv2.EqualOperator,
+							Values:   []string{"some-buildpack"},
+						},
+						ccv2.Query{
+							Filter:   ccv2.StackFilter,
+							Operator: ccv2.EqualOperator,
+							Values:   []string{"some-stack"},
+						},
+					))
+				})
+			})
+
+			Context("when a new stack is assigned", func() {
+				BeforeEach(func() {
+					newStack = "new-stack"
+				})
+
+				It("assigns the stack", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.UpdateBuildpackArgsForCall(0).Stack).To(Equal("new-stack"))
+				})
+
+				Context("when the buildpack already has a stack", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetBuildpacksReturns([]ccv2.Buildpack{{GUID: "some-buildpack-guid", Stack: "old-stack"}}, ccv2.Warnings{"get-warning"}, nil)
+					})
+
+					It("returns a BuildpackStackChangeError", func() {
+						Expect(executeErr).To(MatchError(BuildpackStackChangeError{BuildpackName: "some-buildpack"}))
+						Expect(warnings).To(ConsistOf("get-warning"))
+						Expect(fakeCloudControllerClient.UpdateBuildpackCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the name and new stack are taken", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.UpdateBuildpackReturns(ccv2.Buildpack{}, ccv2.Warnings{"update-warning"}, ccerror.BuildpackAlreadyExistsForStackError{Message: "already exists"})
+					})
+
+					It("returns a BuildpackAlreadyExistsForStackError", func() {
+						Expect(executeErr).To(MatchError(BuildpackAlreadyExistsForStackError{Message: "already exists"}))
+						Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+					})
+				})
+			})
+		})
+
+		Context("when the buildpack does not exist", func() {
+			BeforeEach(func() {
+				stack = "some-stack"
+				fakeCloudControllerClient.GetBuildpacksReturns(nil, ccv2.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns a BuildpackNotFoundError", func() {
+				Expect(executeErr).To(MatchError(BuildpackNotFoundError{BuildpackName: "some-buildpack", StackName: "some-stack"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+
+		Context("when several buildpacks share the name", func() {
+			Context("when one of them has no stack", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetBuildpacksReturns([]ccv2.Buildpack{
+						{GUID: "stack-buildpack-guid", Stack: "some-stack"},
+						{GUID: "nil-stack-buildpack-guid"},
+					}, nil, nil)
+				})
+
+				It("selects the buildpack without a stack", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(guid).To(Equal("nil-stack-buildpack-guid"))
+				})
+			})
+
+			Context("when all of them have a stack", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetBuildpacksReturns([]ccv2.Buildpack{
+						{GUID: "buildpack-guid-1", Stack: "some-stack"},
+						{GUID: "buildpack-guid-2", Stack: "other-stack"},
+					}, ccv2.Warnings{"get-warning"}, nil)
+				})
+
+				It("returns a MultipleBuildpacksFoundError", func() {
+					Expect(executeErr).To(MatchError(MultipleBuildpacksFoundError{BuildpackName: "some-buildpack"}))
+					Expect(warnings).To(ConsistOf("get-warning"))
+				})
+			})
+		})
+	})
+
+	Describe("DeleteBuildpackByNameAndStack", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteBuildpackByNameAndStack("some-buildpack", "some-stack")
+		})
+
+		Context("when the buildpack exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns([]ccv2.Buildpack{{GUID: "some-buildpack-guid"}}, ccv2.Warnings{"get-warning"}, nil)
+				fakeCloudControllerClient.DeleteBuildpackReturns(ccv2.Job{GUID: "some-job-guid"}, ccv2.Warnings{"delete-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-warning"}, nil)
+			})
+
+			It("deletes the buildpack and waits for the job", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "delete-warning", "poll-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteBuildpackCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteBuildpackArgsForCall(0)).To(Equal("some-buildpack-guid"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv2.Job{GUID: "some-job-guid"}))
+			})
+
+			Context("when the deletion fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("delete failed")
+					fakeCloudControllerClient.DeleteBuildpackReturns(ccv2.Job{}, ccv2.Warnings{"delete-warning"}, expectedErr)
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-warning", "delete-warning"))
+					Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the buildpack does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns(nil, ccv2.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns a BuildpackNotFoundError", func() {
+				Expect(executeErr).To(MatchError(BuildpackNotFoundError{BuildpackName: "some-buildpack", StackName: "some-stack"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.DeleteBuildpackCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
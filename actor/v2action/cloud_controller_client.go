@@ -1,6 +1,10 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
 
 //go:generate counterfeiter . CloudControllerClient
 
@@ -10,36 +14,85 @@ type CloudControllerClient interface {
 	AssociateSpaceWithStagingSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	BindRouteToApplication(routeGUID string, appGUID string) (ccv2.Route, ccv2.Warnings, error)
 	CheckRoute(route ccv2.Route) (bool, ccv2.Warnings, error)
+	CreateBuildpack(buildpack ccv2.Buildpack) (ccv2.Buildpack, ccv2.Warnings, error)
+	CreatePrivateDomain(domainName string, orgGUID string) (ccv2.Domain, ccv2.Warnings, error)
+	CreateOrganization(orgName string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error)
+	CreateOrganizationQuota(orgQuota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error)
 	CreateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
 	CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error)
-	CreateServiceBinding(appGUID string, serviceBindingGUID string, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error)
+	CreateServiceBinding(appGUID string, serviceInstanceGUID string, bindingName string, acceptsIncomplete bool, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error)
+	CreateServiceInstance(spaceGUID string, servicePlanGUID string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	CreateServiceKey(serviceInstanceGUID string, keyName string, parameters map[string]interface{}) (ccv2.ServiceKey, ccv2.Warnings, error)
+	CreateSharedDomain(domainName string, routerGroupGUID string, isInternal bool) (ccv2.Domain, ccv2.Warnings, error)
+	CreateSpace(spaceName string, orgGUID string) (ccv2.Space, ccv2.Warnings, error)
+	CreateSpaceQuota(spaceQuota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)
 	CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
+	DeleteApplication(appGUID string) (ccv2.Warnings, error)
+	DeleteBuildpack(buildpackGUID string) (ccv2.Job, ccv2.Warnings, error)
 	DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
+	DeleteOrganizationAuditor(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	DeleteOrganizationAuditorByUsername(orgGUID string, username string) (ccv2.Warnings, error)
+	DeleteOrganizationBillingManager(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	DeleteOrganizationBillingManagerByUsername(orgGUID string, username string) (ccv2.Warnings, error)
+	DeleteOrganizationManager(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	DeleteOrganizationManagerByUsername(orgGUID string, username string) (ccv2.Warnings, error)
+	DeletePrivateDomain(domainGUID string) (ccv2.Job, ccv2.Warnings, error)
 	DeleteRoute(routeGUID string) (ccv2.Warnings, error)
+	DeleteSecurityGroup(securityGroupGUID string) (ccv2.Warnings, error)
 	DeleteServiceBinding(serviceBindingGUID string) (ccv2.Warnings, error)
+	DeleteServiceInstance(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	DeleteServiceKey(guid string) (ccv2.Job, ccv2.Warnings, error)
 	DeleteSpace(spaceGUID string) (ccv2.Job, ccv2.Warnings, error)
+	DeleteSpaceAuditor(spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	DeleteSpaceDeveloper(spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	DeleteSpaceManager(spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	DeleteUser(userGUID string) (ccv2.Warnings, error)
 	GetApplication(guid string) (ccv2.Application, ccv2.Warnings, error)
 	GetApplicationInstancesByApplication(guid string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error)
 	GetApplicationInstanceStatusesByApplication(guid string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error)
 	GetApplicationRoutes(appGUID string, queries ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
 	GetApplications(queries ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error)
+	GetApplicationsPaged(handlePage func([]ccv2.Application) error, queries ...ccv2.Query) (ccv2.Warnings, error)
+	GetBuildpacks(queries ...ccv2.Query) ([]ccv2.Buildpack, ccv2.Warnings, error)
+	GetEnvironmentVariableGroup(name ccv2.EnvironmentVariableGroupName) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error)
+	GetFeatureFlag(name string) (ccv2.FeatureFlag, ccv2.Warnings, error)
+	GetFeatureFlags() ([]ccv2.FeatureFlag, ccv2.Warnings, error)
 	GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	GetOrganization(guid string) (ccv2.Organization, ccv2.Warnings, error)
+	GetOrganizationAuditors(orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	GetOrganizationBillingManagers(orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	GetOrganizationManagers(orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
 	GetOrganizationPrivateDomains(orgGUID string, queries ...ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error)
 	GetOrganizationQuota(guid string) (ccv2.OrganizationQuota, ccv2.Warnings, error)
+	GetOrganizationQuotas(queries ...ccv2.Query) ([]ccv2.OrganizationQuota, ccv2.Warnings, error)
+	GetOrganizationUsers(orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
 	GetOrganizations(queries ...ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error)
 	GetPrivateDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	GetRouteApplications(routeGUID string, queries ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error)
+	GetRouteMappings(queries ...ccv2.Query) ([]ccv2.RouteMapping, ccv2.Warnings, error)
 	GetRoutes(queries ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
+	GetRoutesPaged(handlePage func([]ccv2.Route) error, queries ...ccv2.Query) (ccv2.Warnings, error)
 	GetRunningSpacesBySecurityGroup(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error)
 	GetSecurityGroups(queries ...ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
+	GetSecurityGroupsPaged(handlePage func([]ccv2.SecurityGroup) error, queries ...ccv2.Query) (ccv2.Warnings, error)
+	GetService(serviceGUID string) (ccv2.Service, ccv2.Warnings, error)
+	GetServiceBinding(serviceBindingGUID string) (ccv2.ServiceBinding, ccv2.Warnings, error)
 	GetServiceBindings(queries ...ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
 	GetServiceInstance(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error)
 	GetServiceInstances(queries ...ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
+	GetServiceKeys(queries ...ccv2.Query) ([]ccv2.ServiceKey, ccv2.Warnings, error)
+	GetServicePlan(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error)
+	GetServicePlans(queries ...ccv2.Query) ([]ccv2.ServicePlan, ccv2.Warnings, error)
+	GetServices(queries ...ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error)
 	GetSharedDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	GetSharedDomains(queries ...ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error)
+	GetSpaceAuditors(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	GetSpaceDevelopers(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	GetSpaceManagers(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
 	GetSpaceQuota(guid string) (ccv2.SpaceQuota, ccv2.Warnings, error)
+	GetSpaceQuotas(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error)
 	GetSpaceRoutes(spaceGUID string, queries ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
+	GetSpaceRoutesPaged(spaceGUID string, handlePage func([]ccv2.Route) error, queries ...ccv2.Query) (ccv2.Warnings, error)
 	GetSpaceRunningSecurityGroupsBySpace(spaceGUID string, queries ...ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	GetSpaces(queries ...ccv2.Query) ([]ccv2.Space, ccv2.Warnings, error)
 	GetSpaceServiceInstances(spaceGUID string, includeUserProvidedServices bool, queries ...ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
@@ -48,13 +101,36 @@ type CloudControllerClient interface {
 	GetStacks(queries ...ccv2.Query) ([]ccv2.Stack, ccv2.Warnings, error)
 	GetStagingSpacesBySecurityGroup(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error)
 	PollJob(job ccv2.Job) (ccv2.Warnings, error)
+	PurgeService(serviceGUID string) (ccv2.Job, ccv2.Warnings, error)
 	RemoveSpaceFromRunningSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	RemoveSpaceFromStagingSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	ResourceMatch(resourcesToMatch []ccv2.Resource) ([]ccv2.Resource, ccv2.Warnings, error)
 	RestageApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
+	SetSpaceQuota(spaceGUID string, quotaGUID string) (ccv2.Warnings, error)
 	TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error)
 	UpdateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
+	UpdateBuildpack(buildpack ccv2.Buildpack) (ccv2.Buildpack, ccv2.Warnings, error)
+	UpdateEnvironmentVariableGroup(name ccv2.EnvironmentVariableGroupName, group ccv2.EnvironmentVariableGroup) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error)
+	UpdateFeatureFlag(featureFlag ccv2.FeatureFlag) (ccv2.Warnings, error)
+	UpdateOrganizationAuditor(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	UpdateOrganizationAuditorByUsername(orgGUID string, username string) (ccv2.Warnings, error)
+	UpdateOrganizationBillingManager(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	UpdateOrganizationBillingManagerByUsername(orgGUID string, username string) (ccv2.Warnings, error)
+	UpdateOrganizationManager(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	UpdateOrganizationManagerByUsername(orgGUID string, username string) (ccv2.Warnings, error)
+	UpdateOrganizationQuota(orgQuota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error)
+	UpdateOrganizationUser(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	UpdateOrganizationUserByUsername(orgGUID string, username string) (ccv2.Warnings, error)
+	UpdateServiceInstance(serviceInstanceGUID string, servicePlanGUID string, parameters map[string]interface{}, tags []string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	UpdateSpaceAuditor(spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	UpdateSpaceAuditorByUsername(spaceGUID string, username string) (ccv2.Warnings, error)
+	UpdateSpaceDeveloper(spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	UpdateSpaceDeveloperByUsername(spaceGUID string, username string) (ccv2.Warnings, error)
+	UpdateSpaceManager(spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	UpdateSpaceManagerByUsername(spaceGUID string, username string) (ccv2.Warnings, error)
+	UpdateSpaceQuota(spaceQuota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)
 	UploadApplicationPackage(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
+	UploadBuildpack(buildpackGUID string, buildpackFileName string, buildpack io.Reader, buildpackLength int64) (ccv2.Warnings, error)
 
 	API() string
 	APIVersion() string
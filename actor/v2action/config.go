@@ -6,17 +6,25 @@ import "time"
 
 type Config interface {
 	AccessToken() string
+	CACertFile() string
+	OverallPollingTimeout() time.Duration
 	PollingInterval() time.Duration
 	RefreshToken() string
+	ResourceMatchMinFileSize() int64
 	SSHOAuthClient() string
 	SetAccessToken(accessToken string)
+	SetCACertFile(caCertFile string)
 	SetRefreshToken(refreshToken string)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
+	SetUAAClientCredentials(client string, clientSecret string)
+	SetUAAGrantType(uaaGrantType string)
 	SkipSSLValidation() bool
+	StagingRetries() int
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
 	Target() string
+	UAAGrantType() string
 	UnsetOrganizationInformation()
 	UnsetSpaceInformation()
 	Verbose() (bool, []string)
@@ -9,9 +9,23 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// TCPRouterGroupType is the router group type of domains that serve TCP
+// routes.
+const TCPRouterGroupType = "tcp"
+
 // Domain represents a CLI Domain.
 type Domain ccv2.Domain
 
+// IsHTTP returns true for any router group type that is not 'tcp'.
+func (domain Domain) IsHTTP() bool {
+	return !domain.IsTCP()
+}
+
+// IsTCP returns true only when the router group type is 'tcp'.
+func (domain Domain) IsTCP() bool {
+	return domain.RouterGroupType == TCPRouterGroupType
+}
+
 // DomainNotFoundError is an error wrapper that represents the case
 // when the domain is not found.
 type DomainNotFoundError struct {
@@ -31,6 +45,16 @@ func (e DomainNotFoundError) Error() string {
 	}
 }
 
+// DomainIsSharedError is returned when a shared domain is provided where a
+// private domain is expected.
+type DomainIsSharedError struct {
+	Name string
+}
+
+func (e DomainIsSharedError) Error() string {
+	return fmt.Sprintf("Domain %s is a shared domain", e.Name)
+}
+
 // TODO: Move into own file or add function to CCV2/3
 func isResourceNotFoundError(err error) bool {
 	_, isResourceNotFound := err.(ccerror.ResourceNotFoundError)
@@ -175,6 +199,99 @@ func (actor Actor) GetOrganizationDomains(orgGUID string) ([]Domain, Warnings, e
 	return allDomains, allWarnings, nil
 }
 
+// GetDomains returns the shared domains and the private domains of the
+// provided organization, in that order.
+func (actor Actor) GetDomains(orgGUID string) ([]Domain, []Domain, Warnings, error) {
+	var allWarnings Warnings
+
+	ccSharedDomains, warnings, err := actor.CloudControllerClient.GetSharedDomains()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	ccPrivateDomains, warnings, err := actor.CloudControllerClient.GetOrganizationPrivateDomains(orgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	var sharedDomains []Domain
+	for _, domain := range ccSharedDomains {
+		sharedDomains = append(sharedDomains, Domain(domain))
+	}
+
+	var privateDomains []Domain
+	for _, domain := range ccPrivateDomains {
+		privateDomains = append(privateDomains, Domain(domain))
+	}
+
+	return sharedDomains, privateDomains, allWarnings, nil
+}
+
+// CreateSharedDomain creates a shared domain with the provided name. When a
+// router group is provided, routes for the domain are only configured on that
+// router group. Internal domains are only routable from within the platform.
+func (actor Actor) CreateSharedDomain(domainName string, routerGroup RouterGroup, isInternal bool) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.CreateSharedDomain(domainName, routerGroup.GUID, isInternal)
+	return Warnings(warnings), err
+}
+
+// CreatePrivateDomain creates a private domain with the provided name that is
+// owned by the provided organization.
+func (actor Actor) CreatePrivateDomain(domainName string, orgName string) (Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, warnings, err := actor.CloudControllerClient.CreatePrivateDomain(domainName, org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// DeleteDomain deletes the private domain with the provided name from the
+// provided organization, along with all of its routes. A DomainIsSharedError
+// is returned when the domain is a shared domain.
+func (actor Actor) DeleteDomain(domainName string, orgGUID string) (Warnings, error) {
+	var allWarnings Warnings
+
+	nameQuery := ccv2.Query{
+		Filter:   ccv2.NameFilter,
+		Operator: ccv2.EqualOperator,
+		Values:   []string{domainName},
+	}
+
+	privateDomains, warnings, err := actor.CloudControllerClient.GetOrganizationPrivateDomains(orgGUID, nameQuery)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	if len(privateDomains) == 0 {
+		sharedDomains, sharedWarnings, sharedErr := actor.CloudControllerClient.GetSharedDomains(nameQuery)
+		allWarnings = append(allWarnings, sharedWarnings...)
+		if sharedErr != nil {
+			return allWarnings, sharedErr
+		}
+
+		if len(sharedDomains) > 0 {
+			return allWarnings, DomainIsSharedError{Name: domainName}
+		}
+		return allWarnings, DomainNotFoundError{Name: domainName}
+	}
+
+	job, warnings, err := actor.CloudControllerClient.DeletePrivateDomain(privateDomains[0].GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	pollWarnings, err := actor.PollJob(Job(job))
+	allWarnings = append(allWarnings, pollWarnings...)
+	return allWarnings, err
+}
+
 func (actor Actor) saveDomain(domain ccv2.Domain) {
 	if domain.GUID != "" {
 		actor.domainCache[domain.GUID] = Domain(domain)
@@ -56,6 +56,17 @@ var _ = Describe("Domain Actions", func() {
 		})
 	})
 
+	DescribeTable("IsHTTP and IsTCP",
+		func(routerGroupType string, isHTTP bool, isTCP bool) {
+			domain := Domain{RouterGroupType: routerGroupType}
+			Expect(domain.IsHTTP()).To(Equal(isHTTP))
+			Expect(domain.IsTCP()).To(Equal(isTCP))
+		},
+		Entry("no router group type", "", true, false),
+		Entry("http router group type", "http", true, false),
+		Entry("tcp router group type", "tcp", false, true),
+	)
+
 	Describe("GetDomain", func() {
 		Context("when the domain exists and is a shared domain", func() {
 			var expectedDomain ccv2.Domain
@@ -476,4 +487,264 @@ var _ = Describe("Domain Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetDomains", func() {
+		var (
+			sharedDomains  []Domain
+			privateDomains []Domain
+			warnings       Warnings
+			executeErr     error
+		)
+
+		JustBeforeEach(func() {
+			sharedDomains, privateDomains, warnings, executeErr = actor.GetDomains("some-org-guid")
+		})
+
+		Context("when retrieving the domains succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSharedDomainsReturns(
+					[]ccv2.Domain{{GUID: "shared-domain-guid", Name: "shared.com", Internal: true}},
+					ccv2.Warnings{"shared-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetOrganizationPrivateDomainsReturns(
+					[]ccv2.Domain{{GUID: "private-domain-guid", Name: "private.com"}},
+					ccv2.Warnings{"private-warning"},
+					nil,
+				)
+			})
+
+			It("returns the shared and private domains separately", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("shared-warning", "private-warning"))
+				Expect(sharedDomains).To(Equal([]Domain{{GUID: "shared-domain-guid", Name: "shared.com", Internal: true}}))
+				Expect(privateDomains).To(Equal([]Domain{{GUID: "private-domain-guid", Name: "private.com"}}))
+
+				Expect(fakeCloudControllerClient.GetOrganizationPrivateDomainsCallCount()).To(Equal(1))
+				orgGUID, _ := fakeCloudControllerClient.GetOrganizationPrivateDomainsArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+			})
+		})
+
+		Context("when retrieving the shared domains fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("shared domains error")
+				fakeCloudControllerClient.GetSharedDomainsReturns(nil, ccv2.Warnings{"shared-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("shared-warning"))
+				Expect(fakeCloudControllerClient.GetOrganizationPrivateDomainsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when retrieving the private domains fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("private domains error")
+				fakeCloudControllerClient.GetSharedDomainsReturns(nil, ccv2.Warnings{"shared-warning"}, nil)
+				fakeCloudControllerClient.GetOrganizationPrivateDomainsReturns(nil, ccv2.Warnings{"private-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("shared-warning", "private-warning"))
+			})
+		})
+	})
+
+	Describe("CreateSharedDomain", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.CreateSharedDomain("some-domain", RouterGroup{GUID: "some-router-group-guid", Name: "some-router-group"}, true)
+		})
+
+		Context("when creating the domain succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateSharedDomainReturns(ccv2.Domain{}, ccv2.Warnings{"create-warning"}, nil)
+			})
+
+			It("creates the domain on the router group and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning"))
+
+				Expect(fakeCloudControllerClient.CreateSharedDomainCallCount()).To(Equal(1))
+				domainName, routerGroupGUID, isInternal := fakeCloudControllerClient.CreateSharedDomainArgsForCall(0)
+				Expect(domainName).To(Equal("some-domain"))
+				Expect(routerGroupGUID).To(Equal("some-router-group-guid"))
+				Expect(isInternal).To(BeTrue())
+			})
+		})
+
+		Context("when creating the domain fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create error")
+				fakeCloudControllerClient.CreateSharedDomainReturns(ccv2.Domain{}, ccv2.Warnings{"create-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+
+	Describe("CreatePrivateDomain", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.CreatePrivateDomain("some-domain", "some-org")
+		})
+
+		Context("when the organization exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org"}},
+					ccv2.Warnings{"org-warning"},
+					nil,
+				)
+			})
+
+			Context("when creating the domain succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreatePrivateDomainReturns(ccv2.Domain{}, ccv2.Warnings{"create-warning"}, nil)
+				})
+
+				It("creates the domain in the organization and returns all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("org-warning", "create-warning"))
+
+					Expect(fakeCloudControllerClient.CreatePrivateDomainCallCount()).To(Equal(1))
+					domainName, orgGUID := fakeCloudControllerClient.CreatePrivateDomainArgsForCall(0)
+					Expect(domainName).To(Equal("some-domain"))
+					Expect(orgGUID).To(Equal("some-org-guid"))
+				})
+			})
+
+			Context("when creating the domain fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("create error")
+					fakeCloudControllerClient.CreatePrivateDomainReturns(ccv2.Domain{}, ccv2.Warnings{"create-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("org-warning", "create-warning"))
+				})
+			})
+		})
+
+		Context("when the organization does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("org-warning"))
+				Expect(fakeCloudControllerClient.CreatePrivateDomainCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DeleteDomain", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteDomain("some-domain", "some-org-guid")
+		})
+
+		Context("when the private domain exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationPrivateDomainsReturns(
+					[]ccv2.Domain{{GUID: "some-domain-guid", Name: "some-domain"}},
+					ccv2.Warnings{"get-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.DeletePrivateDomainReturns(ccv2.Job{GUID: "some-job-guid"}, ccv2.Warnings{"delete-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-warning"}, nil)
+			})
+
+			It("deletes the domain, waits for the job and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "delete-warning", "poll-warning"))
+
+				orgGUID, queries := fakeCloudControllerClient.GetOrganizationPrivateDomainsArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(queries).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.NameFilter,
+					Operator: ccv2.EqualOperator,
+					Values:   []string{"some-domain"},
+				}))
+
+				Expect(fakeCloudControllerClient.DeletePrivateDomainCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeletePrivateDomainArgsForCall(0)).To(Equal("some-domain-guid"))
+
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv2.Job{GUID: "some-job-guid"}))
+			})
+
+			Context("when deleting the domain fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("delete error")
+					fakeCloudControllerClient.DeletePrivateDomainReturns(ccv2.Job{}, ccv2.Warnings{"delete-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-warning", "delete-warning"))
+					Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the domain is a shared domain", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationPrivateDomainsReturns(nil, ccv2.Warnings{"get-warning"}, nil)
+				fakeCloudControllerClient.GetSharedDomainsReturns(
+					[]ccv2.Domain{{GUID: "some-domain-guid", Name: "some-domain"}},
+					ccv2.Warnings{"shared-warning"},
+					nil,
+				)
+			})
+
+			It("returns a DomainIsSharedError", func() {
+				Expect(executeErr).To(MatchError(DomainIsSharedError{Name: "some-domain"}))
+				Expect(warnings).To(ConsistOf("get-warning", "shared-warning"))
+				Expect(fakeCloudControllerClient.DeletePrivateDomainCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the domain does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationPrivateDomainsReturns(nil, ccv2.Warnings{"get-warning"}, nil)
+				fakeCloudControllerClient.GetSharedDomainsReturns(nil, ccv2.Warnings{"shared-warning"}, nil)
+			})
+
+			It("returns a DomainNotFoundError", func() {
+				Expect(executeErr).To(MatchError(DomainNotFoundError{Name: "some-domain"}))
+				Expect(warnings).To(ConsistOf("get-warning", "shared-warning"))
+			})
+		})
+	})
 })
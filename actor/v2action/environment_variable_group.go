@@ -0,0 +1,21 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// EnvironmentVariableGroup is the set of environment variables in one of the
+// global environment variable groups, keyed by variable name.
+type EnvironmentVariableGroup ccv2.EnvironmentVariableGroup
+
+// GetEnvironmentVariableGroup returns the environment variables in the
+// provided group.
+func (actor Actor) GetEnvironmentVariableGroup(name ccv2.EnvironmentVariableGroupName) (EnvironmentVariableGroup, Warnings, error) {
+	group, warnings, err := actor.CloudControllerClient.GetEnvironmentVariableGroup(name)
+	return EnvironmentVariableGroup(group), Warnings(warnings), err
+}
+
+// SetEnvironmentVariableGroup replaces the contents of the provided group with
+// the provided environment variables.
+func (actor Actor) SetEnvironmentVariableGroup(name ccv2.EnvironmentVariableGroupName, group EnvironmentVariableGroup) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.UpdateEnvironmentVariableGroup(name, ccv2.EnvironmentVariableGroup(group))
+	return Warnings(warnings), err
+}
@@ -0,0 +1,98 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Environment Variable Group Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetEnvironmentVariableGroup", func() {
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEnvironmentVariableGroupReturns(
+					ccv2.EnvironmentVariableGroup{"key-1": "val-1"},
+					ccv2.Warnings{"get-group-warning"},
+					nil,
+				)
+			})
+
+			It("returns the environment variables and all warnings", func() {
+				group, warnings, err := actor.GetEnvironmentVariableGroup(ccv2.EnvironmentVariableGroupStaging)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(group).To(Equal(EnvironmentVariableGroup{"key-1": "val-1"}))
+				Expect(warnings).To(ConsistOf("get-group-warning"))
+
+				Expect(fakeCloudControllerClient.GetEnvironmentVariableGroupCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetEnvironmentVariableGroupArgsForCall(0)).To(Equal(ccv2.EnvironmentVariableGroupStaging))
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get group error")
+				fakeCloudControllerClient.GetEnvironmentVariableGroupReturns(nil, ccv2.Warnings{"get-group-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetEnvironmentVariableGroup(ccv2.EnvironmentVariableGroupRunning)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-group-warning"))
+			})
+		})
+	})
+
+	Describe("SetEnvironmentVariableGroup", func() {
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateEnvironmentVariableGroupReturns(
+					ccv2.EnvironmentVariableGroup{"key-1": "val-1"},
+					ccv2.Warnings{"update-group-warning"},
+					nil,
+				)
+			})
+
+			It("replaces the group and returns all warnings", func() {
+				warnings, err := actor.SetEnvironmentVariableGroup(ccv2.EnvironmentVariableGroupRunning, EnvironmentVariableGroup{"key-1": "val-1"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("update-group-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateEnvironmentVariableGroupCallCount()).To(Equal(1))
+				name, group := fakeCloudControllerClient.UpdateEnvironmentVariableGroupArgsForCall(0)
+				Expect(name).To(Equal(ccv2.EnvironmentVariableGroupRunning))
+				Expect(group).To(Equal(ccv2.EnvironmentVariableGroup{"key-1": "val-1"}))
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("update group error")
+				fakeCloudControllerClient.UpdateEnvironmentVariableGroupReturns(nil, ccv2.Warnings{"update-group-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.SetEnvironmentVariableGroup(ccv2.EnvironmentVariableGroupStaging, EnvironmentVariableGroup{})
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("update-group-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,104 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// featureFlagDefaults are the values the Cloud Controller uses for feature
+// flags that have not been set by an admin.
+var featureFlagDefaults = map[string]bool{
+	"app_bits_upload":    true,
+	"app_scaling":        true,
+	"diego_docker":       false,
+	"env_var_visibility": true,
+	"hide_marketplace_from_unauthenticated_users": false,
+	"private_domain_creation":                     true,
+	"resource_matching":                           true,
+	"route_creation":                              true,
+	"service_instance_creation":                   true,
+	"service_instance_sharing":                    false,
+	"set_roles_by_username":                       true,
+	"space_developer_env_var_visibility":          true,
+	"space_scoped_private_broker_creation":        true,
+	"task_creation":                               true,
+	"unset_roles_by_username":                     true,
+	"user_org_creation":                           false,
+}
+
+// FeatureFlag represents a Cloud Controller feature flag.
+type FeatureFlag struct {
+	Name    string
+	Enabled bool
+
+	// Default is the state of the feature flag when it has not been set. It is
+	// not set for feature flags unknown to the CLI.
+	Default types.NullBool
+}
+
+// FeatureFlagNotFoundError is returned when a requested feature flag is not
+// found.
+type FeatureFlagNotFoundError struct {
+	Name string
+}
+
+func (e FeatureFlagNotFoundError) Error() string {
+	return fmt.Sprintf("Feature flag '%s' not found.", e.Name)
+}
+
+// GetFeatureFlags returns all of the feature flags.
+func (actor Actor) GetFeatureFlags() ([]FeatureFlag, Warnings, error) {
+	ccFeatureFlags, warnings, err := actor.CloudControllerClient.GetFeatureFlags()
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var featureFlags []FeatureFlag
+	for _, ccFeatureFlag := range ccFeatureFlags {
+		featureFlags = append(featureFlags, newFeatureFlag(ccFeatureFlag))
+	}
+
+	return featureFlags, Warnings(warnings), nil
+}
+
+// GetFeatureFlag returns the feature flag with the provided name.
+func (actor Actor) GetFeatureFlag(name string) (FeatureFlag, Warnings, error) {
+	ccFeatureFlag, warnings, err := actor.CloudControllerClient.GetFeatureFlag(name)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return FeatureFlag{}, Warnings(warnings), FeatureFlagNotFoundError{Name: name}
+	}
+	if err != nil {
+		return FeatureFlag{}, Warnings(warnings), err
+	}
+
+	return newFeatureFlag(ccFeatureFlag), Warnings(warnings), nil
+}
+
+// SetFeatureFlag enables or disables the feature flag with the provided name.
+func (actor Actor) SetFeatureFlag(name string, enabled bool) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UpdateFeatureFlag(ccv2.FeatureFlag{
+		Name:    name,
+		Enabled: enabled,
+	})
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return Warnings(warnings), FeatureFlagNotFoundError{Name: name}
+	}
+
+	return Warnings(warnings), err
+}
+
+func newFeatureFlag(ccFeatureFlag ccv2.FeatureFlag) FeatureFlag {
+	featureFlag := FeatureFlag{
+		Name:    ccFeatureFlag.Name,
+		Enabled: ccFeatureFlag.Enabled,
+	}
+
+	if defaultValue, ok := featureFlagDefaults[ccFeatureFlag.Name]; ok {
+		featureFlag.Default = types.NullBool{Value: defaultValue, IsSet: true}
+	}
+
+	return featureFlag
+}
@@ -0,0 +1,171 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature Flag Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetFeatureFlags", func() {
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetFeatureFlagsReturns(
+					[]ccv2.FeatureFlag{
+						{Name: "user_org_creation", Enabled: true},
+						{Name: "some-unknown-flag", Enabled: false},
+					},
+					ccv2.Warnings{"get-flags-warning"},
+					nil,
+				)
+			})
+
+			It("returns the feature flags with their defaults and all warnings", func() {
+				featureFlags, warnings, err := actor.GetFeatureFlags()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(featureFlags).To(Equal([]FeatureFlag{
+					{Name: "user_org_creation", Enabled: true, Default: types.NullBool{Value: false, IsSet: true}},
+					{Name: "some-unknown-flag", Enabled: false},
+				}))
+				Expect(warnings).To(ConsistOf("get-flags-warning"))
+
+				Expect(fakeCloudControllerClient.GetFeatureFlagsCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get flags error")
+				fakeCloudControllerClient.GetFeatureFlagsReturns(nil, ccv2.Warnings{"get-flags-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetFeatureFlags()
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-flags-warning"))
+			})
+		})
+	})
+
+	Describe("GetFeatureFlag", func() {
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetFeatureFlagReturns(
+					ccv2.FeatureFlag{Name: "app_scaling", Enabled: false},
+					ccv2.Warnings{"get-flag-warning"},
+					nil,
+				)
+			})
+
+			It("returns the feature flag with its default and all warnings", func() {
+				featureFlag, warnings, err := actor.GetFeatureFlag("app_scaling")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(featureFlag).To(Equal(FeatureFlag{
+					Name:    "app_scaling",
+					Enabled: false,
+					Default: types.NullBool{Value: true, IsSet: true},
+				}))
+				Expect(warnings).To(ConsistOf("get-flag-warning"))
+
+				Expect(fakeCloudControllerClient.GetFeatureFlagCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetFeatureFlagArgsForCall(0)).To(Equal("app_scaling"))
+			})
+		})
+
+		Context("when the feature flag does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetFeatureFlagReturns(
+					ccv2.FeatureFlag{},
+					ccv2.Warnings{"get-flag-warning"},
+					ccerror.ResourceNotFoundError{},
+				)
+			})
+
+			It("returns a FeatureFlagNotFoundError and all warnings", func() {
+				_, warnings, err := actor.GetFeatureFlag("some-flag")
+				Expect(err).To(MatchError(FeatureFlagNotFoundError{Name: "some-flag"}))
+				Expect(warnings).To(ConsistOf("get-flag-warning"))
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get flag error")
+				fakeCloudControllerClient.GetFeatureFlagReturns(ccv2.FeatureFlag{}, ccv2.Warnings{"get-flag-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetFeatureFlag("app_scaling")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-flag-warning"))
+			})
+		})
+	})
+
+	Describe("SetFeatureFlag", func() {
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateFeatureFlagReturns(ccv2.Warnings{"update-flag-warning"}, nil)
+			})
+
+			It("updates the feature flag and returns all warnings", func() {
+				warnings, err := actor.SetFeatureFlag("user_org_creation", true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("update-flag-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateFeatureFlagCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateFeatureFlagArgsForCall(0)).To(Equal(ccv2.FeatureFlag{
+					Name:    "user_org_creation",
+					Enabled: true,
+				}))
+			})
+		})
+
+		Context("when the feature flag does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateFeatureFlagReturns(ccv2.Warnings{"update-flag-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a FeatureFlagNotFoundError and all warnings", func() {
+				warnings, err := actor.SetFeatureFlag("some-flag", false)
+				Expect(err).To(MatchError(FeatureFlagNotFoundError{Name: "some-flag"}))
+				Expect(warnings).To(ConsistOf("update-flag-warning"))
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("update flag error")
+				fakeCloudControllerClient.UpdateFeatureFlagReturns(ccv2.Warnings{"update-flag-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.SetFeatureFlag("user_org_creation", false)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("update-flag-warning"))
+			})
+		})
+	})
+})
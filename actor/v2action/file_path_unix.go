@@ -0,0 +1,15 @@
+// +build !windows
+
+package v2action
+
+// extendedLengthPath is unnecessary on UNIX systems, see windows version for
+// more details.
+func extendedLengthPath(path string) string {
+	return path
+}
+
+// normalizeIgnoreCase is unnecessary on UNIX systems, see windows version for
+// more details.
+func normalizeIgnoreCase(path string) string {
+	return path
+}
@@ -0,0 +1,37 @@
+// +build windows
+
+package v2action
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+const extendedLengthPathPrefix = `\\?\`
+
+// extendedLengthPath converts path into an absolute, extended-length path
+// (\\?\C:\... or \\?\UNC\server\share\...) so that files nested deeper than
+// MAX_PATH (260 characters), such as those in node_modules trees, can still
+// be opened. Relative and drive-relative (C:some-dir) paths are resolved
+// first, since Windows does not normalize extended-length paths.
+func extendedLengthPath(path string) string {
+	if strings.HasPrefix(path, extendedLengthPathPrefix) {
+		return path
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+
+	if strings.HasPrefix(absPath, `\\`) {
+		return extendedLengthPathPrefix + `UNC\` + absPath[2:]
+	}
+	return extendedLengthPathPrefix + absPath
+}
+
+// normalizeIgnoreCase lower cases .cfignore patterns and the paths matched
+// against them, because the Windows file system is case insensitive.
+func normalizeIgnoreCase(path string) string {
+	return strings.ToLower(path)
+}
@@ -0,0 +1,40 @@
+package v2action
+
+// GetOrganizationGUIDByName returns the GUID of the named organization. The
+// lookup shares the actor's name cache with GetOrganizationByName.
+func (actor Actor) GetOrganizationGUIDByName(orgName string) (string, Warnings, error) {
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	return org.GUID, warnings, err
+}
+
+// GetSpaceGUIDByOrganizationAndName returns the GUID of the named space in the
+// organization. The lookup shares the actor's name cache with
+// GetSpaceByOrganizationAndName.
+func (actor Actor) GetSpaceGUIDByOrganizationAndName(orgGUID string, spaceName string) (string, Warnings, error) {
+	space, warnings, err := actor.GetSpaceByOrganizationAndName(orgGUID, spaceName)
+	return space.GUID, warnings, err
+}
+
+// GetApplicationGUIDByNameAndSpace returns the GUID of the named application
+// in the space. GUIDs found by this method or by GetApplicationByNameAndSpace
+// are cached for the lifetime of the actor.
+func (actor Actor) GetApplicationGUIDByNameAndSpace(appName string, spaceGUID string) (string, Warnings, error) {
+	if appGUID, found := actor.nameCache.loadApplicationGUID(spaceGUID, appName); found {
+		return appGUID, nil, nil
+	}
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	return app.GUID, warnings, err
+}
+
+// GetServiceInstanceGUIDByNameAndSpace returns the GUID of the named service
+// instance in the space. GUIDs found by this method or by
+// GetServiceInstanceByNameAndSpace are cached for the lifetime of the actor.
+func (actor Actor) GetServiceInstanceGUIDByNameAndSpace(serviceInstanceName string, spaceGUID string) (string, Warnings, error) {
+	if serviceInstanceGUID, found := actor.nameCache.loadServiceInstanceGUID(spaceGUID, serviceInstanceName); found {
+		return serviceInstanceGUID, nil, nil
+	}
+
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	return serviceInstance.GUID, warnings, err
+}
@@ -0,0 +1,170 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GUID Resolver Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetOrganizationGUIDByName", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org"}},
+				ccv2.Warnings{"get-orgs-warning"},
+				nil)
+		})
+
+		It("returns the organization GUID and caches it with the organization", func() {
+			guid, warnings, err := actor.GetOrganizationGUIDByName("some-org")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(guid).To(Equal("some-org-guid"))
+			Expect(warnings).To(ConsistOf("get-orgs-warning"))
+
+			org, _, err := actor.GetOrganizationByName("some-org")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(org.GUID).To(Equal("some-org-guid"))
+			Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+		})
+	})
+
+	Describe("GetSpaceGUIDByOrganizationAndName", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{{GUID: "some-space-guid", Name: "some-space"}},
+				ccv2.Warnings{"get-spaces-warning"},
+				nil)
+		})
+
+		It("returns the space GUID", func() {
+			guid, warnings, err := actor.GetSpaceGUIDByOrganizationAndName("some-org-guid", "some-space")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(guid).To(Equal("some-space-guid"))
+			Expect(warnings).To(ConsistOf("get-spaces-warning"))
+		})
+	})
+
+	Describe("GetApplicationGUIDByNameAndSpace", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app"}},
+					ccv2.Warnings{"get-apps-warning"},
+					nil)
+			})
+
+			It("returns the application GUID and caches it per space", func() {
+				guid, warnings, err := actor.GetApplicationGUIDByNameAndSpace("some-app", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(guid).To(Equal("some-app-guid"))
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+
+				guid, warnings, err = actor.GetApplicationGUIDByNameAndSpace("some-app", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(guid).To(Equal("some-app-guid"))
+				Expect(warnings).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+
+				_, _, err = actor.GetApplicationGUIDByNameAndSpace("some-app", "some-other-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(2))
+			})
+
+			It("reuses GUIDs found by GetApplicationByNameAndSpace", func() {
+				_, _, err := actor.GetApplicationByNameAndSpace("some-app", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+
+				guid, _, err := actor.GetApplicationGUIDByNameAndSpace("some-app", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(guid).To(Equal("some-app-guid"))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+			})
+
+			It("forgets the GUID when the application is renamed", func() {
+				_, _, err := actor.GetApplicationGUIDByNameAndSpace("some-app", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+
+				_, _, err = actor.UpdateApplication(Application{GUID: "some-app-guid", Name: "new-name"})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, _, err = actor.GetApplicationGUIDByNameAndSpace("some-app", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"get-apps-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and does not cache the miss", func() {
+				_, warnings, err := actor.GetApplicationGUIDByNameAndSpace("some-app", "some-space-guid")
+				Expect(err).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+
+				_, _, err = actor.GetApplicationGUIDByNameAndSpace("some-app", "some-space-guid")
+				Expect(err).To(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(2))
+			})
+		})
+	})
+
+	Describe("GetServiceInstanceGUIDByNameAndSpace", func() {
+		Context("when the service instance exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", Name: "some-service-instance"}},
+					ccv2.Warnings{"get-instances-warning"},
+					nil)
+			})
+
+			It("returns the service instance GUID and caches it", func() {
+				guid, warnings, err := actor.GetServiceInstanceGUIDByNameAndSpace("some-service-instance", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(guid).To(Equal("some-service-instance-guid"))
+				Expect(warnings).To(ConsistOf("get-instances-warning"))
+
+				_, _, err = actor.GetServiceInstanceGUIDByNameAndSpace("some-service-instance", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetSpaceServiceInstancesCallCount()).To(Equal(1))
+			})
+
+			It("forgets the GUID when the service instance is deleted", func() {
+				_, _, err := actor.DeleteServiceInstanceByNameAndSpace("some-service-instance", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+
+				_, _, err = actor.GetServiceInstanceGUIDByNameAndSpace("some-service-instance", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetSpaceServiceInstancesCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when getting the service instance fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(nil, ccv2.Warnings{"get-instances-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetServiceInstanceGUIDByNameAndSpace("some-service-instance", "some-space-guid")
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("get-instances-warning"))
+			})
+		})
+	})
+})
@@ -1,13 +1,34 @@
 package v2action
 
 import (
+	"fmt"
 	"io"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 )
 
 type Job ccv2.Job
 
+// JobNotFoundError is returned when a job does not exist, or no longer
+// exists because the Cloud Controller has cleaned it up.
+type JobNotFoundError struct {
+	GUID string
+}
+
+func (e JobNotFoundError) Error() string {
+	return fmt.Sprintf("Job '%s' not found.", e.GUID)
+}
+
+// GetJob returns the job with the provided GUID.
+func (actor Actor) GetJob(jobGUID string) (Job, Warnings, error) {
+	job, warnings, err := actor.CloudControllerClient.GetJob(jobGUID)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return Job{}, Warnings(warnings), JobNotFoundError{GUID: jobGUID}
+	}
+	return Job(job), Warnings(warnings), err
+}
+
 func (actor Actor) PollJob(job Job) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.PollJob(ccv2.Job(job))
 	return Warnings(warnings), err
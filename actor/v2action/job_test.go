@@ -10,6 +10,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -146,4 +147,47 @@ var _ = Describe("Job Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetJob", func() {
+		Context("when getting the job succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturns(
+					ccv2.Job{GUID: "some-job-guid", Status: ccv2.JobStatusRunning},
+					ccv2.Warnings{"get-job-warning"},
+					nil)
+			})
+
+			It("returns the job and warnings", func() {
+				job, warnings, err := actor.GetJob("some-job-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(job).To(Equal(Job{GUID: "some-job-guid", Status: ccv2.JobStatusRunning}))
+				Expect(warnings).To(ConsistOf("get-job-warning"))
+				Expect(fakeCloudControllerClient.GetJobArgsForCall(0)).To(Equal("some-job-guid"))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturns(ccv2.Job{}, ccv2.Warnings{"get-job-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a JobNotFoundError and warnings", func() {
+				_, warnings, err := actor.GetJob("some-job-guid")
+				Expect(err).To(MatchError(JobNotFoundError{GUID: "some-job-guid"}))
+				Expect(warnings).To(ConsistOf("get-job-warning"))
+			})
+		})
+
+		Context("when getting the job fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturns(ccv2.Job{}, ccv2.Warnings{"get-job-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetJob("some-job-guid")
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("get-job-warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,169 @@
+package v2action
+
+import "sync"
+
+// nameCache memoizes organizations and spaces looked up by name, and the
+// GUIDs of applications and service instances, so that each unique name is
+// only fetched once for the lifetime of an Actor. A nil nameCache caches
+// nothing.
+type nameCache struct {
+	mutex            sync.Mutex
+	orgs             map[string]Organization
+	spaces           map[spaceCacheKey]Space
+	apps             map[spaceResourceCacheKey]string
+	serviceInstances map[spaceResourceCacheKey]string
+}
+
+type spaceCacheKey struct {
+	orgGUID   string
+	spaceName string
+}
+
+type spaceResourceCacheKey struct {
+	spaceGUID string
+	name      string
+}
+
+func newNameCache() *nameCache {
+	return &nameCache{
+		orgs:             map[string]Organization{},
+		spaces:           map[spaceCacheKey]Space{},
+		apps:             map[spaceResourceCacheKey]string{},
+		serviceInstances: map[spaceResourceCacheKey]string{},
+	}
+}
+
+func (cache *nameCache) loadOrganization(orgName string) (Organization, bool) {
+	if cache == nil {
+		return Organization{}, false
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	org, found := cache.orgs[orgName]
+	return org, found
+}
+
+func (cache *nameCache) saveOrganization(orgName string, org Organization) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.orgs[orgName] = org
+}
+
+// forgetOrganization removes the organization and all of its spaces.
+func (cache *nameCache) forgetOrganization(orgGUID string) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	for name, org := range cache.orgs {
+		if org.GUID == orgGUID {
+			delete(cache.orgs, name)
+		}
+	}
+	for key := range cache.spaces {
+		if key.orgGUID == orgGUID {
+			delete(cache.spaces, key)
+		}
+	}
+}
+
+func (cache *nameCache) loadSpace(orgGUID string, spaceName string) (Space, bool) {
+	if cache == nil {
+		return Space{}, false
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	space, found := cache.spaces[spaceCacheKey{orgGUID: orgGUID, spaceName: spaceName}]
+	return space, found
+}
+
+func (cache *nameCache) saveSpace(orgGUID string, spaceName string, space Space) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.spaces[spaceCacheKey{orgGUID: orgGUID, spaceName: spaceName}] = space
+}
+
+func (cache *nameCache) forgetSpace(spaceGUID string) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	for key, space := range cache.spaces {
+		if space.GUID == spaceGUID {
+			delete(cache.spaces, key)
+		}
+	}
+}
+
+func (cache *nameCache) loadApplicationGUID(spaceGUID string, appName string) (string, bool) {
+	return cache.loadGUID(cache.apps, spaceGUID, appName)
+}
+
+func (cache *nameCache) saveApplicationGUID(spaceGUID string, appName string, appGUID string) {
+	cache.saveGUID(cache.apps, spaceGUID, appName, appGUID)
+}
+
+func (cache *nameCache) forgetApplication(appGUID string) {
+	cache.forgetGUID(cache.apps, appGUID)
+}
+
+func (cache *nameCache) loadServiceInstanceGUID(spaceGUID string, serviceInstanceName string) (string, bool) {
+	return cache.loadGUID(cache.serviceInstances, spaceGUID, serviceInstanceName)
+}
+
+func (cache *nameCache) saveServiceInstanceGUID(spaceGUID string, serviceInstanceName string, serviceInstanceGUID string) {
+	cache.saveGUID(cache.serviceInstances, spaceGUID, serviceInstanceName, serviceInstanceGUID)
+}
+
+func (cache *nameCache) forgetServiceInstance(serviceInstanceGUID string) {
+	cache.forgetGUID(cache.serviceInstances, serviceInstanceGUID)
+}
+
+func (cache *nameCache) loadGUID(guids map[spaceResourceCacheKey]string, spaceGUID string, name string) (string, bool) {
+	if cache == nil {
+		return "", false
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	guid, found := guids[spaceResourceCacheKey{spaceGUID: spaceGUID, name: name}]
+	return guid, found
+}
+
+func (cache *nameCache) saveGUID(guids map[spaceResourceCacheKey]string, spaceGUID string, name string, guid string) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	guids[spaceResourceCacheKey{spaceGUID: spaceGUID, name: name}] = guid
+}
+
+func (cache *nameCache) forgetGUID(guids map[spaceResourceCacheKey]string, guid string) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	for key, cachedGUID := range guids {
+		if cachedGUID == guid {
+			delete(guids, key)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// OrgRole is a role a user can be granted in an organization.
+type OrgRole string
+
+const (
+	OrgRoleAuditor        OrgRole = "OrgAuditor"
+	OrgRoleBillingManager OrgRole = "BillingManager"
+	OrgRoleManager        OrgRole = "OrgManager"
+)
+
+// GrantOrgRoleByUsername grants the provided role in the organization to the
+// user with the provided username and makes them a member of the
+// organization. When isClient is true, username is treated as the client ID
+// of a UAA client and the role is granted by GUID instead.
+func (actor Actor) GrantOrgRoleByUsername(orgGUID string, username string, isClient bool, role OrgRole) (Warnings, error) {
+	var (
+		updateRole func(string, string) (ccv2.Warnings, error)
+		updateUser func(string, string) (ccv2.Warnings, error)
+	)
+
+	switch role {
+	case OrgRoleAuditor:
+		updateRole = actor.CloudControllerClient.UpdateOrganizationAuditorByUsername
+		if isClient {
+			updateRole = actor.CloudControllerClient.UpdateOrganizationAuditor
+		}
+	case OrgRoleBillingManager:
+		updateRole = actor.CloudControllerClient.UpdateOrganizationBillingManagerByUsername
+		if isClient {
+			updateRole = actor.CloudControllerClient.UpdateOrganizationBillingManager
+		}
+	case OrgRoleManager:
+		updateRole = actor.CloudControllerClient.UpdateOrganizationManagerByUsername
+		if isClient {
+			updateRole = actor.CloudControllerClient.UpdateOrganizationManager
+		}
+	default:
+		return nil, fmt.Errorf("unknown org role %s", role)
+	}
+
+	updateUser = actor.CloudControllerClient.UpdateOrganizationUserByUsername
+	if isClient {
+		updateUser = actor.CloudControllerClient.UpdateOrganizationUser
+	}
+
+	var allWarnings Warnings
+	warnings, err := updateRole(orgGUID, username)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = updateUser(orgGUID, username)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// RevokeOrgRoleByUsername revokes the provided role in the organization from
+// the user with the provided username. When isClient is true, username is
+// treated as the client ID of a UAA client.
+func (actor Actor) RevokeOrgRoleByUsername(orgGUID string, username string, isClient bool, role OrgRole) (Warnings, error) {
+	var deleteRole func(string, string) (ccv2.Warnings, error)
+
+	switch role {
+	case OrgRoleAuditor:
+		deleteRole = actor.CloudControllerClient.DeleteOrganizationAuditorByUsername
+		if isClient {
+			deleteRole = actor.CloudControllerClient.DeleteOrganizationAuditor
+		}
+	case OrgRoleBillingManager:
+		deleteRole = actor.CloudControllerClient.DeleteOrganizationBillingManagerByUsername
+		if isClient {
+			deleteRole = actor.CloudControllerClient.DeleteOrganizationBillingManager
+		}
+	case OrgRoleManager:
+		deleteRole = actor.CloudControllerClient.DeleteOrganizationManagerByUsername
+		if isClient {
+			deleteRole = actor.CloudControllerClient.DeleteOrganizationManager
+		}
+	default:
+		return nil, fmt.Errorf("unknown org role %s", role)
+	}
+
+	warnings, err := deleteRole(orgGUID, username)
+	return Warnings(warnings), err
+}
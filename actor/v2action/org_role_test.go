@@ -0,0 +1,190 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Org Role Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GrantOrgRoleByUsername", func() {
+		DescribeTable("grants the role and adds the user to the org",
+			func(role OrgRole, isClient bool, roleCallCount func() int, roleArgs func(int) (string, string), userCallCount func() int, userArgs func(int) (string, string)) {
+				warnings, err := actor.GrantOrgRoleByUsername("some-org-guid", "some-user", isClient, role)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeEmpty())
+
+				Expect(roleCallCount()).To(Equal(1))
+				orgGUID, username := roleArgs(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(username).To(Equal("some-user"))
+
+				Expect(userCallCount()).To(Equal(1))
+				orgGUID, username = userArgs(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(username).To(Equal("some-user"))
+			},
+
+			Entry("OrgAuditor by username", OrgRoleAuditor, false,
+				func() int { return fakeCloudControllerClient.UpdateOrganizationAuditorByUsernameCallCount() },
+				func(i int) (string, string) {
+					return fakeCloudControllerClient.UpdateOrganizationAuditorByUsernameArgsForCall(i)
+				},
+				func() int { return fakeCloudControllerClient.UpdateOrganizationUserByUsernameCallCount() },
+				func(i int) (string, string) {
+					return fakeCloudControllerClient.UpdateOrganizationUserByUsernameArgsForCall(i)
+				}),
+			Entry("BillingManager by username", OrgRoleBillingManager, false,
+				func() int { return fakeCloudControllerClient.UpdateOrganizationBillingManagerByUsernameCallCount() },
+				func(i int) (string, string) {
+					return fakeCloudControllerClient.UpdateOrganizationBillingManagerByUsernameArgsForCall(i)
+				},
+				func() int { return fakeCloudControllerClient.UpdateOrganizationUserByUsernameCallCount() },
+				func(i int) (string, string) {
+					return fakeCloudControllerClient.UpdateOrganizationUserByUsernameArgsForCall(i)
+				}),
+			Entry("OrgManager by username", OrgRoleManager, false,
+				func() int { return fakeCloudControllerClient.UpdateOrganizationManagerByUsernameCallCount() },
+				func(i int) (string, string) {
+					return fakeCloudControllerClient.UpdateOrganizationManagerByUsernameArgsForCall(i)
+				},
+				func() int { return fakeCloudControllerClient.UpdateOrganizationUserByUsernameCallCount() },
+				func(i int) (string, string) {
+					return fakeCloudControllerClient.UpdateOrganizationUserByUsernameArgsForCall(i)
+				}),
+			Entry("OrgAuditor for a client", OrgRoleAuditor, true,
+				func() int { return fakeCloudControllerClient.UpdateOrganizationAuditorCallCount() },
+				func(i int) (string, string) { return fakeCloudControllerClient.UpdateOrganizationAuditorArgsForCall(i) },
+				func() int { return fakeCloudControllerClient.UpdateOrganizationUserCallCount() },
+				func(i int) (string, string) { return fakeCloudControllerClient.UpdateOrganizationUserArgsForCall(i) }),
+			Entry("BillingManager for a client", OrgRoleBillingManager, true,
+				func() int { return fakeCloudControllerClient.UpdateOrganizationBillingManagerCallCount() },
+				func(i int) (string, string) {
+					return fakeCloudControllerClient.UpdateOrganizationBillingManagerArgsForCall(i)
+				},
+				func() int { return fakeCloudControllerClient.UpdateOrganizationUserCallCount() },
+				func(i int) (string, string) { return fakeCloudControllerClient.UpdateOrganizationUserArgsForCall(i) }),
+			Entry("OrgManager for a client", OrgRoleManager, true,
+				func() int { return fakeCloudControllerClient.UpdateOrganizationManagerCallCount() },
+				func(i int) (string, string) { return fakeCloudControllerClient.UpdateOrganizationManagerArgsForCall(i) },
+				func() int { return fakeCloudControllerClient.UpdateOrganizationUserCallCount() },
+				func(i int) (string, string) { return fakeCloudControllerClient.UpdateOrganizationUserArgsForCall(i) }),
+		)
+
+		Context("when the requests return warnings", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateOrganizationManagerByUsernameReturns(ccv2.Warnings{"role-warning"}, nil)
+				fakeCloudControllerClient.UpdateOrganizationUserByUsernameReturns(ccv2.Warnings{"user-warning"}, nil)
+			})
+
+			It("returns all warnings", func() {
+				warnings, err := actor.GrantOrgRoleByUsername("some-org-guid", "some-user", false, OrgRoleManager)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("role-warning", "user-warning"))
+			})
+		})
+
+		Context("when granting the role fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("role error")
+				fakeCloudControllerClient.UpdateOrganizationManagerByUsernameReturns(ccv2.Warnings{"role-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings without adding the user to the org", func() {
+				warnings, err := actor.GrantOrgRoleByUsername("some-org-guid", "some-user", false, OrgRoleManager)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("role-warning"))
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserByUsernameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when adding the user to the org fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("user error")
+				fakeCloudControllerClient.UpdateOrganizationManagerByUsernameReturns(ccv2.Warnings{"role-warning"}, nil)
+				fakeCloudControllerClient.UpdateOrganizationUserByUsernameReturns(ccv2.Warnings{"user-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.GrantOrgRoleByUsername("some-org-guid", "some-user", false, OrgRoleManager)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("role-warning", "user-warning"))
+			})
+		})
+	})
+
+	Describe("RevokeOrgRoleByUsername", func() {
+		DescribeTable("revokes the role",
+			func(role OrgRole, isClient bool, roleCallCount func() int, roleArgs func(int) (string, string)) {
+				_, err := actor.RevokeOrgRoleByUsername("some-org-guid", "some-user", isClient, role)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(roleCallCount()).To(Equal(1))
+				orgGUID, username := roleArgs(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(username).To(Equal("some-user"))
+			},
+
+			Entry("OrgAuditor by username", OrgRoleAuditor, false,
+				func() int { return fakeCloudControllerClient.DeleteOrganizationAuditorByUsernameCallCount() },
+				func(i int) (string, string) {
+					return fakeCloudControllerClient.DeleteOrganizationAuditorByUsernameArgsForCall(i)
+				}),
+			Entry("BillingManager by username", OrgRoleBillingManager, false,
+				func() int { return fakeCloudControllerClient.DeleteOrganizationBillingManagerByUsernameCallCount() },
+				func(i int) (string, string) {
+					return fakeCloudControllerClient.DeleteOrganizationBillingManagerByUsernameArgsForCall(i)
+				}),
+			Entry("OrgManager by username", OrgRoleManager, false,
+				func() int { return fakeCloudControllerClient.DeleteOrganizationManagerByUsernameCallCount() },
+				func(i int) (string, string) {
+					return fakeCloudControllerClient.DeleteOrganizationManagerByUsernameArgsForCall(i)
+				}),
+			Entry("OrgAuditor for a client", OrgRoleAuditor, true,
+				func() int { return fakeCloudControllerClient.DeleteOrganizationAuditorCallCount() },
+				func(i int) (string, string) { return fakeCloudControllerClient.DeleteOrganizationAuditorArgsForCall(i) }),
+			Entry("BillingManager for a client", OrgRoleBillingManager, true,
+				func() int { return fakeCloudControllerClient.DeleteOrganizationBillingManagerCallCount() },
+				func(i int) (string, string) {
+					return fakeCloudControllerClient.DeleteOrganizationBillingManagerArgsForCall(i)
+				}),
+			Entry("OrgManager for a client", OrgRoleManager, true,
+				func() int { return fakeCloudControllerClient.DeleteOrganizationManagerCallCount() },
+				func(i int) (string, string) { return fakeCloudControllerClient.DeleteOrganizationManagerArgsForCall(i) }),
+		)
+
+		Context("when revoking the role fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("role error")
+				fakeCloudControllerClient.DeleteOrganizationAuditorByUsernameReturns(ccv2.Warnings{"role-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := actor.RevokeOrgRoleByUsername("some-org-guid", "some-user", false, OrgRoleAuditor)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("role-warning"))
+			})
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	log "github.com/sirupsen/logrus"
 )
 
 // Organization represents a CLI Organization.
@@ -22,6 +23,16 @@ func (e OrganizationNotFoundError) Error() string {
 	return fmt.Sprintf("Organization '%s' not found.", e.Name)
 }
 
+// OrganizationNameTakenError is returned when an organization with the
+// provided name already exists.
+type OrganizationNameTakenError struct {
+	Name string
+}
+
+func (e OrganizationNameTakenError) Error() string {
+	return fmt.Sprintf("Organization '%s' already exists.", e.Name)
+}
+
 // MultipleOrganizationsFoundError represents the scenario when the cloud
 // controller returns multiple organizations when filtering by name. This is a
 // far out edge case and should not happen.
@@ -46,8 +57,28 @@ func (actor Actor) GetOrganization(guid string) (Organization, Warnings, error)
 	return Organization(org), Warnings(warnings), err
 }
 
+// GetOrganizations returns all organizations visible to the user.
+func (actor Actor) GetOrganizations() ([]Organization, Warnings, error) {
+	ccv2Orgs, warnings, err := actor.CloudControllerClient.GetOrganizations()
+	if err != nil {
+		return []Organization{}, Warnings(warnings), err
+	}
+
+	orgs := make([]Organization, len(ccv2Orgs))
+	for i, ccv2Org := range ccv2Orgs {
+		orgs[i] = Organization(ccv2Org)
+	}
+
+	return orgs, Warnings(warnings), nil
+}
+
 // GetOrganizationByName returns an Organization based off of the name given.
+// Found organizations are cached for the lifetime of the actor.
 func (actor Actor) GetOrganizationByName(orgName string) (Organization, Warnings, error) {
+	if org, found := actor.nameCache.loadOrganization(orgName); found {
+		return org, nil, nil
+	}
+
 	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(ccv2.Query{
 		Filter:   ccv2.NameFilter,
 		Operator: ccv2.EqualOperator,
@@ -69,7 +100,55 @@ func (actor Actor) GetOrganizationByName(orgName string) (Organization, Warnings
 		return Organization{}, Warnings(warnings), MultipleOrganizationsFoundError{Name: orgName, GUIDs: guids}
 	}
 
-	return Organization(orgs[0]), Warnings(warnings), nil
+	org := Organization(orgs[0])
+	actor.nameCache.saveOrganization(orgName, org)
+	return org, Warnings(warnings), nil
+}
+
+// CreateOrganization creates an organization with the provided name. When
+// quotaName is set the organization is assigned that quota instead of the
+// default one, and when managerUsername is set that user is made an
+// OrgManager of the organization. If the manager cannot be assigned, the
+// organization is deleted again and the error is returned.
+func (actor Actor) CreateOrganization(orgName string, quotaName string, managerUsername string) (Organization, Warnings, error) {
+	var allWarnings Warnings
+
+	var quota OrganizationQuota
+	if quotaName != "" {
+		var (
+			warnings Warnings
+			err      error
+		)
+		quota, warnings, err = actor.GetQuotaByName(quotaName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Organization{}, allWarnings, err
+		}
+	}
+
+	org, ccWarnings, err := actor.CloudControllerClient.CreateOrganization(orgName, quota.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.OrganizationNameTakenError); ok {
+			return Organization{}, allWarnings, OrganizationNameTakenError{Name: orgName}
+		}
+		return Organization{}, allWarnings, err
+	}
+
+	if managerUsername != "" {
+		warnings, err := actor.GrantOrgRoleByUsername(org.GUID, managerUsername, false, OrgRoleManager)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			warnings, rollbackErr := actor.deleteOrganization(org.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if rollbackErr != nil {
+				log.Errorln("rolling back organization creation:", rollbackErr)
+			}
+			return Organization{}, allWarnings, err
+		}
+	}
+
+	return Organization(org), allWarnings, nil
 }
 
 // DeleteOrganization deletes the Organization associated with the provided
@@ -84,16 +163,8 @@ func (actor Actor) DeleteOrganization(orgName string) (Warnings, error) {
 		return allWarnings, err
 	}
 
-	job, deleteWarnings, err := actor.CloudControllerClient.DeleteOrganization(org.GUID)
-	allWarnings = append(allWarnings, deleteWarnings...)
-	if err != nil {
-		return allWarnings, err
-	}
-
-	ccWarnings, err := actor.CloudControllerClient.PollJob(job)
-	for _, warning := range ccWarnings {
-		allWarnings = append(allWarnings, warning)
-	}
+	warnings, err = actor.deleteOrganization(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
 
 	return allWarnings, err
 }
@@ -0,0 +1,287 @@
+package v2action
+
+import (
+	"fmt"
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// maxConcurrentDeleteRequests is the maximum number of requests a recursive
+// organization delete has in flight at once.
+const maxConcurrentDeleteRequests = 10
+
+// DeletedResourceType is the type of a resource deleted as part of a
+// recursive organization delete.
+type DeletedResourceType string
+
+const (
+	DeletedServiceBinding DeletedResourceType = "service binding"
+	DeletedApplication    DeletedResourceType = "app"
+	DeletedRoute          DeletedResourceType = "route"
+	DeletedSpace          DeletedResourceType = "space"
+)
+
+// DeleteOrganizationProgress is the outcome of deleting a single resource as
+// part of a recursive organization delete. Err is nil when the resource was
+// deleted.
+type DeleteOrganizationProgress struct {
+	Type DeletedResourceType
+	Name string
+	Err  error
+}
+
+// OrganizationPartiallyDeletedError is returned when some of the resources in
+// an organization could not be deleted. The organization itself is left in
+// place so that the delete can be retried.
+type OrganizationPartiallyDeletedError struct {
+	Name     string
+	Failures []DeleteOrganizationProgress
+}
+
+func (e OrganizationPartiallyDeletedError) Error() string {
+	return fmt.Sprintf("Organization '%s' was only partially deleted: %d resources could not be deleted.", e.Name, len(e.Failures))
+}
+
+// DeleteOrganizationRecursively deletes the organization along with its
+// spaces, apps, routes and service bindings. Resources are deleted
+// concurrently in dependency order: an app's service bindings before the app,
+// a space's apps before its routes, and a space's routes before the space.
+// The organization is only deleted once every space is gone. progress is
+// called once for every resource that is deleted or fails to delete; calls
+// are never made concurrently.
+func (actor Actor) DeleteOrganizationRecursively(orgName string, progress func(DeleteOrganizationProgress)) (Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	spaces, warnings, err := actor.GetOrganizationSpaces(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	deleter := organizationDeleter{
+		actor:    actor,
+		progress: progress,
+		requests: make(chan struct{}, maxConcurrentDeleteRequests),
+	}
+	deleter.forEach(len(spaces), func(i int) bool {
+		return deleter.deleteSpace(spaces[i])
+	})
+
+	allWarnings = append(allWarnings, deleter.warnings...)
+	if len(deleter.failures) > 0 {
+		return allWarnings, OrganizationPartiallyDeletedError{
+			Name:     orgName,
+			Failures: deleter.failures,
+		}
+	}
+
+	warnings, err = actor.deleteOrganization(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// StartDeleteOrganization asks the Cloud Controller to delete the
+// organization and everything in it, without waiting for the deletion job.
+func (actor Actor) StartDeleteOrganization(orgName string) (Job, Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return Job{}, allWarnings, err
+	}
+
+	job, warnings, err := actor.CloudControllerClient.DeleteOrganization(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Job{}, allWarnings, err
+	}
+	actor.nameCache.forgetOrganization(org.GUID)
+
+	return Job(job), allWarnings, nil
+}
+
+func (actor Actor) deleteOrganization(orgGUID string) (Warnings, error) {
+	job, deleteWarnings, err := actor.CloudControllerClient.DeleteOrganization(orgGUID)
+	allWarnings := Warnings(deleteWarnings)
+	if err != nil {
+		return allWarnings, err
+	}
+	actor.nameCache.forgetOrganization(orgGUID)
+
+	warnings, err := actor.PollJob(Job(job))
+	allWarnings = append(allWarnings, warnings...)
+
+	return allWarnings, err
+}
+
+// organizationDeleter tracks the warnings and failures of a recursive
+// organization delete across all of its goroutines.
+type organizationDeleter struct {
+	actor    Actor
+	progress func(DeleteOrganizationProgress)
+	requests chan struct{}
+
+	mutex    sync.Mutex
+	warnings Warnings
+	failures []DeleteOrganizationProgress
+
+	domainMutex sync.Mutex
+}
+
+// deleteSpace deletes the space's apps, then its routes, then the space. It
+// returns false if anything in the space could not be deleted.
+func (deleter *organizationDeleter) deleteSpace(space Space) bool {
+	var apps []Application
+	err := deleter.call(func() (Warnings, error) {
+		var (
+			warnings Warnings
+			err      error
+		)
+		apps, warnings, err = deleter.actor.GetApplicationsBySpace(space.GUID)
+		return warnings, err
+	})
+	if err != nil {
+		deleter.report(DeletedSpace, space.Name, err)
+		return false
+	}
+
+	if !deleter.forEach(len(apps), func(i int) bool {
+		return deleter.deleteApplication(apps[i])
+	}) {
+		return false
+	}
+
+	var routes Routes
+	err = deleter.call(func() (Warnings, error) {
+		ccv2Routes, warnings, err := deleter.actor.CloudControllerClient.GetSpaceRoutes(space.GUID)
+		if err != nil {
+			return Warnings(warnings), err
+		}
+
+		// The actor's domain cache is not safe for concurrent use.
+		deleter.domainMutex.Lock()
+		defer deleter.domainMutex.Unlock()
+		var domainWarnings Warnings
+		routes, domainWarnings, err = deleter.actor.applyDomain(ccv2Routes)
+		return append(Warnings(warnings), domainWarnings...), err
+	})
+	if err != nil {
+		deleter.report(DeletedSpace, space.Name, err)
+		return false
+	}
+
+	if !deleter.forEach(len(routes), func(i int) bool {
+		return deleter.deleteResource(DeletedRoute, routes[i].String(), func() (Warnings, error) {
+			return deleter.actor.DeleteRoute(routes[i].GUID)
+		})
+	}) {
+		return false
+	}
+
+	return deleter.deleteResource(DeletedSpace, space.Name, func() (Warnings, error) {
+		return deleter.actor.deleteSpace(space.GUID)
+	})
+}
+
+// deleteApplication deletes the app's service bindings, then the app. It
+// returns false if the app or any of its bindings could not be deleted.
+func (deleter *organizationDeleter) deleteApplication(app Application) bool {
+	client := deleter.actor.CloudControllerClient
+
+	var bindings []ccv2.ServiceBinding
+	err := deleter.call(func() (Warnings, error) {
+		var (
+			warnings ccv2.Warnings
+			err      error
+		)
+		bindings, warnings, err = client.GetServiceBindings(ccv2.Query{
+			Filter:   ccv2.AppGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{app.GUID},
+		})
+		return Warnings(warnings), err
+	})
+	if err != nil {
+		deleter.report(DeletedApplication, app.Name, err)
+		return false
+	}
+
+	if !deleter.forEach(len(bindings), func(i int) bool {
+		return deleter.deleteResource(DeletedServiceBinding, bindings[i].GUID, func() (Warnings, error) {
+			warnings, err := client.DeleteServiceBinding(bindings[i].GUID)
+			return Warnings(warnings), err
+		})
+	}) {
+		return false
+	}
+
+	return deleter.deleteResource(DeletedApplication, app.Name, func() (Warnings, error) {
+		warnings, err := client.DeleteApplication(app.GUID)
+		return Warnings(warnings), err
+	})
+}
+
+// deleteResource deletes a single resource and reports the outcome. A
+// resource that no longer exists counts as deleted.
+func (deleter *organizationDeleter) deleteResource(resourceType DeletedResourceType, name string, deleteFunc func() (Warnings, error)) bool {
+	err := deleter.call(deleteFunc)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		err = nil
+	}
+
+	deleter.report(resourceType, name, err)
+	return err == nil
+}
+
+// call makes a request once fewer than maxConcurrentDeleteRequests are in
+// flight and records its warnings.
+func (deleter *organizationDeleter) call(request func() (Warnings, error)) error {
+	deleter.requests <- struct{}{}
+	warnings, err := request()
+	<-deleter.requests
+
+	deleter.mutex.Lock()
+	defer deleter.mutex.Unlock()
+	deleter.warnings = append(deleter.warnings, warnings...)
+	return err
+}
+
+func (deleter *organizationDeleter) report(resourceType DeletedResourceType, name string, err error) {
+	deleter.mutex.Lock()
+	defer deleter.mutex.Unlock()
+
+	progress := DeleteOrganizationProgress{Type: resourceType, Name: name, Err: err}
+	if err != nil {
+		deleter.failures = append(deleter.failures, progress)
+	}
+	if deleter.progress != nil {
+		deleter.progress(progress)
+	}
+}
+
+// forEach calls f concurrently for every index up to count and waits for all
+// of them to finish. It returns true if every call returned true.
+func (deleter *organizationDeleter) forEach(count int, f func(i int) bool) bool {
+	var (
+		wg        sync.WaitGroup
+		mutex     sync.Mutex
+		succeeded = true
+	)
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if !f(i) {
+				mutex.Lock()
+				succeeded = false
+				mutex.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return succeeded
+}
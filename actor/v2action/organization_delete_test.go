@@ -0,0 +1,317 @@
+package v2action_test
+
+import (
+	"errors"
+	"sync"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recursive Organization Delete Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("DeleteOrganizationRecursively", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+
+			progressMutex sync.Mutex
+			progress      []DeleteOrganizationProgress
+
+			callMutex sync.Mutex
+			calls     []string
+		)
+
+		recordCall := func(call string) {
+			callMutex.Lock()
+			defer callMutex.Unlock()
+			calls = append(calls, call)
+		}
+
+		callIndex := func(call string) int {
+			for i, c := range calls {
+				if c == call {
+					return i
+				}
+			}
+			Fail("expected call " + call)
+			return -1
+		}
+
+		BeforeEach(func() {
+			progress = nil
+			calls = nil
+
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org"}},
+				ccv2.Warnings{"get-org-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{
+					{GUID: "space-guid-1", Name: "space-1"},
+					{GUID: "space-guid-2", Name: "space-2"},
+				},
+				ccv2.Warnings{"get-spaces-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsStub = func(queries ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+				switch queries[0].Values[0] {
+				case "space-guid-1":
+					return []ccv2.Application{
+						{GUID: "app-guid-1", Name: "app-1"},
+						{GUID: "app-guid-2", Name: "app-2"},
+					}, ccv2.Warnings{"get-apps-warning"}, nil
+				default:
+					return nil, ccv2.Warnings{"get-apps-warning"}, nil
+				}
+			}
+			fakeCloudControllerClient.GetServiceBindingsStub = func(queries ...ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
+				if queries[0].Values[0] == "app-guid-1" {
+					return []ccv2.ServiceBinding{{GUID: "binding-guid-1"}}, ccv2.Warnings{"get-bindings-warning"}, nil
+				}
+				return nil, ccv2.Warnings{"get-bindings-warning"}, nil
+			}
+			fakeCloudControllerClient.DeleteServiceBindingStub = func(guid string) (ccv2.Warnings, error) {
+				recordCall("binding " + guid)
+				return ccv2.Warnings{"delete-binding-warning"}, nil
+			}
+			fakeCloudControllerClient.DeleteApplicationStub = func(guid string) (ccv2.Warnings, error) {
+				recordCall("app " + guid)
+				return ccv2.Warnings{"delete-app-warning"}, nil
+			}
+			fakeCloudControllerClient.GetSpaceRoutesStub = func(spaceGUID string, _ ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+				if spaceGUID == "space-guid-1" {
+					return []ccv2.Route{{GUID: "route-guid-1", Host: "some-host", DomainGUID: "domain-guid"}}, ccv2.Warnings{"get-routes-warning"}, nil
+				}
+				return nil, ccv2.Warnings{"get-routes-warning"}, nil
+			}
+			fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{GUID: "domain-guid", Name: "some-domain.com"}, nil, nil)
+			fakeCloudControllerClient.DeleteRouteStub = func(guid string) (ccv2.Warnings, error) {
+				recordCall("route " + guid)
+				return ccv2.Warnings{"delete-route-warning"}, nil
+			}
+			fakeCloudControllerClient.DeleteSpaceStub = func(guid string) (ccv2.Job, ccv2.Warnings, error) {
+				recordCall("space " + guid)
+				return ccv2.Job{GUID: "space-job-guid"}, ccv2.Warnings{"delete-space-warning"}, nil
+			}
+			fakeCloudControllerClient.DeleteOrganizationStub = func(guid string) (ccv2.Job, ccv2.Warnings, error) {
+				recordCall("org " + guid)
+				return ccv2.Job{GUID: "org-job-guid"}, ccv2.Warnings{"delete-org-warning"}, nil
+			}
+			fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteOrganizationRecursively("some-org", func(p DeleteOrganizationProgress) {
+				progressMutex.Lock()
+				defer progressMutex.Unlock()
+				progress = append(progress, p)
+			})
+		})
+
+		It("deletes every resource in dependency order and reports progress", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(calls).To(ConsistOf(
+				"binding binding-guid-1",
+				"app app-guid-1",
+				"app app-guid-2",
+				"route route-guid-1",
+				"space space-guid-1",
+				"space space-guid-2",
+				"org some-org-guid",
+			))
+			Expect(callIndex("binding binding-guid-1")).To(BeNumerically("<", callIndex("app app-guid-1")))
+			Expect(callIndex("app app-guid-1")).To(BeNumerically("<", callIndex("route route-guid-1")))
+			Expect(callIndex("app app-guid-2")).To(BeNumerically("<", callIndex("route route-guid-1")))
+			Expect(callIndex("route route-guid-1")).To(BeNumerically("<", callIndex("space space-guid-1")))
+			Expect(callIndex("space space-guid-1")).To(BeNumerically("<", callIndex("org some-org-guid")))
+			Expect(callIndex("space space-guid-2")).To(BeNumerically("<", callIndex("org some-org-guid")))
+
+			Expect(progress).To(ConsistOf(
+				DeleteOrganizationProgress{Type: DeletedServiceBinding, Name: "binding-guid-1"},
+				DeleteOrganizationProgress{Type: DeletedApplication, Name: "app-1"},
+				DeleteOrganizationProgress{Type: DeletedApplication, Name: "app-2"},
+				DeleteOrganizationProgress{Type: DeletedRoute, Name: "some-host.some-domain.com"},
+				DeleteOrganizationProgress{Type: DeletedSpace, Name: "space-1"},
+				DeleteOrganizationProgress{Type: DeletedSpace, Name: "space-2"},
+			))
+
+			Expect(warnings).To(ContainElement("get-org-warning"))
+			Expect(warnings).To(ContainElement("get-spaces-warning"))
+			Expect(warnings).To(ContainElement("delete-binding-warning"))
+			Expect(warnings).To(ContainElement("delete-app-warning"))
+			Expect(warnings).To(ContainElement("delete-route-warning"))
+			Expect(warnings).To(ContainElement("delete-space-warning"))
+			Expect(warnings).To(ContainElement("delete-org-warning"))
+
+			Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(3))
+		})
+
+		Context("when a resource no longer exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationReturns(nil, ccerror.ResourceNotFoundError{})
+				fakeCloudControllerClient.DeleteApplicationStub = nil
+			})
+
+			It("counts it as deleted", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(progress).To(ContainElement(DeleteOrganizationProgress{Type: DeletedApplication, Name: "app-1"}))
+				Expect(fakeCloudControllerClient.DeleteOrganizationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when deleting an app fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete app error")
+				fakeCloudControllerClient.DeleteApplicationStub = func(guid string) (ccv2.Warnings, error) {
+					if guid == "app-guid-2" {
+						return ccv2.Warnings{"delete-app-warning"}, expectedErr
+					}
+					return ccv2.Warnings{"delete-app-warning"}, nil
+				}
+			})
+
+			It("leaves that space and the org in place and returns the failures", func() {
+				Expect(executeErr).To(MatchError(OrganizationPartiallyDeletedError{
+					Name: "some-org",
+					Failures: []DeleteOrganizationProgress{
+						{Type: DeletedApplication, Name: "app-2", Err: expectedErr},
+					},
+				}))
+				Expect(warnings).To(ContainElement("delete-app-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteRouteCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.DeleteSpaceCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteSpaceArgsForCall(0)).To(Equal("space-guid-2"))
+				Expect(fakeCloudControllerClient.DeleteOrganizationCallCount()).To(Equal(0))
+
+				Expect(progress).To(ContainElement(DeleteOrganizationProgress{Type: DeletedApplication, Name: "app-2", Err: expectedErr}))
+			})
+		})
+
+		Context("when listing a space's apps fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get apps error")
+				fakeCloudControllerClient.GetApplicationsStub = nil
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"get-apps-warning"}, expectedErr)
+			})
+
+			It("reports the spaces as failed", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(OrganizationPartiallyDeletedError{}))
+				Expect(executeErr.(OrganizationPartiallyDeletedError).Failures).To(ConsistOf(
+					DeleteOrganizationProgress{Type: DeletedSpace, Name: "space-1", Err: expectedErr},
+					DeleteOrganizationProgress{Type: DeletedSpace, Name: "space-2", Err: expectedErr},
+				))
+				Expect(fakeCloudControllerClient.DeleteSpaceCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.DeleteOrganizationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when listing the spaces fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get spaces error")
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"get-spaces-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-spaces-warning"))
+				Expect(fakeCloudControllerClient.DeleteOrganizationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when deleting the org fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete org error")
+				fakeCloudControllerClient.DeleteOrganizationStub = nil
+				fakeCloudControllerClient.DeleteOrganizationReturns(ccv2.Job{}, ccv2.Warnings{"delete-org-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("delete-org-warning"))
+			})
+		})
+	})
+
+	Describe("StartDeleteOrganization", func() {
+		var (
+			job        Job
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			job, warnings, executeErr = actor.StartDeleteOrganization("some-org")
+		})
+
+		Context("when the organization exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org"}},
+					ccv2.Warnings{"get-org-warning"},
+					nil)
+				fakeCloudControllerClient.DeleteOrganizationReturns(
+					ccv2.Job{GUID: "some-job-guid", Status: ccv2.JobStatusQueued},
+					ccv2.Warnings{"delete-org-warning"},
+					nil)
+			})
+
+			It("deletes the organization and returns the job without polling it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(job).To(Equal(Job{GUID: "some-job-guid", Status: ccv2.JobStatusQueued}))
+				Expect(warnings).To(ConsistOf("get-org-warning", "delete-org-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteOrganizationArgsForCall(0)).To(Equal("some-org-guid"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the organization does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+				Expect(fakeCloudControllerClient.DeleteOrganizationCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
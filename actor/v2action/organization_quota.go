@@ -5,18 +5,52 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 )
 
 type OrganizationQuota ccv2.OrganizationQuota
 
 type OrganizationQuotaNotFoundError struct {
 	GUID string
+	Name string
 }
 
 func (e OrganizationQuotaNotFoundError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("Organization quota '%s' not found.", e.Name)
+	}
 	return fmt.Sprintf("Organization quota with GUID '%s' not found.", e.GUID)
 }
 
+// OrganizationQuotaNameTakenError is returned when an organization quota with
+// the provided name already exists.
+type OrganizationQuotaNameTakenError struct {
+	Name string
+}
+
+func (e OrganizationQuotaNameTakenError) Error() string {
+	return fmt.Sprintf("Organization quota '%s' already exists.", e.Name)
+}
+
+// CreateQuota creates an organization quota with the provided limits. Unset
+// instance memory and app instance limits default to unlimited; the other
+// unset limits default to 0 and paid service plans are disallowed.
+func (actor Actor) CreateQuota(quota OrganizationQuota) (OrganizationQuota, Warnings, error) {
+	quota.NonBasicServicesAllowed = defaultNullBool(quota.NonBasicServicesAllowed, false)
+	quota.TotalServices = defaultNullInt(quota.TotalServices, 0)
+	quota.TotalRoutes = defaultNullInt(quota.TotalRoutes, 0)
+	quota.MemoryLimit = defaultNullInt(quota.MemoryLimit, 0)
+	quota.InstanceMemoryLimit = defaultNullInt(quota.InstanceMemoryLimit, -1)
+	quota.AppInstanceLimit = defaultNullInt(quota.AppInstanceLimit, -1)
+
+	createdQuota, warnings, err := actor.CloudControllerClient.CreateOrganizationQuota(ccv2.OrganizationQuota(quota))
+	if _, ok := err.(ccerror.QuotaNameTakenError); ok {
+		return OrganizationQuota{}, Warnings(warnings), OrganizationQuotaNameTakenError{Name: quota.Name}
+	}
+
+	return OrganizationQuota(createdQuota), Warnings(warnings), err
+}
+
 func (actor Actor) GetOrganizationQuota(guid string) (OrganizationQuota, Warnings, error) {
 	orgQuota, warnings, err := actor.CloudControllerClient.GetOrganizationQuota(guid)
 
@@ -26,3 +60,73 @@ func (actor Actor) GetOrganizationQuota(guid string) (OrganizationQuota, Warning
 
 	return OrganizationQuota(orgQuota), Warnings(warnings), err
 }
+
+// GetQuotaByName returns the organization quota with the provided name.
+func (actor Actor) GetQuotaByName(name string) (OrganizationQuota, Warnings, error) {
+	orgQuotas, warnings, err := actor.CloudControllerClient.GetOrganizationQuotas(ccv2.Query{
+		Filter:   ccv2.NameFilter,
+		Operator: ccv2.EqualOperator,
+		Values:   []string{name},
+	})
+	if err != nil {
+		return OrganizationQuota{}, Warnings(warnings), err
+	}
+
+	if len(orgQuotas) == 0 {
+		return OrganizationQuota{}, Warnings(warnings), OrganizationQuotaNotFoundError{Name: name}
+	}
+
+	return OrganizationQuota(orgQuotas[0]), Warnings(warnings), nil
+}
+
+// GetQuotas returns all the organization quotas.
+func (actor Actor) GetQuotas() ([]OrganizationQuota, Warnings, error) {
+	ccOrgQuotas, warnings, err := actor.CloudControllerClient.GetOrganizationQuotas()
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var orgQuotas []OrganizationQuota
+	for _, orgQuota := range ccOrgQuotas {
+		orgQuotas = append(orgQuotas, OrganizationQuota(orgQuota))
+	}
+
+	return orgQuotas, Warnings(warnings), nil
+}
+
+// UpdateQuota updates the organization quota with the provided name. Only the
+// set limits of the provided quota are changed, and the quota is renamed
+// when the provided quota has a name.
+func (actor Actor) UpdateQuota(name string, quota OrganizationQuota) (OrganizationQuota, Warnings, error) {
+	existingQuota, allWarnings, err := actor.GetQuotaByName(name)
+	if err != nil {
+		return OrganizationQuota{}, allWarnings, err
+	}
+
+	quota.GUID = existingQuota.GUID
+	updatedQuota, warnings, err := actor.CloudControllerClient.UpdateOrganizationQuota(ccv2.OrganizationQuota(quota))
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(ccerror.QuotaNameTakenError); ok {
+		return OrganizationQuota{}, allWarnings, OrganizationQuotaNameTakenError{Name: quota.Name}
+	}
+
+	return OrganizationQuota(updatedQuota), allWarnings, err
+}
+
+// defaultNullInt returns the provided value when it is set and defaultValue
+// otherwise.
+func defaultNullInt(value types.NullInt, defaultValue int) types.NullInt {
+	if value.IsSet {
+		return value
+	}
+	return types.NullInt{IsSet: true, Value: defaultValue}
+}
+
+// defaultNullBool returns the provided value when it is set and defaultValue
+// otherwise.
+func defaultNullBool(value types.NullBool, defaultValue bool) types.NullBool {
+	if value.IsSet {
+		return value
+	}
+	return types.NullBool{IsSet: true, Value: defaultValue}
+}
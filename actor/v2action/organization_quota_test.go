@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -75,4 +76,154 @@ var _ = Describe("OrganizationQuota Actions", func() {
 			})
 		})
 	})
+
+	Describe("CreateQuota", func() {
+		Context("when only some limits are provided", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateOrganizationQuotaReturns(
+					ccv2.OrganizationQuota{GUID: "some-org-quota-guid", Name: "some-org-quota"},
+					ccv2.Warnings{"create-warning"},
+					nil,
+				)
+			})
+
+			It("creates the quota with defaults for the unset limits", func() {
+				orgQuota, warnings, err := actor.CreateQuota(OrganizationQuota{
+					Name:                    "some-org-quota",
+					TotalRoutes:             types.NullInt{IsSet: true, Value: 10},
+					TotalReservedRoutePorts: types.NullInt{IsSet: true, Value: 2},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(orgQuota).To(Equal(OrganizationQuota{GUID: "some-org-quota-guid", Name: "some-org-quota"}))
+
+				Expect(fakeCloudControllerClient.CreateOrganizationQuotaCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CreateOrganizationQuotaArgsForCall(0)).To(Equal(ccv2.OrganizationQuota{
+					Name:                    "some-org-quota",
+					NonBasicServicesAllowed: types.NullBool{IsSet: true, Value: false},
+					TotalServices:           types.NullInt{IsSet: true, Value: 0},
+					TotalRoutes:             types.NullInt{IsSet: true, Value: 10},
+					TotalReservedRoutePorts: types.NullInt{IsSet: true, Value: 2},
+					MemoryLimit:             types.NullInt{IsSet: true, Value: 0},
+					InstanceMemoryLimit:     types.NullInt{IsSet: true, Value: -1},
+					AppInstanceLimit:        types.NullInt{IsSet: true, Value: -1},
+				}))
+			})
+		})
+
+		Context("when the quota name is taken", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateOrganizationQuotaReturns(ccv2.OrganizationQuota{}, ccv2.Warnings{"create-warning"}, ccerror.QuotaNameTakenError{})
+			})
+
+			It("returns an OrganizationQuotaNameTakenError and warnings", func() {
+				_, warnings, err := actor.CreateQuota(OrganizationQuota{Name: "some-org-quota"})
+				Expect(err).To(MatchError(OrganizationQuotaNameTakenError{Name: "some-org-quota"}))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+
+	Describe("GetQuotas", func() {
+		Context("when the cloud controller client succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(
+					[]ccv2.OrganizationQuota{{GUID: "quota-guid-1", Name: "quota-1"}, {GUID: "quota-guid-2", Name: "quota-2"}},
+					ccv2.Warnings{"get-warning"},
+					nil,
+				)
+			})
+
+			It("returns all the quotas and warnings", func() {
+				orgQuotas, warnings, err := actor.GetQuotas()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(orgQuotas).To(ConsistOf(
+					OrganizationQuota{GUID: "quota-guid-1", Name: "quota-1"},
+					OrganizationQuota{GUID: "quota-guid-2", Name: "quota-2"},
+				))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get quotas error")
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(nil, ccv2.Warnings{"get-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetQuotas()
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+
+	Describe("UpdateQuota", func() {
+		Context("when the quota exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(
+					[]ccv2.OrganizationQuota{{GUID: "some-org-quota-guid", Name: "some-org-quota"}},
+					ccv2.Warnings{"get-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateOrganizationQuotaReturns(
+					ccv2.OrganizationQuota{GUID: "some-org-quota-guid", Name: "new-name"},
+					ccv2.Warnings{"update-warning"},
+					nil,
+				)
+			})
+
+			It("updates only the provided limits", func() {
+				orgQuota, warnings, err := actor.UpdateQuota("some-org-quota", OrganizationQuota{
+					Name:             "new-name",
+					AppInstanceLimit: types.NullInt{IsSet: true, Value: -1},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+				Expect(orgQuota).To(Equal(OrganizationQuota{GUID: "some-org-quota-guid", Name: "new-name"}))
+
+				Expect(fakeCloudControllerClient.GetOrganizationQuotasCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetOrganizationQuotasArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.NameFilter,
+					Operator: ccv2.EqualOperator,
+					Values:   []string{"some-org-quota"},
+				}))
+
+				Expect(fakeCloudControllerClient.UpdateOrganizationQuotaCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateOrganizationQuotaArgsForCall(0)).To(Equal(ccv2.OrganizationQuota{
+					GUID:             "some-org-quota-guid",
+					Name:             "new-name",
+					AppInstanceLimit: types.NullInt{IsSet: true, Value: -1},
+				}))
+			})
+
+			Context("when the new name is taken", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateOrganizationQuotaReturns(ccv2.OrganizationQuota{}, ccv2.Warnings{"update-warning"}, ccerror.QuotaNameTakenError{})
+				})
+
+				It("returns an OrganizationQuotaNameTakenError", func() {
+					_, warnings, err := actor.UpdateQuota("some-org-quota", OrganizationQuota{Name: "new-name"})
+					Expect(err).To(MatchError(OrganizationQuotaNameTakenError{Name: "new-name"}))
+					Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+				})
+			})
+		})
+
+		Context("when the quota does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(nil, ccv2.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns an OrganizationQuotaNotFoundError", func() {
+				_, warnings, err := actor.UpdateQuota("some-org-quota", OrganizationQuota{})
+				Expect(err).To(MatchError(OrganizationQuotaNotFoundError{Name: "some-org-quota"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.UpdateOrganizationQuotaCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
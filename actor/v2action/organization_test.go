@@ -94,6 +94,45 @@ var _ = Describe("Org Actions", func() {
 		})
 	})
 
+	Describe("GetOrganizations", func() {
+		Context("when the cloud controller returns organizations", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{
+						{GUID: "org-guid-1", Name: "org-1"},
+						{GUID: "org-guid-2", Name: "org-2"},
+					},
+					ccv2.Warnings{"get-orgs-warning"},
+					nil)
+			})
+
+			It("returns the organizations and warnings", func() {
+				orgs, warnings, err := actor.GetOrganizations()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-orgs-warning"))
+				Expect(orgs).To(Equal([]Organization{
+					{GUID: "org-guid-1", Name: "org-1"},
+					{GUID: "org-guid-2", Name: "org-2"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(BeEmpty())
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"get-orgs-warning"}, errors.New("get-orgs-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetOrganizations()
+				Expect(err).To(MatchError("get-orgs-error"))
+				Expect(warnings).To(ConsistOf("get-orgs-warning"))
+			})
+		})
+	})
+
 	Describe("GetOrganizationByName", func() {
 		var (
 			org      Organization
@@ -129,6 +168,18 @@ var _ = Describe("Org Actions", func() {
 						Values:   []string{"some-org"},
 					}}))
 			})
+
+			It("caches the org for subsequent lookups of the same name", func() {
+				cachedOrg, cachedWarnings, cachedErr := actor.GetOrganizationByName("some-org")
+				Expect(cachedErr).ToNot(HaveOccurred())
+				Expect(cachedOrg).To(Equal(org))
+				Expect(cachedWarnings).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+
+				_, _, err = actor.GetOrganizationByName("some-other-org")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(2))
+			})
 		})
 
 		Context("when the org does not exist", func() {
@@ -184,6 +235,134 @@ var _ = Describe("Org Actions", func() {
 		})
 	})
 
+	Describe("CreateOrganization", func() {
+		var (
+			quotaName       string
+			managerUsername string
+
+			org        Organization
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			quotaName = ""
+			managerUsername = ""
+
+			fakeCloudControllerClient.CreateOrganizationReturns(
+				ccv2.Organization{GUID: "some-org-guid", Name: "some-org"},
+				ccv2.Warnings{"create-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			org, warnings, executeErr = actor.CreateOrganization("some-org", quotaName, managerUsername)
+		})
+
+		Context("when no quota or manager is provided", func() {
+			It("creates the org with the default quota", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(org).To(Equal(Organization{GUID: "some-org-guid", Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("create-warning"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationQuotasCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.CreateOrganizationCallCount()).To(Equal(1))
+				orgName, quotaGUID := fakeCloudControllerClient.CreateOrganizationArgsForCall(0)
+				Expect(orgName).To(Equal("some-org"))
+				Expect(quotaGUID).To(BeEmpty())
+				Expect(fakeCloudControllerClient.UpdateOrganizationManagerByUsernameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a quota is provided", func() {
+			BeforeEach(func() {
+				quotaName = "some-quota"
+			})
+
+			Context("when the quota exists", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationQuotasReturns(
+						[]ccv2.OrganizationQuota{{GUID: "some-quota-guid", Name: "some-quota"}},
+						ccv2.Warnings{"quota-warning"},
+						nil,
+					)
+				})
+
+				It("creates the org with the quota", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("quota-warning", "create-warning"))
+
+					_, quotaGUID := fakeCloudControllerClient.CreateOrganizationArgsForCall(0)
+					Expect(quotaGUID).To(Equal("some-quota-guid"))
+				})
+			})
+
+			Context("when the quota does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationQuotasReturns(nil, ccv2.Warnings{"quota-warning"}, nil)
+				})
+
+				It("returns an OrganizationQuotaNotFoundError without creating the org", func() {
+					Expect(executeErr).To(MatchError(OrganizationQuotaNotFoundError{Name: "some-quota"}))
+					Expect(warnings).To(ConsistOf("quota-warning"))
+					Expect(fakeCloudControllerClient.CreateOrganizationCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when a manager is provided", func() {
+			BeforeEach(func() {
+				managerUsername = "some-user"
+				fakeCloudControllerClient.UpdateOrganizationManagerByUsernameReturns(ccv2.Warnings{"manager-warning"}, nil)
+				fakeCloudControllerClient.UpdateOrganizationUserByUsernameReturns(ccv2.Warnings{"user-warning"}, nil)
+			})
+
+			It("makes the user an OrgManager of the org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning", "manager-warning", "user-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateOrganizationManagerByUsernameCallCount()).To(Equal(1))
+				orgGUID, username := fakeCloudControllerClient.UpdateOrganizationManagerByUsernameArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(username).To(Equal("some-user"))
+			})
+
+			Context("when assigning the manager fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("assign-error")
+					fakeCloudControllerClient.UpdateOrganizationManagerByUsernameReturns(ccv2.Warnings{"manager-warning"}, expectedErr)
+					fakeCloudControllerClient.DeleteOrganizationReturns(ccv2.Job{GUID: "some-job-guid"}, ccv2.Warnings{"delete-warning"}, nil)
+				})
+
+				It("deletes the org and returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("create-warning", "manager-warning", "delete-warning"))
+
+					Expect(fakeCloudControllerClient.DeleteOrganizationCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.DeleteOrganizationArgsForCall(0)).To(Equal("some-org-guid"))
+				})
+			})
+		})
+
+		Context("when the org name is taken", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateOrganizationReturns(
+					ccv2.Organization{},
+					ccv2.Warnings{"create-warning"},
+					ccerror.OrganizationNameTakenError{Message: "name taken"},
+				)
+			})
+
+			It("returns an OrganizationNameTakenError", func() {
+				Expect(executeErr).To(MatchError(OrganizationNameTakenError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+
 	Describe("DeleteOrganization", func() {
 		var (
 			warnings     Warnings
@@ -233,6 +412,12 @@ var _ = Describe("Org Actions", func() {
 				job := fakeCloudControllerClient.PollJobArgsForCall(0)
 				Expect(job.GUID).To(Equal("some-job-guid"))
 			})
+
+			It("no longer caches the org", func() {
+				_, _, err := actor.GetOrganizationByName("some-org")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(2))
+			})
 		})
 
 		Context("when getting the org returns an error", func() {
@@ -56,7 +56,7 @@ type Resource ccv2.Resource
 func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error) {
 	var resources []Resource
 
-	archive, err := os.Open(archivePath)
+	archive, err := os.Open(extendedLengthPath(archivePath))
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +75,7 @@ func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error
 
 	for _, archivedFile := range reader.File {
 		filename := filepath.ToSlash(archivedFile.Name)
-		if gitIgnore.MatchesPath(filename) {
+		if gitIgnore.MatchesPath(normalizeIgnoreCase(filename)) {
 			continue
 		}
 
@@ -118,17 +118,13 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 		return nil, err
 	}
 
-	walkErr := filepath.Walk(sourceDir, func(path string, info os.FileInfo, err error) error {
+	walkRoot := extendedLengthPath(sourceDir)
+	walkErr := filepath.Walk(walkRoot, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
-		// if file ignored contine to the next file
-		if gitIgnore.MatchesPath(path) {
-			return nil
-		}
-
-		relPath, err := filepath.Rel(sourceDir, path)
+		relPath, err := filepath.Rel(walkRoot, path)
 		if err != nil {
 			return err
 		}
@@ -137,6 +133,11 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 			return nil
 		}
 
+		// if file ignored contine to the next file
+		if gitIgnore.MatchesPath(normalizeIgnoreCase(relPath)) {
+			return nil
+		}
+
 		resource := Resource{
 			Filename: filepath.ToSlash(relPath),
 		}
@@ -174,10 +175,12 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 // ResourceMatch returns a set of matched resources and unmatched resources in
 // the order they were given in allResources.
 func (actor Actor) ResourceMatch(allResources []Resource) ([]Resource, []Resource, Warnings, error) {
+	minFileSize := actor.Config.ResourceMatchMinFileSize()
+
 	resourcesToSend := [][]ccv2.Resource{{}}
 	var currentList, sendCount int
 	for _, resource := range allResources {
-		if resource.Size == 0 {
+		if resource.Size == 0 || resource.Size < minFileSize {
 			continue
 		}
 
@@ -247,7 +250,7 @@ func (actor Actor) ZipArchiveResources(sourceArchivePath string, filesToInclude
 	writer := zip.NewWriter(zipFile)
 	defer writer.Close()
 
-	source, err := os.Open(sourceArchivePath)
+	source, err := os.Open(extendedLengthPath(sourceArchivePath))
 	if err != nil {
 		return "", err
 	}
@@ -303,6 +306,7 @@ func (actor Actor) ZipDirectoryResources(sourceDir string, filesToInclude []Reso
 	writer := zip.NewWriter(zipFile)
 	defer writer.Close()
 
+	sourceDir = extendedLengthPath(sourceDir)
 	for _, resource := range filesToInclude {
 		fullPath := filepath.Join(sourceDir, resource.Filename)
 		log.WithField("fullPath", fullPath).Debug("zipping file")
@@ -402,7 +406,7 @@ func (Actor) addFileToZipFromFileSystem(
 
 func (Actor) generateArchiveCFIgnoreMatcher(files []*zip.File) (*ignore.GitIgnore, error) {
 	for _, item := range files {
-		if strings.HasSuffix(item.Name, ".cfignore") {
+		if strings.HasSuffix(normalizeIgnoreCase(item.Name), ".cfignore") {
 			fileReader, err := item.Open()
 			if err != nil {
 				return nil, err
@@ -414,10 +418,10 @@ func (Actor) generateArchiveCFIgnoreMatcher(files []*zip.File) (*ignore.GitIgnor
 				return nil, err
 			}
 			s := append(DefaultIgnoreLines, strings.Split(string(raw), "\n")...)
-			return ignore.CompileIgnoreLines(s...)
+			return compileCFIgnoreLines(s)
 		}
 	}
-	return ignore.CompileIgnoreLines(DefaultIgnoreLines...)
+	return compileCFIgnoreLines(DefaultIgnoreLines)
 }
 
 func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string) (*ignore.GitIgnore, error) {
@@ -433,11 +437,22 @@ func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string) (*ignore.G
 		}
 	}
 
-	if _, err := os.Stat(pathToCFIgnore); !os.IsNotExist(err) {
-		return ignore.CompileIgnoreFileAndLines(pathToCFIgnore, additionalIgnoreLines...)
-	} else {
-		return ignore.CompileIgnoreLines(additionalIgnoreLines...)
+	raw, err := ioutil.ReadFile(extendedLengthPath(pathToCFIgnore))
+	if os.IsNotExist(err) {
+		return compileCFIgnoreLines(additionalIgnoreLines)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return compileCFIgnoreLines(append(strings.Split(string(raw), "\n"), additionalIgnoreLines...))
+}
+
+func compileCFIgnoreLines(lines []string) (*ignore.GitIgnore, error) {
+	normalizedLines := make([]string, 0, len(lines))
+	for _, line := range lines {
+		normalizedLines = append(normalizedLines, normalizeIgnoreCase(line))
 	}
+	return ignore.CompileIgnoreLines(normalizedLines...)
 }
 
 func (Actor) findInResources(path string, filesToInclude []Resource) (Resource, bool) {
@@ -19,12 +19,14 @@ var _ = Describe("Resource Actions", func() {
 	var (
 		actor                     *Actor
 		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
 		srcDir                    string
 	)
 
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
-		actor = NewActor(fakeCloudControllerClient, nil, nil)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
 
 		var err error
 		srcDir, err = ioutil.TempDir("", "resource-actions-test")
@@ -112,6 +114,26 @@ var _ = Describe("Resource Actions", func() {
 				))
 			})
 
+			Context("when a minimum file size is configured", func() {
+				BeforeEach(func() {
+					fakeConfig.ResourceMatchMinFileSizeReturns(14)
+				})
+
+				It("only sends files at or above the minimum size", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.ResourceMatchCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.ResourceMatchArgsForCall(0)).To(ConsistOf(
+						ccv2.Resource{Filename: "file-4", Mode: 0744, Size: 14, SHA1: "some-sha-4"},
+						ccv2.Resource{Filename: "file-5", Mode: 0744, Size: 15, SHA1: "some-sha-5"},
+					))
+				})
+
+				It("returns the unsent files in unmatchedResources", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(unmatchedResources).To(Equal(allResources))
+				})
+			})
+
 			Context("when none of the files are matched", func() {
 				It("returns all files [in order] in unmatchedResources", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
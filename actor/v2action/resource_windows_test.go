@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
@@ -235,6 +236,73 @@ var _ = Describe("Resource Actions", func() {
 			})
 		})
 
+		Context("when the .cfignore patterns differ in case from the files", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(filepath.Join(srcDir, ".cfignore"), []byte("LEVEL2\nTmpFile3"), 0666)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("excludes the files regardless of case", func() {
+				gatheredResources, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(gatheredResources).To(Equal(
+					[]Resource{
+						{Filename: "level1", Mode: DefaultFolderPermissions},
+						{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: 0766},
+					}))
+			})
+		})
+
+		Context("when the source directory is a UNC path", func() {
+			It("gathers the files through the share", func() {
+				volume := filepath.VolumeName(srcDir)
+				uncDir := `\\localhost\` + strings.TrimSuffix(volume, ":") + `$` + srcDir[len(volume):]
+
+				gatheredResources, err := actor.GatherDirectoryResources(uncDir)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(gatheredResources).To(Equal(
+					[]Resource{
+						{Filename: "level1", Mode: DefaultFolderPermissions},
+						{Filename: "level1/level2", Mode: DefaultFolderPermissions},
+						{Filename: "level1/level2/tmpFile1", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Size: 9, Mode: 0766},
+						{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: 0766},
+						{Filename: "tmpFile3", SHA1: "f4c9ca85f3e084ffad3abbdabbd2a890c034c879", Size: 10, Mode: 0766},
+					}))
+			})
+		})
+
+		Context("when files are nested deeper than MAX_PATH", func() {
+			var deepRelPath string
+
+			BeforeEach(func() {
+				deepRelPath = filepath.Join("level1", strings.Repeat("node_modules_", 10), strings.Repeat("nested-dependency", 10))
+				Expect(len(filepath.Join(srcDir, deepRelPath, "deepFile"))).To(BeNumerically(">", 260))
+
+				err := os.MkdirAll(`\\?\`+filepath.Join(srcDir, deepRelPath), 0777)
+				Expect(err).ToNot(HaveOccurred())
+				err = ioutil.WriteFile(`\\?\`+filepath.Join(srcDir, deepRelPath, "deepFile"), []byte("why hello"), 0666)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(`\\?\` + filepath.Join(srcDir, "level1"))).To(Succeed())
+			})
+
+			It("gathers and zips the nested files", func() {
+				gatheredResources, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+
+				deepResource := Resource{Filename: filepath.ToSlash(filepath.Join(deepRelPath, "deepFile")), SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Size: 9, Mode: 0766}
+				Expect(gatheredResources).To(ContainElement(deepResource))
+
+				zipPath, err := actor.ZipDirectoryResources(srcDir, []Resource{deepResource})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(os.RemoveAll(zipPath)).To(Succeed())
+			})
+		})
+
 		Context("when the directory is empty", func() {
 			var emptyDir string
 
@@ -3,6 +3,7 @@ package v2action
 import (
 	"fmt"
 	"path"
+	"strconv"
 	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -11,6 +12,16 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// maxRouteGUIDsPerQuery is the number of route GUIDs sent in a single route
+// mappings query, keeping the request URI within server limits.
+const maxRouteGUIDsPerQuery = 50
+
+// RouteWithApplications is a route along with the applications bound to it.
+type RouteWithApplications struct {
+	Route
+	Applications []Application
+}
+
 // OrphanedRoutesNotFoundError is an error wrapper that represents the case
 // when no orphaned routes are found.
 type OrphanedRoutesNotFoundError struct{}
@@ -34,12 +45,44 @@ func (RouteInDifferentSpaceError) Error() string {
 type RouteNotFoundError struct {
 	Host       string
 	DomainGUID string
+	Port       int
 }
 
 func (e RouteNotFoundError) Error() string {
+	if e.Port != 0 {
+		return fmt.Sprintf("Route with port %d and domain guid %s not found", e.Port, e.DomainGUID)
+	}
 	return fmt.Sprintf("Route with host %s and domain guid %s not found", e.Host, e.DomainGUID)
 }
 
+// InvalidHTTPRouteSettings is returned when a host or path is provided for a
+// route on a TCP domain.
+type InvalidHTTPRouteSettings struct {
+	Domain string
+}
+
+func (e InvalidHTTPRouteSettings) Error() string {
+	return fmt.Sprintf("Host and path not allowed in route with TCP domain %s", e.Domain)
+}
+
+// InvalidTCPRouteSettings is returned when a port or random port is provided
+// for a route on an HTTP domain.
+type InvalidTCPRouteSettings struct {
+	Domain string
+}
+
+func (e InvalidTCPRouteSettings) Error() string {
+	return fmt.Sprintf("Port not allowed in HTTP domain %s", e.Domain)
+}
+
+// TCPRouteOptionsNotProvidedError is returned when a route on a TCP domain is
+// given neither a port nor a request for a random port.
+type TCPRouteOptionsNotProvidedError struct{}
+
+func (TCPRouteOptionsNotProvidedError) Error() string {
+	return "Port or random port must be provided for TCP routes"
+}
+
 // RouteAlreadyExistsError is returned when a route already exists
 type RouteAlreadyExistsError struct {
 	Route Route
@@ -119,20 +162,28 @@ func (actor Actor) CreateRouteWithExistenceCheck(orgGUID string, spaceName strin
 		} else if len(domains) == 0 {
 			return Route{}, warnings, DomainNotFoundError{Name: route.Domain.Name}
 		}
-		route.Domain.GUID = domains[0].GUID
+		route.Domain = domains[0]
 	}
 
-	foundRoute, spaceRouteWarnings, findErr := actor.FindRouteBoundToSpaceWithSettings(route)
-	warnings = append(warnings, spaceRouteWarnings...)
-	routeAlreadyExists := true
-	if _, ok := findErr.(RouteNotFoundError); ok {
-		routeAlreadyExists = false
-	} else if findErr != nil {
-		return Route{}, Warnings(warnings), findErr
+	err := validateRouteForDomain(route, generatePort)
+	if err != nil {
+		return Route{}, Warnings(warnings), err
 	}
 
-	if routeAlreadyExists {
-		return Route{}, Warnings(warnings), RouteAlreadyExistsError{Route: foundRoute}
+	// A randomly generated port cannot collide with an existing route.
+	if !generatePort {
+		foundRoute, spaceRouteWarnings, findErr := actor.FindRouteBoundToSpaceWithSettings(route)
+		warnings = append(warnings, spaceRouteWarnings...)
+		routeAlreadyExists := true
+		if _, ok := findErr.(RouteNotFoundError); ok {
+			routeAlreadyExists = false
+		} else if findErr != nil {
+			return Route{}, Warnings(warnings), findErr
+		}
+
+		if routeAlreadyExists {
+			return Route{}, Warnings(warnings), RouteAlreadyExistsError{Route: foundRoute}
+		}
 	}
 
 	createdRoute, createRouteWarnings, createErr := actor.CreateRoute(route, generatePort)
@@ -207,6 +258,88 @@ func (actor Actor) GetSpaceRoutes(spaceGUID string) ([]Route, Warnings, error) {
 	return routes, append(allWarnings, domainWarnings...), err
 }
 
+// GetSpaceRoutesPaged calls handlePage with each page of routes associated
+// with the provided Space GUID as it is retrieved. Returning an error from
+// handlePage stops the pagination and returns that error.
+func (actor Actor) GetSpaceRoutesPaged(spaceGUID string, handlePage func([]Route) error) (Warnings, error) {
+	var domainWarnings Warnings
+	warnings, err := actor.CloudControllerClient.GetSpaceRoutesPaged(spaceGUID, func(ccv2Routes []ccv2.Route) error {
+		routes, warnings, err := actor.applyDomain(ccv2Routes)
+		domainWarnings = append(domainWarnings, warnings...)
+		if err != nil {
+			return err
+		}
+		return handlePage(routes)
+	})
+
+	return append(Warnings(warnings), domainWarnings...), err
+}
+
+// GetRoutesWithApplications returns the routes associated with the provided
+// Space GUID along with the applications bound to each of them. Bound
+// applications are resolved with bulk route mapping and application queries
+// rather than one request per route.
+func (actor Actor) GetRoutesWithApplications(spaceGUID string) ([]RouteWithApplications, Warnings, error) {
+	routes, allWarnings, err := actor.GetSpaceRoutes(spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	appGUIDsByRouteGUID := map[string][]string{}
+	for start := 0; start < len(routes); start += maxRouteGUIDsPerQuery {
+		end := start + maxRouteGUIDsPerQuery
+		if end > len(routes) {
+			end = len(routes)
+		}
+
+		routeGUIDs := make([]string, 0, end-start)
+		for _, route := range routes[start:end] {
+			routeGUIDs = append(routeGUIDs, route.GUID)
+		}
+
+		routeMappings, warnings, err := actor.CloudControllerClient.GetRouteMappings(ccv2.Query{
+			Filter:   ccv2.RouteGUIDFilter,
+			Operator: ccv2.InOperator,
+			Values:   routeGUIDs,
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, routeMapping := range routeMappings {
+			appGUIDsByRouteGUID[routeMapping.RouteGUID] = append(appGUIDsByRouteGUID[routeMapping.RouteGUID], routeMapping.AppGUID)
+		}
+	}
+
+	// Applications can only be bound to routes in their own space.
+	appsByGUID := map[string]Application{}
+	if len(appGUIDsByRouteGUID) > 0 {
+		apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, app := range apps {
+			appsByGUID[app.GUID] = app
+		}
+	}
+
+	routesWithApps := make([]RouteWithApplications, 0, len(routes))
+	for _, route := range routes {
+		routeWithApps := RouteWithApplications{Route: route}
+		for _, appGUID := range appGUIDsByRouteGUID[route.GUID] {
+			if app, found := appsByGUID[appGUID]; found {
+				routeWithApps.Applications = append(routeWithApps.Applications, app)
+			}
+		}
+		routesWithApps = append(routesWithApps, routeWithApps)
+	}
+
+	return routesWithApps, allWarnings, nil
+}
+
 // DeleteRoute deletes the Route associated with the provided Route GUID.
 func (actor Actor) DeleteRoute(routeGUID string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.DeleteRoute(routeGUID)
@@ -223,8 +356,17 @@ func (actor Actor) CheckRoute(route Route) (bool, Warnings, error) {
 // exists anywhere in the system. When the route exists in another space,
 // RouteInDifferentSpaceError is returned.
 func (actor Actor) FindRouteBoundToSpaceWithSettings(route Route) (Route, Warnings, error) {
-	// TODO: Use a more generic search mechanism to support path, port, and no host
-	existingRoute, warnings, err := actor.GetRouteByHostAndDomain(route.Host, route.Domain.GUID)
+	// TODO: Use a more generic search mechanism to support path and no host
+	var (
+		existingRoute Route
+		warnings      Warnings
+		err           error
+	)
+	if route.Port.IsSet {
+		existingRoute, warnings, err = actor.GetRouteByDomainAndPort(route.Domain.GUID, route.Port.Value)
+	} else {
+		existingRoute, warnings, err = actor.GetRouteByHostAndDomain(route.Host, route.Domain.GUID)
+	}
 	if routeNotFoundErr, ok := err.(RouteNotFoundError); ok {
 		// This check only works for API versions 2.55 or higher. It will return
 		// false for anything below that.
@@ -293,6 +435,37 @@ func (actor Actor) GetRouteByHostAndDomain(host string, domainGUID string) (Rout
 	return routes[0], append(Warnings(warnings), domainWarnings...), err
 }
 
+// GetRouteByDomainAndPort returns the TCP route with the matching port and
+// the associated domain GUID.
+func (actor Actor) GetRouteByDomainAndPort(domainGUID string, port int) (Route, Warnings, error) {
+	ccv2Routes, warnings, err := actor.CloudControllerClient.GetRoutes(
+		ccv2.Query{
+			Filter:   ccv2.DomainGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{domainGUID},
+		},
+		ccv2.Query{
+			Filter:   ccv2.PortFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{strconv.Itoa(port)},
+		},
+	)
+	if err != nil {
+		return Route{}, Warnings(warnings), err
+	}
+
+	if len(ccv2Routes) == 0 {
+		return Route{}, Warnings(warnings), RouteNotFoundError{DomainGUID: domainGUID, Port: port}
+	}
+
+	routes, domainWarnings, err := actor.applyDomain(ccv2Routes)
+	if err != nil {
+		return Route{}, append(Warnings(warnings), domainWarnings...), err
+	}
+
+	return routes[0], append(Warnings(warnings), domainWarnings...), err
+}
+
 func ActorToCCRoute(route Route) ccv2.Route {
 	return ccv2.Route{
 		DomainGUID: route.Domain.GUID,
@@ -319,14 +492,43 @@ func (actor Actor) applyDomain(ccv2Routes []ccv2.Route) (Routes, Warnings, error
 	var routes Routes
 	var allWarnings Warnings
 
+	// Routes commonly share a handful of domains; only look each one up once.
+	domains := map[string]Domain{}
 	for _, ccv2Route := range ccv2Routes {
-		domain, warnings, err := actor.GetDomain(ccv2Route.DomainGUID)
-		allWarnings = append(allWarnings, warnings...)
-		if err != nil {
-			return nil, allWarnings, err
+		domain, found := domains[ccv2Route.DomainGUID]
+		if !found {
+			var (
+				warnings Warnings
+				err      error
+			)
+			domain, warnings, err = actor.GetDomain(ccv2Route.DomainGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			domains[ccv2Route.DomainGUID] = domain
 		}
 		routes = append(routes, CCToActorRoute(ccv2Route, domain))
 	}
 
 	return routes, allWarnings, nil
 }
+
+// validateRouteForDomain checks that the route only uses settings supported
+// by its domain's router group type.
+func validateRouteForDomain(route Route, generatePort bool) error {
+	if route.Domain.IsTCP() {
+		if route.Host != "" || route.Path != "" {
+			return InvalidHTTPRouteSettings{Domain: route.Domain.Name}
+		}
+		if !route.Port.IsSet && !generatePort {
+			return TCPRouteOptionsNotProvidedError{}
+		}
+		return nil
+	}
+
+	if route.Port.IsSet || generatePort {
+		return InvalidTCPRouteSettings{Domain: route.Domain.Name}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// RouteSummary is a route along with the name of its space, the names of the
+// applications bound to it and the name of the service instance bound to it.
+type RouteSummary struct {
+	Route
+	SpaceName           string
+	ApplicationNames    []string
+	ServiceInstanceName string
+}
+
+// GetSpaceRouteSummariesPaged calls handlePage with a summary of each page of
+// routes in the space as the page is retrieved. Returning an error from
+// handlePage stops the pagination and returns that error.
+func (actor Actor) GetSpaceRouteSummariesPaged(spaceGUID string, spaceName string, handlePage func([]RouteSummary) error) (Warnings, error) {
+	summarizer := actor.newRouteSummarizer(map[string]string{spaceGUID: spaceName})
+
+	warnings, err := actor.CloudControllerClient.GetSpaceRoutesPaged(spaceGUID, func(ccv2Routes []ccv2.Route) error {
+		return summarizer.handlePage(ccv2Routes, handlePage)
+	})
+
+	return append(Warnings(warnings), summarizer.warnings...), err
+}
+
+// GetOrganizationRouteSummariesPaged calls handlePage with a summary of each
+// page of routes in every space of the organization as the page is
+// retrieved. Returning an error from handlePage stops the pagination and
+// returns that error.
+func (actor Actor) GetOrganizationRouteSummariesPaged(orgGUID string, handlePage func([]RouteSummary) error) (Warnings, error) {
+	spaces, allWarnings, err := actor.GetOrganizationSpaces(orgGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	spaceNames := map[string]string{}
+	for _, space := range spaces {
+		spaceNames[space.GUID] = space.Name
+	}
+	summarizer := actor.newRouteSummarizer(spaceNames)
+
+	warnings, err := actor.CloudControllerClient.GetRoutesPaged(func(ccv2Routes []ccv2.Route) error {
+		return summarizer.handlePage(ccv2Routes, handlePage)
+	}, ccv2.Query{
+		Filter:   ccv2.OrganizationGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Values:   []string{orgGUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+
+	return append(allWarnings, summarizer.warnings...), err
+}
+
+// routeSummarizer builds route summaries a page at a time, looking up each
+// service instance only once.
+type routeSummarizer struct {
+	actor                Actor
+	spaceNames           map[string]string
+	serviceInstanceNames map[string]string
+	warnings             Warnings
+}
+
+func (actor Actor) newRouteSummarizer(spaceNames map[string]string) *routeSummarizer {
+	return &routeSummarizer{
+		actor:                actor,
+		spaceNames:           spaceNames,
+		serviceInstanceNames: map[string]string{},
+	}
+}
+
+func (summarizer *routeSummarizer) handlePage(ccv2Routes []ccv2.Route, handlePage func([]RouteSummary) error) error {
+	routes, warnings, err := summarizer.actor.applyDomain(ccv2Routes)
+	summarizer.warnings = append(summarizer.warnings, warnings...)
+	if err != nil {
+		return err
+	}
+
+	summaries := make([]RouteSummary, 0, len(routes))
+	for i, route := range routes {
+		summary, err := summarizer.summarize(route, ccv2Routes[i].ServiceInstanceGUID)
+		if err != nil {
+			return err
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return handlePage(summaries)
+}
+
+func (summarizer *routeSummarizer) summarize(route Route, serviceInstanceGUID string) (RouteSummary, error) {
+	summary := RouteSummary{
+		Route:     route,
+		SpaceName: summarizer.spaceNames[route.SpaceGUID],
+	}
+
+	apps, warnings, err := summarizer.actor.GetRouteApplications(route.GUID)
+	summarizer.warnings = append(summarizer.warnings, warnings...)
+	if err != nil {
+		return RouteSummary{}, err
+	}
+	for _, app := range apps {
+		summary.ApplicationNames = append(summary.ApplicationNames, app.Name)
+	}
+
+	if serviceInstanceGUID != "" {
+		name, found := summarizer.serviceInstanceNames[serviceInstanceGUID]
+		if !found {
+			serviceInstance, warnings, err := summarizer.actor.GetServiceInstance(serviceInstanceGUID)
+			summarizer.warnings = append(summarizer.warnings, warnings...)
+			if err != nil {
+				return RouteSummary{}, err
+			}
+			name = serviceInstance.Name
+			summarizer.serviceInstanceNames[serviceInstanceGUID] = name
+		}
+		summary.ServiceInstanceName = name
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,152 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Summary Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+
+		pages      [][]RouteSummary
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+		pages = nil
+
+		fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{GUID: "domain-guid", Name: "example.com"}, nil, nil)
+		fakeCloudControllerClient.GetRouteApplicationsStub = func(routeGUID string, _ ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+			if routeGUID == "route-guid-1" {
+				return []ccv2.Application{{Name: "app-1"}, {Name: "app-2"}}, ccv2.Warnings{"get-route-apps-warning"}, nil
+			}
+			return nil, nil, nil
+		}
+		fakeCloudControllerClient.GetServiceInstanceReturns(ccv2.ServiceInstance{Name: "some-service-instance"}, ccv2.Warnings{"get-service-instance-warning"}, nil)
+	})
+
+	handlePage := func(page []RouteSummary) error {
+		pages = append(pages, page)
+		return nil
+	}
+
+	Describe("GetSpaceRouteSummariesPaged", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceRoutesPagedStub = func(_ string, handlePage func([]ccv2.Route) error, _ ...ccv2.Query) (ccv2.Warnings, error) {
+				for _, page := range [][]ccv2.Route{
+					{{GUID: "route-guid-1", Host: "host-1", DomainGUID: "domain-guid", SpaceGUID: "some-space-guid", ServiceInstanceGUID: "service-instance-guid"}},
+					{{GUID: "route-guid-2", Host: "host-2", DomainGUID: "domain-guid", SpaceGUID: "some-space-guid", ServiceInstanceGUID: "service-instance-guid"}},
+				} {
+					if err := handlePage(page); err != nil {
+						return ccv2.Warnings{"get-routes-warning"}, err
+					}
+				}
+				return ccv2.Warnings{"get-routes-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.GetSpaceRouteSummariesPaged("some-space-guid", "some-space", handlePage)
+		})
+
+		It("calls handlePage with a summary of each page of routes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-routes-warning", "get-route-apps-warning", "get-service-instance-warning"))
+
+			Expect(pages).To(HaveLen(2))
+			Expect(pages[0]).To(HaveLen(1))
+			Expect(pages[0][0].Host).To(Equal("host-1"))
+			Expect(pages[0][0].Domain.Name).To(Equal("example.com"))
+			Expect(pages[0][0].SpaceName).To(Equal("some-space"))
+			Expect(pages[0][0].ApplicationNames).To(Equal([]string{"app-1", "app-2"}))
+			Expect(pages[0][0].ServiceInstanceName).To(Equal("some-service-instance"))
+			Expect(pages[1][0].Host).To(Equal("host-2"))
+			Expect(pages[1][0].ApplicationNames).To(BeEmpty())
+			Expect(pages[1][0].ServiceInstanceName).To(Equal("some-service-instance"))
+
+			Expect(fakeCloudControllerClient.GetSpaceRoutesPagedCallCount()).To(Equal(1))
+			spaceGUID, _, _ := fakeCloudControllerClient.GetSpaceRoutesPagedArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+
+		It("looks up each service instance once", func() {
+			Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceInstanceArgsForCall(0)).To(Equal("service-instance-guid"))
+		})
+
+		Context("when getting the route applications fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouteApplicationsStub = nil
+				fakeCloudControllerClient.GetRouteApplicationsReturns(nil, ccv2.Warnings{"get-route-apps-warning"}, errors.New("route-apps-error"))
+			})
+
+			It("stops and returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("route-apps-error"))
+				Expect(warnings).To(ConsistOf("get-routes-warning", "get-route-apps-warning"))
+				Expect(pages).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("GetOrganizationRouteSummariesPaged", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{{GUID: "space-guid-1", Name: "space-1"}, {GUID: "space-guid-2", Name: "space-2"}},
+				ccv2.Warnings{"get-spaces-warning"},
+				nil)
+			fakeCloudControllerClient.GetRoutesPagedStub = func(handlePage func([]ccv2.Route) error, _ ...ccv2.Query) (ccv2.Warnings, error) {
+				err := handlePage([]ccv2.Route{
+					{GUID: "route-guid-1", Host: "host-1", DomainGUID: "domain-guid", SpaceGUID: "space-guid-1"},
+					{GUID: "route-guid-2", Host: "host-2", DomainGUID: "domain-guid", SpaceGUID: "space-guid-2"},
+				})
+				return ccv2.Warnings{"get-routes-warning"}, err
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.GetOrganizationRouteSummariesPaged("some-org-guid", handlePage)
+		})
+
+		It("calls handlePage with a summary of the routes in every space of the organization", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-spaces-warning", "get-routes-warning", "get-route-apps-warning"))
+
+			Expect(pages).To(HaveLen(1))
+			Expect(pages[0]).To(HaveLen(2))
+			Expect(pages[0][0].SpaceName).To(Equal("space-1"))
+			Expect(pages[0][1].SpaceName).To(Equal("space-2"))
+			Expect(pages[0][1].ServiceInstanceName).To(BeEmpty())
+			Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(0))
+
+			Expect(fakeCloudControllerClient.GetRoutesPagedCallCount()).To(Equal(1))
+			_, queries := fakeCloudControllerClient.GetRoutesPagedArgsForCall(0)
+			Expect(queries).To(ConsistOf(ccv2.Query{
+				Filter:   ccv2.OrganizationGUIDFilter,
+				Operator: ccv2.EqualOperator,
+				Values:   []string{"some-org-guid"},
+			}))
+		})
+
+		Context("when getting the spaces fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"get-spaces-warning"}, errors.New("spaces-error"))
+			})
+
+			It("returns the error and warnings without listing routes", func() {
+				Expect(executeErr).To(MatchError("spaces-error"))
+				Expect(warnings).To(ConsistOf("get-spaces-warning"))
+				Expect(fakeCloudControllerClient.GetRoutesPagedCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"fmt"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
@@ -238,9 +239,11 @@ var _ = Describe("Route Actions", func() {
 			createdRoute        Route
 			createRouteWarnings Warnings
 			createRouteErr      error
+			generatePort        bool
 		)
 
 		BeforeEach(func() {
+			generatePort = false
 			fakeCloudControllerClient.GetSpacesReturns(
 				[]ccv2.Space{
 					{
@@ -258,7 +261,6 @@ var _ = Describe("Route Actions", func() {
 					GUID:       "some-route-guid",
 					Host:       "some-host",
 					Path:       "some-path",
-					Port:       types.NullInt{IsSet: true, Value: 3333},
 					DomainGUID: "some-domain-guid",
 					SpaceGUID:  "some-space-guid",
 				},
@@ -272,7 +274,6 @@ var _ = Describe("Route Actions", func() {
 				},
 				Host: "some-host",
 				Path: "some-path",
-				Port: types.NullInt{IsSet: true, Value: 3333},
 			}
 		})
 
@@ -281,7 +282,7 @@ var _ = Describe("Route Actions", func() {
 				"some-org-guid",
 				"some-space",
 				route,
-				true)
+				generatePort)
 		})
 
 		Context("when route does not exist", func() {
@@ -305,7 +306,6 @@ var _ = Describe("Route Actions", func() {
 					GUID:      "some-route-guid",
 					Host:      "some-host",
 					Path:      "some-path",
-					Port:      types.NullInt{IsSet: true, Value: 3333},
 					SpaceGUID: "some-space-guid",
 				}))
 
@@ -323,15 +323,14 @@ var _ = Describe("Route Actions", func() {
 					}))
 
 				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(1))
-				passedRoute, generatePort := fakeCloudControllerClient.CreateRouteArgsForCall(0)
+				passedRoute, passedGeneratePort := fakeCloudControllerClient.CreateRouteArgsForCall(0)
 				Expect(passedRoute).To(Equal(ccv2.Route{
 					DomainGUID: "some-domain-guid",
 					Host:       "some-host",
 					Path:       "/some-path",
-					Port:       types.NullInt{IsSet: true, Value: 3333},
 					SpaceGUID:  "some-space-guid",
 				}))
-				Expect(generatePort).To(BeTrue())
+				Expect(passedGeneratePort).To(BeFalse())
 			})
 
 			Context("when creating route errors", func() {
@@ -360,7 +359,6 @@ var _ = Describe("Route Actions", func() {
 					DomainGUID: "some-domain-guid",
 					Host:       "some-host",
 					Path:       "some-path",
-					Port:       types.NullInt{IsSet: true, Value: 3333},
 					SpaceGUID:  "some-space-guid",
 				}
 				fakeCloudControllerClient.GetRoutesReturns(
@@ -389,8 +387,7 @@ var _ = Describe("Route Actions", func() {
 					},
 					Host: "some-host",
 					Path: "some-path",
-					Port: types.NullInt{IsSet: true, Value: 3333},
-				}
+					}
 			})
 
 			Context("when the domain exists", func() {
@@ -424,8 +421,7 @@ var _ = Describe("Route Actions", func() {
 						GUID:      "some-route-guid",
 						Host:      "some-host",
 						Path:      "some-path",
-						Port:      types.NullInt{IsSet: true, Value: 3333},
-						SpaceGUID: "some-space-guid",
+							SpaceGUID: "some-space-guid",
 					}))
 					Expect(fakeCloudControllerClient.GetSharedDomainsCallCount()).To(Equal(1))
 					Expect(fakeCloudControllerClient.GetOrganizationPrivateDomainsCallCount()).To(Equal(1))
@@ -495,6 +491,138 @@ var _ = Describe("Route Actions", func() {
 				Expect(createRouteWarnings).To(ConsistOf("get-space-warning", "get-routes-warning"))
 			})
 		})
+
+		Context("when the domain is an HTTP domain", func() {
+			Context("when a port is provided", func() {
+				BeforeEach(func() {
+					route = Route{
+						Domain: Domain{Name: "some-domain", GUID: "some-domain-guid"},
+						Port:   types.NullInt{IsSet: true, Value: 3333},
+					}
+				})
+
+				It("returns an InvalidTCPRouteSettings error without creating the route", func() {
+					Expect(createRouteErr).To(MatchError(InvalidTCPRouteSettings{Domain: "some-domain"}))
+					Expect(createRouteWarnings).To(ConsistOf("get-space-warning"))
+					Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when a random port is requested", func() {
+				BeforeEach(func() {
+					generatePort = true
+				})
+
+				It("returns an InvalidTCPRouteSettings error", func() {
+					Expect(createRouteErr).To(MatchError(InvalidTCPRouteSettings{Domain: "some-domain"}))
+				})
+			})
+		})
+
+		Context("when the domain is a TCP domain", func() {
+			var tcpDomain Domain
+
+			BeforeEach(func() {
+				tcpDomain = Domain{
+					Name:            "some-tcp-domain",
+					GUID:            "some-tcp-domain-guid",
+					RouterGroupGUID: "some-router-group-guid",
+					RouterGroupType: "tcp",
+				}
+			})
+
+			Context("when a host or path is provided", func() {
+				BeforeEach(func() {
+					route = Route{
+						Domain: tcpDomain,
+						Host:   "some-host",
+						Port:   types.NullInt{IsSet: true, Value: 3333},
+					}
+				})
+
+				It("returns an InvalidHTTPRouteSettings error", func() {
+					Expect(createRouteErr).To(MatchError(InvalidHTTPRouteSettings{Domain: "some-tcp-domain"}))
+					Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when neither a port nor a random port is provided", func() {
+				BeforeEach(func() {
+					route = Route{Domain: tcpDomain}
+				})
+
+				It("returns a TCPRouteOptionsNotProvidedError", func() {
+					Expect(createRouteErr).To(MatchError(TCPRouteOptionsNotProvidedError{}))
+					Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when a port is provided", func() {
+				BeforeEach(func() {
+					route = Route{
+						Domain: tcpDomain,
+						Port:   types.NullInt{IsSet: true, Value: 3333},
+					}
+					fakeCloudControllerClient.CreateRouteReturns(
+						ccv2.Route{
+							GUID:       "some-route-guid",
+							Port:       types.NullInt{IsSet: true, Value: 3333},
+							DomainGUID: "some-tcp-domain-guid",
+							SpaceGUID:  "some-space-guid",
+						},
+						ccv2.Warnings{"create-route-warning"},
+						nil)
+				})
+
+				It("looks up the existing route by port and creates the route", func() {
+					Expect(createRouteErr).ToNot(HaveOccurred())
+					Expect(createdRoute).To(Equal(Route{
+						Domain:    tcpDomain,
+						GUID:      "some-route-guid",
+						Port:      types.NullInt{IsSet: true, Value: 3333},
+						SpaceGUID: "some-space-guid",
+					}))
+
+					Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+						ccv2.Query{
+							Filter:   ccv2.DomainGUIDFilter,
+							Operator: ccv2.EqualOperator,
+							Values:   []string{"some-tcp-domain-guid"},
+						},
+						ccv2.Query{
+							Filter:   ccv2.PortFilter,
+							Operator: ccv2.EqualOperator,
+							Values:   []string{"3333"},
+						},
+					))
+
+					passedRoute, passedGeneratePort := fakeCloudControllerClient.CreateRouteArgsForCall(0)
+					Expect(passedRoute).To(Equal(ccv2.Route{
+						DomainGUID: "some-tcp-domain-guid",
+						Port:       types.NullInt{IsSet: true, Value: 3333},
+						SpaceGUID:  "some-space-guid",
+					}))
+					Expect(passedGeneratePort).To(BeFalse())
+				})
+			})
+
+			Context("when a random port is requested", func() {
+				BeforeEach(func() {
+					route = Route{Domain: tcpDomain}
+					generatePort = true
+				})
+
+				It("creates the route without checking for an existing route", func() {
+					Expect(createRouteErr).ToNot(HaveOccurred())
+					Expect(createRouteWarnings).To(ConsistOf("get-space-warning", "create-route-warning"))
+					Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(0))
+
+					_, passedGeneratePort := fakeCloudControllerClient.CreateRouteArgsForCall(0)
+					Expect(passedGeneratePort).To(BeTrue())
+				})
+			})
+		})
 	})
 
 	Describe("GetOrphanedRoutesBySpace", func() {
@@ -636,7 +764,7 @@ var _ = Describe("Route Actions", func() {
 			It("returns all the warnings", func() {
 				_, warnings, err := actor.GetOrphanedRoutesBySpace("space-guid")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(warnings).To(ConsistOf("get-routes-warning", "get-applications-warning", "get-shared-domain-warning", "get-applications-warning", "get-shared-domain-warning"))
+				Expect(warnings).To(ConsistOf("get-routes-warning", "get-shared-domain-warning", "get-applications-warning", "get-applications-warning"))
 			})
 		})
 
@@ -954,6 +1082,224 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("GetSpaceRoutesPaged", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceRoutesPagedStub = func(_ string, handlePage func([]ccv2.Route) error, _ ...ccv2.Query) (ccv2.Warnings, error) {
+				for _, page := range [][]ccv2.Route{
+					{{GUID: "route-guid-1", Host: "host-1", DomainGUID: "domain-1-guid"}},
+					{{GUID: "route-guid-2", Host: "host-2", DomainGUID: "domain-2-guid"}},
+				} {
+					if err := handlePage(page); err != nil {
+						return ccv2.Warnings{"space-routes-warning"}, err
+					}
+				}
+				return ccv2.Warnings{"space-routes-warning"}, nil
+			}
+			fakeCloudControllerClient.GetSharedDomainReturnsOnCall(0, ccv2.Domain{Name: "domain.com"}, ccv2.Warnings{"domain-warning-1"}, nil)
+			fakeCloudControllerClient.GetSharedDomainReturnsOnCall(1, ccv2.Domain{Name: "other-domain.com"}, ccv2.Warnings{"domain-warning-2"}, nil)
+		})
+
+		It("calls handlePage with each page of routes and their domains", func() {
+			var pages [][]Route
+			warnings, err := actor.GetSpaceRoutesPaged("space-guid", func(page []Route) error {
+				pages = append(pages, page)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("space-routes-warning", "domain-warning-1", "domain-warning-2"))
+			Expect(pages).To(Equal([][]Route{
+				{{GUID: "route-guid-1", Host: "host-1", Domain: Domain{Name: "domain.com"}}},
+				{{GUID: "route-guid-2", Host: "host-2", Domain: Domain{Name: "other-domain.com"}}},
+			}))
+
+			Expect(fakeCloudControllerClient.GetSpaceRoutesPagedCallCount()).To(Equal(1))
+			spaceGUID, _, _ := fakeCloudControllerClient.GetSpaceRoutesPagedArgsForCall(0)
+			Expect(spaceGUID).To(Equal("space-guid"))
+		})
+
+		Context("when getting a domain returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSharedDomainReturnsOnCall(0, ccv2.Domain{}, ccv2.Warnings{"domain-warning"}, errors.New("get-domain-error"))
+			})
+
+			It("stops paginating and returns the error and warnings", func() {
+				var pages [][]Route
+				warnings, err := actor.GetSpaceRoutesPaged("space-guid", func(page []Route) error {
+					pages = append(pages, page)
+					return nil
+				})
+				Expect(err).To(MatchError("get-domain-error"))
+				Expect(warnings).To(ConsistOf("space-routes-warning", "domain-warning"))
+				Expect(pages).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("GetRoutesWithApplications", func() {
+		var (
+			routesWithApps []RouteWithApplications
+			warnings       Warnings
+			executeErr     error
+		)
+
+		JustBeforeEach(func() {
+			routesWithApps, warnings, executeErr = actor.GetRoutesWithApplications("space-guid")
+		})
+
+		Context("when the space has routes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceRoutesReturns([]ccv2.Route{
+					{GUID: "route-guid-1", Host: "host-1", DomainGUID: "domain-guid"},
+					{GUID: "route-guid-2", Host: "host-2", DomainGUID: "domain-guid"},
+					{GUID: "route-guid-3", Host: "host-3", DomainGUID: "domain-guid"},
+				}, ccv2.Warnings{"get-space-routes-warning"}, nil)
+				fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{GUID: "domain-guid", Name: "domain.com"}, ccv2.Warnings{"get-domain-warning"}, nil)
+			})
+
+			Context("when the route mappings and applications are retrieved successfully", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetRouteMappingsReturns([]ccv2.RouteMapping{
+						{GUID: "route-mapping-guid-1", RouteGUID: "route-guid-1", AppGUID: "app-guid-1"},
+						{GUID: "route-mapping-guid-2", RouteGUID: "route-guid-1", AppGUID: "app-guid-2"},
+						{GUID: "route-mapping-guid-3", RouteGUID: "route-guid-3", AppGUID: "app-guid-1"},
+					}, ccv2.Warnings{"get-route-mappings-warning"}, nil)
+					fakeCloudControllerClient.GetApplicationsReturns([]ccv2.Application{
+						{GUID: "app-guid-1", Name: "app-1"},
+						{GUID: "app-guid-2", Name: "app-2"},
+						{GUID: "app-guid-3", Name: "app-3"},
+					}, ccv2.Warnings{"get-applications-warning"}, nil)
+				})
+
+				It("returns each route with its bound applications and all warnings", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-space-routes-warning", "get-domain-warning", "get-route-mappings-warning", "get-applications-warning"))
+
+					domain := Domain{GUID: "domain-guid", Name: "domain.com"}
+					Expect(routesWithApps).To(Equal([]RouteWithApplications{
+						{
+							Route: Route{GUID: "route-guid-1", Host: "host-1", Domain: domain},
+							Applications: []Application{
+								{GUID: "app-guid-1", Name: "app-1"},
+								{GUID: "app-guid-2", Name: "app-2"},
+							},
+						},
+						{
+							Route: Route{GUID: "route-guid-2", Host: "host-2", Domain: domain},
+						},
+						{
+							Route: Route{GUID: "route-guid-3", Host: "host-3", Domain: domain},
+							Applications: []Application{
+								{GUID: "app-guid-1", Name: "app-1"},
+							},
+						},
+					}))
+				})
+
+				It("looks up each domain only once", func() {
+					Expect(fakeCloudControllerClient.GetSharedDomainCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetSharedDomainArgsForCall(0)).To(Equal("domain-guid"))
+				})
+
+				It("queries the route mappings and applications in bulk", func() {
+					Expect(fakeCloudControllerClient.GetRouteMappingsCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetRouteMappingsArgsForCall(0)).To(ConsistOf(ccv2.Query{
+						Filter:   ccv2.RouteGUIDFilter,
+						Operator: ccv2.InOperator,
+						Values:   []string{"route-guid-1", "route-guid-2", "route-guid-3"},
+					}))
+
+					Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(ccv2.Query{
+						Filter:   ccv2.SpaceGUIDFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"space-guid"},
+					}))
+
+					Expect(fakeCloudControllerClient.GetRouteApplicationsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when no routes are bound to applications", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetRouteMappingsReturns(nil, nil, nil)
+				})
+
+				It("does not look up the space's applications", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(routesWithApps).To(HaveLen(3))
+					Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when getting the route mappings returns an error", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("route mappings error")
+					fakeCloudControllerClient.GetRouteMappingsReturns(nil, ccv2.Warnings{"get-route-mappings-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-space-routes-warning", "get-domain-warning", "get-route-mappings-warning"))
+				})
+			})
+
+			Context("when getting the applications returns an error", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("applications error")
+					fakeCloudControllerClient.GetRouteMappingsReturns([]ccv2.RouteMapping{
+						{GUID: "route-mapping-guid-1", RouteGUID: "route-guid-1", AppGUID: "app-guid-1"},
+					}, ccv2.Warnings{"get-route-mappings-warning"}, nil)
+					fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"get-applications-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-space-routes-warning", "get-domain-warning", "get-route-mappings-warning", "get-applications-warning"))
+				})
+			})
+		})
+
+		Context("when the space has more routes than fit in a single query", func() {
+			BeforeEach(func() {
+				var routes []ccv2.Route
+				for i := 0; i < 120; i++ {
+					routes = append(routes, ccv2.Route{GUID: fmt.Sprintf("route-guid-%d", i)})
+				}
+				fakeCloudControllerClient.GetSpaceRoutesReturns(routes, nil, nil)
+			})
+
+			It("batches the route GUIDs across route mapping queries", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(routesWithApps).To(HaveLen(120))
+
+				Expect(fakeCloudControllerClient.GetRouteMappingsCallCount()).To(Equal(3))
+				Expect(fakeCloudControllerClient.GetRouteMappingsArgsForCall(0)[0].Values).To(HaveLen(50))
+				Expect(fakeCloudControllerClient.GetRouteMappingsArgsForCall(1)[0].Values).To(HaveLen(50))
+				Expect(fakeCloudControllerClient.GetRouteMappingsArgsForCall(2)[0].Values).To(HaveLen(20))
+				Expect(fakeCloudControllerClient.GetRouteMappingsArgsForCall(2)[0].Values[19]).To(Equal("route-guid-119"))
+			})
+		})
+
+		Context("when getting the space routes returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("space routes error")
+				fakeCloudControllerClient.GetSpaceRoutesReturns(nil, ccv2.Warnings{"get-space-routes-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-space-routes-warning"))
+				Expect(fakeCloudControllerClient.GetRouteMappingsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("GetRouteByHostAndDomain", func() {
 		var (
 			host       string
@@ -0,0 +1,12 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/router"
+
+//go:generate counterfeiter . RouterClient
+
+// RouterClient is a Routing API client.
+type RouterClient interface {
+	GetRouterGroups() ([]router.RouterGroup, error)
+	GetRouterGroupsByName(name string) ([]router.RouterGroup, error)
+	UpdateRouterGroup(routerGroup router.RouterGroup) (router.RouterGroup, error)
+}
@@ -0,0 +1,66 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/router"
+)
+
+// RouterGroupNotFoundError is returned when a requested router group is not
+// found.
+type RouterGroupNotFoundError struct {
+	Name string
+}
+
+func (e RouterGroupNotFoundError) Error() string {
+	return fmt.Sprintf("Router group '%s' not found.", e.Name)
+}
+
+// RouterGroup represents a Routing API router group.
+type RouterGroup router.RouterGroup
+
+// GetRouterGroups returns all the router groups known to the Routing API.
+func (actor Actor) GetRouterGroups() ([]RouterGroup, error) {
+	routerGroups, err := actor.RouterClient.GetRouterGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []RouterGroup
+	for _, routerGroup := range routerGroups {
+		groups = append(groups, RouterGroup(routerGroup))
+	}
+	return groups, nil
+}
+
+// GetRouterGroupByName returns the router group with the provided name.
+func (actor Actor) GetRouterGroupByName(name string) (RouterGroup, error) {
+	routerGroups, err := actor.RouterClient.GetRouterGroupsByName(name)
+	if err != nil {
+		return RouterGroup{}, err
+	}
+
+	if len(routerGroups) == 0 {
+		return RouterGroup{}, RouterGroupNotFoundError{Name: name}
+	}
+
+	return RouterGroup(routerGroups[0]), nil
+}
+
+// UpdateRouterGroupReservablePorts sets the reservable ports of the router
+// group with the provided name. Ports is a comma separated list of ports and
+// port ranges, for example "1024-1033,1050".
+func (actor Actor) UpdateRouterGroupReservablePorts(name string, ports string) (RouterGroup, error) {
+	routerGroup, err := actor.GetRouterGroupByName(name)
+	if err != nil {
+		return RouterGroup{}, err
+	}
+
+	routerGroup.ReservablePorts = ports
+	updatedRouterGroup, err := actor.RouterClient.UpdateRouterGroup(router.RouterGroup(routerGroup))
+	if err != nil {
+		return RouterGroup{}, err
+	}
+
+	return RouterGroup(updatedRouterGroup), nil
+}
@@ -0,0 +1,131 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/router"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Router Group Actions", func() {
+	var (
+		actor            *Actor
+		fakeRouterClient *v2actionfakes.FakeRouterClient
+	)
+
+	BeforeEach(func() {
+		fakeRouterClient = new(v2actionfakes.FakeRouterClient)
+		actor = NewActor(nil, nil, nil)
+		actor.RouterClient = fakeRouterClient
+	})
+
+	Describe("GetRouterGroups", func() {
+		Context("when the router groups are returned", func() {
+			BeforeEach(func() {
+				fakeRouterClient.GetRouterGroupsReturns([]router.RouterGroup{
+					{GUID: "guid-1", Name: "default-tcp", Type: "tcp", ReservablePorts: "1024-1033"},
+					{GUID: "guid-2", Name: "default-http", Type: "http"},
+				}, nil)
+			})
+
+			It("returns the router groups", func() {
+				routerGroups, err := actor.GetRouterGroups()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(routerGroups).To(Equal([]RouterGroup{
+					{GUID: "guid-1", Name: "default-tcp", Type: "tcp", ReservablePorts: "1024-1033"},
+					{GUID: "guid-2", Name: "default-http", Type: "http"},
+				}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get router groups failed")
+				fakeRouterClient.GetRouterGroupsReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				_, err := actor.GetRouterGroups()
+				Expect(err).To(MatchError(expectedErr))
+			})
+		})
+	})
+
+	Describe("GetRouterGroupByName", func() {
+		Context("when the router group exists", func() {
+			BeforeEach(func() {
+				fakeRouterClient.GetRouterGroupsByNameReturns([]router.RouterGroup{
+					{GUID: "guid-1", Name: "default-tcp", Type: "tcp"},
+				}, nil)
+			})
+
+			It("returns the router group", func() {
+				routerGroup, err := actor.GetRouterGroupByName("default-tcp")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(routerGroup).To(Equal(RouterGroup{GUID: "guid-1", Name: "default-tcp", Type: "tcp"}))
+
+				Expect(fakeRouterClient.GetRouterGroupsByNameCallCount()).To(Equal(1))
+				Expect(fakeRouterClient.GetRouterGroupsByNameArgsForCall(0)).To(Equal("default-tcp"))
+			})
+		})
+
+		Context("when the router group does not exist", func() {
+			It("returns a RouterGroupNotFoundError", func() {
+				_, err := actor.GetRouterGroupByName("default-tcp")
+				Expect(err).To(MatchError(RouterGroupNotFoundError{Name: "default-tcp"}))
+			})
+		})
+	})
+
+	Describe("UpdateRouterGroupReservablePorts", func() {
+		Context("when the router group exists", func() {
+			BeforeEach(func() {
+				fakeRouterClient.GetRouterGroupsByNameReturns([]router.RouterGroup{
+					{GUID: "guid-1", Name: "default-tcp", Type: "tcp", ReservablePorts: "1024-1033"},
+				}, nil)
+				fakeRouterClient.UpdateRouterGroupReturns(router.RouterGroup{
+					GUID: "guid-1", Name: "default-tcp", Type: "tcp", ReservablePorts: "2000-3000",
+				}, nil)
+			})
+
+			It("updates the reservable ports", func() {
+				routerGroup, err := actor.UpdateRouterGroupReservablePorts("default-tcp", "2000-3000")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(routerGroup.ReservablePorts).To(Equal("2000-3000"))
+
+				Expect(fakeRouterClient.UpdateRouterGroupCallCount()).To(Equal(1))
+				Expect(fakeRouterClient.UpdateRouterGroupArgsForCall(0)).To(Equal(router.RouterGroup{
+					GUID: "guid-1", Name: "default-tcp", Type: "tcp", ReservablePorts: "2000-3000",
+				}))
+			})
+		})
+
+		Context("when the router group does not exist", func() {
+			It("returns a RouterGroupNotFoundError without updating", func() {
+				_, err := actor.UpdateRouterGroupReservablePorts("default-tcp", "2000-3000")
+				Expect(err).To(MatchError(RouterGroupNotFoundError{Name: "default-tcp"}))
+				Expect(fakeRouterClient.UpdateRouterGroupCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the update fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("update failed")
+				fakeRouterClient.GetRouterGroupsByNameReturns([]router.RouterGroup{{GUID: "guid-1"}}, nil)
+				fakeRouterClient.UpdateRouterGroupReturns(router.RouterGroup{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				_, err := actor.UpdateRouterGroupReservablePorts("default-tcp", "2000-3000")
+				Expect(err).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
@@ -3,11 +3,17 @@ package v2action
 import (
 	"fmt"
 	"sort"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	log "github.com/sirupsen/logrus"
 )
 
+// maxConcurrentBindRequests is the maximum number of spaces
+// BindSecurityGroupToAllSpacesInOrg binds at once.
+const maxConcurrentBindRequests = 10
+
 // SecurityGroup represents a CF SecurityGroup.
 type SecurityGroup ccv2.SecurityGroup
 
@@ -51,22 +57,83 @@ func (e SecurityGroupNotFoundError) Error() string {
 	return fmt.Sprintf("Security group '%s' not found.", e.Name)
 }
 
-func (actor Actor) BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string, lifecycle ccv2.SecurityGroupLifecycle) (Warnings, error) {
+// BindSecurityGroupToSpace binds the security group to the space for each of
+// the provided lifecycles. If any binding fails, the bindings made by this
+// call are removed before the error is returned.
+func (actor Actor) BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string, lifecycles []ccv2.SecurityGroupLifecycle) (Warnings, error) {
+	err := validateLifecycles(lifecycles)
+	if err != nil {
+		return nil, err
+	}
+
+	var allWarnings Warnings
+	for i, lifecycle := range lifecycles {
+		warnings, err := actor.bindSecurityGroupToSpace(securityGroupGUID, spaceGUID, lifecycle)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			for _, boundLifecycle := range lifecycles[:i] {
+				warnings, rollbackErr := actor.unbindSecurityGroupFromSpace(securityGroupGUID, spaceGUID, boundLifecycle)
+				allWarnings = append(allWarnings, warnings...)
+				if rollbackErr != nil {
+					log.Errorln("rolling back security group binding:", rollbackErr)
+				}
+			}
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// BindSecurityGroupToAllSpacesInOrg binds the security group to every space
+// in the organization for the given lifecycles. Up to
+// maxConcurrentBindRequests spaces are bound in parallel and no further
+// spaces are bound once one fails. spaceBound is called with every space that
+// is bound; calls are never made concurrently. The warnings from every
+// binding are returned along with the first error encountered.
+func (actor Actor) BindSecurityGroupToAllSpacesInOrg(securityGroupGUID string, orgGUID string, lifecycles []ccv2.SecurityGroupLifecycle, spaceBound func(Space)) (Warnings, error) {
+	spaces, allWarnings, err := actor.GetOrganizationSpaces(orgGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
 	var (
-		warnings ccv2.Warnings
-		err      error
+		wg       sync.WaitGroup
+		mutex    sync.Mutex
+		firstErr error
 	)
+	requests := make(chan struct{}, maxConcurrentBindRequests)
+	for _, space := range spaces {
+		requests <- struct{}{}
+
+		mutex.Lock()
+		failed := firstErr != nil
+		mutex.Unlock()
+		if failed {
+			<-requests
+			break
+		}
 
-	switch lifecycle {
-	case ccv2.SecurityGroupLifecycleRunning:
-		warnings, err = actor.CloudControllerClient.AssociateSpaceWithRunningSecurityGroup(securityGroupGUID, spaceGUID)
-	case ccv2.SecurityGroupLifecycleStaging:
-		warnings, err = actor.CloudControllerClient.AssociateSpaceWithStagingSecurityGroup(securityGroupGUID, spaceGUID)
-	default:
-		err = InvalidLifecycleError{lifecycle: lifecycle}
+		wg.Add(1)
+		go func(space Space) {
+			defer wg.Done()
+			warnings, bindErr := actor.BindSecurityGroupToSpace(securityGroupGUID, space.GUID, lifecycles)
+			<-requests
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			allWarnings = append(allWarnings, warnings...)
+			switch {
+			case bindErr == nil && spaceBound != nil:
+				spaceBound(space)
+			case bindErr != nil && firstErr == nil:
+				firstErr = bindErr
+			}
+		}(space)
 	}
+	wg.Wait()
 
-	return Warnings(warnings), err
+	return allWarnings, firstErr
 }
 
 func (actor Actor) GetSecurityGroupByName(securityGroupName string) (SecurityGroup, Warnings, error) {
@@ -84,11 +151,76 @@ func (actor Actor) GetSecurityGroupByName(securityGroupName string) (SecurityGro
 		return SecurityGroup{}, Warnings(warnings), SecurityGroupNotFoundError{securityGroupName}
 	}
 
-	securityGroup := SecurityGroup{
-		Name: securityGroups[0].Name,
-		GUID: securityGroups[0].GUID,
+	return SecurityGroup(securityGroups[0]), Warnings(warnings), nil
+}
+
+// GetSecurityGroupOrganizationsAndSpaces returns the orgs and spaces the
+// security group is bound to, sorted by org and then space. Staging bindings
+// are only included when includeStaging is true.
+func (actor Actor) GetSecurityGroupOrganizationsAndSpaces(securityGroup SecurityGroup, includeStaging bool) ([]SecurityGroupWithOrganizationSpaceAndLifecycle, Warnings, error) {
+	secGroupOrgSpaces, warnings, err := actor.securityGroupWithOrganizationSpaceAndLifecycle(ccv2.SecurityGroup(securityGroup), includeStaging, make(map[string]Organization))
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	sortSecurityGroupOrgSpaces(secGroupOrgSpaces)
+
+	return secGroupOrgSpaces, warnings, nil
+}
+
+// DeleteSecurityGroupByName deletes the named security group. Before anything
+// is changed, the spaces the group is bound to (in both the running and
+// staging lifecycles) are passed to confirm; if confirm returns false nothing
+// is deleted. Otherwise the group is unbound from every space and then
+// deleted.
+func (actor Actor) DeleteSecurityGroupByName(securityGroupName string, confirm func(bindings []SecurityGroupWithOrganizationSpaceAndLifecycle) (bool, error)) (Warnings, error) {
+	var allWarnings Warnings
+
+	securityGroup, warnings, err := actor.GetSecurityGroupByName(securityGroupName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	secGroupOrgSpaces, warnings, err := actor.securityGroupWithOrganizationSpaceAndLifecycle(
+		ccv2.SecurityGroup{GUID: securityGroup.GUID, Name: securityGroup.Name},
+		true,
+		map[string]Organization{},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	var bindings []SecurityGroupWithOrganizationSpaceAndLifecycle
+	for _, secGroupOrgSpace := range secGroupOrgSpaces {
+		if secGroupOrgSpace.Space.GUID != "" {
+			bindings = append(bindings, secGroupOrgSpace)
+		}
 	}
-	return securityGroup, Warnings(warnings), nil
+	sortSecurityGroupOrgSpaces(bindings)
+
+	confirmed, err := confirm(bindings)
+	if err != nil || !confirmed {
+		return allWarnings, err
+	}
+
+	for _, binding := range bindings {
+		warnings, err = actor.unbindSecurityGroupFromSpace(securityGroup.GUID, binding.Space.GUID, binding.Lifecycle)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			if _, ok := err.(ccerror.ResourceNotFoundError); !ok {
+				return allWarnings, err
+			}
+		}
+	}
+
+	ccWarnings, err := actor.CloudControllerClient.DeleteSecurityGroup(securityGroup.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return allWarnings, SecurityGroupNotFoundError{Name: securityGroupName}
+	}
+	return allWarnings, err
 }
 
 type SpaceWithLifecycle struct {
@@ -135,101 +267,154 @@ func (actor Actor) GetSecurityGroupsWithOrganizationSpaceAndLifecycle(includeSta
 		return nil, Warnings(allWarnings), err
 	}
 
+	secGroupOrgSpaces, warnings, err := actor.securityGroupsWithOrganizationSpaceAndLifecycle(securityGroups, includeStaging, make(map[string]Organization))
+	if err != nil {
+		return nil, append(Warnings(allWarnings), warnings...), err
+	}
+
+	sortSecurityGroupOrgSpaces(secGroupOrgSpaces)
+
+	return secGroupOrgSpaces, append(Warnings(allWarnings), warnings...), nil
+}
+
+// GetSecurityGroupsWithOrganizationSpaceAndLifecyclePaged calls handlePage
+// with the security groups of each page, along with their org and space
+// information, as the page is retrieved. Each page is sorted on its own, so
+// callers that need a fully sorted list should use
+// GetSecurityGroupsWithOrganizationSpaceAndLifecycle. Returning an error from
+// handlePage stops the pagination and returns that error.
+func (actor Actor) GetSecurityGroupsWithOrganizationSpaceAndLifecyclePaged(includeStaging bool, handlePage func([]SecurityGroupWithOrganizationSpaceAndLifecycle) error) (Warnings, error) {
+	var allWarnings Warnings
 	cachedOrgs := make(map[string]Organization)
-	var secGroupOrgSpaces []SecurityGroupWithOrganizationSpaceAndLifecycle
 
-	for _, s := range securityGroups {
-		securityGroup := SecurityGroup{
-			GUID:           s.GUID,
-			Name:           s.Name,
-			RunningDefault: s.RunningDefault,
-			StagingDefault: s.StagingDefault,
+	ccWarnings, err := actor.CloudControllerClient.GetSecurityGroupsPaged(func(securityGroups []ccv2.SecurityGroup) error {
+		secGroupOrgSpaces, warnings, err := actor.securityGroupsWithOrganizationSpaceAndLifecycle(securityGroups, includeStaging, cachedOrgs)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return err
 		}
 
-		var getErr error
-		spaces, warnings, getErr := actor.getSecurityGroupSpacesAndAssignedLifecycles(s.GUID, includeStaging)
+		sortSecurityGroupOrgSpaces(secGroupOrgSpaces)
+		return handlePage(secGroupOrgSpaces)
+	})
+
+	return append(Warnings(ccWarnings), allWarnings...), err
+}
+
+func (actor Actor) securityGroupsWithOrganizationSpaceAndLifecycle(securityGroups []ccv2.SecurityGroup, includeStaging bool, cachedOrgs map[string]Organization) ([]SecurityGroupWithOrganizationSpaceAndLifecycle, Warnings, error) {
+	var (
+		secGroupOrgSpaces []SecurityGroupWithOrganizationSpaceAndLifecycle
+		allWarnings       Warnings
+	)
+
+	for _, s := range securityGroups {
+		orgSpaces, warnings, err := actor.securityGroupWithOrganizationSpaceAndLifecycle(s, includeStaging, cachedOrgs)
 		allWarnings = append(allWarnings, warnings...)
-		if getErr != nil {
-			if _, ok := getErr.(ccerror.ResourceNotFoundError); ok {
-				allWarnings = append(allWarnings, getErr.Error())
-				continue
-			}
-			return nil, Warnings(allWarnings), getErr
+		if err != nil {
+			return nil, allWarnings, err
 		}
+		secGroupOrgSpaces = append(secGroupOrgSpaces, orgSpaces...)
+	}
 
-		if securityGroup.RunningDefault {
-			secGroupOrgSpaces = append(secGroupOrgSpaces,
-				SecurityGroupWithOrganizationSpaceAndLifecycle{
-					SecurityGroup: &securityGroup,
-					Organization:  &Organization{},
-					Space:         &Space{},
-					Lifecycle:     ccv2.SecurityGroupLifecycleRunning,
-				})
+	return secGroupOrgSpaces, allWarnings, nil
+}
+
+func (actor Actor) securityGroupWithOrganizationSpaceAndLifecycle(s ccv2.SecurityGroup, includeStaging bool, cachedOrgs map[string]Organization) ([]SecurityGroupWithOrganizationSpaceAndLifecycle, Warnings, error) {
+	var (
+		secGroupOrgSpaces []SecurityGroupWithOrganizationSpaceAndLifecycle
+		allWarnings       Warnings
+	)
+
+	securityGroup := SecurityGroup(s)
+
+	var getErr error
+	spaces, warnings, getErr := actor.getSecurityGroupSpacesAndAssignedLifecycles(s.GUID, includeStaging)
+	allWarnings = append(allWarnings, warnings...)
+	if getErr != nil {
+		if _, ok := getErr.(ccerror.ResourceNotFoundError); ok {
+			allWarnings = append(allWarnings, getErr.Error())
+			return nil, allWarnings, nil
 		}
+		return nil, allWarnings, getErr
+	}
 
-		if securityGroup.StagingDefault {
+	if securityGroup.RunningDefault {
+		secGroupOrgSpaces = append(secGroupOrgSpaces,
+			SecurityGroupWithOrganizationSpaceAndLifecycle{
+				SecurityGroup: &securityGroup,
+				Organization:  &Organization{},
+				Space:         &Space{},
+				Lifecycle:     ccv2.SecurityGroupLifecycleRunning,
+			})
+	}
+
+	if securityGroup.StagingDefault {
+		secGroupOrgSpaces = append(secGroupOrgSpaces,
+			SecurityGroupWithOrganizationSpaceAndLifecycle{
+				SecurityGroup: &securityGroup,
+				Organization:  &Organization{},
+				Space:         &Space{},
+				Lifecycle:     ccv2.SecurityGroupLifecycleStaging,
+			})
+	}
+
+	if len(spaces) == 0 {
+		if !securityGroup.RunningDefault && !securityGroup.StagingDefault {
 			secGroupOrgSpaces = append(secGroupOrgSpaces,
 				SecurityGroupWithOrganizationSpaceAndLifecycle{
 					SecurityGroup: &securityGroup,
 					Organization:  &Organization{},
 					Space:         &Space{},
-					Lifecycle:     ccv2.SecurityGroupLifecycleStaging,
 				})
 		}
 
-		if len(spaces) == 0 {
-			if !securityGroup.RunningDefault && !securityGroup.StagingDefault {
-				secGroupOrgSpaces = append(secGroupOrgSpaces,
-					SecurityGroupWithOrganizationSpaceAndLifecycle{
-						SecurityGroup: &securityGroup,
-						Organization:  &Organization{},
-						Space:         &Space{},
-					})
-			}
+		return secGroupOrgSpaces, allWarnings, nil
+	}
 
-			continue
+	for _, sp := range spaces {
+		space := Space{
+			GUID: sp.GUID,
+			Name: sp.Name,
 		}
 
-		for _, sp := range spaces {
-			space := Space{
-				GUID: sp.GUID,
-				Name: sp.Name,
-			}
+		var org Organization
 
-			var org Organization
-
-			if cached, ok := cachedOrgs[sp.OrganizationGUID]; ok {
-				org = cached
-			} else {
-				var getOrgErr error
-				o, warnings, getOrgErr := actor.CloudControllerClient.GetOrganization(sp.OrganizationGUID)
-				allWarnings = append(allWarnings, warnings...)
-				if getOrgErr != nil {
-					if _, ok := getOrgErr.(ccerror.ResourceNotFoundError); ok {
-						allWarnings = append(allWarnings, getOrgErr.Error())
-						continue
-					}
-					return nil, Warnings(allWarnings), getOrgErr
-				}
-
-				org = Organization{
-					GUID: o.GUID,
-					Name: o.Name,
+		if cached, ok := cachedOrgs[sp.OrganizationGUID]; ok {
+			org = cached
+		} else {
+			var getOrgErr error
+			o, warnings, getOrgErr := actor.CloudControllerClient.GetOrganization(sp.OrganizationGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if getOrgErr != nil {
+				if _, ok := getOrgErr.(ccerror.ResourceNotFoundError); ok {
+					allWarnings = append(allWarnings, getOrgErr.Error())
+					continue
 				}
-				cachedOrgs[org.GUID] = org
+				return nil, allWarnings, getOrgErr
 			}
 
-			secGroupOrgSpaces = append(secGroupOrgSpaces,
-				SecurityGroupWithOrganizationSpaceAndLifecycle{
-					SecurityGroup: &securityGroup,
-					Organization:  &org,
-					Space:         &space,
-					Lifecycle:     sp.Lifecycle,
-				})
+			org = Organization{
+				GUID: o.GUID,
+				Name: o.Name,
+			}
+			cachedOrgs[org.GUID] = org
 		}
+
+		secGroupOrgSpaces = append(secGroupOrgSpaces,
+			SecurityGroupWithOrganizationSpaceAndLifecycle{
+				SecurityGroup: &securityGroup,
+				Organization:  &org,
+				Space:         &space,
+				Lifecycle:     sp.Lifecycle,
+			})
 	}
 
-	// Sort the results alphabetically by security group, then org, then space
+	return secGroupOrgSpaces, allWarnings, nil
+}
+
+// sortSecurityGroupOrgSpaces sorts the results alphabetically by security
+// group, then org, then space.
+func sortSecurityGroupOrgSpaces(secGroupOrgSpaces []SecurityGroupWithOrganizationSpaceAndLifecycle) {
 	sort.Slice(secGroupOrgSpaces,
 		func(i, j int) bool {
 			switch {
@@ -257,8 +442,6 @@ func (actor Actor) GetSecurityGroupsWithOrganizationSpaceAndLifecycle(includeSta
 
 			return secGroupOrgSpaces[i].Lifecycle < secGroupOrgSpaces[j].Lifecycle
 		})
-
-	return secGroupOrgSpaces, Warnings(allWarnings), nil
 }
 
 // GetSpaceRunningSecurityGroupsBySpace returns a list of all security groups
@@ -275,9 +458,13 @@ func (actor Actor) GetSpaceStagingSecurityGroupsBySpace(spaceGUID string) ([]Sec
 	return processSecurityGroups(spaceGUID, ccv2SecurityGroups, Warnings(warnings), err)
 }
 
-func (actor Actor) UnbindSecurityGroupByNameAndSpace(securityGroupName string, spaceGUID string, lifecycle ccv2.SecurityGroupLifecycle) (Warnings, error) {
-	if lifecycle != ccv2.SecurityGroupLifecycleRunning && lifecycle != ccv2.SecurityGroupLifecycleStaging {
-		return nil, InvalidLifecycleError{lifecycle: lifecycle}
+// UnbindSecurityGroupByNameAndSpace unbinds the security group from the space
+// for each of the provided lifecycles. If any unbinding fails, the bindings
+// removed by this call are restored before the error is returned.
+func (actor Actor) UnbindSecurityGroupByNameAndSpace(securityGroupName string, spaceGUID string, lifecycles []ccv2.SecurityGroupLifecycle) (Warnings, error) {
+	err := validateLifecycles(lifecycles)
+	if err != nil {
+		return nil, err
 	}
 
 	var allWarnings Warnings
@@ -289,14 +476,15 @@ func (actor Actor) UnbindSecurityGroupByNameAndSpace(securityGroupName string, s
 		return allWarnings, err
 	}
 
-	warnings, err = actor.unbindSecurityGroupAndSpace(securityGroup, spaceGUID, lifecycle)
+	warnings, err = actor.unbindSecurityGroupAndSpace(securityGroup, spaceGUID, lifecycles)
 	allWarnings = append(allWarnings, warnings...)
 	return allWarnings, err
 }
 
-func (actor Actor) UnbindSecurityGroupByNameOrganizationNameAndSpaceName(securityGroupName string, orgName string, spaceName string, lifecycle ccv2.SecurityGroupLifecycle) (Warnings, error) {
-	if lifecycle != ccv2.SecurityGroupLifecycleRunning && lifecycle != ccv2.SecurityGroupLifecycleStaging {
-		return nil, InvalidLifecycleError{lifecycle: lifecycle}
+func (actor Actor) UnbindSecurityGroupByNameOrganizationNameAndSpaceName(securityGroupName string, orgName string, spaceName string, lifecycles []ccv2.SecurityGroupLifecycle) (Warnings, error) {
+	err := validateLifecycles(lifecycles)
+	if err != nil {
+		return nil, err
 	}
 
 	var allWarnings Warnings
@@ -319,12 +507,48 @@ func (actor Actor) UnbindSecurityGroupByNameOrganizationNameAndSpaceName(securit
 		return allWarnings, err
 	}
 
-	warnings, err = actor.unbindSecurityGroupAndSpace(securityGroup, space.GUID, lifecycle)
+	warnings, err = actor.unbindSecurityGroupAndSpace(securityGroup, space.GUID, lifecycles)
 	allWarnings = append(allWarnings, warnings...)
 	return allWarnings, err
 }
 
-func (actor Actor) unbindSecurityGroupAndSpace(securityGroup SecurityGroup, spaceGUID string, lifecycle ccv2.SecurityGroupLifecycle) (Warnings, error) {
+func (actor Actor) unbindSecurityGroupAndSpace(securityGroup SecurityGroup, spaceGUID string, lifecycles []ccv2.SecurityGroupLifecycle) (Warnings, error) {
+	if len(lifecycles) == 1 {
+		return actor.unbindSecurityGroupAndSpaceForLifecycle(securityGroup, spaceGUID, lifecycles[0])
+	}
+
+	var (
+		allWarnings       Warnings
+		unboundLifecycles []ccv2.SecurityGroupLifecycle
+	)
+	for _, lifecycle := range lifecycles {
+		bound, warnings, err := actor.isSecurityGroupBoundToSpace(securityGroup.Name, spaceGUID, lifecycle)
+		allWarnings = append(allWarnings, warnings...)
+		if err == nil && bound {
+			warnings, err = actor.unbindSecurityGroupFromSpace(securityGroup.GUID, spaceGUID, lifecycle)
+			allWarnings = append(allWarnings, warnings...)
+		}
+
+		if err != nil {
+			for _, unboundLifecycle := range unboundLifecycles {
+				warnings, rollbackErr := actor.bindSecurityGroupToSpace(securityGroup.GUID, spaceGUID, unboundLifecycle)
+				allWarnings = append(allWarnings, warnings...)
+				if rollbackErr != nil {
+					log.Errorln("rolling back security group unbinding:", rollbackErr)
+				}
+			}
+			return allWarnings, err
+		}
+
+		if bound {
+			unboundLifecycles = append(unboundLifecycles, lifecycle)
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (actor Actor) unbindSecurityGroupAndSpaceForLifecycle(securityGroup SecurityGroup, spaceGUID string, lifecycle ccv2.SecurityGroupLifecycle) (Warnings, error) {
 	if lifecycle == ccv2.SecurityGroupLifecycleRunning {
 		return actor.doUnbind(securityGroup, spaceGUID, lifecycle,
 			actor.isRunningSecurityGroupBoundToSpace,
@@ -368,6 +592,58 @@ func (Actor) doUnbind(securityGroup SecurityGroup,
 	return allWarnings, err
 }
 
+func (actor Actor) bindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string, lifecycle ccv2.SecurityGroupLifecycle) (Warnings, error) {
+	var (
+		warnings ccv2.Warnings
+		err      error
+	)
+
+	switch lifecycle {
+	case ccv2.SecurityGroupLifecycleRunning:
+		warnings, err = actor.CloudControllerClient.AssociateSpaceWithRunningSecurityGroup(securityGroupGUID, spaceGUID)
+	case ccv2.SecurityGroupLifecycleStaging:
+		warnings, err = actor.CloudControllerClient.AssociateSpaceWithStagingSecurityGroup(securityGroupGUID, spaceGUID)
+	default:
+		err = InvalidLifecycleError{lifecycle: lifecycle}
+	}
+
+	return Warnings(warnings), err
+}
+
+func (actor Actor) unbindSecurityGroupFromSpace(securityGroupGUID string, spaceGUID string, lifecycle ccv2.SecurityGroupLifecycle) (Warnings, error) {
+	var (
+		warnings ccv2.Warnings
+		err      error
+	)
+
+	switch lifecycle {
+	case ccv2.SecurityGroupLifecycleRunning:
+		warnings, err = actor.CloudControllerClient.RemoveSpaceFromRunningSecurityGroup(securityGroupGUID, spaceGUID)
+	case ccv2.SecurityGroupLifecycleStaging:
+		warnings, err = actor.CloudControllerClient.RemoveSpaceFromStagingSecurityGroup(securityGroupGUID, spaceGUID)
+	default:
+		err = InvalidLifecycleError{lifecycle: lifecycle}
+	}
+
+	return Warnings(warnings), err
+}
+
+func (actor Actor) isSecurityGroupBoundToSpace(securityGroupName string, spaceGUID string, lifecycle ccv2.SecurityGroupLifecycle) (bool, Warnings, error) {
+	if lifecycle == ccv2.SecurityGroupLifecycleRunning {
+		return actor.isRunningSecurityGroupBoundToSpace(securityGroupName, spaceGUID)
+	}
+	return actor.isStagingSecurityGroupBoundToSpace(securityGroupName, spaceGUID)
+}
+
+func validateLifecycles(lifecycles []ccv2.SecurityGroupLifecycle) error {
+	for _, lifecycle := range lifecycles {
+		if lifecycle != ccv2.SecurityGroupLifecycleRunning && lifecycle != ccv2.SecurityGroupLifecycleStaging {
+			return InvalidLifecycleError{lifecycle: lifecycle}
+		}
+	}
+	return nil
+}
+
 func extractSecurityGroupRules(securityGroup SecurityGroup, lifecycle ccv2.SecurityGroupLifecycle) []SecurityGroupRule {
 	securityGroupRules := make([]SecurityGroupRule, len(securityGroup.Rules))
 
@@ -0,0 +1,292 @@
+package v2action
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/types"
+)
+
+const (
+	SecurityGroupProtocolAll  = "all"
+	SecurityGroupProtocolICMP = "icmp"
+	SecurityGroupProtocolTCP  = "tcp"
+	SecurityGroupProtocolUDP  = "udp"
+)
+
+// SecurityGroupRuleDefinition is a rule as written in a security group rules
+// file.
+type SecurityGroupRuleDefinition struct {
+	Protocol    string        `json:"protocol"`
+	Destination string        `json:"destination"`
+	Ports       string        `json:"ports"`
+	Type        types.NullInt `json:"type"`
+	Code        types.NullInt `json:"code"`
+	Log         bool          `json:"log"`
+	Description string        `json:"description"`
+}
+
+// SecurityGroupRuleViolation is a problem found in a security group rule.
+// Rule is the position of the rule in the rules file, starting at 1.
+type SecurityGroupRuleViolation struct {
+	Rule   int
+	Reason string
+}
+
+// InvalidSecurityGroupRulesError is returned when a security group rules file
+// cannot be read as a list of rules.
+type InvalidSecurityGroupRulesError struct {
+	Reason string
+}
+
+func (e InvalidSecurityGroupRulesError) Error() string {
+	return fmt.Sprintf("Invalid security group rules: %s", e.Reason)
+}
+
+// ReadSecurityGroupRules reads the security group rules file at the provided
+// path. The rules are not validated.
+func ReadSecurityGroupRules(pathToRules string) ([]SecurityGroupRuleDefinition, error) {
+	raw, err := ioutil.ReadFile(pathToRules)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []SecurityGroupRuleDefinition
+	err = json.Unmarshal(raw, &rules)
+	if err != nil {
+		return nil, InvalidSecurityGroupRulesError{Reason: err.Error()}
+	}
+
+	return rules, nil
+}
+
+// addressRange is an inclusive range of IPv4 addresses.
+type addressRange struct {
+	start uint32
+	end   uint32
+}
+
+func (r addressRange) overlaps(other addressRange) bool {
+	return r.start <= other.end && other.start <= r.end
+}
+
+// portRange is an inclusive range of ports.
+type portRange struct {
+	start int
+	end   int
+}
+
+func (r portRange) overlaps(other portRange) bool {
+	return r.start <= other.end && other.start <= r.end
+}
+
+// parsedSecurityGroupRule is a valid rule in the form used to look for
+// overlapping rules.
+type parsedSecurityGroupRule struct {
+	protocol     string
+	destinations addressRange
+	ports        []portRange
+	icmpType     int
+	icmpCode     int
+}
+
+func (rule parsedSecurityGroupRule) overlaps(other parsedSecurityGroupRule) bool {
+	if !rule.destinations.overlaps(other.destinations) {
+		return false
+	}
+
+	if rule.protocol == SecurityGroupProtocolAll || other.protocol == SecurityGroupProtocolAll {
+		return true
+	}
+	if rule.protocol != other.protocol {
+		return false
+	}
+
+	switch rule.protocol {
+	case SecurityGroupProtocolICMP:
+		return icmpValuesOverlap(rule.icmpType, other.icmpType) && icmpValuesOverlap(rule.icmpCode, other.icmpCode)
+	default:
+		for _, ports := range rule.ports {
+			for _, otherPorts := range other.ports {
+				if ports.overlaps(otherPorts) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+// icmpValuesOverlap returns true if the ICMP types or codes match, where -1
+// matches every value.
+func icmpValuesOverlap(value int, other int) bool {
+	return value == -1 || other == -1 || value == other
+}
+
+// ValidateSecurityGroupRules checks the protocol, destination, ports and ICMP
+// type and code of each rule, and reports rules that overlap an earlier rule.
+// It returns no violations if the rules are valid.
+func ValidateSecurityGroupRules(rules []SecurityGroupRuleDefinition) []SecurityGroupRuleViolation {
+	var violations []SecurityGroupRuleViolation
+
+	parsedRules := map[int]parsedSecurityGroupRule{}
+	for i, rule := range rules {
+		parsedRule, reasons := parseSecurityGroupRule(rule)
+		for _, reason := range reasons {
+			violations = append(violations, SecurityGroupRuleViolation{Rule: i + 1, Reason: reason})
+		}
+		if len(reasons) > 0 {
+			continue
+		}
+
+		for j := 0; j < i; j++ {
+			earlierRule, ok := parsedRules[j]
+			if ok && parsedRule.overlaps(earlierRule) {
+				violations = append(violations, SecurityGroupRuleViolation{
+					Rule:   i + 1,
+					Reason: fmt.Sprintf("overlaps rule %d", j+1),
+				})
+			}
+		}
+		parsedRules[i] = parsedRule
+	}
+
+	return violations
+}
+
+func parseSecurityGroupRule(rule SecurityGroupRuleDefinition) (parsedSecurityGroupRule, []string) {
+	var (
+		parsedRule parsedSecurityGroupRule
+		reasons    []string
+		err        error
+	)
+
+	parsedRule.protocol = rule.Protocol
+	switch rule.Protocol {
+	case SecurityGroupProtocolAll, SecurityGroupProtocolICMP, SecurityGroupProtocolTCP, SecurityGroupProtocolUDP:
+	case "":
+		reasons = append(reasons, "protocol is required")
+	default:
+		reasons = append(reasons, fmt.Sprintf("protocol '%s' is not one of tcp, udp, icmp or all", rule.Protocol))
+	}
+
+	if rule.Destination == "" {
+		reasons = append(reasons, "destination is required")
+	} else {
+		parsedRule.destinations, err = parseSecurityGroupDestination(rule.Destination)
+		if err != nil {
+			reasons = append(reasons, err.Error())
+		}
+	}
+
+	switch rule.Protocol {
+	case SecurityGroupProtocolTCP, SecurityGroupProtocolUDP:
+		if rule.Ports == "" {
+			reasons = append(reasons, fmt.Sprintf("ports are required for protocol %s", rule.Protocol))
+		} else {
+			parsedRule.ports, err = parseSecurityGroupPorts(rule.Ports)
+			if err != nil {
+				reasons = append(reasons, err.Error())
+			}
+		}
+		if rule.Type.IsSet || rule.Code.IsSet {
+			reasons = append(reasons, "type and code are only allowed for protocol icmp")
+		}
+	case SecurityGroupProtocolICMP:
+		if rule.Ports != "" {
+			reasons = append(reasons, "ports are not allowed for protocol icmp")
+		}
+		if !rule.Type.IsSet || !rule.Code.IsSet {
+			reasons = append(reasons, "type and code are required for protocol icmp")
+		}
+		if rule.Type.IsSet && (rule.Type.Value < -1 || rule.Type.Value > 255) {
+			reasons = append(reasons, fmt.Sprintf("type %d is not between -1 and 255", rule.Type.Value))
+		}
+		if rule.Code.IsSet && (rule.Code.Value < -1 || rule.Code.Value > 255) {
+			reasons = append(reasons, fmt.Sprintf("code %d is not between -1 and 255", rule.Code.Value))
+		}
+		parsedRule.icmpType = rule.Type.Value
+		parsedRule.icmpCode = rule.Code.Value
+	case SecurityGroupProtocolAll:
+		if rule.Ports != "" {
+			reasons = append(reasons, "ports are not allowed for protocol all")
+		}
+		if rule.Type.IsSet || rule.Code.IsSet {
+			reasons = append(reasons, "type and code are only allowed for protocol icmp")
+		}
+	}
+
+	return parsedRule, reasons
+}
+
+// parseSecurityGroupDestination parses a single IPv4 address, a CIDR or a
+// range of addresses separated by a dash.
+func parseSecurityGroupDestination(destination string) (addressRange, error) {
+	invalidErr := fmt.Errorf("destination '%s' is not an IPv4 address, CIDR or address range", destination)
+
+	if strings.Contains(destination, "/") {
+		_, network, err := net.ParseCIDR(destination)
+		if err != nil || network.IP.To4() == nil {
+			return addressRange{}, invalidErr
+		}
+		start := binary.BigEndian.Uint32(network.IP.To4())
+		mask := binary.BigEndian.Uint32(network.Mask)
+		return addressRange{start: start, end: start | ^mask}, nil
+	}
+
+	addresses := strings.Split(destination, "-")
+	if len(addresses) > 2 {
+		return addressRange{}, invalidErr
+	}
+
+	var parsed []uint32
+	for _, address := range addresses {
+		ip := net.ParseIP(address).To4()
+		if ip == nil {
+			return addressRange{}, invalidErr
+		}
+		parsed = append(parsed, binary.BigEndian.Uint32(ip))
+	}
+
+	r := addressRange{start: parsed[0], end: parsed[len(parsed)-1]}
+	if r.start > r.end {
+		return addressRange{}, fmt.Errorf("destination range '%s' ends before it starts", destination)
+	}
+	return r, nil
+}
+
+// parseSecurityGroupPorts parses a comma separated list of ports and port
+// ranges, such as "80,443,8000-8080".
+func parseSecurityGroupPorts(ports string) ([]portRange, error) {
+	var parsed []portRange
+	for _, portsEntry := range strings.Split(ports, ",") {
+		bounds := strings.Split(strings.TrimSpace(portsEntry), "-")
+		if len(bounds) > 2 {
+			return nil, fmt.Errorf("ports '%s' is not a list of ports or port ranges", ports)
+		}
+
+		var values []int
+		for _, bound := range bounds {
+			port, err := strconv.Atoi(bound)
+			if err != nil {
+				return nil, fmt.Errorf("ports '%s' is not a list of ports or port ranges", ports)
+			}
+			if port < 1 || port > 65535 {
+				return nil, fmt.Errorf("port %d is not between 1 and 65535", port)
+			}
+			values = append(values, port)
+		}
+
+		r := portRange{start: values[0], end: values[len(values)-1]}
+		if r.start > r.end {
+			return nil, fmt.Errorf("port range '%s' ends before it starts", portsEntry)
+		}
+		parsed = append(parsed, r)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,191 @@
+package v2action_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Rules Actions", func() {
+	Describe("ReadSecurityGroupRules", func() {
+		var (
+			pathToRules string
+			rawRules    string
+
+			rules      []SecurityGroupRuleDefinition
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			tmpFile, err := ioutil.TempFile("", "security-group-rules")
+			Expect(err).ToNot(HaveOccurred())
+			pathToRules = tmpFile.Name()
+			_, err = tmpFile.WriteString(rawRules)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tmpFile.Close()).To(Succeed())
+
+			rules, executeErr = ReadSecurityGroupRules(pathToRules)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(pathToRules)).To(Succeed())
+		})
+
+		Context("when the file is a list of rules", func() {
+			BeforeEach(func() {
+				rawRules = `[
+					{"protocol": "tcp", "destination": "10.0.11.0/24", "ports": "80,443", "log": true, "description": "some-description"},
+					{"protocol": "icmp", "destination": "10.0.0.1", "type": 0, "code": -1}
+				]`
+			})
+
+			It("returns the rules", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(rules).To(Equal([]SecurityGroupRuleDefinition{
+					{
+						Protocol:    "tcp",
+						Destination: "10.0.11.0/24",
+						Ports:       "80,443",
+						Log:         true,
+						Description: "some-description",
+					},
+					{
+						Protocol:    "icmp",
+						Destination: "10.0.0.1",
+						Type:        types.NullInt{Value: 0, IsSet: true},
+						Code:        types.NullInt{Value: -1, IsSet: true},
+					},
+				}))
+			})
+		})
+
+		Context("when the file is not a list of rules", func() {
+			BeforeEach(func() {
+				rawRules = `{"protocol": "tcp"}`
+			})
+
+			It("returns an InvalidSecurityGroupRulesError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(InvalidSecurityGroupRulesError{}))
+			})
+		})
+	})
+
+	Describe("ValidateSecurityGroupRules", func() {
+		DescribeTable("validating a single rule",
+			func(rule SecurityGroupRuleDefinition, expectedReasons ...string) {
+				violations := ValidateSecurityGroupRules([]SecurityGroupRuleDefinition{rule})
+
+				reasons := []string{}
+				for _, violation := range violations {
+					Expect(violation.Rule).To(Equal(1))
+					reasons = append(reasons, violation.Reason)
+				}
+				Expect(reasons).To(Equal(append([]string{}, expectedReasons...)))
+			},
+
+			Entry("a valid tcp rule with a CIDR destination",
+				SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "80,443,8000-8080"}),
+			Entry("a valid udp rule with an address range destination",
+				SecurityGroupRuleDefinition{Protocol: "udp", Destination: "10.0.0.1-10.0.0.9", Ports: "53"}),
+			Entry("a valid icmp rule",
+				SecurityGroupRuleDefinition{Protocol: "icmp", Destination: "0.0.0.0/0", Type: types.NullInt{Value: -1, IsSet: true}, Code: types.NullInt{Value: 0, IsSet: true}}),
+			Entry("a valid all rule",
+				SecurityGroupRuleDefinition{Protocol: "all", Destination: "10.0.0.1"}),
+
+			Entry("a missing protocol and destination",
+				SecurityGroupRuleDefinition{},
+				"protocol is required", "destination is required"),
+			Entry("an unknown protocol",
+				SecurityGroupRuleDefinition{Protocol: "sctp", Destination: "10.0.0.1"},
+				"protocol 'sctp' is not one of tcp, udp, icmp or all"),
+			Entry("an invalid CIDR",
+				SecurityGroupRuleDefinition{Protocol: "all", Destination: "10.0.0.0/33"},
+				"destination '10.0.0.0/33' is not an IPv4 address, CIDR or address range"),
+			Entry("an IPv6 destination",
+				SecurityGroupRuleDefinition{Protocol: "all", Destination: "::1"},
+				"destination '::1' is not an IPv4 address, CIDR or address range"),
+			Entry("a backwards address range",
+				SecurityGroupRuleDefinition{Protocol: "all", Destination: "10.0.0.9-10.0.0.1"},
+				"destination range '10.0.0.9-10.0.0.1' ends before it starts"),
+			Entry("missing tcp ports",
+				SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.0.1"},
+				"ports are required for protocol tcp"),
+			Entry("malformed ports",
+				SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.0.1", Ports: "80-90-100"},
+				"ports '80-90-100' is not a list of ports or port ranges"),
+			Entry("a port out of range",
+				SecurityGroupRuleDefinition{Protocol: "udp", Destination: "10.0.0.1", Ports: "0"},
+				"port 0 is not between 1 and 65535"),
+			Entry("a backwards port range",
+				SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.0.1", Ports: "90-80"},
+				"port range '90-80' ends before it starts"),
+			Entry("an icmp type on a tcp rule",
+				SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.0.1", Ports: "80", Type: types.NullInt{Value: 0, IsSet: true}},
+				"type and code are only allowed for protocol icmp"),
+			Entry("an icmp rule without a code and with ports",
+				SecurityGroupRuleDefinition{Protocol: "icmp", Destination: "10.0.0.1", Ports: "80", Type: types.NullInt{Value: 0, IsSet: true}},
+				"ports are not allowed for protocol icmp", "type and code are required for protocol icmp"),
+			Entry("an icmp type and code out of range",
+				SecurityGroupRuleDefinition{Protocol: "icmp", Destination: "10.0.0.1", Type: types.NullInt{Value: 256, IsSet: true}, Code: types.NullInt{Value: -2, IsSet: true}},
+				"type 256 is not between -1 and 255", "code -2 is not between -1 and 255"),
+			Entry("ports on an all rule",
+				SecurityGroupRuleDefinition{Protocol: "all", Destination: "10.0.0.1", Ports: "80"},
+				"ports are not allowed for protocol all"),
+		)
+
+		DescribeTable("checking for overlapping rules",
+			func(first SecurityGroupRuleDefinition, second SecurityGroupRuleDefinition, overlaps bool) {
+				violations := ValidateSecurityGroupRules([]SecurityGroupRuleDefinition{first, second})
+				if overlaps {
+					Expect(violations).To(ConsistOf(SecurityGroupRuleViolation{Rule: 2, Reason: "overlaps rule 1"}))
+				} else {
+					Expect(violations).To(BeEmpty())
+				}
+			},
+
+			Entry("tcp rules with overlapping destinations and ports",
+				SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80,443"},
+				SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.0.5-10.0.1.5", Ports: "400-500"},
+				true),
+			Entry("tcp rules with different ports",
+				SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80"},
+				SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"},
+				false),
+			Entry("tcp rules with different destinations",
+				SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80"},
+				SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.1.0/24", Ports: "80"},
+				false),
+			Entry("tcp and udp rules with the same destination and ports",
+				SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.0.1", Ports: "53"},
+				SecurityGroupRuleDefinition{Protocol: "udp", Destination: "10.0.0.1", Ports: "53"},
+				false),
+			Entry("an all rule and a rule with an overlapping destination",
+				SecurityGroupRuleDefinition{Protocol: "all", Destination: "10.0.0.0/8"},
+				SecurityGroupRuleDefinition{Protocol: "udp", Destination: "10.1.2.3", Ports: "53"},
+				true),
+			Entry("icmp rules where one matches every type",
+				SecurityGroupRuleDefinition{Protocol: "icmp", Destination: "10.0.0.1", Type: types.NullInt{Value: -1, IsSet: true}, Code: types.NullInt{Value: 0, IsSet: true}},
+				SecurityGroupRuleDefinition{Protocol: "icmp", Destination: "10.0.0.1", Type: types.NullInt{Value: 8, IsSet: true}, Code: types.NullInt{Value: 0, IsSet: true}},
+				true),
+			Entry("icmp rules with different codes",
+				SecurityGroupRuleDefinition{Protocol: "icmp", Destination: "10.0.0.1", Type: types.NullInt{Value: 3, IsSet: true}, Code: types.NullInt{Value: 0, IsSet: true}},
+				SecurityGroupRuleDefinition{Protocol: "icmp", Destination: "10.0.0.1", Type: types.NullInt{Value: 3, IsSet: true}, Code: types.NullInt{Value: 1, IsSet: true}},
+				false),
+		)
+
+		It("does not check invalid rules for overlaps", func() {
+			violations := ValidateSecurityGroupRules([]SecurityGroupRuleDefinition{
+				{Protocol: "all", Destination: "10.0.0.0/8", Ports: "80"},
+				{Protocol: "all", Destination: "10.0.0.1"},
+			})
+			Expect(violations).To(Equal([]SecurityGroupRuleViolation{
+				{Rule: 1, Reason: "ports are not allowed for protocol all"},
+			}))
+		})
+	})
+})
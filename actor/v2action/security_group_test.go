@@ -3,6 +3,8 @@ package v2action_test
 import (
 	"errors"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
@@ -1095,6 +1097,151 @@ var _ = Describe("Security Group Actions", func() {
 		})
 	})
 
+	Describe("GetSecurityGroupsWithOrganizationSpaceAndLifecyclePaged", func() {
+		var (
+			pages    [][]SecurityGroupWithOrganizationSpaceAndLifecycle
+			warnings Warnings
+			err      error
+
+			handlePageErr error
+		)
+
+		BeforeEach(func() {
+			pages = nil
+			handlePageErr = nil
+
+			fakeCloudControllerClient.GetSecurityGroupsPagedStub = func(handlePage func([]ccv2.SecurityGroup) error, _ ...ccv2.Query) (ccv2.Warnings, error) {
+				for _, page := range [][]ccv2.SecurityGroup{
+					{
+						{GUID: "security-group-guid-2", Name: "security-group-2"},
+						{GUID: "security-group-guid-1", Name: "security-group-1"},
+					},
+					{
+						{GUID: "security-group-guid-3", Name: "security-group-3"},
+					},
+				} {
+					if pageErr := handlePage(page); pageErr != nil {
+						return ccv2.Warnings{"security-groups-warning"}, pageErr
+					}
+				}
+				return ccv2.Warnings{"security-groups-warning"}, nil
+			}
+			fakeCloudControllerClient.GetRunningSpacesBySecurityGroupStub = func(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error) {
+				if securityGroupGUID == "security-group-guid-2" {
+					return nil, ccv2.Warnings{"spaces-warning"}, nil
+				}
+				return []ccv2.Space{{GUID: "space-guid", Name: "space", OrganizationGUID: "org-guid"}}, ccv2.Warnings{"spaces-warning"}, nil
+			}
+			fakeCloudControllerClient.GetOrganizationReturns(ccv2.Organization{GUID: "org-guid", Name: "org"}, ccv2.Warnings{"org-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.GetSecurityGroupsWithOrganizationSpaceAndLifecyclePaged(false, func(page []SecurityGroupWithOrganizationSpaceAndLifecycle) error {
+				pages = append(pages, page)
+				return handlePageErr
+			})
+		})
+
+		It("calls handlePage with each sorted page of security groups", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("security-groups-warning", "spaces-warning", "spaces-warning", "spaces-warning", "org-warning"))
+
+			Expect(pages).To(HaveLen(2))
+			Expect(pages[0]).To(HaveLen(2))
+			Expect(pages[0][0].SecurityGroup.Name).To(Equal("security-group-1"))
+			Expect(pages[0][0].Organization.Name).To(Equal("org"))
+			Expect(pages[0][0].Space.Name).To(Equal("space"))
+			Expect(pages[0][0].Lifecycle).To(Equal(ccv2.SecurityGroupLifecycleRunning))
+			Expect(pages[0][1].SecurityGroup.Name).To(Equal("security-group-2"))
+			Expect(pages[0][1].Organization.Name).To(BeEmpty())
+			Expect(pages[1]).To(HaveLen(1))
+			Expect(pages[1][0].SecurityGroup.Name).To(Equal("security-group-3"))
+			Expect(pages[1][0].Organization.Name).To(Equal("org"))
+
+			Expect(fakeCloudControllerClient.GetOrganizationCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetStagingSpacesBySecurityGroupCallCount()).To(Equal(0))
+		})
+
+		Context("when handlePage returns an error", func() {
+			BeforeEach(func() {
+				handlePageErr = errors.New("stop")
+			})
+
+			It("stops paginating and returns the error and warnings", func() {
+				Expect(err).To(MatchError("stop"))
+				Expect(warnings).To(ContainElement("security-groups-warning"))
+				Expect(pages).To(HaveLen(1))
+				Expect(fakeCloudControllerClient.GetRunningSpacesBySecurityGroupCallCount()).To(Equal(2))
+			})
+		})
+	})
+
+	Describe("GetSecurityGroupOrganizationsAndSpaces", func() {
+		var (
+			securityGroup     SecurityGroup
+			includeStaging    bool
+			secGroupOrgSpaces []SecurityGroupWithOrganizationSpaceAndLifecycle
+			warnings          Warnings
+			err               error
+		)
+
+		BeforeEach(func() {
+			securityGroup = SecurityGroup{GUID: "some-security-group-guid", Name: "some-security-group"}
+			includeStaging = false
+		})
+
+		JustBeforeEach(func() {
+			secGroupOrgSpaces, warnings, err = actor.GetSecurityGroupOrganizationsAndSpaces(securityGroup, includeStaging)
+		})
+
+		Context("when the security group is bound to spaces", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRunningSpacesBySecurityGroupReturns(
+					[]ccv2.Space{
+						{GUID: "space-guid-2", Name: "space-2", OrganizationGUID: "org-guid-1"},
+						{GUID: "space-guid-1", Name: "space-1", OrganizationGUID: "org-guid-1"},
+					},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.GetOrganizationReturns(
+					ccv2.Organization{GUID: "org-guid-1", Name: "org-1"},
+					ccv2.Warnings{"warning-2"},
+					nil,
+				)
+			})
+
+			It("returns the sorted orgs and spaces and all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+				Expect(secGroupOrgSpaces).To(HaveLen(2))
+				Expect(secGroupOrgSpaces[0].Organization.Name).To(Equal("org-1"))
+				Expect(secGroupOrgSpaces[0].Space.Name).To(Equal("space-1"))
+				Expect(secGroupOrgSpaces[0].Lifecycle).To(Equal(ccv2.SecurityGroupLifecycleRunning))
+				Expect(secGroupOrgSpaces[1].Space.Name).To(Equal("space-2"))
+
+				Expect(fakeCloudControllerClient.GetRunningSpacesBySecurityGroupArgsForCall(0)).To(Equal("some-security-group-guid"))
+				Expect(fakeCloudControllerClient.GetStagingSpacesBySecurityGroupCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetOrganizationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when getting the spaces fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-spaces-error")
+				fakeCloudControllerClient.GetRunningSpacesBySecurityGroupReturns(nil, ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("GetSecurityGroupByName", func() {
 		var (
 			securityGroup SecurityGroup
@@ -1113,6 +1260,15 @@ var _ = Describe("Security Group Actions", func() {
 						{
 							GUID: "some-security-group-guid",
 							Name: "some-security-group",
+							Rules: []ccv2.SecurityGroupRule{
+								{
+									Description: "some-description",
+									Destination: "10.0.0.0/8",
+									Ports:       "443",
+									Protocol:    "tcp",
+								},
+							},
+							RunningDefault: true,
 						},
 					},
 					ccv2.Warnings{"warning-1", "warning-2"},
@@ -1120,9 +1276,21 @@ var _ = Describe("Security Group Actions", func() {
 				)
 			})
 
-			It("returns the security group and all warnings", func() {
+			It("returns the security group with its rules and all warnings", func() {
 				Expect(err).ToNot(HaveOccurred())
-				Expect(securityGroup.GUID).To(Equal("some-security-group-guid"))
+				Expect(securityGroup).To(Equal(SecurityGroup{
+					GUID: "some-security-group-guid",
+					Name: "some-security-group",
+					Rules: []ccv2.SecurityGroupRule{
+						{
+							Description: "some-description",
+							Destination: "10.0.0.0/8",
+							Ports:       "443",
+							Protocol:    "tcp",
+						},
+					},
+					RunningDefault: true,
+				}))
 				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
 
 				Expect(fakeCloudControllerClient.GetSecurityGroupsCallCount()).To(Equal(1))
@@ -1170,30 +1338,214 @@ var _ = Describe("Security Group Actions", func() {
 		})
 	})
 
+	Describe("DeleteSecurityGroupByName", func() {
+		var (
+			confirmBindings [][]SecurityGroupWithOrganizationSpaceAndLifecycle
+			confirmResult   bool
+			confirmErr      error
+			warnings        Warnings
+			err             error
+		)
+
+		BeforeEach(func() {
+			confirmBindings = nil
+			confirmResult = true
+			confirmErr = nil
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.DeleteSecurityGroupByName("some-security-group", func(bindings []SecurityGroupWithOrganizationSpaceAndLifecycle) (bool, error) {
+				confirmBindings = append(confirmBindings, bindings)
+				return confirmResult, confirmErr
+			})
+		})
+
+		Context("when the security group does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv2.Warnings{"get-security-groups-warning"}, nil)
+			})
+
+			It("returns a SecurityGroupNotFoundError and all warnings", func() {
+				Expect(err).To(MatchError(SecurityGroupNotFoundError{Name: "some-security-group"}))
+				Expect(warnings).To(ConsistOf("get-security-groups-warning"))
+				Expect(confirmBindings).To(BeEmpty())
+				Expect(fakeCloudControllerClient.DeleteSecurityGroupCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the security group exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns(
+					[]ccv2.SecurityGroup{{GUID: "security-group-guid", Name: "some-security-group"}},
+					ccv2.Warnings{"get-security-groups-warning"},
+					nil)
+				fakeCloudControllerClient.GetRunningSpacesBySecurityGroupReturns(
+					[]ccv2.Space{{GUID: "space-guid-1", Name: "space-1", OrganizationGUID: "org-guid-1"}},
+					ccv2.Warnings{"get-running-spaces-warning"},
+					nil)
+				fakeCloudControllerClient.GetStagingSpacesBySecurityGroupReturns(
+					[]ccv2.Space{{GUID: "space-guid-2", Name: "space-2", OrganizationGUID: "org-guid-1"}},
+					ccv2.Warnings{"get-staging-spaces-warning"},
+					nil)
+				fakeCloudControllerClient.GetOrganizationReturns(
+					ccv2.Organization{GUID: "org-guid-1", Name: "org-1"},
+					ccv2.Warnings{"get-org-warning"},
+					nil)
+				fakeCloudControllerClient.RemoveSpaceFromRunningSecurityGroupReturns(ccv2.Warnings{"remove-running-warning"}, nil)
+				fakeCloudControllerClient.RemoveSpaceFromStagingSecurityGroupReturns(ccv2.Warnings{"remove-staging-warning"}, nil)
+				fakeCloudControllerClient.DeleteSecurityGroupReturns(ccv2.Warnings{"delete-warning"}, nil)
+			})
+
+			It("passes the bound spaces to confirm, unbinds them and deletes the security group", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(
+					"get-security-groups-warning",
+					"get-running-spaces-warning",
+					"get-staging-spaces-warning",
+					"get-org-warning",
+					"remove-running-warning",
+					"remove-staging-warning",
+					"delete-warning",
+				))
+
+				Expect(confirmBindings).To(HaveLen(1))
+				Expect(confirmBindings[0]).To(HaveLen(2))
+				Expect(confirmBindings[0][0].Organization.Name).To(Equal("org-1"))
+				Expect(confirmBindings[0][0].Space.Name).To(Equal("space-1"))
+				Expect(confirmBindings[0][0].Lifecycle).To(Equal(ccv2.SecurityGroupLifecycleRunning))
+				Expect(confirmBindings[0][1].Organization.Name).To(Equal("org-1"))
+				Expect(confirmBindings[0][1].Space.Name).To(Equal("space-2"))
+				Expect(confirmBindings[0][1].Lifecycle).To(Equal(ccv2.SecurityGroupLifecycleStaging))
+
+				Expect(fakeCloudControllerClient.RemoveSpaceFromRunningSecurityGroupCallCount()).To(Equal(1))
+				securityGroupGUID, spaceGUID := fakeCloudControllerClient.RemoveSpaceFromRunningSecurityGroupArgsForCall(0)
+				Expect(securityGroupGUID).To(Equal("security-group-guid"))
+				Expect(spaceGUID).To(Equal("space-guid-1"))
+
+				Expect(fakeCloudControllerClient.RemoveSpaceFromStagingSecurityGroupCallCount()).To(Equal(1))
+				securityGroupGUID, spaceGUID = fakeCloudControllerClient.RemoveSpaceFromStagingSecurityGroupArgsForCall(0)
+				Expect(securityGroupGUID).To(Equal("security-group-guid"))
+				Expect(spaceGUID).To(Equal("space-guid-2"))
+
+				Expect(fakeCloudControllerClient.DeleteSecurityGroupCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteSecurityGroupArgsForCall(0)).To(Equal("security-group-guid"))
+			})
+
+			Context("when the security group is not bound to any spaces", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetRunningSpacesBySecurityGroupReturns(nil, nil, nil)
+					fakeCloudControllerClient.GetStagingSpacesBySecurityGroupReturns(nil, nil, nil)
+				})
+
+				It("passes no bindings to confirm and deletes the security group", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(confirmBindings).To(HaveLen(1))
+					Expect(confirmBindings[0]).To(BeEmpty())
+					Expect(fakeCloudControllerClient.RemoveSpaceFromRunningSecurityGroupCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.DeleteSecurityGroupCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when confirm declines", func() {
+				BeforeEach(func() {
+					confirmResult = false
+				})
+
+				It("does not unbind or delete anything", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.RemoveSpaceFromRunningSecurityGroupCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.RemoveSpaceFromStagingSecurityGroupCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.DeleteSecurityGroupCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when confirm returns an error", func() {
+				BeforeEach(func() {
+					confirmErr = errors.New("confirm error")
+				})
+
+				It("returns the error without deleting anything", func() {
+					Expect(err).To(MatchError("confirm error"))
+					Expect(fakeCloudControllerClient.DeleteSecurityGroupCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when unbinding a space fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("remove error")
+					fakeCloudControllerClient.RemoveSpaceFromRunningSecurityGroupReturns(ccv2.Warnings{"remove-running-warning"}, expectedErr)
+				})
+
+				It("returns the error and does not delete the security group", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ContainElement("remove-running-warning"))
+					Expect(fakeCloudControllerClient.DeleteSecurityGroupCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when a space binding has already been removed", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.RemoveSpaceFromRunningSecurityGroupReturns(nil, ccerror.ResourceNotFoundError{})
+				})
+
+				It("continues and deletes the security group", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.DeleteSecurityGroupCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when deleting the security group fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("delete error")
+					fakeCloudControllerClient.DeleteSecurityGroupReturns(ccv2.Warnings{"delete-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ContainElement("delete-warning"))
+				})
+			})
+
+			Context("when the security group disappears before it is deleted", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.DeleteSecurityGroupReturns(ccv2.Warnings{"delete-warning"}, ccerror.ResourceNotFoundError{})
+				})
+
+				It("returns a SecurityGroupNotFoundError", func() {
+					Expect(err).To(MatchError(SecurityGroupNotFoundError{Name: "some-security-group"}))
+				})
+			})
+		})
+	})
+
 	Describe("BindSecurityGroupToSpace", func() {
 		var (
-			lifecycle ccv2.SecurityGroupLifecycle
-			err       error
-			warnings  []string
+			lifecycles []ccv2.SecurityGroupLifecycle
+			err        error
+			warnings   []string
 		)
 
 		JustBeforeEach(func() {
-			warnings, err = actor.BindSecurityGroupToSpace("some-security-group-guid", "some-space-guid", lifecycle)
+			warnings, err = actor.BindSecurityGroupToSpace("some-security-group-guid", "some-space-guid", lifecycles)
 		})
 
 		Context("when the lifecycle is neither running nor staging", func() {
 			BeforeEach(func() {
-				lifecycle = "bill & ted"
+				lifecycles = []ccv2.SecurityGroupLifecycle{"bill & ted"}
 			})
 
 			It("returns and appropriate error", func() {
-				Expect(err).To(MatchError(fmt.Sprintf("Invalid lifecycle: %s", lifecycle)))
+				Expect(err).To(MatchError("Invalid lifecycle: bill & ted"))
 			})
 		})
 
 		Context("when the lifecycle is running", func() {
 			BeforeEach(func() {
-				lifecycle = ccv2.SecurityGroupLifecycleRunning
+				lifecycles = []ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleRunning}
 			})
 
 			Context("when binding the space does not return an error", func() {
@@ -1233,7 +1585,7 @@ var _ = Describe("Security Group Actions", func() {
 
 		Context("when the lifecycle is staging", func() {
 			BeforeEach(func() {
-				lifecycle = ccv2.SecurityGroupLifecycleStaging
+				lifecycles = []ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleStaging}
 			})
 
 			Context("when binding the space does not return an error", func() {
@@ -1270,6 +1622,217 @@ var _ = Describe("Security Group Actions", func() {
 				})
 			})
 		})
+
+		Context("when the lifecycles are running and staging", func() {
+			BeforeEach(func() {
+				lifecycles = []ccv2.SecurityGroupLifecycle{
+					ccv2.SecurityGroupLifecycleRunning,
+					ccv2.SecurityGroupLifecycleStaging,
+				}
+				fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupReturns(
+					ccv2.Warnings{"running-warning"},
+					nil,
+				)
+			})
+
+			Context("when binding both lifecycles succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.AssociateSpaceWithStagingSecurityGroupReturns(
+						ccv2.Warnings{"staging-warning"},
+						nil,
+					)
+				})
+
+				It("binds the space in both lifecycles and returns all warnings", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("running-warning", "staging-warning"))
+					Expect(fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.AssociateSpaceWithStagingSecurityGroupCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.RemoveSpaceFromRunningSecurityGroupCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when binding the staging lifecycle fails", func() {
+				var returnedError error
+
+				BeforeEach(func() {
+					returnedError = errors.New("associate-staging-error")
+					fakeCloudControllerClient.AssociateSpaceWithStagingSecurityGroupReturns(
+						ccv2.Warnings{"staging-warning"},
+						returnedError,
+					)
+					fakeCloudControllerClient.RemoveSpaceFromRunningSecurityGroupReturns(
+						ccv2.Warnings{"rollback-warning"},
+						nil,
+					)
+				})
+
+				It("removes the running binding and returns the error and all warnings", func() {
+					Expect(err).To(MatchError(returnedError))
+					Expect(warnings).To(ConsistOf("running-warning", "staging-warning", "rollback-warning"))
+
+					Expect(fakeCloudControllerClient.RemoveSpaceFromRunningSecurityGroupCallCount()).To(Equal(1))
+					securityGroupGUID, spaceGUID := fakeCloudControllerClient.RemoveSpaceFromRunningSecurityGroupArgsForCall(0)
+					Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+				})
+			})
+
+			Context("when binding the running lifecycle fails", func() {
+				var returnedError error
+
+				BeforeEach(func() {
+					returnedError = errors.New("associate-running-error")
+					fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupReturns(
+						ccv2.Warnings{"running-warning"},
+						returnedError,
+					)
+				})
+
+				It("does not bind the staging lifecycle or roll back", func() {
+					Expect(err).To(MatchError(returnedError))
+					Expect(warnings).To(ConsistOf("running-warning"))
+					Expect(fakeCloudControllerClient.AssociateSpaceWithStagingSecurityGroupCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.RemoveSpaceFromRunningSecurityGroupCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+
+	Describe("BindSecurityGroupToAllSpacesInOrg", func() {
+		var (
+			boundSpaces []Space
+			warnings    Warnings
+			err         error
+		)
+
+		BeforeEach(func() {
+			boundSpaces = nil
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.BindSecurityGroupToAllSpacesInOrg("some-security-group-guid", "some-org-guid", []ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleRunning}, func(space Space) {
+				boundSpaces = append(boundSpaces, space)
+			})
+		})
+
+		Context("when getting the org's spaces returns an error", func() {
+			var returnedError error
+
+			BeforeEach(func() {
+				returnedError = errors.New("get-spaces-error")
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"get-spaces-warning"}, returnedError)
+			})
+
+			It("returns the error and warnings without binding", func() {
+				Expect(err).To(MatchError(returnedError))
+				Expect(warnings).To(ConsistOf("get-spaces-warning"))
+				Expect(fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the org has spaces", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{
+						{GUID: "some-space-guid-1", Name: "some-space-1"},
+						{GUID: "some-space-guid-2", Name: "some-space-2"},
+					},
+					ccv2.Warnings{"get-spaces-warning"},
+					nil,
+				)
+			})
+
+			It("filters the spaces by org", func() {
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.OrganizationGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Values:   []string{"some-org-guid"},
+				}))
+			})
+
+			Context("when binding every space succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupReturns(ccv2.Warnings{"bind-warning"}, nil)
+				})
+
+				It("binds the security group to each space and returns all warnings", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-spaces-warning", "bind-warning", "bind-warning"))
+
+					Expect(fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupCallCount()).To(Equal(2))
+					var spaceGUIDs []string
+					for i := 0; i < 2; i++ {
+						securityGroupGUID, spaceGUID := fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupArgsForCall(i)
+						Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
+						spaceGUIDs = append(spaceGUIDs, spaceGUID)
+					}
+					Expect(spaceGUIDs).To(ConsistOf("some-space-guid-1", "some-space-guid-2"))
+				})
+
+				It("reports each bound space", func() {
+					Expect(boundSpaces).To(ConsistOf(
+						Space{GUID: "some-space-guid-1", Name: "some-space-1"},
+						Space{GUID: "some-space-guid-2", Name: "some-space-2"},
+					))
+				})
+			})
+
+			Context("when the org has more spaces than can be bound at once", func() {
+				var (
+					inFlight    int32
+					maxInFlight int32
+				)
+
+				BeforeEach(func() {
+					inFlight = 0
+					maxInFlight = 0
+
+					var spaces []ccv2.Space
+					for i := 0; i < 25; i++ {
+						spaces = append(spaces, ccv2.Space{GUID: fmt.Sprintf("some-space-guid-%d", i)})
+					}
+					fakeCloudControllerClient.GetSpacesReturns(spaces, nil, nil)
+
+					fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupStub = func(string, string) (ccv2.Warnings, error) {
+						current := atomic.AddInt32(&inFlight, 1)
+						defer atomic.AddInt32(&inFlight, -1)
+						for {
+							max := atomic.LoadInt32(&maxInFlight)
+							if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+								break
+							}
+						}
+						time.Sleep(time.Millisecond)
+						return nil, nil
+					}
+				})
+
+				It("binds at most 10 spaces at a time", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupCallCount()).To(Equal(25))
+					Expect(boundSpaces).To(HaveLen(25))
+					Expect(atomic.LoadInt32(&maxInFlight)).To(BeNumerically("<=", 10))
+				})
+			})
+
+			Context("when binding a space returns an error", func() {
+				var returnedError error
+
+				BeforeEach(func() {
+					returnedError = errors.New("associate-space-error")
+					fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupReturns(ccv2.Warnings{"bind-warning"}, returnedError)
+				})
+
+				It("returns the error and all warnings without reporting the space as bound", func() {
+					Expect(err).To(MatchError(returnedError))
+					Expect(warnings).To(ContainElement("get-spaces-warning"))
+					Expect(warnings).To(ContainElement("bind-warning"))
+					Expect(boundSpaces).To(BeEmpty())
+				})
+			})
+		})
 	})
 
 	Describe("GetSpaceRunningSecurityGroupsBySpace", func() {
@@ -1416,28 +1979,28 @@ var _ = Describe("Security Group Actions", func() {
 
 	Describe("UnbindSecurityGroupByNameAndSpace", func() {
 		var (
-			lifecycle ccv2.SecurityGroupLifecycle
-			warnings  Warnings
-			err       error
+			lifecycles []ccv2.SecurityGroupLifecycle
+			warnings   Warnings
+			err        error
 		)
 
 		JustBeforeEach(func() {
-			warnings, err = actor.UnbindSecurityGroupByNameAndSpace("some-security-group", "some-space-guid", lifecycle)
+			warnings, err = actor.UnbindSecurityGroupByNameAndSpace("some-security-group", "some-space-guid", lifecycles)
 		})
 
 		Context("when the requested lifecycle is neither running nor staging", func() {
 			BeforeEach(func() {
-				lifecycle = "bill & ted"
+				lifecycles = []ccv2.SecurityGroupLifecycle{"bill & ted"}
 			})
 
 			It("returns and appropriate error", func() {
-				Expect(err).To(MatchError(fmt.Sprintf("Invalid lifecycle: %s", lifecycle)))
+				Expect(err).To(MatchError("Invalid lifecycle: bill & ted"))
 			})
 		})
 
 		Context("when the security group is not found", func() {
 			BeforeEach(func() {
-				lifecycle = ccv2.SecurityGroupLifecycleStaging
+				lifecycles = []ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleStaging}
 
 				fakeCloudControllerClient.GetSecurityGroupsReturns(
 					[]ccv2.SecurityGroup{},
@@ -1455,7 +2018,7 @@ var _ = Describe("Security Group Actions", func() {
 			var returnedError error
 
 			BeforeEach(func() {
-				lifecycle = ccv2.SecurityGroupLifecycleRunning
+				lifecycles = []ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleRunning}
 
 				returnedError = errors.New("get-security-groups-error")
 				fakeCloudControllerClient.GetSecurityGroupsReturns(
@@ -1472,7 +2035,7 @@ var _ = Describe("Security Group Actions", func() {
 
 		Context("when the requested lifecycle is running", func() {
 			BeforeEach(func() {
-				lifecycle = ccv2.SecurityGroupLifecycleRunning
+				lifecycles = []ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleRunning}
 
 				fakeCloudControllerClient.GetSecurityGroupsReturns(
 					[]ccv2.SecurityGroup{{
@@ -1669,7 +2232,7 @@ var _ = Describe("Security Group Actions", func() {
 					}))
 					Expect(err).To(MatchError(SecurityGroupNotBoundError{
 						Name:      "some-security-group",
-						Lifecycle: lifecycle,
+						Lifecycle: ccv2.SecurityGroupLifecycleRunning,
 					}))
 
 					Expect(fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceCallCount()).To(Equal(1))
@@ -1698,7 +2261,7 @@ var _ = Describe("Security Group Actions", func() {
 
 		Context("when the requested lifecycle is staging", func() {
 			BeforeEach(func() {
-				lifecycle = ccv2.SecurityGroupLifecycleStaging
+				lifecycles = []ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleStaging}
 
 				fakeCloudControllerClient.GetSecurityGroupsReturns(
 					[]ccv2.SecurityGroup{{
@@ -1891,7 +2454,7 @@ var _ = Describe("Security Group Actions", func() {
 
 					Expect(err).To(MatchError(SecurityGroupNotBoundError{
 						Name:      "some-security-group",
-						Lifecycle: lifecycle,
+						Lifecycle: ccv2.SecurityGroupLifecycleStaging,
 					}))
 
 					Expect(fakeCloudControllerClient.GetSpaceStagingSecurityGroupsBySpaceCallCount()).To(Equal(1))
@@ -1945,6 +2508,108 @@ var _ = Describe("Security Group Actions", func() {
 				})
 			})
 		})
+
+		Context("when the requested lifecycles are running and staging", func() {
+			BeforeEach(func() {
+				lifecycles = []ccv2.SecurityGroupLifecycle{
+					ccv2.SecurityGroupLifecycleRunning,
+					ccv2.SecurityGroupLifecycleStaging,
+				}
+
+				fakeCloudControllerClient.GetSecurityGroupsReturns(
+					[]ccv2.SecurityGroup{{GUID: "some-security-group-guid", Name: "some-security-group"}},
+					ccv2.Warnings{"security-group-warning"},
+					nil)
+				fakeCloudControllerClient.RemoveSpaceFromRunningSecurityGroupReturns(
+					ccv2.Warnings{"remove-running-warning"},
+					nil)
+			})
+
+			Context("when the security group is bound in both lifecycles", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceReturns(
+						[]ccv2.SecurityGroup{{GUID: "some-security-group-guid", Name: "some-security-group"}},
+						ccv2.Warnings{"get-running-warning"},
+						nil)
+					fakeCloudControllerClient.GetSpaceStagingSecurityGroupsBySpaceReturns(
+						[]ccv2.SecurityGroup{{GUID: "some-security-group-guid", Name: "some-security-group"}},
+						ccv2.Warnings{"get-staging-warning"},
+						nil)
+				})
+
+				Context("when removing both bindings succeeds", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.RemoveSpaceFromStagingSecurityGroupReturns(
+							ccv2.Warnings{"remove-staging-warning"},
+							nil)
+					})
+
+					It("removes both bindings and returns all warnings", func() {
+						Expect(err).ToNot(HaveOccurred())
+						Expect(warnings).To(ConsistOf(
+							"security-group-warning",
+							"get-running-warning",
+							"remove-running-warning",
+							"get-staging-warning",
+							"remove-staging-warning",
+						))
+						Expect(fakeCloudControllerClient.RemoveSpaceFromRunningSecurityGroupCallCount()).To(Equal(1))
+						Expect(fakeCloudControllerClient.RemoveSpaceFromStagingSecurityGroupCallCount()).To(Equal(1))
+						Expect(fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when removing the staging binding fails", func() {
+					var returnedError error
+
+					BeforeEach(func() {
+						returnedError = errors.New("remove-staging-error")
+						fakeCloudControllerClient.RemoveSpaceFromStagingSecurityGroupReturns(
+							ccv2.Warnings{"remove-staging-warning"},
+							returnedError)
+						fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupReturns(
+							ccv2.Warnings{"rollback-warning"},
+							nil)
+					})
+
+					It("restores the running binding and returns the error and all warnings", func() {
+						Expect(err).To(MatchError(returnedError))
+						Expect(warnings).To(ConsistOf(
+							"security-group-warning",
+							"get-running-warning",
+							"remove-running-warning",
+							"get-staging-warning",
+							"remove-staging-warning",
+							"rollback-warning",
+						))
+
+						Expect(fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupCallCount()).To(Equal(1))
+						securityGroupGUID, spaceGUID := fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupArgsForCall(0)
+						Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
+						Expect(spaceGUID).To(Equal("some-space-guid"))
+					})
+				})
+			})
+
+			Context("when the security group is only bound in the staging lifecycle", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceReturns(
+						[]ccv2.SecurityGroup{},
+						nil,
+						nil)
+					fakeCloudControllerClient.GetSpaceStagingSecurityGroupsBySpaceReturns(
+						[]ccv2.SecurityGroup{{GUID: "some-security-group-guid", Name: "some-security-group"}},
+						nil,
+						nil)
+				})
+
+				It("only removes the staging binding", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.RemoveSpaceFromRunningSecurityGroupCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.RemoveSpaceFromStagingSecurityGroupCallCount()).To(Equal(1))
+				})
+			})
+		})
 	})
 
 	Describe("UnbindSecurityGroupByNameOrganizationNameAndSpaceName", func() {
@@ -1955,7 +2620,7 @@ var _ = Describe("Security Group Actions", func() {
 		)
 
 		JustBeforeEach(func() {
-			warnings, err = actor.UnbindSecurityGroupByNameOrganizationNameAndSpaceName("some-security-group", "some-org", "some-space", lifecycle)
+			warnings, err = actor.UnbindSecurityGroupByNameOrganizationNameAndSpaceName("some-security-group", "some-org", "some-space", []ccv2.SecurityGroupLifecycle{lifecycle})
 		})
 
 		Context("when the requested lifecycle is neither running nor staging", func() {
@@ -0,0 +1,95 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// Service represents a service offering in the marketplace.
+type Service ccv2.Service
+
+// ServicePlan represents a plan of a service offering, including the broker
+// provided configuration parameter schemas.
+type ServicePlan ccv2.ServicePlan
+
+// ServiceNotFoundError is returned when a requested service offering is not
+// found.
+type ServiceNotFoundError struct {
+	Label string
+}
+
+func (e ServiceNotFoundError) Error() string {
+	return fmt.Sprintf("Service offering '%s' not found.", e.Label)
+}
+
+// GetServiceByLabelAndProvider returns the service offering with the provided
+// label. An empty provider matches any provider.
+func (actor Actor) GetServiceByLabelAndProvider(label string, provider string) (Service, Warnings, error) {
+	queries := []ccv2.Query{{
+		Filter:   ccv2.LabelFilter,
+		Operator: ccv2.EqualOperator,
+		Values:   []string{label},
+	}}
+	if provider != "" {
+		queries = append(queries, ccv2.Query{
+			Filter:   ccv2.ProviderFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{provider},
+		})
+	}
+
+	services, warnings, err := actor.CloudControllerClient.GetServices(queries...)
+	if err != nil {
+		return Service{}, Warnings(warnings), err
+	}
+
+	if len(services) == 0 {
+		return Service{}, Warnings(warnings), ServiceNotFoundError{Label: label}
+	}
+
+	return Service(services[0]), Warnings(warnings), nil
+}
+
+// StartPurgeService purges the service offering with the provided GUID
+// without waiting for the purge job. The returned job is empty when the Cloud
+// Controller purged the service right away.
+func (actor Actor) StartPurgeService(serviceGUID string) (Job, Warnings, error) {
+	job, warnings, err := actor.CloudControllerClient.PurgeService(serviceGUID)
+	return Job(job), Warnings(warnings), err
+}
+
+// GetServicePlansByServiceLabel returns the plans of the service offering
+// with the provided label.
+func (actor Actor) GetServicePlansByServiceLabel(label string) ([]ServicePlan, Warnings, error) {
+	services, warnings, err := actor.CloudControllerClient.GetServices(ccv2.Query{
+		Filter:   ccv2.LabelFilter,
+		Operator: ccv2.EqualOperator,
+		Values:   []string{label},
+	})
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if len(services) == 0 {
+		return nil, allWarnings, ServiceNotFoundError{Label: label}
+	}
+
+	ccv2ServicePlans, warnings, err := actor.CloudControllerClient.GetServicePlans(ccv2.Query{
+		Filter:   ccv2.ServiceGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Values:   []string{services[0].GUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	servicePlans := make([]ServicePlan, len(ccv2ServicePlans))
+	for i, ccv2ServicePlan := range ccv2ServicePlans {
+		servicePlans[i] = ServicePlan(ccv2ServicePlan)
+	}
+
+	return servicePlans, allWarnings, nil
+}
@@ -2,14 +2,45 @@ package v2action
 
 import (
 	"fmt"
+	"time"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/jobs"
+	"code.cloudfoundry.org/cli/util/interrupt"
 )
 
 // ServiceBinding represents the link between a service instance and an
 // application.
 type ServiceBinding ccv2.ServiceBinding
 
+// IsInProgress returns true when the service broker is still creating the
+// binding asynchronously.
+func (serviceBinding ServiceBinding) IsInProgress() bool {
+	return serviceBinding.LastOperation.State == ccv2.LastOperationInProgress
+}
+
+// ServiceBindingFailedError is returned when the service broker fails to
+// create an asynchronous service binding.
+type ServiceBindingFailedError struct {
+	Description string
+}
+
+func (e ServiceBindingFailedError) Error() string {
+	return fmt.Sprintf("Service binding failed: %s", e.Description)
+}
+
+// ServiceBindingTimeoutError is returned when an asynchronous service binding
+// is still in progress after the overall polling timeout.
+type ServiceBindingTimeoutError struct {
+	GUID    string
+	Timeout time.Duration
+}
+
+func (e ServiceBindingTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out after %s waiting for service binding '%s' to complete.", e.Timeout, e.GUID)
+}
+
 // ServiceBindingNotFoundError is returned when a service binding cannot be
 // found.
 type ServiceBindingNotFoundError struct {
@@ -23,30 +54,71 @@ func (e ServiceBindingNotFoundError) Error() string {
 
 // BindServiceByApplicationAndServiceInstance binds the service instance to an application.
 func (actor Actor) BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (Warnings, error) {
-	_, warnings, err := actor.CloudControllerClient.CreateServiceBinding(appGUID, serviceInstanceGUID, nil)
+	_, warnings, err := actor.CloudControllerClient.CreateServiceBinding(appGUID, serviceInstanceGUID, "", false, nil)
 
 	return Warnings(warnings), err
 }
 
-// BindServiceBySpace binds the service instance to an application for a given space.
-func (actor Actor) BindServiceBySpace(appName string, serviceInstanceName string, spaceGUID string, parameters map[string]interface{}) (Warnings, error) {
+// BindServiceBySpace binds the service instance to an application for a given
+// space. An empty bindingName leaves the binding unnamed. The service broker
+// may create the binding asynchronously, in which case the returned binding
+// is in progress; see PollServiceBinding.
+func (actor Actor) BindServiceBySpace(appName string, serviceInstanceName string, spaceGUID string, bindingName string, parameters map[string]interface{}) (ServiceBinding, Warnings, error) {
 	var allWarnings Warnings
 	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
-		return allWarnings, err
+		return ServiceBinding{}, allWarnings, err
 	}
 
 	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
-		return allWarnings, err
+		return ServiceBinding{}, allWarnings, err
 	}
 
-	_, ccv2Warnings, err := actor.CloudControllerClient.CreateServiceBinding(app.GUID, serviceInstance.GUID, parameters)
+	serviceBinding, ccv2Warnings, err := actor.CloudControllerClient.CreateServiceBinding(app.GUID, serviceInstance.GUID, bindingName, true, parameters)
 	allWarnings = append(allWarnings, ccv2Warnings...)
 
-	return allWarnings, err
+	return ServiceBinding(serviceBinding), allWarnings, err
+}
+
+// PollServiceBinding polls an asynchronous service binding until the service
+// broker finishes creating it. If the broker fails to create the binding a
+// ServiceBindingFailedError is returned; if it is still in progress after the
+// overall polling timeout a ServiceBindingTimeoutError is returned.
+func (actor Actor) PollServiceBinding(serviceBinding ServiceBinding) (ServiceBinding, Warnings, error) {
+	var allWarnings Warnings
+
+	if serviceBinding.IsInProgress() {
+		poller := jobs.NewPoller(jobs.JobGetterFunc(func(guid string) (jobs.State, []string, error) {
+			ccServiceBinding, warnings, err := actor.CloudControllerClient.GetServiceBinding(guid)
+			if err != nil {
+				return jobs.State{}, warnings, err
+			}
+			serviceBinding = ServiceBinding(ccServiceBinding)
+			return jobs.State{GUID: guid, Complete: !serviceBinding.IsInProgress()}, warnings, nil
+		}), interrupt.DefaultHandler)
+
+		timeout := actor.Config.OverallPollingTimeout()
+		warnings, err := poller.PollJob(serviceBinding.GUID, actor.Config.PollingInterval(), timeout)
+		allWarnings = append(allWarnings, warnings...)
+		if _, ok := err.(ccerror.JobTimeoutError); ok {
+			return serviceBinding, allWarnings, ServiceBindingTimeoutError{
+				GUID:    serviceBinding.GUID,
+				Timeout: timeout,
+			}
+		}
+		if err != nil {
+			return ServiceBinding{}, allWarnings, err
+		}
+	}
+
+	if serviceBinding.LastOperation.State == ccv2.LastOperationFailed {
+		return serviceBinding, allWarnings, ServiceBindingFailedError{Description: serviceBinding.LastOperation.Description}
+	}
+
+	return serviceBinding, allWarnings, nil
 }
 
 // GetServiceBindingByApplicationAndServiceInstance returns a service binding
@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
@@ -15,11 +16,13 @@ var _ = Describe("Service Binding Actions", func() {
 	var (
 		actor                     *Actor
 		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
 	)
 
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
-		actor = NewActor(fakeCloudControllerClient, nil, nil)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
 	})
 
 	Describe("BindServiceByApplicationAndServiceInstance", func() {
@@ -49,9 +52,11 @@ var _ = Describe("Service Binding Actions", func() {
 				Expect(warnings).To(ConsistOf("some-warnings"))
 
 				Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(1))
-				inputAppGUID, inputServiceInstanceGUID, inputParameters := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+				inputAppGUID, inputServiceInstanceGUID, inputBindingName, inputAcceptsIncomplete, inputParameters := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
 				Expect(inputAppGUID).To(Equal(applicationGUID))
 				Expect(inputServiceInstanceGUID).To(Equal(serviceInstanceGUID))
+				Expect(inputBindingName).To(BeEmpty())
+				Expect(inputAcceptsIncomplete).To(BeFalse())
 				Expect(inputParameters).To(BeNil())
 			})
 		})
@@ -70,12 +75,13 @@ var _ = Describe("Service Binding Actions", func() {
 
 	Describe("BindServiceBySpace", func() {
 		var (
-			executeErr error
-			warnings   Warnings
+			serviceBinding ServiceBinding
+			executeErr     error
+			warnings       Warnings
 		)
 
 		JustBeforeEach(func() {
-			warnings, executeErr = actor.BindServiceBySpace("some-app-name", "some-service-instance-name", "some-space-guid", map[string]interface{}{"some-parameter": "some-value"})
+			serviceBinding, warnings, executeErr = actor.BindServiceBySpace("some-app-name", "some-service-instance-name", "some-space-guid", "some-binding-name", map[string]interface{}{"some-parameter": "some-value"})
 		})
 
 		Context("when getting the application errors", func() {
@@ -149,8 +155,9 @@ var _ = Describe("Service Binding Actions", func() {
 						)
 					})
 
-					It("returns all warnings", func() {
+					It("returns the service binding and all warnings", func() {
 						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(serviceBinding).To(Equal(ServiceBinding{GUID: "some-service-binding-guid"}))
 						Expect(warnings).To(ConsistOf("foo-1", "foo-2", "foo-3"))
 
 						Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
@@ -158,9 +165,11 @@ var _ = Describe("Service Binding Actions", func() {
 						Expect(fakeCloudControllerClient.GetSpaceServiceInstancesCallCount()).To(Equal(1))
 
 						Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(1))
-						appGUID, serviceInstanceGUID, parameters := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+						appGUID, serviceInstanceGUID, bindingName, acceptsIncomplete, parameters := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
 						Expect(appGUID).To(Equal("some-app-guid"))
 						Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+						Expect(bindingName).To(Equal("some-binding-name"))
+						Expect(acceptsIncomplete).To(BeTrue())
 						Expect(parameters).To(Equal(map[string]interface{}{"some-parameter": "some-value"}))
 					})
 				})
@@ -168,6 +177,119 @@ var _ = Describe("Service Binding Actions", func() {
 		})
 	})
 
+	Describe("PollServiceBinding", func() {
+		var (
+			serviceBinding ServiceBinding
+
+			polledBinding ServiceBinding
+			warnings      Warnings
+			executeErr    error
+		)
+
+		BeforeEach(func() {
+			fakeConfig.PollingIntervalReturns(0)
+			fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+			serviceBinding = ServiceBinding{
+				GUID:          "some-service-binding-guid",
+				LastOperation: ccv2.LastOperation{State: ccv2.LastOperationInProgress},
+			}
+		})
+
+		JustBeforeEach(func() {
+			polledBinding, warnings, executeErr = actor.PollServiceBinding(serviceBinding)
+		})
+
+		Context("when the binding is not in progress", func() {
+			BeforeEach(func() {
+				serviceBinding.LastOperation.State = ccv2.LastOperationSucceeded
+			})
+
+			It("returns the binding without polling", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(polledBinding).To(Equal(serviceBinding))
+				Expect(fakeCloudControllerClient.GetServiceBindingCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the binding eventually succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingReturnsOnCall(0,
+					ccv2.ServiceBinding{
+						GUID:          "some-service-binding-guid",
+						LastOperation: ccv2.LastOperation{State: ccv2.LastOperationInProgress},
+					},
+					ccv2.Warnings{"poll-warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServiceBindingReturnsOnCall(1,
+					ccv2.ServiceBinding{
+						GUID:          "some-service-binding-guid",
+						LastOperation: ccv2.LastOperation{State: ccv2.LastOperationSucceeded},
+					},
+					ccv2.Warnings{"poll-warning-2"},
+					nil,
+				)
+			})
+
+			It("polls until the binding is no longer in progress", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(polledBinding.IsInProgress()).To(BeFalse())
+				Expect(warnings).To(ConsistOf("poll-warning-1", "poll-warning-2"))
+
+				Expect(fakeCloudControllerClient.GetServiceBindingCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetServiceBindingArgsForCall(0)).To(Equal("some-service-binding-guid"))
+			})
+		})
+
+		Context("when the binding fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingReturns(
+					ccv2.ServiceBinding{
+						GUID: "some-service-binding-guid",
+						LastOperation: ccv2.LastOperation{
+							State:       ccv2.LastOperationFailed,
+							Description: "some-broker-error",
+						},
+					},
+					ccv2.Warnings{"poll-warning"},
+					nil,
+				)
+			})
+
+			It("returns a ServiceBindingFailedError and warnings", func() {
+				Expect(executeErr).To(MatchError(ServiceBindingFailedError{Description: "some-broker-error"}))
+				Expect(warnings).To(ConsistOf("poll-warning"))
+			})
+		})
+
+		Context("when the overall polling timeout is reached", func() {
+			BeforeEach(func() {
+				fakeConfig.OverallPollingTimeoutReturns(0)
+			})
+
+			It("returns a ServiceBindingTimeoutError and the binding still in progress", func() {
+				Expect(executeErr).To(MatchError(ServiceBindingTimeoutError{GUID: "some-service-binding-guid", Timeout: 0}))
+				Expect(polledBinding.IsInProgress()).To(BeTrue())
+				Expect(fakeCloudControllerClient.GetServiceBindingCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the binding errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingReturns(
+					ccv2.ServiceBinding{},
+					ccv2.Warnings{"poll-warning"},
+					errors.New("some-error"),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("poll-warning"))
+			})
+		})
+	})
+
 	Describe("GetServiceBindingByApplicationAndServiceInstance", func() {
 		Context("when the service binding exists", func() {
 			BeforeEach(func() {
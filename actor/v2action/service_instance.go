@@ -2,14 +2,58 @@ package v2action
 
 import (
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/jobs"
+	"code.cloudfoundry.org/cli/util/interrupt"
 )
 
 // ServiceInstance represents an instance of a service.
 type ServiceInstance ccv2.ServiceInstance
 
+// IsInProgress returns true when the service broker is still performing the
+// last operation on the service instance asynchronously.
+func (instance ServiceInstance) IsInProgress() bool {
+	return instance.LastOperation.State == ccv2.LastOperationInProgress
+}
+
+// ServiceInstanceOperationFailedError is returned when the service broker
+// fails to complete an asynchronous operation on a service instance.
+type ServiceInstanceOperationFailedError struct {
+	Name        string
+	Operation   string
+	Description string
+}
+
+func (e ServiceInstanceOperationFailedError) Error() string {
+	return fmt.Sprintf("Service instance '%s' %s failed: %s", e.Name, e.Operation, e.Description)
+}
+
+// ServiceInstanceOperationTimeoutError is returned when an asynchronous
+// operation on a service instance does not complete within the overall
+// polling timeout.
+type ServiceInstanceOperationTimeoutError struct {
+	Name      string
+	Operation string
+	Timeout   time.Duration
+}
+
+func (e ServiceInstanceOperationTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out after %s waiting for service instance '%s' %s to complete.", e.Timeout, e.Name, e.Operation)
+}
+
+// ServicePlanNotFoundError is returned when a plan cannot be found for a
+// service offering.
+type ServicePlanNotFoundError struct {
+	PlanName string
+}
+
+func (e ServicePlanNotFoundError) Error() string {
+	return fmt.Sprintf("Service plan '%s' not found.", e.PlanName)
+}
+
 type ServiceInstanceNotFoundError struct {
 	GUID string
 	Name string
@@ -50,6 +94,7 @@ func (actor Actor) GetServiceInstanceByNameAndSpace(name string, spaceGUID strin
 		}
 	}
 
+	actor.nameCache.saveServiceInstanceGUID(spaceGUID, name, serviceInstances[0].GUID)
 	return ServiceInstance(serviceInstances[0]), Warnings(warnings), nil
 }
 
@@ -93,3 +138,155 @@ func (actor Actor) GetServiceInstancesBySpace(spaceGUID string) ([]ServiceInstan
 
 	return serviceInstances, Warnings(warnings), nil
 }
+
+// CreateServiceInstance creates a service instance of the plan with the
+// provided name of the service offering with the provided label. It returns
+// the created instance and the plan it was created with. The service broker
+// may create the instance asynchronously; see PollServiceInstanceLastOperation.
+func (actor Actor) CreateServiceInstance(spaceGUID string, serviceName string, planName string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (ServiceInstance, ServicePlan, Warnings, error) {
+	plans, allWarnings, err := actor.GetServicePlansByServiceLabel(serviceName)
+	if err != nil {
+		return ServiceInstance{}, ServicePlan{}, allWarnings, err
+	}
+
+	plan, err := findServicePlanByName(plans, planName)
+	if err != nil {
+		return ServiceInstance{}, ServicePlan{}, allWarnings, err
+	}
+
+	instance, warnings, err := actor.CloudControllerClient.CreateServiceInstance(spaceGUID, plan.GUID, serviceInstanceName, parameters, tags)
+	allWarnings = append(allWarnings, warnings...)
+	return ServiceInstance(instance), plan, allWarnings, err
+}
+
+// UpdateServiceInstanceByNameAndSpace updates the plan, parameters and tags
+// of the service instance with the provided name in the provided space. An
+// empty planName, nil parameters or nil tags leave the corresponding property
+// unchanged. The service broker may update the instance asynchronously; see
+// PollServiceInstanceLastOperation.
+func (actor Actor) UpdateServiceInstanceByNameAndSpace(serviceInstanceName string, spaceGUID string, planName string, parameters map[string]interface{}, tags []string) (ServiceInstance, Warnings, error) {
+	instance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return ServiceInstance{}, allWarnings, err
+	}
+
+	var planGUID string
+	if planName != "" {
+		currentPlan, warnings, err := actor.CloudControllerClient.GetServicePlan(instance.ServicePlanGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ServiceInstance{}, allWarnings, err
+		}
+
+		ccv2Plans, warnings, err := actor.CloudControllerClient.GetServicePlans(ccv2.Query{
+			Filter:   ccv2.ServiceGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{currentPlan.ServiceGUID},
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ServiceInstance{}, allWarnings, err
+		}
+
+		plans := make([]ServicePlan, len(ccv2Plans))
+		for i, ccv2Plan := range ccv2Plans {
+			plans[i] = ServicePlan(ccv2Plan)
+		}
+
+		plan, err := findServicePlanByName(plans, planName)
+		if err != nil {
+			return ServiceInstance{}, allWarnings, err
+		}
+		planGUID = plan.GUID
+	}
+
+	updatedInstance, warnings, err := actor.CloudControllerClient.UpdateServiceInstance(instance.GUID, planGUID, parameters, tags)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstance{}, allWarnings, err
+	}
+
+	return ServiceInstance(updatedInstance), allWarnings, nil
+}
+
+// DeleteServiceInstanceByNameAndSpace deletes the service instance with the
+// provided name in the provided space. The service broker may delete the
+// instance asynchronously, in which case the returned instance is in
+// progress; see PollServiceInstanceLastOperation.
+func (actor Actor) DeleteServiceInstanceByNameAndSpace(serviceInstanceName string, spaceGUID string) (ServiceInstance, Warnings, error) {
+	instance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return ServiceInstance{}, allWarnings, err
+	}
+
+	deletedInstance, warnings, err := actor.CloudControllerClient.DeleteServiceInstance(instance.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstance{}, allWarnings, err
+	}
+	actor.nameCache.forgetServiceInstance(instance.GUID)
+
+	if deletedInstance.GUID == "" {
+		instance.LastOperation = ccv2.LastOperation{Type: "delete", State: ccv2.LastOperationSucceeded}
+		return instance, allWarnings, nil
+	}
+	return ServiceInstance(deletedInstance), allWarnings, nil
+}
+
+// PollServiceInstanceLastOperation polls the service instance every polling
+// interval until the service broker completes the last operation. A failed
+// operation returns a ServiceInstanceOperationFailedError, and an operation
+// still in progress after the overall polling timeout returns a
+// ServiceInstanceOperationTimeoutError. An instance that disappears while
+// being deleted is returned with a succeeded last operation.
+func (actor Actor) PollServiceInstanceLastOperation(instance ServiceInstance) (ServiceInstance, Warnings, error) {
+	var allWarnings Warnings
+
+	if instance.IsInProgress() {
+		poller := jobs.NewPoller(jobs.JobGetterFunc(func(guid string) (jobs.State, []string, error) {
+			ccv2Instance, warnings, err := actor.CloudControllerClient.GetServiceInstance(guid)
+			if _, ok := err.(ccerror.ResourceNotFoundError); ok && instance.LastOperation.Type == "delete" {
+				instance.LastOperation.State = ccv2.LastOperationSucceeded
+				return jobs.State{GUID: guid, Complete: true}, warnings, nil
+			}
+			if err != nil {
+				return jobs.State{}, warnings, err
+			}
+			instance = ServiceInstance(ccv2Instance)
+			return jobs.State{GUID: guid, Complete: !instance.IsInProgress()}, warnings, nil
+		}), interrupt.DefaultHandler)
+
+		timeout := actor.Config.OverallPollingTimeout()
+		warnings, err := poller.PollJob(instance.GUID, actor.Config.PollingInterval(), timeout)
+		allWarnings = append(allWarnings, warnings...)
+		if _, ok := err.(ccerror.JobTimeoutError); ok {
+			return instance, allWarnings, ServiceInstanceOperationTimeoutError{
+				Name:      instance.Name,
+				Operation: instance.LastOperation.Type,
+				Timeout:   timeout,
+			}
+		}
+		if err != nil {
+			return instance, allWarnings, err
+		}
+	}
+
+	if instance.LastOperation.State == ccv2.LastOperationFailed {
+		return instance, allWarnings, ServiceInstanceOperationFailedError{
+			Name:        instance.Name,
+			Operation:   instance.LastOperation.Type,
+			Description: instance.LastOperation.Description,
+		}
+	}
+
+	return instance, allWarnings, nil
+}
+
+func findServicePlanByName(plans []ServicePlan, planName string) (ServicePlan, error) {
+	for _, plan := range plans {
+		if plan.Name == planName {
+			return plan, nil
+		}
+	}
+	return ServicePlan{}, ServicePlanNotFoundError{PlanName: planName}
+}
@@ -0,0 +1,91 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// ServiceInstanceSummary contains a service instance along with the service
+// offering and plan it was created from and the applications bound to it.
+// User provided service instances have no service offering or plan.
+type ServiceInstanceSummary struct {
+	ServiceInstance
+	ServiceLabel          string
+	ServicePlanName       string
+	BoundApplicationNames []string
+}
+
+// GetServiceInstancesSummaryBySpace returns a summary of every service
+// instance in the space, including user provided service instances.
+func (actor Actor) GetServiceInstancesSummaryBySpace(spaceGUID string) ([]ServiceInstanceSummary, Warnings, error) {
+	serviceInstances, allWarnings, err := actor.GetServiceInstancesBySpace(spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	plans := map[string]ccv2.ServicePlan{}
+	serviceLabels := map[string]string{}
+
+	summaries := make([]ServiceInstanceSummary, 0, len(serviceInstances))
+	for _, instance := range serviceInstances {
+		summary := ServiceInstanceSummary{ServiceInstance: instance}
+
+		if instance.ServicePlanGUID != "" {
+			plan, found := plans[instance.ServicePlanGUID]
+			if !found {
+				var warnings ccv2.Warnings
+				plan, warnings, err = actor.CloudControllerClient.GetServicePlan(instance.ServicePlanGUID)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return nil, allWarnings, err
+				}
+				plans[instance.ServicePlanGUID] = plan
+			}
+			summary.ServicePlanName = plan.Name
+
+			label, found := serviceLabels[plan.ServiceGUID]
+			if !found {
+				service, warnings, err := actor.CloudControllerClient.GetService(plan.ServiceGUID)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return nil, allWarnings, err
+				}
+				label = service.Label
+				serviceLabels[plan.ServiceGUID] = label
+			}
+			summary.ServiceLabel = label
+		}
+
+		appNames, warnings, err := actor.getBoundApplicationNames(instance.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		summary.BoundApplicationNames = appNames
+
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, allWarnings, nil
+}
+
+func (actor Actor) getBoundApplicationNames(serviceInstanceGUID string) ([]string, Warnings, error) {
+	bindings, ccv2Warnings, err := actor.CloudControllerClient.GetServiceBindings(ccv2.Query{
+		Filter:   ccv2.ServiceInstanceGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Values:   []string{serviceInstanceGUID},
+	})
+	allWarnings := Warnings(ccv2Warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	appNames := make([]string, 0, len(bindings))
+	for _, binding := range bindings {
+		app, warnings, err := actor.CloudControllerClient.GetApplication(binding.AppGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		appNames = append(appNames, app.Name)
+	}
+
+	return appNames, allWarnings, nil
+}
@@ -0,0 +1,119 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Summary Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetServiceInstancesSummaryBySpace", func() {
+		var (
+			summaries  []ServiceInstanceSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{
+					{GUID: "instance-guid-1", Name: "instance-1", ServicePlanGUID: "plan-guid", Type: ccv2.ManagedService},
+					{GUID: "instance-guid-2", Name: "instance-2", ServicePlanGUID: "plan-guid", Type: ccv2.ManagedService},
+					{GUID: "instance-guid-3", Name: "instance-3", Type: ccv2.UserProvidedService},
+				},
+				ccv2.Warnings{"get-instances-warning"},
+				nil)
+			fakeCloudControllerClient.GetServicePlanReturns(
+				ccv2.ServicePlan{GUID: "plan-guid", Name: "some-plan", ServiceGUID: "service-guid"},
+				ccv2.Warnings{"get-plan-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceReturns(
+				ccv2.Service{GUID: "service-guid", Label: "some-service"},
+				ccv2.Warnings{"get-service-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceBindingsStub = func(queries ...ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
+				if queries[0].Values[0] == "instance-guid-1" {
+					return []ccv2.ServiceBinding{{AppGUID: "app-guid-1"}, {AppGUID: "app-guid-2"}}, ccv2.Warnings{"get-bindings-warning"}, nil
+				}
+				return nil, nil, nil
+			}
+			fakeCloudControllerClient.GetApplicationStub = func(guid string) (ccv2.Application, ccv2.Warnings, error) {
+				return ccv2.Application{GUID: guid, Name: "name-of-" + guid}, nil, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			summaries, warnings, executeErr = actor.GetServiceInstancesSummaryBySpace("some-space-guid")
+		})
+
+		It("returns each service instance with its service, plan and bound apps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-instances-warning", "get-plan-warning", "get-service-warning", "get-bindings-warning"))
+
+			Expect(summaries).To(HaveLen(3))
+			Expect(summaries[0].Name).To(Equal("instance-1"))
+			Expect(summaries[0].ServiceLabel).To(Equal("some-service"))
+			Expect(summaries[0].ServicePlanName).To(Equal("some-plan"))
+			Expect(summaries[0].BoundApplicationNames).To(Equal([]string{"name-of-app-guid-1", "name-of-app-guid-2"}))
+			Expect(summaries[1].ServicePlanName).To(Equal("some-plan"))
+			Expect(summaries[1].BoundApplicationNames).To(BeEmpty())
+			Expect(summaries[2].ServiceLabel).To(BeEmpty())
+			Expect(summaries[2].ServicePlanName).To(BeEmpty())
+
+			Expect(fakeCloudControllerClient.GetSpaceServiceInstancesCallCount()).To(Equal(1))
+			spaceGUID, includeUserProvided, _ := fakeCloudControllerClient.GetSpaceServiceInstancesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(includeUserProvided).To(BeTrue())
+
+			Expect(fakeCloudControllerClient.GetServiceBindingsArgsForCall(0)).To(ConsistOf(ccv2.Query{
+				Filter:   ccv2.ServiceInstanceGUIDFilter,
+				Operator: ccv2.EqualOperator,
+				Values:   []string{"instance-guid-1"},
+			}))
+		})
+
+		It("looks up each plan and service once", func() {
+			Expect(fakeCloudControllerClient.GetServicePlanCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServicePlanArgsForCall(0)).To(Equal("plan-guid"))
+			Expect(fakeCloudControllerClient.GetServiceCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceArgsForCall(0)).To(Equal("service-guid"))
+		})
+
+		Context("when getting the service plan fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlanReturns(ccv2.ServicePlan{}, ccv2.Warnings{"get-plan-warning"}, errors.New("get-plan-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get-plan-error"))
+				Expect(warnings).To(ConsistOf("get-instances-warning", "get-plan-warning"))
+			})
+		})
+
+		Context("when getting the bound apps fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingsStub = nil
+				fakeCloudControllerClient.GetServiceBindingsReturns(nil, ccv2.Warnings{"get-bindings-warning"}, errors.New("get-bindings-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get-bindings-error"))
+				Expect(warnings).To(ConsistOf("get-instances-warning", "get-plan-warning", "get-service-warning", "get-bindings-warning"))
+			})
+		})
+	})
+})
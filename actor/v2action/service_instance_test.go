@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
@@ -16,11 +17,13 @@ var _ = Describe("Service Instance Actions", func() {
 	var (
 		actor                     *Actor
 		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
 	)
 
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
-		actor = NewActor(fakeCloudControllerClient, nil, nil)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
 	})
 
 	Describe("GetServiceInstance", func() {
@@ -305,4 +308,418 @@ var _ = Describe("Service Instance Actions", func() {
 			})
 		})
 	})
+
+	Describe("CreateServiceInstance", func() {
+		var (
+			serviceInstance ServiceInstance
+			servicePlan     ServicePlan
+			warnings        Warnings
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServicesReturns(
+				[]ccv2.Service{{GUID: "some-service-guid", Label: "some-service"}},
+				ccv2.Warnings{"services-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]ccv2.ServicePlan{
+					{GUID: "other-plan-guid", Name: "other-plan"},
+					{GUID: "some-plan-guid", Name: "some-plan", Free: true},
+				},
+				ccv2.Warnings{"plans-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			serviceInstance, servicePlan, warnings, executeErr = actor.CreateServiceInstance(
+				"some-space-guid",
+				"some-service",
+				"some-plan",
+				"some-service-instance",
+				map[string]interface{}{"some-parameter": "some-value"},
+				[]string{"tag-1"},
+			)
+		})
+
+		Context("when the plan exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceInstanceReturns(
+					ccv2.ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"},
+					ccv2.Warnings{"create-warning"},
+					nil,
+				)
+			})
+
+			It("creates the service instance with the plan", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(serviceInstance).To(Equal(ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"}))
+				Expect(servicePlan).To(Equal(ServicePlan{GUID: "some-plan-guid", Name: "some-plan", Free: true}))
+				Expect(warnings).To(ConsistOf("services-warning", "plans-warning", "create-warning"))
+
+				Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(1))
+				spaceGUID, planGUID, name, parameters, tags := fakeCloudControllerClient.CreateServiceInstanceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(planGUID).To(Equal("some-plan-guid"))
+				Expect(name).To(Equal("some-service-instance"))
+				Expect(parameters).To(Equal(map[string]interface{}{"some-parameter": "some-value"}))
+				Expect(tags).To(Equal([]string{"tag-1"}))
+			})
+		})
+
+		Context("when the plan does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv2.Warnings{"plans-warning"}, nil)
+			})
+
+			It("returns a ServicePlanNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ServicePlanNotFoundError{PlanName: "some-plan"}))
+				Expect(warnings).To(ConsistOf("services-warning", "plans-warning"))
+				Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the service does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicesReturns(nil, ccv2.Warnings{"services-warning"}, nil)
+			})
+
+			It("returns a ServiceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ServiceNotFoundError{Label: "some-service"}))
+				Expect(warnings).To(ConsistOf("services-warning"))
+			})
+		})
+
+		Context("when creating the service instance errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceInstanceReturns(
+					ccv2.ServiceInstance{},
+					ccv2.Warnings{"create-warning"},
+					ccerror.ServiceInstanceNameTakenError{Message: "taken"},
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ServiceInstanceNameTakenError{Message: "taken"}))
+				Expect(warnings).To(ConsistOf("services-warning", "plans-warning", "create-warning"))
+			})
+		})
+	})
+
+	Describe("UpdateServiceInstanceByNameAndSpace", func() {
+		var (
+			planName string
+
+			serviceInstance ServiceInstance
+			warnings        Warnings
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			planName = ""
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", ServicePlanGUID: "current-plan-guid"}},
+				ccv2.Warnings{"instance-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateServiceInstanceReturns(
+				ccv2.ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"},
+				ccv2.Warnings{"update-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			serviceInstance, warnings, executeErr = actor.UpdateServiceInstanceByNameAndSpace(
+				"some-service-instance",
+				"some-space-guid",
+				planName,
+				map[string]interface{}{"some-parameter": "some-value"},
+				[]string{"tag-1"},
+			)
+		})
+
+		Context("when no plan is provided", func() {
+			It("updates the parameters and tags", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(serviceInstance).To(Equal(ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("instance-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.GetServicePlanCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateServiceInstanceCallCount()).To(Equal(1))
+				guid, planGUID, parameters, tags := fakeCloudControllerClient.UpdateServiceInstanceArgsForCall(0)
+				Expect(guid).To(Equal("some-service-instance-guid"))
+				Expect(planGUID).To(BeEmpty())
+				Expect(parameters).To(Equal(map[string]interface{}{"some-parameter": "some-value"}))
+				Expect(tags).To(Equal([]string{"tag-1"}))
+			})
+		})
+
+		Context("when a plan is provided", func() {
+			BeforeEach(func() {
+				planName = "new-plan"
+				fakeCloudControllerClient.GetServicePlanReturns(
+					ccv2.ServicePlan{GUID: "current-plan-guid", ServiceGUID: "some-service-guid"},
+					ccv2.Warnings{"plan-warning"},
+					nil,
+				)
+			})
+
+			Context("when the plan exists for the service", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServicePlansReturns(
+						[]ccv2.ServicePlan{{GUID: "new-plan-guid", Name: "new-plan"}},
+						ccv2.Warnings{"plans-warning"},
+						nil,
+					)
+				})
+
+				It("updates the instance to the plan", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("instance-warning", "plan-warning", "plans-warning", "update-warning"))
+
+					Expect(fakeCloudControllerClient.GetServicePlanArgsForCall(0)).To(Equal("current-plan-guid"))
+					Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(ccv2.Query{
+						Filter:   ccv2.ServiceGUIDFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"some-service-guid"},
+					}))
+					_, planGUID, _, _ := fakeCloudControllerClient.UpdateServiceInstanceArgsForCall(0)
+					Expect(planGUID).To(Equal("new-plan-guid"))
+				})
+			})
+
+			Context("when the plan does not exist for the service", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServicePlansReturns(nil, ccv2.Warnings{"plans-warning"}, nil)
+				})
+
+				It("returns a ServicePlanNotFoundError", func() {
+					Expect(executeErr).To(MatchError(ServicePlanNotFoundError{PlanName: "new-plan"}))
+					Expect(fakeCloudControllerClient.UpdateServiceInstanceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(nil, ccv2.Warnings{"instance-warning"}, nil)
+			})
+
+			It("returns a ServiceInstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("instance-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteServiceInstanceByNameAndSpace", func() {
+		var (
+			serviceInstance ServiceInstance
+			warnings        Warnings
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", Name: "some-service-instance"}},
+				ccv2.Warnings{"instance-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			serviceInstance, warnings, executeErr = actor.DeleteServiceInstanceByNameAndSpace("some-service-instance", "some-space-guid")
+		})
+
+		Context("when the delete is asynchronous", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceInstanceReturns(
+					ccv2.ServiceInstance{
+						GUID:          "some-service-instance-guid",
+						Name:          "some-service-instance",
+						LastOperation: ccv2.LastOperation{Type: "delete", State: ccv2.LastOperationInProgress},
+					},
+					ccv2.Warnings{"delete-warning"},
+					nil,
+				)
+			})
+
+			It("returns the instance in progress", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(serviceInstance.IsInProgress()).To(BeTrue())
+				Expect(warnings).To(ConsistOf("instance-warning", "delete-warning"))
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceArgsForCall(0)).To(Equal("some-service-instance-guid"))
+			})
+		})
+
+		Context("when the delete is synchronous", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceInstanceReturns(ccv2.ServiceInstance{}, ccv2.Warnings{"delete-warning"}, nil)
+			})
+
+			It("returns the instance with a succeeded delete operation", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(serviceInstance).To(Equal(ServiceInstance{
+					GUID:          "some-service-instance-guid",
+					Name:          "some-service-instance",
+					LastOperation: ccv2.LastOperation{Type: "delete", State: ccv2.LastOperationSucceeded},
+				}))
+			})
+		})
+
+		Context("when the delete errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceInstanceReturns(ccv2.ServiceInstance{}, ccv2.Warnings{"delete-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("instance-warning", "delete-warning"))
+			})
+		})
+	})
+
+	Describe("PollServiceInstanceLastOperation", func() {
+		var (
+			instance ServiceInstance
+
+			polledInstance ServiceInstance
+			warnings       Warnings
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			fakeConfig.PollingIntervalReturns(0)
+			fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+			instance = ServiceInstance{
+				GUID:          "some-service-instance-guid",
+				Name:          "some-service-instance",
+				LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationInProgress},
+			}
+		})
+
+		JustBeforeEach(func() {
+			polledInstance, warnings, executeErr = actor.PollServiceInstanceLastOperation(instance)
+		})
+
+		Context("when the operation is not in progress", func() {
+			BeforeEach(func() {
+				instance.LastOperation.State = ccv2.LastOperationSucceeded
+			})
+
+			It("returns without polling", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(polledInstance).To(Equal(instance))
+				Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the operation eventually succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(0,
+					ccv2.ServiceInstance{
+						GUID:          "some-service-instance-guid",
+						LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationInProgress},
+					},
+					ccv2.Warnings{"poll-warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(1,
+					ccv2.ServiceInstance{
+						GUID:          "some-service-instance-guid",
+						LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationSucceeded},
+					},
+					ccv2.Warnings{"poll-warning-2"},
+					nil,
+				)
+			})
+
+			It("polls until the operation completes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(polledInstance.LastOperation.State).To(Equal(ccv2.LastOperationSucceeded))
+				Expect(warnings).To(ConsistOf("poll-warning-1", "poll-warning-2"))
+				Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetServiceInstanceArgsForCall(0)).To(Equal("some-service-instance-guid"))
+				Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the operation fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceReturns(
+					ccv2.ServiceInstance{
+						GUID: "some-service-instance-guid",
+						Name: "some-service-instance",
+						LastOperation: ccv2.LastOperation{
+							Type:        "create",
+							State:       ccv2.LastOperationFailed,
+							Description: "some-broker-error",
+						},
+					},
+					ccv2.Warnings{"poll-warning"},
+					nil,
+				)
+			})
+
+			It("returns a ServiceInstanceOperationFailedError", func() {
+				Expect(executeErr).To(MatchError(ServiceInstanceOperationFailedError{
+					Name:        "some-service-instance",
+					Operation:   "create",
+					Description: "some-broker-error",
+				}))
+				Expect(warnings).To(ConsistOf("poll-warning"))
+			})
+		})
+
+		Context("when the instance disappears while being deleted", func() {
+			BeforeEach(func() {
+				instance.LastOperation.Type = "delete"
+				fakeCloudControllerClient.GetServiceInstanceReturns(
+					ccv2.ServiceInstance{},
+					ccv2.Warnings{"poll-warning"},
+					ccerror.ResourceNotFoundError{},
+				)
+			})
+
+			It("returns the instance with a succeeded operation", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(polledInstance.GUID).To(Equal("some-service-instance-guid"))
+				Expect(polledInstance.LastOperation).To(Equal(ccv2.LastOperation{Type: "delete", State: ccv2.LastOperationSucceeded}))
+				Expect(warnings).To(ConsistOf("poll-warning"))
+			})
+		})
+
+		Context("when getting the instance errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceReturns(
+					ccv2.ServiceInstance{},
+					ccv2.Warnings{"poll-warning"},
+					ccerror.ResourceNotFoundError{},
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{}))
+				Expect(warnings).To(ConsistOf("poll-warning"))
+			})
+		})
+
+		Context("when the overall polling timeout is reached", func() {
+			BeforeEach(func() {
+				fakeConfig.OverallPollingTimeoutReturns(0)
+			})
+
+			It("returns a ServiceInstanceOperationTimeoutError", func() {
+				Expect(executeErr).To(MatchError(ServiceInstanceOperationTimeoutError{
+					Name:      "some-service-instance",
+					Operation: "create",
+					Timeout:   0,
+				}))
+				Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
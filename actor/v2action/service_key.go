@@ -0,0 +1,142 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// ServiceKey represents a set of credentials for a service instance that is
+// not bound to an application.
+type ServiceKey ccv2.ServiceKey
+
+// ServiceKeyNameTakenError is returned when creating a service key with a
+// name that is already used by the service instance.
+type ServiceKeyNameTakenError struct {
+	Name                string
+	ServiceInstanceName string
+}
+
+func (e ServiceKeyNameTakenError) Error() string {
+	return fmt.Sprintf("Service key '%s' already exists for service instance '%s'.", e.Name, e.ServiceInstanceName)
+}
+
+// ServiceKeyNotFoundError is returned when a service key cannot be found for a
+// service instance.
+type ServiceKeyNotFoundError struct {
+	Name                string
+	ServiceInstanceName string
+}
+
+func (e ServiceKeyNotFoundError) Error() string {
+	return fmt.Sprintf("Service key '%s' not found for service instance '%s'.", e.Name, e.ServiceInstanceName)
+}
+
+// CreateServiceKey creates a service key for the service instance with the
+// provided name in the provided space. The parameters are passed to the
+// service broker.
+func (actor Actor) CreateServiceKey(serviceInstanceName string, keyName string, spaceGUID string, parameters map[string]interface{}) (ServiceKey, Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return ServiceKey{}, allWarnings, err
+	}
+
+	serviceKey, warnings, err := actor.CloudControllerClient.CreateServiceKey(serviceInstance.GUID, keyName, parameters)
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(ccerror.ServiceKeyNameTakenError); ok {
+		return ServiceKey{}, allWarnings, ServiceKeyNameTakenError{Name: keyName, ServiceInstanceName: serviceInstanceName}
+	}
+
+	return ServiceKey(serviceKey), allWarnings, err
+}
+
+// GetServiceKeysByServiceInstance returns the service keys of the service
+// instance with the provided name in the provided space.
+func (actor Actor) GetServiceKeysByServiceInstance(serviceInstanceName string, spaceGUID string) ([]ServiceKey, Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	ccv2ServiceKeys, warnings, err := actor.CloudControllerClient.GetServiceKeys(ccv2.Query{
+		Filter:   ccv2.ServiceInstanceGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Values:   []string{serviceInstance.GUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var serviceKeys []ServiceKey
+	for _, serviceKey := range ccv2ServiceKeys {
+		serviceKeys = append(serviceKeys, ServiceKey(serviceKey))
+	}
+	return serviceKeys, allWarnings, nil
+}
+
+// GetServiceKeyByNameAndServiceInstance returns the service key with the
+// provided name of the service instance with the provided name in the
+// provided space.
+func (actor Actor) GetServiceKeyByNameAndServiceInstance(keyName string, serviceInstanceName string, spaceGUID string) (ServiceKey, Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return ServiceKey{}, allWarnings, err
+	}
+
+	serviceKeys, warnings, err := actor.CloudControllerClient.GetServiceKeys(
+		ccv2.Query{
+			Filter:   ccv2.ServiceInstanceGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{serviceInstance.GUID},
+		},
+		ccv2.Query{
+			Filter:   ccv2.NameFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{keyName},
+		},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceKey{}, allWarnings, err
+	}
+
+	if len(serviceKeys) == 0 {
+		return ServiceKey{}, allWarnings, ServiceKeyNotFoundError{Name: keyName, ServiceInstanceName: serviceInstanceName}
+	}
+
+	return ServiceKey(serviceKeys[0]), allWarnings, nil
+}
+
+// GetServiceKeyCredentials returns the credentials of the service key with
+// the provided name of the service instance with the provided name in the
+// provided space.
+func (actor Actor) GetServiceKeyCredentials(keyName string, serviceInstanceName string, spaceGUID string) (map[string]interface{}, Warnings, error) {
+	serviceKey, warnings, err := actor.GetServiceKeyByNameAndServiceInstance(keyName, serviceInstanceName, spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return serviceKey.Credentials, warnings, nil
+}
+
+// DeleteServiceKeyByNameAndServiceInstance deletes the service key with the
+// provided name of the service instance with the provided name in the
+// provided space, and waits for the deletion job to finish.
+func (actor Actor) DeleteServiceKeyByNameAndServiceInstance(keyName string, serviceInstanceName string, spaceGUID string) (Warnings, error) {
+	serviceKey, allWarnings, err := actor.GetServiceKeyByNameAndServiceInstance(keyName, serviceInstanceName, spaceGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	job, warnings, err := actor.CloudControllerClient.DeleteServiceKey(serviceKey.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.PollJob(job)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
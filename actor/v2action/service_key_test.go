@@ -0,0 +1,312 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Key Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("CreateServiceKey", func() {
+		var (
+			serviceKey ServiceKey
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			serviceKey, warnings, executeErr = actor.CreateServiceKey("some-service-instance", "some-key", "some-space-guid", map[string]interface{}{"some-parameter": "some-value"})
+		})
+
+		Context("when the service instance exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid"}},
+					ccv2.Warnings{"get-instance-warning"},
+					nil)
+			})
+
+			Context("when creating the key succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreateServiceKeyReturns(
+						ccv2.ServiceKey{GUID: "some-key-guid", Name: "some-key"},
+						ccv2.Warnings{"create-key-warning"},
+						nil)
+				})
+
+				It("creates the key with the parameters and returns it and all warnings", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(serviceKey).To(Equal(ServiceKey{GUID: "some-key-guid", Name: "some-key"}))
+					Expect(warnings).To(ConsistOf("get-instance-warning", "create-key-warning"))
+
+					Expect(fakeCloudControllerClient.GetSpaceServiceInstancesCallCount()).To(Equal(1))
+					spaceGUID, includeUserProvidedServices, queries := fakeCloudControllerClient.GetSpaceServiceInstancesArgsForCall(0)
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(includeUserProvidedServices).To(BeTrue())
+					Expect(queries).To(ConsistOf(ccv2.Query{
+						Filter:   ccv2.NameFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"some-service-instance"},
+					}))
+
+					Expect(fakeCloudControllerClient.CreateServiceKeyCallCount()).To(Equal(1))
+					serviceInstanceGUID, keyName, parameters := fakeCloudControllerClient.CreateServiceKeyArgsForCall(0)
+					Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+					Expect(keyName).To(Equal("some-key"))
+					Expect(parameters).To(Equal(map[string]interface{}{"some-parameter": "some-value"}))
+				})
+			})
+
+			Context("when the key name is taken", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreateServiceKeyReturns(
+						ccv2.ServiceKey{},
+						ccv2.Warnings{"create-key-warning"},
+						ccerror.ServiceKeyNameTakenError{Message: "some-message"})
+				})
+
+				It("returns a ServiceKeyNameTakenError and all warnings", func() {
+					Expect(executeErr).To(MatchError(ServiceKeyNameTakenError{Name: "some-key", ServiceInstanceName: "some-service-instance"}))
+					Expect(warnings).To(ConsistOf("get-instance-warning", "create-key-warning"))
+				})
+			})
+
+			Context("when creating the key fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("create key error")
+					fakeCloudControllerClient.CreateServiceKeyReturns(ccv2.ServiceKey{}, ccv2.Warnings{"create-key-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-instance-warning", "create-key-warning"))
+				})
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(nil, ccv2.Warnings{"get-instance-warning"}, nil)
+			})
+
+			It("returns a ServiceInstanceNotFoundError and does not create the key", func() {
+				Expect(executeErr).To(MatchError(ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("get-instance-warning"))
+				Expect(fakeCloudControllerClient.CreateServiceKeyCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetServiceKeysByServiceInstance", func() {
+		var (
+			serviceKeys []ServiceKey
+			warnings    Warnings
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid"}},
+				ccv2.Warnings{"get-instance-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			serviceKeys, warnings, executeErr = actor.GetServiceKeysByServiceInstance("some-service-instance", "some-space-guid")
+		})
+
+		Context("when getting the keys succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeysReturns(
+					[]ccv2.ServiceKey{{Name: "key-1"}, {Name: "key-2"}},
+					ccv2.Warnings{"get-keys-warning"},
+					nil)
+			})
+
+			It("returns the keys of the service instance and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(serviceKeys).To(Equal([]ServiceKey{{Name: "key-1"}, {Name: "key-2"}}))
+				Expect(warnings).To(ConsistOf("get-instance-warning", "get-keys-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceKeysCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServiceKeysArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.ServiceInstanceGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Values:   []string{"some-service-instance-guid"},
+				}))
+			})
+		})
+
+		Context("when getting the keys fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get keys error")
+				fakeCloudControllerClient.GetServiceKeysReturns(nil, ccv2.Warnings{"get-keys-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-instance-warning", "get-keys-warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceKeyCredentials", func() {
+		var (
+			credentials map[string]interface{}
+			warnings    Warnings
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid"}},
+				ccv2.Warnings{"get-instance-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			credentials, warnings, executeErr = actor.GetServiceKeyCredentials("some-key", "some-service-instance", "some-space-guid")
+		})
+
+		Context("when the key exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeysReturns(
+					[]ccv2.ServiceKey{{Name: "some-key", Credentials: map[string]interface{}{"username": "some-username"}}},
+					ccv2.Warnings{"get-keys-warning"},
+					nil)
+			})
+
+			It("returns the credentials of the key and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(credentials).To(Equal(map[string]interface{}{"username": "some-username"}))
+				Expect(warnings).To(ConsistOf("get-instance-warning", "get-keys-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceKeysCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServiceKeysArgsForCall(0)).To(ConsistOf(
+					ccv2.Query{
+						Filter:   ccv2.ServiceInstanceGUIDFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"some-service-instance-guid"},
+					},
+					ccv2.Query{
+						Filter:   ccv2.NameFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"some-key"},
+					},
+				))
+			})
+		})
+
+		Context("when the key does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeysReturns(nil, ccv2.Warnings{"get-keys-warning"}, nil)
+			})
+
+			It("returns a ServiceKeyNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("get-instance-warning", "get-keys-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteServiceKeyByNameAndServiceInstance", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid"}},
+				ccv2.Warnings{"get-instance-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceKeysReturns(
+				[]ccv2.ServiceKey{{GUID: "some-key-guid", Name: "some-key"}},
+				ccv2.Warnings{"get-keys-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteServiceKeyByNameAndServiceInstance("some-key", "some-service-instance", "some-space-guid")
+		})
+
+		Context("when the deletion job succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceKeyReturns(ccv2.Job{GUID: "some-job-guid"}, ccv2.Warnings{"delete-key-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-job-warning"}, nil)
+			})
+
+			It("deletes the key, polls the job and returns all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-instance-warning", "get-keys-warning", "delete-key-warning", "poll-job-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteServiceKeyCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteServiceKeyArgsForCall(0)).To(Equal("some-key-guid"))
+
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv2.Job{GUID: "some-job-guid"}))
+			})
+		})
+
+		Context("when deleting the key fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete key error")
+				fakeCloudControllerClient.DeleteServiceKeyReturns(ccv2.Job{}, ccv2.Warnings{"delete-key-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings without polling", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-instance-warning", "get-keys-warning", "delete-key-warning"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the deletion job fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = ccerror.JobFailedError{JobGUID: "some-job-guid", Message: "some-message"}
+				fakeCloudControllerClient.DeleteServiceKeyReturns(ccv2.Job{GUID: "some-job-guid"}, ccv2.Warnings{"delete-key-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-job-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-instance-warning", "get-keys-warning", "delete-key-warning", "poll-job-warning"))
+			})
+		})
+
+		Context("when the key does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeysReturns(nil, ccv2.Warnings{"get-keys-warning"}, nil)
+			})
+
+			It("returns a ServiceKeyNotFoundError and does not delete anything", func() {
+				Expect(executeErr).To(MatchError(ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"}))
+				Expect(fakeCloudControllerClient.DeleteServiceKeyCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
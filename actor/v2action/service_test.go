@@ -0,0 +1,209 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetServicePlansByServiceLabel", func() {
+		var (
+			servicePlans []ServicePlan
+			warnings     Warnings
+			executeErr   error
+		)
+
+		JustBeforeEach(func() {
+			servicePlans, warnings, executeErr = actor.GetServicePlansByServiceLabel("some-service")
+		})
+
+		Context("when the service exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicesReturns(
+					[]ccv2.Service{{GUID: "some-service-guid", Label: "some-service"}},
+					ccv2.Warnings{"get-services-warning"},
+					nil)
+			})
+
+			Context("when getting the plans succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServicePlansReturns(
+						[]ccv2.ServicePlan{
+							{
+								GUID: "some-plan-guid",
+								Name: "some-plan",
+								Schemas: ccv2.ServicePlanSchemas{
+									ServiceInstanceCreate: map[string]interface{}{"type": "object"},
+								},
+							},
+						},
+						ccv2.Warnings{"get-service-plans-warning"},
+						nil)
+				})
+
+				It("returns the plans with their schemas and all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-services-warning", "get-service-plans-warning"))
+					Expect(servicePlans).To(Equal([]ServicePlan{
+						{
+							GUID: "some-plan-guid",
+							Name: "some-plan",
+							Schemas: ccv2.ServicePlanSchemas{
+								ServiceInstanceCreate: map[string]interface{}{"type": "object"},
+							},
+						},
+					}))
+
+					Expect(fakeCloudControllerClient.GetServicesCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetServicesArgsForCall(0)).To(ConsistOf(ccv2.Query{
+						Filter:   ccv2.LabelFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"some-service"},
+					}))
+
+					Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(ccv2.Query{
+						Filter:   ccv2.ServiceGUIDFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"some-service-guid"},
+					}))
+				})
+			})
+
+			Context("when getting the plans fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get-service-plans-error")
+					fakeCloudControllerClient.GetServicePlansReturns(
+						nil,
+						ccv2.Warnings{"get-service-plans-warning"},
+						expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-services-warning", "get-service-plans-warning"))
+				})
+			})
+		})
+
+		Context("when the service does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicesReturns(
+					[]ccv2.Service{},
+					ccv2.Warnings{"get-services-warning"},
+					nil)
+			})
+
+			It("returns a ServiceNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ServiceNotFoundError{Label: "some-service"}))
+				Expect(warnings).To(ConsistOf("get-services-warning"))
+				Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the services fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-services-error")
+				fakeCloudControllerClient.GetServicesReturns(
+					nil,
+					ccv2.Warnings{"get-services-warning"},
+					expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-services-warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceByLabelAndProvider", func() {
+		Context("when the service exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicesReturns(
+					[]ccv2.Service{{GUID: "some-service-guid", Label: "some-service"}},
+					ccv2.Warnings{"get-services-warning"},
+					nil)
+			})
+
+			It("filters by label and returns the service", func() {
+				service, warnings, err := actor.GetServiceByLabelAndProvider("some-service", "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(service).To(Equal(Service{GUID: "some-service-guid", Label: "some-service"}))
+				Expect(warnings).To(ConsistOf("get-services-warning"))
+				Expect(fakeCloudControllerClient.GetServicesArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.LabelFilter,
+					Operator: ccv2.EqualOperator,
+					Values:   []string{"some-service"},
+				}))
+			})
+
+			It("also filters by provider when one is given", func() {
+				_, _, err := actor.GetServiceByLabelAndProvider("some-service", "some-provider")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetServicesArgsForCall(0)).To(ConsistOf(
+					ccv2.Query{
+						Filter:   ccv2.LabelFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"some-service"},
+					},
+					ccv2.Query{
+						Filter:   ccv2.ProviderFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"some-provider"},
+					},
+				))
+			})
+		})
+
+		Context("when the service does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicesReturns(nil, ccv2.Warnings{"get-services-warning"}, nil)
+			})
+
+			It("returns a ServiceNotFoundError and warnings", func() {
+				_, warnings, err := actor.GetServiceByLabelAndProvider("some-service", "")
+				Expect(err).To(MatchError(ServiceNotFoundError{Label: "some-service"}))
+				Expect(warnings).To(ConsistOf("get-services-warning"))
+			})
+		})
+	})
+
+	Describe("StartPurgeService", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.PurgeServiceReturns(
+				ccv2.Job{GUID: "some-job-guid", Status: ccv2.JobStatusQueued},
+				ccv2.Warnings{"purge-warning"},
+				nil)
+		})
+
+		It("purges the service and returns the job without polling it", func() {
+			job, warnings, err := actor.StartPurgeService("some-service-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(job).To(Equal(Job{GUID: "some-job-guid", Status: ccv2.JobStatusQueued}))
+			Expect(warnings).To(ConsistOf("purge-warning"))
+			Expect(fakeCloudControllerClient.PurgeServiceArgsForCall(0)).To(Equal("some-service-guid"))
+			Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+		})
+	})
+})
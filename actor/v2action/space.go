@@ -39,6 +39,16 @@ func (e MultipleSpacesFoundError) Error() string {
 	return fmt.Sprintf("Multiple spaces found matching organization GUID '%s' and name '%s'", e.OrgGUID, e.Name)
 }
 
+// SpaceNameTakenError is returned when a space with the provided name already
+// exists in the organization.
+type SpaceNameTakenError struct {
+	Name string
+}
+
+func (e SpaceNameTakenError) Error() string {
+	return fmt.Sprintf("Space '%s' already exists.", e.Name)
+}
+
 func (actor Actor) DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (Warnings, error) {
 	var allWarnings Warnings
 
@@ -54,14 +64,8 @@ func (actor Actor) DeleteSpaceByNameAndOrganizationName(spaceName string, orgNam
 		return allWarnings, err
 	}
 
-	job, deleteWarnings, err := actor.CloudControllerClient.DeleteSpace(space.GUID)
-	allWarnings = append(allWarnings, Warnings(deleteWarnings)...)
-	if err != nil {
-		return allWarnings, err
-	}
-
-	warnings, err = actor.PollJob(Job(job))
-	allWarnings = append(allWarnings, Warnings(warnings)...)
+	warnings, err = actor.deleteSpace(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
 
 	return allWarnings, err
 }
@@ -86,7 +90,12 @@ func (actor Actor) GetOrganizationSpaces(orgGUID string) ([]Space, Warnings, err
 }
 
 // GetSpaceByOrganizationAndName returns an Space based on the org and name.
+// Found spaces are cached for the lifetime of the actor.
 func (actor Actor) GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (Space, Warnings, error) {
+	if space, found := actor.nameCache.loadSpace(orgGUID, spaceName); found {
+		return space, nil, nil
+	}
+
 	ccv2Spaces, warnings, err := actor.CloudControllerClient.GetSpaces(
 		ccv2.Query{
 			Filter:   ccv2.NameFilter,
@@ -111,5 +120,38 @@ func (actor Actor) GetSpaceByOrganizationAndName(orgGUID string, spaceName strin
 		return Space{}, Warnings(warnings), MultipleSpacesFoundError{OrgGUID: orgGUID, Name: spaceName}
 	}
 
-	return Space(ccv2Spaces[0]), Warnings(warnings), nil
+	space := Space(ccv2Spaces[0])
+	actor.nameCache.saveSpace(orgGUID, spaceName, space)
+	return space, Warnings(warnings), nil
+}
+
+// GetOrganizationAndSpaceByName validates that the named space exists in the
+// named organization, returning both.
+func (actor Actor) GetOrganizationAndSpaceByName(orgName string, spaceName string) (Organization, Space, Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return Organization{}, Space{}, allWarnings, err
+	}
+
+	space, warnings, err := actor.GetSpaceByOrganizationAndName(org.GUID, spaceName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Organization{}, Space{}, allWarnings, err
+	}
+
+	return org, space, allWarnings, nil
+}
+
+func (actor Actor) deleteSpace(spaceGUID string) (Warnings, error) {
+	job, deleteWarnings, err := actor.CloudControllerClient.DeleteSpace(spaceGUID)
+	allWarnings := Warnings(deleteWarnings)
+	if err != nil {
+		return allWarnings, err
+	}
+	actor.nameCache.forgetSpace(spaceGUID)
+
+	warnings, err := actor.PollJob(Job(job))
+	allWarnings = append(allWarnings, warnings...)
+
+	return allWarnings, err
 }
@@ -11,12 +11,45 @@ type SpaceQuota ccv2.SpaceQuota
 
 type SpaceQuotaNotFoundError struct {
 	GUID string
+	Name string
 }
 
 func (e SpaceQuotaNotFoundError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("Space quota '%s' not found.", e.Name)
+	}
 	return fmt.Sprintf("Space quota with GUID '%s' not found.", e.GUID)
 }
 
+// SpaceQuotaNameTakenError is returned when a space quota with the provided
+// name already exists in the organization.
+type SpaceQuotaNameTakenError struct {
+	Name string
+}
+
+func (e SpaceQuotaNameTakenError) Error() string {
+	return fmt.Sprintf("Space quota '%s' already exists.", e.Name)
+}
+
+// CreateSpaceQuota creates a space quota with the provided limits in the
+// provided organization. Unset limits default as they do in CreateQuota.
+func (actor Actor) CreateSpaceQuota(orgGUID string, quota SpaceQuota) (SpaceQuota, Warnings, error) {
+	quota.OrganizationGUID = orgGUID
+	quota.NonBasicServicesAllowed = defaultNullBool(quota.NonBasicServicesAllowed, false)
+	quota.TotalServices = defaultNullInt(quota.TotalServices, 0)
+	quota.TotalRoutes = defaultNullInt(quota.TotalRoutes, 0)
+	quota.MemoryLimit = defaultNullInt(quota.MemoryLimit, 0)
+	quota.InstanceMemoryLimit = defaultNullInt(quota.InstanceMemoryLimit, -1)
+	quota.AppInstanceLimit = defaultNullInt(quota.AppInstanceLimit, -1)
+
+	createdQuota, warnings, err := actor.CloudControllerClient.CreateSpaceQuota(ccv2.SpaceQuota(quota))
+	if _, ok := err.(ccerror.QuotaNameTakenError); ok {
+		return SpaceQuota{}, Warnings(warnings), SpaceQuotaNameTakenError{Name: quota.Name}
+	}
+
+	return SpaceQuota(createdQuota), Warnings(warnings), err
+}
+
 func (actor Actor) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 	spaceQuota, warnings, err := actor.CloudControllerClient.GetSpaceQuota(guid)
 
@@ -26,3 +59,55 @@ func (actor Actor) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 
 	return SpaceQuota(spaceQuota), Warnings(warnings), err
 }
+
+// GetSpaceQuotaByName returns the space quota with the provided name in the
+// provided organization.
+func (actor Actor) GetSpaceQuotaByName(orgGUID string, name string) (SpaceQuota, Warnings, error) {
+	spaceQuotas, warnings, err := actor.CloudControllerClient.GetSpaceQuotas(orgGUID)
+	if err != nil {
+		return SpaceQuota{}, Warnings(warnings), err
+	}
+
+	for _, spaceQuota := range spaceQuotas {
+		if spaceQuota.Name == name {
+			return SpaceQuota(spaceQuota), Warnings(warnings), nil
+		}
+	}
+
+	return SpaceQuota{}, Warnings(warnings), SpaceQuotaNotFoundError{Name: name}
+}
+
+// GetSpaceQuotas returns the space quotas defined in the provided
+// organization.
+func (actor Actor) GetSpaceQuotas(orgGUID string) ([]SpaceQuota, Warnings, error) {
+	ccSpaceQuotas, warnings, err := actor.CloudControllerClient.GetSpaceQuotas(orgGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var spaceQuotas []SpaceQuota
+	for _, spaceQuota := range ccSpaceQuotas {
+		spaceQuotas = append(spaceQuotas, SpaceQuota(spaceQuota))
+	}
+
+	return spaceQuotas, Warnings(warnings), nil
+}
+
+// UpdateSpaceQuota updates the space quota with the provided name in the
+// provided organization. Only the set limits of the provided quota are
+// changed, and the quota is renamed when the provided quota has a name.
+func (actor Actor) UpdateSpaceQuota(orgGUID string, name string, quota SpaceQuota) (SpaceQuota, Warnings, error) {
+	existingQuota, allWarnings, err := actor.GetSpaceQuotaByName(orgGUID, name)
+	if err != nil {
+		return SpaceQuota{}, allWarnings, err
+	}
+
+	quota.GUID = existingQuota.GUID
+	updatedQuota, warnings, err := actor.CloudControllerClient.UpdateSpaceQuota(ccv2.SpaceQuota(quota))
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(ccerror.QuotaNameTakenError); ok {
+		return SpaceQuota{}, allWarnings, SpaceQuotaNameTakenError{Name: quota.Name}
+	}
+
+	return SpaceQuota(updatedQuota), allWarnings, err
+}
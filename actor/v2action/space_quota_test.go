@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -76,4 +77,196 @@ var _ = Describe("SpaceQuota Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSpaceQuotaByName", func() {
+		Context("when the space quota exists in the organization", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceQuotasReturns(
+					[]ccv2.SpaceQuota{
+						{GUID: "other-space-quota-guid", Name: "other-space-quota"},
+						{GUID: "some-space-quota-guid", Name: "some-space-quota"},
+					},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the space quota and warnings", func() {
+				spaceQuota, warnings, err := actor.GetSpaceQuotaByName("some-org-guid", "some-space-quota")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(spaceQuota).To(Equal(SpaceQuota{
+					GUID: "some-space-quota-guid",
+					Name: "some-space-quota",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.GetSpaceQuotasCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpaceQuotasArgsForCall(0)).To(Equal("some-org-guid"))
+			})
+		})
+
+		Context("when the space quota does not exist in the organization", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceQuotasReturns(nil, ccv2.Warnings{"warning-1"}, nil)
+			})
+
+			It("returns a SpaceQuotaNotFoundError and warnings", func() {
+				_, warnings, err := actor.GetSpaceQuotaByName("some-org-guid", "some-space-quota")
+				Expect(err).To(MatchError(SpaceQuotaNotFoundError{Name: "some-space-quota"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when getting the space quotas fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some space quotas error")
+				fakeCloudControllerClient.GetSpaceQuotasReturns(nil, ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetSpaceQuotaByName("some-org-guid", "some-space-quota")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("CreateSpaceQuota", func() {
+		Context("when the cloud controller client succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateSpaceQuotaReturns(
+					ccv2.SpaceQuota{GUID: "some-space-quota-guid", Name: "some-space-quota"},
+					ccv2.Warnings{"create-warning"},
+					nil,
+				)
+			})
+
+			It("creates the quota in the organization with defaults for the unset limits", func() {
+				spaceQuota, warnings, err := actor.CreateSpaceQuota("some-org-guid", SpaceQuota{
+					Name:                    "some-space-quota",
+					NonBasicServicesAllowed: types.NullBool{IsSet: true, Value: true},
+					MemoryLimit:             types.NullInt{IsSet: true, Value: 1024},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(spaceQuota).To(Equal(SpaceQuota{GUID: "some-space-quota-guid", Name: "some-space-quota"}))
+
+				Expect(fakeCloudControllerClient.CreateSpaceQuotaCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CreateSpaceQuotaArgsForCall(0)).To(Equal(ccv2.SpaceQuota{
+					Name:                    "some-space-quota",
+					OrganizationGUID:        "some-org-guid",
+					NonBasicServicesAllowed: types.NullBool{IsSet: true, Value: true},
+					TotalServices:           types.NullInt{IsSet: true, Value: 0},
+					TotalRoutes:             types.NullInt{IsSet: true, Value: 0},
+					MemoryLimit:             types.NullInt{IsSet: true, Value: 1024},
+					InstanceMemoryLimit:     types.NullInt{IsSet: true, Value: -1},
+					AppInstanceLimit:        types.NullInt{IsSet: true, Value: -1},
+				}))
+			})
+		})
+
+		Context("when the quota name is taken", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateSpaceQuotaReturns(ccv2.SpaceQuota{}, ccv2.Warnings{"create-warning"}, ccerror.QuotaNameTakenError{})
+			})
+
+			It("returns a SpaceQuotaNameTakenError and warnings", func() {
+				_, warnings, err := actor.CreateSpaceQuota("some-org-guid", SpaceQuota{Name: "some-space-quota"})
+				Expect(err).To(MatchError(SpaceQuotaNameTakenError{Name: "some-space-quota"}))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+
+	Describe("GetSpaceQuotas", func() {
+		Context("when the cloud controller client succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceQuotasReturns(
+					[]ccv2.SpaceQuota{{GUID: "quota-guid-1", Name: "quota-1"}},
+					ccv2.Warnings{"get-warning"},
+					nil,
+				)
+			})
+
+			It("returns the space quotas in the organization and warnings", func() {
+				spaceQuotas, warnings, err := actor.GetSpaceQuotas("some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(spaceQuotas).To(ConsistOf(SpaceQuota{GUID: "quota-guid-1", Name: "quota-1"}))
+				Expect(fakeCloudControllerClient.GetSpaceQuotasArgsForCall(0)).To(Equal("some-org-guid"))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get space quotas error")
+				fakeCloudControllerClient.GetSpaceQuotasReturns(nil, ccv2.Warnings{"get-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetSpaceQuotas("some-org-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceQuota", func() {
+		Context("when the quota exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceQuotasReturns(
+					[]ccv2.SpaceQuota{{GUID: "some-space-quota-guid", Name: "some-space-quota"}},
+					ccv2.Warnings{"get-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateSpaceQuotaReturns(
+					ccv2.SpaceQuota{GUID: "some-space-quota-guid", Name: "some-space-quota"},
+					ccv2.Warnings{"update-warning"},
+					nil,
+				)
+			})
+
+			It("updates only the provided limits", func() {
+				_, warnings, err := actor.UpdateSpaceQuota("some-org-guid", "some-space-quota", SpaceQuota{
+					InstanceMemoryLimit: types.NullInt{IsSet: true, Value: -1},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateSpaceQuotaCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateSpaceQuotaArgsForCall(0)).To(Equal(ccv2.SpaceQuota{
+					GUID:                "some-space-quota-guid",
+					InstanceMemoryLimit: types.NullInt{IsSet: true, Value: -1},
+				}))
+			})
+
+			Context("when the new name is taken", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateSpaceQuotaReturns(ccv2.SpaceQuota{}, nil, ccerror.QuotaNameTakenError{})
+				})
+
+				It("returns a SpaceQuotaNameTakenError", func() {
+					_, _, err := actor.UpdateSpaceQuota("some-org-guid", "some-space-quota", SpaceQuota{Name: "new-name"})
+					Expect(err).To(MatchError(SpaceQuotaNameTakenError{Name: "new-name"}))
+				})
+			})
+		})
+
+		Context("when the quota does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceQuotasReturns(nil, ccv2.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns a SpaceQuotaNotFoundError", func() {
+				_, warnings, err := actor.UpdateSpaceQuota("some-org-guid", "some-space-quota", SpaceQuota{})
+				Expect(err).To(MatchError(SpaceQuotaNotFoundError{Name: "some-space-quota"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.UpdateSpaceQuotaCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
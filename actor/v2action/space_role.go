@@ -0,0 +1,99 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// UserNotFoundError is returned when UAA has no user with the provided
+// username (and origin, if one was provided).
+type UserNotFoundError struct {
+	Username string
+	Origin   string
+}
+
+func (e UserNotFoundError) Error() string {
+	return fmt.Sprintf("User '%s' not found.", e.Username)
+}
+
+// MultipleUAAUsersFoundError is returned when more than one UAA user matches
+// the provided username, which happens when users with the same name exist in
+// different identity providers.
+type MultipleUAAUsersFoundError struct {
+	Username string
+	Origins  []string
+}
+
+func (e MultipleUAAUsersFoundError) Error() string {
+	return fmt.Sprintf("Multiple users named '%s' found.", e.Username)
+}
+
+// SetSpaceRole grants the provided role in the space to the user or client.
+// The user is first made a member of the space's organization; a lack of
+// permission to do so is ignored because a space manager can still grant
+// roles to existing organization members. When isClient is true, username is
+// treated as a client ID and used as the user GUID without looking it up in
+// UAA.
+func (actor Actor) SetSpaceRole(orgGUID string, spaceGUID string, username string, origin string, isClient bool, role SpaceRole) (Warnings, error) {
+	userGUID, err := actor.getUserGUIDForSpaceRole(username, origin, isClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var allWarnings Warnings
+	warnings, err := actor.CloudControllerClient.UpdateOrganizationUser(orgGUID, userGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if _, isForbidden := err.(ccerror.ForbiddenError); err != nil && !isForbidden {
+		return allWarnings, err
+	}
+
+	var updateRole func(string, string) (ccv2.Warnings, error)
+	switch role {
+	case SpaceRoleAuditor:
+		updateRole = actor.CloudControllerClient.UpdateSpaceAuditor
+	case SpaceRoleDeveloper:
+		updateRole = actor.CloudControllerClient.UpdateSpaceDeveloper
+	case SpaceRoleManager:
+		updateRole = actor.CloudControllerClient.UpdateSpaceManager
+	default:
+		return allWarnings, fmt.Errorf("unknown space role %s", role)
+	}
+
+	warnings, err = updateRole(spaceGUID, userGUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// UnsetSpaceRole revokes the provided role in the space from the user or
+// client. When isClient is true, username is treated as a client ID.
+func (actor Actor) UnsetSpaceRole(spaceGUID string, username string, origin string, isClient bool, role SpaceRole) (Warnings, error) {
+	userGUID, err := actor.getUserGUIDForSpaceRole(username, origin, isClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleteRole func(string, string) (ccv2.Warnings, error)
+	switch role {
+	case SpaceRoleAuditor:
+		deleteRole = actor.CloudControllerClient.DeleteSpaceAuditor
+	case SpaceRoleDeveloper:
+		deleteRole = actor.CloudControllerClient.DeleteSpaceDeveloper
+	case SpaceRoleManager:
+		deleteRole = actor.CloudControllerClient.DeleteSpaceManager
+	default:
+		return nil, fmt.Errorf("unknown space role %s", role)
+	}
+
+	warnings, err := deleteRole(spaceGUID, userGUID)
+	return Warnings(warnings), err
+}
+
+func (actor Actor) getUserGUIDForSpaceRole(username string, origin string, isClient bool) (string, error) {
+	if isClient {
+		return username, nil
+	}
+
+	return actor.getUAAUserGUID(username, origin)
+}
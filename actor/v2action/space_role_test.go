@@ -0,0 +1,288 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Role Actions", func() {
+	var (
+		actor                     *Actor
+		fakeUAAClient             *v2actionfakes.FakeUAAClient
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeUAAClient = new(v2actionfakes.FakeUAAClient)
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, fakeUAAClient, nil)
+	})
+
+	Describe("SetSpaceRole", func() {
+		var (
+			isClient bool
+			role     SpaceRole
+
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			isClient = false
+			role = SpaceRoleDeveloper
+
+			fakeUAAClient.ListUsersReturns([]uaa.User{{ID: "some-user-guid", Origin: "some-origin"}}, nil)
+			fakeCloudControllerClient.UpdateOrganizationUserReturns(ccv2.Warnings{"org-user-warning"}, nil)
+			fakeCloudControllerClient.UpdateSpaceAuditorReturns(ccv2.Warnings{"role-warning"}, nil)
+			fakeCloudControllerClient.UpdateSpaceDeveloperReturns(ccv2.Warnings{"role-warning"}, nil)
+			fakeCloudControllerClient.UpdateSpaceManagerReturns(ccv2.Warnings{"role-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.SetSpaceRole("some-org-guid", "some-space-guid", "some-user", "some-origin", isClient, role)
+		})
+
+		Context("when the user is found in UAA", func() {
+			It("adds the user to the org, grants the role and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("org-user-warning", "role-warning"))
+
+				Expect(fakeUAAClient.ListUsersCallCount()).To(Equal(1))
+				username, origin := fakeUAAClient.ListUsersArgsForCall(0)
+				Expect(username).To(Equal("some-user"))
+				Expect(origin).To(Equal("some-origin"))
+
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserCallCount()).To(Equal(1))
+				orgGUID, userGUID := fakeCloudControllerClient.UpdateOrganizationUserArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(userGUID).To(Equal("some-user-guid"))
+
+				Expect(fakeCloudControllerClient.UpdateSpaceDeveloperCallCount()).To(Equal(1))
+				spaceGUID, userGUID := fakeCloudControllerClient.UpdateSpaceDeveloperArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(userGUID).To(Equal("some-user-guid"))
+			})
+		})
+
+		Context("when the role is SpaceAuditor", func() {
+			BeforeEach(func() {
+				role = SpaceRoleAuditor
+			})
+
+			It("grants the auditor role", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.UpdateSpaceAuditorCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateSpaceDeveloperCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the role is SpaceManager", func() {
+			BeforeEach(func() {
+				role = SpaceRoleManager
+			})
+
+			It("grants the manager role", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.UpdateSpaceManagerCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateSpaceDeveloperCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the subject is a client", func() {
+			BeforeEach(func() {
+				isClient = true
+			})
+
+			It("uses the client ID as the user GUID without querying UAA", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeUAAClient.ListUsersCallCount()).To(Equal(0))
+
+				_, userGUID := fakeCloudControllerClient.UpdateSpaceDeveloperArgsForCall(0)
+				Expect(userGUID).To(Equal("some-user"))
+			})
+		})
+
+		Context("when the user is not found in UAA", func() {
+			BeforeEach(func() {
+				fakeUAAClient.ListUsersReturns(nil, nil)
+			})
+
+			It("returns a UserNotFoundError", func() {
+				Expect(executeErr).To(MatchError(UserNotFoundError{Username: "some-user", Origin: "some-origin"}))
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when multiple users are found in UAA", func() {
+			BeforeEach(func() {
+				fakeUAAClient.ListUsersReturns([]uaa.User{
+					{ID: "some-user-guid-1", Origin: "uaa"},
+					{ID: "some-user-guid-2", Origin: "ldap"},
+				}, nil)
+			})
+
+			It("returns a MultipleUAAUsersFoundError", func() {
+				Expect(executeErr).To(MatchError(MultipleUAAUsersFoundError{Username: "some-user", Origins: []string{"uaa", "ldap"}}))
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when listing UAA users returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("list users error")
+				fakeUAAClient.ListUsersReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when adding the user to the org is forbidden", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateOrganizationUserReturns(ccv2.Warnings{"org-user-warning"}, ccerror.ForbiddenError{})
+			})
+
+			It("ignores the error and grants the role", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("org-user-warning", "role-warning"))
+				Expect(fakeCloudControllerClient.UpdateSpaceDeveloperCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when adding the user to the org fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("org user error")
+				fakeCloudControllerClient.UpdateOrganizationUserReturns(ccv2.Warnings{"org-user-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("org-user-warning"))
+				Expect(fakeCloudControllerClient.UpdateSpaceDeveloperCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when granting the role fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("role error")
+				fakeCloudControllerClient.UpdateSpaceDeveloperReturns(ccv2.Warnings{"role-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("org-user-warning", "role-warning"))
+			})
+		})
+	})
+
+	Describe("UnsetSpaceRole", func() {
+		var (
+			isClient bool
+			role     SpaceRole
+
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			isClient = false
+			role = SpaceRoleDeveloper
+
+			fakeUAAClient.ListUsersReturns([]uaa.User{{ID: "some-user-guid", Origin: "uaa"}}, nil)
+			fakeCloudControllerClient.DeleteSpaceAuditorReturns(ccv2.Warnings{"role-warning"}, nil)
+			fakeCloudControllerClient.DeleteSpaceDeveloperReturns(ccv2.Warnings{"role-warning"}, nil)
+			fakeCloudControllerClient.DeleteSpaceManagerReturns(ccv2.Warnings{"role-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UnsetSpaceRole("some-space-guid", "some-user", "", isClient, role)
+		})
+
+		Context("when the user is found in UAA", func() {
+			It("revokes the role and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("role-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteSpaceDeveloperCallCount()).To(Equal(1))
+				spaceGUID, userGUID := fakeCloudControllerClient.DeleteSpaceDeveloperArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(userGUID).To(Equal("some-user-guid"))
+			})
+		})
+
+		Context("when the role is SpaceAuditor", func() {
+			BeforeEach(func() {
+				role = SpaceRoleAuditor
+			})
+
+			It("revokes the auditor role", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.DeleteSpaceAuditorCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the role is SpaceManager", func() {
+			BeforeEach(func() {
+				role = SpaceRoleManager
+			})
+
+			It("revokes the manager role", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.DeleteSpaceManagerCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the subject is a client", func() {
+			BeforeEach(func() {
+				isClient = true
+			})
+
+			It("uses the client ID as the user GUID without querying UAA", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeUAAClient.ListUsersCallCount()).To(Equal(0))
+
+				_, userGUID := fakeCloudControllerClient.DeleteSpaceDeveloperArgsForCall(0)
+				Expect(userGUID).To(Equal("some-user"))
+			})
+		})
+
+		Context("when the user is not found in UAA", func() {
+			BeforeEach(func() {
+				fakeUAAClient.ListUsersReturns(nil, nil)
+			})
+
+			It("returns a UserNotFoundError", func() {
+				Expect(executeErr).To(MatchError(UserNotFoundError{Username: "some-user"}))
+				Expect(fakeCloudControllerClient.DeleteSpaceDeveloperCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when revoking the role fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("role error")
+				fakeCloudControllerClient.DeleteSpaceDeveloperReturns(ccv2.Warnings{"role-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("role-warning"))
+			})
+		})
+	})
+})
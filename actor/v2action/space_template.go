@@ -0,0 +1,248 @@
+package v2action
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	log "github.com/sirupsen/logrus"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// SpaceRole is a role a user can be granted in a space.
+type SpaceRole string
+
+const (
+	SpaceRoleAuditor   SpaceRole = "SpaceAuditor"
+	SpaceRoleDeveloper SpaceRole = "SpaceDeveloper"
+	SpaceRoleManager   SpaceRole = "SpaceManager"
+)
+
+// SpaceTemplateLifecycleBoth binds a template security group for both the
+// running and staging lifecycles.
+const SpaceTemplateLifecycleBoth = "both"
+
+// SpaceTemplate describes the configuration applied to a space created from
+// a template file.
+type SpaceTemplate struct {
+	Quota            string                       `yaml:"quota"`
+	IsolationSegment string                       `yaml:"isolation_segment"`
+	SecurityGroups   []SpaceTemplateSecurityGroup `yaml:"security_groups"`
+	Roles            []SpaceTemplateRole          `yaml:"roles"`
+
+	// EnvironmentVariableGroups is only read so that templates declaring it
+	// are rejected; environment variable groups are platform wide in the v2
+	// API and cannot be applied to a single space.
+	EnvironmentVariableGroups interface{} `yaml:"environment_variable_groups"`
+}
+
+// SpaceTemplateSecurityGroup is a security group binding declared in a space
+// template. Lifecycle is running, staging or both, and defaults to running.
+type SpaceTemplateSecurityGroup struct {
+	Name      string `yaml:"name"`
+	Lifecycle string `yaml:"lifecycle"`
+}
+
+// Lifecycles returns the lifecycles the security group is bound for.
+func (securityGroup SpaceTemplateSecurityGroup) Lifecycles() []ccv2.SecurityGroupLifecycle {
+	switch securityGroup.Lifecycle {
+	case "":
+		return []ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleRunning}
+	case SpaceTemplateLifecycleBoth:
+		return []ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleRunning, ccv2.SecurityGroupLifecycleStaging}
+	default:
+		return []ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycle(securityGroup.Lifecycle)}
+	}
+}
+
+// SpaceTemplateRole is a role grant declared in a space template.
+type SpaceTemplateRole struct {
+	Username string    `yaml:"username"`
+	Role     SpaceRole `yaml:"role"`
+}
+
+// InvalidSpaceTemplateError is returned when a space template cannot be
+// applied as written.
+type InvalidSpaceTemplateError struct {
+	Reason string
+}
+
+func (e InvalidSpaceTemplateError) Error() string {
+	return fmt.Sprintf("Invalid space template: %s", e.Reason)
+}
+
+// ReadSpaceTemplate reads and validates the space template at the provided
+// path.
+func ReadSpaceTemplate(pathToTemplate string) (SpaceTemplate, error) {
+	raw, err := ioutil.ReadFile(pathToTemplate)
+	if err != nil {
+		return SpaceTemplate{}, err
+	}
+
+	var template SpaceTemplate
+	err = yaml.Unmarshal(raw, &template)
+	if err != nil {
+		return SpaceTemplate{}, err
+	}
+
+	return template, template.validate()
+}
+
+func (template SpaceTemplate) validate() error {
+	if template.EnvironmentVariableGroups != nil {
+		return InvalidSpaceTemplateError{Reason: "environment variable groups apply to every space and cannot be set from a space template; use set-running-environment-variable-group or set-staging-environment-variable-group instead"}
+	}
+
+	for _, securityGroup := range template.SecurityGroups {
+		if securityGroup.Name == "" {
+			return InvalidSpaceTemplateError{Reason: "security groups must have a name"}
+		}
+		switch securityGroup.Lifecycle {
+		case "", string(ccv2.SecurityGroupLifecycleRunning), string(ccv2.SecurityGroupLifecycleStaging), SpaceTemplateLifecycleBoth:
+		default:
+			return InvalidSpaceTemplateError{Reason: fmt.Sprintf("security group '%s' has invalid lifecycle '%s'", securityGroup.Name, securityGroup.Lifecycle)}
+		}
+	}
+
+	for _, role := range template.Roles {
+		if role.Username == "" {
+			return InvalidSpaceTemplateError{Reason: "roles must have a username"}
+		}
+		switch role.Role {
+		case SpaceRoleAuditor, SpaceRoleDeveloper, SpaceRoleManager:
+		default:
+			return InvalidSpaceTemplateError{Reason: fmt.Sprintf("user '%s' has invalid role '%s'", role.Username, role.Role)}
+		}
+	}
+
+	return nil
+}
+
+// WithSeededRoles returns a copy of the template that also makes the user
+// with the provided username a SpaceManager and SpaceDeveloper of the space,
+// unless the template already grants them those roles.
+func (template SpaceTemplate) WithSeededRoles(username string) SpaceTemplate {
+	roles := append([]SpaceTemplateRole{}, template.Roles...)
+	for _, seededRole := range []SpaceRole{SpaceRoleManager, SpaceRoleDeveloper} {
+		role := SpaceTemplateRole{Username: username, Role: seededRole}
+
+		granted := false
+		for _, existingRole := range template.Roles {
+			if existingRole == role {
+				granted = true
+				break
+			}
+		}
+		if !granted {
+			roles = append(roles, role)
+		}
+	}
+
+	template.Roles = roles
+	return template
+}
+
+// CreateSpaceFromTemplate creates a space in the organization and applies the
+// template's quota, security group bindings and role grants to it. The quota
+// and security groups are looked up before the space is created; if applying
+// any part of the template fails, the space is deleted before the error is
+// returned. The template's isolation segment is not applied.
+func (actor Actor) CreateSpaceFromTemplate(spaceName string, orgGUID string, template SpaceTemplate) (Space, Warnings, error) {
+	var allWarnings Warnings
+
+	var quota SpaceQuota
+	if template.Quota != "" {
+		var (
+			warnings Warnings
+			err      error
+		)
+		quota, warnings, err = actor.GetSpaceQuotaByName(orgGUID, template.Quota)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Space{}, allWarnings, err
+		}
+	}
+
+	securityGroups := make([]SecurityGroup, len(template.SecurityGroups))
+	for i, templateSecurityGroup := range template.SecurityGroups {
+		securityGroup, warnings, err := actor.GetSecurityGroupByName(templateSecurityGroup.Name)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Space{}, allWarnings, err
+		}
+		securityGroups[i] = securityGroup
+	}
+
+	space, ccWarnings, err := actor.CloudControllerClient.CreateSpace(spaceName, orgGUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.SpaceNameTakenError); ok {
+			return Space{}, allWarnings, SpaceNameTakenError{Name: spaceName}
+		}
+		return Space{}, allWarnings, err
+	}
+
+	warnings, err := actor.applySpaceTemplate(space.GUID, quota, securityGroups, template)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		warnings, rollbackErr := actor.deleteSpace(space.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if rollbackErr != nil {
+			log.Errorln("rolling back space creation:", rollbackErr)
+		}
+		return Space{}, allWarnings, err
+	}
+
+	return Space(space), allWarnings, nil
+}
+
+func (actor Actor) applySpaceTemplate(spaceGUID string, quota SpaceQuota, securityGroups []SecurityGroup, template SpaceTemplate) (Warnings, error) {
+	var allWarnings Warnings
+
+	if quota.GUID != "" {
+		warnings, err := actor.CloudControllerClient.SetSpaceQuota(spaceGUID, quota.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	for i, securityGroup := range securityGroups {
+		warnings, err := actor.BindSecurityGroupToSpace(securityGroup.GUID, spaceGUID, template.SecurityGroups[i].Lifecycles())
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	for _, role := range template.Roles {
+		warnings, err := actor.grantSpaceRole(spaceGUID, role)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (actor Actor) grantSpaceRole(spaceGUID string, role SpaceTemplateRole) (Warnings, error) {
+	var (
+		warnings ccv2.Warnings
+		err      error
+	)
+
+	switch role.Role {
+	case SpaceRoleAuditor:
+		warnings, err = actor.CloudControllerClient.UpdateSpaceAuditorByUsername(spaceGUID, role.Username)
+	case SpaceRoleDeveloper:
+		warnings, err = actor.CloudControllerClient.UpdateSpaceDeveloperByUsername(spaceGUID, role.Username)
+	case SpaceRoleManager:
+		warnings, err = actor.CloudControllerClient.UpdateSpaceManagerByUsername(spaceGUID, role.Username)
+	default:
+		return nil, InvalidSpaceTemplateError{Reason: fmt.Sprintf("user '%s' has invalid role '%s'", role.Username, role.Role)}
+	}
+
+	return Warnings(warnings), err
+}
@@ -0,0 +1,333 @@
+package v2action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Template Actions", func() {
+	Describe("ReadSpaceTemplate", func() {
+		var (
+			pathToTemplate string
+			rawTemplate    string
+
+			template   SpaceTemplate
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			tmpFile, err := ioutil.TempFile("", "space-template")
+			Expect(err).ToNot(HaveOccurred())
+			pathToTemplate = tmpFile.Name()
+			_, err = tmpFile.WriteString(rawTemplate)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tmpFile.Close()).To(Succeed())
+
+			template, executeErr = ReadSpaceTemplate(pathToTemplate)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(pathToTemplate)).To(Succeed())
+		})
+
+		Context("when the template is valid", func() {
+			BeforeEach(func() {
+				rawTemplate = `---
+quota: some-quota
+isolation_segment: some-iso-seg
+security_groups:
+- name: sg-1
+- name: sg-2
+  lifecycle: both
+roles:
+- username: some-user
+  role: SpaceDeveloper
+`
+			})
+
+			It("returns the template", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(template).To(Equal(SpaceTemplate{
+					Quota:            "some-quota",
+					IsolationSegment: "some-iso-seg",
+					SecurityGroups: []SpaceTemplateSecurityGroup{
+						{Name: "sg-1"},
+						{Name: "sg-2", Lifecycle: "both"},
+					},
+					Roles: []SpaceTemplateRole{
+						{Username: "some-user", Role: SpaceRoleDeveloper},
+					},
+				}))
+				Expect(template.SecurityGroups[0].Lifecycles()).To(Equal([]ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleRunning}))
+				Expect(template.SecurityGroups[1].Lifecycles()).To(Equal([]ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleRunning, ccv2.SecurityGroupLifecycleStaging}))
+			})
+		})
+
+		Context("when a security group has an invalid lifecycle", func() {
+			BeforeEach(func() {
+				rawTemplate = `---
+security_groups:
+- name: sg-1
+  lifecycle: sometimes
+`
+			})
+
+			It("returns an InvalidSpaceTemplateError", func() {
+				Expect(executeErr).To(MatchError(InvalidSpaceTemplateError{Reason: "security group 'sg-1' has invalid lifecycle 'sometimes'"}))
+			})
+		})
+
+		Context("when a role is invalid", func() {
+			BeforeEach(func() {
+				rawTemplate = `---
+roles:
+- username: some-user
+  role: OrgManager
+`
+			})
+
+			It("returns an InvalidSpaceTemplateError", func() {
+				Expect(executeErr).To(MatchError(InvalidSpaceTemplateError{Reason: "user 'some-user' has invalid role 'OrgManager'"}))
+			})
+		})
+
+		Context("when the template declares environment variable groups", func() {
+			BeforeEach(func() {
+				rawTemplate = `---
+environment_variable_groups:
+  running:
+    SOME_VAR: some-value
+`
+			})
+
+			It("returns an InvalidSpaceTemplateError", func() {
+				Expect(executeErr).To(MatchError(InvalidSpaceTemplateError{Reason: "environment variable groups apply to every space and cannot be set from a space template; use set-running-environment-variable-group or set-staging-environment-variable-group instead"}))
+			})
+		})
+
+		Context("when the template is not valid YAML", func() {
+			BeforeEach(func() {
+				rawTemplate = "roles: [}"
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("WithSeededRoles", func() {
+		It("grants the user the SpaceManager and SpaceDeveloper roles", func() {
+			template := SpaceTemplate{
+				Quota: "some-quota",
+				Roles: []SpaceTemplateRole{{Username: "other-user", Role: SpaceRoleAuditor}},
+			}
+
+			Expect(template.WithSeededRoles("some-user")).To(Equal(SpaceTemplate{
+				Quota: "some-quota",
+				Roles: []SpaceTemplateRole{
+					{Username: "other-user", Role: SpaceRoleAuditor},
+					{Username: "some-user", Role: SpaceRoleManager},
+					{Username: "some-user", Role: SpaceRoleDeveloper},
+				},
+			}))
+			Expect(template.Roles).To(HaveLen(1))
+		})
+
+		Context("when the template already grants the user a seeded role", func() {
+			It("does not grant the role twice", func() {
+				template := SpaceTemplate{
+					Roles: []SpaceTemplateRole{{Username: "some-user", Role: SpaceRoleDeveloper}},
+				}
+
+				Expect(template.WithSeededRoles("some-user").Roles).To(Equal([]SpaceTemplateRole{
+					{Username: "some-user", Role: SpaceRoleDeveloper},
+					{Username: "some-user", Role: SpaceRoleManager},
+				}))
+			})
+		})
+	})
+
+	Describe("CreateSpaceFromTemplate", func() {
+		var (
+			actor                     *Actor
+			fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+
+			template SpaceTemplate
+
+			space      Space
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+			template = SpaceTemplate{
+				Quota: "some-quota",
+				SecurityGroups: []SpaceTemplateSecurityGroup{
+					{Name: "some-sg", Lifecycle: "both"},
+				},
+				Roles: []SpaceTemplateRole{
+					{Username: "user-1", Role: SpaceRoleManager},
+					{Username: "user-2", Role: SpaceRoleDeveloper},
+					{Username: "user-3", Role: SpaceRoleAuditor},
+				},
+			}
+
+			fakeCloudControllerClient.GetSpaceQuotasReturns(
+				[]ccv2.SpaceQuota{{GUID: "some-quota-guid", Name: "some-quota"}},
+				ccv2.Warnings{"quota-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSecurityGroupsReturns(
+				[]ccv2.SecurityGroup{{GUID: "some-sg-guid", Name: "some-sg"}},
+				ccv2.Warnings{"sg-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.CreateSpaceReturns(
+				ccv2.Space{GUID: "some-space-guid", Name: "some-space"},
+				ccv2.Warnings{"create-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.SetSpaceQuotaReturns(ccv2.Warnings{"set-quota-warning"}, nil)
+			fakeCloudControllerClient.UpdateSpaceManagerByUsernameReturns(ccv2.Warnings{"manager-warning"}, nil)
+			fakeCloudControllerClient.UpdateSpaceDeveloperByUsernameReturns(ccv2.Warnings{"developer-warning"}, nil)
+			fakeCloudControllerClient.UpdateSpaceAuditorByUsernameReturns(ccv2.Warnings{"auditor-warning"}, nil)
+			fakeCloudControllerClient.DeleteSpaceReturns(ccv2.Job{GUID: "some-job-guid"}, ccv2.Warnings{"delete-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			space, warnings, executeErr = actor.CreateSpaceFromTemplate("some-space", "some-org-guid", template)
+		})
+
+		Context("when every part of the template is applied", func() {
+			It("creates the space, applies the template and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(space).To(Equal(Space{GUID: "some-space-guid", Name: "some-space"}))
+				Expect(warnings).To(ConsistOf("quota-warning", "sg-warning", "create-warning", "set-quota-warning", "manager-warning", "developer-warning", "auditor-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpaceQuotasArgsForCall(0)).To(Equal("some-org-guid"))
+
+				Expect(fakeCloudControllerClient.CreateSpaceCallCount()).To(Equal(1))
+				spaceName, orgGUID := fakeCloudControllerClient.CreateSpaceArgsForCall(0)
+				Expect(spaceName).To(Equal("some-space"))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+
+				Expect(fakeCloudControllerClient.SetSpaceQuotaCallCount()).To(Equal(1))
+				spaceGUID, quotaGUID := fakeCloudControllerClient.SetSpaceQuotaArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(quotaGUID).To(Equal("some-quota-guid"))
+
+				Expect(fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.AssociateSpaceWithStagingSecurityGroupCallCount()).To(Equal(1))
+				sgGUID, spaceGUID := fakeCloudControllerClient.AssociateSpaceWithStagingSecurityGroupArgsForCall(0)
+				Expect(sgGUID).To(Equal("some-sg-guid"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				spaceGUID, username := fakeCloudControllerClient.UpdateSpaceManagerByUsernameArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(username).To(Equal("user-1"))
+				_, username = fakeCloudControllerClient.UpdateSpaceDeveloperByUsernameArgsForCall(0)
+				Expect(username).To(Equal("user-2"))
+				_, username = fakeCloudControllerClient.UpdateSpaceAuditorByUsernameArgsForCall(0)
+				Expect(username).To(Equal("user-3"))
+
+				Expect(fakeCloudControllerClient.DeleteSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the quota does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceQuotasReturns(nil, ccv2.Warnings{"quota-warning"}, nil)
+			})
+
+			It("returns a SpaceQuotaNotFoundError without creating the space", func() {
+				Expect(executeErr).To(MatchError(SpaceQuotaNotFoundError{Name: "some-quota"}))
+				Expect(warnings).To(ConsistOf("quota-warning"))
+				Expect(fakeCloudControllerClient.CreateSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a security group does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv2.Warnings{"sg-warning"}, nil)
+			})
+
+			It("returns a SecurityGroupNotFoundError without creating the space", func() {
+				Expect(executeErr).To(MatchError(SecurityGroupNotFoundError{Name: "some-sg"}))
+				Expect(warnings).To(ConsistOf("quota-warning", "sg-warning"))
+				Expect(fakeCloudControllerClient.CreateSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the space name is taken", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateSpaceReturns(ccv2.Space{}, ccv2.Warnings{"create-warning"}, ccerror.SpaceNameTakenError{})
+			})
+
+			It("returns a SpaceNameTakenError and does not touch the existing space", func() {
+				Expect(executeErr).To(MatchError(SpaceNameTakenError{Name: "some-space"}))
+				Expect(warnings).To(ConsistOf("quota-warning", "sg-warning", "create-warning"))
+				Expect(fakeCloudControllerClient.SetSpaceQuotaCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.DeleteSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when granting a role fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("user not in org")
+				fakeCloudControllerClient.UpdateSpaceDeveloperByUsernameReturns(ccv2.Warnings{"developer-warning"}, expectedErr)
+			})
+
+			It("deletes the space and returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("quota-warning", "sg-warning", "create-warning", "set-quota-warning", "manager-warning", "developer-warning", "delete-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateSpaceAuditorByUsernameCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.DeleteSpaceCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteSpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv2.Job{GUID: "some-job-guid"}))
+			})
+
+			Context("when deleting the space also fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.DeleteSpaceReturns(ccv2.Job{}, ccv2.Warnings{"delete-warning"}, errors.New("delete failed"))
+				})
+
+				It("returns the original error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when setting the quota fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("quota exceeded")
+				fakeCloudControllerClient.SetSpaceQuotaReturns(ccv2.Warnings{"set-quota-warning"}, expectedErr)
+			})
+
+			It("deletes the space without applying the rest of the template", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateSpaceManagerByUsernameCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.DeleteSpaceCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -186,6 +186,12 @@ var _ = Describe("Space", func() {
 								Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
 								Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv2.Job{GUID: "some-job-guid"}))
 							})
+
+							It("no longer caches the space", func() {
+								_, _, err := actor.GetSpaceByOrganizationAndName("some-org-guid", "some-space")
+								Expect(err).ToNot(HaveOccurred())
+								Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(2))
+							})
 						})
 					})
 				})
@@ -266,6 +272,76 @@ var _ = Describe("Space", func() {
 			})
 		})
 
+		Describe("GetOrganizationAndSpaceByName", func() {
+			var (
+				org      Organization
+				space    Space
+				warnings Warnings
+				err      error
+			)
+
+			JustBeforeEach(func() {
+				org, space, warnings, err = actor.GetOrganizationAndSpaceByName("some-org", "some-space")
+			})
+
+			Context("when the org is not found", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationsReturns([]ccv2.Organization{}, ccv2.Warnings{"warning-1"}, nil)
+				})
+
+				It("returns an OrganizationNotFoundError and does not look up the space", func() {
+					Expect(err).To(MatchError(OrganizationNotFoundError{Name: "some-org"}))
+					Expect(warnings).To(ConsistOf("warning-1"))
+					Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the org is found", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationsReturns(
+						[]ccv2.Organization{{Name: "some-org", GUID: "some-org-guid"}},
+						ccv2.Warnings{"warning-1"},
+						nil,
+					)
+				})
+
+				Context("when the space is not found", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetSpacesReturns([]ccv2.Space{}, ccv2.Warnings{"warning-2"}, nil)
+					})
+
+					It("returns a SpaceNotFoundError and all warnings", func() {
+						Expect(err).To(MatchError(SpaceNotFoundError{Name: "some-space"}))
+						Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+					})
+				})
+
+				Context("when the space is found", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetSpacesReturns(
+							[]ccv2.Space{{Name: "some-space", GUID: "some-space-guid"}},
+							ccv2.Warnings{"warning-2"},
+							nil,
+						)
+					})
+
+					It("returns the org, the space and all warnings", func() {
+						Expect(err).ToNot(HaveOccurred())
+						Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+						Expect(org).To(Equal(Organization{Name: "some-org", GUID: "some-org-guid"}))
+						Expect(space).To(Equal(Space{Name: "some-space", GUID: "some-space-guid"}))
+
+						Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+						Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ContainElement(ccv2.Query{
+							Filter:   ccv2.OrganizationGUIDFilter,
+							Operator: ccv2.EqualOperator,
+							Values:   []string{"some-org-guid"},
+						}))
+					})
+				})
+			})
+		})
+
 		Describe("GetSpaceByOrganizationAndName", func() {
 			Context("when the space exists", func() {
 				BeforeEach(func() {
@@ -309,6 +385,21 @@ var _ = Describe("Space", func() {
 							},
 						}))
 				})
+
+				It("caches the space for subsequent lookups in the same org", func() {
+					space, _, err := actor.GetSpaceByOrganizationAndName("some-org-guid", "some-space")
+					Expect(err).ToNot(HaveOccurred())
+
+					cachedSpace, cachedWarnings, err := actor.GetSpaceByOrganizationAndName("some-org-guid", "some-space")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(cachedSpace).To(Equal(space))
+					Expect(cachedWarnings).To(BeEmpty())
+					Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+
+					_, _, err = actor.GetSpaceByOrganizationAndName("some-other-org-guid", "some-space")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(2))
+				})
 			})
 
 			Context("when an error is encountered", func() {
@@ -1,16 +1,30 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/transport"
+)
 
 type TargetSettings ccv2.TargetSettings
 
 // SetTarget targets the Cloud Controller using the client and sets target
-// information in the actor based on the response.
+// information in the actor based on the response. When a CA certificate file
+// is provided, it must contain at least one PEM-encoded certificate and is
+// stored with the target.
 func (actor Actor) SetTarget(config Config, settings TargetSettings) (Warnings, error) {
-	if config.Target() == settings.URL && config.SkipSSLValidation() == settings.SkipSSLValidation {
+	if config.Target() == settings.URL &&
+		config.SkipSSLValidation() == settings.SkipSSLValidation &&
+		config.CACertFile() == settings.CACertFile {
 		return nil, nil
 	}
 
+	if settings.CACertFile != "" {
+		_, err := transport.NewCertPool(settings.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	warnings, err := actor.CloudControllerClient.TargetCF(ccv2.TargetSettings(settings))
 	if err != nil {
 		return Warnings(warnings), err
@@ -25,6 +39,7 @@ func (actor Actor) SetTarget(config Config, settings TargetSettings) (Warnings,
 		actor.CloudControllerClient.RoutingEndpoint(),
 		settings.SkipSSLValidation,
 	)
+	config.SetCACertFile(settings.CACertFile)
 	config.SetTokenInformation("", "", "")
 
 	return Warnings(warnings), nil
@@ -33,6 +48,7 @@ func (actor Actor) SetTarget(config Config, settings TargetSettings) (Warnings,
 // ClearTarget clears target information from the actor.
 func (Actor) ClearTarget(config Config) {
 	config.SetTargetInformation("", "", "", "", "", "", false)
+	config.SetCACertFile("")
 	config.SetTokenInformation("", "", "")
 }
 
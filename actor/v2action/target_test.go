@@ -1,8 +1,12 @@
 package v2action_test
 
 import (
+	"io/ioutil"
+	"os"
+
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/transport"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -87,6 +91,61 @@ var _ = Describe("Targeting", func() {
 			Expect(sshOAuthClient).To(BeEmpty())
 		})
 
+		It("stores the CA certificate file with the target", func() {
+			_, err := actor.SetTarget(fakeConfig, settings)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.SetCACertFileCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetCACertFileArgsForCall(0)).To(BeEmpty())
+		})
+
+		Context("when a CA certificate file is provided", func() {
+			var caCertFile string
+
+			BeforeEach(func() {
+				file, err := ioutil.TempFile("", "target-ca")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(file.Close()).To(Succeed())
+				caCertFile = file.Name()
+				settings.CACertFile = caCertFile
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(caCertFile)).To(Succeed())
+			})
+
+			Context("when the file contains a certificate", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(caCertFile, []byte(testCACert), 0600)).To(Succeed())
+				})
+
+				It("targets the API with the CA certificate file and stores it", func() {
+					_, err := actor.SetTarget(fakeConfig, settings)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(fakeCloudControllerClient.TargetCFCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.TargetCFArgsForCall(0).CACertFile).To(Equal(caCertFile))
+
+					Expect(fakeConfig.SetCACertFileCallCount()).To(Equal(1))
+					Expect(fakeConfig.SetCACertFileArgsForCall(0)).To(Equal(caCertFile))
+				})
+			})
+
+			Context("when the file does not contain a certificate", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(caCertFile, []byte("not a certificate"), 0600)).To(Succeed())
+				})
+
+				It("returns an InvalidCACertificateError without targeting the API", func() {
+					_, err := actor.SetTarget(fakeConfig, settings)
+					Expect(err).To(MatchError(transport.InvalidCACertificateError{Path: caCertFile}))
+
+					Expect(fakeCloudControllerClient.TargetCFCallCount()).To(Equal(0))
+					Expect(fakeConfig.SetTargetInformationCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when setting the same API and skip SSL configuration", func() {
 			var APIURL string
 
@@ -104,6 +163,20 @@ var _ = Describe("Targeting", func() {
 
 				Expect(fakeCloudControllerClient.TargetCFCallCount()).To(BeZero())
 			})
+
+			Context("when the CA certificate file differs", func() {
+				BeforeEach(func() {
+					fakeConfig.CACertFileReturns("/some/other/ca.pem")
+				})
+
+				It("targets the API again", func() {
+					_, err := actor.SetTarget(fakeConfig, settings)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeCloudControllerClient.TargetCFCallCount()).To(Equal(1))
+					Expect(fakeConfig.SetCACertFileArgsForCall(0)).To(BeEmpty())
+				})
+			})
 		})
 	})
 
@@ -121,6 +194,9 @@ var _ = Describe("Targeting", func() {
 			Expect(doppler).To(BeEmpty())
 			Expect(routing).To(BeEmpty())
 			Expect(sslDisabled).To(BeFalse())
+
+			Expect(fakeConfig.SetCACertFileCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetCACertFileArgsForCall(0)).To(BeEmpty())
 		})
 
 		It("clears all the token information", func() {
@@ -144,3 +220,18 @@ var _ = Describe("Targeting", func() {
 		})
 	})
 })
+
+// testCACert is a self-signed certificate used only as a valid CA bundle.
+const testCACert = `-----BEGIN CERTIFICATE-----
+MIICCjCCAXOgAwIBAgIUG9w2mLZwy4bozc7cg9xnb9J+Px4wDQYJKoZIhvcNAQEL
+BQAwFjEUMBIGA1UEAwwLY2xpLXRlc3QtY2EwIBcNMjYxMDE3MjAyNzAwWhgPMjEy
+NjA5MjMyMDI3MDBaMBYxFDASBgNVBAMMC2NsaS10ZXN0LWNhMIGfMA0GCSqGSIb3
+DQEBAQUAA4GNADCBiQKBgQDkHkzUJ2/l5mHwpd/LywVvZGCTaDPMBpA4h0BAO5KG
+Inr3WymBxpFUxw75Jb3qp6j25ksnxluw/kDXCIp0vgVyfKKtWC1WgODmRs8sQppZ
+O7q4K/2K9qh/MGF/Sn48uxfcnoyBcHlzfLZqi5V9mp5ZSO8xGAavBq9ExxbvTOBp
+OQIDAQABo1MwUTAdBgNVHQ4EFgQUXUs4x8ox9Ko6QJzlSJTrP3NVBekwHwYDVR0j
+BBgwFoAUXUs4x8ox9Ko6QJzlSJTrP3NVBekwDwYDVR0TAQH/BAUwAwEB/zANBgkq
+hkiG9w0BAQsFAAOBgQA8vYe/Kvow4VzgV0fEh/c/V1xYPgiQCdNZRMogiC819BDC
+LWWiI8Dy/gmafqFvANqIRjbooZt/0jpXXH9j1N4Fsp4qgPJU02RQEjNT5etP3yL4
+iy5yjFGJKWVs8jO29ZWILalPIfiIH5dPLON60kWA6RmpAbQ0y4/n1iOiA3aGYQ==
+-----END CERTIFICATE-----`
@@ -1,5 +1,10 @@
 package v2action
 
+import "code.cloudfoundry.org/cli/api/uaa"
+
+// TokenClaims represents the claims carried by an access token.
+type TokenClaims uaa.TokenClaims
+
 func (actor Actor) RefreshAccessToken(refreshToken string) (string, error) {
 	tokens, err := actor.UAAClient.RefreshAccessToken(refreshToken)
 	if err != nil {
@@ -11,3 +16,17 @@ func (actor Actor) RefreshAccessToken(refreshToken string) (string, error) {
 
 	return tokens.AuthorizationToken(), nil
 }
+
+// DecodeAccessToken returns the claims of the access token. The token is
+// decoded locally and its signature is not verified.
+func (Actor) DecodeAccessToken(accessToken string) (TokenClaims, error) {
+	claims, err := uaa.DecodeToken(accessToken)
+	return TokenClaims(claims), err
+}
+
+// IntrospectAccessToken asks UAA whether the access token is active and
+// returns the claims UAA reports for it.
+func (actor Actor) IntrospectAccessToken(accessToken string) (TokenClaims, error) {
+	claims, err := actor.UAAClient.IntrospectToken(accessToken)
+	return TokenClaims(claims), err
+}
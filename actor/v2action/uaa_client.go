@@ -5,8 +5,12 @@ import "code.cloudfoundry.org/cli/api/uaa"
 //go:generate counterfeiter . UAAClient
 
 type UAAClient interface {
-	Authenticate(username string, password string) (string, string, error)
+	Authenticate(ID string, secret string, origin string, grantType uaa.GrantType) (string, string, error)
 	CreateUser(username string, password string, origin string) (uaa.User, error)
+	DeleteUser(userGUID string) error
 	GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error)
+	IntrospectToken(accessToken string) (uaa.TokenClaims, error)
+	ListUsers(userName string, origin string) ([]uaa.User, error)
+	LoginLink() string
 	RefreshAccessToken(refreshToken string) (uaa.RefreshedTokens, error)
 }
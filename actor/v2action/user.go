@@ -1,9 +1,18 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
 
 // User represents a CLI user.
-type User ccv2.User
+type User struct {
+	GUID     string
+	Username string
+	// Origin is the identity provider the user belongs to. It is only looked
+	// up for users that share their username with another user.
+	Origin string
+}
 
 // CreateUser creates a new user in UAA and registers it with cloud controller.
 func (actor Actor) CreateUser(username string, password string, origin string) (User, Warnings, error) {
@@ -14,5 +23,171 @@ func (actor Actor) CreateUser(username string, password string, origin string) (
 
 	ccUser, ccWarnings, err := actor.CloudControllerClient.CreateUser(uaaUser.ID)
 
-	return User(ccUser), Warnings(ccWarnings), err
+	return User{GUID: ccUser.GUID, Username: ccUser.Username}, Warnings(ccWarnings), err
+}
+
+// DeleteUser deletes the user from cloud controller and UAA. When origin is
+// empty, the username must be unique across identity providers. A user that
+// exists in UAA but was never registered with cloud controller is still
+// deleted from UAA.
+func (actor Actor) DeleteUser(username string, origin string) (Warnings, error) {
+	userGUID, err := actor.getUAAUserGUID(username, origin)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings, err := actor.CloudControllerClient.DeleteUser(userGUID)
+	if _, isNotFound := err.(ccerror.ResourceNotFoundError); err != nil && !isNotFound {
+		return Warnings(warnings), err
+	}
+
+	return Warnings(warnings), actor.UAAClient.DeleteUser(userGUID)
+}
+
+// GetOrgUsers returns every member of the organization, regardless of role.
+func (actor Actor) GetOrgUsers(orgGUID string) ([]User, Warnings, error) {
+	ccUsers, warnings, err := actor.CloudControllerClient.GetOrganizationUsers(orgGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	users := convertCCToActorUsers(ccUsers)
+	actor.setOriginsOfDuplicateUsernames(users)
+	return users, Warnings(warnings), nil
+}
+
+// GetOrgUsersByRole returns the users holding each of the OrgManager,
+// BillingManager and OrgAuditor roles in the organization.
+func (actor Actor) GetOrgUsersByRole(orgGUID string) (map[OrgRole][]User, Warnings, error) {
+	roles := []struct {
+		role     OrgRole
+		getUsers func(string) ([]ccv2.User, ccv2.Warnings, error)
+	}{
+		{OrgRoleManager, actor.CloudControllerClient.GetOrganizationManagers},
+		{OrgRoleBillingManager, actor.CloudControllerClient.GetOrganizationBillingManagers},
+		{OrgRoleAuditor, actor.CloudControllerClient.GetOrganizationAuditors},
+	}
+
+	var (
+		allWarnings Warnings
+		allUsers    []User
+	)
+	usersByRole := map[OrgRole][]User{}
+	for _, role := range roles {
+		ccUsers, warnings, err := role.getUsers(orgGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		usersByRole[role.role] = convertCCToActorUsers(ccUsers)
+		allUsers = append(allUsers, usersByRole[role.role]...)
+	}
+
+	origins := actor.getOriginsOfDuplicateUsernames(allUsers)
+	for _, users := range usersByRole {
+		setOrigins(users, origins)
+	}
+	return usersByRole, allWarnings, nil
+}
+
+// GetSpaceUsersByRole returns the users holding each of the SpaceManager,
+// SpaceDeveloper and SpaceAuditor roles in the space.
+func (actor Actor) GetSpaceUsersByRole(spaceGUID string) (map[SpaceRole][]User, Warnings, error) {
+	roles := []struct {
+		role     SpaceRole
+		getUsers func(string) ([]ccv2.User, ccv2.Warnings, error)
+	}{
+		{SpaceRoleManager, actor.CloudControllerClient.GetSpaceManagers},
+		{SpaceRoleDeveloper, actor.CloudControllerClient.GetSpaceDevelopers},
+		{SpaceRoleAuditor, actor.CloudControllerClient.GetSpaceAuditors},
+	}
+
+	var (
+		allWarnings Warnings
+		allUsers    []User
+	)
+	usersByRole := map[SpaceRole][]User{}
+	for _, role := range roles {
+		ccUsers, warnings, err := role.getUsers(spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		usersByRole[role.role] = convertCCToActorUsers(ccUsers)
+		allUsers = append(allUsers, usersByRole[role.role]...)
+	}
+
+	origins := actor.getOriginsOfDuplicateUsernames(allUsers)
+	for _, users := range usersByRole {
+		setOrigins(users, origins)
+	}
+	return usersByRole, allWarnings, nil
+}
+
+func (actor Actor) getUAAUserGUID(username string, origin string) (string, error) {
+	users, err := actor.UAAClient.ListUsers(username, origin)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(users) {
+	case 0:
+		return "", UserNotFoundError{Username: username, Origin: origin}
+	case 1:
+		return users[0].ID, nil
+	default:
+		var origins []string
+		for _, user := range users {
+			origins = append(origins, user.Origin)
+		}
+		return "", MultipleUAAUsersFoundError{Username: username, Origins: origins}
+	}
+}
+
+func (actor Actor) setOriginsOfDuplicateUsernames(users []User) {
+	setOrigins(users, actor.getOriginsOfDuplicateUsernames(users))
+}
+
+// getOriginsOfDuplicateUsernames returns the origins, keyed by user GUID, of
+// the users whose username belongs to more than one user. Origins are only
+// informational, so a failed UAA lookup (for example, when the current user
+// may not read other UAA users) leaves them out.
+func (actor Actor) getOriginsOfDuplicateUsernames(users []User) map[string]string {
+	guidsByUsername := map[string]map[string]bool{}
+	for _, user := range users {
+		if guidsByUsername[user.Username] == nil {
+			guidsByUsername[user.Username] = map[string]bool{}
+		}
+		guidsByUsername[user.Username][user.GUID] = true
+	}
+
+	origins := map[string]string{}
+	for username, guids := range guidsByUsername {
+		if len(guids) < 2 {
+			continue
+		}
+
+		uaaUsers, err := actor.UAAClient.ListUsers(username, "")
+		if err != nil {
+			continue
+		}
+		for _, uaaUser := range uaaUsers {
+			origins[uaaUser.ID] = uaaUser.Origin
+		}
+	}
+	return origins
+}
+
+func setOrigins(users []User, origins map[string]string) {
+	for i := range users {
+		users[i].Origin = origins[users[i].GUID]
+	}
+}
+
+func convertCCToActorUsers(ccUsers []ccv2.User) []User {
+	var users []User
+	for _, ccUser := range ccUsers {
+		users = append(users, User{GUID: ccUser.GUID, Username: ccUser.Username})
+	}
+	return users
 }
@@ -5,6 +5,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/uaa"
 	. "github.com/onsi/ginkgo"
@@ -61,7 +62,7 @@ var _ = Describe("User Actions", func() {
 			It("creates a new user and returns all warnings", func() {
 				Expect(actualErr).NotTo(HaveOccurred())
 
-				Expect(actualUser).To(Equal(User(createdUser)))
+				Expect(actualUser).To(Equal(User{GUID: "new-user-cc-guid"}))
 				Expect(actualWarnings).To(ConsistOf("warning-1", "warning-2"))
 
 				Expect(fakeUAAClient.CreateUserCallCount()).To(Equal(1))
@@ -121,4 +122,266 @@ var _ = Describe("User Actions", func() {
 			})
 		})
 	})
+
+	Describe("DeleteUser", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeUAAClient.ListUsersReturns([]uaa.User{{ID: "some-user-guid", Origin: "uaa"}}, nil)
+			fakeCloudControllerClient.DeleteUserReturns(ccv2.Warnings{"delete-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteUser("some-user", "some-origin")
+		})
+
+		Context("when the user exists", func() {
+			It("deletes the user from cloud controller and UAA", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-warning"))
+
+				Expect(fakeUAAClient.ListUsersCallCount()).To(Equal(1))
+				username, origin := fakeUAAClient.ListUsersArgsForCall(0)
+				Expect(username).To(Equal("some-user"))
+				Expect(origin).To(Equal("some-origin"))
+
+				Expect(fakeCloudControllerClient.DeleteUserCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteUserArgsForCall(0)).To(Equal("some-user-guid"))
+
+				Expect(fakeUAAClient.DeleteUserCallCount()).To(Equal(1))
+				Expect(fakeUAAClient.DeleteUserArgsForCall(0)).To(Equal("some-user-guid"))
+			})
+		})
+
+		Context("when the user does not exist in UAA", func() {
+			BeforeEach(func() {
+				fakeUAAClient.ListUsersReturns(nil, nil)
+			})
+
+			It("returns a UserNotFoundError", func() {
+				Expect(executeErr).To(MatchError(UserNotFoundError{Username: "some-user", Origin: "some-origin"}))
+				Expect(fakeCloudControllerClient.DeleteUserCallCount()).To(Equal(0))
+				Expect(fakeUAAClient.DeleteUserCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the username exists in multiple origins", func() {
+			BeforeEach(func() {
+				fakeUAAClient.ListUsersReturns([]uaa.User{
+					{ID: "some-user-guid-1", Origin: "uaa"},
+					{ID: "some-user-guid-2", Origin: "ldap"},
+				}, nil)
+			})
+
+			It("returns a MultipleUAAUsersFoundError", func() {
+				Expect(executeErr).To(MatchError(MultipleUAAUsersFoundError{Username: "some-user", Origins: []string{"uaa", "ldap"}}))
+				Expect(fakeCloudControllerClient.DeleteUserCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user is not registered with cloud controller", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteUserReturns(ccv2.Warnings{"delete-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("still deletes the user from UAA", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				Expect(fakeUAAClient.DeleteUserCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when deleting the user from cloud controller fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("cc delete error")
+				fakeCloudControllerClient.DeleteUserReturns(ccv2.Warnings{"delete-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings without deleting the UAA user", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				Expect(fakeUAAClient.DeleteUserCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when deleting the user from UAA fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("uaa delete error")
+				fakeUAAClient.DeleteUserReturns(expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("delete-warning"))
+			})
+		})
+	})
+
+	Describe("GetOrgUsersByRole", func() {
+		var (
+			usersByRole map[OrgRole][]User
+			warnings    Warnings
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationManagersReturns(
+				[]ccv2.User{{GUID: "manager-guid", Username: "some-manager"}},
+				ccv2.Warnings{"managers-warning"}, nil)
+			fakeCloudControllerClient.GetOrganizationBillingManagersReturns(
+				nil, ccv2.Warnings{"billing-managers-warning"}, nil)
+			fakeCloudControllerClient.GetOrganizationAuditorsReturns(
+				[]ccv2.User{{GUID: "auditor-guid", Username: "some-auditor"}},
+				ccv2.Warnings{"auditors-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			usersByRole, warnings, executeErr = actor.GetOrgUsersByRole("some-org-guid")
+		})
+
+		It("returns the users of each role and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("managers-warning", "billing-managers-warning", "auditors-warning"))
+			Expect(usersByRole).To(Equal(map[OrgRole][]User{
+				OrgRoleManager:        {{GUID: "manager-guid", Username: "some-manager"}},
+				OrgRoleBillingManager: nil,
+				OrgRoleAuditor:        {{GUID: "auditor-guid", Username: "some-auditor"}},
+			}))
+
+			Expect(fakeCloudControllerClient.GetOrganizationManagersArgsForCall(0)).To(Equal("some-org-guid"))
+			Expect(fakeCloudControllerClient.GetOrganizationBillingManagersArgsForCall(0)).To(Equal("some-org-guid"))
+			Expect(fakeCloudControllerClient.GetOrganizationAuditorsArgsForCall(0)).To(Equal("some-org-guid"))
+			Expect(fakeUAAClient.ListUsersCallCount()).To(Equal(0))
+		})
+
+		Context("when users from different origins share a username", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationAuditorsReturns(
+					[]ccv2.User{{GUID: "other-manager-guid", Username: "some-manager"}},
+					ccv2.Warnings{"auditors-warning"}, nil)
+				fakeUAAClient.ListUsersReturns([]uaa.User{
+					{ID: "manager-guid", Origin: "uaa"},
+					{ID: "other-manager-guid", Origin: "ldap"},
+				}, nil)
+			})
+
+			It("includes the origins of those users", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(usersByRole[OrgRoleManager]).To(Equal([]User{{GUID: "manager-guid", Username: "some-manager", Origin: "uaa"}}))
+				Expect(usersByRole[OrgRoleAuditor]).To(Equal([]User{{GUID: "other-manager-guid", Username: "some-manager", Origin: "ldap"}}))
+
+				Expect(fakeUAAClient.ListUsersCallCount()).To(Equal(1))
+				username, origin := fakeUAAClient.ListUsersArgsForCall(0)
+				Expect(username).To(Equal("some-manager"))
+				Expect(origin).To(BeEmpty())
+			})
+
+			Context("when looking up the origins fails", func() {
+				BeforeEach(func() {
+					fakeUAAClient.ListUsersReturns(nil, uaa.InsufficientScopeError{})
+				})
+
+				It("returns the users without origins", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(usersByRole[OrgRoleManager]).To(Equal([]User{{GUID: "manager-guid", Username: "some-manager"}}))
+				})
+			})
+		})
+
+		Context("when getting the users of a role fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("billing managers error")
+				fakeCloudControllerClient.GetOrganizationBillingManagersReturns(nil, ccv2.Warnings{"billing-managers-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("managers-warning", "billing-managers-warning"))
+				Expect(fakeCloudControllerClient.GetOrganizationAuditorsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetOrgUsers", func() {
+		It("returns every member of the organization and warnings", func() {
+			fakeCloudControllerClient.GetOrganizationUsersReturns(
+				[]ccv2.User{{GUID: "user-guid", Username: "some-user"}},
+				ccv2.Warnings{"users-warning"}, nil)
+
+			users, warnings, err := actor.GetOrgUsers("some-org-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("users-warning"))
+			Expect(users).To(Equal([]User{{GUID: "user-guid", Username: "some-user"}}))
+			Expect(fakeCloudControllerClient.GetOrganizationUsersArgsForCall(0)).To(Equal("some-org-guid"))
+		})
+
+		It("returns the error and warnings when listing fails", func() {
+			expectedErr := errors.New("users error")
+			fakeCloudControllerClient.GetOrganizationUsersReturns(nil, ccv2.Warnings{"users-warning"}, expectedErr)
+
+			_, warnings, err := actor.GetOrgUsers("some-org-guid")
+			Expect(err).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("users-warning"))
+		})
+	})
+
+	Describe("GetSpaceUsersByRole", func() {
+		var (
+			usersByRole map[SpaceRole][]User
+			warnings    Warnings
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceManagersReturns(
+				[]ccv2.User{{GUID: "manager-guid", Username: "some-manager"}},
+				ccv2.Warnings{"managers-warning"}, nil)
+			fakeCloudControllerClient.GetSpaceDevelopersReturns(
+				[]ccv2.User{{GUID: "developer-guid", Username: "some-developer"}},
+				ccv2.Warnings{"developers-warning"}, nil)
+			fakeCloudControllerClient.GetSpaceAuditorsReturns(
+				nil, ccv2.Warnings{"auditors-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			usersByRole, warnings, executeErr = actor.GetSpaceUsersByRole("some-space-guid")
+		})
+
+		It("returns the users of each role and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("managers-warning", "developers-warning", "auditors-warning"))
+			Expect(usersByRole).To(Equal(map[SpaceRole][]User{
+				SpaceRoleManager:   {{GUID: "manager-guid", Username: "some-manager"}},
+				SpaceRoleDeveloper: {{GUID: "developer-guid", Username: "some-developer"}},
+				SpaceRoleAuditor:   nil,
+			}))
+
+			Expect(fakeCloudControllerClient.GetSpaceManagersArgsForCall(0)).To(Equal("some-space-guid"))
+			Expect(fakeCloudControllerClient.GetSpaceDevelopersArgsForCall(0)).To(Equal("some-space-guid"))
+			Expect(fakeCloudControllerClient.GetSpaceAuditorsArgsForCall(0)).To(Equal("some-space-guid"))
+		})
+
+		Context("when getting the users of a role fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("developers error")
+				fakeCloudControllerClient.GetSpaceDevelopersReturns(nil, ccv2.Warnings{"developers-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("managers-warning", "developers-warning"))
+			})
+		})
+	})
 })
@@ -2,6 +2,7 @@
 package v2actionfakes
 
 import (
+	"io"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
@@ -68,6 +69,52 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateBuildpackStub        func(buildpack ccv2.Buildpack) (ccv2.Buildpack, ccv2.Warnings, error)
+	createBuildpackMutex       sync.RWMutex
+	createBuildpackArgsForCall []struct {
+		buildpack ccv2.Buildpack
+	}
+	createBuildpackReturns struct {
+		result1 ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createBuildpackReturnsOnCall map[int]struct {
+		result1 ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateOrganizationStub        func(orgName string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error)
+	createOrganizationMutex       sync.RWMutex
+	createOrganizationArgsForCall []struct {
+		orgName   string
+		quotaGUID string
+	}
+	createOrganizationReturns struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createOrganizationReturnsOnCall map[int]struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateOrganizationQuotaStub        func(orgQuota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error)
+	createOrganizationQuotaMutex       sync.RWMutex
+	createOrganizationQuotaArgsForCall []struct {
+		orgQuota ccv2.OrganizationQuota
+	}
+	createOrganizationQuotaReturns struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateApplicationStub        func(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
 	createApplicationMutex       sync.RWMutex
 	createApplicationArgsForCall []struct {
@@ -83,6 +130,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreatePrivateDomainStub        func(domainName string, orgGUID string) (ccv2.Domain, ccv2.Warnings, error)
+	createPrivateDomainMutex       sync.RWMutex
+	createPrivateDomainArgsForCall []struct {
+		domainName string
+		orgGUID    string
+	}
+	createPrivateDomainReturns struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createPrivateDomainReturnsOnCall map[int]struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateRouteStub        func(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error)
 	createRouteMutex       sync.RWMutex
 	createRouteArgsForCall []struct {
@@ -99,12 +162,14 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
-	CreateServiceBindingStub        func(appGUID string, serviceBindingGUID string, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error)
+	CreateServiceBindingStub        func(appGUID string, serviceInstanceGUID string, bindingName string, acceptsIncomplete bool, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error)
 	createServiceBindingMutex       sync.RWMutex
 	createServiceBindingArgsForCall []struct {
-		appGUID            string
-		serviceBindingGUID string
-		parameters         map[string]interface{}
+		appGUID             string
+		serviceInstanceGUID string
+		bindingName         string
+		acceptsIncomplete   bool
+		parameters          map[string]interface{}
 	}
 	createServiceBindingReturns struct {
 		result1 ccv2.ServiceBinding
@@ -116,6 +181,90 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateServiceInstanceStub        func(spaceGUID string, servicePlanGUID string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	createServiceInstanceMutex       sync.RWMutex
+	createServiceInstanceArgsForCall []struct {
+		spaceGUID           string
+		servicePlanGUID     string
+		serviceInstanceName string
+		parameters          map[string]interface{}
+		tags                []string
+	}
+	createServiceInstanceReturns struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateServiceKeyStub        func(serviceInstanceGUID string, keyName string, parameters map[string]interface{}) (ccv2.ServiceKey, ccv2.Warnings, error)
+	createServiceKeyMutex       sync.RWMutex
+	createServiceKeyArgsForCall []struct {
+		serviceInstanceGUID string
+		keyName             string
+		parameters          map[string]interface{}
+	}
+	createServiceKeyReturns struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createServiceKeyReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateSharedDomainStub        func(domainName string, routerGroupGUID string, isInternal bool) (ccv2.Domain, ccv2.Warnings, error)
+	createSharedDomainMutex       sync.RWMutex
+	createSharedDomainArgsForCall []struct {
+		domainName      string
+		routerGroupGUID string
+		isInternal      bool
+	}
+	createSharedDomainReturns struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSharedDomainReturnsOnCall map[int]struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateSpaceStub        func(spaceName string, orgGUID string) (ccv2.Space, ccv2.Warnings, error)
+	createSpaceMutex       sync.RWMutex
+	createSpaceArgsForCall []struct {
+		spaceName string
+		orgGUID   string
+	}
+	createSpaceReturns struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSpaceReturnsOnCall map[int]struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateSpaceQuotaStub        func(spaceQuota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)
+	createSpaceQuotaMutex       sync.RWMutex
+	createSpaceQuotaArgsForCall []struct {
+		spaceQuota ccv2.SpaceQuota
+	}
+	createSpaceQuotaReturns struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSpaceQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateUserStub        func(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -131,6 +280,34 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteApplicationStub        func(appGUID string) (ccv2.Warnings, error)
+	deleteApplicationMutex       sync.RWMutex
+	deleteApplicationArgsForCall []struct {
+		appGUID string
+	}
+	deleteApplicationReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteBuildpackStub        func(buildpackGUID string) (ccv2.Job, ccv2.Warnings, error)
+	deleteBuildpackMutex       sync.RWMutex
+	deleteBuildpackArgsForCall []struct {
+		buildpackGUID string
+	}
+	deleteBuildpackReturns struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
+	deleteBuildpackReturnsOnCall map[int]struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
 	DeleteOrganizationStub        func(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteOrganizationMutex       sync.RWMutex
 	deleteOrganizationArgsForCall []struct {
@@ -146,6 +323,105 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteOrganizationAuditorStub        func(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	deleteOrganizationAuditorMutex       sync.RWMutex
+	deleteOrganizationAuditorArgsForCall []struct {
+		orgGUID  string
+		userGUID string
+	}
+	deleteOrganizationAuditorReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteOrganizationAuditorReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteOrganizationAuditorByUsernameStub        func(orgGUID string, username string) (ccv2.Warnings, error)
+	deleteOrganizationAuditorByUsernameMutex       sync.RWMutex
+	deleteOrganizationAuditorByUsernameArgsForCall []struct {
+		orgGUID  string
+		username string
+	}
+	deleteOrganizationAuditorByUsernameReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteOrganizationAuditorByUsernameReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteOrganizationBillingManagerStub        func(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	deleteOrganizationBillingManagerMutex       sync.RWMutex
+	deleteOrganizationBillingManagerArgsForCall []struct {
+		orgGUID  string
+		userGUID string
+	}
+	deleteOrganizationBillingManagerReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteOrganizationBillingManagerReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteOrganizationBillingManagerByUsernameStub        func(orgGUID string, username string) (ccv2.Warnings, error)
+	deleteOrganizationBillingManagerByUsernameMutex       sync.RWMutex
+	deleteOrganizationBillingManagerByUsernameArgsForCall []struct {
+		orgGUID  string
+		username string
+	}
+	deleteOrganizationBillingManagerByUsernameReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteOrganizationBillingManagerByUsernameReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteOrganizationManagerStub        func(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	deleteOrganizationManagerMutex       sync.RWMutex
+	deleteOrganizationManagerArgsForCall []struct {
+		orgGUID  string
+		userGUID string
+	}
+	deleteOrganizationManagerReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteOrganizationManagerReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteOrganizationManagerByUsernameStub        func(orgGUID string, username string) (ccv2.Warnings, error)
+	deleteOrganizationManagerByUsernameMutex       sync.RWMutex
+	deleteOrganizationManagerByUsernameArgsForCall []struct {
+		orgGUID  string
+		username string
+	}
+	deleteOrganizationManagerByUsernameReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteOrganizationManagerByUsernameReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeletePrivateDomainStub        func(domainGUID string) (ccv2.Job, ccv2.Warnings, error)
+	deletePrivateDomainMutex       sync.RWMutex
+	deletePrivateDomainArgsForCall []struct {
+		domainGUID string
+	}
+	deletePrivateDomainReturns struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
+	deletePrivateDomainReturnsOnCall map[int]struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
 	DeleteRouteStub        func(routeGUID string) (ccv2.Warnings, error)
 	deleteRouteMutex       sync.RWMutex
 	deleteRouteArgsForCall []struct {
@@ -159,6 +435,19 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	DeleteSecurityGroupStub        func(securityGroupGUID string) (ccv2.Warnings, error)
+	deleteSecurityGroupMutex       sync.RWMutex
+	deleteSecurityGroupArgsForCall []struct {
+		securityGroupGUID string
+	}
+	deleteSecurityGroupReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteSecurityGroupReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteServiceBindingStub        func(serviceBindingGUID string) (ccv2.Warnings, error)
 	deleteServiceBindingMutex       sync.RWMutex
 	deleteServiceBindingArgsForCall []struct {
@@ -172,6 +461,36 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	DeleteServiceInstanceStub        func(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	deleteServiceInstanceMutex       sync.RWMutex
+	deleteServiceInstanceArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	deleteServiceInstanceReturns struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	deleteServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	DeleteServiceKeyStub        func(guid string) (ccv2.Job, ccv2.Warnings, error)
+	deleteServiceKeyMutex       sync.RWMutex
+	deleteServiceKeyArgsForCall []struct {
+		guid string
+	}
+	deleteServiceKeyReturns struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
+	deleteServiceKeyReturnsOnCall map[int]struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
 	DeleteSpaceStub        func(spaceGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteSpaceMutex       sync.RWMutex
 	deleteSpaceArgsForCall []struct {
@@ -187,6 +506,48 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteSpaceAuditorStub        func(spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	deleteSpaceAuditorMutex       sync.RWMutex
+	deleteSpaceAuditorArgsForCall []struct {
+		spaceGUID string
+		userGUID  string
+	}
+	deleteSpaceAuditorReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteSpaceAuditorReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteSpaceDeveloperStub        func(spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	deleteSpaceDeveloperMutex       sync.RWMutex
+	deleteSpaceDeveloperArgsForCall []struct {
+		spaceGUID string
+		userGUID  string
+	}
+	deleteSpaceDeveloperReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteSpaceDeveloperReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteSpaceManagerStub        func(spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	deleteSpaceManagerMutex       sync.RWMutex
+	deleteSpaceManagerArgsForCall []struct {
+		spaceGUID string
+		userGUID  string
+	}
+	deleteSpaceManagerReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteSpaceManagerReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	GetApplicationStub        func(guid string) (ccv2.Application, ccv2.Warnings, error)
 	getApplicationMutex       sync.RWMutex
 	getApplicationArgsForCall []struct {
@@ -263,6 +624,78 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetApplicationsPagedStub        func(handlePage func([]ccv2.Application) error, queries ...ccv2.Query) (ccv2.Warnings, error)
+	getApplicationsPagedMutex       sync.RWMutex
+	getApplicationsPagedArgsForCall []struct {
+		handlePage func([]ccv2.Application) error
+		queries    []ccv2.Query
+	}
+	getApplicationsPagedReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	getApplicationsPagedReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	GetBuildpacksStub        func(queries ...ccv2.Query) ([]ccv2.Buildpack, ccv2.Warnings, error)
+	getBuildpacksMutex       sync.RWMutex
+	getBuildpacksArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getBuildpacksReturns struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getBuildpacksReturnsOnCall map[int]struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetEnvironmentVariableGroupStub        func(name ccv2.EnvironmentVariableGroupName) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error)
+	getEnvironmentVariableGroupMutex       sync.RWMutex
+	getEnvironmentVariableGroupArgsForCall []struct {
+		name ccv2.EnvironmentVariableGroupName
+	}
+	getEnvironmentVariableGroupReturns struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getEnvironmentVariableGroupReturnsOnCall map[int]struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetFeatureFlagStub        func(name string) (ccv2.FeatureFlag, ccv2.Warnings, error)
+	getFeatureFlagMutex       sync.RWMutex
+	getFeatureFlagArgsForCall []struct {
+		name string
+	}
+	getFeatureFlagReturns struct {
+		result1 ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getFeatureFlagReturnsOnCall map[int]struct {
+		result1 ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetFeatureFlagsStub        func() ([]ccv2.FeatureFlag, ccv2.Warnings, error)
+	getFeatureFlagsMutex       sync.RWMutex
+	getFeatureFlagsArgsForCall []struct{}
+	getFeatureFlagsReturns     struct {
+		result1 []ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getFeatureFlagsReturnsOnCall map[int]struct {
+		result1 []ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetJobStub        func(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	getJobMutex       sync.RWMutex
 	getJobArgsForCall []struct {
@@ -293,41 +726,116 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
-	GetOrganizationPrivateDomainsStub        func(orgGUID string, queries ...ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error)
-	getOrganizationPrivateDomainsMutex       sync.RWMutex
-	getOrganizationPrivateDomainsArgsForCall []struct {
+	GetOrganizationAuditorsStub        func(orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getOrganizationAuditorsMutex       sync.RWMutex
+	getOrganizationAuditorsArgsForCall []struct {
 		orgGUID string
-		queries []ccv2.Query
 	}
-	getOrganizationPrivateDomainsReturns struct {
-		result1 []ccv2.Domain
+	getOrganizationAuditorsReturns struct {
+		result1 []ccv2.User
 		result2 ccv2.Warnings
 		result3 error
 	}
-	getOrganizationPrivateDomainsReturnsOnCall map[int]struct {
-		result1 []ccv2.Domain
+	getOrganizationAuditorsReturnsOnCall map[int]struct {
+		result1 []ccv2.User
 		result2 ccv2.Warnings
 		result3 error
 	}
-	GetOrganizationQuotaStub        func(guid string) (ccv2.OrganizationQuota, ccv2.Warnings, error)
-	getOrganizationQuotaMutex       sync.RWMutex
-	getOrganizationQuotaArgsForCall []struct {
-		guid string
+	GetOrganizationBillingManagersStub        func(orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getOrganizationBillingManagersMutex       sync.RWMutex
+	getOrganizationBillingManagersArgsForCall []struct {
+		orgGUID string
 	}
-	getOrganizationQuotaReturns struct {
-		result1 ccv2.OrganizationQuota
+	getOrganizationBillingManagersReturns struct {
+		result1 []ccv2.User
 		result2 ccv2.Warnings
 		result3 error
 	}
-	getOrganizationQuotaReturnsOnCall map[int]struct {
-		result1 ccv2.OrganizationQuota
+	getOrganizationBillingManagersReturnsOnCall map[int]struct {
+		result1 []ccv2.User
 		result2 ccv2.Warnings
 		result3 error
 	}
-	GetOrganizationsStub        func(queries ...ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error)
-	getOrganizationsMutex       sync.RWMutex
-	getOrganizationsArgsForCall []struct {
-		queries []ccv2.Query
+	GetOrganizationManagersStub        func(orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getOrganizationManagersMutex       sync.RWMutex
+	getOrganizationManagersArgsForCall []struct {
+		orgGUID string
+	}
+	getOrganizationManagersReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationManagersReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetOrganizationPrivateDomainsStub        func(orgGUID string, queries ...ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error)
+	getOrganizationPrivateDomainsMutex       sync.RWMutex
+	getOrganizationPrivateDomainsArgsForCall []struct {
+		orgGUID string
+		queries []ccv2.Query
+	}
+	getOrganizationPrivateDomainsReturns struct {
+		result1 []ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationPrivateDomainsReturnsOnCall map[int]struct {
+		result1 []ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetOrganizationQuotaStub        func(guid string) (ccv2.OrganizationQuota, ccv2.Warnings, error)
+	getOrganizationQuotaMutex       sync.RWMutex
+	getOrganizationQuotaArgsForCall []struct {
+		guid string
+	}
+	getOrganizationQuotaReturns struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetOrganizationQuotasStub        func(queries ...ccv2.Query) ([]ccv2.OrganizationQuota, ccv2.Warnings, error)
+	getOrganizationQuotasMutex       sync.RWMutex
+	getOrganizationQuotasArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getOrganizationQuotasReturns struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationQuotasReturnsOnCall map[int]struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetOrganizationUsersStub        func(orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getOrganizationUsersMutex       sync.RWMutex
+	getOrganizationUsersArgsForCall []struct {
+		orgGUID string
+	}
+	getOrganizationUsersReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationUsersReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetOrganizationsStub        func(queries ...ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error)
+	getOrganizationsMutex       sync.RWMutex
+	getOrganizationsArgsForCall []struct {
+		queries []ccv2.Query
 	}
 	getOrganizationsReturns struct {
 		result1 []ccv2.Organization
@@ -370,6 +878,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetRouteMappingsStub        func(queries ...ccv2.Query) ([]ccv2.RouteMapping, ccv2.Warnings, error)
+	getRouteMappingsMutex       sync.RWMutex
+	getRouteMappingsArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getRouteMappingsReturns struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getRouteMappingsReturnsOnCall map[int]struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetRoutesStub        func(queries ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
 	getRoutesMutex       sync.RWMutex
 	getRoutesArgsForCall []struct {
@@ -385,6 +908,20 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetRoutesPagedStub        func(handlePage func([]ccv2.Route) error, queries ...ccv2.Query) (ccv2.Warnings, error)
+	getRoutesPagedMutex       sync.RWMutex
+	getRoutesPagedArgsForCall []struct {
+		handlePage func([]ccv2.Route) error
+		queries    []ccv2.Query
+	}
+	getRoutesPagedReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	getRoutesPagedReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	GetRunningSpacesBySecurityGroupStub        func(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error)
 	getRunningSpacesBySecurityGroupMutex       sync.RWMutex
 	getRunningSpacesBySecurityGroupArgsForCall []struct {
@@ -415,6 +952,50 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSecurityGroupsPagedStub        func(handlePage func([]ccv2.SecurityGroup) error, queries ...ccv2.Query) (ccv2.Warnings, error)
+	getSecurityGroupsPagedMutex       sync.RWMutex
+	getSecurityGroupsPagedArgsForCall []struct {
+		handlePage func([]ccv2.SecurityGroup) error
+		queries    []ccv2.Query
+	}
+	getSecurityGroupsPagedReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	getSecurityGroupsPagedReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	GetServiceStub        func(serviceGUID string) (ccv2.Service, ccv2.Warnings, error)
+	getServiceMutex       sync.RWMutex
+	getServiceArgsForCall []struct {
+		serviceGUID string
+	}
+	getServiceReturns struct {
+		result1 ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceReturnsOnCall map[int]struct {
+		result1 ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServiceBindingStub        func(serviceBindingGUID string) (ccv2.ServiceBinding, ccv2.Warnings, error)
+	getServiceBindingMutex       sync.RWMutex
+	getServiceBindingArgsForCall []struct {
+		serviceBindingGUID string
+	}
+	getServiceBindingReturns struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceBindingReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServiceBindingsStub        func(queries ...ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
 	getServiceBindingsMutex       sync.RWMutex
 	getServiceBindingsArgsForCall []struct {
@@ -460,6 +1041,66 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceKeysStub        func(queries ...ccv2.Query) ([]ccv2.ServiceKey, ccv2.Warnings, error)
+	getServiceKeysMutex       sync.RWMutex
+	getServiceKeysArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getServiceKeysReturns struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceKeysReturnsOnCall map[int]struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServicePlanStub        func(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error)
+	getServicePlanMutex       sync.RWMutex
+	getServicePlanArgsForCall []struct {
+		servicePlanGUID string
+	}
+	getServicePlanReturns struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicePlanReturnsOnCall map[int]struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServicePlansStub        func(queries ...ccv2.Query) ([]ccv2.ServicePlan, ccv2.Warnings, error)
+	getServicePlansMutex       sync.RWMutex
+	getServicePlansArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getServicePlansReturns struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicePlansReturnsOnCall map[int]struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServicesStub        func(queries ...ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error)
+	getServicesMutex       sync.RWMutex
+	getServicesArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getServicesReturns struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicesReturnsOnCall map[int]struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSharedDomainStub        func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	getSharedDomainMutex       sync.RWMutex
 	getSharedDomainArgsForCall []struct {
@@ -490,6 +1131,51 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceAuditorsStub        func(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getSpaceAuditorsMutex       sync.RWMutex
+	getSpaceAuditorsArgsForCall []struct {
+		spaceGUID string
+	}
+	getSpaceAuditorsReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceAuditorsReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetSpaceDevelopersStub        func(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getSpaceDevelopersMutex       sync.RWMutex
+	getSpaceDevelopersArgsForCall []struct {
+		spaceGUID string
+	}
+	getSpaceDevelopersReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceDevelopersReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetSpaceManagersStub        func(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getSpaceManagersMutex       sync.RWMutex
+	getSpaceManagersArgsForCall []struct {
+		spaceGUID string
+	}
+	getSpaceManagersReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceManagersReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSpaceQuotaStub        func(guid string) (ccv2.SpaceQuota, ccv2.Warnings, error)
 	getSpaceQuotaMutex       sync.RWMutex
 	getSpaceQuotaArgsForCall []struct {
@@ -505,6 +1191,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceQuotasStub        func(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error)
+	getSpaceQuotasMutex       sync.RWMutex
+	getSpaceQuotasArgsForCall []struct {
+		orgGUID string
+	}
+	getSpaceQuotasReturns struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceQuotasReturnsOnCall map[int]struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSpaceRoutesStub        func(spaceGUID string, queries ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
 	getSpaceRoutesMutex       sync.RWMutex
 	getSpaceRoutesArgsForCall []struct {
@@ -521,6 +1222,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceRoutesPagedStub        func(spaceGUID string, handlePage func([]ccv2.Route) error, queries ...ccv2.Query) (ccv2.Warnings, error)
+	getSpaceRoutesPagedMutex       sync.RWMutex
+	getSpaceRoutesPagedArgsForCall []struct {
+		spaceGUID  string
+		handlePage func([]ccv2.Route) error
+		queries    []ccv2.Query
+	}
+	getSpaceRoutesPagedReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	getSpaceRoutesPagedReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	GetSpaceRunningSecurityGroupsBySpaceStub        func(spaceGUID string, queries ...ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	getSpaceRunningSecurityGroupsBySpaceMutex       sync.RWMutex
 	getSpaceRunningSecurityGroupsBySpaceArgsForCall []struct {
@@ -643,6 +1359,21 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	PurgeServiceStub        func(serviceGUID string) (ccv2.Job, ccv2.Warnings, error)
+	purgeServiceMutex       sync.RWMutex
+	purgeServiceArgsForCall []struct {
+		serviceGUID string
+	}
+	purgeServiceReturns struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
+	purgeServiceReturnsOnCall map[int]struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
 	RemoveSpaceFromRunningSecurityGroupStub        func(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	removeSpaceFromRunningSecurityGroupMutex       sync.RWMutex
 	removeSpaceFromRunningSecurityGroupArgsForCall []struct {
@@ -701,6 +1432,20 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	SetSpaceQuotaStub        func(spaceGUID string, quotaGUID string) (ccv2.Warnings, error)
+	setSpaceQuotaMutex       sync.RWMutex
+	setSpaceQuotaArgsForCall []struct {
+		spaceGUID string
+		quotaGUID string
+	}
+	setSpaceQuotaReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	setSpaceQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	TargetCFStub        func(settings ccv2.TargetSettings) (ccv2.Warnings, error)
 	targetCFMutex       sync.RWMutex
 	targetCFArgsForCall []struct {
@@ -729,80 +1474,397 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
-	UploadApplicationPackageStub        func(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
-	uploadApplicationPackageMutex       sync.RWMutex
-	uploadApplicationPackageArgsForCall []struct {
-		appGUID            string
-		existingResources  []ccv2.Resource
-		newResources       ccv2.Reader
-		newResourcesLength int64
+	UpdateBuildpackStub        func(buildpack ccv2.Buildpack) (ccv2.Buildpack, ccv2.Warnings, error)
+	updateBuildpackMutex       sync.RWMutex
+	updateBuildpackArgsForCall []struct {
+		buildpack ccv2.Buildpack
 	}
-	uploadApplicationPackageReturns struct {
-		result1 ccv2.Job
+	updateBuildpackReturns struct {
+		result1 ccv2.Buildpack
 		result2 ccv2.Warnings
 		result3 error
 	}
-	uploadApplicationPackageReturnsOnCall map[int]struct {
-		result1 ccv2.Job
+	updateBuildpackReturnsOnCall map[int]struct {
+		result1 ccv2.Buildpack
 		result2 ccv2.Warnings
 		result3 error
 	}
-	APIStub        func() string
-	aPIMutex       sync.RWMutex
-	aPIArgsForCall []struct{}
-	aPIReturns     struct {
-		result1 string
+	UpdateEnvironmentVariableGroupStub        func(name ccv2.EnvironmentVariableGroupName, group ccv2.EnvironmentVariableGroup) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error)
+	updateEnvironmentVariableGroupMutex       sync.RWMutex
+	updateEnvironmentVariableGroupArgsForCall []struct {
+		name  ccv2.EnvironmentVariableGroupName
+		group ccv2.EnvironmentVariableGroup
 	}
-	aPIReturnsOnCall map[int]struct {
-		result1 string
+	updateEnvironmentVariableGroupReturns struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
 	}
-	APIVersionStub        func() string
-	aPIVersionMutex       sync.RWMutex
-	aPIVersionArgsForCall []struct{}
-	aPIVersionReturns     struct {
-		result1 string
+	updateEnvironmentVariableGroupReturnsOnCall map[int]struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
 	}
-	aPIVersionReturnsOnCall map[int]struct {
-		result1 string
+	UpdateFeatureFlagStub        func(featureFlag ccv2.FeatureFlag) (ccv2.Warnings, error)
+	updateFeatureFlagMutex       sync.RWMutex
+	updateFeatureFlagArgsForCall []struct {
+		featureFlag ccv2.FeatureFlag
 	}
-	AuthorizationEndpointStub        func() string
-	authorizationEndpointMutex       sync.RWMutex
-	authorizationEndpointArgsForCall []struct{}
-	authorizationEndpointReturns     struct {
-		result1 string
+	updateFeatureFlagReturns struct {
+		result1 ccv2.Warnings
+		result2 error
 	}
-	authorizationEndpointReturnsOnCall map[int]struct {
-		result1 string
+	updateFeatureFlagReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
 	}
-	DopplerEndpointStub        func() string
-	dopplerEndpointMutex       sync.RWMutex
-	dopplerEndpointArgsForCall []struct{}
-	dopplerEndpointReturns     struct {
-		result1 string
+	UpdateOrganizationAuditorStub        func(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	updateOrganizationAuditorMutex       sync.RWMutex
+	updateOrganizationAuditorArgsForCall []struct {
+		orgGUID  string
+		userGUID string
 	}
-	dopplerEndpointReturnsOnCall map[int]struct {
-		result1 string
+	updateOrganizationAuditorReturns struct {
+		result1 ccv2.Warnings
+		result2 error
 	}
-	MinCLIVersionStub        func() string
-	minCLIVersionMutex       sync.RWMutex
-	minCLIVersionArgsForCall []struct{}
-	minCLIVersionReturns     struct {
-		result1 string
+	updateOrganizationAuditorReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
 	}
-	minCLIVersionReturnsOnCall map[int]struct {
-		result1 string
+	UpdateOrganizationAuditorByUsernameStub        func(orgGUID string, username string) (ccv2.Warnings, error)
+	updateOrganizationAuditorByUsernameMutex       sync.RWMutex
+	updateOrganizationAuditorByUsernameArgsForCall []struct {
+		orgGUID  string
+		username string
 	}
-	RoutingEndpointStub        func() string
-	routingEndpointMutex       sync.RWMutex
-	routingEndpointArgsForCall []struct{}
-	routingEndpointReturns     struct {
-		result1 string
+	updateOrganizationAuditorByUsernameReturns struct {
+		result1 ccv2.Warnings
+		result2 error
 	}
-	routingEndpointReturnsOnCall map[int]struct {
-		result1 string
+	updateOrganizationAuditorByUsernameReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
 	}
-	TokenEndpointStub        func() string
-	tokenEndpointMutex       sync.RWMutex
+	UpdateOrganizationBillingManagerStub        func(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	updateOrganizationBillingManagerMutex       sync.RWMutex
+	updateOrganizationBillingManagerArgsForCall []struct {
+		orgGUID  string
+		userGUID string
+	}
+	updateOrganizationBillingManagerReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationBillingManagerReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateOrganizationBillingManagerByUsernameStub        func(orgGUID string, username string) (ccv2.Warnings, error)
+	updateOrganizationBillingManagerByUsernameMutex       sync.RWMutex
+	updateOrganizationBillingManagerByUsernameArgsForCall []struct {
+		orgGUID  string
+		username string
+	}
+	updateOrganizationBillingManagerByUsernameReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationBillingManagerByUsernameReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateOrganizationManagerStub        func(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	updateOrganizationManagerMutex       sync.RWMutex
+	updateOrganizationManagerArgsForCall []struct {
+		orgGUID  string
+		userGUID string
+	}
+	updateOrganizationManagerReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationManagerReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateOrganizationManagerByUsernameStub        func(orgGUID string, username string) (ccv2.Warnings, error)
+	updateOrganizationManagerByUsernameMutex       sync.RWMutex
+	updateOrganizationManagerByUsernameArgsForCall []struct {
+		orgGUID  string
+		username string
+	}
+	updateOrganizationManagerByUsernameReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationManagerByUsernameReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateOrganizationQuotaStub        func(orgQuota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error)
+	updateOrganizationQuotaMutex       sync.RWMutex
+	updateOrganizationQuotaArgsForCall []struct {
+		orgQuota ccv2.OrganizationQuota
+	}
+	updateOrganizationQuotaReturns struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateOrganizationUserStub        func(orgGUID string, userGUID string) (ccv2.Warnings, error)
+	updateOrganizationUserMutex       sync.RWMutex
+	updateOrganizationUserArgsForCall []struct {
+		orgGUID  string
+		userGUID string
+	}
+	updateOrganizationUserReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationUserReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateOrganizationUserByUsernameStub        func(orgGUID string, username string) (ccv2.Warnings, error)
+	updateOrganizationUserByUsernameMutex       sync.RWMutex
+	updateOrganizationUserByUsernameArgsForCall []struct {
+		orgGUID  string
+		username string
+	}
+	updateOrganizationUserByUsernameReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationUserByUsernameReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateServiceInstanceStub        func(serviceInstanceGUID string, servicePlanGUID string, parameters map[string]interface{}, tags []string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	updateServiceInstanceMutex       sync.RWMutex
+	updateServiceInstanceArgsForCall []struct {
+		serviceInstanceGUID string
+		servicePlanGUID     string
+		parameters          map[string]interface{}
+		tags                []string
+	}
+	updateServiceInstanceReturns struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateSpaceAuditorStub        func(spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	updateSpaceAuditorMutex       sync.RWMutex
+	updateSpaceAuditorArgsForCall []struct {
+		spaceGUID string
+		userGUID  string
+	}
+	updateSpaceAuditorReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceAuditorReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateSpaceAuditorByUsernameStub        func(spaceGUID string, username string) (ccv2.Warnings, error)
+	updateSpaceAuditorByUsernameMutex       sync.RWMutex
+	updateSpaceAuditorByUsernameArgsForCall []struct {
+		spaceGUID string
+		username  string
+	}
+	updateSpaceAuditorByUsernameReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceAuditorByUsernameReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateSpaceDeveloperStub        func(spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	updateSpaceDeveloperMutex       sync.RWMutex
+	updateSpaceDeveloperArgsForCall []struct {
+		spaceGUID string
+		userGUID  string
+	}
+	updateSpaceDeveloperReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceDeveloperReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateSpaceDeveloperByUsernameStub        func(spaceGUID string, username string) (ccv2.Warnings, error)
+	updateSpaceDeveloperByUsernameMutex       sync.RWMutex
+	updateSpaceDeveloperByUsernameArgsForCall []struct {
+		spaceGUID string
+		username  string
+	}
+	updateSpaceDeveloperByUsernameReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceDeveloperByUsernameReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateSpaceManagerStub        func(spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	updateSpaceManagerMutex       sync.RWMutex
+	updateSpaceManagerArgsForCall []struct {
+		spaceGUID string
+		userGUID  string
+	}
+	updateSpaceManagerReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceManagerReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateSpaceManagerByUsernameStub        func(spaceGUID string, username string) (ccv2.Warnings, error)
+	updateSpaceManagerByUsernameMutex       sync.RWMutex
+	updateSpaceManagerByUsernameArgsForCall []struct {
+		spaceGUID string
+		username  string
+	}
+	updateSpaceManagerByUsernameReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceManagerByUsernameReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateSpaceQuotaStub        func(spaceQuota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)
+	updateSpaceQuotaMutex       sync.RWMutex
+	updateSpaceQuotaArgsForCall []struct {
+		spaceQuota ccv2.SpaceQuota
+	}
+	updateSpaceQuotaReturns struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateSpaceQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UploadApplicationPackageStub        func(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
+	uploadApplicationPackageMutex       sync.RWMutex
+	uploadApplicationPackageArgsForCall []struct {
+		appGUID            string
+		existingResources  []ccv2.Resource
+		newResources       ccv2.Reader
+		newResourcesLength int64
+	}
+	uploadApplicationPackageReturns struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
+	uploadApplicationPackageReturnsOnCall map[int]struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UploadBuildpackStub        func(buildpackGUID string, buildpackFileName string, buildpack io.Reader, buildpackLength int64) (ccv2.Warnings, error)
+	uploadBuildpackMutex       sync.RWMutex
+	uploadBuildpackArgsForCall []struct {
+		buildpackGUID     string
+		buildpackFileName string
+		buildpack         io.Reader
+		buildpackLength   int64
+	}
+	uploadBuildpackReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	uploadBuildpackReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	APIStub        func() string
+	aPIMutex       sync.RWMutex
+	aPIArgsForCall []struct{}
+	aPIReturns     struct {
+		result1 string
+	}
+	aPIReturnsOnCall map[int]struct {
+		result1 string
+	}
+	APIVersionStub        func() string
+	aPIVersionMutex       sync.RWMutex
+	aPIVersionArgsForCall []struct{}
+	aPIVersionReturns     struct {
+		result1 string
+	}
+	aPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	AuthorizationEndpointStub        func() string
+	authorizationEndpointMutex       sync.RWMutex
+	authorizationEndpointArgsForCall []struct{}
+	authorizationEndpointReturns     struct {
+		result1 string
+	}
+	authorizationEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
+	DeleteUserStub        func(userGUID string) (ccv2.Warnings, error)
+	deleteUserMutex       sync.RWMutex
+	deleteUserArgsForCall []struct {
+		userGUID string
+	}
+	deleteUserReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteUserReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DopplerEndpointStub        func() string
+	dopplerEndpointMutex       sync.RWMutex
+	dopplerEndpointArgsForCall []struct{}
+	dopplerEndpointReturns     struct {
+		result1 string
+	}
+	dopplerEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
+	MinCLIVersionStub        func() string
+	minCLIVersionMutex       sync.RWMutex
+	minCLIVersionArgsForCall []struct{}
+	minCLIVersionReturns     struct {
+		result1 string
+	}
+	minCLIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	RoutingEndpointStub        func() string
+	routingEndpointMutex       sync.RWMutex
+	routingEndpointArgsForCall []struct{}
+	routingEndpointReturns     struct {
+		result1 string
+	}
+	routingEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
+	TokenEndpointStub        func() string
+	tokenEndpointMutex       sync.RWMutex
 	tokenEndpointArgsForCall []struct{}
 	tokenEndpointReturns     struct {
 		result1 string
@@ -1027,88 +2089,306 @@ func (fake *FakeCloudControllerClient) CheckRouteReturnsOnCall(i int, result1 bo
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error) {
-	fake.createApplicationMutex.Lock()
-	ret, specificReturn := fake.createApplicationReturnsOnCall[len(fake.createApplicationArgsForCall)]
-	fake.createApplicationArgsForCall = append(fake.createApplicationArgsForCall, struct {
-		app ccv2.Application
-	}{app})
-	fake.recordInvocation("CreateApplication", []interface{}{app})
-	fake.createApplicationMutex.Unlock()
-	if fake.CreateApplicationStub != nil {
-		return fake.CreateApplicationStub(app)
+func (fake *FakeCloudControllerClient) CreateBuildpack(buildpack ccv2.Buildpack) (ccv2.Buildpack, ccv2.Warnings, error) {
+	fake.createBuildpackMutex.Lock()
+	ret, specificReturn := fake.createBuildpackReturnsOnCall[len(fake.createBuildpackArgsForCall)]
+	fake.createBuildpackArgsForCall = append(fake.createBuildpackArgsForCall, struct {
+		buildpack ccv2.Buildpack
+	}{buildpack})
+	fake.recordInvocation("CreateBuildpack", []interface{}{buildpack})
+	fake.createBuildpackMutex.Unlock()
+	if fake.CreateBuildpackStub != nil {
+		return fake.CreateBuildpackStub(buildpack)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.createApplicationReturns.result1, fake.createApplicationReturns.result2, fake.createApplicationReturns.result3
+	return fake.createBuildpackReturns.result1, fake.createBuildpackReturns.result2, fake.createBuildpackReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationCallCount() int {
-	fake.createApplicationMutex.RLock()
-	defer fake.createApplicationMutex.RUnlock()
-	return len(fake.createApplicationArgsForCall)
+func (fake *FakeCloudControllerClient) CreateBuildpackCallCount() int {
+	fake.createBuildpackMutex.RLock()
+	defer fake.createBuildpackMutex.RUnlock()
+	return len(fake.createBuildpackArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationArgsForCall(i int) ccv2.Application {
-	fake.createApplicationMutex.RLock()
-	defer fake.createApplicationMutex.RUnlock()
-	return fake.createApplicationArgsForCall[i].app
+func (fake *FakeCloudControllerClient) CreateBuildpackArgsForCall(i int) ccv2.Buildpack {
+	fake.createBuildpackMutex.RLock()
+	defer fake.createBuildpackMutex.RUnlock()
+	return fake.createBuildpackArgsForCall[i].buildpack
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationReturns(result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.CreateApplicationStub = nil
-	fake.createApplicationReturns = struct {
-		result1 ccv2.Application
+func (fake *FakeCloudControllerClient) CreateBuildpackReturns(result1 ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.CreateBuildpackStub = nil
+	fake.createBuildpackReturns = struct {
+		result1 ccv2.Buildpack
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.CreateApplicationStub = nil
-	if fake.createApplicationReturnsOnCall == nil {
-		fake.createApplicationReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Application
+func (fake *FakeCloudControllerClient) CreateBuildpackReturnsOnCall(i int, result1 ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.CreateBuildpackStub = nil
+	if fake.createBuildpackReturnsOnCall == nil {
+		fake.createBuildpackReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Buildpack
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.createApplicationReturnsOnCall[i] = struct {
-		result1 ccv2.Application
+	fake.createBuildpackReturnsOnCall[i] = struct {
+		result1 ccv2.Buildpack
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error) {
-	fake.createRouteMutex.Lock()
-	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
-	fake.createRouteArgsForCall = append(fake.createRouteArgsForCall, struct {
-		route        ccv2.Route
-		generatePort bool
-	}{route, generatePort})
-	fake.recordInvocation("CreateRoute", []interface{}{route, generatePort})
-	fake.createRouteMutex.Unlock()
-	if fake.CreateRouteStub != nil {
-		return fake.CreateRouteStub(route, generatePort)
+func (fake *FakeCloudControllerClient) CreateOrganization(orgName string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error) {
+	fake.createOrganizationMutex.Lock()
+	ret, specificReturn := fake.createOrganizationReturnsOnCall[len(fake.createOrganizationArgsForCall)]
+	fake.createOrganizationArgsForCall = append(fake.createOrganizationArgsForCall, struct {
+		orgName   string
+		quotaGUID string
+	}{orgName, quotaGUID})
+	fake.recordInvocation("CreateOrganization", []interface{}{orgName, quotaGUID})
+	fake.createOrganizationMutex.Unlock()
+	if fake.CreateOrganizationStub != nil {
+		return fake.CreateOrganizationStub(orgName, quotaGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.createRouteReturns.result1, fake.createRouteReturns.result2, fake.createRouteReturns.result3
+	return fake.createOrganizationReturns.result1, fake.createOrganizationReturns.result2, fake.createOrganizationReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) CreateRouteCallCount() int {
-	fake.createRouteMutex.RLock()
-	defer fake.createRouteMutex.RUnlock()
-	return len(fake.createRouteArgsForCall)
+func (fake *FakeCloudControllerClient) CreateOrganizationCallCount() int {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return len(fake.createOrganizationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) CreateRouteArgsForCall(i int) (ccv2.Route, bool) {
-	fake.createRouteMutex.RLock()
-	defer fake.createRouteMutex.RUnlock()
-	return fake.createRouteArgsForCall[i].route, fake.createRouteArgsForCall[i].generatePort
+func (fake *FakeCloudControllerClient) CreateOrganizationArgsForCall(i int) (string, string) {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return fake.createOrganizationArgsForCall[i].orgName, fake.createOrganizationArgsForCall[i].quotaGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	fake.createOrganizationReturns = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	if fake.createOrganizationReturnsOnCall == nil {
+		fake.createOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Organization
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createOrganizationReturnsOnCall[i] = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuota(orgQuota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error) {
+	fake.createOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.createOrganizationQuotaReturnsOnCall[len(fake.createOrganizationQuotaArgsForCall)]
+	fake.createOrganizationQuotaArgsForCall = append(fake.createOrganizationQuotaArgsForCall, struct {
+		orgQuota ccv2.OrganizationQuota
+	}{orgQuota})
+	fake.recordInvocation("CreateOrganizationQuota", []interface{}{orgQuota})
+	fake.createOrganizationQuotaMutex.Unlock()
+	if fake.CreateOrganizationQuotaStub != nil {
+		return fake.CreateOrganizationQuotaStub(orgQuota)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createOrganizationQuotaReturns.result1, fake.createOrganizationQuotaReturns.result2, fake.createOrganizationQuotaReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaCallCount() int {
+	fake.createOrganizationQuotaMutex.RLock()
+	defer fake.createOrganizationQuotaMutex.RUnlock()
+	return len(fake.createOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaArgsForCall(i int) ccv2.OrganizationQuota {
+	fake.createOrganizationQuotaMutex.RLock()
+	defer fake.createOrganizationQuotaMutex.RUnlock()
+	return fake.createOrganizationQuotaArgsForCall[i].orgQuota
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaReturns(result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationQuotaStub = nil
+	fake.createOrganizationQuotaReturns = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaReturnsOnCall(i int, result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationQuotaStub = nil
+	if fake.createOrganizationQuotaReturnsOnCall == nil {
+		fake.createOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.OrganizationQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error) {
+	fake.createApplicationMutex.Lock()
+	ret, specificReturn := fake.createApplicationReturnsOnCall[len(fake.createApplicationArgsForCall)]
+	fake.createApplicationArgsForCall = append(fake.createApplicationArgsForCall, struct {
+		app ccv2.Application
+	}{app})
+	fake.recordInvocation("CreateApplication", []interface{}{app})
+	fake.createApplicationMutex.Unlock()
+	if fake.CreateApplicationStub != nil {
+		return fake.CreateApplicationStub(app)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createApplicationReturns.result1, fake.createApplicationReturns.result2, fake.createApplicationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationCallCount() int {
+	fake.createApplicationMutex.RLock()
+	defer fake.createApplicationMutex.RUnlock()
+	return len(fake.createApplicationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationArgsForCall(i int) ccv2.Application {
+	fake.createApplicationMutex.RLock()
+	defer fake.createApplicationMutex.RUnlock()
+	return fake.createApplicationArgsForCall[i].app
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationReturns(result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.CreateApplicationStub = nil
+	fake.createApplicationReturns = struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.CreateApplicationStub = nil
+	if fake.createApplicationReturnsOnCall == nil {
+		fake.createApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Application
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreatePrivateDomain(domainName string, orgGUID string) (ccv2.Domain, ccv2.Warnings, error) {
+	fake.createPrivateDomainMutex.Lock()
+	ret, specificReturn := fake.createPrivateDomainReturnsOnCall[len(fake.createPrivateDomainArgsForCall)]
+	fake.createPrivateDomainArgsForCall = append(fake.createPrivateDomainArgsForCall, struct {
+		domainName string
+		orgGUID    string
+	}{domainName, orgGUID})
+	fake.recordInvocation("CreatePrivateDomain", []interface{}{domainName, orgGUID})
+	fake.createPrivateDomainMutex.Unlock()
+	if fake.CreatePrivateDomainStub != nil {
+		return fake.CreatePrivateDomainStub(domainName, orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createPrivateDomainReturns.result1, fake.createPrivateDomainReturns.result2, fake.createPrivateDomainReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreatePrivateDomainCallCount() int {
+	fake.createPrivateDomainMutex.RLock()
+	defer fake.createPrivateDomainMutex.RUnlock()
+	return len(fake.createPrivateDomainArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreatePrivateDomainArgsForCall(i int) (string, string) {
+	fake.createPrivateDomainMutex.RLock()
+	defer fake.createPrivateDomainMutex.RUnlock()
+	return fake.createPrivateDomainArgsForCall[i].domainName, fake.createPrivateDomainArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) CreatePrivateDomainReturns(result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.CreatePrivateDomainStub = nil
+	fake.createPrivateDomainReturns = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreatePrivateDomainReturnsOnCall(i int, result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.CreatePrivateDomainStub = nil
+	if fake.createPrivateDomainReturnsOnCall == nil {
+		fake.createPrivateDomainReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Domain
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createPrivateDomainReturnsOnCall[i] = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error) {
+	fake.createRouteMutex.Lock()
+	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
+	fake.createRouteArgsForCall = append(fake.createRouteArgsForCall, struct {
+		route        ccv2.Route
+		generatePort bool
+	}{route, generatePort})
+	fake.recordInvocation("CreateRoute", []interface{}{route, generatePort})
+	fake.createRouteMutex.Unlock()
+	if fake.CreateRouteStub != nil {
+		return fake.CreateRouteStub(route, generatePort)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createRouteReturns.result1, fake.createRouteReturns.result2, fake.createRouteReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateRouteCallCount() int {
+	fake.createRouteMutex.RLock()
+	defer fake.createRouteMutex.RUnlock()
+	return len(fake.createRouteArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateRouteArgsForCall(i int) (ccv2.Route, bool) {
+	fake.createRouteMutex.RLock()
+	defer fake.createRouteMutex.RUnlock()
+	return fake.createRouteArgsForCall[i].route, fake.createRouteArgsForCall[i].generatePort
 }
 
 func (fake *FakeCloudControllerClient) CreateRouteReturns(result1 ccv2.Route, result2 ccv2.Warnings, result3 error) {
@@ -1136,18 +2416,20 @@ func (fake *FakeCloudControllerClient) CreateRouteReturnsOnCall(i int, result1 c
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreateServiceBinding(appGUID string, serviceBindingGUID string, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error) {
+func (fake *FakeCloudControllerClient) CreateServiceBinding(appGUID string, serviceInstanceGUID string, bindingName string, acceptsIncomplete bool, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error) {
 	fake.createServiceBindingMutex.Lock()
 	ret, specificReturn := fake.createServiceBindingReturnsOnCall[len(fake.createServiceBindingArgsForCall)]
 	fake.createServiceBindingArgsForCall = append(fake.createServiceBindingArgsForCall, struct {
-		appGUID            string
-		serviceBindingGUID string
-		parameters         map[string]interface{}
-	}{appGUID, serviceBindingGUID, parameters})
-	fake.recordInvocation("CreateServiceBinding", []interface{}{appGUID, serviceBindingGUID, parameters})
+		appGUID             string
+		serviceInstanceGUID string
+		bindingName         string
+		acceptsIncomplete   bool
+		parameters          map[string]interface{}
+	}{appGUID, serviceInstanceGUID, bindingName, acceptsIncomplete, parameters})
+	fake.recordInvocation("CreateServiceBinding", []interface{}{appGUID, serviceInstanceGUID, bindingName, acceptsIncomplete, parameters})
 	fake.createServiceBindingMutex.Unlock()
 	if fake.CreateServiceBindingStub != nil {
-		return fake.CreateServiceBindingStub(appGUID, serviceBindingGUID, parameters)
+		return fake.CreateServiceBindingStub(appGUID, serviceInstanceGUID, bindingName, acceptsIncomplete, parameters)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -1161,10 +2443,10 @@ func (fake *FakeCloudControllerClient) CreateServiceBindingCallCount() int {
 	return len(fake.createServiceBindingArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) CreateServiceBindingArgsForCall(i int) (string, string, map[string]interface{}) {
+func (fake *FakeCloudControllerClient) CreateServiceBindingArgsForCall(i int) (string, string, string, bool, map[string]interface{}) {
 	fake.createServiceBindingMutex.RLock()
 	defer fake.createServiceBindingMutex.RUnlock()
-	return fake.createServiceBindingArgsForCall[i].appGUID, fake.createServiceBindingArgsForCall[i].serviceBindingGUID, fake.createServiceBindingArgsForCall[i].parameters
+	return fake.createServiceBindingArgsForCall[i].appGUID, fake.createServiceBindingArgsForCall[i].serviceInstanceGUID, fake.createServiceBindingArgsForCall[i].bindingName, fake.createServiceBindingArgsForCall[i].acceptsIncomplete, fake.createServiceBindingArgsForCall[i].parameters
 }
 
 func (fake *FakeCloudControllerClient) CreateServiceBindingReturns(result1 ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
@@ -1192,2212 +2474,5727 @@ func (fake *FakeCloudControllerClient) CreateServiceBindingReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error) {
-	fake.createUserMutex.Lock()
-	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
-	fake.createUserArgsForCall = append(fake.createUserArgsForCall, struct {
-		uaaUserID string
-	}{uaaUserID})
-	fake.recordInvocation("CreateUser", []interface{}{uaaUserID})
-	fake.createUserMutex.Unlock()
-	if fake.CreateUserStub != nil {
-		return fake.CreateUserStub(uaaUserID)
+func (fake *FakeCloudControllerClient) CreateServiceInstance(spaceGUID string, servicePlanGUID string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
+	var tagsCopy []string
+	if tags != nil {
+		tagsCopy = make([]string, len(tags))
+		copy(tagsCopy, tags)
+	}
+	fake.createServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createServiceInstanceReturnsOnCall[len(fake.createServiceInstanceArgsForCall)]
+	fake.createServiceInstanceArgsForCall = append(fake.createServiceInstanceArgsForCall, struct {
+		spaceGUID           string
+		servicePlanGUID     string
+		serviceInstanceName string
+		parameters          map[string]interface{}
+		tags                []string
+	}{spaceGUID, servicePlanGUID, serviceInstanceName, parameters, tagsCopy})
+	fake.recordInvocation("CreateServiceInstance", []interface{}{spaceGUID, servicePlanGUID, serviceInstanceName, parameters, tagsCopy})
+	fake.createServiceInstanceMutex.Unlock()
+	if fake.CreateServiceInstanceStub != nil {
+		return fake.CreateServiceInstanceStub(spaceGUID, servicePlanGUID, serviceInstanceName, parameters, tags)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.createUserReturns.result1, fake.createUserReturns.result2, fake.createUserReturns.result3
+	return fake.createServiceInstanceReturns.result1, fake.createServiceInstanceReturns.result2, fake.createServiceInstanceReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) CreateUserCallCount() int {
-	fake.createUserMutex.RLock()
-	defer fake.createUserMutex.RUnlock()
-	return len(fake.createUserArgsForCall)
+func (fake *FakeCloudControllerClient) CreateServiceInstanceCallCount() int {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return len(fake.createServiceInstanceArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) CreateUserArgsForCall(i int) string {
-	fake.createUserMutex.RLock()
-	defer fake.createUserMutex.RUnlock()
-	return fake.createUserArgsForCall[i].uaaUserID
+func (fake *FakeCloudControllerClient) CreateServiceInstanceArgsForCall(i int) (string, string, string, map[string]interface{}, []string) {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return fake.createServiceInstanceArgsForCall[i].spaceGUID, fake.createServiceInstanceArgsForCall[i].servicePlanGUID, fake.createServiceInstanceArgsForCall[i].serviceInstanceName, fake.createServiceInstanceArgsForCall[i].parameters, fake.createServiceInstanceArgsForCall[i].tags
 }
 
-func (fake *FakeCloudControllerClient) CreateUserReturns(result1 ccv2.User, result2 ccv2.Warnings, result3 error) {
-	fake.CreateUserStub = nil
-	fake.createUserReturns = struct {
-		result1 ccv2.User
+func (fake *FakeCloudControllerClient) CreateServiceInstanceReturns(result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceInstanceStub = nil
+	fake.createServiceInstanceReturns = struct {
+		result1 ccv2.ServiceInstance
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreateUserReturnsOnCall(i int, result1 ccv2.User, result2 ccv2.Warnings, result3 error) {
-	fake.CreateUserStub = nil
-	if fake.createUserReturnsOnCall == nil {
-		fake.createUserReturnsOnCall = make(map[int]struct {
-			result1 ccv2.User
+func (fake *FakeCloudControllerClient) CreateServiceInstanceReturnsOnCall(i int, result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceInstanceStub = nil
+	if fake.createServiceInstanceReturnsOnCall == nil {
+		fake.createServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceInstance
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.createUserReturnsOnCall[i] = struct {
-		result1 ccv2.User
+	fake.createServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceInstance
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error) {
-	fake.deleteOrganizationMutex.Lock()
-	ret, specificReturn := fake.deleteOrganizationReturnsOnCall[len(fake.deleteOrganizationArgsForCall)]
-	fake.deleteOrganizationArgsForCall = append(fake.deleteOrganizationArgsForCall, struct {
-		orgGUID string
-	}{orgGUID})
-	fake.recordInvocation("DeleteOrganization", []interface{}{orgGUID})
-	fake.deleteOrganizationMutex.Unlock()
-	if fake.DeleteOrganizationStub != nil {
-		return fake.DeleteOrganizationStub(orgGUID)
+func (fake *FakeCloudControllerClient) CreateServiceKey(serviceInstanceGUID string, keyName string, parameters map[string]interface{}) (ccv2.ServiceKey, ccv2.Warnings, error) {
+	fake.createServiceKeyMutex.Lock()
+	ret, specificReturn := fake.createServiceKeyReturnsOnCall[len(fake.createServiceKeyArgsForCall)]
+	fake.createServiceKeyArgsForCall = append(fake.createServiceKeyArgsForCall, struct {
+		serviceInstanceGUID string
+		keyName             string
+		parameters          map[string]interface{}
+	}{serviceInstanceGUID, keyName, parameters})
+	fake.recordInvocation("CreateServiceKey", []interface{}{serviceInstanceGUID, keyName, parameters})
+	fake.createServiceKeyMutex.Unlock()
+	if fake.CreateServiceKeyStub != nil {
+		return fake.CreateServiceKeyStub(serviceInstanceGUID, keyName, parameters)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.deleteOrganizationReturns.result1, fake.deleteOrganizationReturns.result2, fake.deleteOrganizationReturns.result3
+	return fake.createServiceKeyReturns.result1, fake.createServiceKeyReturns.result2, fake.createServiceKeyReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) DeleteOrganizationCallCount() int {
-	fake.deleteOrganizationMutex.RLock()
-	defer fake.deleteOrganizationMutex.RUnlock()
-	return len(fake.deleteOrganizationArgsForCall)
+func (fake *FakeCloudControllerClient) CreateServiceKeyCallCount() int {
+	fake.createServiceKeyMutex.RLock()
+	defer fake.createServiceKeyMutex.RUnlock()
+	return len(fake.createServiceKeyArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) DeleteOrganizationArgsForCall(i int) string {
-	fake.deleteOrganizationMutex.RLock()
-	defer fake.deleteOrganizationMutex.RUnlock()
-	return fake.deleteOrganizationArgsForCall[i].orgGUID
+func (fake *FakeCloudControllerClient) CreateServiceKeyArgsForCall(i int) (string, string, map[string]interface{}) {
+	fake.createServiceKeyMutex.RLock()
+	defer fake.createServiceKeyMutex.RUnlock()
+	return fake.createServiceKeyArgsForCall[i].serviceInstanceGUID, fake.createServiceKeyArgsForCall[i].keyName, fake.createServiceKeyArgsForCall[i].parameters
 }
 
-func (fake *FakeCloudControllerClient) DeleteOrganizationReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
-	fake.DeleteOrganizationStub = nil
-	fake.deleteOrganizationReturns = struct {
-		result1 ccv2.Job
+func (fake *FakeCloudControllerClient) CreateServiceKeyReturns(result1 ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceKeyStub = nil
+	fake.createServiceKeyReturns = struct {
+		result1 ccv2.ServiceKey
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteOrganizationReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
-	fake.DeleteOrganizationStub = nil
-	if fake.deleteOrganizationReturnsOnCall == nil {
-		fake.deleteOrganizationReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Job
+func (fake *FakeCloudControllerClient) CreateServiceKeyReturnsOnCall(i int, result1 ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceKeyStub = nil
+	if fake.createServiceKeyReturnsOnCall == nil {
+		fake.createServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceKey
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.deleteOrganizationReturnsOnCall[i] = struct {
-		result1 ccv2.Job
+	fake.createServiceKeyReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceKey
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteRoute(routeGUID string) (ccv2.Warnings, error) {
-	fake.deleteRouteMutex.Lock()
-	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
-	fake.deleteRouteArgsForCall = append(fake.deleteRouteArgsForCall, struct {
-		routeGUID string
-	}{routeGUID})
-	fake.recordInvocation("DeleteRoute", []interface{}{routeGUID})
-	fake.deleteRouteMutex.Unlock()
-	if fake.DeleteRouteStub != nil {
-		return fake.DeleteRouteStub(routeGUID)
+func (fake *FakeCloudControllerClient) CreateSharedDomain(domainName string, routerGroupGUID string, isInternal bool) (ccv2.Domain, ccv2.Warnings, error) {
+	fake.createSharedDomainMutex.Lock()
+	ret, specificReturn := fake.createSharedDomainReturnsOnCall[len(fake.createSharedDomainArgsForCall)]
+	fake.createSharedDomainArgsForCall = append(fake.createSharedDomainArgsForCall, struct {
+		domainName      string
+		routerGroupGUID string
+		isInternal      bool
+	}{domainName, routerGroupGUID, isInternal})
+	fake.recordInvocation("CreateSharedDomain", []interface{}{domainName, routerGroupGUID, isInternal})
+	fake.createSharedDomainMutex.Unlock()
+	if fake.CreateSharedDomainStub != nil {
+		return fake.CreateSharedDomainStub(domainName, routerGroupGUID, isInternal)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.deleteRouteReturns.result1, fake.deleteRouteReturns.result2
+	return fake.createSharedDomainReturns.result1, fake.createSharedDomainReturns.result2, fake.createSharedDomainReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) DeleteRouteCallCount() int {
-	fake.deleteRouteMutex.RLock()
-	defer fake.deleteRouteMutex.RUnlock()
-	return len(fake.deleteRouteArgsForCall)
+func (fake *FakeCloudControllerClient) CreateSharedDomainCallCount() int {
+	fake.createSharedDomainMutex.RLock()
+	defer fake.createSharedDomainMutex.RUnlock()
+	return len(fake.createSharedDomainArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) DeleteRouteArgsForCall(i int) string {
-	fake.deleteRouteMutex.RLock()
-	defer fake.deleteRouteMutex.RUnlock()
-	return fake.deleteRouteArgsForCall[i].routeGUID
+func (fake *FakeCloudControllerClient) CreateSharedDomainArgsForCall(i int) (string, string, bool) {
+	fake.createSharedDomainMutex.RLock()
+	defer fake.createSharedDomainMutex.RUnlock()
+	return fake.createSharedDomainArgsForCall[i].domainName, fake.createSharedDomainArgsForCall[i].routerGroupGUID, fake.createSharedDomainArgsForCall[i].isInternal
 }
 
-func (fake *FakeCloudControllerClient) DeleteRouteReturns(result1 ccv2.Warnings, result2 error) {
-	fake.DeleteRouteStub = nil
-	fake.deleteRouteReturns = struct {
-		result1 ccv2.Warnings
-		result2 error
-	}{result1, result2}
+func (fake *FakeCloudControllerClient) CreateSharedDomainReturns(result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSharedDomainStub = nil
+	fake.createSharedDomainReturns = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteRouteReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
-	fake.DeleteRouteStub = nil
-	if fake.deleteRouteReturnsOnCall == nil {
-		fake.deleteRouteReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Warnings
-			result2 error
+func (fake *FakeCloudControllerClient) CreateSharedDomainReturnsOnCall(i int, result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSharedDomainStub = nil
+	if fake.createSharedDomainReturnsOnCall == nil {
+		fake.createSharedDomainReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Domain
+			result2 ccv2.Warnings
+			result3 error
 		})
 	}
-	fake.deleteRouteReturnsOnCall[i] = struct {
-		result1 ccv2.Warnings
-		result2 error
-	}{result1, result2}
+	fake.createSharedDomainReturnsOnCall[i] = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteServiceBinding(serviceBindingGUID string) (ccv2.Warnings, error) {
-	fake.deleteServiceBindingMutex.Lock()
-	ret, specificReturn := fake.deleteServiceBindingReturnsOnCall[len(fake.deleteServiceBindingArgsForCall)]
-	fake.deleteServiceBindingArgsForCall = append(fake.deleteServiceBindingArgsForCall, struct {
-		serviceBindingGUID string
-	}{serviceBindingGUID})
-	fake.recordInvocation("DeleteServiceBinding", []interface{}{serviceBindingGUID})
-	fake.deleteServiceBindingMutex.Unlock()
-	if fake.DeleteServiceBindingStub != nil {
-		return fake.DeleteServiceBindingStub(serviceBindingGUID)
+func (fake *FakeCloudControllerClient) CreateSpace(spaceName string, orgGUID string) (ccv2.Space, ccv2.Warnings, error) {
+	fake.createSpaceMutex.Lock()
+	ret, specificReturn := fake.createSpaceReturnsOnCall[len(fake.createSpaceArgsForCall)]
+	fake.createSpaceArgsForCall = append(fake.createSpaceArgsForCall, struct {
+		spaceName string
+		orgGUID   string
+	}{spaceName, orgGUID})
+	fake.recordInvocation("CreateSpace", []interface{}{spaceName, orgGUID})
+	fake.createSpaceMutex.Unlock()
+	if fake.CreateSpaceStub != nil {
+		return fake.CreateSpaceStub(spaceName, orgGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.deleteServiceBindingReturns.result1, fake.deleteServiceBindingReturns.result2
+	return fake.createSpaceReturns.result1, fake.createSpaceReturns.result2, fake.createSpaceReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) DeleteServiceBindingCallCount() int {
-	fake.deleteServiceBindingMutex.RLock()
-	defer fake.deleteServiceBindingMutex.RUnlock()
-	return len(fake.deleteServiceBindingArgsForCall)
+func (fake *FakeCloudControllerClient) CreateSpaceCallCount() int {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return len(fake.createSpaceArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) DeleteServiceBindingArgsForCall(i int) string {
-	fake.deleteServiceBindingMutex.RLock()
-	defer fake.deleteServiceBindingMutex.RUnlock()
-	return fake.deleteServiceBindingArgsForCall[i].serviceBindingGUID
+func (fake *FakeCloudControllerClient) CreateSpaceArgsForCall(i int) (string, string) {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return fake.createSpaceArgsForCall[i].spaceName, fake.createSpaceArgsForCall[i].orgGUID
 }
 
-func (fake *FakeCloudControllerClient) DeleteServiceBindingReturns(result1 ccv2.Warnings, result2 error) {
-	fake.DeleteServiceBindingStub = nil
-	fake.deleteServiceBindingReturns = struct {
-		result1 ccv2.Warnings
-		result2 error
-	}{result1, result2}
+func (fake *FakeCloudControllerClient) CreateSpaceReturns(result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	fake.createSpaceReturns = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteServiceBindingReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
-	fake.DeleteServiceBindingStub = nil
-	if fake.deleteServiceBindingReturnsOnCall == nil {
-		fake.deleteServiceBindingReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Warnings
-			result2 error
+func (fake *FakeCloudControllerClient) CreateSpaceReturnsOnCall(i int, result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	if fake.createSpaceReturnsOnCall == nil {
+		fake.createSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
 		})
 	}
-	fake.deleteServiceBindingReturnsOnCall[i] = struct {
-		result1 ccv2.Warnings
-		result2 error
-	}{result1, result2}
+	fake.createSpaceReturnsOnCall[i] = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteSpace(spaceGUID string) (ccv2.Job, ccv2.Warnings, error) {
-	fake.deleteSpaceMutex.Lock()
-	ret, specificReturn := fake.deleteSpaceReturnsOnCall[len(fake.deleteSpaceArgsForCall)]
-	fake.deleteSpaceArgsForCall = append(fake.deleteSpaceArgsForCall, struct {
-		spaceGUID string
-	}{spaceGUID})
-	fake.recordInvocation("DeleteSpace", []interface{}{spaceGUID})
-	fake.deleteSpaceMutex.Unlock()
-	if fake.DeleteSpaceStub != nil {
-		return fake.DeleteSpaceStub(spaceGUID)
+func (fake *FakeCloudControllerClient) CreateSpaceQuota(spaceQuota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.createSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.createSpaceQuotaReturnsOnCall[len(fake.createSpaceQuotaArgsForCall)]
+	fake.createSpaceQuotaArgsForCall = append(fake.createSpaceQuotaArgsForCall, struct {
+		spaceQuota ccv2.SpaceQuota
+	}{spaceQuota})
+	fake.recordInvocation("CreateSpaceQuota", []interface{}{spaceQuota})
+	fake.createSpaceQuotaMutex.Unlock()
+	if fake.CreateSpaceQuotaStub != nil {
+		return fake.CreateSpaceQuotaStub(spaceQuota)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.deleteSpaceReturns.result1, fake.deleteSpaceReturns.result2, fake.deleteSpaceReturns.result3
+	return fake.createSpaceQuotaReturns.result1, fake.createSpaceQuotaReturns.result2, fake.createSpaceQuotaReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) DeleteSpaceCallCount() int {
-	fake.deleteSpaceMutex.RLock()
-	defer fake.deleteSpaceMutex.RUnlock()
-	return len(fake.deleteSpaceArgsForCall)
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaCallCount() int {
+	fake.createSpaceQuotaMutex.RLock()
+	defer fake.createSpaceQuotaMutex.RUnlock()
+	return len(fake.createSpaceQuotaArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) DeleteSpaceArgsForCall(i int) string {
-	fake.deleteSpaceMutex.RLock()
-	defer fake.deleteSpaceMutex.RUnlock()
-	return fake.deleteSpaceArgsForCall[i].spaceGUID
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaArgsForCall(i int) ccv2.SpaceQuota {
+	fake.createSpaceQuotaMutex.RLock()
+	defer fake.createSpaceQuotaMutex.RUnlock()
+	return fake.createSpaceQuotaArgsForCall[i].spaceQuota
 }
 
-func (fake *FakeCloudControllerClient) DeleteSpaceReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
-	fake.DeleteSpaceStub = nil
-	fake.deleteSpaceReturns = struct {
-		result1 ccv2.Job
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaReturns(result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceQuotaStub = nil
+	fake.createSpaceQuotaReturns = struct {
+		result1 ccv2.SpaceQuota
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteSpaceReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
-	fake.DeleteSpaceStub = nil
-	if fake.deleteSpaceReturnsOnCall == nil {
-		fake.deleteSpaceReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Job
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaReturnsOnCall(i int, result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceQuotaStub = nil
+	if fake.createSpaceQuotaReturnsOnCall == nil {
+		fake.createSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SpaceQuota
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.deleteSpaceReturnsOnCall[i] = struct {
-		result1 ccv2.Job
+	fake.createSpaceQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.SpaceQuota
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplication(guid string) (ccv2.Application, ccv2.Warnings, error) {
-	fake.getApplicationMutex.Lock()
-	ret, specificReturn := fake.getApplicationReturnsOnCall[len(fake.getApplicationArgsForCall)]
-	fake.getApplicationArgsForCall = append(fake.getApplicationArgsForCall, struct {
-		guid string
-	}{guid})
-	fake.recordInvocation("GetApplication", []interface{}{guid})
-	fake.getApplicationMutex.Unlock()
-	if fake.GetApplicationStub != nil {
-		return fake.GetApplicationStub(guid)
+func (fake *FakeCloudControllerClient) CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error) {
+	fake.createUserMutex.Lock()
+	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
+	fake.createUserArgsForCall = append(fake.createUserArgsForCall, struct {
+		uaaUserID string
+	}{uaaUserID})
+	fake.recordInvocation("CreateUser", []interface{}{uaaUserID})
+	fake.createUserMutex.Unlock()
+	if fake.CreateUserStub != nil {
+		return fake.CreateUserStub(uaaUserID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getApplicationReturns.result1, fake.getApplicationReturns.result2, fake.getApplicationReturns.result3
+	return fake.createUserReturns.result1, fake.createUserReturns.result2, fake.createUserReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationCallCount() int {
-	fake.getApplicationMutex.RLock()
-	defer fake.getApplicationMutex.RUnlock()
-	return len(fake.getApplicationArgsForCall)
+func (fake *FakeCloudControllerClient) CreateUserCallCount() int {
+	fake.createUserMutex.RLock()
+	defer fake.createUserMutex.RUnlock()
+	return len(fake.createUserArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationArgsForCall(i int) string {
-	fake.getApplicationMutex.RLock()
-	defer fake.getApplicationMutex.RUnlock()
-	return fake.getApplicationArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) CreateUserArgsForCall(i int) string {
+	fake.createUserMutex.RLock()
+	defer fake.createUserMutex.RUnlock()
+	return fake.createUserArgsForCall[i].uaaUserID
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationReturns(result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationStub = nil
-	fake.getApplicationReturns = struct {
-		result1 ccv2.Application
+func (fake *FakeCloudControllerClient) CreateUserReturns(result1 ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.CreateUserStub = nil
+	fake.createUserReturns = struct {
+		result1 ccv2.User
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationReturnsOnCall(i int, result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationStub = nil
-	if fake.getApplicationReturnsOnCall == nil {
-		fake.getApplicationReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Application
+func (fake *FakeCloudControllerClient) CreateUserReturnsOnCall(i int, result1 ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.CreateUserStub = nil
+	if fake.createUserReturnsOnCall == nil {
+		fake.createUserReturnsOnCall = make(map[int]struct {
+			result1 ccv2.User
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getApplicationReturnsOnCall[i] = struct {
-		result1 ccv2.Application
+	fake.createUserReturnsOnCall[i] = struct {
+		result1 ccv2.User
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplication(guid string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error) {
-	fake.getApplicationInstancesByApplicationMutex.Lock()
-	ret, specificReturn := fake.getApplicationInstancesByApplicationReturnsOnCall[len(fake.getApplicationInstancesByApplicationArgsForCall)]
-	fake.getApplicationInstancesByApplicationArgsForCall = append(fake.getApplicationInstancesByApplicationArgsForCall, struct {
-		guid string
-	}{guid})
-	fake.recordInvocation("GetApplicationInstancesByApplication", []interface{}{guid})
-	fake.getApplicationInstancesByApplicationMutex.Unlock()
-	if fake.GetApplicationInstancesByApplicationStub != nil {
-		return fake.GetApplicationInstancesByApplicationStub(guid)
+func (fake *FakeCloudControllerClient) DeleteApplication(appGUID string) (ccv2.Warnings, error) {
+	fake.deleteApplicationMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationReturnsOnCall[len(fake.deleteApplicationArgsForCall)]
+	fake.deleteApplicationArgsForCall = append(fake.deleteApplicationArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("DeleteApplication", []interface{}{appGUID})
+	fake.deleteApplicationMutex.Unlock()
+	if fake.DeleteApplicationStub != nil {
+		return fake.DeleteApplicationStub(appGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getApplicationInstancesByApplicationReturns.result1, fake.getApplicationInstancesByApplicationReturns.result2, fake.getApplicationInstancesByApplicationReturns.result3
+	return fake.deleteApplicationReturns.result1, fake.deleteApplicationReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationCallCount() int {
-	fake.getApplicationInstancesByApplicationMutex.RLock()
-	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
-	return len(fake.getApplicationInstancesByApplicationArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteApplicationCallCount() int {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return len(fake.deleteApplicationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationArgsForCall(i int) string {
-	fake.getApplicationInstancesByApplicationMutex.RLock()
-	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
-	return fake.getApplicationInstancesByApplicationArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) DeleteApplicationArgsForCall(i int) string {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return fake.deleteApplicationArgsForCall[i].appGUID
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationReturns(result1 map[int]ccv2.ApplicationInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationInstancesByApplicationStub = nil
-	fake.getApplicationInstancesByApplicationReturns = struct {
-		result1 map[int]ccv2.ApplicationInstance
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DeleteApplicationReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	fake.deleteApplicationReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationReturnsOnCall(i int, result1 map[int]ccv2.ApplicationInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationInstancesByApplicationStub = nil
-	if fake.getApplicationInstancesByApplicationReturnsOnCall == nil {
-		fake.getApplicationInstancesByApplicationReturnsOnCall = make(map[int]struct {
-			result1 map[int]ccv2.ApplicationInstance
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DeleteApplicationReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	if fake.deleteApplicationReturnsOnCall == nil {
+		fake.deleteApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getApplicationInstancesByApplicationReturnsOnCall[i] = struct {
-		result1 map[int]ccv2.ApplicationInstance
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplication(guid string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error) {
-	fake.getApplicationInstanceStatusesByApplicationMutex.Lock()
-	ret, specificReturn := fake.getApplicationInstanceStatusesByApplicationReturnsOnCall[len(fake.getApplicationInstanceStatusesByApplicationArgsForCall)]
-	fake.getApplicationInstanceStatusesByApplicationArgsForCall = append(fake.getApplicationInstanceStatusesByApplicationArgsForCall, struct {
-		guid string
-	}{guid})
-	fake.recordInvocation("GetApplicationInstanceStatusesByApplication", []interface{}{guid})
-	fake.getApplicationInstanceStatusesByApplicationMutex.Unlock()
-	if fake.GetApplicationInstanceStatusesByApplicationStub != nil {
-		return fake.GetApplicationInstanceStatusesByApplicationStub(guid)
+func (fake *FakeCloudControllerClient) DeleteBuildpack(buildpackGUID string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.deleteBuildpackMutex.Lock()
+	ret, specificReturn := fake.deleteBuildpackReturnsOnCall[len(fake.deleteBuildpackArgsForCall)]
+	fake.deleteBuildpackArgsForCall = append(fake.deleteBuildpackArgsForCall, struct {
+		buildpackGUID string
+	}{buildpackGUID})
+	fake.recordInvocation("DeleteBuildpack", []interface{}{buildpackGUID})
+	fake.deleteBuildpackMutex.Unlock()
+	if fake.DeleteBuildpackStub != nil {
+		return fake.DeleteBuildpackStub(buildpackGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getApplicationInstanceStatusesByApplicationReturns.result1, fake.getApplicationInstanceStatusesByApplicationReturns.result2, fake.getApplicationInstanceStatusesByApplicationReturns.result3
+	return fake.deleteBuildpackReturns.result1, fake.deleteBuildpackReturns.result2, fake.deleteBuildpackReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationCallCount() int {
-	fake.getApplicationInstanceStatusesByApplicationMutex.RLock()
-	defer fake.getApplicationInstanceStatusesByApplicationMutex.RUnlock()
-	return len(fake.getApplicationInstanceStatusesByApplicationArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteBuildpackCallCount() int {
+	fake.deleteBuildpackMutex.RLock()
+	defer fake.deleteBuildpackMutex.RUnlock()
+	return len(fake.deleteBuildpackArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationArgsForCall(i int) string {
-	fake.getApplicationInstanceStatusesByApplicationMutex.RLock()
-	defer fake.getApplicationInstanceStatusesByApplicationMutex.RUnlock()
-	return fake.getApplicationInstanceStatusesByApplicationArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) DeleteBuildpackArgsForCall(i int) string {
+	fake.deleteBuildpackMutex.RLock()
+	defer fake.deleteBuildpackMutex.RUnlock()
+	return fake.deleteBuildpackArgsForCall[i].buildpackGUID
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationReturns(result1 map[int]ccv2.ApplicationInstanceStatus, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationInstanceStatusesByApplicationStub = nil
-	fake.getApplicationInstanceStatusesByApplicationReturns = struct {
-		result1 map[int]ccv2.ApplicationInstanceStatus
+func (fake *FakeCloudControllerClient) DeleteBuildpackReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteBuildpackStub = nil
+	fake.deleteBuildpackReturns = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationReturnsOnCall(i int, result1 map[int]ccv2.ApplicationInstanceStatus, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationInstanceStatusesByApplicationStub = nil
-	if fake.getApplicationInstanceStatusesByApplicationReturnsOnCall == nil {
-		fake.getApplicationInstanceStatusesByApplicationReturnsOnCall = make(map[int]struct {
-			result1 map[int]ccv2.ApplicationInstanceStatus
+func (fake *FakeCloudControllerClient) DeleteBuildpackReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteBuildpackStub = nil
+	if fake.deleteBuildpackReturnsOnCall == nil {
+		fake.deleteBuildpackReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Job
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getApplicationInstanceStatusesByApplicationReturnsOnCall[i] = struct {
-		result1 map[int]ccv2.ApplicationInstanceStatus
+	fake.deleteBuildpackReturnsOnCall[i] = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationRoutes(appGUID string, queries ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
-	fake.getApplicationRoutesMutex.Lock()
-	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
-	fake.getApplicationRoutesArgsForCall = append(fake.getApplicationRoutesArgsForCall, struct {
-		appGUID string
-		queries []ccv2.Query
-	}{appGUID, queries})
-	fake.recordInvocation("GetApplicationRoutes", []interface{}{appGUID, queries})
-	fake.getApplicationRoutesMutex.Unlock()
-	if fake.GetApplicationRoutesStub != nil {
-		return fake.GetApplicationRoutesStub(appGUID, queries...)
+func (fake *FakeCloudControllerClient) DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.deleteOrganizationMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationReturnsOnCall[len(fake.deleteOrganizationArgsForCall)]
+	fake.deleteOrganizationArgsForCall = append(fake.deleteOrganizationArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("DeleteOrganization", []interface{}{orgGUID})
+	fake.deleteOrganizationMutex.Unlock()
+	if fake.DeleteOrganizationStub != nil {
+		return fake.DeleteOrganizationStub(orgGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getApplicationRoutesReturns.result1, fake.getApplicationRoutesReturns.result2, fake.getApplicationRoutesReturns.result3
+	return fake.deleteOrganizationReturns.result1, fake.deleteOrganizationReturns.result2, fake.deleteOrganizationReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationRoutesCallCount() int {
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	return len(fake.getApplicationRoutesArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteOrganizationCallCount() int {
+	fake.deleteOrganizationMutex.RLock()
+	defer fake.deleteOrganizationMutex.RUnlock()
+	return len(fake.deleteOrganizationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationRoutesArgsForCall(i int) (string, []ccv2.Query) {
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	return fake.getApplicationRoutesArgsForCall[i].appGUID, fake.getApplicationRoutesArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) DeleteOrganizationArgsForCall(i int) string {
+	fake.deleteOrganizationMutex.RLock()
+	defer fake.deleteOrganizationMutex.RUnlock()
+	return fake.deleteOrganizationArgsForCall[i].orgGUID
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationRoutesReturns(result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationRoutesStub = nil
-	fake.getApplicationRoutesReturns = struct {
-		result1 []ccv2.Route
+func (fake *FakeCloudControllerClient) DeleteOrganizationReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteOrganizationStub = nil
+	fake.deleteOrganizationReturns = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationRoutesReturnsOnCall(i int, result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationRoutesStub = nil
-	if fake.getApplicationRoutesReturnsOnCall == nil {
-		fake.getApplicationRoutesReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Route
+func (fake *FakeCloudControllerClient) DeleteOrganizationReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteOrganizationStub = nil
+	if fake.deleteOrganizationReturnsOnCall == nil {
+		fake.deleteOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Job
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getApplicationRoutesReturnsOnCall[i] = struct {
-		result1 []ccv2.Route
+	fake.deleteOrganizationReturnsOnCall[i] = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplications(queries ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
-	fake.getApplicationsMutex.Lock()
-	ret, specificReturn := fake.getApplicationsReturnsOnCall[len(fake.getApplicationsArgsForCall)]
-	fake.getApplicationsArgsForCall = append(fake.getApplicationsArgsForCall, struct {
-		queries []ccv2.Query
-	}{queries})
-	fake.recordInvocation("GetApplications", []interface{}{queries})
-	fake.getApplicationsMutex.Unlock()
-	if fake.GetApplicationsStub != nil {
-		return fake.GetApplicationsStub(queries...)
+func (fake *FakeCloudControllerClient) DeleteOrganizationAuditor(orgGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.deleteOrganizationAuditorMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationAuditorReturnsOnCall[len(fake.deleteOrganizationAuditorArgsForCall)]
+	fake.deleteOrganizationAuditorArgsForCall = append(fake.deleteOrganizationAuditorArgsForCall, struct {
+		orgGUID  string
+		userGUID string
+	}{orgGUID, userGUID})
+	fake.recordInvocation("DeleteOrganizationAuditor", []interface{}{orgGUID, userGUID})
+	fake.deleteOrganizationAuditorMutex.Unlock()
+	if fake.DeleteOrganizationAuditorStub != nil {
+		return fake.DeleteOrganizationAuditorStub(orgGUID, userGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getApplicationsReturns.result1, fake.getApplicationsReturns.result2, fake.getApplicationsReturns.result3
+	return fake.deleteOrganizationAuditorReturns.result1, fake.deleteOrganizationAuditorReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationsCallCount() int {
-	fake.getApplicationsMutex.RLock()
-	defer fake.getApplicationsMutex.RUnlock()
-	return len(fake.getApplicationsArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteOrganizationAuditorCallCount() int {
+	fake.deleteOrganizationAuditorMutex.RLock()
+	defer fake.deleteOrganizationAuditorMutex.RUnlock()
+	return len(fake.deleteOrganizationAuditorArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationsArgsForCall(i int) []ccv2.Query {
-	fake.getApplicationsMutex.RLock()
-	defer fake.getApplicationsMutex.RUnlock()
-	return fake.getApplicationsArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) DeleteOrganizationAuditorArgsForCall(i int) (string, string) {
+	fake.deleteOrganizationAuditorMutex.RLock()
+	defer fake.deleteOrganizationAuditorMutex.RUnlock()
+	return fake.deleteOrganizationAuditorArgsForCall[i].orgGUID, fake.deleteOrganizationAuditorArgsForCall[i].userGUID
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationsReturns(result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationsStub = nil
-	fake.getApplicationsReturns = struct {
-		result1 []ccv2.Application
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DeleteOrganizationAuditorReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationAuditorStub = nil
+	fake.deleteOrganizationAuditorReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationsStub = nil
-	if fake.getApplicationsReturnsOnCall == nil {
-		fake.getApplicationsReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Application
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DeleteOrganizationAuditorReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationAuditorStub = nil
+	if fake.deleteOrganizationAuditorReturnsOnCall == nil {
+		fake.deleteOrganizationAuditorReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getApplicationsReturnsOnCall[i] = struct {
-		result1 []ccv2.Application
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteOrganizationAuditorReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error) {
-	fake.getJobMutex.Lock()
-	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
-	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
-		jobGUID string
-	}{jobGUID})
-	fake.recordInvocation("GetJob", []interface{}{jobGUID})
-	fake.getJobMutex.Unlock()
-	if fake.GetJobStub != nil {
-		return fake.GetJobStub(jobGUID)
+func (fake *FakeCloudControllerClient) DeleteOrganizationAuditorByUsername(orgGUID string, username string) (ccv2.Warnings, error) {
+	fake.deleteOrganizationAuditorByUsernameMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationAuditorByUsernameReturnsOnCall[len(fake.deleteOrganizationAuditorByUsernameArgsForCall)]
+	fake.deleteOrganizationAuditorByUsernameArgsForCall = append(fake.deleteOrganizationAuditorByUsernameArgsForCall, struct {
+		orgGUID  string
+		username string
+	}{orgGUID, username})
+	fake.recordInvocation("DeleteOrganizationAuditorByUsername", []interface{}{orgGUID, username})
+	fake.deleteOrganizationAuditorByUsernameMutex.Unlock()
+	if fake.DeleteOrganizationAuditorByUsernameStub != nil {
+		return fake.DeleteOrganizationAuditorByUsernameStub(orgGUID, username)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getJobReturns.result1, fake.getJobReturns.result2, fake.getJobReturns.result3
+	return fake.deleteOrganizationAuditorByUsernameReturns.result1, fake.deleteOrganizationAuditorByUsernameReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetJobCallCount() int {
-	fake.getJobMutex.RLock()
-	defer fake.getJobMutex.RUnlock()
-	return len(fake.getJobArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteOrganizationAuditorByUsernameCallCount() int {
+	fake.deleteOrganizationAuditorByUsernameMutex.RLock()
+	defer fake.deleteOrganizationAuditorByUsernameMutex.RUnlock()
+	return len(fake.deleteOrganizationAuditorByUsernameArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetJobArgsForCall(i int) string {
-	fake.getJobMutex.RLock()
-	defer fake.getJobMutex.RUnlock()
-	return fake.getJobArgsForCall[i].jobGUID
+func (fake *FakeCloudControllerClient) DeleteOrganizationAuditorByUsernameArgsForCall(i int) (string, string) {
+	fake.deleteOrganizationAuditorByUsernameMutex.RLock()
+	defer fake.deleteOrganizationAuditorByUsernameMutex.RUnlock()
+	return fake.deleteOrganizationAuditorByUsernameArgsForCall[i].orgGUID, fake.deleteOrganizationAuditorByUsernameArgsForCall[i].username
 }
 
-func (fake *FakeCloudControllerClient) GetJobReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
-	fake.GetJobStub = nil
-	fake.getJobReturns = struct {
-		result1 ccv2.Job
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DeleteOrganizationAuditorByUsernameReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationAuditorByUsernameStub = nil
+	fake.deleteOrganizationAuditorByUsernameReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetJobReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
-	fake.GetJobStub = nil
-	if fake.getJobReturnsOnCall == nil {
-		fake.getJobReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Job
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DeleteOrganizationAuditorByUsernameReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationAuditorByUsernameStub = nil
+	if fake.deleteOrganizationAuditorByUsernameReturnsOnCall == nil {
+		fake.deleteOrganizationAuditorByUsernameReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getJobReturnsOnCall[i] = struct {
-		result1 ccv2.Job
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteOrganizationAuditorByUsernameReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganization(guid string) (ccv2.Organization, ccv2.Warnings, error) {
-	fake.getOrganizationMutex.Lock()
-	ret, specificReturn := fake.getOrganizationReturnsOnCall[len(fake.getOrganizationArgsForCall)]
-	fake.getOrganizationArgsForCall = append(fake.getOrganizationArgsForCall, struct {
-		guid string
-	}{guid})
-	fake.recordInvocation("GetOrganization", []interface{}{guid})
-	fake.getOrganizationMutex.Unlock()
-	if fake.GetOrganizationStub != nil {
-		return fake.GetOrganizationStub(guid)
+func (fake *FakeCloudControllerClient) DeleteOrganizationBillingManager(orgGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.deleteOrganizationBillingManagerMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationBillingManagerReturnsOnCall[len(fake.deleteOrganizationBillingManagerArgsForCall)]
+	fake.deleteOrganizationBillingManagerArgsForCall = append(fake.deleteOrganizationBillingManagerArgsForCall, struct {
+		orgGUID  string
+		userGUID string
+	}{orgGUID, userGUID})
+	fake.recordInvocation("DeleteOrganizationBillingManager", []interface{}{orgGUID, userGUID})
+	fake.deleteOrganizationBillingManagerMutex.Unlock()
+	if fake.DeleteOrganizationBillingManagerStub != nil {
+		return fake.DeleteOrganizationBillingManagerStub(orgGUID, userGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getOrganizationReturns.result1, fake.getOrganizationReturns.result2, fake.getOrganizationReturns.result3
+	return fake.deleteOrganizationBillingManagerReturns.result1, fake.deleteOrganizationBillingManagerReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationCallCount() int {
-	fake.getOrganizationMutex.RLock()
-	defer fake.getOrganizationMutex.RUnlock()
-	return len(fake.getOrganizationArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteOrganizationBillingManagerCallCount() int {
+	fake.deleteOrganizationBillingManagerMutex.RLock()
+	defer fake.deleteOrganizationBillingManagerMutex.RUnlock()
+	return len(fake.deleteOrganizationBillingManagerArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationArgsForCall(i int) string {
-	fake.getOrganizationMutex.RLock()
-	defer fake.getOrganizationMutex.RUnlock()
-	return fake.getOrganizationArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) DeleteOrganizationBillingManagerArgsForCall(i int) (string, string) {
+	fake.deleteOrganizationBillingManagerMutex.RLock()
+	defer fake.deleteOrganizationBillingManagerMutex.RUnlock()
+	return fake.deleteOrganizationBillingManagerArgsForCall[i].orgGUID, fake.deleteOrganizationBillingManagerArgsForCall[i].userGUID
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationStub = nil
-	fake.getOrganizationReturns = struct {
-		result1 ccv2.Organization
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DeleteOrganizationBillingManagerReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationBillingManagerStub = nil
+	fake.deleteOrganizationBillingManagerReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationStub = nil
-	if fake.getOrganizationReturnsOnCall == nil {
-		fake.getOrganizationReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Organization
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DeleteOrganizationBillingManagerReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationBillingManagerStub = nil
+	if fake.deleteOrganizationBillingManagerReturnsOnCall == nil {
+		fake.deleteOrganizationBillingManagerReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getOrganizationReturnsOnCall[i] = struct {
-		result1 ccv2.Organization
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteOrganizationBillingManagerReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomains(orgGUID string, queries ...ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error) {
-	fake.getOrganizationPrivateDomainsMutex.Lock()
-	ret, specificReturn := fake.getOrganizationPrivateDomainsReturnsOnCall[len(fake.getOrganizationPrivateDomainsArgsForCall)]
-	fake.getOrganizationPrivateDomainsArgsForCall = append(fake.getOrganizationPrivateDomainsArgsForCall, struct {
-		orgGUID string
-		queries []ccv2.Query
-	}{orgGUID, queries})
-	fake.recordInvocation("GetOrganizationPrivateDomains", []interface{}{orgGUID, queries})
-	fake.getOrganizationPrivateDomainsMutex.Unlock()
-	if fake.GetOrganizationPrivateDomainsStub != nil {
-		return fake.GetOrganizationPrivateDomainsStub(orgGUID, queries...)
+func (fake *FakeCloudControllerClient) DeleteOrganizationBillingManagerByUsername(orgGUID string, username string) (ccv2.Warnings, error) {
+	fake.deleteOrganizationBillingManagerByUsernameMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationBillingManagerByUsernameReturnsOnCall[len(fake.deleteOrganizationBillingManagerByUsernameArgsForCall)]
+	fake.deleteOrganizationBillingManagerByUsernameArgsForCall = append(fake.deleteOrganizationBillingManagerByUsernameArgsForCall, struct {
+		orgGUID  string
+		username string
+	}{orgGUID, username})
+	fake.recordInvocation("DeleteOrganizationBillingManagerByUsername", []interface{}{orgGUID, username})
+	fake.deleteOrganizationBillingManagerByUsernameMutex.Unlock()
+	if fake.DeleteOrganizationBillingManagerByUsernameStub != nil {
+		return fake.DeleteOrganizationBillingManagerByUsernameStub(orgGUID, username)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getOrganizationPrivateDomainsReturns.result1, fake.getOrganizationPrivateDomainsReturns.result2, fake.getOrganizationPrivateDomainsReturns.result3
+	return fake.deleteOrganizationBillingManagerByUsernameReturns.result1, fake.deleteOrganizationBillingManagerByUsernameReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsCallCount() int {
-	fake.getOrganizationPrivateDomainsMutex.RLock()
-	defer fake.getOrganizationPrivateDomainsMutex.RUnlock()
-	return len(fake.getOrganizationPrivateDomainsArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteOrganizationBillingManagerByUsernameCallCount() int {
+	fake.deleteOrganizationBillingManagerByUsernameMutex.RLock()
+	defer fake.deleteOrganizationBillingManagerByUsernameMutex.RUnlock()
+	return len(fake.deleteOrganizationBillingManagerByUsernameArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsArgsForCall(i int) (string, []ccv2.Query) {
-	fake.getOrganizationPrivateDomainsMutex.RLock()
-	defer fake.getOrganizationPrivateDomainsMutex.RUnlock()
-	return fake.getOrganizationPrivateDomainsArgsForCall[i].orgGUID, fake.getOrganizationPrivateDomainsArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) DeleteOrganizationBillingManagerByUsernameArgsForCall(i int) (string, string) {
+	fake.deleteOrganizationBillingManagerByUsernameMutex.RLock()
+	defer fake.deleteOrganizationBillingManagerByUsernameMutex.RUnlock()
+	return fake.deleteOrganizationBillingManagerByUsernameArgsForCall[i].orgGUID, fake.deleteOrganizationBillingManagerByUsernameArgsForCall[i].username
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsReturns(result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationPrivateDomainsStub = nil
-	fake.getOrganizationPrivateDomainsReturns = struct {
-		result1 []ccv2.Domain
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DeleteOrganizationBillingManagerByUsernameReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationBillingManagerByUsernameStub = nil
+	fake.deleteOrganizationBillingManagerByUsernameReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsReturnsOnCall(i int, result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationPrivateDomainsStub = nil
-	if fake.getOrganizationPrivateDomainsReturnsOnCall == nil {
-		fake.getOrganizationPrivateDomainsReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Domain
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DeleteOrganizationBillingManagerByUsernameReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationBillingManagerByUsernameStub = nil
+	if fake.deleteOrganizationBillingManagerByUsernameReturnsOnCall == nil {
+		fake.deleteOrganizationBillingManagerByUsernameReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getOrganizationPrivateDomainsReturnsOnCall[i] = struct {
-		result1 []ccv2.Domain
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteOrganizationBillingManagerByUsernameReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationQuota(guid string) (ccv2.OrganizationQuota, ccv2.Warnings, error) {
-	fake.getOrganizationQuotaMutex.Lock()
-	ret, specificReturn := fake.getOrganizationQuotaReturnsOnCall[len(fake.getOrganizationQuotaArgsForCall)]
-	fake.getOrganizationQuotaArgsForCall = append(fake.getOrganizationQuotaArgsForCall, struct {
-		guid string
-	}{guid})
-	fake.recordInvocation("GetOrganizationQuota", []interface{}{guid})
-	fake.getOrganizationQuotaMutex.Unlock()
-	if fake.GetOrganizationQuotaStub != nil {
-		return fake.GetOrganizationQuotaStub(guid)
+func (fake *FakeCloudControllerClient) DeleteOrganizationManager(orgGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.deleteOrganizationManagerMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationManagerReturnsOnCall[len(fake.deleteOrganizationManagerArgsForCall)]
+	fake.deleteOrganizationManagerArgsForCall = append(fake.deleteOrganizationManagerArgsForCall, struct {
+		orgGUID  string
+		userGUID string
+	}{orgGUID, userGUID})
+	fake.recordInvocation("DeleteOrganizationManager", []interface{}{orgGUID, userGUID})
+	fake.deleteOrganizationManagerMutex.Unlock()
+	if fake.DeleteOrganizationManagerStub != nil {
+		return fake.DeleteOrganizationManagerStub(orgGUID, userGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getOrganizationQuotaReturns.result1, fake.getOrganizationQuotaReturns.result2, fake.getOrganizationQuotaReturns.result3
+	return fake.deleteOrganizationManagerReturns.result1, fake.deleteOrganizationManagerReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationQuotaCallCount() int {
-	fake.getOrganizationQuotaMutex.RLock()
-	defer fake.getOrganizationQuotaMutex.RUnlock()
-	return len(fake.getOrganizationQuotaArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteOrganizationManagerCallCount() int {
+	fake.deleteOrganizationManagerMutex.RLock()
+	defer fake.deleteOrganizationManagerMutex.RUnlock()
+	return len(fake.deleteOrganizationManagerArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationQuotaArgsForCall(i int) string {
-	fake.getOrganizationQuotaMutex.RLock()
-	defer fake.getOrganizationQuotaMutex.RUnlock()
-	return fake.getOrganizationQuotaArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) DeleteOrganizationManagerArgsForCall(i int) (string, string) {
+	fake.deleteOrganizationManagerMutex.RLock()
+	defer fake.deleteOrganizationManagerMutex.RUnlock()
+	return fake.deleteOrganizationManagerArgsForCall[i].orgGUID, fake.deleteOrganizationManagerArgsForCall[i].userGUID
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationQuotaReturns(result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationQuotaStub = nil
-	fake.getOrganizationQuotaReturns = struct {
-		result1 ccv2.OrganizationQuota
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DeleteOrganizationManagerReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationManagerStub = nil
+	fake.deleteOrganizationManagerReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationQuotaReturnsOnCall(i int, result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationQuotaStub = nil
-	if fake.getOrganizationQuotaReturnsOnCall == nil {
-		fake.getOrganizationQuotaReturnsOnCall = make(map[int]struct {
-			result1 ccv2.OrganizationQuota
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DeleteOrganizationManagerReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationManagerStub = nil
+	if fake.deleteOrganizationManagerReturnsOnCall == nil {
+		fake.deleteOrganizationManagerReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getOrganizationQuotaReturnsOnCall[i] = struct {
-		result1 ccv2.OrganizationQuota
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteOrganizationManagerReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizations(queries ...ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error) {
-	fake.getOrganizationsMutex.Lock()
-	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
-	fake.getOrganizationsArgsForCall = append(fake.getOrganizationsArgsForCall, struct {
-		queries []ccv2.Query
-	}{queries})
-	fake.recordInvocation("GetOrganizations", []interface{}{queries})
-	fake.getOrganizationsMutex.Unlock()
-	if fake.GetOrganizationsStub != nil {
-		return fake.GetOrganizationsStub(queries...)
+func (fake *FakeCloudControllerClient) DeleteOrganizationManagerByUsername(orgGUID string, username string) (ccv2.Warnings, error) {
+	fake.deleteOrganizationManagerByUsernameMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationManagerByUsernameReturnsOnCall[len(fake.deleteOrganizationManagerByUsernameArgsForCall)]
+	fake.deleteOrganizationManagerByUsernameArgsForCall = append(fake.deleteOrganizationManagerByUsernameArgsForCall, struct {
+		orgGUID  string
+		username string
+	}{orgGUID, username})
+	fake.recordInvocation("DeleteOrganizationManagerByUsername", []interface{}{orgGUID, username})
+	fake.deleteOrganizationManagerByUsernameMutex.Unlock()
+	if fake.DeleteOrganizationManagerByUsernameStub != nil {
+		return fake.DeleteOrganizationManagerByUsernameStub(orgGUID, username)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getOrganizationsReturns.result1, fake.getOrganizationsReturns.result2, fake.getOrganizationsReturns.result3
+	return fake.deleteOrganizationManagerByUsernameReturns.result1, fake.deleteOrganizationManagerByUsernameReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationsCallCount() int {
-	fake.getOrganizationsMutex.RLock()
-	defer fake.getOrganizationsMutex.RUnlock()
-	return len(fake.getOrganizationsArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteOrganizationManagerByUsernameCallCount() int {
+	fake.deleteOrganizationManagerByUsernameMutex.RLock()
+	defer fake.deleteOrganizationManagerByUsernameMutex.RUnlock()
+	return len(fake.deleteOrganizationManagerByUsernameArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationsArgsForCall(i int) []ccv2.Query {
-	fake.getOrganizationsMutex.RLock()
-	defer fake.getOrganizationsMutex.RUnlock()
-	return fake.getOrganizationsArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) DeleteOrganizationManagerByUsernameArgsForCall(i int) (string, string) {
+	fake.deleteOrganizationManagerByUsernameMutex.RLock()
+	defer fake.deleteOrganizationManagerByUsernameMutex.RUnlock()
+	return fake.deleteOrganizationManagerByUsernameArgsForCall[i].orgGUID, fake.deleteOrganizationManagerByUsernameArgsForCall[i].username
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationsReturns(result1 []ccv2.Organization, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationsStub = nil
-	fake.getOrganizationsReturns = struct {
-		result1 []ccv2.Organization
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DeleteOrganizationManagerByUsernameReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationManagerByUsernameStub = nil
+	fake.deleteOrganizationManagerByUsernameReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationsReturnsOnCall(i int, result1 []ccv2.Organization, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationsStub = nil
-	if fake.getOrganizationsReturnsOnCall == nil {
-		fake.getOrganizationsReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Organization
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DeleteOrganizationManagerByUsernameReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationManagerByUsernameStub = nil
+	if fake.deleteOrganizationManagerByUsernameReturnsOnCall == nil {
+		fake.deleteOrganizationManagerByUsernameReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getOrganizationsReturnsOnCall[i] = struct {
-		result1 []ccv2.Organization
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteOrganizationManagerByUsernameReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetPrivateDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
-	fake.getPrivateDomainMutex.Lock()
-	ret, specificReturn := fake.getPrivateDomainReturnsOnCall[len(fake.getPrivateDomainArgsForCall)]
-	fake.getPrivateDomainArgsForCall = append(fake.getPrivateDomainArgsForCall, struct {
+func (fake *FakeCloudControllerClient) DeletePrivateDomain(domainGUID string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.deletePrivateDomainMutex.Lock()
+	ret, specificReturn := fake.deletePrivateDomainReturnsOnCall[len(fake.deletePrivateDomainArgsForCall)]
+	fake.deletePrivateDomainArgsForCall = append(fake.deletePrivateDomainArgsForCall, struct {
 		domainGUID string
 	}{domainGUID})
-	fake.recordInvocation("GetPrivateDomain", []interface{}{domainGUID})
-	fake.getPrivateDomainMutex.Unlock()
-	if fake.GetPrivateDomainStub != nil {
-		return fake.GetPrivateDomainStub(domainGUID)
+	fake.recordInvocation("DeletePrivateDomain", []interface{}{domainGUID})
+	fake.deletePrivateDomainMutex.Unlock()
+	if fake.DeletePrivateDomainStub != nil {
+		return fake.DeletePrivateDomainStub(domainGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getPrivateDomainReturns.result1, fake.getPrivateDomainReturns.result2, fake.getPrivateDomainReturns.result3
+	return fake.deletePrivateDomainReturns.result1, fake.deletePrivateDomainReturns.result2, fake.deletePrivateDomainReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetPrivateDomainCallCount() int {
-	fake.getPrivateDomainMutex.RLock()
-	defer fake.getPrivateDomainMutex.RUnlock()
-	return len(fake.getPrivateDomainArgsForCall)
+func (fake *FakeCloudControllerClient) DeletePrivateDomainCallCount() int {
+	fake.deletePrivateDomainMutex.RLock()
+	defer fake.deletePrivateDomainMutex.RUnlock()
+	return len(fake.deletePrivateDomainArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetPrivateDomainArgsForCall(i int) string {
-	fake.getPrivateDomainMutex.RLock()
-	defer fake.getPrivateDomainMutex.RUnlock()
-	return fake.getPrivateDomainArgsForCall[i].domainGUID
+func (fake *FakeCloudControllerClient) DeletePrivateDomainArgsForCall(i int) string {
+	fake.deletePrivateDomainMutex.RLock()
+	defer fake.deletePrivateDomainMutex.RUnlock()
+	return fake.deletePrivateDomainArgsForCall[i].domainGUID
 }
 
-func (fake *FakeCloudControllerClient) GetPrivateDomainReturns(result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetPrivateDomainStub = nil
-	fake.getPrivateDomainReturns = struct {
-		result1 ccv2.Domain
+func (fake *FakeCloudControllerClient) DeletePrivateDomainReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeletePrivateDomainStub = nil
+	fake.deletePrivateDomainReturns = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetPrivateDomainReturnsOnCall(i int, result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetPrivateDomainStub = nil
-	if fake.getPrivateDomainReturnsOnCall == nil {
-		fake.getPrivateDomainReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Domain
+func (fake *FakeCloudControllerClient) DeletePrivateDomainReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeletePrivateDomainStub = nil
+	if fake.deletePrivateDomainReturnsOnCall == nil {
+		fake.deletePrivateDomainReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Job
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getPrivateDomainReturnsOnCall[i] = struct {
-		result1 ccv2.Domain
+	fake.deletePrivateDomainReturnsOnCall[i] = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetRouteApplications(routeGUID string, queries ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
-	fake.getRouteApplicationsMutex.Lock()
-	ret, specificReturn := fake.getRouteApplicationsReturnsOnCall[len(fake.getRouteApplicationsArgsForCall)]
-	fake.getRouteApplicationsArgsForCall = append(fake.getRouteApplicationsArgsForCall, struct {
+func (fake *FakeCloudControllerClient) DeleteRoute(routeGUID string) (ccv2.Warnings, error) {
+	fake.deleteRouteMutex.Lock()
+	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
+	fake.deleteRouteArgsForCall = append(fake.deleteRouteArgsForCall, struct {
 		routeGUID string
-		queries   []ccv2.Query
-	}{routeGUID, queries})
-	fake.recordInvocation("GetRouteApplications", []interface{}{routeGUID, queries})
-	fake.getRouteApplicationsMutex.Unlock()
-	if fake.GetRouteApplicationsStub != nil {
-		return fake.GetRouteApplicationsStub(routeGUID, queries...)
+	}{routeGUID})
+	fake.recordInvocation("DeleteRoute", []interface{}{routeGUID})
+	fake.deleteRouteMutex.Unlock()
+	if fake.DeleteRouteStub != nil {
+		return fake.DeleteRouteStub(routeGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getRouteApplicationsReturns.result1, fake.getRouteApplicationsReturns.result2, fake.getRouteApplicationsReturns.result3
+	return fake.deleteRouteReturns.result1, fake.deleteRouteReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetRouteApplicationsCallCount() int {
-	fake.getRouteApplicationsMutex.RLock()
-	defer fake.getRouteApplicationsMutex.RUnlock()
-	return len(fake.getRouteApplicationsArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteRouteCallCount() int {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	return len(fake.deleteRouteArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetRouteApplicationsArgsForCall(i int) (string, []ccv2.Query) {
-	fake.getRouteApplicationsMutex.RLock()
-	defer fake.getRouteApplicationsMutex.RUnlock()
-	return fake.getRouteApplicationsArgsForCall[i].routeGUID, fake.getRouteApplicationsArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) DeleteRouteArgsForCall(i int) string {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	return fake.deleteRouteArgsForCall[i].routeGUID
 }
 
-func (fake *FakeCloudControllerClient) GetRouteApplicationsReturns(result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.GetRouteApplicationsStub = nil
-	fake.getRouteApplicationsReturns = struct {
-		result1 []ccv2.Application
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DeleteRouteReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteRouteStub = nil
+	fake.deleteRouteReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetRouteApplicationsReturnsOnCall(i int, result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.GetRouteApplicationsStub = nil
-	if fake.getRouteApplicationsReturnsOnCall == nil {
-		fake.getRouteApplicationsReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Application
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DeleteRouteReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteRouteStub = nil
+	if fake.deleteRouteReturnsOnCall == nil {
+		fake.deleteRouteReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getRouteApplicationsReturnsOnCall[i] = struct {
-		result1 []ccv2.Application
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteRouteReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetRoutes(queries ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
-	fake.getRoutesMutex.Lock()
-	ret, specificReturn := fake.getRoutesReturnsOnCall[len(fake.getRoutesArgsForCall)]
-	fake.getRoutesArgsForCall = append(fake.getRoutesArgsForCall, struct {
-		queries []ccv2.Query
-	}{queries})
-	fake.recordInvocation("GetRoutes", []interface{}{queries})
-	fake.getRoutesMutex.Unlock()
-	if fake.GetRoutesStub != nil {
-		return fake.GetRoutesStub(queries...)
+func (fake *FakeCloudControllerClient) DeleteSecurityGroup(securityGroupGUID string) (ccv2.Warnings, error) {
+	fake.deleteSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.deleteSecurityGroupReturnsOnCall[len(fake.deleteSecurityGroupArgsForCall)]
+	fake.deleteSecurityGroupArgsForCall = append(fake.deleteSecurityGroupArgsForCall, struct {
+		securityGroupGUID string
+	}{securityGroupGUID})
+	fake.recordInvocation("DeleteSecurityGroup", []interface{}{securityGroupGUID})
+	fake.deleteSecurityGroupMutex.Unlock()
+	if fake.DeleteSecurityGroupStub != nil {
+		return fake.DeleteSecurityGroupStub(securityGroupGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getRoutesReturns.result1, fake.getRoutesReturns.result2, fake.getRoutesReturns.result3
+	return fake.deleteSecurityGroupReturns.result1, fake.deleteSecurityGroupReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetRoutesCallCount() int {
-	fake.getRoutesMutex.RLock()
-	defer fake.getRoutesMutex.RUnlock()
-	return len(fake.getRoutesArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteSecurityGroupCallCount() int {
+	fake.deleteSecurityGroupMutex.RLock()
+	defer fake.deleteSecurityGroupMutex.RUnlock()
+	return len(fake.deleteSecurityGroupArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetRoutesArgsForCall(i int) []ccv2.Query {
-	fake.getRoutesMutex.RLock()
-	defer fake.getRoutesMutex.RUnlock()
-	return fake.getRoutesArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) DeleteSecurityGroupArgsForCall(i int) string {
+	fake.deleteSecurityGroupMutex.RLock()
+	defer fake.deleteSecurityGroupMutex.RUnlock()
+	return fake.deleteSecurityGroupArgsForCall[i].securityGroupGUID
 }
 
-func (fake *FakeCloudControllerClient) GetRoutesReturns(result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
-	fake.GetRoutesStub = nil
-	fake.getRoutesReturns = struct {
-		result1 []ccv2.Route
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DeleteSecurityGroupReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSecurityGroupStub = nil
+	fake.deleteSecurityGroupReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetRoutesReturnsOnCall(i int, result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
-	fake.GetRoutesStub = nil
-	if fake.getRoutesReturnsOnCall == nil {
-		fake.getRoutesReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Route
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DeleteSecurityGroupReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSecurityGroupStub = nil
+	if fake.deleteSecurityGroupReturnsOnCall == nil {
+		fake.deleteSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getRoutesReturnsOnCall[i] = struct {
-		result1 []ccv2.Route
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteSecurityGroupReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetRunningSpacesBySecurityGroup(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error) {
-	fake.getRunningSpacesBySecurityGroupMutex.Lock()
-	ret, specificReturn := fake.getRunningSpacesBySecurityGroupReturnsOnCall[len(fake.getRunningSpacesBySecurityGroupArgsForCall)]
-	fake.getRunningSpacesBySecurityGroupArgsForCall = append(fake.getRunningSpacesBySecurityGroupArgsForCall, struct {
-		securityGroupGUID string
-	}{securityGroupGUID})
-	fake.recordInvocation("GetRunningSpacesBySecurityGroup", []interface{}{securityGroupGUID})
-	fake.getRunningSpacesBySecurityGroupMutex.Unlock()
-	if fake.GetRunningSpacesBySecurityGroupStub != nil {
-		return fake.GetRunningSpacesBySecurityGroupStub(securityGroupGUID)
+func (fake *FakeCloudControllerClient) DeleteServiceBinding(serviceBindingGUID string) (ccv2.Warnings, error) {
+	fake.deleteServiceBindingMutex.Lock()
+	ret, specificReturn := fake.deleteServiceBindingReturnsOnCall[len(fake.deleteServiceBindingArgsForCall)]
+	fake.deleteServiceBindingArgsForCall = append(fake.deleteServiceBindingArgsForCall, struct {
+		serviceBindingGUID string
+	}{serviceBindingGUID})
+	fake.recordInvocation("DeleteServiceBinding", []interface{}{serviceBindingGUID})
+	fake.deleteServiceBindingMutex.Unlock()
+	if fake.DeleteServiceBindingStub != nil {
+		return fake.DeleteServiceBindingStub(serviceBindingGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getRunningSpacesBySecurityGroupReturns.result1, fake.getRunningSpacesBySecurityGroupReturns.result2, fake.getRunningSpacesBySecurityGroupReturns.result3
+	return fake.deleteServiceBindingReturns.result1, fake.deleteServiceBindingReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetRunningSpacesBySecurityGroupCallCount() int {
-	fake.getRunningSpacesBySecurityGroupMutex.RLock()
-	defer fake.getRunningSpacesBySecurityGroupMutex.RUnlock()
-	return len(fake.getRunningSpacesBySecurityGroupArgsForCall)
-}
+func (fake *FakeCloudControllerClient) DeleteServiceBindingCallCount() int {
+	fake.deleteServiceBindingMutex.RLock()
+	defer fake.deleteServiceBindingMutex.RUnlock()
+	return len(fake.deleteServiceBindingArgsForCall)
+}
 
-func (fake *FakeCloudControllerClient) GetRunningSpacesBySecurityGroupArgsForCall(i int) string {
-	fake.getRunningSpacesBySecurityGroupMutex.RLock()
-	defer fake.getRunningSpacesBySecurityGroupMutex.RUnlock()
-	return fake.getRunningSpacesBySecurityGroupArgsForCall[i].securityGroupGUID
+func (fake *FakeCloudControllerClient) DeleteServiceBindingArgsForCall(i int) string {
+	fake.deleteServiceBindingMutex.RLock()
+	defer fake.deleteServiceBindingMutex.RUnlock()
+	return fake.deleteServiceBindingArgsForCall[i].serviceBindingGUID
 }
 
-func (fake *FakeCloudControllerClient) GetRunningSpacesBySecurityGroupReturns(result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
-	fake.GetRunningSpacesBySecurityGroupStub = nil
-	fake.getRunningSpacesBySecurityGroupReturns = struct {
-		result1 []ccv2.Space
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DeleteServiceBindingReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceBindingStub = nil
+	fake.deleteServiceBindingReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetRunningSpacesBySecurityGroupReturnsOnCall(i int, result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
-	fake.GetRunningSpacesBySecurityGroupStub = nil
-	if fake.getRunningSpacesBySecurityGroupReturnsOnCall == nil {
-		fake.getRunningSpacesBySecurityGroupReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Space
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DeleteServiceBindingReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceBindingStub = nil
+	if fake.deleteServiceBindingReturnsOnCall == nil {
+		fake.deleteServiceBindingReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getRunningSpacesBySecurityGroupReturnsOnCall[i] = struct {
-		result1 []ccv2.Space
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteServiceBindingReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetSecurityGroups(queries ...ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
-	fake.getSecurityGroupsMutex.Lock()
-	ret, specificReturn := fake.getSecurityGroupsReturnsOnCall[len(fake.getSecurityGroupsArgsForCall)]
-	fake.getSecurityGroupsArgsForCall = append(fake.getSecurityGroupsArgsForCall, struct {
-		queries []ccv2.Query
-	}{queries})
-	fake.recordInvocation("GetSecurityGroups", []interface{}{queries})
-	fake.getSecurityGroupsMutex.Unlock()
-	if fake.GetSecurityGroupsStub != nil {
-		return fake.GetSecurityGroupsStub(queries...)
+func (fake *FakeCloudControllerClient) DeleteServiceInstance(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
+	fake.deleteServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteServiceInstanceReturnsOnCall[len(fake.deleteServiceInstanceArgsForCall)]
+	fake.deleteServiceInstanceArgsForCall = append(fake.deleteServiceInstanceArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("DeleteServiceInstance", []interface{}{serviceInstanceGUID})
+	fake.deleteServiceInstanceMutex.Unlock()
+	if fake.DeleteServiceInstanceStub != nil {
+		return fake.DeleteServiceInstanceStub(serviceInstanceGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSecurityGroupsReturns.result1, fake.getSecurityGroupsReturns.result2, fake.getSecurityGroupsReturns.result3
+	return fake.deleteServiceInstanceReturns.result1, fake.deleteServiceInstanceReturns.result2, fake.deleteServiceInstanceReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSecurityGroupsCallCount() int {
-	fake.getSecurityGroupsMutex.RLock()
-	defer fake.getSecurityGroupsMutex.RUnlock()
-	return len(fake.getSecurityGroupsArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceCallCount() int {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return len(fake.deleteServiceInstanceArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSecurityGroupsArgsForCall(i int) []ccv2.Query {
-	fake.getSecurityGroupsMutex.RLock()
-	defer fake.getSecurityGroupsMutex.RUnlock()
-	return fake.getSecurityGroupsArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceArgsForCall(i int) string {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return fake.deleteServiceInstanceArgsForCall[i].serviceInstanceGUID
 }
 
-func (fake *FakeCloudControllerClient) GetSecurityGroupsReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
-	fake.GetSecurityGroupsStub = nil
-	fake.getSecurityGroupsReturns = struct {
-		result1 []ccv2.SecurityGroup
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturns(result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteServiceInstanceStub = nil
+	fake.deleteServiceInstanceReturns = struct {
+		result1 ccv2.ServiceInstance
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSecurityGroupsReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
-	fake.GetSecurityGroupsStub = nil
-	if fake.getSecurityGroupsReturnsOnCall == nil {
-		fake.getSecurityGroupsReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.SecurityGroup
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturnsOnCall(i int, result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteServiceInstanceStub = nil
+	if fake.deleteServiceInstanceReturnsOnCall == nil {
+		fake.deleteServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceInstance
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSecurityGroupsReturnsOnCall[i] = struct {
-		result1 []ccv2.SecurityGroup
+	fake.deleteServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceInstance
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetServiceBindings(queries ...ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
-	fake.getServiceBindingsMutex.Lock()
-	ret, specificReturn := fake.getServiceBindingsReturnsOnCall[len(fake.getServiceBindingsArgsForCall)]
-	fake.getServiceBindingsArgsForCall = append(fake.getServiceBindingsArgsForCall, struct {
-		queries []ccv2.Query
-	}{queries})
-	fake.recordInvocation("GetServiceBindings", []interface{}{queries})
-	fake.getServiceBindingsMutex.Unlock()
-	if fake.GetServiceBindingsStub != nil {
-		return fake.GetServiceBindingsStub(queries...)
+func (fake *FakeCloudControllerClient) DeleteServiceKey(guid string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.deleteServiceKeyMutex.Lock()
+	ret, specificReturn := fake.deleteServiceKeyReturnsOnCall[len(fake.deleteServiceKeyArgsForCall)]
+	fake.deleteServiceKeyArgsForCall = append(fake.deleteServiceKeyArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("DeleteServiceKey", []interface{}{guid})
+	fake.deleteServiceKeyMutex.Unlock()
+	if fake.DeleteServiceKeyStub != nil {
+		return fake.DeleteServiceKeyStub(guid)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getServiceBindingsReturns.result1, fake.getServiceBindingsReturns.result2, fake.getServiceBindingsReturns.result3
+	return fake.deleteServiceKeyReturns.result1, fake.deleteServiceKeyReturns.result2, fake.deleteServiceKeyReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetServiceBindingsCallCount() int {
-	fake.getServiceBindingsMutex.RLock()
-	defer fake.getServiceBindingsMutex.RUnlock()
-	return len(fake.getServiceBindingsArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteServiceKeyCallCount() int {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return len(fake.deleteServiceKeyArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetServiceBindingsArgsForCall(i int) []ccv2.Query {
-	fake.getServiceBindingsMutex.RLock()
-	defer fake.getServiceBindingsMutex.RUnlock()
-	return fake.getServiceBindingsArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) DeleteServiceKeyArgsForCall(i int) string {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return fake.deleteServiceKeyArgsForCall[i].guid
 }
 
-func (fake *FakeCloudControllerClient) GetServiceBindingsReturns(result1 []ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
-	fake.GetServiceBindingsStub = nil
-	fake.getServiceBindingsReturns = struct {
-		result1 []ccv2.ServiceBinding
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteServiceKeyStub = nil
+	fake.deleteServiceKeyReturns = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetServiceBindingsReturnsOnCall(i int, result1 []ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
-	fake.GetServiceBindingsStub = nil
-	if fake.getServiceBindingsReturnsOnCall == nil {
-		fake.getServiceBindingsReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.ServiceBinding
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteServiceKeyStub = nil
+	if fake.deleteServiceKeyReturnsOnCall == nil {
+		fake.deleteServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Job
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getServiceBindingsReturnsOnCall[i] = struct {
-		result1 []ccv2.ServiceBinding
+	fake.deleteServiceKeyReturnsOnCall[i] = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstance(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
-	fake.getServiceInstanceMutex.Lock()
-	ret, specificReturn := fake.getServiceInstanceReturnsOnCall[len(fake.getServiceInstanceArgsForCall)]
-	fake.getServiceInstanceArgsForCall = append(fake.getServiceInstanceArgsForCall, struct {
-		serviceInstanceGUID string
-	}{serviceInstanceGUID})
-	fake.recordInvocation("GetServiceInstance", []interface{}{serviceInstanceGUID})
-	fake.getServiceInstanceMutex.Unlock()
-	if fake.GetServiceInstanceStub != nil {
-		return fake.GetServiceInstanceStub(serviceInstanceGUID)
+func (fake *FakeCloudControllerClient) DeleteSpace(spaceGUID string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.deleteSpaceMutex.Lock()
+	ret, specificReturn := fake.deleteSpaceReturnsOnCall[len(fake.deleteSpaceArgsForCall)]
+	fake.deleteSpaceArgsForCall = append(fake.deleteSpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("DeleteSpace", []interface{}{spaceGUID})
+	fake.deleteSpaceMutex.Unlock()
+	if fake.DeleteSpaceStub != nil {
+		return fake.DeleteSpaceStub(spaceGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getServiceInstanceReturns.result1, fake.getServiceInstanceReturns.result2, fake.getServiceInstanceReturns.result3
+	return fake.deleteSpaceReturns.result1, fake.deleteSpaceReturns.result2, fake.deleteSpaceReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstanceCallCount() int {
-	fake.getServiceInstanceMutex.RLock()
-	defer fake.getServiceInstanceMutex.RUnlock()
-	return len(fake.getServiceInstanceArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteSpaceCallCount() int {
+	fake.deleteSpaceMutex.RLock()
+	defer fake.deleteSpaceMutex.RUnlock()
+	return len(fake.deleteSpaceArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstanceArgsForCall(i int) string {
-	fake.getServiceInstanceMutex.RLock()
-	defer fake.getServiceInstanceMutex.RUnlock()
-	return fake.getServiceInstanceArgsForCall[i].serviceInstanceGUID
+func (fake *FakeCloudControllerClient) DeleteSpaceArgsForCall(i int) string {
+	fake.deleteSpaceMutex.RLock()
+	defer fake.deleteSpaceMutex.RUnlock()
+	return fake.deleteSpaceArgsForCall[i].spaceGUID
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstanceReturns(result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetServiceInstanceStub = nil
-	fake.getServiceInstanceReturns = struct {
-		result1 ccv2.ServiceInstance
+func (fake *FakeCloudControllerClient) DeleteSpaceReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteSpaceStub = nil
+	fake.deleteSpaceReturns = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstanceReturnsOnCall(i int, result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetServiceInstanceStub = nil
-	if fake.getServiceInstanceReturnsOnCall == nil {
-		fake.getServiceInstanceReturnsOnCall = make(map[int]struct {
-			result1 ccv2.ServiceInstance
+func (fake *FakeCloudControllerClient) DeleteSpaceReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteSpaceStub = nil
+	if fake.deleteSpaceReturnsOnCall == nil {
+		fake.deleteSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Job
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getServiceInstanceReturnsOnCall[i] = struct {
-		result1 ccv2.ServiceInstance
+	fake.deleteSpaceReturnsOnCall[i] = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstances(queries ...ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
-	fake.getServiceInstancesMutex.Lock()
-	ret, specificReturn := fake.getServiceInstancesReturnsOnCall[len(fake.getServiceInstancesArgsForCall)]
-	fake.getServiceInstancesArgsForCall = append(fake.getServiceInstancesArgsForCall, struct {
-		queries []ccv2.Query
-	}{queries})
-	fake.recordInvocation("GetServiceInstances", []interface{}{queries})
-	fake.getServiceInstancesMutex.Unlock()
-	if fake.GetServiceInstancesStub != nil {
-		return fake.GetServiceInstancesStub(queries...)
+func (fake *FakeCloudControllerClient) DeleteSpaceAuditor(spaceGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.deleteSpaceAuditorMutex.Lock()
+	ret, specificReturn := fake.deleteSpaceAuditorReturnsOnCall[len(fake.deleteSpaceAuditorArgsForCall)]
+	fake.deleteSpaceAuditorArgsForCall = append(fake.deleteSpaceAuditorArgsForCall, struct {
+		spaceGUID string
+		userGUID  string
+	}{spaceGUID, userGUID})
+	fake.recordInvocation("DeleteSpaceAuditor", []interface{}{spaceGUID, userGUID})
+	fake.deleteSpaceAuditorMutex.Unlock()
+	if fake.DeleteSpaceAuditorStub != nil {
+		return fake.DeleteSpaceAuditorStub(spaceGUID, userGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getServiceInstancesReturns.result1, fake.getServiceInstancesReturns.result2, fake.getServiceInstancesReturns.result3
+	return fake.deleteSpaceAuditorReturns.result1, fake.deleteSpaceAuditorReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesCallCount() int {
-	fake.getServiceInstancesMutex.RLock()
-	defer fake.getServiceInstancesMutex.RUnlock()
-	return len(fake.getServiceInstancesArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteSpaceAuditorCallCount() int {
+	fake.deleteSpaceAuditorMutex.RLock()
+	defer fake.deleteSpaceAuditorMutex.RUnlock()
+	return len(fake.deleteSpaceAuditorArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesArgsForCall(i int) []ccv2.Query {
-	fake.getServiceInstancesMutex.RLock()
-	defer fake.getServiceInstancesMutex.RUnlock()
-	return fake.getServiceInstancesArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) DeleteSpaceAuditorArgsForCall(i int) (string, string) {
+	fake.deleteSpaceAuditorMutex.RLock()
+	defer fake.deleteSpaceAuditorMutex.RUnlock()
+	return fake.deleteSpaceAuditorArgsForCall[i].spaceGUID, fake.deleteSpaceAuditorArgsForCall[i].userGUID
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesReturns(result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetServiceInstancesStub = nil
-	fake.getServiceInstancesReturns = struct {
-		result1 []ccv2.ServiceInstance
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DeleteSpaceAuditorReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSpaceAuditorStub = nil
+	fake.deleteSpaceAuditorReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesReturnsOnCall(i int, result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetServiceInstancesStub = nil
-	if fake.getServiceInstancesReturnsOnCall == nil {
-		fake.getServiceInstancesReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.ServiceInstance
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DeleteSpaceAuditorReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSpaceAuditorStub = nil
+	if fake.deleteSpaceAuditorReturnsOnCall == nil {
+		fake.deleteSpaceAuditorReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getServiceInstancesReturnsOnCall[i] = struct {
-		result1 []ccv2.ServiceInstance
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteSpaceAuditorReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
-	fake.getSharedDomainMutex.Lock()
-	ret, specificReturn := fake.getSharedDomainReturnsOnCall[len(fake.getSharedDomainArgsForCall)]
-	fake.getSharedDomainArgsForCall = append(fake.getSharedDomainArgsForCall, struct {
-		domainGUID string
-	}{domainGUID})
-	fake.recordInvocation("GetSharedDomain", []interface{}{domainGUID})
-	fake.getSharedDomainMutex.Unlock()
-	if fake.GetSharedDomainStub != nil {
-		return fake.GetSharedDomainStub(domainGUID)
+func (fake *FakeCloudControllerClient) DeleteSpaceDeveloper(spaceGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.deleteSpaceDeveloperMutex.Lock()
+	ret, specificReturn := fake.deleteSpaceDeveloperReturnsOnCall[len(fake.deleteSpaceDeveloperArgsForCall)]
+	fake.deleteSpaceDeveloperArgsForCall = append(fake.deleteSpaceDeveloperArgsForCall, struct {
+		spaceGUID string
+		userGUID  string
+	}{spaceGUID, userGUID})
+	fake.recordInvocation("DeleteSpaceDeveloper", []interface{}{spaceGUID, userGUID})
+	fake.deleteSpaceDeveloperMutex.Unlock()
+	if fake.DeleteSpaceDeveloperStub != nil {
+		return fake.DeleteSpaceDeveloperStub(spaceGUID, userGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getSharedDomainReturns.result1, fake.getSharedDomainReturns.result2, fake.getSharedDomainReturns.result3
+	return fake.deleteSpaceDeveloperReturns.result1, fake.deleteSpaceDeveloperReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainCallCount() int {
-	fake.getSharedDomainMutex.RLock()
-	defer fake.getSharedDomainMutex.RUnlock()
-	return len(fake.getSharedDomainArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteSpaceDeveloperCallCount() int {
+	fake.deleteSpaceDeveloperMutex.RLock()
+	defer fake.deleteSpaceDeveloperMutex.RUnlock()
+	return len(fake.deleteSpaceDeveloperArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainArgsForCall(i int) string {
-	fake.getSharedDomainMutex.RLock()
-	defer fake.getSharedDomainMutex.RUnlock()
-	return fake.getSharedDomainArgsForCall[i].domainGUID
+func (fake *FakeCloudControllerClient) DeleteSpaceDeveloperArgsForCall(i int) (string, string) {
+	fake.deleteSpaceDeveloperMutex.RLock()
+	defer fake.deleteSpaceDeveloperMutex.RUnlock()
+	return fake.deleteSpaceDeveloperArgsForCall[i].spaceGUID, fake.deleteSpaceDeveloperArgsForCall[i].userGUID
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainReturns(result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetSharedDomainStub = nil
-	fake.getSharedDomainReturns = struct {
-		result1 ccv2.Domain
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DeleteSpaceDeveloperReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSpaceDeveloperStub = nil
+	fake.deleteSpaceDeveloperReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainReturnsOnCall(i int, result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetSharedDomainStub = nil
-	if fake.getSharedDomainReturnsOnCall == nil {
-		fake.getSharedDomainReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Domain
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DeleteSpaceDeveloperReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSpaceDeveloperStub = nil
+	if fake.deleteSpaceDeveloperReturnsOnCall == nil {
+		fake.deleteSpaceDeveloperReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getSharedDomainReturnsOnCall[i] = struct {
-		result1 ccv2.Domain
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteSpaceDeveloperReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomains(queries ...ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error) {
-	fake.getSharedDomainsMutex.Lock()
-	ret, specificReturn := fake.getSharedDomainsReturnsOnCall[len(fake.getSharedDomainsArgsForCall)]
-	fake.getSharedDomainsArgsForCall = append(fake.getSharedDomainsArgsForCall, struct {
-		queries []ccv2.Query
-	}{queries})
-	fake.recordInvocation("GetSharedDomains", []interface{}{queries})
-	fake.getSharedDomainsMutex.Unlock()
-	if fake.GetSharedDomainsStub != nil {
-		return fake.GetSharedDomainsStub(queries...)
+func (fake *FakeCloudControllerClient) DeleteSpaceManager(spaceGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.deleteSpaceManagerMutex.Lock()
+	ret, specificReturn := fake.deleteSpaceManagerReturnsOnCall[len(fake.deleteSpaceManagerArgsForCall)]
+	fake.deleteSpaceManagerArgsForCall = append(fake.deleteSpaceManagerArgsForCall, struct {
+		spaceGUID string
+		userGUID  string
+	}{spaceGUID, userGUID})
+	fake.recordInvocation("DeleteSpaceManager", []interface{}{spaceGUID, userGUID})
+	fake.deleteSpaceManagerMutex.Unlock()
+	if fake.DeleteSpaceManagerStub != nil {
+		return fake.DeleteSpaceManagerStub(spaceGUID, userGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getSharedDomainsReturns.result1, fake.getSharedDomainsReturns.result2, fake.getSharedDomainsReturns.result3
+	return fake.deleteSpaceManagerReturns.result1, fake.deleteSpaceManagerReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainsCallCount() int {
-	fake.getSharedDomainsMutex.RLock()
-	defer fake.getSharedDomainsMutex.RUnlock()
-	return len(fake.getSharedDomainsArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteSpaceManagerCallCount() int {
+	fake.deleteSpaceManagerMutex.RLock()
+	defer fake.deleteSpaceManagerMutex.RUnlock()
+	return len(fake.deleteSpaceManagerArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainsArgsForCall(i int) []ccv2.Query {
-	fake.getSharedDomainsMutex.RLock()
-	defer fake.getSharedDomainsMutex.RUnlock()
-	return fake.getSharedDomainsArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) DeleteSpaceManagerArgsForCall(i int) (string, string) {
+	fake.deleteSpaceManagerMutex.RLock()
+	defer fake.deleteSpaceManagerMutex.RUnlock()
+	return fake.deleteSpaceManagerArgsForCall[i].spaceGUID, fake.deleteSpaceManagerArgsForCall[i].userGUID
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainsReturns(result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetSharedDomainsStub = nil
-	fake.getSharedDomainsReturns = struct {
-		result1 []ccv2.Domain
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DeleteSpaceManagerReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSpaceManagerStub = nil
+	fake.deleteSpaceManagerReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainsReturnsOnCall(i int, result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetSharedDomainsStub = nil
-	if fake.getSharedDomainsReturnsOnCall == nil {
-		fake.getSharedDomainsReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Domain
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DeleteSpaceManagerReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSpaceManagerStub = nil
+	if fake.deleteSpaceManagerReturnsOnCall == nil {
+		fake.deleteSpaceManagerReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getSharedDomainsReturnsOnCall[i] = struct {
-		result1 []ccv2.Domain
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteSpaceManagerReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceQuota(guid string) (ccv2.SpaceQuota, ccv2.Warnings, error) {
-	fake.getSpaceQuotaMutex.Lock()
-	ret, specificReturn := fake.getSpaceQuotaReturnsOnCall[len(fake.getSpaceQuotaArgsForCall)]
-	fake.getSpaceQuotaArgsForCall = append(fake.getSpaceQuotaArgsForCall, struct {
+func (fake *FakeCloudControllerClient) GetApplication(guid string) (ccv2.Application, ccv2.Warnings, error) {
+	fake.getApplicationMutex.Lock()
+	ret, specificReturn := fake.getApplicationReturnsOnCall[len(fake.getApplicationArgsForCall)]
+	fake.getApplicationArgsForCall = append(fake.getApplicationArgsForCall, struct {
 		guid string
 	}{guid})
-	fake.recordInvocation("GetSpaceQuota", []interface{}{guid})
-	fake.getSpaceQuotaMutex.Unlock()
-	if fake.GetSpaceQuotaStub != nil {
-		return fake.GetSpaceQuotaStub(guid)
+	fake.recordInvocation("GetApplication", []interface{}{guid})
+	fake.getApplicationMutex.Unlock()
+	if fake.GetApplicationStub != nil {
+		return fake.GetApplicationStub(guid)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSpaceQuotaReturns.result1, fake.getSpaceQuotaReturns.result2, fake.getSpaceQuotaReturns.result3
+	return fake.getApplicationReturns.result1, fake.getApplicationReturns.result2, fake.getApplicationReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceQuotaCallCount() int {
-	fake.getSpaceQuotaMutex.RLock()
-	defer fake.getSpaceQuotaMutex.RUnlock()
-	return len(fake.getSpaceQuotaArgsForCall)
+func (fake *FakeCloudControllerClient) GetApplicationCallCount() int {
+	fake.getApplicationMutex.RLock()
+	defer fake.getApplicationMutex.RUnlock()
+	return len(fake.getApplicationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceQuotaArgsForCall(i int) string {
-	fake.getSpaceQuotaMutex.RLock()
-	defer fake.getSpaceQuotaMutex.RUnlock()
-	return fake.getSpaceQuotaArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) GetApplicationArgsForCall(i int) string {
+	fake.getApplicationMutex.RLock()
+	defer fake.getApplicationMutex.RUnlock()
+	return fake.getApplicationArgsForCall[i].guid
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceQuotaReturns(result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceQuotaStub = nil
-	fake.getSpaceQuotaReturns = struct {
-		result1 ccv2.SpaceQuota
+func (fake *FakeCloudControllerClient) GetApplicationReturns(result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationStub = nil
+	fake.getApplicationReturns = struct {
+		result1 ccv2.Application
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceQuotaReturnsOnCall(i int, result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceQuotaStub = nil
-	if fake.getSpaceQuotaReturnsOnCall == nil {
-		fake.getSpaceQuotaReturnsOnCall = make(map[int]struct {
-			result1 ccv2.SpaceQuota
+func (fake *FakeCloudControllerClient) GetApplicationReturnsOnCall(i int, result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationStub = nil
+	if fake.getApplicationReturnsOnCall == nil {
+		fake.getApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Application
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSpaceQuotaReturnsOnCall[i] = struct {
-		result1 ccv2.SpaceQuota
+	fake.getApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Application
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRoutes(spaceGUID string, queries ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
-	fake.getSpaceRoutesMutex.Lock()
-	ret, specificReturn := fake.getSpaceRoutesReturnsOnCall[len(fake.getSpaceRoutesArgsForCall)]
-	fake.getSpaceRoutesArgsForCall = append(fake.getSpaceRoutesArgsForCall, struct {
-		spaceGUID string
-		queries   []ccv2.Query
-	}{spaceGUID, queries})
-	fake.recordInvocation("GetSpaceRoutes", []interface{}{spaceGUID, queries})
-	fake.getSpaceRoutesMutex.Unlock()
-	if fake.GetSpaceRoutesStub != nil {
-		return fake.GetSpaceRoutesStub(spaceGUID, queries...)
+func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplication(guid string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error) {
+	fake.getApplicationInstancesByApplicationMutex.Lock()
+	ret, specificReturn := fake.getApplicationInstancesByApplicationReturnsOnCall[len(fake.getApplicationInstancesByApplicationArgsForCall)]
+	fake.getApplicationInstancesByApplicationArgsForCall = append(fake.getApplicationInstancesByApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplicationInstancesByApplication", []interface{}{guid})
+	fake.getApplicationInstancesByApplicationMutex.Unlock()
+	if fake.GetApplicationInstancesByApplicationStub != nil {
+		return fake.GetApplicationInstancesByApplicationStub(guid)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSpaceRoutesReturns.result1, fake.getSpaceRoutesReturns.result2, fake.getSpaceRoutesReturns.result3
+	return fake.getApplicationInstancesByApplicationReturns.result1, fake.getApplicationInstancesByApplicationReturns.result2, fake.getApplicationInstancesByApplicationReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRoutesCallCount() int {
-	fake.getSpaceRoutesMutex.RLock()
-	defer fake.getSpaceRoutesMutex.RUnlock()
-	return len(fake.getSpaceRoutesArgsForCall)
+func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationCallCount() int {
+	fake.getApplicationInstancesByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
+	return len(fake.getApplicationInstancesByApplicationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRoutesArgsForCall(i int) (string, []ccv2.Query) {
-	fake.getSpaceRoutesMutex.RLock()
-	defer fake.getSpaceRoutesMutex.RUnlock()
-	return fake.getSpaceRoutesArgsForCall[i].spaceGUID, fake.getSpaceRoutesArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationArgsForCall(i int) string {
+	fake.getApplicationInstancesByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
+	return fake.getApplicationInstancesByApplicationArgsForCall[i].guid
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRoutesReturns(result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceRoutesStub = nil
-	fake.getSpaceRoutesReturns = struct {
-		result1 []ccv2.Route
+func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationReturns(result1 map[int]ccv2.ApplicationInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationInstancesByApplicationStub = nil
+	fake.getApplicationInstancesByApplicationReturns = struct {
+		result1 map[int]ccv2.ApplicationInstance
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRoutesReturnsOnCall(i int, result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceRoutesStub = nil
-	if fake.getSpaceRoutesReturnsOnCall == nil {
-		fake.getSpaceRoutesReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Route
+func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationReturnsOnCall(i int, result1 map[int]ccv2.ApplicationInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationInstancesByApplicationStub = nil
+	if fake.getApplicationInstancesByApplicationReturnsOnCall == nil {
+		fake.getApplicationInstancesByApplicationReturnsOnCall = make(map[int]struct {
+			result1 map[int]ccv2.ApplicationInstance
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSpaceRoutesReturnsOnCall[i] = struct {
-		result1 []ccv2.Route
+	fake.getApplicationInstancesByApplicationReturnsOnCall[i] = struct {
+		result1 map[int]ccv2.ApplicationInstance
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpace(spaceGUID string, queries ...ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
-	fake.getSpaceRunningSecurityGroupsBySpaceMutex.Lock()
-	ret, specificReturn := fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[len(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall)]
-	fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall = append(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall, struct {
-		spaceGUID string
-		queries   []ccv2.Query
-	}{spaceGUID, queries})
-	fake.recordInvocation("GetSpaceRunningSecurityGroupsBySpace", []interface{}{spaceGUID, queries})
-	fake.getSpaceRunningSecurityGroupsBySpaceMutex.Unlock()
-	if fake.GetSpaceRunningSecurityGroupsBySpaceStub != nil {
-		return fake.GetSpaceRunningSecurityGroupsBySpaceStub(spaceGUID, queries...)
+func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplication(guid string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error) {
+	fake.getApplicationInstanceStatusesByApplicationMutex.Lock()
+	ret, specificReturn := fake.getApplicationInstanceStatusesByApplicationReturnsOnCall[len(fake.getApplicationInstanceStatusesByApplicationArgsForCall)]
+	fake.getApplicationInstanceStatusesByApplicationArgsForCall = append(fake.getApplicationInstanceStatusesByApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplicationInstanceStatusesByApplication", []interface{}{guid})
+	fake.getApplicationInstanceStatusesByApplicationMutex.Unlock()
+	if fake.GetApplicationInstanceStatusesByApplicationStub != nil {
+		return fake.GetApplicationInstanceStatusesByApplicationStub(guid)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSpaceRunningSecurityGroupsBySpaceReturns.result1, fake.getSpaceRunningSecurityGroupsBySpaceReturns.result2, fake.getSpaceRunningSecurityGroupsBySpaceReturns.result3
+	return fake.getApplicationInstanceStatusesByApplicationReturns.result1, fake.getApplicationInstanceStatusesByApplicationReturns.result2, fake.getApplicationInstanceStatusesByApplicationReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceCallCount() int {
-	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
-	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
-	return len(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall)
+func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationCallCount() int {
+	fake.getApplicationInstanceStatusesByApplicationMutex.RLock()
+	defer fake.getApplicationInstanceStatusesByApplicationMutex.RUnlock()
+	return len(fake.getApplicationInstanceStatusesByApplicationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceArgsForCall(i int) (string, []ccv2.Query) {
-	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
-	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
-	return fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall[i].spaceGUID, fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationArgsForCall(i int) string {
+	fake.getApplicationInstanceStatusesByApplicationMutex.RLock()
+	defer fake.getApplicationInstanceStatusesByApplicationMutex.RUnlock()
+	return fake.getApplicationInstanceStatusesByApplicationArgsForCall[i].guid
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
-	fake.getSpaceRunningSecurityGroupsBySpaceReturns = struct {
-		result1 []ccv2.SecurityGroup
+func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationReturns(result1 map[int]ccv2.ApplicationInstanceStatus, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationInstanceStatusesByApplicationStub = nil
+	fake.getApplicationInstanceStatusesByApplicationReturns = struct {
+		result1 map[int]ccv2.ApplicationInstanceStatus
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
-	if fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall == nil {
-		fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.SecurityGroup
+func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationReturnsOnCall(i int, result1 map[int]ccv2.ApplicationInstanceStatus, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationInstanceStatusesByApplicationStub = nil
+	if fake.getApplicationInstanceStatusesByApplicationReturnsOnCall == nil {
+		fake.getApplicationInstanceStatusesByApplicationReturnsOnCall = make(map[int]struct {
+			result1 map[int]ccv2.ApplicationInstanceStatus
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[i] = struct {
-		result1 []ccv2.SecurityGroup
+	fake.getApplicationInstanceStatusesByApplicationReturnsOnCall[i] = struct {
+		result1 map[int]ccv2.ApplicationInstanceStatus
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaces(queries ...ccv2.Query) ([]ccv2.Space, ccv2.Warnings, error) {
-	fake.getSpacesMutex.Lock()
-	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
-	fake.getSpacesArgsForCall = append(fake.getSpacesArgsForCall, struct {
+func (fake *FakeCloudControllerClient) GetApplicationRoutes(appGUID string, queries ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+	fake.getApplicationRoutesMutex.Lock()
+	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
+	fake.getApplicationRoutesArgsForCall = append(fake.getApplicationRoutesArgsForCall, struct {
+		appGUID string
+		queries []ccv2.Query
+	}{appGUID, queries})
+	fake.recordInvocation("GetApplicationRoutes", []interface{}{appGUID, queries})
+	fake.getApplicationRoutesMutex.Unlock()
+	if fake.GetApplicationRoutesStub != nil {
+		return fake.GetApplicationRoutesStub(appGUID, queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationRoutesReturns.result1, fake.getApplicationRoutesReturns.result2, fake.getApplicationRoutesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRoutesCallCount() int {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	return len(fake.getApplicationRoutesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRoutesArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	return fake.getApplicationRoutesArgsForCall[i].appGUID, fake.getApplicationRoutesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRoutesReturns(result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationRoutesStub = nil
+	fake.getApplicationRoutesReturns = struct {
+		result1 []ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRoutesReturnsOnCall(i int, result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationRoutesStub = nil
+	if fake.getApplicationRoutesReturnsOnCall == nil {
+		fake.getApplicationRoutesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Route
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationRoutesReturnsOnCall[i] = struct {
+		result1 []ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplications(queries ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+	fake.getApplicationsMutex.Lock()
+	ret, specificReturn := fake.getApplicationsReturnsOnCall[len(fake.getApplicationsArgsForCall)]
+	fake.getApplicationsArgsForCall = append(fake.getApplicationsArgsForCall, struct {
 		queries []ccv2.Query
 	}{queries})
-	fake.recordInvocation("GetSpaces", []interface{}{queries})
-	fake.getSpacesMutex.Unlock()
-	if fake.GetSpacesStub != nil {
-		return fake.GetSpacesStub(queries...)
+	fake.recordInvocation("GetApplications", []interface{}{queries})
+	fake.getApplicationsMutex.Unlock()
+	if fake.GetApplicationsStub != nil {
+		return fake.GetApplicationsStub(queries...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSpacesReturns.result1, fake.getSpacesReturns.result2, fake.getSpacesReturns.result3
+	return fake.getApplicationsReturns.result1, fake.getApplicationsReturns.result2, fake.getApplicationsReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSpacesCallCount() int {
-	fake.getSpacesMutex.RLock()
-	defer fake.getSpacesMutex.RUnlock()
-	return len(fake.getSpacesArgsForCall)
+func (fake *FakeCloudControllerClient) GetApplicationsCallCount() int {
+	fake.getApplicationsMutex.RLock()
+	defer fake.getApplicationsMutex.RUnlock()
+	return len(fake.getApplicationsArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSpacesArgsForCall(i int) []ccv2.Query {
-	fake.getSpacesMutex.RLock()
-	defer fake.getSpacesMutex.RUnlock()
-	return fake.getSpacesArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) GetApplicationsArgsForCall(i int) []ccv2.Query {
+	fake.getApplicationsMutex.RLock()
+	defer fake.getApplicationsMutex.RUnlock()
+	return fake.getApplicationsArgsForCall[i].queries
 }
 
-func (fake *FakeCloudControllerClient) GetSpacesReturns(result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpacesStub = nil
-	fake.getSpacesReturns = struct {
-		result1 []ccv2.Space
+func (fake *FakeCloudControllerClient) GetApplicationsReturns(result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationsStub = nil
+	fake.getApplicationsReturns = struct {
+		result1 []ccv2.Application
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpacesReturnsOnCall(i int, result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpacesStub = nil
-	if fake.getSpacesReturnsOnCall == nil {
-		fake.getSpacesReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Space
+func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationsStub = nil
+	if fake.getApplicationsReturnsOnCall == nil {
+		fake.getApplicationsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Application
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSpacesReturnsOnCall[i] = struct {
-		result1 []ccv2.Space
+	fake.getApplicationsReturnsOnCall[i] = struct {
+		result1 []ccv2.Application
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceServiceInstances(spaceGUID string, includeUserProvidedServices bool, queries ...ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
-	fake.getSpaceServiceInstancesMutex.Lock()
-	ret, specificReturn := fake.getSpaceServiceInstancesReturnsOnCall[len(fake.getSpaceServiceInstancesArgsForCall)]
-	fake.getSpaceServiceInstancesArgsForCall = append(fake.getSpaceServiceInstancesArgsForCall, struct {
-		spaceGUID                   string
-		includeUserProvidedServices bool
-		queries                     []ccv2.Query
-	}{spaceGUID, includeUserProvidedServices, queries})
-	fake.recordInvocation("GetSpaceServiceInstances", []interface{}{spaceGUID, includeUserProvidedServices, queries})
-	fake.getSpaceServiceInstancesMutex.Unlock()
-	if fake.GetSpaceServiceInstancesStub != nil {
-		return fake.GetSpaceServiceInstancesStub(spaceGUID, includeUserProvidedServices, queries...)
+func (fake *FakeCloudControllerClient) GetApplicationsPaged(handlePage func([]ccv2.Application) error, queries ...ccv2.Query) (ccv2.Warnings, error) {
+	fake.getApplicationsPagedMutex.Lock()
+	ret, specificReturn := fake.getApplicationsPagedReturnsOnCall[len(fake.getApplicationsPagedArgsForCall)]
+	fake.getApplicationsPagedArgsForCall = append(fake.getApplicationsPagedArgsForCall, struct {
+		handlePage func([]ccv2.Application) error
+		queries    []ccv2.Query
+	}{handlePage, queries})
+	fake.recordInvocation("GetApplicationsPaged", []interface{}{handlePage, queries})
+	fake.getApplicationsPagedMutex.Unlock()
+	if fake.GetApplicationsPagedStub != nil {
+		return fake.GetApplicationsPagedStub(handlePage, queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getApplicationsPagedReturns.result1, fake.getApplicationsPagedReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsPagedCallCount() int {
+	fake.getApplicationsPagedMutex.RLock()
+	defer fake.getApplicationsPagedMutex.RUnlock()
+	return len(fake.getApplicationsPagedArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsPagedArgsForCall(i int) (func([]ccv2.Application) error, []ccv2.Query) {
+	fake.getApplicationsPagedMutex.RLock()
+	defer fake.getApplicationsPagedMutex.RUnlock()
+	return fake.getApplicationsPagedArgsForCall[i].handlePage, fake.getApplicationsPagedArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsPagedReturns(result1 ccv2.Warnings, result2 error) {
+	fake.GetApplicationsPagedStub = nil
+	fake.getApplicationsPagedReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsPagedReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.GetApplicationsPagedStub = nil
+	if fake.getApplicationsPagedReturnsOnCall == nil {
+		fake.getApplicationsPagedReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.getApplicationsPagedReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacks(queries ...ccv2.Query) ([]ccv2.Buildpack, ccv2.Warnings, error) {
+	fake.getBuildpacksMutex.Lock()
+	ret, specificReturn := fake.getBuildpacksReturnsOnCall[len(fake.getBuildpacksArgsForCall)]
+	fake.getBuildpacksArgsForCall = append(fake.getBuildpacksArgsForCall, struct {
+		queries []ccv2.Query
+	}{queries})
+	fake.recordInvocation("GetBuildpacks", []interface{}{queries})
+	fake.getBuildpacksMutex.Unlock()
+	if fake.GetBuildpacksStub != nil {
+		return fake.GetBuildpacksStub(queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getBuildpacksReturns.result1, fake.getBuildpacksReturns.result2, fake.getBuildpacksReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksCallCount() int {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return len(fake.getBuildpacksArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksArgsForCall(i int) []ccv2.Query {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return fake.getBuildpacksArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksReturns(result1 []ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	fake.getBuildpacksReturns = struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksReturnsOnCall(i int, result1 []ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	if fake.getBuildpacksReturnsOnCall == nil {
+		fake.getBuildpacksReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Buildpack
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getBuildpacksReturnsOnCall[i] = struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetEnvironmentVariableGroup(name ccv2.EnvironmentVariableGroupName) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error) {
+	fake.getEnvironmentVariableGroupMutex.Lock()
+	ret, specificReturn := fake.getEnvironmentVariableGroupReturnsOnCall[len(fake.getEnvironmentVariableGroupArgsForCall)]
+	fake.getEnvironmentVariableGroupArgsForCall = append(fake.getEnvironmentVariableGroupArgsForCall, struct {
+		name ccv2.EnvironmentVariableGroupName
+	}{name})
+	fake.recordInvocation("GetEnvironmentVariableGroup", []interface{}{name})
+	fake.getEnvironmentVariableGroupMutex.Unlock()
+	if fake.GetEnvironmentVariableGroupStub != nil {
+		return fake.GetEnvironmentVariableGroupStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getEnvironmentVariableGroupReturns.result1, fake.getEnvironmentVariableGroupReturns.result2, fake.getEnvironmentVariableGroupReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetEnvironmentVariableGroupCallCount() int {
+	fake.getEnvironmentVariableGroupMutex.RLock()
+	defer fake.getEnvironmentVariableGroupMutex.RUnlock()
+	return len(fake.getEnvironmentVariableGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetEnvironmentVariableGroupArgsForCall(i int) ccv2.EnvironmentVariableGroupName {
+	fake.getEnvironmentVariableGroupMutex.RLock()
+	defer fake.getEnvironmentVariableGroupMutex.RUnlock()
+	return fake.getEnvironmentVariableGroupArgsForCall[i].name
+}
+
+func (fake *FakeCloudControllerClient) GetEnvironmentVariableGroupReturns(result1 ccv2.EnvironmentVariableGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetEnvironmentVariableGroupStub = nil
+	fake.getEnvironmentVariableGroupReturns = struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetEnvironmentVariableGroupReturnsOnCall(i int, result1 ccv2.EnvironmentVariableGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetEnvironmentVariableGroupStub = nil
+	if fake.getEnvironmentVariableGroupReturnsOnCall == nil {
+		fake.getEnvironmentVariableGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.EnvironmentVariableGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getEnvironmentVariableGroupReturnsOnCall[i] = struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlag(name string) (ccv2.FeatureFlag, ccv2.Warnings, error) {
+	fake.getFeatureFlagMutex.Lock()
+	ret, specificReturn := fake.getFeatureFlagReturnsOnCall[len(fake.getFeatureFlagArgsForCall)]
+	fake.getFeatureFlagArgsForCall = append(fake.getFeatureFlagArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetFeatureFlag", []interface{}{name})
+	fake.getFeatureFlagMutex.Unlock()
+	if fake.GetFeatureFlagStub != nil {
+		return fake.GetFeatureFlagStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getFeatureFlagReturns.result1, fake.getFeatureFlagReturns.result2, fake.getFeatureFlagReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlagCallCount() int {
+	fake.getFeatureFlagMutex.RLock()
+	defer fake.getFeatureFlagMutex.RUnlock()
+	return len(fake.getFeatureFlagArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlagArgsForCall(i int) string {
+	fake.getFeatureFlagMutex.RLock()
+	defer fake.getFeatureFlagMutex.RUnlock()
+	return fake.getFeatureFlagArgsForCall[i].name
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlagReturns(result1 ccv2.FeatureFlag, result2 ccv2.Warnings, result3 error) {
+	fake.GetFeatureFlagStub = nil
+	fake.getFeatureFlagReturns = struct {
+		result1 ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlagReturnsOnCall(i int, result1 ccv2.FeatureFlag, result2 ccv2.Warnings, result3 error) {
+	fake.GetFeatureFlagStub = nil
+	if fake.getFeatureFlagReturnsOnCall == nil {
+		fake.getFeatureFlagReturnsOnCall = make(map[int]struct {
+			result1 ccv2.FeatureFlag
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getFeatureFlagReturnsOnCall[i] = struct {
+		result1 ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlags() ([]ccv2.FeatureFlag, ccv2.Warnings, error) {
+	fake.getFeatureFlagsMutex.Lock()
+	ret, specificReturn := fake.getFeatureFlagsReturnsOnCall[len(fake.getFeatureFlagsArgsForCall)]
+	fake.getFeatureFlagsArgsForCall = append(fake.getFeatureFlagsArgsForCall, struct{}{})
+	fake.recordInvocation("GetFeatureFlags", []interface{}{})
+	fake.getFeatureFlagsMutex.Unlock()
+	if fake.GetFeatureFlagsStub != nil {
+		return fake.GetFeatureFlagsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getFeatureFlagsReturns.result1, fake.getFeatureFlagsReturns.result2, fake.getFeatureFlagsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlagsCallCount() int {
+	fake.getFeatureFlagsMutex.RLock()
+	defer fake.getFeatureFlagsMutex.RUnlock()
+	return len(fake.getFeatureFlagsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlagsReturns(result1 []ccv2.FeatureFlag, result2 ccv2.Warnings, result3 error) {
+	fake.GetFeatureFlagsStub = nil
+	fake.getFeatureFlagsReturns = struct {
+		result1 []ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetFeatureFlagsReturnsOnCall(i int, result1 []ccv2.FeatureFlag, result2 ccv2.Warnings, result3 error) {
+	fake.GetFeatureFlagsStub = nil
+	if fake.getFeatureFlagsReturnsOnCall == nil {
+		fake.getFeatureFlagsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.FeatureFlag
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getFeatureFlagsReturnsOnCall[i] = struct {
+		result1 []ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.getJobMutex.Lock()
+	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
+	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
+		jobGUID string
+	}{jobGUID})
+	fake.recordInvocation("GetJob", []interface{}{jobGUID})
+	fake.getJobMutex.Unlock()
+	if fake.GetJobStub != nil {
+		return fake.GetJobStub(jobGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getJobReturns.result1, fake.getJobReturns.result2, fake.getJobReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetJobCallCount() int {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return len(fake.getJobArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetJobArgsForCall(i int) string {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return fake.getJobArgsForCall[i].jobGUID
+}
+
+func (fake *FakeCloudControllerClient) GetJobReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.GetJobStub = nil
+	fake.getJobReturns = struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetJobReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.GetJobStub = nil
+	if fake.getJobReturnsOnCall == nil {
+		fake.getJobReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Job
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getJobReturnsOnCall[i] = struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganization(guid string) (ccv2.Organization, ccv2.Warnings, error) {
+	fake.getOrganizationMutex.Lock()
+	ret, specificReturn := fake.getOrganizationReturnsOnCall[len(fake.getOrganizationArgsForCall)]
+	fake.getOrganizationArgsForCall = append(fake.getOrganizationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetOrganization", []interface{}{guid})
+	fake.getOrganizationMutex.Unlock()
+	if fake.GetOrganizationStub != nil {
+		return fake.GetOrganizationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationReturns.result1, fake.getOrganizationReturns.result2, fake.getOrganizationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationCallCount() int {
+	fake.getOrganizationMutex.RLock()
+	defer fake.getOrganizationMutex.RUnlock()
+	return len(fake.getOrganizationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationArgsForCall(i int) string {
+	fake.getOrganizationMutex.RLock()
+	defer fake.getOrganizationMutex.RUnlock()
+	return fake.getOrganizationArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationStub = nil
+	fake.getOrganizationReturns = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationStub = nil
+	if fake.getOrganizationReturnsOnCall == nil {
+		fake.getOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Organization
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationReturnsOnCall[i] = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationAuditors(orgGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getOrganizationAuditorsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationAuditorsReturnsOnCall[len(fake.getOrganizationAuditorsArgsForCall)]
+	fake.getOrganizationAuditorsArgsForCall = append(fake.getOrganizationAuditorsArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationAuditors", []interface{}{orgGUID})
+	fake.getOrganizationAuditorsMutex.Unlock()
+	if fake.GetOrganizationAuditorsStub != nil {
+		return fake.GetOrganizationAuditorsStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationAuditorsReturns.result1, fake.getOrganizationAuditorsReturns.result2, fake.getOrganizationAuditorsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationAuditorsCallCount() int {
+	fake.getOrganizationAuditorsMutex.RLock()
+	defer fake.getOrganizationAuditorsMutex.RUnlock()
+	return len(fake.getOrganizationAuditorsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationAuditorsArgsForCall(i int) string {
+	fake.getOrganizationAuditorsMutex.RLock()
+	defer fake.getOrganizationAuditorsMutex.RUnlock()
+	return fake.getOrganizationAuditorsArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationAuditorsReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationAuditorsStub = nil
+	fake.getOrganizationAuditorsReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationAuditorsReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationAuditorsStub = nil
+	if fake.getOrganizationAuditorsReturnsOnCall == nil {
+		fake.getOrganizationAuditorsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationAuditorsReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationBillingManagers(orgGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getOrganizationBillingManagersMutex.Lock()
+	ret, specificReturn := fake.getOrganizationBillingManagersReturnsOnCall[len(fake.getOrganizationBillingManagersArgsForCall)]
+	fake.getOrganizationBillingManagersArgsForCall = append(fake.getOrganizationBillingManagersArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationBillingManagers", []interface{}{orgGUID})
+	fake.getOrganizationBillingManagersMutex.Unlock()
+	if fake.GetOrganizationBillingManagersStub != nil {
+		return fake.GetOrganizationBillingManagersStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationBillingManagersReturns.result1, fake.getOrganizationBillingManagersReturns.result2, fake.getOrganizationBillingManagersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationBillingManagersCallCount() int {
+	fake.getOrganizationBillingManagersMutex.RLock()
+	defer fake.getOrganizationBillingManagersMutex.RUnlock()
+	return len(fake.getOrganizationBillingManagersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationBillingManagersArgsForCall(i int) string {
+	fake.getOrganizationBillingManagersMutex.RLock()
+	defer fake.getOrganizationBillingManagersMutex.RUnlock()
+	return fake.getOrganizationBillingManagersArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationBillingManagersReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationBillingManagersStub = nil
+	fake.getOrganizationBillingManagersReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationBillingManagersReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationBillingManagersStub = nil
+	if fake.getOrganizationBillingManagersReturnsOnCall == nil {
+		fake.getOrganizationBillingManagersReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationBillingManagersReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationManagers(orgGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getOrganizationManagersMutex.Lock()
+	ret, specificReturn := fake.getOrganizationManagersReturnsOnCall[len(fake.getOrganizationManagersArgsForCall)]
+	fake.getOrganizationManagersArgsForCall = append(fake.getOrganizationManagersArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationManagers", []interface{}{orgGUID})
+	fake.getOrganizationManagersMutex.Unlock()
+	if fake.GetOrganizationManagersStub != nil {
+		return fake.GetOrganizationManagersStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationManagersReturns.result1, fake.getOrganizationManagersReturns.result2, fake.getOrganizationManagersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationManagersCallCount() int {
+	fake.getOrganizationManagersMutex.RLock()
+	defer fake.getOrganizationManagersMutex.RUnlock()
+	return len(fake.getOrganizationManagersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationManagersArgsForCall(i int) string {
+	fake.getOrganizationManagersMutex.RLock()
+	defer fake.getOrganizationManagersMutex.RUnlock()
+	return fake.getOrganizationManagersArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationManagersReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationManagersStub = nil
+	fake.getOrganizationManagersReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationManagersReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationManagersStub = nil
+	if fake.getOrganizationManagersReturnsOnCall == nil {
+		fake.getOrganizationManagersReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationManagersReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomains(orgGUID string, queries ...ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error) {
+	fake.getOrganizationPrivateDomainsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationPrivateDomainsReturnsOnCall[len(fake.getOrganizationPrivateDomainsArgsForCall)]
+	fake.getOrganizationPrivateDomainsArgsForCall = append(fake.getOrganizationPrivateDomainsArgsForCall, struct {
+		orgGUID string
+		queries []ccv2.Query
+	}{orgGUID, queries})
+	fake.recordInvocation("GetOrganizationPrivateDomains", []interface{}{orgGUID, queries})
+	fake.getOrganizationPrivateDomainsMutex.Unlock()
+	if fake.GetOrganizationPrivateDomainsStub != nil {
+		return fake.GetOrganizationPrivateDomainsStub(orgGUID, queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationPrivateDomainsReturns.result1, fake.getOrganizationPrivateDomainsReturns.result2, fake.getOrganizationPrivateDomainsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsCallCount() int {
+	fake.getOrganizationPrivateDomainsMutex.RLock()
+	defer fake.getOrganizationPrivateDomainsMutex.RUnlock()
+	return len(fake.getOrganizationPrivateDomainsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getOrganizationPrivateDomainsMutex.RLock()
+	defer fake.getOrganizationPrivateDomainsMutex.RUnlock()
+	return fake.getOrganizationPrivateDomainsArgsForCall[i].orgGUID, fake.getOrganizationPrivateDomainsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsReturns(result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationPrivateDomainsStub = nil
+	fake.getOrganizationPrivateDomainsReturns = struct {
+		result1 []ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsReturnsOnCall(i int, result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationPrivateDomainsStub = nil
+	if fake.getOrganizationPrivateDomainsReturnsOnCall == nil {
+		fake.getOrganizationPrivateDomainsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Domain
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationPrivateDomainsReturnsOnCall[i] = struct {
+		result1 []ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuota(guid string) (ccv2.OrganizationQuota, ccv2.Warnings, error) {
+	fake.getOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.getOrganizationQuotaReturnsOnCall[len(fake.getOrganizationQuotaArgsForCall)]
+	fake.getOrganizationQuotaArgsForCall = append(fake.getOrganizationQuotaArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetOrganizationQuota", []interface{}{guid})
+	fake.getOrganizationQuotaMutex.Unlock()
+	if fake.GetOrganizationQuotaStub != nil {
+		return fake.GetOrganizationQuotaStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationQuotaReturns.result1, fake.getOrganizationQuotaReturns.result2, fake.getOrganizationQuotaReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotaCallCount() int {
+	fake.getOrganizationQuotaMutex.RLock()
+	defer fake.getOrganizationQuotaMutex.RUnlock()
+	return len(fake.getOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotaArgsForCall(i int) string {
+	fake.getOrganizationQuotaMutex.RLock()
+	defer fake.getOrganizationQuotaMutex.RUnlock()
+	return fake.getOrganizationQuotaArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotaReturns(result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotaStub = nil
+	fake.getOrganizationQuotaReturns = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotaReturnsOnCall(i int, result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotaStub = nil
+	if fake.getOrganizationQuotaReturnsOnCall == nil {
+		fake.getOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.OrganizationQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotas(queries ...ccv2.Query) ([]ccv2.OrganizationQuota, ccv2.Warnings, error) {
+	fake.getOrganizationQuotasMutex.Lock()
+	ret, specificReturn := fake.getOrganizationQuotasReturnsOnCall[len(fake.getOrganizationQuotasArgsForCall)]
+	fake.getOrganizationQuotasArgsForCall = append(fake.getOrganizationQuotasArgsForCall, struct {
+		queries []ccv2.Query
+	}{queries})
+	fake.recordInvocation("GetOrganizationQuotas", []interface{}{queries})
+	fake.getOrganizationQuotasMutex.Unlock()
+	if fake.GetOrganizationQuotasStub != nil {
+		return fake.GetOrganizationQuotasStub(queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationQuotasReturns.result1, fake.getOrganizationQuotasReturns.result2, fake.getOrganizationQuotasReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasCallCount() int {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return len(fake.getOrganizationQuotasArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasArgsForCall(i int) []ccv2.Query {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return fake.getOrganizationQuotasArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturns(result1 []ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotasStub = nil
+	fake.getOrganizationQuotasReturns = struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturnsOnCall(i int, result1 []ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotasStub = nil
+	if fake.getOrganizationQuotasReturnsOnCall == nil {
+		fake.getOrganizationQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.OrganizationQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationQuotasReturnsOnCall[i] = struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsers(orgGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getOrganizationUsersMutex.Lock()
+	ret, specificReturn := fake.getOrganizationUsersReturnsOnCall[len(fake.getOrganizationUsersArgsForCall)]
+	fake.getOrganizationUsersArgsForCall = append(fake.getOrganizationUsersArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationUsers", []interface{}{orgGUID})
+	fake.getOrganizationUsersMutex.Unlock()
+	if fake.GetOrganizationUsersStub != nil {
+		return fake.GetOrganizationUsersStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationUsersReturns.result1, fake.getOrganizationUsersReturns.result2, fake.getOrganizationUsersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersCallCount() int {
+	fake.getOrganizationUsersMutex.RLock()
+	defer fake.getOrganizationUsersMutex.RUnlock()
+	return len(fake.getOrganizationUsersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersArgsForCall(i int) string {
+	fake.getOrganizationUsersMutex.RLock()
+	defer fake.getOrganizationUsersMutex.RUnlock()
+	return fake.getOrganizationUsersArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationUsersStub = nil
+	fake.getOrganizationUsersReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationUsersStub = nil
+	if fake.getOrganizationUsersReturnsOnCall == nil {
+		fake.getOrganizationUsersReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationUsersReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizations(queries ...ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error) {
+	fake.getOrganizationsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
+	fake.getOrganizationsArgsForCall = append(fake.getOrganizationsArgsForCall, struct {
+		queries []ccv2.Query
+	}{queries})
+	fake.recordInvocation("GetOrganizations", []interface{}{queries})
+	fake.getOrganizationsMutex.Unlock()
+	if fake.GetOrganizationsStub != nil {
+		return fake.GetOrganizationsStub(queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationsReturns.result1, fake.getOrganizationsReturns.result2, fake.getOrganizationsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsCallCount() int {
+	fake.getOrganizationsMutex.RLock()
+	defer fake.getOrganizationsMutex.RUnlock()
+	return len(fake.getOrganizationsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsArgsForCall(i int) []ccv2.Query {
+	fake.getOrganizationsMutex.RLock()
+	defer fake.getOrganizationsMutex.RUnlock()
+	return fake.getOrganizationsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsReturns(result1 []ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationsStub = nil
+	fake.getOrganizationsReturns = struct {
+		result1 []ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsReturnsOnCall(i int, result1 []ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationsStub = nil
+	if fake.getOrganizationsReturnsOnCall == nil {
+		fake.getOrganizationsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Organization
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationsReturnsOnCall[i] = struct {
+		result1 []ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetPrivateDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
+	fake.getPrivateDomainMutex.Lock()
+	ret, specificReturn := fake.getPrivateDomainReturnsOnCall[len(fake.getPrivateDomainArgsForCall)]
+	fake.getPrivateDomainArgsForCall = append(fake.getPrivateDomainArgsForCall, struct {
+		domainGUID string
+	}{domainGUID})
+	fake.recordInvocation("GetPrivateDomain", []interface{}{domainGUID})
+	fake.getPrivateDomainMutex.Unlock()
+	if fake.GetPrivateDomainStub != nil {
+		return fake.GetPrivateDomainStub(domainGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getPrivateDomainReturns.result1, fake.getPrivateDomainReturns.result2, fake.getPrivateDomainReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetPrivateDomainCallCount() int {
+	fake.getPrivateDomainMutex.RLock()
+	defer fake.getPrivateDomainMutex.RUnlock()
+	return len(fake.getPrivateDomainArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetPrivateDomainArgsForCall(i int) string {
+	fake.getPrivateDomainMutex.RLock()
+	defer fake.getPrivateDomainMutex.RUnlock()
+	return fake.getPrivateDomainArgsForCall[i].domainGUID
+}
+
+func (fake *FakeCloudControllerClient) GetPrivateDomainReturns(result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetPrivateDomainStub = nil
+	fake.getPrivateDomainReturns = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetPrivateDomainReturnsOnCall(i int, result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetPrivateDomainStub = nil
+	if fake.getPrivateDomainReturnsOnCall == nil {
+		fake.getPrivateDomainReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Domain
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getPrivateDomainReturnsOnCall[i] = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteApplications(routeGUID string, queries ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+	fake.getRouteApplicationsMutex.Lock()
+	ret, specificReturn := fake.getRouteApplicationsReturnsOnCall[len(fake.getRouteApplicationsArgsForCall)]
+	fake.getRouteApplicationsArgsForCall = append(fake.getRouteApplicationsArgsForCall, struct {
+		routeGUID string
+		queries   []ccv2.Query
+	}{routeGUID, queries})
+	fake.recordInvocation("GetRouteApplications", []interface{}{routeGUID, queries})
+	fake.getRouteApplicationsMutex.Unlock()
+	if fake.GetRouteApplicationsStub != nil {
+		return fake.GetRouteApplicationsStub(routeGUID, queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRouteApplicationsReturns.result1, fake.getRouteApplicationsReturns.result2, fake.getRouteApplicationsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRouteApplicationsCallCount() int {
+	fake.getRouteApplicationsMutex.RLock()
+	defer fake.getRouteApplicationsMutex.RUnlock()
+	return len(fake.getRouteApplicationsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRouteApplicationsArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getRouteApplicationsMutex.RLock()
+	defer fake.getRouteApplicationsMutex.RUnlock()
+	return fake.getRouteApplicationsArgsForCall[i].routeGUID, fake.getRouteApplicationsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetRouteApplicationsReturns(result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouteApplicationsStub = nil
+	fake.getRouteApplicationsReturns = struct {
+		result1 []ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteApplicationsReturnsOnCall(i int, result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouteApplicationsStub = nil
+	if fake.getRouteApplicationsReturnsOnCall == nil {
+		fake.getRouteApplicationsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Application
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteApplicationsReturnsOnCall[i] = struct {
+		result1 []ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappings(queries ...ccv2.Query) ([]ccv2.RouteMapping, ccv2.Warnings, error) {
+	fake.getRouteMappingsMutex.Lock()
+	ret, specificReturn := fake.getRouteMappingsReturnsOnCall[len(fake.getRouteMappingsArgsForCall)]
+	fake.getRouteMappingsArgsForCall = append(fake.getRouteMappingsArgsForCall, struct {
+		queries []ccv2.Query
+	}{queries})
+	fake.recordInvocation("GetRouteMappings", []interface{}{queries})
+	fake.getRouteMappingsMutex.Unlock()
+	if fake.GetRouteMappingsStub != nil {
+		return fake.GetRouteMappingsStub(queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRouteMappingsReturns.result1, fake.getRouteMappingsReturns.result2, fake.getRouteMappingsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsCallCount() int {
+	fake.getRouteMappingsMutex.RLock()
+	defer fake.getRouteMappingsMutex.RUnlock()
+	return len(fake.getRouteMappingsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsArgsForCall(i int) []ccv2.Query {
+	fake.getRouteMappingsMutex.RLock()
+	defer fake.getRouteMappingsMutex.RUnlock()
+	return fake.getRouteMappingsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsReturns(result1 []ccv2.RouteMapping, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouteMappingsStub = nil
+	fake.getRouteMappingsReturns = struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsReturnsOnCall(i int, result1 []ccv2.RouteMapping, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouteMappingsStub = nil
+	if fake.getRouteMappingsReturnsOnCall == nil {
+		fake.getRouteMappingsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.RouteMapping
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteMappingsReturnsOnCall[i] = struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRoutes(queries ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+	fake.getRoutesMutex.Lock()
+	ret, specificReturn := fake.getRoutesReturnsOnCall[len(fake.getRoutesArgsForCall)]
+	fake.getRoutesArgsForCall = append(fake.getRoutesArgsForCall, struct {
+		queries []ccv2.Query
+	}{queries})
+	fake.recordInvocation("GetRoutes", []interface{}{queries})
+	fake.getRoutesMutex.Unlock()
+	if fake.GetRoutesStub != nil {
+		return fake.GetRoutesStub(queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRoutesReturns.result1, fake.getRoutesReturns.result2, fake.getRoutesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesCallCount() int {
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	return len(fake.getRoutesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesArgsForCall(i int) []ccv2.Query {
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	return fake.getRoutesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesReturns(result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.GetRoutesStub = nil
+	fake.getRoutesReturns = struct {
+		result1 []ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesReturnsOnCall(i int, result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.GetRoutesStub = nil
+	if fake.getRoutesReturnsOnCall == nil {
+		fake.getRoutesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Route
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRoutesReturnsOnCall[i] = struct {
+		result1 []ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesPaged(handlePage func([]ccv2.Route) error, queries ...ccv2.Query) (ccv2.Warnings, error) {
+	fake.getRoutesPagedMutex.Lock()
+	ret, specificReturn := fake.getRoutesPagedReturnsOnCall[len(fake.getRoutesPagedArgsForCall)]
+	fake.getRoutesPagedArgsForCall = append(fake.getRoutesPagedArgsForCall, struct {
+		handlePage func([]ccv2.Route) error
+		queries    []ccv2.Query
+	}{handlePage, queries})
+	fake.recordInvocation("GetRoutesPaged", []interface{}{handlePage, queries})
+	fake.getRoutesPagedMutex.Unlock()
+	if fake.GetRoutesPagedStub != nil {
+		return fake.GetRoutesPagedStub(handlePage, queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getRoutesPagedReturns.result1, fake.getRoutesPagedReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesPagedCallCount() int {
+	fake.getRoutesPagedMutex.RLock()
+	defer fake.getRoutesPagedMutex.RUnlock()
+	return len(fake.getRoutesPagedArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesPagedArgsForCall(i int) (func([]ccv2.Route) error, []ccv2.Query) {
+	fake.getRoutesPagedMutex.RLock()
+	defer fake.getRoutesPagedMutex.RUnlock()
+	return fake.getRoutesPagedArgsForCall[i].handlePage, fake.getRoutesPagedArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesPagedReturns(result1 ccv2.Warnings, result2 error) {
+	fake.GetRoutesPagedStub = nil
+	fake.getRoutesPagedReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesPagedReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.GetRoutesPagedStub = nil
+	if fake.getRoutesPagedReturnsOnCall == nil {
+		fake.getRoutesPagedReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.getRoutesPagedReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetRunningSpacesBySecurityGroup(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error) {
+	fake.getRunningSpacesBySecurityGroupMutex.Lock()
+	ret, specificReturn := fake.getRunningSpacesBySecurityGroupReturnsOnCall[len(fake.getRunningSpacesBySecurityGroupArgsForCall)]
+	fake.getRunningSpacesBySecurityGroupArgsForCall = append(fake.getRunningSpacesBySecurityGroupArgsForCall, struct {
+		securityGroupGUID string
+	}{securityGroupGUID})
+	fake.recordInvocation("GetRunningSpacesBySecurityGroup", []interface{}{securityGroupGUID})
+	fake.getRunningSpacesBySecurityGroupMutex.Unlock()
+	if fake.GetRunningSpacesBySecurityGroupStub != nil {
+		return fake.GetRunningSpacesBySecurityGroupStub(securityGroupGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRunningSpacesBySecurityGroupReturns.result1, fake.getRunningSpacesBySecurityGroupReturns.result2, fake.getRunningSpacesBySecurityGroupReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRunningSpacesBySecurityGroupCallCount() int {
+	fake.getRunningSpacesBySecurityGroupMutex.RLock()
+	defer fake.getRunningSpacesBySecurityGroupMutex.RUnlock()
+	return len(fake.getRunningSpacesBySecurityGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRunningSpacesBySecurityGroupArgsForCall(i int) string {
+	fake.getRunningSpacesBySecurityGroupMutex.RLock()
+	defer fake.getRunningSpacesBySecurityGroupMutex.RUnlock()
+	return fake.getRunningSpacesBySecurityGroupArgsForCall[i].securityGroupGUID
+}
+
+func (fake *FakeCloudControllerClient) GetRunningSpacesBySecurityGroupReturns(result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetRunningSpacesBySecurityGroupStub = nil
+	fake.getRunningSpacesBySecurityGroupReturns = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRunningSpacesBySecurityGroupReturnsOnCall(i int, result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetRunningSpacesBySecurityGroupStub = nil
+	if fake.getRunningSpacesBySecurityGroupReturnsOnCall == nil {
+		fake.getRunningSpacesBySecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRunningSpacesBySecurityGroupReturnsOnCall[i] = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroups(queries ...ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
+	fake.getSecurityGroupsMutex.Lock()
+	ret, specificReturn := fake.getSecurityGroupsReturnsOnCall[len(fake.getSecurityGroupsArgsForCall)]
+	fake.getSecurityGroupsArgsForCall = append(fake.getSecurityGroupsArgsForCall, struct {
+		queries []ccv2.Query
+	}{queries})
+	fake.recordInvocation("GetSecurityGroups", []interface{}{queries})
+	fake.getSecurityGroupsMutex.Unlock()
+	if fake.GetSecurityGroupsStub != nil {
+		return fake.GetSecurityGroupsStub(queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSecurityGroupsReturns.result1, fake.getSecurityGroupsReturns.result2, fake.getSecurityGroupsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupsCallCount() int {
+	fake.getSecurityGroupsMutex.RLock()
+	defer fake.getSecurityGroupsMutex.RUnlock()
+	return len(fake.getSecurityGroupsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupsArgsForCall(i int) []ccv2.Query {
+	fake.getSecurityGroupsMutex.RLock()
+	defer fake.getSecurityGroupsMutex.RUnlock()
+	return fake.getSecurityGroupsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupsReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetSecurityGroupsStub = nil
+	fake.getSecurityGroupsReturns = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupsReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetSecurityGroupsStub = nil
+	if fake.getSecurityGroupsReturnsOnCall == nil {
+		fake.getSecurityGroupsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSecurityGroupsReturnsOnCall[i] = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupsPaged(handlePage func([]ccv2.SecurityGroup) error, queries ...ccv2.Query) (ccv2.Warnings, error) {
+	fake.getSecurityGroupsPagedMutex.Lock()
+	ret, specificReturn := fake.getSecurityGroupsPagedReturnsOnCall[len(fake.getSecurityGroupsPagedArgsForCall)]
+	fake.getSecurityGroupsPagedArgsForCall = append(fake.getSecurityGroupsPagedArgsForCall, struct {
+		handlePage func([]ccv2.SecurityGroup) error
+		queries    []ccv2.Query
+	}{handlePage, queries})
+	fake.recordInvocation("GetSecurityGroupsPaged", []interface{}{handlePage, queries})
+	fake.getSecurityGroupsPagedMutex.Unlock()
+	if fake.GetSecurityGroupsPagedStub != nil {
+		return fake.GetSecurityGroupsPagedStub(handlePage, queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSecurityGroupsPagedReturns.result1, fake.getSecurityGroupsPagedReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupsPagedCallCount() int {
+	fake.getSecurityGroupsPagedMutex.RLock()
+	defer fake.getSecurityGroupsPagedMutex.RUnlock()
+	return len(fake.getSecurityGroupsPagedArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupsPagedArgsForCall(i int) (func([]ccv2.SecurityGroup) error, []ccv2.Query) {
+	fake.getSecurityGroupsPagedMutex.RLock()
+	defer fake.getSecurityGroupsPagedMutex.RUnlock()
+	return fake.getSecurityGroupsPagedArgsForCall[i].handlePage, fake.getSecurityGroupsPagedArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupsPagedReturns(result1 ccv2.Warnings, result2 error) {
+	fake.GetSecurityGroupsPagedStub = nil
+	fake.getSecurityGroupsPagedReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupsPagedReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.GetSecurityGroupsPagedStub = nil
+	if fake.getSecurityGroupsPagedReturnsOnCall == nil {
+		fake.getSecurityGroupsPagedReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.getSecurityGroupsPagedReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetService(serviceGUID string) (ccv2.Service, ccv2.Warnings, error) {
+	fake.getServiceMutex.Lock()
+	ret, specificReturn := fake.getServiceReturnsOnCall[len(fake.getServiceArgsForCall)]
+	fake.getServiceArgsForCall = append(fake.getServiceArgsForCall, struct {
+		serviceGUID string
+	}{serviceGUID})
+	fake.recordInvocation("GetService", []interface{}{serviceGUID})
+	fake.getServiceMutex.Unlock()
+	if fake.GetServiceStub != nil {
+		return fake.GetServiceStub(serviceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceReturns.result1, fake.getServiceReturns.result2, fake.getServiceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceCallCount() int {
+	fake.getServiceMutex.RLock()
+	defer fake.getServiceMutex.RUnlock()
+	return len(fake.getServiceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceArgsForCall(i int) string {
+	fake.getServiceMutex.RLock()
+	defer fake.getServiceMutex.RUnlock()
+	return fake.getServiceArgsForCall[i].serviceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceReturns(result1 ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceStub = nil
+	fake.getServiceReturns = struct {
+		result1 ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceReturnsOnCall(i int, result1 ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceStub = nil
+	if fake.getServiceReturnsOnCall == nil {
+		fake.getServiceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Service
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceReturnsOnCall[i] = struct {
+		result1 ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBinding(serviceBindingGUID string) (ccv2.ServiceBinding, ccv2.Warnings, error) {
+	fake.getServiceBindingMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingReturnsOnCall[len(fake.getServiceBindingArgsForCall)]
+	fake.getServiceBindingArgsForCall = append(fake.getServiceBindingArgsForCall, struct {
+		serviceBindingGUID string
+	}{serviceBindingGUID})
+	fake.recordInvocation("GetServiceBinding", []interface{}{serviceBindingGUID})
+	fake.getServiceBindingMutex.Unlock()
+	if fake.GetServiceBindingStub != nil {
+		return fake.GetServiceBindingStub(serviceBindingGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBindingReturns.result1, fake.getServiceBindingReturns.result2, fake.getServiceBindingReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingCallCount() int {
+	fake.getServiceBindingMutex.RLock()
+	defer fake.getServiceBindingMutex.RUnlock()
+	return len(fake.getServiceBindingArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingArgsForCall(i int) string {
+	fake.getServiceBindingMutex.RLock()
+	defer fake.getServiceBindingMutex.RUnlock()
+	return fake.getServiceBindingArgsForCall[i].serviceBindingGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingReturns(result1 ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBindingStub = nil
+	fake.getServiceBindingReturns = struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingReturnsOnCall(i int, result1 ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBindingStub = nil
+	if fake.getServiceBindingReturnsOnCall == nil {
+		fake.getServiceBindingReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceBinding
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindings(queries ...ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
+	fake.getServiceBindingsMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingsReturnsOnCall[len(fake.getServiceBindingsArgsForCall)]
+	fake.getServiceBindingsArgsForCall = append(fake.getServiceBindingsArgsForCall, struct {
+		queries []ccv2.Query
+	}{queries})
+	fake.recordInvocation("GetServiceBindings", []interface{}{queries})
+	fake.getServiceBindingsMutex.Unlock()
+	if fake.GetServiceBindingsStub != nil {
+		return fake.GetServiceBindingsStub(queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBindingsReturns.result1, fake.getServiceBindingsReturns.result2, fake.getServiceBindingsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingsCallCount() int {
+	fake.getServiceBindingsMutex.RLock()
+	defer fake.getServiceBindingsMutex.RUnlock()
+	return len(fake.getServiceBindingsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingsArgsForCall(i int) []ccv2.Query {
+	fake.getServiceBindingsMutex.RLock()
+	defer fake.getServiceBindingsMutex.RUnlock()
+	return fake.getServiceBindingsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingsReturns(result1 []ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBindingsStub = nil
+	fake.getServiceBindingsReturns = struct {
+		result1 []ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingsReturnsOnCall(i int, result1 []ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBindingsStub = nil
+	if fake.getServiceBindingsReturnsOnCall == nil {
+		fake.getServiceBindingsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceBinding
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingsReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstance(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
+	fake.getServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceReturnsOnCall[len(fake.getServiceInstanceArgsForCall)]
+	fake.getServiceInstanceArgsForCall = append(fake.getServiceInstanceArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("GetServiceInstance", []interface{}{serviceInstanceGUID})
+	fake.getServiceInstanceMutex.Unlock()
+	if fake.GetServiceInstanceStub != nil {
+		return fake.GetServiceInstanceStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceReturns.result1, fake.getServiceInstanceReturns.result2, fake.getServiceInstanceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCallCount() int {
+	fake.getServiceInstanceMutex.RLock()
+	defer fake.getServiceInstanceMutex.RUnlock()
+	return len(fake.getServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceArgsForCall(i int) string {
+	fake.getServiceInstanceMutex.RLock()
+	defer fake.getServiceInstanceMutex.RUnlock()
+	return fake.getServiceInstanceArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceReturns(result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceStub = nil
+	fake.getServiceInstanceReturns = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceReturnsOnCall(i int, result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceStub = nil
+	if fake.getServiceInstanceReturnsOnCall == nil {
+		fake.getServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstances(queries ...ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
+	fake.getServiceInstancesMutex.Lock()
+	ret, specificReturn := fake.getServiceInstancesReturnsOnCall[len(fake.getServiceInstancesArgsForCall)]
+	fake.getServiceInstancesArgsForCall = append(fake.getServiceInstancesArgsForCall, struct {
+		queries []ccv2.Query
+	}{queries})
+	fake.recordInvocation("GetServiceInstances", []interface{}{queries})
+	fake.getServiceInstancesMutex.Unlock()
+	if fake.GetServiceInstancesStub != nil {
+		return fake.GetServiceInstancesStub(queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstancesReturns.result1, fake.getServiceInstancesReturns.result2, fake.getServiceInstancesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesCallCount() int {
+	fake.getServiceInstancesMutex.RLock()
+	defer fake.getServiceInstancesMutex.RUnlock()
+	return len(fake.getServiceInstancesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesArgsForCall(i int) []ccv2.Query {
+	fake.getServiceInstancesMutex.RLock()
+	defer fake.getServiceInstancesMutex.RUnlock()
+	return fake.getServiceInstancesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesReturns(result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstancesStub = nil
+	fake.getServiceInstancesReturns = struct {
+		result1 []ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesReturnsOnCall(i int, result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstancesStub = nil
+	if fake.getServiceInstancesReturnsOnCall == nil {
+		fake.getServiceInstancesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstancesReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeys(queries ...ccv2.Query) ([]ccv2.ServiceKey, ccv2.Warnings, error) {
+	fake.getServiceKeysMutex.Lock()
+	ret, specificReturn := fake.getServiceKeysReturnsOnCall[len(fake.getServiceKeysArgsForCall)]
+	fake.getServiceKeysArgsForCall = append(fake.getServiceKeysArgsForCall, struct {
+		queries []ccv2.Query
+	}{queries})
+	fake.recordInvocation("GetServiceKeys", []interface{}{queries})
+	fake.getServiceKeysMutex.Unlock()
+	if fake.GetServiceKeysStub != nil {
+		return fake.GetServiceKeysStub(queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceKeysReturns.result1, fake.getServiceKeysReturns.result2, fake.getServiceKeysReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysCallCount() int {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	return len(fake.getServiceKeysArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysArgsForCall(i int) []ccv2.Query {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	return fake.getServiceKeysArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysReturns(result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeysStub = nil
+	fake.getServiceKeysReturns = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysReturnsOnCall(i int, result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeysStub = nil
+	if fake.getServiceKeysReturnsOnCall == nil {
+		fake.getServiceKeysReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceKey
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeysReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlan(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error) {
+	fake.getServicePlanMutex.Lock()
+	ret, specificReturn := fake.getServicePlanReturnsOnCall[len(fake.getServicePlanArgsForCall)]
+	fake.getServicePlanArgsForCall = append(fake.getServicePlanArgsForCall, struct {
+		servicePlanGUID string
+	}{servicePlanGUID})
+	fake.recordInvocation("GetServicePlan", []interface{}{servicePlanGUID})
+	fake.getServicePlanMutex.Unlock()
+	if fake.GetServicePlanStub != nil {
+		return fake.GetServicePlanStub(servicePlanGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicePlanReturns.result1, fake.getServicePlanReturns.result2, fake.getServicePlanReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanCallCount() int {
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	return len(fake.getServicePlanArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanArgsForCall(i int) string {
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	return fake.getServicePlanArgsForCall[i].servicePlanGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanReturns(result1 ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlanStub = nil
+	fake.getServicePlanReturns = struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanReturnsOnCall(i int, result1 ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlanStub = nil
+	if fake.getServicePlanReturnsOnCall == nil {
+		fake.getServicePlanReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServicePlan
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlanReturnsOnCall[i] = struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlans(queries ...ccv2.Query) ([]ccv2.ServicePlan, ccv2.Warnings, error) {
+	fake.getServicePlansMutex.Lock()
+	ret, specificReturn := fake.getServicePlansReturnsOnCall[len(fake.getServicePlansArgsForCall)]
+	fake.getServicePlansArgsForCall = append(fake.getServicePlansArgsForCall, struct {
+		queries []ccv2.Query
+	}{queries})
+	fake.recordInvocation("GetServicePlans", []interface{}{queries})
+	fake.getServicePlansMutex.Unlock()
+	if fake.GetServicePlansStub != nil {
+		return fake.GetServicePlansStub(queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicePlansReturns.result1, fake.getServicePlansReturns.result2, fake.getServicePlansReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansCallCount() int {
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	return len(fake.getServicePlansArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansArgsForCall(i int) []ccv2.Query {
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	return fake.getServicePlansArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansReturns(result1 []ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlansStub = nil
+	fake.getServicePlansReturns = struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansReturnsOnCall(i int, result1 []ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlansStub = nil
+	if fake.getServicePlansReturnsOnCall == nil {
+		fake.getServicePlansReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServicePlan
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlansReturnsOnCall[i] = struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServices(queries ...ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error) {
+	fake.getServicesMutex.Lock()
+	ret, specificReturn := fake.getServicesReturnsOnCall[len(fake.getServicesArgsForCall)]
+	fake.getServicesArgsForCall = append(fake.getServicesArgsForCall, struct {
+		queries []ccv2.Query
+	}{queries})
+	fake.recordInvocation("GetServices", []interface{}{queries})
+	fake.getServicesMutex.Unlock()
+	if fake.GetServicesStub != nil {
+		return fake.GetServicesStub(queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicesReturns.result1, fake.getServicesReturns.result2, fake.getServicesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicesCallCount() int {
+	fake.getServicesMutex.RLock()
+	defer fake.getServicesMutex.RUnlock()
+	return len(fake.getServicesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicesArgsForCall(i int) []ccv2.Query {
+	fake.getServicesMutex.RLock()
+	defer fake.getServicesMutex.RUnlock()
+	return fake.getServicesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServicesReturns(result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicesStub = nil
+	fake.getServicesReturns = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicesReturnsOnCall(i int, result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicesStub = nil
+	if fake.getServicesReturnsOnCall == nil {
+		fake.getServicesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Service
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServicesReturnsOnCall[i] = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
+	fake.getSharedDomainMutex.Lock()
+	ret, specificReturn := fake.getSharedDomainReturnsOnCall[len(fake.getSharedDomainArgsForCall)]
+	fake.getSharedDomainArgsForCall = append(fake.getSharedDomainArgsForCall, struct {
+		domainGUID string
+	}{domainGUID})
+	fake.recordInvocation("GetSharedDomain", []interface{}{domainGUID})
+	fake.getSharedDomainMutex.Unlock()
+	if fake.GetSharedDomainStub != nil {
+		return fake.GetSharedDomainStub(domainGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSharedDomainReturns.result1, fake.getSharedDomainReturns.result2, fake.getSharedDomainReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainCallCount() int {
+	fake.getSharedDomainMutex.RLock()
+	defer fake.getSharedDomainMutex.RUnlock()
+	return len(fake.getSharedDomainArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainArgsForCall(i int) string {
+	fake.getSharedDomainMutex.RLock()
+	defer fake.getSharedDomainMutex.RUnlock()
+	return fake.getSharedDomainArgsForCall[i].domainGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainReturns(result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetSharedDomainStub = nil
+	fake.getSharedDomainReturns = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainReturnsOnCall(i int, result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetSharedDomainStub = nil
+	if fake.getSharedDomainReturnsOnCall == nil {
+		fake.getSharedDomainReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Domain
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSharedDomainReturnsOnCall[i] = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomains(queries ...ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error) {
+	fake.getSharedDomainsMutex.Lock()
+	ret, specificReturn := fake.getSharedDomainsReturnsOnCall[len(fake.getSharedDomainsArgsForCall)]
+	fake.getSharedDomainsArgsForCall = append(fake.getSharedDomainsArgsForCall, struct {
+		queries []ccv2.Query
+	}{queries})
+	fake.recordInvocation("GetSharedDomains", []interface{}{queries})
+	fake.getSharedDomainsMutex.Unlock()
+	if fake.GetSharedDomainsStub != nil {
+		return fake.GetSharedDomainsStub(queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSharedDomainsReturns.result1, fake.getSharedDomainsReturns.result2, fake.getSharedDomainsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainsCallCount() int {
+	fake.getSharedDomainsMutex.RLock()
+	defer fake.getSharedDomainsMutex.RUnlock()
+	return len(fake.getSharedDomainsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainsArgsForCall(i int) []ccv2.Query {
+	fake.getSharedDomainsMutex.RLock()
+	defer fake.getSharedDomainsMutex.RUnlock()
+	return fake.getSharedDomainsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainsReturns(result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetSharedDomainsStub = nil
+	fake.getSharedDomainsReturns = struct {
+		result1 []ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainsReturnsOnCall(i int, result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetSharedDomainsStub = nil
+	if fake.getSharedDomainsReturnsOnCall == nil {
+		fake.getSharedDomainsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Domain
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSharedDomainsReturnsOnCall[i] = struct {
+		result1 []ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceAuditors(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getSpaceAuditorsMutex.Lock()
+	ret, specificReturn := fake.getSpaceAuditorsReturnsOnCall[len(fake.getSpaceAuditorsArgsForCall)]
+	fake.getSpaceAuditorsArgsForCall = append(fake.getSpaceAuditorsArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceAuditors", []interface{}{spaceGUID})
+	fake.getSpaceAuditorsMutex.Unlock()
+	if fake.GetSpaceAuditorsStub != nil {
+		return fake.GetSpaceAuditorsStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceAuditorsReturns.result1, fake.getSpaceAuditorsReturns.result2, fake.getSpaceAuditorsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceAuditorsCallCount() int {
+	fake.getSpaceAuditorsMutex.RLock()
+	defer fake.getSpaceAuditorsMutex.RUnlock()
+	return len(fake.getSpaceAuditorsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceAuditorsArgsForCall(i int) string {
+	fake.getSpaceAuditorsMutex.RLock()
+	defer fake.getSpaceAuditorsMutex.RUnlock()
+	return fake.getSpaceAuditorsArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceAuditorsReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceAuditorsStub = nil
+	fake.getSpaceAuditorsReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceAuditorsReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceAuditorsStub = nil
+	if fake.getSpaceAuditorsReturnsOnCall == nil {
+		fake.getSpaceAuditorsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceAuditorsReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceDevelopers(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getSpaceDevelopersMutex.Lock()
+	ret, specificReturn := fake.getSpaceDevelopersReturnsOnCall[len(fake.getSpaceDevelopersArgsForCall)]
+	fake.getSpaceDevelopersArgsForCall = append(fake.getSpaceDevelopersArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceDevelopers", []interface{}{spaceGUID})
+	fake.getSpaceDevelopersMutex.Unlock()
+	if fake.GetSpaceDevelopersStub != nil {
+		return fake.GetSpaceDevelopersStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceDevelopersReturns.result1, fake.getSpaceDevelopersReturns.result2, fake.getSpaceDevelopersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceDevelopersCallCount() int {
+	fake.getSpaceDevelopersMutex.RLock()
+	defer fake.getSpaceDevelopersMutex.RUnlock()
+	return len(fake.getSpaceDevelopersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceDevelopersArgsForCall(i int) string {
+	fake.getSpaceDevelopersMutex.RLock()
+	defer fake.getSpaceDevelopersMutex.RUnlock()
+	return fake.getSpaceDevelopersArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceDevelopersReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceDevelopersStub = nil
+	fake.getSpaceDevelopersReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceDevelopersReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceDevelopersStub = nil
+	if fake.getSpaceDevelopersReturnsOnCall == nil {
+		fake.getSpaceDevelopersReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceDevelopersReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceManagers(spaceGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getSpaceManagersMutex.Lock()
+	ret, specificReturn := fake.getSpaceManagersReturnsOnCall[len(fake.getSpaceManagersArgsForCall)]
+	fake.getSpaceManagersArgsForCall = append(fake.getSpaceManagersArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceManagers", []interface{}{spaceGUID})
+	fake.getSpaceManagersMutex.Unlock()
+	if fake.GetSpaceManagersStub != nil {
+		return fake.GetSpaceManagersStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceManagersReturns.result1, fake.getSpaceManagersReturns.result2, fake.getSpaceManagersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceManagersCallCount() int {
+	fake.getSpaceManagersMutex.RLock()
+	defer fake.getSpaceManagersMutex.RUnlock()
+	return len(fake.getSpaceManagersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceManagersArgsForCall(i int) string {
+	fake.getSpaceManagersMutex.RLock()
+	defer fake.getSpaceManagersMutex.RUnlock()
+	return fake.getSpaceManagersArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceManagersReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceManagersStub = nil
+	fake.getSpaceManagersReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceManagersReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceManagersStub = nil
+	if fake.getSpaceManagersReturnsOnCall == nil {
+		fake.getSpaceManagersReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceManagersReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuota(guid string) (ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.getSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.getSpaceQuotaReturnsOnCall[len(fake.getSpaceQuotaArgsForCall)]
+	fake.getSpaceQuotaArgsForCall = append(fake.getSpaceQuotaArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetSpaceQuota", []interface{}{guid})
+	fake.getSpaceQuotaMutex.Unlock()
+	if fake.GetSpaceQuotaStub != nil {
+		return fake.GetSpaceQuotaStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceQuotaReturns.result1, fake.getSpaceQuotaReturns.result2, fake.getSpaceQuotaReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotaCallCount() int {
+	fake.getSpaceQuotaMutex.RLock()
+	defer fake.getSpaceQuotaMutex.RUnlock()
+	return len(fake.getSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotaArgsForCall(i int) string {
+	fake.getSpaceQuotaMutex.RLock()
+	defer fake.getSpaceQuotaMutex.RUnlock()
+	return fake.getSpaceQuotaArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotaReturns(result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceQuotaStub = nil
+	fake.getSpaceQuotaReturns = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotaReturnsOnCall(i int, result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceQuotaStub = nil
+	if fake.getSpaceQuotaReturnsOnCall == nil {
+		fake.getSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SpaceQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotas(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.getSpaceQuotasMutex.Lock()
+	ret, specificReturn := fake.getSpaceQuotasReturnsOnCall[len(fake.getSpaceQuotasArgsForCall)]
+	fake.getSpaceQuotasArgsForCall = append(fake.getSpaceQuotasArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetSpaceQuotas", []interface{}{orgGUID})
+	fake.getSpaceQuotasMutex.Unlock()
+	if fake.GetSpaceQuotasStub != nil {
+		return fake.GetSpaceQuotasStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceQuotasReturns.result1, fake.getSpaceQuotasReturns.result2, fake.getSpaceQuotasReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasCallCount() int {
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
+	return len(fake.getSpaceQuotasArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasArgsForCall(i int) string {
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
+	return fake.getSpaceQuotasArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasReturns(result1 []ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceQuotasStub = nil
+	fake.getSpaceQuotasReturns = struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasReturnsOnCall(i int, result1 []ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceQuotasStub = nil
+	if fake.getSpaceQuotasReturnsOnCall == nil {
+		fake.getSpaceQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SpaceQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceQuotasReturnsOnCall[i] = struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRoutes(spaceGUID string, queries ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+	fake.getSpaceRoutesMutex.Lock()
+	ret, specificReturn := fake.getSpaceRoutesReturnsOnCall[len(fake.getSpaceRoutesArgsForCall)]
+	fake.getSpaceRoutesArgsForCall = append(fake.getSpaceRoutesArgsForCall, struct {
+		spaceGUID string
+		queries   []ccv2.Query
+	}{spaceGUID, queries})
+	fake.recordInvocation("GetSpaceRoutes", []interface{}{spaceGUID, queries})
+	fake.getSpaceRoutesMutex.Unlock()
+	if fake.GetSpaceRoutesStub != nil {
+		return fake.GetSpaceRoutesStub(spaceGUID, queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceRoutesReturns.result1, fake.getSpaceRoutesReturns.result2, fake.getSpaceRoutesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRoutesCallCount() int {
+	fake.getSpaceRoutesMutex.RLock()
+	defer fake.getSpaceRoutesMutex.RUnlock()
+	return len(fake.getSpaceRoutesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRoutesArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getSpaceRoutesMutex.RLock()
+	defer fake.getSpaceRoutesMutex.RUnlock()
+	return fake.getSpaceRoutesArgsForCall[i].spaceGUID, fake.getSpaceRoutesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRoutesReturns(result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceRoutesStub = nil
+	fake.getSpaceRoutesReturns = struct {
+		result1 []ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRoutesReturnsOnCall(i int, result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceRoutesStub = nil
+	if fake.getSpaceRoutesReturnsOnCall == nil {
+		fake.getSpaceRoutesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Route
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceRoutesReturnsOnCall[i] = struct {
+		result1 []ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRoutesPaged(spaceGUID string, handlePage func([]ccv2.Route) error, queries ...ccv2.Query) (ccv2.Warnings, error) {
+	fake.getSpaceRoutesPagedMutex.Lock()
+	ret, specificReturn := fake.getSpaceRoutesPagedReturnsOnCall[len(fake.getSpaceRoutesPagedArgsForCall)]
+	fake.getSpaceRoutesPagedArgsForCall = append(fake.getSpaceRoutesPagedArgsForCall, struct {
+		spaceGUID  string
+		handlePage func([]ccv2.Route) error
+		queries    []ccv2.Query
+	}{spaceGUID, handlePage, queries})
+	fake.recordInvocation("GetSpaceRoutesPaged", []interface{}{spaceGUID, handlePage, queries})
+	fake.getSpaceRoutesPagedMutex.Unlock()
+	if fake.GetSpaceRoutesPagedStub != nil {
+		return fake.GetSpaceRoutesPagedStub(spaceGUID, handlePage, queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSpaceRoutesPagedReturns.result1, fake.getSpaceRoutesPagedReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRoutesPagedCallCount() int {
+	fake.getSpaceRoutesPagedMutex.RLock()
+	defer fake.getSpaceRoutesPagedMutex.RUnlock()
+	return len(fake.getSpaceRoutesPagedArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRoutesPagedArgsForCall(i int) (string, func([]ccv2.Route) error, []ccv2.Query) {
+	fake.getSpaceRoutesPagedMutex.RLock()
+	defer fake.getSpaceRoutesPagedMutex.RUnlock()
+	return fake.getSpaceRoutesPagedArgsForCall[i].spaceGUID, fake.getSpaceRoutesPagedArgsForCall[i].handlePage, fake.getSpaceRoutesPagedArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRoutesPagedReturns(result1 ccv2.Warnings, result2 error) {
+	fake.GetSpaceRoutesPagedStub = nil
+	fake.getSpaceRoutesPagedReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRoutesPagedReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.GetSpaceRoutesPagedStub = nil
+	if fake.getSpaceRoutesPagedReturnsOnCall == nil {
+		fake.getSpaceRoutesPagedReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.getSpaceRoutesPagedReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpace(spaceGUID string, queries ...ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[len(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall)]
+	fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall = append(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall, struct {
+		spaceGUID string
+		queries   []ccv2.Query
+	}{spaceGUID, queries})
+	fake.recordInvocation("GetSpaceRunningSecurityGroupsBySpace", []interface{}{spaceGUID, queries})
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.Unlock()
+	if fake.GetSpaceRunningSecurityGroupsBySpaceStub != nil {
+		return fake.GetSpaceRunningSecurityGroupsBySpaceStub(spaceGUID, queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceRunningSecurityGroupsBySpaceReturns.result1, fake.getSpaceRunningSecurityGroupsBySpaceReturns.result2, fake.getSpaceRunningSecurityGroupsBySpaceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceCallCount() int {
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
+	return len(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
+	return fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall[i].spaceGUID, fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
+	fake.getSpaceRunningSecurityGroupsBySpaceReturns = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
+	if fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall == nil {
+		fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[i] = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaces(queries ...ccv2.Query) ([]ccv2.Space, ccv2.Warnings, error) {
+	fake.getSpacesMutex.Lock()
+	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
+	fake.getSpacesArgsForCall = append(fake.getSpacesArgsForCall, struct {
+		queries []ccv2.Query
+	}{queries})
+	fake.recordInvocation("GetSpaces", []interface{}{queries})
+	fake.getSpacesMutex.Unlock()
+	if fake.GetSpacesStub != nil {
+		return fake.GetSpacesStub(queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpacesReturns.result1, fake.getSpacesReturns.result2, fake.getSpacesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesCallCount() int {
+	fake.getSpacesMutex.RLock()
+	defer fake.getSpacesMutex.RUnlock()
+	return len(fake.getSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesArgsForCall(i int) []ccv2.Query {
+	fake.getSpacesMutex.RLock()
+	defer fake.getSpacesMutex.RUnlock()
+	return fake.getSpacesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesReturns(result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpacesStub = nil
+	fake.getSpacesReturns = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesReturnsOnCall(i int, result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpacesStub = nil
+	if fake.getSpacesReturnsOnCall == nil {
+		fake.getSpacesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpacesReturnsOnCall[i] = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServiceInstances(spaceGUID string, includeUserProvidedServices bool, queries ...ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
+	fake.getSpaceServiceInstancesMutex.Lock()
+	ret, specificReturn := fake.getSpaceServiceInstancesReturnsOnCall[len(fake.getSpaceServiceInstancesArgsForCall)]
+	fake.getSpaceServiceInstancesArgsForCall = append(fake.getSpaceServiceInstancesArgsForCall, struct {
+		spaceGUID                   string
+		includeUserProvidedServices bool
+		queries                     []ccv2.Query
+	}{spaceGUID, includeUserProvidedServices, queries})
+	fake.recordInvocation("GetSpaceServiceInstances", []interface{}{spaceGUID, includeUserProvidedServices, queries})
+	fake.getSpaceServiceInstancesMutex.Unlock()
+	if fake.GetSpaceServiceInstancesStub != nil {
+		return fake.GetSpaceServiceInstancesStub(spaceGUID, includeUserProvidedServices, queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceServiceInstancesReturns.result1, fake.getSpaceServiceInstancesReturns.result2, fake.getSpaceServiceInstancesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesCallCount() int {
+	fake.getSpaceServiceInstancesMutex.RLock()
+	defer fake.getSpaceServiceInstancesMutex.RUnlock()
+	return len(fake.getSpaceServiceInstancesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesArgsForCall(i int) (string, bool, []ccv2.Query) {
+	fake.getSpaceServiceInstancesMutex.RLock()
+	defer fake.getSpaceServiceInstancesMutex.RUnlock()
+	return fake.getSpaceServiceInstancesArgsForCall[i].spaceGUID, fake.getSpaceServiceInstancesArgsForCall[i].includeUserProvidedServices, fake.getSpaceServiceInstancesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesReturns(result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceServiceInstancesStub = nil
+	fake.getSpaceServiceInstancesReturns = struct {
+		result1 []ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesReturnsOnCall(i int, result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceServiceInstancesStub = nil
+	if fake.getSpaceServiceInstancesReturnsOnCall == nil {
+		fake.getSpaceServiceInstancesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceServiceInstancesReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpace(spaceGUID string, queries ...ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
+	fake.getSpaceStagingSecurityGroupsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall[len(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall)]
+	fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall = append(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall, struct {
+		spaceGUID string
+		queries   []ccv2.Query
+	}{spaceGUID, queries})
+	fake.recordInvocation("GetSpaceStagingSecurityGroupsBySpace", []interface{}{spaceGUID, queries})
+	fake.getSpaceStagingSecurityGroupsBySpaceMutex.Unlock()
+	if fake.GetSpaceStagingSecurityGroupsBySpaceStub != nil {
+		return fake.GetSpaceStagingSecurityGroupsBySpaceStub(spaceGUID, queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceStagingSecurityGroupsBySpaceReturns.result1, fake.getSpaceStagingSecurityGroupsBySpaceReturns.result2, fake.getSpaceStagingSecurityGroupsBySpaceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceCallCount() int {
+	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
+	return len(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
+	return fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall[i].spaceGUID, fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceStagingSecurityGroupsBySpaceStub = nil
+	fake.getSpaceStagingSecurityGroupsBySpaceReturns = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceStagingSecurityGroupsBySpaceStub = nil
+	if fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall == nil {
+		fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall[i] = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetStack(guid string) (ccv2.Stack, ccv2.Warnings, error) {
+	fake.getStackMutex.Lock()
+	ret, specificReturn := fake.getStackReturnsOnCall[len(fake.getStackArgsForCall)]
+	fake.getStackArgsForCall = append(fake.getStackArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetStack", []interface{}{guid})
+	fake.getStackMutex.Unlock()
+	if fake.GetStackStub != nil {
+		return fake.GetStackStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStackReturns.result1, fake.getStackReturns.result2, fake.getStackReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetStackCallCount() int {
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	return len(fake.getStackArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetStackArgsForCall(i int) string {
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	return fake.getStackArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetStackReturns(result1 ccv2.Stack, result2 ccv2.Warnings, result3 error) {
+	fake.GetStackStub = nil
+	fake.getStackReturns = struct {
+		result1 ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetStackReturnsOnCall(i int, result1 ccv2.Stack, result2 ccv2.Warnings, result3 error) {
+	fake.GetStackStub = nil
+	if fake.getStackReturnsOnCall == nil {
+		fake.getStackReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Stack
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getStackReturnsOnCall[i] = struct {
+		result1 ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetStacks(queries ...ccv2.Query) ([]ccv2.Stack, ccv2.Warnings, error) {
+	fake.getStacksMutex.Lock()
+	ret, specificReturn := fake.getStacksReturnsOnCall[len(fake.getStacksArgsForCall)]
+	fake.getStacksArgsForCall = append(fake.getStacksArgsForCall, struct {
+		queries []ccv2.Query
+	}{queries})
+	fake.recordInvocation("GetStacks", []interface{}{queries})
+	fake.getStacksMutex.Unlock()
+	if fake.GetStacksStub != nil {
+		return fake.GetStacksStub(queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStacksReturns.result1, fake.getStacksReturns.result2, fake.getStacksReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetStacksCallCount() int {
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	return len(fake.getStacksArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetStacksArgsForCall(i int) []ccv2.Query {
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	return fake.getStacksArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetStacksReturns(result1 []ccv2.Stack, result2 ccv2.Warnings, result3 error) {
+	fake.GetStacksStub = nil
+	fake.getStacksReturns = struct {
+		result1 []ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetStacksReturnsOnCall(i int, result1 []ccv2.Stack, result2 ccv2.Warnings, result3 error) {
+	fake.GetStacksStub = nil
+	if fake.getStacksReturnsOnCall == nil {
+		fake.getStacksReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Stack
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getStacksReturnsOnCall[i] = struct {
+		result1 []ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetStagingSpacesBySecurityGroup(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error) {
+	fake.getStagingSpacesBySecurityGroupMutex.Lock()
+	ret, specificReturn := fake.getStagingSpacesBySecurityGroupReturnsOnCall[len(fake.getStagingSpacesBySecurityGroupArgsForCall)]
+	fake.getStagingSpacesBySecurityGroupArgsForCall = append(fake.getStagingSpacesBySecurityGroupArgsForCall, struct {
+		securityGroupGUID string
+	}{securityGroupGUID})
+	fake.recordInvocation("GetStagingSpacesBySecurityGroup", []interface{}{securityGroupGUID})
+	fake.getStagingSpacesBySecurityGroupMutex.Unlock()
+	if fake.GetStagingSpacesBySecurityGroupStub != nil {
+		return fake.GetStagingSpacesBySecurityGroupStub(securityGroupGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStagingSpacesBySecurityGroupReturns.result1, fake.getStagingSpacesBySecurityGroupReturns.result2, fake.getStagingSpacesBySecurityGroupReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetStagingSpacesBySecurityGroupCallCount() int {
+	fake.getStagingSpacesBySecurityGroupMutex.RLock()
+	defer fake.getStagingSpacesBySecurityGroupMutex.RUnlock()
+	return len(fake.getStagingSpacesBySecurityGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetStagingSpacesBySecurityGroupArgsForCall(i int) string {
+	fake.getStagingSpacesBySecurityGroupMutex.RLock()
+	defer fake.getStagingSpacesBySecurityGroupMutex.RUnlock()
+	return fake.getStagingSpacesBySecurityGroupArgsForCall[i].securityGroupGUID
+}
+
+func (fake *FakeCloudControllerClient) GetStagingSpacesBySecurityGroupReturns(result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetStagingSpacesBySecurityGroupStub = nil
+	fake.getStagingSpacesBySecurityGroupReturns = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetStagingSpacesBySecurityGroupReturnsOnCall(i int, result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetStagingSpacesBySecurityGroupStub = nil
+	if fake.getStagingSpacesBySecurityGroupReturnsOnCall == nil {
+		fake.getStagingSpacesBySecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getStagingSpacesBySecurityGroupReturnsOnCall[i] = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) PollJob(job ccv2.Job) (ccv2.Warnings, error) {
+	fake.pollJobMutex.Lock()
+	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
+	fake.pollJobArgsForCall = append(fake.pollJobArgsForCall, struct {
+		job ccv2.Job
+	}{job})
+	fake.recordInvocation("PollJob", []interface{}{job})
+	fake.pollJobMutex.Unlock()
+	if fake.PollJobStub != nil {
+		return fake.PollJobStub(job)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.pollJobReturns.result1, fake.pollJobReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) PollJobCallCount() int {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return len(fake.pollJobArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) PollJobArgsForCall(i int) ccv2.Job {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return fake.pollJobArgsForCall[i].job
+}
+
+func (fake *FakeCloudControllerClient) PollJobReturns(result1 ccv2.Warnings, result2 error) {
+	fake.PollJobStub = nil
+	fake.pollJobReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) PollJobReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.PollJobStub = nil
+	if fake.pollJobReturnsOnCall == nil {
+		fake.pollJobReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.pollJobReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) PurgeService(serviceGUID string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.purgeServiceMutex.Lock()
+	ret, specificReturn := fake.purgeServiceReturnsOnCall[len(fake.purgeServiceArgsForCall)]
+	fake.purgeServiceArgsForCall = append(fake.purgeServiceArgsForCall, struct {
+		serviceGUID string
+	}{serviceGUID})
+	fake.recordInvocation("PurgeService", []interface{}{serviceGUID})
+	fake.purgeServiceMutex.Unlock()
+	if fake.PurgeServiceStub != nil {
+		return fake.PurgeServiceStub(serviceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.purgeServiceReturns.result1, fake.purgeServiceReturns.result2, fake.purgeServiceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) PurgeServiceCallCount() int {
+	fake.purgeServiceMutex.RLock()
+	defer fake.purgeServiceMutex.RUnlock()
+	return len(fake.purgeServiceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) PurgeServiceArgsForCall(i int) string {
+	fake.purgeServiceMutex.RLock()
+	defer fake.purgeServiceMutex.RUnlock()
+	return fake.purgeServiceArgsForCall[i].serviceGUID
+}
+
+func (fake *FakeCloudControllerClient) PurgeServiceReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.PurgeServiceStub = nil
+	fake.purgeServiceReturns = struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) PurgeServiceReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.PurgeServiceStub = nil
+	if fake.purgeServiceReturnsOnCall == nil {
+		fake.purgeServiceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Job
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.purgeServiceReturnsOnCall[i] = struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) RemoveSpaceFromRunningSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error) {
+	fake.removeSpaceFromRunningSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.removeSpaceFromRunningSecurityGroupReturnsOnCall[len(fake.removeSpaceFromRunningSecurityGroupArgsForCall)]
+	fake.removeSpaceFromRunningSecurityGroupArgsForCall = append(fake.removeSpaceFromRunningSecurityGroupArgsForCall, struct {
+		securityGroupGUID string
+		spaceGUID         string
+	}{securityGroupGUID, spaceGUID})
+	fake.recordInvocation("RemoveSpaceFromRunningSecurityGroup", []interface{}{securityGroupGUID, spaceGUID})
+	fake.removeSpaceFromRunningSecurityGroupMutex.Unlock()
+	if fake.RemoveSpaceFromRunningSecurityGroupStub != nil {
+		return fake.RemoveSpaceFromRunningSecurityGroupStub(securityGroupGUID, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.removeSpaceFromRunningSecurityGroupReturns.result1, fake.removeSpaceFromRunningSecurityGroupReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) RemoveSpaceFromRunningSecurityGroupCallCount() int {
+	fake.removeSpaceFromRunningSecurityGroupMutex.RLock()
+	defer fake.removeSpaceFromRunningSecurityGroupMutex.RUnlock()
+	return len(fake.removeSpaceFromRunningSecurityGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) RemoveSpaceFromRunningSecurityGroupArgsForCall(i int) (string, string) {
+	fake.removeSpaceFromRunningSecurityGroupMutex.RLock()
+	defer fake.removeSpaceFromRunningSecurityGroupMutex.RUnlock()
+	return fake.removeSpaceFromRunningSecurityGroupArgsForCall[i].securityGroupGUID, fake.removeSpaceFromRunningSecurityGroupArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) RemoveSpaceFromRunningSecurityGroupReturns(result1 ccv2.Warnings, result2 error) {
+	fake.RemoveSpaceFromRunningSecurityGroupStub = nil
+	fake.removeSpaceFromRunningSecurityGroupReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) RemoveSpaceFromRunningSecurityGroupReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.RemoveSpaceFromRunningSecurityGroupStub = nil
+	if fake.removeSpaceFromRunningSecurityGroupReturnsOnCall == nil {
+		fake.removeSpaceFromRunningSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.removeSpaceFromRunningSecurityGroupReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) RemoveSpaceFromStagingSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error) {
+	fake.removeSpaceFromStagingSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.removeSpaceFromStagingSecurityGroupReturnsOnCall[len(fake.removeSpaceFromStagingSecurityGroupArgsForCall)]
+	fake.removeSpaceFromStagingSecurityGroupArgsForCall = append(fake.removeSpaceFromStagingSecurityGroupArgsForCall, struct {
+		securityGroupGUID string
+		spaceGUID         string
+	}{securityGroupGUID, spaceGUID})
+	fake.recordInvocation("RemoveSpaceFromStagingSecurityGroup", []interface{}{securityGroupGUID, spaceGUID})
+	fake.removeSpaceFromStagingSecurityGroupMutex.Unlock()
+	if fake.RemoveSpaceFromStagingSecurityGroupStub != nil {
+		return fake.RemoveSpaceFromStagingSecurityGroupStub(securityGroupGUID, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.removeSpaceFromStagingSecurityGroupReturns.result1, fake.removeSpaceFromStagingSecurityGroupReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) RemoveSpaceFromStagingSecurityGroupCallCount() int {
+	fake.removeSpaceFromStagingSecurityGroupMutex.RLock()
+	defer fake.removeSpaceFromStagingSecurityGroupMutex.RUnlock()
+	return len(fake.removeSpaceFromStagingSecurityGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) RemoveSpaceFromStagingSecurityGroupArgsForCall(i int) (string, string) {
+	fake.removeSpaceFromStagingSecurityGroupMutex.RLock()
+	defer fake.removeSpaceFromStagingSecurityGroupMutex.RUnlock()
+	return fake.removeSpaceFromStagingSecurityGroupArgsForCall[i].securityGroupGUID, fake.removeSpaceFromStagingSecurityGroupArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) RemoveSpaceFromStagingSecurityGroupReturns(result1 ccv2.Warnings, result2 error) {
+	fake.RemoveSpaceFromStagingSecurityGroupStub = nil
+	fake.removeSpaceFromStagingSecurityGroupReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) RemoveSpaceFromStagingSecurityGroupReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.RemoveSpaceFromStagingSecurityGroupStub = nil
+	if fake.removeSpaceFromStagingSecurityGroupReturnsOnCall == nil {
+		fake.removeSpaceFromStagingSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.removeSpaceFromStagingSecurityGroupReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) ResourceMatch(resourcesToMatch []ccv2.Resource) ([]ccv2.Resource, ccv2.Warnings, error) {
+	var resourcesToMatchCopy []ccv2.Resource
+	if resourcesToMatch != nil {
+		resourcesToMatchCopy = make([]ccv2.Resource, len(resourcesToMatch))
+		copy(resourcesToMatchCopy, resourcesToMatch)
+	}
+	fake.resourceMatchMutex.Lock()
+	ret, specificReturn := fake.resourceMatchReturnsOnCall[len(fake.resourceMatchArgsForCall)]
+	fake.resourceMatchArgsForCall = append(fake.resourceMatchArgsForCall, struct {
+		resourcesToMatch []ccv2.Resource
+	}{resourcesToMatchCopy})
+	fake.recordInvocation("ResourceMatch", []interface{}{resourcesToMatchCopy})
+	fake.resourceMatchMutex.Unlock()
+	if fake.ResourceMatchStub != nil {
+		return fake.ResourceMatchStub(resourcesToMatch)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.resourceMatchReturns.result1, fake.resourceMatchReturns.result2, fake.resourceMatchReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) ResourceMatchCallCount() int {
+	fake.resourceMatchMutex.RLock()
+	defer fake.resourceMatchMutex.RUnlock()
+	return len(fake.resourceMatchArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) ResourceMatchArgsForCall(i int) []ccv2.Resource {
+	fake.resourceMatchMutex.RLock()
+	defer fake.resourceMatchMutex.RUnlock()
+	return fake.resourceMatchArgsForCall[i].resourcesToMatch
+}
+
+func (fake *FakeCloudControllerClient) ResourceMatchReturns(result1 []ccv2.Resource, result2 ccv2.Warnings, result3 error) {
+	fake.ResourceMatchStub = nil
+	fake.resourceMatchReturns = struct {
+		result1 []ccv2.Resource
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) ResourceMatchReturnsOnCall(i int, result1 []ccv2.Resource, result2 ccv2.Warnings, result3 error) {
+	fake.ResourceMatchStub = nil
+	if fake.resourceMatchReturnsOnCall == nil {
+		fake.resourceMatchReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Resource
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.resourceMatchReturnsOnCall[i] = struct {
+		result1 []ccv2.Resource
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) RestageApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error) {
+	fake.restageApplicationMutex.Lock()
+	ret, specificReturn := fake.restageApplicationReturnsOnCall[len(fake.restageApplicationArgsForCall)]
+	fake.restageApplicationArgsForCall = append(fake.restageApplicationArgsForCall, struct {
+		app ccv2.Application
+	}{app})
+	fake.recordInvocation("RestageApplication", []interface{}{app})
+	fake.restageApplicationMutex.Unlock()
+	if fake.RestageApplicationStub != nil {
+		return fake.RestageApplicationStub(app)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.restageApplicationReturns.result1, fake.restageApplicationReturns.result2, fake.restageApplicationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) RestageApplicationCallCount() int {
+	fake.restageApplicationMutex.RLock()
+	defer fake.restageApplicationMutex.RUnlock()
+	return len(fake.restageApplicationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) RestageApplicationArgsForCall(i int) ccv2.Application {
+	fake.restageApplicationMutex.RLock()
+	defer fake.restageApplicationMutex.RUnlock()
+	return fake.restageApplicationArgsForCall[i].app
+}
+
+func (fake *FakeCloudControllerClient) RestageApplicationReturns(result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.RestageApplicationStub = nil
+	fake.restageApplicationReturns = struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) RestageApplicationReturnsOnCall(i int, result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.RestageApplicationStub = nil
+	if fake.restageApplicationReturnsOnCall == nil {
+		fake.restageApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Application
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.restageApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) SetSpaceQuota(spaceGUID string, quotaGUID string) (ccv2.Warnings, error) {
+	fake.setSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.setSpaceQuotaReturnsOnCall[len(fake.setSpaceQuotaArgsForCall)]
+	fake.setSpaceQuotaArgsForCall = append(fake.setSpaceQuotaArgsForCall, struct {
+		spaceGUID string
+		quotaGUID string
+	}{spaceGUID, quotaGUID})
+	fake.recordInvocation("SetSpaceQuota", []interface{}{spaceGUID, quotaGUID})
+	fake.setSpaceQuotaMutex.Unlock()
+	if fake.SetSpaceQuotaStub != nil {
+		return fake.SetSpaceQuotaStub(spaceGUID, quotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setSpaceQuotaReturns.result1, fake.setSpaceQuotaReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) SetSpaceQuotaCallCount() int {
+	fake.setSpaceQuotaMutex.RLock()
+	defer fake.setSpaceQuotaMutex.RUnlock()
+	return len(fake.setSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) SetSpaceQuotaArgsForCall(i int) (string, string) {
+	fake.setSpaceQuotaMutex.RLock()
+	defer fake.setSpaceQuotaMutex.RUnlock()
+	return fake.setSpaceQuotaArgsForCall[i].spaceGUID, fake.setSpaceQuotaArgsForCall[i].quotaGUID
+}
+
+func (fake *FakeCloudControllerClient) SetSpaceQuotaReturns(result1 ccv2.Warnings, result2 error) {
+	fake.SetSpaceQuotaStub = nil
+	fake.setSpaceQuotaReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) SetSpaceQuotaReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.SetSpaceQuotaStub = nil
+	if fake.setSpaceQuotaReturnsOnCall == nil {
+		fake.setSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.setSpaceQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error) {
+	fake.targetCFMutex.Lock()
+	ret, specificReturn := fake.targetCFReturnsOnCall[len(fake.targetCFArgsForCall)]
+	fake.targetCFArgsForCall = append(fake.targetCFArgsForCall, struct {
+		settings ccv2.TargetSettings
+	}{settings})
+	fake.recordInvocation("TargetCF", []interface{}{settings})
+	fake.targetCFMutex.Unlock()
+	if fake.TargetCFStub != nil {
+		return fake.TargetCFStub(settings)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.targetCFReturns.result1, fake.targetCFReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) TargetCFCallCount() int {
+	fake.targetCFMutex.RLock()
+	defer fake.targetCFMutex.RUnlock()
+	return len(fake.targetCFArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) TargetCFArgsForCall(i int) ccv2.TargetSettings {
+	fake.targetCFMutex.RLock()
+	defer fake.targetCFMutex.RUnlock()
+	return fake.targetCFArgsForCall[i].settings
+}
+
+func (fake *FakeCloudControllerClient) TargetCFReturns(result1 ccv2.Warnings, result2 error) {
+	fake.TargetCFStub = nil
+	fake.targetCFReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) TargetCFReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.TargetCFStub = nil
+	if fake.targetCFReturnsOnCall == nil {
+		fake.targetCFReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.targetCFReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error) {
+	fake.updateApplicationMutex.Lock()
+	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
+	fake.updateApplicationArgsForCall = append(fake.updateApplicationArgsForCall, struct {
+		app ccv2.Application
+	}{app})
+	fake.recordInvocation("UpdateApplication", []interface{}{app})
+	fake.updateApplicationMutex.Unlock()
+	if fake.UpdateApplicationStub != nil {
+		return fake.UpdateApplicationStub(app)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateApplicationReturns.result1, fake.updateApplicationReturns.result2, fake.updateApplicationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationCallCount() int {
+	fake.updateApplicationMutex.RLock()
+	defer fake.updateApplicationMutex.RUnlock()
+	return len(fake.updateApplicationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationArgsForCall(i int) ccv2.Application {
+	fake.updateApplicationMutex.RLock()
+	defer fake.updateApplicationMutex.RUnlock()
+	return fake.updateApplicationArgsForCall[i].app
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationReturns(result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateApplicationStub = nil
+	fake.updateApplicationReturns = struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationReturnsOnCall(i int, result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateApplicationStub = nil
+	if fake.updateApplicationReturnsOnCall == nil {
+		fake.updateApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Application
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateBuildpack(buildpack ccv2.Buildpack) (ccv2.Buildpack, ccv2.Warnings, error) {
+	fake.updateBuildpackMutex.Lock()
+	ret, specificReturn := fake.updateBuildpackReturnsOnCall[len(fake.updateBuildpackArgsForCall)]
+	fake.updateBuildpackArgsForCall = append(fake.updateBuildpackArgsForCall, struct {
+		buildpack ccv2.Buildpack
+	}{buildpack})
+	fake.recordInvocation("UpdateBuildpack", []interface{}{buildpack})
+	fake.updateBuildpackMutex.Unlock()
+	if fake.UpdateBuildpackStub != nil {
+		return fake.UpdateBuildpackStub(buildpack)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateBuildpackReturns.result1, fake.updateBuildpackReturns.result2, fake.updateBuildpackReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateBuildpackCallCount() int {
+	fake.updateBuildpackMutex.RLock()
+	defer fake.updateBuildpackMutex.RUnlock()
+	return len(fake.updateBuildpackArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateBuildpackArgsForCall(i int) ccv2.Buildpack {
+	fake.updateBuildpackMutex.RLock()
+	defer fake.updateBuildpackMutex.RUnlock()
+	return fake.updateBuildpackArgsForCall[i].buildpack
+}
+
+func (fake *FakeCloudControllerClient) UpdateBuildpackReturns(result1 ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateBuildpackStub = nil
+	fake.updateBuildpackReturns = struct {
+		result1 ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateBuildpackReturnsOnCall(i int, result1 ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateBuildpackStub = nil
+	if fake.updateBuildpackReturnsOnCall == nil {
+		fake.updateBuildpackReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Buildpack
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateBuildpackReturnsOnCall[i] = struct {
+		result1 ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateEnvironmentVariableGroup(name ccv2.EnvironmentVariableGroupName, group ccv2.EnvironmentVariableGroup) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error) {
+	fake.updateEnvironmentVariableGroupMutex.Lock()
+	ret, specificReturn := fake.updateEnvironmentVariableGroupReturnsOnCall[len(fake.updateEnvironmentVariableGroupArgsForCall)]
+	fake.updateEnvironmentVariableGroupArgsForCall = append(fake.updateEnvironmentVariableGroupArgsForCall, struct {
+		name  ccv2.EnvironmentVariableGroupName
+		group ccv2.EnvironmentVariableGroup
+	}{name, group})
+	fake.recordInvocation("UpdateEnvironmentVariableGroup", []interface{}{name, group})
+	fake.updateEnvironmentVariableGroupMutex.Unlock()
+	if fake.UpdateEnvironmentVariableGroupStub != nil {
+		return fake.UpdateEnvironmentVariableGroupStub(name, group)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateEnvironmentVariableGroupReturns.result1, fake.updateEnvironmentVariableGroupReturns.result2, fake.updateEnvironmentVariableGroupReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateEnvironmentVariableGroupCallCount() int {
+	fake.updateEnvironmentVariableGroupMutex.RLock()
+	defer fake.updateEnvironmentVariableGroupMutex.RUnlock()
+	return len(fake.updateEnvironmentVariableGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateEnvironmentVariableGroupArgsForCall(i int) (ccv2.EnvironmentVariableGroupName, ccv2.EnvironmentVariableGroup) {
+	fake.updateEnvironmentVariableGroupMutex.RLock()
+	defer fake.updateEnvironmentVariableGroupMutex.RUnlock()
+	return fake.updateEnvironmentVariableGroupArgsForCall[i].name, fake.updateEnvironmentVariableGroupArgsForCall[i].group
+}
+
+func (fake *FakeCloudControllerClient) UpdateEnvironmentVariableGroupReturns(result1 ccv2.EnvironmentVariableGroup, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateEnvironmentVariableGroupStub = nil
+	fake.updateEnvironmentVariableGroupReturns = struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateEnvironmentVariableGroupReturnsOnCall(i int, result1 ccv2.EnvironmentVariableGroup, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateEnvironmentVariableGroupStub = nil
+	if fake.updateEnvironmentVariableGroupReturnsOnCall == nil {
+		fake.updateEnvironmentVariableGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.EnvironmentVariableGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateEnvironmentVariableGroupReturnsOnCall[i] = struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateFeatureFlag(featureFlag ccv2.FeatureFlag) (ccv2.Warnings, error) {
+	fake.updateFeatureFlagMutex.Lock()
+	ret, specificReturn := fake.updateFeatureFlagReturnsOnCall[len(fake.updateFeatureFlagArgsForCall)]
+	fake.updateFeatureFlagArgsForCall = append(fake.updateFeatureFlagArgsForCall, struct {
+		featureFlag ccv2.FeatureFlag
+	}{featureFlag})
+	fake.recordInvocation("UpdateFeatureFlag", []interface{}{featureFlag})
+	fake.updateFeatureFlagMutex.Unlock()
+	if fake.UpdateFeatureFlagStub != nil {
+		return fake.UpdateFeatureFlagStub(featureFlag)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateFeatureFlagReturns.result1, fake.updateFeatureFlagReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateFeatureFlagCallCount() int {
+	fake.updateFeatureFlagMutex.RLock()
+	defer fake.updateFeatureFlagMutex.RUnlock()
+	return len(fake.updateFeatureFlagArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateFeatureFlagArgsForCall(i int) ccv2.FeatureFlag {
+	fake.updateFeatureFlagMutex.RLock()
+	defer fake.updateFeatureFlagMutex.RUnlock()
+	return fake.updateFeatureFlagArgsForCall[i].featureFlag
+}
+
+func (fake *FakeCloudControllerClient) UpdateFeatureFlagReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateFeatureFlagStub = nil
+	fake.updateFeatureFlagReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateFeatureFlagReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateFeatureFlagStub = nil
+	if fake.updateFeatureFlagReturnsOnCall == nil {
+		fake.updateFeatureFlagReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateFeatureFlagReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationAuditor(orgGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.updateOrganizationAuditorMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationAuditorReturnsOnCall[len(fake.updateOrganizationAuditorArgsForCall)]
+	fake.updateOrganizationAuditorArgsForCall = append(fake.updateOrganizationAuditorArgsForCall, struct {
+		orgGUID  string
+		userGUID string
+	}{orgGUID, userGUID})
+	fake.recordInvocation("UpdateOrganizationAuditor", []interface{}{orgGUID, userGUID})
+	fake.updateOrganizationAuditorMutex.Unlock()
+	if fake.UpdateOrganizationAuditorStub != nil {
+		return fake.UpdateOrganizationAuditorStub(orgGUID, userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationAuditorReturns.result1, fake.updateOrganizationAuditorReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationAuditorCallCount() int {
+	fake.updateOrganizationAuditorMutex.RLock()
+	defer fake.updateOrganizationAuditorMutex.RUnlock()
+	return len(fake.updateOrganizationAuditorArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationAuditorArgsForCall(i int) (string, string) {
+	fake.updateOrganizationAuditorMutex.RLock()
+	defer fake.updateOrganizationAuditorMutex.RUnlock()
+	return fake.updateOrganizationAuditorArgsForCall[i].orgGUID, fake.updateOrganizationAuditorArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationAuditorReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationAuditorStub = nil
+	fake.updateOrganizationAuditorReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationAuditorReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationAuditorStub = nil
+	if fake.updateOrganizationAuditorReturnsOnCall == nil {
+		fake.updateOrganizationAuditorReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationAuditorReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationAuditorByUsername(orgGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateOrganizationAuditorByUsernameMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationAuditorByUsernameReturnsOnCall[len(fake.updateOrganizationAuditorByUsernameArgsForCall)]
+	fake.updateOrganizationAuditorByUsernameArgsForCall = append(fake.updateOrganizationAuditorByUsernameArgsForCall, struct {
+		orgGUID  string
+		username string
+	}{orgGUID, username})
+	fake.recordInvocation("UpdateOrganizationAuditorByUsername", []interface{}{orgGUID, username})
+	fake.updateOrganizationAuditorByUsernameMutex.Unlock()
+	if fake.UpdateOrganizationAuditorByUsernameStub != nil {
+		return fake.UpdateOrganizationAuditorByUsernameStub(orgGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationAuditorByUsernameReturns.result1, fake.updateOrganizationAuditorByUsernameReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationAuditorByUsernameCallCount() int {
+	fake.updateOrganizationAuditorByUsernameMutex.RLock()
+	defer fake.updateOrganizationAuditorByUsernameMutex.RUnlock()
+	return len(fake.updateOrganizationAuditorByUsernameArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationAuditorByUsernameArgsForCall(i int) (string, string) {
+	fake.updateOrganizationAuditorByUsernameMutex.RLock()
+	defer fake.updateOrganizationAuditorByUsernameMutex.RUnlock()
+	return fake.updateOrganizationAuditorByUsernameArgsForCall[i].orgGUID, fake.updateOrganizationAuditorByUsernameArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationAuditorByUsernameReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationAuditorByUsernameStub = nil
+	fake.updateOrganizationAuditorByUsernameReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationAuditorByUsernameReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationAuditorByUsernameStub = nil
+	if fake.updateOrganizationAuditorByUsernameReturnsOnCall == nil {
+		fake.updateOrganizationAuditorByUsernameReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationAuditorByUsernameReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationBillingManager(orgGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.updateOrganizationBillingManagerMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationBillingManagerReturnsOnCall[len(fake.updateOrganizationBillingManagerArgsForCall)]
+	fake.updateOrganizationBillingManagerArgsForCall = append(fake.updateOrganizationBillingManagerArgsForCall, struct {
+		orgGUID  string
+		userGUID string
+	}{orgGUID, userGUID})
+	fake.recordInvocation("UpdateOrganizationBillingManager", []interface{}{orgGUID, userGUID})
+	fake.updateOrganizationBillingManagerMutex.Unlock()
+	if fake.UpdateOrganizationBillingManagerStub != nil {
+		return fake.UpdateOrganizationBillingManagerStub(orgGUID, userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationBillingManagerReturns.result1, fake.updateOrganizationBillingManagerReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationBillingManagerCallCount() int {
+	fake.updateOrganizationBillingManagerMutex.RLock()
+	defer fake.updateOrganizationBillingManagerMutex.RUnlock()
+	return len(fake.updateOrganizationBillingManagerArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationBillingManagerArgsForCall(i int) (string, string) {
+	fake.updateOrganizationBillingManagerMutex.RLock()
+	defer fake.updateOrganizationBillingManagerMutex.RUnlock()
+	return fake.updateOrganizationBillingManagerArgsForCall[i].orgGUID, fake.updateOrganizationBillingManagerArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationBillingManagerReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationBillingManagerStub = nil
+	fake.updateOrganizationBillingManagerReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationBillingManagerReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationBillingManagerStub = nil
+	if fake.updateOrganizationBillingManagerReturnsOnCall == nil {
+		fake.updateOrganizationBillingManagerReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationBillingManagerReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationBillingManagerByUsername(orgGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateOrganizationBillingManagerByUsernameMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationBillingManagerByUsernameReturnsOnCall[len(fake.updateOrganizationBillingManagerByUsernameArgsForCall)]
+	fake.updateOrganizationBillingManagerByUsernameArgsForCall = append(fake.updateOrganizationBillingManagerByUsernameArgsForCall, struct {
+		orgGUID  string
+		username string
+	}{orgGUID, username})
+	fake.recordInvocation("UpdateOrganizationBillingManagerByUsername", []interface{}{orgGUID, username})
+	fake.updateOrganizationBillingManagerByUsernameMutex.Unlock()
+	if fake.UpdateOrganizationBillingManagerByUsernameStub != nil {
+		return fake.UpdateOrganizationBillingManagerByUsernameStub(orgGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationBillingManagerByUsernameReturns.result1, fake.updateOrganizationBillingManagerByUsernameReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationBillingManagerByUsernameCallCount() int {
+	fake.updateOrganizationBillingManagerByUsernameMutex.RLock()
+	defer fake.updateOrganizationBillingManagerByUsernameMutex.RUnlock()
+	return len(fake.updateOrganizationBillingManagerByUsernameArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationBillingManagerByUsernameArgsForCall(i int) (string, string) {
+	fake.updateOrganizationBillingManagerByUsernameMutex.RLock()
+	defer fake.updateOrganizationBillingManagerByUsernameMutex.RUnlock()
+	return fake.updateOrganizationBillingManagerByUsernameArgsForCall[i].orgGUID, fake.updateOrganizationBillingManagerByUsernameArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationBillingManagerByUsernameReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationBillingManagerByUsernameStub = nil
+	fake.updateOrganizationBillingManagerByUsernameReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationBillingManagerByUsernameReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationBillingManagerByUsernameStub = nil
+	if fake.updateOrganizationBillingManagerByUsernameReturnsOnCall == nil {
+		fake.updateOrganizationBillingManagerByUsernameReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationBillingManagerByUsernameReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationManager(orgGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.updateOrganizationManagerMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationManagerReturnsOnCall[len(fake.updateOrganizationManagerArgsForCall)]
+	fake.updateOrganizationManagerArgsForCall = append(fake.updateOrganizationManagerArgsForCall, struct {
+		orgGUID  string
+		userGUID string
+	}{orgGUID, userGUID})
+	fake.recordInvocation("UpdateOrganizationManager", []interface{}{orgGUID, userGUID})
+	fake.updateOrganizationManagerMutex.Unlock()
+	if fake.UpdateOrganizationManagerStub != nil {
+		return fake.UpdateOrganizationManagerStub(orgGUID, userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationManagerReturns.result1, fake.updateOrganizationManagerReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationManagerCallCount() int {
+	fake.updateOrganizationManagerMutex.RLock()
+	defer fake.updateOrganizationManagerMutex.RUnlock()
+	return len(fake.updateOrganizationManagerArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationManagerArgsForCall(i int) (string, string) {
+	fake.updateOrganizationManagerMutex.RLock()
+	defer fake.updateOrganizationManagerMutex.RUnlock()
+	return fake.updateOrganizationManagerArgsForCall[i].orgGUID, fake.updateOrganizationManagerArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationManagerReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationManagerStub = nil
+	fake.updateOrganizationManagerReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationManagerReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationManagerStub = nil
+	if fake.updateOrganizationManagerReturnsOnCall == nil {
+		fake.updateOrganizationManagerReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationManagerReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationManagerByUsername(orgGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateOrganizationManagerByUsernameMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationManagerByUsernameReturnsOnCall[len(fake.updateOrganizationManagerByUsernameArgsForCall)]
+	fake.updateOrganizationManagerByUsernameArgsForCall = append(fake.updateOrganizationManagerByUsernameArgsForCall, struct {
+		orgGUID  string
+		username string
+	}{orgGUID, username})
+	fake.recordInvocation("UpdateOrganizationManagerByUsername", []interface{}{orgGUID, username})
+	fake.updateOrganizationManagerByUsernameMutex.Unlock()
+	if fake.UpdateOrganizationManagerByUsernameStub != nil {
+		return fake.UpdateOrganizationManagerByUsernameStub(orgGUID, username)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getSpaceServiceInstancesReturns.result1, fake.getSpaceServiceInstancesReturns.result2, fake.getSpaceServiceInstancesReturns.result3
+	return fake.updateOrganizationManagerByUsernameReturns.result1, fake.updateOrganizationManagerByUsernameReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesCallCount() int {
-	fake.getSpaceServiceInstancesMutex.RLock()
-	defer fake.getSpaceServiceInstancesMutex.RUnlock()
-	return len(fake.getSpaceServiceInstancesArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateOrganizationManagerByUsernameCallCount() int {
+	fake.updateOrganizationManagerByUsernameMutex.RLock()
+	defer fake.updateOrganizationManagerByUsernameMutex.RUnlock()
+	return len(fake.updateOrganizationManagerByUsernameArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesArgsForCall(i int) (string, bool, []ccv2.Query) {
-	fake.getSpaceServiceInstancesMutex.RLock()
-	defer fake.getSpaceServiceInstancesMutex.RUnlock()
-	return fake.getSpaceServiceInstancesArgsForCall[i].spaceGUID, fake.getSpaceServiceInstancesArgsForCall[i].includeUserProvidedServices, fake.getSpaceServiceInstancesArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) UpdateOrganizationManagerByUsernameArgsForCall(i int) (string, string) {
+	fake.updateOrganizationManagerByUsernameMutex.RLock()
+	defer fake.updateOrganizationManagerByUsernameMutex.RUnlock()
+	return fake.updateOrganizationManagerByUsernameArgsForCall[i].orgGUID, fake.updateOrganizationManagerByUsernameArgsForCall[i].username
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesReturns(result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceServiceInstancesStub = nil
-	fake.getSpaceServiceInstancesReturns = struct {
-		result1 []ccv2.ServiceInstance
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) UpdateOrganizationManagerByUsernameReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationManagerByUsernameStub = nil
+	fake.updateOrganizationManagerByUsernameReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesReturnsOnCall(i int, result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceServiceInstancesStub = nil
-	if fake.getSpaceServiceInstancesReturnsOnCall == nil {
-		fake.getSpaceServiceInstancesReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.ServiceInstance
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) UpdateOrganizationManagerByUsernameReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationManagerByUsernameStub = nil
+	if fake.updateOrganizationManagerByUsernameReturnsOnCall == nil {
+		fake.updateOrganizationManagerByUsernameReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getSpaceServiceInstancesReturnsOnCall[i] = struct {
-		result1 []ccv2.ServiceInstance
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.updateOrganizationManagerByUsernameReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpace(spaceGUID string, queries ...ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
-	fake.getSpaceStagingSecurityGroupsBySpaceMutex.Lock()
-	ret, specificReturn := fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall[len(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall)]
-	fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall = append(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall, struct {
-		spaceGUID string
-		queries   []ccv2.Query
-	}{spaceGUID, queries})
-	fake.recordInvocation("GetSpaceStagingSecurityGroupsBySpace", []interface{}{spaceGUID, queries})
-	fake.getSpaceStagingSecurityGroupsBySpaceMutex.Unlock()
-	if fake.GetSpaceStagingSecurityGroupsBySpaceStub != nil {
-		return fake.GetSpaceStagingSecurityGroupsBySpaceStub(spaceGUID, queries...)
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuota(orgQuota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationQuotaReturnsOnCall[len(fake.updateOrganizationQuotaArgsForCall)]
+	fake.updateOrganizationQuotaArgsForCall = append(fake.updateOrganizationQuotaArgsForCall, struct {
+		orgQuota ccv2.OrganizationQuota
+	}{orgQuota})
+	fake.recordInvocation("UpdateOrganizationQuota", []interface{}{orgQuota})
+	fake.updateOrganizationQuotaMutex.Unlock()
+	if fake.UpdateOrganizationQuotaStub != nil {
+		return fake.UpdateOrganizationQuotaStub(orgQuota)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSpaceStagingSecurityGroupsBySpaceReturns.result1, fake.getSpaceStagingSecurityGroupsBySpaceReturns.result2, fake.getSpaceStagingSecurityGroupsBySpaceReturns.result3
+	return fake.updateOrganizationQuotaReturns.result1, fake.updateOrganizationQuotaReturns.result2, fake.updateOrganizationQuotaReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceCallCount() int {
-	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
-	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
-	return len(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaCallCount() int {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return len(fake.updateOrganizationQuotaArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceArgsForCall(i int) (string, []ccv2.Query) {
-	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
-	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
-	return fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall[i].spaceGUID, fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaArgsForCall(i int) ccv2.OrganizationQuota {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return fake.updateOrganizationQuotaArgsForCall[i].orgQuota
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceStagingSecurityGroupsBySpaceStub = nil
-	fake.getSpaceStagingSecurityGroupsBySpaceReturns = struct {
-		result1 []ccv2.SecurityGroup
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturns(result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	fake.updateOrganizationQuotaReturns = struct {
+		result1 ccv2.OrganizationQuota
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceStagingSecurityGroupsBySpaceStub = nil
-	if fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall == nil {
-		fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.SecurityGroup
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturnsOnCall(i int, result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	if fake.updateOrganizationQuotaReturnsOnCall == nil {
+		fake.updateOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.OrganizationQuota
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall[i] = struct {
-		result1 []ccv2.SecurityGroup
+	fake.updateOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.OrganizationQuota
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetStack(guid string) (ccv2.Stack, ccv2.Warnings, error) {
-	fake.getStackMutex.Lock()
-	ret, specificReturn := fake.getStackReturnsOnCall[len(fake.getStackArgsForCall)]
-	fake.getStackArgsForCall = append(fake.getStackArgsForCall, struct {
-		guid string
-	}{guid})
-	fake.recordInvocation("GetStack", []interface{}{guid})
-	fake.getStackMutex.Unlock()
-	if fake.GetStackStub != nil {
-		return fake.GetStackStub(guid)
+func (fake *FakeCloudControllerClient) UpdateOrganizationUser(orgGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.updateOrganizationUserMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationUserReturnsOnCall[len(fake.updateOrganizationUserArgsForCall)]
+	fake.updateOrganizationUserArgsForCall = append(fake.updateOrganizationUserArgsForCall, struct {
+		orgGUID  string
+		userGUID string
+	}{orgGUID, userGUID})
+	fake.recordInvocation("UpdateOrganizationUser", []interface{}{orgGUID, userGUID})
+	fake.updateOrganizationUserMutex.Unlock()
+	if fake.UpdateOrganizationUserStub != nil {
+		return fake.UpdateOrganizationUserStub(orgGUID, userGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getStackReturns.result1, fake.getStackReturns.result2, fake.getStackReturns.result3
+	return fake.updateOrganizationUserReturns.result1, fake.updateOrganizationUserReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetStackCallCount() int {
-	fake.getStackMutex.RLock()
-	defer fake.getStackMutex.RUnlock()
-	return len(fake.getStackArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserCallCount() int {
+	fake.updateOrganizationUserMutex.RLock()
+	defer fake.updateOrganizationUserMutex.RUnlock()
+	return len(fake.updateOrganizationUserArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetStackArgsForCall(i int) string {
-	fake.getStackMutex.RLock()
-	defer fake.getStackMutex.RUnlock()
-	return fake.getStackArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserArgsForCall(i int) (string, string) {
+	fake.updateOrganizationUserMutex.RLock()
+	defer fake.updateOrganizationUserMutex.RUnlock()
+	return fake.updateOrganizationUserArgsForCall[i].orgGUID, fake.updateOrganizationUserArgsForCall[i].userGUID
 }
 
-func (fake *FakeCloudControllerClient) GetStackReturns(result1 ccv2.Stack, result2 ccv2.Warnings, result3 error) {
-	fake.GetStackStub = nil
-	fake.getStackReturns = struct {
-		result1 ccv2.Stack
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserStub = nil
+	fake.updateOrganizationUserReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetStackReturnsOnCall(i int, result1 ccv2.Stack, result2 ccv2.Warnings, result3 error) {
-	fake.GetStackStub = nil
-	if fake.getStackReturnsOnCall == nil {
-		fake.getStackReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Stack
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserStub = nil
+	if fake.updateOrganizationUserReturnsOnCall == nil {
+		fake.updateOrganizationUserReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getStackReturnsOnCall[i] = struct {
-		result1 ccv2.Stack
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.updateOrganizationUserReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetStacks(queries ...ccv2.Query) ([]ccv2.Stack, ccv2.Warnings, error) {
-	fake.getStacksMutex.Lock()
-	ret, specificReturn := fake.getStacksReturnsOnCall[len(fake.getStacksArgsForCall)]
-	fake.getStacksArgsForCall = append(fake.getStacksArgsForCall, struct {
-		queries []ccv2.Query
-	}{queries})
-	fake.recordInvocation("GetStacks", []interface{}{queries})
-	fake.getStacksMutex.Unlock()
-	if fake.GetStacksStub != nil {
-		return fake.GetStacksStub(queries...)
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByUsername(orgGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateOrganizationUserByUsernameMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationUserByUsernameReturnsOnCall[len(fake.updateOrganizationUserByUsernameArgsForCall)]
+	fake.updateOrganizationUserByUsernameArgsForCall = append(fake.updateOrganizationUserByUsernameArgsForCall, struct {
+		orgGUID  string
+		username string
+	}{orgGUID, username})
+	fake.recordInvocation("UpdateOrganizationUserByUsername", []interface{}{orgGUID, username})
+	fake.updateOrganizationUserByUsernameMutex.Unlock()
+	if fake.UpdateOrganizationUserByUsernameStub != nil {
+		return fake.UpdateOrganizationUserByUsernameStub(orgGUID, username)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getStacksReturns.result1, fake.getStacksReturns.result2, fake.getStacksReturns.result3
+	return fake.updateOrganizationUserByUsernameReturns.result1, fake.updateOrganizationUserByUsernameReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetStacksCallCount() int {
-	fake.getStacksMutex.RLock()
-	defer fake.getStacksMutex.RUnlock()
-	return len(fake.getStacksArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByUsernameCallCount() int {
+	fake.updateOrganizationUserByUsernameMutex.RLock()
+	defer fake.updateOrganizationUserByUsernameMutex.RUnlock()
+	return len(fake.updateOrganizationUserByUsernameArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetStacksArgsForCall(i int) []ccv2.Query {
-	fake.getStacksMutex.RLock()
-	defer fake.getStacksMutex.RUnlock()
-	return fake.getStacksArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByUsernameArgsForCall(i int) (string, string) {
+	fake.updateOrganizationUserByUsernameMutex.RLock()
+	defer fake.updateOrganizationUserByUsernameMutex.RUnlock()
+	return fake.updateOrganizationUserByUsernameArgsForCall[i].orgGUID, fake.updateOrganizationUserByUsernameArgsForCall[i].username
 }
 
-func (fake *FakeCloudControllerClient) GetStacksReturns(result1 []ccv2.Stack, result2 ccv2.Warnings, result3 error) {
-	fake.GetStacksStub = nil
-	fake.getStacksReturns = struct {
-		result1 []ccv2.Stack
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByUsernameReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserByUsernameStub = nil
+	fake.updateOrganizationUserByUsernameReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetStacksReturnsOnCall(i int, result1 []ccv2.Stack, result2 ccv2.Warnings, result3 error) {
-	fake.GetStacksStub = nil
-	if fake.getStacksReturnsOnCall == nil {
-		fake.getStacksReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Stack
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByUsernameReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserByUsernameStub = nil
+	if fake.updateOrganizationUserByUsernameReturnsOnCall == nil {
+		fake.updateOrganizationUserByUsernameReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getStacksReturnsOnCall[i] = struct {
-		result1 []ccv2.Stack
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.updateOrganizationUserByUsernameReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetStagingSpacesBySecurityGroup(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error) {
-	fake.getStagingSpacesBySecurityGroupMutex.Lock()
-	ret, specificReturn := fake.getStagingSpacesBySecurityGroupReturnsOnCall[len(fake.getStagingSpacesBySecurityGroupArgsForCall)]
-	fake.getStagingSpacesBySecurityGroupArgsForCall = append(fake.getStagingSpacesBySecurityGroupArgsForCall, struct {
-		securityGroupGUID string
-	}{securityGroupGUID})
-	fake.recordInvocation("GetStagingSpacesBySecurityGroup", []interface{}{securityGroupGUID})
-	fake.getStagingSpacesBySecurityGroupMutex.Unlock()
-	if fake.GetStagingSpacesBySecurityGroupStub != nil {
-		return fake.GetStagingSpacesBySecurityGroupStub(securityGroupGUID)
+func (fake *FakeCloudControllerClient) UpdateServiceInstance(serviceInstanceGUID string, servicePlanGUID string, parameters map[string]interface{}, tags []string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
+	var tagsCopy []string
+	if tags != nil {
+		tagsCopy = make([]string, len(tags))
+		copy(tagsCopy, tags)
+	}
+	fake.updateServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.updateServiceInstanceReturnsOnCall[len(fake.updateServiceInstanceArgsForCall)]
+	fake.updateServiceInstanceArgsForCall = append(fake.updateServiceInstanceArgsForCall, struct {
+		serviceInstanceGUID string
+		servicePlanGUID     string
+		parameters          map[string]interface{}
+		tags                []string
+	}{serviceInstanceGUID, servicePlanGUID, parameters, tagsCopy})
+	fake.recordInvocation("UpdateServiceInstance", []interface{}{serviceInstanceGUID, servicePlanGUID, parameters, tagsCopy})
+	fake.updateServiceInstanceMutex.Unlock()
+	if fake.UpdateServiceInstanceStub != nil {
+		return fake.UpdateServiceInstanceStub(serviceInstanceGUID, servicePlanGUID, parameters, tags)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getStagingSpacesBySecurityGroupReturns.result1, fake.getStagingSpacesBySecurityGroupReturns.result2, fake.getStagingSpacesBySecurityGroupReturns.result3
+	return fake.updateServiceInstanceReturns.result1, fake.updateServiceInstanceReturns.result2, fake.updateServiceInstanceReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetStagingSpacesBySecurityGroupCallCount() int {
-	fake.getStagingSpacesBySecurityGroupMutex.RLock()
-	defer fake.getStagingSpacesBySecurityGroupMutex.RUnlock()
-	return len(fake.getStagingSpacesBySecurityGroupArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceCallCount() int {
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	return len(fake.updateServiceInstanceArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetStagingSpacesBySecurityGroupArgsForCall(i int) string {
-	fake.getStagingSpacesBySecurityGroupMutex.RLock()
-	defer fake.getStagingSpacesBySecurityGroupMutex.RUnlock()
-	return fake.getStagingSpacesBySecurityGroupArgsForCall[i].securityGroupGUID
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceArgsForCall(i int) (string, string, map[string]interface{}, []string) {
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	return fake.updateServiceInstanceArgsForCall[i].serviceInstanceGUID, fake.updateServiceInstanceArgsForCall[i].servicePlanGUID, fake.updateServiceInstanceArgsForCall[i].parameters, fake.updateServiceInstanceArgsForCall[i].tags
 }
 
-func (fake *FakeCloudControllerClient) GetStagingSpacesBySecurityGroupReturns(result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
-	fake.GetStagingSpacesBySecurityGroupStub = nil
-	fake.getStagingSpacesBySecurityGroupReturns = struct {
-		result1 []ccv2.Space
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceReturns(result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateServiceInstanceStub = nil
+	fake.updateServiceInstanceReturns = struct {
+		result1 ccv2.ServiceInstance
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetStagingSpacesBySecurityGroupReturnsOnCall(i int, result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
-	fake.GetStagingSpacesBySecurityGroupStub = nil
-	if fake.getStagingSpacesBySecurityGroupReturnsOnCall == nil {
-		fake.getStagingSpacesBySecurityGroupReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Space
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceReturnsOnCall(i int, result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateServiceInstanceStub = nil
+	if fake.updateServiceInstanceReturnsOnCall == nil {
+		fake.updateServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceInstance
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getStagingSpacesBySecurityGroupReturnsOnCall[i] = struct {
-		result1 []ccv2.Space
+	fake.updateServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceInstance
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) PollJob(job ccv2.Job) (ccv2.Warnings, error) {
-	fake.pollJobMutex.Lock()
-	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
-	fake.pollJobArgsForCall = append(fake.pollJobArgsForCall, struct {
-		job ccv2.Job
-	}{job})
-	fake.recordInvocation("PollJob", []interface{}{job})
-	fake.pollJobMutex.Unlock()
-	if fake.PollJobStub != nil {
-		return fake.PollJobStub(job)
+func (fake *FakeCloudControllerClient) UpdateSpaceAuditor(spaceGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.updateSpaceAuditorMutex.Lock()
+	ret, specificReturn := fake.updateSpaceAuditorReturnsOnCall[len(fake.updateSpaceAuditorArgsForCall)]
+	fake.updateSpaceAuditorArgsForCall = append(fake.updateSpaceAuditorArgsForCall, struct {
+		spaceGUID string
+		userGUID  string
+	}{spaceGUID, userGUID})
+	fake.recordInvocation("UpdateSpaceAuditor", []interface{}{spaceGUID, userGUID})
+	fake.updateSpaceAuditorMutex.Unlock()
+	if fake.UpdateSpaceAuditorStub != nil {
+		return fake.UpdateSpaceAuditorStub(spaceGUID, userGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.pollJobReturns.result1, fake.pollJobReturns.result2
+	return fake.updateSpaceAuditorReturns.result1, fake.updateSpaceAuditorReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) PollJobCallCount() int {
-	fake.pollJobMutex.RLock()
-	defer fake.pollJobMutex.RUnlock()
-	return len(fake.pollJobArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateSpaceAuditorCallCount() int {
+	fake.updateSpaceAuditorMutex.RLock()
+	defer fake.updateSpaceAuditorMutex.RUnlock()
+	return len(fake.updateSpaceAuditorArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) PollJobArgsForCall(i int) ccv2.Job {
-	fake.pollJobMutex.RLock()
-	defer fake.pollJobMutex.RUnlock()
-	return fake.pollJobArgsForCall[i].job
+func (fake *FakeCloudControllerClient) UpdateSpaceAuditorArgsForCall(i int) (string, string) {
+	fake.updateSpaceAuditorMutex.RLock()
+	defer fake.updateSpaceAuditorMutex.RUnlock()
+	return fake.updateSpaceAuditorArgsForCall[i].spaceGUID, fake.updateSpaceAuditorArgsForCall[i].userGUID
 }
 
-func (fake *FakeCloudControllerClient) PollJobReturns(result1 ccv2.Warnings, result2 error) {
-	fake.PollJobStub = nil
-	fake.pollJobReturns = struct {
+func (fake *FakeCloudControllerClient) UpdateSpaceAuditorReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceAuditorStub = nil
+	fake.updateSpaceAuditorReturns = struct {
 		result1 ccv2.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) PollJobReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
-	fake.PollJobStub = nil
-	if fake.pollJobReturnsOnCall == nil {
-		fake.pollJobReturnsOnCall = make(map[int]struct {
+func (fake *FakeCloudControllerClient) UpdateSpaceAuditorReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceAuditorStub = nil
+	if fake.updateSpaceAuditorReturnsOnCall == nil {
+		fake.updateSpaceAuditorReturnsOnCall = make(map[int]struct {
 			result1 ccv2.Warnings
 			result2 error
 		})
 	}
-	fake.pollJobReturnsOnCall[i] = struct {
+	fake.updateSpaceAuditorReturnsOnCall[i] = struct {
 		result1 ccv2.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) RemoveSpaceFromRunningSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error) {
-	fake.removeSpaceFromRunningSecurityGroupMutex.Lock()
-	ret, specificReturn := fake.removeSpaceFromRunningSecurityGroupReturnsOnCall[len(fake.removeSpaceFromRunningSecurityGroupArgsForCall)]
-	fake.removeSpaceFromRunningSecurityGroupArgsForCall = append(fake.removeSpaceFromRunningSecurityGroupArgsForCall, struct {
-		securityGroupGUID string
-		spaceGUID         string
-	}{securityGroupGUID, spaceGUID})
-	fake.recordInvocation("RemoveSpaceFromRunningSecurityGroup", []interface{}{securityGroupGUID, spaceGUID})
-	fake.removeSpaceFromRunningSecurityGroupMutex.Unlock()
-	if fake.RemoveSpaceFromRunningSecurityGroupStub != nil {
-		return fake.RemoveSpaceFromRunningSecurityGroupStub(securityGroupGUID, spaceGUID)
+func (fake *FakeCloudControllerClient) UpdateSpaceAuditorByUsername(spaceGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateSpaceAuditorByUsernameMutex.Lock()
+	ret, specificReturn := fake.updateSpaceAuditorByUsernameReturnsOnCall[len(fake.updateSpaceAuditorByUsernameArgsForCall)]
+	fake.updateSpaceAuditorByUsernameArgsForCall = append(fake.updateSpaceAuditorByUsernameArgsForCall, struct {
+		spaceGUID string
+		username  string
+	}{spaceGUID, username})
+	fake.recordInvocation("UpdateSpaceAuditorByUsername", []interface{}{spaceGUID, username})
+	fake.updateSpaceAuditorByUsernameMutex.Unlock()
+	if fake.UpdateSpaceAuditorByUsernameStub != nil {
+		return fake.UpdateSpaceAuditorByUsernameStub(spaceGUID, username)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
-	}
-	return fake.removeSpaceFromRunningSecurityGroupReturns.result1, fake.removeSpaceFromRunningSecurityGroupReturns.result2
-}
-
-func (fake *FakeCloudControllerClient) RemoveSpaceFromRunningSecurityGroupCallCount() int {
-	fake.removeSpaceFromRunningSecurityGroupMutex.RLock()
-	defer fake.removeSpaceFromRunningSecurityGroupMutex.RUnlock()
-	return len(fake.removeSpaceFromRunningSecurityGroupArgsForCall)
+	}
+	return fake.updateSpaceAuditorByUsernameReturns.result1, fake.updateSpaceAuditorByUsernameReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) RemoveSpaceFromRunningSecurityGroupArgsForCall(i int) (string, string) {
-	fake.removeSpaceFromRunningSecurityGroupMutex.RLock()
-	defer fake.removeSpaceFromRunningSecurityGroupMutex.RUnlock()
-	return fake.removeSpaceFromRunningSecurityGroupArgsForCall[i].securityGroupGUID, fake.removeSpaceFromRunningSecurityGroupArgsForCall[i].spaceGUID
+func (fake *FakeCloudControllerClient) UpdateSpaceAuditorByUsernameCallCount() int {
+	fake.updateSpaceAuditorByUsernameMutex.RLock()
+	defer fake.updateSpaceAuditorByUsernameMutex.RUnlock()
+	return len(fake.updateSpaceAuditorByUsernameArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) RemoveSpaceFromRunningSecurityGroupReturns(result1 ccv2.Warnings, result2 error) {
-	fake.RemoveSpaceFromRunningSecurityGroupStub = nil
-	fake.removeSpaceFromRunningSecurityGroupReturns = struct {
+func (fake *FakeCloudControllerClient) UpdateSpaceAuditorByUsernameArgsForCall(i int) (string, string) {
+	fake.updateSpaceAuditorByUsernameMutex.RLock()
+	defer fake.updateSpaceAuditorByUsernameMutex.RUnlock()
+	return fake.updateSpaceAuditorByUsernameArgsForCall[i].spaceGUID, fake.updateSpaceAuditorByUsernameArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceAuditorByUsernameReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceAuditorByUsernameStub = nil
+	fake.updateSpaceAuditorByUsernameReturns = struct {
 		result1 ccv2.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) RemoveSpaceFromRunningSecurityGroupReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
-	fake.RemoveSpaceFromRunningSecurityGroupStub = nil
-	if fake.removeSpaceFromRunningSecurityGroupReturnsOnCall == nil {
-		fake.removeSpaceFromRunningSecurityGroupReturnsOnCall = make(map[int]struct {
+func (fake *FakeCloudControllerClient) UpdateSpaceAuditorByUsernameReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceAuditorByUsernameStub = nil
+	if fake.updateSpaceAuditorByUsernameReturnsOnCall == nil {
+		fake.updateSpaceAuditorByUsernameReturnsOnCall = make(map[int]struct {
 			result1 ccv2.Warnings
 			result2 error
 		})
 	}
-	fake.removeSpaceFromRunningSecurityGroupReturnsOnCall[i] = struct {
+	fake.updateSpaceAuditorByUsernameReturnsOnCall[i] = struct {
 		result1 ccv2.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) RemoveSpaceFromStagingSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error) {
-	fake.removeSpaceFromStagingSecurityGroupMutex.Lock()
-	ret, specificReturn := fake.removeSpaceFromStagingSecurityGroupReturnsOnCall[len(fake.removeSpaceFromStagingSecurityGroupArgsForCall)]
-	fake.removeSpaceFromStagingSecurityGroupArgsForCall = append(fake.removeSpaceFromStagingSecurityGroupArgsForCall, struct {
-		securityGroupGUID string
-		spaceGUID         string
-	}{securityGroupGUID, spaceGUID})
-	fake.recordInvocation("RemoveSpaceFromStagingSecurityGroup", []interface{}{securityGroupGUID, spaceGUID})
-	fake.removeSpaceFromStagingSecurityGroupMutex.Unlock()
-	if fake.RemoveSpaceFromStagingSecurityGroupStub != nil {
-		return fake.RemoveSpaceFromStagingSecurityGroupStub(securityGroupGUID, spaceGUID)
+func (fake *FakeCloudControllerClient) UpdateSpaceDeveloper(spaceGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.updateSpaceDeveloperMutex.Lock()
+	ret, specificReturn := fake.updateSpaceDeveloperReturnsOnCall[len(fake.updateSpaceDeveloperArgsForCall)]
+	fake.updateSpaceDeveloperArgsForCall = append(fake.updateSpaceDeveloperArgsForCall, struct {
+		spaceGUID string
+		userGUID  string
+	}{spaceGUID, userGUID})
+	fake.recordInvocation("UpdateSpaceDeveloper", []interface{}{spaceGUID, userGUID})
+	fake.updateSpaceDeveloperMutex.Unlock()
+	if fake.UpdateSpaceDeveloperStub != nil {
+		return fake.UpdateSpaceDeveloperStub(spaceGUID, userGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.removeSpaceFromStagingSecurityGroupReturns.result1, fake.removeSpaceFromStagingSecurityGroupReturns.result2
+	return fake.updateSpaceDeveloperReturns.result1, fake.updateSpaceDeveloperReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) RemoveSpaceFromStagingSecurityGroupCallCount() int {
-	fake.removeSpaceFromStagingSecurityGroupMutex.RLock()
-	defer fake.removeSpaceFromStagingSecurityGroupMutex.RUnlock()
-	return len(fake.removeSpaceFromStagingSecurityGroupArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateSpaceDeveloperCallCount() int {
+	fake.updateSpaceDeveloperMutex.RLock()
+	defer fake.updateSpaceDeveloperMutex.RUnlock()
+	return len(fake.updateSpaceDeveloperArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) RemoveSpaceFromStagingSecurityGroupArgsForCall(i int) (string, string) {
-	fake.removeSpaceFromStagingSecurityGroupMutex.RLock()
-	defer fake.removeSpaceFromStagingSecurityGroupMutex.RUnlock()
-	return fake.removeSpaceFromStagingSecurityGroupArgsForCall[i].securityGroupGUID, fake.removeSpaceFromStagingSecurityGroupArgsForCall[i].spaceGUID
+func (fake *FakeCloudControllerClient) UpdateSpaceDeveloperArgsForCall(i int) (string, string) {
+	fake.updateSpaceDeveloperMutex.RLock()
+	defer fake.updateSpaceDeveloperMutex.RUnlock()
+	return fake.updateSpaceDeveloperArgsForCall[i].spaceGUID, fake.updateSpaceDeveloperArgsForCall[i].userGUID
 }
 
-func (fake *FakeCloudControllerClient) RemoveSpaceFromStagingSecurityGroupReturns(result1 ccv2.Warnings, result2 error) {
-	fake.RemoveSpaceFromStagingSecurityGroupStub = nil
-	fake.removeSpaceFromStagingSecurityGroupReturns = struct {
+func (fake *FakeCloudControllerClient) UpdateSpaceDeveloperReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceDeveloperStub = nil
+	fake.updateSpaceDeveloperReturns = struct {
 		result1 ccv2.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) RemoveSpaceFromStagingSecurityGroupReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
-	fake.RemoveSpaceFromStagingSecurityGroupStub = nil
-	if fake.removeSpaceFromStagingSecurityGroupReturnsOnCall == nil {
-		fake.removeSpaceFromStagingSecurityGroupReturnsOnCall = make(map[int]struct {
+func (fake *FakeCloudControllerClient) UpdateSpaceDeveloperReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceDeveloperStub = nil
+	if fake.updateSpaceDeveloperReturnsOnCall == nil {
+		fake.updateSpaceDeveloperReturnsOnCall = make(map[int]struct {
 			result1 ccv2.Warnings
 			result2 error
 		})
 	}
-	fake.removeSpaceFromStagingSecurityGroupReturnsOnCall[i] = struct {
+	fake.updateSpaceDeveloperReturnsOnCall[i] = struct {
 		result1 ccv2.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) ResourceMatch(resourcesToMatch []ccv2.Resource) ([]ccv2.Resource, ccv2.Warnings, error) {
-	var resourcesToMatchCopy []ccv2.Resource
-	if resourcesToMatch != nil {
-		resourcesToMatchCopy = make([]ccv2.Resource, len(resourcesToMatch))
-		copy(resourcesToMatchCopy, resourcesToMatch)
-	}
-	fake.resourceMatchMutex.Lock()
-	ret, specificReturn := fake.resourceMatchReturnsOnCall[len(fake.resourceMatchArgsForCall)]
-	fake.resourceMatchArgsForCall = append(fake.resourceMatchArgsForCall, struct {
-		resourcesToMatch []ccv2.Resource
-	}{resourcesToMatchCopy})
-	fake.recordInvocation("ResourceMatch", []interface{}{resourcesToMatchCopy})
-	fake.resourceMatchMutex.Unlock()
-	if fake.ResourceMatchStub != nil {
-		return fake.ResourceMatchStub(resourcesToMatch)
+func (fake *FakeCloudControllerClient) UpdateSpaceDeveloperByUsername(spaceGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateSpaceDeveloperByUsernameMutex.Lock()
+	ret, specificReturn := fake.updateSpaceDeveloperByUsernameReturnsOnCall[len(fake.updateSpaceDeveloperByUsernameArgsForCall)]
+	fake.updateSpaceDeveloperByUsernameArgsForCall = append(fake.updateSpaceDeveloperByUsernameArgsForCall, struct {
+		spaceGUID string
+		username  string
+	}{spaceGUID, username})
+	fake.recordInvocation("UpdateSpaceDeveloperByUsername", []interface{}{spaceGUID, username})
+	fake.updateSpaceDeveloperByUsernameMutex.Unlock()
+	if fake.UpdateSpaceDeveloperByUsernameStub != nil {
+		return fake.UpdateSpaceDeveloperByUsernameStub(spaceGUID, username)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.resourceMatchReturns.result1, fake.resourceMatchReturns.result2, fake.resourceMatchReturns.result3
+	return fake.updateSpaceDeveloperByUsernameReturns.result1, fake.updateSpaceDeveloperByUsernameReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) ResourceMatchCallCount() int {
-	fake.resourceMatchMutex.RLock()
-	defer fake.resourceMatchMutex.RUnlock()
-	return len(fake.resourceMatchArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateSpaceDeveloperByUsernameCallCount() int {
+	fake.updateSpaceDeveloperByUsernameMutex.RLock()
+	defer fake.updateSpaceDeveloperByUsernameMutex.RUnlock()
+	return len(fake.updateSpaceDeveloperByUsernameArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) ResourceMatchArgsForCall(i int) []ccv2.Resource {
-	fake.resourceMatchMutex.RLock()
-	defer fake.resourceMatchMutex.RUnlock()
-	return fake.resourceMatchArgsForCall[i].resourcesToMatch
+func (fake *FakeCloudControllerClient) UpdateSpaceDeveloperByUsernameArgsForCall(i int) (string, string) {
+	fake.updateSpaceDeveloperByUsernameMutex.RLock()
+	defer fake.updateSpaceDeveloperByUsernameMutex.RUnlock()
+	return fake.updateSpaceDeveloperByUsernameArgsForCall[i].spaceGUID, fake.updateSpaceDeveloperByUsernameArgsForCall[i].username
 }
 
-func (fake *FakeCloudControllerClient) ResourceMatchReturns(result1 []ccv2.Resource, result2 ccv2.Warnings, result3 error) {
-	fake.ResourceMatchStub = nil
-	fake.resourceMatchReturns = struct {
-		result1 []ccv2.Resource
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) UpdateSpaceDeveloperByUsernameReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceDeveloperByUsernameStub = nil
+	fake.updateSpaceDeveloperByUsernameReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) ResourceMatchReturnsOnCall(i int, result1 []ccv2.Resource, result2 ccv2.Warnings, result3 error) {
-	fake.ResourceMatchStub = nil
-	if fake.resourceMatchReturnsOnCall == nil {
-		fake.resourceMatchReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Resource
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) UpdateSpaceDeveloperByUsernameReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceDeveloperByUsernameStub = nil
+	if fake.updateSpaceDeveloperByUsernameReturnsOnCall == nil {
+		fake.updateSpaceDeveloperByUsernameReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.resourceMatchReturnsOnCall[i] = struct {
-		result1 []ccv2.Resource
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.updateSpaceDeveloperByUsernameReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) RestageApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error) {
-	fake.restageApplicationMutex.Lock()
-	ret, specificReturn := fake.restageApplicationReturnsOnCall[len(fake.restageApplicationArgsForCall)]
-	fake.restageApplicationArgsForCall = append(fake.restageApplicationArgsForCall, struct {
-		app ccv2.Application
-	}{app})
-	fake.recordInvocation("RestageApplication", []interface{}{app})
-	fake.restageApplicationMutex.Unlock()
-	if fake.RestageApplicationStub != nil {
-		return fake.RestageApplicationStub(app)
+func (fake *FakeCloudControllerClient) UpdateSpaceManager(spaceGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.updateSpaceManagerMutex.Lock()
+	ret, specificReturn := fake.updateSpaceManagerReturnsOnCall[len(fake.updateSpaceManagerArgsForCall)]
+	fake.updateSpaceManagerArgsForCall = append(fake.updateSpaceManagerArgsForCall, struct {
+		spaceGUID string
+		userGUID  string
+	}{spaceGUID, userGUID})
+	fake.recordInvocation("UpdateSpaceManager", []interface{}{spaceGUID, userGUID})
+	fake.updateSpaceManagerMutex.Unlock()
+	if fake.UpdateSpaceManagerStub != nil {
+		return fake.UpdateSpaceManagerStub(spaceGUID, userGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.restageApplicationReturns.result1, fake.restageApplicationReturns.result2, fake.restageApplicationReturns.result3
+	return fake.updateSpaceManagerReturns.result1, fake.updateSpaceManagerReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) RestageApplicationCallCount() int {
-	fake.restageApplicationMutex.RLock()
-	defer fake.restageApplicationMutex.RUnlock()
-	return len(fake.restageApplicationArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateSpaceManagerCallCount() int {
+	fake.updateSpaceManagerMutex.RLock()
+	defer fake.updateSpaceManagerMutex.RUnlock()
+	return len(fake.updateSpaceManagerArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) RestageApplicationArgsForCall(i int) ccv2.Application {
-	fake.restageApplicationMutex.RLock()
-	defer fake.restageApplicationMutex.RUnlock()
-	return fake.restageApplicationArgsForCall[i].app
+func (fake *FakeCloudControllerClient) UpdateSpaceManagerArgsForCall(i int) (string, string) {
+	fake.updateSpaceManagerMutex.RLock()
+	defer fake.updateSpaceManagerMutex.RUnlock()
+	return fake.updateSpaceManagerArgsForCall[i].spaceGUID, fake.updateSpaceManagerArgsForCall[i].userGUID
 }
 
-func (fake *FakeCloudControllerClient) RestageApplicationReturns(result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.RestageApplicationStub = nil
-	fake.restageApplicationReturns = struct {
-		result1 ccv2.Application
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) UpdateSpaceManagerReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceManagerStub = nil
+	fake.updateSpaceManagerReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) RestageApplicationReturnsOnCall(i int, result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.RestageApplicationStub = nil
-	if fake.restageApplicationReturnsOnCall == nil {
-		fake.restageApplicationReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Application
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) UpdateSpaceManagerReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceManagerStub = nil
+	if fake.updateSpaceManagerReturnsOnCall == nil {
+		fake.updateSpaceManagerReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.restageApplicationReturnsOnCall[i] = struct {
-		result1 ccv2.Application
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.updateSpaceManagerReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error) {
-	fake.targetCFMutex.Lock()
-	ret, specificReturn := fake.targetCFReturnsOnCall[len(fake.targetCFArgsForCall)]
-	fake.targetCFArgsForCall = append(fake.targetCFArgsForCall, struct {
-		settings ccv2.TargetSettings
-	}{settings})
-	fake.recordInvocation("TargetCF", []interface{}{settings})
-	fake.targetCFMutex.Unlock()
-	if fake.TargetCFStub != nil {
-		return fake.TargetCFStub(settings)
+func (fake *FakeCloudControllerClient) UpdateSpaceManagerByUsername(spaceGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateSpaceManagerByUsernameMutex.Lock()
+	ret, specificReturn := fake.updateSpaceManagerByUsernameReturnsOnCall[len(fake.updateSpaceManagerByUsernameArgsForCall)]
+	fake.updateSpaceManagerByUsernameArgsForCall = append(fake.updateSpaceManagerByUsernameArgsForCall, struct {
+		spaceGUID string
+		username  string
+	}{spaceGUID, username})
+	fake.recordInvocation("UpdateSpaceManagerByUsername", []interface{}{spaceGUID, username})
+	fake.updateSpaceManagerByUsernameMutex.Unlock()
+	if fake.UpdateSpaceManagerByUsernameStub != nil {
+		return fake.UpdateSpaceManagerByUsernameStub(spaceGUID, username)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.targetCFReturns.result1, fake.targetCFReturns.result2
+	return fake.updateSpaceManagerByUsernameReturns.result1, fake.updateSpaceManagerByUsernameReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) TargetCFCallCount() int {
-	fake.targetCFMutex.RLock()
-	defer fake.targetCFMutex.RUnlock()
-	return len(fake.targetCFArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateSpaceManagerByUsernameCallCount() int {
+	fake.updateSpaceManagerByUsernameMutex.RLock()
+	defer fake.updateSpaceManagerByUsernameMutex.RUnlock()
+	return len(fake.updateSpaceManagerByUsernameArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) TargetCFArgsForCall(i int) ccv2.TargetSettings {
-	fake.targetCFMutex.RLock()
-	defer fake.targetCFMutex.RUnlock()
-	return fake.targetCFArgsForCall[i].settings
+func (fake *FakeCloudControllerClient) UpdateSpaceManagerByUsernameArgsForCall(i int) (string, string) {
+	fake.updateSpaceManagerByUsernameMutex.RLock()
+	defer fake.updateSpaceManagerByUsernameMutex.RUnlock()
+	return fake.updateSpaceManagerByUsernameArgsForCall[i].spaceGUID, fake.updateSpaceManagerByUsernameArgsForCall[i].username
 }
 
-func (fake *FakeCloudControllerClient) TargetCFReturns(result1 ccv2.Warnings, result2 error) {
-	fake.TargetCFStub = nil
-	fake.targetCFReturns = struct {
+func (fake *FakeCloudControllerClient) UpdateSpaceManagerByUsernameReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceManagerByUsernameStub = nil
+	fake.updateSpaceManagerByUsernameReturns = struct {
 		result1 ccv2.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) TargetCFReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
-	fake.TargetCFStub = nil
-	if fake.targetCFReturnsOnCall == nil {
-		fake.targetCFReturnsOnCall = make(map[int]struct {
+func (fake *FakeCloudControllerClient) UpdateSpaceManagerByUsernameReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceManagerByUsernameStub = nil
+	if fake.updateSpaceManagerByUsernameReturnsOnCall == nil {
+		fake.updateSpaceManagerByUsernameReturnsOnCall = make(map[int]struct {
 			result1 ccv2.Warnings
 			result2 error
 		})
 	}
-	fake.targetCFReturnsOnCall[i] = struct {
+	fake.updateSpaceManagerByUsernameReturnsOnCall[i] = struct {
 		result1 ccv2.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) UpdateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error) {
-	fake.updateApplicationMutex.Lock()
-	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
-	fake.updateApplicationArgsForCall = append(fake.updateApplicationArgsForCall, struct {
-		app ccv2.Application
-	}{app})
-	fake.recordInvocation("UpdateApplication", []interface{}{app})
-	fake.updateApplicationMutex.Unlock()
-	if fake.UpdateApplicationStub != nil {
-		return fake.UpdateApplicationStub(app)
+func (fake *FakeCloudControllerClient) UpdateSpaceQuota(spaceQuota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.updateSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.updateSpaceQuotaReturnsOnCall[len(fake.updateSpaceQuotaArgsForCall)]
+	fake.updateSpaceQuotaArgsForCall = append(fake.updateSpaceQuotaArgsForCall, struct {
+		spaceQuota ccv2.SpaceQuota
+	}{spaceQuota})
+	fake.recordInvocation("UpdateSpaceQuota", []interface{}{spaceQuota})
+	fake.updateSpaceQuotaMutex.Unlock()
+	if fake.UpdateSpaceQuotaStub != nil {
+		return fake.UpdateSpaceQuotaStub(spaceQuota)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.updateApplicationReturns.result1, fake.updateApplicationReturns.result2, fake.updateApplicationReturns.result3
+	return fake.updateSpaceQuotaReturns.result1, fake.updateSpaceQuotaReturns.result2, fake.updateSpaceQuotaReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) UpdateApplicationCallCount() int {
-	fake.updateApplicationMutex.RLock()
-	defer fake.updateApplicationMutex.RUnlock()
-	return len(fake.updateApplicationArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaCallCount() int {
+	fake.updateSpaceQuotaMutex.RLock()
+	defer fake.updateSpaceQuotaMutex.RUnlock()
+	return len(fake.updateSpaceQuotaArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) UpdateApplicationArgsForCall(i int) ccv2.Application {
-	fake.updateApplicationMutex.RLock()
-	defer fake.updateApplicationMutex.RUnlock()
-	return fake.updateApplicationArgsForCall[i].app
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaArgsForCall(i int) ccv2.SpaceQuota {
+	fake.updateSpaceQuotaMutex.RLock()
+	defer fake.updateSpaceQuotaMutex.RUnlock()
+	return fake.updateSpaceQuotaArgsForCall[i].spaceQuota
 }
 
-func (fake *FakeCloudControllerClient) UpdateApplicationReturns(result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.UpdateApplicationStub = nil
-	fake.updateApplicationReturns = struct {
-		result1 ccv2.Application
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaReturns(result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSpaceQuotaStub = nil
+	fake.updateSpaceQuotaReturns = struct {
+		result1 ccv2.SpaceQuota
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) UpdateApplicationReturnsOnCall(i int, result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.UpdateApplicationStub = nil
-	if fake.updateApplicationReturnsOnCall == nil {
-		fake.updateApplicationReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Application
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaReturnsOnCall(i int, result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSpaceQuotaStub = nil
+	if fake.updateSpaceQuotaReturnsOnCall == nil {
+		fake.updateSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SpaceQuota
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.updateApplicationReturnsOnCall[i] = struct {
-		result1 ccv2.Application
+	fake.updateSpaceQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.SpaceQuota
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -3465,6 +8262,60 @@ func (fake *FakeCloudControllerClient) UploadApplicationPackageReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UploadBuildpack(buildpackGUID string, buildpackFileName string, buildpack io.Reader, buildpackLength int64) (ccv2.Warnings, error) {
+	fake.uploadBuildpackMutex.Lock()
+	ret, specificReturn := fake.uploadBuildpackReturnsOnCall[len(fake.uploadBuildpackArgsForCall)]
+	fake.uploadBuildpackArgsForCall = append(fake.uploadBuildpackArgsForCall, struct {
+		buildpackGUID     string
+		buildpackFileName string
+		buildpack         io.Reader
+		buildpackLength   int64
+	}{buildpackGUID, buildpackFileName, buildpack, buildpackLength})
+	fake.recordInvocation("UploadBuildpack", []interface{}{buildpackGUID, buildpackFileName, buildpack, buildpackLength})
+	fake.uploadBuildpackMutex.Unlock()
+	if fake.UploadBuildpackStub != nil {
+		return fake.UploadBuildpackStub(buildpackGUID, buildpackFileName, buildpack, buildpackLength)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.uploadBuildpackReturns.result1, fake.uploadBuildpackReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UploadBuildpackCallCount() int {
+	fake.uploadBuildpackMutex.RLock()
+	defer fake.uploadBuildpackMutex.RUnlock()
+	return len(fake.uploadBuildpackArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UploadBuildpackArgsForCall(i int) (string, string, io.Reader, int64) {
+	fake.uploadBuildpackMutex.RLock()
+	defer fake.uploadBuildpackMutex.RUnlock()
+	return fake.uploadBuildpackArgsForCall[i].buildpackGUID, fake.uploadBuildpackArgsForCall[i].buildpackFileName, fake.uploadBuildpackArgsForCall[i].buildpack, fake.uploadBuildpackArgsForCall[i].buildpackLength
+}
+
+func (fake *FakeCloudControllerClient) UploadBuildpackReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UploadBuildpackStub = nil
+	fake.uploadBuildpackReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UploadBuildpackReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UploadBuildpackStub = nil
+	if fake.uploadBuildpackReturnsOnCall == nil {
+		fake.uploadBuildpackReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.uploadBuildpackReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) API() string {
 	fake.aPIMutex.Lock()
 	ret, specificReturn := fake.aPIReturnsOnCall[len(fake.aPIArgsForCall)]
@@ -3585,6 +8436,57 @@ func (fake *FakeCloudControllerClient) AuthorizationEndpointReturnsOnCall(i int,
 	}{result1}
 }
 
+func (fake *FakeCloudControllerClient) DeleteUser(userGUID string) (ccv2.Warnings, error) {
+	fake.deleteUserMutex.Lock()
+	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
+	fake.deleteUserArgsForCall = append(fake.deleteUserArgsForCall, struct {
+		userGUID string
+	}{userGUID})
+	fake.recordInvocation("DeleteUser", []interface{}{userGUID})
+	fake.deleteUserMutex.Unlock()
+	if fake.DeleteUserStub != nil {
+		return fake.DeleteUserStub(userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteUserReturns.result1, fake.deleteUserReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserCallCount() int {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	return len(fake.deleteUserArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserArgsForCall(i int) string {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	return fake.deleteUserArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteUserStub = nil
+	fake.deleteUserReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteUserStub = nil
+	if fake.deleteUserReturnsOnCall == nil {
+		fake.deleteUserReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteUserReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DopplerEndpoint() string {
 	fake.dopplerEndpointMutex.Lock()
 	ret, specificReturn := fake.dopplerEndpointReturnsOnCall[len(fake.dopplerEndpointArgsForCall)]
@@ -3756,22 +8658,70 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.bindRouteToApplicationMutex.RUnlock()
 	fake.checkRouteMutex.RLock()
 	defer fake.checkRouteMutex.RUnlock()
+	fake.createBuildpackMutex.RLock()
+	defer fake.createBuildpackMutex.RUnlock()
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	fake.createOrganizationQuotaMutex.RLock()
+	defer fake.createOrganizationQuotaMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.createPrivateDomainMutex.RLock()
+	defer fake.createPrivateDomainMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
 	fake.createServiceBindingMutex.RLock()
 	defer fake.createServiceBindingMutex.RUnlock()
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	fake.createServiceKeyMutex.RLock()
+	defer fake.createServiceKeyMutex.RUnlock()
+	fake.createSharedDomainMutex.RLock()
+	defer fake.createSharedDomainMutex.RUnlock()
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	fake.createSpaceQuotaMutex.RLock()
+	defer fake.createSpaceQuotaMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	fake.deleteBuildpackMutex.RLock()
+	defer fake.deleteBuildpackMutex.RUnlock()
 	fake.deleteOrganizationMutex.RLock()
 	defer fake.deleteOrganizationMutex.RUnlock()
+	fake.deleteOrganizationAuditorMutex.RLock()
+	defer fake.deleteOrganizationAuditorMutex.RUnlock()
+	fake.deleteOrganizationAuditorByUsernameMutex.RLock()
+	defer fake.deleteOrganizationAuditorByUsernameMutex.RUnlock()
+	fake.deleteOrganizationBillingManagerMutex.RLock()
+	defer fake.deleteOrganizationBillingManagerMutex.RUnlock()
+	fake.deleteOrganizationBillingManagerByUsernameMutex.RLock()
+	defer fake.deleteOrganizationBillingManagerByUsernameMutex.RUnlock()
+	fake.deleteOrganizationManagerMutex.RLock()
+	defer fake.deleteOrganizationManagerMutex.RUnlock()
+	fake.deleteOrganizationManagerByUsernameMutex.RLock()
+	defer fake.deleteOrganizationManagerByUsernameMutex.RUnlock()
+	fake.deletePrivateDomainMutex.RLock()
+	defer fake.deletePrivateDomainMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
 	defer fake.deleteRouteMutex.RUnlock()
+	fake.deleteSecurityGroupMutex.RLock()
+	defer fake.deleteSecurityGroupMutex.RUnlock()
 	fake.deleteServiceBindingMutex.RLock()
 	defer fake.deleteServiceBindingMutex.RUnlock()
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
 	fake.deleteSpaceMutex.RLock()
 	defer fake.deleteSpaceMutex.RUnlock()
+	fake.deleteSpaceAuditorMutex.RLock()
+	defer fake.deleteSpaceAuditorMutex.RUnlock()
+	fake.deleteSpaceDeveloperMutex.RLock()
+	defer fake.deleteSpaceDeveloperMutex.RUnlock()
+	fake.deleteSpaceManagerMutex.RLock()
+	defer fake.deleteSpaceManagerMutex.RUnlock()
 	fake.getApplicationMutex.RLock()
 	defer fake.getApplicationMutex.RUnlock()
 	fake.getApplicationInstancesByApplicationMutex.RLock()
@@ -3782,40 +8732,88 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationRoutesMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
+	fake.getApplicationsPagedMutex.RLock()
+	defer fake.getApplicationsPagedMutex.RUnlock()
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	fake.getEnvironmentVariableGroupMutex.RLock()
+	defer fake.getEnvironmentVariableGroupMutex.RUnlock()
+	fake.getFeatureFlagMutex.RLock()
+	defer fake.getFeatureFlagMutex.RUnlock()
+	fake.getFeatureFlagsMutex.RLock()
+	defer fake.getFeatureFlagsMutex.RUnlock()
 	fake.getJobMutex.RLock()
 	defer fake.getJobMutex.RUnlock()
 	fake.getOrganizationMutex.RLock()
 	defer fake.getOrganizationMutex.RUnlock()
+	fake.getOrganizationAuditorsMutex.RLock()
+	defer fake.getOrganizationAuditorsMutex.RUnlock()
+	fake.getOrganizationBillingManagersMutex.RLock()
+	defer fake.getOrganizationBillingManagersMutex.RUnlock()
+	fake.getOrganizationManagersMutex.RLock()
+	defer fake.getOrganizationManagersMutex.RUnlock()
 	fake.getOrganizationPrivateDomainsMutex.RLock()
 	defer fake.getOrganizationPrivateDomainsMutex.RUnlock()
 	fake.getOrganizationQuotaMutex.RLock()
 	defer fake.getOrganizationQuotaMutex.RUnlock()
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	fake.getOrganizationUsersMutex.RLock()
+	defer fake.getOrganizationUsersMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getPrivateDomainMutex.RLock()
 	defer fake.getPrivateDomainMutex.RUnlock()
 	fake.getRouteApplicationsMutex.RLock()
 	defer fake.getRouteApplicationsMutex.RUnlock()
+	fake.getRouteMappingsMutex.RLock()
+	defer fake.getRouteMappingsMutex.RUnlock()
 	fake.getRoutesMutex.RLock()
 	defer fake.getRoutesMutex.RUnlock()
+	fake.getRoutesPagedMutex.RLock()
+	defer fake.getRoutesPagedMutex.RUnlock()
 	fake.getRunningSpacesBySecurityGroupMutex.RLock()
 	defer fake.getRunningSpacesBySecurityGroupMutex.RUnlock()
 	fake.getSecurityGroupsMutex.RLock()
 	defer fake.getSecurityGroupsMutex.RUnlock()
+	fake.getSecurityGroupsPagedMutex.RLock()
+	defer fake.getSecurityGroupsPagedMutex.RUnlock()
+	fake.getServiceMutex.RLock()
+	defer fake.getServiceMutex.RUnlock()
+	fake.getServiceBindingMutex.RLock()
+	defer fake.getServiceBindingMutex.RUnlock()
 	fake.getServiceBindingsMutex.RLock()
 	defer fake.getServiceBindingsMutex.RUnlock()
 	fake.getServiceInstanceMutex.RLock()
 	defer fake.getServiceInstanceMutex.RUnlock()
 	fake.getServiceInstancesMutex.RLock()
 	defer fake.getServiceInstancesMutex.RUnlock()
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	fake.getServicesMutex.RLock()
+	defer fake.getServicesMutex.RUnlock()
 	fake.getSharedDomainMutex.RLock()
 	defer fake.getSharedDomainMutex.RUnlock()
 	fake.getSharedDomainsMutex.RLock()
 	defer fake.getSharedDomainsMutex.RUnlock()
+	fake.getSpaceAuditorsMutex.RLock()
+	defer fake.getSpaceAuditorsMutex.RUnlock()
+	fake.getSpaceDevelopersMutex.RLock()
+	defer fake.getSpaceDevelopersMutex.RUnlock()
+	fake.getSpaceManagersMutex.RLock()
+	defer fake.getSpaceManagersMutex.RUnlock()
 	fake.getSpaceQuotaMutex.RLock()
 	defer fake.getSpaceQuotaMutex.RUnlock()
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
 	fake.getSpaceRoutesMutex.RLock()
 	defer fake.getSpaceRoutesMutex.RUnlock()
+	fake.getSpaceRoutesPagedMutex.RLock()
+	defer fake.getSpaceRoutesPagedMutex.RUnlock()
 	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
 	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
@@ -3832,6 +8830,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getStagingSpacesBySecurityGroupMutex.RUnlock()
 	fake.pollJobMutex.RLock()
 	defer fake.pollJobMutex.RUnlock()
+	fake.purgeServiceMutex.RLock()
+	defer fake.purgeServiceMutex.RUnlock()
 	fake.removeSpaceFromRunningSecurityGroupMutex.RLock()
 	defer fake.removeSpaceFromRunningSecurityGroupMutex.RUnlock()
 	fake.removeSpaceFromStagingSecurityGroupMutex.RLock()
@@ -3840,18 +8840,64 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.resourceMatchMutex.RUnlock()
 	fake.restageApplicationMutex.RLock()
 	defer fake.restageApplicationMutex.RUnlock()
+	fake.setSpaceQuotaMutex.RLock()
+	defer fake.setSpaceQuotaMutex.RUnlock()
 	fake.targetCFMutex.RLock()
 	defer fake.targetCFMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateBuildpackMutex.RLock()
+	defer fake.updateBuildpackMutex.RUnlock()
+	fake.updateEnvironmentVariableGroupMutex.RLock()
+	defer fake.updateEnvironmentVariableGroupMutex.RUnlock()
+	fake.updateFeatureFlagMutex.RLock()
+	defer fake.updateFeatureFlagMutex.RUnlock()
+	fake.updateOrganizationAuditorMutex.RLock()
+	defer fake.updateOrganizationAuditorMutex.RUnlock()
+	fake.updateOrganizationAuditorByUsernameMutex.RLock()
+	defer fake.updateOrganizationAuditorByUsernameMutex.RUnlock()
+	fake.updateOrganizationBillingManagerMutex.RLock()
+	defer fake.updateOrganizationBillingManagerMutex.RUnlock()
+	fake.updateOrganizationBillingManagerByUsernameMutex.RLock()
+	defer fake.updateOrganizationBillingManagerByUsernameMutex.RUnlock()
+	fake.updateOrganizationManagerMutex.RLock()
+	defer fake.updateOrganizationManagerMutex.RUnlock()
+	fake.updateOrganizationManagerByUsernameMutex.RLock()
+	defer fake.updateOrganizationManagerByUsernameMutex.RUnlock()
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	fake.updateOrganizationUserMutex.RLock()
+	defer fake.updateOrganizationUserMutex.RUnlock()
+	fake.updateOrganizationUserByUsernameMutex.RLock()
+	defer fake.updateOrganizationUserByUsernameMutex.RUnlock()
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	fake.updateSpaceAuditorMutex.RLock()
+	defer fake.updateSpaceAuditorMutex.RUnlock()
+	fake.updateSpaceAuditorByUsernameMutex.RLock()
+	defer fake.updateSpaceAuditorByUsernameMutex.RUnlock()
+	fake.updateSpaceDeveloperMutex.RLock()
+	defer fake.updateSpaceDeveloperMutex.RUnlock()
+	fake.updateSpaceDeveloperByUsernameMutex.RLock()
+	defer fake.updateSpaceDeveloperByUsernameMutex.RUnlock()
+	fake.updateSpaceManagerMutex.RLock()
+	defer fake.updateSpaceManagerMutex.RUnlock()
+	fake.updateSpaceManagerByUsernameMutex.RLock()
+	defer fake.updateSpaceManagerByUsernameMutex.RUnlock()
+	fake.updateSpaceQuotaMutex.RLock()
+	defer fake.updateSpaceQuotaMutex.RUnlock()
 	fake.uploadApplicationPackageMutex.RLock()
 	defer fake.uploadApplicationPackageMutex.RUnlock()
+	fake.uploadBuildpackMutex.RLock()
+	defer fake.uploadBuildpackMutex.RUnlock()
 	fake.aPIMutex.RLock()
 	defer fake.aPIMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
 	defer fake.aPIVersionMutex.RUnlock()
 	fake.authorizationEndpointMutex.RLock()
 	defer fake.authorizationEndpointMutex.RUnlock()
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
 	fake.dopplerEndpointMutex.RLock()
 	defer fake.dopplerEndpointMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
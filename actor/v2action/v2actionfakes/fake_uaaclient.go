@@ -9,11 +9,13 @@ import (
 )
 
 type FakeUAAClient struct {
-	AuthenticateStub        func(username string, password string) (string, string, error)
+	AuthenticateStub        func(ID string, secret string, origin string, grantType uaa.GrantType) (string, string, error)
 	authenticateMutex       sync.RWMutex
 	authenticateArgsForCall []struct {
-		username string
-		password string
+		ID        string
+		secret    string
+		origin    string
+		grantType uaa.GrantType
 	}
 	authenticateReturns struct {
 		result1 string
@@ -40,6 +42,17 @@ type FakeUAAClient struct {
 		result1 uaa.User
 		result2 error
 	}
+	DeleteUserStub        func(userGUID string) error
+	deleteUserMutex       sync.RWMutex
+	deleteUserArgsForCall []struct {
+		userGUID string
+	}
+	deleteUserReturns struct {
+		result1 error
+	}
+	deleteUserReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetSSHPasscodeStub        func(accessToken string, sshOAuthClient string) (string, error)
 	getSSHPasscodeMutex       sync.RWMutex
 	getSSHPasscodeArgsForCall []struct {
@@ -54,6 +67,42 @@ type FakeUAAClient struct {
 		result1 string
 		result2 error
 	}
+	IntrospectTokenStub        func(accessToken string) (uaa.TokenClaims, error)
+	introspectTokenMutex       sync.RWMutex
+	introspectTokenArgsForCall []struct {
+		accessToken string
+	}
+	introspectTokenReturns struct {
+		result1 uaa.TokenClaims
+		result2 error
+	}
+	introspectTokenReturnsOnCall map[int]struct {
+		result1 uaa.TokenClaims
+		result2 error
+	}
+	ListUsersStub        func(userName string, origin string) ([]uaa.User, error)
+	listUsersMutex       sync.RWMutex
+	listUsersArgsForCall []struct {
+		userName string
+		origin   string
+	}
+	listUsersReturns struct {
+		result1 []uaa.User
+		result2 error
+	}
+	listUsersReturnsOnCall map[int]struct {
+		result1 []uaa.User
+		result2 error
+	}
+	LoginLinkStub        func() string
+	loginLinkMutex       sync.RWMutex
+	loginLinkArgsForCall []struct{}
+	loginLinkReturns     struct {
+		result1 string
+	}
+	loginLinkReturnsOnCall map[int]struct {
+		result1 string
+	}
 	RefreshAccessTokenStub        func(refreshToken string) (uaa.RefreshedTokens, error)
 	refreshAccessTokenMutex       sync.RWMutex
 	refreshAccessTokenArgsForCall []struct {
@@ -71,17 +120,19 @@ type FakeUAAClient struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeUAAClient) Authenticate(username string, password string) (string, string, error) {
+func (fake *FakeUAAClient) Authenticate(ID string, secret string, origin string, grantType uaa.GrantType) (string, string, error) {
 	fake.authenticateMutex.Lock()
 	ret, specificReturn := fake.authenticateReturnsOnCall[len(fake.authenticateArgsForCall)]
 	fake.authenticateArgsForCall = append(fake.authenticateArgsForCall, struct {
-		username string
-		password string
-	}{username, password})
-	fake.recordInvocation("Authenticate", []interface{}{username, password})
+		ID        string
+		secret    string
+		origin    string
+		grantType uaa.GrantType
+	}{ID, secret, origin, grantType})
+	fake.recordInvocation("Authenticate", []interface{}{ID, secret, origin, grantType})
 	fake.authenticateMutex.Unlock()
 	if fake.AuthenticateStub != nil {
-		return fake.AuthenticateStub(username, password)
+		return fake.AuthenticateStub(ID, secret, origin, grantType)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -95,10 +146,10 @@ func (fake *FakeUAAClient) AuthenticateCallCount() int {
 	return len(fake.authenticateArgsForCall)
 }
 
-func (fake *FakeUAAClient) AuthenticateArgsForCall(i int) (string, string) {
+func (fake *FakeUAAClient) AuthenticateArgsForCall(i int) (string, string, string, uaa.GrantType) {
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
-	return fake.authenticateArgsForCall[i].username, fake.authenticateArgsForCall[i].password
+	return fake.authenticateArgsForCall[i].ID, fake.authenticateArgsForCall[i].secret, fake.authenticateArgsForCall[i].origin, fake.authenticateArgsForCall[i].grantType
 }
 
 func (fake *FakeUAAClient) AuthenticateReturns(result1 string, result2 string, result3 error) {
@@ -179,6 +230,54 @@ func (fake *FakeUAAClient) CreateUserReturnsOnCall(i int, result1 uaa.User, resu
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) DeleteUser(userGUID string) error {
+	fake.deleteUserMutex.Lock()
+	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
+	fake.deleteUserArgsForCall = append(fake.deleteUserArgsForCall, struct {
+		userGUID string
+	}{userGUID})
+	fake.recordInvocation("DeleteUser", []interface{}{userGUID})
+	fake.deleteUserMutex.Unlock()
+	if fake.DeleteUserStub != nil {
+		return fake.DeleteUserStub(userGUID)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteUserReturns.result1
+}
+
+func (fake *FakeUAAClient) DeleteUserCallCount() int {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	return len(fake.deleteUserArgsForCall)
+}
+
+func (fake *FakeUAAClient) DeleteUserArgsForCall(i int) string {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	return fake.deleteUserArgsForCall[i].userGUID
+}
+
+func (fake *FakeUAAClient) DeleteUserReturns(result1 error) {
+	fake.DeleteUserStub = nil
+	fake.deleteUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) DeleteUserReturnsOnCall(i int, result1 error) {
+	fake.DeleteUserStub = nil
+	if fake.deleteUserReturnsOnCall == nil {
+		fake.deleteUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUAAClient) GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error) {
 	fake.getSSHPasscodeMutex.Lock()
 	ret, specificReturn := fake.getSSHPasscodeReturnsOnCall[len(fake.getSSHPasscodeArgsForCall)]
@@ -231,6 +330,149 @@ func (fake *FakeUAAClient) GetSSHPasscodeReturnsOnCall(i int, result1 string, re
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) IntrospectToken(accessToken string) (uaa.TokenClaims, error) {
+	fake.introspectTokenMutex.Lock()
+	ret, specificReturn := fake.introspectTokenReturnsOnCall[len(fake.introspectTokenArgsForCall)]
+	fake.introspectTokenArgsForCall = append(fake.introspectTokenArgsForCall, struct {
+		accessToken string
+	}{accessToken})
+	fake.recordInvocation("IntrospectToken", []interface{}{accessToken})
+	fake.introspectTokenMutex.Unlock()
+	if fake.IntrospectTokenStub != nil {
+		return fake.IntrospectTokenStub(accessToken)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.introspectTokenReturns.result1, fake.introspectTokenReturns.result2
+}
+
+func (fake *FakeUAAClient) IntrospectTokenCallCount() int {
+	fake.introspectTokenMutex.RLock()
+	defer fake.introspectTokenMutex.RUnlock()
+	return len(fake.introspectTokenArgsForCall)
+}
+
+func (fake *FakeUAAClient) IntrospectTokenArgsForCall(i int) string {
+	fake.introspectTokenMutex.RLock()
+	defer fake.introspectTokenMutex.RUnlock()
+	return fake.introspectTokenArgsForCall[i].accessToken
+}
+
+func (fake *FakeUAAClient) IntrospectTokenReturns(result1 uaa.TokenClaims, result2 error) {
+	fake.IntrospectTokenStub = nil
+	fake.introspectTokenReturns = struct {
+		result1 uaa.TokenClaims
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) IntrospectTokenReturnsOnCall(i int, result1 uaa.TokenClaims, result2 error) {
+	fake.IntrospectTokenStub = nil
+	if fake.introspectTokenReturnsOnCall == nil {
+		fake.introspectTokenReturnsOnCall = make(map[int]struct {
+			result1 uaa.TokenClaims
+			result2 error
+		})
+	}
+	fake.introspectTokenReturnsOnCall[i] = struct {
+		result1 uaa.TokenClaims
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) ListUsers(userName string, origin string) ([]uaa.User, error) {
+	fake.listUsersMutex.Lock()
+	ret, specificReturn := fake.listUsersReturnsOnCall[len(fake.listUsersArgsForCall)]
+	fake.listUsersArgsForCall = append(fake.listUsersArgsForCall, struct {
+		userName string
+		origin   string
+	}{userName, origin})
+	fake.recordInvocation("ListUsers", []interface{}{userName, origin})
+	fake.listUsersMutex.Unlock()
+	if fake.ListUsersStub != nil {
+		return fake.ListUsersStub(userName, origin)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listUsersReturns.result1, fake.listUsersReturns.result2
+}
+
+func (fake *FakeUAAClient) ListUsersCallCount() int {
+	fake.listUsersMutex.RLock()
+	defer fake.listUsersMutex.RUnlock()
+	return len(fake.listUsersArgsForCall)
+}
+
+func (fake *FakeUAAClient) ListUsersArgsForCall(i int) (string, string) {
+	fake.listUsersMutex.RLock()
+	defer fake.listUsersMutex.RUnlock()
+	return fake.listUsersArgsForCall[i].userName, fake.listUsersArgsForCall[i].origin
+}
+
+func (fake *FakeUAAClient) ListUsersReturns(result1 []uaa.User, result2 error) {
+	fake.ListUsersStub = nil
+	fake.listUsersReturns = struct {
+		result1 []uaa.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) ListUsersReturnsOnCall(i int, result1 []uaa.User, result2 error) {
+	fake.ListUsersStub = nil
+	if fake.listUsersReturnsOnCall == nil {
+		fake.listUsersReturnsOnCall = make(map[int]struct {
+			result1 []uaa.User
+			result2 error
+		})
+	}
+	fake.listUsersReturnsOnCall[i] = struct {
+		result1 []uaa.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) LoginLink() string {
+	fake.loginLinkMutex.Lock()
+	ret, specificReturn := fake.loginLinkReturnsOnCall[len(fake.loginLinkArgsForCall)]
+	fake.loginLinkArgsForCall = append(fake.loginLinkArgsForCall, struct{}{})
+	fake.recordInvocation("LoginLink", []interface{}{})
+	fake.loginLinkMutex.Unlock()
+	if fake.LoginLinkStub != nil {
+		return fake.LoginLinkStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.loginLinkReturns.result1
+}
+
+func (fake *FakeUAAClient) LoginLinkCallCount() int {
+	fake.loginLinkMutex.RLock()
+	defer fake.loginLinkMutex.RUnlock()
+	return len(fake.loginLinkArgsForCall)
+}
+
+func (fake *FakeUAAClient) LoginLinkReturns(result1 string) {
+	fake.LoginLinkStub = nil
+	fake.loginLinkReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeUAAClient) LoginLinkReturnsOnCall(i int, result1 string) {
+	fake.LoginLinkStub = nil
+	if fake.loginLinkReturnsOnCall == nil {
+		fake.loginLinkReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.loginLinkReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeUAAClient) RefreshAccessToken(refreshToken string) (uaa.RefreshedTokens, error) {
 	fake.refreshAccessTokenMutex.Lock()
 	ret, specificReturn := fake.refreshAccessTokenReturnsOnCall[len(fake.refreshAccessTokenArgsForCall)]
@@ -289,8 +531,16 @@ func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	defer fake.authenticateMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
 	fake.getSSHPasscodeMutex.RLock()
 	defer fake.getSSHPasscodeMutex.RUnlock()
+	fake.introspectTokenMutex.RLock()
+	defer fake.introspectTokenMutex.RUnlock()
+	fake.listUsersMutex.RLock()
+	defer fake.listUsersMutex.RUnlock()
+	fake.loginLinkMutex.RLock()
+	defer fake.loginLinkMutex.RUnlock()
 	fake.refreshAccessTokenMutex.RLock()
 	defer fake.refreshAccessTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
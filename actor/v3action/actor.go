@@ -16,12 +16,17 @@ type Warnings []string
 type Actor struct {
 	CloudControllerClient CloudControllerClient
 	Config                Config
+	UAAClient             UAAClient
+
+	nameCache *nameCache
 }
 
 // NewActor returns a new V3 actor.
-func NewActor(client CloudControllerClient, config Config) *Actor {
+func NewActor(client CloudControllerClient, uaaClient UAAClient, config Config) *Actor {
 	return &Actor{
 		CloudControllerClient: client,
 		Config:                config,
+		UAAClient:             uaaClient,
+		nameCache:             newNameCache(),
 	}
 }
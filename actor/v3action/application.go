@@ -7,6 +7,8 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/jobs"
+	"code.cloudfoundry.org/cli/util/interrupt"
 )
 
 // Application represents a V3 actor application.
@@ -15,6 +17,7 @@ type Application struct {
 	GUID      string
 	State     string
 	Lifecycle AppLifecycle
+	Metadata  *Metadata
 }
 
 type AppLifecycle struct {
@@ -97,6 +100,7 @@ func (actor Actor) GetApplicationByNameAndSpace(appName string, spaceGUID string
 			Type: AppLifecycleType(apps[0].Lifecycle.Type),
 			Data: AppLifecycleData(apps[0].Lifecycle.Data),
 		},
+		Metadata: (*Metadata)(apps[0].Metadata),
 	}, Warnings(warnings), nil
 }
 
@@ -110,6 +114,23 @@ func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warn
 		return []Application{}, Warnings(warnings), err
 	}
 
+	return convertApplications(ccv3Apps), Warnings(warnings), nil
+}
+
+// GetApplicationsBySpacePaged calls handlePage with each page of
+// applications in a space as it is retrieved. Returning an error from
+// handlePage stops the pagination and returns that error.
+func (actor Actor) GetApplicationsBySpacePaged(spaceGUID string, handlePage func([]Application) error) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.GetApplicationsPaged(url.Values{
+		"space_guids": []string{spaceGUID},
+	}, func(ccv3Apps []ccv3.Application) error {
+		return handlePage(convertApplications(ccv3Apps))
+	})
+
+	return Warnings(warnings), err
+}
+
+func convertApplications(ccv3Apps []ccv3.Application) []Application {
 	apps := make([]Application, len(ccv3Apps))
 	for i, ccv3App := range ccv3Apps {
 		apps[i] = Application{
@@ -122,24 +143,29 @@ func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warn
 			},
 		}
 	}
-	return apps, Warnings(warnings), nil
+	return apps
 }
 
 // CreateApplicationInSpace creates and returns the application with the given
 // name in the given space.
 func (actor Actor) CreateApplicationInSpace(app Application, spaceGUID string) (Application, Warnings, error) {
+	lifecycle := ccv3.AppLifecycle{
+		Type: ccv3.AppLifecycleType(app.Lifecycle.Type),
+	}
+	// Docker apps are run from an image, so they never have buildpacks.
+	if app.Lifecycle.Type != DockerAppLifecycleType {
+		lifecycle.Data = ccv3.AppLifecycleData{
+			Buildpacks: app.Lifecycle.Data.Buildpacks,
+		}
+	}
+
 	createdApp, warnings, err := actor.CloudControllerClient.CreateApplication(
 		ccv3.Application{
 			Name: app.Name,
 			Relationships: ccv3.Relationships{
 				ccv3.SpaceRelationship: ccv3.Relationship{GUID: spaceGUID},
 			},
-			Lifecycle: ccv3.AppLifecycle{
-				Type: ccv3.AppLifecycleType(app.Lifecycle.Type),
-				Data: ccv3.AppLifecycleData{
-					Buildpacks: app.Lifecycle.Data.Buildpacks,
-				},
-			},
+			Lifecycle: lifecycle,
 		})
 
 	if err != nil {
@@ -185,20 +211,26 @@ func (actor Actor) StartApplication(appGUID string) (Application, Warnings, erro
 	}, Warnings(warnings), nil
 }
 
-func (actor Actor) PollStart(appGUID string, warningsChannel chan<- Warnings) error {
+// PollStart waits for all of the application's processes to have a running
+// instance. If startupTimeout is zero, the configured startup timeout is used
+// instead.
+func (actor Actor) PollStart(appGUID string, startupTimeout time.Duration, warningsChannel chan<- Warnings) error {
 	processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
 	warningsChannel <- Warnings(warnings)
 	if err != nil {
 		return err
 	}
 
-	timeout := time.Now().Add(actor.Config.StartupTimeout())
-	for time.Now().Before(timeout) {
+	if startupTimeout == 0 {
+		startupTimeout = actor.Config.StartupTimeout()
+	}
+
+	poller := jobs.NewPoller(jobs.JobGetterFunc(func(guid string) (jobs.State, []string, error) {
 		readyProcs := 0
 		for _, process := range processes {
 			ready, err := actor.processReady(process, warningsChannel)
 			if err != nil {
-				return err
+				return jobs.State{}, nil, err
 			}
 
 			if ready {
@@ -206,13 +238,14 @@ func (actor Actor) PollStart(appGUID string, warningsChannel chan<- Warnings) er
 			}
 		}
 
-		if readyProcs == len(processes) {
-			return nil
-		}
-		time.Sleep(actor.Config.PollingInterval())
-	}
+		return jobs.State{GUID: guid, Complete: readyProcs == len(processes)}, nil, nil
+	}), interrupt.DefaultHandler)
 
-	return StartupTimeoutError{}
+	_, err = poller.PollJob(appGUID, actor.Config.PollingInterval(), startupTimeout)
+	if _, ok := err.(ccerror.JobTimeoutError); ok {
+		return StartupTimeoutError{}
+	}
+	return err
 }
 
 // UpdateApplication updates the buildpacks on an application
@@ -1,13 +1,25 @@
 package v3action
 
-import "net/url"
+import (
+	"net/url"
 
-func (actor Actor) GetApplicationSummariesBySpace(spaceGUID string) ([]ApplicationSummary, Warnings, error) {
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// GetApplicationSummariesBySpace returns summaries of the applications in the
+// space. When labelSelector is not empty, only applications whose labels
+// match it are returned.
+func (actor Actor) GetApplicationSummariesBySpace(spaceGUID string, labelSelector string) ([]ApplicationSummary, Warnings, error) {
 	var allWarnings Warnings
 
-	apps, warnings, err := actor.CloudControllerClient.GetApplications(url.Values{
+	query := url.Values{
 		"space_guids": []string{spaceGUID},
-	})
+	}
+	if labelSelector != "" {
+		query.Add(ccv3.LabelSelectorFilter, labelSelector)
+	}
+
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(query)
 	allWarnings = Warnings(warnings)
 	if err != nil {
 		return nil, allWarnings, err
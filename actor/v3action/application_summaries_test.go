@@ -20,7 +20,7 @@ var _ = Describe("Application Summaries Actions", func() {
 
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
-		actor = NewActor(fakeCloudControllerClient, nil)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
 	})
 
 	Describe("GetApplicationSummariesBySpace", func() {
@@ -91,7 +91,7 @@ var _ = Describe("Application Summaries Actions", func() {
 			})
 
 			It("returns app summaries and warnings", func() {
-				summaries, warnings, err := actor.GetApplicationSummariesBySpace("some-space-guid")
+				summaries, warnings, err := actor.GetApplicationSummariesBySpace("some-space-guid", "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(summaries).To(Equal([]ApplicationSummary{
 					{
@@ -148,6 +148,19 @@ var _ = Describe("Application Summaries Actions", func() {
 				processGUID = fakeCloudControllerClient.GetProcessInstancesArgsForCall(2)
 				Expect(processGUID).To(Equal("some-process-guid-3"))
 			})
+
+			Context("when a label selector is provided", func() {
+				It("filters the apps by the label selector", func() {
+					_, _, err := actor.GetApplicationSummariesBySpace("some-space-guid", "env=prod")
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{
+						"space_guids":            []string{"some-space-guid"},
+						ccv3.LabelSelectorFilter: []string{"env=prod"},
+					}))
+				})
+			})
 		})
 
 		Context("when getting the app processes returns an error", func() {
@@ -175,7 +188,7 @@ var _ = Describe("Application Summaries Actions", func() {
 			})
 
 			It("returns the error", func() {
-				_, warnings, err := actor.GetApplicationSummariesBySpace("some-space-guid")
+				_, warnings, err := actor.GetApplicationSummariesBySpace("some-space-guid", "")
 				Expect(err).To(Equal(expectedErr))
 				Expect(warnings).To(Equal(Warnings{"some-warning", "some-process-warning"}))
 			})
@@ -217,7 +230,7 @@ var _ = Describe("Application Summaries Actions", func() {
 			})
 
 			It("returns the error", func() {
-				_, warnings, err := actor.GetApplicationSummariesBySpace("some-space-guid")
+				_, warnings, err := actor.GetApplicationSummariesBySpace("some-space-guid", "")
 				Expect(err).To(Equal(expectedErr))
 				Expect(warnings).To(Equal(Warnings{"some-warning", "some-process-warning", "some-process-stats-warning"}))
 			})
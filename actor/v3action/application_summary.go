@@ -61,17 +61,15 @@ func (actor Actor) getProcessSummariesForApp(appGUID string) (ProcessSummaries,
 	var processSummaries ProcessSummaries
 	for _, ccv3Process := range ccv3Processes {
 		processGUID := ccv3Process.GUID
-		instances, warnings, err := actor.CloudControllerClient.GetProcessInstances(processGUID)
-		allWarnings = append(allWarnings, Warnings(warnings)...)
+		instances, warnings, err := actor.GetProcessInstances(processGUID)
+		allWarnings = append(allWarnings, warnings...)
 		if err != nil {
 			return nil, allWarnings, err
 		}
 
 		processSummary := ProcessSummary{
-			Process: Process(ccv3Process),
-		}
-		for _, instance := range instances {
-			processSummary.InstanceDetails = append(processSummary.InstanceDetails, Instance(instance))
+			Process:         Process(ccv3Process),
+			InstanceDetails: instances,
 		}
 
 		processSummaries = append(processSummaries, processSummary)
@@ -26,7 +26,7 @@ var _ = Describe("Application Actions", func() {
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
 		fakeConfig = new(v3actionfakes.FakeConfig)
-		actor = NewActor(fakeCloudControllerClient, fakeConfig)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
 	})
 
 	Describe("DeleteApplicationByNameAndSpace", func() {
@@ -240,15 +240,63 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationsBySpacePaged", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsPagedStub = func(_ url.Values, handlePage func([]ccv3.Application) error) (ccv3.Warnings, error) {
+				for _, page := range [][]ccv3.Application{
+					{{GUID: "some-app-guid-1", Name: "some-app-1"}},
+					{{GUID: "some-app-guid-2", Name: "some-app-2"}},
+				} {
+					if err := handlePage(page); err != nil {
+						return ccv3.Warnings{"warning-1"}, err
+					}
+				}
+				return ccv3.Warnings{"warning-1", "warning-2"}, nil
+			}
+		})
+
+		It("calls handlePage with each page of applications in the space", func() {
+			var pages [][]Application
+			warnings, err := actor.GetApplicationsBySpacePaged("some-space-guid", func(page []Application) error {
+				pages = append(pages, page)
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			Expect(pages).To(Equal([][]Application{
+				{{GUID: "some-app-guid-1", Name: "some-app-1"}},
+				{{GUID: "some-app-guid-2", Name: "some-app-2"}},
+			}))
+
+			Expect(fakeCloudControllerClient.GetApplicationsPagedCallCount()).To(Equal(1))
+			query, _ := fakeCloudControllerClient.GetApplicationsPagedArgsForCall(0)
+			Expect(query).To(Equal(url.Values{
+				"space_guids": []string{"some-space-guid"},
+			}))
+		})
+
+		Context("when handlePage returns an error", func() {
+			It("returns the error and warnings", func() {
+				expectedErr := errors.New("stop")
+				warnings, err := actor.GetApplicationsBySpacePaged("some-space-guid", func([]Application) error {
+					return expectedErr
+				})
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("CreateApplicationInSpace", func() {
 		var (
+			appToCreate Application
 			application Application
 			warnings    Warnings
 			err         error
 		)
 
-		JustBeforeEach(func() {
-			application, warnings, err = actor.CreateApplicationInSpace(Application{
+		BeforeEach(func() {
+			appToCreate = Application{
 				Name: "some-app-name",
 				Lifecycle: AppLifecycle{
 					Type: "buildpack",
@@ -256,7 +304,11 @@ var _ = Describe("Application Actions", func() {
 						Buildpacks: []string{"buildpack-1", "buildpack-2"},
 					},
 				},
-			}, "some-space-guid")
+			}
+		})
+
+		JustBeforeEach(func() {
+			application, warnings, err = actor.CreateApplicationInSpace(appToCreate, "some-space-guid")
 		})
 
 		Context("when the app successfully gets created", func() {
@@ -308,6 +360,55 @@ var _ = Describe("Application Actions", func() {
 			})
 		})
 
+		Context("when the app has a docker lifecycle", func() {
+			BeforeEach(func() {
+				appToCreate = Application{
+					Name: "some-app-name",
+					Lifecycle: AppLifecycle{
+						Type: DockerAppLifecycleType,
+						Data: AppLifecycleData{
+							Buildpacks: []string{"buildpack-1"},
+						},
+					},
+				}
+
+				fakeCloudControllerClient.CreateApplicationReturns(
+					ccv3.Application{
+						Name: "some-app-name",
+						GUID: "some-app-guid",
+						Lifecycle: ccv3.AppLifecycle{
+							Type: ccv3.DockerAppLifecycleType,
+						},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("creates the application without buildpacks", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(application).To(Equal(Application{
+					Name: "some-app-name",
+					GUID: "some-app-guid",
+					Lifecycle: AppLifecycle{
+						Type: DockerAppLifecycleType,
+					},
+				}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.CreateApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CreateApplicationArgsForCall(0)).To(Equal(ccv3.Application{
+					Name: "some-app-name",
+					Relationships: ccv3.Relationships{
+						ccv3.SpaceRelationship: ccv3.Relationship{GUID: "some-space-guid"},
+					},
+					Lifecycle: ccv3.AppLifecycle{
+						Type: ccv3.DockerAppLifecycleType,
+					},
+				}))
+			})
+		})
+
 		Context("when the cc client returns an error", func() {
 			var expectedError error
 
@@ -451,7 +552,7 @@ var _ = Describe("Application Actions", func() {
 			})
 
 			It("returns the error and all warnings", func() {
-				err := actor.PollStart("some-guid", warningsChannel)
+				err := actor.PollStart("some-guid", 0, warningsChannel)
 				funcDone <- nil
 				Expect(allWarnings).To(ConsistOf("get-app-warning-1", "get-app-warning-2"))
 				Expect(err).To(MatchError(errors.New("some-error")))
@@ -489,7 +590,7 @@ var _ = Describe("Application Actions", func() {
 					})
 
 					It("returns the timeout error", func() {
-						err := actor.PollStart("some-guid", warningsChannel)
+						err := actor.PollStart("some-guid", 0, warningsChannel)
 						funcDone <- nil
 
 						Expect(allWarnings).To(ConsistOf("get-app-warning-1", "get-process-warning-1", "get-process-warning-2"))
@@ -497,12 +598,22 @@ var _ = Describe("Application Actions", func() {
 					})
 
 					It("gets polling and timeout values from the config", func() {
-						actor.PollStart("some-guid", warningsChannel)
+						actor.PollStart("some-guid", 0, warningsChannel)
 						funcDone <- nil
 
 						Expect(fakeConfig.StartupTimeoutCallCount()).To(Equal(1))
 						Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(1))
 					})
+
+					Context("when a startup timeout is provided", func() {
+						It("uses the provided timeout instead of the config", func() {
+							err := actor.PollStart("some-guid", time.Millisecond, warningsChannel)
+							funcDone <- nil
+
+							Expect(err).To(MatchError(StartupTimeoutError{}))
+							Expect(fakeConfig.StartupTimeoutCallCount()).To(Equal(0))
+						})
+					})
 				})
 
 				Context("when getting the process instances errors", func() {
@@ -515,7 +626,7 @@ var _ = Describe("Application Actions", func() {
 					})
 
 					It("returns the error", func() {
-						err := actor.PollStart("some-guid", warningsChannel)
+						err := actor.PollStart("some-guid", 0, warningsChannel)
 						funcDone <- nil
 
 						Expect(allWarnings).To(ConsistOf("get-app-warning-1", "get-process-warning-1", "get-process-warning-2"))
@@ -549,7 +660,7 @@ var _ = Describe("Application Actions", func() {
 							}
 						}
 
-						pollStartErr = actor.PollStart("some-guid", warningsChannel)
+						pollStartErr = actor.PollStart("some-guid", 0, warningsChannel)
 						funcDone <- nil
 					})
 
@@ -635,7 +746,7 @@ var _ = Describe("Application Actions", func() {
 						}
 					}
 
-					pollStartErr = actor.PollStart("some-guid", warningsChannel)
+					pollStartErr = actor.PollStart("some-guid", 0, warningsChannel)
 					funcDone <- nil
 				})
 
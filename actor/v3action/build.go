@@ -4,7 +4,10 @@ import (
 	"errors"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/jobs"
+	"code.cloudfoundry.org/cli/util/interrupt"
 )
 
 type StagingTimeoutError struct {
@@ -16,7 +19,9 @@ func (StagingTimeoutError) Error() string {
 	return "Timed out waiting for package to stage"
 }
 
-func (actor Actor) StagePackage(packageGUID string, appName string) (<-chan Droplet, <-chan Warnings, <-chan error) {
+// StagePackage stages the package, using the given buildpacks in order when
+// any are provided, and streams back the resulting droplet.
+func (actor Actor) StagePackage(packageGUID string, appName string, buildpacks []string) (<-chan Droplet, <-chan Warnings, <-chan error) {
 	dropletStream := make(chan Droplet)
 	warningsStream := make(chan Warnings)
 	errorStream := make(chan error)
@@ -26,55 +31,69 @@ func (actor Actor) StagePackage(packageGUID string, appName string) (<-chan Drop
 		defer close(warningsStream)
 		defer close(errorStream)
 
-		build := ccv3.Build{PackageGUID: packageGUID}
-		build, allWarnings, err := actor.CloudControllerClient.CreateBuild(build)
-		warningsStream <- Warnings(allWarnings)
-
+		droplet, err := actor.stagePackage(packageGUID, appName, buildpacks, warningsStream)
 		if err != nil {
 			errorStream <- err
 			return
 		}
 
-		timeout := time.Now().Add(actor.Config.StagingTimeout())
-
-		for time.Now().Before(timeout) {
-			var warnings ccv3.Warnings
-			build, warnings, err = actor.CloudControllerClient.GetBuild(build.GUID)
-			warningsStream <- Warnings(warnings)
-			if err != nil {
-				errorStream <- err
-				return
-			}
-
-			switch build.State {
-			case ccv3.BuildStateFailed:
-				errorStream <- errors.New(build.Error)
-				return
-			case ccv3.BuildStateStaging:
-				time.Sleep(actor.Config.PollingInterval())
-			default:
-
-				//TODO: uncommend after #150569020
-				// ccv3Droplet, warnings, err := actor.CloudControllerClient.GetDroplet(build.DropletGUID)
-				// warningsStream <- Warnings(warnings)
-				// if err != nil {
-				// 	errorStream <- err
-				// 	return
-				// }
-
-				ccv3Droplet := ccv3.Droplet{
-					GUID:      build.DropletGUID,
-					State:     ccv3.DropletState(build.State),
-					CreatedAt: build.CreatedAt,
-				}
-
-				dropletStream <- actor.convertCCToActorDroplet(ccv3Droplet)
-				return
-			}
-		}
-
-		errorStream <- StagingTimeoutError{AppName: appName, Timeout: actor.Config.StagingTimeout()}
+		dropletStream <- droplet
 	}()
 
 	return dropletStream, warningsStream, errorStream
 }
+
+// stagePackage creates a build for the package and polls it until it
+// produces a droplet, sending warnings to warningsStream as they arrive.
+func (actor Actor) stagePackage(packageGUID string, appName string, buildpacks []string, warningsStream chan<- Warnings) (Droplet, error) {
+	build := ccv3.Build{PackageGUID: packageGUID, Buildpacks: buildpacks}
+	build, allWarnings, err := actor.CloudControllerClient.CreateBuild(build)
+	warningsStream <- Warnings(allWarnings)
+
+	if err != nil {
+		return Droplet{}, err
+	}
+
+	var droplet Droplet
+	poller := jobs.NewPoller(jobs.JobGetterFunc(func(guid string) (jobs.State, []string, error) {
+		build, warnings, err := actor.CloudControllerClient.GetBuild(guid)
+		warningsStream <- Warnings(warnings)
+		if err != nil {
+			return jobs.State{}, nil, err
+		}
+
+		switch build.State {
+		case ccv3.BuildStateFailed:
+			return jobs.State{}, nil, errors.New(build.Error)
+		case ccv3.BuildStateStaging:
+			return jobs.State{GUID: guid}, nil, nil
+		}
+
+		//TODO: uncommend after #150569020
+		// ccv3Droplet, warnings, err := actor.CloudControllerClient.GetDroplet(build.DropletGUID)
+		// warningsStream <- Warnings(warnings)
+		// if err != nil {
+		// 	errorStream <- err
+		// 	return
+		// }
+
+		ccv3Droplet := ccv3.Droplet{
+			GUID:      build.DropletGUID,
+			State:     ccv3.DropletState(build.State),
+			CreatedAt: build.CreatedAt,
+		}
+
+		droplet = actor.convertCCToActorDroplet(ccv3Droplet)
+		return jobs.State{GUID: guid, Complete: true}, nil, nil
+	}), interrupt.DefaultHandler)
+
+	_, err = poller.PollJob(build.GUID, actor.Config.PollingInterval(), actor.Config.StagingTimeout())
+	if _, ok := err.(ccerror.JobTimeoutError); ok {
+		return Droplet{}, StagingTimeoutError{AppName: appName, Timeout: actor.Config.StagingTimeout()}
+	}
+	if err != nil {
+		return Droplet{}, err
+	}
+
+	return droplet, nil
+}
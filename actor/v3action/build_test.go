@@ -21,7 +21,7 @@ var _ = Describe("Build Actions", func() {
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
 		fakeConfig = new(v3actionfakes.FakeConfig)
-		actor = NewActor(fakeCloudControllerClient, fakeConfig)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
 	})
 
 	Describe("StagePackage", func() {
@@ -32,8 +32,13 @@ var _ = Describe("Build Actions", func() {
 
 			buildGUID   string
 			dropletGUID string
+			buildpacks  []string
 		)
 
+		BeforeEach(func() {
+			buildpacks = nil
+		})
+
 		AfterEach(func() {
 			Eventually(errorStream).Should(BeClosed())
 			Eventually(warningsStream).Should(BeClosed())
@@ -41,7 +46,7 @@ var _ = Describe("Build Actions", func() {
 		})
 
 		JustBeforeEach(func() {
-			dropletStream, warningsStream, errorStream = actor.StagePackage("some-package-guid", "some-app")
+			dropletStream, warningsStream, errorStream = actor.StagePackage("some-package-guid", "some-app", buildpacks)
 		})
 
 		Context("when the creation is successful", func() {
@@ -101,6 +106,25 @@ var _ = Describe("Build Actions", func() {
 				})
 				// })
 
+				Context("when buildpacks are provided", func() {
+					BeforeEach(func() {
+						buildpacks = []string{"some-buildpack", "some-other-buildpack"}
+					})
+
+					It("creates the build with the buildpacks", func() {
+						Eventually(warningsStream).Should(Receive(ConsistOf("create-warnings-1", "create-warnings-2")))
+						Eventually(warningsStream).Should(Receive(ConsistOf("get-warnings-1", "get-warnings-2")))
+						Eventually(warningsStream).Should(Receive(ConsistOf("get-warnings-3", "get-warnings-4")))
+						Eventually(dropletStream).Should(Receive())
+
+						Expect(fakeCloudControllerClient.CreateBuildCallCount()).To(Equal(1))
+						Expect(fakeCloudControllerClient.CreateBuildArgsForCall(0)).To(Equal(ccv3.Build{
+							PackageGUID: "some-package-guid",
+							Buildpacks:  []string{"some-buildpack", "some-other-buildpack"},
+						}))
+					})
+				})
+
 				Context("when polling returns a failed build", func() {
 					BeforeEach(func() {
 						fakeCloudControllerClient.GetBuildReturnsOnCall(
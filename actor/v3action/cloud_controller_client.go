@@ -4,31 +4,51 @@ import (
 	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
 )
 
 //go:generate counterfeiter . CloudControllerClient
 
 // CloudControllerClient is the interface to the cloud controller V3 API.
 type CloudControllerClient interface {
+	AppSSHEndpoint() string
+	AppSSHHostKeyFingerprint() string
 	AssignSpaceToIsolationSegment(spaceGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error)
+	CancelDeployment(deploymentGUID string) (ccv3.Warnings, error)
 	CloudControllerAPIVersion() string
+	CopyPackage(sourcePackageGUID string, targetAppGUID string) (ccv3.Package, ccv3.Warnings, error)
 	CreateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
+	CreateApplicationDeployment(appGUID string, dropletGUID string) (ccv3.Deployment, ccv3.Warnings, error)
+	CreateApplicationDeploymentByRevision(appGUID string, revisionGUID string) (ccv3.Deployment, ccv3.Warnings, error)
 	CreateApplicationProcessScale(appGUID string, process ccv3.Process) (ccv3.Warnings, error)
 	CreateApplicationTask(appGUID string, task ccv3.Task) (ccv3.Task, ccv3.Warnings, error)
 	CreateBuild(build ccv3.Build) (ccv3.Build, ccv3.Warnings, error)
 	CreateIsolationSegment(isolationSegment ccv3.IsolationSegment) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	CreatePackage(pkg ccv3.Package) (ccv3.Package, ccv3.Warnings, error)
+	CreateRoute(route ccv3.Route) (ccv3.Route, ccv3.Warnings, error)
 	DeleteApplication(guid string) (string, ccv3.Warnings, error)
 	DeleteApplicationProcessInstance(appGUID string, processType string, instanceIndex int) (ccv3.Warnings, error)
+	DeleteDroplet(dropletGUID string) (string, ccv3.Warnings, error)
 	DeleteIsolationSegment(guid string) (ccv3.Warnings, error)
+	DeletePackage(packageGUID string) (string, ccv3.Warnings, error)
+	DeleteServiceInstanceRelationshipsSharedSpace(serviceInstanceGUID string, spaceGUID string) (ccv3.Warnings, error)
 	EntitleIsolationSegmentToOrganizations(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
+	GetApplicationDeployedRevisions(appGUID string) ([]ccv3.Revision, ccv3.Warnings, error)
 	GetApplicationDroplets(appGUID string, query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error)
+	GetApplicationEnvironment(appGUID string) (ccv3.Environment, ccv3.Warnings, error)
 	GetApplicationProcessByType(appGUID string, processType string) (ccv3.Process, ccv3.Warnings, error)
 	GetApplicationProcesses(appGUID string) ([]ccv3.Process, ccv3.Warnings, error)
+	GetApplicationRevisions(appGUID string, query url.Values) ([]ccv3.Revision, ccv3.Warnings, error)
 	GetApplicationTasks(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error)
 	GetApplications(query url.Values) ([]ccv3.Application, ccv3.Warnings, error)
+	GetApplicationsPaged(query url.Values, handlePage func([]ccv3.Application) error) (ccv3.Warnings, error)
+	GetAuditEventsPaged(query url.Values, handlePage func([]ccv3.AuditEvent) error) (ccv3.Warnings, error)
 	GetBuild(guid string) (ccv3.Build, ccv3.Warnings, error)
+	GetDeployment(deploymentGUID string) (ccv3.Deployment, ccv3.Warnings, error)
+	GetDeployments(query url.Values) ([]ccv3.Deployment, ccv3.Warnings, error)
+	GetDomains(query url.Values) ([]ccv3.Domain, ccv3.Warnings, error)
 	GetDroplet(guid string) (ccv3.Droplet, ccv3.Warnings, error)
+	GetDroplets(query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error)
 	GetIsolationSegment(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	GetIsolationSegmentOrganizationsByIsolationSegment(isolationSegmentGUID string) ([]ccv3.Organization, ccv3.Warnings, error)
 	GetIsolationSegments(query url.Values) ([]ccv3.IsolationSegment, ccv3.Warnings, error)
@@ -37,15 +57,28 @@ type CloudControllerClient interface {
 	GetPackages(query url.Values) ([]ccv3.Package, ccv3.Warnings, error)
 	GetPackage(guid string) (ccv3.Package, ccv3.Warnings, error)
 	GetProcessInstances(processGUID string) ([]ccv3.Instance, ccv3.Warnings, error)
+	GetRouteDestinations(routeGUID string) ([]ccv3.RouteDestination, ccv3.Warnings, error)
+	GetRoutes(query url.Values) ([]ccv3.Route, ccv3.Warnings, error)
+	GetServiceInstances(query url.Values) ([]ccv3.ServiceInstance, ccv3.Warnings, error)
 	GetSpaceIsolationSegment(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error)
-	PatchApplicationProcessHealthCheck(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string) (ccv3.Warnings, error)
+	GetSpaces(query url.Values) ([]ccv3.Space, ccv3.Warnings, error)
+	GetStacks(query url.Values) ([]ccv3.Stack, ccv3.Warnings, error)
+	MapRouteDestination(routeGUID string, destination ccv3.RouteDestination) (ccv3.Warnings, error)
+	PatchApplicationProcessCommand(processGUID string, command types.FilteredString) (ccv3.Warnings, error)
+	PatchApplicationProcessHealthCheck(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string, processInvocationTimeout types.NullInt) (ccv3.Warnings, error)
 	PatchOrganizationDefaultIsolationSegment(orgGUID string, isolationSegmentGUID string) (ccv3.Warnings, error)
 	PollJob(jobURL string) (ccv3.Warnings, error)
 	RevokeIsolationSegmentFromOrganization(isolationSegmentGUID string, organizationGUID string) (ccv3.Warnings, error)
 	SetApplicationDroplet(appGUID string, dropletGUID string) (ccv3.Relationship, ccv3.Warnings, error)
+	ShareServiceInstanceToSpaces(serviceInstanceGUID string, spaceGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
 	StartApplication(appGUID string) (ccv3.Application, ccv3.Warnings, error)
 	StopApplication(appGUID string) (ccv3.Warnings, error)
+	UnmapRouteDestination(routeGUID string, destinationGUID string) (ccv3.Warnings, error)
 	UpdateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
+	UpdateApplicationEnvironmentVariables(appGUID string, envVars ccv3.EnvironmentVariables) (ccv3.EnvironmentVariables, ccv3.Warnings, error)
+	UpdateOrganization(org ccv3.Organization) (ccv3.Organization, ccv3.Warnings, error)
+	UpdateSpace(space ccv3.Space) (ccv3.Space, ccv3.Warnings, error)
+	UpdateSpaceApplyManifest(spaceGUID string, rawManifest []byte) (string, ccv3.Warnings, error)
 	UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
 	UploadPackage(pkg ccv3.Package, zipFilepath string) (ccv3.Package, ccv3.Warnings, error)
 }
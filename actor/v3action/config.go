@@ -5,7 +5,10 @@ import "time"
 //go:generate counterfeiter . Config
 
 type Config interface {
+	AccessToken() string
+	OverallPollingTimeout() time.Duration
 	PollingInterval() time.Duration
+	SSHOAuthClient() string
 	StartupTimeout() time.Duration
 	StagingTimeout() time.Duration
 }
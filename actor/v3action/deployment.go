@@ -0,0 +1,101 @@
+package v3action
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/jobs"
+	"code.cloudfoundry.org/cli/util/interrupt"
+)
+
+// Deployment represents a V3 actor deployment.
+type Deployment ccv3.Deployment
+
+// ActiveDeploymentNotFoundError is returned when an application has no
+// deployment in progress.
+type ActiveDeploymentNotFoundError struct {
+	AppName string
+}
+
+func (ActiveDeploymentNotFoundError) Error() string {
+	return "No active deployment found"
+}
+
+// DeploymentCanceledError is returned when a deployment is canceled before it
+// finishes.
+type DeploymentCanceledError struct{}
+
+func (DeploymentCanceledError) Error() string {
+	return "Deployment was canceled"
+}
+
+// CreateDeployment starts a rolling deployment of the droplet to the
+// application.
+func (actor Actor) CreateDeployment(appGUID string, dropletGUID string) (Deployment, Warnings, error) {
+	deployment, warnings, err := actor.CloudControllerClient.CreateApplicationDeployment(appGUID, dropletGUID)
+	return Deployment(deployment), Warnings(warnings), err
+}
+
+// GetDeployment returns the deployment with the given GUID.
+func (actor Actor) GetDeployment(deploymentGUID string) (Deployment, Warnings, error) {
+	deployment, warnings, err := actor.CloudControllerClient.GetDeployment(deploymentGUID)
+	return Deployment(deployment), Warnings(warnings), err
+}
+
+// GetActiveDeploymentByApplicationNameAndSpace returns the deployment in
+// progress for the application.
+func (actor Actor) GetActiveDeploymentByApplicationNameAndSpace(appName string, spaceGUID string) (Deployment, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return Deployment{}, allWarnings, err
+	}
+
+	deployments, warnings, err := actor.CloudControllerClient.GetDeployments(url.Values{
+		ccv3.AppGUIDFilter: []string{app.GUID},
+		ccv3.StatesFilter:  []string{string(ccv3.DeploymentStateDeploying)},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Deployment{}, allWarnings, err
+	}
+
+	if len(deployments) == 0 {
+		return Deployment{}, allWarnings, ActiveDeploymentNotFoundError{AppName: appName}
+	}
+
+	return Deployment(deployments[0]), allWarnings, nil
+}
+
+// CancelDeployment stops the deployment and rolls the application back to
+// the droplet it was running before the deployment started.
+func (actor Actor) CancelDeployment(deploymentGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.CancelDeployment(deploymentGUID)
+	return Warnings(warnings), err
+}
+
+// PollDeployment polls the deployment until it has replaced every instance of
+// the application. A DeploymentCanceledError is returned if the deployment is
+// canceled and a StartupTimeoutError if it does not finish within the startup
+// timeout.
+func (actor Actor) PollDeployment(deploymentGUID string, warningsChannel chan<- Warnings) error {
+	poller := jobs.NewPoller(jobs.JobGetterFunc(func(guid string) (jobs.State, []string, error) {
+		deployment, warnings, err := actor.CloudControllerClient.GetDeployment(guid)
+		warningsChannel <- Warnings(warnings)
+		if err != nil {
+			return jobs.State{}, nil, err
+		}
+
+		switch deployment.State {
+		case ccv3.DeploymentStateCanceling, ccv3.DeploymentStateCanceled:
+			return jobs.State{}, nil, DeploymentCanceledError{}
+		}
+		return jobs.State{GUID: guid, Complete: deployment.State == ccv3.DeploymentStateDeployed}, nil, nil
+	}), interrupt.DefaultHandler)
+
+	_, err := poller.PollJob(deploymentGUID, actor.Config.PollingInterval(), actor.Config.StartupTimeout())
+	if _, ok := err.(ccerror.JobTimeoutError); ok {
+		return StartupTimeoutError{}
+	}
+	return err
+}
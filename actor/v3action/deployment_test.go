@@ -0,0 +1,266 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Deployment Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+	})
+
+	Describe("CreateDeployment", func() {
+		Context("when creating the deployment succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateApplicationDeploymentReturns(
+					ccv3.Deployment{GUID: "some-deployment-guid", State: ccv3.DeploymentStateDeploying},
+					ccv3.Warnings{"create-warning"},
+					nil,
+				)
+			})
+
+			It("returns the deployment and warnings", func() {
+				deployment, warnings, err := actor.CreateDeployment("some-app-guid", "some-droplet-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(deployment).To(Equal(Deployment{GUID: "some-deployment-guid", State: ccv3.DeploymentStateDeploying}))
+
+				Expect(fakeCloudControllerClient.CreateApplicationDeploymentCallCount()).To(Equal(1))
+				appGUID, dropletGUID := fakeCloudControllerClient.CreateApplicationDeploymentArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(dropletGUID).To(Equal("some-droplet-guid"))
+			})
+		})
+
+		Context("when creating the deployment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create error")
+				fakeCloudControllerClient.CreateApplicationDeploymentReturns(ccv3.Deployment{}, ccv3.Warnings{"create-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.CreateDeployment("some-app-guid", "some-droplet-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+
+	Describe("GetActiveDeploymentByApplicationNameAndSpace", func() {
+		var (
+			deployment Deployment
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			deployment, warnings, executeErr = actor.GetActiveDeploymentByApplicationNameAndSpace("some-app", "some-space-guid")
+		})
+
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{GUID: "some-app-guid", Name: "some-app"}},
+					ccv3.Warnings{"get-app-warning"},
+					nil,
+				)
+			})
+
+			Context("when the app has a deployment in progress", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetDeploymentsReturns(
+						[]ccv3.Deployment{{GUID: "some-deployment-guid", State: ccv3.DeploymentStateDeploying}},
+						ccv3.Warnings{"get-deployments-warning"},
+						nil,
+					)
+				})
+
+				It("returns the deployment and all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-app-warning", "get-deployments-warning"))
+					Expect(deployment).To(Equal(Deployment{GUID: "some-deployment-guid", State: ccv3.DeploymentStateDeploying}))
+
+					Expect(fakeCloudControllerClient.GetDeploymentsCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetDeploymentsArgsForCall(0)).To(Equal(url.Values{
+						ccv3.AppGUIDFilter: []string{"some-app-guid"},
+						ccv3.StatesFilter:  []string{"DEPLOYING"},
+					}))
+				})
+			})
+
+			Context("when the app has no deployment in progress", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetDeploymentsReturns(nil, ccv3.Warnings{"get-deployments-warning"}, nil)
+				})
+
+				It("returns an ActiveDeploymentNotFoundError and all warnings", func() {
+					Expect(executeErr).To(MatchError(ActiveDeploymentNotFoundError{AppName: "some-app"}))
+					Expect(warnings).To(ConsistOf("get-app-warning", "get-deployments-warning"))
+				})
+			})
+
+			Context("when getting the deployments fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get deployments error")
+					fakeCloudControllerClient.GetDeploymentsReturns(nil, ccv3.Warnings{"get-deployments-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-app-warning", "get-deployments-warning"))
+				})
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.GetDeploymentsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("CancelDeployment", func() {
+		Context("when canceling the deployment succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CancelDeploymentReturns(ccv3.Warnings{"cancel-warning"}, nil)
+			})
+
+			It("cancels the deployment and returns warnings", func() {
+				warnings, err := actor.CancelDeployment("some-deployment-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("cancel-warning"))
+
+				Expect(fakeCloudControllerClient.CancelDeploymentCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CancelDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		Context("when canceling the deployment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("cancel error")
+				fakeCloudControllerClient.CancelDeploymentReturns(ccv3.Warnings{"cancel-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := actor.CancelDeployment("some-deployment-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("cancel-warning"))
+			})
+		})
+	})
+
+	Describe("PollDeployment", func() {
+		var (
+			warningsChannel chan Warnings
+			allWarnings     Warnings
+			funcDone        chan interface{}
+		)
+
+		BeforeEach(func() {
+			warningsChannel = make(chan Warnings)
+			funcDone = make(chan interface{})
+			allWarnings = Warnings{}
+			go func() {
+				for {
+					select {
+					case warnings := <-warningsChannel:
+						allWarnings = append(allWarnings, warnings...)
+					case <-funcDone:
+						return
+					}
+				}
+			}()
+
+			fakeConfig.StartupTimeoutReturns(time.Second)
+			fakeConfig.PollingIntervalReturns(0)
+		})
+
+		Context("when the deployment finishes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(0, ccv3.Deployment{State: ccv3.DeploymentStateDeploying}, ccv3.Warnings{"get-warning-1"}, nil)
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(1, ccv3.Deployment{State: ccv3.DeploymentStateDeployed}, ccv3.Warnings{"get-warning-2"}, nil)
+			})
+
+			It("polls until the deployment is deployed and returns all warnings", func() {
+				err := actor.PollDeployment("some-deployment-guid", warningsChannel)
+				funcDone <- nil
+				Expect(err).ToNot(HaveOccurred())
+				Expect(allWarnings).To(ConsistOf("get-warning-1", "get-warning-2"))
+
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		Context("when the deployment is canceled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(ccv3.Deployment{State: ccv3.DeploymentStateCanceled}, ccv3.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns a DeploymentCanceledError", func() {
+				err := actor.PollDeployment("some-deployment-guid", warningsChannel)
+				funcDone <- nil
+				Expect(err).To(MatchError(DeploymentCanceledError{}))
+				Expect(allWarnings).To(ConsistOf("get-warning"))
+			})
+		})
+
+		Context("when the deployment does not finish before the startup timeout", func() {
+			BeforeEach(func() {
+				fakeConfig.StartupTimeoutReturns(time.Millisecond)
+				fakeCloudControllerClient.GetDeploymentReturns(ccv3.Deployment{State: ccv3.DeploymentStateDeploying}, nil, nil)
+			})
+
+			It("returns a StartupTimeoutError", func() {
+				err := actor.PollDeployment("some-deployment-guid", warningsChannel)
+				funcDone <- nil
+				Expect(err).To(MatchError(StartupTimeoutError{}))
+			})
+		})
+
+		Context("when getting the deployment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get deployment error")
+				fakeCloudControllerClient.GetDeploymentReturns(ccv3.Deployment{}, ccv3.Warnings{"get-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				err := actor.PollDeployment("some-deployment-guid", warningsChannel)
+				funcDone <- nil
+				Expect(err).To(MatchError(expectedErr))
+				Expect(allWarnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+})
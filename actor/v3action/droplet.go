@@ -1,6 +1,7 @@
 package v3action
 
 import (
+	"fmt"
 	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -37,6 +38,35 @@ func (a AssignDropletError) Error() string {
 	return a.Message
 }
 
+// DropletNotFoundError is returned when a droplet with the given GUID cannot
+// be found.
+type DropletNotFoundError struct {
+	GUID string
+}
+
+func (e DropletNotFoundError) Error() string {
+	return fmt.Sprintf("Droplet %s not found.", e.GUID)
+}
+
+// DeleteDroplet deletes the droplet with the given GUID and waits for the
+// deletion to complete.
+func (actor Actor) DeleteDroplet(dropletGUID string) (Warnings, error) {
+	var allWarnings Warnings
+
+	jobURL, deleteWarnings, err := actor.CloudControllerClient.DeleteDroplet(dropletGUID)
+	allWarnings = append(allWarnings, deleteWarnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.DropletNotFoundError); ok {
+			return allWarnings, DropletNotFoundError{GUID: dropletGUID}
+		}
+		return allWarnings, err
+	}
+
+	pollWarnings, err := actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, pollWarnings...)
+	return allWarnings, err
+}
+
 // SetApplicationDroplet sets the droplet for an application.
 func (actor Actor) SetApplicationDroplet(appName string, spaceGUID string, dropletGUID string) (Warnings, error) {
 	allWarnings := Warnings{}
@@ -21,7 +21,77 @@ var _ = Describe("Droplet Actions", func() {
 
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
-		actor = NewActor(fakeCloudControllerClient, nil)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("DeleteDroplet", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteDroplet("some-droplet-guid")
+		})
+
+		Context("when the droplet does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteDropletReturns("", ccv3.Warnings{"some-delete-warning"}, ccerror.DropletNotFoundError{})
+			})
+
+			It("returns a DropletNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(DropletNotFoundError{GUID: "some-droplet-guid"}))
+				Expect(warnings).To(ConsistOf("some-delete-warning"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when sending the delete fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteDropletReturns("", ccv3.Warnings{"some-delete-warning"}, errors.New("some-delete-error"))
+			})
+
+			It("returns the warnings and error", func() {
+				Expect(executeErr).To(MatchError("some-delete-error"))
+				Expect(warnings).To(ConsistOf("some-delete-warning"))
+			})
+		})
+
+		Context("when sending the delete succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteDropletReturns("/some-job-url", ccv3.Warnings{"some-delete-warning"}, nil)
+			})
+
+			It("deletes the droplet with the given GUID", func() {
+				Expect(fakeCloudControllerClient.DeleteDropletCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteDropletArgsForCall(0)).To(Equal("some-droplet-guid"))
+			})
+
+			Context("when polling fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"some-poll-warning"}, errors.New("some-poll-error"))
+				})
+
+				It("returns the warnings and poll error", func() {
+					Expect(executeErr).To(MatchError("some-poll-error"))
+					Expect(warnings).To(ConsistOf("some-delete-warning", "some-poll-warning"))
+				})
+			})
+
+			Context("when polling succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"some-poll-warning"}, nil)
+				})
+
+				It("polls the returned job and returns all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("some-delete-warning", "some-poll-warning"))
+
+					Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal("/some-job-url"))
+				})
+			})
+		})
 	})
 
 	Describe("SetApplicationDroplet", func() {
@@ -0,0 +1,86 @@
+package v3action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Environment represents the environment of an application, grouped by the
+// source of the variables.
+type Environment struct {
+	System               map[string]interface{}
+	Application          map[string]interface{}
+	EnvironmentVariables map[string]interface{}
+	Running              map[string]interface{}
+	Staging              map[string]interface{}
+}
+
+// EnvironmentVariableNotSetError is returned when unsetting an environment
+// variable that the application does not have.
+type EnvironmentVariableNotSetError struct {
+	Name string
+}
+
+func (e EnvironmentVariableNotSetError) Error() string {
+	return fmt.Sprintf("Environment variable %s not set", e.Name)
+}
+
+// GetApplicationEnvironment returns the environment of the application with
+// the given name in the given space.
+func (actor Actor) GetApplicationEnvironment(appName string, spaceGUID string) (Environment, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings := append(Warnings{}, warnings...)
+	if err != nil {
+		return Environment{}, allWarnings, err
+	}
+
+	ccEnvironment, apiWarnings, err := actor.CloudControllerClient.GetApplicationEnvironment(app.GUID)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		return Environment{}, allWarnings, err
+	}
+
+	return Environment(ccEnvironment), allWarnings, nil
+}
+
+// SetApplicationEnvironmentVariable sets the environment variable with the
+// given name on the application. The value can be any JSON type.
+func (actor Actor) SetApplicationEnvironmentVariable(appName string, spaceGUID string, name string, value interface{}) (Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings := append(Warnings{}, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, apiWarnings, err := actor.CloudControllerClient.UpdateApplicationEnvironmentVariables(app.GUID, ccv3.EnvironmentVariables{
+		name: value,
+	})
+	return append(allWarnings, apiWarnings...), err
+}
+
+// UnsetApplicationEnvironmentVariable removes the environment variable with
+// the given name from the application. It returns an
+// EnvironmentVariableNotSetError when the variable is not set.
+func (actor Actor) UnsetApplicationEnvironmentVariable(appName string, spaceGUID string, name string) (Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings := append(Warnings{}, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	ccEnvironment, apiWarnings, err := actor.CloudControllerClient.GetApplicationEnvironment(app.GUID)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	if _, ok := ccEnvironment.EnvironmentVariables[name]; !ok {
+		return allWarnings, EnvironmentVariableNotSetError{Name: name}
+	}
+
+	_, apiWarnings, err = actor.CloudControllerClient.UpdateApplicationEnvironmentVariables(app.GUID, ccv3.EnvironmentVariables{
+		name: nil,
+	})
+	return append(allWarnings, apiWarnings...), err
+}
@@ -0,0 +1,260 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Environment Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetApplicationEnvironment", func() {
+		var (
+			environment Environment
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			environment, warnings, executeErr = actor.GetApplicationEnvironment("some-app", "some-space-guid")
+		})
+
+		Context("when getting the application fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, errors.New("get-app-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-app-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the application succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}}, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("looks up the application by name and space", func() {
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{
+					"space_guids": []string{"some-space-guid"},
+					"names":       []string{"some-app"},
+				}))
+			})
+
+			Context("when getting the environment succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationEnvironmentReturns(
+						ccv3.Environment{
+							System:               map[string]interface{}{"VCAP_SERVICES": map[string]interface{}{}},
+							Application:          map[string]interface{}{"VCAP_APPLICATION": map[string]interface{}{}},
+							EnvironmentVariables: map[string]interface{}{"SOME_VAR": "some-value"},
+							Running:              map[string]interface{}{"running-var": "running-value"},
+							Staging:              map[string]interface{}{"staging-var": "staging-value"},
+						},
+						ccv3.Warnings{"get-env-warning"},
+						nil)
+				})
+
+				It("returns the environment and all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-app-warning", "get-env-warning"))
+					Expect(environment).To(Equal(Environment{
+						System:               map[string]interface{}{"VCAP_SERVICES": map[string]interface{}{}},
+						Application:          map[string]interface{}{"VCAP_APPLICATION": map[string]interface{}{}},
+						EnvironmentVariables: map[string]interface{}{"SOME_VAR": "some-value"},
+						Running:              map[string]interface{}{"running-var": "running-value"},
+						Staging:              map[string]interface{}{"staging-var": "staging-value"},
+					}))
+
+					Expect(fakeCloudControllerClient.GetApplicationEnvironmentCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetApplicationEnvironmentArgsForCall(0)).To(Equal("some-app-guid"))
+				})
+			})
+
+			Context("when getting the environment fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv3.Environment{}, ccv3.Warnings{"get-env-warning"}, errors.New("get-env-error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("get-env-error"))
+					Expect(warnings).To(ConsistOf("get-app-warning", "get-env-warning"))
+				})
+			})
+		})
+	})
+
+	Describe("SetApplicationEnvironmentVariable", func() {
+		var (
+			value      interface{}
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			value = map[string]interface{}{"key": []interface{}{"value"}}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.SetApplicationEnvironmentVariable("some-app", "some-space-guid", "SOME_VAR", value)
+		})
+
+		Context("when getting the application fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, errors.New("get-app-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-app-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the application succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}}, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			Context("when updating the environment variables succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesReturns(nil, ccv3.Warnings{"set-env-warning"}, nil)
+				})
+
+				It("sets only the given variable and returns all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-app-warning", "set-env-warning"))
+
+					Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(1))
+					appGUID, envVars := fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+					Expect(envVars).To(Equal(ccv3.EnvironmentVariables{
+						"SOME_VAR": map[string]interface{}{"key": []interface{}{"value"}},
+					}))
+				})
+			})
+
+			Context("when updating the environment variables fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesReturns(nil, ccv3.Warnings{"set-env-warning"}, errors.New("set-env-error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("set-env-error"))
+					Expect(warnings).To(ConsistOf("get-app-warning", "set-env-warning"))
+				})
+			})
+		})
+	})
+
+	Describe("UnsetApplicationEnvironmentVariable", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UnsetApplicationEnvironmentVariable("some-app", "some-space-guid", "SOME_VAR")
+		})
+
+		Context("when getting the application fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, errors.New("get-app-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-app-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the application succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}}, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			Context("when getting the environment fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv3.Environment{}, ccv3.Warnings{"get-env-warning"}, errors.New("get-env-error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("get-env-error"))
+					Expect(warnings).To(ConsistOf("get-app-warning", "get-env-warning"))
+					Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the variable is not set", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationEnvironmentReturns(
+						ccv3.Environment{EnvironmentVariables: map[string]interface{}{"OTHER_VAR": "other-value"}},
+						ccv3.Warnings{"get-env-warning"},
+						nil)
+				})
+
+				It("returns an EnvironmentVariableNotSetError and all warnings", func() {
+					Expect(executeErr).To(MatchError(EnvironmentVariableNotSetError{Name: "SOME_VAR"}))
+					Expect(warnings).To(ConsistOf("get-app-warning", "get-env-warning"))
+					Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the variable is set", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationEnvironmentReturns(
+						ccv3.Environment{EnvironmentVariables: map[string]interface{}{"SOME_VAR": "some-value"}},
+						ccv3.Warnings{"get-env-warning"},
+						nil)
+				})
+
+				Context("when updating the environment variables succeeds", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesReturns(nil, ccv3.Warnings{"unset-env-warning"}, nil)
+					})
+
+					It("unsets only the given variable and returns all warnings", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(warnings).To(ConsistOf("get-app-warning", "get-env-warning", "unset-env-warning"))
+
+						Expect(fakeCloudControllerClient.GetApplicationEnvironmentArgsForCall(0)).To(Equal("some-app-guid"))
+						Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(1))
+						appGUID, envVars := fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(envVars).To(Equal(ccv3.EnvironmentVariables{"SOME_VAR": nil}))
+					})
+				})
+
+				Context("when updating the environment variables fails", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesReturns(nil, ccv3.Warnings{"unset-env-warning"}, errors.New("unset-env-error"))
+					})
+
+					It("returns the error and all warnings", func() {
+						Expect(executeErr).To(MatchError("unset-env-error"))
+						Expect(warnings).To(ConsistOf("get-app-warning", "get-env-warning", "unset-env-warning"))
+					})
+				})
+			})
+		})
+	})
+})
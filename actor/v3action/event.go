@@ -0,0 +1,102 @@
+package v3action
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Event represents an audit event recorded for an application.
+type Event struct {
+	GUID      string
+	Type      string
+	Time      time.Time
+	ActorName string
+	ActorType string
+	// Data contains details of the action that depend on the type of the
+	// event.
+	Data map[string]interface{}
+}
+
+// EventFilter restricts the events returned by GetEventsByApplication. The
+// zero value of each field matches every event.
+type EventFilter struct {
+	// Types are the event types to include, such as audit.app.update.
+	Types []string
+	// Actor matches the name or GUID of the actor that caused the event.
+	Actor string
+	// Since and Until bound the time the events happened at, inclusively.
+	Since time.Time
+	Until time.Time
+}
+
+// errEventsBeforeSince stops the pagination of events once the events are
+// older than the filter allows.
+var errEventsBeforeSince = errors.New("events are older than the filter")
+
+// GetEventsByApplication calls handlePage with each page of the application's
+// events that match the filter, newest first, as it is retrieved. Pages are
+// only retrieved until an event older than filter.Since is reached. Returning
+// an error from handlePage stops the pagination and returns that error.
+func (actor Actor) GetEventsByApplication(appGUID string, filter EventFilter, handlePage func([]Event) error) (Warnings, error) {
+	query := url.Values{
+		ccv3.TargetGUIDFilter: []string{appGUID},
+		ccv3.OrderBy:          []string{ccv3.CreatedAtDescendingOrder},
+	}
+	if len(filter.Types) > 0 {
+		query.Set(ccv3.TypesFilter, strings.Join(filter.Types, ","))
+	}
+
+	warnings, err := actor.CloudControllerClient.GetAuditEventsPaged(query, func(ccv3Events []ccv3.AuditEvent) error {
+		var (
+			events       []Event
+			reachedSince bool
+		)
+		for _, ccv3Event := range ccv3Events {
+			eventTime, err := time.Parse(time.RFC3339, ccv3Event.CreatedAt)
+			if err != nil {
+				return err
+			}
+
+			if !filter.Since.IsZero() && eventTime.Before(filter.Since) {
+				reachedSince = true
+				break
+			}
+			if !filter.Until.IsZero() && eventTime.After(filter.Until) {
+				continue
+			}
+			if filter.Actor != "" && filter.Actor != ccv3Event.ActorName && filter.Actor != ccv3Event.ActorGUID {
+				continue
+			}
+
+			events = append(events, Event{
+				GUID:      ccv3Event.GUID,
+				Type:      ccv3Event.Type,
+				Time:      eventTime,
+				ActorName: ccv3Event.ActorName,
+				ActorType: ccv3Event.ActorType,
+				Data:      ccv3Event.Data,
+			})
+		}
+
+		if len(events) > 0 {
+			err := handlePage(events)
+			if err != nil {
+				return err
+			}
+		}
+
+		if reachedSince {
+			return errEventsBeforeSince
+		}
+		return nil
+	})
+	if err == errEventsBeforeSince {
+		err = nil
+	}
+
+	return Warnings(warnings), err
+}
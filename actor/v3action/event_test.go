@@ -0,0 +1,166 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Event Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetEventsByApplication", func() {
+		var (
+			filter     EventFilter
+			handleErr  error
+			pages      [][]Event
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			filter = EventFilter{}
+			handleErr = nil
+			pages = nil
+
+			fakeCloudControllerClient.GetAuditEventsPagedStub = func(_ url.Values, handlePage func([]ccv3.AuditEvent) error) (ccv3.Warnings, error) {
+				err := handlePage([]ccv3.AuditEvent{
+					{GUID: "event-1", Type: "audit.app.update", CreatedAt: "2017-08-14T21:16:42Z", ActorGUID: "user-guid-1", ActorName: "user-1", ActorType: "user", Data: map[string]interface{}{"some-key": "some-value"}},
+					{GUID: "event-2", Type: "audit.app.start", CreatedAt: "2017-08-14T21:10:00Z", ActorGUID: "user-guid-2", ActorName: "user-2", ActorType: "user"},
+				})
+				if err != nil {
+					return ccv3.Warnings{"page-1-warning"}, err
+				}
+
+				err = handlePage([]ccv3.AuditEvent{
+					{GUID: "event-3", Type: "audit.app.create", CreatedAt: "2017-08-14T20:00:00Z", ActorGUID: "user-guid-1", ActorName: "user-1", ActorType: "user"},
+				})
+				return ccv3.Warnings{"page-1-warning", "page-2-warning"}, err
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.GetEventsByApplication("some-app-guid", filter, func(page []Event) error {
+				pages = append(pages, page)
+				return handleErr
+			})
+		})
+
+		It("requests the app's events newest first", func() {
+			Expect(fakeCloudControllerClient.GetAuditEventsPagedCallCount()).To(Equal(1))
+			query, _ := fakeCloudControllerClient.GetAuditEventsPagedArgsForCall(0)
+			Expect(query).To(Equal(url.Values{
+				ccv3.TargetGUIDFilter: []string{"some-app-guid"},
+				ccv3.OrderBy:          []string{ccv3.CreatedAtDescendingOrder},
+			}))
+		})
+
+		It("calls handlePage with each page of events and returns the warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("page-1-warning", "page-2-warning"))
+			Expect(pages).To(Equal([][]Event{
+				{
+					{GUID: "event-1", Type: "audit.app.update", Time: time.Date(2017, 8, 14, 21, 16, 42, 0, time.UTC), ActorName: "user-1", ActorType: "user", Data: map[string]interface{}{"some-key": "some-value"}},
+					{GUID: "event-2", Type: "audit.app.start", Time: time.Date(2017, 8, 14, 21, 10, 0, 0, time.UTC), ActorName: "user-2", ActorType: "user"},
+				},
+				{
+					{GUID: "event-3", Type: "audit.app.create", Time: time.Date(2017, 8, 14, 20, 0, 0, 0, time.UTC), ActorName: "user-1", ActorType: "user"},
+				},
+			}))
+		})
+
+		Context("when filtering by type", func() {
+			BeforeEach(func() {
+				filter.Types = []string{"audit.app.update", "audit.app.create"}
+			})
+
+			It("passes the types to the cloud controller", func() {
+				query, _ := fakeCloudControllerClient.GetAuditEventsPagedArgsForCall(0)
+				Expect(query.Get(ccv3.TypesFilter)).To(Equal("audit.app.update,audit.app.create"))
+			})
+		})
+
+		Context("when filtering by actor", func() {
+			BeforeEach(func() {
+				filter.Actor = "user-guid-2"
+			})
+
+			It("only returns the events caused by the actor", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(pages).To(HaveLen(1))
+				Expect(pages[0]).To(HaveLen(1))
+				Expect(pages[0][0].GUID).To(Equal("event-2"))
+			})
+		})
+
+		Context("when filtering by start time", func() {
+			BeforeEach(func() {
+				filter.Since = time.Date(2017, 8, 14, 21, 15, 0, 0, time.UTC)
+			})
+
+			It("stops paginating once older events are reached", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("page-1-warning"))
+				Expect(pages).To(HaveLen(1))
+				Expect(pages[0]).To(HaveLen(1))
+				Expect(pages[0][0].GUID).To(Equal("event-1"))
+			})
+		})
+
+		Context("when filtering by end time", func() {
+			BeforeEach(func() {
+				filter.Until = time.Date(2017, 8, 14, 21, 15, 0, 0, time.UTC)
+			})
+
+			It("only returns the events up to the end time", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(pages).To(HaveLen(2))
+				Expect(pages[0]).To(HaveLen(1))
+				Expect(pages[0][0].GUID).To(Equal("event-2"))
+				Expect(pages[1][0].GUID).To(Equal("event-3"))
+			})
+		})
+
+		Context("when handlePage returns an error", func() {
+			BeforeEach(func() {
+				handleErr = errors.New("stop")
+			})
+
+			It("returns the error and the warnings", func() {
+				Expect(executeErr).To(MatchError(handleErr))
+				Expect(warnings).To(ConsistOf("page-1-warning"))
+				Expect(pages).To(HaveLen(1))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetAuditEventsPagedStub = nil
+				fakeCloudControllerClient.GetAuditEventsPagedReturns(ccv3.Warnings{"some-warning"}, expectedErr)
+			})
+
+			It("returns the error and the warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+})
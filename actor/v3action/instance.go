@@ -4,6 +4,9 @@ import (
 	"fmt"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/jobs"
+	"code.cloudfoundry.org/cli/util/interrupt"
 )
 
 // ProcessInstanceNotFoundError is returned when the proccess type or process instance cannot be found
@@ -45,3 +48,79 @@ func (actor Actor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(appNa
 
 	return allWarnings, nil
 }
+
+// GetProcessInstances returns the instance stats of the provided process.
+func (actor Actor) GetProcessInstances(processGUID string) ([]Instance, Warnings, error) {
+	ccv3Instances, warnings, err := actor.CloudControllerClient.GetProcessInstances(processGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var instances []Instance
+	for _, ccv3Instance := range ccv3Instances {
+		instances = append(instances, Instance(ccv3Instance))
+	}
+
+	return instances, Warnings(warnings), nil
+}
+
+// GetProcessInstancesByApplicationAndType returns the instances of the
+// provided application's process type.
+func (actor Actor) GetProcessInstancesByApplicationAndType(appGUID string, processType string) ([]Instance, Warnings, error) {
+	process, allWarnings, err := actor.GetProcessByApplicationAndProcessType(appGUID, processType)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	instances, warnings, err := actor.GetProcessInstances(process.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	return instances, allWarnings, err
+}
+
+// PollProcessInstance waits for the replacement of the provided instance of
+// the application's process type to be RUNNING. Right after an instance is
+// deleted, the Cloud Controller can still report the old instance as RUNNING,
+// so a RUNNING instance is only accepted once the old instance is known to be
+// gone: the index has been reported in another state or not at all, or its
+// uptime is lower than the old instance's. It returns a StartupTimeoutError if
+// the replacement does not become RUNNING within the configured startup
+// timeout.
+func (actor Actor) PollProcessInstance(appGUID string, processType string, replacedInstance Instance) (Warnings, error) {
+	process, allWarnings, err := actor.GetProcessByApplicationAndProcessType(appGUID, processType)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	replaced := false
+	poller := jobs.NewPoller(jobs.JobGetterFunc(func(guid string) (jobs.State, []string, error) {
+		instances, warnings, err := actor.CloudControllerClient.GetProcessInstances(guid)
+		if err != nil {
+			return jobs.State{}, warnings, err
+		}
+
+		instance, found := findInstance(instances, replacedInstance.Index)
+		switch {
+		case !found, instance.State != "RUNNING":
+			replaced = true
+		case replaced, instance.Uptime < replacedInstance.Uptime:
+			return jobs.State{GUID: guid, Complete: true}, warnings, nil
+		}
+		return jobs.State{GUID: guid}, warnings, nil
+	}), interrupt.DefaultHandler)
+
+	warnings, err := poller.PollJob(process.GUID, actor.Config.PollingInterval(), actor.Config.StartupTimeout())
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(ccerror.JobTimeoutError); ok {
+		return allWarnings, StartupTimeoutError{}
+	}
+	return allWarnings, err
+}
+
+func findInstance(instances []ccv3.Instance, index int) (ccv3.Instance, bool) {
+	for _, instance := range instances {
+		if instance.Index == index {
+			return instance, true
+		}
+	}
+	return ccv3.Instance{}, false
+}
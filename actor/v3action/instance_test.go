@@ -3,6 +3,7 @@ package v3action_test
 import (
 	"errors"
 	"net/url"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -16,11 +17,13 @@ var _ = Describe("instance actions", func() {
 	var (
 		actor                     *Actor
 		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
 	)
 
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
-		actor = NewActor(fakeCloudControllerClient, nil)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
 	})
 
 	Describe("DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex", func() {
@@ -108,4 +111,252 @@ var _ = Describe("instance actions", func() {
 			})
 		})
 	})
+
+	Describe("GetProcessInstances", func() {
+		var (
+			instances  []Instance
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			instances, warnings, executeErr = actor.GetProcessInstances("some-process-guid")
+		})
+
+		Context("when getting the instances succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns(
+					[]ccv3.Instance{
+						{Index: 0, State: "RUNNING", Uptime: 10, Routable: true},
+						{Index: 1, State: "STARTING", Uptime: 0},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the instances and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(instances).To(ConsistOf(
+					Instance{Index: 0, State: "RUNNING", Uptime: 10, Routable: true},
+					Instance{Index: 1, State: "STARTING", Uptime: 0},
+				))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("some-process-guid"))
+			})
+		})
+
+		Context("when getting the instances fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get instances error")
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"some-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("GetProcessInstancesByApplicationAndType", func() {
+		var (
+			instances  []Instance
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			instances, warnings, executeErr = actor.GetProcessInstancesByApplicationAndType("some-app-guid", "some-process-type")
+		})
+
+		Context("when getting the process returns ProcessNotFoundError", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(ccv3.Process{}, ccv3.Warnings{"get-process-warning"}, ccerror.ProcessNotFoundError{})
+			})
+
+			It("returns all warnings and a ProcessNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ProcessNotFoundError{ProcessType: "some-process-type"}))
+				Expect(warnings).To(ConsistOf("get-process-warning"))
+			})
+		})
+
+		Context("when getting the process succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(ccv3.Process{GUID: "some-process-guid"}, ccv3.Warnings{"get-process-warning"}, nil)
+			})
+
+			Context("when getting the process instances returns an error", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"get-instances-warning"}, errors.New("some-instances-error"))
+				})
+
+				It("returns all warnings and the error", func() {
+					Expect(executeErr).To(MatchError("some-instances-error"))
+					Expect(warnings).To(ConsistOf("get-process-warning", "get-instances-warning"))
+				})
+			})
+
+			Context("when getting the process instances succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetProcessInstancesReturns(
+						[]ccv3.Instance{{Index: 0, State: "RUNNING"}, {Index: 1, State: "STARTING"}},
+						ccv3.Warnings{"get-instances-warning"},
+						nil)
+				})
+
+				It("returns the instances and all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-process-warning", "get-instances-warning"))
+					Expect(instances).To(Equal([]Instance{{Index: 0, State: "RUNNING"}, {Index: 1, State: "STARTING"}}))
+
+					Expect(fakeCloudControllerClient.GetApplicationProcessByTypeCallCount()).To(Equal(1))
+					appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+					Expect(processType).To(Equal("some-process-type"))
+
+					Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("some-process-guid"))
+				})
+			})
+		})
+	})
+
+	Describe("PollProcessInstance", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeConfig.StartupTimeoutReturns(time.Second)
+			fakeConfig.PollingIntervalReturns(0)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.PollProcessInstance("some-app-guid", "some-process-type", Instance{Index: 1, State: "RUNNING", Uptime: 300})
+		})
+
+		Context("when getting the process returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(ccv3.Process{}, ccv3.Warnings{"get-process-warning"}, errors.New("some-process-error"))
+			})
+
+			It("returns all warnings and the error", func() {
+				Expect(executeErr).To(MatchError("some-process-error"))
+				Expect(warnings).To(ConsistOf("get-process-warning"))
+			})
+		})
+
+		Context("when getting the process succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(ccv3.Process{GUID: "some-process-guid"}, ccv3.Warnings{"get-process-warning"}, nil)
+			})
+
+			Context("when getting the process instances returns an error", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"get-instances-warning"}, errors.New("some-instances-error"))
+				})
+
+				It("returns all warnings and the error", func() {
+					Expect(executeErr).To(MatchError("some-instances-error"))
+					Expect(warnings).To(ConsistOf("get-process-warning", "get-instances-warning"))
+				})
+			})
+
+			Context("when the instance becomes RUNNING", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0,
+						[]ccv3.Instance{{Index: 0, State: "RUNNING"}, {Index: 1, State: "STARTING"}},
+						ccv3.Warnings{"get-instances-warning-1"},
+						nil)
+					fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1,
+						[]ccv3.Instance{{Index: 0, State: "RUNNING"}, {Index: 1, State: "RUNNING"}},
+						ccv3.Warnings{"get-instances-warning-2"},
+						nil)
+				})
+
+				It("polls until the instance is RUNNING and returns all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-process-warning", "get-instances-warning-1", "get-instances-warning-2"))
+
+					Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("some-process-guid"))
+				})
+			})
+
+			Context("when the old instance is still reported as RUNNING", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0,
+						[]ccv3.Instance{{Index: 1, State: "RUNNING", Uptime: 301}},
+						ccv3.Warnings{"get-instances-warning-1"},
+						nil)
+					fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1,
+						[]ccv3.Instance{{Index: 1, State: "STARTING"}},
+						ccv3.Warnings{"get-instances-warning-2"},
+						nil)
+					fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(2,
+						[]ccv3.Instance{{Index: 1, State: "RUNNING", Uptime: 2}},
+						ccv3.Warnings{"get-instances-warning-3"},
+						nil)
+				})
+
+				It("waits for the replacement instance to be RUNNING", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-process-warning", "get-instances-warning-1", "get-instances-warning-2", "get-instances-warning-3"))
+
+					Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(3))
+				})
+			})
+
+			Context("when the replacement instance is RUNNING when first polled", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetProcessInstancesReturns(
+						[]ccv3.Instance{{Index: 1, State: "RUNNING", Uptime: 5}},
+						ccv3.Warnings{"get-instances-warning"},
+						nil)
+				})
+
+				It("detects the replacement by its lower uptime", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the old instance stays RUNNING", func() {
+				BeforeEach(func() {
+					fakeConfig.StartupTimeoutReturns(5 * time.Millisecond)
+					fakeConfig.PollingIntervalReturns(time.Millisecond)
+					fakeCloudControllerClient.GetProcessInstancesReturns(
+						[]ccv3.Instance{{Index: 1, State: "RUNNING", Uptime: 301}},
+						ccv3.Warnings{"get-instances-warning"},
+						nil)
+				})
+
+				It("returns a StartupTimeoutError", func() {
+					Expect(executeErr).To(MatchError(StartupTimeoutError{}))
+				})
+			})
+
+			Context("when the instance does not become RUNNING before the timeout", func() {
+				BeforeEach(func() {
+					fakeConfig.StartupTimeoutReturns(time.Millisecond)
+					fakeConfig.PollingIntervalReturns(2 * time.Millisecond)
+					fakeCloudControllerClient.GetProcessInstancesReturns(
+						[]ccv3.Instance{{Index: 1, State: "STARTING"}},
+						ccv3.Warnings{"get-instances-warning"},
+						nil)
+				})
+
+				It("returns a StartupTimeoutError", func() {
+					Expect(executeErr).To(MatchError(StartupTimeoutError{}))
+					Expect(warnings).To(ContainElement("get-process-warning"))
+				})
+			})
+		})
+	})
 })
@@ -0,0 +1,100 @@
+package v3action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Metadata represents the user defined data attached to a V3 resource.
+type Metadata ccv3.Metadata
+
+// GetApplicationLabels returns the labels of the application with the given
+// name in the given space.
+func (actor Actor) GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return labelsFromMetadata(app.Metadata), warnings, nil
+}
+
+// GetOrganizationLabels returns the labels of the organization with the
+// given name.
+func (actor Actor) GetOrganizationLabels(orgName string) (map[string]types.NullString, Warnings, error) {
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return labelsFromMetadata((*Metadata)(org.Metadata)), warnings, nil
+}
+
+// GetSpaceLabels returns the labels of the space with the given name in the
+// given organization.
+func (actor Actor) GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, Warnings, error) {
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return labelsFromMetadata((*Metadata)(space.Metadata)), warnings, nil
+}
+
+// UpdateApplicationLabelsByApplicationName merges the given labels into the
+// labels of the application with the given name in the given space. Labels
+// with an unset value are removed.
+func (actor Actor) UpdateApplicationLabelsByApplicationName(appName string, spaceGUID string, labels map[string]types.NullString) (Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	_, ccWarnings, err := actor.CloudControllerClient.UpdateApplication(ccv3.Application{
+		GUID:     app.GUID,
+		Metadata: &ccv3.Metadata{Labels: labels},
+	})
+
+	return append(warnings, ccWarnings...), err
+}
+
+// UpdateOrganizationLabelsByOrganizationName merges the given labels into the
+// labels of the organization with the given name. Labels with an unset value
+// are removed.
+func (actor Actor) UpdateOrganizationLabelsByOrganizationName(orgName string, labels map[string]types.NullString) (Warnings, error) {
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return warnings, err
+	}
+
+	_, ccWarnings, err := actor.CloudControllerClient.UpdateOrganization(ccv3.Organization{
+		GUID:     org.GUID,
+		Metadata: &ccv3.Metadata{Labels: labels},
+	})
+
+	return append(warnings, ccWarnings...), err
+}
+
+// UpdateSpaceLabelsBySpaceName merges the given labels into the labels of the
+// space with the given name in the given organization. Labels with an unset
+// value are removed.
+func (actor Actor) UpdateSpaceLabelsBySpaceName(spaceName string, orgGUID string, labels map[string]types.NullString) (Warnings, error) {
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	_, ccWarnings, err := actor.CloudControllerClient.UpdateSpace(ccv3.Space{
+		GUID:     space.GUID,
+		Metadata: &ccv3.Metadata{Labels: labels},
+	})
+
+	return append(warnings, ccWarnings...), err
+}
+
+func labelsFromMetadata(metadata *Metadata) map[string]types.NullString {
+	if metadata == nil {
+		return nil
+	}
+	return metadata.Labels
+}
@@ -0,0 +1,253 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Labels", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		labels                    map[string]types.NullString
+		warnings                  Warnings
+		executeErr                error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("UpdateApplicationLabelsByApplicationName", func() {
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateApplicationLabelsByApplicationName("some-app", "some-space-guid", labels)
+		})
+
+		BeforeEach(func() {
+			labels = map[string]types.NullString{
+				"some-key":    types.NewNullString("some-value"),
+				"removed-key": {},
+			}
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "some-app-guid"}}, ccv3.Warnings{"get-warning"}, nil)
+			})
+
+			Context("when updating the application succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateApplicationReturns(ccv3.Application{}, ccv3.Warnings{"update-warning"}, nil)
+				})
+
+				It("updates only the labels of the application", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+
+					Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv3.Application{
+						GUID:     "some-app-guid",
+						Metadata: &ccv3.Metadata{Labels: labels},
+					}))
+				})
+			})
+
+			Context("when updating the application fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateApplicationReturns(ccv3.Application{}, ccv3.Warnings{"update-warning"}, errors.New("some-error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("some-error"))
+					Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+				})
+			})
+		})
+	})
+
+	Describe("UpdateOrganizationLabelsByOrganizationName", func() {
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateOrganizationLabelsByOrganizationName("some-org", labels)
+		})
+
+		BeforeEach(func() {
+			labels = map[string]types.NullString{"some-key": types.NewNullString("some-value")}
+		})
+
+		Context("when the organization does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.UpdateOrganizationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the organization exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns([]ccv3.Organization{{GUID: "some-org-guid"}}, ccv3.Warnings{"get-warning"}, nil)
+				fakeCloudControllerClient.UpdateOrganizationReturns(ccv3.Organization{}, ccv3.Warnings{"update-warning"}, nil)
+			})
+
+			It("updates only the labels of the organization", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateOrganizationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateOrganizationArgsForCall(0)).To(Equal(ccv3.Organization{
+					GUID:     "some-org-guid",
+					Metadata: &ccv3.Metadata{Labels: labels},
+				}))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceLabelsBySpaceName", func() {
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateSpaceLabelsBySpaceName("some-space", "some-org-guid", labels)
+		})
+
+		BeforeEach(func() {
+			labels = map[string]types.NullString{"some-key": types.NewNullString("some-value")}
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns a SpaceNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(SpaceNotFoundError{Name: "some-space"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.UpdateSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the space exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns([]ccv3.Space{{GUID: "some-space-guid"}}, ccv3.Warnings{"get-warning"}, nil)
+				fakeCloudControllerClient.UpdateSpaceReturns(ccv3.Space{}, ccv3.Warnings{"update-warning"}, nil)
+			})
+
+			It("updates only the labels of the space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateSpaceCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateSpaceArgsForCall(0)).To(Equal(ccv3.Space{
+					GUID:     "some-space-guid",
+					Metadata: &ccv3.Metadata{Labels: labels},
+				}))
+			})
+		})
+	})
+
+	Describe("GetApplicationLabels", func() {
+		var returnedLabels map[string]types.NullString
+
+		JustBeforeEach(func() {
+			returnedLabels, warnings, executeErr = actor.GetApplicationLabels("some-app", "some-space-guid")
+		})
+
+		Context("when the application has labels", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{
+					GUID:     "some-app-guid",
+					Metadata: &ccv3.Metadata{Labels: map[string]types.NullString{"some-key": types.NewNullString("some-value")}},
+				}}, ccv3.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns the labels and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(returnedLabels).To(Equal(map[string]types.NullString{"some-key": types.NewNullString("some-value")}))
+			})
+		})
+
+		Context("when the application has no metadata", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "some-app-guid"}}, ccv3.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns no labels", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(returnedLabels).To(BeEmpty())
+			})
+		})
+
+		Context("when getting the application fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+
+	Describe("GetOrganizationLabels", func() {
+		var returnedLabels map[string]types.NullString
+
+		JustBeforeEach(func() {
+			returnedLabels, warnings, executeErr = actor.GetOrganizationLabels("some-org")
+		})
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns([]ccv3.Organization{{
+				GUID:     "some-org-guid",
+				Metadata: &ccv3.Metadata{Labels: map[string]types.NullString{"some-key": types.NewNullString("some-value")}},
+			}}, ccv3.Warnings{"get-warning"}, nil)
+		})
+
+		It("returns the labels and warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-warning"))
+			Expect(returnedLabels).To(Equal(map[string]types.NullString{"some-key": types.NewNullString("some-value")}))
+		})
+	})
+
+	Describe("GetSpaceLabels", func() {
+		var returnedLabels map[string]types.NullString
+
+		JustBeforeEach(func() {
+			returnedLabels, warnings, executeErr = actor.GetSpaceLabels("some-space", "some-org-guid")
+		})
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns([]ccv3.Space{{
+				GUID:     "some-space-guid",
+				Metadata: &ccv3.Metadata{Labels: map[string]types.NullString{"some-key": types.NewNullString("some-value")}},
+			}}, ccv3.Warnings{"get-warning"}, nil)
+		})
+
+		It("returns the labels and warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-warning"))
+			Expect(returnedLabels).To(Equal(map[string]types.NullString{"some-key": types.NewNullString("some-value")}))
+		})
+	})
+})
@@ -0,0 +1,174 @@
+package v3action
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/logcache"
+	log "github.com/sirupsen/logrus"
+)
+
+// LogCacheReadLimit is the maximum number of envelopes Log Cache returns in a
+// single read.
+const LogCacheReadLimit = 1000
+
+// LogCacheWalkInterval is how long StreamLogs waits between reads when
+// following an app's envelopes.
+var LogCacheWalkInterval = 250 * time.Millisecond
+
+// Envelope is a single envelope read from Log Cache. It satisfies the same
+// interface as LogMessage so both can be displayed the same way.
+type Envelope struct {
+	envelope logcache.Envelope
+}
+
+func NewEnvelope(envelope logcache.Envelope) Envelope {
+	return Envelope{envelope: envelope}
+}
+
+// EnvelopeType returns the kind of data carried by the envelope.
+func (envelope Envelope) EnvelopeType() logcache.EnvelopeType {
+	return envelope.envelope.Type()
+}
+
+// Message returns the log line of LOG envelopes and a summary of the values
+// of all other envelopes.
+func (envelope Envelope) Message() string {
+	e := envelope.envelope
+	switch e.Type() {
+	case logcache.LogEnvelopeType:
+		return string(e.Log.Payload)
+	case logcache.CounterEnvelopeType:
+		return fmt.Sprintf("%s: %d (delta: %d)", e.Counter.Name, e.Counter.Total, e.Counter.Delta)
+	case logcache.GaugeEnvelopeType:
+		var names []string
+		for name := range e.Gauge.Metrics {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var metrics []string
+		for _, name := range names {
+			metric := e.Gauge.Metrics[name]
+			metrics = append(metrics, strings.TrimSpace(fmt.Sprintf("%s: %g %s", name, metric.Value, metric.Unit)))
+		}
+		return strings.Join(metrics, ", ")
+	case logcache.TimerEnvelopeType:
+		return fmt.Sprintf("%s: %s", e.Timer.Name, e.Timer.Stop.Sub(e.Timer.Start))
+	case logcache.EventEnvelopeType:
+		return fmt.Sprintf("%s: %s", e.Event.Title, e.Event.Body)
+	default:
+		return ""
+	}
+}
+
+// Type returns OUT or ERR for LOG envelopes and the envelope type for all
+// other envelopes.
+func (envelope Envelope) Type() string {
+	if envelope.envelope.Log != nil {
+		return string(envelope.envelope.Log.Type)
+	}
+	return string(envelope.envelope.Type())
+}
+
+func (envelope Envelope) Staging() bool {
+	return envelope.SourceType() == StagingLog
+}
+
+func (envelope Envelope) Timestamp() time.Time {
+	return envelope.envelope.Timestamp
+}
+
+// SourceType returns the component that emitted the envelope. Metrics emitted
+// on behalf of the app are not tagged with a source type and default to APP.
+func (envelope Envelope) SourceType() string {
+	if sourceType := envelope.envelope.Tags["source_type"]; sourceType != "" {
+		return sourceType
+	}
+	return "APP"
+}
+
+func (envelope Envelope) SourceInstance() string {
+	return envelope.envelope.InstanceID
+}
+
+// GetRecentLogs returns the most recent envelopes of the provided types for
+// the app, oldest first. All envelope types are returned when none are
+// provided.
+func (Actor) GetRecentLogs(appGUID string, client LogCacheClient, envelopeTypes []logcache.EnvelopeType) ([]Envelope, error) {
+	rawEnvelopes, err := client.GetEnvelopes(appGUID, logcache.ReadOptions{
+		EnvelopeTypes: envelopeTypes,
+		Limit:         LogCacheReadLimit,
+		Descending:    true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	envelopes := make([]Envelope, len(rawEnvelopes))
+	for i, rawEnvelope := range rawEnvelopes {
+		envelopes[len(rawEnvelopes)-1-i] = NewEnvelope(rawEnvelope)
+	}
+
+	return envelopes, nil
+}
+
+// StreamLogs follows the app's envelopes of the provided types, starting from
+// the current time, until the returned stop function is called or reading
+// from Log Cache fails. Both channels are closed when streaming ends.
+func (Actor) StreamLogs(appGUID string, client LogCacheClient, envelopeTypes []logcache.EnvelopeType) (<-chan Envelope, <-chan error, func()) {
+	envelopes := make(chan Envelope)
+	errs := make(chan error)
+	stop := make(chan struct{})
+
+	var stopOnce sync.Once
+	stopFunc := func() {
+		stopOnce.Do(func() { close(stop) })
+	}
+
+	go func() {
+		defer close(envelopes)
+		defer close(errs)
+
+		startTime := time.Now()
+		for {
+			log.WithField("start_time", startTime).Debug("reading from log cache")
+			rawEnvelopes, err := client.GetEnvelopes(appGUID, logcache.ReadOptions{
+				StartTime:     startTime,
+				EnvelopeTypes: envelopeTypes,
+				Limit:         LogCacheReadLimit,
+			})
+			if err != nil {
+				select {
+				case errs <- err:
+				case <-stop:
+				}
+				return
+			}
+
+			for _, rawEnvelope := range rawEnvelopes {
+				select {
+				case envelopes <- NewEnvelope(rawEnvelope):
+				case <-stop:
+					return
+				}
+				startTime = rawEnvelope.Timestamp.Add(time.Nanosecond)
+			}
+
+			if len(rawEnvelopes) == LogCacheReadLimit {
+				continue
+			}
+
+			select {
+			case <-time.After(LogCacheWalkInterval):
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return envelopes, errs, stopFunc
+}
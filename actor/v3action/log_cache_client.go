@@ -0,0 +1,10 @@
+package v3action
+
+import "code.cloudfoundry.org/cli/api/logcache"
+
+//go:generate counterfeiter . LogCacheClient
+
+// LogCacheClient is a client for reading envelopes from Log Cache.
+type LogCacheClient interface {
+	GetEnvelopes(sourceID string, options logcache.ReadOptions) ([]logcache.Envelope, error)
+}
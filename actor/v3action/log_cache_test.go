@@ -0,0 +1,202 @@
+package v3action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/logcache"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Log Cache Actions", func() {
+	var (
+		actor              *Actor
+		fakeLogCacheClient *v3actionfakes.FakeLogCacheClient
+	)
+
+	BeforeEach(func() {
+		fakeLogCacheClient = new(v3actionfakes.FakeLogCacheClient)
+		actor = NewActor(nil, nil, nil)
+	})
+
+	Describe("Envelope", func() {
+		var timestamp time.Time
+
+		BeforeEach(func() {
+			timestamp = time.Unix(0, 1500000000000000000)
+		})
+
+		Context("when the envelope is a log", func() {
+			It("displays as a log message", func() {
+				envelope := NewEnvelope(logcache.Envelope{
+					Timestamp:  timestamp,
+					InstanceID: "1",
+					Tags:       map[string]string{"source_type": "STG"},
+					Log:        &logcache.Log{Payload: []byte("some-log"), Type: logcache.ErrLogType},
+				})
+
+				Expect(envelope.EnvelopeType()).To(Equal(logcache.LogEnvelopeType))
+				Expect(envelope.Message()).To(Equal("some-log"))
+				Expect(envelope.Type()).To(Equal("ERR"))
+				Expect(envelope.Timestamp()).To(Equal(timestamp))
+				Expect(envelope.SourceType()).To(Equal("STG"))
+				Expect(envelope.SourceInstance()).To(Equal("1"))
+				Expect(envelope.Staging()).To(BeTrue())
+			})
+		})
+
+		Context("when the envelope is a metric", func() {
+			It("summarizes the values of the metric", func() {
+				counter := NewEnvelope(logcache.Envelope{Counter: &logcache.Counter{Name: "requests", Delta: 1, Total: 42}})
+				Expect(counter.Message()).To(Equal("requests: 42 (delta: 1)"))
+				Expect(counter.Type()).To(Equal("COUNTER"))
+				Expect(counter.SourceType()).To(Equal("APP"))
+				Expect(counter.Staging()).To(BeFalse())
+
+				gauge := NewEnvelope(logcache.Envelope{Gauge: &logcache.Gauge{Metrics: map[string]logcache.GaugeValue{
+					"memory": {Unit: "bytes", Value: 1024},
+					"cpu":    {Unit: "percentage", Value: 1.5},
+					"count":  {Value: 2},
+				}}})
+				Expect(gauge.Message()).To(Equal("count: 2, cpu: 1.5 percentage, memory: 1024 bytes"))
+				Expect(gauge.Type()).To(Equal("GAUGE"))
+
+				timer := NewEnvelope(logcache.Envelope{Timer: &logcache.Timer{Name: "http", Start: timestamp, Stop: timestamp.Add(1500 * time.Millisecond)}})
+				Expect(timer.Message()).To(Equal("http: 1.5s"))
+				Expect(timer.Type()).To(Equal("TIMER"))
+
+				event := NewEnvelope(logcache.Envelope{Event: &logcache.Event{Title: "some-title", Body: "some-body"}})
+				Expect(event.Message()).To(Equal("some-title: some-body"))
+				Expect(event.Type()).To(Equal("EVENT"))
+			})
+		})
+	})
+
+	Describe("GetRecentLogs", func() {
+		var (
+			envelopes  []Envelope
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			envelopes, executeErr = actor.GetRecentLogs("some-app-guid", fakeLogCacheClient, []logcache.EnvelopeType{logcache.LogEnvelopeType})
+		})
+
+		Context("when reading from log cache succeeds", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.GetEnvelopesReturns([]logcache.Envelope{
+					{Timestamp: time.Unix(0, 3), Log: &logcache.Log{Payload: []byte("message-3")}},
+					{Timestamp: time.Unix(0, 2), Log: &logcache.Log{Payload: []byte("message-2")}},
+					{Timestamp: time.Unix(0, 1), Log: &logcache.Log{Payload: []byte("message-1")}},
+				}, nil)
+			})
+
+			It("returns the newest envelopes, oldest first", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(envelopes).To(HaveLen(3))
+				Expect(envelopes[0].Message()).To(Equal("message-1"))
+				Expect(envelopes[1].Message()).To(Equal("message-2"))
+				Expect(envelopes[2].Message()).To(Equal("message-3"))
+
+				Expect(fakeLogCacheClient.GetEnvelopesCallCount()).To(Equal(1))
+				sourceID, options := fakeLogCacheClient.GetEnvelopesArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+				Expect(options).To(Equal(logcache.ReadOptions{
+					EnvelopeTypes: []logcache.EnvelopeType{logcache.LogEnvelopeType},
+					Limit:         LogCacheReadLimit,
+					Descending:    true,
+				}))
+			})
+		})
+
+		Context("when reading from log cache fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeLogCacheClient.GetEnvelopesReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+
+	Describe("StreamLogs", func() {
+		var (
+			originalWalkInterval time.Duration
+
+			envelopes <-chan Envelope
+			errs      <-chan error
+			stop      func()
+		)
+
+		BeforeEach(func() {
+			originalWalkInterval = LogCacheWalkInterval
+			LogCacheWalkInterval = time.Millisecond
+		})
+
+		AfterEach(func() {
+			stop()
+			Eventually(envelopes).Should(BeClosed())
+			Eventually(errs).Should(BeClosed())
+			LogCacheWalkInterval = originalWalkInterval
+		})
+
+		JustBeforeEach(func() {
+			envelopes, errs, stop = actor.StreamLogs("some-app-guid", fakeLogCacheClient, []logcache.EnvelopeType{logcache.GaugeEnvelopeType})
+		})
+
+		Context("when reading from log cache succeeds", func() {
+			var startTime time.Time
+
+			BeforeEach(func() {
+				startTime = time.Now()
+				fakeLogCacheClient.GetEnvelopesReturnsOnCall(0, []logcache.Envelope{
+					{Timestamp: startTime.Add(time.Second), Event: &logcache.Event{Title: "event-1"}},
+					{Timestamp: startTime.Add(2 * time.Second), Event: &logcache.Event{Title: "event-2"}},
+				}, nil)
+				fakeLogCacheClient.GetEnvelopesReturnsOnCall(1, nil, nil)
+				fakeLogCacheClient.GetEnvelopesReturnsOnCall(2, []logcache.Envelope{
+					{Timestamp: startTime.Add(3 * time.Second), Event: &logcache.Event{Title: "event-3"}},
+				}, nil)
+			})
+
+			It("streams new envelopes, reading from after the last envelope seen", func() {
+				Eventually(envelopes).Should(Receive(WithTransform(Envelope.Message, Equal("event-1: "))))
+				Eventually(envelopes).Should(Receive(WithTransform(Envelope.Message, Equal("event-2: "))))
+				Eventually(envelopes).Should(Receive(WithTransform(Envelope.Message, Equal("event-3: "))))
+
+				sourceID, options := fakeLogCacheClient.GetEnvelopesArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+				Expect(options.StartTime).To(BeTemporally(">=", startTime))
+				Expect(options.EnvelopeTypes).To(Equal([]logcache.EnvelopeType{logcache.GaugeEnvelopeType}))
+				Expect(options.Limit).To(Equal(LogCacheReadLimit))
+				Expect(options.Descending).To(BeFalse())
+
+				_, options = fakeLogCacheClient.GetEnvelopesArgsForCall(1)
+				Expect(options.StartTime).To(Equal(startTime.Add(2*time.Second + time.Nanosecond)))
+			})
+		})
+
+		Context("when reading from log cache fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeLogCacheClient.GetEnvelopesReturns(nil, expectedErr)
+			})
+
+			It("returns the error and stops streaming", func() {
+				Eventually(errs).Should(Receive(MatchError(expectedErr)))
+				Eventually(envelopes).Should(BeClosed())
+				Expect(fakeLogCacheClient.GetEnvelopesCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
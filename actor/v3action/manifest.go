@@ -0,0 +1,24 @@
+package v3action
+
+import "io/ioutil"
+
+// ApplyManifest reads the manifest at the given path, applies it to the space
+// and waits for the Cloud Controller to finish processing it.
+func (actor Actor) ApplyManifest(spaceGUID string, manifestPath string) (Warnings, error) {
+	rawManifest, err := ioutil.ReadFile(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var allWarnings Warnings
+
+	jobURL, applyWarnings, err := actor.CloudControllerClient.UpdateSpaceApplyManifest(spaceGUID, rawManifest)
+	allWarnings = append(allWarnings, applyWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	pollWarnings, err := actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, pollWarnings...)
+	return allWarnings, err
+}
@@ -0,0 +1,121 @@
+package v3action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("ApplyManifest", func() {
+		var (
+			tmpDir       string
+			manifestPath string
+			rawManifest  []byte
+
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "apply-manifest")
+			Expect(err).ToNot(HaveOccurred())
+
+			manifestPath = filepath.Join(tmpDir, "manifest.yml")
+			rawManifest = []byte("applications:\n- name: some-app\n")
+			Expect(ioutil.WriteFile(manifestPath, rawManifest, 0600)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplyManifest("some-space-guid", manifestPath)
+		})
+
+		Context("when the manifest cannot be read", func() {
+			BeforeEach(func() {
+				manifestPath = filepath.Join(tmpDir, "missing.yml")
+			})
+
+			It("returns the error without calling the API", func() {
+				Expect(os.IsNotExist(executeErr)).To(BeTrue())
+				Expect(fakeCloudControllerClient.UpdateSpaceApplyManifestCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when applying the manifest succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateSpaceApplyManifestReturns("some-job-url", ccv3.Warnings{"apply-warning"}, nil)
+			})
+
+			Context("when polling the job succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+				})
+
+				It("applies the raw manifest to the space and waits for the job", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("apply-warning", "poll-warning"))
+
+					Expect(fakeCloudControllerClient.UpdateSpaceApplyManifestCallCount()).To(Equal(1))
+					spaceGUID, manifest := fakeCloudControllerClient.UpdateSpaceApplyManifestArgsForCall(0)
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(manifest).To(Equal(rawManifest))
+
+					Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal("some-job-url"))
+				})
+			})
+
+			Context("when polling the job fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("poll error")
+					fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("apply-warning", "poll-warning"))
+				})
+			})
+		})
+
+		Context("when applying the manifest fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("apply error")
+				fakeCloudControllerClient.UpdateSpaceApplyManifestReturns("", ccv3.Warnings{"apply-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings without polling", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("apply-warning"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
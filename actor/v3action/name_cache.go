@@ -0,0 +1,66 @@
+package v3action
+
+import "sync"
+
+// nameCache memoizes organizations and spaces looked up by name so that each
+// unique name is only fetched once for the lifetime of an Actor. A nil
+// nameCache caches nothing.
+type nameCache struct {
+	mutex  sync.Mutex
+	orgs   map[string]Organization
+	spaces map[spaceCacheKey]Space
+}
+
+type spaceCacheKey struct {
+	orgGUID   string
+	spaceName string
+}
+
+func newNameCache() *nameCache {
+	return &nameCache{
+		orgs:   map[string]Organization{},
+		spaces: map[spaceCacheKey]Space{},
+	}
+}
+
+func (cache *nameCache) loadOrganization(orgName string) (Organization, bool) {
+	if cache == nil {
+		return Organization{}, false
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	org, found := cache.orgs[orgName]
+	return org, found
+}
+
+func (cache *nameCache) saveOrganization(orgName string, org Organization) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.orgs[orgName] = org
+}
+
+func (cache *nameCache) loadSpace(orgGUID string, spaceName string) (Space, bool) {
+	if cache == nil {
+		return Space{}, false
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	space, found := cache.spaces[spaceCacheKey{orgGUID: orgGUID, spaceName: spaceName}]
+	return space, found
+}
+
+func (cache *nameCache) saveSpace(orgGUID string, spaceName string, space Space) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.spaces[spaceCacheKey{orgGUID: orgGUID, spaceName: spaceName}] = space
+}
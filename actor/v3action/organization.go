@@ -20,8 +20,13 @@ func (e OrganizationNotFoundError) Error() string {
 	return fmt.Sprintf("Organization '%s' not found.", e.Name)
 }
 
-// GetOrganizationByName returns the organization with the given name.
+// GetOrganizationByName returns the organization with the given name. Found
+// organizations are cached for the lifetime of the actor.
 func (actor Actor) GetOrganizationByName(name string) (Organization, Warnings, error) {
+	if org, found := actor.nameCache.loadOrganization(name); found {
+		return org, nil, nil
+	}
+
 	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(url.Values{
 		ccv3.NameFilter: []string{name},
 	})
@@ -33,5 +38,7 @@ func (actor Actor) GetOrganizationByName(name string) (Organization, Warnings, e
 		return Organization{}, Warnings(warnings), OrganizationNotFoundError{Name: name}
 	}
 
-	return Organization(orgs[0]), Warnings(warnings), nil
+	org := Organization(orgs[0])
+	actor.nameCache.saveOrganization(name, org)
+	return org, Warnings(warnings), nil
 }
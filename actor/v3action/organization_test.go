@@ -20,7 +20,7 @@ var _ = Describe("Organization Actions", func() {
 
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
-		actor = NewActor(fakeCloudControllerClient, nil)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
 	})
 
 	Describe("GetOrganizationByName", func() {
@@ -54,6 +54,18 @@ var _ = Describe("Organization Actions", func() {
 				query := fakeCloudControllerClient.GetOrganizationsArgsForCall(0)
 				Expect(query).To(Equal(expectedQuery))
 			})
+
+			It("caches the organization for subsequent lookups", func() {
+				_, _, err := actor.GetOrganizationByName("some-org-name")
+				Expect(err).ToNot(HaveOccurred())
+
+				org, warnings, err := actor.GetOrganizationByName("some-org-name")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(org.GUID).To(Equal("some-org-guid"))
+				Expect(warnings).To(BeEmpty())
+
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+			})
 		})
 
 		Context("when the cloud controller client returns an error", func() {
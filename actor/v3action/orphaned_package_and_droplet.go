@@ -0,0 +1,127 @@
+package v3action
+
+import (
+	"net/url"
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// OrphanedPackagesAndDroplets are the packages and droplets in a space that
+// are not in use by any application in that space.
+type OrphanedPackagesAndDroplets struct {
+	Packages []Package
+	Droplets []Droplet
+}
+
+// GetOrphanedPackagesAndDropletsBySpace returns the packages and droplets in
+// the space that are no longer in use. A droplet is in use when it is the
+// current droplet of an application, and a package is in use when a current
+// droplet was staged from it. Packages and droplets that are still being
+// uploaded, copied or staged are never considered orphaned. The current
+// droplets of the space's applications are looked up in parallel.
+func (actor Actor) GetOrphanedPackagesAndDropletsBySpace(spaceGUID string) (OrphanedPackagesAndDroplets, Warnings, error) {
+	apps, allWarnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return OrphanedPackagesAndDroplets{}, allWarnings, err
+	}
+
+	currentDroplets, warnings, err := actor.getCurrentDroplets(apps)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrphanedPackagesAndDroplets{}, allWarnings, err
+	}
+
+	spaceQuery := url.Values{ccv3.SpaceGUIDFilter: []string{spaceGUID}}
+
+	ccv3Droplets, ccWarnings, err := actor.CloudControllerClient.GetDroplets(spaceQuery)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return OrphanedPackagesAndDroplets{}, allWarnings, err
+	}
+
+	inUseDroplets := map[string]bool{}
+	inUsePackages := map[string]bool{}
+	for _, droplet := range currentDroplets {
+		inUseDroplets[droplet.GUID] = true
+		inUsePackages[droplet.PackageGUID] = true
+	}
+	for _, droplet := range ccv3Droplets {
+		if !dropletFinished(droplet) {
+			inUseDroplets[droplet.GUID] = true
+			inUsePackages[droplet.PackageGUID] = true
+		}
+	}
+
+	ccv3Packages, ccWarnings, err := actor.CloudControllerClient.GetPackages(spaceQuery)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return OrphanedPackagesAndDroplets{}, allWarnings, err
+	}
+
+	var orphaned OrphanedPackagesAndDroplets
+	for _, pkg := range ccv3Packages {
+		if packageFinished(pkg) && !inUsePackages[pkg.GUID] {
+			orphaned.Packages = append(orphaned.Packages, Package(pkg))
+		}
+	}
+	for _, droplet := range ccv3Droplets {
+		if !inUseDroplets[droplet.GUID] {
+			orphaned.Droplets = append(orphaned.Droplets, actor.convertCCToActorDroplet(droplet))
+		}
+	}
+
+	return orphaned, allWarnings, nil
+}
+
+// getCurrentDroplets returns the current droplet of every application that
+// has one. The warnings from every lookup are returned along with the first
+// error encountered.
+func (actor Actor) getCurrentDroplets(apps []Application) ([]ccv3.Droplet, Warnings, error) {
+	var (
+		wg              sync.WaitGroup
+		mutex           sync.Mutex
+		currentDroplets []ccv3.Droplet
+		allWarnings     Warnings
+		firstErr        error
+	)
+	for _, app := range apps {
+		wg.Add(1)
+		go func(appGUID string) {
+			defer wg.Done()
+			droplets, warnings, getErr := actor.CloudControllerClient.GetApplicationDroplets(appGUID, url.Values{
+				"current": []string{"true"},
+			})
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			allWarnings = append(allWarnings, warnings...)
+			if getErr != nil {
+				if firstErr == nil {
+					firstErr = getErr
+				}
+				return
+			}
+			currentDroplets = append(currentDroplets, droplets...)
+		}(app.GUID)
+	}
+	wg.Wait()
+
+	return currentDroplets, allWarnings, firstErr
+}
+
+func dropletFinished(droplet ccv3.Droplet) bool {
+	switch droplet.State {
+	case ccv3.DropletStateStaged, ccv3.DropletStateFailed, ccv3.DropletStateExpired:
+		return true
+	}
+	return false
+}
+
+func packageFinished(pkg ccv3.Package) bool {
+	switch pkg.State {
+	case ccv3.PackageStateReady, ccv3.PackageStateFailed, ccv3.PackageStateExpired:
+		return true
+	}
+	return false
+}
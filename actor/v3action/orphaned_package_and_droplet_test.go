@@ -0,0 +1,174 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Orphaned Package and Droplet Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetOrphanedPackagesAndDropletsBySpace", func() {
+		var (
+			orphaned   OrphanedPackagesAndDroplets
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{GUID: "app-guid-1"}, {GUID: "app-guid-2"}, {GUID: "app-guid-3"}},
+				ccv3.Warnings{"get-apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationDropletsStub = func(appGUID string, _ url.Values) ([]ccv3.Droplet, ccv3.Warnings, error) {
+				switch appGUID {
+				case "app-guid-1":
+					return []ccv3.Droplet{{GUID: "current-droplet-1", PackageGUID: "current-package-1"}}, ccv3.Warnings{"get-current-droplet-warning-1"}, nil
+				case "app-guid-2":
+					return []ccv3.Droplet{{GUID: "current-droplet-2", PackageGUID: "current-package-2"}}, ccv3.Warnings{"get-current-droplet-warning-2"}, nil
+				default:
+					return nil, ccv3.Warnings{"get-current-droplet-warning-3"}, nil
+				}
+			}
+			fakeCloudControllerClient.GetDropletsReturns(
+				[]ccv3.Droplet{
+					{GUID: "current-droplet-1", State: ccv3.DropletStateStaged, PackageGUID: "current-package-1"},
+					{GUID: "current-droplet-2", State: ccv3.DropletStateStaged, PackageGUID: "current-package-2"},
+					{GUID: "old-droplet", State: ccv3.DropletStateStaged, PackageGUID: "old-package"},
+					{GUID: "failed-droplet", State: ccv3.DropletStateFailed, PackageGUID: "failed-package"},
+					{GUID: "staging-droplet", State: "STAGING", PackageGUID: "staging-package"},
+				},
+				ccv3.Warnings{"get-droplets-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetPackagesReturns(
+				[]ccv3.Package{
+					{GUID: "current-package-1", State: ccv3.PackageStateReady},
+					{GUID: "current-package-2", State: ccv3.PackageStateReady},
+					{GUID: "old-package", State: ccv3.PackageStateReady},
+					{GUID: "failed-package", State: ccv3.PackageStateReady},
+					{GUID: "staging-package", State: ccv3.PackageStateReady},
+					{GUID: "uploading-package", State: ccv3.PackageStateProcessingUpload},
+				},
+				ccv3.Warnings{"get-packages-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			orphaned, warnings, executeErr = actor.GetOrphanedPackagesAndDropletsBySpace("some-space-guid")
+		})
+
+		It("returns the finished packages and droplets that are not in use and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"get-apps-warning",
+				"get-current-droplet-warning-1",
+				"get-current-droplet-warning-2",
+				"get-current-droplet-warning-3",
+				"get-droplets-warning",
+				"get-packages-warning",
+			))
+
+			Expect(orphaned.Packages).To(Equal([]Package{
+				{GUID: "old-package", State: ccv3.PackageStateReady},
+				{GUID: "failed-package", State: ccv3.PackageStateReady},
+			}))
+			Expect(orphaned.Droplets).To(Equal([]Droplet{
+				{GUID: "old-droplet", State: DropletStateStaged},
+				{GUID: "failed-droplet", State: DropletStateFailed},
+			}))
+
+			Expect(fakeCloudControllerClient.GetApplicationDropletsCallCount()).To(Equal(3))
+			_, query := fakeCloudControllerClient.GetApplicationDropletsArgsForCall(0)
+			Expect(query).To(Equal(url.Values{"current": []string{"true"}}))
+
+			Expect(fakeCloudControllerClient.GetDropletsArgsForCall(0)).To(Equal(url.Values{
+				ccv3.SpaceGUIDFilter: []string{"some-space-guid"},
+			}))
+			Expect(fakeCloudControllerClient.GetPackagesArgsForCall(0)).To(Equal(url.Values{
+				ccv3.SpaceGUIDFilter: []string{"some-space-guid"},
+			}))
+		})
+
+		Context("when getting the applications fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get apps error")
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationDropletsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting a current droplet fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get current droplet error")
+				fakeCloudControllerClient.GetApplicationDropletsStub = nil
+				fakeCloudControllerClient.GetApplicationDropletsReturns(nil, ccv3.Warnings{"get-current-droplet-warning"}, expectedErr)
+			})
+
+			It("returns the error and the warnings from every application", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf(
+					"get-apps-warning",
+					"get-current-droplet-warning",
+					"get-current-droplet-warning",
+					"get-current-droplet-warning",
+				))
+				Expect(fakeCloudControllerClient.GetDropletsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the droplets fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get droplets error")
+				fakeCloudControllerClient.GetDropletsReturns(nil, ccv3.Warnings{"get-droplets-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("get-droplets-warning"))
+				Expect(fakeCloudControllerClient.GetPackagesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the packages fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get packages error")
+				fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"get-packages-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("get-packages-warning"))
+			})
+		})
+	})
+})
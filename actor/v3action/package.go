@@ -10,9 +10,11 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
-	"time"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/jobs"
+	"code.cloudfoundry.org/cli/util/interrupt"
 	"code.cloudfoundry.org/gofileutils/fileutils"
 	"code.cloudfoundry.org/ykk"
 )
@@ -34,8 +36,26 @@ func (PackageProcessingExpiredError) Error() string {
 	return "Package expired after upload"
 }
 
+// PackageNotFoundError is returned when a package with the given GUID cannot
+// be found.
+type PackageNotFoundError struct {
+	GUID string
+}
+
+func (e PackageNotFoundError) Error() string {
+	return fmt.Sprintf("Package %s not found.", e.GUID)
+}
+
 type Package ccv3.Package
 
+// DockerImageCredentials are the location of a docker image and, for private
+// registries, the credentials needed to pull it.
+type DockerImageCredentials struct {
+	Path     string
+	Username string
+	Password string
+}
+
 type EmptyDirectoryError struct {
 	Path string
 }
@@ -44,8 +64,27 @@ func (e EmptyDirectoryError) Error() string {
 	return fmt.Sprint(e.Path, "is empty")
 }
 
-func (actor Actor) CreatePackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string, dockerImage string) (Package, Warnings, error) {
-	if dockerImage == "" {
+// DeletePackage deletes the package with the given GUID and waits for the
+// deletion to complete.
+func (actor Actor) DeletePackage(packageGUID string) (Warnings, error) {
+	var allWarnings Warnings
+
+	jobURL, deleteWarnings, err := actor.CloudControllerClient.DeletePackage(packageGUID)
+	allWarnings = append(allWarnings, deleteWarnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+			return allWarnings, PackageNotFoundError{GUID: packageGUID}
+		}
+		return allWarnings, err
+	}
+
+	pollWarnings, err := actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, pollWarnings...)
+	return allWarnings, err
+}
+
+func (actor Actor) CreatePackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string, dockerImageCredentials DockerImageCredentials) (Package, Warnings, error) {
+	if dockerImageCredentials.Path == "" {
 		if bitsPath == "" {
 			var err error
 			bitsPath, err = os.Getwd()
@@ -55,10 +94,10 @@ func (actor Actor) CreatePackageByApplicationNameAndSpace(appName string, spaceG
 		}
 		return actor.createAndUploadBitsPackageByApplicationNameAndSpace(appName, spaceGUID, bitsPath)
 	}
-	return actor.createDockerPackageByApplicationNameAndSpace(appName, spaceGUID, dockerImage)
+	return actor.createDockerPackageByApplicationNameAndSpace(appName, spaceGUID, dockerImageCredentials)
 }
 
-func (actor Actor) createDockerPackageByApplicationNameAndSpace(appName string, spaceGUID string, dockerImage string) (Package, Warnings, error) {
+func (actor Actor) createDockerPackageByApplicationNameAndSpace(appName string, spaceGUID string, dockerImageCredentials DockerImageCredentials) (Package, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return Package{}, allWarnings, err
@@ -68,7 +107,9 @@ func (actor Actor) createDockerPackageByApplicationNameAndSpace(appName string,
 		Relationships: ccv3.Relationships{
 			ccv3.ApplicationRelationship: ccv3.Relationship{GUID: app.GUID},
 		},
-		DockerImage: dockerImage,
+		DockerImage:    dockerImageCredentials.Path,
+		DockerUsername: dockerImageCredentials.Username,
+		DockerPassword: dockerImageCredentials.Password,
 	}
 	pkg, warnings, err := actor.CloudControllerClient.CreatePackage(inputPackage)
 	allWarnings = append(allWarnings, warnings...)
@@ -124,11 +165,70 @@ func (actor Actor) createAndUploadBitsPackageByApplicationNameAndSpace(appName s
 		return Package{}, allWarnings, err
 	}
 
-	for pkg.State != ccv3.PackageStateReady &&
-		pkg.State != ccv3.PackageStateFailed &&
-		pkg.State != ccv3.PackageStateExpired {
-		time.Sleep(actor.Config.PollingInterval())
-		pkg, warnings, err = actor.CloudControllerClient.GetPackage(pkg.GUID)
+	readyPackage, pollWarnings, err := actor.pollPackage(pkg)
+	allWarnings = append(allWarnings, pollWarnings...)
+	return readyPackage, allWarnings, err
+}
+
+// CopyPackage copies the newest ready package of the source application to
+// the target application and waits for the copy to finish processing.
+func (actor Actor) CopyPackage(sourceAppName string, targetAppName string, spaceGUID string) (Package, Warnings, error) {
+	sourceApp, allWarnings, err := actor.GetApplicationByNameAndSpace(sourceAppName, spaceGUID)
+	if err != nil {
+		return Package{}, allWarnings, err
+	}
+
+	targetApp, warnings, err := actor.GetApplicationByNameAndSpace(targetAppName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Package{}, allWarnings, err
+	}
+
+	packages, ccWarnings, err := actor.CloudControllerClient.GetPackages(url.Values{
+		ccv3.AppGUIDFilter: []string{sourceApp.GUID},
+		ccv3.StatesFilter:  []string{string(ccv3.PackageStateReady)},
+		ccv3.OrderBy:       []string{ccv3.CreatedAtDescendingOrder},
+	})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return Package{}, allWarnings, err
+	}
+
+	if len(packages) == 0 {
+		return Package{}, allWarnings, ReadyPackageNotFoundError{AppName: sourceAppName}
+	}
+
+	pkg, ccWarnings, err := actor.CloudControllerClient.CopyPackage(packages[0].GUID, targetApp.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return Package{}, allWarnings, err
+	}
+
+	readyPackage, warnings, err := actor.pollPackage(pkg)
+	allWarnings = append(allWarnings, warnings...)
+	return readyPackage, allWarnings, err
+}
+
+// pollPackage waits for the package to finish processing and returns it once
+// it is ready. A JobTimeoutError is returned if the package is still being
+// processed after the overall polling timeout.
+func (actor Actor) pollPackage(pkg ccv3.Package) (Package, Warnings, error) {
+	var allWarnings Warnings
+
+	if !packageProcessed(pkg) {
+		poller := jobs.NewPoller(jobs.JobGetterFunc(func(guid string) (jobs.State, []string, error) {
+			var (
+				warnings ccv3.Warnings
+				err      error
+			)
+			pkg, warnings, err = actor.CloudControllerClient.GetPackage(guid)
+			if err != nil {
+				return jobs.State{}, warnings, err
+			}
+			return jobs.State{GUID: guid, Complete: packageProcessed(pkg)}, warnings, nil
+		}), interrupt.DefaultHandler)
+
+		warnings, err := poller.PollJob(pkg.GUID, actor.Config.PollingInterval(), actor.Config.OverallPollingTimeout())
 		allWarnings = append(allWarnings, warnings...)
 		if err != nil {
 			return Package{}, allWarnings, err
@@ -141,7 +241,15 @@ func (actor Actor) createAndUploadBitsPackageByApplicationNameAndSpace(appName s
 		return Package{}, allWarnings, PackageProcessingExpiredError{}
 	}
 
-	return Package(pkg), allWarnings, err
+	return Package(pkg), allWarnings, nil
+}
+
+// packageProcessed returns true once the package is no longer being
+// processed.
+func packageProcessed(pkg ccv3.Package) bool {
+	return pkg.State == ccv3.PackageStateReady ||
+		pkg.State == ccv3.PackageStateFailed ||
+		pkg.State == ccv3.PackageStateExpired
 }
 
 // GetApplicationPackages returns a list of package of an app.
@@ -7,9 +7,11 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/ykk"
 
@@ -38,7 +40,8 @@ var _ = Describe("Package Actions", func() {
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
 		fakeConfig = new(v3actionfakes.FakeConfig)
-		actor = NewActor(fakeCloudControllerClient, fakeConfig)
+		fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
 	})
 
 	Describe("GetApplicationPackages", func() {
@@ -150,6 +153,180 @@ var _ = Describe("Package Actions", func() {
 		})
 	})
 
+	Describe("DeletePackage", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeletePackage("some-package-guid")
+		})
+
+		Context("when the package does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeletePackageReturns("", ccv3.Warnings{"some-delete-warning"}, ccerror.ResourceNotFoundError{Message: "Package not found"})
+			})
+
+			It("returns a PackageNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(PackageNotFoundError{GUID: "some-package-guid"}))
+				Expect(warnings).To(ConsistOf("some-delete-warning"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when sending the delete fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeletePackageReturns("", ccv3.Warnings{"some-delete-warning"}, errors.New("some-delete-error"))
+			})
+
+			It("returns the warnings and error", func() {
+				Expect(executeErr).To(MatchError("some-delete-error"))
+				Expect(warnings).To(ConsistOf("some-delete-warning"))
+			})
+		})
+
+		Context("when sending the delete succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeletePackageReturns("/some-job-url", ccv3.Warnings{"some-delete-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"some-poll-warning"}, nil)
+			})
+
+			It("deletes the package, polls the returned job and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-delete-warning", "some-poll-warning"))
+
+				Expect(fakeCloudControllerClient.DeletePackageCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeletePackageArgsForCall(0)).To(Equal("some-package-guid"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal("/some-job-url"))
+			})
+
+			Context("when polling fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"some-poll-warning"}, errors.New("some-poll-error"))
+				})
+
+				It("returns the warnings and poll error", func() {
+					Expect(executeErr).To(MatchError("some-poll-error"))
+					Expect(warnings).To(ConsistOf("some-delete-warning", "some-poll-warning"))
+				})
+			})
+		})
+	})
+
+	Describe("CopyPackage", func() {
+		var (
+			pkg        Package
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturnsOnCall(0,
+				[]ccv3.Application{{Name: "source-app", GUID: "source-app-guid"}},
+				ccv3.Warnings{"get-source-app-warning"},
+				nil)
+			fakeCloudControllerClient.GetApplicationsReturnsOnCall(1,
+				[]ccv3.Application{{Name: "target-app", GUID: "target-app-guid"}},
+				ccv3.Warnings{"get-target-app-warning"},
+				nil)
+			fakeCloudControllerClient.GetPackagesReturns(
+				[]ccv3.Package{{GUID: "newest-package-guid"}, {GUID: "older-package-guid"}},
+				ccv3.Warnings{"get-packages-warning"},
+				nil)
+			fakeCloudControllerClient.CopyPackageReturns(
+				ccv3.Package{GUID: "copied-package-guid", State: ccv3.PackageStateCopying},
+				ccv3.Warnings{"copy-package-warning"},
+				nil)
+			fakeCloudControllerClient.GetPackageReturns(
+				ccv3.Package{GUID: "copied-package-guid", State: ccv3.PackageStateReady},
+				ccv3.Warnings{"get-package-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			pkg, warnings, executeErr = actor.CopyPackage("source-app", "target-app", "some-space-guid")
+		})
+
+		It("copies the newest ready package to the target app and waits for it to be ready", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(pkg).To(Equal(Package{GUID: "copied-package-guid", State: ccv3.PackageStateReady}))
+			Expect(warnings).To(ConsistOf(
+				"get-source-app-warning",
+				"get-target-app-warning",
+				"get-packages-warning",
+				"copy-package-warning",
+				"get-package-warning",
+			))
+
+			Expect(fakeCloudControllerClient.GetPackagesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetPackagesArgsForCall(0)).To(Equal(url.Values{
+				ccv3.AppGUIDFilter: []string{"source-app-guid"},
+				ccv3.StatesFilter:  []string{string(ccv3.PackageStateReady)},
+				ccv3.OrderBy:       []string{ccv3.CreatedAtDescendingOrder},
+			}))
+
+			Expect(fakeCloudControllerClient.CopyPackageCallCount()).To(Equal(1))
+			sourcePackageGUID, targetAppGUID := fakeCloudControllerClient.CopyPackageArgsForCall(0)
+			Expect(sourcePackageGUID).To(Equal("newest-package-guid"))
+			Expect(targetAppGUID).To(Equal("target-app-guid"))
+
+			Expect(fakeCloudControllerClient.GetPackageCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetPackageArgsForCall(0)).To(Equal("copied-package-guid"))
+		})
+
+		Context("when the target app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(1, nil, ccv3.Warnings{"get-target-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{Name: "target-app"}))
+				Expect(warnings).To(ConsistOf("get-source-app-warning", "get-target-app-warning"))
+				Expect(fakeCloudControllerClient.CopyPackageCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the source app has no ready packages", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"get-packages-warning"}, nil)
+			})
+
+			It("returns a ReadyPackageNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ReadyPackageNotFoundError{AppName: "source-app"}))
+				Expect(fakeCloudControllerClient.CopyPackageCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when copying the package fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("copy error")
+				fakeCloudControllerClient.CopyPackageReturns(ccv3.Package{}, ccv3.Warnings{"copy-package-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-source-app-warning", "get-target-app-warning", "get-packages-warning", "copy-package-warning"))
+			})
+		})
+
+		Context("when the copied package fails processing", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackageReturns(
+					ccv3.Package{GUID: "copied-package-guid", State: ccv3.PackageStateFailed},
+					ccv3.Warnings{"get-package-warning"},
+					nil)
+			})
+
+			It("returns a PackageProcessingFailedError", func() {
+				Expect(executeErr).To(MatchError(PackageProcessingFailedError{}))
+			})
+		})
+	})
+
 	Describe("CreatePackageByApplicationNameAndSpace", func() {
 		Describe("for bits packages", func() {
 			Context("when the application can be retrieved", func() {
@@ -278,7 +455,7 @@ var _ = Describe("Package Actions", func() {
 							})
 
 							It("creates a new archive with correct permissions", func() {
-								_, _, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", archivePath, "")
+								_, _, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", archivePath, DockerImageCredentials{})
 
 								Expect(err).NotTo(HaveOccurred())
 								Expect(fakeCloudControllerClient.UploadPackageCallCount()).To(Equal(1))
@@ -312,19 +489,19 @@ var _ = Describe("Package Actions", func() {
 								})
 
 								It("correctly constructs the zip", func() {
-									_, _, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, "")
+									_, _, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, DockerImageCredentials{})
 									Expect(err).NotTo(HaveOccurred())
 									Expect(fakeCloudControllerClient.UploadPackageCallCount()).To(Equal(1))
 								})
 
 								It("collects all warnings", func() {
-									_, warnings, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, "")
+									_, warnings, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, DockerImageCredentials{})
 									Expect(err).NotTo(HaveOccurred())
 									Expect(warnings).To(ConsistOf("some-app-warning", "some-pkg-warning", "some-upload-pkg-warning", "some-get-pkg-warning"))
 								})
 
 								It("successfully resolves the app name", func() {
-									_, _, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, "")
+									_, _, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, DockerImageCredentials{})
 									Expect(err).ToNot(HaveOccurred())
 
 									Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
@@ -337,7 +514,7 @@ var _ = Describe("Package Actions", func() {
 								})
 
 								It("successfully creates the Package", func() {
-									_, _, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, "")
+									_, _, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, DockerImageCredentials{})
 									Expect(err).ToNot(HaveOccurred())
 
 									Expect(fakeCloudControllerClient.CreatePackageCallCount()).To(Equal(1))
@@ -351,7 +528,7 @@ var _ = Describe("Package Actions", func() {
 								})
 
 								It("returns the package", func() {
-									pkg, _, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, "")
+									pkg, _, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, DockerImageCredentials{})
 									Expect(err).ToNot(HaveOccurred())
 
 									expectedPackage := ccv3.Package{
@@ -374,7 +551,7 @@ var _ = Describe("Package Actions", func() {
 										}()
 										err = os.Chdir(bitsPath)
 										Expect(err).NotTo(HaveOccurred())
-										_, _, err = actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", "", "")
+										_, _, err = actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", "", DockerImageCredentials{})
 
 										Expect(err).NotTo(HaveOccurred())
 										Expect(fakeCloudControllerClient.UploadPackageCallCount()).To(Equal(1))
@@ -397,7 +574,7 @@ var _ = Describe("Package Actions", func() {
 											nil,
 										)
 
-										_, warnings, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, "")
+										_, warnings, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, DockerImageCredentials{})
 
 										if expectedErr == nil {
 											Expect(err).ToNot(HaveOccurred())
@@ -408,7 +585,7 @@ var _ = Describe("Package Actions", func() {
 										Expect(warnings).To(ConsistOf("some-app-warning", "some-pkg-warning", "some-upload-pkg-warning", "some-get-pkg-warning", "some-get-pkg-warning", "some-get-pkg-warning"))
 
 										Expect(fakeCloudControllerClient.GetPackageCallCount()).To(Equal(3))
-										Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(3))
+										Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(1))
 									},
 
 									Entry("READY", ccv3.PackageStateReady, nil),
@@ -430,11 +607,31 @@ var _ = Describe("Package Actions", func() {
 								})
 
 								It("returns the error and warnings", func() {
-									_, warnings, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, "")
+									_, warnings, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, DockerImageCredentials{})
 									Expect(err).To(MatchError(expectedErr))
 									Expect(warnings).To(ConsistOf("some-app-warning", "some-pkg-warning", "some-upload-pkg-warning", "some-get-pkg-warning"))
 								})
 							})
+
+							Context("when the package is still processing after the overall polling timeout", func() {
+								BeforeEach(func() {
+									fakeConfig.OverallPollingTimeoutReturns(time.Millisecond)
+									fakeCloudControllerClient.GetPackageReturns(
+										ccv3.Package{GUID: "some-pkg-guid", State: ccv3.PackageStateProcessingUpload},
+										ccv3.Warnings{"some-get-pkg-warning"},
+										nil,
+									)
+								})
+
+								It("returns a JobTimeoutError and warnings", func() {
+									_, warnings, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, DockerImageCredentials{})
+									Expect(err).To(MatchError(ccerror.JobTimeoutError{
+										JobGUID: "some-pkg-guid",
+										Timeout: time.Millisecond,
+									}))
+									Expect(warnings).To(ContainElement("some-get-pkg-warning"))
+								})
+							})
 						})
 
 						Context("when the file uploading errors", func() {
@@ -446,7 +643,7 @@ var _ = Describe("Package Actions", func() {
 							})
 
 							It("returns the warnings and the error", func() {
-								_, warnings, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, "")
+								_, warnings, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, DockerImageCredentials{})
 								Expect(err).To(MatchError(expectedErr))
 								Expect(warnings).To(ConsistOf("some-app-warning", "some-pkg-warning", "some-upload-pkg-warning"))
 							})
@@ -466,7 +663,7 @@ var _ = Describe("Package Actions", func() {
 						})
 
 						It("returns the warnings and the error", func() {
-							_, warnings, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, "")
+							_, warnings, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, DockerImageCredentials{})
 							Expect(err).To(MatchError(expectedErr))
 							Expect(warnings).To(ConsistOf("some-app-warning", "some-pkg-warning"))
 						})
@@ -481,7 +678,7 @@ var _ = Describe("Package Actions", func() {
 					)
 
 					JustBeforeEach(func() {
-						_, warnings, executeErr = actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", appPath, "")
+						_, warnings, executeErr = actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", appPath, DockerImageCredentials{})
 					})
 
 					Context("when the provided path is an empty directory", func() {
@@ -530,7 +727,7 @@ var _ = Describe("Package Actions", func() {
 				})
 
 				It("returns the warnings and the error", func() {
-					_, warnings, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", "some-path", "")
+					_, warnings, err := actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", "some-path", DockerImageCredentials{})
 					Expect(err).To(MatchError(expectedErr))
 					Expect(warnings).To(ConsistOf("some-warning"))
 				})
@@ -539,13 +736,18 @@ var _ = Describe("Package Actions", func() {
 
 		Describe("for docker packages", func() {
 			var (
-				dockerPackage Package
-				warnings      Warnings
-				executeErr    error
+				dockerImageCredentials DockerImageCredentials
+				dockerPackage          Package
+				warnings               Warnings
+				executeErr             error
 			)
 
+			BeforeEach(func() {
+				dockerImageCredentials = DockerImageCredentials{Path: "some-docker-image"}
+			})
+
 			JustBeforeEach(func() {
-				dockerPackage, warnings, executeErr = actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", "", "some-docker-image")
+				dockerPackage, warnings, executeErr = actor.CreatePackageByApplicationNameAndSpace("some-app-name", "some-space-guid", "", dockerImageCredentials)
 			})
 
 			Context("when the application can't be retrieved", func() {
@@ -642,6 +844,23 @@ var _ = Describe("Package Actions", func() {
 							},
 						}))
 					})
+
+					Context("when docker credentials are provided", func() {
+						BeforeEach(func() {
+							dockerImageCredentials.Username = "some-username"
+							dockerImageCredentials.Password = "some-password"
+						})
+
+						It("passes the credentials to CC", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeCloudControllerClient.CreatePackageCallCount()).To(Equal(1))
+							inputPackage := fakeCloudControllerClient.CreatePackageArgsForCall(0)
+							Expect(inputPackage.DockerImage).To(Equal("some-docker-image"))
+							Expect(inputPackage.DockerUsername).To(Equal("some-username"))
+							Expect(inputPackage.DockerPassword).To(Equal("some-password"))
+						})
+					})
 				})
 			})
 		})
@@ -8,6 +8,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // Process represents a V3 actor process.
@@ -118,3 +119,20 @@ func (actor Actor) ScaleProcessByApplication(appGUID string, process Process) (W
 
 	return allWarnings, nil
 }
+
+// SetProcessCommand sets the start command of the application's process type.
+// An unset or empty command resets the process to the command detected by the
+// buildpack during staging.
+func (actor Actor) SetProcessCommand(appGUID string, processType string, command types.FilteredString) (Warnings, error) {
+	process, allWarnings, err := actor.GetProcessByApplicationAndProcessType(appGUID, processType)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err := actor.CloudControllerClient.PatchApplicationProcessCommand(process.GUID, command)
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(ccerror.ProcessNotFoundError); ok {
+		return allWarnings, ProcessNotFoundError{ProcessType: processType}
+	}
+	return allWarnings, err
+}
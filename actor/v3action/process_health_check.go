@@ -4,12 +4,15 @@ import (
 	"sort"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/types"
 )
 
 type ProcessHealthCheck struct {
-	ProcessType     string
-	HealthCheckType string
-	Endpoint        string
+	ProcessType       string
+	HealthCheckType   string
+	Endpoint          string
+	InvocationTimeout types.NullInt
+	Timeout           types.NullInt
 }
 
 type ProcessHealthChecks []ProcessHealthCheck
@@ -64,9 +67,11 @@ func (actor Actor) GetApplicationProcessHealthChecksByNameAndSpace(appName strin
 	var processHealthChecks ProcessHealthChecks
 	for _, ccv3Process := range ccv3Processes {
 		processHealthCheck := ProcessHealthCheck{
-			ProcessType:     ccv3Process.Type,
-			HealthCheckType: ccv3Process.HealthCheck.Type,
-			Endpoint:        ccv3Process.HealthCheck.Data.Endpoint,
+			ProcessType:       ccv3Process.Type,
+			HealthCheckType:   ccv3Process.HealthCheck.Type,
+			Endpoint:          ccv3Process.HealthCheck.Data.Endpoint,
+			InvocationTimeout: ccv3Process.HealthCheck.Data.InvocationTimeout,
+			Timeout:           ccv3Process.HealthCheck.Data.Timeout,
 		}
 		processHealthChecks = append(processHealthChecks, processHealthCheck)
 	}
@@ -76,7 +81,7 @@ func (actor Actor) GetApplicationProcessHealthChecksByNameAndSpace(appName strin
 	return processHealthChecks, allWarnings, nil
 }
 
-func (actor Actor) SetApplicationProcessHealthCheckTypeByNameAndSpace(appName string, spaceGUID string, healthCheckType string, httpEndpoint string, processType string) (Application, Warnings, error) {
+func (actor Actor) SetApplicationProcessHealthCheckTypeByNameAndSpace(appName string, spaceGUID string, healthCheckType string, httpEndpoint string, processType string, invocationTimeout types.NullInt) (Application, Warnings, error) {
 	if healthCheckType != "http" {
 		if httpEndpoint == "/" {
 			httpEndpoint = ""
@@ -103,6 +108,7 @@ func (actor Actor) SetApplicationProcessHealthCheckTypeByNameAndSpace(appName st
 		process.GUID,
 		healthCheckType,
 		httpEndpoint,
+		invocationTimeout,
 	)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -19,7 +20,7 @@ var _ = Describe("Process Health Check Actions", func() {
 
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
-		actor = NewActor(fakeCloudControllerClient, nil)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
 	})
 
 	Describe("ProcessHealthChecks", func() {
@@ -131,7 +132,9 @@ var _ = Describe("Process Health Check Actions", func() {
 								HealthCheck: ccv3.ProcessHealthCheck{
 									Type: "health-check-type-1",
 									Data: ccv3.ProcessHealthCheckData{
-										Endpoint: "health-check-endpoint-1",
+										Endpoint:          "health-check-endpoint-1",
+										InvocationTimeout: types.NullInt{Value: 42, IsSet: true},
+										Timeout:           types.NullInt{Value: 120, IsSet: true},
 									},
 								},
 							},
@@ -155,9 +158,11 @@ var _ = Describe("Process Health Check Actions", func() {
 					Expect(warnings).To(Equal(Warnings{"some-warning", "some-process-warning"}))
 					Expect(processHealthChecks).To(Equal([]ProcessHealthCheck{
 						{
-							ProcessType:     "process-type-1",
-							HealthCheckType: "health-check-type-1",
-							Endpoint:        "health-check-endpoint-1",
+							ProcessType:       "process-type-1",
+							HealthCheckType:   "health-check-type-1",
+							Endpoint:          "health-check-endpoint-1",
+							InvocationTimeout: types.NullInt{Value: 42, IsSet: true},
+							Timeout:           types.NullInt{Value: 120, IsSet: true},
 						},
 						{
 							ProcessType:     "process-type-2",
@@ -172,7 +177,7 @@ var _ = Describe("Process Health Check Actions", func() {
 	Describe("SetApplicationProcessHealthCheckTypeByNameAndSpace", func() {
 		Context("when the user specifies an endpoint for a non-http health check", func() {
 			It("returns an HTTPHealthCheckInvalidError", func() {
-				_, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "port", "some-http-endpoint", "some-process-type")
+				_, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "port", "some-http-endpoint", "some-process-type", types.NullInt{})
 				Expect(err).To(MatchError(HTTPHealthCheckInvalidError{}))
 				Expect(warnings).To(BeNil())
 			})
@@ -188,7 +193,7 @@ var _ = Describe("Process Health Check Actions", func() {
 			})
 
 			It("returns the error and warnings", func() {
-				_, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "http", "some-http-endpoint", "some-process-type")
+				_, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "http", "some-http-endpoint", "some-process-type", types.NullInt{})
 				Expect(err).To(Equal(ApplicationNotFoundError{Name: "some-app-name"}))
 				Expect(warnings).To(Equal(Warnings{"some-warning"}))
 			})
@@ -207,7 +212,7 @@ var _ = Describe("Process Health Check Actions", func() {
 			})
 
 			It("returns the error and warnings", func() {
-				_, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "http", "some-http-endpoint", "some-process-type")
+				_, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "http", "some-http-endpoint", "some-process-type", types.NullInt{})
 				Expect(err).To(Equal(expectedErr))
 				Expect(warnings).To(Equal(Warnings{"some-warning"}))
 			})
@@ -241,7 +246,7 @@ var _ = Describe("Process Health Check Actions", func() {
 					})
 
 					It("returns a ProcessNotFoundError and all warnings", func() {
-						_, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "http", "some-http-endpoint", "some-process-type")
+						_, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "http", "some-http-endpoint", "some-process-type", types.NullInt{})
 						Expect(err).To(Equal(ProcessNotFoundError{ProcessType: "some-process-type"}))
 						Expect(warnings).To(Equal(Warnings{"some-warning", "some-process-warning"}))
 					})
@@ -258,7 +263,7 @@ var _ = Describe("Process Health Check Actions", func() {
 					})
 
 					It("returns the error and warnings", func() {
-						_, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "http", "some-http-endpoint", "some-process-type")
+						_, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "http", "some-http-endpoint", "some-process-type", types.NullInt{})
 						Expect(err).To(Equal(expectedErr))
 						Expect(warnings).To(Equal(Warnings{"some-warning", "some-process-warning"}))
 					})
@@ -288,7 +293,7 @@ var _ = Describe("Process Health Check Actions", func() {
 					})
 
 					It("returns the error and warnings", func() {
-						_, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "http", "some-http-endpoint", "some-process-type")
+						_, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "http", "some-http-endpoint", "some-process-type", types.NullInt{})
 						Expect(err).To(Equal(expectedErr))
 						Expect(warnings).To(Equal(Warnings{"some-warning", "some-process-warning", "some-health-check-warning"}))
 					})
@@ -303,7 +308,7 @@ var _ = Describe("Process Health Check Actions", func() {
 					})
 					Context("when the health check type is http", func() {
 						It("returns the application", func() {
-							app, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "http", "some-http-endpoint", "some-process-type")
+							app, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "http", "some-http-endpoint", "some-process-type", types.NullInt{Value: 42, IsSet: true})
 							Expect(err).NotTo(HaveOccurred())
 							Expect(warnings).To(Equal(Warnings{"some-warning", "some-process-warning", "some-health-check-warning"}))
 
@@ -317,15 +322,16 @@ var _ = Describe("Process Health Check Actions", func() {
 							Expect(processType).To(Equal("some-process-type"))
 
 							Expect(fakeCloudControllerClient.PatchApplicationProcessHealthCheckCallCount()).To(Equal(1))
-							processGUID, processHealthCheckType, processHealthCheckEndpoint := fakeCloudControllerClient.PatchApplicationProcessHealthCheckArgsForCall(0)
+							processGUID, processHealthCheckType, processHealthCheckEndpoint, processInvocationTimeout := fakeCloudControllerClient.PatchApplicationProcessHealthCheckArgsForCall(0)
 							Expect(processGUID).To(Equal("some-process-guid"))
 							Expect(processHealthCheckType).To(Equal("http"))
 							Expect(processHealthCheckEndpoint).To(Equal("some-http-endpoint"))
+							Expect(processInvocationTimeout).To(Equal(types.NullInt{Value: 42, IsSet: true}))
 						})
 					})
 					Context("when the health check type is not http", func() {
 						It("does not send the / endpoint and returns the application", func() {
-							app, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "port", "/", "some-process-type")
+							app, warnings, err := actor.SetApplicationProcessHealthCheckTypeByNameAndSpace("some-app-name", "some-space-guid", "port", "/", "some-process-type", types.NullInt{})
 							Expect(err).NotTo(HaveOccurred())
 							Expect(warnings).To(Equal(Warnings{"some-warning", "some-process-warning", "some-health-check-warning"}))
 
@@ -339,10 +345,11 @@ var _ = Describe("Process Health Check Actions", func() {
 							Expect(processType).To(Equal("some-process-type"))
 
 							Expect(fakeCloudControllerClient.PatchApplicationProcessHealthCheckCallCount()).To(Equal(1))
-							processGUID, processHealthCheckType, processHealthCheckEndpoint := fakeCloudControllerClient.PatchApplicationProcessHealthCheckArgsForCall(0)
+							processGUID, processHealthCheckType, processHealthCheckEndpoint, processInvocationTimeout := fakeCloudControllerClient.PatchApplicationProcessHealthCheckArgsForCall(0)
 							Expect(processGUID).To(Equal("some-process-guid"))
 							Expect(processHealthCheckType).To(Equal("port"))
 							Expect(processHealthCheckEndpoint).To(BeEmpty())
+							Expect(processInvocationTimeout).To(Equal(types.NullInt{}))
 						})
 					})
 				})
@@ -22,7 +22,7 @@ var _ = Describe("Process Actions", func() {
 
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
-		actor = NewActor(fakeCloudControllerClient, nil)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
 	})
 
 	Describe("Instance", func() {
@@ -248,4 +248,81 @@ var _ = Describe("Process Actions", func() {
 			})
 		})
 	})
+
+	Describe("SetProcessCommand", func() {
+		var (
+			command    types.FilteredString
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			command = types.FilteredString{IsSet: true, Value: "some-command"}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.SetProcessCommand("some-app-guid", "web", command)
+		})
+
+		Context("when the process exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+					ccv3.Process{GUID: "some-process-guid"},
+					ccv3.Warnings{"get-process-warning"},
+					nil,
+				)
+			})
+
+			Context("when patching the command succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.PatchApplicationProcessCommandReturns(ccv3.Warnings{"patch-warning"}, nil)
+				})
+
+				It("updates the process command and returns all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-process-warning", "patch-warning"))
+
+					Expect(fakeCloudControllerClient.GetApplicationProcessByTypeCallCount()).To(Equal(1))
+					appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+					Expect(processType).To(Equal("web"))
+
+					Expect(fakeCloudControllerClient.PatchApplicationProcessCommandCallCount()).To(Equal(1))
+					processGUID, passedCommand := fakeCloudControllerClient.PatchApplicationProcessCommandArgsForCall(0)
+					Expect(processGUID).To(Equal("some-process-guid"))
+					Expect(passedCommand).To(Equal(command))
+				})
+			})
+
+			Context("when patching the command fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("patch error")
+					fakeCloudControllerClient.PatchApplicationProcessCommandReturns(ccv3.Warnings{"patch-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-process-warning", "patch-warning"))
+				})
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+					ccv3.Process{},
+					ccv3.Warnings{"get-process-warning"},
+					ccerror.ProcessNotFoundError{},
+				)
+			})
+
+			It("returns a ProcessNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(ProcessNotFoundError{ProcessType: "web"}))
+				Expect(warnings).To(ConsistOf("get-process-warning"))
+				Expect(fakeCloudControllerClient.PatchApplicationProcessCommandCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
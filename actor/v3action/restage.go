@@ -0,0 +1,98 @@
+package v3action
+
+import (
+	"fmt"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// ReadyPackageNotFoundError is returned when an application has no package
+// in the READY state to stage.
+type ReadyPackageNotFoundError struct {
+	AppName string
+}
+
+func (e ReadyPackageNotFoundError) Error() string {
+	return fmt.Sprintf("Application %s has no ready package to restage", e.AppName)
+}
+
+// RestageApplication stages the newest ready package of the application,
+// sets the resulting droplet as the application's current droplet and
+// restarts the application if it was running.
+func (actor Actor) RestageApplication(appName string, spaceGUID string) (<-chan Droplet, <-chan Warnings, <-chan error) {
+	dropletStream := make(chan Droplet)
+	warningsStream := make(chan Warnings)
+	errorStream := make(chan error)
+
+	go func() {
+		defer close(dropletStream)
+		defer close(warningsStream)
+		defer close(errorStream)
+
+		droplet, err := actor.restageApplication(appName, spaceGUID, warningsStream)
+		if err != nil {
+			errorStream <- err
+			return
+		}
+
+		dropletStream <- droplet
+	}()
+
+	return dropletStream, warningsStream, errorStream
+}
+
+func (actor Actor) restageApplication(appName string, spaceGUID string, warningsStream chan<- Warnings) (Droplet, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	warningsStream <- warnings
+	if err != nil {
+		return Droplet{}, err
+	}
+
+	packages, ccWarnings, err := actor.CloudControllerClient.GetPackages(url.Values{
+		ccv3.AppGUIDFilter: []string{app.GUID},
+		ccv3.StatesFilter:  []string{string(ccv3.PackageStateReady)},
+		ccv3.OrderBy:       []string{ccv3.CreatedAtDescendingOrder},
+	})
+	warningsStream <- Warnings(ccWarnings)
+	if err != nil {
+		return Droplet{}, err
+	}
+
+	if len(packages) == 0 {
+		return Droplet{}, ReadyPackageNotFoundError{AppName: appName}
+	}
+
+	droplet, err := actor.stagePackage(packages[0].GUID, appName, nil, warningsStream)
+	if err != nil {
+		return Droplet{}, err
+	}
+
+	_, ccWarnings, err = actor.CloudControllerClient.SetApplicationDroplet(app.GUID, droplet.GUID)
+	warningsStream <- Warnings(ccWarnings)
+	if err != nil {
+		if newErr, ok := err.(ccerror.UnprocessableEntityError); ok {
+			return Droplet{}, AssignDropletError{Message: newErr.Message}
+		}
+		return Droplet{}, err
+	}
+
+	if !app.Started() {
+		return droplet, nil
+	}
+
+	ccWarnings, err = actor.CloudControllerClient.StopApplication(app.GUID)
+	warningsStream <- Warnings(ccWarnings)
+	if err != nil {
+		return Droplet{}, err
+	}
+
+	_, ccWarnings, err = actor.CloudControllerClient.StartApplication(app.GUID)
+	warningsStream <- Warnings(ccWarnings)
+	if err != nil {
+		return Droplet{}, err
+	}
+
+	return droplet, nil
+}
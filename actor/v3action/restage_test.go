@@ -0,0 +1,224 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Restage Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		fakeConfig.StagingTimeoutReturns(time.Minute)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+	})
+
+	Describe("RestageApplication", func() {
+		var (
+			dropletStream  <-chan Droplet
+			warningsStream <-chan Warnings
+			errorStream    <-chan error
+		)
+
+		AfterEach(func() {
+			Eventually(errorStream).Should(BeClosed())
+			Eventually(warningsStream).Should(BeClosed())
+			Eventually(dropletStream).Should(BeClosed())
+		})
+
+		JustBeforeEach(func() {
+			dropletStream, warningsStream, errorStream = actor.RestageApplication("some-app", "some-space-guid")
+		})
+
+		Context("when getting the application fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"app-warning"}, errors.New("some-app-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("app-warning")))
+				Eventually(errorStream).Should(Receive(MatchError("some-app-error")))
+				Expect(fakeCloudControllerClient.GetPackagesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the application exists", func() {
+			var appState string
+
+			// RestageApplication starts polling as soon as the outer
+			// JustBeforeEach runs, so the application must be stubbed in a
+			// BeforeEach. The stub reads appState when it is called so that
+			// nested contexts can still change it.
+			BeforeEach(func() {
+				appState = "STARTED"
+				fakeCloudControllerClient.GetApplicationsStub = func(url.Values) ([]ccv3.Application, ccv3.Warnings, error) {
+					return []ccv3.Application{{GUID: "some-app-guid", Name: "some-app", State: appState}},
+						ccv3.Warnings{"app-warning"},
+						nil
+				}
+			})
+
+			Context("when getting the packages fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"packages-warning"}, errors.New("some-packages-error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Eventually(warningsStream).Should(Receive(ConsistOf("app-warning")))
+					Eventually(warningsStream).Should(Receive(ConsistOf("packages-warning")))
+					Eventually(errorStream).Should(Receive(MatchError("some-packages-error")))
+					Expect(fakeCloudControllerClient.CreateBuildCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the application has no ready package", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"packages-warning"}, nil)
+				})
+
+				It("returns a ReadyPackageNotFoundError", func() {
+					Eventually(warningsStream).Should(Receive(ConsistOf("app-warning")))
+					Eventually(warningsStream).Should(Receive(ConsistOf("packages-warning")))
+					Eventually(errorStream).Should(Receive(MatchError(ReadyPackageNotFoundError{AppName: "some-app"})))
+					Expect(fakeCloudControllerClient.CreateBuildCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the application has ready packages", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetPackagesReturns(
+						[]ccv3.Package{{GUID: "newest-package-guid"}, {GUID: "older-package-guid"}},
+						ccv3.Warnings{"packages-warning"},
+						nil,
+					)
+					fakeCloudControllerClient.CreateBuildReturns(ccv3.Build{GUID: "some-build-guid", State: ccv3.BuildStateStaging}, ccv3.Warnings{"create-build-warning"}, nil)
+				})
+
+				Context("when staging fails", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetBuildReturns(ccv3.Build{GUID: "some-build-guid", State: ccv3.BuildStateFailed, Error: "some staging error"}, ccv3.Warnings{"get-build-warning"}, nil)
+					})
+
+					It("returns the error and does not set the droplet", func() {
+						Eventually(warningsStream).Should(Receive(ConsistOf("app-warning")))
+						Eventually(warningsStream).Should(Receive(ConsistOf("packages-warning")))
+						Eventually(warningsStream).Should(Receive(ConsistOf("create-build-warning")))
+						Eventually(warningsStream).Should(Receive(ConsistOf("get-build-warning")))
+						Eventually(errorStream).Should(Receive(MatchError("some staging error")))
+						Expect(fakeCloudControllerClient.SetApplicationDropletCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when staging succeeds", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetBuildReturns(ccv3.Build{GUID: "some-build-guid", State: ccv3.BuildStateStaged, DropletGUID: "some-droplet-guid", CreatedAt: "some-time"}, ccv3.Warnings{"get-build-warning"}, nil)
+					})
+
+					Context("when setting the droplet fails", func() {
+						BeforeEach(func() {
+							fakeCloudControllerClient.SetApplicationDropletReturns(ccv3.Relationship{}, ccv3.Warnings{"set-droplet-warning"}, ccerror.UnprocessableEntityError{Message: "some-message"})
+						})
+
+						It("returns an AssignDropletError and warnings", func() {
+							Eventually(warningsStream).Should(Receive(ConsistOf("app-warning")))
+							Eventually(warningsStream).Should(Receive(ConsistOf("packages-warning")))
+							Eventually(warningsStream).Should(Receive(ConsistOf("create-build-warning")))
+							Eventually(warningsStream).Should(Receive(ConsistOf("get-build-warning")))
+							Eventually(warningsStream).Should(Receive(ConsistOf("set-droplet-warning")))
+							Eventually(errorStream).Should(Receive(MatchError(AssignDropletError{Message: "some-message"})))
+							Expect(fakeCloudControllerClient.StopApplicationCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when setting the droplet succeeds", func() {
+						BeforeEach(func() {
+							fakeCloudControllerClient.SetApplicationDropletReturns(ccv3.Relationship{}, ccv3.Warnings{"set-droplet-warning"}, nil)
+							fakeCloudControllerClient.StopApplicationReturns(ccv3.Warnings{"stop-warning"}, nil)
+							fakeCloudControllerClient.StartApplicationReturns(ccv3.Application{}, ccv3.Warnings{"start-warning"}, nil)
+						})
+
+						It("stages the newest ready package, sets the droplet and restarts the app", func() {
+							Eventually(warningsStream).Should(Receive(ConsistOf("app-warning")))
+							Eventually(warningsStream).Should(Receive(ConsistOf("packages-warning")))
+							Eventually(warningsStream).Should(Receive(ConsistOf("create-build-warning")))
+							Eventually(warningsStream).Should(Receive(ConsistOf("get-build-warning")))
+							Eventually(warningsStream).Should(Receive(ConsistOf("set-droplet-warning")))
+							Eventually(warningsStream).Should(Receive(ConsistOf("stop-warning")))
+							Eventually(warningsStream).Should(Receive(ConsistOf("start-warning")))
+							Eventually(dropletStream).Should(Receive(Equal(Droplet{GUID: "some-droplet-guid", State: DropletState(ccv3.BuildStateStaged), CreatedAt: "some-time"})))
+
+							Expect(fakeCloudControllerClient.GetPackagesCallCount()).To(Equal(1))
+							Expect(fakeCloudControllerClient.GetPackagesArgsForCall(0)).To(Equal(url.Values{
+								ccv3.AppGUIDFilter: []string{"some-app-guid"},
+								ccv3.StatesFilter:  []string{"READY"},
+								ccv3.OrderBy:       []string{"-created_at"},
+							}))
+
+							Expect(fakeCloudControllerClient.CreateBuildCallCount()).To(Equal(1))
+							Expect(fakeCloudControllerClient.CreateBuildArgsForCall(0)).To(Equal(ccv3.Build{PackageGUID: "newest-package-guid"}))
+
+							Expect(fakeCloudControllerClient.SetApplicationDropletCallCount()).To(Equal(1))
+							appGUID, dropletGUID := fakeCloudControllerClient.SetApplicationDropletArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(dropletGUID).To(Equal("some-droplet-guid"))
+
+							Expect(fakeCloudControllerClient.StopApplicationCallCount()).To(Equal(1))
+							Expect(fakeCloudControllerClient.StopApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+							Expect(fakeCloudControllerClient.StartApplicationCallCount()).To(Equal(1))
+							Expect(fakeCloudControllerClient.StartApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+						})
+
+						Context("when the application is stopped", func() {
+							BeforeEach(func() {
+								appState = "STOPPED"
+							})
+
+							It("sets the droplet without starting the app", func() {
+								Eventually(warningsStream).Should(Receive(ConsistOf("app-warning")))
+								Eventually(warningsStream).Should(Receive(ConsistOf("packages-warning")))
+								Eventually(warningsStream).Should(Receive(ConsistOf("create-build-warning")))
+								Eventually(warningsStream).Should(Receive(ConsistOf("get-build-warning")))
+								Eventually(warningsStream).Should(Receive(ConsistOf("set-droplet-warning")))
+								Eventually(dropletStream).Should(Receive())
+
+								Expect(fakeCloudControllerClient.StopApplicationCallCount()).To(Equal(0))
+								Expect(fakeCloudControllerClient.StartApplicationCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when restarting the app fails", func() {
+							BeforeEach(func() {
+								fakeCloudControllerClient.StartApplicationReturns(ccv3.Application{}, ccv3.Warnings{"start-warning"}, errors.New("some-start-error"))
+							})
+
+							It("returns the error and warnings", func() {
+								Eventually(warningsStream).Should(Receive(ConsistOf("app-warning")))
+								Eventually(warningsStream).Should(Receive(ConsistOf("packages-warning")))
+								Eventually(warningsStream).Should(Receive(ConsistOf("create-build-warning")))
+								Eventually(warningsStream).Should(Receive(ConsistOf("get-build-warning")))
+								Eventually(warningsStream).Should(Receive(ConsistOf("set-droplet-warning")))
+								Eventually(warningsStream).Should(Receive(ConsistOf("stop-warning")))
+								Eventually(warningsStream).Should(Receive(ConsistOf("start-warning")))
+								Eventually(errorStream).Should(Receive(MatchError("some-start-error")))
+							})
+						})
+					})
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,113 @@
+package v3action
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Revision is a snapshot of an application's droplet and configuration.
+type Revision struct {
+	GUID        string
+	Version     int
+	Description string
+	DropletGUID string
+	Deployable  bool
+	CreatedAt   string
+	// Deployed is true when the application's running instances were
+	// deployed with the revision.
+	Deployed bool
+}
+
+// RevisionNotFoundError is returned when an application has no revision with
+// the requested version.
+type RevisionNotFoundError struct {
+	Version int
+}
+
+func (e RevisionNotFoundError) Error() string {
+	return fmt.Sprintf("Revision %d not found", e.Version)
+}
+
+// RevisionNotDeployableError is returned when rolling back to a revision
+// whose droplet no longer exists.
+type RevisionNotDeployableError struct {
+	Version int
+}
+
+func (e RevisionNotDeployableError) Error() string {
+	return fmt.Sprintf("Revision %d cannot be deployed", e.Version)
+}
+
+// GetRevisionsByApplication returns the application's revisions, newest
+// first, marking the revisions its running instances were deployed with.
+func (actor Actor) GetRevisionsByApplication(appGUID string) ([]Revision, Warnings, error) {
+	ccv3Revisions, warnings, err := actor.CloudControllerClient.GetApplicationRevisions(appGUID, url.Values{
+		ccv3.OrderBy: []string{ccv3.CreatedAtDescendingOrder},
+	})
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	deployedRevisions, warnings, err := actor.CloudControllerClient.GetApplicationDeployedRevisions(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	deployed := map[string]bool{}
+	for _, revision := range deployedRevisions {
+		deployed[revision.GUID] = true
+	}
+
+	var revisions []Revision
+	for _, ccv3Revision := range ccv3Revisions {
+		revision := actor.convertCCToActorRevision(ccv3Revision)
+		revision.Deployed = deployed[revision.GUID]
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, allWarnings, nil
+}
+
+// GetRevisionByApplicationAndVersion returns the application's revision with
+// the provided version.
+func (actor Actor) GetRevisionByApplicationAndVersion(appGUID string, version int) (Revision, Warnings, error) {
+	ccv3Revisions, warnings, err := actor.CloudControllerClient.GetApplicationRevisions(appGUID, url.Values{
+		ccv3.VersionsFilter: []string{strconv.Itoa(version)},
+	})
+	if err != nil {
+		return Revision{}, Warnings(warnings), err
+	}
+
+	if len(ccv3Revisions) == 0 {
+		return Revision{}, Warnings(warnings), RevisionNotFoundError{Version: version}
+	}
+
+	return actor.convertCCToActorRevision(ccv3Revisions[0]), Warnings(warnings), nil
+}
+
+// CreateDeploymentByRevision starts a rolling deployment of the revision's
+// droplet and configuration to the application.
+func (actor Actor) CreateDeploymentByRevision(appGUID string, revision Revision) (Deployment, Warnings, error) {
+	if !revision.Deployable {
+		return Deployment{}, nil, RevisionNotDeployableError{Version: revision.Version}
+	}
+
+	deployment, warnings, err := actor.CloudControllerClient.CreateApplicationDeploymentByRevision(appGUID, revision.GUID)
+	return Deployment(deployment), Warnings(warnings), err
+}
+
+func (actor Actor) convertCCToActorRevision(revision ccv3.Revision) Revision {
+	return Revision{
+		GUID:        revision.GUID,
+		Version:     revision.Version,
+		Description: revision.Description,
+		DropletGUID: revision.DropletGUID,
+		Deployable:  revision.Deployable,
+		CreatedAt:   revision.CreatedAt,
+	}
+}
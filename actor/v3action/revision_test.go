@@ -0,0 +1,190 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Revision Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetRevisionsByApplication", func() {
+		var (
+			revisions  []Revision
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			revisions, warnings, executeErr = actor.GetRevisionsByApplication("some-app-guid")
+		})
+
+		Context("when getting the revisions succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(
+					[]ccv3.Revision{
+						{GUID: "revision-guid-2", Version: 2, Deployable: true, DropletGUID: "droplet-guid-2"},
+						{GUID: "revision-guid-1", Version: 1, Description: "Initial revision."},
+					},
+					ccv3.Warnings{"get-revisions-warning"},
+					nil)
+				fakeCloudControllerClient.GetApplicationDeployedRevisionsReturns(
+					[]ccv3.Revision{{GUID: "revision-guid-2"}},
+					ccv3.Warnings{"get-deployed-revisions-warning"},
+					nil)
+			})
+
+			It("returns the revisions newest first, marking the deployed ones", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-revisions-warning", "get-deployed-revisions-warning"))
+				Expect(revisions).To(Equal([]Revision{
+					{GUID: "revision-guid-2", Version: 2, Deployable: true, DropletGUID: "droplet-guid-2", Deployed: true},
+					{GUID: "revision-guid-1", Version: 1, Description: "Initial revision."},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationRevisionsCallCount()).To(Equal(1))
+				appGUID, query := fakeCloudControllerClient.GetApplicationRevisionsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(Equal(url.Values{ccv3.OrderBy: []string{ccv3.CreatedAtDescendingOrder}}))
+
+				Expect(fakeCloudControllerClient.GetApplicationDeployedRevisionsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationDeployedRevisionsArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when getting the revisions fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get revisions error")
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(nil, ccv3.Warnings{"get-revisions-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-revisions-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationDeployedRevisionsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the deployed revisions fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get deployed revisions error")
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(nil, ccv3.Warnings{"get-revisions-warning"}, nil)
+				fakeCloudControllerClient.GetApplicationDeployedRevisionsReturns(nil, ccv3.Warnings{"get-deployed-revisions-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-revisions-warning", "get-deployed-revisions-warning"))
+			})
+		})
+	})
+
+	Describe("GetRevisionByApplicationAndVersion", func() {
+		var (
+			revision   Revision
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			revision, warnings, executeErr = actor.GetRevisionByApplicationAndVersion("some-app-guid", 3)
+		})
+
+		Context("when the revision exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(
+					[]ccv3.Revision{{GUID: "revision-guid-3", Version: 3, Deployable: true}},
+					ccv3.Warnings{"get-revisions-warning"},
+					nil)
+			})
+
+			It("returns the revision and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-revisions-warning"))
+				Expect(revision).To(Equal(Revision{GUID: "revision-guid-3", Version: 3, Deployable: true}))
+
+				appGUID, query := fakeCloudControllerClient.GetApplicationRevisionsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(Equal(url.Values{ccv3.VersionsFilter: []string{"3"}}))
+			})
+		})
+
+		Context("when the revision does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(nil, ccv3.Warnings{"get-revisions-warning"}, nil)
+			})
+
+			It("returns a RevisionNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(RevisionNotFoundError{Version: 3}))
+				Expect(warnings).To(ConsistOf("get-revisions-warning"))
+			})
+		})
+	})
+
+	Describe("CreateDeploymentByRevision", func() {
+		var (
+			revision   Revision
+			deployment Deployment
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			revision = Revision{GUID: "revision-guid-3", Version: 3, Deployable: true}
+		})
+
+		JustBeforeEach(func() {
+			deployment, warnings, executeErr = actor.CreateDeploymentByRevision("some-app-guid", revision)
+		})
+
+		Context("when creating the deployment succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateApplicationDeploymentByRevisionReturns(
+					ccv3.Deployment{GUID: "some-deployment-guid"},
+					ccv3.Warnings{"create-warning"},
+					nil)
+			})
+
+			It("deploys the revision and returns the deployment and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(deployment).To(Equal(Deployment{GUID: "some-deployment-guid"}))
+
+				Expect(fakeCloudControllerClient.CreateApplicationDeploymentByRevisionCallCount()).To(Equal(1))
+				appGUID, revisionGUID := fakeCloudControllerClient.CreateApplicationDeploymentByRevisionArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(revisionGUID).To(Equal("revision-guid-3"))
+			})
+		})
+
+		Context("when the revision is not deployable", func() {
+			BeforeEach(func() {
+				revision.Deployable = false
+			})
+
+			It("returns a RevisionNotDeployableError without creating a deployment", func() {
+				Expect(executeErr).To(MatchError(RevisionNotDeployableError{Version: 3}))
+				Expect(fakeCloudControllerClient.CreateApplicationDeploymentByRevisionCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
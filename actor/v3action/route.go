@@ -0,0 +1,166 @@
+package v3action
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// DomainNotFoundError is returned when a requested domain does not exist.
+type DomainNotFoundError struct {
+	Name string
+}
+
+func (e DomainNotFoundError) Error() string {
+	return fmt.Sprintf("Domain %s not found", e.Name)
+}
+
+// RouteNotFoundError is returned when a requested route does not exist.
+type RouteNotFoundError struct {
+	Host       string
+	DomainName string
+	Path       string
+}
+
+func (e RouteNotFoundError) Error() string {
+	return fmt.Sprintf("Route %s not found", RouteURL(e.Host, e.DomainName, e.Path))
+}
+
+// RouteURL returns the URL of the route with the given host, domain and path.
+func RouteURL(host string, domainName string, path string) string {
+	routeURL := domainName
+	if host != "" {
+		routeURL = fmt.Sprintf("%s.%s", host, domainName)
+	}
+	return routeURL + normalizeRoutePath(path)
+}
+
+// MapRouteToApplication sends the traffic of the route with the given host,
+// domain and path to the process of the application, creating the route in
+// the space if it does not exist. When weight is set, the process receives
+// that share of the route's traffic relative to the route's other
+// destinations.
+func (actor Actor) MapRouteToApplication(appName string, spaceGUID string, domainName string, host string, path string, processType string, weight types.NullInt) (Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	domain, warnings, err := actor.getDomainByName(domainName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	route, warnings, err := actor.getRoute(domain, host, path)
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(RouteNotFoundError); ok {
+		var ccWarnings ccv3.Warnings
+		route, ccWarnings, err = actor.CloudControllerClient.CreateRoute(ccv3.Route{
+			Host:       host,
+			Path:       normalizeRoutePath(path),
+			SpaceGUID:  spaceGUID,
+			DomainGUID: domain.GUID,
+		})
+		allWarnings = append(allWarnings, ccWarnings...)
+	}
+	if err != nil {
+		return allWarnings, err
+	}
+
+	ccWarnings, err := actor.CloudControllerClient.MapRouteDestination(route.GUID, ccv3.RouteDestination{
+		AppGUID:     app.GUID,
+		ProcessType: processType,
+		Weight:      weight,
+	})
+	allWarnings = append(allWarnings, ccWarnings...)
+	return allWarnings, err
+}
+
+// UnmapRouteFromApplication stops sending the traffic of the route with the
+// given host, domain and path to the process of the application. Nothing is
+// done if the route is not mapped to the process.
+func (actor Actor) UnmapRouteFromApplication(appName string, spaceGUID string, domainName string, host string, path string, processType string) (Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	domain, warnings, err := actor.getDomainByName(domainName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	route, warnings, err := actor.getRoute(domain, host, path)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	destinations, ccWarnings, err := actor.CloudControllerClient.GetRouteDestinations(route.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	for _, destination := range destinations {
+		if destination.AppGUID != app.GUID || destination.ProcessType != processType {
+			continue
+		}
+
+		ccWarnings, err = actor.CloudControllerClient.UnmapRouteDestination(route.GUID, destination.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (actor Actor) getDomainByName(domainName string) (ccv3.Domain, Warnings, error) {
+	domains, warnings, err := actor.CloudControllerClient.GetDomains(url.Values{
+		ccv3.NameFilter: []string{domainName},
+	})
+	if err != nil {
+		return ccv3.Domain{}, Warnings(warnings), err
+	}
+
+	if len(domains) == 0 {
+		return ccv3.Domain{}, Warnings(warnings), DomainNotFoundError{Name: domainName}
+	}
+
+	return domains[0], Warnings(warnings), nil
+}
+
+func (actor Actor) getRoute(domain ccv3.Domain, host string, path string) (ccv3.Route, Warnings, error) {
+	routes, warnings, err := actor.CloudControllerClient.GetRoutes(url.Values{
+		ccv3.DomainGUIDFilter: []string{domain.GUID},
+		ccv3.HostsFilter:      []string{host},
+		ccv3.PathsFilter:      []string{normalizeRoutePath(path)},
+	})
+	if err != nil {
+		return ccv3.Route{}, Warnings(warnings), err
+	}
+
+	if len(routes) == 0 {
+		return ccv3.Route{}, Warnings(warnings), RouteNotFoundError{
+			Host:       host,
+			DomainName: domain.Name,
+			Path:       path,
+		}
+	}
+
+	return routes[0], Warnings(warnings), nil
+}
+
+func normalizeRoutePath(path string) string {
+	if path != "" && !strings.HasPrefix(path, "/") {
+		return "/" + path
+	}
+	return path
+}
@@ -0,0 +1,226 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+		fakeCloudControllerClient.GetApplicationsReturns(
+			[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}},
+			ccv3.Warnings{"app-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetDomainsReturns(
+			[]ccv3.Domain{{Name: "some-domain.com", GUID: "some-domain-guid"}},
+			ccv3.Warnings{"domain-warning"},
+			nil,
+		)
+	})
+
+	Describe("RouteURL", func() {
+		It("joins the host, domain and path", func() {
+			Expect(RouteURL("some-host", "some-domain.com", "some-path")).To(Equal("some-host.some-domain.com/some-path"))
+			Expect(RouteURL("", "some-domain.com", "/some-path")).To(Equal("some-domain.com/some-path"))
+			Expect(RouteURL("some-host", "some-domain.com", "")).To(Equal("some-host.some-domain.com"))
+		})
+	})
+
+	Describe("MapRouteToApplication", func() {
+		var (
+			weight     types.NullInt
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			weight = types.NullInt{Value: 20, IsSet: true}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.MapRouteToApplication("some-app", "some-space-guid", "some-domain.com", "some-host", "some-path", "web", weight)
+		})
+
+		Context("when the route exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]ccv3.Route{{GUID: "some-route-guid"}},
+					ccv3.Warnings{"route-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.MapRouteDestinationReturns(ccv3.Warnings{"map-warning"}, nil)
+			})
+
+			It("adds the app process as a destination of the route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("app-warning", "domain-warning", "route-warning", "map-warning"))
+
+				Expect(fakeCloudControllerClient.GetDomainsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetDomainsArgsForCall(0)).To(Equal(url.Values{
+					ccv3.NameFilter: []string{"some-domain.com"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal(url.Values{
+					ccv3.DomainGUIDFilter: []string{"some-domain-guid"},
+					ccv3.HostsFilter:      []string{"some-host"},
+					ccv3.PathsFilter:      []string{"/some-path"},
+				}))
+
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(0))
+
+				Expect(fakeCloudControllerClient.MapRouteDestinationCallCount()).To(Equal(1))
+				routeGUID, destination := fakeCloudControllerClient.MapRouteDestinationArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid"))
+				Expect(destination).To(Equal(ccv3.RouteDestination{
+					AppGUID:     "some-app-guid",
+					ProcessType: "web",
+					Weight:      types.NullInt{Value: 20, IsSet: true},
+				}))
+			})
+		})
+
+		Context("when the route does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"route-warning"}, nil)
+				fakeCloudControllerClient.CreateRouteReturns(ccv3.Route{GUID: "new-route-guid"}, ccv3.Warnings{"create-warning"}, nil)
+			})
+
+			It("creates the route in the space and maps it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("app-warning", "domain-warning", "route-warning", "create-warning"))
+
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CreateRouteArgsForCall(0)).To(Equal(ccv3.Route{
+					Host:       "some-host",
+					Path:       "/some-path",
+					SpaceGUID:  "some-space-guid",
+					DomainGUID: "some-domain-guid",
+				}))
+
+				Expect(fakeCloudControllerClient.MapRouteDestinationCallCount()).To(Equal(1))
+				routeGUID, _ := fakeCloudControllerClient.MapRouteDestinationArgsForCall(0)
+				Expect(routeGUID).To(Equal("new-route-guid"))
+			})
+
+			Context("when creating the route fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreateRouteReturns(ccv3.Route{}, ccv3.Warnings{"create-warning"}, errors.New("create-error"))
+				})
+
+				It("returns the error and does not map the route", func() {
+					Expect(executeErr).To(MatchError("create-error"))
+					Expect(warnings).To(ConsistOf("app-warning", "domain-warning", "route-warning", "create-warning"))
+					Expect(fakeCloudControllerClient.MapRouteDestinationCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the domain does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDomainsReturns(nil, ccv3.Warnings{"domain-warning"}, nil)
+			})
+
+			It("returns a DomainNotFoundError", func() {
+				Expect(executeErr).To(MatchError(DomainNotFoundError{Name: "some-domain.com"}))
+				Expect(warnings).To(ConsistOf("app-warning", "domain-warning"))
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("app-warning"))
+				Expect(fakeCloudControllerClient.GetDomainsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UnmapRouteFromApplication", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UnmapRouteFromApplication("some-app", "some-space-guid", "some-domain.com", "some-host", "", "web")
+		})
+
+		Context("when the route exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]ccv3.Route{{GUID: "some-route-guid"}},
+					ccv3.Warnings{"route-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetRouteDestinationsReturns(
+					[]ccv3.RouteDestination{
+						{GUID: "destination-guid-1", AppGUID: "some-app-guid", ProcessType: "web"},
+						{GUID: "destination-guid-2", AppGUID: "some-app-guid", ProcessType: "worker"},
+						{GUID: "destination-guid-3", AppGUID: "other-app-guid", ProcessType: "web"},
+					},
+					ccv3.Warnings{"destinations-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UnmapRouteDestinationReturns(ccv3.Warnings{"unmap-warning"}, nil)
+			})
+
+			It("removes only the destination of the app process", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("app-warning", "domain-warning", "route-warning", "destinations-warning", "unmap-warning"))
+
+				Expect(fakeCloudControllerClient.GetRouteDestinationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetRouteDestinationsArgsForCall(0)).To(Equal("some-route-guid"))
+
+				Expect(fakeCloudControllerClient.UnmapRouteDestinationCallCount()).To(Equal(1))
+				routeGUID, destinationGUID := fakeCloudControllerClient.UnmapRouteDestinationArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid"))
+				Expect(destinationGUID).To(Equal("destination-guid-1"))
+			})
+
+			Context("when unmapping fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UnmapRouteDestinationReturns(ccv3.Warnings{"unmap-warning"}, errors.New("unmap-error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("unmap-error"))
+					Expect(warnings).To(ConsistOf("app-warning", "domain-warning", "route-warning", "destinations-warning", "unmap-warning"))
+				})
+			})
+		})
+
+		Context("when the route does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"route-warning"}, nil)
+			})
+
+			It("returns a RouteNotFoundError", func() {
+				Expect(executeErr).To(MatchError(RouteNotFoundError{Host: "some-host", DomainName: "some-domain.com"}))
+				Expect(warnings).To(ConsistOf("app-warning", "domain-warning", "route-warning"))
+				Expect(fakeCloudControllerClient.GetRouteDestinationsCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,80 @@
+package v3action
+
+import (
+	"fmt"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// ServiceInstance represents a V3 actor service instance.
+type ServiceInstance ccv3.ServiceInstance
+
+// ServiceInstanceNotFoundError is returned when a service instance cannot be
+// found.
+type ServiceInstanceNotFoundError struct {
+	Name string
+}
+
+func (e ServiceInstanceNotFoundError) Error() string {
+	return fmt.Sprintf("Service instance '%s' not found.", e.Name)
+}
+
+// GetServiceInstanceByNameAndSpace returns the service instance with the
+// given name in the given space.
+func (actor Actor) GetServiceInstanceByNameAndSpace(serviceInstanceName string, spaceGUID string) (ServiceInstance, Warnings, error) {
+	serviceInstances, warnings, err := actor.CloudControllerClient.GetServiceInstances(url.Values{
+		ccv3.NameFilter:      []string{serviceInstanceName},
+		ccv3.SpaceGUIDFilter: []string{spaceGUID},
+	})
+	if err != nil {
+		return ServiceInstance{}, Warnings(warnings), err
+	}
+
+	if len(serviceInstances) == 0 {
+		return ServiceInstance{}, Warnings(warnings), ServiceInstanceNotFoundError{Name: serviceInstanceName}
+	}
+
+	return ServiceInstance(serviceInstances[0]), Warnings(warnings), nil
+}
+
+// ShareServiceInstanceToSpace shares the named service instance in the
+// source space into the named space of the given organization.
+func (actor Actor) ShareServiceInstanceToSpace(serviceInstanceName string, sourceSpaceGUID string, sharedToOrgGUID string, sharedToSpaceName string) (Warnings, error) {
+	serviceInstance, sharedToSpace, allWarnings, err := actor.getServiceInstanceAndSharedToSpace(serviceInstanceName, sourceSpaceGUID, sharedToOrgGUID, sharedToSpaceName)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, apiWarnings, err := actor.CloudControllerClient.ShareServiceInstanceToSpaces(serviceInstance.GUID, []string{sharedToSpace.GUID})
+	allWarnings = append(allWarnings, apiWarnings...)
+	return allWarnings, err
+}
+
+// UnshareServiceInstanceFromSpace stops sharing the named service instance
+// in the source space with the named space of the given organization.
+func (actor Actor) UnshareServiceInstanceFromSpace(serviceInstanceName string, sourceSpaceGUID string, sharedToOrgGUID string, sharedToSpaceName string) (Warnings, error) {
+	serviceInstance, sharedToSpace, allWarnings, err := actor.getServiceInstanceAndSharedToSpace(serviceInstanceName, sourceSpaceGUID, sharedToOrgGUID, sharedToSpaceName)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	apiWarnings, err := actor.CloudControllerClient.DeleteServiceInstanceRelationshipsSharedSpace(serviceInstance.GUID, sharedToSpace.GUID)
+	allWarnings = append(allWarnings, apiWarnings...)
+	return allWarnings, err
+}
+
+func (actor Actor) getServiceInstanceAndSharedToSpace(serviceInstanceName string, sourceSpaceGUID string, sharedToOrgGUID string, sharedToSpaceName string) (ServiceInstance, Space, Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, sourceSpaceGUID)
+	if err != nil {
+		return ServiceInstance{}, Space{}, allWarnings, err
+	}
+
+	sharedToSpace, warnings, err := actor.GetSpaceByNameAndOrganization(sharedToSpaceName, sharedToOrgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstance{}, Space{}, allWarnings, err
+	}
+
+	return serviceInstance, sharedToSpace, allWarnings, nil
+}
@@ -0,0 +1,209 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetServiceInstanceByNameAndSpace", func() {
+		var (
+			serviceInstance ServiceInstance
+			warnings        Warnings
+			executeErr      error
+		)
+
+		JustBeforeEach(func() {
+			serviceInstance, warnings, executeErr = actor.GetServiceInstanceByNameAndSpace("some-service-instance", "some-space-guid")
+		})
+
+		Context("when the service instance exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]ccv3.ServiceInstance{{Name: "some-service-instance", GUID: "some-service-instance-guid"}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the service instance and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(serviceInstance).To(Equal(ServiceInstance{Name: "some-service-instance", GUID: "some-service-instance-guid"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(Equal(url.Values{
+					ccv3.NameFilter:      []string{"some-service-instance"},
+					ccv3.SpaceGUIDFilter: []string{"some-space-guid"},
+				}))
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.Warnings{"some-warning"}, nil)
+			})
+
+			It("returns a ServiceInstanceNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get service instances error")
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.Warnings{"some-warning"}, expectedErr)
+			})
+
+			It("returns the error and the warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("sharing", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]ccv3.ServiceInstance{{Name: "some-service-instance", GUID: "some-service-instance-guid"}},
+				ccv3.Warnings{"get-service-instance-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv3.Space{{Name: "some-other-space", GUID: "some-other-space-guid"}},
+				ccv3.Warnings{"get-space-warning"},
+				nil,
+			)
+		})
+
+		Describe("ShareServiceInstanceToSpace", func() {
+			var (
+				warnings   Warnings
+				executeErr error
+			)
+
+			BeforeEach(func() {
+				fakeCloudControllerClient.ShareServiceInstanceToSpacesReturns(
+					ccv3.RelationshipList{GUIDs: []string{"some-other-space-guid"}},
+					ccv3.Warnings{"share-warning"},
+					nil,
+				)
+			})
+
+			JustBeforeEach(func() {
+				warnings, executeErr = actor.ShareServiceInstanceToSpace("some-service-instance", "some-space-guid", "some-other-org-guid", "some-other-space")
+			})
+
+			It("shares the service instance into the space and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-service-instance-warning", "get-space-warning", "share-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal(url.Values{
+					ccv3.NameFilter:             []string{"some-other-space"},
+					ccv3.OrganizationGUIDFilter: []string{"some-other-org-guid"},
+				}))
+
+				Expect(fakeCloudControllerClient.ShareServiceInstanceToSpacesCallCount()).To(Equal(1))
+				serviceInstanceGUID, spaceGUIDs := fakeCloudControllerClient.ShareServiceInstanceToSpacesArgsForCall(0)
+				Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+				Expect(spaceGUIDs).To(Equal([]string{"some-other-space-guid"}))
+			})
+
+			Context("when the service instance does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.Warnings{"get-service-instance-warning"}, nil)
+				})
+
+				It("returns a ServiceInstanceNotFoundError and the warnings", func() {
+					Expect(executeErr).To(MatchError(ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+					Expect(warnings).To(ConsistOf("get-service-instance-warning"))
+					Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the space does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"get-space-warning"}, nil)
+				})
+
+				It("returns a SpaceNotFoundError and the warnings", func() {
+					Expect(executeErr).To(MatchError(SpaceNotFoundError{Name: "some-other-space"}))
+					Expect(warnings).To(ConsistOf("get-service-instance-warning", "get-space-warning"))
+					Expect(fakeCloudControllerClient.ShareServiceInstanceToSpacesCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when sharing fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("share error")
+					fakeCloudControllerClient.ShareServiceInstanceToSpacesReturns(ccv3.RelationshipList{}, ccv3.Warnings{"share-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-service-instance-warning", "get-space-warning", "share-warning"))
+				})
+			})
+		})
+
+		Describe("UnshareServiceInstanceFromSpace", func() {
+			var (
+				warnings   Warnings
+				executeErr error
+			)
+
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceInstanceRelationshipsSharedSpaceReturns(ccv3.Warnings{"unshare-warning"}, nil)
+			})
+
+			JustBeforeEach(func() {
+				warnings, executeErr = actor.UnshareServiceInstanceFromSpace("some-service-instance", "some-space-guid", "some-other-org-guid", "some-other-space")
+			})
+
+			It("unshares the service instance from the space and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-service-instance-warning", "get-space-warning", "unshare-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceRelationshipsSharedSpaceCallCount()).To(Equal(1))
+				serviceInstanceGUID, spaceGUID := fakeCloudControllerClient.DeleteServiceInstanceRelationshipsSharedSpaceArgsForCall(0)
+				Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+				Expect(spaceGUID).To(Equal("some-other-space-guid"))
+			})
+
+			Context("when unsharing fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("unshare error")
+					fakeCloudControllerClient.DeleteServiceInstanceRelationshipsSharedSpaceReturns(ccv3.Warnings{"unshare-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-service-instance-warning", "get-space-warning", "unshare-warning"))
+				})
+			})
+		})
+	})
+})
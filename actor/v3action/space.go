@@ -1,5 +1,66 @@
 package v3action
 
+import (
+	"fmt"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Space represents a V3 actor space.
+type Space ccv3.Space
+
+// SpaceNotFoundError represents the error that occurs when the space is not
+// found.
+type SpaceNotFoundError struct {
+	Name string
+}
+
+func (e SpaceNotFoundError) Error() string {
+	return fmt.Sprintf("Space '%s' not found.", e.Name)
+}
+
+// GetSpaceByNameAndOrganization returns the space with the given name in the
+// given organization. Found spaces are cached for the lifetime of the actor.
+func (actor Actor) GetSpaceByNameAndOrganization(spaceName string, orgGUID string) (Space, Warnings, error) {
+	if space, found := actor.nameCache.loadSpace(orgGUID, spaceName); found {
+		return space, nil, nil
+	}
+
+	spaces, warnings, err := actor.CloudControllerClient.GetSpaces(url.Values{
+		ccv3.NameFilter:             []string{spaceName},
+		ccv3.OrganizationGUIDFilter: []string{orgGUID},
+	})
+	if err != nil {
+		return Space{}, Warnings(warnings), err
+	}
+
+	if len(spaces) == 0 {
+		return Space{}, Warnings(warnings), SpaceNotFoundError{Name: spaceName}
+	}
+
+	space := Space(spaces[0])
+	actor.nameCache.saveSpace(orgGUID, spaceName, space)
+	return space, Warnings(warnings), nil
+}
+
+// GetOrganizationAndSpaceByName validates that the named space exists in the
+// named organization, returning both.
+func (actor Actor) GetOrganizationAndSpaceByName(orgName string, spaceName string) (Organization, Space, Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return Organization{}, Space{}, allWarnings, err
+	}
+
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Organization{}, Space{}, allWarnings, err
+	}
+
+	return org, space, allWarnings, nil
+}
+
 // ResetSpaceIsolationSegment disassociates a space from an isolation segment.
 //
 // If the space's organization has a default isolation segment, return its
@@ -2,6 +2,7 @@ package v3action_test
 
 import (
 	"errors"
+	"net/url"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -19,7 +20,144 @@ var _ = Describe("Space", func() {
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
 		fakeConfig := new(v3actionfakes.FakeConfig)
-		actor = NewActor(fakeCloudControllerClient, fakeConfig)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+	})
+
+	Describe("GetSpaceByNameAndOrganization", func() {
+		var (
+			space      Space
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			space, warnings, executeErr = actor.GetSpaceByNameAndOrganization("some-space-name", "some-org-guid")
+		})
+
+		Context("when the space exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv3.Space{{Name: "some-space-name", GUID: "some-space-guid"}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the space and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(space).To(Equal(Space{Name: "some-space-name", GUID: "some-space-guid"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal(url.Values{
+					ccv3.NameFilter:             []string{"some-space-name"},
+					ccv3.OrganizationGUIDFilter: []string{"some-org-guid"},
+				}))
+			})
+
+			It("caches the space for subsequent lookups", func() {
+				cachedSpace, cachedWarnings, err := actor.GetSpaceByNameAndOrganization("some-space-name", "some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cachedSpace).To(Equal(space))
+				Expect(cachedWarnings).To(BeEmpty())
+
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"some-warning"}, nil)
+			})
+
+			It("returns a SpaceNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(SpaceNotFoundError{Name: "some-space-name"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get spaces error")
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"some-warning"}, expectedErr)
+			})
+
+			It("returns the error and the warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("GetOrganizationAndSpaceByName", func() {
+		var (
+			org        Organization
+			space      Space
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			org, space, warnings, executeErr = actor.GetOrganizationAndSpaceByName("some-org-name", "some-space-name")
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError and does not look up the space", func() {
+				Expect(executeErr).To(MatchError(OrganizationNotFoundError{Name: "some-org-name"}))
+				Expect(warnings).To(ConsistOf("org-warning"))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the org exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv3.Organization{{Name: "some-org-name", GUID: "some-org-guid"}},
+					ccv3.Warnings{"org-warning"},
+					nil,
+				)
+			})
+
+			Context("when the space does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"space-warning"}, nil)
+				})
+
+				It("returns a SpaceNotFoundError and all warnings", func() {
+					Expect(executeErr).To(MatchError(SpaceNotFoundError{Name: "some-space-name"}))
+					Expect(warnings).To(ConsistOf("org-warning", "space-warning"))
+				})
+			})
+
+			Context("when the space exists", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpacesReturns(
+						[]ccv3.Space{{Name: "some-space-name", GUID: "some-space-guid"}},
+						ccv3.Warnings{"space-warning"},
+						nil,
+					)
+				})
+
+				It("returns the org, the space and all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("org-warning", "space-warning"))
+					Expect(org).To(Equal(Organization{Name: "some-org-name", GUID: "some-org-guid"}))
+					Expect(space).To(Equal(Space{Name: "some-space-name", GUID: "some-space-guid"}))
+
+					Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal(url.Values{
+						ccv3.NameFilter:             []string{"some-space-name"},
+						ccv3.OrganizationGUIDFilter: []string{"some-org-guid"},
+					}))
+				})
+			})
+		})
 	})
 
 	Describe("ResetSpaceIsolationSegment", func() {
@@ -0,0 +1,128 @@
+package v3action
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+//go:generate counterfeiter . SSHClient
+
+// SSHClient runs commands on process instances over SSH without an
+// interactive shell.
+type SSHClient interface {
+	// RunCommand runs command on the process instance described by details,
+	// authenticated with passcode, and returns the command's exit code.
+	RunCommand(details SSHDetails, passcode string, command string, stdout io.Writer, stderr io.Writer) (int, error)
+}
+
+// ApplicationNotStartedError is returned when trying to SSH into an
+// application that is not started.
+type ApplicationNotStartedError struct {
+	Name string
+}
+
+func (e ApplicationNotStartedError) Error() string {
+	return fmt.Sprintf("Application %s is not in the STARTED state", e.Name)
+}
+
+// SSHCommandFailedError is returned when a command run over SSH exits with a
+// non-zero exit code.
+type SSHCommandFailedError struct {
+	ExitCode int
+	Stderr   string
+}
+
+func (e SSHCommandFailedError) Error() string {
+	return fmt.Sprintf("SSH command failed with exit code %d: %s", e.ExitCode, e.Stderr)
+}
+
+// SSHDetails contains the information needed to open an SSH connection to a
+// process instance.
+type SSHDetails struct {
+	Endpoint           string
+	HostKeyFingerprint string
+	ProcessGUID        string
+	ProcessIndex       int
+}
+
+// GetSSHPasscode returns a one time passcode for SSHing into an application
+// instance.
+func (actor Actor) GetSSHPasscode() (string, error) {
+	return actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
+}
+
+// GetProcessSSHDetailsByApplicationNameSpaceProcessTypeAndIndex returns the
+// SSH endpoint and the process to connect to for the given instance of the
+// application's process type. The application must be started and the
+// instance must exist.
+func (actor Actor) GetProcessSSHDetailsByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex int) (SSHDetails, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return SSHDetails{}, allWarnings, err
+	}
+
+	if !app.Started() {
+		return SSHDetails{}, allWarnings, ApplicationNotStartedError{Name: appName}
+	}
+
+	process, warnings, err := actor.GetProcessByApplicationAndProcessType(app.GUID, processType)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SSHDetails{}, allWarnings, err
+	}
+
+	instances, ccWarnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return SSHDetails{}, allWarnings, err
+	}
+
+	for _, instance := range instances {
+		if instance.Index == processIndex {
+			return SSHDetails{
+				Endpoint:           actor.CloudControllerClient.AppSSHEndpoint(),
+				HostKeyFingerprint: actor.CloudControllerClient.AppSSHHostKeyFingerprint(),
+				ProcessGUID:        process.GUID,
+				ProcessIndex:       processIndex,
+			}, allWarnings, nil
+		}
+	}
+
+	return SSHDetails{}, allWarnings, ProcessInstanceNotFoundError{
+		ProcessType:   processType,
+		InstanceIndex: processIndex,
+	}
+}
+
+// RunSSHCommand runs the non-interactive command on the given instance of the
+// application's process type and returns what the command wrote to standard
+// output.
+func (actor Actor) RunSSHCommand(client SSHClient, appName string, spaceGUID string, processType string, processIndex int, command string) ([]byte, Warnings, error) {
+	details, warnings, err := actor.GetProcessSSHDetailsByApplicationNameSpaceProcessTypeAndIndex(appName, spaceGUID, processType, processIndex)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	passcode, err := actor.GetSSHPasscode()
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
+	exitCode, err := client.RunCommand(details, passcode, command, stdout, stderr)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	if exitCode != 0 {
+		return stdout.Bytes(), warnings, SSHCommandFailedError{
+			ExitCode: exitCode,
+			Stderr:   strings.TrimSpace(stderr.String()),
+		}
+	}
+
+	return stdout.Bytes(), warnings, nil
+}
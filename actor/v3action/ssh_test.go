@@ -0,0 +1,302 @@
+package v3action_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SSH Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+		fakeUAAClient             *v3actionfakes.FakeUAAClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		fakeUAAClient = new(v3actionfakes.FakeUAAClient)
+		actor = NewActor(fakeCloudControllerClient, fakeUAAClient, fakeConfig)
+	})
+
+	Describe("GetSSHPasscode", func() {
+		BeforeEach(func() {
+			fakeConfig.AccessTokenReturns("some-access-token")
+			fakeConfig.SSHOAuthClientReturns("some-id")
+		})
+
+		Context("when no errors are encountered getting the ssh passcode", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetSSHPasscodeReturns("s3curep4ss", nil)
+			})
+
+			It("returns the ssh passcode", func() {
+				code, err := actor.GetSSHPasscode()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(code).To(Equal("s3curep4ss"))
+
+				Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(1))
+				accessToken, sshOAuthClient := fakeUAAClient.GetSSHPasscodeArgsForCall(0)
+				Expect(accessToken).To(Equal("some-access-token"))
+				Expect(sshOAuthClient).To(Equal("some-id"))
+			})
+		})
+
+		Context("when an error is encountered getting the ssh passcode", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("failed fetching code")
+				fakeUAAClient.GetSSHPasscodeReturns("", expectedErr)
+			})
+
+			It("returns the error", func() {
+				_, err := actor.GetSSHPasscode()
+				Expect(err).To(MatchError(expectedErr))
+			})
+		})
+	})
+
+	Describe("GetProcessSSHDetailsByApplicationNameSpaceProcessTypeAndIndex", func() {
+		var (
+			processIndex int
+			details      SSHDetails
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			processIndex = 1
+
+			fakeCloudControllerClient.AppSSHEndpointReturns("ssh.example.com:2222")
+			fakeCloudControllerClient.AppSSHHostKeyFingerprintReturns("some-fingerprint")
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid", State: "STARTED"}},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+				ccv3.Process{GUID: "some-process-guid", Type: "worker"},
+				ccv3.Warnings{"get-process-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessInstancesReturns(
+				[]ccv3.Instance{{Index: 0}, {Index: 1}},
+				ccv3.Warnings{"get-instances-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			details, warnings, executeErr = actor.GetProcessSSHDetailsByApplicationNameSpaceProcessTypeAndIndex("some-app", "some-space-guid", "worker", processIndex)
+		})
+
+		It("returns the ssh endpoint and process and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(details).To(Equal(SSHDetails{
+				Endpoint:           "ssh.example.com:2222",
+				HostKeyFingerprint: "some-fingerprint",
+				ProcessGUID:        "some-process-guid",
+				ProcessIndex:       1,
+			}))
+			Expect(warnings).To(ConsistOf("get-app-warning", "get-process-warning", "get-instances-warning"))
+
+			appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(processType).To(Equal("worker"))
+			Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("some-process-guid"))
+		})
+
+		Context("when the application is not started", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid", State: "STOPPED"}},
+					ccv3.Warnings{"get-app-warning"},
+					nil,
+				)
+			})
+
+			It("returns an ApplicationNotStartedError and the warnings", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotStartedError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationProcessByTypeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+					ccv3.Process{},
+					ccv3.Warnings{"get-process-warning"},
+					ccerror.ProcessNotFoundError{},
+				)
+			})
+
+			It("returns a ProcessNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(ProcessNotFoundError{ProcessType: "worker"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-process-warning"))
+			})
+		})
+
+		Context("when the instance does not exist", func() {
+			BeforeEach(func() {
+				processIndex = 2
+			})
+
+			It("returns a ProcessInstanceNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(ProcessInstanceNotFoundError{ProcessType: "worker", InstanceIndex: 2}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-process-warning", "get-instances-warning"))
+			})
+		})
+
+		Context("when getting the instances returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("instances error")
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"get-instances-warning"}, expectedErr)
+			})
+
+			It("returns the error and the warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-process-warning", "get-instances-warning"))
+			})
+		})
+	})
+
+	Describe("RunSSHCommand", func() {
+		var (
+			fakeSSHClient *v3actionfakes.FakeSSHClient
+			output        []byte
+			warnings      Warnings
+			executeErr    error
+		)
+
+		BeforeEach(func() {
+			fakeSSHClient = new(v3actionfakes.FakeSSHClient)
+
+			fakeConfig.AccessTokenReturns("some-access-token")
+			fakeConfig.SSHOAuthClientReturns("some-id")
+			fakeUAAClient.GetSSHPasscodeReturns("s3curep4ss", nil)
+
+			fakeCloudControllerClient.AppSSHEndpointReturns("ssh.example.com:2222")
+			fakeCloudControllerClient.AppSSHHostKeyFingerprintReturns("some-fingerprint")
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid", State: "STARTED"}},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+				ccv3.Process{GUID: "some-process-guid", Type: "web"},
+				ccv3.Warnings{"get-process-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessInstancesReturns(
+				[]ccv3.Instance{{Index: 0}},
+				ccv3.Warnings{"get-instances-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			output, warnings, executeErr = actor.RunSSHCommand(fakeSSHClient, "some-app", "some-space-guid", "web", 0, "ls -la")
+		})
+
+		Context("when the command succeeds", func() {
+			BeforeEach(func() {
+				fakeSSHClient.RunCommandStub = func(_ SSHDetails, _ string, _ string, stdout io.Writer, stderr io.Writer) (int, error) {
+					fmt.Fprint(stdout, "some-output")
+					return 0, nil
+				}
+			})
+
+			It("runs the command on the instance and returns its output and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(output)).To(Equal("some-output"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-process-warning", "get-instances-warning"))
+
+				Expect(fakeSSHClient.RunCommandCallCount()).To(Equal(1))
+				details, passcode, command, _, _ := fakeSSHClient.RunCommandArgsForCall(0)
+				Expect(details).To(Equal(SSHDetails{
+					Endpoint:           "ssh.example.com:2222",
+					HostKeyFingerprint: "some-fingerprint",
+					ProcessGUID:        "some-process-guid",
+					ProcessIndex:       0,
+				}))
+				Expect(passcode).To(Equal("s3curep4ss"))
+				Expect(command).To(Equal("ls -la"))
+			})
+		})
+
+		Context("when the command exits with a non-zero exit code", func() {
+			BeforeEach(func() {
+				fakeSSHClient.RunCommandStub = func(_ SSHDetails, _ string, _ string, stdout io.Writer, stderr io.Writer) (int, error) {
+					fmt.Fprintln(stderr, "No such file or directory")
+					return 2, nil
+				}
+			})
+
+			It("returns an SSHCommandFailedError and all warnings", func() {
+				Expect(executeErr).To(MatchError(SSHCommandFailedError{
+					ExitCode: 2,
+					Stderr:   "No such file or directory",
+				}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-process-warning", "get-instances-warning"))
+			})
+		})
+
+		Context("when running the command fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("dial error")
+				fakeSSHClient.RunCommandReturns(0, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-process-warning", "get-instances-warning"))
+			})
+		})
+
+		Context("when getting the ssh details fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid", State: "STOPPED"}},
+					ccv3.Warnings{"get-app-warning"},
+					nil,
+				)
+			})
+
+			It("returns the error and warnings without running the command", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotStartedError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeSSHClient.RunCommandCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the ssh passcode fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("failed fetching code")
+				fakeUAAClient.GetSSHPasscodeReturns("", expectedErr)
+			})
+
+			It("returns the error and warnings without running the command", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-process-warning", "get-instances-warning"))
+				Expect(fakeSSHClient.RunCommandCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
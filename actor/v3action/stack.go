@@ -0,0 +1,51 @@
+package v3action
+
+import (
+	"fmt"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Stack represents a V3 actor stack.
+type Stack ccv3.Stack
+
+// StackNotFoundError is returned when a requested stack is not found.
+type StackNotFoundError struct {
+	Name string
+}
+
+func (e StackNotFoundError) Error() string {
+	return fmt.Sprintf("Stack '%s' not found.", e.Name)
+}
+
+// GetStacks returns all the stacks.
+func (actor Actor) GetStacks() ([]Stack, Warnings, error) {
+	ccv3Stacks, warnings, err := actor.CloudControllerClient.GetStacks(nil)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var stacks []Stack
+	for _, stack := range ccv3Stacks {
+		stacks = append(stacks, Stack(stack))
+	}
+
+	return stacks, Warnings(warnings), nil
+}
+
+// GetStackByName returns the stack with the given name.
+func (actor Actor) GetStackByName(stackName string) (Stack, Warnings, error) {
+	stacks, warnings, err := actor.CloudControllerClient.GetStacks(url.Values{
+		ccv3.NameFilter: []string{stackName},
+	})
+	if err != nil {
+		return Stack{}, Warnings(warnings), err
+	}
+
+	if len(stacks) == 0 {
+		return Stack{}, Warnings(warnings), StackNotFoundError{Name: stackName}
+	}
+
+	return Stack(stacks[0]), Warnings(warnings), nil
+}
@@ -0,0 +1,119 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stack Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetStacks", func() {
+		Context("when getting the stacks succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(
+					[]ccv3.Stack{
+						{GUID: "stack-guid-1", Name: "cflinuxfs2"},
+						{GUID: "stack-guid-2", Name: "cflinuxfs3"},
+					},
+					ccv3.Warnings{"get-stacks-warning"},
+					nil,
+				)
+			})
+
+			It("returns the stacks and warnings", func() {
+				stacks, warnings, err := actor.GetStacks()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+				Expect(stacks).To(ConsistOf(
+					Stack{GUID: "stack-guid-1", Name: "cflinuxfs2"},
+					Stack{GUID: "stack-guid-2", Name: "cflinuxfs3"},
+				))
+
+				Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetStacksArgsForCall(0)).To(BeNil())
+			})
+		})
+
+		Context("when getting the stacks fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get stacks error")
+				fakeCloudControllerClient.GetStacksReturns(nil, ccv3.Warnings{"get-stacks-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetStacks()
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+			})
+		})
+	})
+
+	Describe("GetStackByName", func() {
+		Context("when the stack exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(
+					[]ccv3.Stack{{GUID: "stack-guid", Name: "cflinuxfs3"}},
+					ccv3.Warnings{"get-stacks-warning"},
+					nil,
+				)
+			})
+
+			It("returns the stack and warnings", func() {
+				stack, warnings, err := actor.GetStackByName("cflinuxfs3")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+				Expect(stack).To(Equal(Stack{GUID: "stack-guid", Name: "cflinuxfs3"}))
+
+				Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetStacksArgsForCall(0)).To(Equal(url.Values{
+					ccv3.NameFilter: []string{"cflinuxfs3"},
+				}))
+			})
+		})
+
+		Context("when the stack does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(nil, ccv3.Warnings{"get-stacks-warning"}, nil)
+			})
+
+			It("returns a StackNotFoundError and warnings", func() {
+				_, warnings, err := actor.GetStackByName("cflinuxfs3")
+				Expect(err).To(MatchError(StackNotFoundError{Name: "cflinuxfs3"}))
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+			})
+		})
+
+		Context("when getting the stacks fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get stacks error")
+				fakeCloudControllerClient.GetStacksReturns(nil, ccv3.Warnings{"get-stacks-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetStackByName("cflinuxfs3")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+			})
+		})
+	})
+})
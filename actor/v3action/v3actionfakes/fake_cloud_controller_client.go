@@ -7,9 +7,28 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
 )
 
 type FakeCloudControllerClient struct {
+	AppSSHEndpointStub        func() string
+	appSSHEndpointMutex       sync.RWMutex
+	appSSHEndpointArgsForCall []struct{}
+	appSSHEndpointReturns     struct {
+		result1 string
+	}
+	appSSHEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
+	AppSSHHostKeyFingerprintStub        func() string
+	appSSHHostKeyFingerprintMutex       sync.RWMutex
+	appSSHHostKeyFingerprintArgsForCall []struct{}
+	appSSHHostKeyFingerprintReturns     struct {
+		result1 string
+	}
+	appSSHHostKeyFingerprintReturnsOnCall map[int]struct {
+		result1 string
+	}
 	AssignSpaceToIsolationSegmentStub        func(spaceGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	assignSpaceToIsolationSegmentMutex       sync.RWMutex
 	assignSpaceToIsolationSegmentArgsForCall []struct {
@@ -26,6 +45,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CancelDeploymentStub        func(deploymentGUID string) (ccv3.Warnings, error)
+	cancelDeploymentMutex       sync.RWMutex
+	cancelDeploymentArgsForCall []struct {
+		deploymentGUID string
+	}
+	cancelDeploymentReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	cancelDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct{}
@@ -35,6 +67,22 @@ type FakeCloudControllerClient struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CopyPackageStub        func(sourcePackageGUID string, targetAppGUID string) (ccv3.Package, ccv3.Warnings, error)
+	copyPackageMutex       sync.RWMutex
+	copyPackageArgsForCall []struct {
+		sourcePackageGUID string
+		targetAppGUID     string
+	}
+	copyPackageReturns struct {
+		result1 ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}
+	copyPackageReturnsOnCall map[int]struct {
+		result1 ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationStub        func(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
 	createApplicationMutex       sync.RWMutex
 	createApplicationArgsForCall []struct {
@@ -50,6 +98,38 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateApplicationDeploymentStub        func(appGUID string, dropletGUID string) (ccv3.Deployment, ccv3.Warnings, error)
+	createApplicationDeploymentMutex       sync.RWMutex
+	createApplicationDeploymentArgsForCall []struct {
+		appGUID     string
+		dropletGUID string
+	}
+	createApplicationDeploymentReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	CreateApplicationDeploymentByRevisionStub        func(appGUID string, revisionGUID string) (ccv3.Deployment, ccv3.Warnings, error)
+	createApplicationDeploymentByRevisionMutex       sync.RWMutex
+	createApplicationDeploymentByRevisionArgsForCall []struct {
+		appGUID      string
+		revisionGUID string
+	}
+	createApplicationDeploymentByRevisionReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationDeploymentByRevisionReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationProcessScaleStub        func(appGUID string, process ccv3.Process) (ccv3.Warnings, error)
 	createApplicationProcessScaleMutex       sync.RWMutex
 	createApplicationProcessScaleArgsForCall []struct {
@@ -125,6 +205,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateRouteStub        func(route ccv3.Route) (ccv3.Route, ccv3.Warnings, error)
+	createRouteMutex       sync.RWMutex
+	createRouteArgsForCall []struct {
+		route ccv3.Route
+	}
+	createRouteReturns struct {
+		result1 ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createRouteReturnsOnCall map[int]struct {
+		result1 ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteApplicationStub        func(guid string) (string, ccv3.Warnings, error)
 	deleteApplicationMutex       sync.RWMutex
 	deleteApplicationArgsForCall []struct {
@@ -155,6 +250,21 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeleteDropletStub        func(dropletGUID string) (string, ccv3.Warnings, error)
+	deleteDropletMutex       sync.RWMutex
+	deleteDropletArgsForCall []struct {
+		dropletGUID string
+	}
+	deleteDropletReturns struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteDropletReturnsOnCall map[int]struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteIsolationSegmentStub        func(guid string) (ccv3.Warnings, error)
 	deleteIsolationSegmentMutex       sync.RWMutex
 	deleteIsolationSegmentArgsForCall []struct {
@@ -168,6 +278,35 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeletePackageStub        func(packageGUID string) (string, ccv3.Warnings, error)
+	deletePackageMutex       sync.RWMutex
+	deletePackageArgsForCall []struct {
+		packageGUID string
+	}
+	deletePackageReturns struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deletePackageReturnsOnCall map[int]struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	DeleteServiceInstanceRelationshipsSharedSpaceStub        func(serviceInstanceGUID string, spaceGUID string) (ccv3.Warnings, error)
+	deleteServiceInstanceRelationshipsSharedSpaceMutex       sync.RWMutex
+	deleteServiceInstanceRelationshipsSharedSpaceArgsForCall []struct {
+		serviceInstanceGUID string
+		spaceGUID           string
+	}
+	deleteServiceInstanceRelationshipsSharedSpaceReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	deleteServiceInstanceRelationshipsSharedSpaceReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	EntitleIsolationSegmentToOrganizationsStub        func(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
 	entitleIsolationSegmentToOrganizationsMutex       sync.RWMutex
 	entitleIsolationSegmentToOrganizationsArgsForCall []struct {
@@ -184,6 +323,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationDeployedRevisionsStub        func(appGUID string) ([]ccv3.Revision, ccv3.Warnings, error)
+	getApplicationDeployedRevisionsMutex       sync.RWMutex
+	getApplicationDeployedRevisionsArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationDeployedRevisionsReturns struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationDeployedRevisionsReturnsOnCall map[int]struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationDropletsStub        func(appGUID string, query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error)
 	getApplicationDropletsMutex       sync.RWMutex
 	getApplicationDropletsArgsForCall []struct {
@@ -200,6 +354,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationEnvironmentStub        func(appGUID string) (ccv3.Environment, ccv3.Warnings, error)
+	getApplicationEnvironmentMutex       sync.RWMutex
+	getApplicationEnvironmentArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationEnvironmentReturns struct {
+		result1 ccv3.Environment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationEnvironmentReturnsOnCall map[int]struct {
+		result1 ccv3.Environment
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationProcessByTypeStub        func(appGUID string, processType string) (ccv3.Process, ccv3.Warnings, error)
 	getApplicationProcessByTypeMutex       sync.RWMutex
 	getApplicationProcessByTypeArgsForCall []struct {
@@ -231,6 +400,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationRevisionsStub        func(appGUID string, query url.Values) ([]ccv3.Revision, ccv3.Warnings, error)
+	getApplicationRevisionsMutex       sync.RWMutex
+	getApplicationRevisionsArgsForCall []struct {
+		appGUID string
+		query   url.Values
+	}
+	getApplicationRevisionsReturns struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationRevisionsReturnsOnCall map[int]struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationTasksStub        func(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -262,6 +447,34 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationsPagedStub        func(query url.Values, handlePage func([]ccv3.Application) error) (ccv3.Warnings, error)
+	getApplicationsPagedMutex       sync.RWMutex
+	getApplicationsPagedArgsForCall []struct {
+		query      url.Values
+		handlePage func([]ccv3.Application) error
+	}
+	getApplicationsPagedReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	getApplicationsPagedReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	GetAuditEventsPagedStub        func(query url.Values, handlePage func([]ccv3.AuditEvent) error) (ccv3.Warnings, error)
+	getAuditEventsPagedMutex       sync.RWMutex
+	getAuditEventsPagedArgsForCall []struct {
+		query      url.Values
+		handlePage func([]ccv3.AuditEvent) error
+	}
+	getAuditEventsPagedReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	getAuditEventsPagedReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	GetBuildStub        func(guid string) (ccv3.Build, ccv3.Warnings, error)
 	getBuildMutex       sync.RWMutex
 	getBuildArgsForCall []struct {
@@ -277,6 +490,51 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetDeploymentStub        func(deploymentGUID string) (ccv3.Deployment, ccv3.Warnings, error)
+	getDeploymentMutex       sync.RWMutex
+	getDeploymentArgsForCall []struct {
+		deploymentGUID string
+	}
+	getDeploymentReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetDeploymentsStub        func(query url.Values) ([]ccv3.Deployment, ccv3.Warnings, error)
+	getDeploymentsMutex       sync.RWMutex
+	getDeploymentsArgsForCall []struct {
+		query url.Values
+	}
+	getDeploymentsReturns struct {
+		result1 []ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDeploymentsReturnsOnCall map[int]struct {
+		result1 []ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetDomainsStub        func(query url.Values) ([]ccv3.Domain, ccv3.Warnings, error)
+	getDomainsMutex       sync.RWMutex
+	getDomainsArgsForCall []struct {
+		query url.Values
+	}
+	getDomainsReturns struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDomainsReturnsOnCall map[int]struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetDropletStub        func(guid string) (ccv3.Droplet, ccv3.Warnings, error)
 	getDropletMutex       sync.RWMutex
 	getDropletArgsForCall []struct {
@@ -292,6 +550,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetDropletsStub        func(query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error)
+	getDropletsMutex       sync.RWMutex
+	getDropletsArgsForCall []struct {
+		query url.Values
+	}
+	getDropletsReturns struct {
+		result1 []ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDropletsReturnsOnCall map[int]struct {
+		result1 []ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetIsolationSegmentStub        func(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	getIsolationSegmentMutex       sync.RWMutex
 	getIsolationSegmentArgsForCall []struct {
@@ -412,6 +685,51 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetRouteDestinationsStub        func(routeGUID string) ([]ccv3.RouteDestination, ccv3.Warnings, error)
+	getRouteDestinationsMutex       sync.RWMutex
+	getRouteDestinationsArgsForCall []struct {
+		routeGUID string
+	}
+	getRouteDestinationsReturns struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getRouteDestinationsReturnsOnCall map[int]struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetRoutesStub        func(query url.Values) ([]ccv3.Route, ccv3.Warnings, error)
+	getRoutesMutex       sync.RWMutex
+	getRoutesArgsForCall []struct {
+		query url.Values
+	}
+	getRoutesReturns struct {
+		result1 []ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getRoutesReturnsOnCall map[int]struct {
+		result1 []ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetServiceInstancesStub        func(query url.Values) ([]ccv3.ServiceInstance, ccv3.Warnings, error)
+	getServiceInstancesMutex       sync.RWMutex
+	getServiceInstancesArgsForCall []struct {
+		query url.Values
+	}
+	getServiceInstancesReturns struct {
+		result1 []ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServiceInstancesReturnsOnCall map[int]struct {
+		result1 []ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetSpaceIsolationSegmentStub        func(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	getSpaceIsolationSegmentMutex       sync.RWMutex
 	getSpaceIsolationSegmentArgsForCall []struct {
@@ -427,12 +745,71 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
-	PatchApplicationProcessHealthCheckStub        func(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string) (ccv3.Warnings, error)
+	GetSpacesStub        func(query url.Values) ([]ccv3.Space, ccv3.Warnings, error)
+	getSpacesMutex       sync.RWMutex
+	getSpacesArgsForCall []struct {
+		query url.Values
+	}
+	getSpacesReturns struct {
+		result1 []ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getSpacesReturnsOnCall map[int]struct {
+		result1 []ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetStacksStub        func(query url.Values) ([]ccv3.Stack, ccv3.Warnings, error)
+	getStacksMutex       sync.RWMutex
+	getStacksArgsForCall []struct {
+		query url.Values
+	}
+	getStacksReturns struct {
+		result1 []ccv3.Stack
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getStacksReturnsOnCall map[int]struct {
+		result1 []ccv3.Stack
+		result2 ccv3.Warnings
+		result3 error
+	}
+	MapRouteDestinationStub        func(routeGUID string, destination ccv3.RouteDestination) (ccv3.Warnings, error)
+	mapRouteDestinationMutex       sync.RWMutex
+	mapRouteDestinationArgsForCall []struct {
+		routeGUID   string
+		destination ccv3.RouteDestination
+	}
+	mapRouteDestinationReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	mapRouteDestinationReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	PatchApplicationProcessCommandStub        func(processGUID string, command types.FilteredString) (ccv3.Warnings, error)
+	patchApplicationProcessCommandMutex       sync.RWMutex
+	patchApplicationProcessCommandArgsForCall []struct {
+		processGUID string
+		command     types.FilteredString
+	}
+	patchApplicationProcessCommandReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	patchApplicationProcessCommandReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	PatchApplicationProcessHealthCheckStub        func(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string, processInvocationTimeout types.NullInt) (ccv3.Warnings, error)
 	patchApplicationProcessHealthCheckMutex       sync.RWMutex
 	patchApplicationProcessHealthCheckArgsForCall []struct {
 		processGUID                string
 		processHealthCheckType     string
 		processHealthCheckEndpoint string
+		processInvocationTimeout   types.NullInt
 	}
 	patchApplicationProcessHealthCheckReturns struct {
 		result1 ccv3.Warnings
@@ -499,6 +876,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	ShareServiceInstanceToSpacesStub        func(serviceInstanceGUID string, spaceGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
+	shareServiceInstanceToSpacesMutex       sync.RWMutex
+	shareServiceInstanceToSpacesArgsForCall []struct {
+		serviceInstanceGUID string
+		spaceGUIDs          []string
+	}
+	shareServiceInstanceToSpacesReturns struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
+	shareServiceInstanceToSpacesReturnsOnCall map[int]struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
 	StartApplicationStub        func(appGUID string) (ccv3.Application, ccv3.Warnings, error)
 	startApplicationMutex       sync.RWMutex
 	startApplicationArgsForCall []struct {
@@ -527,6 +920,20 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	UnmapRouteDestinationStub        func(routeGUID string, destinationGUID string) (ccv3.Warnings, error)
+	unmapRouteDestinationMutex       sync.RWMutex
+	unmapRouteDestinationArgsForCall []struct {
+		routeGUID       string
+		destinationGUID string
+	}
+	unmapRouteDestinationReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	unmapRouteDestinationReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	UpdateApplicationStub        func(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
 	updateApplicationMutex       sync.RWMutex
 	updateApplicationArgsForCall []struct {
@@ -542,6 +949,68 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateApplicationEnvironmentVariablesStub        func(appGUID string, envVars ccv3.EnvironmentVariables) (ccv3.EnvironmentVariables, ccv3.Warnings, error)
+	updateApplicationEnvironmentVariablesMutex       sync.RWMutex
+	updateApplicationEnvironmentVariablesArgsForCall []struct {
+		appGUID string
+		envVars ccv3.EnvironmentVariables
+	}
+	updateApplicationEnvironmentVariablesReturns struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateApplicationEnvironmentVariablesReturnsOnCall map[int]struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateOrganizationStub        func(org ccv3.Organization) (ccv3.Organization, ccv3.Warnings, error)
+	updateOrganizationMutex       sync.RWMutex
+	updateOrganizationArgsForCall []struct {
+		org ccv3.Organization
+	}
+	updateOrganizationReturns struct {
+		result1 ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateOrganizationReturnsOnCall map[int]struct {
+		result1 ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateSpaceStub        func(space ccv3.Space) (ccv3.Space, ccv3.Warnings, error)
+	updateSpaceMutex       sync.RWMutex
+	updateSpaceArgsForCall []struct {
+		space ccv3.Space
+	}
+	updateSpaceReturns struct {
+		result1 ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateSpaceReturnsOnCall map[int]struct {
+		result1 ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateSpaceApplyManifestStub        func(spaceGUID string, rawManifest []byte) (string, ccv3.Warnings, error)
+	updateSpaceApplyManifestMutex       sync.RWMutex
+	updateSpaceApplyManifestArgsForCall []struct {
+		spaceGUID   string
+		rawManifest []byte
+	}
+	updateSpaceApplyManifestReturns struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateSpaceApplyManifestReturnsOnCall map[int]struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateTaskStub        func(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
 	updateTaskMutex       sync.RWMutex
 	updateTaskArgsForCall []struct {
@@ -577,16 +1046,96 @@ type FakeCloudControllerClient struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeCloudControllerClient) AssignSpaceToIsolationSegment(spaceGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
-	fake.assignSpaceToIsolationSegmentMutex.Lock()
-	ret, specificReturn := fake.assignSpaceToIsolationSegmentReturnsOnCall[len(fake.assignSpaceToIsolationSegmentArgsForCall)]
-	fake.assignSpaceToIsolationSegmentArgsForCall = append(fake.assignSpaceToIsolationSegmentArgsForCall, struct {
-		spaceGUID            string
-		isolationSegmentGUID string
-	}{spaceGUID, isolationSegmentGUID})
-	fake.recordInvocation("AssignSpaceToIsolationSegment", []interface{}{spaceGUID, isolationSegmentGUID})
-	fake.assignSpaceToIsolationSegmentMutex.Unlock()
-	if fake.AssignSpaceToIsolationSegmentStub != nil {
+func (fake *FakeCloudControllerClient) AppSSHEndpoint() string {
+	fake.appSSHEndpointMutex.Lock()
+	ret, specificReturn := fake.appSSHEndpointReturnsOnCall[len(fake.appSSHEndpointArgsForCall)]
+	fake.appSSHEndpointArgsForCall = append(fake.appSSHEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("AppSSHEndpoint", []interface{}{})
+	fake.appSSHEndpointMutex.Unlock()
+	if fake.AppSSHEndpointStub != nil {
+		return fake.AppSSHEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.appSSHEndpointReturns.result1
+}
+
+func (fake *FakeCloudControllerClient) AppSSHEndpointCallCount() int {
+	fake.appSSHEndpointMutex.RLock()
+	defer fake.appSSHEndpointMutex.RUnlock()
+	return len(fake.appSSHEndpointArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) AppSSHEndpointReturns(result1 string) {
+	fake.AppSSHEndpointStub = nil
+	fake.appSSHEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHEndpointReturnsOnCall(i int, result1 string) {
+	fake.AppSSHEndpointStub = nil
+	if fake.appSSHEndpointReturnsOnCall == nil {
+		fake.appSSHEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.appSSHEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprint() string {
+	fake.appSSHHostKeyFingerprintMutex.Lock()
+	ret, specificReturn := fake.appSSHHostKeyFingerprintReturnsOnCall[len(fake.appSSHHostKeyFingerprintArgsForCall)]
+	fake.appSSHHostKeyFingerprintArgsForCall = append(fake.appSSHHostKeyFingerprintArgsForCall, struct{}{})
+	fake.recordInvocation("AppSSHHostKeyFingerprint", []interface{}{})
+	fake.appSSHHostKeyFingerprintMutex.Unlock()
+	if fake.AppSSHHostKeyFingerprintStub != nil {
+		return fake.AppSSHHostKeyFingerprintStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.appSSHHostKeyFingerprintReturns.result1
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprintCallCount() int {
+	fake.appSSHHostKeyFingerprintMutex.RLock()
+	defer fake.appSSHHostKeyFingerprintMutex.RUnlock()
+	return len(fake.appSSHHostKeyFingerprintArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprintReturns(result1 string) {
+	fake.AppSSHHostKeyFingerprintStub = nil
+	fake.appSSHHostKeyFingerprintReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprintReturnsOnCall(i int, result1 string) {
+	fake.AppSSHHostKeyFingerprintStub = nil
+	if fake.appSSHHostKeyFingerprintReturnsOnCall == nil {
+		fake.appSSHHostKeyFingerprintReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.appSSHHostKeyFingerprintReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AssignSpaceToIsolationSegment(spaceGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
+	fake.assignSpaceToIsolationSegmentMutex.Lock()
+	ret, specificReturn := fake.assignSpaceToIsolationSegmentReturnsOnCall[len(fake.assignSpaceToIsolationSegmentArgsForCall)]
+	fake.assignSpaceToIsolationSegmentArgsForCall = append(fake.assignSpaceToIsolationSegmentArgsForCall, struct {
+		spaceGUID            string
+		isolationSegmentGUID string
+	}{spaceGUID, isolationSegmentGUID})
+	fake.recordInvocation("AssignSpaceToIsolationSegment", []interface{}{spaceGUID, isolationSegmentGUID})
+	fake.assignSpaceToIsolationSegmentMutex.Unlock()
+	if fake.AssignSpaceToIsolationSegmentStub != nil {
 		return fake.AssignSpaceToIsolationSegmentStub(spaceGUID, isolationSegmentGUID)
 	}
 	if specificReturn {
@@ -632,6 +1181,57 @@ func (fake *FakeCloudControllerClient) AssignSpaceToIsolationSegmentReturnsOnCal
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CancelDeployment(deploymentGUID string) (ccv3.Warnings, error) {
+	fake.cancelDeploymentMutex.Lock()
+	ret, specificReturn := fake.cancelDeploymentReturnsOnCall[len(fake.cancelDeploymentArgsForCall)]
+	fake.cancelDeploymentArgsForCall = append(fake.cancelDeploymentArgsForCall, struct {
+		deploymentGUID string
+	}{deploymentGUID})
+	fake.recordInvocation("CancelDeployment", []interface{}{deploymentGUID})
+	fake.cancelDeploymentMutex.Unlock()
+	if fake.CancelDeploymentStub != nil {
+		return fake.CancelDeploymentStub(deploymentGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cancelDeploymentReturns.result1, fake.cancelDeploymentReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentCallCount() int {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return len(fake.cancelDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentArgsForCall(i int) string {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return fake.cancelDeploymentArgsForCall[i].deploymentGUID
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentReturns(result1 ccv3.Warnings, result2 error) {
+	fake.CancelDeploymentStub = nil
+	fake.cancelDeploymentReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.CancelDeploymentStub = nil
+	if fake.cancelDeploymentReturnsOnCall == nil {
+		fake.cancelDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.cancelDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
@@ -672,6 +1272,61 @@ func (fake *FakeCloudControllerClient) CloudControllerAPIVersionReturnsOnCall(i
 	}{result1}
 }
 
+func (fake *FakeCloudControllerClient) CopyPackage(sourcePackageGUID string, targetAppGUID string) (ccv3.Package, ccv3.Warnings, error) {
+	fake.copyPackageMutex.Lock()
+	ret, specificReturn := fake.copyPackageReturnsOnCall[len(fake.copyPackageArgsForCall)]
+	fake.copyPackageArgsForCall = append(fake.copyPackageArgsForCall, struct {
+		sourcePackageGUID string
+		targetAppGUID     string
+	}{sourcePackageGUID, targetAppGUID})
+	fake.recordInvocation("CopyPackage", []interface{}{sourcePackageGUID, targetAppGUID})
+	fake.copyPackageMutex.Unlock()
+	if fake.CopyPackageStub != nil {
+		return fake.CopyPackageStub(sourcePackageGUID, targetAppGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.copyPackageReturns.result1, fake.copyPackageReturns.result2, fake.copyPackageReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CopyPackageCallCount() int {
+	fake.copyPackageMutex.RLock()
+	defer fake.copyPackageMutex.RUnlock()
+	return len(fake.copyPackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CopyPackageArgsForCall(i int) (string, string) {
+	fake.copyPackageMutex.RLock()
+	defer fake.copyPackageMutex.RUnlock()
+	return fake.copyPackageArgsForCall[i].sourcePackageGUID, fake.copyPackageArgsForCall[i].targetAppGUID
+}
+
+func (fake *FakeCloudControllerClient) CopyPackageReturns(result1 ccv3.Package, result2 ccv3.Warnings, result3 error) {
+	fake.CopyPackageStub = nil
+	fake.copyPackageReturns = struct {
+		result1 ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CopyPackageReturnsOnCall(i int, result1 ccv3.Package, result2 ccv3.Warnings, result3 error) {
+	fake.CopyPackageStub = nil
+	if fake.copyPackageReturnsOnCall == nil {
+		fake.copyPackageReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Package
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.copyPackageReturnsOnCall[i] = struct {
+		result1 ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error) {
 	fake.createApplicationMutex.Lock()
 	ret, specificReturn := fake.createApplicationReturnsOnCall[len(fake.createApplicationArgsForCall)]
@@ -726,6 +1381,116 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateApplicationDeployment(appGUID string, dropletGUID string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.createApplicationDeploymentMutex.Lock()
+	ret, specificReturn := fake.createApplicationDeploymentReturnsOnCall[len(fake.createApplicationDeploymentArgsForCall)]
+	fake.createApplicationDeploymentArgsForCall = append(fake.createApplicationDeploymentArgsForCall, struct {
+		appGUID     string
+		dropletGUID string
+	}{appGUID, dropletGUID})
+	fake.recordInvocation("CreateApplicationDeployment", []interface{}{appGUID, dropletGUID})
+	fake.createApplicationDeploymentMutex.Unlock()
+	if fake.CreateApplicationDeploymentStub != nil {
+		return fake.CreateApplicationDeploymentStub(appGUID, dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createApplicationDeploymentReturns.result1, fake.createApplicationDeploymentReturns.result2, fake.createApplicationDeploymentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentCallCount() int {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	return len(fake.createApplicationDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentArgsForCall(i int) (string, string) {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	return fake.createApplicationDeploymentArgsForCall[i].appGUID, fake.createApplicationDeploymentArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentStub = nil
+	fake.createApplicationDeploymentReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentStub = nil
+	if fake.createApplicationDeploymentReturnsOnCall == nil {
+		fake.createApplicationDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevision(appGUID string, revisionGUID string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.createApplicationDeploymentByRevisionMutex.Lock()
+	ret, specificReturn := fake.createApplicationDeploymentByRevisionReturnsOnCall[len(fake.createApplicationDeploymentByRevisionArgsForCall)]
+	fake.createApplicationDeploymentByRevisionArgsForCall = append(fake.createApplicationDeploymentByRevisionArgsForCall, struct {
+		appGUID      string
+		revisionGUID string
+	}{appGUID, revisionGUID})
+	fake.recordInvocation("CreateApplicationDeploymentByRevision", []interface{}{appGUID, revisionGUID})
+	fake.createApplicationDeploymentByRevisionMutex.Unlock()
+	if fake.CreateApplicationDeploymentByRevisionStub != nil {
+		return fake.CreateApplicationDeploymentByRevisionStub(appGUID, revisionGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createApplicationDeploymentByRevisionReturns.result1, fake.createApplicationDeploymentByRevisionReturns.result2, fake.createApplicationDeploymentByRevisionReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionCallCount() int {
+	fake.createApplicationDeploymentByRevisionMutex.RLock()
+	defer fake.createApplicationDeploymentByRevisionMutex.RUnlock()
+	return len(fake.createApplicationDeploymentByRevisionArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionArgsForCall(i int) (string, string) {
+	fake.createApplicationDeploymentByRevisionMutex.RLock()
+	defer fake.createApplicationDeploymentByRevisionMutex.RUnlock()
+	return fake.createApplicationDeploymentByRevisionArgsForCall[i].appGUID, fake.createApplicationDeploymentByRevisionArgsForCall[i].revisionGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentByRevisionStub = nil
+	fake.createApplicationDeploymentByRevisionReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentByRevisionStub = nil
+	if fake.createApplicationDeploymentByRevisionReturnsOnCall == nil {
+		fake.createApplicationDeploymentByRevisionReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationDeploymentByRevisionReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplicationProcessScale(appGUID string, process ccv3.Process) (ccv3.Warnings, error) {
 	fake.createApplicationProcessScaleMutex.Lock()
 	ret, specificReturn := fake.createApplicationProcessScaleReturnsOnCall[len(fake.createApplicationProcessScaleArgsForCall)]
@@ -995,6 +1760,60 @@ func (fake *FakeCloudControllerClient) CreatePackageReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateRoute(route ccv3.Route) (ccv3.Route, ccv3.Warnings, error) {
+	fake.createRouteMutex.Lock()
+	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
+	fake.createRouteArgsForCall = append(fake.createRouteArgsForCall, struct {
+		route ccv3.Route
+	}{route})
+	fake.recordInvocation("CreateRoute", []interface{}{route})
+	fake.createRouteMutex.Unlock()
+	if fake.CreateRouteStub != nil {
+		return fake.CreateRouteStub(route)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createRouteReturns.result1, fake.createRouteReturns.result2, fake.createRouteReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateRouteCallCount() int {
+	fake.createRouteMutex.RLock()
+	defer fake.createRouteMutex.RUnlock()
+	return len(fake.createRouteArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateRouteArgsForCall(i int) ccv3.Route {
+	fake.createRouteMutex.RLock()
+	defer fake.createRouteMutex.RUnlock()
+	return fake.createRouteArgsForCall[i].route
+}
+
+func (fake *FakeCloudControllerClient) CreateRouteReturns(result1 ccv3.Route, result2 ccv3.Warnings, result3 error) {
+	fake.CreateRouteStub = nil
+	fake.createRouteReturns = struct {
+		result1 ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateRouteReturnsOnCall(i int, result1 ccv3.Route, result2 ccv3.Warnings, result3 error) {
+	fake.CreateRouteStub = nil
+	if fake.createRouteReturnsOnCall == nil {
+		fake.createRouteReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Route
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createRouteReturnsOnCall[i] = struct {
+		result1 ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteApplication(guid string) (string, ccv3.Warnings, error) {
 	fake.deleteApplicationMutex.Lock()
 	ret, specificReturn := fake.deleteApplicationReturnsOnCall[len(fake.deleteApplicationArgsForCall)]
@@ -1102,6 +1921,60 @@ func (fake *FakeCloudControllerClient) DeleteApplicationProcessInstanceReturnsOn
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteDroplet(dropletGUID string) (string, ccv3.Warnings, error) {
+	fake.deleteDropletMutex.Lock()
+	ret, specificReturn := fake.deleteDropletReturnsOnCall[len(fake.deleteDropletArgsForCall)]
+	fake.deleteDropletArgsForCall = append(fake.deleteDropletArgsForCall, struct {
+		dropletGUID string
+	}{dropletGUID})
+	fake.recordInvocation("DeleteDroplet", []interface{}{dropletGUID})
+	fake.deleteDropletMutex.Unlock()
+	if fake.DeleteDropletStub != nil {
+		return fake.DeleteDropletStub(dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteDropletReturns.result1, fake.deleteDropletReturns.result2, fake.deleteDropletReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletCallCount() int {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return len(fake.deleteDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletArgsForCall(i int) string {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return fake.deleteDropletArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturns(result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteDropletStub = nil
+	fake.deleteDropletReturns = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteDropletStub = nil
+	if fake.deleteDropletReturnsOnCall == nil {
+		fake.deleteDropletReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteDropletReturnsOnCall[i] = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteIsolationSegment(guid string) (ccv3.Warnings, error) {
 	fake.deleteIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.deleteIsolationSegmentReturnsOnCall[len(fake.deleteIsolationSegmentArgsForCall)]
@@ -1153,6 +2026,112 @@ func (fake *FakeCloudControllerClient) DeleteIsolationSegmentReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeletePackage(packageGUID string) (string, ccv3.Warnings, error) {
+	fake.deletePackageMutex.Lock()
+	ret, specificReturn := fake.deletePackageReturnsOnCall[len(fake.deletePackageArgsForCall)]
+	fake.deletePackageArgsForCall = append(fake.deletePackageArgsForCall, struct {
+		packageGUID string
+	}{packageGUID})
+	fake.recordInvocation("DeletePackage", []interface{}{packageGUID})
+	fake.deletePackageMutex.Unlock()
+	if fake.DeletePackageStub != nil {
+		return fake.DeletePackageStub(packageGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deletePackageReturns.result1, fake.deletePackageReturns.result2, fake.deletePackageReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageCallCount() int {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return len(fake.deletePackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageArgsForCall(i int) string {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return fake.deletePackageArgsForCall[i].packageGUID
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturns(result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.DeletePackageStub = nil
+	fake.deletePackageReturns = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.DeletePackageStub = nil
+	if fake.deletePackageReturnsOnCall == nil {
+		fake.deletePackageReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deletePackageReturnsOnCall[i] = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceRelationshipsSharedSpace(serviceInstanceGUID string, spaceGUID string) (ccv3.Warnings, error) {
+	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.Lock()
+	ret, specificReturn := fake.deleteServiceInstanceRelationshipsSharedSpaceReturnsOnCall[len(fake.deleteServiceInstanceRelationshipsSharedSpaceArgsForCall)]
+	fake.deleteServiceInstanceRelationshipsSharedSpaceArgsForCall = append(fake.deleteServiceInstanceRelationshipsSharedSpaceArgsForCall, struct {
+		serviceInstanceGUID string
+		spaceGUID           string
+	}{serviceInstanceGUID, spaceGUID})
+	fake.recordInvocation("DeleteServiceInstanceRelationshipsSharedSpace", []interface{}{serviceInstanceGUID, spaceGUID})
+	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.Unlock()
+	if fake.DeleteServiceInstanceRelationshipsSharedSpaceStub != nil {
+		return fake.DeleteServiceInstanceRelationshipsSharedSpaceStub(serviceInstanceGUID, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteServiceInstanceRelationshipsSharedSpaceReturns.result1, fake.deleteServiceInstanceRelationshipsSharedSpaceReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceRelationshipsSharedSpaceCallCount() int {
+	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RLock()
+	defer fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RUnlock()
+	return len(fake.deleteServiceInstanceRelationshipsSharedSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceRelationshipsSharedSpaceArgsForCall(i int) (string, string) {
+	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RLock()
+	defer fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RUnlock()
+	return fake.deleteServiceInstanceRelationshipsSharedSpaceArgsForCall[i].serviceInstanceGUID, fake.deleteServiceInstanceRelationshipsSharedSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceRelationshipsSharedSpaceReturns(result1 ccv3.Warnings, result2 error) {
+	fake.DeleteServiceInstanceRelationshipsSharedSpaceStub = nil
+	fake.deleteServiceInstanceRelationshipsSharedSpaceReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceRelationshipsSharedSpaceReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.DeleteServiceInstanceRelationshipsSharedSpaceStub = nil
+	if fake.deleteServiceInstanceRelationshipsSharedSpaceReturnsOnCall == nil {
+		fake.deleteServiceInstanceRelationshipsSharedSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceInstanceRelationshipsSharedSpaceReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) EntitleIsolationSegmentToOrganizations(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error) {
 	var orgGUIDsCopy []string
 	if orgGUIDs != nil {
@@ -1213,9 +2192,63 @@ func (fake *FakeCloudControllerClient) EntitleIsolationSegmentToOrganizationsRet
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationDroplets(appGUID string, query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error) {
-	fake.getApplicationDropletsMutex.Lock()
-	ret, specificReturn := fake.getApplicationDropletsReturnsOnCall[len(fake.getApplicationDropletsArgsForCall)]
+func (fake *FakeCloudControllerClient) GetApplicationDeployedRevisions(appGUID string) ([]ccv3.Revision, ccv3.Warnings, error) {
+	fake.getApplicationDeployedRevisionsMutex.Lock()
+	ret, specificReturn := fake.getApplicationDeployedRevisionsReturnsOnCall[len(fake.getApplicationDeployedRevisionsArgsForCall)]
+	fake.getApplicationDeployedRevisionsArgsForCall = append(fake.getApplicationDeployedRevisionsArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationDeployedRevisions", []interface{}{appGUID})
+	fake.getApplicationDeployedRevisionsMutex.Unlock()
+	if fake.GetApplicationDeployedRevisionsStub != nil {
+		return fake.GetApplicationDeployedRevisionsStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationDeployedRevisionsReturns.result1, fake.getApplicationDeployedRevisionsReturns.result2, fake.getApplicationDeployedRevisionsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDeployedRevisionsCallCount() int {
+	fake.getApplicationDeployedRevisionsMutex.RLock()
+	defer fake.getApplicationDeployedRevisionsMutex.RUnlock()
+	return len(fake.getApplicationDeployedRevisionsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDeployedRevisionsArgsForCall(i int) string {
+	fake.getApplicationDeployedRevisionsMutex.RLock()
+	defer fake.getApplicationDeployedRevisionsMutex.RUnlock()
+	return fake.getApplicationDeployedRevisionsArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDeployedRevisionsReturns(result1 []ccv3.Revision, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationDeployedRevisionsStub = nil
+	fake.getApplicationDeployedRevisionsReturns = struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDeployedRevisionsReturnsOnCall(i int, result1 []ccv3.Revision, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationDeployedRevisionsStub = nil
+	if fake.getApplicationDeployedRevisionsReturnsOnCall == nil {
+		fake.getApplicationDeployedRevisionsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Revision
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationDeployedRevisionsReturnsOnCall[i] = struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDroplets(appGUID string, query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error) {
+	fake.getApplicationDropletsMutex.Lock()
+	ret, specificReturn := fake.getApplicationDropletsReturnsOnCall[len(fake.getApplicationDropletsArgsForCall)]
 	fake.getApplicationDropletsArgsForCall = append(fake.getApplicationDropletsArgsForCall, struct {
 		appGUID string
 		query   url.Values
@@ -1268,6 +2301,60 @@ func (fake *FakeCloudControllerClient) GetApplicationDropletsReturnsOnCall(i int
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationEnvironment(appGUID string) (ccv3.Environment, ccv3.Warnings, error) {
+	fake.getApplicationEnvironmentMutex.Lock()
+	ret, specificReturn := fake.getApplicationEnvironmentReturnsOnCall[len(fake.getApplicationEnvironmentArgsForCall)]
+	fake.getApplicationEnvironmentArgsForCall = append(fake.getApplicationEnvironmentArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationEnvironment", []interface{}{appGUID})
+	fake.getApplicationEnvironmentMutex.Unlock()
+	if fake.GetApplicationEnvironmentStub != nil {
+		return fake.GetApplicationEnvironmentStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationEnvironmentReturns.result1, fake.getApplicationEnvironmentReturns.result2, fake.getApplicationEnvironmentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationEnvironmentCallCount() int {
+	fake.getApplicationEnvironmentMutex.RLock()
+	defer fake.getApplicationEnvironmentMutex.RUnlock()
+	return len(fake.getApplicationEnvironmentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationEnvironmentArgsForCall(i int) string {
+	fake.getApplicationEnvironmentMutex.RLock()
+	defer fake.getApplicationEnvironmentMutex.RUnlock()
+	return fake.getApplicationEnvironmentArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationEnvironmentReturns(result1 ccv3.Environment, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationEnvironmentStub = nil
+	fake.getApplicationEnvironmentReturns = struct {
+		result1 ccv3.Environment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationEnvironmentReturnsOnCall(i int, result1 ccv3.Environment, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationEnvironmentStub = nil
+	if fake.getApplicationEnvironmentReturnsOnCall == nil {
+		fake.getApplicationEnvironmentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Environment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationEnvironmentReturnsOnCall[i] = struct {
+		result1 ccv3.Environment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationProcessByType(appGUID string, processType string) (ccv3.Process, ccv3.Warnings, error) {
 	fake.getApplicationProcessByTypeMutex.Lock()
 	ret, specificReturn := fake.getApplicationProcessByTypeReturnsOnCall[len(fake.getApplicationProcessByTypeArgsForCall)]
@@ -1377,6 +2464,61 @@ func (fake *FakeCloudControllerClient) GetApplicationProcessesReturnsOnCall(i in
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationRevisions(appGUID string, query url.Values) ([]ccv3.Revision, ccv3.Warnings, error) {
+	fake.getApplicationRevisionsMutex.Lock()
+	ret, specificReturn := fake.getApplicationRevisionsReturnsOnCall[len(fake.getApplicationRevisionsArgsForCall)]
+	fake.getApplicationRevisionsArgsForCall = append(fake.getApplicationRevisionsArgsForCall, struct {
+		appGUID string
+		query   url.Values
+	}{appGUID, query})
+	fake.recordInvocation("GetApplicationRevisions", []interface{}{appGUID, query})
+	fake.getApplicationRevisionsMutex.Unlock()
+	if fake.GetApplicationRevisionsStub != nil {
+		return fake.GetApplicationRevisionsStub(appGUID, query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationRevisionsReturns.result1, fake.getApplicationRevisionsReturns.result2, fake.getApplicationRevisionsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsCallCount() int {
+	fake.getApplicationRevisionsMutex.RLock()
+	defer fake.getApplicationRevisionsMutex.RUnlock()
+	return len(fake.getApplicationRevisionsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsArgsForCall(i int) (string, url.Values) {
+	fake.getApplicationRevisionsMutex.RLock()
+	defer fake.getApplicationRevisionsMutex.RUnlock()
+	return fake.getApplicationRevisionsArgsForCall[i].appGUID, fake.getApplicationRevisionsArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsReturns(result1 []ccv3.Revision, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationRevisionsStub = nil
+	fake.getApplicationRevisionsReturns = struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsReturnsOnCall(i int, result1 []ccv3.Revision, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationRevisionsStub = nil
+	if fake.getApplicationRevisionsReturnsOnCall == nil {
+		fake.getApplicationRevisionsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Revision
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationRevisionsReturnsOnCall[i] = struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationTasks(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
@@ -1486,6 +2628,110 @@ func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationsPaged(query url.Values, handlePage func([]ccv3.Application) error) (ccv3.Warnings, error) {
+	fake.getApplicationsPagedMutex.Lock()
+	ret, specificReturn := fake.getApplicationsPagedReturnsOnCall[len(fake.getApplicationsPagedArgsForCall)]
+	fake.getApplicationsPagedArgsForCall = append(fake.getApplicationsPagedArgsForCall, struct {
+		query      url.Values
+		handlePage func([]ccv3.Application) error
+	}{query, handlePage})
+	fake.recordInvocation("GetApplicationsPaged", []interface{}{query, handlePage})
+	fake.getApplicationsPagedMutex.Unlock()
+	if fake.GetApplicationsPagedStub != nil {
+		return fake.GetApplicationsPagedStub(query, handlePage)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getApplicationsPagedReturns.result1, fake.getApplicationsPagedReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsPagedCallCount() int {
+	fake.getApplicationsPagedMutex.RLock()
+	defer fake.getApplicationsPagedMutex.RUnlock()
+	return len(fake.getApplicationsPagedArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsPagedArgsForCall(i int) (url.Values, func([]ccv3.Application) error) {
+	fake.getApplicationsPagedMutex.RLock()
+	defer fake.getApplicationsPagedMutex.RUnlock()
+	return fake.getApplicationsPagedArgsForCall[i].query, fake.getApplicationsPagedArgsForCall[i].handlePage
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsPagedReturns(result1 ccv3.Warnings, result2 error) {
+	fake.GetApplicationsPagedStub = nil
+	fake.getApplicationsPagedReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsPagedReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.GetApplicationsPagedStub = nil
+	if fake.getApplicationsPagedReturnsOnCall == nil {
+		fake.getApplicationsPagedReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.getApplicationsPagedReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetAuditEventsPaged(query url.Values, handlePage func([]ccv3.AuditEvent) error) (ccv3.Warnings, error) {
+	fake.getAuditEventsPagedMutex.Lock()
+	ret, specificReturn := fake.getAuditEventsPagedReturnsOnCall[len(fake.getAuditEventsPagedArgsForCall)]
+	fake.getAuditEventsPagedArgsForCall = append(fake.getAuditEventsPagedArgsForCall, struct {
+		query      url.Values
+		handlePage func([]ccv3.AuditEvent) error
+	}{query, handlePage})
+	fake.recordInvocation("GetAuditEventsPaged", []interface{}{query, handlePage})
+	fake.getAuditEventsPagedMutex.Unlock()
+	if fake.GetAuditEventsPagedStub != nil {
+		return fake.GetAuditEventsPagedStub(query, handlePage)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAuditEventsPagedReturns.result1, fake.getAuditEventsPagedReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) GetAuditEventsPagedCallCount() int {
+	fake.getAuditEventsPagedMutex.RLock()
+	defer fake.getAuditEventsPagedMutex.RUnlock()
+	return len(fake.getAuditEventsPagedArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetAuditEventsPagedArgsForCall(i int) (url.Values, func([]ccv3.AuditEvent) error) {
+	fake.getAuditEventsPagedMutex.RLock()
+	defer fake.getAuditEventsPagedMutex.RUnlock()
+	return fake.getAuditEventsPagedArgsForCall[i].query, fake.getAuditEventsPagedArgsForCall[i].handlePage
+}
+
+func (fake *FakeCloudControllerClient) GetAuditEventsPagedReturns(result1 ccv3.Warnings, result2 error) {
+	fake.GetAuditEventsPagedStub = nil
+	fake.getAuditEventsPagedReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetAuditEventsPagedReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.GetAuditEventsPagedStub = nil
+	if fake.getAuditEventsPagedReturnsOnCall == nil {
+		fake.getAuditEventsPagedReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.getAuditEventsPagedReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) GetBuild(guid string) (ccv3.Build, ccv3.Warnings, error) {
 	fake.getBuildMutex.Lock()
 	ret, specificReturn := fake.getBuildReturnsOnCall[len(fake.getBuildArgsForCall)]
@@ -1540,6 +2786,168 @@ func (fake *FakeCloudControllerClient) GetBuildReturnsOnCall(i int, result1 ccv3
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetDeployment(deploymentGUID string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.getDeploymentMutex.Lock()
+	ret, specificReturn := fake.getDeploymentReturnsOnCall[len(fake.getDeploymentArgsForCall)]
+	fake.getDeploymentArgsForCall = append(fake.getDeploymentArgsForCall, struct {
+		deploymentGUID string
+	}{deploymentGUID})
+	fake.recordInvocation("GetDeployment", []interface{}{deploymentGUID})
+	fake.getDeploymentMutex.Unlock()
+	if fake.GetDeploymentStub != nil {
+		return fake.GetDeploymentStub(deploymentGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getDeploymentReturns.result1, fake.getDeploymentReturns.result2, fake.getDeploymentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentCallCount() int {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return len(fake.getDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentArgsForCall(i int) string {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return fake.getDeploymentArgsForCall[i].deploymentGUID
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentStub = nil
+	fake.getDeploymentReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentStub = nil
+	if fake.getDeploymentReturnsOnCall == nil {
+		fake.getDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeployments(query url.Values) ([]ccv3.Deployment, ccv3.Warnings, error) {
+	fake.getDeploymentsMutex.Lock()
+	ret, specificReturn := fake.getDeploymentsReturnsOnCall[len(fake.getDeploymentsArgsForCall)]
+	fake.getDeploymentsArgsForCall = append(fake.getDeploymentsArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetDeployments", []interface{}{query})
+	fake.getDeploymentsMutex.Unlock()
+	if fake.GetDeploymentsStub != nil {
+		return fake.GetDeploymentsStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getDeploymentsReturns.result1, fake.getDeploymentsReturns.result2, fake.getDeploymentsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentsCallCount() int {
+	fake.getDeploymentsMutex.RLock()
+	defer fake.getDeploymentsMutex.RUnlock()
+	return len(fake.getDeploymentsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentsArgsForCall(i int) url.Values {
+	fake.getDeploymentsMutex.RLock()
+	defer fake.getDeploymentsMutex.RUnlock()
+	return fake.getDeploymentsArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentsReturns(result1 []ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentsStub = nil
+	fake.getDeploymentsReturns = struct {
+		result1 []ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentsReturnsOnCall(i int, result1 []ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentsStub = nil
+	if fake.getDeploymentsReturnsOnCall == nil {
+		fake.getDeploymentsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentsReturnsOnCall[i] = struct {
+		result1 []ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDomains(query url.Values) ([]ccv3.Domain, ccv3.Warnings, error) {
+	fake.getDomainsMutex.Lock()
+	ret, specificReturn := fake.getDomainsReturnsOnCall[len(fake.getDomainsArgsForCall)]
+	fake.getDomainsArgsForCall = append(fake.getDomainsArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetDomains", []interface{}{query})
+	fake.getDomainsMutex.Unlock()
+	if fake.GetDomainsStub != nil {
+		return fake.GetDomainsStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getDomainsReturns.result1, fake.getDomainsReturns.result2, fake.getDomainsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsCallCount() int {
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
+	return len(fake.getDomainsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsArgsForCall(i int) url.Values {
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
+	return fake.getDomainsArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsReturns(result1 []ccv3.Domain, result2 ccv3.Warnings, result3 error) {
+	fake.GetDomainsStub = nil
+	fake.getDomainsReturns = struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsReturnsOnCall(i int, result1 []ccv3.Domain, result2 ccv3.Warnings, result3 error) {
+	fake.GetDomainsStub = nil
+	if fake.getDomainsReturnsOnCall == nil {
+		fake.getDomainsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Domain
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDomainsReturnsOnCall[i] = struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetDroplet(guid string) (ccv3.Droplet, ccv3.Warnings, error) {
 	fake.getDropletMutex.Lock()
 	ret, specificReturn := fake.getDropletReturnsOnCall[len(fake.getDropletArgsForCall)]
@@ -1594,6 +3002,60 @@ func (fake *FakeCloudControllerClient) GetDropletReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetDroplets(query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error) {
+	fake.getDropletsMutex.Lock()
+	ret, specificReturn := fake.getDropletsReturnsOnCall[len(fake.getDropletsArgsForCall)]
+	fake.getDropletsArgsForCall = append(fake.getDropletsArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetDroplets", []interface{}{query})
+	fake.getDropletsMutex.Unlock()
+	if fake.GetDropletsStub != nil {
+		return fake.GetDropletsStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getDropletsReturns.result1, fake.getDropletsReturns.result2, fake.getDropletsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDropletsCallCount() int {
+	fake.getDropletsMutex.RLock()
+	defer fake.getDropletsMutex.RUnlock()
+	return len(fake.getDropletsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDropletsArgsForCall(i int) url.Values {
+	fake.getDropletsMutex.RLock()
+	defer fake.getDropletsMutex.RUnlock()
+	return fake.getDropletsArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetDropletsReturns(result1 []ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.GetDropletsStub = nil
+	fake.getDropletsReturns = struct {
+		result1 []ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDropletsReturnsOnCall(i int, result1 []ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.GetDropletsStub = nil
+	if fake.getDropletsReturnsOnCall == nil {
+		fake.getDropletsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Droplet
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDropletsReturnsOnCall[i] = struct {
+		result1 []ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetIsolationSegment(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error) {
 	fake.getIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.getIsolationSegmentReturnsOnCall[len(fake.getIsolationSegmentArgsForCall)]
@@ -2026,37 +3488,199 @@ func (fake *FakeCloudControllerClient) GetProcessInstancesReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceIsolationSegment(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
-	fake.getSpaceIsolationSegmentMutex.Lock()
-	ret, specificReturn := fake.getSpaceIsolationSegmentReturnsOnCall[len(fake.getSpaceIsolationSegmentArgsForCall)]
-	fake.getSpaceIsolationSegmentArgsForCall = append(fake.getSpaceIsolationSegmentArgsForCall, struct {
-		spaceGUID string
-	}{spaceGUID})
-	fake.recordInvocation("GetSpaceIsolationSegment", []interface{}{spaceGUID})
-	fake.getSpaceIsolationSegmentMutex.Unlock()
-	if fake.GetSpaceIsolationSegmentStub != nil {
-		return fake.GetSpaceIsolationSegmentStub(spaceGUID)
+func (fake *FakeCloudControllerClient) GetRouteDestinations(routeGUID string) ([]ccv3.RouteDestination, ccv3.Warnings, error) {
+	fake.getRouteDestinationsMutex.Lock()
+	ret, specificReturn := fake.getRouteDestinationsReturnsOnCall[len(fake.getRouteDestinationsArgsForCall)]
+	fake.getRouteDestinationsArgsForCall = append(fake.getRouteDestinationsArgsForCall, struct {
+		routeGUID string
+	}{routeGUID})
+	fake.recordInvocation("GetRouteDestinations", []interface{}{routeGUID})
+	fake.getRouteDestinationsMutex.Unlock()
+	if fake.GetRouteDestinationsStub != nil {
+		return fake.GetRouteDestinationsStub(routeGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSpaceIsolationSegmentReturns.result1, fake.getSpaceIsolationSegmentReturns.result2, fake.getSpaceIsolationSegmentReturns.result3
+	return fake.getRouteDestinationsReturns.result1, fake.getRouteDestinationsReturns.result2, fake.getRouteDestinationsReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentCallCount() int {
-	fake.getSpaceIsolationSegmentMutex.RLock()
-	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
-	return len(fake.getSpaceIsolationSegmentArgsForCall)
+func (fake *FakeCloudControllerClient) GetRouteDestinationsCallCount() int {
+	fake.getRouteDestinationsMutex.RLock()
+	defer fake.getRouteDestinationsMutex.RUnlock()
+	return len(fake.getRouteDestinationsArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentArgsForCall(i int) string {
-	fake.getSpaceIsolationSegmentMutex.RLock()
-	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
-	return fake.getSpaceIsolationSegmentArgsForCall[i].spaceGUID
+func (fake *FakeCloudControllerClient) GetRouteDestinationsArgsForCall(i int) string {
+	fake.getRouteDestinationsMutex.RLock()
+	defer fake.getRouteDestinationsMutex.RUnlock()
+	return fake.getRouteDestinationsArgsForCall[i].routeGUID
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentReturns(result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
-	fake.GetSpaceIsolationSegmentStub = nil
+func (fake *FakeCloudControllerClient) GetRouteDestinationsReturns(result1 []ccv3.RouteDestination, result2 ccv3.Warnings, result3 error) {
+	fake.GetRouteDestinationsStub = nil
+	fake.getRouteDestinationsReturns = struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteDestinationsReturnsOnCall(i int, result1 []ccv3.RouteDestination, result2 ccv3.Warnings, result3 error) {
+	fake.GetRouteDestinationsStub = nil
+	if fake.getRouteDestinationsReturnsOnCall == nil {
+		fake.getRouteDestinationsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.RouteDestination
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteDestinationsReturnsOnCall[i] = struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRoutes(query url.Values) ([]ccv3.Route, ccv3.Warnings, error) {
+	fake.getRoutesMutex.Lock()
+	ret, specificReturn := fake.getRoutesReturnsOnCall[len(fake.getRoutesArgsForCall)]
+	fake.getRoutesArgsForCall = append(fake.getRoutesArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetRoutes", []interface{}{query})
+	fake.getRoutesMutex.Unlock()
+	if fake.GetRoutesStub != nil {
+		return fake.GetRoutesStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRoutesReturns.result1, fake.getRoutesReturns.result2, fake.getRoutesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesCallCount() int {
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	return len(fake.getRoutesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesArgsForCall(i int) url.Values {
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	return fake.getRoutesArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesReturns(result1 []ccv3.Route, result2 ccv3.Warnings, result3 error) {
+	fake.GetRoutesStub = nil
+	fake.getRoutesReturns = struct {
+		result1 []ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesReturnsOnCall(i int, result1 []ccv3.Route, result2 ccv3.Warnings, result3 error) {
+	fake.GetRoutesStub = nil
+	if fake.getRoutesReturnsOnCall == nil {
+		fake.getRoutesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Route
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getRoutesReturnsOnCall[i] = struct {
+		result1 []ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstances(query url.Values) ([]ccv3.ServiceInstance, ccv3.Warnings, error) {
+	fake.getServiceInstancesMutex.Lock()
+	ret, specificReturn := fake.getServiceInstancesReturnsOnCall[len(fake.getServiceInstancesArgsForCall)]
+	fake.getServiceInstancesArgsForCall = append(fake.getServiceInstancesArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetServiceInstances", []interface{}{query})
+	fake.getServiceInstancesMutex.Unlock()
+	if fake.GetServiceInstancesStub != nil {
+		return fake.GetServiceInstancesStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstancesReturns.result1, fake.getServiceInstancesReturns.result2, fake.getServiceInstancesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesCallCount() int {
+	fake.getServiceInstancesMutex.RLock()
+	defer fake.getServiceInstancesMutex.RUnlock()
+	return len(fake.getServiceInstancesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesArgsForCall(i int) url.Values {
+	fake.getServiceInstancesMutex.RLock()
+	defer fake.getServiceInstancesMutex.RUnlock()
+	return fake.getServiceInstancesArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesReturns(result1 []ccv3.ServiceInstance, result2 ccv3.Warnings, result3 error) {
+	fake.GetServiceInstancesStub = nil
+	fake.getServiceInstancesReturns = struct {
+		result1 []ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesReturnsOnCall(i int, result1 []ccv3.ServiceInstance, result2 ccv3.Warnings, result3 error) {
+	fake.GetServiceInstancesStub = nil
+	if fake.getServiceInstancesReturnsOnCall == nil {
+		fake.getServiceInstancesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.ServiceInstance
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstancesReturnsOnCall[i] = struct {
+		result1 []ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceIsolationSegment(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
+	fake.getSpaceIsolationSegmentMutex.Lock()
+	ret, specificReturn := fake.getSpaceIsolationSegmentReturnsOnCall[len(fake.getSpaceIsolationSegmentArgsForCall)]
+	fake.getSpaceIsolationSegmentArgsForCall = append(fake.getSpaceIsolationSegmentArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceIsolationSegment", []interface{}{spaceGUID})
+	fake.getSpaceIsolationSegmentMutex.Unlock()
+	if fake.GetSpaceIsolationSegmentStub != nil {
+		return fake.GetSpaceIsolationSegmentStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceIsolationSegmentReturns.result1, fake.getSpaceIsolationSegmentReturns.result2, fake.getSpaceIsolationSegmentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentCallCount() int {
+	fake.getSpaceIsolationSegmentMutex.RLock()
+	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
+	return len(fake.getSpaceIsolationSegmentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentArgsForCall(i int) string {
+	fake.getSpaceIsolationSegmentMutex.RLock()
+	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
+	return fake.getSpaceIsolationSegmentArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentReturns(result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
+	fake.GetSpaceIsolationSegmentStub = nil
 	fake.getSpaceIsolationSegmentReturns = struct {
 		result1 ccv3.Relationship
 		result2 ccv3.Warnings
@@ -2080,18 +3704,231 @@ func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) PatchApplicationProcessHealthCheck(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string) (ccv3.Warnings, error) {
+func (fake *FakeCloudControllerClient) GetSpaces(query url.Values) ([]ccv3.Space, ccv3.Warnings, error) {
+	fake.getSpacesMutex.Lock()
+	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
+	fake.getSpacesArgsForCall = append(fake.getSpacesArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetSpaces", []interface{}{query})
+	fake.getSpacesMutex.Unlock()
+	if fake.GetSpacesStub != nil {
+		return fake.GetSpacesStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpacesReturns.result1, fake.getSpacesReturns.result2, fake.getSpacesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesCallCount() int {
+	fake.getSpacesMutex.RLock()
+	defer fake.getSpacesMutex.RUnlock()
+	return len(fake.getSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesArgsForCall(i int) url.Values {
+	fake.getSpacesMutex.RLock()
+	defer fake.getSpacesMutex.RUnlock()
+	return fake.getSpacesArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesReturns(result1 []ccv3.Space, result2 ccv3.Warnings, result3 error) {
+	fake.GetSpacesStub = nil
+	fake.getSpacesReturns = struct {
+		result1 []ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesReturnsOnCall(i int, result1 []ccv3.Space, result2 ccv3.Warnings, result3 error) {
+	fake.GetSpacesStub = nil
+	if fake.getSpacesReturnsOnCall == nil {
+		fake.getSpacesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Space
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getSpacesReturnsOnCall[i] = struct {
+		result1 []ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetStacks(query url.Values) ([]ccv3.Stack, ccv3.Warnings, error) {
+	fake.getStacksMutex.Lock()
+	ret, specificReturn := fake.getStacksReturnsOnCall[len(fake.getStacksArgsForCall)]
+	fake.getStacksArgsForCall = append(fake.getStacksArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetStacks", []interface{}{query})
+	fake.getStacksMutex.Unlock()
+	if fake.GetStacksStub != nil {
+		return fake.GetStacksStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStacksReturns.result1, fake.getStacksReturns.result2, fake.getStacksReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetStacksCallCount() int {
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	return len(fake.getStacksArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetStacksArgsForCall(i int) url.Values {
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	return fake.getStacksArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetStacksReturns(result1 []ccv3.Stack, result2 ccv3.Warnings, result3 error) {
+	fake.GetStacksStub = nil
+	fake.getStacksReturns = struct {
+		result1 []ccv3.Stack
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetStacksReturnsOnCall(i int, result1 []ccv3.Stack, result2 ccv3.Warnings, result3 error) {
+	fake.GetStacksStub = nil
+	if fake.getStacksReturnsOnCall == nil {
+		fake.getStacksReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Stack
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getStacksReturnsOnCall[i] = struct {
+		result1 []ccv3.Stack
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) MapRouteDestination(routeGUID string, destination ccv3.RouteDestination) (ccv3.Warnings, error) {
+	fake.mapRouteDestinationMutex.Lock()
+	ret, specificReturn := fake.mapRouteDestinationReturnsOnCall[len(fake.mapRouteDestinationArgsForCall)]
+	fake.mapRouteDestinationArgsForCall = append(fake.mapRouteDestinationArgsForCall, struct {
+		routeGUID   string
+		destination ccv3.RouteDestination
+	}{routeGUID, destination})
+	fake.recordInvocation("MapRouteDestination", []interface{}{routeGUID, destination})
+	fake.mapRouteDestinationMutex.Unlock()
+	if fake.MapRouteDestinationStub != nil {
+		return fake.MapRouteDestinationStub(routeGUID, destination)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.mapRouteDestinationReturns.result1, fake.mapRouteDestinationReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) MapRouteDestinationCallCount() int {
+	fake.mapRouteDestinationMutex.RLock()
+	defer fake.mapRouteDestinationMutex.RUnlock()
+	return len(fake.mapRouteDestinationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) MapRouteDestinationArgsForCall(i int) (string, ccv3.RouteDestination) {
+	fake.mapRouteDestinationMutex.RLock()
+	defer fake.mapRouteDestinationMutex.RUnlock()
+	return fake.mapRouteDestinationArgsForCall[i].routeGUID, fake.mapRouteDestinationArgsForCall[i].destination
+}
+
+func (fake *FakeCloudControllerClient) MapRouteDestinationReturns(result1 ccv3.Warnings, result2 error) {
+	fake.MapRouteDestinationStub = nil
+	fake.mapRouteDestinationReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) MapRouteDestinationReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.MapRouteDestinationStub = nil
+	if fake.mapRouteDestinationReturnsOnCall == nil {
+		fake.mapRouteDestinationReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.mapRouteDestinationReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) PatchApplicationProcessCommand(processGUID string, command types.FilteredString) (ccv3.Warnings, error) {
+	fake.patchApplicationProcessCommandMutex.Lock()
+	ret, specificReturn := fake.patchApplicationProcessCommandReturnsOnCall[len(fake.patchApplicationProcessCommandArgsForCall)]
+	fake.patchApplicationProcessCommandArgsForCall = append(fake.patchApplicationProcessCommandArgsForCall, struct {
+		processGUID string
+		command     types.FilteredString
+	}{processGUID, command})
+	fake.recordInvocation("PatchApplicationProcessCommand", []interface{}{processGUID, command})
+	fake.patchApplicationProcessCommandMutex.Unlock()
+	if fake.PatchApplicationProcessCommandStub != nil {
+		return fake.PatchApplicationProcessCommandStub(processGUID, command)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.patchApplicationProcessCommandReturns.result1, fake.patchApplicationProcessCommandReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) PatchApplicationProcessCommandCallCount() int {
+	fake.patchApplicationProcessCommandMutex.RLock()
+	defer fake.patchApplicationProcessCommandMutex.RUnlock()
+	return len(fake.patchApplicationProcessCommandArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) PatchApplicationProcessCommandArgsForCall(i int) (string, types.FilteredString) {
+	fake.patchApplicationProcessCommandMutex.RLock()
+	defer fake.patchApplicationProcessCommandMutex.RUnlock()
+	return fake.patchApplicationProcessCommandArgsForCall[i].processGUID, fake.patchApplicationProcessCommandArgsForCall[i].command
+}
+
+func (fake *FakeCloudControllerClient) PatchApplicationProcessCommandReturns(result1 ccv3.Warnings, result2 error) {
+	fake.PatchApplicationProcessCommandStub = nil
+	fake.patchApplicationProcessCommandReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) PatchApplicationProcessCommandReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.PatchApplicationProcessCommandStub = nil
+	if fake.patchApplicationProcessCommandReturnsOnCall == nil {
+		fake.patchApplicationProcessCommandReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.patchApplicationProcessCommandReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) PatchApplicationProcessHealthCheck(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string, processInvocationTimeout types.NullInt) (ccv3.Warnings, error) {
 	fake.patchApplicationProcessHealthCheckMutex.Lock()
 	ret, specificReturn := fake.patchApplicationProcessHealthCheckReturnsOnCall[len(fake.patchApplicationProcessHealthCheckArgsForCall)]
 	fake.patchApplicationProcessHealthCheckArgsForCall = append(fake.patchApplicationProcessHealthCheckArgsForCall, struct {
 		processGUID                string
 		processHealthCheckType     string
 		processHealthCheckEndpoint string
-	}{processGUID, processHealthCheckType, processHealthCheckEndpoint})
-	fake.recordInvocation("PatchApplicationProcessHealthCheck", []interface{}{processGUID, processHealthCheckType, processHealthCheckEndpoint})
+		processInvocationTimeout   types.NullInt
+	}{processGUID, processHealthCheckType, processHealthCheckEndpoint, processInvocationTimeout})
+	fake.recordInvocation("PatchApplicationProcessHealthCheck", []interface{}{processGUID, processHealthCheckType, processHealthCheckEndpoint, processInvocationTimeout})
 	fake.patchApplicationProcessHealthCheckMutex.Unlock()
 	if fake.PatchApplicationProcessHealthCheckStub != nil {
-		return fake.PatchApplicationProcessHealthCheckStub(processGUID, processHealthCheckType, processHealthCheckEndpoint)
+		return fake.PatchApplicationProcessHealthCheckStub(processGUID, processHealthCheckType, processHealthCheckEndpoint, processInvocationTimeout)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2105,10 +3942,10 @@ func (fake *FakeCloudControllerClient) PatchApplicationProcessHealthCheckCallCou
 	return len(fake.patchApplicationProcessHealthCheckArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) PatchApplicationProcessHealthCheckArgsForCall(i int) (string, string, string) {
+func (fake *FakeCloudControllerClient) PatchApplicationProcessHealthCheckArgsForCall(i int) (string, string, string, types.NullInt) {
 	fake.patchApplicationProcessHealthCheckMutex.RLock()
 	defer fake.patchApplicationProcessHealthCheckMutex.RUnlock()
-	return fake.patchApplicationProcessHealthCheckArgsForCall[i].processGUID, fake.patchApplicationProcessHealthCheckArgsForCall[i].processHealthCheckType, fake.patchApplicationProcessHealthCheckArgsForCall[i].processHealthCheckEndpoint
+	return fake.patchApplicationProcessHealthCheckArgsForCall[i].processGUID, fake.patchApplicationProcessHealthCheckArgsForCall[i].processHealthCheckType, fake.patchApplicationProcessHealthCheckArgsForCall[i].processHealthCheckEndpoint, fake.patchApplicationProcessHealthCheckArgsForCall[i].processInvocationTimeout
 }
 
 func (fake *FakeCloudControllerClient) PatchApplicationProcessHealthCheckReturns(result1 ccv3.Warnings, result2 error) {
@@ -2343,6 +4180,66 @@ func (fake *FakeCloudControllerClient) SetApplicationDropletReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpaces(serviceInstanceGUID string, spaceGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error) {
+	var spaceGUIDsCopy []string
+	if spaceGUIDs != nil {
+		spaceGUIDsCopy = make([]string, len(spaceGUIDs))
+		copy(spaceGUIDsCopy, spaceGUIDs)
+	}
+	fake.shareServiceInstanceToSpacesMutex.Lock()
+	ret, specificReturn := fake.shareServiceInstanceToSpacesReturnsOnCall[len(fake.shareServiceInstanceToSpacesArgsForCall)]
+	fake.shareServiceInstanceToSpacesArgsForCall = append(fake.shareServiceInstanceToSpacesArgsForCall, struct {
+		serviceInstanceGUID string
+		spaceGUIDs          []string
+	}{serviceInstanceGUID, spaceGUIDsCopy})
+	fake.recordInvocation("ShareServiceInstanceToSpaces", []interface{}{serviceInstanceGUID, spaceGUIDsCopy})
+	fake.shareServiceInstanceToSpacesMutex.Unlock()
+	if fake.ShareServiceInstanceToSpacesStub != nil {
+		return fake.ShareServiceInstanceToSpacesStub(serviceInstanceGUID, spaceGUIDs)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.shareServiceInstanceToSpacesReturns.result1, fake.shareServiceInstanceToSpacesReturns.result2, fake.shareServiceInstanceToSpacesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesCallCount() int {
+	fake.shareServiceInstanceToSpacesMutex.RLock()
+	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
+	return len(fake.shareServiceInstanceToSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesArgsForCall(i int) (string, []string) {
+	fake.shareServiceInstanceToSpacesMutex.RLock()
+	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
+	return fake.shareServiceInstanceToSpacesArgsForCall[i].serviceInstanceGUID, fake.shareServiceInstanceToSpacesArgsForCall[i].spaceGUIDs
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesReturns(result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.ShareServiceInstanceToSpacesStub = nil
+	fake.shareServiceInstanceToSpacesReturns = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesReturnsOnCall(i int, result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.ShareServiceInstanceToSpacesStub = nil
+	if fake.shareServiceInstanceToSpacesReturnsOnCall == nil {
+		fake.shareServiceInstanceToSpacesReturnsOnCall = make(map[int]struct {
+			result1 ccv3.RelationshipList
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.shareServiceInstanceToSpacesReturnsOnCall[i] = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) StartApplication(appGUID string) (ccv3.Application, ccv3.Warnings, error) {
 	fake.startApplicationMutex.Lock()
 	ret, specificReturn := fake.startApplicationReturnsOnCall[len(fake.startApplicationArgsForCall)]
@@ -2448,6 +4345,58 @@ func (fake *FakeCloudControllerClient) StopApplicationReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) UnmapRouteDestination(routeGUID string, destinationGUID string) (ccv3.Warnings, error) {
+	fake.unmapRouteDestinationMutex.Lock()
+	ret, specificReturn := fake.unmapRouteDestinationReturnsOnCall[len(fake.unmapRouteDestinationArgsForCall)]
+	fake.unmapRouteDestinationArgsForCall = append(fake.unmapRouteDestinationArgsForCall, struct {
+		routeGUID       string
+		destinationGUID string
+	}{routeGUID, destinationGUID})
+	fake.recordInvocation("UnmapRouteDestination", []interface{}{routeGUID, destinationGUID})
+	fake.unmapRouteDestinationMutex.Unlock()
+	if fake.UnmapRouteDestinationStub != nil {
+		return fake.UnmapRouteDestinationStub(routeGUID, destinationGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.unmapRouteDestinationReturns.result1, fake.unmapRouteDestinationReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UnmapRouteDestinationCallCount() int {
+	fake.unmapRouteDestinationMutex.RLock()
+	defer fake.unmapRouteDestinationMutex.RUnlock()
+	return len(fake.unmapRouteDestinationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UnmapRouteDestinationArgsForCall(i int) (string, string) {
+	fake.unmapRouteDestinationMutex.RLock()
+	defer fake.unmapRouteDestinationMutex.RUnlock()
+	return fake.unmapRouteDestinationArgsForCall[i].routeGUID, fake.unmapRouteDestinationArgsForCall[i].destinationGUID
+}
+
+func (fake *FakeCloudControllerClient) UnmapRouteDestinationReturns(result1 ccv3.Warnings, result2 error) {
+	fake.UnmapRouteDestinationStub = nil
+	fake.unmapRouteDestinationReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UnmapRouteDestinationReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.UnmapRouteDestinationStub = nil
+	if fake.unmapRouteDestinationReturnsOnCall == nil {
+		fake.unmapRouteDestinationReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.unmapRouteDestinationReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UpdateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error) {
 	fake.updateApplicationMutex.Lock()
 	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
@@ -2502,6 +4451,229 @@ func (fake *FakeCloudControllerClient) UpdateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateApplicationEnvironmentVariables(appGUID string, envVars ccv3.EnvironmentVariables) (ccv3.EnvironmentVariables, ccv3.Warnings, error) {
+	fake.updateApplicationEnvironmentVariablesMutex.Lock()
+	ret, specificReturn := fake.updateApplicationEnvironmentVariablesReturnsOnCall[len(fake.updateApplicationEnvironmentVariablesArgsForCall)]
+	fake.updateApplicationEnvironmentVariablesArgsForCall = append(fake.updateApplicationEnvironmentVariablesArgsForCall, struct {
+		appGUID string
+		envVars ccv3.EnvironmentVariables
+	}{appGUID, envVars})
+	fake.recordInvocation("UpdateApplicationEnvironmentVariables", []interface{}{appGUID, envVars})
+	fake.updateApplicationEnvironmentVariablesMutex.Unlock()
+	if fake.UpdateApplicationEnvironmentVariablesStub != nil {
+		return fake.UpdateApplicationEnvironmentVariablesStub(appGUID, envVars)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateApplicationEnvironmentVariablesReturns.result1, fake.updateApplicationEnvironmentVariablesReturns.result2, fake.updateApplicationEnvironmentVariablesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationEnvironmentVariablesCallCount() int {
+	fake.updateApplicationEnvironmentVariablesMutex.RLock()
+	defer fake.updateApplicationEnvironmentVariablesMutex.RUnlock()
+	return len(fake.updateApplicationEnvironmentVariablesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationEnvironmentVariablesArgsForCall(i int) (string, ccv3.EnvironmentVariables) {
+	fake.updateApplicationEnvironmentVariablesMutex.RLock()
+	defer fake.updateApplicationEnvironmentVariablesMutex.RUnlock()
+	return fake.updateApplicationEnvironmentVariablesArgsForCall[i].appGUID, fake.updateApplicationEnvironmentVariablesArgsForCall[i].envVars
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationEnvironmentVariablesReturns(result1 ccv3.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationEnvironmentVariablesStub = nil
+	fake.updateApplicationEnvironmentVariablesReturns = struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationEnvironmentVariablesReturnsOnCall(i int, result1 ccv3.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationEnvironmentVariablesStub = nil
+	if fake.updateApplicationEnvironmentVariablesReturnsOnCall == nil {
+		fake.updateApplicationEnvironmentVariablesReturnsOnCall = make(map[int]struct {
+			result1 ccv3.EnvironmentVariables
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateApplicationEnvironmentVariablesReturnsOnCall[i] = struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganization(org ccv3.Organization) (ccv3.Organization, ccv3.Warnings, error) {
+	fake.updateOrganizationMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationReturnsOnCall[len(fake.updateOrganizationArgsForCall)]
+	fake.updateOrganizationArgsForCall = append(fake.updateOrganizationArgsForCall, struct {
+		org ccv3.Organization
+	}{org})
+	fake.recordInvocation("UpdateOrganization", []interface{}{org})
+	fake.updateOrganizationMutex.Unlock()
+	if fake.UpdateOrganizationStub != nil {
+		return fake.UpdateOrganizationStub(org)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateOrganizationReturns.result1, fake.updateOrganizationReturns.result2, fake.updateOrganizationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationCallCount() int {
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
+	return len(fake.updateOrganizationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationArgsForCall(i int) ccv3.Organization {
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
+	return fake.updateOrganizationArgsForCall[i].org
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationReturns(result1 ccv3.Organization, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateOrganizationStub = nil
+	fake.updateOrganizationReturns = struct {
+		result1 ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationReturnsOnCall(i int, result1 ccv3.Organization, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateOrganizationStub = nil
+	if fake.updateOrganizationReturnsOnCall == nil {
+		fake.updateOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Organization
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateOrganizationReturnsOnCall[i] = struct {
+		result1 ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpace(space ccv3.Space) (ccv3.Space, ccv3.Warnings, error) {
+	fake.updateSpaceMutex.Lock()
+	ret, specificReturn := fake.updateSpaceReturnsOnCall[len(fake.updateSpaceArgsForCall)]
+	fake.updateSpaceArgsForCall = append(fake.updateSpaceArgsForCall, struct {
+		space ccv3.Space
+	}{space})
+	fake.recordInvocation("UpdateSpace", []interface{}{space})
+	fake.updateSpaceMutex.Unlock()
+	if fake.UpdateSpaceStub != nil {
+		return fake.UpdateSpaceStub(space)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateSpaceReturns.result1, fake.updateSpaceReturns.result2, fake.updateSpaceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceCallCount() int {
+	fake.updateSpaceMutex.RLock()
+	defer fake.updateSpaceMutex.RUnlock()
+	return len(fake.updateSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceArgsForCall(i int) ccv3.Space {
+	fake.updateSpaceMutex.RLock()
+	defer fake.updateSpaceMutex.RUnlock()
+	return fake.updateSpaceArgsForCall[i].space
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceReturns(result1 ccv3.Space, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateSpaceStub = nil
+	fake.updateSpaceReturns = struct {
+		result1 ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceReturnsOnCall(i int, result1 ccv3.Space, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateSpaceStub = nil
+	if fake.updateSpaceReturnsOnCall == nil {
+		fake.updateSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Space
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateSpaceReturnsOnCall[i] = struct {
+		result1 ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifest(spaceGUID string, rawManifest []byte) (string, ccv3.Warnings, error) {
+	var rawManifestCopy []byte
+	if rawManifest != nil {
+		rawManifestCopy = make([]byte, len(rawManifest))
+		copy(rawManifestCopy, rawManifest)
+	}
+	fake.updateSpaceApplyManifestMutex.Lock()
+	ret, specificReturn := fake.updateSpaceApplyManifestReturnsOnCall[len(fake.updateSpaceApplyManifestArgsForCall)]
+	fake.updateSpaceApplyManifestArgsForCall = append(fake.updateSpaceApplyManifestArgsForCall, struct {
+		spaceGUID   string
+		rawManifest []byte
+	}{spaceGUID, rawManifestCopy})
+	fake.recordInvocation("UpdateSpaceApplyManifest", []interface{}{spaceGUID, rawManifestCopy})
+	fake.updateSpaceApplyManifestMutex.Unlock()
+	if fake.UpdateSpaceApplyManifestStub != nil {
+		return fake.UpdateSpaceApplyManifestStub(spaceGUID, rawManifest)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateSpaceApplyManifestReturns.result1, fake.updateSpaceApplyManifestReturns.result2, fake.updateSpaceApplyManifestReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestCallCount() int {
+	fake.updateSpaceApplyManifestMutex.RLock()
+	defer fake.updateSpaceApplyManifestMutex.RUnlock()
+	return len(fake.updateSpaceApplyManifestArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestArgsForCall(i int) (string, []byte) {
+	fake.updateSpaceApplyManifestMutex.RLock()
+	defer fake.updateSpaceApplyManifestMutex.RUnlock()
+	return fake.updateSpaceApplyManifestArgsForCall[i].spaceGUID, fake.updateSpaceApplyManifestArgsForCall[i].rawManifest
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestReturns(result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateSpaceApplyManifestStub = nil
+	fake.updateSpaceApplyManifestReturns = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateSpaceApplyManifestStub = nil
+	if fake.updateSpaceApplyManifestReturnsOnCall == nil {
+		fake.updateSpaceApplyManifestReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateSpaceApplyManifestReturnsOnCall[i] = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error) {
 	fake.updateTaskMutex.Lock()
 	ret, specificReturn := fake.updateTaskReturnsOnCall[len(fake.updateTaskArgsForCall)]
@@ -2614,12 +4786,24 @@ func (fake *FakeCloudControllerClient) UploadPackageReturnsOnCall(i int, result1
 func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.appSSHEndpointMutex.RLock()
+	defer fake.appSSHEndpointMutex.RUnlock()
+	fake.appSSHHostKeyFingerprintMutex.RLock()
+	defer fake.appSSHHostKeyFingerprintMutex.RUnlock()
 	fake.assignSpaceToIsolationSegmentMutex.RLock()
 	defer fake.assignSpaceToIsolationSegmentMutex.RUnlock()
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.copyPackageMutex.RLock()
+	defer fake.copyPackageMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	fake.createApplicationDeploymentByRevisionMutex.RLock()
+	defer fake.createApplicationDeploymentByRevisionMutex.RUnlock()
 	fake.createApplicationProcessScaleMutex.RLock()
 	defer fake.createApplicationProcessScaleMutex.RUnlock()
 	fake.createApplicationTaskMutex.RLock()
@@ -2630,28 +4814,54 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.createIsolationSegmentMutex.RUnlock()
 	fake.createPackageMutex.RLock()
 	defer fake.createPackageMutex.RUnlock()
+	fake.createRouteMutex.RLock()
+	defer fake.createRouteMutex.RUnlock()
 	fake.deleteApplicationMutex.RLock()
 	defer fake.deleteApplicationMutex.RUnlock()
 	fake.deleteApplicationProcessInstanceMutex.RLock()
 	defer fake.deleteApplicationProcessInstanceMutex.RUnlock()
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
 	fake.deleteIsolationSegmentMutex.RLock()
 	defer fake.deleteIsolationSegmentMutex.RUnlock()
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RLock()
+	defer fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationsMutex.RLock()
 	defer fake.entitleIsolationSegmentToOrganizationsMutex.RUnlock()
+	fake.getApplicationDeployedRevisionsMutex.RLock()
+	defer fake.getApplicationDeployedRevisionsMutex.RUnlock()
 	fake.getApplicationDropletsMutex.RLock()
 	defer fake.getApplicationDropletsMutex.RUnlock()
+	fake.getApplicationEnvironmentMutex.RLock()
+	defer fake.getApplicationEnvironmentMutex.RUnlock()
 	fake.getApplicationProcessByTypeMutex.RLock()
 	defer fake.getApplicationProcessByTypeMutex.RUnlock()
 	fake.getApplicationProcessesMutex.RLock()
 	defer fake.getApplicationProcessesMutex.RUnlock()
+	fake.getApplicationRevisionsMutex.RLock()
+	defer fake.getApplicationRevisionsMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
+	fake.getApplicationsPagedMutex.RLock()
+	defer fake.getApplicationsPagedMutex.RUnlock()
+	fake.getAuditEventsPagedMutex.RLock()
+	defer fake.getAuditEventsPagedMutex.RUnlock()
 	fake.getBuildMutex.RLock()
 	defer fake.getBuildMutex.RUnlock()
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	fake.getDeploymentsMutex.RLock()
+	defer fake.getDeploymentsMutex.RUnlock()
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
 	fake.getDropletMutex.RLock()
 	defer fake.getDropletMutex.RUnlock()
+	fake.getDropletsMutex.RLock()
+	defer fake.getDropletsMutex.RUnlock()
 	fake.getIsolationSegmentMutex.RLock()
 	defer fake.getIsolationSegmentMutex.RUnlock()
 	fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.RLock()
@@ -2668,8 +4878,22 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getPackageMutex.RUnlock()
 	fake.getProcessInstancesMutex.RLock()
 	defer fake.getProcessInstancesMutex.RUnlock()
+	fake.getRouteDestinationsMutex.RLock()
+	defer fake.getRouteDestinationsMutex.RUnlock()
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	fake.getServiceInstancesMutex.RLock()
+	defer fake.getServiceInstancesMutex.RUnlock()
 	fake.getSpaceIsolationSegmentMutex.RLock()
 	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
+	fake.getSpacesMutex.RLock()
+	defer fake.getSpacesMutex.RUnlock()
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	fake.mapRouteDestinationMutex.RLock()
+	defer fake.mapRouteDestinationMutex.RUnlock()
+	fake.patchApplicationProcessCommandMutex.RLock()
+	defer fake.patchApplicationProcessCommandMutex.RUnlock()
 	fake.patchApplicationProcessHealthCheckMutex.RLock()
 	defer fake.patchApplicationProcessHealthCheckMutex.RUnlock()
 	fake.patchOrganizationDefaultIsolationSegmentMutex.RLock()
@@ -2680,12 +4904,24 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.revokeIsolationSegmentFromOrganizationMutex.RUnlock()
 	fake.setApplicationDropletMutex.RLock()
 	defer fake.setApplicationDropletMutex.RUnlock()
+	fake.shareServiceInstanceToSpacesMutex.RLock()
+	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
 	fake.startApplicationMutex.RLock()
 	defer fake.startApplicationMutex.RUnlock()
 	fake.stopApplicationMutex.RLock()
 	defer fake.stopApplicationMutex.RUnlock()
+	fake.unmapRouteDestinationMutex.RLock()
+	defer fake.unmapRouteDestinationMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateApplicationEnvironmentVariablesMutex.RLock()
+	defer fake.updateApplicationEnvironmentVariablesMutex.RUnlock()
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
+	fake.updateSpaceMutex.RLock()
+	defer fake.updateSpaceMutex.RUnlock()
+	fake.updateSpaceApplyManifestMutex.RLock()
+	defer fake.updateSpaceApplyManifestMutex.RUnlock()
 	fake.updateTaskMutex.RLock()
 	defer fake.updateTaskMutex.RUnlock()
 	fake.uploadPackageMutex.RLock()
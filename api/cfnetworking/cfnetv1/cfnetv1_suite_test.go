@@ -6,6 +6,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/api/cfnetworking/cfnetv1"
 
+	"code.cloudfoundry.org/cli/api/transport"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -35,6 +36,7 @@ var _ = SynchronizedAfterSuite(func() {
 
 var _ = BeforeEach(func() {
 	server.Reset()
+	transport.CloseIdleConnections()
 })
 
 func NewTestClient(passed ...Config) *Client {
@@ -91,10 +91,19 @@ type Config struct {
 	// AppVersion is the version of the application/process using the client.
 	AppVersion string
 
+	// CACertFile is the path to a PEM-encoded bundle of CA certificates that
+	// are trusted, along with the system trust store, when verifying the
+	// server's certificate chain.
+	CACertFile string
+
 	// DialTimeout is the DNS timeout used to make all requests to the Cloud
 	// Controller.
 	DialTimeout time.Duration
 
+	// MaxIdleConnsPerHost is the number of idle keep-alive connections kept
+	// per host.
+	MaxIdleConnsPerHost int
+
 	// SkipSSLValidation controls whether a client verifies the server's
 	// certificate chain and host name. If SkipSSLValidation is true, TLS accepts
 	// any certificate presented by the server and any host name in that
@@ -104,6 +113,9 @@ type Config struct {
 	// be used only for testing.
 	SkipSSLValidation bool
 
+	// TLSHandshakeTimeout is the timeout for the TLS handshake.
+	TLSHandshakeTimeout time.Duration
+
 	// URL is a fully qualified URL to the CF Networking API.
 	URL string
 
@@ -116,8 +128,11 @@ func NewClient(config Config) *Client {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
 
 	connection := cfnetworking.NewConnection(cfnetworking.Config{
-		DialTimeout:       config.DialTimeout,
-		SkipSSLValidation: config.SkipSSLValidation,
+		CACertFile:          config.CACertFile,
+		DialTimeout:         config.DialTimeout,
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost,
+		SkipSSLValidation:   config.SkipSSLValidation,
+		TLSHandshakeTimeout: config.TLSHandshakeTimeout,
 	})
 
 	wrappedConnection := cfnetworking.NewErrorWrapper().Wrap(connection)
@@ -5,6 +5,7 @@ import (
 	"log"
 	"testing"
 
+	"code.cloudfoundry.org/cli/api/transport"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -32,4 +33,5 @@ var _ = SynchronizedAfterSuite(func() {
 
 var _ = BeforeEach(func() {
 	server.Reset()
+	transport.CloseIdleConnections()
 })
@@ -2,16 +2,15 @@ package cfnetworking
 
 import (
 	"bytes"
-	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"io/ioutil"
-	"net"
 	"net/http"
 	"net/url"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cfnetworking/networkerror"
+	"code.cloudfoundry.org/cli/api/transport"
 )
 
 // NetworkingConnection represents a connection to the Cloud Controller
@@ -19,26 +18,32 @@ import (
 type NetworkingConnection struct {
 	HTTPClient *http.Client
 	UserAgent  string
+
+	transportErr error
 }
 
 // Config is for configuring a NetworkingConnection.
 type Config struct {
-	DialTimeout       time.Duration
-	SkipSSLValidation bool
+	CACertFile          string
+	DialTimeout         time.Duration
+	MaxIdleConnsPerHost int
+	SkipSSLValidation   bool
+	TLSHandshakeTimeout time.Duration
 }
 
 // NewConnection returns a new NetworkingConnection with provided
 // configuration.
 func NewConnection(config Config) *NetworkingConnection {
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: config.SkipSSLValidation,
-		},
-		Proxy: http.ProxyFromEnvironment,
-		DialContext: (&net.Dialer{
-			KeepAlive: 30 * time.Second,
-			Timeout:   config.DialTimeout,
-		}).DialContext,
+	tr, err := transport.Shared(transport.Config{
+		CACertFile:          config.CACertFile,
+		DialTimeout:         config.DialTimeout,
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost,
+		SkipSSLValidation:   config.SkipSSLValidation,
+		TLSHandshakeTimeout: config.TLSHandshakeTimeout,
+	})
+
+	if err != nil {
+		return &NetworkingConnection{transportErr: err}
 	}
 
 	return &NetworkingConnection{
@@ -53,6 +58,10 @@ func (connection *NetworkingConnection) Make(request *Request, passedResponse *R
 	// error and we don't repopulate it in populateResponse.
 	passedResponse.reset()
 
+	if connection.transportErr != nil {
+		return connection.transportErr
+	}
+
 	response, err := connection.HTTPClient.Do(request.Request)
 	if err != nil {
 		return connection.processRequestErrors(request.Request, err)
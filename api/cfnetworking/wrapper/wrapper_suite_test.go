@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"log"
 
+	"code.cloudfoundry.org/cli/api/transport"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -33,4 +34,5 @@ var _ = SynchronizedAfterSuite(func() {
 
 var _ = BeforeEach(func() {
 	server.Reset()
+	transport.CloseIdleConnections()
 })
@@ -0,0 +1,11 @@
+package ccerror
+
+// BuildpackAlreadyExistsForStackError is returned when a buildpack's name and
+// stack are already used by another buildpack.
+type BuildpackAlreadyExistsForStackError struct {
+	Message string
+}
+
+func (e BuildpackAlreadyExistsForStackError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,11 @@
+package ccerror
+
+// BuildpackNameTakenError is returned when creating a buildpack with a name
+// that is already used by a buildpack without a stack.
+type BuildpackNameTakenError struct {
+	Message string
+}
+
+func (e BuildpackNameTakenError) Error() string {
+	return e.Message
+}
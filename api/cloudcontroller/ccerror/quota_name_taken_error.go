@@ -0,0 +1,11 @@
+package ccerror
+
+// QuotaNameTakenError is returned when creating or renaming an organization
+// or space quota with a name that is already used.
+type QuotaNameTakenError struct {
+	Message string
+}
+
+func (e QuotaNameTakenError) Error() string {
+	return e.Message
+}
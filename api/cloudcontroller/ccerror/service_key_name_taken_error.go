@@ -0,0 +1,11 @@
+package ccerror
+
+// ServiceKeyNameTakenError is returned when creating a service key with a
+// name that is already used by the service instance.
+type ServiceKeyNameTakenError struct {
+	Message string
+}
+
+func (e ServiceKeyNameTakenError) Error() string {
+	return e.Message
+}
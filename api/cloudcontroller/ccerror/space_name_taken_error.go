@@ -0,0 +1,11 @@
+package ccerror
+
+// SpaceNameTakenError is returned when creating a space with a name that is
+// already used in the organization.
+type SpaceNameTakenError struct {
+	Message string
+}
+
+func (e SpaceNameTakenError) Error() string {
+	return e.Message
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -269,6 +270,25 @@ func (client *Client) CreateApplication(app Application) (Application, Warnings,
 	return updatedApp, response.Warnings, err
 }
 
+// DeleteApplication deletes the Application associated with the provided
+// GUID, along with its service bindings and route mappings.
+func (client *Client) DeleteApplication(guid string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteAppRequest,
+		URIParams:   Params{"app_guid": guid},
+		Query: url.Values{
+			"recursive": {"true"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // GetApplication returns back an Application.
 func (client *Client) GetApplication(guid string) (Application, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -291,28 +311,41 @@ func (client *Client) GetApplication(guid string) (Application, Warnings, error)
 // GetApplications returns back a list of Applications based off of the
 // provided queries.
 func (client *Client) GetApplications(queries ...Query) ([]Application, Warnings, error) {
+	var fullAppsList []Application
+	warnings, err := client.GetApplicationsPaged(func(page []Application) error {
+		fullAppsList = append(fullAppsList, page...)
+		return nil
+	}, queries...)
+
+	return fullAppsList, warnings, err
+}
+
+// GetApplicationsPaged calls handlePage with each page of Applications
+// matching the provided queries as it is retrieved. Returning an error from
+// handlePage stops the pagination and returns that error.
+func (client *Client) GetApplicationsPaged(handlePage func([]Application) error, queries ...Query) (Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.GetAppsRequest,
 		Query:       FormatQueryParameters(queries),
 	})
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	var fullAppsList []Application
-	warnings, err := client.paginate(request, Application{}, func(item interface{}) error {
-		if app, ok := item.(Application); ok {
-			fullAppsList = append(fullAppsList, app)
-		} else {
-			return ccerror.UnknownObjectInListError{
-				Expected:   Application{},
-				Unexpected: item,
+	return client.paginatePages(request, Application{}, func(list []interface{}) error {
+		apps := make([]Application, 0, len(list))
+		for _, item := range list {
+			app, ok := item.(Application)
+			if !ok {
+				return ccerror.UnknownObjectInListError{
+					Expected:   Application{},
+					Unexpected: item,
+				}
 			}
+			apps = append(apps, app)
 		}
-		return nil
+		return handlePage(apps)
 	})
-
-	return fullAppsList, warnings, err
 }
 
 // UpdateApplication updates the application with the given GUID. Note: Sending
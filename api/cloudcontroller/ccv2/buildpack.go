@@ -0,0 +1,231 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Buildpack represents a Cloud Controller Buildpack.
+type Buildpack struct {
+	// GUID is the unique buildpack identifier.
+	GUID string
+
+	// Name is the name of the buildpack.
+	Name string
+
+	// Position is the order in which buildpacks are checked during
+	// auto-detection.
+	Position types.NullInt
+
+	// Enabled is true when the buildpack can be used for staging.
+	Enabled types.NullBool
+
+	// Locked is true when the buildpack cannot be updated.
+	Locked types.NullBool
+
+	// Stack is the name of the stack the buildpack is associated with. It is
+	// empty when the buildpack is not associated with a stack.
+	Stack string
+}
+
+// MarshalJSON converts a Buildpack into a Cloud Controller Buildpack. Unset
+// properties are omitted so that they are left unchanged on update.
+func (buildpack Buildpack) MarshalJSON() ([]byte, error) {
+	ccBuildpack := struct {
+		Name     string `json:"name,omitempty"`
+		Position *int   `json:"position,omitempty"`
+		Enabled  *bool  `json:"enabled,omitempty"`
+		Locked   *bool  `json:"locked,omitempty"`
+		Stack    string `json:"stack,omitempty"`
+	}{
+		Name:  buildpack.Name,
+		Stack: buildpack.Stack,
+	}
+
+	if buildpack.Position.IsSet {
+		ccBuildpack.Position = &buildpack.Position.Value
+	}
+
+	if buildpack.Enabled.IsSet {
+		ccBuildpack.Enabled = &buildpack.Enabled.Value
+	}
+
+	if buildpack.Locked.IsSet {
+		ccBuildpack.Locked = &buildpack.Locked.Value
+	}
+
+	return json.Marshal(ccBuildpack)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Buildpack response.
+func (buildpack *Buildpack) UnmarshalJSON(data []byte) error {
+	var ccBuildpack struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Name     string         `json:"name"`
+			Position types.NullInt  `json:"position"`
+			Enabled  types.NullBool `json:"enabled"`
+			Locked   types.NullBool `json:"locked"`
+			Stack    string         `json:"stack"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccBuildpack); err != nil {
+		return err
+	}
+
+	buildpack.GUID = ccBuildpack.Metadata.GUID
+	buildpack.Name = ccBuildpack.Entity.Name
+	buildpack.Position = ccBuildpack.Entity.Position
+	buildpack.Enabled = ccBuildpack.Entity.Enabled
+	buildpack.Locked = ccBuildpack.Entity.Locked
+	buildpack.Stack = ccBuildpack.Entity.Stack
+	return nil
+}
+
+//go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Buildpack codetemplates/delete_async_by_guid.go.template delete_buildpack.go
+//go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Buildpack codetemplates/delete_async_by_guid_test.go.template delete_buildpack_test.go
+
+// CreateBuildpack creates a new buildpack without any bits. The bits are
+// uploaded separately with UploadBuildpack.
+func (client *Client) CreateBuildpack(buildpack Buildpack) (Buildpack, Warnings, error) {
+	return client.makeBuildpackRequest(internal.PostBuildpackRequest, nil, buildpack)
+}
+
+// GetBuildpacks returns a list of Buildpacks based off of the provided queries.
+func (client *Client) GetBuildpacks(queries ...Query) ([]Buildpack, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetBuildpacksRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullBuildpacksList []Buildpack
+	warnings, err := client.paginate(request, Buildpack{}, func(item interface{}) error {
+		if buildpack, ok := item.(Buildpack); ok {
+			fullBuildpacksList = append(fullBuildpacksList, buildpack)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Buildpack{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullBuildpacksList, warnings, err
+}
+
+// UpdateBuildpack updates the buildpack with the provided GUID. Only the set
+// properties of the provided buildpack are changed.
+func (client *Client) UpdateBuildpack(buildpack Buildpack) (Buildpack, Warnings, error) {
+	return client.makeBuildpackRequest(internal.PutBuildpackRequest, Params{"buildpack_guid": buildpack.GUID}, buildpack)
+}
+
+// UploadBuildpack uploads the contents of a buildpack zip file to the
+// buildpack with the provided GUID. The buildpack is read as the request is
+// sent, so the request will return a PipeSeekError on retry.
+func (client *Client) UploadBuildpack(buildpackGUID string, buildpackFileName string, buildpack io.Reader, buildpackLength int64) (Warnings, error) {
+	contentLength, err := client.calculateBuildpackRequestSize(buildpackFileName, buildpackLength)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType, body, writeErrors := client.createMultipartBodyAndHeaderForBuildpack(buildpackFileName, buildpack)
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutBuildpackBitsRequest,
+		URIParams:   Params{"buildpack_guid": buildpackGUID},
+		Body:        body,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", contentType)
+	request.ContentLength = contentLength
+
+	response := cloudcontroller.Response{}
+	httpErrors := client.uploadBits(request, &response)
+
+	return response.Warnings, waitForUpload(writeErrors, httpErrors)
+}
+
+func (client *Client) makeBuildpackRequest(requestName string, uriParams Params, buildpack Buildpack) (Buildpack, Warnings, error) {
+	bodyBytes, err := json.Marshal(buildpack)
+	if err != nil {
+		return Buildpack{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Buildpack{}, nil, err
+	}
+
+	var updatedBuildpack Buildpack
+	response := cloudcontroller.Response{
+		Result: &updatedBuildpack,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedBuildpack, response.Warnings, err
+}
+
+func (*Client) createMultipartBodyAndHeaderForBuildpack(buildpackFileName string, buildpack io.Reader) (string, io.ReadSeeker, <-chan error) {
+	writerOutput, writerInput := cloudcontroller.NewPipeBomb()
+	form := multipart.NewWriter(writerInput)
+
+	writeErrors := make(chan error)
+
+	go func() {
+		defer close(writeErrors)
+		defer writerInput.Close()
+
+		writer, err := form.CreateFormFile("buildpack", buildpackFileName)
+		if err != nil {
+			writeErrors <- err
+			return
+		}
+
+		_, err = io.Copy(writer, buildpack)
+		if err != nil {
+			writeErrors <- err
+			return
+		}
+
+		err = form.Close()
+		if err != nil {
+			writeErrors <- err
+		}
+	}()
+
+	return form.FormDataContentType(), writerOutput, writeErrors
+}
+
+func (*Client) calculateBuildpackRequestSize(buildpackFileName string, buildpackLength int64) (int64, error) {
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+
+	_, err := form.CreateFormFile("buildpack", buildpackFileName)
+	if err != nil {
+		return 0, err
+	}
+	err = form.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(body.Len()) + buildpackLength, nil
+}
@@ -0,0 +1,328 @@
+package ccv2_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Buildpack", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateBuildpack", func() {
+		Context("when the creation is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-buildpack-guid"
+					},
+					"entity": {
+						"name": "some-buildpack",
+						"position": 3,
+						"enabled": false,
+						"locked": false,
+						"stack": null
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/buildpacks"),
+						VerifyJSON(`{"name":"some-buildpack","position":3,"enabled":false}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created buildpack and warnings", func() {
+				buildpack, warnings, err := client.CreateBuildpack(Buildpack{
+					Name:     "some-buildpack",
+					Position: types.NullInt{IsSet: true, Value: 3},
+					Enabled:  types.NullBool{IsSet: true, Value: false},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(buildpack).To(Equal(Buildpack{
+					GUID:     "some-buildpack-guid",
+					Name:     "some-buildpack",
+					Position: types.NullInt{IsSet: true, Value: 3},
+					Enabled:  types.NullBool{IsSet: true, Value: false},
+					Locked:   types.NullBool{IsSet: true, Value: false},
+				}))
+			})
+		})
+
+		Context("when the buildpack name is taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 290001,
+					"description": "The buildpack name is already in use: some-buildpack",
+					"error_code": "CF-BuildpackNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/buildpacks"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateBuildpack(Buildpack{Name: "some-buildpack"})
+				Expect(err).To(MatchError(ccerror.BuildpackNameTakenError{Message: "The buildpack name is already in use: some-buildpack"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetBuildpacks", func() {
+		Context("when there are buildpacks", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/buildpacks?q=name:some-buildpack&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "buildpack-guid-1"
+							},
+							"entity": {
+								"name": "some-buildpack",
+								"position": 1,
+								"enabled": true,
+								"locked": false,
+								"stack": "some-stack"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "buildpack-guid-2"
+							},
+							"entity": {
+								"name": "some-buildpack",
+								"position": 2,
+								"enabled": false,
+								"locked": true,
+								"stack": null
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks", "q=name:some-buildpack"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks", "q=name:some-buildpack&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns all the buildpacks and all warnings", func() {
+				buildpacks, warnings, err := client.GetBuildpacks(Query{
+					Filter:   NameFilter,
+					Operator: EqualOperator,
+					Values:   []string{"some-buildpack"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(buildpacks).To(ConsistOf(
+					Buildpack{
+						GUID:     "buildpack-guid-1",
+						Name:     "some-buildpack",
+						Position: types.NullInt{IsSet: true, Value: 1},
+						Enabled:  types.NullBool{IsSet: true, Value: true},
+						Locked:   types.NullBool{IsSet: true, Value: false},
+						Stack:    "some-stack",
+					},
+					Buildpack{
+						GUID:     "buildpack-guid-2",
+						Name:     "some-buildpack",
+						Position: types.NullInt{IsSet: true, Value: 2},
+						Enabled:  types.NullBool{IsSet: true, Value: false},
+						Locked:   types.NullBool{IsSet: true, Value: true},
+					},
+				))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 1,
+					"description": "some error description",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetBuildpacks()
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        1,
+						Description: "some error description",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateBuildpack", func() {
+		Context("when the update is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-buildpack-guid"
+					},
+					"entity": {
+						"name": "some-buildpack",
+						"position": 1,
+						"enabled": true,
+						"locked": true,
+						"stack": "some-stack"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/buildpacks/some-buildpack-guid"),
+						VerifyJSON(`{"locked":true,"stack":"some-stack"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("only sends the set properties and returns the updated buildpack", func() {
+				buildpack, warnings, err := client.UpdateBuildpack(Buildpack{
+					GUID:   "some-buildpack-guid",
+					Locked: types.NullBool{IsSet: true, Value: true},
+					Stack:  "some-stack",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(buildpack).To(Equal(Buildpack{
+					GUID:     "some-buildpack-guid",
+					Name:     "some-buildpack",
+					Position: types.NullInt{IsSet: true, Value: 1},
+					Enabled:  types.NullBool{IsSet: true, Value: true},
+					Locked:   types.NullBool{IsSet: true, Value: true},
+					Stack:    "some-stack",
+				}))
+			})
+		})
+
+		Context("when the buildpack does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10000,
+					"description": "Unknown request",
+					"error_code": "CF-NotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/buildpacks/some-buildpack-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.UpdateBuildpack(Buildpack{GUID: "some-buildpack-guid"})
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Unknown request"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UploadBuildpack", func() {
+		var buildpackBody []byte
+
+		BeforeEach(func() {
+			buildpackBody = []byte("some-buildpack-bits")
+		})
+
+		Context("when the upload is successful", func() {
+			BeforeEach(func() {
+				verifyHeaderAndBody := func(_ http.ResponseWriter, req *http.Request) {
+					contentType := req.Header.Get("Content-Type")
+					Expect(contentType).To(MatchRegexp("multipart/form-data; boundary=[\\w\\d]+"))
+
+					defer req.Body.Close()
+					reader := multipart.NewReader(req.Body, contentType[30:])
+
+					buildpackPart, err := reader.NextPart()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(buildpackPart.FormName()).To(Equal("buildpack"))
+					Expect(buildpackPart.FileName()).To(Equal("some-buildpack.zip"))
+
+					defer buildpackPart.Close()
+					Expect(ioutil.ReadAll(buildpackPart)).To(Equal(buildpackBody))
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/buildpacks/some-buildpack-guid/bits"),
+						verifyHeaderAndBody,
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("uploads the buildpack bits and returns warnings", func() {
+				warnings, err := client.UploadBuildpack("some-buildpack-guid", "some-buildpack.zip", bytes.NewReader(buildpackBody), int64(len(buildpackBody)))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the buildpack name and stack are taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 290000,
+					"description": "The buildpack name some-buildpack is already in use for the stack some-stack",
+					"error_code": "CF-BuildpackNameStackTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/buildpacks/some-buildpack-guid/bits"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.UploadBuildpack("some-buildpack-guid", "some-buildpack.zip", bytes.NewReader(buildpackBody), int64(len(buildpackBody)))
+				Expect(err).To(MatchError(ccerror.BuildpackAlreadyExistsForStackError{Message: "The buildpack name some-buildpack is already in use for the stack some-stack"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
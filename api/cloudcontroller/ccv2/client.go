@@ -73,6 +73,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/jobs"
 	"github.com/tedsuo/rata"
 )
 
@@ -91,8 +92,9 @@ type Client struct {
 	routingEndpoint           string
 	tokenEndpoint             string
 
-	jobPollingInterval time.Duration
-	jobPollingTimeout  time.Duration
+	jobPollingInterval  time.Duration
+	jobPollingTimeout   time.Duration
+	jobPollingCanceller jobs.Canceller
 
 	connection cloudcontroller.Connection
 	router     *rata.RequestGenerator
@@ -114,6 +116,9 @@ type Config struct {
 	// JobPollingInterval is the wait time between job polls.
 	JobPollingInterval time.Duration
 
+	// JobPollingCanceller cancels job polling when its context is cancelled.
+	JobPollingCanceller jobs.Canceller
+
 	// Wrappers that apply to the client connection.
 	Wrappers []ConnectionWrapper
 }
@@ -122,9 +127,10 @@ type Config struct {
 func NewClient(config Config) *Client {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
 	return &Client{
-		userAgent:          userAgent,
-		jobPollingInterval: config.JobPollingInterval,
-		jobPollingTimeout:  config.JobPollingTimeout,
-		wrappers:           append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
+		userAgent:           userAgent,
+		jobPollingInterval:  config.JobPollingInterval,
+		jobPollingTimeout:   config.JobPollingTimeout,
+		jobPollingCanceller: config.JobPollingCanceller,
+		wrappers:            append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
 	}
 }
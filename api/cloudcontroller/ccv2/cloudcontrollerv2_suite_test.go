@@ -9,6 +9,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 
+	"code.cloudfoundry.org/cli/api/transport"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -38,6 +39,7 @@ var _ = SynchronizedAfterSuite(func() {
 
 var _ = BeforeEach(func() {
 	server.Reset()
+	transport.CloseIdleConnections()
 })
 
 func NewTestClient(passed ...Config) *Client {
@@ -0,0 +1,35 @@
+// generated from codetemplates/delete_async_by_guid.go.template
+
+package ccv2
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// DeleteBuildpack deletes the Buildpack associated with the provided
+// GUID. It will return the Cloud Controller job that is assigned to the
+// Buildpack deletion.
+func (client *Client) DeleteBuildpack(guid string) (Job, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteBuildpackRequest,
+		URIParams:   Params{"buildpack_guid": guid},
+		Query: url.Values{
+			"recursive": {"true"},
+			"async":     {"true"},
+		},
+	})
+	if err != nil {
+		return Job{}, nil, err
+	}
+
+	var job Job
+	response := cloudcontroller.Response{
+		Result: &job,
+	}
+
+	err = client.connection.Make(request, &response)
+	return job, response.Warnings, err
+}
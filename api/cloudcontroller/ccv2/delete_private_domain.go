@@ -0,0 +1,35 @@
+// generated from codetemplates/delete_async_by_guid.go.template
+
+package ccv2
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// DeletePrivateDomain deletes the PrivateDomain associated with the provided
+// GUID. It will return the Cloud Controller job that is assigned to the
+// PrivateDomain deletion.
+func (client *Client) DeletePrivateDomain(guid string) (Job, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeletePrivateDomainRequest,
+		URIParams:   Params{"private_domain_guid": guid},
+		Query: url.Values{
+			"recursive": {"true"},
+			"async":     {"true"},
+		},
+	})
+	if err != nil {
+		return Job{}, nil, err
+	}
+
+	var job Job
+	response := cloudcontroller.Response{
+		Result: &job,
+	}
+
+	err = client.connection.Make(request, &response)
+	return job, response.Warnings, err
+}
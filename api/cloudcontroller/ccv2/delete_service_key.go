@@ -0,0 +1,35 @@
+// generated from codetemplates/delete_async_by_guid.go.template
+
+package ccv2
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// DeleteServiceKey deletes the ServiceKey associated with the provided
+// GUID. It will return the Cloud Controller job that is assigned to the
+// ServiceKey deletion.
+func (client *Client) DeleteServiceKey(guid string) (Job, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceKeyRequest,
+		URIParams:   Params{"service_key_guid": guid},
+		Query: url.Values{
+			"recursive": {"true"},
+			"async":     {"true"},
+		},
+	})
+	if err != nil {
+		return Job{}, nil, err
+	}
+
+	var job Job
+	response := cloudcontroller.Response{
+		Result: &job,
+	}
+
+	err = client.connection.Make(request, &response)
+	return job, response.Warnings, err
+}
@@ -1,6 +1,7 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -14,6 +15,10 @@ type Domain struct {
 	Name            string
 	RouterGroupGUID string
 	RouterGroupType string
+
+	// Internal is true for shared domains that are only routable from within
+	// the platform.
+	Internal bool
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Domain response.
@@ -24,6 +29,7 @@ func (domain *Domain) UnmarshalJSON(data []byte) error {
 			Name            string `json:"name"`
 			RouterGroupGUID string `json:"router_group_guid"`
 			RouterGroupType string `json:"router_group_type"`
+			Internal        bool   `json:"internal"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccDomain); err != nil {
@@ -34,9 +40,78 @@ func (domain *Domain) UnmarshalJSON(data []byte) error {
 	domain.Name = ccDomain.Entity.Name
 	domain.RouterGroupGUID = ccDomain.Entity.RouterGroupGUID
 	domain.RouterGroupType = ccDomain.Entity.RouterGroupType
+	domain.Internal = ccDomain.Entity.Internal
 	return nil
 }
 
+// CreateSharedDomain creates a shared domain with the provided name. TCP
+// domains are created by providing the GUID of a TCP router group. Internal
+// domains are only routable from within the platform.
+func (client *Client) CreateSharedDomain(domainName string, routerGroupGUID string, isInternal bool) (Domain, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name            string `json:"name"`
+		RouterGroupGUID string `json:"router_group_guid,omitempty"`
+		Internal        bool   `json:"internal,omitempty"`
+	}{
+		Name:            domainName,
+		RouterGroupGUID: routerGroupGUID,
+		Internal:        isInternal,
+	})
+	if err != nil {
+		return Domain{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSharedDomainRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return Domain{}, nil, err
+	}
+
+	var domain Domain
+	response := cloudcontroller.Response{
+		Result: &domain,
+	}
+
+	err = client.connection.Make(request, &response)
+	return domain, response.Warnings, err
+}
+
+// CreatePrivateDomain creates a private domain with the provided name owned by
+// the provided organization.
+func (client *Client) CreatePrivateDomain(domainName string, orgGUID string) (Domain, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name                   string `json:"name"`
+		OwningOrganizationGUID string `json:"owning_organization_guid"`
+	}{
+		Name:                   domainName,
+		OwningOrganizationGUID: orgGUID,
+	})
+	if err != nil {
+		return Domain{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostPrivateDomainRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return Domain{}, nil, err
+	}
+
+	var domain Domain
+	response := cloudcontroller.Response{
+		Result: &domain,
+	}
+
+	err = client.connection.Make(request, &response)
+	return domain, response.Warnings, err
+}
+
+//go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go PrivateDomain codetemplates/delete_async_by_guid.go.template delete_private_domain.go
+//go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go PrivateDomain codetemplates/delete_async_by_guid_test.go.template delete_private_domain_test.go
+
 // GetSharedDomain returns the Shared Domain associated with the provided
 // Domain GUID.
 func (client *Client) GetSharedDomain(domainGUID string) (Domain, Warnings, error) {
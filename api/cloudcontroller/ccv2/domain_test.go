@@ -391,4 +391,201 @@ var _ = Describe("Domain", func() {
 			})
 		})
 	})
+
+	Describe("CreateSharedDomain", func() {
+		var (
+			routerGroupGUID string
+			isInternal      bool
+
+			domain     Domain
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			routerGroupGUID = ""
+			isInternal = false
+		})
+
+		JustBeforeEach(func() {
+			domain, warnings, executeErr = client.CreateSharedDomain("some-domain-name", routerGroupGUID, isInternal)
+		})
+
+		Context("when the request succeeds", func() {
+			Context("when only a name is provided", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPost, "/v2/shared_domains"),
+							VerifyJSON(`{"name":"some-domain-name"}`),
+							RespondWith(http.StatusCreated, `{
+								"metadata": {
+									"guid": "some-domain-guid"
+								},
+								"entity": {
+									"name": "some-domain-name"
+								}
+							}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+						),
+					)
+				})
+
+				It("creates the shared domain and returns all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(domain).To(Equal(Domain{
+						GUID: "some-domain-guid",
+						Name: "some-domain-name",
+					}))
+					Expect(warnings).To(ConsistOf("warning-1"))
+				})
+			})
+
+			Context("when a router group is provided", func() {
+				BeforeEach(func() {
+					routerGroupGUID = "some-router-group-guid"
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPost, "/v2/shared_domains"),
+							VerifyJSON(`{"name":"some-domain-name","router_group_guid":"some-router-group-guid"}`),
+							RespondWith(http.StatusCreated, `{
+								"metadata": {
+									"guid": "some-domain-guid"
+								},
+								"entity": {
+									"name": "some-domain-name",
+									"router_group_guid": "some-router-group-guid",
+									"router_group_type": "tcp"
+								}
+							}`),
+						),
+					)
+				})
+
+				It("creates the shared domain on the router group", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(domain).To(Equal(Domain{
+						GUID:            "some-domain-guid",
+						Name:            "some-domain-name",
+						RouterGroupGUID: "some-router-group-guid",
+						RouterGroupType: "tcp",
+					}))
+				})
+			})
+
+			Context("when the domain is internal", func() {
+				BeforeEach(func() {
+					isInternal = true
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPost, "/v2/shared_domains"),
+							VerifyJSON(`{"name":"some-domain-name","internal":true}`),
+							RespondWith(http.StatusCreated, `{
+								"metadata": {
+									"guid": "some-domain-guid"
+								},
+								"entity": {
+									"name": "some-domain-name",
+									"internal": true
+								}
+							}`),
+						),
+					)
+				})
+
+				It("creates an internal shared domain", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(domain).To(Equal(Domain{
+						GUID:     "some-domain-guid",
+						Name:     "some-domain-name",
+						Internal: true,
+					}))
+				})
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/shared_domains"),
+						RespondWith(http.StatusForbidden, `{
+							"code": 10003,
+							"description": "You are not authorized to perform the requested action",
+							"error_code": "CF-NotAuthorized"
+						}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ForbiddenError{
+					Message: "You are not authorized to perform the requested action",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("CreatePrivateDomain", func() {
+		var (
+			domain     Domain
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			domain, warnings, executeErr = client.CreatePrivateDomain("some-domain-name", "some-org-guid")
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/private_domains"),
+						VerifyJSON(`{"name":"some-domain-name","owning_organization_guid":"some-org-guid"}`),
+						RespondWith(http.StatusCreated, `{
+							"metadata": {
+								"guid": "some-domain-guid"
+							},
+							"entity": {
+								"name": "some-domain-name",
+								"owning_organization_guid": "some-org-guid"
+							}
+						}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("creates the private domain and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(domain).To(Equal(Domain{
+					GUID: "some-domain-guid",
+					Name: "some-domain-name",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/private_domains"),
+						RespondWith(http.StatusBadRequest, `{
+							"code": 130003,
+							"description": "The domain name is taken: some-domain-name",
+							"error_code": "CF-DomainNameTaken"
+						}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.BadRequestError{
+					Message: "The domain name is taken: some-domain-name",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
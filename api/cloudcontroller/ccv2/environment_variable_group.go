@@ -0,0 +1,77 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// EnvironmentVariableGroupName is the name of one of the global environment
+// variable groups.
+type EnvironmentVariableGroupName string
+
+const (
+	// EnvironmentVariableGroupRunning is the group of environment variables
+	// provided to all running app instances.
+	EnvironmentVariableGroupRunning EnvironmentVariableGroupName = "running"
+
+	// EnvironmentVariableGroupStaging is the group of environment variables
+	// provided to all staging tasks.
+	EnvironmentVariableGroupStaging EnvironmentVariableGroupName = "staging"
+)
+
+// EnvironmentVariableGroup represents the environment variables of a Cloud
+// Controller environment variable group, keyed by variable name.
+type EnvironmentVariableGroup map[string]interface{}
+
+// GetEnvironmentVariableGroup returns the environment variables in the
+// provided group.
+func (client *Client) GetEnvironmentVariableGroup(name EnvironmentVariableGroupName) (EnvironmentVariableGroup, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetConfigEnvironmentVariableGroupRequest,
+		URIParams:   Params{"group_name": string(name)},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var group EnvironmentVariableGroup
+	response := cloudcontroller.Response{
+		Result: &group,
+	}
+
+	err = client.connection.Make(request, &response)
+	return group, response.Warnings, err
+}
+
+// UpdateEnvironmentVariableGroup replaces the contents of the provided group
+// with the provided environment variables.
+func (client *Client) UpdateEnvironmentVariableGroup(name EnvironmentVariableGroupName, group EnvironmentVariableGroup) (EnvironmentVariableGroup, Warnings, error) {
+	if group == nil {
+		group = EnvironmentVariableGroup{}
+	}
+
+	bodyBytes, err := json.Marshal(group)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutConfigEnvironmentVariableGroupRequest,
+		URIParams:   Params{"group_name": string(name)},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedGroup EnvironmentVariableGroup
+	response := cloudcontroller.Response{
+		Result: &updatedGroup,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedGroup, response.Warnings, err
+}
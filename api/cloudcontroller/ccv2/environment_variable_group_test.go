@@ -0,0 +1,142 @@
+package ccv2_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Environment Variable Group", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetEnvironmentVariableGroup", func() {
+		Context("when the group is returned", func() {
+			BeforeEach(func() {
+				response := `{
+					"key-1": "val-1",
+					"key-2": 2
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/environment_variable_groups/staging"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the environment variables and warnings", func() {
+				group, warnings, err := client.GetEnvironmentVariableGroup(EnvironmentVariableGroupStaging)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(group).To(Equal(EnvironmentVariableGroup{
+					"key-1": "val-1",
+					"key-2": json.Number("2"),
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10003,
+					"description": "You are not authorized to perform the requested action",
+					"error_code": "CF-NotAuthorized"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/environment_variable_groups/running"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetEnvironmentVariableGroup(EnvironmentVariableGroupRunning)
+				Expect(err).To(MatchError(ccerror.ForbiddenError{
+					Message: "You are not authorized to perform the requested action",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("UpdateEnvironmentVariableGroup", func() {
+		Context("when the group is updated", func() {
+			BeforeEach(func() {
+				response := `{
+					"key-1": "val-1"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/environment_variable_groups/running"),
+						VerifyJSON(`{"key-1":"val-1"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the updated environment variables and warnings", func() {
+				group, warnings, err := client.UpdateEnvironmentVariableGroup(EnvironmentVariableGroupRunning, EnvironmentVariableGroup{"key-1": "val-1"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(group).To(Equal(EnvironmentVariableGroup{"key-1": "val-1"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the group is cleared", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/environment_variable_groups/staging"),
+						VerifyJSON(`{}`),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("sends an empty object", func() {
+				group, warnings, err := client.UpdateEnvironmentVariableGroup(EnvironmentVariableGroupStaging, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(group).To(BeEmpty())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 1001,
+					"description": "Request invalid due to parse error: Field: root, Error: Missing field root",
+					"error_code": "CF-MessageParseError"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/environment_variable_groups/staging"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.UpdateEnvironmentVariableGroup(EnvironmentVariableGroupStaging, EnvironmentVariableGroup{"key-1": "val-1"})
+				Expect(err).To(MatchError(ccerror.BadRequestError{
+					Message: "Request invalid due to parse error: Field: root, Error: Missing field root",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
@@ -41,7 +41,10 @@ func convert(rawHTTPStatusErr ccerror.RawHTTPStatusError) error {
 	var errorResponse ccerror.V2ErrorResponse
 	err := json.Unmarshal(rawHTTPStatusErr.RawResponse, &errorResponse)
 	if err != nil {
-		if rawHTTPStatusErr.StatusCode == http.StatusNotFound {
+		switch rawHTTPStatusErr.StatusCode {
+		case http.StatusForbidden:
+			return ccerror.ForbiddenError{Message: string(rawHTTPStatusErr.RawResponse)}
+		case http.StatusNotFound:
 			return ccerror.NotFoundError{Message: string(rawHTTPStatusErr.RawResponse)}
 		}
 		return rawHTTPStatusErr
@@ -57,7 +60,7 @@ func convert(rawHTTPStatusErr ccerror.RawHTTPStatusError) error {
 	case http.StatusNotFound: // 404
 		return ccerror.ResourceNotFoundError{Message: errorResponse.Description}
 	case http.StatusUnprocessableEntity: // 422
-		return ccerror.UnprocessableEntityError{Message: errorResponse.Description}
+		return handleUnprocessableEntity(errorResponse)
 	default:
 		return ccerror.V2UnexpectedResponseError{
 			V2ErrorResponse: errorResponse,
@@ -77,13 +80,34 @@ func handleBadRequest(errorResponse ccerror.V2ErrorResponse) error {
 		return ccerror.InvalidRelationError{Message: errorResponse.Description}
 	case "CF-NotStaged":
 		return ccerror.NotStagedError{Message: errorResponse.Description}
+	case "CF-OrganizationNameTaken":
+		return ccerror.OrganizationNameTakenError{Message: errorResponse.Description}
+	case "CF-QuotaDefinitionNameTaken", "CF-SpaceQuotaDefinitionNameTaken":
+		return ccerror.QuotaNameTakenError{Message: errorResponse.Description}
 	case "CF-ServiceBindingAppServiceTaken":
 		return ccerror.ServiceBindingTakenError{Message: errorResponse.Description}
+	case "CF-ServiceInstanceNameTaken":
+		return ccerror.ServiceInstanceNameTakenError{Message: errorResponse.Description}
+	case "CF-ServiceKeyNameTaken":
+		return ccerror.ServiceKeyNameTakenError{Message: errorResponse.Description}
+	case "CF-SpaceNameTaken":
+		return ccerror.SpaceNameTakenError{Message: errorResponse.Description}
 	default:
 		return ccerror.BadRequestError{Message: errorResponse.Description}
 	}
 }
 
+func handleUnprocessableEntity(errorResponse ccerror.V2ErrorResponse) error {
+	switch errorResponse.ErrorCode {
+	case "CF-BuildpackNameStackTaken":
+		return ccerror.BuildpackAlreadyExistsForStackError{Message: errorResponse.Description}
+	case "CF-BuildpackNameTaken":
+		return ccerror.BuildpackNameTakenError{Message: errorResponse.Description}
+	default:
+		return ccerror.UnprocessableEntityError{Message: errorResponse.Description}
+	}
+}
+
 func handleUnauthorized(errorResponse ccerror.V2ErrorResponse) error {
 	if errorResponse.ErrorCode == "CF-InvalidAuthToken" {
 		return ccerror.InvalidAuthTokenError{Message: errorResponse.Description}
@@ -135,6 +135,74 @@ var _ = Describe("Error Wrapper", func() {
 					})
 				})
 
+				Context("when a service instance name is taken", func() {
+					BeforeEach(func() {
+						response = `{
+							"code": 60002,
+							"description": "The service instance name is taken: some-service-instance",
+							"error_code": "CF-ServiceInstanceNameTaken"
+						}`
+					})
+
+					It("returns a ServiceInstanceNameTakenError", func() {
+						_, _, err := client.GetApplications()
+						Expect(err).To(MatchError(ccerror.ServiceInstanceNameTakenError{
+							Message: "The service instance name is taken: some-service-instance",
+						}))
+					})
+				})
+
+				Context("when a service key name is taken", func() {
+					BeforeEach(func() {
+						response = `{
+							"code": 360001,
+							"description": "The service key name is taken: some-key",
+							"error_code": "CF-ServiceKeyNameTaken"
+						}`
+					})
+
+					It("returns a ServiceKeyNameTakenError", func() {
+						_, _, err := client.GetApplications()
+						Expect(err).To(MatchError(ccerror.ServiceKeyNameTakenError{
+							Message: "The service key name is taken: some-key",
+						}))
+					})
+				})
+
+				Context("when an organization quota name is taken", func() {
+					BeforeEach(func() {
+						response = `{
+							"code": 240002,
+							"description": "Quota Definition is taken: some-quota",
+							"error_code": "CF-QuotaDefinitionNameTaken"
+						}`
+					})
+
+					It("returns a QuotaNameTakenError", func() {
+						_, _, err := client.GetApplications()
+						Expect(err).To(MatchError(ccerror.QuotaNameTakenError{
+							Message: "Quota Definition is taken: some-quota",
+						}))
+					})
+				})
+
+				Context("when a space quota name is taken", func() {
+					BeforeEach(func() {
+						response = `{
+							"code": 310001,
+							"description": "The space quota definition name is taken: some-quota",
+							"error_code": "CF-SpaceQuotaDefinitionNameTaken"
+						}`
+					})
+
+					It("returns a QuotaNameTakenError", func() {
+						_, _, err := client.GetApplications()
+						Expect(err).To(MatchError(ccerror.QuotaNameTakenError{
+							Message: "The space quota definition name is taken: some-quota",
+						}))
+					})
+				})
+
 				Context("getting stats for a stopped app", func() {
 					BeforeEach(func() {
 						response = `{
@@ -190,6 +258,17 @@ var _ = Describe("Error Wrapper", func() {
 					_, _, err := client.GetApplications()
 					Expect(err).To(MatchError(ccerror.ForbiddenError{Message: "SomeCC Error Message"}))
 				})
+
+				Context("when the error is not from the cloud controller API", func() {
+					BeforeEach(func() {
+						response = "an error not from the CC API"
+					})
+
+					It("returns a ForbiddenError", func() {
+						_, _, err := client.GetApplications()
+						Expect(err).To(MatchError(ccerror.ForbiddenError{Message: response}))
+					})
+				})
 			})
 
 			Context("(404) Not Found", func() {
@@ -225,6 +304,40 @@ var _ = Describe("Error Wrapper", func() {
 					_, _, err := client.GetApplications()
 					Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "SomeCC Error Message"}))
 				})
+
+				Context("when the buildpack name is taken", func() {
+					BeforeEach(func() {
+						response = `{
+							"code": 290001,
+							"description": "The buildpack name is already in use: some-buildpack",
+							"error_code": "CF-BuildpackNameTaken"
+						}`
+					})
+
+					It("returns a BuildpackNameTakenError", func() {
+						_, _, err := client.GetApplications()
+						Expect(err).To(MatchError(ccerror.BuildpackNameTakenError{
+							Message: "The buildpack name is already in use: some-buildpack",
+						}))
+					})
+				})
+
+				Context("when the buildpack name and stack are taken", func() {
+					BeforeEach(func() {
+						response = `{
+							"code": 290000,
+							"description": "The buildpack name some-buildpack is already in use for the stack some-stack",
+							"error_code": "CF-BuildpackNameStackTaken"
+						}`
+					})
+
+					It("returns a BuildpackAlreadyExistsForStackError", func() {
+						_, _, err := client.GetApplications()
+						Expect(err).To(MatchError(ccerror.BuildpackAlreadyExistsForStackError{
+							Message: "The buildpack name some-buildpack is already in use for the stack some-stack",
+						}))
+					})
+				})
 			})
 
 			Context("unhandled Error Codes", func() {
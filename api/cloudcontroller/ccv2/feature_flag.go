@@ -0,0 +1,82 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// FeatureFlag represents a Cloud Controller feature flag.
+type FeatureFlag struct {
+	// Name is the name of the feature flag.
+	Name string `json:"name"`
+
+	// Enabled is true when the feature is available for use.
+	Enabled bool `json:"enabled"`
+}
+
+// GetFeatureFlags returns all of the Cloud Controller feature flags.
+func (client *Client) GetFeatureFlags() ([]FeatureFlag, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetConfigFeatureFlagsRequest,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var featureFlags []FeatureFlag
+	response := cloudcontroller.Response{
+		Result: &featureFlags,
+	}
+
+	err = client.connection.Make(request, &response)
+	return featureFlags, response.Warnings, err
+}
+
+// GetFeatureFlag returns the feature flag with the provided name.
+func (client *Client) GetFeatureFlag(name string) (FeatureFlag, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetConfigFeatureFlagRequest,
+		URIParams:   Params{"feature_flag_name": name},
+	})
+	if err != nil {
+		return FeatureFlag{}, nil, err
+	}
+
+	var featureFlag FeatureFlag
+	response := cloudcontroller.Response{
+		Result: &featureFlag,
+	}
+
+	err = client.connection.Make(request, &response)
+	return featureFlag, response.Warnings, err
+}
+
+// UpdateFeatureFlag enables or disables the feature flag with the provided
+// name.
+func (client *Client) UpdateFeatureFlag(featureFlag FeatureFlag) (Warnings, error) {
+	bodyBytes, err := json.Marshal(struct {
+		Enabled bool `json:"enabled"`
+	}{
+		Enabled: featureFlag.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutConfigFeatureFlagRequest,
+		URIParams:   Params{"feature_flag_name": featureFlag.Name},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
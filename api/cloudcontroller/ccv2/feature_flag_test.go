@@ -0,0 +1,204 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Feature Flag", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetFeatureFlags", func() {
+		Context("when the feature flags are returned", func() {
+			BeforeEach(func() {
+				response := `[
+					{
+						"name": "user_org_creation",
+						"enabled": false,
+						"error_message": null,
+						"url": "/v2/config/feature_flags/user_org_creation"
+					},
+					{
+						"name": "app_scaling",
+						"enabled": true,
+						"error_message": null,
+						"url": "/v2/config/feature_flags/app_scaling"
+					}
+				]`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/feature_flags"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the feature flags and warnings", func() {
+				featureFlags, warnings, err := client.GetFeatureFlags()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(featureFlags).To(ConsistOf(
+					FeatureFlag{Name: "user_org_creation", Enabled: false},
+					FeatureFlag{Name: "app_scaling", Enabled: true},
+				))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10003,
+					"description": "You are not authorized to perform the requested action",
+					"error_code": "CF-NotAuthorized"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/feature_flags"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetFeatureFlags()
+				Expect(err).To(MatchError(ccerror.ForbiddenError{
+					Message: "You are not authorized to perform the requested action",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetFeatureFlag", func() {
+		Context("when the feature flag is returned", func() {
+			BeforeEach(func() {
+				response := `{
+					"name": "user_org_creation",
+					"enabled": true,
+					"error_message": null,
+					"url": "/v2/config/feature_flags/user_org_creation"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/feature_flags/user_org_creation"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the feature flag and warnings", func() {
+				featureFlag, warnings, err := client.GetFeatureFlag("user_org_creation")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(featureFlag).To(Equal(FeatureFlag{Name: "user_org_creation", Enabled: true}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the feature flag does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 330000,
+					"description": "The feature flag could not be found: some-flag",
+					"error_code": "CF-FeatureFlagNotFound"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/feature_flags/some-flag"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and warnings", func() {
+				_, warnings, err := client.GetFeatureFlag("some-flag")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The feature flag could not be found: some-flag",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("UpdateFeatureFlag", func() {
+		Context("when the feature flag is updated", func() {
+			BeforeEach(func() {
+				response := `{
+					"name": "user_org_creation",
+					"enabled": true,
+					"error_message": null,
+					"url": "/v2/config/feature_flags/user_org_creation"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/feature_flags/user_org_creation"),
+						VerifyJSON(`{"enabled":true}`),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns warnings", func() {
+				warnings, err := client.UpdateFeatureFlag(FeatureFlag{Name: "user_org_creation", Enabled: true})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the feature flag is disabled", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/feature_flags/app_scaling"),
+						VerifyJSON(`{"enabled":false}`),
+						RespondWith(http.StatusOK, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("sends enabled as false", func() {
+				warnings, err := client.UpdateFeatureFlag(FeatureFlag{Name: "app_scaling", Enabled: false})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 330000,
+					"description": "The feature flag could not be found: some-flag",
+					"error_code": "CF-FeatureFlagNotFound"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/feature_flags/some-flag"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.UpdateFeatureFlag(FeatureFlag{Name: "some-flag", Enabled: true})
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The feature flag could not be found: some-flag",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
@@ -15,58 +15,126 @@ import (
 //
 // The const name should always be the const value + Request.
 const (
-	DeleteOrganizationRequest              = "DeleteOrganization"
-	DeleteRouteRequest                     = "DeleteRoute"
-	DeleteRunningSecurityGroupSpaceRequest = "DeleteRunningSecurityGroupSpace"
-	DeleteSecurityGroupSpaceRequest        = "DeleteSecurityGroupSpace"
-	DeleteServiceBindingRequest            = "DeleteServiceBinding"
-	DeleteSpaceRequest                     = "DeleteSpaceRequest"
-	DeleteStagingSecurityGroupSpaceRequest = "DeleteStagingSecurityGroupSpace"
-	GetAppInstancesRequest                 = "GetAppInstances"
-	GetAppRequest                          = "GetApp"
-	GetAppRoutesRequest                    = "GetAppRoutes"
-	GetAppsRequest                         = "GetApps"
-	GetAppStatsRequest                     = "GetAppStats"
-	GetInfoRequest                         = "GetInfo"
-	GetJobRequest                          = "GetJob"
-	GetOrganizationPrivateDomainsRequest   = "GetOrganizationPrivateDomains"
-	GetOrganizationQuotaDefinitionRequest  = "GetOrganizationQuotaDefinition"
-	GetOrganizationRequest                 = "GetOrganization"
-	GetOrganizationsRequest                = "GetOrganizations"
-	GetPrivateDomainRequest                = "GetPrivateDomain"
-	GetRouteAppsRequest                    = "GetRouteApps"
-	GetRouteReservedRequest                = "GetRouteReserved"
-	GetRouteReservedDeprecatedRequest      = "GetRouteReservedDeprecated"
-	GetRouteRouteMappingsRequest           = "GetRouteRouteMappings"
-	GetRoutesRequest                       = "GetRoutes"
-	GetSecurityGroupRunningSpacesRequest   = "GetSecurityGroupRunningSpaces"
-	GetSecurityGroupsRequest               = "GetSecurityGroups"
-	GetSecurityGroupStagingSpacesRequest   = "GetSecurityGroupStagingSpaces"
-	GetServiceBindingsRequest              = "GetServiceBindings"
-	GetServiceInstanceRequest              = "GetServiceInstance"
-	GetServiceInstancesRequest             = "GetServiceInstances"
-	GetSharedDomainRequest                 = "GetSharedDomain"
-	GetSharedDomainsRequest                = "GetSharedDomains"
-	GetSpaceQuotaDefinitionRequest         = "GetSpaceQuotaDefinition"
-	GetSpaceRoutesRequest                  = "GetSpaceRoutes"
-	GetSpaceRunningSecurityGroupsRequest   = "GetSpaceRunningSecurityGroups"
-	GetSpaceServiceInstancesRequest        = "GetSpaceServiceInstances"
-	GetSpacesRequest                       = "GetSpaces"
-	GetSpaceStagingSecurityGroupsRequest   = "GetSpaceStagingSecurityGroups"
-	GetStackRequest                        = "GetStack"
-	GetStacksRequest                       = "GetStacks"
-	GetUsersRequest                        = "GetUsers"
-	PostAppRequest                         = "PostApp"
-	PostAppRestageRequest                  = "PostAppRestage"
-	PostRouteRequest                       = "PostRoute"
-	PostServiceBindingRequest              = "PostServiceBinding"
-	PostUserRequest                        = "PostUser"
-	PutAppBitsRequest                      = "PutAppBits"
-	PutAppRequest                          = "PutApp"
-	PutBindRouteAppRequest                 = "PutBindRouteApp"
-	PutResourceMatch                       = "PutResourceMatch"
-	PutRunningSecurityGroupSpaceRequest    = "PutRunningSecurityGroupSpace"
-	PutStagingSecurityGroupSpaceRequest    = "PutStagingSecurityGroupSpace"
+	DeleteAppRequest                                  = "DeleteApp"
+	DeleteBuildpackRequest                            = "DeleteBuildpack"
+	DeleteOrganizationAuditorByUsernameRequest        = "DeleteOrganizationAuditorByUsername"
+	DeleteOrganizationAuditorRequest                  = "DeleteOrganizationAuditor"
+	DeleteOrganizationBillingManagerByUsernameRequest = "DeleteOrganizationBillingManagerByUsername"
+	DeleteOrganizationBillingManagerRequest           = "DeleteOrganizationBillingManager"
+	DeleteOrganizationManagerByUsernameRequest        = "DeleteOrganizationManagerByUsername"
+	DeleteOrganizationManagerRequest                  = "DeleteOrganizationManager"
+	DeleteOrganizationRequest                         = "DeleteOrganization"
+	DeletePrivateDomainRequest                        = "DeletePrivateDomain"
+	DeleteRouteRequest                                = "DeleteRoute"
+	DeleteRunningSecurityGroupSpaceRequest            = "DeleteRunningSecurityGroupSpace"
+	DeleteSecurityGroupRequest                        = "DeleteSecurityGroup"
+	DeleteSecurityGroupSpaceRequest                   = "DeleteSecurityGroupSpace"
+	DeleteServiceBindingRequest                       = "DeleteServiceBinding"
+	DeleteServiceRequest                              = "DeleteService"
+	DeleteServiceInstanceRequest                      = "DeleteServiceInstance"
+	DeleteServiceKeyRequest                           = "DeleteServiceKey"
+	DeleteSpaceAuditorRequest                         = "DeleteSpaceAuditor"
+	DeleteSpaceDeveloperRequest                       = "DeleteSpaceDeveloper"
+	DeleteSpaceManagerRequest                         = "DeleteSpaceManager"
+	DeleteSpaceRequest                                = "DeleteSpaceRequest"
+	DeleteStagingSecurityGroupSpaceRequest            = "DeleteStagingSecurityGroupSpace"
+	DeleteUserRequest                                 = "DeleteUser"
+	GetAppInstancesRequest                            = "GetAppInstances"
+	GetAppRequest                                     = "GetApp"
+	GetAppRoutesRequest                               = "GetAppRoutes"
+	GetAppsRequest                                    = "GetApps"
+	GetAppStatsRequest                                = "GetAppStats"
+	GetBuildpacksRequest                              = "GetBuildpacks"
+	GetConfigEnvironmentVariableGroupRequest          = "GetConfigEnvironmentVariableGroup"
+	GetConfigFeatureFlagRequest                       = "GetConfigFeatureFlag"
+	GetConfigFeatureFlagsRequest                      = "GetConfigFeatureFlags"
+	GetInfoRequest                                    = "GetInfo"
+	GetJobRequest                                     = "GetJob"
+	GetOrganizationAuditorsRequest                    = "GetOrganizationAuditors"
+	GetOrganizationBillingManagersRequest             = "GetOrganizationBillingManagers"
+	GetOrganizationManagersRequest                    = "GetOrganizationManagers"
+	GetOrganizationPrivateDomainsRequest              = "GetOrganizationPrivateDomains"
+	GetOrganizationQuotaDefinitionRequest             = "GetOrganizationQuotaDefinition"
+	GetOrganizationQuotaDefinitionsRequest            = "GetOrganizationQuotaDefinitions"
+	GetOrganizationRequest                            = "GetOrganization"
+	GetOrganizationSpaceQuotasRequest                 = "GetOrganizationSpaceQuotas"
+	GetOrganizationUsersRequest                       = "GetOrganizationUsers"
+	GetOrganizationsRequest                           = "GetOrganizations"
+	GetPrivateDomainRequest                           = "GetPrivateDomain"
+	GetRouteAppsRequest                               = "GetRouteApps"
+	GetRouteMappingsRequest                           = "GetRouteMappings"
+	GetRouteReservedRequest                           = "GetRouteReserved"
+	GetRouteReservedDeprecatedRequest                 = "GetRouteReservedDeprecated"
+	GetRouteRouteMappingsRequest                      = "GetRouteRouteMappings"
+	GetRoutesRequest                                  = "GetRoutes"
+	GetSecurityGroupRunningSpacesRequest              = "GetSecurityGroupRunningSpaces"
+	GetSecurityGroupsRequest                          = "GetSecurityGroups"
+	GetSecurityGroupStagingSpacesRequest              = "GetSecurityGroupStagingSpaces"
+	GetServiceBindingRequest                          = "GetServiceBinding"
+	GetServiceBindingsRequest                         = "GetServiceBindings"
+	GetServiceInstanceRequest                         = "GetServiceInstance"
+	GetServiceInstancesRequest                        = "GetServiceInstances"
+	GetServiceKeysRequest                             = "GetServiceKeys"
+	GetServicePlanRequest                             = "GetServicePlan"
+	GetServiceRequest                                 = "GetService"
+	GetServicePlansRequest                            = "GetServicePlans"
+	GetServicesRequest                                = "GetServices"
+	GetSharedDomainRequest                            = "GetSharedDomain"
+	GetSharedDomainsRequest                           = "GetSharedDomains"
+	GetSpaceAuditorsRequest                           = "GetSpaceAuditors"
+	GetSpaceDevelopersRequest                         = "GetSpaceDevelopers"
+	GetSpaceManagersRequest                           = "GetSpaceManagers"
+	GetSpaceQuotaDefinitionRequest                    = "GetSpaceQuotaDefinition"
+	GetSpaceRoutesRequest                             = "GetSpaceRoutes"
+	GetSpaceRunningSecurityGroupsRequest              = "GetSpaceRunningSecurityGroups"
+	GetSpaceServiceInstancesRequest                   = "GetSpaceServiceInstances"
+	GetSpacesRequest                                  = "GetSpaces"
+	GetSpaceStagingSecurityGroupsRequest              = "GetSpaceStagingSecurityGroups"
+	GetStackRequest                                   = "GetStack"
+	GetStacksRequest                                  = "GetStacks"
+	GetUsersRequest                                   = "GetUsers"
+	PostAppRequest                                    = "PostApp"
+	PostAppRestageRequest                             = "PostAppRestage"
+	PostBuildpackRequest                              = "PostBuildpack"
+	PostOrganizationQuotaDefinitionRequest            = "PostOrganizationQuotaDefinition"
+	PostOrganizationRequest                           = "PostOrganization"
+	PostPrivateDomainRequest                          = "PostPrivateDomain"
+	PostRouteRequest                                  = "PostRoute"
+	PostServiceBindingRequest                         = "PostServiceBinding"
+	PostServiceInstancesRequest                       = "PostServiceInstances"
+	PostServiceKeyRequest                             = "PostServiceKey"
+	PostSharedDomainRequest                           = "PostSharedDomain"
+	PostSpaceQuotaDefinitionRequest                   = "PostSpaceQuotaDefinition"
+	PostSpaceRequest                                  = "PostSpace"
+	PostUserRequest                                   = "PostUser"
+	PutAppBitsRequest                                 = "PutAppBits"
+	PutAppRequest                                     = "PutApp"
+	PutBindRouteAppRequest                            = "PutBindRouteApp"
+	PutBuildpackBitsRequest                           = "PutBuildpackBits"
+	PutBuildpackRequest                               = "PutBuildpack"
+	PutConfigEnvironmentVariableGroupRequest          = "PutConfigEnvironmentVariableGroup"
+	PutConfigFeatureFlagRequest                       = "PutConfigFeatureFlag"
+	PutOrganizationAuditorByUsernameRequest           = "PutOrganizationAuditorByUsername"
+	PutOrganizationAuditorRequest                     = "PutOrganizationAuditor"
+	PutOrganizationBillingManagerByUsernameRequest    = "PutOrganizationBillingManagerByUsername"
+	PutOrganizationBillingManagerRequest              = "PutOrganizationBillingManager"
+	PutOrganizationManagerByUsernameRequest           = "PutOrganizationManagerByUsername"
+	PutOrganizationManagerRequest                     = "PutOrganizationManager"
+	PutOrganizationQuotaDefinitionRequest             = "PutOrganizationQuotaDefinition"
+	PutOrganizationUserByUsernameRequest              = "PutOrganizationUserByUsername"
+	PutOrganizationUserRequest                        = "PutOrganizationUser"
+	PutResourceMatch                                  = "PutResourceMatch"
+	PutRunningSecurityGroupSpaceRequest               = "PutRunningSecurityGroupSpace"
+	PutServiceInstanceRequest                         = "PutServiceInstance"
+	PutSpaceAuditorByUsernameRequest                  = "PutSpaceAuditorByUsername"
+	PutSpaceAuditorRequest                            = "PutSpaceAuditor"
+	PutSpaceDeveloperByUsernameRequest                = "PutSpaceDeveloperByUsername"
+	PutSpaceDeveloperRequest                          = "PutSpaceDeveloper"
+	PutSpaceManagerByUsernameRequest                  = "PutSpaceManagerByUsername"
+	PutSpaceManagerRequest                            = "PutSpaceManager"
+	PutSpaceQuotaDefinitionRequest                    = "PutSpaceQuotaDefinition"
+	PutSpaceQuotaRequest                              = "PutSpaceQuota"
+	PutStagingSecurityGroupSpaceRequest               = "PutStagingSecurityGroupSpace"
 )
 
 // APIRoutes is a list of routes used by the rata library to construct request
@@ -74,6 +142,7 @@ const (
 var APIRoutes = rata.Routes{
 	{Path: "/v2/apps", Method: http.MethodGet, Name: GetAppsRequest},
 	{Path: "/v2/apps", Method: http.MethodPost, Name: PostAppRequest},
+	{Path: "/v2/apps/:app_guid", Method: http.MethodDelete, Name: DeleteAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodGet, Name: GetAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodPut, Name: PutAppRequest},
 	{Path: "/v2/apps/:app_guid/bits", Method: http.MethodPut, Name: PutAppBitsRequest},
@@ -81,15 +150,51 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/apps/:app_guid/restage", Method: http.MethodPost, Name: PostAppRestageRequest},
 	{Path: "/v2/apps/:app_guid/routes", Method: http.MethodGet, Name: GetAppRoutesRequest},
 	{Path: "/v2/apps/:app_guid/stats", Method: http.MethodGet, Name: GetAppStatsRequest},
+	{Path: "/v2/buildpacks", Method: http.MethodGet, Name: GetBuildpacksRequest},
+	{Path: "/v2/buildpacks", Method: http.MethodPost, Name: PostBuildpackRequest},
+	{Path: "/v2/buildpacks/:buildpack_guid", Method: http.MethodDelete, Name: DeleteBuildpackRequest},
+	{Path: "/v2/buildpacks/:buildpack_guid", Method: http.MethodPut, Name: PutBuildpackRequest},
+	{Path: "/v2/buildpacks/:buildpack_guid/bits", Method: http.MethodPut, Name: PutBuildpackBitsRequest},
+	{Path: "/v2/config/environment_variable_groups/:group_name", Method: http.MethodGet, Name: GetConfigEnvironmentVariableGroupRequest},
+	{Path: "/v2/config/environment_variable_groups/:group_name", Method: http.MethodPut, Name: PutConfigEnvironmentVariableGroupRequest},
+	{Path: "/v2/config/feature_flags", Method: http.MethodGet, Name: GetConfigFeatureFlagsRequest},
+	{Path: "/v2/config/feature_flags/:feature_flag_name", Method: http.MethodGet, Name: GetConfigFeatureFlagRequest},
+	{Path: "/v2/config/feature_flags/:feature_flag_name", Method: http.MethodPut, Name: PutConfigFeatureFlagRequest},
 	{Path: "/v2/info", Method: http.MethodGet, Name: GetInfoRequest},
 	{Path: "/v2/jobs/:job_guid", Method: http.MethodGet, Name: GetJobRequest},
 	{Path: "/v2/organizations", Method: http.MethodGet, Name: GetOrganizationsRequest},
+	{Path: "/v2/organizations", Method: http.MethodPost, Name: PostOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodGet, Name: GetOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid/auditors", Method: http.MethodGet, Name: GetOrganizationAuditorsRequest},
+	{Path: "/v2/organizations/:organization_guid/auditors", Method: http.MethodDelete, Name: DeleteOrganizationAuditorByUsernameRequest},
+	{Path: "/v2/organizations/:organization_guid/auditors", Method: http.MethodPut, Name: PutOrganizationAuditorByUsernameRequest},
+	{Path: "/v2/organizations/:organization_guid/auditors/:user_guid", Method: http.MethodDelete, Name: DeleteOrganizationAuditorRequest},
+	{Path: "/v2/organizations/:organization_guid/auditors/:user_guid", Method: http.MethodPut, Name: PutOrganizationAuditorRequest},
+	{Path: "/v2/organizations/:organization_guid/billing_managers", Method: http.MethodGet, Name: GetOrganizationBillingManagersRequest},
+	{Path: "/v2/organizations/:organization_guid/billing_managers", Method: http.MethodDelete, Name: DeleteOrganizationBillingManagerByUsernameRequest},
+	{Path: "/v2/organizations/:organization_guid/billing_managers", Method: http.MethodPut, Name: PutOrganizationBillingManagerByUsernameRequest},
+	{Path: "/v2/organizations/:organization_guid/billing_managers/:user_guid", Method: http.MethodDelete, Name: DeleteOrganizationBillingManagerRequest},
+	{Path: "/v2/organizations/:organization_guid/billing_managers/:user_guid", Method: http.MethodPut, Name: PutOrganizationBillingManagerRequest},
+	{Path: "/v2/organizations/:organization_guid/managers", Method: http.MethodGet, Name: GetOrganizationManagersRequest},
+	{Path: "/v2/organizations/:organization_guid/managers", Method: http.MethodDelete, Name: DeleteOrganizationManagerByUsernameRequest},
+	{Path: "/v2/organizations/:organization_guid/managers", Method: http.MethodPut, Name: PutOrganizationManagerByUsernameRequest},
+	{Path: "/v2/organizations/:organization_guid/managers/:user_guid", Method: http.MethodDelete, Name: DeleteOrganizationManagerRequest},
+	{Path: "/v2/organizations/:organization_guid/managers/:user_guid", Method: http.MethodPut, Name: PutOrganizationManagerRequest},
 	{Path: "/v2/organizations/:organization_guid/private_domains", Method: http.MethodGet, Name: GetOrganizationPrivateDomainsRequest},
+	{Path: "/v2/organizations/:organization_guid/space_quota_definitions", Method: http.MethodGet, Name: GetOrganizationSpaceQuotasRequest},
+	{Path: "/v2/organizations/:organization_guid/users", Method: http.MethodGet, Name: GetOrganizationUsersRequest},
+	{Path: "/v2/organizations/:organization_guid/users", Method: http.MethodPut, Name: PutOrganizationUserByUsernameRequest},
+	{Path: "/v2/organizations/:organization_guid/users/:user_guid", Method: http.MethodPut, Name: PutOrganizationUserRequest},
+	{Path: "/v2/private_domains", Method: http.MethodPost, Name: PostPrivateDomainRequest},
 	{Path: "/v2/private_domains/:private_domain_guid", Method: http.MethodGet, Name: GetPrivateDomainRequest},
+	{Path: "/v2/private_domains/:private_domain_guid", Method: http.MethodDelete, Name: DeletePrivateDomainRequest},
+	{Path: "/v2/quota_definitions", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionsRequest},
+	{Path: "/v2/quota_definitions", Method: http.MethodPost, Name: PostOrganizationQuotaDefinitionRequest},
 	{Path: "/v2/quota_definitions/:organization_quota_guid", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionRequest},
+	{Path: "/v2/quota_definitions/:organization_quota_guid", Method: http.MethodPut, Name: PutOrganizationQuotaDefinitionRequest},
 	{Path: "/v2/resource_match", Method: http.MethodPut, Name: PutResourceMatch},
+	{Path: "/v2/route_mappings", Method: http.MethodGet, Name: GetRouteMappingsRequest},
 	{Path: "/v2/routes", Method: http.MethodGet, Name: GetRoutesRequest},
 	{Path: "/v2/routes", Method: http.MethodPost, Name: PostRouteRequest},
 	{Path: "/v2/routes/:route_guid", Method: http.MethodDelete, Name: DeleteRouteRequest},
@@ -99,6 +204,7 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/routes/reserved/domain/:domain_guid", Method: http.MethodGet, Name: GetRouteReservedRequest},
 	{Path: "/v2/routes/reserved/domain/:domain_guid/host/:host", Method: http.MethodGet, Name: GetRouteReservedDeprecatedRequest},
 	{Path: "/v2/security_groups", Method: http.MethodGet, Name: GetSecurityGroupsRequest},
+	{Path: "/v2/security_groups/:security_group_guid", Method: http.MethodDelete, Name: DeleteSecurityGroupRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces", Method: http.MethodGet, Name: GetSecurityGroupRunningSpacesRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteRunningSecurityGroupSpaceRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces/:space_guid", Method: http.MethodPut, Name: PutRunningSecurityGroupSpaceRequest},
@@ -108,18 +214,48 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/service_bindings", Method: http.MethodGet, Name: GetServiceBindingsRequest},
 	{Path: "/v2/service_bindings", Method: http.MethodPost, Name: PostServiceBindingRequest},
 	{Path: "/v2/service_bindings/:service_binding_guid", Method: http.MethodDelete, Name: DeleteServiceBindingRequest},
+	{Path: "/v2/service_bindings/:service_binding_guid", Method: http.MethodGet, Name: GetServiceBindingRequest},
 	{Path: "/v2/service_instances", Method: http.MethodGet, Name: GetServiceInstancesRequest},
+	{Path: "/v2/service_instances", Method: http.MethodPost, Name: PostServiceInstancesRequest},
+	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodDelete, Name: DeleteServiceInstanceRequest},
 	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodGet, Name: GetServiceInstanceRequest},
+	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodPut, Name: PutServiceInstanceRequest},
+	{Path: "/v2/service_keys", Method: http.MethodGet, Name: GetServiceKeysRequest},
+	{Path: "/v2/service_keys", Method: http.MethodPost, Name: PostServiceKeyRequest},
+	{Path: "/v2/service_keys/:service_key_guid", Method: http.MethodDelete, Name: DeleteServiceKeyRequest},
+	{Path: "/v2/service_plans", Method: http.MethodGet, Name: GetServicePlansRequest},
+	{Path: "/v2/service_plans/:service_plan_guid", Method: http.MethodGet, Name: GetServicePlanRequest},
+	{Path: "/v2/services", Method: http.MethodGet, Name: GetServicesRequest},
+	{Path: "/v2/services/:service_guid", Method: http.MethodDelete, Name: DeleteServiceRequest},
+	{Path: "/v2/services/:service_guid", Method: http.MethodGet, Name: GetServiceRequest},
 	{Path: "/v2/shared_domains", Method: http.MethodGet, Name: GetSharedDomainsRequest},
+	{Path: "/v2/shared_domains", Method: http.MethodPost, Name: PostSharedDomainRequest},
 	{Path: "/v2/shared_domains/:shared_domain_guid", Method: http.MethodGet, Name: GetSharedDomainRequest},
+	{Path: "/v2/space_quota_definitions", Method: http.MethodPost, Name: PostSpaceQuotaDefinitionRequest},
 	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodGet, Name: GetSpaceQuotaDefinitionRequest},
+	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodPut, Name: PutSpaceQuotaDefinitionRequest},
+	{Path: "/v2/space_quota_definitions/:space_quota_guid/spaces/:space_guid", Method: http.MethodPut, Name: PutSpaceQuotaRequest},
 	{Path: "/v2/spaces", Method: http.MethodGet, Name: GetSpacesRequest},
+	{Path: "/v2/spaces", Method: http.MethodPost, Name: PostSpaceRequest},
 	{Path: "/v2/spaces/:guid/service_instances", Method: http.MethodGet, Name: GetSpaceServiceInstancesRequest},
 	{Path: "/v2/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteSpaceRequest},
+	{Path: "/v2/spaces/:space_guid/auditors", Method: http.MethodGet, Name: GetSpaceAuditorsRequest},
+	{Path: "/v2/spaces/:space_guid/auditors", Method: http.MethodPut, Name: PutSpaceAuditorByUsernameRequest},
+	{Path: "/v2/spaces/:space_guid/auditors/:user_guid", Method: http.MethodDelete, Name: DeleteSpaceAuditorRequest},
+	{Path: "/v2/spaces/:space_guid/auditors/:user_guid", Method: http.MethodPut, Name: PutSpaceAuditorRequest},
+	{Path: "/v2/spaces/:space_guid/developers", Method: http.MethodGet, Name: GetSpaceDevelopersRequest},
+	{Path: "/v2/spaces/:space_guid/developers", Method: http.MethodPut, Name: PutSpaceDeveloperByUsernameRequest},
+	{Path: "/v2/spaces/:space_guid/developers/:user_guid", Method: http.MethodDelete, Name: DeleteSpaceDeveloperRequest},
+	{Path: "/v2/spaces/:space_guid/developers/:user_guid", Method: http.MethodPut, Name: PutSpaceDeveloperRequest},
+	{Path: "/v2/spaces/:space_guid/managers", Method: http.MethodGet, Name: GetSpaceManagersRequest},
+	{Path: "/v2/spaces/:space_guid/managers", Method: http.MethodPut, Name: PutSpaceManagerByUsernameRequest},
+	{Path: "/v2/spaces/:space_guid/managers/:user_guid", Method: http.MethodDelete, Name: DeleteSpaceManagerRequest},
+	{Path: "/v2/spaces/:space_guid/managers/:user_guid", Method: http.MethodPut, Name: PutSpaceManagerRequest},
 	{Path: "/v2/spaces/:space_guid/routes", Method: http.MethodGet, Name: GetSpaceRoutesRequest},
 	{Path: "/v2/spaces/:space_guid/security_groups", Method: http.MethodGet, Name: GetSpaceRunningSecurityGroupsRequest},
 	{Path: "/v2/spaces/:space_guid/staging_security_groups", Method: http.MethodGet, Name: GetSpaceStagingSecurityGroupsRequest},
 	{Path: "/v2/stacks", Method: http.MethodGet, Name: GetStacksRequest},
 	{Path: "/v2/stacks/:stack_guid", Method: http.MethodGet, Name: GetStackRequest},
 	{Path: "/v2/users", Method: http.MethodPost, Name: PostUserRequest},
+	{Path: "/v2/users/:user_guid", Method: http.MethodDelete, Name: DeleteUserRequest},
 }
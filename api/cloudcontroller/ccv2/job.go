@@ -6,11 +6,11 @@ import (
 	"io"
 	"mime/multipart"
 	"net/url"
-	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/jobs"
 )
 
 //go:generate counterfeiter . Reader
@@ -101,43 +101,28 @@ func (client *Client) GetJob(jobGUID string) (Job, Warnings, error) {
 }
 
 // PollJob will keep polling the given job until the job has terminated, an
-// error is encountered, or config.OverallPollingTimeout is reached. In the
-// last case, a JobTimeoutError is returned.
+// error is encountered, config.JobPollingCanceller cancels polling, or
+// config.JobPollingTimeout is reached. In the last case, a JobTimeoutError is
+// returned.
 func (client *Client) PollJob(job Job) (Warnings, error) {
-	originalJobGUID := job.GUID
-
-	var (
-		err         error
-		warnings    Warnings
-		allWarnings Warnings
-	)
-
-	startTime := time.Now()
-	for time.Now().Sub(startTime) < client.jobPollingTimeout {
-		job, warnings, err = client.GetJob(job.GUID)
-		allWarnings = append(allWarnings, Warnings(warnings)...)
-		if err != nil {
-			return allWarnings, err
-		}
-
-		if job.Failed() {
-			return allWarnings, ccerror.JobFailedError{
-				JobGUID: originalJobGUID,
-				Message: job.ErrorDetails.Description,
-			}
-		}
-
-		if job.Finished() {
-			return allWarnings, nil
-		}
+	poller := jobs.NewPoller(jobs.JobGetterFunc(client.getJobState), client.jobPollingCanceller)
+	warnings, err := poller.PollJob(job.GUID, client.jobPollingInterval, client.jobPollingTimeout)
+	return Warnings(warnings), err
+}
 
-		time.Sleep(client.jobPollingInterval)
+// getJobState converts the job for the provided GUID into a jobs.State.
+func (client *Client) getJobState(jobGUID string) (jobs.State, []string, error) {
+	job, warnings, err := client.GetJob(jobGUID)
+	if err != nil {
+		return jobs.State{}, warnings, err
 	}
 
-	return allWarnings, ccerror.JobTimeoutError{
-		JobGUID: originalJobGUID,
-		Timeout: client.jobPollingTimeout,
-	}
+	return jobs.State{
+		GUID:           jobGUID,
+		Complete:       job.Finished(),
+		Failed:         job.Failed(),
+		FailureMessage: job.ErrorDetails.Description,
+	}, warnings, nil
 }
 
 // UploadApplicationPackage uploads the newResources and a list of existing
@@ -181,40 +166,7 @@ func (client *Client) UploadApplicationPackage(appGUID string, existingResources
 
 	httpErrors := client.uploadBits(request, &response)
 
-	// The following section makes the following assumptions:
-	// 1) If an error occurs during file reading, an EOF is sent to the request
-	// object. Thus ending the request transfer.
-	// 2) If an error occurs during request transfer, an EOF is sent to the pipe.
-	// Thus ending the writing routine.
-	var firstError error
-	var writeClosed, httpClosed bool
-
-	for {
-		select {
-		case writeErr, ok := <-writeErrors:
-			if !ok {
-				writeClosed = true
-				break
-			}
-			if firstError == nil {
-				firstError = writeErr
-			}
-		case httpErr, ok := <-httpErrors:
-			if !ok {
-				httpClosed = true
-				break
-			}
-			if firstError == nil {
-				firstError = httpErr
-			}
-		}
-
-		if writeClosed && httpClosed {
-			break
-		}
-	}
-
-	return job, response.Warnings, firstError
+	return job, response.Warnings, waitForUpload(writeErrors, httpErrors)
 }
 
 func (*Client) createMultipartBodyAndHeaderForAppBits(existingResources []Resource, newResources io.Reader, newResourcesLength int64) (string, io.ReadSeeker, <-chan error) {
@@ -300,3 +252,42 @@ func (client *Client) uploadBits(request *cloudcontroller.Request, response *clo
 
 	return httpErrors
 }
+
+// waitForUpload waits for both the writing of the request body and the
+// request itself to finish, and returns the first error from either.
+func waitForUpload(writeErrors <-chan error, httpErrors <-chan error) error {
+	// The following section makes the following assumptions:
+	// 1) If an error occurs during file reading, an EOF is sent to the request
+	// object. Thus ending the request transfer.
+	// 2) If an error occurs during request transfer, an EOF is sent to the pipe.
+	// Thus ending the writing routine.
+	var firstError error
+	var writeClosed, httpClosed bool
+
+	for {
+		select {
+		case writeErr, ok := <-writeErrors:
+			if !ok {
+				writeClosed = true
+				break
+			}
+			if firstError == nil {
+				firstError = writeErr
+			}
+		case httpErr, ok := <-httpErrors:
+			if !ok {
+				httpClosed = true
+				break
+			}
+			if firstError == nil {
+				firstError = httpErr
+			}
+		}
+
+		if writeClosed && httpClosed {
+			break
+		}
+	}
+
+	return firstError
+}
@@ -0,0 +1,25 @@
+package ccv2
+
+// LastOperationState is the state of the last asynchronous operation a
+// service broker performed on a service instance or service binding.
+type LastOperationState string
+
+const (
+	// LastOperationFailed is when the broker failed to complete the operation.
+	LastOperationFailed LastOperationState = "failed"
+
+	// LastOperationInProgress is when the broker is still performing the
+	// operation asynchronously.
+	LastOperationInProgress LastOperationState = "in progress"
+
+	// LastOperationSucceeded is when the broker completed the operation.
+	LastOperationSucceeded LastOperationState = "succeeded"
+)
+
+// LastOperation is the last operation a service broker performed on a service
+// instance or service binding.
+type LastOperation struct {
+	Description string
+	State       LastOperationState
+	Type        string
+}
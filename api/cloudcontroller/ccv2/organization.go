@@ -1,6 +1,7 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -37,9 +38,50 @@ func (org *Organization) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// createOrganizationRequestBody represents the body of a create organization
+// request.
+type createOrganizationRequestBody struct {
+	Name                string `json:"name"`
+	QuotaDefinitionGUID string `json:"quota_definition_guid,omitempty"`
+}
+
+// organizationRoleRequestBody represents the body of a request granting or
+// revoking an organization role by username.
+type organizationRoleRequestBody struct {
+	Username string `json:"username"`
+}
+
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Organization codetemplates/delete_async_by_guid.go.template delete_organization.go
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Organization codetemplates/delete_async_by_guid_test.go.template delete_organization_test.go
 
+// CreateOrganization creates a new Organization with the provided name. When
+// quotaGUID is empty the organization is assigned the default quota.
+func (client *Client) CreateOrganization(orgName string, quotaGUID string) (Organization, Warnings, error) {
+	bodyBytes, err := json.Marshal(createOrganizationRequestBody{
+		Name:                orgName,
+		QuotaDefinitionGUID: quotaGUID,
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostOrganizationRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var org Organization
+	response := cloudcontroller.Response{
+		Result: &org,
+	}
+
+	err = client.connection.Make(request, &response)
+	return org, response.Warnings, err
+}
+
 // GetOrganization returns an Organization associated with the provided guid.
 func (client *Client) GetOrganization(guid string) (Organization, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -86,3 +128,136 @@ func (client *Client) GetOrganizations(queries ...Query) ([]Organization, Warnin
 
 	return fullOrgsList, warnings, err
 }
+
+// UpdateOrganizationUser adds the user or client with the provided GUID to
+// the provided Organization.
+func (client *Client) UpdateOrganizationUser(orgGUID string, userGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutOrganizationUserRequest,
+		URIParams:   Params{"organization_guid": orgGUID, "user_guid": userGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// UpdateOrganizationAuditor grants the OrgAuditor role in the provided
+// Organization to the user or client with the provided GUID.
+func (client *Client) UpdateOrganizationAuditor(orgGUID string, userGUID string) (Warnings, error) {
+	return client.makeOrganizationRoleRequest(internal.PutOrganizationAuditorRequest, orgGUID, userGUID)
+}
+
+// UpdateOrganizationAuditorByUsername grants the OrgAuditor role in the
+// provided Organization to the user with the provided username.
+func (client *Client) UpdateOrganizationAuditorByUsername(orgGUID string, username string) (Warnings, error) {
+	return client.makeOrganizationRoleRequestByUsername(internal.PutOrganizationAuditorByUsernameRequest, orgGUID, username)
+}
+
+// UpdateOrganizationBillingManager grants the BillingManager role in the provided
+// Organization to the user or client with the provided GUID.
+func (client *Client) UpdateOrganizationBillingManager(orgGUID string, userGUID string) (Warnings, error) {
+	return client.makeOrganizationRoleRequest(internal.PutOrganizationBillingManagerRequest, orgGUID, userGUID)
+}
+
+// UpdateOrganizationBillingManagerByUsername grants the BillingManager role in the
+// provided Organization to the user with the provided username.
+func (client *Client) UpdateOrganizationBillingManagerByUsername(orgGUID string, username string) (Warnings, error) {
+	return client.makeOrganizationRoleRequestByUsername(internal.PutOrganizationBillingManagerByUsernameRequest, orgGUID, username)
+}
+
+// UpdateOrganizationManager grants the OrgManager role in the provided
+// Organization to the user or client with the provided GUID.
+func (client *Client) UpdateOrganizationManager(orgGUID string, userGUID string) (Warnings, error) {
+	return client.makeOrganizationRoleRequest(internal.PutOrganizationManagerRequest, orgGUID, userGUID)
+}
+
+// UpdateOrganizationManagerByUsername grants the OrgManager role in the
+// provided Organization to the user with the provided username.
+func (client *Client) UpdateOrganizationManagerByUsername(orgGUID string, username string) (Warnings, error) {
+	return client.makeOrganizationRoleRequestByUsername(internal.PutOrganizationManagerByUsernameRequest, orgGUID, username)
+}
+
+// UpdateOrganizationUserByUsername adds the user with the provided username
+// to the provided Organization.
+func (client *Client) UpdateOrganizationUserByUsername(orgGUID string, username string) (Warnings, error) {
+	return client.makeOrganizationRoleRequestByUsername(internal.PutOrganizationUserByUsernameRequest, orgGUID, username)
+}
+
+// DeleteOrganizationAuditor revokes the OrgAuditor role in the provided
+// Organization from the user or client with the provided GUID.
+func (client *Client) DeleteOrganizationAuditor(orgGUID string, userGUID string) (Warnings, error) {
+	return client.makeOrganizationRoleRequest(internal.DeleteOrganizationAuditorRequest, orgGUID, userGUID)
+}
+
+// DeleteOrganizationAuditorByUsername revokes the OrgAuditor role in the
+// provided Organization from the user with the provided username.
+func (client *Client) DeleteOrganizationAuditorByUsername(orgGUID string, username string) (Warnings, error) {
+	return client.makeOrganizationRoleRequestByUsername(internal.DeleteOrganizationAuditorByUsernameRequest, orgGUID, username)
+}
+
+// DeleteOrganizationBillingManager revokes the BillingManager role in the provided
+// Organization from the user or client with the provided GUID.
+func (client *Client) DeleteOrganizationBillingManager(orgGUID string, userGUID string) (Warnings, error) {
+	return client.makeOrganizationRoleRequest(internal.DeleteOrganizationBillingManagerRequest, orgGUID, userGUID)
+}
+
+// DeleteOrganizationBillingManagerByUsername revokes the BillingManager role in the
+// provided Organization from the user with the provided username.
+func (client *Client) DeleteOrganizationBillingManagerByUsername(orgGUID string, username string) (Warnings, error) {
+	return client.makeOrganizationRoleRequestByUsername(internal.DeleteOrganizationBillingManagerByUsernameRequest, orgGUID, username)
+}
+
+// DeleteOrganizationManager revokes the OrgManager role in the provided
+// Organization from the user or client with the provided GUID.
+func (client *Client) DeleteOrganizationManager(orgGUID string, userGUID string) (Warnings, error) {
+	return client.makeOrganizationRoleRequest(internal.DeleteOrganizationManagerRequest, orgGUID, userGUID)
+}
+
+// DeleteOrganizationManagerByUsername revokes the OrgManager role in the
+// provided Organization from the user with the provided username.
+func (client *Client) DeleteOrganizationManagerByUsername(orgGUID string, username string) (Warnings, error) {
+	return client.makeOrganizationRoleRequestByUsername(internal.DeleteOrganizationManagerByUsernameRequest, orgGUID, username)
+}
+
+func (client *Client) makeOrganizationRoleRequest(requestName string, orgGUID string, userGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   Params{"organization_guid": orgGUID, "user_guid": userGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+func (client *Client) makeOrganizationRoleRequestByUsername(requestName string, orgGUID string, username string) (Warnings, error) {
+	bodyBytes, err := json.Marshal(organizationRoleRequestBody{
+		Username: username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   Params{"organization_guid": orgGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
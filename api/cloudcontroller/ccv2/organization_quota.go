@@ -1,37 +1,115 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // OrganizationQuota is the definition of a quota for an organization.
 type OrganizationQuota struct {
+	// GUID is the unique organization quota identifier.
 	GUID string
+
+	// Name is the name of the organization quota.
 	Name string
+
+	// NonBasicServicesAllowed is true when instances of paid service plans
+	// can be provisioned.
+	NonBasicServicesAllowed types.NullBool
+
+	// TotalServices is the maximum number of service instances. -1 represents
+	// an unlimited amount.
+	TotalServices types.NullInt
+
+	// TotalRoutes is the maximum number of routes. -1 represents an unlimited
+	// amount.
+	TotalRoutes types.NullInt
+
+	// TotalReservedRoutePorts is the maximum number of routes with reserved
+	// ports. -1 represents an unlimited amount.
+	TotalReservedRoutePorts types.NullInt
+
+	// MemoryLimit is the maximum amount of memory in megabytes that all
+	// application instances can use.
+	MemoryLimit types.NullInt
+
+	// InstanceMemoryLimit is the maximum amount of memory in megabytes that a
+	// single application instance can use. -1 represents an unlimited amount.
+	InstanceMemoryLimit types.NullInt
+
+	// AppInstanceLimit is the maximum number of application instances. -1
+	// represents an unlimited amount.
+	AppInstanceLimit types.NullInt
+}
+
+// MarshalJSON converts an OrganizationQuota into a Cloud Controller quota
+// definition. Unset limits are omitted so that they are left unchanged on
+// update.
+func (orgQuota OrganizationQuota) MarshalJSON() ([]byte, error) {
+	ccQuota := struct {
+		Name                    string `json:"name,omitempty"`
+		NonBasicServicesAllowed *bool  `json:"non_basic_services_allowed,omitempty"`
+		TotalServices           *int   `json:"total_services,omitempty"`
+		TotalRoutes             *int   `json:"total_routes,omitempty"`
+		TotalReservedRoutePorts *int   `json:"total_reserved_route_ports,omitempty"`
+		MemoryLimit             *int   `json:"memory_limit,omitempty"`
+		InstanceMemoryLimit     *int   `json:"instance_memory_limit,omitempty"`
+		AppInstanceLimit        *int   `json:"app_instance_limit,omitempty"`
+	}{
+		Name: orgQuota.Name,
+	}
+
+	if orgQuota.NonBasicServicesAllowed.IsSet {
+		ccQuota.NonBasicServicesAllowed = &orgQuota.NonBasicServicesAllowed.Value
+	}
+	ccQuota.TotalServices = nullIntPointer(orgQuota.TotalServices)
+	ccQuota.TotalRoutes = nullIntPointer(orgQuota.TotalRoutes)
+	ccQuota.TotalReservedRoutePorts = nullIntPointer(orgQuota.TotalReservedRoutePorts)
+	ccQuota.MemoryLimit = nullIntPointer(orgQuota.MemoryLimit)
+	ccQuota.InstanceMemoryLimit = nullIntPointer(orgQuota.InstanceMemoryLimit)
+	ccQuota.AppInstanceLimit = nullIntPointer(orgQuota.AppInstanceLimit)
+
+	return json.Marshal(ccQuota)
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller organization quota response.
-func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
+func (orgQuota *OrganizationQuota) UnmarshalJSON(data []byte) error {
 	var ccOrgQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name                    string         `json:"name"`
+			NonBasicServicesAllowed types.NullBool `json:"non_basic_services_allowed"`
+			TotalServices           types.NullInt  `json:"total_services"`
+			TotalRoutes             types.NullInt  `json:"total_routes"`
+			TotalReservedRoutePorts types.NullInt  `json:"total_reserved_route_ports"`
+			MemoryLimit             types.NullInt  `json:"memory_limit"`
+			InstanceMemoryLimit     types.NullInt  `json:"instance_memory_limit"`
+			AppInstanceLimit        types.NullInt  `json:"app_instance_limit"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccOrgQuota); err != nil {
 		return err
 	}
 
-	application.GUID = ccOrgQuota.Metadata.GUID
-	application.Name = ccOrgQuota.Entity.Name
+	orgQuota.GUID = ccOrgQuota.Metadata.GUID
+	orgQuota.Name = ccOrgQuota.Entity.Name
+	orgQuota.NonBasicServicesAllowed = ccOrgQuota.Entity.NonBasicServicesAllowed
+	orgQuota.TotalServices = ccOrgQuota.Entity.TotalServices
+	orgQuota.TotalRoutes = ccOrgQuota.Entity.TotalRoutes
+	orgQuota.TotalReservedRoutePorts = ccOrgQuota.Entity.TotalReservedRoutePorts
+	orgQuota.MemoryLimit = ccOrgQuota.Entity.MemoryLimit
+	orgQuota.InstanceMemoryLimit = ccOrgQuota.Entity.InstanceMemoryLimit
+	orgQuota.AppInstanceLimit = ccOrgQuota.Entity.AppInstanceLimit
 
 	return nil
 }
 
-// GetOrganizaitonQuota gets an organization quota (quota definition) from the API.
+// GetOrganizationQuota gets an organization quota (quota definition) from the API.
 func (client *Client) GetOrganizationQuota(guid string) (OrganizationQuota, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.GetOrganizationQuotaDefinitionRequest,
@@ -49,3 +127,75 @@ func (client *Client) GetOrganizationQuota(guid string) (OrganizationQuota, Warn
 	err = client.connection.Make(request, &response)
 	return orgQuota, response.Warnings, err
 }
+
+// CreateOrganizationQuota creates an organization quota (quota definition)
+// with the provided limits.
+func (client *Client) CreateOrganizationQuota(orgQuota OrganizationQuota) (OrganizationQuota, Warnings, error) {
+	return client.makeOrganizationQuotaRequest(internal.PostOrganizationQuotaDefinitionRequest, nil, orgQuota)
+}
+
+// GetOrganizationQuotas returns the organization quotas (quota definitions)
+// based off of the provided queries.
+func (client *Client) GetOrganizationQuotas(queries ...Query) ([]OrganizationQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationQuotaDefinitionsRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullOrgQuotasList []OrganizationQuota
+	warnings, err := client.paginate(request, OrganizationQuota{}, func(item interface{}) error {
+		if orgQuota, ok := item.(OrganizationQuota); ok {
+			fullOrgQuotasList = append(fullOrgQuotasList, orgQuota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   OrganizationQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullOrgQuotasList, warnings, err
+}
+
+// UpdateOrganizationQuota updates the organization quota with the provided
+// GUID. Only the name and the set limits of the provided quota are changed.
+func (client *Client) UpdateOrganizationQuota(orgQuota OrganizationQuota) (OrganizationQuota, Warnings, error) {
+	return client.makeOrganizationQuotaRequest(internal.PutOrganizationQuotaDefinitionRequest, Params{"organization_quota_guid": orgQuota.GUID}, orgQuota)
+}
+
+func (client *Client) makeOrganizationQuotaRequest(requestName string, uriParams Params, orgQuota OrganizationQuota) (OrganizationQuota, Warnings, error) {
+	bodyBytes, err := json.Marshal(orgQuota)
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	var updatedOrgQuota OrganizationQuota
+	response := cloudcontroller.Response{
+		Result: &updatedOrgQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedOrgQuota, response.Warnings, err
+}
+
+// nullIntPointer returns a pointer to the value of the provided NullInt, or
+// nil when it is not set, so that unset values can be omitted from requests.
+func nullIntPointer(value types.NullInt) *int {
+	if !value.IsSet {
+		return nil
+	}
+	return &value.Value
+}
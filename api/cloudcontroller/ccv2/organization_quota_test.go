@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -73,4 +74,230 @@ var _ = Describe("OrganizationQuota", func() {
 		})
 
 	})
+
+	Describe("CreateOrganizationQuota", func() {
+		Context("when the creation is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-org-quota-guid"
+					},
+					"entity": {
+						"name": "some-org-quota",
+						"non_basic_services_allowed": true,
+						"total_services": 10,
+						"total_routes": -1,
+						"total_reserved_route_ports": 0,
+						"memory_limit": 2048,
+						"instance_memory_limit": -1,
+						"app_instance_limit": -1
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/quota_definitions"),
+						VerifyJSON(`{
+							"name": "some-org-quota",
+							"non_basic_services_allowed": true,
+							"total_services": 10,
+							"total_routes": -1,
+							"total_reserved_route_ports": 0,
+							"memory_limit": 2048,
+							"instance_memory_limit": -1,
+							"app_instance_limit": -1
+						}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created organization quota and warnings", func() {
+				orgQuota := OrganizationQuota{
+					Name:                    "some-org-quota",
+					NonBasicServicesAllowed: types.NullBool{IsSet: true, Value: true},
+					TotalServices:           types.NullInt{IsSet: true, Value: 10},
+					TotalRoutes:             types.NullInt{IsSet: true, Value: -1},
+					TotalReservedRoutePorts: types.NullInt{IsSet: true, Value: 0},
+					MemoryLimit:             types.NullInt{IsSet: true, Value: 2048},
+					InstanceMemoryLimit:     types.NullInt{IsSet: true, Value: -1},
+					AppInstanceLimit:        types.NullInt{IsSet: true, Value: -1},
+				}
+
+				createdQuota, warnings, err := client.CreateOrganizationQuota(orgQuota)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				orgQuota.GUID = "some-org-quota-guid"
+				Expect(createdQuota).To(Equal(orgQuota))
+			})
+		})
+
+		Context("when the quota name is taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 240002,
+					"description": "Quota Definition is taken: some-org-quota",
+					"error_code": "CF-QuotaDefinitionNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/quota_definitions"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateOrganizationQuota(OrganizationQuota{Name: "some-org-quota"})
+				Expect(err).To(MatchError(ccerror.QuotaNameTakenError{Message: "Quota Definition is taken: some-org-quota"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetOrganizationQuotas", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/quota_definitions?q=name:some-org-quota&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "org-quota-guid-1"
+							},
+							"entity": {
+								"name": "some-org-quota"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "org-quota-guid-2"
+							},
+							"entity": {
+								"name": "some-org-quota"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/quota_definitions", "q=name:some-org-quota"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/quota_definitions", "q=name:some-org-quota&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns all the organization quotas and all warnings", func() {
+				orgQuotas, warnings, err := client.GetOrganizationQuotas(Query{
+					Filter:   NameFilter,
+					Operator: EqualOperator,
+					Values:   []string{"some-org-quota"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(orgQuotas).To(ConsistOf(
+					OrganizationQuota{GUID: "org-quota-guid-1", Name: "some-org-quota"},
+					OrganizationQuota{GUID: "org-quota-guid-2", Name: "some-org-quota"},
+				))
+			})
+		})
+
+		Context("when the request returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/quota_definitions"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetOrganizationQuotas()
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateOrganizationQuota", func() {
+		Context("when the update is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-org-quota-guid"
+					},
+					"entity": {
+						"name": "new-org-quota",
+						"total_routes": 8
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/quota_definitions/some-org-quota-guid"),
+						VerifyJSON(`{"name":"new-org-quota","total_routes":8}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("only sends the name and the set limits", func() {
+				orgQuota, warnings, err := client.UpdateOrganizationQuota(OrganizationQuota{
+					GUID:        "some-org-quota-guid",
+					Name:        "new-org-quota",
+					TotalRoutes: types.NullInt{IsSet: true, Value: 8},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(orgQuota).To(Equal(OrganizationQuota{
+					GUID:        "some-org-quota-guid",
+					Name:        "new-org-quota",
+					TotalRoutes: types.NullInt{IsSet: true, Value: 8},
+				}))
+			})
+		})
+
+		Context("when the quota does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 240001,
+					"description": "Quota Definition could not be found: some-org-quota-guid",
+					"error_code": "CF-QuotaDefinitionNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/quota_definitions/some-org-quota-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.UpdateOrganizationQuota(OrganizationQuota{GUID: "some-org-quota-guid"})
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Quota Definition could not be found: some-org-quota-guid"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
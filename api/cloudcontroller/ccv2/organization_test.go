@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
 )
@@ -17,6 +18,79 @@ var _ = Describe("Organization", func() {
 		client = NewTestClient()
 	})
 
+	Describe("CreateOrganization", func() {
+		Context("when the organization is created successfully", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-org-guid"
+					},
+					"entity": {
+						"name": "some-org",
+						"quota_definition_guid": "some-quota-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/organizations"),
+						VerifyJSON(`{"name":"some-org","quota_definition_guid":"some-quota-guid"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the created org and all warnings", func() {
+				org, warnings, err := client.CreateOrganization("some-org", "some-quota-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(org).To(Equal(Organization{
+					GUID:                "some-org-guid",
+					Name:                "some-org",
+					QuotaDefinitionGUID: "some-quota-guid",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when no quota is provided", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/organizations"),
+						VerifyJSON(`{"name":"some-org"}`),
+						RespondWith(http.StatusCreated, `{"metadata": {"guid": "some-org-guid"}}`),
+					),
+				)
+			})
+
+			It("leaves the quota out of the request", func() {
+				_, _, err := client.CreateOrganization("some-org", "")
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the organization name is taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 30002,
+					"description": "The organization name is taken: some-org",
+					"error_code": "CF-OrganizationNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/organizations"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					),
+				)
+			})
+
+			It("returns an OrganizationNameTakenError and all warnings", func() {
+				_, warnings, err := client.CreateOrganization("some-org", "")
+				Expect(err).To(MatchError(ccerror.OrganizationNameTakenError{Message: "The organization name is taken: some-org"}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
 	Describe("GetOrganization", func() {
 		Context("when the organization exists", func() {
 			BeforeEach(func() {
@@ -212,4 +286,115 @@ var _ = Describe("Organization", func() {
 			})
 		})
 	})
+
+	Describe("UpdateOrganizationUser", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/users/some-user-guid"),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("adds the user to the organization and returns all warnings", func() {
+				warnings, err := client.UpdateOrganizationUser("some-org-guid", "some-user-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 10003,
+  "description": "You are not authorized to perform the requested action",
+  "error_code": "CF-NotAuthorized"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/users/some-user-guid"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				warnings, err := client.UpdateOrganizationUser("some-org-guid", "some-user-guid")
+				Expect(err).To(MatchError(ccerror.ForbiddenError{Message: "You are not authorized to perform the requested action"}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
+	DescribeTable("managing organization roles by user GUID",
+		func(method string, path string, makeRequest func(*Client) (Warnings, error)) {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(method, path),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+				),
+			)
+
+			warnings, err := makeRequest(client)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+		},
+
+		Entry("UpdateOrganizationAuditor", http.MethodPut, "/v2/organizations/org-guid/auditors/user-guid", func(client *Client) (Warnings, error) {
+			return client.UpdateOrganizationAuditor("org-guid", "user-guid")
+		}),
+		Entry("DeleteOrganizationAuditor", http.MethodDelete, "/v2/organizations/org-guid/auditors/user-guid", func(client *Client) (Warnings, error) {
+			return client.DeleteOrganizationAuditor("org-guid", "user-guid")
+		}),
+		Entry("UpdateOrganizationBillingManager", http.MethodPut, "/v2/organizations/org-guid/billing_managers/user-guid", func(client *Client) (Warnings, error) {
+			return client.UpdateOrganizationBillingManager("org-guid", "user-guid")
+		}),
+		Entry("DeleteOrganizationBillingManager", http.MethodDelete, "/v2/organizations/org-guid/billing_managers/user-guid", func(client *Client) (Warnings, error) {
+			return client.DeleteOrganizationBillingManager("org-guid", "user-guid")
+		}),
+		Entry("UpdateOrganizationManager", http.MethodPut, "/v2/organizations/org-guid/managers/user-guid", func(client *Client) (Warnings, error) {
+			return client.UpdateOrganizationManager("org-guid", "user-guid")
+		}),
+		Entry("DeleteOrganizationManager", http.MethodDelete, "/v2/organizations/org-guid/managers/user-guid", func(client *Client) (Warnings, error) {
+			return client.DeleteOrganizationManager("org-guid", "user-guid")
+		}),
+	)
+
+	DescribeTable("managing organization roles by username",
+		func(method string, path string, makeRequest func(*Client) (Warnings, error)) {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(method, path),
+					VerifyJSON(`{"username":"some-user"}`),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+				),
+			)
+
+			warnings, err := makeRequest(client)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+		},
+
+		Entry("UpdateOrganizationAuditorByUsername", http.MethodPut, "/v2/organizations/org-guid/auditors", func(client *Client) (Warnings, error) {
+			return client.UpdateOrganizationAuditorByUsername("org-guid", "some-user")
+		}),
+		Entry("DeleteOrganizationAuditorByUsername", http.MethodDelete, "/v2/organizations/org-guid/auditors", func(client *Client) (Warnings, error) {
+			return client.DeleteOrganizationAuditorByUsername("org-guid", "some-user")
+		}),
+		Entry("UpdateOrganizationUserByUsername", http.MethodPut, "/v2/organizations/org-guid/users", func(client *Client) (Warnings, error) {
+			return client.UpdateOrganizationUserByUsername("org-guid", "some-user")
+		}),
+		Entry("UpdateOrganizationBillingManagerByUsername", http.MethodPut, "/v2/organizations/org-guid/billing_managers", func(client *Client) (Warnings, error) {
+			return client.UpdateOrganizationBillingManagerByUsername("org-guid", "some-user")
+		}),
+		Entry("DeleteOrganizationBillingManagerByUsername", http.MethodDelete, "/v2/organizations/org-guid/billing_managers", func(client *Client) (Warnings, error) {
+			return client.DeleteOrganizationBillingManagerByUsername("org-guid", "some-user")
+		}),
+		Entry("UpdateOrganizationManagerByUsername", http.MethodPut, "/v2/organizations/org-guid/managers", func(client *Client) (Warnings, error) {
+			return client.UpdateOrganizationManagerByUsername("org-guid", "some-user")
+		}),
+		Entry("DeleteOrganizationManagerByUsername", http.MethodDelete, "/v2/organizations/org-guid/managers", func(client *Client) (Warnings, error) {
+			return client.DeleteOrganizationManagerByUsername("org-guid", "some-user")
+		}),
+	)
 })
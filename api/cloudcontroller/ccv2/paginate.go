@@ -7,6 +7,21 @@ import (
 )
 
 func (client Client) paginate(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
+	return client.paginatePages(request, obj, func(list []interface{}) error {
+		for _, item := range list {
+			err := appendToExternalList(item)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// paginatePages makes the request and follows the pagination links, calling
+// handlePage with the resources of each page as it arrives. An error returned
+// by handlePage stops the pagination.
+func (client Client) paginatePages(request *cloudcontroller.Request, obj interface{}, handlePage func([]interface{}) error) (Warnings, error) {
 	fullWarningsList := Warnings{}
 
 	for {
@@ -26,11 +41,9 @@ func (client Client) paginate(request *cloudcontroller.Request, obj interface{},
 			return fullWarningsList, err
 		}
 
-		for _, item := range list {
-			err = appendToExternalList(item)
-			if err != nil {
-				return fullWarningsList, err
-			}
+		err = handlePage(list)
+		if err != nil {
+			return fullWarningsList, err
 		}
 
 		if wrapper.NextURL == "" {
@@ -21,15 +21,27 @@ const (
 	OrganizationGUIDFilter QueryFilter = "organization_guid"
 	// RouteGUIDFilter is the name of the 'route_guid' filter.
 	RouteGUIDFilter QueryFilter = "route_guid"
+	// ServiceGUIDFilter is the name of the 'service_guid' filter.
+	ServiceGUIDFilter QueryFilter = "service_guid"
 	// ServiceInstanceGUIDFilter is the name of the 'service_instance_guid' filter.
 	ServiceInstanceGUIDFilter QueryFilter = "service_instance_guid"
 	// SpaceGUIDFilter is the name of the 'space_guid' filter.
 	SpaceGUIDFilter QueryFilter = "space_guid"
+	// StackFilter is the name of the 'stack' filter.
+	StackFilter QueryFilter = "stack"
+	// StateFilter is the name of the 'state' filter.
+	StateFilter QueryFilter = "state"
 
 	// NameFilter is the name of the 'name' filter.
 	NameFilter QueryFilter = "name"
 	// HostFilter is the name of the 'host' filter.
 	HostFilter QueryFilter = "host"
+	// LabelFilter is the name of the 'label' filter.
+	LabelFilter QueryFilter = "label"
+	// PortFilter is the name of the 'port' filter.
+	PortFilter QueryFilter = "port"
+	// ProviderFilter is the name of the 'provider' filter.
+	ProviderFilter QueryFilter = "provider"
 )
 
 const (
@@ -16,12 +16,13 @@ import (
 
 // Route represents a Cloud Controller Route.
 type Route struct {
-	GUID       string        `json:"-"`
-	Host       string        `json:"host,omitempty"`
-	Path       string        `json:"path,omitempty"`
-	Port       types.NullInt `json:"port,omitempty"`
-	DomainGUID string        `json:"domain_guid"`
-	SpaceGUID  string        `json:"space_guid"`
+	GUID                string        `json:"-"`
+	Host                string        `json:"host,omitempty"`
+	Path                string        `json:"path,omitempty"`
+	Port                types.NullInt `json:"port,omitempty"`
+	DomainGUID          string        `json:"domain_guid"`
+	SpaceGUID           string        `json:"space_guid"`
+	ServiceInstanceGUID string        `json:"-"`
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Route response.
@@ -29,11 +30,12 @@ func (route *Route) UnmarshalJSON(data []byte) error {
 	var ccRoute struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Host       string        `json:"host"`
-			Path       string        `json:"path"`
-			Port       types.NullInt `json:"port"`
-			DomainGUID string        `json:"domain_guid"`
-			SpaceGUID  string        `json:"space_guid"`
+			Host                string        `json:"host"`
+			Path                string        `json:"path"`
+			Port                types.NullInt `json:"port"`
+			DomainGUID          string        `json:"domain_guid"`
+			SpaceGUID           string        `json:"space_guid"`
+			ServiceInstanceGUID string        `json:"service_instance_guid"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccRoute); err != nil {
@@ -46,6 +48,7 @@ func (route *Route) UnmarshalJSON(data []byte) error {
 	route.Port = ccRoute.Entity.Port
 	route.DomainGUID = ccRoute.Entity.DomainGUID
 	route.SpaceGUID = ccRoute.Entity.SpaceGUID
+	route.ServiceInstanceGUID = ccRoute.Entity.ServiceInstanceGUID
 	return nil
 }
 
@@ -136,55 +139,81 @@ func (client *Client) GetApplicationRoutes(appGUID string, queryParams ...Query)
 // GetSpaceRoutes returns a list of Routes associated with the provided Space
 // GUID, and filtered by the provided queries.
 func (client *Client) GetSpaceRoutes(spaceGUID string, queryParams ...Query) ([]Route, Warnings, error) {
+	var fullRoutesList []Route
+	warnings, err := client.GetSpaceRoutesPaged(spaceGUID, func(page []Route) error {
+		fullRoutesList = append(fullRoutesList, page...)
+		return nil
+	}, queryParams...)
+
+	return fullRoutesList, warnings, err
+}
+
+// GetSpaceRoutesPaged calls handlePage with each page of Routes associated
+// with the provided Space GUID as it is retrieved. Returning an error from
+// handlePage stops the pagination and returns that error.
+func (client *Client) GetSpaceRoutesPaged(spaceGUID string, handlePage func([]Route) error, queryParams ...Query) (Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.GetSpaceRoutesRequest,
 		URIParams:   map[string]string{"space_guid": spaceGUID},
 		Query:       FormatQueryParameters(queryParams),
 	})
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	var fullRoutesList []Route
-	warnings, err := client.paginate(request, Route{}, func(item interface{}) error {
-		if route, ok := item.(Route); ok {
-			fullRoutesList = append(fullRoutesList, route)
-		} else {
-			return ccerror.UnknownObjectInListError{
-				Expected:   Route{},
-				Unexpected: item,
+	return client.paginatePages(request, Route{}, func(list []interface{}) error {
+		routes := make([]Route, 0, len(list))
+		for _, item := range list {
+			route, ok := item.(Route)
+			if !ok {
+				return ccerror.UnknownObjectInListError{
+					Expected:   Route{},
+					Unexpected: item,
+				}
 			}
+			routes = append(routes, route)
 		}
-		return nil
+		return handlePage(routes)
 	})
-
-	return fullRoutesList, warnings, err
 }
 
 // GetRoutes returns a list of Routes based off of the provided queries.
 func (client *Client) GetRoutes(queryParams ...Query) ([]Route, Warnings, error) {
+	var fullRoutesList []Route
+	warnings, err := client.GetRoutesPaged(func(page []Route) error {
+		fullRoutesList = append(fullRoutesList, page...)
+		return nil
+	}, queryParams...)
+
+	return fullRoutesList, warnings, err
+}
+
+// GetRoutesPaged calls handlePage with each page of Routes matching the
+// provided queries as it is retrieved. Returning an error from handlePage
+// stops the pagination and returns that error.
+func (client *Client) GetRoutesPaged(handlePage func([]Route) error, queryParams ...Query) (Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.GetRoutesRequest,
 		Query:       FormatQueryParameters(queryParams),
 	})
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	var fullRoutesList []Route
-	warnings, err := client.paginate(request, Route{}, func(item interface{}) error {
-		if route, ok := item.(Route); ok {
-			fullRoutesList = append(fullRoutesList, route)
-		} else {
-			return ccerror.UnknownObjectInListError{
-				Expected:   Route{},
-				Unexpected: item,
+	return client.paginatePages(request, Route{}, func(list []interface{}) error {
+		routes := make([]Route, 0, len(list))
+		for _, item := range list {
+			route, ok := item.(Route)
+			if !ok {
+				return ccerror.UnknownObjectInListError{
+					Expected:   Route{},
+					Unexpected: item,
+				}
 			}
+			routes = append(routes, route)
 		}
-		return nil
+		return handlePage(routes)
 	})
-
-	return fullRoutesList, warnings, err
 }
 
 // DeleteRoute deletes the Route associated with the provided Route GUID.
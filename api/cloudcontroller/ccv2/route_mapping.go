@@ -0,0 +1,63 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// RouteMapping represents the binding of a Cloud Controller Route to an
+// Application.
+type RouteMapping struct {
+	GUID      string
+	AppGUID   string
+	RouteGUID string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Route Mapping response.
+func (routeMapping *RouteMapping) UnmarshalJSON(data []byte) error {
+	var ccRouteMapping struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			AppGUID   string `json:"app_guid"`
+			RouteGUID string `json:"route_guid"`
+		} `json:"entity"`
+	}
+	err := json.Unmarshal(data, &ccRouteMapping)
+	if err != nil {
+		return err
+	}
+
+	routeMapping.GUID = ccRouteMapping.Metadata.GUID
+	routeMapping.AppGUID = ccRouteMapping.Entity.AppGUID
+	routeMapping.RouteGUID = ccRouteMapping.Entity.RouteGUID
+	return nil
+}
+
+// GetRouteMappings returns a list of Route Mappings based off of the provided
+// queries.
+func (client *Client) GetRouteMappings(queries ...Query) ([]RouteMapping, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetRouteMappingsRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullRouteMappingsList []RouteMapping
+	warnings, err := client.paginate(request, RouteMapping{}, func(item interface{}) error {
+		if routeMapping, ok := item.(RouteMapping); ok {
+			fullRouteMappingsList = append(fullRouteMappingsList, routeMapping)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   RouteMapping{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullRouteMappingsList, warnings, err
+}
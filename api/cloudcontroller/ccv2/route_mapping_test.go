@@ -0,0 +1,125 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Route Mapping", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetRouteMappings", func() {
+		var (
+			routeMappings []RouteMapping
+			warnings      Warnings
+			executeErr    error
+		)
+
+		JustBeforeEach(func() {
+			routeMappings, warnings, executeErr = client.GetRouteMappings(Query{
+				Filter:   RouteGUIDFilter,
+				Operator: InOperator,
+				Values:   []string{"route-guid-1", "route-guid-2"},
+			})
+		})
+
+		Context("when the route mappings exist", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/route_mappings?q=route_guid%20IN%20route-guid-1,route-guid-2&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "route-mapping-guid-1"
+							},
+							"entity": {
+								"app_guid": "app-guid-1",
+								"route_guid": "route-guid-1"
+							}
+						},
+						{
+							"metadata": {
+								"guid": "route-mapping-guid-2"
+							},
+							"entity": {
+								"app_guid": "app-guid-2",
+								"route_guid": "route-guid-1"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "route-mapping-guid-3"
+							},
+							"entity": {
+								"app_guid": "app-guid-1",
+								"route_guid": "route-guid-2"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/route_mappings", "q=route_guid%20IN%20route-guid-1,route-guid-2"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/route_mappings", "q=route_guid%20IN%20route-guid-1,route-guid-2&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns all the queried route mappings and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(routeMappings).To(ConsistOf(
+					RouteMapping{GUID: "route-mapping-guid-1", AppGUID: "app-guid-1", RouteGUID: "route-guid-1"},
+					RouteMapping{GUID: "route-mapping-guid-2", AppGUID: "app-guid-2", RouteGUID: "route-guid-1"},
+					RouteMapping{GUID: "route-mapping-guid-3", AppGUID: "app-guid-1", RouteGUID: "route-guid-2"},
+				))
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/route_mappings", "q=route_guid%20IN%20route-guid-1,route-guid-2"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -639,7 +639,8 @@ var _ = Describe("Route", func() {
 							"path": "path",
 							"port": null,
 							"domain_guid": "some-http-domain",
-							"space_guid": "some-space-guid-1"
+							"space_guid": "some-space-guid-1",
+							"service_instance_guid": "some-service-instance-guid"
 						}
 					},
 					{
@@ -696,12 +697,13 @@ var _ = Describe("Route", func() {
 						SpaceGUID:  "some-space-guid-1",
 					},
 					{
-						GUID:       "route-guid-3",
-						Host:       "host-3",
-						Path:       "path",
-						Port:       types.NullInt{IsSet: false},
-						DomainGUID: "some-http-domain",
-						SpaceGUID:  "some-space-guid-1",
+						GUID:                "route-guid-3",
+						Host:                "host-3",
+						Path:                "path",
+						Port:                types.NullInt{IsSet: false},
+						DomainGUID:          "some-http-domain",
+						SpaceGUID:           "some-space-guid-1",
+						ServiceInstanceGUID: "some-service-instance-guid",
 					},
 					{
 						GUID:       "route-guid-4",
@@ -110,29 +110,42 @@ func (client *Client) AssociateSpaceWithStagingSecurityGroup(securityGroupGUID s
 }
 
 func (client *Client) GetSecurityGroups(queries ...Query) ([]SecurityGroup, Warnings, error) {
+	var securityGroupsList []SecurityGroup
+	warnings, err := client.GetSecurityGroupsPaged(func(page []SecurityGroup) error {
+		securityGroupsList = append(securityGroupsList, page...)
+		return nil
+	}, queries...)
+
+	return securityGroupsList, warnings, err
+}
+
+// GetSecurityGroupsPaged calls handlePage with each page of Security Groups
+// matching the provided queries as it is retrieved. Returning an error from
+// handlePage stops the pagination and returns that error.
+func (client *Client) GetSecurityGroupsPaged(handlePage func([]SecurityGroup) error, queries ...Query) (Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.GetSecurityGroupsRequest,
 		Query:       FormatQueryParameters(queries),
 	})
 
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	var securityGroupsList []SecurityGroup
-	warnings, err := client.paginate(request, SecurityGroup{}, func(item interface{}) error {
-		if securityGroup, ok := item.(SecurityGroup); ok {
-			securityGroupsList = append(securityGroupsList, securityGroup)
-		} else {
-			return ccerror.UnknownObjectInListError{
-				Expected:   SecurityGroup{},
-				Unexpected: item,
+	return client.paginatePages(request, SecurityGroup{}, func(list []interface{}) error {
+		securityGroups := make([]SecurityGroup, 0, len(list))
+		for _, item := range list {
+			securityGroup, ok := item.(SecurityGroup)
+			if !ok {
+				return ccerror.UnknownObjectInListError{
+					Expected:   SecurityGroup{},
+					Unexpected: item,
+				}
 			}
+			securityGroups = append(securityGroups, securityGroup)
 		}
-		return nil
+		return handlePage(securityGroups)
 	})
-
-	return securityGroupsList, warnings, err
 }
 
 // GetSpaceRunningSecurityGroupsBySpace returns the running Security Groups
@@ -173,6 +186,23 @@ func (client *Client) getSpaceSecurityGroupsBySpaceAndLifecycle(spaceGUID string
 	return securityGroupsList, warnings, err
 }
 
+// DeleteSecurityGroup deletes the security group with the given GUID.
+func (client *Client) DeleteSecurityGroup(securityGroupGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteSecurityGroupRequest,
+		URIParams:   Params{"security_group_guid": securityGroupGUID},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // RemoveSpaceRunningFromSecurityGroup disassociates a security group in the
 // running phase fo the lifecycle, specified by its GUID, from a space, which
 // is also specified by its GUID.
@@ -1,6 +1,7 @@
 package ccv2_test
 
 import (
+	"errors"
 	"net/http"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -228,6 +229,74 @@ var _ = Describe("Security Groups", func() {
 		})
 	})
 
+	Describe("GetSecurityGroupsPaged", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/security_groups?page=2",
+				"resources": [
+					{
+						"metadata": {
+							"guid": "security-group-guid-1"
+						},
+						"entity": {
+							"name": "security-group-1"
+						}
+					}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "security-group-guid-2"
+						},
+						"entity": {
+							"name": "security-group-2"
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/security_groups"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/security_groups", "page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+				),
+			)
+		})
+
+		It("calls handlePage with each page as it is retrieved", func() {
+			var pages [][]SecurityGroup
+			warnings, err := client.GetSecurityGroupsPaged(func(page []SecurityGroup) error {
+				pages = append(pages, page)
+				return nil
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pages).To(Equal([][]SecurityGroup{
+				{{GUID: "security-group-guid-1", Name: "security-group-1", Rules: []SecurityGroupRule{}}},
+				{{GUID: "security-group-guid-2", Name: "security-group-2", Rules: []SecurityGroupRule{}}},
+			}))
+			Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+		})
+
+		Context("when handlePage returns an error", func() {
+			It("stops paginating and returns the error and the warnings so far", func() {
+				expectedErr := errors.New("stop")
+				warnings, err := client.GetSecurityGroupsPaged(func(page []SecurityGroup) error {
+					return expectedErr
+				})
+
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("GetSpaceRunningSecurityGroupsBySpace", func() {
 		Context("when the space exists", func() {
 			BeforeEach(func() {
@@ -606,6 +675,59 @@ var _ = Describe("Security Groups", func() {
 		})
 	})
 
+	Describe("DeleteSecurityGroup", func() {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			warnings, err = client.DeleteSecurityGroup("security-group-guid")
+		})
+
+		Context("when the client call is successful", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/security_groups/security-group-guid"),
+						RespondWith(http.StatusNoContent, nil, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"warning-1"}))
+			})
+		})
+
+		Context("when the client call is unsuccessful", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 10001,
+  "description": "Some Error",
+  "error_code": "CF-SomeError"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/security_groups/security-group-guid"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("RemoveSpaceFromRunningSecurityGroup", func() {
 		var (
 			warnings Warnings
@@ -0,0 +1,112 @@
+package ccv2
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Service represents a Cloud Controller Service.
+type Service struct {
+	GUID        string
+	Label       string
+	Description string
+	Tags        []string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service response.
+func (service *Service) UnmarshalJSON(data []byte) error {
+	var ccService struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Label       string   `json:"label"`
+			Description string   `json:"description"`
+			Tags        []string `json:"tags"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccService); err != nil {
+		return err
+	}
+
+	service.GUID = ccService.Metadata.GUID
+	service.Label = ccService.Entity.Label
+	service.Description = ccService.Entity.Description
+	service.Tags = ccService.Entity.Tags
+	return nil
+}
+
+// GetService returns the service with the provided GUID.
+func (client *Client) GetService(serviceGUID string) (Service, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceRequest,
+		URIParams:   Params{"service_guid": serviceGUID},
+	})
+	if err != nil {
+		return Service{}, nil, err
+	}
+
+	var service Service
+	response := cloudcontroller.Response{
+		Result: &service,
+	}
+
+	err = client.connection.Make(request, &response)
+	return service, response.Warnings, err
+}
+
+// GetServices returns a list of Services based off of the provided queries.
+func (client *Client) GetServices(queries ...Query) ([]Service, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicesRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServicesList []Service
+	warnings, err := client.paginate(request, Service{}, func(item interface{}) error {
+		if service, ok := item.(Service); ok {
+			fullServicesList = append(fullServicesList, service)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Service{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServicesList, warnings, err
+}
+
+// PurgeService removes the service with the provided GUID, and all of its
+// plans, instances and bindings, without contacting the service broker. When
+// the Cloud Controller runs the purge in the background the job is returned;
+// otherwise the returned job is empty.
+func (client *Client) PurgeService(serviceGUID string) (Job, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceRequest,
+		URIParams:   Params{"service_guid": serviceGUID},
+		Query: url.Values{
+			"purge": {"true"},
+			"async": {"true"},
+		},
+	})
+	if err != nil {
+		return Job{}, nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	if err != nil || len(response.RawResponse) == 0 {
+		return Job{}, response.Warnings, err
+	}
+
+	var job Job
+	err = json.Unmarshal(response.RawResponse, &job)
+	return job, response.Warnings, err
+}
@@ -3,6 +3,8 @@ package ccv2
 import (
 	"bytes"
 	"encoding/json"
+	"net/url"
+	"strconv"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -12,8 +14,13 @@ import (
 // ServiceBinding represents a Cloud Controller Service Binding.
 type ServiceBinding struct {
 	AppGUID             string
+	Credentials         map[string]interface{}
 	GUID                string
+	LastOperation       LastOperation
+	Name                string
 	ServiceInstanceGUID string
+	SyslogDrainURL      string
+	VolumeMounts        []map[string]interface{}
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Binding response.
@@ -21,8 +28,17 @@ func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	var ccServiceBinding struct {
 		Metadata internal.Metadata
 		Entity   struct {
-			AppGUID             string `json:"app_guid"`
-			ServiceInstanceGUID string `json:"service_instance_guid"`
+			AppGUID       string                 `json:"app_guid"`
+			Credentials   map[string]interface{} `json:"credentials"`
+			LastOperation struct {
+				Description string `json:"description"`
+				State       string `json:"state"`
+				Type        string `json:"type"`
+			} `json:"last_operation"`
+			Name                string                   `json:"name"`
+			ServiceInstanceGUID string                   `json:"service_instance_guid"`
+			SyslogDrainURL      string                   `json:"syslog_drain_url"`
+			VolumeMounts        []map[string]interface{} `json:"volume_mounts"`
 		} `json:"entity"`
 	}
 	err := json.Unmarshal(data, &ccServiceBinding)
@@ -31,8 +47,17 @@ func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	}
 
 	serviceBinding.AppGUID = ccServiceBinding.Entity.AppGUID
+	serviceBinding.Credentials = ccServiceBinding.Entity.Credentials
 	serviceBinding.GUID = ccServiceBinding.Metadata.GUID
+	serviceBinding.LastOperation = LastOperation{
+		Description: ccServiceBinding.Entity.LastOperation.Description,
+		State:       LastOperationState(ccServiceBinding.Entity.LastOperation.State),
+		Type:        ccServiceBinding.Entity.LastOperation.Type,
+	}
+	serviceBinding.Name = ccServiceBinding.Entity.Name
 	serviceBinding.ServiceInstanceGUID = ccServiceBinding.Entity.ServiceInstanceGUID
+	serviceBinding.SyslogDrainURL = ccServiceBinding.Entity.SyslogDrainURL
+	serviceBinding.VolumeMounts = ccServiceBinding.Entity.VolumeMounts
 	return nil
 }
 
@@ -41,14 +66,19 @@ func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 type serviceBindingRequestBody struct {
 	ServiceInstanceGUID string                 `json:"service_instance_guid"`
 	AppGUID             string                 `json:"app_guid"`
+	Name                string                 `json:"name,omitempty"`
 	Parameters          map[string]interface{} `json:"parameters"`
 }
 
-// CreateServiceBinding creates a service binding
-func (client *Client) CreateServiceBinding(appGUID string, serviceInstanceGUID string, parameters map[string]interface{}) (ServiceBinding, Warnings, error) {
+// CreateServiceBinding creates a service binding. When bindingName is empty,
+// the Cloud Controller does not name the binding. When acceptsIncomplete is
+// true, the broker may create the binding asynchronously, in which case the
+// returned binding's last operation is in progress.
+func (client *Client) CreateServiceBinding(appGUID string, serviceInstanceGUID string, bindingName string, acceptsIncomplete bool, parameters map[string]interface{}) (ServiceBinding, Warnings, error) {
 	requestBody := serviceBindingRequestBody{
 		ServiceInstanceGUID: serviceInstanceGUID,
 		AppGUID:             appGUID,
+		Name:                bindingName,
 		Parameters:          parameters,
 	}
 
@@ -60,6 +90,7 @@ func (client *Client) CreateServiceBinding(appGUID string, serviceInstanceGUID s
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.PostServiceBindingRequest,
 		Body:        bytes.NewReader(bodyBytes),
+		Query:       url.Values{"accepts_incomplete": {strconv.FormatBool(acceptsIncomplete)}},
 	})
 	if err != nil {
 		return ServiceBinding{}, nil, err
@@ -78,6 +109,25 @@ func (client *Client) CreateServiceBinding(appGUID string, serviceInstanceGUID s
 	return serviceBinding, response.Warnings, nil
 }
 
+// GetServiceBinding returns back the service binding with the provided GUID.
+func (client *Client) GetServiceBinding(serviceBindingGUID string) (ServiceBinding, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceBindingRequest,
+		URIParams:   Params{"service_binding_guid": serviceBindingGUID},
+	})
+	if err != nil {
+		return ServiceBinding{}, nil, err
+	}
+
+	var serviceBinding ServiceBinding
+	response := cloudcontroller.Response{
+		Result: &serviceBinding,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceBinding, response.Warnings, err
+}
+
 // GetServiceBindings returns back a list of Service Bindings based off of the
 // provided queries.
 func (client *Client) GetServiceBindings(queries ...Query) ([]ServiceBinding, Warnings, error) {
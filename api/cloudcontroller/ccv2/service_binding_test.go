@@ -19,38 +19,97 @@ var _ = Describe("Service Binding", func() {
 
 	Describe("CreateServiceBinding", func() {
 		Context("when the create is successful", func() {
-			BeforeEach(func() {
-				response := `
+			Context("when a binding name and accepts incomplete are provided", func() {
+				BeforeEach(func() {
+					response := `
 						{
 							"metadata": {
 								"guid": "some-service-binding-guid"
+							},
+							"entity": {
+								"app_guid": "some-app-guid",
+								"name": "some-binding-name",
+								"service_instance_guid": "some-service-instance-guid",
+								"last_operation": {
+									"type": "create",
+									"state": "in progress",
+									"description": "some-description"
+								}
 							}
 						}`
-				requestBody := map[string]interface{}{
-					"service_instance_guid": "some-service-instance-guid",
-					"app_guid":              "some-app-guid",
-					"parameters": map[string]interface{}{
+					requestBody := map[string]interface{}{
+						"service_instance_guid": "some-service-instance-guid",
+						"app_guid":              "some-app-guid",
+						"name":                  "some-binding-name",
+						"parameters": map[string]interface{}{
+							"the-service-broker": "wants this object",
+						},
+					}
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPost, "/v2/service_bindings", "accepts_incomplete=true"),
+							VerifyJSONRepresenting(requestBody),
+							RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("returns the created object and warnings", func() {
+					parameters := map[string]interface{}{
 						"the-service-broker": "wants this object",
-					},
-				}
-				server.AppendHandlers(
-					CombineHandlers(
-						VerifyRequest(http.MethodPost, "/v2/service_bindings"),
-						VerifyJSONRepresenting(requestBody),
-						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
-					),
-				)
+					}
+					serviceBinding, warnings, err := client.CreateServiceBinding("some-app-guid", "some-service-instance-guid", "some-binding-name", true, parameters)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(serviceBinding).To(Equal(ServiceBinding{
+						AppGUID: "some-app-guid",
+						GUID:    "some-service-binding-guid",
+						LastOperation: LastOperation{
+							Description: "some-description",
+							State:       LastOperationInProgress,
+							Type:        "create",
+						},
+						Name:                "some-binding-name",
+						ServiceInstanceGUID: "some-service-instance-guid",
+					}))
+					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				})
 			})
 
-			It("returns the created object and warnings", func() {
-				parameters := map[string]interface{}{
-					"the-service-broker": "wants this object",
-				}
-				serviceBinding, warnings, err := client.CreateServiceBinding("some-app-guid", "some-service-instance-guid", parameters)
-				Expect(err).NotTo(HaveOccurred())
+			Context("when no binding name is provided", func() {
+				BeforeEach(func() {
+					response := `
+						{
+							"metadata": {
+								"guid": "some-service-binding-guid"
+							}
+						}`
+					requestBody := map[string]interface{}{
+						"service_instance_guid": "some-service-instance-guid",
+						"app_guid":              "some-app-guid",
+						"parameters": map[string]interface{}{
+							"the-service-broker": "wants this object",
+						},
+					}
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPost, "/v2/service_bindings", "accepts_incomplete=false"),
+							VerifyJSONRepresenting(requestBody),
+							RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
 
-				Expect(serviceBinding).To(Equal(ServiceBinding{GUID: "some-service-binding-guid"}))
-				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				It("omits the name and returns the created object and warnings", func() {
+					parameters := map[string]interface{}{
+						"the-service-broker": "wants this object",
+					}
+					serviceBinding, warnings, err := client.CreateServiceBinding("some-app-guid", "some-service-instance-guid", "", false, parameters)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(serviceBinding).To(Equal(ServiceBinding{GUID: "some-service-binding-guid"}))
+					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				})
 			})
 		})
 
@@ -75,13 +134,92 @@ var _ = Describe("Service Binding", func() {
 				parameters := map[string]interface{}{
 					"the-service-broker": "wants this object",
 				}
-				_, warnings, err := client.CreateServiceBinding("some-app-guid", "some-service-instance-guid", parameters)
+				_, warnings, err := client.CreateServiceBinding("some-app-guid", "some-service-instance-guid", "", false, parameters)
 				Expect(err).To(MatchError(ccerror.ServiceBindingTakenError{Message: "The app space binding to service is taken: some-app-guid some-service-instance-guid"}))
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 			})
 		})
 	})
 
+	Describe("GetServiceBinding", func() {
+		Context("when the service binding exists", func() {
+			BeforeEach(func() {
+				response := `
+					{
+						"metadata": {
+							"guid": "some-service-binding-guid"
+						},
+						"entity": {
+							"app_guid": "some-app-guid",
+							"service_instance_guid": "some-service-instance-guid",
+							"credentials": {
+								"username": "some-user"
+							},
+							"syslog_drain_url": "syslog://some-drain",
+							"volume_mounts": [
+								{
+									"container_dir": "/some/dir"
+								}
+							],
+							"last_operation": {
+								"type": "create",
+								"state": "succeeded",
+								"description": ""
+							}
+						}
+					}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_bindings/some-service-binding-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service binding and warnings", func() {
+				serviceBinding, warnings, err := client.GetServiceBinding("some-service-binding-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(serviceBinding).To(Equal(ServiceBinding{
+					AppGUID:     "some-app-guid",
+					Credentials: map[string]interface{}{"username": "some-user"},
+					GUID:        "some-service-binding-guid",
+					LastOperation: LastOperation{
+						State: LastOperationSucceeded,
+						Type:  "create",
+					},
+					ServiceInstanceGUID: "some-service-instance-guid",
+					SyslogDrainURL:      "syslog://some-drain",
+					VolumeMounts:        []map[string]interface{}{{"container_dir": "/some/dir"}},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service binding does not exist", func() {
+			BeforeEach(func() {
+				response := `
+					{
+						"code": 90004,
+						"description": "The service binding could not be found: some-service-binding-guid",
+						"error_code": "CF-ServiceBindingNotFound"
+					}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_bindings/some-service-binding-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetServiceBinding("some-service-binding-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The service binding could not be found: some-service-binding-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetServiceBindings", func() {
 		BeforeEach(func() {
 			response1 := `{
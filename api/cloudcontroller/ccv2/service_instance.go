@@ -1,7 +1,9 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
+	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -21,10 +23,15 @@ const (
 
 // ServiceInstance represents a Cloud Controller Service Instance.
 type ServiceInstance struct {
-	GUID      string
-	Name      string
-	SpaceGUID string
-	Type      ServiceInstanceType
+	// Credentials are only returned for user provided service instances.
+	Credentials     map[string]interface{}
+	GUID            string
+	LastOperation   LastOperation
+	Name            string
+	ServicePlanGUID string
+	SpaceGUID       string
+	Tags            []string
+	Type            ServiceInstanceType
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Instance response.
@@ -32,9 +39,17 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 	var ccServiceInstance struct {
 		Metadata internal.Metadata
 		Entity   struct {
-			Name      string `json:"name"`
-			SpaceGUID string `json:"space_guid"`
-			Type      string `json:"type"`
+			Credentials   map[string]interface{} `json:"credentials"`
+			LastOperation struct {
+				Description string `json:"description"`
+				State       string `json:"state"`
+				Type        string `json:"type"`
+			} `json:"last_operation"`
+			Name            string   `json:"name"`
+			ServicePlanGUID string   `json:"service_plan_guid"`
+			SpaceGUID       string   `json:"space_guid"`
+			Tags            []string `json:"tags"`
+			Type            string   `json:"type"`
 		}
 	}
 	err := json.Unmarshal(data, &ccServiceInstance)
@@ -42,9 +57,17 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	serviceInstance.Credentials = ccServiceInstance.Entity.Credentials
 	serviceInstance.GUID = ccServiceInstance.Metadata.GUID
+	serviceInstance.LastOperation = LastOperation{
+		Description: ccServiceInstance.Entity.LastOperation.Description,
+		State:       LastOperationState(ccServiceInstance.Entity.LastOperation.State),
+		Type:        ccServiceInstance.Entity.LastOperation.Type,
+	}
 	serviceInstance.Name = ccServiceInstance.Entity.Name
+	serviceInstance.ServicePlanGUID = ccServiceInstance.Entity.ServicePlanGUID
 	serviceInstance.SpaceGUID = ccServiceInstance.Entity.SpaceGUID
+	serviceInstance.Tags = ccServiceInstance.Entity.Tags
 	serviceInstance.Type = ServiceInstanceType(ccServiceInstance.Entity.Type)
 	return nil
 }
@@ -60,6 +83,108 @@ func (serviceInstance ServiceInstance) Managed() bool {
 	return serviceInstance.Type == ManagedService
 }
 
+// createServiceInstanceRequestBody represents the body of the service
+// instance create request.
+type createServiceInstanceRequestBody struct {
+	Name            string                 `json:"name"`
+	Parameters      map[string]interface{} `json:"parameters,omitempty"`
+	ServicePlanGUID string                 `json:"service_plan_guid"`
+	SpaceGUID       string                 `json:"space_guid"`
+	Tags            []string               `json:"tags,omitempty"`
+}
+
+// updateServiceInstanceRequestBody represents the body of the service
+// instance update request.
+type updateServiceInstanceRequestBody struct {
+	Parameters      map[string]interface{} `json:"parameters,omitempty"`
+	ServicePlanGUID string                 `json:"service_plan_guid,omitempty"`
+	Tags            *[]string              `json:"tags,omitempty"`
+}
+
+// CreateServiceInstance creates a managed service instance of the provided
+// service plan in the provided space. The service broker may create the
+// instance asynchronously, in which case the returned instance's last
+// operation is in progress.
+func (client *Client) CreateServiceInstance(spaceGUID string, servicePlanGUID string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (ServiceInstance, Warnings, error) {
+	requestBody := createServiceInstanceRequestBody{
+		Name:            serviceInstanceName,
+		Parameters:      parameters,
+		ServicePlanGUID: servicePlanGUID,
+		SpaceGUID:       spaceGUID,
+		Tags:            tags,
+	}
+
+	return client.makeServiceInstanceRequest(internal.PostServiceInstancesRequest, nil, requestBody)
+}
+
+// UpdateServiceInstance updates the plan, parameters and tags of a managed
+// service instance. An empty servicePlanGUID, nil parameters or nil tags
+// leave the corresponding property unchanged; an empty, non-nil tags slice
+// removes all tags. The service broker may update the instance
+// asynchronously, in which case the returned instance's last operation is in
+// progress.
+func (client *Client) UpdateServiceInstance(serviceInstanceGUID string, servicePlanGUID string, parameters map[string]interface{}, tags []string) (ServiceInstance, Warnings, error) {
+	requestBody := updateServiceInstanceRequestBody{
+		Parameters:      parameters,
+		ServicePlanGUID: servicePlanGUID,
+	}
+	if tags != nil {
+		requestBody.Tags = &tags
+	}
+
+	return client.makeServiceInstanceRequest(internal.PutServiceInstanceRequest, Params{"service_instance_guid": serviceInstanceGUID}, requestBody)
+}
+
+// DeleteServiceInstance deletes the service instance with the provided GUID.
+// The service broker may delete the instance asynchronously, in which case the
+// returned instance's last operation is in progress. When the instance is
+// deleted synchronously an empty ServiceInstance is returned.
+func (client *Client) DeleteServiceInstance(serviceInstanceGUID string) (ServiceInstance, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceInstanceRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+		Query:       url.Values{"accepts_incomplete": {"true"}},
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	if err != nil || len(response.RawResponse) == 0 {
+		return ServiceInstance{}, response.Warnings, err
+	}
+
+	var serviceInstance ServiceInstance
+	err = json.Unmarshal(response.RawResponse, &serviceInstance)
+	return serviceInstance, response.Warnings, err
+}
+
+func (client *Client) makeServiceInstanceRequest(requestName string, uriParams Params, requestBody interface{}) (ServiceInstance, Warnings, error) {
+	bodyBytes, err := json.Marshal(requestBody)
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+		Query:       url.Values{"accepts_incomplete": {"true"}},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	var serviceInstance ServiceInstance
+	response := cloudcontroller.Response{
+		Result: &serviceInstance,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceInstance, response.Warnings, err
+}
+
 // GetServiceInstance returns the service instance with the given GUID. This
 // service can be either a managed or user provided.
 func (client *Client) GetServiceInstance(serviceInstanceGUID string) (ServiceInstance, Warnings, error) {
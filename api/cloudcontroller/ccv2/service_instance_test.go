@@ -119,6 +119,224 @@ var _ = Describe("Service Instance", func() {
 		})
 	})
 
+	Describe("CreateServiceInstance", func() {
+		Context("when the create is accepted", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-instance-guid"
+					},
+					"entity": {
+						"name": "some-service-instance",
+						"space_guid": "some-space-guid",
+						"service_plan_guid": "some-service-plan-guid",
+						"type": "managed_service_instance",
+						"last_operation": {
+							"type": "create",
+							"state": "in progress",
+							"description": ""
+						}
+					}
+				}`
+				requestBody := map[string]interface{}{
+					"name":              "some-service-instance",
+					"space_guid":        "some-space-guid",
+					"service_plan_guid": "some-service-plan-guid",
+					"parameters": map[string]interface{}{
+						"some-parameter": "some-value",
+					},
+					"tags": []string{"tag-1", "tag-2"},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_instances", "accepts_incomplete=true"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service instance and warnings", func() {
+				serviceInstance, warnings, err := client.CreateServiceInstance(
+					"some-space-guid",
+					"some-service-plan-guid",
+					"some-service-instance",
+					map[string]interface{}{"some-parameter": "some-value"},
+					[]string{"tag-1", "tag-2"},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(serviceInstance).To(Equal(ServiceInstance{
+					GUID:            "some-service-instance-guid",
+					LastOperation:   LastOperation{State: LastOperationInProgress, Type: "create"},
+					Name:            "some-service-instance",
+					ServicePlanGUID: "some-service-plan-guid",
+					SpaceGUID:       "some-space-guid",
+					Type:            ManagedService,
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the name is taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 60002,
+					"description": "The service instance name is taken: some-service-instance",
+					"error_code": "CF-ServiceInstanceNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_instances", "accepts_incomplete=true"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateServiceInstance("some-space-guid", "some-service-plan-guid", "some-service-instance", nil, nil)
+				Expect(err).To(MatchError(ccerror.ServiceInstanceNameTakenError{Message: "The service instance name is taken: some-service-instance"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("UpdateServiceInstance", func() {
+		var requestBody map[string]interface{}
+
+		JustBeforeEach(func() {
+			response := `{
+				"metadata": {
+					"guid": "some-service-instance-guid"
+				},
+				"entity": {
+					"name": "some-service-instance",
+					"last_operation": {
+						"type": "update",
+						"state": "succeeded",
+						"description": ""
+					}
+				}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/service_instances/some-service-instance-guid", "accepts_incomplete=true"),
+					VerifyJSONRepresenting(requestBody),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		Context("when the plan, parameters and tags are provided", func() {
+			BeforeEach(func() {
+				requestBody = map[string]interface{}{
+					"service_plan_guid": "some-service-plan-guid",
+					"parameters": map[string]interface{}{
+						"some-parameter": "some-value",
+					},
+					"tags": []string{"tag-1"},
+				}
+			})
+
+			It("sends them and returns the service instance and warnings", func() {
+				serviceInstance, warnings, err := client.UpdateServiceInstance(
+					"some-service-instance-guid",
+					"some-service-plan-guid",
+					map[string]interface{}{"some-parameter": "some-value"},
+					[]string{"tag-1"},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(serviceInstance).To(Equal(ServiceInstance{
+					GUID:          "some-service-instance-guid",
+					LastOperation: LastOperation{State: LastOperationSucceeded, Type: "update"},
+					Name:          "some-service-instance",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the tags are empty", func() {
+			BeforeEach(func() {
+				requestBody = map[string]interface{}{
+					"tags": []string{},
+				}
+			})
+
+			It("removes all tags", func() {
+				_, _, err := client.UpdateServiceInstance("some-service-instance-guid", "", nil, []string{})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when nothing is provided", func() {
+			BeforeEach(func() {
+				requestBody = map[string]interface{}{}
+			})
+
+			It("sends an empty body", func() {
+				_, _, err := client.UpdateServiceInstance("some-service-instance-guid", "", nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("DeleteServiceInstance", func() {
+		Context("when the delete is accepted", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-instance-guid"
+					},
+					"entity": {
+						"name": "some-service-instance",
+						"last_operation": {
+							"type": "delete",
+							"state": "in progress",
+							"description": ""
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "accepts_incomplete=true"),
+						RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service instance in progress and warnings", func() {
+				serviceInstance, warnings, err := client.DeleteServiceInstance("some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(serviceInstance).To(Equal(ServiceInstance{
+					GUID:          "some-service-instance-guid",
+					LastOperation: LastOperation{State: LastOperationInProgress, Type: "delete"},
+					Name:          "some-service-instance",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the instance is deleted synchronously", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "accepts_incomplete=true"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an empty service instance and warnings", func() {
+				serviceInstance, warnings, err := client.DeleteServiceInstance("some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceInstance).To(Equal(ServiceInstance{}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetServiceInstance", func() {
 		BeforeEach(func() {
 			response := `{
@@ -128,7 +346,13 @@ var _ = Describe("Service Instance", func() {
 				"entity": {
 					"name": "some-service-name",
 					"space_guid": "some-space-guid",
-					"type": "managed_service_instance"
+					"service_plan_guid": "some-service-plan-guid",
+					"type": "managed_service_instance",
+					"last_operation": {
+						"type": "create",
+						"state": "succeeded",
+						"description": "some-description"
+					}
 				}
 			}`
 
@@ -146,10 +370,16 @@ var _ = Describe("Service Instance", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(serviceInstance).To(Equal(ServiceInstance{
-					Name:      "some-service-name",
-					GUID:      "some-service-guid",
-					SpaceGUID: "some-space-guid",
-					Type:      ManagedService,
+					Name: "some-service-name",
+					GUID: "some-service-guid",
+					LastOperation: LastOperation{
+						Description: "some-description",
+						State:       LastOperationSucceeded,
+						Type:        "create",
+					},
+					ServicePlanGUID: "some-service-plan-guid",
+					SpaceGUID:       "some-space-guid",
+					Type:            ManagedService,
 				}))
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 			})
@@ -0,0 +1,223 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Key", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateServiceKey", func() {
+		Context("when the create is successful", func() {
+			BeforeEach(func() {
+				response := `
+					{
+						"metadata": {
+							"guid": "some-service-key-guid"
+						},
+						"entity": {
+							"name": "some-key-name",
+							"service_instance_guid": "some-service-instance-guid",
+							"credentials": {
+								"username": "some-username"
+							}
+						}
+					}`
+				requestBody := map[string]interface{}{
+					"service_instance_guid": "some-service-instance-guid",
+					"name":                  "some-key-name",
+					"parameters": map[string]interface{}{
+						"the-service-broker": "wants this object",
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_keys"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created key and warnings", func() {
+				parameters := map[string]interface{}{
+					"the-service-broker": "wants this object",
+				}
+				serviceKey, warnings, err := client.CreateServiceKey("some-service-instance-guid", "some-key-name", parameters)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(serviceKey).To(Equal(ServiceKey{
+					GUID:                "some-service-key-guid",
+					Name:                "some-key-name",
+					ServiceInstanceGUID: "some-service-instance-guid",
+					Credentials: map[string]interface{}{
+						"username": "some-username",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when no parameters are provided", func() {
+			BeforeEach(func() {
+				requestBody := map[string]interface{}{
+					"service_instance_guid": "some-service-instance-guid",
+					"name":                  "some-key-name",
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_keys"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusCreated, `{"metadata": {"guid": "some-service-key-guid"}}`),
+					),
+				)
+			})
+
+			It("omits the parameters", func() {
+				serviceKey, _, err := client.CreateServiceKey("some-service-instance-guid", "some-key-name", nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceKey).To(Equal(ServiceKey{GUID: "some-service-key-guid"}))
+			})
+		})
+
+		Context("when the create returns an error", func() {
+			BeforeEach(func() {
+				response := `
+					{
+						"description": "The service key name is taken: some-key-name",
+						"error_code": "CF-ServiceKeyNameTaken",
+						"code": 360001
+					}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_keys"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateServiceKey("some-service-instance-guid", "some-key-name", nil)
+				Expect(err).To(MatchError(ccerror.ServiceKeyNameTakenError{Message: "The service key name is taken: some-key-name"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetServiceKeys", func() {
+		Context("when there are service keys", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/service_keys?q=service_instance_guid:some-service-instance-guid&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "service-key-guid-1"
+							},
+							"entity": {
+								"name": "key-1",
+								"service_instance_guid": "some-service-instance-guid",
+								"credentials": {}
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "service-key-guid-2"
+							},
+							"entity": {
+								"name": "key-2",
+								"service_instance_guid": "some-service-instance-guid",
+								"credentials": {
+									"password": "some-password"
+								}
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_keys", "q=service_instance_guid:some-service-instance-guid"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_keys", "q=service_instance_guid:some-service-instance-guid&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns all the queried service keys and warnings", func() {
+				serviceKeys, warnings, err := client.GetServiceKeys(Query{
+					Filter:   ServiceInstanceGUIDFilter,
+					Operator: EqualOperator,
+					Values:   []string{"some-service-instance-guid"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(serviceKeys).To(ConsistOf([]ServiceKey{
+					{
+						GUID:                "service-key-guid-1",
+						Name:                "key-1",
+						ServiceInstanceGUID: "some-service-instance-guid",
+						Credentials:         map[string]interface{}{},
+					},
+					{
+						GUID:                "service-key-guid-2",
+						Name:                "key-2",
+						ServiceInstanceGUID: "some-service-instance-guid",
+						Credentials: map[string]interface{}{
+							"password": "some-password",
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_keys"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetServiceKeys()
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
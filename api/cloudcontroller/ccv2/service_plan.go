@@ -0,0 +1,113 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ServicePlanSchemas are the broker provided JSON schemas for the
+// configuration parameters accepted when creating or updating a service
+// instance, and when creating a service binding. A schema is nil when the
+// broker does not provide it.
+type ServicePlanSchemas struct {
+	ServiceInstanceCreate map[string]interface{}
+	ServiceInstanceUpdate map[string]interface{}
+	ServiceBindingCreate  map[string]interface{}
+}
+
+// ServicePlan represents a Cloud Controller Service Plan.
+type ServicePlan struct {
+	GUID        string
+	Name        string
+	Description string
+	Free        bool
+	ServiceGUID string
+	Schemas     ServicePlanSchemas
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Plan response.
+func (servicePlan *ServicePlan) UnmarshalJSON(data []byte) error {
+	type parametersSchema struct {
+		Parameters map[string]interface{} `json:"parameters"`
+	}
+	var ccServicePlan struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Name        string `json:"name"`
+			Description string `json:"description"`
+			Free        bool   `json:"free"`
+			ServiceGUID string `json:"service_guid"`
+			Schemas     struct {
+				ServiceInstance struct {
+					Create parametersSchema `json:"create"`
+					Update parametersSchema `json:"update"`
+				} `json:"service_instance"`
+				ServiceBinding struct {
+					Create parametersSchema `json:"create"`
+				} `json:"service_binding"`
+			} `json:"schemas"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccServicePlan); err != nil {
+		return err
+	}
+
+	servicePlan.GUID = ccServicePlan.Metadata.GUID
+	servicePlan.Name = ccServicePlan.Entity.Name
+	servicePlan.Description = ccServicePlan.Entity.Description
+	servicePlan.Free = ccServicePlan.Entity.Free
+	servicePlan.ServiceGUID = ccServicePlan.Entity.ServiceGUID
+	servicePlan.Schemas.ServiceInstanceCreate = ccServicePlan.Entity.Schemas.ServiceInstance.Create.Parameters
+	servicePlan.Schemas.ServiceInstanceUpdate = ccServicePlan.Entity.Schemas.ServiceInstance.Update.Parameters
+	servicePlan.Schemas.ServiceBindingCreate = ccServicePlan.Entity.Schemas.ServiceBinding.Create.Parameters
+	return nil
+}
+
+// GetServicePlan returns the service plan with the provided GUID.
+func (client *Client) GetServicePlan(servicePlanGUID string) (ServicePlan, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicePlanRequest,
+		URIParams:   Params{"service_plan_guid": servicePlanGUID},
+	})
+	if err != nil {
+		return ServicePlan{}, nil, err
+	}
+
+	var servicePlan ServicePlan
+	response := cloudcontroller.Response{
+		Result: &servicePlan,
+	}
+
+	err = client.connection.Make(request, &response)
+	return servicePlan, response.Warnings, err
+}
+
+// GetServicePlans returns a list of Service Plans based off of the provided
+// queries.
+func (client *Client) GetServicePlans(queries ...Query) ([]ServicePlan, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicePlansRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServicePlansList []ServicePlan
+	warnings, err := client.paginate(request, ServicePlan{}, func(item interface{}) error {
+		if servicePlan, ok := item.(ServicePlan); ok {
+			fullServicePlansList = append(fullServicePlansList, servicePlan)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServicePlan{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServicePlansList, warnings, err
+}
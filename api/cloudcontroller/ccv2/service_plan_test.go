@@ -0,0 +1,227 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Plan", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServicePlan", func() {
+		Context("when the service plan exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-plan-guid"
+					},
+					"entity": {
+						"name": "some-service-plan",
+						"free": true,
+						"service_guid": "some-service-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_plans/some-service-plan-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service plan and warnings", func() {
+				servicePlan, warnings, err := client.GetServicePlan("some-service-plan-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(servicePlan).To(Equal(ServicePlan{
+					GUID:        "some-service-plan-guid",
+					Name:        "some-service-plan",
+					Free:        true,
+					ServiceGUID: "some-service-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service plan does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 110003,
+					"description": "The service plan could not be found: some-service-plan-guid",
+					"error_code": "CF-ServicePlanNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_plans/some-service-plan-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetServicePlan("some-service-plan-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The service plan could not be found: some-service-plan-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetServicePlans", func() {
+		Context("when no errors are encountered", func() {
+			Context("when results are paginated", func() {
+				BeforeEach(func() {
+					response1 := `{
+						"next_url": "/v2/service_plans?q=service_guid:some-service-guid&page=2",
+						"resources": [
+							{
+								"metadata": {
+									"guid": "service-plan-guid-1"
+								},
+								"entity": {
+									"name": "plan-1",
+									"description": "some-description-1",
+									"free": true,
+									"service_guid": "some-service-guid",
+									"schemas": {
+										"service_instance": {
+											"create": {
+												"parameters": {
+													"$schema": "http://json-schema.org/draft-04/schema#",
+													"type": "object"
+												}
+											},
+											"update": {
+												"parameters": {
+													"type": "object"
+												}
+											}
+										},
+										"service_binding": {
+											"create": {
+												"parameters": {
+													"type": "string"
+												}
+											}
+										}
+									}
+								}
+							}
+						]
+					}`
+					response2 := `{
+						"next_url": null,
+						"resources": [
+							{
+								"metadata": {
+									"guid": "service-plan-guid-2"
+								},
+								"entity": {
+									"name": "plan-2",
+									"description": "some-description-2",
+									"free": false,
+									"service_guid": "some-service-guid",
+									"schemas": {
+										"service_instance": {
+											"create": {},
+											"update": {}
+										},
+										"service_binding": {
+											"create": {}
+										}
+									}
+								}
+							}
+						]
+					}`
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/service_plans", "q=service_guid:some-service-guid"),
+							RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+						))
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/service_plans", "q=service_guid:some-service-guid&page=2"),
+							RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+						))
+				})
+
+				It("returns paginated results and all warnings", func() {
+					servicePlans, warnings, err := client.GetServicePlans(Query{
+						Filter:   ServiceGUIDFilter,
+						Operator: EqualOperator,
+						Values:   []string{"some-service-guid"},
+					})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(servicePlans).To(Equal([]ServicePlan{
+						{
+							GUID:        "service-plan-guid-1",
+							Name:        "plan-1",
+							Description: "some-description-1",
+							Free:        true,
+							ServiceGUID: "some-service-guid",
+							Schemas: ServicePlanSchemas{
+								ServiceInstanceCreate: map[string]interface{}{
+									"$schema": "http://json-schema.org/draft-04/schema#",
+									"type":    "object",
+								},
+								ServiceInstanceUpdate: map[string]interface{}{
+									"type": "object",
+								},
+								ServiceBindingCreate: map[string]interface{}{
+									"type": "string",
+								},
+							},
+						},
+						{
+							GUID:        "service-plan-guid-2",
+							Name:        "plan-2",
+							Description: "some-description-2",
+							Free:        false,
+							ServiceGUID: "some-service-guid",
+						},
+					}))
+					Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				})
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 10001,
+  "description": "Some Error",
+  "error_code": "CF-SomeError"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_plans"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				_, warnings, err := client.GetServicePlans()
+
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+})
@@ -0,0 +1,243 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetService", func() {
+		Context("when the service exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-guid"
+					},
+					"entity": {
+						"label": "some-label",
+						"description": "some-description",
+						"tags": ["some-tag"]
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/services/some-service-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					))
+			})
+
+			It("returns the service and warnings", func() {
+				service, warnings, err := client.GetService("some-service-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(service).To(Equal(Service{
+					GUID:        "some-service-guid",
+					Label:       "some-label",
+					Description: "some-description",
+					Tags:        []string{"some-tag"},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the service does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 120003,
+					"description": "The service could not be found: some-service-guid",
+					"error_code": "CF-ServiceNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/services/some-service-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetService("some-service-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The service could not be found: some-service-guid"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetServices", func() {
+		Context("when no errors are encountered", func() {
+			Context("when results are paginated", func() {
+				BeforeEach(func() {
+					response1 := `{
+						"next_url": "/v2/services?q=label:some-label&page=2",
+						"resources": [
+							{
+								"metadata": {
+									"guid": "service-guid-1"
+								},
+								"entity": {
+									"label": "some-label",
+									"description": "some-description-1"
+								}
+							}
+						]
+					}`
+					response2 := `{
+						"next_url": null,
+						"resources": [
+							{
+								"metadata": {
+									"guid": "service-guid-2"
+								},
+								"entity": {
+									"label": "some-label",
+									"description": "some-description-2"
+								}
+							}
+						]
+					}`
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/services", "q=label:some-label"),
+							RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+						))
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/services", "q=label:some-label&page=2"),
+							RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+						))
+				})
+
+				It("returns paginated results and all warnings", func() {
+					services, warnings, err := client.GetServices(Query{
+						Filter:   LabelFilter,
+						Operator: EqualOperator,
+						Values:   []string{"some-label"},
+					})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(services).To(Equal([]Service{
+						{
+							GUID:        "service-guid-1",
+							Label:       "some-label",
+							Description: "some-description-1",
+						},
+						{
+							GUID:        "service-guid-2",
+							Label:       "some-label",
+							Description: "some-description-2",
+						},
+					}))
+					Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				})
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 10001,
+  "description": "Some Error",
+  "error_code": "CF-SomeError"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/services"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				_, warnings, err := client.GetServices()
+
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
+	Describe("PurgeService", func() {
+		Context("when the Cloud Controller purges the service in a job", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "job-guid"
+					},
+					"entity": {
+						"guid": "job-guid",
+						"status": "queued"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/services/some-service-guid", "purge=true&async=true"),
+						RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the job and all warnings", func() {
+				job, warnings, err := client.PurgeService("some-service-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(job.GUID).To(Equal("job-guid"))
+				Expect(job.Status).To(Equal(JobStatusQueued))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when the Cloud Controller purges the service right away", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/services/some-service-guid", "purge=true&async=true"),
+						RespondWith(http.StatusNoContent, nil, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					),
+				)
+			})
+
+			It("returns an empty job and all warnings", func() {
+				job, warnings, err := client.PurgeService("some-service-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(job).To(Equal(Job{}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when the Cloud Controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10010,
+					"description": "The service could not be found: some-service-guid",
+					"error_code": "CF-ServiceNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/services/some-service-guid", "purge=true&async=true"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.PurgeService("some-service-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The service could not be found: some-service-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+})
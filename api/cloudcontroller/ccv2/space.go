@@ -1,8 +1,10 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
@@ -39,9 +41,49 @@ func (space *Space) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// createSpaceRequestBody represents the body of a create space request.
+type createSpaceRequestBody struct {
+	Name             string `json:"name"`
+	OrganizationGUID string `json:"organization_guid"`
+}
+
+// spaceRoleRequestBody represents the body of a request granting a space role
+// to a user.
+type spaceRoleRequestBody struct {
+	Username string `json:"username"`
+}
+
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Space codetemplates/delete_async_by_guid.go.template delete_space.go
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Space codetemplates/delete_async_by_guid_test.go.template delete_space_test.go
 
+// CreateSpace creates a new Space with the provided name in the provided
+// Organization.
+func (client *Client) CreateSpace(spaceName string, orgGUID string) (Space, Warnings, error) {
+	bodyBytes, err := json.Marshal(createSpaceRequestBody{
+		Name:             spaceName,
+		OrganizationGUID: orgGUID,
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	var space Space
+	response := cloudcontroller.Response{
+		Result: &space,
+	}
+
+	err = client.connection.Make(request, &response)
+	return space, response.Warnings, err
+}
+
 // GetSpaces returns a list of Spaces based off of the provided queries.
 func (client *Client) GetSpaces(queries ...Query) ([]Space, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -121,3 +163,95 @@ func (client *Client) GetRunningSpacesBySecurityGroup(securityGroupGUID string)
 
 	return fullSpacesList, warnings, err
 }
+
+// UpdateSpaceAuditorByUsername grants the SpaceAuditor role in the provided
+// Space to the user with the provided username.
+func (client *Client) UpdateSpaceAuditorByUsername(spaceGUID string, username string) (Warnings, error) {
+	return client.updateSpaceRoleByUsername(internal.PutSpaceAuditorByUsernameRequest, spaceGUID, username)
+}
+
+// UpdateSpaceDeveloperByUsername grants the SpaceDeveloper role in the
+// provided Space to the user with the provided username.
+func (client *Client) UpdateSpaceDeveloperByUsername(spaceGUID string, username string) (Warnings, error) {
+	return client.updateSpaceRoleByUsername(internal.PutSpaceDeveloperByUsernameRequest, spaceGUID, username)
+}
+
+// UpdateSpaceManagerByUsername grants the SpaceManager role in the provided
+// Space to the user with the provided username.
+func (client *Client) UpdateSpaceManagerByUsername(spaceGUID string, username string) (Warnings, error) {
+	return client.updateSpaceRoleByUsername(internal.PutSpaceManagerByUsernameRequest, spaceGUID, username)
+}
+
+func (client *Client) updateSpaceRoleByUsername(requestName string, spaceGUID string, username string) (Warnings, error) {
+	bodyBytes, err := json.Marshal(spaceRoleRequestBody{
+		Username: username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   Params{"space_guid": spaceGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// UpdateSpaceAuditor grants the SpaceAuditor role in the provided Space to
+// the user or client with the provided GUID.
+func (client *Client) UpdateSpaceAuditor(spaceGUID string, userGUID string) (Warnings, error) {
+	return client.makeSpaceRoleRequest(internal.PutSpaceAuditorRequest, spaceGUID, userGUID)
+}
+
+// UpdateSpaceDeveloper grants the SpaceDeveloper role in the provided Space to
+// the user or client with the provided GUID.
+func (client *Client) UpdateSpaceDeveloper(spaceGUID string, userGUID string) (Warnings, error) {
+	return client.makeSpaceRoleRequest(internal.PutSpaceDeveloperRequest, spaceGUID, userGUID)
+}
+
+// UpdateSpaceManager grants the SpaceManager role in the provided Space to
+// the user or client with the provided GUID.
+func (client *Client) UpdateSpaceManager(spaceGUID string, userGUID string) (Warnings, error) {
+	return client.makeSpaceRoleRequest(internal.PutSpaceManagerRequest, spaceGUID, userGUID)
+}
+
+// DeleteSpaceAuditor revokes the SpaceAuditor role in the provided Space from
+// the user or client with the provided GUID.
+func (client *Client) DeleteSpaceAuditor(spaceGUID string, userGUID string) (Warnings, error) {
+	return client.makeSpaceRoleRequest(internal.DeleteSpaceAuditorRequest, spaceGUID, userGUID)
+}
+
+// DeleteSpaceDeveloper revokes the SpaceDeveloper role in the provided Space
+// from the user or client with the provided GUID.
+func (client *Client) DeleteSpaceDeveloper(spaceGUID string, userGUID string) (Warnings, error) {
+	return client.makeSpaceRoleRequest(internal.DeleteSpaceDeveloperRequest, spaceGUID, userGUID)
+}
+
+// DeleteSpaceManager revokes the SpaceManager role in the provided Space from
+// the user or client with the provided GUID.
+func (client *Client) DeleteSpaceManager(spaceGUID string, userGUID string) (Warnings, error) {
+	return client.makeSpaceRoleRequest(internal.DeleteSpaceManagerRequest, spaceGUID, userGUID)
+}
+
+func (client *Client) makeSpaceRoleRequest(requestName string, spaceGUID string, userGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   Params{"space_guid": spaceGUID, "user_guid": userGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
@@ -1,15 +1,86 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
+// SpaceQuota is the definition of a quota for a space.
 type SpaceQuota struct {
+	// GUID is the unique space quota identifier.
 	GUID string
+
+	// Name is the name of the space quota.
 	Name string
+
+	// OrganizationGUID is the GUID of the organization the space quota is
+	// defined in.
+	OrganizationGUID string
+
+	// NonBasicServicesAllowed is true when instances of paid service plans
+	// can be provisioned.
+	NonBasicServicesAllowed types.NullBool
+
+	// TotalServices is the maximum number of service instances. -1 represents
+	// an unlimited amount.
+	TotalServices types.NullInt
+
+	// TotalRoutes is the maximum number of routes. -1 represents an unlimited
+	// amount.
+	TotalRoutes types.NullInt
+
+	// TotalReservedRoutePorts is the maximum number of routes with reserved
+	// ports. -1 represents an unlimited amount.
+	TotalReservedRoutePorts types.NullInt
+
+	// MemoryLimit is the maximum amount of memory in megabytes that all
+	// application instances can use.
+	MemoryLimit types.NullInt
+
+	// InstanceMemoryLimit is the maximum amount of memory in megabytes that a
+	// single application instance can use. -1 represents an unlimited amount.
+	InstanceMemoryLimit types.NullInt
+
+	// AppInstanceLimit is the maximum number of application instances. -1
+	// represents an unlimited amount.
+	AppInstanceLimit types.NullInt
+}
+
+// MarshalJSON converts a SpaceQuota into a Cloud Controller space quota
+// definition. Unset limits are omitted so that they are left unchanged on
+// update.
+func (spaceQuota SpaceQuota) MarshalJSON() ([]byte, error) {
+	ccQuota := struct {
+		Name                    string `json:"name,omitempty"`
+		OrganizationGUID        string `json:"organization_guid,omitempty"`
+		NonBasicServicesAllowed *bool  `json:"non_basic_services_allowed,omitempty"`
+		TotalServices           *int   `json:"total_services,omitempty"`
+		TotalRoutes             *int   `json:"total_routes,omitempty"`
+		TotalReservedRoutePorts *int   `json:"total_reserved_route_ports,omitempty"`
+		MemoryLimit             *int   `json:"memory_limit,omitempty"`
+		InstanceMemoryLimit     *int   `json:"instance_memory_limit,omitempty"`
+		AppInstanceLimit        *int   `json:"app_instance_limit,omitempty"`
+	}{
+		Name:             spaceQuota.Name,
+		OrganizationGUID: spaceQuota.OrganizationGUID,
+	}
+
+	if spaceQuota.NonBasicServicesAllowed.IsSet {
+		ccQuota.NonBasicServicesAllowed = &spaceQuota.NonBasicServicesAllowed.Value
+	}
+	ccQuota.TotalServices = nullIntPointer(spaceQuota.TotalServices)
+	ccQuota.TotalRoutes = nullIntPointer(spaceQuota.TotalRoutes)
+	ccQuota.TotalReservedRoutePorts = nullIntPointer(spaceQuota.TotalReservedRoutePorts)
+	ccQuota.MemoryLimit = nullIntPointer(spaceQuota.MemoryLimit)
+	ccQuota.InstanceMemoryLimit = nullIntPointer(spaceQuota.InstanceMemoryLimit)
+	ccQuota.AppInstanceLimit = nullIntPointer(spaceQuota.AppInstanceLimit)
+
+	return json.Marshal(ccQuota)
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Space Quota response.
@@ -17,7 +88,15 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 	var ccSpaceQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name                    string         `json:"name"`
+			OrganizationGUID        string         `json:"organization_guid"`
+			NonBasicServicesAllowed types.NullBool `json:"non_basic_services_allowed"`
+			TotalServices           types.NullInt  `json:"total_services"`
+			TotalRoutes             types.NullInt  `json:"total_routes"`
+			TotalReservedRoutePorts types.NullInt  `json:"total_reserved_route_ports"`
+			MemoryLimit             types.NullInt  `json:"memory_limit"`
+			InstanceMemoryLimit     types.NullInt  `json:"instance_memory_limit"`
+			AppInstanceLimit        types.NullInt  `json:"app_instance_limit"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccSpaceQuota); err != nil {
@@ -26,9 +105,23 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 
 	spaceQuota.GUID = ccSpaceQuota.Metadata.GUID
 	spaceQuota.Name = ccSpaceQuota.Entity.Name
+	spaceQuota.OrganizationGUID = ccSpaceQuota.Entity.OrganizationGUID
+	spaceQuota.NonBasicServicesAllowed = ccSpaceQuota.Entity.NonBasicServicesAllowed
+	spaceQuota.TotalServices = ccSpaceQuota.Entity.TotalServices
+	spaceQuota.TotalRoutes = ccSpaceQuota.Entity.TotalRoutes
+	spaceQuota.TotalReservedRoutePorts = ccSpaceQuota.Entity.TotalReservedRoutePorts
+	spaceQuota.MemoryLimit = ccSpaceQuota.Entity.MemoryLimit
+	spaceQuota.InstanceMemoryLimit = ccSpaceQuota.Entity.InstanceMemoryLimit
+	spaceQuota.AppInstanceLimit = ccSpaceQuota.Entity.AppInstanceLimit
 	return nil
 }
 
+// CreateSpaceQuota creates a space quota with the provided limits in the
+// provided space quota's organization.
+func (client *Client) CreateSpaceQuota(spaceQuota SpaceQuota) (SpaceQuota, Warnings, error) {
+	return client.makeSpaceQuotaRequest(internal.PostSpaceQuotaDefinitionRequest, nil, spaceQuota)
+}
+
 // GetSpaceQuota returns a Space Quota.
 func (client *Client) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -47,3 +140,79 @@ func (client *Client) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 	err = client.connection.Make(request, &response)
 	return spaceQuota, response.Warnings, err
 }
+
+// GetSpaceQuotas returns the Space Quotas defined in the provided
+// Organization.
+func (client *Client) GetSpaceQuotas(orgGUID string) ([]SpaceQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationSpaceQuotasRequest,
+		URIParams:   Params{"organization_guid": orgGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullSpaceQuotasList []SpaceQuota
+	warnings, err := client.paginate(request, SpaceQuota{}, func(item interface{}) error {
+		if spaceQuota, ok := item.(SpaceQuota); ok {
+			fullSpaceQuotasList = append(fullSpaceQuotasList, spaceQuota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   SpaceQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullSpaceQuotasList, warnings, err
+}
+
+// SetSpaceQuota assigns the provided Space Quota to the provided Space.
+func (client *Client) SetSpaceQuota(spaceGUID string, quotaGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSpaceQuotaRequest,
+		URIParams: Params{
+			"space_quota_guid": quotaGUID,
+			"space_guid":       spaceGUID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// UpdateSpaceQuota updates the space quota with the provided GUID. Only the
+// name and the set limits of the provided quota are changed.
+func (client *Client) UpdateSpaceQuota(spaceQuota SpaceQuota) (SpaceQuota, Warnings, error) {
+	return client.makeSpaceQuotaRequest(internal.PutSpaceQuotaDefinitionRequest, Params{"space_quota_guid": spaceQuota.GUID}, spaceQuota)
+}
+
+func (client *Client) makeSpaceQuotaRequest(requestName string, uriParams Params, spaceQuota SpaceQuota) (SpaceQuota, Warnings, error) {
+	bodyBytes, err := json.Marshal(spaceQuota)
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	var updatedSpaceQuota SpaceQuota
+	response := cloudcontroller.Response{
+		Result: &updatedSpaceQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedSpaceQuota, response.Warnings, err
+}
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -71,4 +72,261 @@ var _ = Describe("Space Quotas", func() {
 			})
 		})
 	})
+
+	Describe("GetSpaceQuotas", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/organizations/org-guid/space_quota_definitions?page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "space-quota-guid-1"
+							},
+							"entity": {
+								"name": "space-quota-1"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "space-quota-guid-2"
+							},
+							"entity": {
+								"name": "space-quota-2"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/org-guid/space_quota_definitions"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/org-guid/space_quota_definitions", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns all the space quotas in the organization and all warnings", func() {
+				spaceQuotas, warnings, err := client.GetSpaceQuotas("org-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(spaceQuotas).To(ConsistOf(
+					SpaceQuota{GUID: "space-quota-guid-1", Name: "space-quota-1"},
+					SpaceQuota{GUID: "space-quota-guid-2", Name: "space-quota-2"},
+				))
+			})
+		})
+
+		Context("when the request returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 30003,
+					"description": "The organization could not be found: org-guid",
+					"error_code": "CF-OrganizationNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/org-guid/space_quota_definitions"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetSpaceQuotas("org-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The organization could not be found: org-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("SetSpaceQuota", func() {
+		Context("when the quota is assigned successfully", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/space-quota-guid/spaces/space-guid"),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns all warnings", func() {
+				warnings, err := client.SetSpaceQuota("space-guid", "space-quota-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the request returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 40005,
+					"description": "The space quota could not be found: space-quota-guid",
+					"error_code": "CF-SpaceQuotaDefinitionNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/space-quota-guid/spaces/space-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.SetSpaceQuota("space-guid", "space-quota-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The space quota could not be found: space-quota-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("CreateSpaceQuota", func() {
+		Context("when the creation is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-space-quota-guid"
+					},
+					"entity": {
+						"name": "some-space-quota",
+						"organization_guid": "some-org-guid",
+						"non_basic_services_allowed": false,
+						"total_services": 5,
+						"total_routes": 10,
+						"memory_limit": 1024,
+						"instance_memory_limit": -1,
+						"app_instance_limit": -1
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/space_quota_definitions"),
+						VerifyJSON(`{
+							"name": "some-space-quota",
+							"organization_guid": "some-org-guid",
+							"non_basic_services_allowed": false,
+							"total_services": 5,
+							"total_routes": 10,
+							"memory_limit": 1024,
+							"instance_memory_limit": -1,
+							"app_instance_limit": -1
+						}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created space quota and warnings", func() {
+				spaceQuota := SpaceQuota{
+					Name:                    "some-space-quota",
+					OrganizationGUID:        "some-org-guid",
+					NonBasicServicesAllowed: types.NullBool{IsSet: true, Value: false},
+					TotalServices:           types.NullInt{IsSet: true, Value: 5},
+					TotalRoutes:             types.NullInt{IsSet: true, Value: 10},
+					MemoryLimit:             types.NullInt{IsSet: true, Value: 1024},
+					InstanceMemoryLimit:     types.NullInt{IsSet: true, Value: -1},
+					AppInstanceLimit:        types.NullInt{IsSet: true, Value: -1},
+				}
+
+				createdQuota, warnings, err := client.CreateSpaceQuota(spaceQuota)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				spaceQuota.GUID = "some-space-quota-guid"
+				Expect(createdQuota).To(Equal(spaceQuota))
+			})
+		})
+
+		Context("when the quota name is taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 310001,
+					"description": "The space quota definition name is taken: some-space-quota",
+					"error_code": "CF-SpaceQuotaDefinitionNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/space_quota_definitions"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateSpaceQuota(SpaceQuota{Name: "some-space-quota"})
+				Expect(err).To(MatchError(ccerror.QuotaNameTakenError{Message: "The space quota definition name is taken: some-space-quota"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceQuota", func() {
+		Context("when the update is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-space-quota-guid"
+					},
+					"entity": {
+						"name": "some-space-quota",
+						"organization_guid": "some-org-guid",
+						"app_instance_limit": -1
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/some-space-quota-guid"),
+						VerifyJSON(`{"app_instance_limit":-1}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("only sends the set limits", func() {
+				spaceQuota, warnings, err := client.UpdateSpaceQuota(SpaceQuota{
+					GUID:             "some-space-quota-guid",
+					AppInstanceLimit: types.NullInt{IsSet: true, Value: -1},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(spaceQuota).To(Equal(SpaceQuota{
+					GUID:             "some-space-quota-guid",
+					Name:             "some-space-quota",
+					OrganizationGUID: "some-org-guid",
+					AppInstanceLimit: types.NullInt{IsSet: true, Value: -1},
+				}))
+			})
+		})
+
+		Context("when the request returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 310007,
+					"description": "Space Quota Definition could not be found: some-space-quota-guid",
+					"error_code": "CF-SpaceQuotaDefinitionNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/some-space-quota-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.UpdateSpaceQuota(SpaceQuota{GUID: "some-space-quota-guid"})
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Space Quota Definition could not be found: some-space-quota-guid"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
 )
@@ -437,4 +438,147 @@ var _ = Describe("Space", func() {
 			})
 		})
 	})
+
+	Describe("CreateSpace", func() {
+		Context("when the space is created successfully", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "space-guid"
+					},
+					"entity": {
+						"name": "some-space",
+						"organization_guid": "org-guid",
+						"space_quota_definition_guid": null,
+						"allow_ssh": true
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces"),
+						VerifyJSON(`{"name":"some-space","organization_guid":"org-guid"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the created space and all warnings", func() {
+				space, warnings, err := client.CreateSpace("some-space", "org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(space).To(Equal(Space{
+					GUID:             "space-guid",
+					Name:             "some-space",
+					OrganizationGUID: "org-guid",
+					AllowSSH:         true,
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when cloud controller returns an error and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 40002,
+					"description": "The app space name is taken: some-space",
+					"error_code": "CF-SpaceNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.CreateSpace("some-space", "org-guid")
+				Expect(err).To(MatchError(ccerror.SpaceNameTakenError{Message: "The app space name is taken: some-space"}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
+	DescribeTable("granting space roles by username",
+		func(path string, update func(*Client) (Warnings, error)) {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, path),
+					VerifyJSON(`{"username":"some-user"}`),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+				),
+			)
+
+			warnings, err := update(client)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+		},
+
+		Entry("UpdateSpaceAuditorByUsername", "/v2/spaces/space-guid/auditors", func(client *Client) (Warnings, error) {
+			return client.UpdateSpaceAuditorByUsername("space-guid", "some-user")
+		}),
+		Entry("UpdateSpaceDeveloperByUsername", "/v2/spaces/space-guid/developers", func(client *Client) (Warnings, error) {
+			return client.UpdateSpaceDeveloperByUsername("space-guid", "some-user")
+		}),
+		Entry("UpdateSpaceManagerByUsername", "/v2/spaces/space-guid/managers", func(client *Client) (Warnings, error) {
+			return client.UpdateSpaceManagerByUsername("space-guid", "some-user")
+		}),
+	)
+
+	DescribeTable("managing space roles by user GUID",
+		func(method string, path string, makeRequest func(*Client) (Warnings, error)) {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(method, path),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+				),
+			)
+
+			warnings, err := makeRequest(client)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+		},
+
+		Entry("UpdateSpaceAuditor", http.MethodPut, "/v2/spaces/space-guid/auditors/user-guid", func(client *Client) (Warnings, error) {
+			return client.UpdateSpaceAuditor("space-guid", "user-guid")
+		}),
+		Entry("UpdateSpaceDeveloper", http.MethodPut, "/v2/spaces/space-guid/developers/user-guid", func(client *Client) (Warnings, error) {
+			return client.UpdateSpaceDeveloper("space-guid", "user-guid")
+		}),
+		Entry("UpdateSpaceManager", http.MethodPut, "/v2/spaces/space-guid/managers/user-guid", func(client *Client) (Warnings, error) {
+			return client.UpdateSpaceManager("space-guid", "user-guid")
+		}),
+		Entry("DeleteSpaceAuditor", http.MethodDelete, "/v2/spaces/space-guid/auditors/user-guid", func(client *Client) (Warnings, error) {
+			return client.DeleteSpaceAuditor("space-guid", "user-guid")
+		}),
+		Entry("DeleteSpaceDeveloper", http.MethodDelete, "/v2/spaces/space-guid/developers/user-guid", func(client *Client) (Warnings, error) {
+			return client.DeleteSpaceDeveloper("space-guid", "user-guid")
+		}),
+		Entry("DeleteSpaceManager", http.MethodDelete, "/v2/spaces/space-guid/managers/user-guid", func(client *Client) (Warnings, error) {
+			return client.DeleteSpaceManager("space-guid", "user-guid")
+		}),
+	)
+
+	Describe("UpdateSpaceDeveloperByUsername", func() {
+		Context("when the user does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 20003,
+					"description": "The user could not be found: some-user",
+					"error_code": "CF-UserNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/spaces/space-guid/developers"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.UpdateSpaceDeveloperByUsername("space-guid", "some-user")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The user could not be found: some-user"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
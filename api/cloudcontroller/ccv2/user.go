@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
 // User represents a Cloud Controller User.
 type User struct {
-	GUID string
+	GUID     string
+	Username string
 }
 
 // userRequestBody represents the body of the request.
@@ -22,12 +24,16 @@ type userRequestBody struct {
 func (user *User) UnmarshalJSON(data []byte) error {
 	var ccUser struct {
 		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Username string `json:"username"`
+		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccUser); err != nil {
 		return err
 	}
 
 	user.GUID = ccUser.Metadata.GUID
+	user.Username = ccUser.Entity.Username
 	return nil
 }
 
@@ -61,3 +67,85 @@ func (client *Client) CreateUser(uaaUserID string) (User, Warnings, error) {
 
 	return user, response.Warnings, nil
 }
+
+// DeleteUser deletes the Cloud Controller User with the provided GUID.
+func (client *Client) DeleteUser(userGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteUserRequest,
+		URIParams:   Params{"user_guid": userGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// GetOrganizationAuditors returns the users with the OrgAuditor role in the
+// provided organization.
+func (client *Client) GetOrganizationAuditors(orgGUID string) ([]User, Warnings, error) {
+	return client.getUsers(internal.GetOrganizationAuditorsRequest, Params{"organization_guid": orgGUID})
+}
+
+// GetOrganizationBillingManagers returns the users with the BillingManager
+// role in the provided organization.
+func (client *Client) GetOrganizationBillingManagers(orgGUID string) ([]User, Warnings, error) {
+	return client.getUsers(internal.GetOrganizationBillingManagersRequest, Params{"organization_guid": orgGUID})
+}
+
+// GetOrganizationManagers returns the users with the OrgManager role in the
+// provided organization.
+func (client *Client) GetOrganizationManagers(orgGUID string) ([]User, Warnings, error) {
+	return client.getUsers(internal.GetOrganizationManagersRequest, Params{"organization_guid": orgGUID})
+}
+
+// GetOrganizationUsers returns every member of the provided organization.
+func (client *Client) GetOrganizationUsers(orgGUID string) ([]User, Warnings, error) {
+	return client.getUsers(internal.GetOrganizationUsersRequest, Params{"organization_guid": orgGUID})
+}
+
+// GetSpaceAuditors returns the users with the SpaceAuditor role in the
+// provided space.
+func (client *Client) GetSpaceAuditors(spaceGUID string) ([]User, Warnings, error) {
+	return client.getUsers(internal.GetSpaceAuditorsRequest, Params{"space_guid": spaceGUID})
+}
+
+// GetSpaceDevelopers returns the users with the SpaceDeveloper role in the
+// provided space.
+func (client *Client) GetSpaceDevelopers(spaceGUID string) ([]User, Warnings, error) {
+	return client.getUsers(internal.GetSpaceDevelopersRequest, Params{"space_guid": spaceGUID})
+}
+
+// GetSpaceManagers returns the users with the SpaceManager role in the
+// provided space.
+func (client *Client) GetSpaceManagers(spaceGUID string) ([]User, Warnings, error) {
+	return client.getUsers(internal.GetSpaceManagersRequest, Params{"space_guid": spaceGUID})
+}
+
+func (client *Client) getUsers(requestName string, uriParams Params) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var usersList []User
+	warnings, err := client.paginate(request, User{}, func(item interface{}) error {
+		if user, ok := item.(User); ok {
+			usersList = append(usersList, user)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   User{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return usersList, warnings, err
+}
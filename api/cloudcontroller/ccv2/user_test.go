@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
 )
@@ -87,4 +88,142 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("DeleteUser", func() {
+		Context("when the user is deleted", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/users/some-user-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					),
+				)
+			})
+
+			It("deletes the user and returns all warnings", func() {
+				warnings, err := client.DeleteUser("some-user-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when cloud controller returns an error and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 20003,
+					"description": "The user could not be found: some-user-guid",
+					"error_code": "CF-UserNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/users/some-user-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns the errors and all warnings", func() {
+				warnings, err := client.DeleteUser("some-user-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The user could not be found: some-user-guid",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
+	Describe("role listing", func() {
+		DescribeTable("returns the users with the role across all pages",
+			func(listUsers func(*Client) ([]User, Warnings, error), path string) {
+				response1 := `{
+					"next_url": "` + path + `?page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-user-guid-1"
+							},
+							"entity": {
+								"username": "some-user-1"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-user-guid-2"
+							},
+							"entity": {
+								"username": "some-user-2"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, path),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, path, "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+
+				users, warnings, err := listUsers(client)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(users).To(Equal([]User{
+					{GUID: "some-user-guid-1", Username: "some-user-1"},
+					{GUID: "some-user-guid-2", Username: "some-user-2"},
+				}))
+			},
+
+			Entry("GetOrganizationAuditors",
+				func(c *Client) ([]User, Warnings, error) { return c.GetOrganizationAuditors("some-org-guid") },
+				"/v2/organizations/some-org-guid/auditors"),
+			Entry("GetOrganizationBillingManagers",
+				func(c *Client) ([]User, Warnings, error) { return c.GetOrganizationBillingManagers("some-org-guid") },
+				"/v2/organizations/some-org-guid/billing_managers"),
+			Entry("GetOrganizationManagers",
+				func(c *Client) ([]User, Warnings, error) { return c.GetOrganizationManagers("some-org-guid") },
+				"/v2/organizations/some-org-guid/managers"),
+			Entry("GetOrganizationUsers",
+				func(c *Client) ([]User, Warnings, error) { return c.GetOrganizationUsers("some-org-guid") },
+				"/v2/organizations/some-org-guid/users"),
+			Entry("GetSpaceAuditors",
+				func(c *Client) ([]User, Warnings, error) { return c.GetSpaceAuditors("some-space-guid") },
+				"/v2/spaces/some-space-guid/auditors"),
+			Entry("GetSpaceDevelopers",
+				func(c *Client) ([]User, Warnings, error) { return c.GetSpaceDevelopers("some-space-guid") },
+				"/v2/spaces/some-space-guid/developers"),
+			Entry("GetSpaceManagers",
+				func(c *Client) ([]User, Warnings, error) { return c.GetSpaceManagers("some-space-guid") },
+				"/v2/spaces/some-space-guid/managers"),
+		)
+
+		Context("when cloud controller returns an error and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 30003,
+					"description": "The organization could not be found: some-org-guid",
+					"error_code": "CF-OrganizationNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/managers"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetOrganizationManagers("some-org-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The organization could not be found: some-org-guid",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
 })
@@ -24,6 +24,7 @@ type Application struct {
 	GUID          string        `json:"guid,omitempty"`
 	State         string        `json:"state,omitempty"`
 	Lifecycle     AppLifecycle  `json:"lifecycle,omitempty"`
+	Metadata      *Metadata     `json:"metadata,omitempty"`
 }
 
 type AppLifecycle struct {
@@ -33,6 +34,7 @@ type AppLifecycle struct {
 
 type AppLifecycleData struct {
 	Buildpacks []string `json:"buildpacks,omitempty"`
+	Stack      string   `json:"stack,omitempty"`
 }
 
 func (a Application) MarshalJSON() ([]byte, error) {
@@ -40,29 +42,32 @@ func (a Application) MarshalJSON() ([]byte, error) {
 		Name          string                 `json:"name,omitempty"`
 		Relationships Relationships          `json:"relationships,omitempty"`
 		Lifecycle     map[string]interface{} `json:"lifecycle,omitempty"`
+		Metadata      *Metadata              `json:"metadata,omitempty"`
 	}
 
 	ccApp.Name = a.Name
 	ccApp.Relationships = a.Relationships
+	ccApp.Metadata = a.Metadata
 
 	switch a.Lifecycle.Type {
 	case BuildpackAppLifecycleType:
+		data := map[string]interface{}{}
 		if len(a.Lifecycle.Data.Buildpacks) > 0 {
 			switch a.Lifecycle.Data.Buildpacks[0] {
 			case "default", "null":
-				ccApp.Lifecycle = map[string]interface{}{
-					"type": a.Lifecycle.Type,
-					"data": map[string]interface{}{
-						"buildpacks": nil,
-					},
-				}
+				data["buildpacks"] = nil
 			default:
-				ccApp.Lifecycle = map[string]interface{}{
-					"type": a.Lifecycle.Type,
-					"data": map[string]interface{}{
-						"buildpacks": a.Lifecycle.Data.Buildpacks,
-					},
-				}
+				data["buildpacks"] = a.Lifecycle.Data.Buildpacks
+			}
+		}
+		if a.Lifecycle.Data.Stack != "" {
+			data["stack"] = a.Lifecycle.Data.Stack
+		}
+
+		if len(data) > 0 {
+			ccApp.Lifecycle = map[string]interface{}{
+				"type": a.Lifecycle.Type,
+				"data": data,
 			}
 		}
 	case DockerAppLifecycleType:
@@ -84,28 +89,41 @@ type DropletRelationship struct {
 
 // GetApplications lists applications with optional filters.
 func (client *Client) GetApplications(query url.Values) ([]Application, Warnings, error) {
+	var fullAppsList []Application
+	warnings, err := client.GetApplicationsPaged(query, func(page []Application) error {
+		fullAppsList = append(fullAppsList, page...)
+		return nil
+	})
+
+	return fullAppsList, warnings, err
+}
+
+// GetApplicationsPaged calls handlePage with each page of applications
+// matching the optional filters as it is retrieved. Returning an error from
+// handlePage stops the pagination and returns that error.
+func (client *Client) GetApplicationsPaged(query url.Values, handlePage func([]Application) error) (Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.GetAppsRequest,
 		Query:       query,
 	})
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	var fullAppsList []Application
-	warnings, err := client.paginate(request, Application{}, func(item interface{}) error {
-		if app, ok := item.(Application); ok {
-			fullAppsList = append(fullAppsList, app)
-		} else {
-			return ccerror.UnknownObjectInListError{
-				Expected:   Application{},
-				Unexpected: item,
+	return client.paginatePages(request, Application{}, func(list []interface{}) error {
+		apps := make([]Application, 0, len(list))
+		for _, item := range list {
+			app, ok := item.(Application)
+			if !ok {
+				return ccerror.UnknownObjectInListError{
+					Expected:   Application{},
+					Unexpected: item,
+				}
 			}
+			apps = append(apps, app)
 		}
-		return nil
+		return handlePage(apps)
 	})
-
-	return fullAppsList, warnings, err
 }
 
 // CreateApplication creates an application with the given settings
@@ -1,12 +1,14 @@
 package ccv3_test
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -120,6 +122,41 @@ var _ = Describe("Application", func() {
 					Expect(string(appBytes)).To(Equal(`{"lifecycle":{"data":{"buildpacks":["some-buildpack"]},"type":"buildpack"}}`))
 				})
 			})
+
+			Context("when a stack is provided", func() {
+				BeforeEach(func() {
+					app = Application{
+						Lifecycle: AppLifecycle{
+							Type: BuildpackAppLifecycleType,
+							Data: AppLifecycleData{
+								Stack: "some-stack",
+							},
+						},
+					}
+				})
+
+				It("sets it in the JSON", func() {
+					Expect(string(appBytes)).To(Equal(`{"lifecycle":{"data":{"stack":"some-stack"},"type":"buildpack"}}`))
+				})
+			})
+
+			Context("when buildpacks and a stack are provided", func() {
+				BeforeEach(func() {
+					app = Application{
+						Lifecycle: AppLifecycle{
+							Type: BuildpackAppLifecycleType,
+							Data: AppLifecycleData{
+								Buildpacks: []string{"some-buildpack"},
+								Stack:      "some-stack",
+							},
+						},
+					}
+				})
+
+				It("sets both in the JSON", func() {
+					Expect(string(appBytes)).To(Equal(`{"lifecycle":{"data":{"buildpacks":["some-buildpack"],"stack":"some-stack"},"type":"buildpack"}}`))
+				})
+			})
 		})
 	})
 
@@ -190,6 +227,7 @@ var _ = Describe("Application", func() {
 							Type: BuildpackAppLifecycleType,
 							Data: AppLifecycleData{
 								Buildpacks: []string{"some-buildpack"},
+								Stack:      "some-stack",
 							},
 						},
 					},
@@ -248,6 +286,76 @@ var _ = Describe("Application", func() {
 		})
 	})
 
+	Describe("GetApplicationsPaged", func() {
+		BeforeEach(func() {
+			response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/apps?space_guids=some-space-guid&page=2"
+		}
+	},
+	"resources": [
+		{
+			"name": "app-name-1",
+			"guid": "app-guid-1"
+		}
+	]
+}`, server.URL())
+			response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"name": "app-name-2",
+			"guid": "app-guid-2"
+		}
+	]
+}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/apps", "space_guids=some-space-guid"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/apps", "space_guids=some-space-guid&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("calls handlePage with each page as it is retrieved", func() {
+			var pages [][]Application
+			warnings, err := client.GetApplicationsPaged(url.Values{
+				SpaceGUIDFilter: []string{"some-space-guid"},
+			}, func(page []Application) error {
+				pages = append(pages, page)
+				return nil
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pages).To(Equal([][]Application{
+				{{Name: "app-name-1", GUID: "app-guid-1"}},
+				{{Name: "app-name-2", GUID: "app-guid-2"}},
+			}))
+			Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+		})
+
+		Context("when handlePage returns an error", func() {
+			It("stops paginating and returns the error and the warnings so far", func() {
+				expectedErr := errors.New("stop")
+				warnings, err := client.GetApplicationsPaged(url.Values{
+					SpaceGUIDFilter: []string{"some-space-guid"},
+				}, func(page []Application) error {
+					return expectedErr
+				})
+
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("UpdateApplication", func() {
 		Context("when the application successfully is updated", func() {
 			BeforeEach(func() {
@@ -306,6 +414,55 @@ var _ = Describe("Application", func() {
 			})
 		})
 
+		Context("when only labels are updated", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-app-guid",
+					"name": "some-app-name",
+					"metadata": {
+						"labels": {
+							"env": "prod"
+						}
+					}
+				}`
+
+				expectedBody := map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"env":  "prod",
+							"tier": nil,
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("sends only the metadata and returns the updated labels", func() {
+				app, warnings, err := client.UpdateApplication(Application{
+					GUID: "some-app-guid",
+					Metadata: &Metadata{
+						Labels: map[string]types.NullString{
+							"env":  types.NewNullString("prod"),
+							"tier": {},
+						},
+					},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(app.Metadata.Labels).To(Equal(map[string]types.NullString{
+					"env": types.NewNullString("prod"),
+				}))
+			})
+		})
+
 		Context("when cc returns back an error or warnings", func() {
 			BeforeEach(func() {
 				response := `{
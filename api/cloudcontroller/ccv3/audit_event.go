@@ -0,0 +1,93 @@
+package ccv3
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// AuditEvent represents a Cloud Controller V3 audit event, a record of an
+// action taken by an actor on a target such as an app.
+type AuditEvent struct {
+	GUID      string
+	Type      string
+	CreatedAt string
+
+	ActorGUID string
+	ActorType string
+	ActorName string
+
+	TargetGUID string
+	TargetType string
+	TargetName string
+
+	// Data contains details of the action that depend on the type of the
+	// event.
+	Data map[string]interface{}
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller V3 Audit Event response.
+func (event *AuditEvent) UnmarshalJSON(data []byte) error {
+	type participant struct {
+		GUID string `json:"guid"`
+		Type string `json:"type"`
+		Name string `json:"name"`
+	}
+
+	var ccEvent struct {
+		GUID      string                 `json:"guid"`
+		Type      string                 `json:"type"`
+		CreatedAt string                 `json:"created_at"`
+		Actor     participant            `json:"actor"`
+		Target    participant            `json:"target"`
+		Data      map[string]interface{} `json:"data"`
+	}
+
+	err := json.Unmarshal(data, &ccEvent)
+	if err != nil {
+		return err
+	}
+
+	event.GUID = ccEvent.GUID
+	event.Type = ccEvent.Type
+	event.CreatedAt = ccEvent.CreatedAt
+	event.ActorGUID = ccEvent.Actor.GUID
+	event.ActorType = ccEvent.Actor.Type
+	event.ActorName = ccEvent.Actor.Name
+	event.TargetGUID = ccEvent.Target.GUID
+	event.TargetType = ccEvent.Target.Type
+	event.TargetName = ccEvent.Target.Name
+	event.Data = ccEvent.Data
+
+	return nil
+}
+
+// GetAuditEventsPaged calls handlePage with each page of audit events
+// matching the optional filters as it is retrieved. Returning an error from
+// handlePage stops the pagination and returns that error.
+func (client *Client) GetAuditEventsPaged(query url.Values, handlePage func([]AuditEvent) error) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAuditEventsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.paginatePages(request, AuditEvent{}, func(list []interface{}) error {
+		events := make([]AuditEvent, 0, len(list))
+		for _, item := range list {
+			event, ok := item.(AuditEvent)
+			if !ok {
+				return ccerror.UnknownObjectInListError{
+					Expected:   AuditEvent{},
+					Unexpected: item,
+				}
+			}
+			events = append(events, event)
+		}
+		return handlePage(events)
+	})
+}
@@ -0,0 +1,197 @@
+package ccv3_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Audit Events", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetAuditEventsPaged", func() {
+		var (
+			pages      [][]AuditEvent
+			handleErr  error
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			pages = nil
+			handleErr = nil
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = client.GetAuditEventsPaged(url.Values{
+				TargetGUIDFilter: []string{"some-app-guid"},
+				OrderBy:          []string{CreatedAtDescendingOrder},
+			}, func(page []AuditEvent) error {
+				pages = append(pages, page)
+				return handleErr
+			})
+		})
+
+		Context("when the cloud controller returns events", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/audit_events?target_guids=some-app-guid&order_by=-created_at&page=2"
+		}
+	},
+	"resources": [
+		{
+			"guid": "event-guid-1",
+			"created_at": "2017-08-14T21:16:42Z",
+			"type": "audit.app.update",
+			"actor": {
+				"guid": "user-guid",
+				"type": "user",
+				"name": "some-user"
+			},
+			"target": {
+				"guid": "some-app-guid",
+				"type": "app",
+				"name": "some-app"
+			},
+			"data": {
+				"request": {
+					"instances": 2
+				}
+			}
+		}
+	]
+}`, server.URL())
+				response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"guid": "event-guid-2",
+			"created_at": "2017-08-14T21:16:12Z",
+			"type": "audit.app.create",
+			"actor": {
+				"guid": "user-guid",
+				"type": "user",
+				"name": "some-user"
+			},
+			"target": {
+				"guid": "some-app-guid",
+				"type": "app",
+				"name": "some-app"
+			}
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/audit_events", "order_by=-created_at&target_guids=some-app-guid"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/audit_events", "target_guids=some-app-guid&order_by=-created_at&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("calls handlePage with each page as it is retrieved", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(pages).To(Equal([][]AuditEvent{
+					{
+						{
+							GUID:       "event-guid-1",
+							Type:       "audit.app.update",
+							CreatedAt:  "2017-08-14T21:16:42Z",
+							ActorGUID:  "user-guid",
+							ActorType:  "user",
+							ActorName:  "some-user",
+							TargetGUID: "some-app-guid",
+							TargetType: "app",
+							TargetName: "some-app",
+							Data: map[string]interface{}{
+								"request": map[string]interface{}{"instances": float64(2)},
+							},
+						},
+					},
+					{
+						{
+							GUID:       "event-guid-2",
+							Type:       "audit.app.create",
+							CreatedAt:  "2017-08-14T21:16:12Z",
+							ActorGUID:  "user-guid",
+							ActorType:  "user",
+							ActorName:  "some-user",
+							TargetGUID: "some-app-guid",
+							TargetType: "app",
+							TargetName: "some-app",
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+			})
+
+			Context("when handlePage returns an error", func() {
+				BeforeEach(func() {
+					handleErr = errors.New("stop")
+				})
+
+				It("stops paginating and returns the error and the warnings so far", func() {
+					Expect(executeErr).To(MatchError(handleErr))
+					Expect(pages).To(HaveLen(1))
+					Expect(warnings).To(ConsistOf("this is a warning"))
+				})
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10008,
+			"detail": "The request is semantically invalid: command presence",
+			"title": "CF-UnprocessableEntity"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/audit_events"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "The request is semantically invalid: command presence",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(pages).To(BeEmpty())
+			})
+		})
+	})
+})
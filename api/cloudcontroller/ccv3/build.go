@@ -23,6 +23,9 @@ type Build struct {
 	PackageGUID string
 	State       BuildState
 	DropletGUID string
+	// Buildpacks is the ordered list of buildpacks used to stage the package.
+	// When empty, the application's lifecycle buildpacks are used.
+	Buildpacks []string
 }
 
 func (b Build) MarshalJSON() ([]byte, error) {
@@ -30,10 +33,27 @@ func (b Build) MarshalJSON() ([]byte, error) {
 		Package struct {
 			GUID string `json:"guid"`
 		} `json:"package"`
+		Lifecycle map[string]interface{} `json:"lifecycle,omitempty"`
 	}
 
 	ccBuild.Package.GUID = b.PackageGUID
 
+	if len(b.Buildpacks) > 0 {
+		var buildpacks []string
+		switch b.Buildpacks[0] {
+		case "default", "null":
+		default:
+			buildpacks = b.Buildpacks
+		}
+
+		ccBuild.Lifecycle = map[string]interface{}{
+			"type": BuildpackAppLifecycleType,
+			"data": map[string]interface{}{
+				"buildpacks": buildpacks,
+			},
+		}
+	}
+
 	return json.Marshal(ccBuild)
 }
 
@@ -49,6 +69,11 @@ func (b *Build) UnmarshalJSON(data []byte) error {
 		Droplet struct {
 			GUID string `json:"guid"`
 		} `json:"droplet"`
+		Lifecycle struct {
+			Data struct {
+				Buildpacks []string `json:"buildpacks"`
+			} `json:"data"`
+		} `json:"lifecycle"`
 	}
 
 	if err := json.Unmarshal(data, &ccBuild); err != nil {
@@ -61,6 +86,7 @@ func (b *Build) UnmarshalJSON(data []byte) error {
 	b.PackageGUID = ccBuild.Package.GUID
 	b.State = ccBuild.State
 	b.DropletGUID = ccBuild.Droplet.GUID
+	b.Buildpacks = ccBuild.Lifecycle.Data.Buildpacks
 
 	return nil
 }
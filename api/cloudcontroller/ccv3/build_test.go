@@ -55,6 +55,86 @@ var _ = Describe("Build", func() {
 			})
 		})
 
+		Context("when buildpacks are provided", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-build-guid",
+					"state": "STAGING",
+					"lifecycle": {
+						"type": "buildpack",
+						"data": {
+							"buildpacks": ["some-buildpack", "some-other-buildpack"]
+						}
+					}
+				}`
+
+				expectedBody := map[string]interface{}{
+					"package": map[string]interface{}{
+						"guid": "some-package-guid",
+					},
+					"lifecycle": map[string]interface{}{
+						"type": "buildpack",
+						"data": map[string]interface{}{
+							"buildpacks": []string{"some-buildpack", "some-other-buildpack"},
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/builds"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("stages with the buildpacks in the given order", func() {
+				build, warnings, err := client.CreateBuild(Build{
+					PackageGUID: "some-package-guid",
+					Buildpacks:  []string{"some-buildpack", "some-other-buildpack"},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(build).To(Equal(Build{
+					GUID:       "some-build-guid",
+					State:      BuildStateStaging,
+					Buildpacks: []string{"some-buildpack", "some-other-buildpack"},
+				}))
+			})
+		})
+
+		Context("when the default buildpack is provided", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"package": map[string]interface{}{
+						"guid": "some-package-guid",
+					},
+					"lifecycle": map[string]interface{}{
+						"type": "buildpack",
+						"data": map[string]interface{}{
+							"buildpacks": nil,
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/builds"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, `{"guid": "some-build-guid"}`, nil),
+					),
+				)
+			})
+
+			It("stages with buildpack autodetection", func() {
+				_, _, err := client.CreateBuild(Build{
+					PackageGUID: "some-package-guid",
+					Buildpacks:  []string{"default"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
 		Context("when cc returns back an error or warnings", func() {
 			BeforeEach(func() {
 				response := ` {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/transport"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -36,6 +37,7 @@ var _ = SynchronizedAfterSuite(func() {
 
 var _ = BeforeEach(func() {
 	server.Reset()
+	transport.CloseIdleConnections()
 })
 
 func NewTestClient(config ...Config) *Client {
@@ -97,6 +99,9 @@ func SetupV3Response() {
 			"apps": {
 				"href": "SERVER_URL/v3/apps"
 			},
+			"audit_events": {
+				"href": "SERVER_URL/v3/audit_events"
+			},
 			"tasks": {
 				"href": "SERVER_URL/v3/tasks"
 			},
@@ -106,18 +111,33 @@ func SetupV3Response() {
 			"builds": {
 				"href": "SERVER_URL/v3/builds"
 			},
+			"deployments": {
+				"href": "SERVER_URL/v3/deployments"
+			},
+			"domains": {
+				"href": "SERVER_URL/v3/domains"
+			},
 			"organizations": {
 				"href": "SERVER_URL/v3/organizations"
 			},
 			"spaces": {
 				"href": "SERVER_URL/v3/spaces"
 			},
+			"stacks": {
+				"href": "SERVER_URL/v3/stacks"
+			},
+			"service_instances": {
+				"href": "SERVER_URL/v3/service_instances"
+			},
 			"packages": {
 				"href": "SERVER_URL/v3/packages"
 			},
 			"processes": {
 				"href": "SERVER_URL/v3/processes"
 			},
+			"routes": {
+				"href": "SERVER_URL/v3/routes"
+			},
 			"droplets": {
 				"href": "SERVER_URL/v3/droplets"
 			}
@@ -71,6 +71,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/jobs"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
 
@@ -88,8 +89,9 @@ type Client struct {
 	userAgent  string
 	wrappers   []ConnectionWrapper
 
-	jobPollingInterval time.Duration
-	jobPollingTimeout  time.Duration
+	jobPollingInterval  time.Duration
+	jobPollingTimeout   time.Duration
+	jobPollingCanceller jobs.Canceller
 }
 
 // Config allows the Client to be configured
@@ -106,6 +108,9 @@ type Config struct {
 	// JobPollingInterval is the wait time between job polls.
 	JobPollingInterval time.Duration
 
+	// JobPollingCanceller cancels job polling when its context is cancelled.
+	JobPollingCanceller jobs.Canceller
+
 	// Wrappers that apply to the client connection.
 	Wrappers []ConnectionWrapper
 }
@@ -114,9 +119,10 @@ type Config struct {
 func NewClient(config Config) *Client {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
 	return &Client{
-		userAgent:          userAgent,
-		jobPollingInterval: config.JobPollingInterval,
-		jobPollingTimeout:  config.JobPollingTimeout,
-		wrappers:           append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
+		userAgent:           userAgent,
+		jobPollingInterval:  config.JobPollingInterval,
+		jobPollingTimeout:   config.JobPollingTimeout,
+		jobPollingCanceller: config.JobPollingCanceller,
+		wrappers:            append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
 	}
 }
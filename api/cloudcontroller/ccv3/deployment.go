@@ -0,0 +1,187 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+type DeploymentState string
+
+const (
+	DeploymentStateDeploying DeploymentState = "DEPLOYING"
+	DeploymentStateDeployed  DeploymentState = "DEPLOYED"
+	DeploymentStateCanceling DeploymentState = "CANCELING"
+	DeploymentStateCanceled  DeploymentState = "CANCELED"
+)
+
+// Deployment replaces the instances of an application's web process with
+// instances running a new droplet, one at a time, so that the application
+// stays up throughout.
+type Deployment struct {
+	GUID        string
+	State       DeploymentState
+	DropletGUID string
+	// RevisionGUID is the revision that was deployed, when the deployment
+	// rolled the application back to an earlier revision.
+	RevisionGUID string
+	AppGUID      string
+	CreatedAt    string
+}
+
+func (d Deployment) MarshalJSON() ([]byte, error) {
+	type Droplet struct {
+		GUID string `json:"guid"`
+	}
+	type Revision struct {
+		GUID string `json:"guid"`
+	}
+
+	var ccDeployment struct {
+		Droplet       *Droplet      `json:"droplet,omitempty"`
+		Revision      *Revision     `json:"revision,omitempty"`
+		Relationships Relationships `json:"relationships"`
+	}
+
+	if d.DropletGUID != "" {
+		ccDeployment.Droplet = &Droplet{GUID: d.DropletGUID}
+	}
+	if d.RevisionGUID != "" {
+		ccDeployment.Revision = &Revision{GUID: d.RevisionGUID}
+	}
+	ccDeployment.Relationships = Relationships{
+		ApplicationRelationship: Relationship{GUID: d.AppGUID},
+	}
+
+	return json.Marshal(ccDeployment)
+}
+
+func (d *Deployment) UnmarshalJSON(data []byte) error {
+	var ccDeployment struct {
+		GUID      string          `json:"guid"`
+		State     DeploymentState `json:"state"`
+		CreatedAt string          `json:"created_at"`
+		Droplet   struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+		Revision struct {
+			GUID string `json:"guid"`
+		} `json:"revision"`
+		Relationships Relationships `json:"relationships"`
+	}
+
+	if err := json.Unmarshal(data, &ccDeployment); err != nil {
+		return err
+	}
+
+	d.GUID = ccDeployment.GUID
+	d.State = ccDeployment.State
+	d.CreatedAt = ccDeployment.CreatedAt
+	d.DropletGUID = ccDeployment.Droplet.GUID
+	d.RevisionGUID = ccDeployment.Revision.GUID
+	d.AppGUID = ccDeployment.Relationships[ApplicationRelationship].GUID
+
+	return nil
+}
+
+// CreateApplicationDeployment starts a deployment of the given droplet to the
+// application. When dropletGUID is empty, the application's current droplet
+// is deployed.
+func (client *Client) CreateApplicationDeployment(appGUID string, dropletGUID string) (Deployment, Warnings, error) {
+	return client.createDeployment(Deployment{AppGUID: appGUID, DropletGUID: dropletGUID})
+}
+
+// CreateApplicationDeploymentByRevision starts a deployment of the droplet
+// and configuration of the given revision to the application.
+func (client *Client) CreateApplicationDeploymentByRevision(appGUID string, revisionGUID string) (Deployment, Warnings, error) {
+	return client.createDeployment(Deployment{AppGUID: appGUID, RevisionGUID: revisionGUID})
+}
+
+func (client *Client) createDeployment(deployment Deployment) (Deployment, Warnings, error) {
+	bodyBytes, err := json.Marshal(deployment)
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDeploymentRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	var createdDeployment Deployment
+	response := cloudcontroller.Response{
+		Result: &createdDeployment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return createdDeployment, response.Warnings, err
+}
+
+// GetDeployment returns the deployment with the given GUID.
+func (client *Client) GetDeployment(deploymentGUID string) (Deployment, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDeploymentRequest,
+		URIParams:   internal.Params{"deployment_guid": deploymentGUID},
+	})
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	var deployment Deployment
+	response := cloudcontroller.Response{
+		Result: &deployment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return deployment, response.Warnings, err
+}
+
+// GetDeployments lists deployments with optional filters.
+func (client *Client) GetDeployments(query url.Values) ([]Deployment, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDeploymentsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullDeploymentsList []Deployment
+	warnings, err := client.paginate(request, Deployment{}, func(item interface{}) error {
+		if deployment, ok := item.(Deployment); ok {
+			fullDeploymentsList = append(fullDeploymentsList, deployment)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Deployment{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullDeploymentsList, warnings, err
+}
+
+// CancelDeployment stops the deployment with the given GUID and rolls the
+// application back to the droplet it was running before the deployment.
+func (client *Client) CancelDeployment(deploymentGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDeploymentCancelRequest,
+		URIParams:   internal.Params{"deployment_guid": deploymentGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
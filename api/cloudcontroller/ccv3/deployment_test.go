@@ -0,0 +1,361 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Deployment", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateApplicationDeployment", func() {
+		Context("when the deployment is created", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-deployment-guid",
+					"state": "DEPLOYING",
+					"created_at": "some-time",
+					"droplet": {
+						"guid": "some-droplet-guid"
+					},
+					"relationships": {
+						"app": {
+							"data": {
+								"guid": "some-app-guid"
+							}
+						}
+					}
+				}`
+
+				expectedBody := map[string]interface{}{
+					"droplet": map[string]string{
+						"guid": "some-droplet-guid",
+					},
+					"relationships": map[string]interface{}{
+						"app": map[string]interface{}{
+							"data": map[string]string{
+								"guid": "some-app-guid",
+							},
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the deployment and all warnings", func() {
+				deployment, warnings, err := client.CreateApplicationDeployment("some-app-guid", "some-droplet-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(deployment).To(Equal(Deployment{
+					GUID:        "some-deployment-guid",
+					State:       DeploymentStateDeploying,
+					DropletGUID: "some-droplet-guid",
+					AppGUID:     "some-app-guid",
+					CreatedAt:   "some-time",
+				}))
+			})
+		})
+
+		Context("when no droplet is given", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"relationships": map[string]interface{}{
+						"app": map[string]interface{}{
+							"data": map[string]string{
+								"guid": "some-app-guid",
+							},
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, `{"guid": "some-deployment-guid"}`),
+					),
+				)
+			})
+
+			It("deploys the current droplet", func() {
+				deployment, _, err := client.CreateApplicationDeployment("some-app-guid", "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deployment.GUID).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "I can't even",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.CreateApplicationDeployment("some-app-guid", "some-droplet-guid")
+				Expect(err).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "I can't even",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("CreateApplicationDeploymentByRevision", func() {
+		BeforeEach(func() {
+			response := `{
+				"guid": "some-deployment-guid",
+				"state": "DEPLOYING",
+				"droplet": {
+					"guid": "some-droplet-guid"
+				},
+				"revision": {
+					"guid": "some-revision-guid"
+				},
+				"relationships": {
+					"app": {
+						"data": {
+							"guid": "some-app-guid"
+						}
+					}
+				}
+			}`
+
+			expectedBody := map[string]interface{}{
+				"revision": map[string]string{
+					"guid": "some-revision-guid",
+				},
+				"relationships": map[string]interface{}{
+					"app": map[string]interface{}{
+						"data": map[string]string{
+							"guid": "some-app-guid",
+						},
+					},
+				},
+			}
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/deployments"),
+					VerifyJSONRepresenting(expectedBody),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("deploys the revision and returns the deployment and all warnings", func() {
+			deployment, warnings, err := client.CreateApplicationDeploymentByRevision("some-app-guid", "some-revision-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(deployment).To(Equal(Deployment{
+				GUID:         "some-deployment-guid",
+				State:        DeploymentStateDeploying,
+				DropletGUID:  "some-droplet-guid",
+				RevisionGUID: "some-revision-guid",
+				AppGUID:      "some-app-guid",
+			}))
+		})
+	})
+	Describe("GetDeployment", func() {
+		Context("when the deployment exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-deployment-guid",
+					"state": "DEPLOYED",
+					"droplet": {
+						"guid": "some-droplet-guid"
+					},
+					"relationships": {
+						"app": {
+							"data": {
+								"guid": "some-app-guid"
+							}
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments/some-deployment-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the deployment and all warnings", func() {
+				deployment, warnings, err := client.GetDeployment("some-deployment-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(deployment).To(Equal(Deployment{
+					GUID:        "some-deployment-guid",
+					State:       DeploymentStateDeployed,
+					DropletGUID: "some-droplet-guid",
+					AppGUID:     "some-app-guid",
+				}))
+			})
+		})
+
+		Context("when the deployment does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Deployment not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments/some-deployment-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and all warnings", func() {
+				_, warnings, err := client.GetDeployment("some-deployment-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Deployment not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetDeployments", func() {
+		Context("when deployments exist", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+					"pagination": {
+						"next": {
+							"href": "%s/v3/deployments?app_guids=some-app-guid&states=DEPLOYING&page=2"
+						}
+					},
+					"resources": [
+						{
+							"guid": "deployment-1-guid",
+							"state": "DEPLOYING"
+						}
+					]
+				}`, server.URL())
+				response2 := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "deployment-2-guid",
+							"state": "DEPLOYING"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments", "app_guids=some-app-guid&states=DEPLOYING"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments", "app_guids=some-app-guid&states=DEPLOYING&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns all the deployments and all warnings", func() {
+				deployments, warnings, err := client.GetDeployments(url.Values{
+					AppGUIDFilter: []string{"some-app-guid"},
+					StatesFilter:  []string{string(DeploymentStateDeploying)},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(deployments).To(ConsistOf(
+					Deployment{GUID: "deployment-1-guid", State: DeploymentStateDeploying},
+					Deployment{GUID: "deployment-2-guid", State: DeploymentStateDeploying},
+				))
+			})
+		})
+	})
+
+	Describe("CancelDeployment", func() {
+		Context("when the deployment is canceled", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/cancel"),
+						RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns all warnings", func() {
+				warnings, err := client.CancelDeployment("some-deployment-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Cannot cancel a DEPLOYED deployment",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/cancel"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.CancelDeployment("some-deployment-guid")
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "Cannot cancel a DEPLOYED deployment"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
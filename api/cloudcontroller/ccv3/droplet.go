@@ -1,7 +1,9 @@
 package ccv3
 
 import (
+	"encoding/json"
 	"net/url"
+	"path"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -23,6 +25,31 @@ type Droplet struct {
 	CreatedAt  string             `json:"created_at"`
 	Stack      string             `json:"stack,omitempty"`
 	Buildpacks []DropletBuildpack `json:"buildpacks,omitempty"`
+
+	// PackageGUID is the GUID of the package the droplet was staged from. It is
+	// read from the droplet's package link and is empty for droplets that were
+	// not staged from a package.
+	PackageGUID string `json:"-"`
+}
+
+func (d *Droplet) UnmarshalJSON(data []byte) error {
+	type ccDroplet Droplet
+	var ccDropletWithLinks struct {
+		ccDroplet
+		Links struct {
+			Package APILink `json:"package"`
+		} `json:"links"`
+	}
+	if err := json.Unmarshal(data, &ccDropletWithLinks); err != nil {
+		return err
+	}
+
+	*d = Droplet(ccDropletWithLinks.ccDroplet)
+	if href := ccDropletWithLinks.Links.Package.HREF; href != "" {
+		d.PackageGUID = path.Base(href)
+	}
+
+	return nil
 }
 
 type DropletBuildpack struct {
@@ -30,6 +57,23 @@ type DropletBuildpack struct {
 	DetectOutput string `json:"detect_output"`
 }
 
+// DeleteDroplet deletes the droplet with the given GUID and returns the job
+// URL to poll for completion.
+func (client *Client) DeleteDroplet(dropletGUID string) (string, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteDropletRequest,
+		URIParams:   internal.Params{"droplet_guid": dropletGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.ResourceLocationURL, response.Warnings, err
+}
+
 // GetApplicationDroplets returns the Droplets for a given app
 func (client *Client) GetApplicationDroplets(appGUID string, query url.Values) ([]Droplet, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -57,6 +101,32 @@ func (client *Client) GetApplicationDroplets(appGUID string, query url.Values) (
 	return responseDroplets, warnings, err
 }
 
+// GetDroplets returns the droplets matching the given query.
+func (client *Client) GetDroplets(query url.Values) ([]Droplet, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDropletsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var responseDroplets []Droplet
+	warnings, err := client.paginate(request, Droplet{}, func(item interface{}) error {
+		if droplet, ok := item.(Droplet); ok {
+			responseDroplets = append(responseDroplets, droplet)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Droplet{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return responseDroplets, warnings, err
+}
+
 func (client *Client) GetDroplet(dropletGUID string) (Droplet, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.GetDropletRequest,
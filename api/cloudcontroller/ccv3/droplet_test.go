@@ -39,7 +39,9 @@ var _ = Describe("Droplet", func() {
 							"state": "STAGED",
 							"created_at": "2017-08-16T00:18:24Z",
 							"links": {
-								"package": "https://api.com/v3/packages/some-package-guid"
+								"package": {
+									"href": "https://api.com/v3/packages/some-package-guid"
+								}
 							}
 						},
 						{
@@ -100,7 +102,8 @@ var _ = Describe("Droplet", func() {
 							DetectOutput: "detected-buildpack-1",
 						},
 					},
-					CreatedAt: "2017-08-16T00:18:24Z",
+					CreatedAt:   "2017-08-16T00:18:24Z",
+					PackageGUID: "some-package-guid",
 				}))
 				Expect(droplets[1]).To(Equal(Droplet{
 					GUID:  "some-guid-2",
@@ -156,6 +159,57 @@ var _ = Describe("Droplet", func() {
 		})
 	})
 
+	Describe("DeleteDroplet", func() {
+		Context("when the droplet is deleted successfully", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusAccepted, ``,
+							http.Header{
+								"X-Cf-Warnings": {"some-warning"},
+								"Location":      {"/v3/jobs/some-location"},
+							},
+						),
+					),
+				)
+			})
+
+			It("returns the job location and all warnings", func() {
+				jobLocation, warnings, err := client.DeleteDroplet("some-droplet-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jobLocation).To(Equal("/v3/jobs/some-location"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		Context("when the droplet does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"some-warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.DeleteDroplet("some-droplet-guid")
+				Expect(err).To(MatchError(ccerror.DropletNotFoundError{}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
 	Describe("GetDroplet", func() {
 		Context("when the request succeeds", func() {
 			BeforeEach(func() {
@@ -230,4 +284,97 @@ var _ = Describe("Droplet", func() {
 			})
 		})
 	})
+
+	Describe("GetDroplets", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+					"pagination": {
+						"next": {
+							"href": "%s/v3/droplets?space_guids=some-space-guid&page=2"
+						}
+					},
+					"resources": [
+						{
+							"guid": "some-guid-1",
+							"state": "STAGED",
+							"created_at": "2017-08-16T00:18:24Z",
+							"links": {
+								"package": {
+									"href": "https://api.com/v3/packages/some-package-guid"
+								}
+							}
+						}
+					]
+				}`, server.URL())
+				response2 := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "some-guid-2",
+							"state": "FAILED",
+							"created_at": "2017-08-22T17:55:02Z"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets", "space_guids=some-space-guid"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets", "space_guids=some-space-guid&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the droplets and all warnings", func() {
+				droplets, warnings, err := client.GetDroplets(url.Values{SpaceGUIDFilter: []string{"some-space-guid"}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(droplets).To(Equal([]Droplet{
+					{
+						GUID:        "some-guid-1",
+						State:       "STAGED",
+						CreatedAt:   "2017-08-16T00:18:24Z",
+						PackageGUID: "some-package-guid",
+					},
+					{
+						GUID:      "some-guid-2",
+						State:     "FAILED",
+						CreatedAt: "2017-08-22T17:55:02Z",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid: space_guids must be an array",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetDroplets(url.Values{})
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "The request is semantically invalid: space_guids must be an array"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
@@ -0,0 +1,101 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Environment represents the environment of an application, grouped by the
+// source of the variables.
+type Environment struct {
+	// System contains the variables provided by the platform, such as
+	// VCAP_SERVICES.
+	System map[string]interface{} `json:"system_env_json"`
+	// Application contains VCAP_APPLICATION.
+	Application map[string]interface{} `json:"application_env_json"`
+	// EnvironmentVariables contains the variables set by the user.
+	EnvironmentVariables map[string]interface{} `json:"environment_variables"`
+	// Running contains the running environment variable group.
+	Running map[string]interface{} `json:"running_env_json"`
+	// Staging contains the staging environment variable group.
+	Staging map[string]interface{} `json:"staging_env_json"`
+}
+
+// EnvironmentVariables are the user provided environment variables of an
+// application. Values can be any JSON type; a nil value unsets the variable.
+type EnvironmentVariables map[string]interface{}
+
+// MarshalJSON converts EnvironmentVariables into a Cloud Controller
+// environment variables request.
+func (variables EnvironmentVariables) MarshalJSON() ([]byte, error) {
+	ccEnvVars := struct {
+		Var map[string]interface{} `json:"var"`
+	}{
+		Var: variables,
+	}
+
+	return json.Marshal(ccEnvVars)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller environment variables
+// response.
+func (variables *EnvironmentVariables) UnmarshalJSON(data []byte) error {
+	var ccEnvVars struct {
+		Var map[string]interface{} `json:"var"`
+	}
+	if err := json.Unmarshal(data, &ccEnvVars); err != nil {
+		return err
+	}
+
+	*variables = EnvironmentVariables(ccEnvVars.Var)
+	return nil
+}
+
+// GetApplicationEnvironment returns the environment of the given application.
+func (client *Client) GetApplicationEnvironment(appGUID string) (Environment, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetApplicationEnvironmentRequest,
+		URIParams:   internal.Params{"app_guid": appGUID},
+	})
+	if err != nil {
+		return Environment{}, nil, err
+	}
+
+	var responseEnvironment Environment
+	response := cloudcontroller.Response{
+		Result: &responseEnvironment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseEnvironment, response.Warnings, err
+}
+
+// UpdateApplicationEnvironmentVariables adds, updates or unsets the given
+// environment variables on the application and returns the resulting set of
+// user provided variables.
+func (client *Client) UpdateApplicationEnvironmentVariables(appGUID string, envVars EnvironmentVariables) (EnvironmentVariables, Warnings, error) {
+	bodyBytes, err := json.Marshal(envVars)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchApplicationEnvironmentVariablesRequest,
+		URIParams:   internal.Params{"app_guid": appGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var responseEnvVars EnvironmentVariables
+	response := cloudcontroller.Response{
+		Result: &responseEnvVars,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseEnvVars, response.Warnings, err
+}
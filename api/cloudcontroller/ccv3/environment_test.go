@@ -0,0 +1,172 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Environment", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationEnvironment", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"staging_env_json": {
+						"staging-group": "staging-value"
+					},
+					"running_env_json": {
+						"running-group": "running-value"
+					},
+					"environment_variables": {
+						"SOME_VAR": "some-value",
+						"SOME_JSON": {"key": ["value"]}
+					},
+					"system_env_json": {
+						"VCAP_SERVICES": {}
+					},
+					"application_env_json": {
+						"VCAP_APPLICATION": {
+							"name": "some-app"
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/env"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the environment and all warnings", func() {
+				environment, warnings, err := client.GetApplicationEnvironment("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(environment).To(Equal(Environment{
+					System: map[string]interface{}{
+						"VCAP_SERVICES": map[string]interface{}{},
+					},
+					Application: map[string]interface{}{
+						"VCAP_APPLICATION": map[string]interface{}{"name": "some-app"},
+					},
+					EnvironmentVariables: map[string]interface{}{
+						"SOME_VAR":  "some-value",
+						"SOME_JSON": map[string]interface{}{"key": []interface{}{"value"}},
+					},
+					Running: map[string]interface{}{"running-group": "running-value"},
+					Staging: map[string]interface{}{"staging-group": "staging-value"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/env"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetApplicationEnvironment("some-app-guid")
+				Expect(err).To(MatchError(ccerror.ApplicationNotFoundError{}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateApplicationEnvironmentVariables", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"var": map[string]interface{}{
+						"SOME_VAR":  "some-value",
+						"SOME_JSON": map[string]interface{}{"key": []interface{}{"value"}},
+						"OLD_VAR":   nil,
+					},
+				}
+				response := `{
+					"var": {
+						"SOME_VAR": "some-value",
+						"SOME_JSON": {"key": ["value"]}
+					},
+					"links": {
+						"self": {
+							"href": "https://api.example.org/v3/apps/some-app-guid/environment_variables"
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid/environment_variables"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the updated environment variables and all warnings", func() {
+				envVars, warnings, err := client.UpdateApplicationEnvironmentVariables("some-app-guid", EnvironmentVariables{
+					"SOME_VAR":  "some-value",
+					"SOME_JSON": map[string]interface{}{"key": []interface{}{"value"}},
+					"OLD_VAR":   nil,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(envVars).To(Equal(EnvironmentVariables{
+					"SOME_VAR":  "some-value",
+					"SOME_JSON": map[string]interface{}{"key": []interface{}{"value"}},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid: command presence",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid/environment_variables"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.UpdateApplicationEnvironmentVariables("some-app-guid", EnvironmentVariables{"SOME_VAR": "some-value"})
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "The request is semantically invalid: command presence"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -43,7 +43,10 @@ func convert(rawHTTPStatusErr ccerror.RawHTTPStatusError) error {
 
 	// error parsing json
 	if err != nil {
-		if rawHTTPStatusErr.StatusCode == http.StatusNotFound {
+		switch rawHTTPStatusErr.StatusCode {
+		case http.StatusForbidden:
+			return ccerror.ForbiddenError{Message: string(rawHTTPStatusErr.RawResponse)}
+		case http.StatusNotFound:
 			return ccerror.NotFoundError{Message: string(rawHTTPStatusErr.RawResponse)}
 		}
 		return rawHTTPStatusErr
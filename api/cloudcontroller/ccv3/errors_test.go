@@ -67,6 +67,16 @@ var _ = Describe("Error Wrapper", func() {
 				})
 			})
 
+			Context("and the raw status is 403", func() {
+				BeforeEach(func() {
+					serverResponseCode = http.StatusForbidden
+					serverResponse = "some forbidden message"
+				})
+				It("returns a ForbiddenError", func() {
+					Expect(makeError).To(MatchError(ccerror.ForbiddenError{Message: serverResponse}))
+				})
+			})
+
 			Context("and the raw status is another error", func() {
 				BeforeEach(func() {
 					serverResponseCode = http.StatusTeapot
@@ -13,9 +13,20 @@ import (
 type APIInfo struct {
 	// Links is a list of top level Cloud Controller APIs.
 	Links struct {
+		// AppSSH is the link for application ssh info.
+		AppSSH struct {
+			HREF string `json:"href"`
+			Meta struct {
+				HostKeyFingerprint string `json:"host_key_fingerprint"`
+			} `json:"meta"`
+		} `json:"app_ssh"`
+
 		// CCV3 is the link to the Cloud Controller V3 API
 		CCV3 APILink `json:"cloud_controller_v3"`
 
+		// LogCache is the link to the Log Cache API
+		LogCache APILink `json:"log_cache"`
+
 		// Logging is the link to the Logging API
 		Logging APILink `json:"logging"`
 
@@ -26,6 +37,22 @@ type APIInfo struct {
 	} `json:"links"`
 }
 
+// AppSSHEndpoint returns the HREF for SSHing into an app container.
+func (info APIInfo) AppSSHEndpoint() string {
+	return info.Links.AppSSH.HREF
+}
+
+// AppSSHHostKeyFingerprint returns the SSH key fingerprint of the SSH proxy
+// that brokers connections to application instances.
+func (info APIInfo) AppSSHHostKeyFingerprint() string {
+	return info.Links.AppSSH.Meta.HostKeyFingerprint
+}
+
+// LogCache returns the HREF for Log Cache.
+func (info APIInfo) LogCache() string {
+	return info.Links.LogCache.HREF
+}
+
 // Logging returns the HREF for Logging.
 func (info APIInfo) Logging() string {
 	return info.Links.Logging.HREF
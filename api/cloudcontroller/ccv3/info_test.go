@@ -62,6 +62,16 @@ var _ = Describe("Info", func() {
 					},
 					"logging": {
 						"href": "wss://doppler.bosh-lite.com:443"
+					},
+					"log_cache": {
+						"href": "https://log-cache.bosh-lite.com"
+					},
+					"app_ssh": {
+						"href": "ssh.bosh-lite.com:2222",
+						"meta": {
+							"host_key_fingerprint": "some-fingerprint",
+							"oauth_client": "ssh-proxy"
+						}
 					}
 				}
 			}`, "SERVER_URL", server.URL(), -1)
@@ -95,8 +105,11 @@ var _ = Describe("Info", func() {
 			apis, _, _, err := client.Info()
 			Expect(err).NotTo(HaveOccurred())
 			Expect(apis.UAA()).To(Equal("https://uaa.bosh-lite.com"))
+			Expect(apis.LogCache()).To(Equal("https://log-cache.bosh-lite.com"))
 			Expect(apis.Logging()).To(Equal("wss://doppler.bosh-lite.com:443"))
 			Expect(apis.NetworkPolicyV1()).To(Equal(fmt.Sprintf("%s/networking/v1/external", server.URL())))
+			Expect(apis.AppSSHEndpoint()).To(Equal("ssh.bosh-lite.com:2222"))
+			Expect(apis.AppSSHHostKeyFingerprint()).To(Equal("some-fingerprint"))
 		})
 
 		It("returns back the resource links", func() {
@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
 
+// Instance represents the stats of a single V3 process instance.
 type Instance struct {
 	Index       int
 	State       string
@@ -18,6 +19,9 @@ type Instance struct {
 	MemoryQuota uint64
 	DiskUsage   uint64
 	DiskQuota   uint64
+	// Routable is true when the instance is able to receive traffic from its
+	// routes.
+	Routable bool
 }
 
 // UnmarshalJSON helps unmarshal a V3 Cloud Controller Instance response.
@@ -33,6 +37,7 @@ func (instance *Instance) UnmarshalJSON(data []byte) error {
 		DiskQuota uint64 `json:"disk_quota"`
 		Index     int    `json:"index"`
 		Uptime    int    `json:"uptime"`
+		Routable  bool   `json:"routable"`
 	}
 	if err := json.Unmarshal(data, &inputInstance); err != nil {
 		return err
@@ -47,6 +52,7 @@ func (instance *Instance) UnmarshalJSON(data []byte) error {
 	instance.DiskQuota = inputInstance.DiskQuota
 	instance.Index = inputInstance.Index
 	instance.Uptime = inputInstance.Uptime
+	instance.Routable = inputInstance.Routable
 
 	return nil
 }
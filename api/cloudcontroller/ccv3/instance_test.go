@@ -85,7 +85,8 @@ var _ = Describe("Instance", func() {
 							"mem_quota": 2000000,
 							"disk_quota": 4000000,
 							"index": 0,
-							"uptime": 123
+							"uptime": 123,
+							"routable": true
 						},
 						{
 							"state": "RUNNING",
@@ -123,6 +124,7 @@ var _ = Describe("Instance", func() {
 						DiskQuota:   4000000,
 						Index:       0,
 						Uptime:      123,
+						Routable:    true,
 					},
 					Instance{
 						State:       "RUNNING",
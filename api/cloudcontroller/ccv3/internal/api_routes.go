@@ -13,15 +13,27 @@ import "net/http"
 const (
 	DeleteApplicationProcessInstanceRequest               = "DeleteApplicationProcessInstanceRequest"
 	DeleteApplicationRequest                              = "DeleteApplication"
+	DeleteDropletRequest                                  = "DeleteDroplet"
 	DeleteIsolationSegmentRelationshipOrganizationRequest = "DeleteIsolationSegmentRelationshipOrganization"
 	DeleteIsolationSegmentRequest                         = "DeleteIsolationSegment"
+	DeletePackageRequest                                  = "DeletePackage"
+	DeleteRouteDestinationRequest                         = "DeleteRouteDestination"
+	DeleteServiceInstanceRelationshipsSharedSpaceRequest  = "DeleteServiceInstanceRelationshipsSharedSpace"
 	GetAppDropletsRequest                                 = "GetAppDroplets"
 	GetAppProcessesRequest                                = "GetAppProcesses"
+	GetAppRevisionsRequest                                = "GetAppRevisions"
+	GetAppRevisionsDeployedRequest                        = "GetAppRevisionsDeployed"
+	GetApplicationEnvironmentRequest                      = "GetApplicationEnvironment"
 	GetAppTasksRequest                                    = "GetAppTasks"
 	GetApplicationProcessByTypeRequest                    = "GetApplicationProcessByType"
+	GetAuditEventsRequest                                 = "GetAuditEvents"
 	GetAppsRequest                                        = "GetApps"
 	GetBuildRequest                                       = "GetBuild"
+	GetDeploymentRequest                                  = "GetDeployment"
+	GetDeploymentsRequest                                 = "GetDeployments"
+	GetDomainsRequest                                     = "GetDomains"
 	GetDropletRequest                                     = "GetDroplet"
+	GetDropletsRequest                                    = "GetDroplets"
 	GetIsolationSegmentOrganizationsRequest               = "GetIsolationSegmentRelationshipOrganizations"
 	GetIsolationSegmentRequest                            = "GetIsolationSegment"
 	GetIsolationSegmentsRequest                           = "GetIsolationSegments"
@@ -30,57 +42,97 @@ const (
 	GetPackageRequest                                     = "GetPackage"
 	GetPackagesRequest                                    = "GetPackages"
 	GetProcessInstancesRequest                            = "GetProcessInstances"
+	GetRouteDestinationsRequest                           = "GetRouteDestinations"
+	GetRoutesRequest                                      = "GetRoutes"
+	GetServiceInstancesRequest                            = "GetServiceInstances"
 	GetSpaceRelationshipIsolationSegmentRequest           = "GetSpaceRelationshipIsolationSegmentRequest"
+	GetSpacesRequest                                      = "GetSpaces"
+	GetStacksRequest                                      = "GetStacks"
 	PatchApplicationCurrentDropletRequest                 = "PatchApplicationCurrentDroplet"
+	PatchApplicationEnvironmentVariablesRequest           = "PatchApplicationEnvironmentVariables"
+	PatchApplicationProcessCommandRequest                 = "PatchApplicationProcessCommand"
 	PatchApplicationProcessHealthCheckRequest             = "PatchApplicationProcessHealthCheck"
 	PatchApplicationRequest                               = "PatchApplicationRequest"
 	PatchOrganizationDefaultIsolationSegmentRequest       = "PatchOrganizationDefaultIsolationSegmentRequest"
+	PatchOrganizationRequest                              = "PatchOrganization"
 	PatchSpaceRelationshipIsolationSegmentRequest         = "PatchSpaceRelationshipIsolationSegmentRequest"
+	PatchSpaceRequest                                     = "PatchSpace"
 	PostAppTasksRequest                                   = "PostAppTasks"
 	PostApplicationProcessScaleRequest                    = "PostApplicationProcessScale"
 	PostApplicationRequest                                = "PostApplicationRequest"
 	PostApplicationStartRequest                           = "PostApplicationStart"
 	PostApplicationStopRequest                            = "PostApplicationStop"
 	PostBuildRequest                                      = "PostBuild"
+	PostDeploymentCancelRequest                           = "PostDeploymentCancel"
+	PostDeploymentRequest                                 = "PostDeployment"
 	PostIsolationSegmentRelationshipOrganizationsRequest  = "PostIsolationSegmentRelationshipOrganizations"
 	PostIsolationSegmentsRequest                          = "PostIsolationSegments"
 	PostPackageRequest                                    = "PostPackageRequest"
+	PostRouteDestinationsRequest                          = "PostRouteDestinations"
+	PostRouteRequest                                      = "PostRoute"
+	PostServiceInstanceRelationshipsSharedSpacesRequest   = "PostServiceInstanceRelationshipsSharedSpaces"
+	PostSpaceActionApplyManifestRequest                   = "PostSpaceActionApplyManifest"
 	PutTaskCancelRequest                                  = "PutTaskCancelRequest"
 )
 
 const (
 	AppsResource              = "apps"
+	AuditEventsResource       = "audit_events"
 	BuildsResource            = "builds"
+	DeploymentsResource       = "deployments"
+	DomainsResource           = "domains"
 	DropletsResource          = "droplets"
 	IsolationSegmentsResource = "isolation_segments"
 	OrgsResource              = "organizations"
 	PackagesResource          = "packages"
 	ProcessesResource         = "processes"
+	RoutesResource            = "routes"
+	ServiceInstancesResource  = "service_instances"
 	SpacesResource            = "spaces"
+	StacksResource            = "stacks"
 	TasksResource             = "tasks"
 )
 
 // APIRoutes is a list of routes used by the router to construct request URLs.
 var APIRoutes = []Route{
 	{Path: "/", Method: http.MethodGet, Name: GetAppsRequest, Resource: AppsResource},
+	{Path: "/", Method: http.MethodGet, Name: GetAuditEventsRequest, Resource: AuditEventsResource},
+	{Path: "/", Method: http.MethodGet, Name: GetDeploymentsRequest, Resource: DeploymentsResource},
+	{Path: "/", Method: http.MethodGet, Name: GetDomainsRequest, Resource: DomainsResource},
+	{Path: "/", Method: http.MethodGet, Name: GetDropletsRequest, Resource: DropletsResource},
 	{Path: "/", Method: http.MethodGet, Name: GetIsolationSegmentsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/", Method: http.MethodGet, Name: GetOrgsRequest, Resource: OrgsResource},
+	{Path: "/", Method: http.MethodGet, Name: GetServiceInstancesRequest, Resource: ServiceInstancesResource},
+	{Path: "/", Method: http.MethodGet, Name: GetSpacesRequest, Resource: SpacesResource},
+	{Path: "/", Method: http.MethodGet, Name: GetStacksRequest, Resource: StacksResource},
 	{Path: "/", Method: http.MethodGet, Name: GetPackagesRequest, Resource: PackagesResource},
+	{Path: "/", Method: http.MethodGet, Name: GetRoutesRequest, Resource: RoutesResource},
 	{Path: "/", Method: http.MethodPost, Name: PostApplicationRequest, Resource: AppsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostBuildRequest, Resource: BuildsResource},
+	{Path: "/", Method: http.MethodPost, Name: PostDeploymentRequest, Resource: DeploymentsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostIsolationSegmentsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostPackageRequest, Resource: PackagesResource},
+	{Path: "/", Method: http.MethodPost, Name: PostRouteRequest, Resource: RoutesResource},
 	{Path: "/:app_guid", Method: http.MethodDelete, Name: DeleteApplicationRequest, Resource: AppsResource},
+	{Path: "/:droplet_guid", Method: http.MethodDelete, Name: DeleteDropletRequest, Resource: DropletsResource},
+	{Path: "/:package_guid", Method: http.MethodDelete, Name: DeletePackageRequest, Resource: PackagesResource},
 	{Path: "/:isolation_segment_guid", Method: http.MethodDelete, Name: DeleteIsolationSegmentRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:build_guid", Method: http.MethodGet, Name: GetBuildRequest, Resource: BuildsResource},
+	{Path: "/:deployment_guid", Method: http.MethodGet, Name: GetDeploymentRequest, Resource: DeploymentsResource},
+	{Path: "/:deployment_guid/actions/cancel", Method: http.MethodPost, Name: PostDeploymentCancelRequest, Resource: DeploymentsResource},
 	{Path: "/:isolation_segment_guid", Method: http.MethodGet, Name: GetIsolationSegmentRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:package_guid", Method: http.MethodGet, Name: GetPackageRequest, Resource: PackagesResource},
+	{Path: "/:process_guid", Method: http.MethodPatch, Name: PatchApplicationProcessCommandRequest, Resource: ProcessesResource},
 	{Path: "/:process_guid", Method: http.MethodPatch, Name: PatchApplicationProcessHealthCheckRequest, Resource: ProcessesResource},
 	{Path: "/:app_guid", Method: http.MethodPatch, Name: PatchApplicationRequest, Resource: AppsResource},
+	{Path: "/:organization_guid", Method: http.MethodPatch, Name: PatchOrganizationRequest, Resource: OrgsResource},
+	{Path: "/:space_guid", Method: http.MethodPatch, Name: PatchSpaceRequest, Resource: SpacesResource},
 	{Path: "/:app_guid/actions/start", Method: http.MethodPost, Name: PostApplicationStartRequest, Resource: AppsResource},
 	{Path: "/:app_guid/actions/stop", Method: http.MethodPost, Name: PostApplicationStopRequest, Resource: AppsResource},
 	{Path: "/:task_guid/cancel", Method: http.MethodPut, Name: PutTaskCancelRequest, Resource: TasksResource},
 	{Path: "/:app_guid/droplets", Method: http.MethodGet, Name: GetAppDropletsRequest, Resource: AppsResource},
+	{Path: "/:app_guid/env", Method: http.MethodGet, Name: GetApplicationEnvironmentRequest, Resource: AppsResource},
+	{Path: "/:app_guid/environment_variables", Method: http.MethodPatch, Name: PatchApplicationEnvironmentVariablesRequest, Resource: AppsResource},
 	{Path: "/:droplet_guid", Method: http.MethodGet, Name: GetDropletRequest, Resource: DropletsResource},
 	{Path: "/:isolation_segment_guid/organizations", Method: http.MethodGet, Name: GetIsolationSegmentOrganizationsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:app_guid/processes", Method: http.MethodGet, Name: GetAppProcessesRequest, Resource: AppsResource},
@@ -88,13 +140,21 @@ var APIRoutes = []Route{
 	{Path: "/:app_guid/processes/:type/actions/scale", Method: http.MethodPost, Name: PostApplicationProcessScaleRequest, Resource: AppsResource},
 	{Path: "/:app_guid/processes/:type/instances/:index", Method: http.MethodDelete, Name: DeleteApplicationProcessInstanceRequest, Resource: AppsResource},
 	{Path: "/:app_guid/relationships/current_droplet", Method: http.MethodPatch, Name: PatchApplicationCurrentDropletRequest, Resource: AppsResource},
+	{Path: "/:app_guid/revisions", Method: http.MethodGet, Name: GetAppRevisionsRequest, Resource: AppsResource},
+	{Path: "/:app_guid/revisions/deployed", Method: http.MethodGet, Name: GetAppRevisionsDeployedRequest, Resource: AppsResource},
 	{Path: "/:organization_guid/relationships/default_isolation_segment", Method: http.MethodGet, Name: GetOrganizationDefaultIsolationSegmentRequest, Resource: OrgsResource},
 	{Path: "/:organization_guid/relationships/default_isolation_segment", Method: http.MethodPatch, Name: PatchOrganizationDefaultIsolationSegmentRequest, Resource: OrgsResource},
+	{Path: "/:service_instance_guid/relationships/shared_spaces", Method: http.MethodPost, Name: PostServiceInstanceRelationshipsSharedSpacesRequest, Resource: ServiceInstancesResource},
+	{Path: "/:service_instance_guid/relationships/shared_spaces/:space_guid", Method: http.MethodDelete, Name: DeleteServiceInstanceRelationshipsSharedSpaceRequest, Resource: ServiceInstancesResource},
+	{Path: "/:space_guid/actions/apply_manifest", Method: http.MethodPost, Name: PostSpaceActionApplyManifestRequest, Resource: SpacesResource},
 	{Path: "/:space_guid/relationships/isolation_segment", Method: http.MethodGet, Name: GetSpaceRelationshipIsolationSegmentRequest, Resource: SpacesResource},
 	{Path: "/:space_guid/relationships/isolation_segment", Method: http.MethodPatch, Name: PatchSpaceRelationshipIsolationSegmentRequest, Resource: SpacesResource},
 	{Path: "/:isolation_segment_guid/relationships/organizations", Method: http.MethodPost, Name: PostIsolationSegmentRelationshipOrganizationsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:isolation_segment_guid/relationships/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteIsolationSegmentRelationshipOrganizationRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:process_guid/stats", Method: http.MethodGet, Name: GetProcessInstancesRequest, Resource: ProcessesResource},
+	{Path: "/:route_guid/destinations", Method: http.MethodGet, Name: GetRouteDestinationsRequest, Resource: RoutesResource},
+	{Path: "/:route_guid/destinations", Method: http.MethodPost, Name: PostRouteDestinationsRequest, Resource: RoutesResource},
+	{Path: "/:route_guid/destinations/:destination_guid", Method: http.MethodDelete, Name: DeleteRouteDestinationRequest, Resource: RoutesResource},
 	{Path: "/:app_guid/tasks", Method: http.MethodGet, Name: GetAppTasksRequest, Resource: AppsResource},
 	{Path: "/:app_guid/tasks", Method: http.MethodPost, Name: PostAppTasksRequest, Resource: AppsResource},
 }
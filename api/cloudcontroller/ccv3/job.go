@@ -1,10 +1,8 @@
 package ccv3
 
 import (
-	"time"
-
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
-	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/jobs"
 )
 
 // JobState is the current state of a job.
@@ -61,40 +59,30 @@ func (client *Client) GetJob(jobURL string) (Job, Warnings, error) {
 }
 
 // PollJob will keep polling the given job until the job has terminated, an
-// error is encountered, or config.OverallPollingTimeout is reached. In the
-// last case, a JobTimeoutError is returned.
+// error is encountered, config.JobPollingCanceller cancels polling, or
+// config.JobPollingTimeout is reached. In the last case, a JobTimeoutError is
+// returned.
 func (client *Client) PollJob(jobURL string) (Warnings, error) {
-	var (
-		err         error
-		warnings    Warnings
-		allWarnings Warnings
-		job         Job
-	)
-
-	startTime := time.Now()
-	for time.Now().Sub(startTime) < client.jobPollingTimeout {
-		job, warnings, err = client.GetJob(jobURL)
-		allWarnings = append(allWarnings, warnings...)
-		if err != nil {
-			return allWarnings, err
-		}
-
-		if job.Failed() {
-			return allWarnings, ccerror.JobFailedError{
-				JobGUID: job.GUID,
-				Message: job.Errors[0].Detail,
-			}
-		}
-
-		if job.Complete() {
-			return allWarnings, nil
-		}
-
-		time.Sleep(client.jobPollingInterval)
+	poller := jobs.NewPoller(jobs.JobGetterFunc(client.getJobState), client.jobPollingCanceller)
+	warnings, err := poller.PollJob(jobURL, client.jobPollingInterval, client.jobPollingTimeout)
+	return Warnings(warnings), err
+}
+
+// getJobState converts the job at the provided URL into a jobs.State.
+func (client *Client) getJobState(jobURL string) (jobs.State, []string, error) {
+	job, warnings, err := client.GetJob(jobURL)
+	if err != nil {
+		return jobs.State{}, warnings, err
 	}
 
-	return allWarnings, ccerror.JobTimeoutError{
-		JobGUID: job.GUID,
-		Timeout: client.jobPollingTimeout,
+	state := jobs.State{
+		GUID:     job.GUID,
+		Complete: job.Complete(),
+		Failed:   job.Failed(),
+	}
+	if len(job.Errors) > 0 {
+		state.FailureMessage = job.Errors[0].Detail
 	}
+
+	return state, warnings, nil
 }
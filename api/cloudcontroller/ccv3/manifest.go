@@ -0,0 +1,28 @@
+package ccv3
+
+import (
+	"bytes"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// UpdateSpaceApplyManifest applies the provided raw YAML manifest to the space
+// with the given GUID and returns the job URL to poll for completion.
+func (client *Client) UpdateSpaceApplyManifest(spaceGUID string, rawManifest []byte) (string, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceActionApplyManifestRequest,
+		URIParams:   internal.Params{"space_guid": spaceGUID},
+		Body:        bytes.NewReader(rawManifest),
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	request.Header.Set("Content-Type", "application/x-yaml")
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.ResourceLocationURL, response.Warnings, err
+}
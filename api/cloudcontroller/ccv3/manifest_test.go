@@ -0,0 +1,88 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Manifest", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("UpdateSpaceApplyManifest", func() {
+		var (
+			rawManifest []byte
+
+			jobURL     string
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			rawManifest = []byte("applications:\n- name: some-app\n  instances: 3\n")
+		})
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.UpdateSpaceApplyManifest("some-space-guid", rawManifest)
+		})
+
+		Context("when the manifest is accepted", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/spaces/some-space-guid/actions/apply_manifest"),
+						VerifyHeaderKV("Content-Type", "application/x-yaml"),
+						VerifyBody(rawManifest),
+						RespondWith(http.StatusAccepted, "",
+							http.Header{
+								"X-Cf-Warnings": {"this is a warning"},
+								"Location":      {"/v3/jobs/some-job-guid"},
+							},
+						),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal("/v3/jobs/some-job-guid"))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "For application 'some-app': Instances must be greater than or equal to 0",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/spaces/some-space-guid/actions/apply_manifest"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "For application 'some-app': Instances must be greater than or equal to 0",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,10 @@
+package ccv3
+
+import "code.cloudfoundry.org/cli/types"
+
+// Metadata represents the user defined data attached to a V3 resource.
+type Metadata struct {
+	// Labels are key/value pairs that can be used to select resources. A label
+	// with an unset value is removed from the resource when updated.
+	Labels map[string]types.NullString `json:"labels,omitempty"`
+}
@@ -1,16 +1,34 @@
 package ccv3
 
 import (
+	"bytes"
+	"encoding/json"
 	"net/url"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
 
 // Organization represents a Cloud Controller V3 Organization.
 type Organization struct {
-	Name string `json:"name"`
-	GUID string `json:"guid"`
+	Name     string    `json:"name"`
+	GUID     string    `json:"guid"`
+	Metadata *Metadata `json:"metadata,omitempty"`
+}
+
+// MarshalJSON converts a Organization into a Cloud Controller Organization. Only the
+// name and metadata can be updated.
+func (org Organization) MarshalJSON() ([]byte, error) {
+	var ccOrganization struct {
+		Name     string    `json:"name,omitempty"`
+		Metadata *Metadata `json:"metadata,omitempty"`
+	}
+
+	ccOrganization.Name = org.Name
+	ccOrganization.Metadata = org.Metadata
+
+	return json.Marshal(ccOrganization)
 }
 
 // GetOrganizations lists organizations with optional filters.
@@ -65,3 +83,28 @@ func (client *Client) GetIsolationSegmentOrganizationsByIsolationSegment(isolati
 
 	return fullOrgsList, warnings, err
 }
+
+// UpdateOrganization updates the organization with the given GUID.
+func (client *Client) UpdateOrganization(org Organization) (Organization, Warnings, error) {
+	bodyBytes, err := json.Marshal(org)
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchOrganizationRequest,
+		Body:        bytes.NewReader(bodyBytes),
+		URIParams:   map[string]string{"organization_guid": org.GUID},
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var updatedOrganization Organization
+	response := cloudcontroller.Response{
+		Result: &updatedOrganization,
+	}
+	err = client.connection.Make(request, &response)
+
+	return updatedOrganization, response.Warnings, err
+}
@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -232,4 +233,86 @@ var _ = Describe("Organizations", func() {
 			})
 		})
 	})
+
+	Describe("UpdateOrganization", func() {
+		Context("when the organization is updated successfully", func() {
+			BeforeEach(func() {
+				response := `{
+					"name": "some-organization-name",
+					"guid": "some-organization-guid",
+					"metadata": {
+						"labels": {
+							"k1": "v1"
+						}
+					}
+				}`
+
+				expectedBody := map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"k1": "v1",
+							"k2": nil,
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/organizations/some-organization-guid"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the updated organization and warnings", func() {
+				org, warnings, err := client.UpdateOrganization(Organization{
+					GUID: "some-organization-guid",
+					Metadata: &Metadata{
+						Labels: map[string]types.NullString{
+							"k1": types.NewNullString("v1"),
+							"k2": {},
+						},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(org).To(Equal(Organization{
+					Name: "some-organization-name",
+					GUID: "some-organization-guid",
+					Metadata: &Metadata{
+						Labels: map[string]types.NullString{
+							"k1": types.NewNullString("v1"),
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Organization not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/organizations/some-organization-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.UpdateOrganization(Organization{GUID: "some-organization-guid"})
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Organization not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
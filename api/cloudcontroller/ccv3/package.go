@@ -33,18 +33,23 @@ const (
 )
 
 type Package struct {
-	GUID          string
-	CreatedAt     string
-	Links         APILinks
-	Relationships Relationships
-	State         PackageState
-	Type          PackageType
-	DockerImage   string
+	GUID           string
+	Checksum       string
+	CreatedAt      string
+	Links          APILinks
+	Relationships  Relationships
+	State          PackageState
+	Type           PackageType
+	DockerImage    string
+	DockerUsername string
+	DockerPassword string
 }
 
 func (p Package) MarshalJSON() ([]byte, error) {
 	type ccPackageData struct {
-		Image string `json:"image,omitempty"`
+		Image    string `json:"image,omitempty"`
+		Username string `json:"username,omitempty"`
+		Password string `json:"password,omitempty"`
 	}
 	var ccPackage struct {
 		GUID          string         `json:"guid,omitempty"`
@@ -63,7 +68,11 @@ func (p Package) MarshalJSON() ([]byte, error) {
 	ccPackage.State = p.State
 	ccPackage.Type = p.Type
 	if p.DockerImage != "" {
-		ccPackage.Data = &ccPackageData{Image: p.DockerImage}
+		ccPackage.Data = &ccPackageData{
+			Image:    p.DockerImage,
+			Username: p.DockerUsername,
+			Password: p.DockerPassword,
+		}
 	}
 
 	return json.Marshal(ccPackage)
@@ -78,7 +87,11 @@ func (p *Package) UnmarshalJSON(data []byte) error {
 		State         PackageState  `json:"state,omitempty"`
 		Type          PackageType   `json:"type,omitempty"`
 		Data          struct {
-			Image string `json:"image"`
+			Image    string `json:"image"`
+			Username string `json:"username"`
+			Checksum struct {
+				Value string `json:"value"`
+			} `json:"checksum"`
 		} `json:"data"`
 	}
 	if err := json.Unmarshal(data, &ccPackage); err != nil {
@@ -86,12 +99,14 @@ func (p *Package) UnmarshalJSON(data []byte) error {
 	}
 
 	p.GUID = ccPackage.GUID
+	p.Checksum = ccPackage.Data.Checksum.Value
 	p.CreatedAt = ccPackage.CreatedAt
 	p.Links = ccPackage.Links
 	p.Relationships = ccPackage.Relationships
 	p.State = ccPackage.State
 	p.Type = ccPackage.Type
 	p.DockerImage = ccPackage.Data.Image
+	p.DockerUsername = ccPackage.Data.Username
 
 	return nil
 }
@@ -140,6 +155,36 @@ func (client *Client) CreatePackage(pkg Package) (Package, Warnings, error) {
 	return responsePackage, response.Warnings, err
 }
 
+// CopyPackage creates a copy of the source package and associates it with
+// the target application.
+func (client *Client) CopyPackage(sourcePackageGUID string, targetAppGUID string) (Package, Warnings, error) {
+	bodyBytes, err := json.Marshal(Package{
+		Relationships: Relationships{
+			ApplicationRelationship: Relationship{GUID: targetAppGUID},
+		},
+	})
+	if err != nil {
+		return Package{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostPackageRequest,
+		Query:       url.Values{"source_guid": {sourcePackageGUID}},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Package{}, nil, err
+	}
+
+	var responsePackage Package
+	response := cloudcontroller.Response{
+		Result: &responsePackage,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responsePackage, response.Warnings, err
+}
+
 // UploadPackage uploads a file to a given package's Upload resource. Note:
 // fileToUpload is read entirely into memory prior to sending data to CC.
 func (client *Client) UploadPackage(pkg Package, fileToUpload string) (Package, Warnings, error) {
@@ -173,6 +218,23 @@ func (client *Client) UploadPackage(pkg Package, fileToUpload string) (Package,
 	return responsePackage, response.Warnings, err
 }
 
+// DeletePackage deletes the package with the given GUID and returns the job
+// URL to poll for completion.
+func (client *Client) DeletePackage(packageGUID string) (string, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeletePackageRequest,
+		URIParams:   internal.Params{"package_guid": packageGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.ResourceLocationURL, response.Warnings, err
+}
+
 // GetPackages returns the list of packages.
 func (client *Client) GetPackages(query url.Values) ([]Package, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -173,6 +173,68 @@ var _ = Describe("Package", func() {
 					Expect(pkg).To(Equal(expectedPackage))
 				})
 			})
+
+			Context("when creating a docker package with credentials", func() {
+				BeforeEach(func() {
+					response := `{
+					"data": {
+						"image": "some-docker-image",
+						"username": "some-username",
+						"password": "***"
+					},
+					"guid": "some-pkg-guid",
+					"type": "docker",
+					"state": "READY"
+				}`
+
+					expectedBody := map[string]interface{}{
+						"type": "docker",
+						"data": map[string]string{
+							"image":    "some-docker-image",
+							"username": "some-username",
+							"password": "some-password",
+						},
+						"relationships": map[string]interface{}{
+							"app": map[string]interface{}{
+								"data": map[string]string{
+									"guid": "some-app-guid",
+								},
+							},
+						},
+					}
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPost, "/v3/packages"),
+							VerifyJSONRepresenting(expectedBody),
+							RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("sends the credentials and returns the created package and warnings", func() {
+					pkg, warnings, err := client.CreatePackage(Package{
+						Type: PackageTypeDocker,
+						Relationships: Relationships{
+							ApplicationRelationship: Relationship{GUID: "some-app-guid"},
+						},
+						DockerImage:    "some-docker-image",
+						DockerUsername: "some-username",
+						DockerPassword: "some-password",
+					})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(warnings).To(ConsistOf("this is a warning"))
+
+					Expect(pkg).To(Equal(Package{
+						GUID:           "some-pkg-guid",
+						Type:           PackageTypeDocker,
+						State:          PackageStateReady,
+						DockerImage:    "some-docker-image",
+						DockerUsername: "some-username",
+					}))
+				})
+			})
+
 			Context("when creating a bits package", func() {
 				BeforeEach(func() {
 					response := `{
@@ -278,6 +340,80 @@ var _ = Describe("Package", func() {
 		})
 	})
 
+	Describe("CopyPackage", func() {
+		var (
+			pkg        Package
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			pkg, warnings, executeErr = client.CopyPackage("source-package-guid", "target-app-guid")
+		})
+
+		Context("when the package is copied successfully", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "copied-package-guid",
+					"type": "bits",
+					"state": "COPYING"
+				}`
+
+				expectedBody := map[string]interface{}{
+					"relationships": map[string]interface{}{
+						"app": map[string]interface{}{
+							"data": map[string]string{
+								"guid": "target-app-guid",
+							},
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/packages", "source_guid=source-package-guid"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the copied package and warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(pkg).To(Equal(Package{
+					GUID:  "copied-package-guid",
+					Type:  PackageTypeBits,
+					State: PackageStateCopying,
+				}))
+			})
+		})
+
+		Context("when cc returns back an error or warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid: command presence",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/packages", "source_guid=source-package-guid"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "The request is semantically invalid: command presence"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("UploadPackage", func() {
 		Context("when the package successfully is created", func() {
 			var tempFile *os.File
@@ -368,6 +504,57 @@ var _ = Describe("Package", func() {
 		})
 	})
 
+	Describe("DeletePackage", func() {
+		Context("when the package is deleted successfully", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/packages/some-package-guid"),
+						RespondWith(http.StatusAccepted, ``,
+							http.Header{
+								"X-Cf-Warnings": {"some-warning"},
+								"Location":      {"/v3/jobs/some-location"},
+							},
+						),
+					),
+				)
+			})
+
+			It("returns the job location and all warnings", func() {
+				jobLocation, warnings, err := client.DeletePackage("some-package-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jobLocation).To(Equal("/v3/jobs/some-location"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		Context("when the package does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Package not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/packages/some-package-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"some-warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.DeletePackage("some-package-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Package not found"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
 	Describe("GetPackages", func() {
 		Context("when cloud controller returns list of packages", func() {
 			BeforeEach(func() {
@@ -390,6 +577,13 @@ var _ = Describe("Package", func() {
 							"type": "bits",
 						  "state": "READY",
 							"created_at": "2017-08-14T21:20:13Z",
+							"data": {
+								"checksum": {
+									"type": "sha256",
+									"value": "some-sha256-checksum"
+								},
+								"error": null
+							},
 							"links": {
 								"upload": {
 									"href": "some-pkg-upload-url-2",
@@ -423,6 +617,7 @@ var _ = Describe("Package", func() {
 					},
 					{
 						GUID:      "some-pkg-guid-2",
+						Checksum:  "some-sha256-checksum",
 						Type:      "bits",
 						State:     PackageStateReady,
 						CreatedAt: "2017-08-14T21:20:13Z",
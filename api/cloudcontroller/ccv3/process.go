@@ -14,6 +14,7 @@ import (
 type Process struct {
 	GUID        string             `json:"guid"`
 	Type        string             `json:"type"`
+	Command     string             `json:"command"`
 	HealthCheck ProcessHealthCheck `json:"health_check"`
 	Instances   types.NullInt      `json:"instances"`
 	MemoryInMB  types.NullUint64   `json:"memory_in_mb"`
@@ -26,7 +27,9 @@ type ProcessHealthCheck struct {
 }
 
 type ProcessHealthCheckData struct {
-	Endpoint string `json:"endpoint"`
+	Endpoint          string        `json:"endpoint"`
+	InvocationTimeout types.NullInt `json:"invocation_timeout"`
+	Timeout           types.NullInt `json:"timeout"`
 }
 
 func (p Process) MarshalJSON() ([]byte, error) {
@@ -34,7 +37,8 @@ func (p Process) MarshalJSON() ([]byte, error) {
 		HealthCheck struct {
 			Type string `json:"type"`
 			Data struct {
-				Endpoint interface{} `json:"endpoint"`
+				Endpoint          interface{} `json:"endpoint"`
+				InvocationTimeout interface{} `json:"invocation_timeout"`
 			} `json:"data"`
 		} `json:"health_check"`
 	}
@@ -43,6 +47,9 @@ func (p Process) MarshalJSON() ([]byte, error) {
 	if p.HealthCheck.Data.Endpoint != "" {
 		ccProcess.HealthCheck.Data.Endpoint = p.HealthCheck.Data.Endpoint
 	}
+	if p.HealthCheck.Data.InvocationTimeout.IsSet {
+		ccProcess.HealthCheck.Data.InvocationTimeout = p.HealthCheck.Data.InvocationTimeout.Value
+	}
 	return json.Marshal(ccProcess)
 }
 
@@ -93,13 +100,45 @@ func (client *Client) GetApplicationProcessByType(appGUID string, processType st
 	return process, response.Warnings, err
 }
 
-// PatchApplicationProcessHealthCheck updates application health check type
-func (client *Client) PatchApplicationProcessHealthCheck(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string) (Warnings, error) {
+// PatchApplicationProcessCommand updates the start command of a process. An
+// unset or empty command resets it to the command detected during staging.
+func (client *Client) PatchApplicationProcessCommand(processGUID string, command types.FilteredString) (Warnings, error) {
+	var ccProcess struct {
+		Command interface{} `json:"command"`
+	}
+	if command.IsSet && command.Value != "" {
+		ccProcess.Command = command.Value
+	}
+
+	body, err := json.Marshal(ccProcess)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchApplicationProcessCommandRequest,
+		Body:        bytes.NewReader(body),
+		URIParams:   internal.Params{"process_guid": processGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// PatchApplicationProcessHealthCheck updates application health check type,
+// endpoint and invocation timeout. An unset invocation timeout resets it to
+// the Cloud Controller default.
+func (client *Client) PatchApplicationProcessHealthCheck(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string, processInvocationTimeout types.NullInt) (Warnings, error) {
 	body, err := json.Marshal(Process{
 		HealthCheck: ProcessHealthCheck{
 			Type: processHealthCheckType,
 			Data: ProcessHealthCheckData{
-				Endpoint: processHealthCheckEndpoint,
+				Endpoint:          processHealthCheckEndpoint,
+				InvocationTimeout: processInvocationTimeout,
 			}}})
 	if err != nil {
 		return nil, err
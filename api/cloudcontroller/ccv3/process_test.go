@@ -50,7 +50,8 @@ var _ = Describe("Process", func() {
                   "type": "http",
                   "data": {
                     "timeout": 60,
-                    "endpoint": "/health"
+                    "endpoint": "/health",
+                    "invocation_timeout": 15
                   }
                 }
 							}
@@ -107,14 +108,23 @@ var _ = Describe("Process", func() {
 						MemoryInMB: types.NullUint64{Value: 64, IsSet: true},
 						HealthCheck: ProcessHealthCheck{
 							Type: "http",
-							Data: ProcessHealthCheckData{Endpoint: "/health"},
+							Data: ProcessHealthCheckData{
+								Endpoint:          "/health",
+								InvocationTimeout: types.NullInt{Value: 15, IsSet: true},
+								Timeout:           types.NullInt{Value: 60, IsSet: true},
+							},
 						},
 					},
 					Process{
-						GUID:        "process-3-guid",
-						Type:        "console",
-						MemoryInMB:  types.NullUint64{Value: 128, IsSet: true},
-						HealthCheck: ProcessHealthCheck{Type: "process"},
+						GUID:       "process-3-guid",
+						Type:       "console",
+						MemoryInMB: types.NullUint64{Value: 128, IsSet: true},
+						HealthCheck: ProcessHealthCheck{
+							Type: "process",
+							Data: ProcessHealthCheckData{
+								Timeout: types.NullInt{Value: 90, IsSet: true},
+							},
+						},
 					},
 				))
 				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
@@ -168,7 +178,8 @@ var _ = Describe("Process", func() {
 						"type": "http",
 						"data": {
 							"timeout": 90,
-							"endpoint": "/health"
+							"endpoint": "/health",
+							"invocation_timeout": 10
 						}
 					}
 				}`
@@ -189,7 +200,11 @@ var _ = Describe("Process", func() {
 					MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
 					HealthCheck: ProcessHealthCheck{
 						Type: "http",
-						Data: ProcessHealthCheckData{Endpoint: "/health"}},
+						Data: ProcessHealthCheckData{
+							Endpoint:          "/health",
+							InvocationTimeout: types.NullInt{Value: 10, IsSet: true},
+							Timeout:           types.NullInt{Value: 90, IsSet: true},
+						}},
 				}))
 			})
 		})
@@ -266,16 +281,97 @@ var _ = Describe("Process", func() {
 		})
 	})
 
+	Describe("PatchApplicationProcessCommand", func() {
+		var (
+			command types.FilteredString
+
+			warnings []string
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			warnings, err = client.PatchApplicationProcessCommand("some-process-guid", command)
+		})
+
+		Context("when the command is set", func() {
+			BeforeEach(func() {
+				command = types.FilteredString{IsSet: true, Value: "some-command"}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						VerifyJSON(`{"command": "some-command"}`),
+						RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("patches this process's command", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the command is reset to the default", func() {
+			BeforeEach(func() {
+				command = types.FilteredString{IsSet: true}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						VerifyJSON(`{"command": null}`),
+						RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("sends a null command", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				command = types.FilteredString{IsSet: true, Value: "some-command"}
+				response := `{
+					"errors": [
+						{
+							"detail": "Process not found",
+							"title": "CF-ResourceNotFound",
+							"code": 10010
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an error and warnings", func() {
+				Expect(err).To(MatchError(ccerror.ProcessNotFoundError{}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("PatchApplicationProcessHealthCheck", func() {
 		var (
-			endpoint string
+			endpoint          string
+			invocationTimeout types.NullInt
 
 			warnings []string
 			err      error
 		)
 
+		BeforeEach(func() {
+			invocationTimeout = types.NullInt{}
+		})
+
 		JustBeforeEach(func() {
-			warnings, err = client.PatchApplicationProcessHealthCheck("some-process-guid", "some-type", endpoint)
+			warnings, err = client.PatchApplicationProcessHealthCheck("some-process-guid", "some-type", endpoint, invocationTimeout)
 		})
 
 		Context("when patching the process succeeds", func() {
@@ -286,7 +382,8 @@ var _ = Describe("Process", func() {
 					"health_check": {
 						"type": "some-type",
 						"data": {
-							"endpoint": "some-endpoint"
+							"endpoint": "some-endpoint",
+							"invocation_timeout": null
 						}
 					}
 				}`
@@ -312,7 +409,36 @@ var _ = Describe("Process", func() {
 					"health_check": {
 						"type": "some-type",
 						"data": {
-							"endpoint": null
+							"endpoint": null,
+							"invocation_timeout": null
+						}
+					}
+				}`
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+							VerifyJSON(expectedBody),
+							RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("patches this process's health check", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("this is a warning"))
+				})
+			})
+
+			Context("and the invocation timeout is set", func() {
+				BeforeEach(func() {
+					endpoint = "some-endpoint"
+					invocationTimeout = types.NullInt{Value: 42, IsSet: true}
+					expectedBody := `{
+					"health_check": {
+						"type": "some-type",
+						"data": {
+							"endpoint": "some-endpoint",
+							"invocation_timeout": 42
 						}
 					}
 				}`
@@ -1,14 +1,37 @@
 package ccv3
 
 const (
+	// DomainGUIDFilter is a query parameter for listing routes by domain GUID.
+	DomainGUIDFilter = "domain_guids"
 	// GUIDFilter is a query paramater for listing objects by GUID.
 	GUIDFilter = "guids"
+	// HostsFilter is a query parameter for listing routes by hostname.
+	HostsFilter = "hosts"
 	// NameFilter is a query paramater for listing objects by name.
 	NameFilter = "names"
 	// AppGUIDFilter is a query paramater for listing objects by app GUID.
 	AppGUIDFilter = "app_guids"
 	// OrganizationGUIDFilter is a query paramater for listing objects by Organization GUID.
 	OrganizationGUIDFilter = "organization_guids"
+	// StatesFilter is a query paramater for listing objects by state.
+	StatesFilter = "states"
+	// PathsFilter is a query parameter for listing routes by path.
+	PathsFilter = "paths"
 	// SpaceGUIDFilter is a query paramater for listing objects by Space GUID.
 	SpaceGUIDFilter = "space_guids"
+	// TargetGUIDFilter is a query parameter for listing audit events by the
+	// GUID of their target.
+	TargetGUIDFilter = "target_guids"
+	// TypesFilter is a query parameter for listing objects by type.
+	TypesFilter = "types"
+	// VersionsFilter is a query parameter for listing revisions by version.
+	VersionsFilter = "versions"
+	// LabelSelectorFilter is a query parameter for listing objects whose labels
+	// match the given selector.
+	LabelSelectorFilter = "label_selector"
+
+	// OrderBy is a query parameter for sorting the listed objects.
+	OrderBy = "order_by"
+	// CreatedAtDescendingOrder sorts the listed objects newest first.
+	CreatedAtDescendingOrder = "-created_at"
 )
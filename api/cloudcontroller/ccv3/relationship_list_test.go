@@ -92,4 +92,69 @@ var _ = Describe("RelationshipList", func() {
 			})
 		})
 	})
+
+	Describe("ShareServiceInstanceToSpaces", func() {
+		Context("when the share is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"data": [
+						{
+							"guid": "some-space-guid"
+						},
+						{
+							"guid": "some-other-space-guid"
+						}
+					]
+				}`
+
+				requestBody := map[string][]map[string]string{
+					"data": {{"guid": "some-space-guid"}, {"guid": "some-other-space-guid"}},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_instances/some-service-instance-guid/relationships/shared_spaces"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns all relationships and warnings", func() {
+				relationships, warnings, err := client.ShareServiceInstanceToSpaces("some-service-instance-guid", []string{"some-space-guid", "some-other-space-guid"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(relationships).To(Equal(RelationshipList{
+					GUIDs: []string{"some-space-guid", "some-other-space-guid"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Service instances cannot be shared into the space where they were created.",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_instances/some-service-instance-guid/relationships/shared_spaces"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.ShareServiceInstanceToSpaces("some-service-instance-guid", []string{"some-space-guid"})
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "Service instances cannot be shared into the space where they were created.",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
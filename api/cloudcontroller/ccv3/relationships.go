@@ -4,6 +4,7 @@ type RelationshipType string
 
 const (
 	ApplicationRelationship RelationshipType = "app"
+	DomainRelationship      RelationshipType = "domain"
 	SpaceRelationship       RelationshipType = "space"
 )
 
@@ -0,0 +1,87 @@
+package ccv3
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Revision is a snapshot of an application's droplet and configuration that
+// the application can be deployed with again.
+type Revision struct {
+	GUID        string
+	Version     int
+	Description string
+	DropletGUID string
+	// Deployable is false when the revision's droplet no longer exists.
+	Deployable bool
+	CreatedAt  string
+}
+
+func (r *Revision) UnmarshalJSON(data []byte) error {
+	var ccRevision struct {
+		GUID        string `json:"guid"`
+		Version     int    `json:"version"`
+		Description string `json:"description"`
+		Droplet     struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+		Deployable *bool  `json:"deployable"`
+		CreatedAt  string `json:"created_at"`
+	}
+
+	if err := json.Unmarshal(data, &ccRevision); err != nil {
+		return err
+	}
+
+	r.GUID = ccRevision.GUID
+	r.Version = ccRevision.Version
+	r.Description = ccRevision.Description
+	r.DropletGUID = ccRevision.Droplet.GUID
+	// Older APIs do not report whether a revision is deployable, in which
+	// case every revision is.
+	r.Deployable = ccRevision.Deployable == nil || *ccRevision.Deployable
+	r.CreatedAt = ccRevision.CreatedAt
+
+	return nil
+}
+
+// GetApplicationRevisions lists the revisions of the application with
+// optional filters.
+func (client *Client) GetApplicationRevisions(appGUID string, query url.Values) ([]Revision, Warnings, error) {
+	return client.getRevisions(internal.GetAppRevisionsRequest, appGUID, query)
+}
+
+// GetApplicationDeployedRevisions lists the revisions that the application's
+// running instances were deployed with.
+func (client *Client) GetApplicationDeployedRevisions(appGUID string) ([]Revision, Warnings, error) {
+	return client.getRevisions(internal.GetAppRevisionsDeployedRequest, appGUID, nil)
+}
+
+func (client *Client) getRevisions(requestName string, appGUID string, query url.Values) ([]Revision, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   internal.Params{"app_guid": appGUID},
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullRevisionsList []Revision
+	warnings, err := client.paginate(request, Revision{}, func(item interface{}) error {
+		if revision, ok := item.(Revision); ok {
+			fullRevisionsList = append(fullRevisionsList, revision)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Revision{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullRevisionsList, warnings, err
+}
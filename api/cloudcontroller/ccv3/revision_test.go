@@ -0,0 +1,184 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Revision", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationRevisions", func() {
+		Context("when the application has revisions", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+					"pagination": {
+						"next": {
+							"href": "%s/v3/apps/some-app-guid/revisions?order_by=-created_at&page=2"
+						}
+					},
+					"resources": [
+						{
+							"guid": "some-revision-guid-2",
+							"version": 2,
+							"description": "New droplet deployed.",
+							"droplet": {
+								"guid": "some-droplet-guid-2"
+							},
+							"deployable": true,
+							"created_at": "2018-10-02T00:00:00Z"
+						}
+					]
+				}`, server.URL())
+				response2 := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "some-revision-guid-1",
+							"version": 1,
+							"description": "Initial revision.",
+							"droplet": {
+								"guid": "some-droplet-guid-1"
+							},
+							"deployable": false,
+							"created_at": "2018-10-01T00:00:00Z"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions", "order_by=-created_at"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions", "order_by=-created_at&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the revisions and all warnings", func() {
+				revisions, warnings, err := client.GetApplicationRevisions("some-app-guid", url.Values{OrderBy: []string{CreatedAtDescendingOrder}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(revisions).To(Equal([]Revision{
+					{
+						GUID:        "some-revision-guid-2",
+						Version:     2,
+						Description: "New droplet deployed.",
+						DropletGUID: "some-droplet-guid-2",
+						Deployable:  true,
+						CreatedAt:   "2018-10-02T00:00:00Z",
+					},
+					{
+						GUID:        "some-revision-guid-1",
+						Version:     1,
+						Description: "Initial revision.",
+						DropletGUID: "some-droplet-guid-1",
+						Deployable:  false,
+						CreatedAt:   "2018-10-01T00:00:00Z",
+					},
+				}))
+			})
+		})
+
+		Context("when the API does not report whether revisions are deployable", func() {
+			BeforeEach(func() {
+				response := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "some-revision-guid",
+							"version": 1
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions"),
+						RespondWith(http.StatusOK, response),
+					),
+				)
+			})
+
+			It("treats the revisions as deployable", func() {
+				revisions, _, err := client.GetApplicationRevisions("some-app-guid", nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(revisions).To(ConsistOf(Revision{GUID: "some-revision-guid", Version: 1, Deployable: true}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetApplicationRevisions("some-app-guid", nil)
+				Expect(err).To(MatchError(ccerror.ApplicationNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetApplicationDeployedRevisions", func() {
+		BeforeEach(func() {
+			response := `{
+				"pagination": {
+					"next": null
+				},
+				"resources": [
+					{
+						"guid": "some-revision-guid",
+						"version": 3,
+						"deployable": true
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions/deployed"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("returns the deployed revisions and all warnings", func() {
+			revisions, warnings, err := client.GetApplicationDeployedRevisions("some-app-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(revisions).To(ConsistOf(Revision{GUID: "some-revision-guid", Version: 3, Deployable: true}))
+		})
+	})
+})
@@ -0,0 +1,244 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Route represents a Cloud Controller V3 Route.
+type Route struct {
+	GUID       string
+	Host       string
+	Path       string
+	SpaceGUID  string
+	DomainGUID string
+}
+
+// MarshalJSON converts a Route into a Cloud Controller Route.
+func (r Route) MarshalJSON() ([]byte, error) {
+	var ccRoute struct {
+		Host          string        `json:"host,omitempty"`
+		Path          string        `json:"path,omitempty"`
+		Relationships Relationships `json:"relationships"`
+	}
+
+	ccRoute.Host = r.Host
+	ccRoute.Path = r.Path
+	ccRoute.Relationships = Relationships{
+		SpaceRelationship:  Relationship{GUID: r.SpaceGUID},
+		DomainRelationship: Relationship{GUID: r.DomainGUID},
+	}
+
+	return json.Marshal(ccRoute)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Route response.
+func (r *Route) UnmarshalJSON(data []byte) error {
+	var ccRoute struct {
+		GUID          string        `json:"guid"`
+		Host          string        `json:"host"`
+		Path          string        `json:"path"`
+		Relationships Relationships `json:"relationships"`
+	}
+
+	if err := json.Unmarshal(data, &ccRoute); err != nil {
+		return err
+	}
+
+	r.GUID = ccRoute.GUID
+	r.Host = ccRoute.Host
+	r.Path = ccRoute.Path
+	r.SpaceGUID = ccRoute.Relationships[SpaceRelationship].GUID
+	r.DomainGUID = ccRoute.Relationships[DomainRelationship].GUID
+
+	return nil
+}
+
+// RouteDestination is an application process that receives a share of the
+// traffic sent to a route.
+type RouteDestination struct {
+	GUID        string
+	AppGUID     string
+	ProcessType string
+	// Weight is the share of the route's traffic sent to the destination,
+	// relative to the weights of the route's other destinations. Unset means
+	// traffic is shared equally.
+	Weight types.NullInt
+}
+
+// MarshalJSON converts a RouteDestination into a Cloud Controller
+// destination.
+func (d RouteDestination) MarshalJSON() ([]byte, error) {
+	type process struct {
+		Type string `json:"type"`
+	}
+	type app struct {
+		GUID    string   `json:"guid"`
+		Process *process `json:"process,omitempty"`
+	}
+
+	var ccDestination struct {
+		App    app  `json:"app"`
+		Weight *int `json:"weight,omitempty"`
+	}
+
+	ccDestination.App.GUID = d.AppGUID
+	if d.ProcessType != "" {
+		ccDestination.App.Process = &process{Type: d.ProcessType}
+	}
+	if d.Weight.IsSet {
+		ccDestination.Weight = &d.Weight.Value
+	}
+
+	return json.Marshal(ccDestination)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller destination.
+func (d *RouteDestination) UnmarshalJSON(data []byte) error {
+	var ccDestination struct {
+		GUID string `json:"guid"`
+		App  struct {
+			GUID    string `json:"guid"`
+			Process struct {
+				Type string `json:"type"`
+			} `json:"process"`
+		} `json:"app"`
+		Weight *int `json:"weight"`
+	}
+
+	if err := json.Unmarshal(data, &ccDestination); err != nil {
+		return err
+	}
+
+	d.GUID = ccDestination.GUID
+	d.AppGUID = ccDestination.App.GUID
+	d.ProcessType = ccDestination.App.Process.Type
+	if ccDestination.Weight != nil {
+		d.Weight = types.NullInt{Value: *ccDestination.Weight, IsSet: true}
+	}
+
+	return nil
+}
+
+// CreateRoute creates the route in the route's space and domain.
+func (client *Client) CreateRoute(route Route) (Route, Warnings, error) {
+	bodyBytes, err := json.Marshal(route)
+	if err != nil {
+		return Route{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostRouteRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Route{}, nil, err
+	}
+
+	var createdRoute Route
+	response := cloudcontroller.Response{
+		Result: &createdRoute,
+	}
+	err = client.connection.Make(request, &response)
+
+	return createdRoute, response.Warnings, err
+}
+
+// GetRoutes lists routes with optional filters.
+func (client *Client) GetRoutes(query url.Values) ([]Route, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetRoutesRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullRoutesList []Route
+	warnings, err := client.paginate(request, Route{}, func(item interface{}) error {
+		if route, ok := item.(Route); ok {
+			fullRoutesList = append(fullRoutesList, route)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Route{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullRoutesList, warnings, err
+}
+
+// GetRouteDestinations returns the destinations of the route.
+func (client *Client) GetRouteDestinations(routeGUID string) ([]RouteDestination, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetRouteDestinationsRequest,
+		URIParams:   internal.Params{"route_guid": routeGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var destinations struct {
+		Destinations []RouteDestination `json:"destinations"`
+	}
+	response := cloudcontroller.Response{
+		Result: &destinations,
+	}
+	err = client.connection.Make(request, &response)
+
+	return destinations.Destinations, response.Warnings, err
+}
+
+// MapRouteDestination adds the destination to the route, keeping the route's
+// existing destinations.
+func (client *Client) MapRouteDestination(routeGUID string, destination RouteDestination) (Warnings, error) {
+	bodyBytes, err := json.Marshal(struct {
+		Destinations []RouteDestination `json:"destinations"`
+	}{
+		Destinations: []RouteDestination{destination},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostRouteDestinationsRequest,
+		URIParams:   internal.Params{"route_guid": routeGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
+
+// UnmapRouteDestination removes the destination from the route.
+func (client *Client) UnmapRouteDestination(routeGUID string, destinationGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteRouteDestinationRequest,
+		URIParams: internal.Params{
+			"route_guid":       routeGUID,
+			"destination_guid": destinationGUID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
@@ -0,0 +1,302 @@
+package ccv3_test
+
+import (
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Route", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateRoute", func() {
+		Context("when the route is created", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-route-guid",
+					"host": "some-host",
+					"path": "/some-path",
+					"relationships": {
+						"space": {
+							"data": { "guid": "some-space-guid" }
+						},
+						"domain": {
+							"data": { "guid": "some-domain-guid" }
+						}
+					}
+				}`
+
+				expectedBody := map[string]interface{}{
+					"host": "some-host",
+					"path": "/some-path",
+					"relationships": map[string]interface{}{
+						"space": map[string]interface{}{
+							"data": map[string]string{"guid": "some-space-guid"},
+						},
+						"domain": map[string]interface{}{
+							"data": map[string]string{"guid": "some-domain-guid"},
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/routes"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the route and all warnings", func() {
+				route, warnings, err := client.CreateRoute(Route{
+					Host:       "some-host",
+					Path:       "/some-path",
+					SpaceGUID:  "some-space-guid",
+					DomainGUID: "some-domain-guid",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(route).To(Equal(Route{
+					GUID:       "some-route-guid",
+					Host:       "some-host",
+					Path:       "/some-path",
+					SpaceGUID:  "some-space-guid",
+					DomainGUID: "some-domain-guid",
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Route already exists",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/routes"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.CreateRoute(Route{SpaceGUID: "some-space-guid", DomainGUID: "some-domain-guid"})
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "Route already exists"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetRoutes", func() {
+		BeforeEach(func() {
+			response := `{
+				"pagination": {
+					"next": null
+				},
+				"resources": [
+					{
+						"guid": "some-route-guid",
+						"host": "some-host",
+						"path": "",
+						"relationships": {
+							"space": {
+								"data": { "guid": "some-space-guid" }
+							},
+							"domain": {
+								"data": { "guid": "some-domain-guid" }
+							}
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/routes", "domain_guids=some-domain-guid&hosts=some-host"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the queried routes and all warnings", func() {
+			routes, warnings, err := client.GetRoutes(url.Values{
+				DomainGUIDFilter: []string{"some-domain-guid"},
+				HostsFilter:      []string{"some-host"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(routes).To(ConsistOf(Route{
+				GUID:       "some-route-guid",
+				Host:       "some-host",
+				SpaceGUID:  "some-space-guid",
+				DomainGUID: "some-domain-guid",
+			}))
+		})
+	})
+
+	Describe("GetRouteDestinations", func() {
+		BeforeEach(func() {
+			response := `{
+				"destinations": [
+					{
+						"guid": "destination-guid-1",
+						"app": {
+							"guid": "app-guid-1",
+							"process": { "type": "web" }
+						},
+						"weight": 80
+					},
+					{
+						"guid": "destination-guid-2",
+						"app": {
+							"guid": "app-guid-2",
+							"process": { "type": "worker" }
+						},
+						"weight": null
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/routes/some-route-guid/destinations"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the destinations and all warnings", func() {
+			destinations, warnings, err := client.GetRouteDestinations("some-route-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(destinations).To(Equal([]RouteDestination{
+				{GUID: "destination-guid-1", AppGUID: "app-guid-1", ProcessType: "web", Weight: types.NullInt{Value: 80, IsSet: true}},
+				{GUID: "destination-guid-2", AppGUID: "app-guid-2", ProcessType: "worker"},
+			}))
+		})
+	})
+
+	Describe("MapRouteDestination", func() {
+		Context("when a weight is given", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"destinations": []map[string]interface{}{
+						{
+							"app": map[string]interface{}{
+								"guid":    "some-app-guid",
+								"process": map[string]string{"type": "web"},
+							},
+							"weight": 20,
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/routes/some-route-guid/destinations"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusOK, `{"destinations": []}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("adds the destination and returns all warnings", func() {
+				warnings, err := client.MapRouteDestination("some-route-guid", RouteDestination{
+					AppGUID:     "some-app-guid",
+					ProcessType: "web",
+					Weight:      types.NullInt{Value: 20, IsSet: true},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when no weight is given", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"destinations": []map[string]interface{}{
+						{
+							"app": map[string]interface{}{
+								"guid":    "some-app-guid",
+								"process": map[string]string{"type": "worker"},
+							},
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/routes/some-route-guid/destinations"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusOK, `{"destinations": []}`),
+					),
+				)
+			})
+
+			It("omits the weight", func() {
+				_, err := client.MapRouteDestination("some-route-guid", RouteDestination{
+					AppGUID:     "some-app-guid",
+					ProcessType: "worker",
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("UnmapRouteDestination", func() {
+		Context("when the destination is removed", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/routes/some-route-guid/destinations/some-destination-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns all warnings", func() {
+				warnings, err := client.UnmapRouteDestination("some-route-guid", "some-destination-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the destination does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Destination not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/routes/some-route-guid/destinations/some-destination-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.UnmapRouteDestination("some-route-guid", "some-destination-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Destination not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
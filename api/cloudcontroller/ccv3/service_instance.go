@@ -0,0 +1,40 @@
+package ccv3
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// ServiceInstance represents a Cloud Controller V3 Service Instance.
+type ServiceInstance struct {
+	Name string `json:"name"`
+	GUID string `json:"guid"`
+}
+
+// GetServiceInstances lists service instances with optional filters.
+func (client *Client) GetServiceInstances(query url.Values) ([]ServiceInstance, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceInstancesRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServiceInstanceList []ServiceInstance
+	warnings, err := client.paginate(request, ServiceInstance{}, func(item interface{}) error {
+		if serviceInstance, ok := item.(ServiceInstance); ok {
+			fullServiceInstanceList = append(fullServiceInstanceList, serviceInstance)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServiceInstance{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServiceInstanceList, warnings, err
+}
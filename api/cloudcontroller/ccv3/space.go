@@ -0,0 +1,83 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Space represents a Cloud Controller V3 Space.
+type Space struct {
+	Name     string    `json:"name"`
+	GUID     string    `json:"guid"`
+	Metadata *Metadata `json:"metadata,omitempty"`
+}
+
+// MarshalJSON converts a Space into a Cloud Controller Space. Only the
+// name and metadata can be updated.
+func (space Space) MarshalJSON() ([]byte, error) {
+	var ccSpace struct {
+		Name     string    `json:"name,omitempty"`
+		Metadata *Metadata `json:"metadata,omitempty"`
+	}
+
+	ccSpace.Name = space.Name
+	ccSpace.Metadata = space.Metadata
+
+	return json.Marshal(ccSpace)
+}
+
+// GetSpaces lists spaces with optional filters.
+func (client *Client) GetSpaces(query url.Values) ([]Space, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpacesRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullSpacesList []Space
+	warnings, err := client.paginate(request, Space{}, func(item interface{}) error {
+		if space, ok := item.(Space); ok {
+			fullSpacesList = append(fullSpacesList, space)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Space{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullSpacesList, warnings, err
+}
+
+// UpdateSpace updates the space with the given GUID.
+func (client *Client) UpdateSpace(space Space) (Space, Warnings, error) {
+	bodyBytes, err := json.Marshal(space)
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchSpaceRequest,
+		Body:        bytes.NewReader(bodyBytes),
+		URIParams:   map[string]string{"space_guid": space.GUID},
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	var updatedSpace Space
+	response := cloudcontroller.Response{
+		Result: &updatedSpace,
+	}
+	err = client.connection.Make(request, &response)
+
+	return updatedSpace, response.Warnings, err
+}
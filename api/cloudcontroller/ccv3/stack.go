@@ -0,0 +1,44 @@
+package ccv3
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Stack represents a Cloud Controller V3 Stack.
+type Stack struct {
+	// GUID is a unique stack identifier.
+	GUID string `json:"guid"`
+	// Name is the name of the stack.
+	Name string `json:"name"`
+	// Description describes the stack.
+	Description string `json:"description"`
+}
+
+// GetStacks lists stacks with optional filters.
+func (client *Client) GetStacks(query url.Values) ([]Stack, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetStacksRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullStacksList []Stack
+	warnings, err := client.paginate(request, Stack{}, func(item interface{}) error {
+		if stack, ok := item.(Stack); ok {
+			fullStacksList = append(fullStacksList, stack)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Stack{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullStacksList, warnings, err
+}
@@ -0,0 +1,138 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Stacks", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetStacks", func() {
+		var (
+			query url.Values
+
+			stacks     []Stack
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			stacks, warnings, executeErr = client.GetStacks(query)
+		})
+
+		Context("when stacks exist", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+					"pagination": {
+						"next": {
+							"href": "%s/v3/stacks?names=cflinuxfs2,cflinuxfs3&page=2"
+						}
+					},
+					"resources": [
+						{
+							"guid": "stack-guid-1",
+							"name": "cflinuxfs2",
+							"description": "Cloud Foundry Linux-based filesystem (Ubuntu 14.04)"
+						}
+					]
+				}`, server.URL())
+				response2 := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "stack-guid-2",
+							"name": "cflinuxfs3",
+							"description": "Cloud Foundry Linux-based filesystem (Ubuntu 18.04)"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/stacks", "names=cflinuxfs2,cflinuxfs3"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/stacks", "names=cflinuxfs2,cflinuxfs3&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+
+				query = url.Values{NameFilter: []string{"cflinuxfs2,cflinuxfs3"}}
+			})
+
+			It("returns the queried stacks and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(stacks).To(ConsistOf(
+					Stack{GUID: "stack-guid-1", Name: "cflinuxfs2", Description: "Cloud Foundry Linux-based filesystem (Ubuntu 14.04)"},
+					Stack{GUID: "stack-guid-2", Name: "cflinuxfs3", Description: "Cloud Foundry Linux-based filesystem (Ubuntu 18.04)"},
+				))
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid: command presence",
+							"title": "CF-UnprocessableEntity"
+						},
+						{
+							"code": 10010,
+							"detail": "Stack not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/stacks"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				query = nil
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "The request is semantically invalid: command presence",
+								Title:  "CF-UnprocessableEntity",
+							},
+							{
+								Code:   10010,
+								Detail: "Stack not found",
+								Title:  "CF-ResourceNotFound",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -10,10 +10,19 @@ import (
 // TargetSettings represents configuration for establishing a connection to the
 // Cloud Controller server.
 type TargetSettings struct {
+	// CACertFile is the path to a PEM-encoded bundle of CA certificates that
+	// are trusted, along with the system trust store, when verifying the
+	// server's certificate chain.
+	CACertFile string
+
 	// DialTimeout is the DNS timeout used to make all requests to the Cloud
 	// Controller.
 	DialTimeout time.Duration
 
+	// MaxIdleConnsPerHost is the number of idle keep-alive connections kept
+	// per host.
+	MaxIdleConnsPerHost int
+
 	// SkipSSLValidation controls whether a client verifies the server's
 	// certificate chain and host name. If SkipSSLValidation is true, TLS accepts
 	// any certificate presented by the server and any host name in that
@@ -23,6 +32,9 @@ type TargetSettings struct {
 	// be used only for testing.
 	SkipSSLValidation bool
 
+	// TLSHandshakeTimeout is the timeout for the TLS handshake.
+	TLSHandshakeTimeout time.Duration
+
 	// URL is a fully qualified URL to the Cloud Controller API.
 	URL string
 }
@@ -33,8 +45,11 @@ func (client *Client) TargetCF(settings TargetSettings) (Warnings, error) {
 	client.cloudControllerURL = settings.URL
 
 	client.connection = cloudcontroller.NewConnection(cloudcontroller.Config{
-		DialTimeout:       settings.DialTimeout,
-		SkipSSLValidation: settings.SkipSSLValidation,
+		CACertFile:          settings.CACertFile,
+		DialTimeout:         settings.DialTimeout,
+		MaxIdleConnsPerHost: settings.MaxIdleConnsPerHost,
+		SkipSSLValidation:   settings.SkipSSLValidation,
+		TLSHandshakeTimeout: settings.TLSHandshakeTimeout,
 	})
 
 	for _, wrapper := range client.wrappers {
@@ -2,17 +2,16 @@ package cloudcontroller
 
 import (
 	"bytes"
-	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"io/ioutil"
-	"net"
 	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/transport"
 )
 
 // CloudControllerConnection represents a connection to the Cloud Controller
@@ -20,26 +19,32 @@ import (
 type CloudControllerConnection struct {
 	HTTPClient *http.Client
 	UserAgent  string
+
+	transportErr error
 }
 
 // Config is for configuring a CloudControllerConnection.
 type Config struct {
-	DialTimeout       time.Duration
-	SkipSSLValidation bool
+	CACertFile          string
+	DialTimeout         time.Duration
+	MaxIdleConnsPerHost int
+	SkipSSLValidation   bool
+	TLSHandshakeTimeout time.Duration
 }
 
 // NewConnection returns a new CloudControllerConnection with provided
 // configuration.
 func NewConnection(config Config) *CloudControllerConnection {
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: config.SkipSSLValidation,
-		},
-		Proxy: http.ProxyFromEnvironment,
-		DialContext: (&net.Dialer{
-			KeepAlive: 30 * time.Second,
-			Timeout:   config.DialTimeout,
-		}).DialContext,
+	tr, err := transport.Shared(transport.Config{
+		CACertFile:          config.CACertFile,
+		DialTimeout:         config.DialTimeout,
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost,
+		SkipSSLValidation:   config.SkipSSLValidation,
+		TLSHandshakeTimeout: config.TLSHandshakeTimeout,
+	})
+
+	if err != nil {
+		return &CloudControllerConnection{transportErr: err}
 	}
 
 	return &CloudControllerConnection{
@@ -54,6 +59,10 @@ func (connection *CloudControllerConnection) Make(request *Request, passedRespon
 	// error and we don't repopulate it in populateResponse.
 	passedResponse.reset()
 
+	if connection.transportErr != nil {
+		return connection.transportErr
+	}
+
 	response, err := connection.HTTPClient.Do(request.Request)
 	if err != nil {
 		return connection.processRequestErrors(request.Request, err)
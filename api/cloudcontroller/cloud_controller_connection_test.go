@@ -2,12 +2,16 @@ package cloudcontroller_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
 	"runtime"
 	"strings"
+	"time"
 
 	. "code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/transport"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -26,6 +30,44 @@ var _ = Describe("Cloud Controller Connection", func() {
 		connection = NewConnection(Config{SkipSSLValidation: true})
 	})
 
+	Describe("NewConnection", func() {
+		It("reuses the transport of connections with the same configuration", func() {
+			otherConnection := NewConnection(Config{SkipSSLValidation: true})
+			Expect(otherConnection.HTTPClient.Transport).To(BeIdenticalTo(connection.HTTPClient.Transport))
+		})
+
+		It("applies the connection pool settings to the transport", func() {
+			connection = NewConnection(Config{
+				MaxIdleConnsPerHost: 25,
+				TLSHandshakeTimeout: 3 * time.Second,
+			})
+
+			transport, ok := connection.HTTPClient.Transport.(*http.Transport)
+			Expect(ok).To(BeTrue())
+			Expect(transport.MaxIdleConnsPerHost).To(Equal(25))
+			Expect(transport.TLSHandshakeTimeout).To(Equal(3 * time.Second))
+			Expect(transport.TLSClientConfig.InsecureSkipVerify).To(BeFalse())
+		})
+
+		It("returns an InvalidCACertificateError from requests when the CA certificate file is invalid", func() {
+			caCertFile, err := ioutil.TempFile("", "cc-connection-ca")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.Remove(caCertFile.Name())
+			Expect(caCertFile.Close()).To(Succeed())
+
+			connection = NewConnection(Config{CACertFile: caCertFile.Name()})
+			Expect(connection.HTTPClient).To(BeNil())
+
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			var response Response
+			err = connection.Make(&Request{Request: req}, &response)
+			Expect(err).To(MatchError(transport.InvalidCACertificateError{Path: caCertFile.Name()}))
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
 	Describe("Make", func() {
 		Describe("Data Unmarshalling", func() {
 			var request *Request
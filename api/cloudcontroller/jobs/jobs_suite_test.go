@@ -1,4 +1,4 @@
-package noaabridge_test
+package jobs_test
 
 import (
 	. "github.com/onsi/ginkgo"
@@ -7,7 +7,7 @@ import (
 	"testing"
 )
 
-func TestNOAABridge(t *testing.T) {
+func TestJobs(t *testing.T) {
 	RegisterFailHandler(Fail)
-	RunSpecs(t, "NOAA Bridge Suite")
+	RunSpecs(t, "Jobs Suite")
 }
@@ -0,0 +1,157 @@
+// Package jobs polls asynchronous Cloud Controller jobs until they terminate.
+//
+// The V2 and V3 APIs represent jobs differently; each client converts its jobs
+// into a State through a JobGetter so that polling, timeouts and failures
+// behave the same regardless of the API version.
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+)
+
+// State is the API version agnostic state of a job.
+type State struct {
+	// GUID is the GUID of the job.
+	GUID string
+
+	// Complete is true when the job has finished successfully.
+	Complete bool
+
+	// Failed is true when the job is no longer running due to a failure.
+	Failed bool
+
+	// FailureMessage describes why the job failed.
+	FailureMessage string
+}
+
+//go:generate counterfeiter . JobGetter
+
+// JobGetter retrieves the current state of a job.
+type JobGetter interface {
+	GetJobState(jobURL string) (State, []string, error)
+}
+
+// JobGetterFunc is an adapter to allow the use of ordinary functions as
+// JobGetters.
+type JobGetterFunc func(jobURL string) (State, []string, error)
+
+// GetJobState calls getter(jobURL).
+func (getter JobGetterFunc) GetJobState(jobURL string) (State, []string, error) {
+	return getter(jobURL)
+}
+
+//go:generate counterfeiter . Canceller
+
+// Canceller provides the context jobs are polled with and controls when that
+// context may be cancelled.
+type Canceller interface {
+	// Context returns the context jobs are polled with.
+	Context() context.Context
+
+	// Catch is called before sleeping between polls to allow the context to
+	// be cancelled; the returned function is called once the sleep is over.
+	Catch() func()
+}
+
+// Poller polls jobs retrieved by a JobGetter.
+type Poller struct {
+	getter    JobGetter
+	canceller Canceller
+}
+
+// NewPoller returns a new Poller. Polling stops once the canceller's context
+// is cancelled; a nil canceller polls with a context that is never cancelled.
+func NewPoller(getter JobGetter, canceller Canceller) *Poller {
+	if canceller == nil {
+		canceller = backgroundCanceller{}
+	}
+
+	return &Poller{
+		getter:    getter,
+		canceller: canceller,
+	}
+}
+
+// PollJob will keep polling the job identified by jobURL every interval until
+// the job has terminated, an error is encountered, the canceller's context is
+// cancelled or the timeout is reached. A failed job returns a JobFailedError,
+// reaching the timeout returns a JobTimeoutError and cancelling the context
+// returns a RequestCancelledError. Warnings from every poll are returned.
+//
+// Errors returned by the JobGetter are returned unchanged, so a JobGetter may
+// report failures that are not represented by a State.
+func (poller Poller) PollJob(jobURL string, interval time.Duration, timeout time.Duration) ([]string, error) {
+	var (
+		allWarnings []string
+		state       State
+	)
+
+	ctx := poller.canceller.Context()
+
+	startTime := time.Now()
+	for time.Now().Sub(startTime) < timeout {
+		if ctx.Err() != nil {
+			return allWarnings, ccerror.RequestCancelledError{}
+		}
+
+		var (
+			warnings []string
+			err      error
+		)
+		state, warnings, err = poller.getter.GetJobState(jobURL)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		if state.Failed {
+			return allWarnings, ccerror.JobFailedError{
+				JobGUID: state.GUID,
+				Message: state.FailureMessage,
+			}
+		}
+
+		if state.Complete {
+			return allWarnings, nil
+		}
+
+		if !poller.sleep(ctx, interval) {
+			return allWarnings, ccerror.RequestCancelledError{}
+		}
+	}
+
+	return allWarnings, ccerror.JobTimeoutError{
+		JobGUID: state.GUID,
+		Timeout: timeout,
+	}
+}
+
+// sleep waits for interval while catching cancellation. It returns false if
+// the context is cancelled before the interval is over.
+func (poller Poller) sleep(ctx context.Context, interval time.Duration) bool {
+	release := poller.canceller.Catch()
+	defer release()
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+type backgroundCanceller struct{}
+
+func (backgroundCanceller) Context() context.Context {
+	return context.Background()
+}
+
+func (backgroundCanceller) Catch() func() {
+	return func() {}
+}
@@ -0,0 +1,158 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/jobs"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/jobs/jobsfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Poller", func() {
+	var (
+		fakeJobGetter *jobsfakes.FakeJobGetter
+		fakeCanceller *jobsfakes.FakeCanceller
+		poller        *Poller
+
+		ctx      context.Context
+		released int
+		interval time.Duration
+		timeout  time.Duration
+
+		warnings   []string
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeJobGetter = new(jobsfakes.FakeJobGetter)
+		fakeCanceller = new(jobsfakes.FakeCanceller)
+		poller = NewPoller(fakeJobGetter, fakeCanceller)
+
+		ctx = context.Background()
+		fakeCanceller.ContextStub = func() context.Context {
+			return ctx
+		}
+		released = 0
+		fakeCanceller.CatchReturns(func() { released++ })
+		interval = time.Millisecond
+		timeout = time.Minute
+	})
+
+	JustBeforeEach(func() {
+		warnings, executeErr = poller.PollJob("some-job-url", interval, timeout)
+	})
+
+	Context("when the job completes", func() {
+		BeforeEach(func() {
+			fakeJobGetter.GetJobStateReturnsOnCall(0, State{GUID: "some-job-guid"}, []string{"warning-1"}, nil)
+			fakeJobGetter.GetJobStateReturnsOnCall(1, State{GUID: "some-job-guid", Complete: true}, []string{"warning-2"}, nil)
+		})
+
+		It("polls until the job is complete and returns all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+			Expect(fakeJobGetter.GetJobStateCallCount()).To(Equal(2))
+			Expect(fakeJobGetter.GetJobStateArgsForCall(0)).To(Equal("some-job-url"))
+			Expect(fakeJobGetter.GetJobStateArgsForCall(1)).To(Equal("some-job-url"))
+		})
+
+		It("catches cancellation only while sleeping between polls", func() {
+			Expect(fakeCanceller.CatchCallCount()).To(Equal(1))
+			Expect(released).To(Equal(1))
+		})
+	})
+
+	Context("when the job fails", func() {
+		BeforeEach(func() {
+			fakeJobGetter.GetJobStateReturns(State{GUID: "some-job-guid", Failed: true, FailureMessage: "some-failure"}, []string{"warning-1"}, nil)
+		})
+
+		It("returns a JobFailedError and all warnings", func() {
+			Expect(executeErr).To(MatchError(ccerror.JobFailedError{
+				JobGUID: "some-job-guid",
+				Message: "some-failure",
+			}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(fakeJobGetter.GetJobStateCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when getting the job fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeJobGetter.GetJobStateReturns(State{}, []string{"warning-1"}, expectedErr)
+		})
+
+		It("returns the error and all warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Context("when the job runs longer than the timeout", func() {
+		BeforeEach(func() {
+			interval = 10 * time.Millisecond
+			timeout = 50 * time.Millisecond
+			fakeJobGetter.GetJobStateReturns(State{GUID: "some-job-guid"}, []string{"warning-1"}, nil)
+		})
+
+		It("returns a JobTimeoutError and all warnings", func() {
+			Expect(executeErr).To(MatchError(ccerror.JobTimeoutError{
+				JobGUID: "some-job-guid",
+				Timeout: timeout,
+			}))
+			Expect(len(warnings)).To(BeNumerically(">", 1))
+			Expect(warnings).To(HaveLen(fakeJobGetter.GetJobStateCallCount()))
+		})
+	})
+
+	Context("when the context is cancelled while polling", func() {
+		BeforeEach(func() {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(context.Background())
+
+			fakeJobGetter.GetJobStateStub = func(string) (State, []string, error) {
+				cancel()
+				return State{GUID: "some-job-guid"}, []string{"warning-1"}, nil
+			}
+		})
+
+		It("stops polling and returns a RequestCancelledError", func() {
+			Expect(executeErr).To(MatchError(ccerror.RequestCancelledError{}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(fakeJobGetter.GetJobStateCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when no canceller is provided", func() {
+		BeforeEach(func() {
+			poller = NewPoller(fakeJobGetter, nil)
+			fakeJobGetter.GetJobStateReturns(State{GUID: "some-job-guid", Complete: true}, nil, nil)
+		})
+
+		It("polls the job", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeJobGetter.GetJobStateCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the context is cancelled before polling", func() {
+		BeforeEach(func() {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(context.Background())
+			cancel()
+		})
+
+		It("does not poll the job", func() {
+			Expect(executeErr).To(MatchError(ccerror.RequestCancelledError{}))
+			Expect(fakeJobGetter.GetJobStateCallCount()).To(Equal(0))
+		})
+	})
+})
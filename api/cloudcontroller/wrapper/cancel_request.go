@@ -0,0 +1,63 @@
+package wrapper
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+)
+
+//go:generate counterfeiter . RequestCanceller
+
+// RequestCanceller provides the context requests are made with and controls
+// when that context may be cancelled.
+type RequestCanceller interface {
+	// Context returns the context requests are made with.
+	Context() context.Context
+
+	// Catch is called before each request to allow the context to be
+	// cancelled; the returned function is called once the request is done.
+	Catch() func()
+}
+
+// CancelRequest is a wrapper that ties every request to a cancellable
+// context, so that in-flight requests are aborted and new ones are not sent
+// once the context is cancelled.
+type CancelRequest struct {
+	canceller  RequestCanceller
+	connection cloudcontroller.Connection
+}
+
+// NewCancelRequest returns a pointer to a CancelRequest wrapper.
+func NewCancelRequest(canceller RequestCanceller) *CancelRequest {
+	return &CancelRequest{
+		canceller: canceller,
+	}
+}
+
+// Wrap sets the connection in the CancelRequest and returns itself.
+func (cancel *CancelRequest) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	cancel.connection = innerconnection
+	return cancel
+}
+
+// Make performs the request with the canceller's context, returning a
+// RequestCancelledError if the context is cancelled before or while the
+// request is made.
+func (cancel *CancelRequest) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	ctx := cancel.canceller.Context()
+	if ctx.Err() != nil {
+		return ccerror.RequestCancelledError{}
+	}
+
+	release := cancel.canceller.Catch()
+	defer release()
+
+	request.Request = request.Request.WithContext(ctx)
+
+	err := cancel.connection.Make(request, passedResponse)
+	if err != nil && ctx.Err() != nil {
+		return ccerror.RequestCancelledError{}
+	}
+	return err
+}
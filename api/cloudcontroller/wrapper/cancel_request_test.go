@@ -0,0 +1,106 @@
+package wrapper_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CancelRequest", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		fakeCanceller  *wrapperfakes.FakeRequestCanceller
+		ctx            context.Context
+		cancelFunc     context.CancelFunc
+		releaseCount   int
+
+		request    *cloudcontroller.Request
+		response   *cloudcontroller.Response
+		wrapper    cloudcontroller.Connection
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+
+		ctx, cancelFunc = context.WithCancel(context.Background())
+		releaseCount = 0
+		fakeCanceller = new(wrapperfakes.FakeRequestCanceller)
+		fakeCanceller.ContextReturns(ctx)
+		fakeCanceller.CatchReturns(func() { releaseCount++ })
+
+		req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request = cloudcontroller.NewRequest(req, nil)
+		response = &cloudcontroller.Response{}
+
+		wrapper = NewCancelRequest(fakeCanceller).Wrap(fakeConnection)
+	})
+
+	AfterEach(func() {
+		cancelFunc()
+	})
+
+	JustBeforeEach(func() {
+		executeErr = wrapper.Make(request, response)
+	})
+
+	Context("when the context is not cancelled", func() {
+		It("makes the request with the context while catching cancellation", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			passedRequest, passedResponse := fakeConnection.MakeArgsForCall(0)
+			Expect(passedRequest.Context()).To(Equal(ctx))
+			Expect(passedResponse).To(Equal(response))
+
+			Expect(fakeCanceller.CatchCallCount()).To(Equal(1))
+			Expect(releaseCount).To(Equal(1))
+		})
+
+		Context("when the request fails", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeReturns(errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(releaseCount).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when the context is cancelled while the request is in flight", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeStub = func(*cloudcontroller.Request, *cloudcontroller.Response) error {
+				cancelFunc()
+				return ccerror.RequestError{Err: context.Canceled}
+			}
+		})
+
+		It("returns a RequestCancelledError", func() {
+			Expect(executeErr).To(MatchError(ccerror.RequestCancelledError{}))
+			Expect(releaseCount).To(Equal(1))
+		})
+	})
+
+	Context("when the context is cancelled before the request is made", func() {
+		BeforeEach(func() {
+			cancelFunc()
+		})
+
+		It("returns a RequestCancelledError without making the request", func() {
+			Expect(executeErr).To(MatchError(ccerror.RequestCancelledError{}))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+			Expect(fakeCanceller.CatchCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,184 @@
+package wrapper
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+var guidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// DefaultRequestSummary collects the requests made by every Cloud Controller
+// connection of the running command.
+var DefaultRequestSummary = NewRequestSummary()
+
+// EndpointMetrics are the totals of the requests made to a single endpoint.
+type EndpointMetrics struct {
+	// Endpoint is the request method followed by the URL path, with GUIDs
+	// replaced by ":guid".
+	Endpoint      string
+	Requests      int
+	Retries       int
+	RequestBytes  int64
+	ResponseBytes int64
+	Duration      time.Duration
+}
+
+// RequestSummary accumulates the metrics recorded by RequestMetrics
+// wrappers. It is safe for concurrent use.
+type RequestSummary struct {
+	mutex     sync.Mutex
+	endpoints map[string]*EndpointMetrics
+	seen      map[*cloudcontroller.Request]bool
+}
+
+// NewRequestSummary returns an empty RequestSummary.
+func NewRequestSummary() *RequestSummary {
+	return &RequestSummary{
+		endpoints: map[string]*EndpointMetrics{},
+		seen:      map[*cloudcontroller.Request]bool{},
+	}
+}
+
+// Endpoints returns the metrics of every endpoint requested, the most
+// requested first.
+func (summary *RequestSummary) Endpoints() []EndpointMetrics {
+	summary.mutex.Lock()
+	defer summary.mutex.Unlock()
+
+	endpoints := make([]EndpointMetrics, 0, len(summary.endpoints))
+	for _, metrics := range summary.endpoints {
+		endpoints = append(endpoints, *metrics)
+	}
+
+	sort.Slice(endpoints, func(i int, j int) bool {
+		if endpoints[i].Requests != endpoints[j].Requests {
+			return endpoints[i].Requests > endpoints[j].Requests
+		}
+		return endpoints[i].Endpoint < endpoints[j].Endpoint
+	})
+
+	return endpoints
+}
+
+// Display writes a table of the endpoint metrics, followed by their totals,
+// to the output. Nothing is written when no requests were recorded.
+func (summary *RequestSummary) Display(output RequestLoggerOutput) error {
+	endpoints := summary.Endpoints()
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	err := output.Start()
+	if err != nil {
+		return err
+	}
+	defer output.Stop()
+
+	err = output.DisplayType("REQUEST SUMMARY", time.Now())
+	if err != nil {
+		return err
+	}
+
+	buffer := new(bytes.Buffer)
+	writer := tabwriter.NewWriter(buffer, 0, 1, 2, ' ', 0)
+	fmt.Fprintln(writer, "ENDPOINT\tREQUESTS\tRETRIES\tSENT\tRECEIVED\tTIME")
+
+	var total EndpointMetrics
+	for _, metrics := range endpoints {
+		writeEndpointMetrics(writer, metrics)
+		total.Requests += metrics.Requests
+		total.Retries += metrics.Retries
+		total.RequestBytes += metrics.RequestBytes
+		total.ResponseBytes += metrics.ResponseBytes
+		total.Duration += metrics.Duration
+	}
+	total.Endpoint = "TOTAL"
+	writeEndpointMetrics(writer, total)
+	writer.Flush()
+
+	return output.DisplayMessage(strings.TrimRight(buffer.String(), "\n"))
+}
+
+func (summary *RequestSummary) record(request *cloudcontroller.Request, response *cloudcontroller.Response, duration time.Duration) {
+	summary.mutex.Lock()
+	defer summary.mutex.Unlock()
+
+	endpoint := endpointName(request)
+	metrics, ok := summary.endpoints[endpoint]
+	if !ok {
+		metrics = &EndpointMetrics{Endpoint: endpoint}
+		summary.endpoints[endpoint] = metrics
+	}
+
+	// Retries are made with the same request, so seeing it again means the
+	// request is being retried.
+	if summary.seen[request] {
+		metrics.Retries++
+	}
+	summary.seen[request] = true
+
+	metrics.Requests++
+	if request.ContentLength > 0 {
+		metrics.RequestBytes += request.ContentLength
+	}
+	metrics.ResponseBytes += int64(len(response.RawResponse))
+	metrics.Duration += duration
+}
+
+// RequestMetrics is a wrapper that records the number of requests, retries,
+// bytes transferred and time spent per Cloud Controller endpoint.
+type RequestMetrics struct {
+	connection cloudcontroller.Connection
+	summary    *RequestSummary
+}
+
+// NewRequestMetrics returns a pointer to a RequestMetrics wrapper that records
+// into the summary.
+func NewRequestMetrics(summary *RequestSummary) *RequestMetrics {
+	return &RequestMetrics{
+		summary: summary,
+	}
+}
+
+// Wrap sets the connection on the RequestMetrics and returns itself.
+func (metrics *RequestMetrics) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	metrics.connection = innerconnection
+	return metrics
+}
+
+// Make records the request in the summary once the response is received.
+func (metrics *RequestMetrics) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	startTime := time.Now()
+	err := metrics.connection.Make(request, passedResponse)
+	metrics.summary.record(request, passedResponse, time.Since(startTime))
+	return err
+}
+
+func endpointName(request *cloudcontroller.Request) string {
+	segments := strings.Split(request.URL.Path, "/")
+	for i, segment := range segments {
+		if guidRegexp.MatchString(segment) {
+			segments[i] = ":guid"
+		}
+	}
+	return fmt.Sprintf("%s %s", request.Method, strings.Join(segments, "/"))
+}
+
+func writeEndpointMetrics(writer *tabwriter.Writer, metrics EndpointMetrics) {
+	fmt.Fprintf(writer, "%s\t%d\t%d\t%d B\t%d B\t%s\n",
+		metrics.Endpoint,
+		metrics.Requests,
+		metrics.Retries,
+		metrics.RequestBytes,
+		metrics.ResponseBytes,
+		metrics.Duration.Round(time.Millisecond),
+	)
+}
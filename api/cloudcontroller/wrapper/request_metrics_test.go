@@ -0,0 +1,136 @@
+package wrapper_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request Metrics", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		summary        *RequestSummary
+		wrapper        cloudcontroller.Connection
+	)
+
+	newRequest := func(method string, uri string, body string) *cloudcontroller.Request {
+		bodyReader := strings.NewReader(body)
+		req, err := http.NewRequest(method, uri, bodyReader)
+		Expect(err).ToNot(HaveOccurred())
+		return cloudcontroller.NewRequest(req, bodyReader)
+	}
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			passedResponse.RawResponse = []byte("some-response")
+			return nil
+		}
+
+		summary = NewRequestSummary()
+		wrapper = NewRequestMetrics(summary).Wrap(fakeConnection)
+	})
+
+	Describe("Make", func() {
+		It("makes the request and records it per endpoint", func() {
+			err := wrapper.Make(newRequest(http.MethodGet, "https://foo.bar.com/v2/security_groups/2a1e5d8c-0d6b-4d5e-9c3f-1f2e3d4c5b6a/spaces?page=1", ""), &cloudcontroller.Response{})
+			Expect(err).ToNot(HaveOccurred())
+			err = wrapper.Make(newRequest(http.MethodGet, "https://foo.bar.com/v2/security_groups/7b2c4e6f-8a9b-4c1d-a2e3-f4a5b6c7d8e9/spaces", ""), &cloudcontroller.Response{})
+			Expect(err).ToNot(HaveOccurred())
+			err = wrapper.Make(newRequest(http.MethodPut, "https://foo.bar.com/v2/apps/some-app", "some-body"), &cloudcontroller.Response{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+
+			endpoints := summary.Endpoints()
+			Expect(endpoints).To(HaveLen(2))
+			Expect(endpoints[0].Endpoint).To(Equal("GET /v2/security_groups/:guid/spaces"))
+			Expect(endpoints[0].Requests).To(Equal(2))
+			Expect(endpoints[0].Retries).To(Equal(0))
+			Expect(endpoints[0].RequestBytes).To(BeEquivalentTo(0))
+			Expect(endpoints[0].ResponseBytes).To(BeEquivalentTo(2 * len("some-response")))
+
+			Expect(endpoints[1].Endpoint).To(Equal("PUT /v2/apps/some-app"))
+			Expect(endpoints[1].Requests).To(Equal(1))
+			Expect(endpoints[1].RequestBytes).To(BeEquivalentTo(len("some-body")))
+		})
+
+		Context("when the request is retried", func() {
+			It("counts the retries", func() {
+				request := newRequest(http.MethodGet, "https://foo.bar.com/v2/info", "")
+				err := NewRetryRequest(2).Wrap(wrapper).Make(request, &cloudcontroller.Response{
+					HTTPResponse: &http.Response{StatusCode: http.StatusServiceUnavailable},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				fakeConnection.MakeReturns(errors.New("some-error"))
+				err = NewRetryRequest(2).Wrap(wrapper).Make(request, &cloudcontroller.Response{
+					HTTPResponse: &http.Response{StatusCode: http.StatusServiceUnavailable},
+				})
+				Expect(err).To(MatchError("some-error"))
+
+				endpoints := summary.Endpoints()
+				Expect(endpoints).To(HaveLen(1))
+				Expect(endpoints[0].Requests).To(Equal(4))
+				Expect(endpoints[0].Retries).To(Equal(3))
+			})
+		})
+
+		Context("when the connection returns an error", func() {
+			It("returns the error and records the request", func() {
+				fakeConnection.MakeReturns(errors.New("some-error"))
+				err := wrapper.Make(newRequest(http.MethodDelete, "https://foo.bar.com/v2/apps/some-app", ""), &cloudcontroller.Response{})
+				Expect(err).To(MatchError("some-error"))
+				Expect(summary.Endpoints()).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("Display", func() {
+		var fakeOutput *wrapperfakes.FakeRequestLoggerOutput
+
+		BeforeEach(func() {
+			fakeOutput = new(wrapperfakes.FakeRequestLoggerOutput)
+		})
+
+		Context("when no requests were made", func() {
+			It("displays nothing", func() {
+				Expect(summary.Display(fakeOutput)).To(Succeed())
+				Expect(fakeOutput.StartCallCount()).To(Equal(0))
+				Expect(fakeOutput.DisplayMessageCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when requests were made", func() {
+			BeforeEach(func() {
+				Expect(wrapper.Make(newRequest(http.MethodGet, "https://foo.bar.com/v2/info", ""), &cloudcontroller.Response{})).To(Succeed())
+				Expect(wrapper.Make(newRequest(http.MethodGet, "https://foo.bar.com/v2/info", ""), &cloudcontroller.Response{})).To(Succeed())
+			})
+
+			It("displays the endpoints and their totals", func() {
+				Expect(summary.Display(fakeOutput)).To(Succeed())
+
+				Expect(fakeOutput.StartCallCount()).To(Equal(1))
+				Expect(fakeOutput.StopCallCount()).To(Equal(1))
+
+				Expect(fakeOutput.DisplayTypeCallCount()).To(Equal(1))
+				name, _ := fakeOutput.DisplayTypeArgsForCall(0)
+				Expect(name).To(Equal("REQUEST SUMMARY"))
+
+				Expect(fakeOutput.DisplayMessageCallCount()).To(Equal(1))
+				lines := strings.Split(fakeOutput.DisplayMessageArgsForCall(0), "\n")
+				Expect(lines).To(HaveLen(3))
+				Expect(lines[0]).To(MatchRegexp(`^ENDPOINT\s+REQUESTS\s+RETRIES\s+SENT\s+RECEIVED\s+TIME$`))
+				Expect(lines[1]).To(MatchRegexp(`^GET /v2/info\s+2\s+0\s+0 B\s+26 B\s+\S+$`))
+				Expect(lines[2]).To(MatchRegexp(`^TOTAL\s+2\s+0\s+0 B\s+26 B\s+\S+$`))
+			})
+		})
+	})
+})
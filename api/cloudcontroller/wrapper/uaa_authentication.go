@@ -28,15 +28,22 @@ type TokenCache interface {
 type UAAAuthentication struct {
 	connection cloudcontroller.Connection
 	client     UAAClient
-	cache      TokenCache
+	refresher  *uaa.TokenRefresher
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
 // the client and a token cache.
 func NewUAAAuthentication(client UAAClient, cache TokenCache) *UAAAuthentication {
+	return NewUAAAuthenticationWithRefresher(client, uaa.NewTokenRefresher(client, cache))
+}
+
+// NewUAAAuthenticationWithRefresher returns a pointer to a UAAAuthentication
+// wrapper with the client and a token refresher that may be shared with other
+// wrappers using the same token cache.
+func NewUAAAuthenticationWithRefresher(client UAAClient, refresher *uaa.TokenRefresher) *UAAAuthentication {
 	return &UAAAuthentication{
-		client: client,
-		cache:  cache,
+		client:    client,
+		refresher: refresher,
 	}
 }
 
@@ -46,9 +53,11 @@ func (t *UAAAuthentication) Wrap(innerconnection cloudcontroller.Connection) clo
 	return t
 }
 
-// SetClient sets the UAA client that the wrapper will use.
+// SetClient sets the UAA client that the wrapper and its token refresher will
+// use.
 func (t *UAAAuthentication) SetClient(client UAAClient) {
 	t.client = client
+	t.refresher.SetClient(client)
 }
 
 // Make adds authentication headers to the passed in request and then calls the
@@ -59,18 +68,16 @@ func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedRespons
 		return t.connection.Make(request, passedResponse)
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	accessToken := t.refresher.AccessToken()
+	request.Header.Set("Authorization", accessToken)
 
 	requestErr := t.connection.Make(request, passedResponse)
 	if _, ok := requestErr.(ccerror.InvalidAuthTokenError); ok {
-		tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+		accessToken, err := t.refresher.RefreshStaleToken(accessToken)
 		if err != nil {
 			return err
 		}
 
-		t.cache.SetAccessToken(tokens.AuthorizationToken())
-		t.cache.SetRefreshToken(tokens.RefreshToken)
-
 		if request.Body != nil {
 			err = request.ResetBody()
 			if err != nil {
@@ -80,7 +87,7 @@ func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedRespons
 				return err
 			}
 		}
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", accessToken)
 		requestErr = t.connection.Make(request, passedResponse)
 	}
 
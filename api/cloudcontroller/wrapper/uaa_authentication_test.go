@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -191,5 +192,50 @@ var _ = Describe("UAA Authentication", func() {
 				})
 			})
 		})
+
+		Context("when concurrent requests fail with an invalid token", func() {
+			BeforeEach(func() {
+				inMemoryCache.SetAccessToken("what")
+
+				var rejectedRequests sync.WaitGroup
+				rejectedRequests.Add(2)
+				fakeConnection.MakeStub = func(request *cloudcontroller.Request, response *cloudcontroller.Response) error {
+					if request.Header.Get("Authorization") == "what" {
+						rejectedRequests.Done()
+						rejectedRequests.Wait()
+						return ccerror.InvalidAuthTokenError{}
+					}
+					return nil
+				}
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshedTokens{
+						AccessToken:  "foobar-2",
+						RefreshToken: "bananananananana",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			It("refreshes the token once and resends both requests with the new token", func() {
+				errs := make(chan error, 2)
+				for i := 0; i < 2; i++ {
+					go func() {
+						errs <- wrapper.Make(&cloudcontroller.Request{Request: &http.Request{Header: http.Header{}}}, nil)
+					}()
+				}
+
+				Eventually(errs).Should(Receive(BeNil()))
+				Eventually(errs).Should(Receive(BeNil()))
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(4))
+				for i := 0; i < 4; i++ {
+					requestArg, _ := fakeConnection.MakeArgsForCall(i)
+					Expect(requestArg.Header.Get("Authorization")).To(Or(Equal("what"), Equal("bearer foobar-2")))
+				}
+			})
+		})
 	})
 })
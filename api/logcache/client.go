@@ -0,0 +1,104 @@
+// Package logcache represents a Log Cache API client.
+//
+// These sets of packages are still under development/pre-pre-pre...alpha. Use
+// at your own risk! Functionality and design may change without warning.
+//
+// For more information on the Log Cache API see
+// https://github.com/cloudfoundry/log-cache-release
+//
+// Method Naming Conventions
+//
+// The client follows the same '<Action Name><Top Level Endpoint><Return
+// Value>' naming approach as the Cloud Controller clients.
+//
+// Error Handling
+//
+// All error handling that requires parsing the message returned back from the
+// Log Cache API should be placed in the errorWrapper. All parsed Log Cache API
+// errors should exist in the logcacheerror package.
+package logcache
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/logcache/internal"
+
+	"github.com/tedsuo/rata"
+)
+
+// Client is a client that can be used to talk to a Log Cache API.
+type Client struct {
+	connection Connection
+	router     *rata.RequestGenerator
+	url        string
+	userAgent  string
+}
+
+// ClientConfig allows the Client to be configured
+type ClientConfig struct {
+	// AppName is the name of the application/process using the client.
+	AppName string
+
+	// AppVersion is the version of the application/process using the client.
+	AppVersion string
+
+	// CACertFile is the path to a PEM-encoded bundle of CA certificates that
+	// are trusted, along with the system trust store, when verifying the
+	// server's certificate chain.
+	CACertFile string
+
+	// DialTimeout is the DNS timeout used to make all requests to the Log Cache
+	// API.
+	DialTimeout time.Duration
+
+	// MaxIdleConnsPerHost is the number of idle keep-alive connections kept
+	// per host.
+	MaxIdleConnsPerHost int
+
+	// SkipSSLValidation controls whether a client verifies the server's
+	// certificate chain and host name. If SkipSSLValidation is true, TLS accepts
+	// any certificate presented by the server and any host name in that
+	// certificate for *all* client requests going forward.
+	//
+	// In this mode, TLS is susceptible to man-in-the-middle attacks. This should
+	// be used only for testing.
+	SkipSSLValidation bool
+
+	// TLSHandshakeTimeout is the timeout for the TLS handshake.
+	TLSHandshakeTimeout time.Duration
+
+	// URL is a fully qualified URL to the Log Cache API.
+	URL string
+
+	// Wrappers that apply to the client connection.
+	Wrappers []ConnectionWrapper
+}
+
+// NewClient returns a new Log Cache API client.
+func NewClient(config ClientConfig) *Client {
+	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
+
+	connection := NewConnection(Config{
+		CACertFile:          config.CACertFile,
+		DialTimeout:         config.DialTimeout,
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost,
+		SkipSSLValidation:   config.SkipSSLValidation,
+		TLSHandshakeTimeout: config.TLSHandshakeTimeout,
+	})
+
+	wrappedConnection := NewErrorWrapper().Wrap(connection)
+	for _, wrapper := range config.Wrappers {
+		wrappedConnection = wrapper.Wrap(wrappedConnection)
+	}
+
+	client := &Client{
+		connection: wrappedConnection,
+		router:     rata.NewRequestGenerator(config.URL, internal.Routes),
+		url:        config.URL,
+		userAgent:  userAgent,
+	}
+
+	return client
+}
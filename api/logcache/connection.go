@@ -0,0 +1,8 @@
+package logcache
+
+//go:generate counterfeiter . Connection
+
+// Connection creates and executes http requests
+type Connection interface {
+	Make(request *Request, passedResponse *Response) error
+}
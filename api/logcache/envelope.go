@@ -0,0 +1,214 @@
+package logcache
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/logcache/internal"
+)
+
+// EnvelopeType is the kind of data an envelope carries.
+type EnvelopeType string
+
+const (
+	LogEnvelopeType     EnvelopeType = "LOG"
+	CounterEnvelopeType EnvelopeType = "COUNTER"
+	GaugeEnvelopeType   EnvelopeType = "GAUGE"
+	TimerEnvelopeType   EnvelopeType = "TIMER"
+	EventEnvelopeType   EnvelopeType = "EVENT"
+)
+
+// LogType is the stream a log line was written to.
+type LogType string
+
+const (
+	OutLogType LogType = "OUT"
+	ErrLogType LogType = "ERR"
+)
+
+// Envelope represents a single loggregator v2 envelope stored in Log Cache.
+// Exactly one of Log, Counter, Gauge, Timer and Event is set.
+type Envelope struct {
+	Timestamp  time.Time
+	SourceID   string
+	InstanceID string
+	Tags       map[string]string
+
+	Log     *Log
+	Counter *Counter
+	Gauge   *Gauge
+	Timer   *Timer
+	Event   *Event
+}
+
+// Log is the payload of a LOG envelope.
+type Log struct {
+	Payload []byte  `json:"payload"`
+	Type    LogType `json:"type"`
+}
+
+// Counter is the payload of a COUNTER envelope.
+type Counter struct {
+	Name  string `json:"name"`
+	Delta uint64 `json:"delta,string"`
+	Total uint64 `json:"total,string"`
+}
+
+// Gauge is the payload of a GAUGE envelope.
+type Gauge struct {
+	Metrics map[string]GaugeValue `json:"metrics"`
+}
+
+// GaugeValue is a single metric of a GAUGE envelope.
+type GaugeValue struct {
+	Unit  string  `json:"unit"`
+	Value float64 `json:"value"`
+}
+
+// Timer is the payload of a TIMER envelope.
+type Timer struct {
+	Name  string    `json:"name"`
+	Start time.Time `json:"-"`
+	Stop  time.Time `json:"-"`
+}
+
+// Event is the payload of an EVENT envelope.
+type Event struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// Type returns the type of the envelope based on the payload it carries.
+func (envelope Envelope) Type() EnvelopeType {
+	switch {
+	case envelope.Log != nil:
+		return LogEnvelopeType
+	case envelope.Counter != nil:
+		return CounterEnvelopeType
+	case envelope.Gauge != nil:
+		return GaugeEnvelopeType
+	case envelope.Timer != nil:
+		return TimerEnvelopeType
+	case envelope.Event != nil:
+		return EventEnvelopeType
+	default:
+		return ""
+	}
+}
+
+// UnmarshalJSON helps unmarshal a Log Cache envelope response. Log Cache
+// encodes 64-bit integers as strings and nanosecond timestamps.
+func (envelope *Envelope) UnmarshalJSON(data []byte) error {
+	var ccEnvelope struct {
+		Timestamp  int64             `json:"timestamp,string"`
+		SourceID   string            `json:"source_id"`
+		InstanceID string            `json:"instance_id"`
+		Tags       map[string]string `json:"tags"`
+		Log        *Log              `json:"log"`
+		Counter    *Counter          `json:"counter"`
+		Gauge      *Gauge            `json:"gauge"`
+		Timer      *struct {
+			Name  string `json:"name"`
+			Start int64  `json:"start,string"`
+			Stop  int64  `json:"stop,string"`
+		} `json:"timer"`
+		Event *Event `json:"event"`
+	}
+	if err := json.Unmarshal(data, &ccEnvelope); err != nil {
+		return err
+	}
+
+	envelope.Timestamp = time.Unix(0, ccEnvelope.Timestamp)
+	envelope.SourceID = ccEnvelope.SourceID
+	envelope.InstanceID = ccEnvelope.InstanceID
+	envelope.Tags = ccEnvelope.Tags
+	envelope.Log = ccEnvelope.Log
+	envelope.Counter = ccEnvelope.Counter
+	envelope.Gauge = ccEnvelope.Gauge
+	envelope.Event = ccEnvelope.Event
+
+	if envelope.Log != nil && envelope.Log.Type == "" {
+		// The default log type is omitted from responses.
+		envelope.Log.Type = OutLogType
+	}
+
+	if ccEnvelope.Timer != nil {
+		envelope.Timer = &Timer{
+			Name:  ccEnvelope.Timer.Name,
+			Start: time.Unix(0, ccEnvelope.Timer.Start),
+			Stop:  time.Unix(0, ccEnvelope.Timer.Stop),
+		}
+	}
+
+	return nil
+}
+
+// ReadOptions filters the envelopes returned by GetEnvelopes.
+type ReadOptions struct {
+	// StartTime is the inclusive lower bound of the envelope timestamps.
+	StartTime time.Time
+
+	// EndTime is the exclusive upper bound of the envelope timestamps.
+	// Defaults to the current time.
+	EndTime time.Time
+
+	// EnvelopeTypes limits the envelopes to the provided types. All types are
+	// returned when empty.
+	EnvelopeTypes []EnvelopeType
+
+	// Limit is the maximum number of envelopes returned. Log Cache defaults to
+	// 100 and caps it at 1000.
+	Limit int
+
+	// Descending returns the newest envelopes first.
+	Descending bool
+}
+
+func (options ReadOptions) query() url.Values {
+	query := url.Values{}
+
+	if !options.StartTime.IsZero() {
+		query.Set("start_time", strconv.FormatInt(options.StartTime.UnixNano(), 10))
+	}
+	if !options.EndTime.IsZero() {
+		query.Set("end_time", strconv.FormatInt(options.EndTime.UnixNano(), 10))
+	}
+	for _, envelopeType := range options.EnvelopeTypes {
+		query.Add("envelope_types", string(envelopeType))
+	}
+	if options.Limit > 0 {
+		query.Set("limit", strconv.Itoa(options.Limit))
+	}
+	if options.Descending {
+		query.Set("descending", "true")
+	}
+
+	return query
+}
+
+// GetEnvelopes returns the envelopes stored for the provided source ID, which
+// is the GUID of an app, that match the read options.
+func (client Client) GetEnvelopes(sourceID string, options ReadOptions) ([]Envelope, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.Read,
+		URIParams:   Params{"source_id": sourceID},
+		Query:       options.query(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var readResponse struct {
+		Envelopes struct {
+			Batch []Envelope `json:"batch"`
+		} `json:"envelopes"`
+	}
+	response := Response{
+		Result: &readResponse,
+	}
+
+	err = client.connection.Make(request, &response)
+	return readResponse.Envelopes.Batch, err
+}
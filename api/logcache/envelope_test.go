@@ -0,0 +1,159 @@
+package logcache_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Envelopes", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetEnvelopes", func() {
+		var (
+			options   ReadOptions
+			envelopes []Envelope
+			err       error
+		)
+
+		BeforeEach(func() {
+			options = ReadOptions{}
+		})
+
+		JustBeforeEach(func() {
+			envelopes, err = client.GetEnvelopes("some-app-guid", options)
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"envelopes": {
+						"batch": [
+							{
+								"timestamp": "1500000000000000000",
+								"source_id": "some-app-guid",
+								"instance_id": "0",
+								"tags": {"source_type": "APP/PROC/WEB"},
+								"log": {"payload": "aGVsbG8gd29ybGQ="}
+							},
+							{
+								"timestamp": "1500000001000000000",
+								"source_id": "some-app-guid",
+								"instance_id": "1",
+								"tags": {"source_type": "STG"},
+								"log": {"payload": "b29wcw==", "type": "ERR"}
+							},
+							{
+								"timestamp": "1500000002000000000",
+								"source_id": "some-app-guid",
+								"counter": {"name": "requests", "delta": "1", "total": "42"}
+							},
+							{
+								"timestamp": "1500000003000000000",
+								"source_id": "some-app-guid",
+								"instance_id": "0",
+								"gauge": {"metrics": {"cpu": {"unit": "percentage", "value": 1.5}}}
+							},
+							{
+								"timestamp": "1500000004000000000",
+								"source_id": "some-app-guid",
+								"timer": {"name": "http", "start": "1500000003000000000", "stop": "1500000004000000000"}
+							},
+							{
+								"timestamp": "1500000005000000000",
+								"source_id": "some-app-guid",
+								"event": {"title": "some-title", "body": "some-body"}
+							}
+						]
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid"),
+						RespondWith(http.StatusOK, response),
+					),
+				)
+			})
+
+			It("returns the envelopes", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(envelopes).To(HaveLen(6))
+
+				Expect(envelopes[0]).To(Equal(Envelope{
+					Timestamp:  time.Unix(0, 1500000000000000000),
+					SourceID:   "some-app-guid",
+					InstanceID: "0",
+					Tags:       map[string]string{"source_type": "APP/PROC/WEB"},
+					Log:        &Log{Payload: []byte("hello world"), Type: OutLogType},
+				}))
+				Expect(envelopes[0].Type()).To(Equal(LogEnvelopeType))
+
+				Expect(envelopes[1].Log).To(Equal(&Log{Payload: []byte("oops"), Type: ErrLogType}))
+
+				Expect(envelopes[2].Counter).To(Equal(&Counter{Name: "requests", Delta: 1, Total: 42}))
+				Expect(envelopes[2].Type()).To(Equal(CounterEnvelopeType))
+
+				Expect(envelopes[3].Gauge).To(Equal(&Gauge{Metrics: map[string]GaugeValue{"cpu": {Unit: "percentage", Value: 1.5}}}))
+				Expect(envelopes[3].Type()).To(Equal(GaugeEnvelopeType))
+
+				Expect(envelopes[4].Timer).To(Equal(&Timer{
+					Name:  "http",
+					Start: time.Unix(0, 1500000003000000000),
+					Stop:  time.Unix(0, 1500000004000000000),
+				}))
+				Expect(envelopes[4].Type()).To(Equal(TimerEnvelopeType))
+
+				Expect(envelopes[5].Event).To(Equal(&Event{Title: "some-title", Body: "some-body"}))
+				Expect(envelopes[5].Type()).To(Equal(EventEnvelopeType))
+			})
+		})
+
+		Context("when read options are provided", func() {
+			BeforeEach(func() {
+				options = ReadOptions{
+					StartTime:     time.Unix(0, 100),
+					EndTime:       time.Unix(0, 200),
+					EnvelopeTypes: []EnvelopeType{LogEnvelopeType, GaugeEnvelopeType},
+					Limit:         50,
+					Descending:    true,
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "descending=true&end_time=200&envelope_types=LOG&envelope_types=GAUGE&limit=50&start_time=100"),
+						RespondWith(http.StatusOK, `{"envelopes": {"batch": []}}`),
+					),
+				)
+			})
+
+			It("passes them as query parameters", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(envelopes).To(BeEmpty())
+			})
+		})
+
+		Context("when the Log Cache API returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid"),
+						RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError(logcacheerror.NotFoundError{Message: "Not Found"}))
+			})
+		})
+	})
+})
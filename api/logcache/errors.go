@@ -0,0 +1,72 @@
+package logcache
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+)
+
+// errorWrapper is the wrapper that converts responses with 4xx and 5xx status
+// codes to an error.
+type errorWrapper struct {
+	connection Connection
+}
+
+func NewErrorWrapper() *errorWrapper {
+	return new(errorWrapper)
+}
+
+// Wrap wraps a Log Cache API connection in this error handling wrapper.
+func (e *errorWrapper) Wrap(innerconnection Connection) Connection {
+	e.connection = innerconnection
+	return e
+}
+
+// Make converts RawHTTPStatusError, which represents responses with 4xx and
+// 5xx status codes, to specific errors.
+func (e *errorWrapper) Make(request *Request, passedResponse *Response) error {
+	err := e.connection.Make(request, passedResponse)
+
+	if rawHTTPStatusErr, ok := err.(logcacheerror.RawHTTPStatusError); ok {
+		return convert(rawHTTPStatusErr)
+	}
+	return err
+}
+
+func convert(rawHTTPStatusErr logcacheerror.RawHTTPStatusError) error {
+	// The Log Cache auth proxy responds to authentication and authorization
+	// failures without a JSON body, so those are converted on the status code
+	// alone.
+	var errorResponse logcacheerror.ErrorResponse
+	jsonErr := json.Unmarshal(rawHTTPStatusErr.RawResponse, &errorResponse)
+	if errorResponse.Message == "" {
+		errorResponse.Message = http.StatusText(rawHTTPStatusErr.StatusCode)
+	}
+
+	switch rawHTTPStatusErr.StatusCode {
+	case http.StatusUnauthorized: // 401
+		return logcacheerror.InvalidAuthTokenError{Message: errorResponse.Message}
+	case http.StatusNotFound: // 404
+		return logcacheerror.NotFoundError{Message: errorResponse.Message}
+	}
+
+	// Try to unmarshal the raw error into a Log Cache API error. If
+	// unmarshaling fails, return the raw error.
+	if jsonErr != nil {
+		return rawHTTPStatusErr
+	}
+
+	switch rawHTTPStatusErr.StatusCode {
+	case http.StatusBadRequest: // 400
+		return logcacheerror.BadRequestError{Message: errorResponse.Message}
+	case http.StatusForbidden: // 403
+		return logcacheerror.ForbiddenError{Message: errorResponse.Message}
+	default:
+		return logcacheerror.UnexpectedResponseError{
+			ErrorResponse: errorResponse,
+			RequestIDs:    rawHTTPStatusErr.RequestIDs,
+			ResponseCode:  rawHTTPStatusErr.StatusCode,
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package logcache_test
+
+import (
+	"fmt"
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+	"code.cloudfoundry.org/cli/api/logcache/logcachefakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Error Wrapper", func() {
+	DescribeTable("Make",
+		func(statusCode int, errorMessage string, expectedError error) {
+			fakeConnection := new(logcachefakes.FakeConnection)
+			fakeConnection.MakeReturns(logcacheerror.RawHTTPStatusError{
+				StatusCode:  statusCode,
+				RawResponse: []byte(fmt.Sprintf(`{"error":"%s","code":3,"message":"%s"}`, errorMessage, errorMessage)),
+				RequestIDs:  []string{"some-request-id"},
+			})
+
+			errorWrapper := NewErrorWrapper().Wrap(fakeConnection)
+			err := errorWrapper.Make(nil, nil)
+			Expect(err).To(MatchError(expectedError))
+		},
+		Entry("400 -> BadRequestError", http.StatusBadRequest, "I am an error", logcacheerror.BadRequestError{Message: "I am an error"}),
+		Entry("401 -> InvalidAuthTokenError", http.StatusUnauthorized, "I am an error", logcacheerror.InvalidAuthTokenError{Message: "I am an error"}),
+		Entry("403 -> ForbiddenError", http.StatusForbidden, "I am an error", logcacheerror.ForbiddenError{Message: "I am an error"}),
+		Entry("404 -> NotFoundError", http.StatusNotFound, "I am an error", logcacheerror.NotFoundError{Message: "I am an error"}),
+		Entry("500 -> UnexpectedResponseError", http.StatusInternalServerError, "I am an error", logcacheerror.UnexpectedResponseError{
+			ErrorResponse: logcacheerror.ErrorResponse{Message: "I am an error"},
+			RequestIDs:    []string{"some-request-id"},
+			ResponseCode:  http.StatusInternalServerError,
+		}),
+	)
+
+	Context("when the response is not JSON", func() {
+		It("returns the raw error", func() {
+			rawErr := logcacheerror.RawHTTPStatusError{StatusCode: http.StatusBadGateway, RawResponse: []byte("bad gateway")}
+			fakeConnection := new(logcachefakes.FakeConnection)
+			fakeConnection.MakeReturns(rawErr)
+
+			err := NewErrorWrapper().Wrap(fakeConnection).Make(nil, nil)
+			Expect(err).To(MatchError(rawErr))
+		})
+
+		DescribeTable("converts authentication and authorization failures from the auth proxy",
+			func(statusCode int, expectedError error) {
+				fakeConnection := new(logcachefakes.FakeConnection)
+				fakeConnection.MakeReturns(logcacheerror.RawHTTPStatusError{StatusCode: statusCode})
+
+				err := NewErrorWrapper().Wrap(fakeConnection).Make(nil, nil)
+				Expect(err).To(MatchError(expectedError))
+			},
+			Entry("401 -> InvalidAuthTokenError", http.StatusUnauthorized, logcacheerror.InvalidAuthTokenError{Message: "Unauthorized"}),
+			Entry("404 -> NotFoundError", http.StatusNotFound, logcacheerror.NotFoundError{Message: "Not Found"}),
+		)
+	})
+})
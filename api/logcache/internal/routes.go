@@ -0,0 +1,17 @@
+package internal
+
+import (
+	"net/http"
+
+	"github.com/tedsuo/rata"
+)
+
+const (
+	Read = "Read"
+)
+
+// Routes is a list of routes used by the rata library to construct request
+// URLs.
+var Routes = rata.Routes{
+	{Path: "/api/v1/read/:source_id", Method: http.MethodGet, Name: Read},
+}
@@ -0,0 +1,135 @@
+package logcache
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+	"code.cloudfoundry.org/cli/api/transport"
+)
+
+// LogCacheConnection represents a connection to the Log Cache API
+// server.
+type LogCacheConnection struct {
+	HTTPClient *http.Client
+	UserAgent  string
+
+	transportErr error
+}
+
+// Config is for configuring a LogCacheConnection.
+type Config struct {
+	CACertFile          string
+	DialTimeout         time.Duration
+	MaxIdleConnsPerHost int
+	SkipSSLValidation   bool
+	TLSHandshakeTimeout time.Duration
+}
+
+// NewConnection returns a new LogCacheConnection with provided
+// configuration.
+func NewConnection(config Config) *LogCacheConnection {
+	tr, err := transport.Shared(transport.Config{
+		CACertFile:          config.CACertFile,
+		DialTimeout:         config.DialTimeout,
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost,
+		SkipSSLValidation:   config.SkipSSLValidation,
+		TLSHandshakeTimeout: config.TLSHandshakeTimeout,
+	})
+
+	if err != nil {
+		return &LogCacheConnection{transportErr: err}
+	}
+
+	return &LogCacheConnection{
+		HTTPClient: &http.Client{Transport: tr},
+	}
+}
+
+// Make performs the request and parses the response.
+func (connection *LogCacheConnection) Make(request *Request, passedResponse *Response) error {
+	// In case this function is called from a retry, passedResponse may already
+	// be populated with a previous response. We reset in case there's an HTTP
+	// error and we don't repopulate it in populateResponse.
+	passedResponse.reset()
+
+	if connection.transportErr != nil {
+		return connection.transportErr
+	}
+
+	response, err := connection.HTTPClient.Do(request.Request)
+	if err != nil {
+		return connection.processRequestErrors(request.Request, err)
+	}
+
+	return connection.populateResponse(response, passedResponse)
+}
+
+func (*LogCacheConnection) processRequestErrors(request *http.Request, err error) error {
+	switch e := err.(type) {
+	case *url.Error:
+		switch urlErr := e.Err.(type) {
+		case x509.UnknownAuthorityError:
+			return logcacheerror.UnverifiedServerError{
+				URL: request.URL.String(),
+			}
+		case x509.HostnameError:
+			return logcacheerror.SSLValidationHostnameError{
+				Message: urlErr.Error(),
+			}
+		default:
+			return logcacheerror.RequestError{Err: e}
+		}
+	default:
+		return err
+	}
+}
+
+func (connection *LogCacheConnection) populateResponse(response *http.Response, passedResponse *Response) error {
+	passedResponse.HTTPResponse = response
+
+	if resourceLocationURL := response.Header.Get("Location"); resourceLocationURL != "" {
+		passedResponse.ResourceLocationURL = resourceLocationURL
+	}
+
+	rawBytes, err := ioutil.ReadAll(response.Body)
+	defer response.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	passedResponse.RawResponse = rawBytes
+
+	err = connection.handleStatusCodes(response, passedResponse)
+	if err != nil {
+		return err
+	}
+
+	if passedResponse.Result != nil {
+		decoder := json.NewDecoder(bytes.NewBuffer(passedResponse.RawResponse))
+		decoder.UseNumber()
+		err = decoder.Decode(passedResponse.Result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (*LogCacheConnection) handleStatusCodes(response *http.Response, passedResponse *Response) error {
+	if response.StatusCode >= 400 {
+		return logcacheerror.RawHTTPStatusError{
+			StatusCode:  response.StatusCode,
+			RawResponse: passedResponse.RawResponse,
+			RequestIDs:  response.Header["X-Vcap-Request-Id"],
+		}
+	}
+
+	return nil
+}
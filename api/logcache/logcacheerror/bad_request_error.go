@@ -0,0 +1,9 @@
+package logcacheerror
+
+type BadRequestError struct {
+	Message string
+}
+
+func (e BadRequestError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,10 @@
+package logcacheerror
+
+// ErrorResponse represents the body of a Log Cache API error response.
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
+
+func (e ErrorResponse) Error() string {
+	return e.Message
+}
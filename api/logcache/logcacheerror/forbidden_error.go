@@ -0,0 +1,9 @@
+package logcacheerror
+
+type ForbiddenError struct {
+	Message string
+}
+
+func (e ForbiddenError) Error() string {
+	return e.Message
+}
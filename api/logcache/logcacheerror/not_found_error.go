@@ -0,0 +1,10 @@
+package logcacheerror
+
+// NotFoundError wraps a generic 404 error.
+type NotFoundError struct {
+	Message string
+}
+
+func (e NotFoundError) Error() string {
+	return e.Message
+}
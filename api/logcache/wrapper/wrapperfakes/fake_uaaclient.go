@@ -1,11 +1,11 @@
 // Code generated by counterfeiter. DO NOT EDIT.
-package noaabridgefakes
+package wrapperfakes
 
 import (
 	"sync"
 
+	"code.cloudfoundry.org/cli/api/logcache/wrapper"
 	"code.cloudfoundry.org/cli/api/uaa"
-	"code.cloudfoundry.org/cli/api/uaa/noaabridge"
 )
 
 type FakeUAAClient struct {
@@ -101,4 +101,4 @@ func (fake *FakeUAAClient) recordInvocation(key string, args []interface{}) {
 	fake.invocations[key] = append(fake.invocations[key], args)
 }
 
-var _ noaabridge.UAAClient = new(FakeUAAClient)
+var _ wrapper.UAAClient = new(FakeUAAClient)
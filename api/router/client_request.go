@@ -0,0 +1,50 @@
+package router
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Params represents URI parameters for a request.
+type Params map[string]string
+
+// requestOptions contains all the options to create an HTTP request.
+type requestOptions struct {
+	// URIParams are the list URI route parameters
+	URIParams Params
+
+	// Query is a list of HTTP query parameters
+	Query url.Values
+
+	// RequestName is the name of the request (see routes)
+	RequestName string
+
+	// Body is the request body
+	Body io.ReadSeeker
+}
+
+// newHTTPRequest returns a constructed HTTP.Request with some defaults.
+// Defaults are applied when Request fields are not filled in.
+func (client Client) newHTTPRequest(passedRequest requestOptions) (*Request, error) {
+	request, err := client.router.CreateRequest(
+		passedRequest.RequestName,
+		map[string]string(passedRequest.URIParams),
+		passedRequest.Body,
+	)
+	if err != nil {
+		return nil, err
+	}
+	request.URL.RawQuery = passedRequest.Query.Encode()
+
+	request.Header = http.Header{}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("User-Agent", client.userAgent)
+
+	if passedRequest.Body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	// Make sure the body is the same as the one in the request
+	return NewRequest(request, passedRequest.Body), nil
+}
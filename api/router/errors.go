@@ -0,0 +1,71 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/router/routererror"
+)
+
+// expiredTokenMessage is the message the Routing API returns with a 401 when
+// the access token has expired.
+const expiredTokenMessage = "Token is expired"
+
+// errorWrapper is the wrapper that converts responses with 4xx and 5xx status
+// codes to an error.
+type errorWrapper struct {
+	connection Connection
+}
+
+func NewErrorWrapper() *errorWrapper {
+	return new(errorWrapper)
+}
+
+// Wrap wraps a Routing API connection in this error handling wrapper.
+func (e *errorWrapper) Wrap(innerconnection Connection) Connection {
+	e.connection = innerconnection
+	return e
+}
+
+// Make converts RawHTTPStatusError, which represents responses with 4xx and
+// 5xx status codes, to specific errors.
+func (e *errorWrapper) Make(request *Request, passedResponse *Response) error {
+	err := e.connection.Make(request, passedResponse)
+
+	if rawHTTPStatusErr, ok := err.(routererror.RawHTTPStatusError); ok {
+		return convert(rawHTTPStatusErr)
+	}
+	return err
+}
+
+func convert(rawHTTPStatusErr routererror.RawHTTPStatusError) error {
+	// Try to unmarshal the raw error into a Routing API error. If unmarshaling
+	// fails, return the raw error.
+	var errorResponse routererror.ErrorResponse
+	err := json.Unmarshal(rawHTTPStatusErr.RawResponse, &errorResponse)
+	if err != nil {
+		return rawHTTPStatusErr
+	}
+
+	switch rawHTTPStatusErr.StatusCode {
+	case http.StatusBadRequest: // 400
+		return routererror.BadRequestError{Message: errorResponse.Message}
+	case http.StatusUnauthorized: // 401
+		if errorResponse.Message == expiredTokenMessage {
+			return routererror.InvalidAuthTokenError{Message: errorResponse.Message}
+		}
+		return routererror.UnauthorizedError{Message: errorResponse.Message}
+	case http.StatusForbidden: // 403
+		return routererror.ForbiddenError{Message: errorResponse.Message}
+	case http.StatusNotFound: // 404
+		return routererror.NotFoundError{Message: errorResponse.Message}
+	case http.StatusConflict: // 409
+		return routererror.ConflictError{Message: errorResponse.Message}
+	default:
+		return routererror.UnexpectedResponseError{
+			ErrorResponse: errorResponse,
+			RequestIDs:    rawHTTPStatusErr.RequestIDs,
+			ResponseCode:  rawHTTPStatusErr.StatusCode,
+		}
+	}
+}
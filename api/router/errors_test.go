@@ -0,0 +1,53 @@
+package router_test
+
+import (
+	"fmt"
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/router"
+	"code.cloudfoundry.org/cli/api/router/routererror"
+	"code.cloudfoundry.org/cli/api/router/routerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Error Wrapper", func() {
+	DescribeTable("Make",
+		func(statusCode int, errorMessage string, expectedError error) {
+			fakeConnection := new(routerfakes.FakeConnection)
+			fakeConnection.MakeReturns(routererror.RawHTTPStatusError{
+				StatusCode:  statusCode,
+				RawResponse: []byte(fmt.Sprintf(`{"name":"SomeError","message":"%s"}`, errorMessage)),
+				RequestIDs:  []string{"some-request-id"},
+			})
+
+			errorWrapper := NewErrorWrapper().Wrap(fakeConnection)
+			err := errorWrapper.Make(nil, nil)
+			Expect(err).To(MatchError(expectedError))
+		},
+		Entry("400 -> BadRequestError", http.StatusBadRequest, "I am an error", routererror.BadRequestError{Message: "I am an error"}),
+		Entry("401 with an expired token -> InvalidAuthTokenError", http.StatusUnauthorized, "Token is expired", routererror.InvalidAuthTokenError{Message: "Token is expired"}),
+		Entry("401 -> UnauthorizedError", http.StatusUnauthorized, "I am an error", routererror.UnauthorizedError{Message: "I am an error"}),
+		Entry("403 -> ForbiddenError", http.StatusForbidden, "I am an error", routererror.ForbiddenError{Message: "I am an error"}),
+		Entry("404 -> NotFoundError", http.StatusNotFound, "I am an error", routererror.NotFoundError{Message: "I am an error"}),
+		Entry("409 -> ConflictError", http.StatusConflict, "I am an error", routererror.ConflictError{Message: "I am an error"}),
+		Entry("500 -> UnexpectedResponseError", http.StatusInternalServerError, "I am an error", routererror.UnexpectedResponseError{
+			ErrorResponse: routererror.ErrorResponse{Name: "SomeError", Message: "I am an error"},
+			RequestIDs:    []string{"some-request-id"},
+			ResponseCode:  http.StatusInternalServerError,
+		}),
+	)
+
+	Context("when the response is not JSON", func() {
+		It("returns the raw error", func() {
+			rawErr := routererror.RawHTTPStatusError{StatusCode: http.StatusBadGateway, RawResponse: []byte("bad gateway")}
+			fakeConnection := new(routerfakes.FakeConnection)
+			fakeConnection.MakeReturns(rawErr)
+
+			err := NewErrorWrapper().Wrap(fakeConnection).Make(nil, nil)
+			Expect(err).To(MatchError(rawErr))
+		})
+	})
+})
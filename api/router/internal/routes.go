@@ -0,0 +1,19 @@
+package internal
+
+import (
+	"net/http"
+
+	"github.com/tedsuo/rata"
+)
+
+const (
+	GetRouterGroups   = "GetRouterGroups"
+	UpdateRouterGroup = "UpdateRouterGroup"
+)
+
+// Routes is a list of routes used by the rata library to construct request
+// URLs.
+var Routes = rata.Routes{
+	{Path: "/routing/v1/router_groups", Method: http.MethodGet, Name: GetRouterGroups},
+	{Path: "/routing/v1/router_groups/:router_group_guid", Method: http.MethodPut, Name: UpdateRouterGroup},
+}
@@ -0,0 +1,76 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/router/internal"
+)
+
+// RouterGroup represents a group of routers that share a set of reservable
+// ports, used for TCP routing.
+type RouterGroup struct {
+	GUID            string `json:"guid"`
+	Name            string `json:"name"`
+	Type            string `json:"type"`
+	ReservablePorts string `json:"reservable_ports"`
+}
+
+// GetRouterGroups returns a list of all router groups.
+func (client Client) GetRouterGroups() ([]RouterGroup, error) {
+	return client.getRouterGroups(nil)
+}
+
+// GetRouterGroupsByName returns the router groups with the provided name.
+func (client Client) GetRouterGroupsByName(name string) ([]RouterGroup, error) {
+	return client.getRouterGroups(url.Values{"name": []string{name}})
+}
+
+// UpdateRouterGroup updates the reservable ports of the provided router group
+// and returns the updated router group.
+func (client Client) UpdateRouterGroup(routerGroup RouterGroup) (RouterGroup, error) {
+	body, err := json.Marshal(struct {
+		ReservablePorts string `json:"reservable_ports"`
+	}{
+		ReservablePorts: routerGroup.ReservablePorts,
+	})
+	if err != nil {
+		return RouterGroup{}, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.UpdateRouterGroup,
+		URIParams:   Params{"router_group_guid": routerGroup.GUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return RouterGroup{}, err
+	}
+
+	var updatedRouterGroup RouterGroup
+	response := Response{
+		Result: &updatedRouterGroup,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedRouterGroup, err
+}
+
+func (client Client) getRouterGroups(query url.Values) ([]RouterGroup, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetRouterGroups,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var routerGroups []RouterGroup
+	response := Response{
+		Result: &routerGroups,
+	}
+
+	err = client.connection.Make(request, &response)
+	return routerGroups, err
+}
@@ -0,0 +1,120 @@
+package router_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/router"
+	"code.cloudfoundry.org/cli/api/router/routererror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Router Groups", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetRouterGroups", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				response := `[
+					{"guid": "guid-1", "name": "default-tcp", "type": "tcp", "reservable_ports": "1024-1033"},
+					{"guid": "guid-2", "name": "default-http", "type": "http", "reservable_ports": ""}
+				]`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/routing/v1/router_groups"),
+						RespondWith(http.StatusOK, response),
+					),
+				)
+			})
+
+			It("returns the router groups", func() {
+				routerGroups, err := client.GetRouterGroups()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(routerGroups).To(ConsistOf(
+					RouterGroup{GUID: "guid-1", Name: "default-tcp", Type: "tcp", ReservablePorts: "1024-1033"},
+					RouterGroup{GUID: "guid-2", Name: "default-http", Type: "http"},
+				))
+			})
+		})
+
+		Context("when the Routing API returns an error", func() {
+			BeforeEach(func() {
+				response := `{"name": "UnauthorizedError", "message": "You are not authorized"}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/routing/v1/router_groups"),
+						RespondWith(http.StatusForbidden, response),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				_, err := client.GetRouterGroups()
+				Expect(err).To(MatchError(routererror.ForbiddenError{Message: "You are not authorized"}))
+			})
+		})
+	})
+
+	Describe("GetRouterGroupsByName", func() {
+		BeforeEach(func() {
+			response := `[{"guid": "guid-1", "name": "default-tcp", "type": "tcp", "reservable_ports": "1024-1033"}]`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/routing/v1/router_groups", "name=default-tcp"),
+					RespondWith(http.StatusOK, response),
+				),
+			)
+		})
+
+		It("filters the router groups by name", func() {
+			routerGroups, err := client.GetRouterGroupsByName("default-tcp")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(routerGroups).To(ConsistOf(
+				RouterGroup{GUID: "guid-1", Name: "default-tcp", Type: "tcp", ReservablePorts: "1024-1033"},
+			))
+		})
+	})
+
+	Describe("UpdateRouterGroup", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{"guid": "guid-1", "name": "default-tcp", "type": "tcp", "reservable_ports": "2000-3000"}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/routing/v1/router_groups/guid-1"),
+						VerifyJSON(`{"reservable_ports": "2000-3000"}`),
+						RespondWith(http.StatusOK, response),
+					),
+				)
+			})
+
+			It("updates the reservable ports and returns the router group", func() {
+				routerGroup, err := client.UpdateRouterGroup(RouterGroup{GUID: "guid-1", ReservablePorts: "2000-3000"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(routerGroup).To(Equal(RouterGroup{GUID: "guid-1", Name: "default-tcp", Type: "tcp", ReservablePorts: "2000-3000"}))
+			})
+		})
+
+		Context("when the ports are invalid", func() {
+			BeforeEach(func() {
+				response := `{"name": "ProcessRequestError", "message": "Port must be between 1024 and 65535"}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/routing/v1/router_groups/guid-1"),
+						RespondWith(http.StatusBadRequest, response),
+					),
+				)
+			})
+
+			It("returns a bad request error", func() {
+				_, err := client.UpdateRouterGroup(RouterGroup{GUID: "guid-1", ReservablePorts: "1-2"})
+				Expect(err).To(MatchError(routererror.BadRequestError{Message: "Port must be between 1024 and 65535"}))
+			})
+		})
+	})
+})
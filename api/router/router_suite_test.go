@@ -0,0 +1,47 @@
+package router_test
+
+import (
+	"bytes"
+	"log"
+	"testing"
+
+	. "code.cloudfoundry.org/cli/api/router"
+	"code.cloudfoundry.org/cli/api/transport"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+func TestRouter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Router Suite")
+}
+
+var server *Server
+
+var _ = SynchronizedBeforeSuite(func() []byte {
+	return []byte{}
+}, func(data []byte) {
+	server = NewTLSServer()
+
+	// Suppresses ginkgo server logs
+	server.HTTPTestServer.Config.ErrorLog = log.New(&bytes.Buffer{}, "", 0)
+})
+
+var _ = SynchronizedAfterSuite(func() {
+	server.Close()
+}, func() {})
+
+var _ = BeforeEach(func() {
+	server.Reset()
+	transport.CloseIdleConnections()
+})
+
+func NewTestClient() *Client {
+	return NewClient(ClientConfig{
+		AppName:           "Router Test",
+		AppVersion:        "Unknown",
+		SkipSSLValidation: true,
+		URL:               server.URL(),
+	})
+}
@@ -0,0 +1,9 @@
+package routererror
+
+type ConflictError struct {
+	Message string
+}
+
+func (e ConflictError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,11 @@
+package routererror
+
+// ErrorResponse represents the body of a Routing API error response.
+type ErrorResponse struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+func (e ErrorResponse) Error() string {
+	return e.Message
+}
@@ -0,0 +1,10 @@
+package routererror
+
+// RequestCancelledError is returned when a request is interrupted, or never
+// sent, because its context was cancelled.
+type RequestCancelledError struct {
+}
+
+func (RequestCancelledError) Error() string {
+	return "request cancelled"
+}
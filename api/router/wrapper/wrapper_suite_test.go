@@ -0,0 +1,38 @@
+package wrapper_test
+
+import (
+	"bytes"
+	"log"
+
+	"code.cloudfoundry.org/cli/api/transport"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+
+	"testing"
+)
+
+func TestWrapper(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Wrapper Suite")
+}
+
+var server *Server
+
+var _ = SynchronizedBeforeSuite(func() []byte {
+	return []byte{}
+}, func(data []byte) {
+	server = NewTLSServer()
+
+	// Suppresses ginkgo server logs
+	server.HTTPTestServer.Config.ErrorLog = log.New(&bytes.Buffer{}, "", 0)
+})
+
+var _ = SynchronizedAfterSuite(func() {
+	server.Close()
+}, func() {})
+
+var _ = BeforeEach(func() {
+	server.Reset()
+	transport.CloseIdleConnections()
+})
@@ -0,0 +1,181 @@
+// Package transport builds the http.Transport shared by the CLI's API
+// connections.
+//
+// Every connection (Cloud Controller V2 and V3, UAA, CF Networking) used to
+// build its own transport, so each client kept its own pool of idle
+// connections and bulk operations could exhaust the local ephemeral ports.
+// Connections created with the same Config now reuse a single transport and
+// therefore a single connection pool.
+package transport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultKeepAlive is the TCP keep-alive period for dialed connections.
+	DefaultKeepAlive = 30 * time.Second
+
+	// DefaultIdleConnTimeout is how long an idle connection stays in the pool.
+	DefaultIdleConnTimeout = 90 * time.Second
+
+	// DefaultMaxIdleConnsPerHost is the number of idle connections kept per
+	// host when Config.MaxIdleConnsPerHost is not set.
+	DefaultMaxIdleConnsPerHost = 10
+
+	// DefaultTLSHandshakeTimeout is the TLS handshake timeout used when
+	// Config.TLSHandshakeTimeout is not set.
+	DefaultTLSHandshakeTimeout = 10 * time.Second
+)
+
+// InvalidCACertificateError is returned when a CA certificate file does not
+// contain any PEM-encoded certificates.
+type InvalidCACertificateError struct {
+	Path string
+}
+
+func (e InvalidCACertificateError) Error() string {
+	return fmt.Sprintf("no PEM-encoded certificates found in %s", e.Path)
+}
+
+// Config is for configuring a transport.
+type Config struct {
+	// CACertFile is the path to a PEM-encoded bundle of CA certificates that
+	// are trusted in addition to the system trust store.
+	CACertFile string
+
+	// DialTimeout is the timeout for establishing a connection, including name
+	// resolution. If not set, it is infinite.
+	DialTimeout time.Duration
+
+	// MaxIdleConnsPerHost is the number of idle keep-alive connections kept
+	// per host. If not set, DefaultMaxIdleConnsPerHost is used.
+	MaxIdleConnsPerHost int
+
+	// SkipSSLValidation controls whether the server's certificate chain and
+	// host name are verified.
+	SkipSSLValidation bool
+
+	// TLSHandshakeTimeout is the timeout for the TLS handshake. If not set,
+	// DefaultTLSHandshakeTimeout is used.
+	TLSHandshakeTimeout time.Duration
+}
+
+var (
+	sharedTransportsMutex sync.Mutex
+	sharedTransports      = map[Config]*http.Transport{}
+)
+
+// Shared returns the transport for the provided configuration, creating it on
+// first use. All callers passing an equal Config receive the same transport.
+//
+// An error is returned when the CA certificate file cannot be loaded. API
+// connections keep it and return it from every request rather than failing to
+// build, so commands that never reach the network still run; their HTTPClient
+// is left nil in that case.
+func Shared(config Config) (*http.Transport, error) {
+	config = config.withDefaults()
+
+	sharedTransportsMutex.Lock()
+	defer sharedTransportsMutex.Unlock()
+
+	if tr, ok := sharedTransports[config]; ok {
+		return tr, nil
+	}
+
+	tr, err := New(config)
+	if err != nil {
+		return nil, err
+	}
+	sharedTransports[config] = tr
+	return tr, nil
+}
+
+// CloseIdleConnections closes the idle connections of every shared transport.
+// Connections in use are not interrupted.
+func CloseIdleConnections() {
+	sharedTransportsMutex.Lock()
+	defer sharedTransportsMutex.Unlock()
+
+	for _, tr := range sharedTransports {
+		tr.CloseIdleConnections()
+	}
+}
+
+// New returns a new, unshared transport for the provided configuration.
+func New(config Config) (*http.Transport, error) {
+	config = config.withDefaults()
+
+	tlsConfig, err := NewTLSConfig(config.SkipSSLValidation, config.CACertFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Transport{
+		TLSClientConfig: tlsConfig,
+		Proxy:           http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			KeepAlive: DefaultKeepAlive,
+			Timeout:   config.DialTimeout,
+		}).DialContext,
+		IdleConnTimeout:     DefaultIdleConnTimeout,
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost,
+		TLSHandshakeTimeout: config.TLSHandshakeTimeout,
+	}, nil
+}
+
+// NewTLSConfig returns the TLS configuration for verifying servers. When
+// caCertFile is set, its certificates are trusted along with the system trust
+// store, and an error is returned if the file cannot be loaded.
+func NewTLSConfig(skipSSLValidation bool, caCertFile string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: skipSSLValidation,
+	}
+
+	if caCertFile != "" {
+		pool, err := NewCertPool(caCertFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+// NewCertPool returns the system trust store with the PEM-encoded
+// certificates in caCertFile added to it.
+func NewCertPool(caCertFile string) (*x509.CertPool, error) {
+	contents, err := ioutil.ReadFile(caCertFile)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(contents) {
+		return nil, InvalidCACertificateError{Path: caCertFile}
+	}
+
+	return pool, nil
+}
+
+func (config Config) withDefaults() Config {
+	if config.MaxIdleConnsPerHost <= 0 {
+		config.MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	}
+	if config.TLSHandshakeTimeout <= 0 {
+		config.TLSHandshakeTimeout = DefaultTLSHandshakeTimeout
+	}
+	return config
+}
@@ -0,0 +1,168 @@
+package transport_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/transport"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Transport", func() {
+	Describe("New", func() {
+		It("applies the provided configuration", func() {
+			tr, err := New(Config{
+				MaxIdleConnsPerHost: 42,
+				SkipSSLValidation:   true,
+				TLSHandshakeTimeout: 3 * time.Second,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(tr.MaxIdleConnsPerHost).To(Equal(42))
+			Expect(tr.TLSHandshakeTimeout).To(Equal(3 * time.Second))
+			Expect(tr.TLSClientConfig.InsecureSkipVerify).To(BeTrue())
+			Expect(tr.IdleConnTimeout).To(Equal(DefaultIdleConnTimeout))
+			Expect(tr.Proxy).ToNot(BeNil())
+			Expect(tr.DialContext).ToNot(BeNil())
+		})
+
+		It("uses defaults for unset values", func() {
+			tr, err := New(Config{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(tr.MaxIdleConnsPerHost).To(Equal(DefaultMaxIdleConnsPerHost))
+			Expect(tr.TLSHandshakeTimeout).To(Equal(DefaultTLSHandshakeTimeout))
+			Expect(tr.TLSClientConfig.InsecureSkipVerify).To(BeFalse())
+		})
+
+		It("trusts the certificates in the CA certificate file", func() {
+			caCertFile := writeTempFile(testCACert)
+			defer os.Remove(caCertFile)
+
+			tr, err := New(Config{CACertFile: caCertFile})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(tr.TLSClientConfig.InsecureSkipVerify).To(BeFalse())
+			Expect(tr.TLSClientConfig.RootCAs).ToNot(BeNil())
+		})
+
+		It("returns an InvalidCACertificateError when the CA certificate file is invalid", func() {
+			caCertFile := writeTempFile("not a certificate")
+			defer os.Remove(caCertFile)
+
+			_, err := New(Config{CACertFile: caCertFile})
+			Expect(err).To(MatchError(InvalidCACertificateError{Path: caCertFile}))
+		})
+
+		It("returns a new transport on every call", func() {
+			tr1, err := New(Config{})
+			Expect(err).ToNot(HaveOccurred())
+			tr2, err := New(Config{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(tr1).ToNot(BeIdenticalTo(tr2))
+		})
+	})
+
+	Describe("Shared", func() {
+		shared := func(config Config) *http.Transport {
+			tr, err := Shared(config)
+			Expect(err).ToNot(HaveOccurred())
+			return tr
+		}
+
+		It("returns the same transport for equal configurations", func() {
+			config := Config{DialTimeout: 7 * time.Second, SkipSSLValidation: true}
+			Expect(shared(config)).To(BeIdenticalTo(shared(config)))
+		})
+
+		It("treats unset values the same as their defaults", func() {
+			Expect(shared(Config{})).To(BeIdenticalTo(shared(Config{
+				MaxIdleConnsPerHost: DefaultMaxIdleConnsPerHost,
+				TLSHandshakeTimeout: DefaultTLSHandshakeTimeout,
+			})))
+		})
+
+		It("returns different transports for different configurations", func() {
+			caCertFile := writeTempFile(testCACert)
+			defer os.Remove(caCertFile)
+
+			Expect(shared(Config{SkipSSLValidation: true})).ToNot(BeIdenticalTo(shared(Config{SkipSSLValidation: false})))
+			Expect(shared(Config{MaxIdleConnsPerHost: 1})).ToNot(BeIdenticalTo(shared(Config{MaxIdleConnsPerHost: 2})))
+			Expect(shared(Config{CACertFile: caCertFile})).ToNot(BeIdenticalTo(shared(Config{})))
+		})
+
+		It("returns the error when the CA certificate file cannot be loaded", func() {
+			_, err := Shared(Config{CACertFile: "/does/not/exist.pem"})
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	Describe("NewTLSConfig", func() {
+		It("only uses the system trust store when no CA certificate file is provided", func() {
+			tlsConfig, err := NewTLSConfig(true, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tlsConfig.InsecureSkipVerify).To(BeTrue())
+			Expect(tlsConfig.RootCAs).To(BeNil())
+		})
+
+		It("returns an InvalidCACertificateError when the file has no certificates", func() {
+			caCertFile := writeTempFile("not a certificate")
+			defer os.Remove(caCertFile)
+
+			_, err := NewTLSConfig(false, caCertFile)
+			Expect(err).To(MatchError(InvalidCACertificateError{Path: caCertFile}))
+		})
+	})
+
+	Describe("NewCertPool", func() {
+		It("returns a pool containing the certificates in the file", func() {
+			caCertFile := writeTempFile(testCACert)
+			defer os.Remove(caCertFile)
+
+			pool, err := NewCertPool(caCertFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pool).ToNot(BeNil())
+		})
+
+		It("returns an InvalidCACertificateError when the file has no certificates", func() {
+			caCertFile := writeTempFile("not a certificate")
+			defer os.Remove(caCertFile)
+
+			_, err := NewCertPool(caCertFile)
+			Expect(err).To(MatchError(InvalidCACertificateError{Path: caCertFile}))
+		})
+
+		It("returns the error when the file cannot be read", func() {
+			_, err := NewCertPool("/does/not/exist.pem")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
+
+func writeTempFile(contents string) string {
+	file, err := ioutil.TempFile("", "transport-ca")
+	Expect(err).ToNot(HaveOccurred())
+	_, err = file.WriteString(contents)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(file.Close()).To(Succeed())
+	return file.Name()
+}
+
+// testCACert is a self-signed certificate used only to build cert pools.
+const testCACert = `-----BEGIN CERTIFICATE-----
+MIICCjCCAXOgAwIBAgIUG9w2mLZwy4bozc7cg9xnb9J+Px4wDQYJKoZIhvcNAQEL
+BQAwFjEUMBIGA1UEAwwLY2xpLXRlc3QtY2EwIBcNMjYxMDE3MjAyNzAwWhgPMjEy
+NjA5MjMyMDI3MDBaMBYxFDASBgNVBAMMC2NsaS10ZXN0LWNhMIGfMA0GCSqGSIb3
+DQEBAQUAA4GNADCBiQKBgQDkHkzUJ2/l5mHwpd/LywVvZGCTaDPMBpA4h0BAO5KG
+Inr3WymBxpFUxw75Jb3qp6j25ksnxluw/kDXCIp0vgVyfKKtWC1WgODmRs8sQppZ
+O7q4K/2K9qh/MGF/Sn48uxfcnoyBcHlzfLZqi5V9mp5ZSO8xGAavBq9ExxbvTOBp
+OQIDAQABo1MwUTAdBgNVHQ4EFgQUXUs4x8ox9Ko6QJzlSJTrP3NVBekwHwYDVR0j
+BBgwFoAUXUs4x8ox9Ko6QJzlSJTrP3NVBekwDwYDVR0TAQH/BAUwAwEB/zANBgkq
+hkiG9w0BAQsFAAOBgQA8vYe/Kvow4VzgV0fEh/c/V1xYPgiQCdNZRMogiC819BDC
+LWWiI8Dy/gmafqFvANqIRjbooZt/0jpXXH9j1N4Fsp4qgPJU02RQEjNT5etP3yL4
+iy5yjFGJKWVs8jO29ZWILalPIfiIH5dPLON60kWA6RmpAbQ0y4/n1iOiA3aGYQ==
+-----END CERTIFICATE-----`
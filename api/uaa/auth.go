@@ -1,6 +1,7 @@
 package uaa
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
@@ -8,6 +9,22 @@ import (
 	"code.cloudfoundry.org/cli/api/uaa/internal"
 )
 
+// GrantType is the type of authentication being used to obtain a token.
+type GrantType string
+
+const (
+	// GrantTypeClientCredentials is used to authenticate as a (non-user)
+	// client account.
+	GrantTypeClientCredentials GrantType = "client_credentials"
+	// GrantTypePassword is used to authenticate a user with their username and
+	// password.
+	GrantTypePassword GrantType = "password"
+	// GrantTypePasscode is used to authenticate a user with a one-time
+	// passcode obtained from the login server. UAA treats it as a password
+	// grant.
+	GrantTypePasscode GrantType = "passcode"
+)
+
 // AuthResponse contains the access token and refresh token which are granted
 // after UAA has authorized a user.
 type AuthResponse struct {
@@ -15,13 +32,33 @@ type AuthResponse struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
-// Authenticate sends a username and password to UAA then returns an access
-// token and a refresh token.
-func (client Client) Authenticate(username string, password string) (string, string, error) {
+// Authenticate sends the ID and secret to UAA using the given grant type then
+// returns an access token and a refresh token. For the password grant the ID
+// and secret are the username and password; for the client credentials grant
+// they are the client ID and client secret, and no refresh token is returned.
+// For the passcode grant the secret is the one-time passcode and the ID is
+// ignored.
+//
+// When origin is provided, it is sent as a login hint so that UAA
+// authenticates the user against that identity provider.
+func (client Client) Authenticate(ID string, secret string, origin string, grantType GrantType) (string, string, error) {
 	requestBody := url.Values{}
-	requestBody.Set("username", username)
-	requestBody.Set("password", password)
-	requestBody.Set("grant_type", "password")
+	requestBody.Set("grant_type", string(grantType))
+	switch grantType {
+	case GrantTypeClientCredentials:
+		requestBody.Set("client_id", ID)
+		requestBody.Set("client_secret", secret)
+	case GrantTypePassword:
+		requestBody.Set("username", ID)
+		requestBody.Set("password", secret)
+	case GrantTypePasscode:
+		requestBody.Set("grant_type", string(GrantTypePassword))
+		requestBody.Set("passcode", secret)
+	}
+
+	if origin != "" {
+		requestBody.Set("login_hint", fmt.Sprintf(`{"origin":"%s"}`, origin))
+	}
 
 	request, err := client.newRequest(requestOptions{
 		RequestName: internal.PostOAuthTokenRequest,
@@ -33,7 +70,9 @@ func (client Client) Authenticate(username string, password string) (string, str
 	if err != nil {
 		return "", "", err
 	}
-	request.SetBasicAuth(client.id, client.secret)
+	if grantType == GrantTypePassword || grantType == GrantTypePasscode {
+		request.SetBasicAuth(client.id, client.secret)
+	}
 
 	responseBody := AuthResponse{}
 	response := Response{
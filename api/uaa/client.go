@@ -15,8 +15,10 @@ import (
 
 // Client is the UAA client
 type Client struct {
-	id     string
-	secret string
+	id        string
+	secret    string
+	grantType GrantType
+	loginLink string
 
 	connection Connection
 	router     *internal.Router
@@ -31,6 +33,11 @@ type Config struct {
 	// AppVersion is the version of the application/process using the client.
 	AppVersion string
 
+	// CACertFile is the path to a PEM-encoded bundle of CA certificates that
+	// are trusted, along with the system trust store, when verifying the
+	// server's certificate chain.
+	CACertFile string
+
 	// DialTimeout is the DNS lookup timeout for the client. If not set, it is
 	// infinite.
 	DialTimeout time.Duration
@@ -41,6 +48,15 @@ type Config struct {
 	// ClientSecret is the UAA client secret the client will use.
 	ClientSecret string
 
+	// GrantType is the grant type that was used to authenticate. When it is
+	// GrantTypeClientCredentials, access tokens are refreshed by requesting a
+	// new token with the client ID and secret instead of a refresh token.
+	GrantType GrantType
+
+	// MaxIdleConnsPerHost is the number of idle keep-alive connections kept
+	// per host.
+	MaxIdleConnsPerHost int
+
 	// SkipSSLValidation controls whether a client verifies the server's
 	// certificate chain and host name. If SkipSSLValidation is true, TLS accepts
 	// any certificate presented by the server and any host name in that
@@ -49,6 +65,9 @@ type Config struct {
 	// In this mode, TLS is susceptible to man-in-the-middle attacks. This should
 	// be used only for testing.
 	SkipSSLValidation bool
+
+	// TLSHandshakeTimeout is the timeout for the TLS handshake.
+	TLSHandshakeTimeout time.Duration
 }
 
 // NewClient returns a new UAA Client with the provided configuration
@@ -62,11 +81,18 @@ func NewClient(config Config) *Client {
 	)
 
 	client := Client{
-		id:     config.ClientID,
-		secret: config.ClientSecret,
+		id:        config.ClientID,
+		secret:    config.ClientSecret,
+		grantType: config.GrantType,
 
-		connection: NewConnection(config.SkipSSLValidation, config.DialTimeout),
-		userAgent:  userAgent,
+		connection: NewConnection(ConnectionConfig{
+			CACertFile:          config.CACertFile,
+			DialTimeout:         config.DialTimeout,
+			MaxIdleConnsPerHost: config.MaxIdleConnsPerHost,
+			SkipSSLValidation:   config.SkipSSLValidation,
+			TLSHandshakeTimeout: config.TLSHandshakeTimeout,
+		}),
+		userAgent: userAgent,
 	}
 	client.WrapConnection(NewErrorWrapper())
 
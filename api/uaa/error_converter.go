@@ -3,6 +3,7 @@ package uaa
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 )
 
 // errorWrapper is the wrapper that converts responses with 4xx and 5xx status
@@ -48,12 +49,18 @@ func convert(rawHTTPStatusErr RawHTTPStatusError) error {
 		if uaaErrorResponse.Type == "invalid_scim_resource" {
 			return InvalidSCIMResourceError{Message: uaaErrorResponse.Description}
 		}
+		if uaaErrorResponse.Type == "invalid_password" {
+			return InvalidPasswordError{Message: uaaErrorResponse.Description}
+		}
 		return rawHTTPStatusErr
 	case http.StatusUnauthorized: // 401
 		if uaaErrorResponse.Type == "invalid_token" {
 			return InvalidAuthTokenError{Message: uaaErrorResponse.Description}
 		}
 		if uaaErrorResponse.Type == "unauthorized" {
+			if strings.HasPrefix(uaaErrorResponse.Description, "The origin provided in the login_hint") {
+				return InvalidOriginError{Message: uaaErrorResponse.Description}
+			}
 			return BadCredentialsError{Message: uaaErrorResponse.Description}
 		}
 		return rawHTTPStatusErr
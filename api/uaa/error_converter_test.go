@@ -83,6 +83,22 @@ var _ = Describe("Error Wrapper", func() {
 						Expect(makeErr).To(MatchError(InvalidSCIMResourceError{Message: "A username must be provided"}))
 					})
 				})
+
+				Context("invalid password", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{
+  "error": "invalid_password",
+  "error_description": "Password must be at least 8 characters in length."
+}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns an InvalidPasswordError", func() {
+						Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+						Expect(makeErr).To(MatchError(InvalidPasswordError{Message: "Password must be at least 8 characters in length."}))
+					})
+				})
 			})
 
 			Context("(401) Unauthorized", func() {
@@ -134,6 +150,20 @@ var _ = Describe("Error Wrapper", func() {
 						Expect(makeErr).To(MatchError(BadCredentialsError{Message: "Bad credentials"}))
 					})
 				})
+
+				Context("unauthorized because of an invalid origin", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{
+  "error": "unauthorized",
+  "error_description": "The origin provided in the login_hint does not match an active Identity Provider, that supports password grant."
+}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns an InvalidOriginError", func() {
+						Expect(makeErr).To(MatchError(InvalidOriginError{Message: "The origin provided in the login_hint does not match an active Identity Provider, that supports password grant."}))
+					})
+				})
 			})
 
 			Context("(403) Forbidden", func() {
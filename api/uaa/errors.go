@@ -61,6 +61,16 @@ func (e BadCredentialsError) Error() string {
 	return e.Message
 }
 
+// InvalidOriginError is returned when the origin provided in the login hint
+// does not match an identity provider that supports the password grant.
+type InvalidOriginError struct {
+	Message string
+}
+
+func (e InvalidOriginError) Error() string {
+	return e.Message
+}
+
 // InvalidAuthTokenError is returned when the client has an invalid
 // authorization header.
 type InvalidAuthTokenError struct {
@@ -88,3 +98,22 @@ type InvalidSCIMResourceError struct {
 func (e InvalidSCIMResourceError) Error() string {
 	return e.Message
 }
+
+// InvalidPasswordError is returned when the password provided for a new user
+// violates the UAA password policy.
+type InvalidPasswordError struct {
+	Message string
+}
+
+func (e InvalidPasswordError) Error() string {
+	return e.Message
+}
+
+// RequestCancelledError is returned when a request is interrupted, or never
+// sent, because its context was cancelled.
+type RequestCancelledError struct {
+}
+
+func (RequestCancelledError) Error() string {
+	return "request cancelled"
+}
@@ -5,7 +5,10 @@ import (
 )
 
 const (
+	DeleteUserRequest     = "DeleteUser"
 	GetSSHPasscodeRequest = "GetSSHPasscode"
+	GetUsersRequest       = "GetUsers"
+	PostIntrospectRequest = "PostIntrospect"
 	PostOAuthTokenRequest = "PostOAuthToken"
 	PostUserRequest       = "PostUser"
 )
@@ -17,7 +20,10 @@ const (
 
 // APIRoutes is a list of routes used by the router to construct request URLs.
 var APIRoutes = []Route{
+	{Path: "/Users", Method: http.MethodGet, Name: GetUsersRequest, Resource: UAAResource},
 	{Path: "/Users", Method: http.MethodPost, Name: PostUserRequest, Resource: UAAResource},
+	{Path: "/Users/:user_guid", Method: http.MethodDelete, Name: DeleteUserRequest, Resource: UAAResource},
+	{Path: "/introspect", Method: http.MethodPost, Name: PostIntrospectRequest, Resource: UAAResource},
 	{Path: "/oauth/authorize", Method: http.MethodGet, Name: GetSSHPasscodeRequest, Resource: UAAResource},
 	{Path: "/oauth/token", Method: http.MethodPost, Name: PostOAuthTokenRequest, Resource: AuthorizationResource},
 }
@@ -21,14 +21,26 @@ func (refreshTokenResponse RefreshedTokens) AuthorizationToken() string {
 	return fmt.Sprintf("%s %s", refreshTokenResponse.Type, refreshTokenResponse.AccessToken)
 }
 
-// RefreshAccessToken refreshes the current access token.
+// RefreshAccessToken refreshes the current access token. Clients that
+// authenticated with the client credentials grant have no refresh token, so a
+// new token is requested with the client credentials instead.
 func (client *Client) RefreshAccessToken(refreshToken string) (RefreshedTokens, error) {
-	body := strings.NewReader(url.Values{
-		"client_id":     {client.id},
-		"client_secret": {client.secret},
-		"grant_type":    {"refresh_token"},
-		"refresh_token": {refreshToken},
-	}.Encode())
+	var values url.Values
+	if client.grantType == GrantTypeClientCredentials {
+		values = url.Values{
+			"client_id":     {client.id},
+			"client_secret": {client.secret},
+			"grant_type":    {string(GrantTypeClientCredentials)},
+		}
+	} else {
+		values = url.Values{
+			"client_id":     {client.id},
+			"client_secret": {client.secret},
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {refreshToken},
+		}
+	}
+	body := strings.NewReader(values.Encode())
 
 	request, err := client.newRequest(requestOptions{
 		RequestName: internal.PostOAuthTokenRequest,
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	. "code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -61,5 +62,43 @@ var _ = Describe("UAA Client", func() {
 
 			Expect(server.ReceivedRequests()).To(HaveLen(2))
 		})
+
+		Context("when the client authenticated with client credentials", func() {
+			BeforeEach(func() {
+				server.Reset()
+
+				client = NewClient(Config{
+					AppName:           "CF CLI UAA API Test",
+					AppVersion:        "Unknown",
+					ClientID:          "client-id",
+					ClientSecret:      "client-secret",
+					GrantType:         GrantTypeClientCredentials,
+					SkipSSLValidation: true,
+				})
+				SetupBootstrapResponse()
+				err := client.SetupResources(new(uaafakes.FakeUAAEndpointStore), server.URL())
+				Expect(err).ToNot(HaveOccurred())
+
+				server.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestAuthorizationResource),
+						VerifyRequest(http.MethodPost, "/oauth/token"),
+						VerifyBody([]byte("client_id=client-id&client_secret=client-secret&grant_type=client_credentials")),
+						RespondWith(http.StatusOK, fmt.Sprintf(`{
+							"access_token": "%s",
+							"token_type": "bearer"
+						}`, returnedAccessToken)),
+					))
+			})
+
+			It("requests a new token with the client credentials", func() {
+				token, err := client.RefreshAccessToken("")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(token).To(Equal(RefreshedTokens{
+					AccessToken: returnedAccessToken,
+					Type:        "bearer",
+				}))
+			})
+		})
 	})
 })
@@ -36,7 +36,8 @@ type SetupSettings struct {
 // AuthInfo represents a GET response from a login server
 type AuthInfo struct {
 	Links struct {
-		UAA string `json:"uaa"`
+		UAA   string `json:"uaa"`
+		Login string `json:"login"`
 	} `json:"links"`
 }
 
@@ -67,6 +68,11 @@ func (client *Client) SetupResources(store UAAEndpointStore, bootstrapURL string
 	}
 	store.SetUAAEndpoint(UAALink)
 
+	client.loginLink = info.Links.Login
+	if client.loginLink == "" {
+		client.loginLink = bootstrapURL
+	}
+
 	resources := map[string]string{
 		"uaa": UAALink,
 		"authorization_endpoint": bootstrapURL,
@@ -76,3 +82,9 @@ func (client *Client) SetupResources(store UAAEndpointStore, bootstrapURL string
 
 	return nil
 }
+
+// LoginLink returns the URL of the login server discovered by SetupResources.
+// One-time passcodes are obtained from its /passcode page.
+func (client Client) LoginLink() string {
+	return client.loginLink
+}
@@ -53,7 +53,8 @@ var _ = Describe("SetupResources", func() {
 			BeforeEach(func() {
 				response := `{
 				"links": {
-					"uaa": "https://uaa.bosh-lite.com"
+					"uaa": "https://uaa.bosh-lite.com",
+					"login": "https://login.bosh-lite.com"
 				}
 			}`
 
@@ -70,6 +71,10 @@ var _ = Describe("SetupResources", func() {
 				Expect(fakeStore.SetUAAEndpointCallCount()).To(Equal(1))
 				Expect(fakeStore.SetUAAEndpointArgsForCall(0)).To(Equal("https://uaa.bosh-lite.com"))
 			})
+
+			It("sets the login link", func() {
+				Expect(client.LoginLink()).To(Equal("https://login.bosh-lite.com"))
+			})
 		})
 
 		Context("when the UAA field is not populated", func() {
@@ -91,6 +96,10 @@ var _ = Describe("SetupResources", func() {
 				Expect(fakeStore.SetUAAEndpointCallCount()).To(Equal(1))
 				Expect(fakeStore.SetUAAEndpointArgsForCall(0)).To(Equal(server.URL()))
 			})
+
+			It("sets the login link to the bootstrap endpoint", func() {
+				Expect(client.LoginLink()).To(Equal(server.URL()))
+			})
 		})
 	})
 })
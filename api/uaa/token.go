@@ -0,0 +1,146 @@
+package uaa
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// MalformedTokenError is returned when a token is not a well-formed JWT.
+type MalformedTokenError struct {
+	Message string
+}
+
+func (e MalformedTokenError) Error() string {
+	return fmt.Sprintf("malformed token: %s", e.Message)
+}
+
+// TokenClaims represents the claims carried by a UAA access token.
+type TokenClaims struct {
+	// Active is whether UAA considers the token valid. It is only set by
+	// IntrospectToken.
+	Active bool
+
+	// ClientID is the OAuth client the token was issued to.
+	ClientID string
+
+	// ExpiresAt is when the token expires.
+	ExpiresAt time.Time
+
+	// IssuedAt is when the token was issued.
+	IssuedAt time.Time
+
+	// Issuer is the URL of the UAA that issued the token.
+	Issuer string
+
+	// Scopes are the scopes granted to the token.
+	Scopes []string
+
+	// UserID is the GUID of the user the token was issued for. It is empty for
+	// client credentials tokens.
+	UserID string
+
+	// UserName is the name of the user the token was issued for. It is empty
+	// for client credentials tokens.
+	UserName string
+}
+
+// UnmarshalJSON helps unmarshal the claims of a JWT or an introspection
+// response.
+func (claims *TokenClaims) UnmarshalJSON(data []byte) error {
+	var uaaClaims struct {
+		Active    bool     `json:"active"`
+		ClientID  string   `json:"client_id"`
+		ExpiresAt int64    `json:"exp"`
+		IssuedAt  int64    `json:"iat"`
+		Issuer    string   `json:"iss"`
+		Scopes    []string `json:"scope"`
+		UserID    string   `json:"user_id"`
+		UserName  string   `json:"user_name"`
+	}
+	err := json.Unmarshal(data, &uaaClaims)
+	if err != nil {
+		return err
+	}
+
+	claims.Active = uaaClaims.Active
+	claims.ClientID = uaaClaims.ClientID
+	claims.Issuer = uaaClaims.Issuer
+	claims.Scopes = uaaClaims.Scopes
+	claims.UserID = uaaClaims.UserID
+	claims.UserName = uaaClaims.UserName
+	if uaaClaims.ExpiresAt != 0 {
+		claims.ExpiresAt = time.Unix(uaaClaims.ExpiresAt, 0)
+	}
+	if uaaClaims.IssuedAt != 0 {
+		claims.IssuedAt = time.Unix(uaaClaims.IssuedAt, 0)
+	}
+
+	return nil
+}
+
+// DecodeToken returns the claims of the access token without contacting UAA.
+// The token's signature is not verified. A leading token type, such as
+// "bearer", is ignored.
+func DecodeToken(accessToken string) (TokenClaims, error) {
+	segments := strings.Split(rawToken(accessToken), ".")
+	if len(segments) != 3 {
+		return TokenClaims{}, MalformedTokenError{Message: "expected 3 segments"}
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[1], "="))
+	if err != nil {
+		return TokenClaims{}, MalformedTokenError{Message: err.Error()}
+	}
+
+	var claims TokenClaims
+	err = json.Unmarshal(payload, &claims)
+	if err != nil {
+		return TokenClaims{}, MalformedTokenError{Message: err.Error()}
+	}
+
+	return claims, nil
+}
+
+// IntrospectToken asks UAA whether the access token is active and returns
+// the claims UAA reports for it. UAA only answers callers that have the
+// uaa.resource scope.
+func (client *Client) IntrospectToken(accessToken string) (TokenClaims, error) {
+	values := url.Values{
+		"token": {rawToken(accessToken)},
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostIntrospectRequest,
+		Header:      http.Header{"Content-Type": {"application/x-www-form-urlencoded"}},
+		Body:        strings.NewReader(values.Encode()),
+	})
+	if err != nil {
+		return TokenClaims{}, err
+	}
+
+	var claims TokenClaims
+	response := Response{
+		Result: &claims,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return TokenClaims{}, err
+	}
+
+	return claims, nil
+}
+
+func rawToken(accessToken string) string {
+	if i := strings.Index(accessToken, " "); i != -1 {
+		return accessToken[i+1:]
+	}
+	return accessToken
+}
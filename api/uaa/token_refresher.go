@@ -0,0 +1,107 @@
+package uaa
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+//go:generate counterfeiter . TokenRefreshClient
+
+// TokenRefreshClient is the interface for exchanging a refresh token for new
+// access and refresh tokens.
+type TokenRefreshClient interface {
+	RefreshAccessToken(refreshToken string) (RefreshedTokens, error)
+}
+
+//go:generate counterfeiter . TokenCache
+
+// TokenCache is where the UAA token information is stored.
+type TokenCache interface {
+	AccessToken() string
+	RefreshToken() string
+	SetAccessToken(token string)
+	SetRefreshToken(token string)
+}
+
+// TokenRefresher refreshes the tokens in a token cache. Every client that
+// authenticates with the same cache should share one TokenRefresher so that
+// concurrent requests failing with an expired token trigger a single refresh.
+type TokenRefresher struct {
+	client TokenRefreshClient
+	cache  TokenCache
+
+	// tokenLock guards the token cache, and refreshCount, which is
+	// incremented after every refresh, lets callers that waited on the lock
+	// detect that the token was refreshed for them.
+	tokenLock    sync.RWMutex
+	refreshCount uint64
+}
+
+// NewTokenRefresher returns a pointer to a TokenRefresher using the client
+// and token cache.
+func NewTokenRefresher(client TokenRefreshClient, cache TokenCache) *TokenRefresher {
+	return &TokenRefresher{
+		client: client,
+		cache:  cache,
+	}
+}
+
+// SetClient sets the client that the refresher will use.
+func (t *TokenRefresher) SetClient(client TokenRefreshClient) {
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+	t.client = client
+}
+
+// AccessToken returns the access token currently in the cache.
+func (t *TokenRefresher) AccessToken() string {
+	t.tokenLock.RLock()
+	defer t.tokenLock.RUnlock()
+	return t.cache.AccessToken()
+}
+
+// RefreshStaleToken refreshes the tokens in the cache and returns the new
+// access token. If another caller already replaced staleToken while this one
+// was waiting, the new access token is reused instead of refreshing again.
+func (t *TokenRefresher) RefreshStaleToken(staleToken string) (string, error) {
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+
+	if accessToken := t.cache.AccessToken(); accessToken != staleToken {
+		return accessToken, nil
+	}
+
+	return t.refresh()
+}
+
+// RefreshAuthToken refreshes the current Authorization Token and stores the
+// Access and Refresh token in it's cache. The returned Authorization Token
+// includes the type prefixed by a space. It satisfies the noaa consumer's
+// TokenRefresher interface.
+//
+// When several callers ask for a refresh at the same time, only the first one
+// refreshes the token; the others return the token it obtained.
+func (t *TokenRefresher) RefreshAuthToken() (string, error) {
+	refreshCount := atomic.LoadUint64(&t.refreshCount)
+
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+
+	if atomic.LoadUint64(&t.refreshCount) != refreshCount {
+		return t.cache.AccessToken(), nil
+	}
+
+	return t.refresh()
+}
+
+func (t *TokenRefresher) refresh() (string, error) {
+	tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return "", err
+	}
+
+	t.cache.SetAccessToken(tokens.AuthorizationToken())
+	t.cache.SetRefreshToken(tokens.RefreshToken)
+	atomic.AddUint64(&t.refreshCount, 1)
+	return tokens.AuthorizationToken(), nil
+}
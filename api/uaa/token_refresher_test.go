@@ -0,0 +1,155 @@
+package uaa_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TokenRefresher", func() {
+	var (
+		fakeClient     *uaafakes.FakeTokenRefreshClient
+		fakeTokenCache *uaafakes.FakeTokenCache
+		tokenRefresher *TokenRefresher
+	)
+
+	BeforeEach(func() {
+		fakeClient = new(uaafakes.FakeTokenRefreshClient)
+		fakeTokenCache = new(uaafakes.FakeTokenCache)
+		tokenRefresher = NewTokenRefresher(fakeClient, fakeTokenCache)
+	})
+
+	Describe("RefreshStaleToken", func() {
+		BeforeEach(func() {
+			fakeTokenCache.AccessTokenReturns("bearer stale-access-token")
+			fakeTokenCache.RefreshTokenReturns("old-refresh-token")
+			fakeClient.RefreshAccessTokenReturns(RefreshedTokens{
+				AccessToken:  "some-access-token",
+				RefreshToken: "some-refresh-token",
+				Type:         "bearer",
+			}, nil)
+		})
+
+		Context("when the cached token is the stale token", func() {
+			It("refreshes and stores the tokens", func() {
+				token, err := tokenRefresher.RefreshStaleToken("bearer stale-access-token")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(token).To(Equal("bearer some-access-token"))
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeClient.RefreshAccessTokenArgsForCall(0)).To(Equal("old-refresh-token"))
+				Expect(fakeTokenCache.SetAccessTokenArgsForCall(0)).To(Equal("bearer some-access-token"))
+				Expect(fakeTokenCache.SetRefreshTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+			})
+		})
+
+		Context("when the cached token has already been refreshed", func() {
+			It("returns the cached token without refreshing", func() {
+				token, err := tokenRefresher.RefreshStaleToken("bearer older-access-token")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(token).To(Equal("bearer stale-access-token"))
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+				Expect(fakeTokenCache.SetAccessTokenCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RefreshAuthToken", func() {
+
+		Context("when UAA communication is successful", func() {
+			BeforeEach(func() {
+				fakeTokenCache.RefreshTokenReturns("old-refresh-token")
+
+				refreshToken := RefreshedTokens{
+					AccessToken:  "some-access-token",
+					RefreshToken: "some-refresh-token",
+					Type:         "bearer",
+				}
+				fakeClient.RefreshAccessTokenReturns(refreshToken, nil)
+			})
+
+			It("refreshes the token", func() {
+				token, err := tokenRefresher.RefreshAuthToken()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(token).To(Equal("bearer some-access-token"))
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeClient.RefreshAccessTokenArgsForCall(0)).To(Equal("old-refresh-token"))
+			})
+
+			It("stores the new access and refresh tokens", func() {
+				_, err := tokenRefresher.RefreshAuthToken()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeTokenCache.SetAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeTokenCache.SetAccessTokenArgsForCall(0)).To(Equal("bearer some-access-token"))
+				Expect(fakeTokenCache.SetRefreshTokenCallCount()).To(Equal(1))
+				Expect(fakeTokenCache.SetRefreshTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+			})
+		})
+
+		Context("when the token is refreshed concurrently", func() {
+			var releaseRefresh chan bool
+
+			BeforeEach(func() {
+				fakeTokenCache.AccessTokenReturns("bearer new-access-token")
+
+				releaseRefresh = make(chan bool)
+				fakeClient.RefreshAccessTokenStub = func(string) (RefreshedTokens, error) {
+					<-releaseRefresh
+					return RefreshedTokens{
+						AccessToken:  "new-access-token",
+						RefreshToken: "new-refresh-token",
+						Type:         "bearer",
+					}, nil
+				}
+			})
+
+			It("refreshes the token once and returns the new token to every waiting caller", func() {
+				firstToken := make(chan string)
+				go func() {
+					defer GinkgoRecover()
+					token, err := tokenRefresher.RefreshAuthToken()
+					Expect(err).ToNot(HaveOccurred())
+					firstToken <- token
+				}()
+				Eventually(fakeClient.RefreshAccessTokenCallCount).Should(Equal(1))
+
+				secondToken := make(chan string)
+				go func() {
+					defer GinkgoRecover()
+					token, err := tokenRefresher.RefreshAuthToken()
+					Expect(err).ToNot(HaveOccurred())
+					secondToken <- token
+				}()
+				Consistently(secondToken, "50ms").ShouldNot(Receive())
+
+				close(releaseRefresh)
+				Eventually(firstToken).Should(Receive(Equal("bearer new-access-token")))
+				Eventually(secondToken).Should(Receive(Equal("bearer new-access-token")))
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeTokenCache.SetAccessTokenCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when UAA communication returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("it's not working!!!!")
+				fakeClient.RefreshAccessTokenReturns(RefreshedTokens{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				_, err := tokenRefresher.RefreshAuthToken()
+				Expect(err).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
@@ -2,32 +2,44 @@ package uaa
 
 import (
 	"bytes"
-	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"io/ioutil"
-	"net"
 	"net/http"
 	"net/url"
 	"time"
+
+	"code.cloudfoundry.org/cli/api/transport"
 )
 
 // UAAConnection represents the connection to UAA
 type UAAConnection struct {
 	HTTPClient *http.Client
+
+	transportErr error
+}
+
+// ConnectionConfig is for configuring a UAAConnection.
+type ConnectionConfig struct {
+	CACertFile          string
+	DialTimeout         time.Duration
+	MaxIdleConnsPerHost int
+	SkipSSLValidation   bool
+	TLSHandshakeTimeout time.Duration
 }
 
 // NewConnection returns a pointer to a new UAA Connection
-func NewConnection(skipSSLValidation bool, dialTimeout time.Duration) *UAAConnection {
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: skipSSLValidation,
-		},
-		Proxy: http.ProxyFromEnvironment,
-		DialContext: (&net.Dialer{
-			KeepAlive: 30 * time.Second,
-			Timeout:   dialTimeout,
-		}).DialContext,
+func NewConnection(config ConnectionConfig) *UAAConnection {
+	tr, err := transport.Shared(transport.Config{
+		CACertFile:          config.CACertFile,
+		DialTimeout:         config.DialTimeout,
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost,
+		SkipSSLValidation:   config.SkipSSLValidation,
+		TLSHandshakeTimeout: config.TLSHandshakeTimeout,
+	})
+
+	if err != nil {
+		return &UAAConnection{transportErr: err}
 	}
 
 	return &UAAConnection{
@@ -51,6 +63,10 @@ func (connection *UAAConnection) Make(request *http.Request, passedResponse *Res
 	// error and we don't repopulate it in populateResponse.
 	passedResponse.reset()
 
+	if connection.transportErr != nil {
+		return connection.transportErr
+	}
+
 	response, err := connection.HTTPClient.Do(request)
 	if err != nil {
 		return connection.processRequestErrors(request, err)
@@ -2,8 +2,11 @@ package uaa_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
 
+	"code.cloudfoundry.org/cli/api/transport"
 	. "code.cloudfoundry.org/cli/api/uaa"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -22,10 +25,41 @@ var _ = Describe("UAA Connection", func() {
 	)
 
 	BeforeEach(func() {
-		connection = NewConnection(true, 0)
+		connection = NewConnection(ConnectionConfig{SkipSSLValidation: true})
 	})
 
 	Describe("Make", func() {
+		Context("when the CA certificate file is invalid", func() {
+			var caCertFile string
+
+			BeforeEach(func() {
+				file, err := ioutil.TempFile("", "uaa-connection-ca")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(file.Close()).To(Succeed())
+				caCertFile = file.Name()
+
+				connection = NewConnection(ConnectionConfig{CACertFile: caCertFile})
+
+				request, err = http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(os.Remove(caCertFile)).To(Succeed())
+			})
+
+			It("does not configure an HTTP client", func() {
+				Expect(connection.HTTPClient).To(BeNil())
+			})
+
+			It("returns an InvalidCACertificateError without making the request", func() {
+				var response Response
+				err := connection.Make(request, &response)
+				Expect(err).To(MatchError(transport.InvalidCACertificateError{Path: caCertFile}))
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+
 		Describe("Data Unmarshalling", func() {
 			BeforeEach(func() {
 				response := `{
@@ -99,7 +133,7 @@ var _ = Describe("UAA Connection", func() {
 		Describe("Errors", func() {
 			Context("when the server does not exist", func() {
 				BeforeEach(func() {
-					connection = NewConnection(false, 0)
+					connection = NewConnection(ConnectionConfig{})
 				})
 
 				It("returns a RequestError", func() {
@@ -125,7 +159,7 @@ var _ = Describe("UAA Connection", func() {
 							),
 						)
 
-						connection = NewConnection(false, 0)
+						connection = NewConnection(ConnectionConfig{})
 					})
 
 					It("returns a UnverifiedServerError", func() {
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"code.cloudfoundry.org/cli/api/transport"
 	. "code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
 	. "github.com/onsi/ginkgo"
@@ -55,6 +56,7 @@ var _ = SynchronizedAfterSuite(func() {
 
 var _ = BeforeEach(func() {
 	server.Reset()
+	transport.CloseIdleConnections()
 })
 
 func NewTestUAAClientAndStore() *Client {
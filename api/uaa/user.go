@@ -3,14 +3,17 @@ package uaa
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
 
 	"code.cloudfoundry.org/cli/api/uaa/internal"
 )
 
 // User represents an UAA user account.
 type User struct {
-	ID string
+	ID     string
+	Origin string
 }
 
 // newUserRequestBody represents the body of the request.
@@ -37,6 +40,14 @@ type newUserResponse struct {
 	ID string `json:"id"`
 }
 
+// listUsersResponse represents the HTTP JSON response.
+type listUsersResponse struct {
+	Resources []struct {
+		ID     string `json:"id"`
+		Origin string `json:"origin"`
+	} `json:"resources"`
+}
+
 // CreateUser creates a new UAA user account with the provided password.
 func (client *Client) CreateUser(user string, password string, origin string) (User, error) {
 	userRequest := newUserRequestBody{
@@ -83,3 +94,52 @@ func (client *Client) CreateUser(user string, password string, origin string) (U
 
 	return User{ID: userResponse.ID}, nil
 }
+
+// DeleteUser deletes the UAA user account with the provided GUID.
+func (client *Client) DeleteUser(userGUID string) error {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.DeleteUserRequest,
+		URIParams:   internal.Params{"user_guid": userGUID},
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.connection.Make(request, &Response{})
+}
+
+// ListUsers returns the UAA user accounts matching the provided username. If
+// origin is provided, only users from that identity provider are returned.
+func (client *Client) ListUsers(userName string, origin string) ([]User, error) {
+	filter := fmt.Sprintf(`userName Eq "%s"`, userName)
+	if origin != "" {
+		filter = fmt.Sprintf(`%s and origin Eq "%s"`, filter, origin)
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetUsersRequest,
+		Query: url.Values{
+			"attributes": {"id,origin"},
+			"filter":     {filter},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var usersResponse listUsersResponse
+	response := Response{
+		Result: &usersResponse,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []User
+	for _, resource := range usersResponse.Resources {
+		users = append(users, User{ID: resource.ID, Origin: resource.Origin})
+	}
+	return users, nil
+}
@@ -2,6 +2,7 @@ package uaa_test
 
 import (
 	"net/http"
+	"net/url"
 
 	. "code.cloudfoundry.org/cli/api/uaa"
 	. "github.com/onsi/ginkgo"
@@ -92,5 +93,165 @@ var _ = Describe("User", func() {
 				}))
 			})
 		})
+
+		Context("when the password violates the password policy", func() {
+			BeforeEach(func() {
+				response := `{
+					"error": "invalid_password",
+					"error_description": "Password must contain at least 1 special characters."
+				}`
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodPost, "/Users"),
+						RespondWith(http.StatusBadRequest, response),
+					))
+			})
+
+			It("returns an InvalidPasswordError", func() {
+				_, err := client.CreateUser("new-user", "new-password", "")
+				Expect(err).To(MatchError(InvalidPasswordError{Message: "Password must contain at least 1 special characters."}))
+			})
+		})
+	})
+
+	Describe("DeleteUser", func() {
+		Context("when no errors occur", func() {
+			BeforeEach(func() {
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodDelete, "/Users/some-user-guid"),
+						RespondWith(http.StatusOK, `{"id": "some-user-guid"}`),
+					))
+			})
+
+			It("deletes the user", func() {
+				err := client.DeleteUser("some-user-guid")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when an error occurs", func() {
+			var response string
+
+			BeforeEach(func() {
+				response = `{
+					"error": "some-error",
+					"error_description": "some-description"
+				}`
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodDelete, "/Users/some-user-guid"),
+						RespondWith(http.StatusTeapot, response),
+					))
+			})
+
+			It("returns the error", func() {
+				err := client.DeleteUser("some-user-guid")
+				Expect(err).To(MatchError(RawHTTPStatusError{
+					StatusCode:  http.StatusTeapot,
+					RawResponse: []byte(response),
+				}))
+			})
+		})
+	})
+
+	Describe("ListUsers", func() {
+		var (
+			origin string
+
+			users      []User
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			origin = ""
+		})
+
+		JustBeforeEach(func() {
+			users, executeErr = client.ListUsers("some-user", origin)
+		})
+
+		Context("when no errors occur", func() {
+			BeforeEach(func() {
+				response := `{
+					"resources": [
+						{ "id": "some-user-guid-1", "origin": "uaa" },
+						{ "id": "some-user-guid-2", "origin": "ldap" }
+					]
+				}`
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodGet, "/Users"),
+						VerifyForm(url.Values{
+							"attributes": {"id,origin"},
+							"filter":     {`userName Eq "some-user"`},
+						}),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("returns the users matching the username", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(users).To(ConsistOf(
+					User{ID: "some-user-guid-1", Origin: "uaa"},
+					User{ID: "some-user-guid-2", Origin: "ldap"},
+				))
+			})
+		})
+
+		Context("when an origin is provided", func() {
+			BeforeEach(func() {
+				origin = "ldap"
+
+				response := `{
+					"resources": [
+						{ "id": "some-user-guid-2", "origin": "ldap" }
+					]
+				}`
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodGet, "/Users"),
+						VerifyForm(url.Values{
+							"attributes": {"id,origin"},
+							"filter":     {`userName Eq "some-user" and origin Eq "ldap"`},
+						}),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("filters the users by origin", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(users).To(ConsistOf(User{ID: "some-user-guid-2", Origin: "ldap"}))
+			})
+		})
+
+		Context("when an error occurs", func() {
+			var response string
+
+			BeforeEach(func() {
+				response = `{
+					"error": "some-error",
+					"error_description": "some-description"
+				}`
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodGet, "/Users"),
+						RespondWith(http.StatusTeapot, response),
+					))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(RawHTTPStatusError{
+					StatusCode:  http.StatusTeapot,
+					RawResponse: []byte(response),
+				}))
+			})
+		})
 	})
 })
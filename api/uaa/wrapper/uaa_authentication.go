@@ -31,15 +31,22 @@ type TokenCache interface {
 type UAAAuthentication struct {
 	connection uaa.Connection
 	client     UAAClient
-	cache      TokenCache
+	refresher  *uaa.TokenRefresher
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
 // the client and token cache.
 func NewUAAAuthentication(client UAAClient, cache TokenCache) *UAAAuthentication {
+	return NewUAAAuthenticationWithRefresher(client, uaa.NewTokenRefresher(client, cache))
+}
+
+// NewUAAAuthenticationWithRefresher returns a pointer to a UAAAuthentication
+// wrapper with the client and a token refresher that may be shared with other
+// wrappers using the same token cache.
+func NewUAAAuthenticationWithRefresher(client UAAClient, refresher *uaa.TokenRefresher) *UAAAuthentication {
 	return &UAAAuthentication{
-		client: client,
-		cache:  cache,
+		client:    client,
+		refresher: refresher,
 	}
 }
 
@@ -49,9 +56,11 @@ func (t *UAAAuthentication) Wrap(innerconnection uaa.Connection) uaa.Connection
 	return t
 }
 
-// SetClient sets the UAA client that the wrapper will use.
+// SetClient sets the UAA client that the wrapper and its token refresher will
+// use.
 func (t *UAAAuthentication) SetClient(client UAAClient) {
 	t.client = client
+	t.refresher.SetClient(client)
 }
 
 // Make adds authentication headers to the passed in request and then calls the
@@ -78,22 +87,20 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Resp
 		}
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	accessToken := t.refresher.AccessToken()
+	request.Header.Set("Authorization", accessToken)
 
 	err = t.connection.Make(request, passedResponse)
 	if _, ok := err.(uaa.InvalidAuthTokenError); ok {
-		tokens, refreshErr := t.client.RefreshAccessToken(t.cache.RefreshToken())
+		accessToken, refreshErr := t.refresher.RefreshStaleToken(accessToken)
 		if refreshErr != nil {
 			return refreshErr
 		}
 
-		t.cache.SetAccessToken(tokens.AuthorizationToken())
-		t.cache.SetRefreshToken(tokens.RefreshToken)
-
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", accessToken)
 		return t.connection.Make(request, passedResponse)
 	}
 
@@ -108,5 +115,6 @@ func skipAuthenticationHeader(request *http.Request, body []byte) bool {
 	return strings.Contains(request.URL.String(), "/oauth/token") &&
 		request.Method == http.MethodPost &&
 		(strings.Contains(stringBody, "grant_type=refresh_token") ||
-			strings.Contains(stringBody, "grant_type=password"))
+			strings.Contains(stringBody, "grant_type=password") ||
+			strings.Contains(stringBody, "grant_type=client_credentials"))
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
@@ -162,6 +163,52 @@ var _ = Describe("UAA Authentication", func() {
 			})
 		})
 
+		Context("when concurrent requests fail with an invalid token", func() {
+			BeforeEach(func() {
+				inMemoryCache.SetAccessToken("what")
+
+				var rejectedRequests sync.WaitGroup
+				rejectedRequests.Add(2)
+				fakeConnection.MakeStub = func(request *http.Request, response *uaa.Response) error {
+					if request.Header.Get("Authorization") == "what" {
+						rejectedRequests.Done()
+						rejectedRequests.Wait()
+						return uaa.InvalidAuthTokenError{}
+					}
+					return nil
+				}
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshedTokens{
+						AccessToken:  "foobar-2",
+						RefreshToken: "bananananananana",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			It("refreshes the token once and resends both requests with the new token", func() {
+				errs := make(chan error, 2)
+				for i := 0; i < 2; i++ {
+					go func() {
+						request, err := http.NewRequest(http.MethodGet, server.URL(), nil)
+						if err != nil {
+							errs <- err
+							return
+						}
+						errs <- wrapper.Make(request, nil)
+					}()
+				}
+
+				Eventually(errs).Should(Receive(BeNil()))
+				Eventually(errs).Should(Receive(BeNil()))
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(4))
+			})
+		})
+
 		Context("when refreshing the token", func() {
 			var originalAuthHeader string
 			BeforeEach(func() {
@@ -213,5 +260,28 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
 			})
 		})
+
+		Context("when logging in with client credentials", func() {
+			BeforeEach(func() {
+				body := strings.NewReader(url.Values{
+					"grant_type": {"client_credentials"},
+				}.Encode())
+
+				request, err := http.NewRequest("POST", fmt.Sprintf("%s/oauth/token", server.URL()), body)
+				Expect(err).NotTo(HaveOccurred())
+
+				inMemoryCache.SetAccessToken("some-access-token")
+
+				err = wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not add an 'Authorization' header", func() {
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+				request, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(request.Header.Get("Authorization")).To(BeEmpty())
+			})
+		})
 	})
 })
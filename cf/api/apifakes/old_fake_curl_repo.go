@@ -5,8 +5,10 @@ type OldFakeCurlRepository struct {
 	Path           string
 	Header         string
 	Body           string
+	Retries        int
 	ResponseHeader string
 	ResponseBody   string
+	StatusCode     int
 	Error          error
 }
 
@@ -21,3 +23,10 @@ func (repo *OldFakeCurlRepository) Request(method, path, header, body string) (r
 	apiErr = repo.Error
 	return
 }
+
+func (repo *OldFakeCurlRepository) RequestWithRetries(method, path, header, body string, retries int) (resHeaders, resBody string, statusCode int, apiErr error) {
+	repo.Retries = retries
+	resHeaders, resBody, apiErr = repo.Request(method, path, header, body)
+	statusCode = repo.StatusCode
+	return
+}
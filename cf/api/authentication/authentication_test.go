@@ -3,10 +3,13 @@ package authentication_test
 import (
 	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
 
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/net"
 	"code.cloudfoundry.org/cli/cf/terminal/terminalfakes"
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
@@ -309,6 +312,29 @@ var _ = Describe("AuthenticationRepository", func() {
 			})
 		})
 
+		Context("when the CA certificate file is invalid", func() {
+			var caCertFile string
+
+			BeforeEach(func() {
+				file, err := ioutil.TempFile("", "authorize-ca")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(file.Close()).To(Succeed())
+				caCertFile = file.Name()
+
+				config.SetCACertFile(caCertFile)
+			})
+
+			AfterEach(func() {
+				os.Remove(caCertFile)
+			})
+
+			It("returns an invalid CA certificate error without requesting the code", func() {
+				_, err := authRepo.Authorize("auth-token")
+				Expect(err).To(Equal(errors.NewInvalidCACertificate(caCertFile)))
+				Expect(uaaServer.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+
 		Context("when the authorization server returns multiple codes", func() {
 			BeforeEach(func() {
 				uaaServer.SetHandler(0, ghttp.RespondWith(http.StatusFound, ``, http.Header{
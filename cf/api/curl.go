@@ -8,6 +8,7 @@ import (
 	"net/http/httputil"
 	"net/textproto"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
@@ -19,6 +20,7 @@ import (
 
 type CurlRepository interface {
 	Request(method, path, header, body string) (resHeaders string, resBody string, apiErr error)
+	RequestWithRetries(method, path, header, body string, retries int) (resHeaders string, resBody string, statusCode int, apiErr error)
 }
 
 type CloudControllerCurlRepository struct {
@@ -33,6 +35,27 @@ func NewCloudControllerCurlRepository(config coreconfig.Reader, gateway net.Gate
 }
 
 func (repo CloudControllerCurlRepository) Request(method, path, headerString, body string) (resHeaders, resBody string, err error) {
+	resHeaders, resBody, _, err = repo.RequestWithRetries(method, path, headerString, body, 0)
+	return
+}
+
+// RequestWithRetries performs the request and, when the request fails with a
+// network error or a transient status code (408, 429 or 5xx), retries it up
+// to the given number of times, waiting the gateway's polling throttle
+// between attempts. The status code of the final response is returned.
+func (repo CloudControllerCurlRepository) RequestWithRetries(method, path, headerString, body string, retries int) (resHeaders, resBody string, statusCode int, err error) {
+	for attempt := 0; ; attempt++ {
+		var retryable bool
+		resHeaders, resBody, statusCode, retryable, err = repo.performRequest(method, path, headerString, body)
+		if !retryable || attempt >= retries {
+			return
+		}
+
+		time.Sleep(repo.gateway.PollingThrottle)
+	}
+}
+
+func (repo CloudControllerCurlRepository) performRequest(method, path, headerString, body string) (resHeaders, resBody string, statusCode int, retryable bool, err error) {
 	url := fmt.Sprintf("%s/%s", repo.config.APIEndpoint(), strings.TrimLeft(path, "/"))
 
 	if method == "" && body != "" {
@@ -57,10 +80,15 @@ func (repo CloudControllerCurlRepository) Request(method, path, headerString, bo
 	}
 
 	if err != nil {
+		_, invalidCert := err.(*errors.InvalidSSLCert)
+		retryable = res == nil && !invalidCert
 		return
 	}
 	defer res.Body.Close()
 
+	statusCode = res.StatusCode
+	retryable = isRetryableStatusCode(statusCode)
+
 	headerBytes, _ := httputil.DumpResponse(res, false)
 	resHeaders = string(headerBytes)
 
@@ -73,6 +101,12 @@ func (repo CloudControllerCurlRepository) Request(method, path, headerString, bo
 	return
 }
 
+func isRetryableStatusCode(statusCode int) bool {
+	return statusCode == http.StatusRequestTimeout ||
+		statusCode == http.StatusTooManyRequests ||
+		statusCode >= http.StatusInternalServerError
+}
+
 func mergeHeaders(destination http.Header, headerString string) (err error) {
 	headerString = strings.TrimSpace(headerString)
 	headerString += "\n\n"
@@ -158,6 +158,108 @@ var _ = Describe("CloudControllerCurlRepository ", func() {
 		})
 	})
 
+	Describe("RequestWithRetries", func() {
+		var (
+			ccServer   *ghttp.Server
+			repo       CloudControllerCurlRepository
+			statusCode int
+		)
+
+		BeforeEach(func() {
+			ccServer = ghttp.NewServer()
+
+			deps := newCurlDependencies()
+			deps.config.SetAPIEndpoint(ccServer.URL())
+			deps.gateway.PollingThrottle = 0
+
+			repo = NewCloudControllerCurlRepository(deps.config, deps.gateway)
+		})
+
+		AfterEach(func() {
+			ccServer.Close()
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				ccServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/v2/endpoint"),
+						ghttp.RespondWith(http.StatusOK, "some-body"),
+					),
+				)
+			})
+
+			It("returns the body and status code without retrying", func() {
+				_, body, statusCode, apiErr = repo.RequestWithRetries("GET", "/v2/endpoint", "", "", 3)
+				Expect(apiErr).NotTo(HaveOccurred())
+				Expect(body).To(Equal("some-body"))
+				Expect(statusCode).To(Equal(http.StatusOK))
+
+				Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the server responds with a transient status code", func() {
+			BeforeEach(func() {
+				ccServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/v2/endpoint"),
+						ghttp.VerifyBody([]byte("some-request")),
+						ghttp.RespondWith(http.StatusServiceUnavailable, "unavailable"),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/v2/endpoint"),
+						ghttp.VerifyBody([]byte("some-request")),
+						ghttp.RespondWith(http.StatusTooManyRequests, "slow down"),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/v2/endpoint"),
+						ghttp.VerifyBody([]byte("some-request")),
+						ghttp.RespondWith(http.StatusCreated, "created"),
+					),
+				)
+			})
+
+			It("retries the request until it succeeds", func() {
+				_, body, statusCode, apiErr = repo.RequestWithRetries("POST", "/v2/endpoint", "", "some-request", 2)
+				Expect(apiErr).NotTo(HaveOccurred())
+				Expect(body).To(Equal("created"))
+				Expect(statusCode).To(Equal(http.StatusCreated))
+
+				Expect(ccServer.ReceivedRequests()).To(HaveLen(3))
+			})
+
+			It("returns the last response when it runs out of retries", func() {
+				_, body, statusCode, apiErr = repo.RequestWithRetries("POST", "/v2/endpoint", "", "some-request", 1)
+				Expect(apiErr).NotTo(HaveOccurred())
+				Expect(body).To(Equal("slow down"))
+				Expect(statusCode).To(Equal(http.StatusTooManyRequests))
+
+				Expect(ccServer.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+
+		Context("when the server responds with a client error", func() {
+			BeforeEach(func() {
+				ccServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/v2/endpoint"),
+						ghttp.RespondWith(http.StatusNotFound, "not found"),
+					),
+				)
+			})
+
+			It("does not retry the request", func() {
+				_, body, statusCode, apiErr = repo.RequestWithRetries("GET", "/v2/endpoint", "", "", 3)
+				Expect(apiErr).NotTo(HaveOccurred())
+				Expect(body).To(Equal("not found"))
+				Expect(statusCode).To(Equal(http.StatusNotFound))
+
+				Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+	})
+
 	It("uses POST as the default method when a body is provided", func() {
 		ccServer := ghttp.NewServer()
 		ccServer.AppendHandlers(
@@ -99,7 +99,13 @@ func NewRepositoryLocator(config coreconfig.ReadWriter, gatewaysByName map[strin
 	loc.domainRepo = NewCloudControllerDomainRepository(config, cloudControllerGateway)
 	loc.endpointRepo = NewEndpointRepository(cloudControllerGateway)
 
-	tlsConfig := net.NewTLSConfig([]tls.Certificate{}, config.IsSSLDisabled())
+	tlsConfig, err := net.NewTLSConfig([]tls.Certificate{}, config.IsSSLDisabled(), config.CACertFile())
+	if err != nil {
+		// Logs are only streamed after the Cloud Controller gateway has
+		// reported the invalid CA certificate file, so fall back to the system
+		// trust store here.
+		tlsConfig, _ = net.NewTLSConfig([]tls.Certificate{}, config.IsSSLDisabled(), "")
+	}
 
 	var noaaRetryTimeout time.Duration
 	convertedTime, err := strconv.Atoi(envDialTimeout)
@@ -45,7 +45,7 @@ func Main(traceEnv string, args []string) {
 	args = append([]string{args[0]}, handleHelp(args[1:])...)
 
 	newArgs, isVerbose := handleVerbose(args)
-	args = newArgs
+	args = handleOutput(handleErrorFormat(handleNoPager(newArgs)))
 
 	errFunc := func(err error) {
 		if err != nil {
@@ -204,6 +204,50 @@ func handleHelp(args []string) []string {
 	}
 }
 
+// handleNoPager removes the --no-pager global flag, which only affects
+// commands that display through util/ui.
+func handleNoPager(args []string) []string {
+	newArgs := []string{}
+	for _, arg := range args {
+		if arg != "--no-pager" {
+			newArgs = append(newArgs, arg)
+		}
+	}
+	return newArgs
+}
+
+// handleErrorFormat removes the --error-format global flag and its value,
+// which only affect commands that display through util/ui.
+func handleErrorFormat(args []string) []string {
+	return removeFlagWithValue(args, "--error-format")
+}
+
+// handleOutput removes the --output global flag and its value; commands
+// implemented here always display their results as text. The curl command has
+// its own --output flag, so only occurrences before it are removed.
+func handleOutput(args []string) []string {
+	for i, arg := range args {
+		if arg == "curl" {
+			return append(removeFlagWithValue(args[:i], "--output"), args[i:]...)
+		}
+	}
+	return removeFlagWithValue(args, "--output")
+}
+
+func removeFlagWithValue(args []string, flag string) []string {
+	newArgs := []string{}
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == flag:
+			i++
+		case strings.HasPrefix(args[i], flag+"="):
+		default:
+			newArgs = append(newArgs, args[i])
+		}
+	}
+	return newArgs
+}
+
 func handleVerbose(args []string) ([]string, bool) {
 	var verbose bool
 	idx := -1
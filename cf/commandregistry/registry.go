@@ -21,11 +21,14 @@ var Commands = NewRegistry()
 func initI18nFunc() bool {
 	config, err := configv3.LoadConfig()
 
-	var configErrTemplate string
+	var configErr translatableerror.TranslatableError
 	if err != nil {
-		if ce, ok := err.(translatableerror.EmptyConfigError); ok {
-			configErrTemplate = ce.Error()
-		} else {
+		switch e := err.(type) {
+		case translatableerror.EmptyConfigError:
+			configErr = e
+		case translatableerror.CorruptConfigError:
+			configErr = e
+		default:
 			fmt.Println(FailureColor("FAILED"))
 			fmt.Println("Error read/writing config: ", err.Error())
 			os.Exit(1)
@@ -34,10 +37,8 @@ func initI18nFunc() bool {
 
 	T = Init(config)
 
-	if err != nil {
-		fmt.Fprintf(os.Stderr, fmt.Sprintf("%s\n", T(configErrTemplate, map[string]interface{}{
-			"FilePath": configv3.ConfigFilePath(),
-		})))
+	if configErr != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", configErr.Translate(T))
 	}
 	return true
 }
@@ -91,6 +91,7 @@ func (cmd API) setAPIEndpoint(endpoint string, skipSSL bool, cmdName string) err
 	}
 
 	cmd.config.SetSSLDisabled(skipSSL)
+	cmd.config.SetCACertFile("")
 
 	refresher := coreconfig.APIConfigRefresher{
 		Endpoint:     endpoint,
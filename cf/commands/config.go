@@ -27,13 +27,15 @@ func (cmd *ConfigCommands) MetaData() commandregistry.CommandMetadata {
 	fs["async-timeout"] = &flags.IntFlag{Name: "async-timeout", Usage: T("Timeout for async HTTP requests")}
 	fs["trace"] = &flags.StringFlag{Name: "trace", Usage: T("Trace HTTP requests")}
 	fs["color"] = &flags.StringFlag{Name: "color", Usage: T("Enable or disable color")}
+	fs["pager"] = &flags.StringFlag{Name: "pager", Usage: T("Enable or disable piping long output through $PAGER")}
+	fs["resource-match-min-file-size"] = &flags.IntFlag{Name: "resource-match-min-file-size", Usage: T("Minimum size, in bytes, of files checked for previously uploaded matches")}
 	fs["locale"] = &flags.StringFlag{Name: "locale", Usage: T("Set default locale. If LOCALE is 'CLEAR', previous locale is deleted.")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "config",
 		Description: T("Write default values to the config"),
 		Usage: []string{
-			T("CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"),
+			T("CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--pager (true | false)] [--resource-match-min-file-size BYTES] [--locale (LOCALE | CLEAR)]"),
 		},
 		Flags: fs,
 	}
@@ -51,7 +53,7 @@ func (cmd *ConfigCommands) SetDependency(deps commandregistry.Dependency, plugin
 }
 
 func (cmd *ConfigCommands) Execute(context flags.FlagContext) error {
-	if !context.IsSet("trace") && !context.IsSet("async-timeout") && !context.IsSet("color") && !context.IsSet("locale") {
+	if !context.IsSet("trace") && !context.IsSet("async-timeout") && !context.IsSet("color") && !context.IsSet("pager") && !context.IsSet("resource-match-min-file-size") && !context.IsSet("locale") {
 		return errors.New(T("Incorrect Usage") + "\n\n" + commandregistry.Commands.CommandUsage("config"))
 	}
 
@@ -80,6 +82,27 @@ func (cmd *ConfigCommands) Execute(context flags.FlagContext) error {
 		}
 	}
 
+	if context.IsSet("pager") {
+		value := context.String("pager")
+		switch value {
+		case "true":
+			cmd.config.SetPagerEnabled("true")
+		case "false":
+			cmd.config.SetPagerEnabled("false")
+		default:
+			return errors.New(T("Incorrect Usage") + "\n\n" + commandregistry.Commands.CommandUsage("config"))
+		}
+	}
+
+	if context.IsSet("resource-match-min-file-size") {
+		size := context.Int("resource-match-min-file-size")
+		if size < 0 {
+			return errors.New(T("Incorrect Usage") + "\n\n" + commandregistry.Commands.CommandUsage("config"))
+		}
+
+		cmd.config.SetResourceMatchMinFileSize(int64(size))
+	}
+
 	if context.IsSet("locale") {
 		locale := context.String("locale")
 
@@ -96,6 +96,38 @@ var _ = Describe("config command", func() {
 		})
 	})
 
+	Context("--pager flag", func() {
+		It("stores the pager value when --pager flag is provided", func() {
+			runCommand("--pager", "true")
+			Expect(configRepo.PagerEnabled()).Should(Equal("true"))
+
+			runCommand("--pager", "false")
+			Expect(configRepo.PagerEnabled()).Should(Equal("false"))
+		})
+
+		It("fails with usage when a non-bool value is provided", func() {
+			runCommand("--pager", "plaid")
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage"},
+			))
+		})
+	})
+
+	Context("--resource-match-min-file-size flag", func() {
+		It("stores the size when the --resource-match-min-file-size flag is provided", func() {
+			runCommand("--resource-match-min-file-size", "4096")
+			Expect(configRepo.ResourceMatchMinFileSize()).Should(BeEquivalentTo(4096))
+		})
+
+		It("fails with usage when a negative size is passed", func() {
+			runCommand("--resource-match-min-file-size", "-1")
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage"},
+			))
+			Expect(configRepo.ResourceMatchMinFileSize()).To(BeZero())
+		})
+	})
+
 	Context("--locale flag", func() {
 		It("stores the locale value when --locale [locale] is provided", func() {
 			runCommand("--locale", "zh-Hans")
@@ -40,12 +40,14 @@ func (cmd *Curl) MetaData() commandregistry.CommandMetadata {
 	fs["H"] = &flags.StringSliceFlag{ShortName: "H", Usage: T("Custom headers to include in the request, flag can be specified multiple times")}
 	fs["d"] = &flags.StringFlag{ShortName: "d", Usage: T("HTTP data to include in the request body, or '@' followed by a file name to read the data from")}
 	fs["output"] = &flags.StringFlag{Name: "output", Usage: T("Write curl body to FILE instead of stdout")}
+	fs["fail"] = &flags.BoolFlag{Name: "fail", ShortName: "f", Usage: T("Fail with a non-zero exit code when the server responds with a 4xx or 5xx status code")}
+	fs["retry"] = &flags.IntFlag{Name: "retry", Usage: T("Number of times to retry the request on network errors and transient (408, 429, 5xx) responses")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "curl",
 		Description: T("Executes a request to the targeted API endpoint"),
 		Usage: []string{
-			T(`CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE]
+			T(`CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE] [--fail] [--retry N]
 
    By default 'CF_NAME curl' will perform a GET to the specified PATH. If data
    is provided via -d, a POST will be performed instead, and the Content-Type
@@ -68,6 +70,11 @@ func (cmd *Curl) Requirements(requirementsFactory requirements.Factory, fc flags
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
 	}
 
+	if fc.Int("retry") < 0 {
+		cmd.ui.Failed(T("Incorrect Usage: --retry must be a non-negative integer.\n\n") + commandregistry.Commands.CommandUsage("curl"))
+		return nil, fmt.Errorf("Incorrect usage: --retry must be non-negative")
+	}
+
 	reqs := []requirements.Requirement{
 		requirementsFactory.NewAPIEndpointRequirement(),
 	}
@@ -106,11 +113,18 @@ func (cmd *Curl) Execute(c flags.FlagContext) error {
 
 	reqHeader := strings.Join(headers, "\n")
 
-	responseHeader, responseBody, apiErr := cmd.curlRepo.Request(method, path, reqHeader, body)
+	responseHeader, responseBody, statusCode, apiErr := cmd.curlRepo.RequestWithRetries(method, path, reqHeader, body, c.Int("retry"))
 	if apiErr != nil {
 		return errors.New(T("Error creating request:\n{{.Err}}", map[string]interface{}{"Err": apiErr.Error()}))
 	}
 
+	if c.Bool("fail") && statusCode >= 400 {
+		return errors.New(T("The server responded with status code {{.StatusCode}}:\n{{.Body}}", map[string]interface{}{
+			"StatusCode": statusCode,
+			"Body":       responseBody,
+		}))
+	}
+
 	if trace.LoggingToStdout && !cmd.pluginCall {
 		return nil
 	}
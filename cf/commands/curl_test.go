@@ -130,6 +130,78 @@ var _ = Describe("curl command", func() {
 		})
 	})
 
+	Context("when the --retry flag is provided", func() {
+		It("passes the number of retries to the repository", func() {
+			runCurlWithInputs([]string{"--retry", "3", "/foo"})
+
+			Expect(curlRepo.Retries).To(Equal(3))
+			Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"FAILED"}))
+		})
+
+		It("fails with usage when the number of retries is negative", func() {
+			Expect(runCurlWithInputs([]string{"--retry", "-1", "/foo"})).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "--retry must be a non-negative integer"},
+			))
+		})
+	})
+
+	Context("when the --fail flag is provided", func() {
+		BeforeEach(func() {
+			curlRepo.ResponseBody = "response body"
+		})
+
+		Context("when the server responds with an error status code", func() {
+			BeforeEach(func() {
+				curlRepo.StatusCode = 404
+			})
+
+			It("fails and includes the status code and response body", func() {
+				runCurlWithInputs([]string{"--fail", "/foo"})
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"The server responded with status code 404"},
+					[]string{"response body"},
+				))
+			})
+
+			It("does not write the response to the output file", func() {
+				fileutils.TempDir("poor-mans-dir", func(tmpDir string, err error) {
+					Expect(err).ToNot(HaveOccurred())
+
+					filePath := filepath.Join(tmpDir, "banana.txt")
+					runCurlWithInputs([]string{"-f", "--output", filePath, "/foo"})
+
+					Expect(filePath).ToNot(BeAnExistingFile())
+					Expect(ui.Outputs()).To(ContainSubstrings([]string{"FAILED"}))
+				})
+			})
+		})
+
+		Context("when the server responds with a success status code", func() {
+			BeforeEach(func() {
+				curlRepo.StatusCode = 200
+			})
+
+			It("prints the response", func() {
+				runCurlWithInputs([]string{"--fail", "/foo"})
+
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"response body"}))
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"FAILED"}))
+			})
+		})
+	})
+
+	It("does not fail on an error status code when --fail is not provided", func() {
+		curlRepo.StatusCode = 500
+		curlRepo.ResponseBody = "response body"
+		runCurlWithInputs([]string{"/foo"})
+
+		Expect(ui.Outputs()).To(ContainSubstrings([]string{"response body"}))
+		Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"FAILED"}))
+	})
+
 	It("makes a post request given -X", func() {
 		runCurlWithInputs([]string{"-X", "post", "/foo"})
 
@@ -29,18 +29,26 @@ type Data struct {
 	AccessToken              string
 	UAAOAuthClient           string
 	UAAOAuthClientSecret     string
+	UAAGrantType             string
 	SSHOAuthClient           string
 	RefreshToken             string
 	OrganizationFields       models.OrganizationFields
 	SpaceFields              models.SpaceFields
 	SSLDisabled              bool
+	CACertFile               string `json:",omitempty"`
 	AsyncTimeout             uint
+	ResourceMatchMinFileSize int64
 	Trace                    string
 	ColorEnabled             string
+	PagerEnabled             string
 	Locale                   string
 	PluginRepos              []models.PluginRepo
 	MinCLIVersion            string
 	MinRecommendedCLIVersion string
+
+	// DetachedJobs are only used by the v6 commands; they are kept as is so
+	// that writing the config here does not drop them.
+	DetachedJobs json.RawMessage `json:",omitempty"`
 }
 
 func NewData() *Data {
@@ -21,6 +21,7 @@ var _ = Describe("V3 Config files", func() {
 		"AccessToken": "the-access-token",
 		"UAAOAuthClient": "cf-oauth-client-id",
 		"UAAOAuthClientSecret": "cf-oauth-client-secret",
+		"UAAGrantType": "",
 		"SSHOAuthClient": "ssh-oauth-client-id",
 		"RefreshToken": "the-refresh-token",
 		"OrganizationFields": {
@@ -43,8 +44,10 @@ var _ = Describe("V3 Config files", func() {
 		},
 		"SSLDisabled": true,
 		"AsyncTimeout": 1000,
+		"ResourceMatchMinFileSize": 4096,
 		"Trace": "path/to/some/file",
 		"ColorEnabled": "true",
+		"PagerEnabled": "true",
 		"Locale": "fr_FR",
 		"PluginRepos": [
 		{
@@ -136,11 +139,13 @@ var _ = Describe("V3 Config files", func() {
 					GUID: "the-space-guid",
 					Name: "the-space",
 				},
-				SSLDisabled:  true,
-				Trace:        "path/to/some/file",
-				AsyncTimeout: 1000,
-				ColorEnabled: "true",
-				Locale:       "fr_FR",
+				SSLDisabled:              true,
+				Trace:                    "path/to/some/file",
+				AsyncTimeout:             1000,
+				ResourceMatchMinFileSize: 4096,
+				ColorEnabled:             "true",
+				PagerEnabled:             "true",
+				Locale:                   "fr_FR",
 				PluginRepos: []models.PluginRepo{
 					{
 						Name: "repo1",
@@ -187,11 +192,13 @@ var _ = Describe("V3 Config files", func() {
 					GUID: "the-space-guid",
 					Name: "the-space",
 				},
-				SSLDisabled:  true,
-				Trace:        "path/to/some/file",
-				AsyncTimeout: 1000,
-				ColorEnabled: "true",
-				Locale:       "fr_FR",
+				SSLDisabled:              true,
+				Trace:                    "path/to/some/file",
+				AsyncTimeout:             1000,
+				ResourceMatchMinFileSize: 4096,
+				ColorEnabled:             "true",
+				PagerEnabled:             "true",
+				Locale:                   "fr_FR",
 				PluginRepos: []models.PluginRepo{
 					{
 						Name: "repo1",
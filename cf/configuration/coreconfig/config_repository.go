@@ -81,6 +81,7 @@ type Reader interface {
 	UserEmail() string
 	IsLoggedIn() bool
 	IsSSLDisabled() bool
+	CACertFile() string
 	IsMinAPIVersion(semver.Version) bool
 	IsMinCLIVersion(string) bool
 	MinCLIVersion() string
@@ -88,10 +89,13 @@ type Reader interface {
 	CLIVersion() string
 
 	AsyncTimeout() uint
+	ResourceMatchMinFileSize() int64
 	Trace() string
 
 	ColorEnabled() string
 
+	PagerEnabled() string
+
 	Locale() string
 
 	PluginRepos() []models.PluginRepo
@@ -118,9 +122,12 @@ type ReadWriter interface {
 	SetOrganizationFields(models.OrganizationFields)
 	SetSpaceFields(models.SpaceFields)
 	SetSSLDisabled(bool)
+	SetCACertFile(string)
 	SetAsyncTimeout(uint)
+	SetResourceMatchMinFileSize(int64)
 	SetTrace(string)
 	SetColorEnabled(string)
+	SetPagerEnabled(string)
 	SetLocale(string)
 	SetPluginRepo(models.PluginRepo)
 	UnSetPluginRepo(int)
@@ -327,6 +334,13 @@ func (c *ConfigRepository) IsSSLDisabled() (isSSLDisabled bool) {
 	return
 }
 
+func (c *ConfigRepository) CACertFile() (caCertFile string) {
+	c.read(func() {
+		caCertFile = c.data.CACertFile
+	})
+	return
+}
+
 // SetCLIVersion should only be used in testing
 func (c *ConfigRepository) SetCLIVersion(v string) {
 	c.CFCLIVersion = v
@@ -397,6 +411,13 @@ func (c *ConfigRepository) AsyncTimeout() (timeout uint) {
 	return
 }
 
+func (c *ConfigRepository) ResourceMatchMinFileSize() (size int64) {
+	c.read(func() {
+		size = c.data.ResourceMatchMinFileSize
+	})
+	return
+}
+
 func (c *ConfigRepository) Trace() (trace string) {
 	c.read(func() {
 		trace = c.data.Trace
@@ -411,6 +432,13 @@ func (c *ConfigRepository) ColorEnabled() (enabled string) {
 	return
 }
 
+func (c *ConfigRepository) PagerEnabled() (enabled string) {
+	c.read(func() {
+		enabled = c.data.PagerEnabled
+	})
+	return
+}
+
 func (c *ConfigRepository) Locale() (locale string) {
 	c.read(func() {
 		locale = c.data.Locale
@@ -433,6 +461,12 @@ func (c *ConfigRepository) ClearSession() {
 		c.data.RefreshToken = ""
 		c.data.OrganizationFields = models.OrganizationFields{}
 		c.data.SpaceFields = models.SpaceFields{}
+
+		if c.data.UAAGrantType == "client_credentials" {
+			c.data.UAAOAuthClient = "cf"
+			c.data.UAAOAuthClientSecret = ""
+		}
+		c.data.UAAGrantType = ""
 	})
 }
 
@@ -532,12 +566,24 @@ func (c *ConfigRepository) SetSSLDisabled(disabled bool) {
 	})
 }
 
+func (c *ConfigRepository) SetCACertFile(caCertFile string) {
+	c.write(func() {
+		c.data.CACertFile = caCertFile
+	})
+}
+
 func (c *ConfigRepository) SetAsyncTimeout(timeout uint) {
 	c.write(func() {
 		c.data.AsyncTimeout = timeout
 	})
 }
 
+func (c *ConfigRepository) SetResourceMatchMinFileSize(size int64) {
+	c.write(func() {
+		c.data.ResourceMatchMinFileSize = size
+	})
+}
+
 func (c *ConfigRepository) SetTrace(value string) {
 	c.write(func() {
 		c.data.Trace = value
@@ -550,6 +596,12 @@ func (c *ConfigRepository) SetColorEnabled(enabled string) {
 	})
 }
 
+func (c *ConfigRepository) SetPagerEnabled(enabled string) {
+	c.write(func() {
+		c.data.PagerEnabled = enabled
+	})
+}
+
 func (c *ConfigRepository) SetLocale(locale string) {
 	c.write(func() {
 		c.data.Locale = locale
@@ -103,6 +103,15 @@ var _ = Describe("Configuration Repository", func() {
 		config.SetSSLDisabled(false)
 		Expect(config.IsSSLDisabled()).To(BeFalse())
 
+		config.SetCACertFile("/some/ca.pem")
+		Expect(config.CACertFile()).To(Equal("/some/ca.pem"))
+
+		config.SetPagerEnabled("true")
+		Expect(config.PagerEnabled()).To(Equal("true"))
+
+		config.SetResourceMatchMinFileSize(4096)
+		Expect(config.ResourceMatchMinFileSize()).To(BeEquivalentTo(4096))
+
 		config.SetLocale("en_US")
 		Expect(config.Locale()).To(Equal("en_US"))
 
@@ -204,6 +213,44 @@ var _ = Describe("Configuration Repository", func() {
 		})
 	})
 
+	Describe("ClearSession", func() {
+		Context("when a service account is logged in", func() {
+			BeforeEach(func() {
+				persistor.LoadStub = func(data configuration.DataInterface) error {
+					configData := data.(*coreconfig.Data)
+					configData.AccessToken = "some-access-token"
+					configData.UAAOAuthClient = "some-client"
+					configData.UAAOAuthClientSecret = "some-client-secret"
+					configData.UAAGrantType = "client_credentials"
+					return nil
+				}
+				config = coreconfig.NewRepositoryFromPersistor(persistor, func(err error) { panic(err) })
+			})
+
+			It("resets the tokens and the UAA client credentials", func() {
+				config.ClearSession()
+
+				Expect(config.AccessToken()).To(BeEmpty())
+				Expect(config.UAAOAuthClient()).To(Equal("cf"))
+				Expect(config.UAAOAuthClientSecret()).To(BeEmpty())
+			})
+		})
+
+		Context("when a user is logged in", func() {
+			BeforeEach(func() {
+				config.SetUAAOAuthClient("some-client")
+				config.SetAccessToken("some-access-token")
+			})
+
+			It("resets the tokens and keeps the UAA client", func() {
+				config.ClearSession()
+
+				Expect(config.AccessToken()).To(BeEmpty())
+				Expect(config.UAAOAuthClient()).To(Equal("some-client"))
+			})
+		})
+	})
+
 	Describe("NewRepositoryFromFilepath", func() {
 		var configPath string
 
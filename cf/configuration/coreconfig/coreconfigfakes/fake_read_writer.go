@@ -46,6 +46,15 @@ type FakeReadWriter struct {
 	uaaEndpointReturns     struct {
 		result1 string
 	}
+	ResourceMatchMinFileSizeStub        func() int64
+	resourceMatchMinFileSizeMutex       sync.RWMutex
+	resourceMatchMinFileSizeArgsForCall []struct{}
+	resourceMatchMinFileSizeReturns     struct {
+		result1 int64
+	}
+	resourceMatchMinFileSizeReturnsOnCall map[int]struct {
+		result1 int64
+	}
 	RoutingAPIEndpointStub        func() string
 	routingAPIEndpointMutex       sync.RWMutex
 	routingAPIEndpointArgsForCall []struct{}
@@ -164,6 +173,15 @@ type FakeReadWriter struct {
 	minRecommendedCLIVersionReturns     struct {
 		result1 string
 	}
+	CACertFileStub        func() string
+	cACertFileMutex       sync.RWMutex
+	cACertFileArgsForCall []struct{}
+	cACertFileReturns     struct {
+		result1 string
+	}
+	cACertFileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CLIVersionStub        func() string
 	cLIVersionMutex       sync.RWMutex
 	cLIVersionArgsForCall []struct{}
@@ -188,6 +206,12 @@ type FakeReadWriter struct {
 	colorEnabledReturns     struct {
 		result1 string
 	}
+	PagerEnabledStub        func() string
+	pagerEnabledMutex       sync.RWMutex
+	pagerEnabledArgsForCall []struct{}
+	pagerEnabledReturns     struct {
+		result1 string
+	}
 	LocaleStub        func() string
 	localeMutex       sync.RWMutex
 	localeArgsForCall []struct{}
@@ -238,6 +262,11 @@ type FakeReadWriter struct {
 	setUaaEndpointArgsForCall []struct {
 		arg1 string
 	}
+	SetResourceMatchMinFileSizeStub        func(size int64)
+	setResourceMatchMinFileSizeMutex       sync.RWMutex
+	setResourceMatchMinFileSizeArgsForCall []struct {
+		size int64
+	}
 	SetRoutingAPIEndpointStub        func(string)
 	setRoutingAPIEndpointMutex       sync.RWMutex
 	setRoutingAPIEndpointArgsForCall []struct {
@@ -298,6 +327,11 @@ type FakeReadWriter struct {
 	setColorEnabledArgsForCall []struct {
 		arg1 string
 	}
+	SetPagerEnabledStub        func(string)
+	setPagerEnabledMutex       sync.RWMutex
+	setPagerEnabledArgsForCall []struct {
+		arg1 string
+	}
 	SetLocaleStub        func(string)
 	setLocaleMutex       sync.RWMutex
 	setLocaleArgsForCall []struct {
@@ -313,6 +347,11 @@ type FakeReadWriter struct {
 	unSetPluginRepoArgsForCall []struct {
 		arg1 int
 	}
+	SetCACertFileStub        func(arg1 string)
+	setCACertFileMutex       sync.RWMutex
+	setCACertFileArgsForCall []struct {
+		arg1 string
+	}
 	SetCLIVersionStub        func(string)
 	setCLIVersionMutex       sync.RWMutex
 	setCLIVersionArgsForCall []struct {
@@ -472,6 +511,46 @@ func (fake *FakeReadWriter) UaaEndpointReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeReadWriter) ResourceMatchMinFileSize() int64 {
+	fake.resourceMatchMinFileSizeMutex.Lock()
+	ret, specificReturn := fake.resourceMatchMinFileSizeReturnsOnCall[len(fake.resourceMatchMinFileSizeArgsForCall)]
+	fake.resourceMatchMinFileSizeArgsForCall = append(fake.resourceMatchMinFileSizeArgsForCall, struct{}{})
+	fake.recordInvocation("ResourceMatchMinFileSize", []interface{}{})
+	fake.resourceMatchMinFileSizeMutex.Unlock()
+	if fake.ResourceMatchMinFileSizeStub != nil {
+		return fake.ResourceMatchMinFileSizeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.resourceMatchMinFileSizeReturns.result1
+}
+
+func (fake *FakeReadWriter) ResourceMatchMinFileSizeCallCount() int {
+	fake.resourceMatchMinFileSizeMutex.RLock()
+	defer fake.resourceMatchMinFileSizeMutex.RUnlock()
+	return len(fake.resourceMatchMinFileSizeArgsForCall)
+}
+
+func (fake *FakeReadWriter) ResourceMatchMinFileSizeReturns(result1 int64) {
+	fake.ResourceMatchMinFileSizeStub = nil
+	fake.resourceMatchMinFileSizeReturns = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeReadWriter) ResourceMatchMinFileSizeReturnsOnCall(i int, result1 int64) {
+	fake.ResourceMatchMinFileSizeStub = nil
+	if fake.resourceMatchMinFileSizeReturnsOnCall == nil {
+		fake.resourceMatchMinFileSizeReturnsOnCall = make(map[int]struct {
+			result1 int64
+		})
+	}
+	fake.resourceMatchMinFileSizeReturnsOnCall[i] = struct {
+		result1 int64
+	}{result1}
+}
+
 func (fake *FakeReadWriter) RoutingAPIEndpoint() string {
 	fake.routingAPIEndpointMutex.Lock()
 	fake.routingAPIEndpointArgsForCall = append(fake.routingAPIEndpointArgsForCall, struct{}{})
@@ -963,6 +1042,46 @@ func (fake *FakeReadWriter) MinRecommendedCLIVersionReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeReadWriter) CACertFile() string {
+	fake.cACertFileMutex.Lock()
+	ret, specificReturn := fake.cACertFileReturnsOnCall[len(fake.cACertFileArgsForCall)]
+	fake.cACertFileArgsForCall = append(fake.cACertFileArgsForCall, struct{}{})
+	fake.recordInvocation("CACertFile", []interface{}{})
+	fake.cACertFileMutex.Unlock()
+	if fake.CACertFileStub != nil {
+		return fake.CACertFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cACertFileReturns.result1
+}
+
+func (fake *FakeReadWriter) CACertFileCallCount() int {
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
+	return len(fake.cACertFileArgsForCall)
+}
+
+func (fake *FakeReadWriter) CACertFileReturns(result1 string) {
+	fake.CACertFileStub = nil
+	fake.cACertFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeReadWriter) CACertFileReturnsOnCall(i int, result1 string) {
+	fake.CACertFileStub = nil
+	if fake.cACertFileReturnsOnCall == nil {
+		fake.cACertFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cACertFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeReadWriter) CLIVersion() string {
 	fake.cLIVersionMutex.Lock()
 	fake.cLIVersionArgsForCall = append(fake.cLIVersionArgsForCall, struct{}{})
@@ -1063,6 +1182,31 @@ func (fake *FakeReadWriter) ColorEnabledReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeReadWriter) PagerEnabled() string {
+	fake.pagerEnabledMutex.Lock()
+	fake.pagerEnabledArgsForCall = append(fake.pagerEnabledArgsForCall, struct{}{})
+	fake.recordInvocation("PagerEnabled", []interface{}{})
+	fake.pagerEnabledMutex.Unlock()
+	if fake.PagerEnabledStub != nil {
+		return fake.PagerEnabledStub()
+	} else {
+		return fake.pagerEnabledReturns.result1
+	}
+}
+
+func (fake *FakeReadWriter) PagerEnabledCallCount() int {
+	fake.pagerEnabledMutex.RLock()
+	defer fake.pagerEnabledMutex.RUnlock()
+	return len(fake.pagerEnabledArgsForCall)
+}
+
+func (fake *FakeReadWriter) PagerEnabledReturns(result1 string) {
+	fake.PagerEnabledStub = nil
+	fake.pagerEnabledReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeReadWriter) Locale() string {
 	fake.localeMutex.Lock()
 	fake.localeArgsForCall = append(fake.localeArgsForCall, struct{}{})
@@ -1297,6 +1441,30 @@ func (fake *FakeReadWriter) SetUaaEndpointArgsForCall(i int) string {
 	return fake.setUaaEndpointArgsForCall[i].arg1
 }
 
+func (fake *FakeReadWriter) SetResourceMatchMinFileSize(size int64) {
+	fake.setResourceMatchMinFileSizeMutex.Lock()
+	fake.setResourceMatchMinFileSizeArgsForCall = append(fake.setResourceMatchMinFileSizeArgsForCall, struct {
+		size int64
+	}{size})
+	fake.recordInvocation("SetResourceMatchMinFileSize", []interface{}{size})
+	fake.setResourceMatchMinFileSizeMutex.Unlock()
+	if fake.SetResourceMatchMinFileSizeStub != nil {
+		fake.SetResourceMatchMinFileSizeStub(size)
+	}
+}
+
+func (fake *FakeReadWriter) SetResourceMatchMinFileSizeCallCount() int {
+	fake.setResourceMatchMinFileSizeMutex.RLock()
+	defer fake.setResourceMatchMinFileSizeMutex.RUnlock()
+	return len(fake.setResourceMatchMinFileSizeArgsForCall)
+}
+
+func (fake *FakeReadWriter) SetResourceMatchMinFileSizeArgsForCall(i int) int64 {
+	fake.setResourceMatchMinFileSizeMutex.RLock()
+	defer fake.setResourceMatchMinFileSizeMutex.RUnlock()
+	return fake.setResourceMatchMinFileSizeArgsForCall[i].size
+}
+
 func (fake *FakeReadWriter) SetRoutingAPIEndpoint(arg1 string) {
 	fake.setRoutingAPIEndpointMutex.Lock()
 	fake.setRoutingAPIEndpointArgsForCall = append(fake.setRoutingAPIEndpointArgsForCall, struct {
@@ -1585,6 +1753,30 @@ func (fake *FakeReadWriter) SetColorEnabledArgsForCall(i int) string {
 	return fake.setColorEnabledArgsForCall[i].arg1
 }
 
+func (fake *FakeReadWriter) SetPagerEnabled(arg1 string) {
+	fake.setPagerEnabledMutex.Lock()
+	fake.setPagerEnabledArgsForCall = append(fake.setPagerEnabledArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetPagerEnabled", []interface{}{arg1})
+	fake.setPagerEnabledMutex.Unlock()
+	if fake.SetPagerEnabledStub != nil {
+		fake.SetPagerEnabledStub(arg1)
+	}
+}
+
+func (fake *FakeReadWriter) SetPagerEnabledCallCount() int {
+	fake.setPagerEnabledMutex.RLock()
+	defer fake.setPagerEnabledMutex.RUnlock()
+	return len(fake.setPagerEnabledArgsForCall)
+}
+
+func (fake *FakeReadWriter) SetPagerEnabledArgsForCall(i int) string {
+	fake.setPagerEnabledMutex.RLock()
+	defer fake.setPagerEnabledMutex.RUnlock()
+	return fake.setPagerEnabledArgsForCall[i].arg1
+}
+
 func (fake *FakeReadWriter) SetLocale(arg1 string) {
 	fake.setLocaleMutex.Lock()
 	fake.setLocaleArgsForCall = append(fake.setLocaleArgsForCall, struct {
@@ -1657,6 +1849,30 @@ func (fake *FakeReadWriter) UnSetPluginRepoArgsForCall(i int) int {
 	return fake.unSetPluginRepoArgsForCall[i].arg1
 }
 
+func (fake *FakeReadWriter) SetCACertFile(arg1 string) {
+	fake.setCACertFileMutex.Lock()
+	fake.setCACertFileArgsForCall = append(fake.setCACertFileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetCACertFile", []interface{}{arg1})
+	fake.setCACertFileMutex.Unlock()
+	if fake.SetCACertFileStub != nil {
+		fake.SetCACertFileStub(arg1)
+	}
+}
+
+func (fake *FakeReadWriter) SetCACertFileCallCount() int {
+	fake.setCACertFileMutex.RLock()
+	defer fake.setCACertFileMutex.RUnlock()
+	return len(fake.setCACertFileArgsForCall)
+}
+
+func (fake *FakeReadWriter) SetCACertFileArgsForCall(i int) string {
+	fake.setCACertFileMutex.RLock()
+	defer fake.setCACertFileMutex.RUnlock()
+	return fake.setCACertFileArgsForCall[i].arg1
+}
+
 func (fake *FakeReadWriter) SetCLIVersion(arg1 string) {
 	fake.setCLIVersionMutex.Lock()
 	fake.setCLIVersionArgsForCall = append(fake.setCLIVersionArgsForCall, struct {
@@ -1696,6 +1912,8 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.dopplerEndpointMutex.RUnlock()
 	fake.uaaEndpointMutex.RLock()
 	defer fake.uaaEndpointMutex.RUnlock()
+	fake.resourceMatchMinFileSizeMutex.RLock()
+	defer fake.resourceMatchMinFileSizeMutex.RUnlock()
 	fake.routingAPIEndpointMutex.RLock()
 	defer fake.routingAPIEndpointMutex.RUnlock()
 	fake.accessTokenMutex.RLock()
@@ -1734,6 +1952,8 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.minCLIVersionMutex.RUnlock()
 	fake.minRecommendedCLIVersionMutex.RLock()
 	defer fake.minRecommendedCLIVersionMutex.RUnlock()
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
 	fake.cLIVersionMutex.RLock()
 	defer fake.cLIVersionMutex.RUnlock()
 	fake.asyncTimeoutMutex.RLock()
@@ -1742,6 +1962,8 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.traceMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.pagerEnabledMutex.RLock()
+	defer fake.pagerEnabledMutex.RUnlock()
 	fake.localeMutex.RLock()
 	defer fake.localeMutex.RUnlock()
 	fake.pluginReposMutex.RLock()
@@ -1762,6 +1984,8 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.setDopplerEndpointMutex.RUnlock()
 	fake.setUaaEndpointMutex.RLock()
 	defer fake.setUaaEndpointMutex.RUnlock()
+	fake.setResourceMatchMinFileSizeMutex.RLock()
+	defer fake.setResourceMatchMinFileSizeMutex.RUnlock()
 	fake.setRoutingAPIEndpointMutex.RLock()
 	defer fake.setRoutingAPIEndpointMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
@@ -1786,12 +2010,16 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.setTraceMutex.RUnlock()
 	fake.setColorEnabledMutex.RLock()
 	defer fake.setColorEnabledMutex.RUnlock()
+	fake.setPagerEnabledMutex.RLock()
+	defer fake.setPagerEnabledMutex.RUnlock()
 	fake.setLocaleMutex.RLock()
 	defer fake.setLocaleMutex.RUnlock()
 	fake.setPluginRepoMutex.RLock()
 	defer fake.setPluginRepoMutex.RUnlock()
 	fake.unSetPluginRepoMutex.RLock()
 	defer fake.unSetPluginRepoMutex.RUnlock()
+	fake.setCACertFileMutex.RLock()
+	defer fake.setCACertFileMutex.RUnlock()
 	fake.setCLIVersionMutex.RLock()
 	defer fake.setCLIVersionMutex.RUnlock()
 	return fake.invocations
@@ -0,0 +1,20 @@
+package errors
+
+import (
+	. "code.cloudfoundry.org/cli/cf/i18n"
+)
+
+type InvalidCACertificate struct {
+	Path string
+}
+
+func NewInvalidCACertificate(path string) *InvalidCACertificate {
+	return &InvalidCACertificate{
+		Path: path,
+	}
+}
+
+func (err *InvalidCACertificate) Error() string {
+	return T("Unable to load CA certificates from {{.Path}}: the file must contain at least one PEM-encoded certificate.",
+		map[string]interface{}{"Path": err.Path})
+}
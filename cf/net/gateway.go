@@ -413,7 +413,10 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 	var err error
 
 	if gateway.transport == nil {
-		makeHTTPTransport(&gateway)
+		err = makeHTTPTransport(&gateway)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	httpClient := NewHTTPClient(gateway.transport, NewRequestDumper(gateway.logger))
@@ -445,15 +448,22 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 	return response, err
 }
 
-func makeHTTPTransport(gateway *Gateway) {
+func makeHTTPTransport(gateway *Gateway) error {
+	tlsConfig, err := NewTLSConfig(gateway.trustedCerts, gateway.config.IsSSLDisabled(), gateway.config.CACertFile())
+	if err != nil {
+		gateway.transport = nil
+		return err
+	}
+
 	gateway.transport = &http.Transport{
 		Dial: (&net.Dialer{
 			KeepAlive: 30 * time.Second,
 			Timeout:   gateway.DialTimeout,
 		}).Dial,
-		TLSClientConfig: NewTLSConfig(gateway.trustedCerts, gateway.config.IsSSLDisabled()),
+		TLSClientConfig: tlsConfig,
 		Proxy:           http.ProxyFromEnvironment,
 	}
+	return nil
 }
 
 func dialTimeout(envDialTimeout string) time.Duration {
@@ -466,5 +476,7 @@ func dialTimeout(envDialTimeout string) time.Duration {
 
 func (gateway *Gateway) SetTrustedCerts(certificates []tls.Certificate) {
 	gateway.trustedCerts = certificates
-	makeHTTPTransport(gateway)
+	// An invalid CA certificate file leaves the transport unset, so the error
+	// is returned by the next request when it tries to build the transport.
+	_ = makeHTTPTransport(gateway)
 }
@@ -594,6 +594,29 @@ var _ = Describe("Gateway", func() {
 			})
 		})
 
+		Context("when the CA certificate file is invalid", func() {
+			var caCertFile string
+
+			BeforeEach(func() {
+				file, err := ioutil.TempFile("", "gateway-ca")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(file.Close()).To(Succeed())
+				caCertFile = file.Name()
+
+				config.SetCACertFile(caCertFile)
+			})
+
+			AfterEach(func() {
+				os.Remove(caCertFile)
+			})
+
+			It("returns an invalid CA certificate error", func() {
+				_, apiErr := ccGateway.PerformRequest(request)
+				certErr, ok := apiErr.(*errors.InvalidCACertificate)
+				Expect(ok).To(BeTrue())
+				Expect(certErr.Path).To(Equal(caCertFile))
+			})
+		})
 	})
 
 	Describe("collecting warnings", func() {
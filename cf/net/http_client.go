@@ -80,6 +80,8 @@ func WrapNetworkErrors(host string, err error) error {
 		innerErr = typedErr.Err
 	case *websocket.DialError:
 		innerErr = typedErr.Err
+	case *errors.InvalidCACertificate:
+		return typedErr
 	}
 
 	if innerErr != nil {
@@ -3,23 +3,55 @@ package net
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/cf/errors"
 )
 
-func NewTLSConfig(trustedCerts []tls.Certificate, disableSSL bool) (TLSConfig *tls.Config) {
-	TLSConfig = &tls.Config{
+func NewTLSConfig(trustedCerts []tls.Certificate, disableSSL bool, caCertFile string) (*tls.Config, error) {
+	TLSConfig := &tls.Config{
 		MinVersion: tls.VersionTLS10,
 	}
 
+	if caCertFile != "" {
+		certPool, err := newCertPool(caCertFile)
+		if err != nil {
+			return nil, err
+		}
+		TLSConfig.RootCAs = certPool
+	}
+
 	if len(trustedCerts) > 0 {
-		certPool := x509.NewCertPool()
+		if TLSConfig.RootCAs == nil {
+			TLSConfig.RootCAs = x509.NewCertPool()
+		}
 		for _, tlsCert := range trustedCerts {
 			cert, _ := x509.ParseCertificate(tlsCert.Certificate[0])
-			certPool.AddCert(cert)
+			TLSConfig.RootCAs.AddCert(cert)
 		}
-		TLSConfig.RootCAs = certPool
 	}
 
 	TLSConfig.InsecureSkipVerify = disableSSL
 
-	return
+	return TLSConfig, nil
+}
+
+// newCertPool returns the system trust store with the PEM-encoded
+// certificates in caCertFile added to it.
+func newCertPool(caCertFile string) (*x509.CertPool, error) {
+	contents, err := ioutil.ReadFile(caCertFile)
+	if err != nil {
+		return nil, err
+	}
+
+	certPool, err := x509.SystemCertPool()
+	if err != nil || certPool == nil {
+		certPool = x509.NewCertPool()
+	}
+
+	if !certPool.AppendCertsFromPEM(contents) {
+		return nil, errors.NewInvalidCACertificate(caCertFile)
+	}
+
+	return certPool, nil
 }
@@ -42,7 +42,7 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 
 	if fc.IsSet("L") {
 		for _, arg := range fc.StringSlice("L") {
-			forwardSpec, err := sshOptions.parseLocalForwardingSpec(arg)
+			forwardSpec, err := ParseLocalForwardingSpec(arg)
 			if err != nil {
 				return sshOptions, err
 			}
@@ -65,7 +65,9 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 	return sshOptions, nil
 }
 
-func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error) {
+// ParseLocalForwardingSpec parses a local port forward specification of the
+// form [bind_address:]port:host:hostport.
+func ParseLocalForwardingSpec(arg string) (*ForwardSpec, error) {
 	arg = strings.TrimSpace(arg)
 
 	parts := []string{}
@@ -19,6 +19,11 @@ type FakeConfig struct {
 	accessTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	AddDetachedJobStub        func(job configv3.DetachedJob)
+	addDetachedJobMutex       sync.RWMutex
+	addDetachedJobArgsForCall []struct {
+		job configv3.DetachedJob
+	}
 	AddPluginStub        func(configv3.Plugin)
 	addPluginMutex       sync.RWMutex
 	addPluginArgsForCall []struct {
@@ -57,6 +62,15 @@ type FakeConfig struct {
 	binaryVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CACertFileStub        func() string
+	cACertFileMutex       sync.RWMutex
+	cACertFileArgsForCall []struct{}
+	cACertFileReturns     struct {
+		result1 string
+	}
+	cACertFileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	ColorEnabledStub        func() configv3.ColorSetting
 	colorEnabledMutex       sync.RWMutex
 	colorEnabledArgsForCall []struct{}
@@ -77,6 +91,15 @@ type FakeConfig struct {
 		result1 configv3.User
 		result2 error
 	}
+	DetachedJobsStub        func() []configv3.DetachedJob
+	detachedJobsMutex       sync.RWMutex
+	detachedJobsArgsForCall []struct{}
+	detachedJobsReturns     struct {
+		result1 []configv3.DetachedJob
+	}
+	detachedJobsReturnsOnCall map[int]struct {
+		result1 []configv3.DetachedJob
+	}
 	DialTimeoutStub        func() time.Duration
 	dialTimeoutMutex       sync.RWMutex
 	dialTimeoutArgsForCall []struct{}
@@ -148,6 +171,15 @@ type FakeConfig struct {
 	hasTargetedSpaceReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsTTYStub        func() bool
+	isTTYMutex       sync.RWMutex
+	isTTYArgsForCall []struct{}
+	isTTYReturns     struct {
+		result1 bool
+	}
+	isTTYReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	LocaleStub        func() string
 	localeMutex       sync.RWMutex
 	localeArgsForCall []struct{}
@@ -157,6 +189,15 @@ type FakeConfig struct {
 	localeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	MaxIdleConnsPerHostStub        func() int
+	maxIdleConnsPerHostMutex       sync.RWMutex
+	maxIdleConnsPerHostArgsForCall []struct{}
+	maxIdleConnsPerHostReturns     struct {
+		result1 int
+	}
+	maxIdleConnsPerHostReturnsOnCall map[int]struct {
+		result1 int
+	}
 	MinCLIVersionStub        func() string
 	minCLIVersionMutex       sync.RWMutex
 	minCLIVersionArgsForCall []struct{}
@@ -166,6 +207,15 @@ type FakeConfig struct {
 	minCLIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	OutputFormatStub        func() string
+	outputFormatMutex       sync.RWMutex
+	outputFormatArgsForCall []struct{}
+	outputFormatReturns     struct {
+		result1 string
+	}
+	outputFormatReturnsOnCall map[int]struct {
+		result1 string
+	}
 	OverallPollingTimeoutStub        func() time.Duration
 	overallPollingTimeoutMutex       sync.RWMutex
 	overallPollingTimeoutArgsForCall []struct{}
@@ -220,16 +270,35 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	RemoveDetachedJobStub        func(guid string)
+	removeDetachedJobMutex       sync.RWMutex
+	removeDetachedJobArgsForCall []struct {
+		guid string
+	}
 	RemovePluginStub        func(string)
 	removePluginMutex       sync.RWMutex
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	ResourceMatchMinFileSizeStub        func() int64
+	resourceMatchMinFileSizeMutex       sync.RWMutex
+	resourceMatchMinFileSizeArgsForCall []struct{}
+	resourceMatchMinFileSizeReturns     struct {
+		result1 int64
+	}
+	resourceMatchMinFileSizeReturnsOnCall map[int]struct {
+		result1 int64
+	}
 	SetAccessTokenStub        func(token string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
 		token string
 	}
+	SetCACertFileStub        func(caCertFile string)
+	setCACertFileMutex       sync.RWMutex
+	setCACertFileArgsForCall []struct {
+		caCertFile string
+	}
 	SetOrganizationInformationStub        func(guid string, name string)
 	setOrganizationInformationMutex       sync.RWMutex
 	setOrganizationInformationArgsForCall []struct {
@@ -266,11 +335,22 @@ type FakeConfig struct {
 		refreshToken   string
 		sshOAuthClient string
 	}
+	SetUAAClientCredentialsStub        func(client string, clientSecret string)
+	setUAAClientCredentialsMutex       sync.RWMutex
+	setUAAClientCredentialsArgsForCall []struct {
+		client       string
+		clientSecret string
+	}
 	SetUAAEndpointStub        func(uaaEndpoint string)
 	setUAAEndpointMutex       sync.RWMutex
 	setUAAEndpointArgsForCall []struct {
 		uaaEndpoint string
 	}
+	SetUAAGrantTypeStub        func(uaaGrantType string)
+	setUAAGrantTypeMutex       sync.RWMutex
+	setUAAGrantTypeArgsForCall []struct {
+		uaaGrantType string
+	}
 	SkipSSLValidationStub        func() bool
 	skipSSLValidationMutex       sync.RWMutex
 	skipSSLValidationArgsForCall []struct{}
@@ -289,6 +369,15 @@ type FakeConfig struct {
 	sSHOAuthClientReturnsOnCall map[int]struct {
 		result1 string
 	}
+	StagingRetriesStub        func() int
+	stagingRetriesMutex       sync.RWMutex
+	stagingRetriesArgsForCall []struct{}
+	stagingRetriesReturns     struct {
+		result1 int
+	}
+	stagingRetriesReturnsOnCall map[int]struct {
+		result1 int
+	}
 	StagingTimeoutStub        func() time.Duration
 	stagingTimeoutMutex       sync.RWMutex
 	stagingTimeoutArgsForCall []struct{}
@@ -334,6 +423,24 @@ type FakeConfig struct {
 	targetedSpaceReturnsOnCall map[int]struct {
 		result1 configv3.Space
 	}
+	TLSHandshakeTimeoutStub        func() time.Duration
+	tLSHandshakeTimeoutMutex       sync.RWMutex
+	tLSHandshakeTimeoutArgsForCall []struct{}
+	tLSHandshakeTimeoutReturns     struct {
+		result1 time.Duration
+	}
+	tLSHandshakeTimeoutReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
+	UAAGrantTypeStub        func() string
+	uAAGrantTypeMutex       sync.RWMutex
+	uAAGrantTypeArgsForCall []struct{}
+	uAAGrantTypeReturns     struct {
+		result1 string
+	}
+	uAAGrantTypeReturnsOnCall map[int]struct {
+		result1 string
+	}
 	UAAOAuthClientStub        func() string
 	uAAOAuthClientMutex       sync.RWMutex
 	uAAOAuthClientArgsForCall []struct{}
@@ -422,6 +529,30 @@ func (fake *FakeConfig) AccessTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) AddDetachedJob(job configv3.DetachedJob) {
+	fake.addDetachedJobMutex.Lock()
+	fake.addDetachedJobArgsForCall = append(fake.addDetachedJobArgsForCall, struct {
+		job configv3.DetachedJob
+	}{job})
+	fake.recordInvocation("AddDetachedJob", []interface{}{job})
+	fake.addDetachedJobMutex.Unlock()
+	if fake.AddDetachedJobStub != nil {
+		fake.AddDetachedJobStub(job)
+	}
+}
+
+func (fake *FakeConfig) AddDetachedJobCallCount() int {
+	fake.addDetachedJobMutex.RLock()
+	defer fake.addDetachedJobMutex.RUnlock()
+	return len(fake.addDetachedJobArgsForCall)
+}
+
+func (fake *FakeConfig) AddDetachedJobArgsForCall(i int) configv3.DetachedJob {
+	fake.addDetachedJobMutex.RLock()
+	defer fake.addDetachedJobMutex.RUnlock()
+	return fake.addDetachedJobArgsForCall[i].job
+}
+
 func (fake *FakeConfig) AddPlugin(arg1 configv3.Plugin) {
 	fake.addPluginMutex.Lock()
 	fake.addPluginArgsForCall = append(fake.addPluginArgsForCall, struct {
@@ -591,6 +722,46 @@ func (fake *FakeConfig) BinaryVersionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) CACertFile() string {
+	fake.cACertFileMutex.Lock()
+	ret, specificReturn := fake.cACertFileReturnsOnCall[len(fake.cACertFileArgsForCall)]
+	fake.cACertFileArgsForCall = append(fake.cACertFileArgsForCall, struct{}{})
+	fake.recordInvocation("CACertFile", []interface{}{})
+	fake.cACertFileMutex.Unlock()
+	if fake.CACertFileStub != nil {
+		return fake.CACertFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cACertFileReturns.result1
+}
+
+func (fake *FakeConfig) CACertFileCallCount() int {
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
+	return len(fake.cACertFileArgsForCall)
+}
+
+func (fake *FakeConfig) CACertFileReturns(result1 string) {
+	fake.CACertFileStub = nil
+	fake.cACertFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CACertFileReturnsOnCall(i int, result1 string) {
+	fake.CACertFileStub = nil
+	if fake.cACertFileReturnsOnCall == nil {
+		fake.cACertFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cACertFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) ColorEnabled() configv3.ColorSetting {
 	fake.colorEnabledMutex.Lock()
 	ret, specificReturn := fake.colorEnabledReturnsOnCall[len(fake.colorEnabledArgsForCall)]
@@ -674,6 +845,46 @@ func (fake *FakeConfig) CurrentUserReturnsOnCall(i int, result1 configv3.User, r
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) DetachedJobs() []configv3.DetachedJob {
+	fake.detachedJobsMutex.Lock()
+	ret, specificReturn := fake.detachedJobsReturnsOnCall[len(fake.detachedJobsArgsForCall)]
+	fake.detachedJobsArgsForCall = append(fake.detachedJobsArgsForCall, struct{}{})
+	fake.recordInvocation("DetachedJobs", []interface{}{})
+	fake.detachedJobsMutex.Unlock()
+	if fake.DetachedJobsStub != nil {
+		return fake.DetachedJobsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.detachedJobsReturns.result1
+}
+
+func (fake *FakeConfig) DetachedJobsCallCount() int {
+	fake.detachedJobsMutex.RLock()
+	defer fake.detachedJobsMutex.RUnlock()
+	return len(fake.detachedJobsArgsForCall)
+}
+
+func (fake *FakeConfig) DetachedJobsReturns(result1 []configv3.DetachedJob) {
+	fake.DetachedJobsStub = nil
+	fake.detachedJobsReturns = struct {
+		result1 []configv3.DetachedJob
+	}{result1}
+}
+
+func (fake *FakeConfig) DetachedJobsReturnsOnCall(i int, result1 []configv3.DetachedJob) {
+	fake.DetachedJobsStub = nil
+	if fake.detachedJobsReturnsOnCall == nil {
+		fake.detachedJobsReturnsOnCall = make(map[int]struct {
+			result1 []configv3.DetachedJob
+		})
+	}
+	fake.detachedJobsReturnsOnCall[i] = struct {
+		result1 []configv3.DetachedJob
+	}{result1}
+}
+
 func (fake *FakeConfig) DialTimeout() time.Duration {
 	fake.dialTimeoutMutex.Lock()
 	ret, specificReturn := fake.dialTimeoutReturnsOnCall[len(fake.dialTimeoutArgsForCall)]
@@ -976,6 +1187,46 @@ func (fake *FakeConfig) HasTargetedSpaceReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) IsTTY() bool {
+	fake.isTTYMutex.Lock()
+	ret, specificReturn := fake.isTTYReturnsOnCall[len(fake.isTTYArgsForCall)]
+	fake.isTTYArgsForCall = append(fake.isTTYArgsForCall, struct{}{})
+	fake.recordInvocation("IsTTY", []interface{}{})
+	fake.isTTYMutex.Unlock()
+	if fake.IsTTYStub != nil {
+		return fake.IsTTYStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isTTYReturns.result1
+}
+
+func (fake *FakeConfig) IsTTYCallCount() int {
+	fake.isTTYMutex.RLock()
+	defer fake.isTTYMutex.RUnlock()
+	return len(fake.isTTYArgsForCall)
+}
+
+func (fake *FakeConfig) IsTTYReturns(result1 bool) {
+	fake.IsTTYStub = nil
+	fake.isTTYReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) IsTTYReturnsOnCall(i int, result1 bool) {
+	fake.IsTTYStub = nil
+	if fake.isTTYReturnsOnCall == nil {
+		fake.isTTYReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isTTYReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) Locale() string {
 	fake.localeMutex.Lock()
 	ret, specificReturn := fake.localeReturnsOnCall[len(fake.localeArgsForCall)]
@@ -1016,6 +1267,46 @@ func (fake *FakeConfig) LocaleReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) MaxIdleConnsPerHost() int {
+	fake.maxIdleConnsPerHostMutex.Lock()
+	ret, specificReturn := fake.maxIdleConnsPerHostReturnsOnCall[len(fake.maxIdleConnsPerHostArgsForCall)]
+	fake.maxIdleConnsPerHostArgsForCall = append(fake.maxIdleConnsPerHostArgsForCall, struct{}{})
+	fake.recordInvocation("MaxIdleConnsPerHost", []interface{}{})
+	fake.maxIdleConnsPerHostMutex.Unlock()
+	if fake.MaxIdleConnsPerHostStub != nil {
+		return fake.MaxIdleConnsPerHostStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.maxIdleConnsPerHostReturns.result1
+}
+
+func (fake *FakeConfig) MaxIdleConnsPerHostCallCount() int {
+	fake.maxIdleConnsPerHostMutex.RLock()
+	defer fake.maxIdleConnsPerHostMutex.RUnlock()
+	return len(fake.maxIdleConnsPerHostArgsForCall)
+}
+
+func (fake *FakeConfig) MaxIdleConnsPerHostReturns(result1 int) {
+	fake.MaxIdleConnsPerHostStub = nil
+	fake.maxIdleConnsPerHostReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) MaxIdleConnsPerHostReturnsOnCall(i int, result1 int) {
+	fake.MaxIdleConnsPerHostStub = nil
+	if fake.maxIdleConnsPerHostReturnsOnCall == nil {
+		fake.maxIdleConnsPerHostReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.maxIdleConnsPerHostReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeConfig) MinCLIVersion() string {
 	fake.minCLIVersionMutex.Lock()
 	ret, specificReturn := fake.minCLIVersionReturnsOnCall[len(fake.minCLIVersionArgsForCall)]
@@ -1056,6 +1347,46 @@ func (fake *FakeConfig) MinCLIVersionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) OutputFormat() string {
+	fake.outputFormatMutex.Lock()
+	ret, specificReturn := fake.outputFormatReturnsOnCall[len(fake.outputFormatArgsForCall)]
+	fake.outputFormatArgsForCall = append(fake.outputFormatArgsForCall, struct{}{})
+	fake.recordInvocation("OutputFormat", []interface{}{})
+	fake.outputFormatMutex.Unlock()
+	if fake.OutputFormatStub != nil {
+		return fake.OutputFormatStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.outputFormatReturns.result1
+}
+
+func (fake *FakeConfig) OutputFormatCallCount() int {
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
+	return len(fake.outputFormatArgsForCall)
+}
+
+func (fake *FakeConfig) OutputFormatReturns(result1 string) {
+	fake.OutputFormatStub = nil
+	fake.outputFormatReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) OutputFormatReturnsOnCall(i int, result1 string) {
+	fake.OutputFormatStub = nil
+	if fake.outputFormatReturnsOnCall == nil {
+		fake.outputFormatReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.outputFormatReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) OverallPollingTimeout() time.Duration {
 	fake.overallPollingTimeoutMutex.Lock()
 	ret, specificReturn := fake.overallPollingTimeoutReturnsOnCall[len(fake.overallPollingTimeoutArgsForCall)]
@@ -1296,6 +1627,30 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RemoveDetachedJob(guid string) {
+	fake.removeDetachedJobMutex.Lock()
+	fake.removeDetachedJobArgsForCall = append(fake.removeDetachedJobArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("RemoveDetachedJob", []interface{}{guid})
+	fake.removeDetachedJobMutex.Unlock()
+	if fake.RemoveDetachedJobStub != nil {
+		fake.RemoveDetachedJobStub(guid)
+	}
+}
+
+func (fake *FakeConfig) RemoveDetachedJobCallCount() int {
+	fake.removeDetachedJobMutex.RLock()
+	defer fake.removeDetachedJobMutex.RUnlock()
+	return len(fake.removeDetachedJobArgsForCall)
+}
+
+func (fake *FakeConfig) RemoveDetachedJobArgsForCall(i int) string {
+	fake.removeDetachedJobMutex.RLock()
+	defer fake.removeDetachedJobMutex.RUnlock()
+	return fake.removeDetachedJobArgsForCall[i].guid
+}
+
 func (fake *FakeConfig) RemovePlugin(arg1 string) {
 	fake.removePluginMutex.Lock()
 	fake.removePluginArgsForCall = append(fake.removePluginArgsForCall, struct {
@@ -1320,6 +1675,46 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) ResourceMatchMinFileSize() int64 {
+	fake.resourceMatchMinFileSizeMutex.Lock()
+	ret, specificReturn := fake.resourceMatchMinFileSizeReturnsOnCall[len(fake.resourceMatchMinFileSizeArgsForCall)]
+	fake.resourceMatchMinFileSizeArgsForCall = append(fake.resourceMatchMinFileSizeArgsForCall, struct{}{})
+	fake.recordInvocation("ResourceMatchMinFileSize", []interface{}{})
+	fake.resourceMatchMinFileSizeMutex.Unlock()
+	if fake.ResourceMatchMinFileSizeStub != nil {
+		return fake.ResourceMatchMinFileSizeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.resourceMatchMinFileSizeReturns.result1
+}
+
+func (fake *FakeConfig) ResourceMatchMinFileSizeCallCount() int {
+	fake.resourceMatchMinFileSizeMutex.RLock()
+	defer fake.resourceMatchMinFileSizeMutex.RUnlock()
+	return len(fake.resourceMatchMinFileSizeArgsForCall)
+}
+
+func (fake *FakeConfig) ResourceMatchMinFileSizeReturns(result1 int64) {
+	fake.ResourceMatchMinFileSizeStub = nil
+	fake.resourceMatchMinFileSizeReturns = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeConfig) ResourceMatchMinFileSizeReturnsOnCall(i int, result1 int64) {
+	fake.ResourceMatchMinFileSizeStub = nil
+	if fake.resourceMatchMinFileSizeReturnsOnCall == nil {
+		fake.resourceMatchMinFileSizeReturnsOnCall = make(map[int]struct {
+			result1 int64
+		})
+	}
+	fake.resourceMatchMinFileSizeReturnsOnCall[i] = struct {
+		result1 int64
+	}{result1}
+}
+
 func (fake *FakeConfig) SetAccessToken(token string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -1344,6 +1739,30 @@ func (fake *FakeConfig) SetAccessTokenArgsForCall(i int) string {
 	return fake.setAccessTokenArgsForCall[i].token
 }
 
+func (fake *FakeConfig) SetCACertFile(caCertFile string) {
+	fake.setCACertFileMutex.Lock()
+	fake.setCACertFileArgsForCall = append(fake.setCACertFileArgsForCall, struct {
+		caCertFile string
+	}{caCertFile})
+	fake.recordInvocation("SetCACertFile", []interface{}{caCertFile})
+	fake.setCACertFileMutex.Unlock()
+	if fake.SetCACertFileStub != nil {
+		fake.SetCACertFileStub(caCertFile)
+	}
+}
+
+func (fake *FakeConfig) SetCACertFileCallCount() int {
+	fake.setCACertFileMutex.RLock()
+	defer fake.setCACertFileMutex.RUnlock()
+	return len(fake.setCACertFileArgsForCall)
+}
+
+func (fake *FakeConfig) SetCACertFileArgsForCall(i int) string {
+	fake.setCACertFileMutex.RLock()
+	defer fake.setCACertFileMutex.RUnlock()
+	return fake.setCACertFileArgsForCall[i].caCertFile
+}
+
 func (fake *FakeConfig) SetOrganizationInformation(guid string, name string) {
 	fake.setOrganizationInformationMutex.Lock()
 	fake.setOrganizationInformationArgsForCall = append(fake.setOrganizationInformationArgsForCall, struct {
@@ -1475,6 +1894,31 @@ func (fake *FakeConfig) SetTokenInformationArgsForCall(i int) (string, string, s
 	return fake.setTokenInformationArgsForCall[i].accessToken, fake.setTokenInformationArgsForCall[i].refreshToken, fake.setTokenInformationArgsForCall[i].sshOAuthClient
 }
 
+func (fake *FakeConfig) SetUAAClientCredentials(client string, clientSecret string) {
+	fake.setUAAClientCredentialsMutex.Lock()
+	fake.setUAAClientCredentialsArgsForCall = append(fake.setUAAClientCredentialsArgsForCall, struct {
+		client       string
+		clientSecret string
+	}{client, clientSecret})
+	fake.recordInvocation("SetUAAClientCredentials", []interface{}{client, clientSecret})
+	fake.setUAAClientCredentialsMutex.Unlock()
+	if fake.SetUAAClientCredentialsStub != nil {
+		fake.SetUAAClientCredentialsStub(client, clientSecret)
+	}
+}
+
+func (fake *FakeConfig) SetUAAClientCredentialsCallCount() int {
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	return len(fake.setUAAClientCredentialsArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAClientCredentialsArgsForCall(i int) (string, string) {
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	return fake.setUAAClientCredentialsArgsForCall[i].client, fake.setUAAClientCredentialsArgsForCall[i].clientSecret
+}
+
 func (fake *FakeConfig) SetUAAEndpoint(uaaEndpoint string) {
 	fake.setUAAEndpointMutex.Lock()
 	fake.setUAAEndpointArgsForCall = append(fake.setUAAEndpointArgsForCall, struct {
@@ -1499,6 +1943,30 @@ func (fake *FakeConfig) SetUAAEndpointArgsForCall(i int) string {
 	return fake.setUAAEndpointArgsForCall[i].uaaEndpoint
 }
 
+func (fake *FakeConfig) SetUAAGrantType(uaaGrantType string) {
+	fake.setUAAGrantTypeMutex.Lock()
+	fake.setUAAGrantTypeArgsForCall = append(fake.setUAAGrantTypeArgsForCall, struct {
+		uaaGrantType string
+	}{uaaGrantType})
+	fake.recordInvocation("SetUAAGrantType", []interface{}{uaaGrantType})
+	fake.setUAAGrantTypeMutex.Unlock()
+	if fake.SetUAAGrantTypeStub != nil {
+		fake.SetUAAGrantTypeStub(uaaGrantType)
+	}
+}
+
+func (fake *FakeConfig) SetUAAGrantTypeCallCount() int {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return len(fake.setUAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAGrantTypeArgsForCall(i int) string {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return fake.setUAAGrantTypeArgsForCall[i].uaaGrantType
+}
+
 func (fake *FakeConfig) SkipSSLValidation() bool {
 	fake.skipSSLValidationMutex.Lock()
 	ret, specificReturn := fake.skipSSLValidationReturnsOnCall[len(fake.skipSSLValidationArgsForCall)]
@@ -1579,6 +2047,46 @@ func (fake *FakeConfig) SSHOAuthClientReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) StagingRetries() int {
+	fake.stagingRetriesMutex.Lock()
+	ret, specificReturn := fake.stagingRetriesReturnsOnCall[len(fake.stagingRetriesArgsForCall)]
+	fake.stagingRetriesArgsForCall = append(fake.stagingRetriesArgsForCall, struct{}{})
+	fake.recordInvocation("StagingRetries", []interface{}{})
+	fake.stagingRetriesMutex.Unlock()
+	if fake.StagingRetriesStub != nil {
+		return fake.StagingRetriesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.stagingRetriesReturns.result1
+}
+
+func (fake *FakeConfig) StagingRetriesCallCount() int {
+	fake.stagingRetriesMutex.RLock()
+	defer fake.stagingRetriesMutex.RUnlock()
+	return len(fake.stagingRetriesArgsForCall)
+}
+
+func (fake *FakeConfig) StagingRetriesReturns(result1 int) {
+	fake.StagingRetriesStub = nil
+	fake.stagingRetriesReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) StagingRetriesReturnsOnCall(i int, result1 int) {
+	fake.StagingRetriesStub = nil
+	if fake.stagingRetriesReturnsOnCall == nil {
+		fake.stagingRetriesReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.stagingRetriesReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeConfig) StagingTimeout() time.Duration {
 	fake.stagingTimeoutMutex.Lock()
 	ret, specificReturn := fake.stagingTimeoutReturnsOnCall[len(fake.stagingTimeoutArgsForCall)]
@@ -1779,6 +2287,86 @@ func (fake *FakeConfig) TargetedSpaceReturnsOnCall(i int, result1 configv3.Space
 	}{result1}
 }
 
+func (fake *FakeConfig) TLSHandshakeTimeout() time.Duration {
+	fake.tLSHandshakeTimeoutMutex.Lock()
+	ret, specificReturn := fake.tLSHandshakeTimeoutReturnsOnCall[len(fake.tLSHandshakeTimeoutArgsForCall)]
+	fake.tLSHandshakeTimeoutArgsForCall = append(fake.tLSHandshakeTimeoutArgsForCall, struct{}{})
+	fake.recordInvocation("TLSHandshakeTimeout", []interface{}{})
+	fake.tLSHandshakeTimeoutMutex.Unlock()
+	if fake.TLSHandshakeTimeoutStub != nil {
+		return fake.TLSHandshakeTimeoutStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.tLSHandshakeTimeoutReturns.result1
+}
+
+func (fake *FakeConfig) TLSHandshakeTimeoutCallCount() int {
+	fake.tLSHandshakeTimeoutMutex.RLock()
+	defer fake.tLSHandshakeTimeoutMutex.RUnlock()
+	return len(fake.tLSHandshakeTimeoutArgsForCall)
+}
+
+func (fake *FakeConfig) TLSHandshakeTimeoutReturns(result1 time.Duration) {
+	fake.TLSHandshakeTimeoutStub = nil
+	fake.tLSHandshakeTimeoutReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) TLSHandshakeTimeoutReturnsOnCall(i int, result1 time.Duration) {
+	fake.TLSHandshakeTimeoutStub = nil
+	if fake.tLSHandshakeTimeoutReturnsOnCall == nil {
+		fake.tLSHandshakeTimeoutReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.tLSHandshakeTimeoutReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAGrantType() string {
+	fake.uAAGrantTypeMutex.Lock()
+	ret, specificReturn := fake.uAAGrantTypeReturnsOnCall[len(fake.uAAGrantTypeArgsForCall)]
+	fake.uAAGrantTypeArgsForCall = append(fake.uAAGrantTypeArgsForCall, struct{}{})
+	fake.recordInvocation("UAAGrantType", []interface{}{})
+	fake.uAAGrantTypeMutex.Unlock()
+	if fake.UAAGrantTypeStub != nil {
+		return fake.UAAGrantTypeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.uAAGrantTypeReturns.result1
+}
+
+func (fake *FakeConfig) UAAGrantTypeCallCount() int {
+	fake.uAAGrantTypeMutex.RLock()
+	defer fake.uAAGrantTypeMutex.RUnlock()
+	return len(fake.uAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeConfig) UAAGrantTypeReturns(result1 string) {
+	fake.UAAGrantTypeStub = nil
+	fake.uAAGrantTypeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAGrantTypeReturnsOnCall(i int, result1 string) {
+	fake.UAAGrantTypeStub = nil
+	if fake.uAAGrantTypeReturnsOnCall == nil {
+		fake.uAAGrantTypeReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uAAGrantTypeReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) UAAOAuthClient() string {
 	fake.uAAOAuthClientMutex.Lock()
 	ret, specificReturn := fake.uAAOAuthClientReturnsOnCall[len(fake.uAAOAuthClientArgsForCall)]
@@ -1979,6 +2567,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.accessTokenMutex.RLock()
 	defer fake.accessTokenMutex.RUnlock()
+	fake.addDetachedJobMutex.RLock()
+	defer fake.addDetachedJobMutex.RUnlock()
 	fake.addPluginMutex.RLock()
 	defer fake.addPluginMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
@@ -1989,10 +2579,14 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
 	defer fake.binaryVersionMutex.RUnlock()
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
+	fake.detachedJobsMutex.RLock()
+	defer fake.detachedJobsMutex.RUnlock()
 	fake.dialTimeoutMutex.RLock()
 	defer fake.dialTimeoutMutex.RUnlock()
 	fake.dockerPasswordMutex.RLock()
@@ -2007,10 +2601,16 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
 	defer fake.hasTargetedSpaceMutex.RUnlock()
+	fake.isTTYMutex.RLock()
+	defer fake.isTTYMutex.RUnlock()
 	fake.localeMutex.RLock()
 	defer fake.localeMutex.RUnlock()
+	fake.maxIdleConnsPerHostMutex.RLock()
+	defer fake.maxIdleConnsPerHostMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
@@ -2023,10 +2623,16 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.pollingIntervalMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.removeDetachedJobMutex.RLock()
+	defer fake.removeDetachedJobMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.resourceMatchMinFileSizeMutex.RLock()
+	defer fake.resourceMatchMinFileSizeMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
+	fake.setCACertFileMutex.RLock()
+	defer fake.setCACertFileMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
 	defer fake.setOrganizationInformationMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
@@ -2037,12 +2643,18 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setTargetInformationMutex.RUnlock()
 	fake.setTokenInformationMutex.RLock()
 	defer fake.setTokenInformationMutex.RUnlock()
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
 	fake.setUAAEndpointMutex.RLock()
 	defer fake.setUAAEndpointMutex.RUnlock()
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
 	fake.skipSSLValidationMutex.RLock()
 	defer fake.skipSSLValidationMutex.RUnlock()
 	fake.sSHOAuthClientMutex.RLock()
 	defer fake.sSHOAuthClientMutex.RUnlock()
+	fake.stagingRetriesMutex.RLock()
+	defer fake.stagingRetriesMutex.RUnlock()
 	fake.stagingTimeoutMutex.RLock()
 	defer fake.stagingTimeoutMutex.RUnlock()
 	fake.startupTimeoutMutex.RLock()
@@ -2053,6 +2665,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.targetedOrganizationMutex.RUnlock()
 	fake.targetedSpaceMutex.RLock()
 	defer fake.targetedSpaceMutex.RUnlock()
+	fake.tLSHandshakeTimeoutMutex.RLock()
+	defer fake.tLSHandshakeTimeoutMutex.RUnlock()
+	fake.uAAGrantTypeMutex.RLock()
+	defer fake.uAAGrantTypeMutex.RUnlock()
 	fake.uAAOAuthClientMutex.RLock()
 	defer fake.uAAOAuthClientMutex.RUnlock()
 	fake.uAAOAuthClientSecretMutex.RLock()
@@ -11,27 +11,48 @@ import (
 var Commands commandList
 
 type commandList struct {
-	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	VerboseOrVersion bool   `short:"v" long:"version" description:"verbose and version flag"`
+	NoPager          bool   `long:"no-pager" description:"Do not pipe long output through $PAGER"`
+	ErrorFormat      string `long:"error-format" choice:"text" choice:"json" description:"Output errors as text or as JSON objects with a code, message and details"`
+	Output           string `long:"output" choice:"text" choice:"json" description:"Output the results of read-only commands as text or as JSON documents"`
 
 	V2Push v2.V2PushCommand `command:"v2-push" description:"Push a new app or sync changes to an existing app"`
 
-	V3App                v3.V3AppCommand                `command:"v3-app" description:"Display health and status for an app"`
-	V3Apps               v3.V3AppsCommand               `command:"v3-apps" description:"List all apps in the target space"`
-	V3CreateApp          v3.V3CreateAppCommand          `command:"v3-create-app" description:"**EXPERIMENTAL** Create a V3 App"`
-	V3DeleteApp          v3.V3DeleteCommand             `command:"v3-delete" description:"**EXPERIMENTAL** Delete a V3 App"`
-	V3CreatePackage      v3.V3CreatePackageCommand      `command:"v3-create-package" description:"**EXPERIMENTAL** Uploads a V3 Package"`
-	V3GetHealthCheck     v3.V3GetHealthCheckCommand     `command:"v3-get-health-check" description:"**EXPERIMENTAL** Show the type of health check performed on an app"`
-	V3Droplets           v3.V3DropletsCommand           `command:"v3-droplets" description:"**EXPERIMENTAL** List droplets of an app"`
-	V3Packages           v3.V3PackagesCommand           `command:"v3-packages" description:"**EXPERIMENTAL** List packages of an app"`
-	V3Push               v3.V3PushCommand               `command:"v3-push" description:"Push a new app or sync changes to an existing app"`
-	V3Restart            v3.V3RestartCommand            `command:"v3-restart" description:"Stop all instances of the app, then start them again. This may cause downtime."`
-	V3RestartAppInstance v3.V3RestartAppInstanceCommand `command:"v3-restart-app-instance" description:"**EXPERIMENTAL** Terminate, then instantiate an app instance"`
-	V3Scale              v3.V3ScaleCommand              `command:"v3-scale" description:"**EXPERIMENTAL** Change or view the instance count, disk space limit, and memory limit for an app"`
-	V3SetDroplet         v3.V3SetDropletCommand         `command:"v3-set-droplet" description:"Set the droplet used to run an app"`
-	V3SetHealthCheck     v3.V3SetHealthCheckCommand     `command:"v3-set-health-check" description:"**EXPERIMENTAL** Change type of health check performed on an app's process"`
-	V3Stage              v3.V3StageCommand              `command:"v3-stage" description:"**EXPERIMENTAL** Create a new droplet for an app"`
-	V3Start              v3.V3StartCommand              `command:"v3-start" description:"Start an app"`
-	V3Stop               v3.V3StopCommand               `command:"v3-stop" description:"Stop an app"`
+	V3App                    v3.V3AppCommand                    `command:"v3-app" description:"Display health and status for an app"`
+	V3Apps                   v3.V3AppsCommand                   `command:"v3-apps" description:"List all apps in the target space"`
+	V3ApplyManifest          v3.V3ApplyManifestCommand          `command:"v3-apply-manifest" description:"**EXPERIMENTAL** Applies manifest properties to an application"`
+	V3CancelDeployment       v3.V3CancelDeploymentCommand       `command:"v3-cancel-deployment" description:"**EXPERIMENTAL** Cancel the active deployment of an app and roll it back to its previous droplet"`
+	V3CopySource             v3.V3CopySourceCommand             `command:"v3-copy-source" description:"**EXPERIMENTAL** Copies the source code of an application to another existing application (and restarts that application)"`
+	V3CreateApp              v3.V3CreateAppCommand              `command:"v3-create-app" description:"**EXPERIMENTAL** Create a V3 App"`
+	V3DeleteApp              v3.V3DeleteCommand                 `command:"v3-delete" description:"**EXPERIMENTAL** Delete a V3 App"`
+	V3DeleteDroplet          v3.V3DeleteDropletCommand          `command:"v3-delete-droplet" description:"**EXPERIMENTAL** Delete a droplet"`
+	V3DeletePackage          v3.V3DeletePackageCommand          `command:"v3-delete-package" description:"**EXPERIMENTAL** Delete a package"`
+	V3DeleteOrphanedPackages v3.V3DeleteOrphanedPackagesCommand `command:"v3-delete-orphaned-packages" description:"**EXPERIMENTAL** Delete all packages and droplets in the target space that are not in use by an app"`
+	V3CreatePackage          v3.V3CreatePackageCommand          `command:"v3-create-package" description:"**EXPERIMENTAL** Uploads a V3 Package"`
+	V3GetHealthCheck         v3.V3GetHealthCheckCommand         `command:"v3-get-health-check" description:"**EXPERIMENTAL** Show the type of health check performed on an app"`
+	V3Droplets               v3.V3DropletsCommand               `command:"v3-droplets" description:"**EXPERIMENTAL** List droplets of an app"`
+	V3Env                    v3.V3EnvCommand                    `command:"v3-env" description:"**EXPERIMENTAL** Show all env variables for an app"`
+	V3Files                  v3.V3FilesCommand                  `command:"v3-files" description:"**EXPERIMENTAL** List the files of an app instance or download one of them over SSH"`
+	V3Logs                   v3.V3LogsCommand                   `command:"v3-logs" description:"**EXPERIMENTAL** Tail or show recent logs for an app from Log Cache"`
+	V3MapRoute               v3.V3MapRouteCommand               `command:"v3-map-route" description:"**EXPERIMENTAL** Send a share of a route's traffic to an app's process"`
+	V3Packages               v3.V3PackagesCommand               `command:"v3-packages" description:"**EXPERIMENTAL** List packages of an app"`
+	V3Push                   v3.V3PushCommand                   `command:"v3-push" description:"Push a new app or sync changes to an existing app"`
+	V3Restage                v3.V3RestageCommand                `command:"v3-restage" description:"**EXPERIMENTAL** Recreate the app's executable artifact using the latest pushed app files and the latest environment (variables, service bindings, buildpack, stack, etc.)"`
+	V3Restart                v3.V3RestartCommand                `command:"v3-restart" description:"Stop all instances of the app, then start them again. This may cause downtime."`
+	V3RestartAppInstance     v3.V3RestartAppInstanceCommand     `command:"v3-restart-app-instance" description:"**EXPERIMENTAL** Terminate, then instantiate an app instance"`
+	V3Revisions              v3.V3RevisionsCommand              `command:"v3-revisions" description:"**EXPERIMENTAL** List revisions of an app"`
+	V3Rollback               v3.V3RollbackCommand               `command:"v3-rollback" description:"**EXPERIMENTAL** Roll back an app to a previous revision"`
+	V3Scale                  v3.V3ScaleCommand                  `command:"v3-scale" description:"**EXPERIMENTAL** Change or view the instance count, disk space limit, and memory limit for an app"`
+	V3SetDroplet             v3.V3SetDropletCommand             `command:"v3-set-droplet" description:"Set the droplet used to run an app"`
+	V3SetEnv                 v3.V3SetEnvCommand                 `command:"v3-set-env" description:"**EXPERIMENTAL** Set an env variable for an app"`
+	V3SetHealthCheck         v3.V3SetHealthCheckCommand         `command:"v3-set-health-check" description:"**EXPERIMENTAL** Change type of health check performed on an app's process"`
+	V3SetProcessCommand      v3.V3SetProcessCommandCommand      `command:"v3-set-process-command" description:"**EXPERIMENTAL** Change the start command of an app's process"`
+	V3SSH                    v3.V3SSHCommand                    `command:"v3-ssh" description:"**EXPERIMENTAL** SSH to an application container instance"`
+	V3Stage                  v3.V3StageCommand                  `command:"v3-stage" description:"**EXPERIMENTAL** Create a new droplet for an app"`
+	V3Start                  v3.V3StartCommand                  `command:"v3-start" description:"Start an app"`
+	V3Stop                   v3.V3StopCommand                   `command:"v3-stop" description:"Stop an app"`
+	V3UnmapRoute             v3.V3UnmapRouteCommand             `command:"v3-unmap-route" description:"**EXPERIMENTAL** Stop sending a route's traffic to an app's process"`
+	V3UnsetEnv               v3.V3UnsetEnvCommand               `command:"v3-unset-env" description:"**EXPERIMENTAL** Remove an env variable from an app"`
 
 	AddPluginRepo                      plugin.AddPluginRepoCommand                  `command:"add-plugin-repo" description:"Add a new plugin repository"`
 	AddNetworkPolicy                   v3.AddNetworkPolicyCommand                   `command:"add-network-policy" description:"Create policy to allow direct network traffic from one app to another"`
@@ -39,6 +60,7 @@ type commandList struct {
 	Api                                v2.ApiCommand                                `command:"api" description:"Set or view target api url"`
 	Apps                               v2.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
 	App                                v2.AppCommand                                `command:"app" description:"Display health and status for an app"`
+	AppEnvPreview                      v2.AppEnvPreviewCommand                      `command:"app-env-preview" description:"Preview the service environment of an app after binding or unbinding service instances"`
 	Auth                               v2.AuthCommand                               `command:"auth" description:"Authenticate user non-interactively"`
 	BindRouteService                   v2.BindRouteServiceCommand                   `command:"bind-route-service" alias:"brs" description:"Bind a service instance to an HTTP route"`
 	BindRunningSecurityGroup           v2.BindRunningSecurityGroupCommand           `command:"bind-running-security-group" description:"Bind a security group to the list of security groups to be used for running applications"`
@@ -47,6 +69,8 @@ type commandList struct {
 	BindStagingSecurityGroup           v2.BindStagingSecurityGroupCommand           `command:"bind-staging-security-group" description:"Bind a security group to the list of security groups to be used for staging applications"`
 	Buildpacks                         v2.BuildpacksCommand                         `command:"buildpacks" description:"List all buildpacks"`
 	CheckRoute                         v2.CheckRouteCommand                         `command:"check-route" description:"Perform a simple check to determine whether a route currently exists or not"`
+	CheckSecurityGroupRules            v2.CheckSecurityGroupRulesCommand            `command:"check-security-group-rules" description:"Check a security group rules file for problems without contacting the API"`
+	CompareApp                         v2.CompareAppCommand                         `command:"compare-app" description:"Compare the configuration of an app with the same-named app in another space"`
 	Config                             v2.ConfigCommand                             `command:"config" description:"Write default values to the config"`
 	CopySource                         v2.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application (and restarts that application)"`
 	CreateAppManifest                  v2.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
@@ -103,6 +127,8 @@ type commandList struct {
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
 	InstallPlugin                      InstallPluginCommand                         `command:"install-plugin" description:"Install CLI plugin"`
 	IsolationSegments                  v3.IsolationSegmentsCommand                  `command:"isolation-segments" description:"List all isolation segments"`
+	Jobs                               v2.JobsCommand                               `command:"jobs" description:"Show the status of background jobs started with --detach"`
+	Labels                             v3.LabelsCommand                             `command:"labels" description:"List all labels (key-value pairs) for an API resource"`
 	NetworkPolicies                    v3.NetworkPoliciesCommand                    `command:"network-policies" description:"List direct network traffic policies"`
 	ListPluginRepos                    plugin.ListPluginReposCommand                `command:"list-plugin-repos" description:"List all the added plugin repositories"`
 	Login                              v2.LoginCommand                              `command:"login" alias:"l" description:"Log user in"`
@@ -153,6 +179,7 @@ type commandList struct {
 	Service                            v2.ServiceCommand                            `command:"service" description:"Show service instance info"`
 	SetEnv                             v2.SetEnvCommand                             `command:"set-env" alias:"se" description:"Set an env variable for an app"`
 	SetHealthCheck                     v2.SetHealthCheckCommand                     `command:"set-health-check" description:"Change type of health check performed on an app"`
+	SetLabel                           v3.SetLabelCommand                           `command:"set-label" description:"Set a label (key-value pairs) for an API resource"`
 	SetOrgDefaultIsolationSegment      v3.SetOrgDefaultIsolationSegmentCommand      `command:"set-org-default-isolation-segment" description:"Set the default isolation segment used for apps in spaces in an org"`
 	SetOrgRole                         v2.SetOrgRoleCommand                         `command:"set-org-role" description:"Assign an org role to a user"`
 	SetQuota                           v2.SetQuotaCommand                           `command:"set-quota" description:"Assign a quota to an org"`
@@ -162,6 +189,7 @@ type commandList struct {
 	SetSpaceRole                       v2.SetSpaceRoleCommand                       `command:"set-space-role" description:"Assign a space role to a user"`
 	SetStagingEnvironmentVariableGroup v2.SetStagingEnvironmentVariableGroupCommand `command:"set-staging-environment-variable-group" alias:"ssevg" description:"Pass parameters as JSON to create a staging environment variable group"`
 	SharePrivateDomain                 v2.SharePrivateDomainCommand                 `command:"share-private-domain" description:"Share a private domain with an org"`
+	ShareService                       v3.ShareServiceCommand                       `command:"share-service" description:"Share a service instance with another space"`
 	SpaceQuotas                        v2.SpaceQuotasCommand                        `command:"space-quotas" description:"List available space resource quotas"`
 	SpaceQuota                         v2.SpaceQuotaCommand                         `command:"space-quota" description:"Show space quota info"`
 	SpaceSSHAllowed                    v2.SpaceSSHAllowedCommand                    `command:"space-ssh-allowed" description:"Reports whether SSH is allowed in a space"`
@@ -188,12 +216,15 @@ type commandList struct {
 	UninstallPlugin                    plugin.UninstallPluginCommand                `command:"uninstall-plugin" description:"Uninstall CLI plugin"`
 	UnmapRoute                         v2.UnmapRouteCommand                         `command:"unmap-route" description:"Remove a url route from an app"`
 	UnsetEnv                           v2.UnsetEnvCommand                           `command:"unset-env" description:"Remove an env variable"`
+	UnsetLabel                         v3.UnsetLabelCommand                         `command:"unset-label" description:"Unset a label (key-value pairs) for an API resource"`
 	UnsetOrgRole                       v2.UnsetOrgRoleCommand                       `command:"unset-org-role" description:"Remove an org role from a user"`
 	UnsetSpaceQuota                    v2.UnsetSpaceQuotaCommand                    `command:"unset-space-quota" description:"Unassign a quota from a space"`
 	UnsetSpaceRole                     v2.UnsetSpaceRoleCommand                     `command:"unset-space-role" description:"Remove a space role from a user"`
 	UnsharePrivateDomain               v2.UnsharePrivateDomainCommand               `command:"unshare-private-domain" description:"Unshare a private domain with an org"`
+	UnshareService                     v3.UnshareServiceCommand                     `command:"unshare-service" description:"Unshare a shared service instance from a space"`
 	UpdateBuildpack                    v2.UpdateBuildpackCommand                    `command:"update-buildpack" description:"Update a buildpack"`
 	UpdateQuota                        v2.UpdateQuotaCommand                        `command:"update-quota" description:"Update an existing resource quota"`
+	UpdateRouterGroup                  v2.UpdateRouterGroupCommand                  `command:"update-router-group" description:"Update the reservable ports of a router group"`
 	UpdateSecurityGroup                v2.UpdateSecurityGroupCommand                `command:"update-security-group" description:"Update a security group"`
 	UpdateServiceAuthToken             v2.UpdateServiceAuthTokenCommand             `command:"update-service-auth-token" description:"Update a service auth token"`
 	UpdateServiceBroker                v2.UpdateServiceBrokerCommand                `command:"update-service-broker" description:"Update a service broker"`
@@ -131,7 +131,7 @@ func (cmd HelpCommand) displayHelpFooter() {
 	cmd.UI.DisplayNewline()
 
 	cmd.UI.DisplayHeader("GLOBAL OPTIONS:")
-	cmd.UI.DisplayNonWrappingTable(allCommandsIndent, cmd.globalOptionsTableData(), 25)
+	cmd.UI.DisplayNonWrappingTable(allCommandsIndent, cmd.globalOptionsTableData(), 16)
 }
 
 func (cmd HelpCommand) displayCommonCommands() {
@@ -199,7 +199,7 @@ func (cmd HelpCommand) displayCommonCommands() {
 	cmd.UI.DisplayNewline()
 
 	cmd.UI.DisplayHeader("Global options:")
-	cmd.UI.DisplayNonWrappingTable(commonCommandsIndent, cmd.globalOptionsTableData(), 25)
+	cmd.UI.DisplayNonWrappingTable(commonCommandsIndent, cmd.globalOptionsTableData(), 16)
 	cmd.UI.DisplayNewline()
 
 	cmd.UI.DisplayText("Use 'cf help -a' to see all commands.")
@@ -299,16 +299,26 @@ func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
 		{"CF_DIAL_TIMEOUT=5", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
+		{"CF_LOCALE_DIR=path/to/dir/", cmd.UI.TranslateText("Load additional translation files from this directory")},
+		{"CF_MAX_IDLE_CONNS_PER_HOST=10", cmd.UI.TranslateText("Number of idle keep-alive connections kept open to each API host")},
+		{"CF_OUTPUT=json", cmd.UI.TranslateText("Output the results of read-only commands as JSON documents where supported")},
+		{"CF_OUTPUT_WIDTH=120", cmd.UI.TranslateText("Wrap output to this many columns instead of the terminal width")},
+		{"CF_PAGER=true", cmd.UI.TranslateText("Pipe long output through $PAGER")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_TLS_HANDSHAKE_TIMEOUT=10", cmd.UI.TranslateText("Max wait time for the TLS handshake with an API host, in seconds")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
+		{"CF_TRACE_MAX_SIZE=10485760", cmd.UI.TranslateText("Rotate the trace log file once it reaches this many bytes")},
 		{"https_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Enable HTTP proxying for API requests")},
 	}
 }
 
 func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
+		{"--error-format=json", cmd.UI.TranslateText("Output errors to stderr as JSON objects with a code, message and details")},
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
+		{"--no-pager", cmd.UI.TranslateText("Do not pipe long output through $PAGER")},
+		{"--output=json", cmd.UI.TranslateText("Output the results of read-only commands to stdout as JSON documents where supported")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 	}
 }
@@ -411,6 +411,7 @@ var _ = Describe("help Command", func() {
 			Expect(testUI.Out).To(Say("  install-plugin    list-plugin-repos"))
 
 			Expect(testUI.Out).To(Say("Global options:"))
+			Expect(testUI.Out).To(Say("  --error-format=json                Output errors to stderr as JSON objects with a code, message and details"))
 			Expect(testUI.Out).To(Say("  --help, -h                         Show help"))
 			Expect(testUI.Out).To(Say("  -v                                 Print API request diagnostics to stdout"))
 
@@ -598,13 +599,23 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("   CF_COLOR=false                     Do not colorize output"))
 				Expect(testUI.Out).To(Say("   CF_DIAL_TIMEOUT=5                  Max wait time to establish a connection, including name resolution, in seconds"))
 				Expect(testUI.Out).To(Say("   CF_HOME=path/to/dir/               Override path to default config directory"))
+				Expect(testUI.Out).To(Say("   CF_LOCALE_DIR=path/to/dir/         Load additional translation files from this directory"))
+				Expect(testUI.Out).To(Say("   CF_MAX_IDLE_CONNS_PER_HOST=10      Number of idle keep-alive connections kept open to each API host"))
+				Expect(testUI.Out).To(Say("   CF_OUTPUT=json                     Output the results of read-only commands as JSON documents where supported"))
+				Expect(testUI.Out).To(Say("   CF_OUTPUT_WIDTH=120                Wrap output to this many columns instead of the terminal width"))
+				Expect(testUI.Out).To(Say("   CF_PAGER=true                      Pipe long output through \\$PAGER"))
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
+				Expect(testUI.Out).To(Say("   CF_TLS_HANDSHAKE_TIMEOUT=10        Max wait time for the TLS handshake with an API host, in seconds"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
+				Expect(testUI.Out).To(Say("   CF_TRACE_MAX_SIZE=10485760         Rotate the trace log file once it reaches this many bytes"))
 				Expect(testUI.Out).To(Say("   https_proxy=proxy.example.com:8080 Enable HTTP proxying for API requests"))
 
 				Expect(testUI.Out).To(Say("GLOBAL OPTIONS:"))
+				Expect(testUI.Out).To(Say("   --error-format=json                Output errors to stderr as JSON objects with a code, message and details"))
 				Expect(testUI.Out).To(Say("   --help, -h                         Show help"))
+				Expect(testUI.Out).To(Say("   --no-pager                         Do not pipe long output through \\$PAGER"))
+				Expect(testUI.Out).To(Say("   --output=json                      Output the results of read-only commands to stdout as JSON documents where supported"))
 				Expect(testUI.Out).To(Say("   -v                                 Print API request diagnostics to stdout"))
 			})
 
@@ -16,9 +16,9 @@ var HelpCategoryList = []HelpCategory{
 			{"start", "stop", "restart", "restage", "restart-app-instance"},
 			{"run-task", "tasks", "terminate-task"},
 			{"events", "files", "logs"},
-			{"env", "set-env", "unset-env"},
+			{"env", "set-env", "unset-env", "app-env-preview"},
 			{"stacks", "stack"},
-			{"copy-source", "create-app-manifest"},
+			{"copy-source", "create-app-manifest", "compare-app"},
 			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
 		},
 	},
@@ -29,6 +29,7 @@ var HelpCategoryList = []HelpCategory{
 			{"create-service", "update-service", "delete-service", "rename-service"},
 			{"create-service-key", "service-keys", "service-key", "delete-service-key"},
 			{"bind-service", "unbind-service"},
+			{"share-service", "unshare-service"},
 			{"bind-route-service", "unbind-route-service"},
 			{"create-user-provided-service", "update-user-provided-service"},
 		},
@@ -52,7 +53,7 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "DOMAINS:",
 		CommandList: [][]string{
 			{"domains", "create-domain", "delete-domain", "create-shared-domain", "delete-shared-domain"},
-			{"router-groups"},
+			{"router-groups", "update-router-group"},
 		},
 	},
 	{
@@ -109,7 +110,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "SECURITY GROUP:",
 		CommandList: [][]string{
-			{"security-group", "security-groups", "create-security-group", "update-security-group", "delete-security-group", "bind-security-group", "unbind-security-group"},
+			{"security-group", "security-groups", "create-security-group", "check-security-group-rules", "update-security-group", "delete-security-group", "bind-security-group", "unbind-security-group"},
 			{"bind-staging-security-group", "staging-security-groups", "unbind-staging-security-group"},
 			{"bind-running-security-group", "running-security-groups", "unbind-running-security-group"},
 		},
@@ -126,6 +127,12 @@ var HelpCategoryList = []HelpCategory{
 			{"isolation-segments", "create-isolation-segment", "delete-isolation-segment", "enable-org-isolation", "disable-org-isolation", "set-org-default-isolation-segment", "reset-org-default-isolation-segment", "set-space-isolation-segment", "reset-space-isolation-segment"},
 		},
 	},
+	{
+		CategoryName: "METADATA:",
+		CommandList: [][]string{
+			{"labels", "set-label", "unset-label"},
+		},
+	},
 	{
 		CategoryName: "FEATURE FLAGS:",
 		CommandList: [][]string{
@@ -135,7 +142,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADVANCED:",
 		CommandList: [][]string{
-			{"curl", "config", "oauth-token", "ssh-code"},
+			{"curl", "config", "oauth-token", "ssh-code", "jobs"},
 		},
 	},
 	{
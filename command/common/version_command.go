@@ -1,13 +1,38 @@
 package common
 
-import "code.cloudfoundry.org/cli/command"
+import (
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/version"
+)
 
 type VersionCommand struct {
-	usage  interface{} `usage:"CF_NAME version\n\n   'cf -v' and 'cf --version' are also accepted."`
+	JSON   bool        `long:"json" description:"Output version and compatibility information as JSON"`
+	usage  interface{} `usage:"CF_NAME version [--json]\n\n   'cf -v' and 'cf --version' are also accepted."`
 	UI     command.UI
 	Config command.Config
 }
 
+type versionReport struct {
+	Version string `json:"version"`
+	Build   struct {
+		SHA  string `json:"sha"`
+		Date string `json:"date"`
+	} `json:"build"`
+	API struct {
+		Target          string `json:"target"`
+		Version         string `json:"version"`
+		MinCLIVersion   string `json:"min_cli_version"`
+		MinV3APIVersion string `json:"min_v3_api_version"`
+		MaxV2APIVersion string `json:"max_v2_api_version"`
+		MaxV3APIVersion string `json:"max_v3_api_version"`
+	} `json:"api"`
+	PluginAPIVersion string `json:"plugin_api_version"`
+	Experimental     bool   `json:"experimental"`
+}
+
 func (cmd *VersionCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
@@ -15,6 +40,10 @@ func (cmd *VersionCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd VersionCommand) Execute(args []string) error {
+	if cmd.JSON {
+		return cmd.displayJSON()
+	}
+
 	cmd.UI.DisplayText("{{.BinaryName}} version {{.VersionString}}",
 		map[string]interface{}{
 			"BinaryName":    cmd.Config.BinaryName(),
@@ -23,3 +52,26 @@ func (cmd VersionCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd VersionCommand) displayJSON() error {
+	var report versionReport
+	report.Version = cmd.Config.BinaryVersion()
+	report.Build.SHA = version.BinarySHA()
+	report.Build.Date = version.BinaryBuildDate()
+	report.API.Target = cmd.Config.Target()
+	report.API.Version = cmd.Config.APIVersion()
+	report.API.MinCLIVersion = cmd.Config.MinCLIVersion()
+	report.API.MinV3APIVersion = version.MinVersionV3
+	report.API.MaxV2APIVersion = version.MaxVersionV2
+	report.API.MaxV3APIVersion = version.MaxVersionV3
+	report.PluginAPIVersion = version.PluginAPIVersion
+	report.Experimental = cmd.Config.Experimental()
+
+	output, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
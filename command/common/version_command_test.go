@@ -1,9 +1,12 @@
 package common_test
 
 import (
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/common"
 	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -35,4 +38,39 @@ var _ = Describe("Version Command", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(testUI.Out).To(Say("faceman version 0.0.0-invalid-version"))
 	})
+
+	Context("when --json is provided", func() {
+		BeforeEach(func() {
+			cmd.JSON = true
+			fakeConfig.TargetReturns("https://api.some-domain.com")
+			fakeConfig.APIVersionReturns("2.75.0")
+			fakeConfig.MinCLIVersionReturns("6.22.0")
+			fakeConfig.ExperimentalReturns(true)
+		})
+
+		It("displays the version and compatibility information as JSON", func() {
+			err = cmd.Execute(nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			var report map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &report)).To(Succeed())
+			Expect(report).To(Equal(map[string]interface{}{
+				"version": "0.0.0-invalid-version",
+				"build": map[string]interface{}{
+					"sha":  "",
+					"date": "",
+				},
+				"api": map[string]interface{}{
+					"target":             "https://api.some-domain.com",
+					"version":            "2.75.0",
+					"min_cli_version":    "6.22.0",
+					"min_v3_api_version": version.MinVersionV3,
+					"max_v2_api_version": version.MaxVersionV2,
+					"max_v3_api_version": version.MaxVersionV3,
+				},
+				"plugin_api_version": version.PluginAPIVersion,
+				"experimental":       true,
+			}))
+		})
+	})
 })
@@ -11,13 +11,16 @@ import (
 // Config a way of getting basic CF configuration
 type Config interface {
 	AccessToken() string
+	AddDetachedJob(job configv3.DetachedJob)
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(name string, url string)
 	APIVersion() string
 	BinaryName() string
 	BinaryVersion() string
+	CACertFile() string
 	ColorEnabled() configv3.ColorSetting
 	CurrentUser() (configv3.User, error)
+	DetachedJobs() []configv3.DetachedJob
 	DialTimeout() time.Duration
 	DockerPassword() string
 	Experimental() bool
@@ -25,29 +28,40 @@ type Config interface {
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	IsTTY() bool
 	Locale() string
+	MaxIdleConnsPerHost() int
 	MinCLIVersion() string
+	OutputFormat() string
 	OverallPollingTimeout() time.Duration
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
 	RefreshToken() string
+	RemoveDetachedJob(guid string)
 	RemovePlugin(string)
+	ResourceMatchMinFileSize() int64
 	SetAccessToken(token string)
+	SetCACertFile(caCertFile string)
 	SetOrganizationInformation(guid string, name string)
 	SetRefreshToken(token string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
+	SetUAAClientCredentials(client string, clientSecret string)
 	SetUAAEndpoint(uaaEndpoint string)
+	SetUAAGrantType(uaaGrantType string)
 	SkipSSLValidation() bool
 	SSHOAuthClient() string
+	StagingRetries() int
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
 	Target() string
 	TargetedOrganization() configv3.Organization
 	TargetedSpace() configv3.Space
+	TLSHandshakeTimeout() time.Duration
+	UAAGrantType() string
 	UAAOAuthClient() string
 	UAAOAuthClientSecret() string
 	UnsetOrganizationInformation()
@@ -0,0 +1,41 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// AppFilter is a single `--filter KEY=VALUE` option of the apps command.
+// Only one of Names and State is set.
+type AppFilter struct {
+	Names []string
+	State string
+}
+
+func (f *AppFilter) UnmarshalFlag(val string) error {
+	parts := strings.SplitN(val, "=", 2)
+	if len(parts) == 2 && parts[1] != "" {
+		switch strings.ToLower(parts[0]) {
+		case "name":
+			f.Names = strings.Split(parts[1], ",")
+			return nil
+		case "state":
+			state := strings.ToLower(parts[1])
+			if state == "started" || state == "stopped" {
+				f.State = strings.ToUpper(state)
+				return nil
+			}
+		case "label":
+			return &flags.Error{
+				Type:    flags.ErrRequired,
+				Message: "FILTER cannot match labels because V2 applications do not have labels",
+			}
+		}
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: `FILTER must be "name=APP_NAME[,APP_NAME...]" or "state=(started | stopped)"`,
+	}
+}
@@ -0,0 +1,56 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AppFilter", func() {
+	var filter AppFilter
+
+	BeforeEach(func() {
+		filter = AppFilter{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("parses the filter",
+			func(input string, expectedFilter AppFilter) {
+				err := filter.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(filter).To(Equal(expectedFilter))
+			},
+			Entry("sets one name", "name=app-1", AppFilter{Names: []string{"app-1"}}),
+			Entry("sets several names", "name=app-1,app-2", AppFilter{Names: []string{"app-1", "app-2"}}),
+			Entry("keeps '=' in the value", "NAME=a=b", AppFilter{Names: []string{"a=b"}}),
+			Entry("upcases a started state", "state=started", AppFilter{State: "STARTED"}),
+			Entry("upcases a stopped state", "State=STOPPED", AppFilter{State: "STOPPED"}),
+		)
+
+		DescribeTable("rejects invalid filters",
+			func(input string) {
+				err := filter.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `FILTER must be "name=APP_NAME[,APP_NAME...]" or "state=(started | stopped)"`,
+				}))
+			},
+			Entry("without a key", "app-1"),
+			Entry("without a value", "name="),
+			Entry("with an unknown key", "stack=cflinuxfs2"),
+			Entry("with an unknown state", "state=crashed"),
+		)
+
+		Context("when filtering by label", func() {
+			It("returns an error explaining that labels are not supported", func() {
+				err := filter.UnmarshalFlag("label=env=prod")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "FILTER cannot match labels because V2 applications do not have labels",
+				}))
+			})
+		})
+	})
+})
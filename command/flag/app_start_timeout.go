@@ -0,0 +1,34 @@
+package flag
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// AppStartTimeout is the maximum time, in minutes, to wait for an app's
+// instances to start.
+type AppStartTimeout struct {
+	types.NullInt
+}
+
+func (t *AppStartTimeout) UnmarshalFlag(val string) error {
+	err := t.ParseFlagValue(val)
+	if err != nil || (t.IsSet && t.Value < 1) {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "invalid argument for flag '-t, --app-start-timeout' (expected int > 0)",
+		}
+	}
+	return nil
+}
+
+// Duration returns the timeout as a duration, or zero if the flag was not
+// provided.
+func (t AppStartTimeout) Duration() time.Duration {
+	if !t.IsSet {
+		return 0
+	}
+	return time.Duration(t.Value) * time.Minute
+}
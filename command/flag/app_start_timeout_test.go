@@ -0,0 +1,64 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AppStartTimeout", func() {
+	var timeout AppStartTimeout
+
+	BeforeEach(func() {
+		timeout = AppStartTimeout{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when an invalid integer is provided", func() {
+			It("returns an error", func() {
+				err := timeout.UnmarshalFlag("abcdef")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '-t, --app-start-timeout' (expected int > 0)",
+				}))
+			})
+		})
+
+		Context("when zero is provided", func() {
+			It("returns an error", func() {
+				err := timeout.UnmarshalFlag("0")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '-t, --app-start-timeout' (expected int > 0)",
+				}))
+			})
+		})
+
+		Context("when a valid integer is provided", func() {
+			It("stores the integer and sets IsSet to true", func() {
+				err := timeout.UnmarshalFlag("10")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timeout).To(Equal(AppStartTimeout{NullInt: types.NullInt{Value: 10, IsSet: true}}))
+			})
+		})
+	})
+
+	Describe("Duration", func() {
+		Context("when the flag is not set", func() {
+			It("returns zero", func() {
+				Expect(timeout.Duration()).To(BeZero())
+			})
+		})
+
+		Context("when the flag is set", func() {
+			It("returns the value in minutes", func() {
+				timeout = AppStartTimeout{NullInt: types.NullInt{Value: 10, IsSet: true}}
+				Expect(timeout.Duration()).To(Equal(10 * time.Minute))
+			})
+		})
+	})
+})
@@ -12,6 +12,10 @@ type BuildpackName struct {
 	Buildpack string `positional-arg-name:"BUILDPACK" required:"true" description:"The buildpack"`
 }
 
+type RouterGroupName struct {
+	RouterGroup string `positional-arg-name:"ROUTER_GROUP_NAME" required:"true" description:"The router group"`
+}
+
 type CommandName struct {
 	CommandName string `positional-arg-name:"COMMAND_NAME" description:"The command name"`
 }
@@ -25,7 +29,7 @@ type Feature struct {
 }
 
 type ParamsAsJSON struct {
-	JSON string `positional-arg-name:"JSON" required:"true" description:"Parameters as JSON"`
+	JSON JSONOrFile `positional-arg-name:"JSON" required:"true" description:"Parameters as JSON, or the path to a file containing them"`
 }
 
 type Service struct {
@@ -57,7 +61,7 @@ type Quota struct {
 }
 
 type SecurityGroup struct {
-	ServiceGroup string `positional-arg-name:"SECURITY_GROUP" required:"true" description:"The security group"`
+	SecurityGroup string `positional-arg-name:"SECURITY_GROUP" required:"true" description:"The security group"`
 }
 
 type ServiceBroker struct {
@@ -99,6 +103,11 @@ type AppInstance struct {
 	Index   int    `positional-arg-name:"INDEX" required:"true" description:"The index of the application instance"`
 }
 
+type AppInstanceOptionalIndex struct {
+	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	Index   *int   `positional-arg-name:"INDEX" description:"The index of the application instance"`
+}
+
 type OrgSpace struct {
 	Organization string `positional-arg-name:"ORG" required:"true" description:"The organization"`
 	Space        string `positional-arg-name:"SPACE" required:"true" description:"The space"`
@@ -157,6 +166,23 @@ type UnsetEnvironmentArgs struct {
 	EnvironmentVariableName string `positional-arg-name:"ENV_VAR_NAME" required:"true" description:"The environment variable name"`
 }
 
+type LabelsArgs struct {
+	ResourceType string `positional-arg-name:"RESOURCE" required:"true" description:"The type of the resource: app, org or space"`
+	ResourceName string `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+}
+
+type SetLabelArgs struct {
+	ResourceType string   `positional-arg-name:"RESOURCE" required:"true" description:"The type of the resource: app, org or space"`
+	ResourceName string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+	Labels       []string `positional-arg-name:"KEY=VALUE" required:"1" description:"The labels to set, as KEY=VALUE pairs"`
+}
+
+type UnsetLabelArgs struct {
+	ResourceType string   `positional-arg-name:"RESOURCE" required:"true" description:"The type of the resource: app, org or space"`
+	ResourceName string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+	LabelKeys    []string `positional-arg-name:"KEY" required:"1" description:"The keys of the labels to remove"`
+}
+
 type CopySourceArgs struct {
 	SourceAppName string `positional-arg-name:"SOURCE-APP" required:"true" description:"The old application name"`
 	TargetAppName string `positional-arg-name:"TARGET-NAME" required:"true" description:"The new application name"`
@@ -268,6 +294,10 @@ type MigrateServiceInstancesArgs struct {
 	V2Plan     string `positional-arg-name:"v2_PLAN" required:"true" description:"The new service plan"`
 }
 
+type SecurityGroupRulesArgs struct {
+	PathToJsonRules PathWithExistenceCheck `positional-arg-name:"PATH_TO_JSON_RULES_FILE" required:"true" description:"Path to file of JSON describing security group rules"`
+}
+
 type SecurityGroupArgs struct {
 	SecurityGroup   string                 `positional-arg-name:"SECURITY_GROUP" required:"true" description:"The security group"`
 	PathToJsonRules PathWithExistenceCheck `positional-arg-name:"PATH_TO_JSON_RULES_FILE" required:"true" description:"Path to file of JSON describing security group rules"`
@@ -292,6 +322,14 @@ type TerminateTaskArgs struct {
 	SequenceID string `positional-arg-name:"TASK_ID" required:"true" description:"The task's unique sequence ID"`
 }
 
+type DropletGUID struct {
+	DropletGUID string `positional-arg-name:"DROPLET_GUID" required:"true" description:"The droplet GUID"`
+}
+
+type PackageGUID struct {
+	PackageGUID string `positional-arg-name:"PACKAGE_GUID" required:"true" description:"The package GUID"`
+}
+
 type IsolationSegmentName struct {
 	IsolationSegmentName string `positional-arg-name:"SEGMENT_NAME" required:"true" description:"The isolation segment name"`
 }
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type EnvelopeType struct {
+	Type string
+}
+
+func (EnvelopeType) Complete(prefix string) []flags.Completion {
+	return completions([]string{"counter", "event", "gauge", "log", "timer"}, prefix, false)
+}
+
+func (e *EnvelopeType) UnmarshalFlag(val string) error {
+	valUpper := strings.ToUpper(val)
+	switch valUpper {
+	case "LOG", "COUNTER", "GAUGE", "TIMER", "EVENT":
+		e.Type = valUpper
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `ENVELOPE_TYPE must be "log", "counter", "gauge", "timer", or "event"`,
+		}
+	}
+	return nil
+}
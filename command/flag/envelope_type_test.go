@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EnvelopeType", func() {
+	var envelopeType EnvelopeType
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := envelopeType.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("completes to 'gauge' when passed 'g'", "g",
+				[]flags.Completion{{Item: "gauge"}}),
+			Entry("completes to 'log' when passed 'L'", "L",
+				[]flags.Completion{{Item: "log"}}),
+			Entry("completes to all types when passed nothing", "",
+				[]flags.Completion{{Item: "counter"}, {Item: "event"}, {Item: "gauge"}, {Item: "log"}, {Item: "timer"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			envelopeType = EnvelopeType{}
+		})
+
+		DescribeTable("upcases and sets type",
+			func(settingType string, expectedType string) {
+				err := envelopeType.UnmarshalFlag(settingType)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(envelopeType.Type).To(Equal(expectedType))
+			},
+			Entry("sets 'LOG' when passed 'log'", "log", "LOG"),
+			Entry("sets 'COUNTER' when passed 'Counter'", "Counter", "COUNTER"),
+			Entry("sets 'GAUGE' when passed 'gauge'", "gauge", "GAUGE"),
+			Entry("sets 'TIMER' when passed 'TIMER'", "TIMER", "TIMER"),
+			Entry("sets 'EVENT' when passed 'event'", "event", "EVENT"),
+		)
+
+		It("returns an error when passed an unknown type", func() {
+			err := envelopeType.UnmarshalFlag("banana")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `ENVELOPE_TYPE must be "log", "counter", "gauge", "timer", or "event"`,
+			}))
+			Expect(envelopeType.Type).To(BeEmpty())
+		})
+	})
+})
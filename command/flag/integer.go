@@ -0,0 +1,21 @@
+package flag
+
+import (
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type Integer struct {
+	types.NullInt
+}
+
+func (i *Integer) UnmarshalFlag(val string) error {
+	err := i.ParseFlagValue(val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: "invalid argument for flag `-i' (expected int)",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Integer", func() {
+	var integer Integer
+
+	BeforeEach(func() {
+		integer = Integer{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when the empty string is provided", func() {
+			It("sets IsSet to false", func() {
+				err := integer.UnmarshalFlag("")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(integer).To(Equal(Integer{NullInt: types.NullInt{Value: 0, IsSet: false}}))
+			})
+		})
+
+		Context("when an invalid integer is provided", func() {
+			It("returns an error", func() {
+				err := integer.UnmarshalFlag("abcdef")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: "invalid argument for flag `-i' (expected int)",
+				}))
+				Expect(integer).To(Equal(Integer{NullInt: types.NullInt{Value: 0, IsSet: false}}))
+			})
+		})
+
+		Context("when a valid integer is provided", func() {
+			It("stores the integer and sets IsSet to true", func() {
+				err := integer.UnmarshalFlag("5")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(integer).To(Equal(Integer{NullInt: types.NullInt{Value: 5, IsSet: true}}))
+			})
+		})
+	})
+})
@@ -0,0 +1,21 @@
+package flag
+
+import (
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type InvocationTimeout struct {
+	types.NullInt
+}
+
+func (i *InvocationTimeout) UnmarshalFlag(val string) error {
+	err := i.ParseFlagValue(val)
+	if err != nil || (i.IsSet && i.Value < 1) {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "invalid argument for flag '--invocation-timeout' (expected int > 0)",
+		}
+	}
+	return nil
+}
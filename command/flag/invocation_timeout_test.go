@@ -0,0 +1,55 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InvocationTimeout", func() {
+	var timeout InvocationTimeout
+
+	BeforeEach(func() {
+		timeout = InvocationTimeout{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when the empty string is provided", func() {
+			It("sets IsSet to false", func() {
+				err := timeout.UnmarshalFlag("")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timeout).To(Equal(InvocationTimeout{NullInt: types.NullInt{Value: 0, IsSet: false}}))
+			})
+		})
+
+		Context("when an invalid integer is provided", func() {
+			It("returns an error", func() {
+				err := timeout.UnmarshalFlag("abcdef")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '--invocation-timeout' (expected int > 0)",
+				}))
+			})
+		})
+
+		Context("when zero is provided", func() {
+			It("returns an error", func() {
+				err := timeout.UnmarshalFlag("0")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '--invocation-timeout' (expected int > 0)",
+				}))
+			})
+		})
+
+		Context("when a valid integer is provided", func() {
+			It("stores the integer and sets IsSet to true", func() {
+				err := timeout.UnmarshalFlag("10")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timeout).To(Equal(InvocationTimeout{NullInt: types.NullInt{Value: 10, IsSet: true}}))
+			})
+		})
+	})
+})
@@ -0,0 +1,21 @@
+package flag
+
+import (
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type MaxInFlight struct {
+	types.NullInt
+}
+
+func (m *MaxInFlight) UnmarshalFlag(val string) error {
+	err := m.ParseFlagValue(val)
+	if err != nil || (m.IsSet && m.Value < 1) {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "invalid argument for flag '--max-in-flight' (expected int > 0)",
+		}
+	}
+	return nil
+}
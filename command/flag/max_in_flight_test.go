@@ -0,0 +1,55 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MaxInFlight", func() {
+	var maxInFlight MaxInFlight
+
+	BeforeEach(func() {
+		maxInFlight = MaxInFlight{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when the empty string is provided", func() {
+			It("sets IsSet to false", func() {
+				err := maxInFlight.UnmarshalFlag("")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(maxInFlight).To(Equal(MaxInFlight{NullInt: types.NullInt{Value: 0, IsSet: false}}))
+			})
+		})
+
+		Context("when an invalid integer is provided", func() {
+			It("returns an error", func() {
+				err := maxInFlight.UnmarshalFlag("abcdef")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '--max-in-flight' (expected int > 0)",
+				}))
+			})
+		})
+
+		Context("when zero is provided", func() {
+			It("returns an error", func() {
+				err := maxInFlight.UnmarshalFlag("0")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '--max-in-flight' (expected int > 0)",
+				}))
+			})
+		})
+
+		Context("when a positive integer is provided", func() {
+			It("stores the integer and sets IsSet to true", func() {
+				err := maxInFlight.UnmarshalFlag("3")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(maxInFlight).To(Equal(MaxInFlight{NullInt: types.NullInt{Value: 3, IsSet: true}}))
+			})
+		})
+	})
+})
@@ -1,8 +1,42 @@
 package flag
 
-type MemoryWithUnlimited int64
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/types"
+	"github.com/cloudfoundry/bytefmt"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// MemoryWithUnlimited is an amount of memory in megabytes, where -1
+// represents an unlimited amount.
+type MemoryWithUnlimited struct {
+	types.NullInt
+}
 
-//TODO:Code for this flag exists in cf/formatters/bytes.go, move tests from there to here
 func (m *MemoryWithUnlimited) UnmarshalFlag(val string) error {
+	if val == "" {
+		return nil
+	}
+
+	if val == "-1" {
+		m.Value = -1
+		m.IsSet = true
+		return nil
+	}
+
+	size, err := bytefmt.ToMegabytes(val)
+	if err != nil ||
+		!strings.ContainsAny(strings.ToLower(val), ALLOWED_UNITS) ||
+		strings.Contains(strings.ToLower(val), ".") {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `Byte quantity must be an integer with a unit of measurement like M, MB, G, or GB, or -1 for an unlimited amount`,
+		}
+	}
+
+	m.Value = int(size)
+	m.IsSet = true
+
 	return nil
 }
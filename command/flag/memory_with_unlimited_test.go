@@ -0,0 +1,50 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MemoryWithUnlimited", func() {
+	var memory MemoryWithUnlimited
+
+	BeforeEach(func() {
+		memory = MemoryWithUnlimited{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("valid values",
+			func(input string, expected types.NullInt) {
+				Expect(memory.UnmarshalFlag(input)).To(Succeed())
+				Expect(memory.NullInt).To(Equal(expected))
+			},
+
+			Entry("empty", "", types.NullInt{}),
+			Entry("unlimited", "-1", types.NullInt{IsSet: true, Value: -1}),
+			Entry("megabytes", "17M", types.NullInt{IsSet: true, Value: 17}),
+			Entry("megabytes with MB suffix", "19MB", types.NullInt{IsSet: true, Value: 19}),
+			Entry("gigabytes", "2G", types.NullInt{IsSet: true, Value: 2048}),
+			Entry("lowercase gigabytes", "3gb", types.NullInt{IsSet: true, Value: 3072}),
+		)
+
+		DescribeTable("invalid values",
+			func(input string) {
+				err := memory.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `Byte quantity must be an integer with a unit of measurement like M, MB, G, or GB, or -1 for an unlimited amount`,
+				}))
+				Expect(memory.IsSet).To(BeFalse())
+			},
+
+			Entry("no unit", "1024"),
+			Entry("other negative number", "-2"),
+			Entry("decimal", "1.5G"),
+			Entry("not a number", "banana"),
+		)
+	})
+})
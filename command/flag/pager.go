@@ -0,0 +1,31 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type Pager struct {
+	Pager bool
+}
+
+func (Pager) Complete(prefix string) []flags.Completion {
+	return completions([]string{"true", "false"}, prefix, false)
+}
+
+func (p *Pager) UnmarshalFlag(val string) error {
+	switch strings.ToLower(val) {
+	case "true":
+		p.Pager = true
+	case "false":
+		p.Pager = false
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `PAGER must be "true" or "false"`,
+		}
+	}
+
+	return nil
+}
@@ -50,31 +50,63 @@ func (JSONOrFileWithValidation) Complete(prefix string) []flags.Completion {
 }
 
 func (p *JSONOrFileWithValidation) UnmarshalFlag(pathOrJSON string) error {
-	var jsonBytes []byte
+	jsonMap, ok := parseJSONOrFile(pathOrJSON)
+	if !ok {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "Invalid configuration provided for -c flag. Please provide a valid JSON object or path to a file containing a valid JSON object.",
+		}
+	}
+
+	*p = JSONOrFileWithValidation(jsonMap)
+	return nil
+}
+
+// JSONOrFile is a JSON object provided either inline or as the path to a file
+// containing it.
+type JSONOrFile map[string]interface{}
+
+func (JSONOrFile) Complete(prefix string) []flags.Completion {
+	return completeWithTilde(prefix)
+}
 
-	errorToReturn := &flags.Error{
-		Type:    flags.ErrRequired,
-		Message: "Invalid configuration provided for -c flag. Please provide a valid JSON object or path to a file containing a valid JSON object.",
+func (p *JSONOrFile) UnmarshalFlag(pathOrJSON string) error {
+	jsonMap, ok := parseJSONOrFile(pathOrJSON)
+	if !ok {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "Invalid JSON provided. Please provide a valid JSON object or path to a file containing a valid JSON object.",
+		}
 	}
 
-	_, err := os.Stat(pathOrJSON)
+	*p = JSONOrFile(jsonMap)
+	return nil
+}
+
+// parseJSONOrFile reads a JSON object from the file at the provided path,
+// optionally prefixed with '@', or otherwise parses the value itself as JSON.
+func parseJSONOrFile(pathOrJSON string) (map[string]interface{}, bool) {
+	var jsonBytes []byte
+
+	path := strings.TrimPrefix(pathOrJSON, "@")
+	_, err := os.Stat(path)
 	if err == nil {
-		jsonBytes, err = ioutil.ReadFile(pathOrJSON)
+		jsonBytes, err = ioutil.ReadFile(path)
 		if err != nil {
-			return errorToReturn
+			return nil, false
 		}
+	} else if path != pathOrJSON {
+		return nil, false
 	} else {
 		jsonBytes = []byte(pathOrJSON)
 	}
 
 	var jsonMap map[string]interface{}
-
 	if jsonIsInvalid := json.Unmarshal(jsonBytes, &jsonMap); jsonIsInvalid != nil {
-		return errorToReturn
+		return nil, false
 	}
 
-	*p = JSONOrFileWithValidation(jsonMap)
-	return nil
+	return jsonMap, true
 }
 
 type PathWithExistenceCheckOrURL string
@@ -261,6 +261,20 @@ var _ = Describe("path types", func() {
 					})
 				})
 
+				Context("when the path is prefixed with '@'", func() {
+					BeforeEach(func() {
+						tempPath = tempFile(`{"this is":"valid JSON"}`)
+					})
+
+					It("reads the JSON from the file", func() {
+						err := jsonOrFile.UnmarshalFlag("@" + tempPath)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(jsonOrFile).To(BeEquivalentTo(map[string]interface{}{
+							"this is": "valid JSON",
+						}))
+					})
+				})
+
 				Context("when the file has invalid JSON", func() {
 					BeforeEach(func() {
 						tempPath = tempFile(`{"this is":"invalid JSON"`)
@@ -276,6 +290,16 @@ var _ = Describe("path types", func() {
 				})
 			})
 
+			Context("when an '@' prefixed file does not exist", func() {
+				It("errors with the invalid configuration error", func() {
+					err := jsonOrFile.UnmarshalFlag("@/some/missing/file.json")
+					Expect(err).To(Equal(&flags.Error{
+						Type:    flags.ErrRequired,
+						Message: "Invalid configuration provided for -c flag. Please provide a valid JSON object or path to a file containing a valid JSON object.",
+					}))
+				})
+			})
+
 			Context("when the JSON is invalid", func() {
 				It("errors with the invalid configuration error", func() {
 					err := jsonOrFile.UnmarshalFlag(`{"this is":"invalid JSON"`)
@@ -298,6 +322,52 @@ var _ = Describe("path types", func() {
 		})
 	})
 
+	Describe("JSONOrFile", func() {
+		var jsonOrFile JSONOrFile
+
+		BeforeEach(func() {
+			jsonOrFile = JSONOrFile(nil)
+		})
+
+		Describe("UnmarshalFlag", func() {
+			Context("when the file exists and has valid JSON", func() {
+				var tempPath string
+
+				BeforeEach(func() {
+					tempPath = tempFile(`{"this is":"valid JSON"}`)
+				})
+
+				It("reads the JSON from the file", func() {
+					err := jsonOrFile.UnmarshalFlag(tempPath)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(jsonOrFile).To(BeEquivalentTo(map[string]interface{}{
+						"this is": "valid JSON",
+					}))
+				})
+			})
+
+			Context("when the JSON is valid", func() {
+				It("parses the JSON", func() {
+					err := jsonOrFile.UnmarshalFlag(`{"this is":"valid JSON"}`)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(jsonOrFile).To(BeEquivalentTo(map[string]interface{}{
+						"this is": "valid JSON",
+					}))
+				})
+			})
+
+			Context("when the JSON is invalid", func() {
+				It("errors with the invalid JSON error", func() {
+					err := jsonOrFile.UnmarshalFlag(`{"this is":"invalid JSON"`)
+					Expect(err).To(Equal(&flags.Error{
+						Type:    flags.ErrRequired,
+						Message: "Invalid JSON provided. Please provide a valid JSON object or path to a file containing a valid JSON object.",
+					}))
+				})
+			})
+		})
+	})
+
 	Describe("PathWithExistenceCheckOrURL", func() {
 		var pathWithExistenceCheckOrURL PathWithExistenceCheckOrURL
 
@@ -2,8 +2,12 @@ package flag
 
 import flags "github.com/jessevdk/go-flags"
 
+// SecurityGroupLifecycleBoth selects both the running and staging lifecycle
+// phases.
+const SecurityGroupLifecycleBoth SecurityGroupLifecycle = "both"
+
 type SecurityGroupLifecycle string
 
 func (SecurityGroupLifecycle) Complete(prefix string) []flags.Completion {
-	return completions([]string{"staging", "running"}, prefix, false)
+	return completions([]string{"staging", "running", "both"}, prefix, false)
 }
@@ -26,8 +26,10 @@ var _ = Describe("SecurityGroupLifecycle", func() {
 				[]flags.Completion{{Item: "staging"}}),
 			Entry("completes to 'running' when passed 'Ru'", "Ru",
 				[]flags.Completion{{Item: "running"}}),
-			Entry("returns 'staging' and 'running' when passed nothing", "",
-				[]flags.Completion{{Item: "staging"}, {Item: "running"}}),
+			Entry("completes to 'both' when passed 'b'", "b",
+				[]flags.Completion{{Item: "both"}}),
+			Entry("returns 'staging', 'running' and 'both' when passed nothing", "",
+				[]flags.Completion{{Item: "staging"}, {Item: "running"}, {Item: "both"}}),
 			Entry("completes to nothing when passed 'wut'", "wut",
 				[]flags.Completion{}),
 		)
@@ -0,0 +1,18 @@
+package flag
+
+import "strings"
+
+// Tags is a comma separated list of service instance tags. It is nil when the
+// flag is not provided and empty when the flag is provided without any tags.
+type Tags []string
+
+func (t *Tags) UnmarshalFlag(val string) error {
+	tags := Tags{}
+	for _, tag := range strings.Split(strings.Trim(val, `"`), ",") {
+		if trimmed := strings.TrimSpace(tag); trimmed != "" {
+			tags = append(tags, trimmed)
+		}
+	}
+	*t = tags
+	return nil
+}
@@ -0,0 +1,38 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tags", func() {
+	var tags Tags
+
+	BeforeEach(func() {
+		tags = nil
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("splits the value on commas and trims whitespace", func() {
+			err := tags.UnmarshalFlag(" tag-1, tag-2 ,tag-3")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tags).To(Equal(Tags{"tag-1", "tag-2", "tag-3"}))
+		})
+
+		It("strips surrounding quotes and drops empty tags", func() {
+			err := tags.UnmarshalFlag(`"tag-1,,tag-2,"`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tags).To(Equal(Tags{"tag-1", "tag-2"}))
+		})
+
+		Context("when the value is empty", func() {
+			It("sets an empty, non-nil list", func() {
+				err := tags.UnmarshalFlag("")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tags).ToNot(BeNil())
+				Expect(tags).To(BeEmpty())
+			})
+		})
+	})
+})
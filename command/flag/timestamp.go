@@ -0,0 +1,25 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Timestamp is a point in time given in RFC3339 format, such as
+// 2017-08-14T21:16:42Z.
+type Timestamp struct {
+	time.Time
+}
+
+func (t *Timestamp) UnmarshalFlag(val string) error {
+	parsed, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: "invalid argument for timestamp (expected RFC3339, such as 2017-08-14T21:16:42Z)",
+		}
+	}
+	t.Time = parsed
+	return nil
+}
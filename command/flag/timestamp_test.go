@@ -0,0 +1,39 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timestamp", func() {
+	var timestamp Timestamp
+
+	BeforeEach(func() {
+		timestamp = Timestamp{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when an RFC3339 timestamp is provided", func() {
+			It("stores the time", func() {
+				err := timestamp.UnmarshalFlag("2017-08-14T21:16:42Z")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timestamp.Time).To(Equal(time.Date(2017, 8, 14, 21, 16, 42, 0, time.UTC)))
+			})
+		})
+
+		Context("when an invalid timestamp is provided", func() {
+			It("returns an error", func() {
+				err := timestamp.UnmarshalFlag("yesterday")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: "invalid argument for timestamp (expected RFC3339, such as 2017-08-14T21:16:42Z)",
+				}))
+				Expect(timestamp.IsZero()).To(BeTrue())
+			})
+		})
+	})
+})
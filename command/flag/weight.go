@@ -0,0 +1,21 @@
+package flag
+
+import (
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type Weight struct {
+	types.NullInt
+}
+
+func (w *Weight) UnmarshalFlag(val string) error {
+	err := w.ParseFlagValue(val)
+	if err != nil || (w.IsSet && w.Value < 1) {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "invalid argument for flag '--weight' (expected int > 0)",
+		}
+	}
+	return nil
+}
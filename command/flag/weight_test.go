@@ -0,0 +1,55 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Weight", func() {
+	var weight Weight
+
+	BeforeEach(func() {
+		weight = Weight{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when the empty string is provided", func() {
+			It("sets IsSet to false", func() {
+				err := weight.UnmarshalFlag("")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(weight).To(Equal(Weight{NullInt: types.NullInt{Value: 0, IsSet: false}}))
+			})
+		})
+
+		Context("when an invalid integer is provided", func() {
+			It("returns an error", func() {
+				err := weight.UnmarshalFlag("abcdef")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '--weight' (expected int > 0)",
+				}))
+			})
+		})
+
+		Context("when zero is provided", func() {
+			It("returns an error", func() {
+				err := weight.UnmarshalFlag("0")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '--weight' (expected int > 0)",
+				}))
+			})
+		})
+
+		Context("when a positive integer is provided", func() {
+			It("stores the integer and sets IsSet to true", func() {
+				err := weight.UnmarshalFlag("3")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(weight).To(Equal(Weight{NullInt: types.NullInt{Value: 3, IsSet: true}}))
+			})
+		})
+	})
+})
@@ -0,0 +1,92 @@
+package presenter
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+)
+
+const (
+	// AppKind is the kind of documents describing a single app.
+	AppKind = "app"
+	// AppSchemaVersion is the current version of the app schema.
+	AppSchemaVersion = 1
+)
+
+// App is the JSON representation of an app and its running instances.
+type App struct {
+	GUID             string        `json:"guid"`
+	Name             string        `json:"name"`
+	RequestedState   string        `json:"requested_state"`
+	Instances        int           `json:"instances"`
+	RunningInstances int           `json:"running_instances"`
+	MemoryInMB       uint64        `json:"memory_in_mb"`
+	DiskQuotaInMB    uint64        `json:"disk_quota_in_mb"`
+	IsolationSegment string        `json:"isolation_segment"`
+	Routes           []string      `json:"routes"`
+	LastUploaded     *time.Time    `json:"last_uploaded"`
+	Stack            string        `json:"stack"`
+	Buildpack        string        `json:"buildpack"`
+	InstanceDetails  []AppInstance `json:"instance_details"`
+}
+
+// AppInstance is the JSON representation of a running app instance.
+type AppInstance struct {
+	Index              int       `json:"index"`
+	State              string    `json:"state"`
+	Since              time.Time `json:"since"`
+	CPU                float64   `json:"cpu"`
+	MemoryInBytes      int       `json:"memory_in_bytes"`
+	MemoryQuotaInBytes int       `json:"memory_quota_in_bytes"`
+	DiskInBytes        int       `json:"disk_in_bytes"`
+	DiskQuotaInBytes   int       `json:"disk_quota_in_bytes"`
+	Details            string    `json:"details"`
+}
+
+// NewAppDocument returns the document describing the provided app summary.
+func NewAppDocument(appSummary v2action.ApplicationSummary) Document {
+	app := App{
+		GUID:             appSummary.GUID,
+		Name:             appSummary.Name,
+		RequestedState:   strings.ToLower(string(appSummary.State)),
+		Instances:        appSummary.Instances.Value,
+		RunningInstances: appSummary.StartingOrRunningInstanceCount(),
+		MemoryInMB:       appSummary.Memory,
+		DiskQuotaInMB:    appSummary.DiskQuota,
+		IsolationSegment: appSummary.IsolationSegment,
+		Routes:           []string{},
+		Stack:            appSummary.Stack.Name,
+		Buildpack:        appSummary.Application.CalculatedBuildpack(),
+		InstanceDetails:  []AppInstance{},
+	}
+
+	if !appSummary.PackageUpdatedAt.IsZero() {
+		lastUploaded := appSummary.PackageUpdatedAt.UTC()
+		app.LastUploaded = &lastUploaded
+	}
+
+	for _, route := range appSummary.Routes {
+		app.Routes = append(app.Routes, route.String())
+	}
+
+	for _, instance := range appSummary.RunningInstances {
+		app.InstanceDetails = append(app.InstanceDetails, AppInstance{
+			Index:              instance.ID,
+			State:              strings.ToLower(string(instance.State)),
+			Since:              instance.TimeSinceCreation().UTC(),
+			CPU:                instance.CPU,
+			MemoryInBytes:      instance.Memory,
+			MemoryQuotaInBytes: instance.MemoryQuota,
+			DiskInBytes:        instance.Disk,
+			DiskQuotaInBytes:   instance.DiskQuota,
+			Details:            instance.Details,
+		})
+	}
+
+	return Document{
+		Kind:          AppKind,
+		SchemaVersion: AppSchemaVersion,
+		Data:          app,
+	}
+}
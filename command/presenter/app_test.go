@@ -0,0 +1,72 @@
+package presenter_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "code.cloudfoundry.org/cli/command/presenter"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewAppDocument", func() {
+	It("presents a started app with routes and instances", func() {
+		document := NewAppDocument(v2action.ApplicationSummary{
+			Application: v2action.Application{
+				GUID:              "some-app-guid",
+				Name:              "some-app",
+				State:             ccv2.ApplicationStarted,
+				Instances:         types.NullInt{IsSet: true, Value: 2},
+				Memory:            128,
+				DiskQuota:         1024,
+				DetectedBuildpack: types.FilteredString{IsSet: true, Value: "ruby_buildpack"},
+				PackageUpdatedAt:  time.Date(2017, 4, 1, 10, 30, 0, 0, time.FixedZone("PDT", -7*60*60)),
+			},
+			Stack:            v2action.Stack{Name: "cflinuxfs2"},
+			IsolationSegment: "some-isolation-segment",
+			Routes: []v2action.Route{
+				{Host: "some-app", Domain: v2action.Domain{Name: "example.com"}},
+				{Domain: v2action.Domain{Name: "tcp.example.com"}, Port: types.NullInt{IsSet: true, Value: 1024}},
+			},
+			RunningInstances: []v2action.ApplicationInstanceWithStats{
+				{
+					ID:          0,
+					State:       v2action.ApplicationInstanceState(ccv2.ApplicationInstanceRunning),
+					Since:       1491042600,
+					CPU:         0.25,
+					Memory:      13000000,
+					MemoryQuota: 134217728,
+					Disk:        64000000,
+					DiskQuota:   1073741824,
+				},
+				{
+					ID:      1,
+					State:   v2action.ApplicationInstanceState(ccv2.ApplicationInstanceCrashed),
+					Since:   1491042660,
+					Details: "some-details",
+				},
+			},
+		})
+
+		Expect(document.Kind).To(Equal("app"))
+		Expect(document.SchemaVersion).To(Equal(1))
+		matchGoldenFile(document, "app_v1.json")
+	})
+
+	It("presents a stopped app without routes or instances", func() {
+		document := NewAppDocument(v2action.ApplicationSummary{
+			Application: v2action.Application{
+				GUID:      "some-app-guid",
+				Name:      "some-app",
+				State:     ccv2.ApplicationStopped,
+				Instances: types.NullInt{IsSet: true, Value: 1},
+				Memory:    256,
+				DiskQuota: 512,
+			},
+		})
+
+		matchGoldenFile(document, "app_v1_stopped.json")
+	})
+})
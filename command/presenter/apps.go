@@ -0,0 +1,53 @@
+package presenter
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+)
+
+const (
+	// AppsKind is the kind of documents listing the apps in a space.
+	AppsKind = "apps"
+	// AppsSchemaVersion is the current version of the apps schema.
+	AppsSchemaVersion = 1
+)
+
+// AppListItem is the JSON representation of an app in a list of apps.
+type AppListItem struct {
+	GUID             string   `json:"guid"`
+	Name             string   `json:"name"`
+	RequestedState   string   `json:"requested_state"`
+	Instances        int      `json:"instances"`
+	RunningInstances int      `json:"running_instances"`
+	MemoryInMB       uint64   `json:"memory_in_mb"`
+	DiskQuotaInMB    uint64   `json:"disk_quota_in_mb"`
+	Routes           []string `json:"routes"`
+}
+
+// NewAppsDocument returns the document listing the provided apps.
+func NewAppsDocument(apps []v2action.ApplicationWithInstancesAndRoutes) Document {
+	items := []AppListItem{}
+	for _, app := range apps {
+		item := AppListItem{
+			GUID:             app.GUID,
+			Name:             app.Name,
+			RequestedState:   strings.ToLower(string(app.State)),
+			Instances:        app.Instances.Value,
+			RunningInstances: app.RunningInstances,
+			MemoryInMB:       app.Memory,
+			DiskQuotaInMB:    app.DiskQuota,
+			Routes:           []string{},
+		}
+		for _, route := range app.Routes {
+			item.Routes = append(item.Routes, route.String())
+		}
+		items = append(items, item)
+	}
+
+	return Document{
+		Kind:          AppsKind,
+		SchemaVersion: AppsSchemaVersion,
+		Data:          items,
+	}
+}
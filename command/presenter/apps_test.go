@@ -0,0 +1,50 @@
+package presenter_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "code.cloudfoundry.org/cli/command/presenter"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewAppsDocument", func() {
+	It("presents each app with its running instances and routes", func() {
+		document := NewAppsDocument([]v2action.ApplicationWithInstancesAndRoutes{
+			{
+				Application: v2action.Application{
+					GUID:      "app-guid-1",
+					Name:      "app-1",
+					State:     ccv2.ApplicationStarted,
+					Instances: types.NullInt{IsSet: true, Value: 2},
+					Memory:    256,
+					DiskQuota: 1024,
+				},
+				RunningInstances: 1,
+				Routes: v2action.Routes{
+					{Host: "app-1", Domain: v2action.Domain{Name: "example.com"}},
+					{Host: "www", Domain: v2action.Domain{Name: "example.com"}, Path: "/app-1"},
+				},
+			},
+			{
+				Application: v2action.Application{
+					GUID:      "app-guid-2",
+					Name:      "app-2",
+					State:     ccv2.ApplicationStopped,
+					Instances: types.NullInt{IsSet: true, Value: 1},
+					Memory:    1024,
+					DiskQuota: 1024,
+				},
+			},
+		})
+
+		Expect(document.Kind).To(Equal("apps"))
+		Expect(document.SchemaVersion).To(Equal(1))
+		matchGoldenFile(document, "apps_v1.json")
+	})
+
+	It("presents no apps as an empty list", func() {
+		matchGoldenFile(NewAppsDocument(nil), "apps_v1_empty.json")
+	})
+})
@@ -0,0 +1,36 @@
+package presenter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/command"
+)
+
+// Document is the top level JSON object displayed by commands when the output
+// format is JSON.
+type Document struct {
+	// Kind is the type of resource described by Data.
+	Kind string `json:"kind"`
+	// SchemaVersion is the version of the schema Data conforms to.
+	SchemaVersion int `json:"schema_version"`
+	// Data is the presented resource or list of resources.
+	Data interface{} `json:"data"`
+}
+
+// Marshal returns the indented JSON encoding of the document.
+func (document Document) Marshal() ([]byte, error) {
+	return json.MarshalIndent(document, "", "  ")
+}
+
+// Display outputs the document to the UI's writer. Warnings and errors are
+// still displayed on stderr, so that stdout only contains the document.
+func Display(ui command.UI, document Document) error {
+	output, err := document.Marshal()
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(ui.Writer(), "%s\n", output)
+	return err
+}
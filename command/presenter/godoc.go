@@ -0,0 +1,7 @@
+// Package presenter should not be imported by external consumers. It was not
+// designed for external use.
+//
+// Package presenter converts the results of read-only commands into versioned
+// JSON documents. The fields of each schema version are stable: fields may be
+// added, but renaming or removing a field requires a new schema version.
+package presenter
@@ -0,0 +1,35 @@
+package presenter
+
+import "code.cloudfoundry.org/cli/actor/v2action"
+
+const (
+	// OrganizationsKind is the kind of documents listing organizations.
+	OrganizationsKind = "organizations"
+	// OrganizationsSchemaVersion is the current version of the organizations
+	// schema.
+	OrganizationsSchemaVersion = 1
+)
+
+// Organization is the JSON representation of an organization.
+type Organization struct {
+	GUID string `json:"guid"`
+	Name string `json:"name"`
+}
+
+// NewOrganizationsDocument returns the document listing the provided
+// organizations.
+func NewOrganizationsDocument(orgs []v2action.Organization) Document {
+	organizations := []Organization{}
+	for _, org := range orgs {
+		organizations = append(organizations, Organization{
+			GUID: org.GUID,
+			Name: org.Name,
+		})
+	}
+
+	return Document{
+		Kind:          OrganizationsKind,
+		SchemaVersion: OrganizationsSchemaVersion,
+		Data:          organizations,
+	}
+}
@@ -0,0 +1,25 @@
+package presenter_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	. "code.cloudfoundry.org/cli/command/presenter"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewOrganizationsDocument", func() {
+	It("presents each organization", func() {
+		document := NewOrganizationsDocument([]v2action.Organization{
+			{GUID: "org-guid-1", Name: "org-1", QuotaDefinitionGUID: "quota-guid"},
+			{GUID: "org-guid-2", Name: "org-2"},
+		})
+
+		Expect(document.Kind).To(Equal("organizations"))
+		Expect(document.SchemaVersion).To(Equal(1))
+		matchGoldenFile(document, "organizations_v1.json")
+	})
+
+	It("presents no organizations as an empty list", func() {
+		matchGoldenFile(NewOrganizationsDocument(nil), "organizations_v1_empty.json")
+	})
+})
@@ -0,0 +1,29 @@
+package presenter_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/presenter"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestPresenter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Presenter Suite")
+}
+
+// matchGoldenFile asserts that the document marshals to the contents of the
+// named file in testdata. Golden files pin each schema version, so a change
+// that breaks one needs a new schema version rather than a new golden file.
+func matchGoldenFile(document presenter.Document, name string) {
+	expected, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	Expect(err).ToNot(HaveOccurred())
+
+	actual, err := document.Marshal()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(actual) + "\n").To(Equal(string(expected)))
+}
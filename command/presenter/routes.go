@@ -0,0 +1,53 @@
+package presenter
+
+import "code.cloudfoundry.org/cli/actor/v2action"
+
+const (
+	// RoutesKind is the kind of documents listing routes.
+	RoutesKind = "routes"
+	// RoutesSchemaVersion is the current version of the routes schema.
+	RoutesSchemaVersion = 1
+)
+
+// Route is the JSON representation of a route. Port is null for HTTP routes,
+// and Service is empty when no route service is bound.
+type Route struct {
+	GUID    string   `json:"guid"`
+	Space   string   `json:"space"`
+	Host    string   `json:"host"`
+	Domain  string   `json:"domain"`
+	Port    *int     `json:"port"`
+	Path    string   `json:"path"`
+	Type    string   `json:"type"`
+	Apps    []string `json:"apps"`
+	Service string   `json:"service"`
+}
+
+// NewRoutesDocument returns the document listing the provided routes.
+func NewRoutesDocument(summaries []v2action.RouteSummary) Document {
+	routes := []Route{}
+	for _, summary := range summaries {
+		route := Route{
+			GUID:    summary.GUID,
+			Space:   summary.SpaceName,
+			Host:    summary.Host,
+			Domain:  summary.Domain.Name,
+			Path:    summary.Path,
+			Type:    summary.Domain.RouterGroupType,
+			Apps:    []string{},
+			Service: summary.ServiceInstanceName,
+		}
+		if summary.Port.IsSet {
+			port := summary.Port.Value
+			route.Port = &port
+		}
+		route.Apps = append(route.Apps, summary.ApplicationNames...)
+		routes = append(routes, route)
+	}
+
+	return Document{
+		Kind:          RoutesKind,
+		SchemaVersion: RoutesSchemaVersion,
+		Data:          routes,
+	}
+}
@@ -0,0 +1,43 @@
+package presenter_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	. "code.cloudfoundry.org/cli/command/presenter"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewRoutesDocument", func() {
+	It("presents HTTP and TCP routes", func() {
+		document := NewRoutesDocument([]v2action.RouteSummary{
+			{
+				Route: v2action.Route{
+					GUID:   "route-guid-1",
+					Host:   "www",
+					Domain: v2action.Domain{Name: "example.com"},
+					Path:   "/app",
+				},
+				SpaceName:           "some-space",
+				ApplicationNames:    []string{"app-1", "app-2"},
+				ServiceInstanceName: "some-route-service",
+			},
+			{
+				Route: v2action.Route{
+					GUID:   "route-guid-2",
+					Domain: v2action.Domain{Name: "tcp.example.com", RouterGroupType: v2action.TCPRouterGroupType},
+					Port:   types.NullInt{IsSet: true, Value: 1024},
+				},
+				SpaceName: "other-space",
+			},
+		})
+
+		Expect(document.Kind).To(Equal("routes"))
+		Expect(document.SchemaVersion).To(Equal(1))
+		matchGoldenFile(document, "routes_v1.json")
+	})
+
+	It("presents no routes as an empty list", func() {
+		matchGoldenFile(NewRoutesDocument(nil), "routes_v1_empty.json")
+	})
+})
@@ -0,0 +1,66 @@
+package presenter
+
+import "code.cloudfoundry.org/cli/actor/v2action"
+
+const (
+	// SecurityGroupsKind is the kind of documents listing security groups.
+	SecurityGroupsKind = "security_groups"
+	// SecurityGroupsSchemaVersion is the current version of the security
+	// groups schema.
+	SecurityGroupsSchemaVersion = 1
+)
+
+// SecurityGroup is the JSON representation of a security group and the spaces
+// it is bound to.
+type SecurityGroup struct {
+	GUID           string                 `json:"guid"`
+	Name           string                 `json:"name"`
+	RunningDefault bool                   `json:"running_default"`
+	StagingDefault bool                   `json:"staging_default"`
+	Bindings       []SecurityGroupBinding `json:"bindings"`
+}
+
+// SecurityGroupBinding is the JSON representation of a security group bound
+// to a space for a lifecycle.
+type SecurityGroupBinding struct {
+	Organization string `json:"organization"`
+	Space        string `json:"space"`
+	Lifecycle    string `json:"lifecycle"`
+}
+
+// NewSecurityGroupsDocument returns the document listing the provided
+// security groups. Consecutive entries for the same security group are
+// grouped together, and groups without any space bindings have no bindings.
+func NewSecurityGroupsDocument(secGroupOrgSpaces []v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) Document {
+	securityGroups := []SecurityGroup{}
+
+	for _, secGroupOrgSpace := range secGroupOrgSpaces {
+		last := len(securityGroups) - 1
+		if last < 0 || securityGroups[last].Name != secGroupOrgSpace.SecurityGroup.Name {
+			securityGroups = append(securityGroups, SecurityGroup{
+				GUID:           secGroupOrgSpace.SecurityGroup.GUID,
+				Name:           secGroupOrgSpace.SecurityGroup.Name,
+				RunningDefault: secGroupOrgSpace.SecurityGroup.RunningDefault,
+				StagingDefault: secGroupOrgSpace.SecurityGroup.StagingDefault,
+				Bindings:       []SecurityGroupBinding{},
+			})
+			last++
+		}
+
+		if secGroupOrgSpace.Space.Name == "" {
+			continue
+		}
+
+		securityGroups[last].Bindings = append(securityGroups[last].Bindings, SecurityGroupBinding{
+			Organization: secGroupOrgSpace.Organization.Name,
+			Space:        secGroupOrgSpace.Space.Name,
+			Lifecycle:    string(secGroupOrgSpace.Lifecycle),
+		})
+	}
+
+	return Document{
+		Kind:          SecurityGroupsKind,
+		SchemaVersion: SecurityGroupsSchemaVersion,
+		Data:          securityGroups,
+	}
+}
@@ -0,0 +1,51 @@
+package presenter_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "code.cloudfoundry.org/cli/command/presenter"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewSecurityGroupsDocument", func() {
+	It("groups the bindings of each security group", func() {
+		defaultGroup := v2action.SecurityGroup{GUID: "default-guid", Name: "default-group", RunningDefault: true}
+		boundGroup := v2action.SecurityGroup{GUID: "bound-guid", Name: "bound-group"}
+		unboundGroup := v2action.SecurityGroup{GUID: "unbound-guid", Name: "unbound-group"}
+
+		document := NewSecurityGroupsDocument([]v2action.SecurityGroupWithOrganizationSpaceAndLifecycle{
+			{
+				SecurityGroup: &boundGroup,
+				Organization:  &v2action.Organization{Name: "some-org"},
+				Space:         &v2action.Space{Name: "some-space"},
+				Lifecycle:     ccv2.SecurityGroupLifecycleRunning,
+			},
+			{
+				SecurityGroup: &boundGroup,
+				Organization:  &v2action.Organization{Name: "some-org"},
+				Space:         &v2action.Space{Name: "other-space"},
+				Lifecycle:     ccv2.SecurityGroupLifecycleStaging,
+			},
+			{
+				SecurityGroup: &defaultGroup,
+				Organization:  &v2action.Organization{},
+				Space:         &v2action.Space{},
+				Lifecycle:     ccv2.SecurityGroupLifecycleRunning,
+			},
+			{
+				SecurityGroup: &unboundGroup,
+				Organization:  &v2action.Organization{},
+				Space:         &v2action.Space{},
+			},
+		})
+
+		Expect(document.Kind).To(Equal("security_groups"))
+		Expect(document.SchemaVersion).To(Equal(1))
+		matchGoldenFile(document, "security_groups_v1.json")
+	})
+
+	It("presents no security groups as an empty list", func() {
+		matchGoldenFile(NewSecurityGroupsDocument(nil), "security_groups_v1_empty.json")
+	})
+})
@@ -0,0 +1,64 @@
+package presenter
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+const (
+	// ServiceInstancesKind is the kind of documents listing the service
+	// instances in a space.
+	ServiceInstancesKind = "service_instances"
+	// ServiceInstancesSchemaVersion is the current version of the service
+	// instances schema.
+	ServiceInstancesSchemaVersion = 1
+)
+
+// ServiceInstance is the JSON representation of a service instance. Service
+// and Plan are empty for user provided service instances.
+type ServiceInstance struct {
+	GUID          string                       `json:"guid"`
+	Name          string                       `json:"name"`
+	UserProvided  bool                         `json:"user_provided"`
+	Service       string                       `json:"service"`
+	Plan          string                       `json:"plan"`
+	BoundApps     []string                     `json:"bound_apps"`
+	LastOperation ServiceInstanceLastOperation `json:"last_operation"`
+}
+
+// ServiceInstanceLastOperation is the JSON representation of the last
+// operation performed on a service instance.
+type ServiceInstanceLastOperation struct {
+	Type        string `json:"type"`
+	State       string `json:"state"`
+	Description string `json:"description"`
+}
+
+// NewServiceInstancesDocument returns the document listing the provided
+// service instances.
+func NewServiceInstancesDocument(summaries []v2action.ServiceInstanceSummary) Document {
+	serviceInstances := []ServiceInstance{}
+	for _, summary := range summaries {
+		serviceInstance := ServiceInstance{
+			GUID:         summary.GUID,
+			Name:         summary.Name,
+			UserProvided: summary.Type == ccv2.UserProvidedService,
+			Service:      summary.ServiceLabel,
+			Plan:         summary.ServicePlanName,
+			BoundApps:    []string{},
+			LastOperation: ServiceInstanceLastOperation{
+				Type:        summary.LastOperation.Type,
+				State:       string(summary.LastOperation.State),
+				Description: summary.LastOperation.Description,
+			},
+		}
+		serviceInstance.BoundApps = append(serviceInstance.BoundApps, summary.BoundApplicationNames...)
+		serviceInstances = append(serviceInstances, serviceInstance)
+	}
+
+	return Document{
+		Kind:          ServiceInstancesKind,
+		SchemaVersion: ServiceInstancesSchemaVersion,
+		Data:          serviceInstances,
+	}
+}
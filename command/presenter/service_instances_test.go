@@ -0,0 +1,46 @@
+package presenter_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "code.cloudfoundry.org/cli/command/presenter"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewServiceInstancesDocument", func() {
+	It("presents managed and user provided service instances", func() {
+		document := NewServiceInstancesDocument([]v2action.ServiceInstanceSummary{
+			{
+				ServiceInstance: v2action.ServiceInstance{
+					GUID: "instance-guid-1",
+					Name: "some-db",
+					Type: ccv2.ManagedService,
+					LastOperation: ccv2.LastOperation{
+						Type:        "create",
+						State:       ccv2.LastOperationSucceeded,
+						Description: "created",
+					},
+				},
+				ServiceLabel:          "p-mysql",
+				ServicePlanName:       "small",
+				BoundApplicationNames: []string{"app-1", "app-2"},
+			},
+			{
+				ServiceInstance: v2action.ServiceInstance{
+					GUID: "instance-guid-2",
+					Name: "some-ups",
+					Type: ccv2.UserProvidedService,
+				},
+			},
+		})
+
+		Expect(document.Kind).To(Equal("service_instances"))
+		Expect(document.SchemaVersion).To(Equal(1))
+		matchGoldenFile(document, "service_instances_v1.json")
+	})
+
+	It("presents no service instances as an empty list", func() {
+		matchGoldenFile(NewServiceInstancesDocument(nil), "service_instances_v1_empty.json")
+	})
+})
@@ -0,0 +1,36 @@
+package presenter
+
+import "code.cloudfoundry.org/cli/actor/v2action"
+
+const (
+	// SpacesKind is the kind of documents listing the spaces in an
+	// organization.
+	SpacesKind = "spaces"
+	// SpacesSchemaVersion is the current version of the spaces schema.
+	SpacesSchemaVersion = 1
+)
+
+// Space is the JSON representation of a space.
+type Space struct {
+	GUID     string `json:"guid"`
+	Name     string `json:"name"`
+	AllowSSH bool   `json:"allow_ssh"`
+}
+
+// NewSpacesDocument returns the document listing the provided spaces.
+func NewSpacesDocument(spaces []v2action.Space) Document {
+	presentedSpaces := []Space{}
+	for _, space := range spaces {
+		presentedSpaces = append(presentedSpaces, Space{
+			GUID:     space.GUID,
+			Name:     space.Name,
+			AllowSSH: space.AllowSSH,
+		})
+	}
+
+	return Document{
+		Kind:          SpacesKind,
+		SchemaVersion: SpacesSchemaVersion,
+		Data:          presentedSpaces,
+	}
+}
@@ -0,0 +1,25 @@
+package presenter_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	. "code.cloudfoundry.org/cli/command/presenter"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewSpacesDocument", func() {
+	It("presents each space", func() {
+		document := NewSpacesDocument([]v2action.Space{
+			{GUID: "space-guid-1", Name: "space-1", AllowSSH: true, OrganizationGUID: "org-guid"},
+			{GUID: "space-guid-2", Name: "space-2", OrganizationGUID: "org-guid"},
+		})
+
+		Expect(document.Kind).To(Equal("spaces"))
+		Expect(document.SchemaVersion).To(Equal(1))
+		matchGoldenFile(document, "spaces_v1.json")
+	})
+
+	It("presents no spaces as an empty list", func() {
+		matchGoldenFile(NewSpacesDocument(nil), "spaces_v1_empty.json")
+	})
+})
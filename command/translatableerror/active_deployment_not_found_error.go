@@ -0,0 +1,21 @@
+package translatableerror
+
+// ActiveDeploymentNotFoundError is returned when an application has no
+// deployment in progress.
+type ActiveDeploymentNotFoundError struct {
+	AppName string
+}
+
+func (ActiveDeploymentNotFoundError) Error() string {
+	return "No active deployment found for app {{.AppName}}."
+}
+
+func (e ActiveDeploymentNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
+
+func (ActiveDeploymentNotFoundError) ErrorCode() string {
+	return "ActiveDeploymentNotFound"
+}
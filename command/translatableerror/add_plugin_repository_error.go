@@ -17,3 +17,7 @@ func (e AddPluginRepositoryError) Translate(translate func(string, ...interface{
 		"Message":        e.Message,
 	})
 }
+
+func (AddPluginRepositoryError) ErrorCode() string {
+	return "AddPluginRepository"
+}
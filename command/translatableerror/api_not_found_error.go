@@ -13,3 +13,7 @@ func (e APINotFoundError) Translate(translate func(string, ...interface{}) strin
 		"URL": e.URL,
 	})
 }
+
+func (APINotFoundError) ErrorCode() string {
+	return "APINotFound"
+}
@@ -13,3 +13,7 @@ func (e APIRequestError) Translate(translate func(string, ...interface{}) string
 		"Error": e.Err,
 	})
 }
+
+func (APIRequestError) ErrorCode() string {
+	return "APIRequest"
+}
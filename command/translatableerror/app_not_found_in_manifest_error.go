@@ -13,3 +13,7 @@ func (e AppNotFoundInManifestError) Translate(translate func(string, ...interfac
 		"AppName": e.Name,
 	})
 }
+
+func (AppNotFoundInManifestError) ErrorCode() string {
+	return "AppNotFoundInManifest"
+}
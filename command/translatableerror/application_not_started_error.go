@@ -0,0 +1,21 @@
+package translatableerror
+
+// ApplicationNotStartedError is returned when trying to SSH into an
+// application that is not started.
+type ApplicationNotStartedError struct {
+	Name string
+}
+
+func (ApplicationNotStartedError) Error() string {
+	return "Application '{{.AppName}}' is not in the STARTED state"
+}
+
+func (e ApplicationNotStartedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.Name,
+	})
+}
+
+func (ApplicationNotStartedError) ErrorCode() string {
+	return "ApplicationNotStarted"
+}
@@ -19,3 +19,7 @@ func (e ArgumentCombinationError) Translate(translate func(string, ...interface{
 		"Args": strings.Join(e.Args, ", "),
 	})
 }
+
+func (ArgumentCombinationError) ErrorCode() string {
+	return "ArgumentCombination"
+}
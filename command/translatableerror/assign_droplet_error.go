@@ -15,3 +15,7 @@ func (e AssignDropletError) Translate(translate func(string, ...interface{}) str
 		"CloudControllerMessage": e.Message,
 	})
 }
+
+func (AssignDropletError) ErrorCode() string {
+	return "AssignDroplet"
+}
@@ -10,3 +10,7 @@ func (AuthorizationEndpointNotFoundError) Error() string {
 func (e AuthorizationEndpointNotFoundError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error())
 }
+
+func (AuthorizationEndpointNotFoundError) ErrorCode() string {
+	return "AuthorizationEndpointNotFound"
+}
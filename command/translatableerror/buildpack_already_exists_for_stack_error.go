@@ -0,0 +1,17 @@
+package translatableerror
+
+type BuildpackAlreadyExistsForStackError struct {
+	Message string
+}
+
+func (e BuildpackAlreadyExistsForStackError) Error() string {
+	return e.Message
+}
+
+func (e BuildpackAlreadyExistsForStackError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+func (BuildpackAlreadyExistsForStackError) ErrorCode() string {
+	return "BuildpackAlreadyExistsForStack"
+}
@@ -0,0 +1,25 @@
+package translatableerror
+
+type BuildpackNotFoundError struct {
+	BuildpackName string
+	StackName     string
+}
+
+func (e BuildpackNotFoundError) Error() string {
+	if e.StackName == "" {
+		return "Buildpack {{.BuildpackName}} not found"
+	}
+
+	return "Buildpack {{.BuildpackName}} with stack {{.StackName}} not found"
+}
+
+func (e BuildpackNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"BuildpackName": e.BuildpackName,
+		"StackName":     e.StackName,
+	})
+}
+
+func (BuildpackNotFoundError) ErrorCode() string {
+	return "BuildpackNotFound"
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+type BuildpackStackChangeError struct {
+	BuildpackName string
+}
+
+func (BuildpackStackChangeError) Error() string {
+	return "Buildpack {{.BuildpackName}} is already associated with a stack"
+}
+
+func (e BuildpackStackChangeError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"BuildpackName": e.BuildpackName,
+	})
+}
+
+func (BuildpackStackChangeError) ErrorCode() string {
+	return "BuildpackStackChange"
+}
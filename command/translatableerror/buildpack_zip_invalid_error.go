@@ -0,0 +1,15 @@
+package translatableerror
+
+type BuildpackZipInvalidError struct{}
+
+func (BuildpackZipInvalidError) Error() string {
+	return "Zip archive does not contain a buildpack"
+}
+
+func (e BuildpackZipInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+func (BuildpackZipInvalidError) ErrorCode() string {
+	return "BuildpackZipInvalid"
+}
@@ -10,3 +10,7 @@ func (CFNetworkingEndpointNotFoundError) Error() string {
 func (e CFNetworkingEndpointNotFoundError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error())
 }
+
+func (CFNetworkingEndpointNotFoundError) ErrorCode() string {
+	return "CFNetworkingEndpointNotFound"
+}
@@ -10,3 +10,7 @@ func (CommandLineArgsWithMultipleAppsError) Error() string {
 func (e CommandLineArgsWithMultipleAppsError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error())
 }
+
+func (CommandLineArgsWithMultipleAppsError) ErrorCode() string {
+	return "CommandLineArgsWithMultipleApps"
+}
@@ -10,3 +10,7 @@ func (ConflictingBuildpacksError) Error() string {
 func (e ConflictingBuildpacksError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error(), nil)
 }
+
+func (ConflictingBuildpacksError) ErrorCode() string {
+	return "ConflictingBuildpacks"
+}
@@ -0,0 +1,23 @@
+package translatableerror
+
+// CorruptConfigError is returned when the config file could not be parsed.
+// The file has been moved to BackupPath and replaced with a default config.
+type CorruptConfigError struct {
+	FilePath   string
+	BackupPath string
+}
+
+func (CorruptConfigError) Error() string {
+	return "Warning: Config file {{.FilePath}} could not be read and has been backed up to {{.BackupPath}}. A new config was created; you may need to log in and target again."
+}
+
+func (e CorruptConfigError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FilePath":   e.FilePath,
+		"BackupPath": e.BackupPath,
+	})
+}
+
+func (CorruptConfigError) ErrorCode() string {
+	return "CorruptConfig"
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// DeploymentCanceledError is returned when a deployment is canceled before it
+// finishes.
+type DeploymentCanceledError struct{}
+
+func (DeploymentCanceledError) Error() string {
+	return "Deployment was canceled. The app was rolled back to its previous droplet."
+}
+
+func (e DeploymentCanceledError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+func (DeploymentCanceledError) ErrorCode() string {
+	return "DeploymentCanceled"
+}
@@ -11,3 +11,7 @@ func (DockerPasswordNotSetError) Error() string {
 func (e DockerPasswordNotSetError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error())
 }
+
+func (DockerPasswordNotSetError) ErrorCode() string {
+	return "DockerPasswordNotSet"
+}
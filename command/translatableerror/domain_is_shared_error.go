@@ -0,0 +1,19 @@
+package translatableerror
+
+type DomainIsSharedError struct {
+	Name string
+}
+
+func (DomainIsSharedError) Error() string {
+	return "domain {{.DomainName}} is a shared domain, not an owned domain."
+}
+
+func (e DomainIsSharedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"DomainName": e.Name,
+	})
+}
+
+func (DomainIsSharedError) ErrorCode() string {
+	return "DomainIsShared"
+}
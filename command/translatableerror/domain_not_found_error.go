@@ -22,3 +22,7 @@ func (e DomainNotFoundError) Translate(translate func(string, ...interface{}) st
 		"DomainGUID": e.GUID,
 	})
 }
+
+func (DomainNotFoundError) ErrorCode() string {
+	return "DomainNotFound"
+}
@@ -13,3 +13,7 @@ func (e DownloadPluginHTTPError) Translate(translate func(string, ...interface{}
 		"ErrorMessage": e.Message,
 	})
 }
+
+func (DownloadPluginHTTPError) ErrorCode() string {
+	return "DownloadPluginHTTP"
+}
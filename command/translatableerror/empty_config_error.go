@@ -13,3 +13,7 @@ func (e EmptyConfigError) Translate(translate func(string, ...interface{}) strin
 		"FilePath": e.FilePath,
 	})
 }
+
+func (EmptyConfigError) ErrorCode() string {
+	return "EmptyConfig"
+}
@@ -13,3 +13,7 @@ func (e EmptyDirectoryError) Translate(translate func(string, ...interface{}) st
 		"Path": e.Path,
 	})
 }
+
+func (EmptyDirectoryError) ErrorCode() string {
+	return "EmptyDirectory"
+}
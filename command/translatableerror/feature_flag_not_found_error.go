@@ -0,0 +1,19 @@
+package translatableerror
+
+type FeatureFlagNotFoundError struct {
+	Name string
+}
+
+func (FeatureFlagNotFoundError) Error() string {
+	return "Feature flag '{{.Name}}' not found."
+}
+
+func (e FeatureFlagNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+func (FeatureFlagNotFoundError) ErrorCode() string {
+	return "FeatureFlagNotFound"
+}
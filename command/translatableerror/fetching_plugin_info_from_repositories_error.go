@@ -15,3 +15,7 @@ func (e FetchingPluginInfoFromRepositoriesError) Translate(translate func(string
 		"ErrorMessage":   e.Message,
 	})
 }
+
+func (FetchingPluginInfoFromRepositoriesError) ErrorCode() string {
+	return "FetchingPluginInfoFromRepositories"
+}
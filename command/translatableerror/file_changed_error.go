@@ -13,3 +13,7 @@ func (e FileChangedError) Translate(translate func(string, ...interface{}) strin
 		"Filename": e.Filename,
 	})
 }
+
+func (FileChangedError) ErrorCode() string {
+	return "FileChanged"
+}
@@ -15,3 +15,7 @@ func (e FileNotFoundError) Translate(translate func(string, ...interface{}) stri
 		"FilePath": e.Path,
 	})
 }
+
+func (FileNotFoundError) ErrorCode() string {
+	return "FileNotFound"
+}
@@ -0,0 +1,22 @@
+package translatableerror
+
+// ForbiddenError is returned when the Cloud Controller refuses a request
+// because the user lacks the permission to perform it, as opposed to the
+// resource not existing.
+type ForbiddenError struct {
+	Message string
+}
+
+func (ForbiddenError) Error() string {
+	return "You do not have permission to perform this action: {{.Message}}\nCheck that your user has the required role in the targeted org and space."
+}
+
+func (e ForbiddenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Message": e.Message,
+	})
+}
+
+func (ForbiddenError) ErrorCode() string {
+	return "Forbidden"
+}
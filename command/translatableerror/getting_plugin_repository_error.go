@@ -14,3 +14,7 @@ func (GettingPluginRepositoryError) Error() string {
 func (e GettingPluginRepositoryError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error(), map[string]interface{}{"RepositoryName": e.Name, "ErrorMessage": e.Message})
 }
+
+func (GettingPluginRepositoryError) ErrorCode() string {
+	return "GettingPluginRepository"
+}
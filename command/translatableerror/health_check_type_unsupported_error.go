@@ -16,3 +16,7 @@ func (e HealthCheckTypeUnsupportedError) Translate(translate func(string, ...int
 		"LastSupportedType": e.SupportedTypes[len(e.SupportedTypes)-1],
 	})
 }
+
+func (HealthCheckTypeUnsupportedError) ErrorCode() string {
+	return "HealthCheckTypeUnsupported"
+}
@@ -10,3 +10,7 @@ func (HTTPHealthCheckInvalidError) Error() string {
 func (e HTTPHealthCheckInvalidError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error())
 }
+
+func (HTTPHealthCheckInvalidError) ErrorCode() string {
+	return "HTTPHealthCheckInvalid"
+}
@@ -0,0 +1,21 @@
+package translatableerror
+
+// InvalidCACertificateError is returned when the CA certificate file set with
+// 'cf api --ca-cert' does not contain any PEM-encoded certificates.
+type InvalidCACertificateError struct {
+	Path string
+}
+
+func (InvalidCACertificateError) Error() string {
+	return "Unable to load CA certificates from {{.Path}}: the file must contain at least one PEM-encoded certificate."
+}
+
+func (e InvalidCACertificateError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
+
+func (InvalidCACertificateError) ErrorCode() string {
+	return "InvalidCACertificate"
+}
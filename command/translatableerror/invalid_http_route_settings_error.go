@@ -0,0 +1,19 @@
+package translatableerror
+
+type InvalidHTTPRouteSettings struct {
+	Domain string
+}
+
+func (InvalidHTTPRouteSettings) Error() string {
+	return "Host and path not allowed in route with TCP domain {{.Domain}}"
+}
+
+func (e InvalidHTTPRouteSettings) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Domain": e.Domain,
+	})
+}
+
+func (InvalidHTTPRouteSettings) ErrorCode() string {
+	return "InvalidHTTPRouteSettings"
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// InvalidOriginError is returned when the origin provided to authenticate
+// does not match an identity provider.
+type InvalidOriginError struct{}
+
+func (InvalidOriginError) Error() string {
+	return "The origin provided is invalid."
+}
+
+func (e InvalidOriginError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+func (InvalidOriginError) ErrorCode() string {
+	return "InvalidOrigin"
+}
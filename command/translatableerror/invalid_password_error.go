@@ -0,0 +1,21 @@
+package translatableerror
+
+// InvalidPasswordError is returned when UAA rejects a new user's password
+// because it violates the password policy.
+type InvalidPasswordError struct {
+	Message string
+}
+
+func (InvalidPasswordError) Error() string {
+	return "The password does not meet the password policy: {{.Message}}\nChoose a password that meets the policy and try again."
+}
+
+func (e InvalidPasswordError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Message": e.Message,
+	})
+}
+
+func (InvalidPasswordError) ErrorCode() string {
+	return "InvalidPassword"
+}
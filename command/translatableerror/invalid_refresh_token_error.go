@@ -10,3 +10,7 @@ func (InvalidRefreshTokenError) Error() string {
 func (e InvalidRefreshTokenError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error())
 }
+
+func (InvalidRefreshTokenError) ErrorCode() string {
+	return "InvalidRefreshToken"
+}
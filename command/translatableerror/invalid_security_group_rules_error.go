@@ -0,0 +1,19 @@
+package translatableerror
+
+type InvalidSecurityGroupRulesError struct {
+	Reason string
+}
+
+func (InvalidSecurityGroupRulesError) Error() string {
+	return "Invalid security group rules: {{.Reason}}"
+}
+
+func (e InvalidSecurityGroupRulesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Reason": e.Reason,
+	})
+}
+
+func (InvalidSecurityGroupRulesError) ErrorCode() string {
+	return "InvalidSecurityGroupRules"
+}
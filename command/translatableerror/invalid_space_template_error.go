@@ -0,0 +1,19 @@
+package translatableerror
+
+type InvalidSpaceTemplateError struct {
+	Reason string
+}
+
+func (InvalidSpaceTemplateError) Error() string {
+	return "Invalid space template: {{.Reason}}"
+}
+
+func (e InvalidSpaceTemplateError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Reason": e.Reason,
+	})
+}
+
+func (InvalidSpaceTemplateError) ErrorCode() string {
+	return "InvalidSpaceTemplate"
+}
@@ -13,3 +13,7 @@ func (e InvalidSSLCertError) Translate(translate func(string, ...interface{}) st
 		"API": e.API,
 	})
 }
+
+func (InvalidSSLCertError) ErrorCode() string {
+	return "InvalidSSLCert"
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+type InvalidTCPRouteSettings struct {
+	Domain string
+}
+
+func (InvalidTCPRouteSettings) Error() string {
+	return "Port not allowed in HTTP domain {{.Domain}}"
+}
+
+func (e InvalidTCPRouteSettings) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Domain": e.Domain,
+	})
+}
+
+func (InvalidTCPRouteSettings) ErrorCode() string {
+	return "InvalidTCPRouteSettings"
+}
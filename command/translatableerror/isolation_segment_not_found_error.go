@@ -13,3 +13,7 @@ func (e IsolationSegmentNotFoundError) Translate(translate func(string, ...inter
 		"Name": e.Name,
 	})
 }
+
+func (IsolationSegmentNotFoundError) ErrorCode() string {
+	return "IsolationSegmentNotFound"
+}
@@ -15,3 +15,7 @@ func (e JobFailedError) Translate(translate func(string, ...interface{}) string)
 		"JobGUID": e.JobGUID,
 	})
 }
+
+func (JobFailedError) ErrorCode() string {
+	return "JobFailed"
+}
@@ -16,3 +16,7 @@ func (e JobTimeoutError) Translate(translate func(string, ...interface{}) string
 		"JobGUID": e.JobGUID,
 	})
 }
+
+func (JobTimeoutError) ErrorCode() string {
+	return "JobTimeout"
+}
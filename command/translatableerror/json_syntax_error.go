@@ -13,3 +13,7 @@ func (e JSONSyntaxError) Translate(translate func(string, ...interface{}) string
 		"Err": e.Err.Error(),
 	})
 }
+
+func (JSONSyntaxError) ErrorCode() string {
+	return "JSONSyntax"
+}
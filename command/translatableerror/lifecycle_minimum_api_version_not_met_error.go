@@ -15,3 +15,7 @@ func (e LifecycleMinimumAPIVersionNotMetError) Translate(translate func(string,
 		"MinimumVersion": e.MinimumVersion,
 	})
 }
+
+func (LifecycleMinimumAPIVersionNotMetError) ErrorCode() string {
+	return "LifecycleMinimumAPIVersionNotMet"
+}
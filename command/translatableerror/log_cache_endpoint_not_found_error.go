@@ -0,0 +1,16 @@
+package translatableerror
+
+type LogCacheEndpointNotFoundError struct {
+}
+
+func (LogCacheEndpointNotFoundError) Error() string {
+	return "This command requires the Log Cache API. Your targeted endpoint does not expose it."
+}
+
+func (e LogCacheEndpointNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+func (LogCacheEndpointNotFoundError) ErrorCode() string {
+	return "LogCacheEndpointNotFound"
+}
@@ -20,3 +20,7 @@ func (e MinimumAPIVersionNotMetError) Translate(translate func(string, ...interf
 		"MinimumVersion": e.MinimumVersion,
 	})
 }
+
+func (MinimumAPIVersionNotMetError) ErrorCode() string {
+	return "MinimumAPIVersionNotMet"
+}
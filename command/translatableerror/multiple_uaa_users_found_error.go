@@ -0,0 +1,25 @@
+package translatableerror
+
+import "strings"
+
+// MultipleUAAUsersFoundError is returned when the provided username exists in
+// more than one identity provider.
+type MultipleUAAUsersFoundError struct {
+	Username string
+	Origins  []string
+}
+
+func (MultipleUAAUsersFoundError) Error() string {
+	return "The username '{{.Username}}' is found in multiple origins: {{.Origins}}. Specify the origin with the '--origin' flag."
+}
+
+func (e MultipleUAAUsersFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Username": e.Username,
+		"Origins":  strings.Join(e.Origins, ", "),
+	})
+}
+
+func (MultipleUAAUsersFoundError) ErrorCode() string {
+	return "MultipleUAAUsersFound"
+}
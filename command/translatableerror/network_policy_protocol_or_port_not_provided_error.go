@@ -11,3 +11,7 @@ func (NetworkPolicyProtocolOrPortNotProvidedError) Error() string {
 func (e NetworkPolicyProtocolOrPortNotProvidedError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error())
 }
+
+func (NetworkPolicyProtocolOrPortNotProvidedError) ErrorCode() string {
+	return "NetworkPolicyProtocolOrPortNotProvided"
+}
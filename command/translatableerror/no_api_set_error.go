@@ -16,3 +16,7 @@ func (e NoAPISetError) Translate(translate func(string, ...interface{}) string)
 		"APITip":   fmt.Sprintf("%s api", e.BinaryName),
 	})
 }
+
+func (NoAPISetError) ErrorCode() string {
+	return "NoAPISet"
+}
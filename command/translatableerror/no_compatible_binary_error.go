@@ -10,3 +10,7 @@ func (e NoCompatibleBinaryError) Error() string {
 func (e NoCompatibleBinaryError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error())
 }
+
+func (NoCompatibleBinaryError) ErrorCode() string {
+	return "NoCompatibleBinary"
+}
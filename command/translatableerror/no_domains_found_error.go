@@ -12,3 +12,7 @@ func (NoDomainsFoundError) Error() string {
 func (e NoDomainsFoundError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error())
 }
+
+func (NoDomainsFoundError) ErrorCode() string {
+	return "NoDomainsFound"
+}
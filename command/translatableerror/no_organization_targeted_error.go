@@ -15,3 +15,7 @@ func (e NoOrganizationTargetedError) Translate(translate func(string, ...interfa
 		"Command": fmt.Sprintf("%s target -o ORG", e.BinaryName),
 	})
 }
+
+func (NoOrganizationTargetedError) ErrorCode() string {
+	return "NoOrganizationTargeted"
+}
@@ -9,3 +9,7 @@ func (NoPluginRepositoriesError) Error() string {
 func (e NoPluginRepositoriesError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error())
 }
+
+func (NoPluginRepositoriesError) ErrorCode() string {
+	return "NoPluginRepositories"
+}
@@ -15,3 +15,7 @@ func (e NoSpaceTargetedError) Translate(translate func(string, ...interface{}) s
 		"Command": fmt.Sprintf("%s target -s SPACE", e.BinaryName),
 	})
 }
+
+func (NoSpaceTargetedError) ErrorCode() string {
+	return "NoSpaceTargeted"
+}
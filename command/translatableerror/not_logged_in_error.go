@@ -15,3 +15,7 @@ func (e NotLoggedInError) Translate(translate func(string, ...interface{}) strin
 		"CFLoginCommand": fmt.Sprintf("%s login", e.BinaryName),
 	})
 }
+
+func (NotLoggedInError) ErrorCode() string {
+	return "NotLoggedIn"
+}
@@ -0,0 +1,18 @@
+package translatableerror
+
+// OperationCancelledError is returned when the user interrupts a command
+// while it is talking to the Cloud Controller.
+type OperationCancelledError struct {
+}
+
+func (OperationCancelledError) Error() string {
+	return "Operation cancelled. Changes already made by the command were not rolled back."
+}
+
+func (e OperationCancelledError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+func (OperationCancelledError) ErrorCode() string {
+	return "OperationCancelled"
+}
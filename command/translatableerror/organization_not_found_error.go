@@ -13,3 +13,7 @@ func (e OrganizationNotFoundError) Translate(translate func(string, ...interface
 		"Name": e.Name,
 	})
 }
+
+func (OrganizationNotFoundError) ErrorCode() string {
+	return "OrganizationNotFound"
+}
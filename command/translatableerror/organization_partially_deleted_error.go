@@ -0,0 +1,21 @@
+package translatableerror
+
+type OrganizationPartiallyDeletedError struct {
+	Name         string
+	FailureCount int
+}
+
+func (OrganizationPartiallyDeletedError) Error() string {
+	return "Org {{.Name}} was not deleted because {{.FailureCount}} of its resources could not be deleted. Review the failures above and run the command again."
+}
+
+func (e OrganizationPartiallyDeletedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":         e.Name,
+		"FailureCount": e.FailureCount,
+	})
+}
+
+func (OrganizationPartiallyDeletedError) ErrorCode() string {
+	return "OrganizationPartiallyDeleted"
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// OrganizationQuotaNameTakenError is returned when renaming an organization
+// quota fails due to a quota already existing with the same name.
+type OrganizationQuotaNameTakenError struct {
+	Name string
+}
+
+func (OrganizationQuotaNameTakenError) Error() string {
+	return "Quota Definition {{.QuotaName}} already exists"
+}
+
+func (e OrganizationQuotaNameTakenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"QuotaName": e.Name})
+}
+
+func (OrganizationQuotaNameTakenError) ErrorCode() string {
+	return "OrganizationQuotaNameTaken"
+}
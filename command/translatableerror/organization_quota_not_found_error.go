@@ -0,0 +1,19 @@
+package translatableerror
+
+type OrganizationQuotaNotFoundError struct {
+	Name string
+}
+
+func (OrganizationQuotaNotFoundError) Error() string {
+	return "Quota '{{.Name}}' not found."
+}
+
+func (e OrganizationQuotaNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+func (OrganizationQuotaNotFoundError) ErrorCode() string {
+	return "OrganizationQuotaNotFound"
+}
@@ -17,3 +17,7 @@ func (e ParseArgumentError) Translate(translate func(string, ...interface{}) str
 		"ExpectedType": e.ExpectedType,
 	})
 }
+
+func (ParseArgumentError) ErrorCode() string {
+	return "ParseArgument"
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// PasswordGrantTypeLogoutRequiredError is returned when a user tries to log
+// in while a service account is authenticated.
+type PasswordGrantTypeLogoutRequiredError struct{}
+
+func (PasswordGrantTypeLogoutRequiredError) Error() string {
+	return "Service account currently logged in. Use 'logout' to log out service account and try again."
+}
+
+func (e PasswordGrantTypeLogoutRequiredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+func (PasswordGrantTypeLogoutRequiredError) ErrorCode() string {
+	return "PasswordGrantTypeLogoutRequired"
+}
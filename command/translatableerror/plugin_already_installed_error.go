@@ -19,3 +19,7 @@ func (e PluginAlreadyInstalledError) Translate(translate func(string, ...interfa
 		"Version":    e.Version,
 	})
 }
+
+func (PluginAlreadyInstalledError) ErrorCode() string {
+	return "PluginAlreadyInstalled"
+}
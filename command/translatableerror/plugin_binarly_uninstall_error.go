@@ -15,3 +15,7 @@ func (e PluginBinaryUninstallError) Translate(translate func(string, ...interfac
 		"Err": e.Err,
 	})
 }
+
+func (PluginBinaryUninstallError) ErrorCode() string {
+	return "PluginBinaryUninstall"
+}
@@ -15,3 +15,7 @@ func (e PluginBinaryRemoveFailedError) Translate(translate func(string, ...inter
 		"Err": e.Err,
 	})
 }
+
+func (PluginBinaryRemoveFailedError) ErrorCode() string {
+	return "PluginBinaryRemoveFailed"
+}
@@ -33,3 +33,7 @@ func (e PluginCommandsConflictError) Translate(translate func(string, ...interfa
 		"CommandNamesAndAliases": strings.Join(append(e.CommandNames, e.CommandAliases...), ", "),
 	})
 }
+
+func (PluginCommandsConflictError) ErrorCode() string {
+	return "PluginCommandsConflict"
+}
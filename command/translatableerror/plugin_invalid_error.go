@@ -21,3 +21,7 @@ func (e PluginInvalidError) Error() string {
 func (e PluginInvalidError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error())
 }
+
+func (PluginInvalidError) ErrorCode() string {
+	return "PluginInvalid"
+}
@@ -13,3 +13,7 @@ func (e PluginNotFoundError) Translate(translate func(string, ...interface{}) st
 		"PluginName": e.PluginName,
 	})
 }
+
+func (PluginNotFoundError) ErrorCode() string {
+	return "PluginNotFound"
+}
@@ -17,3 +17,7 @@ func (e PluginNotFoundInRepositoryError) Translate(translate func(string, ...int
 		"BinaryName":     e.BinaryName,
 	})
 }
+
+func (PluginNotFoundInRepositoryError) ErrorCode() string {
+	return "PluginNotFoundInRepository"
+}
@@ -15,3 +15,7 @@ func (e PluginNotFoundOnDiskOrInAnyRepositoryError) Translate(translate func(str
 		"BinaryName": e.BinaryName,
 	})
 }
+
+func (PluginNotFoundOnDiskOrInAnyRepositoryError) ErrorCode() string {
+	return "PluginNotFoundOnDiskOrInAnyRepository"
+}
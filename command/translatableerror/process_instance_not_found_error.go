@@ -16,3 +16,7 @@ func (e ProcessInstanceNotFoundError) Translate(translate func(string, ...interf
 		"InstanceIndex": e.InstanceIndex,
 	})
 }
+
+func (ProcessInstanceNotFoundError) ErrorCode() string {
+	return "ProcessInstanceNotFound"
+}
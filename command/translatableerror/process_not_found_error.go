@@ -14,3 +14,7 @@ func (e ProcessNotFoundError) Translate(translate func(string, ...interface{}) s
 		"ProcessType": e.ProcessType,
 	})
 }
+
+func (ProcessNotFoundError) ErrorCode() string {
+	return "ProcessNotFound"
+}
@@ -0,0 +1,25 @@
+package translatableerror
+
+import "strings"
+
+// PropertyCombinationError represents an error caused by configuring an
+// application with properties that cannot be used together.
+type PropertyCombinationError struct {
+	AppName    string
+	Properties []string
+}
+
+func (PropertyCombinationError) Error() string {
+	return "Application {{.AppName}} cannot use the combination of properties: {{.Properties}}"
+}
+
+func (e PropertyCombinationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":    e.AppName,
+		"Properties": strings.Join(e.Properties, ", "),
+	})
+}
+
+func (PropertyCombinationError) ErrorCode() string {
+	return "PropertyCombination"
+}
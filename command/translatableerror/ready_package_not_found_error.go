@@ -0,0 +1,21 @@
+package translatableerror
+
+// ReadyPackageNotFoundError is returned when an application has no package
+// that is ready to be staged.
+type ReadyPackageNotFoundError struct {
+	AppName string
+}
+
+func (ReadyPackageNotFoundError) Error() string {
+	return "App {{.AppName}} has no ready package to stage. Push the app before restaging."
+}
+
+func (e ReadyPackageNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
+
+func (ReadyPackageNotFoundError) ErrorCode() string {
+	return "ReadyPackageNotFound"
+}
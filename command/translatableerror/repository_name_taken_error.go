@@ -13,3 +13,7 @@ func (RepositoryNameTakenError) Error() string {
 func (e RepositoryNameTakenError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error(), map[string]interface{}{"RepositoryName": e.Name})
 }
+
+func (RepositoryNameTakenError) ErrorCode() string {
+	return "RepositoryNameTaken"
+}
@@ -13,3 +13,7 @@ func (e RepositoryNotRegisteredError) Translate(translate func(string, ...interf
 		"Name": e.Name,
 	})
 }
+
+func (RepositoryNotRegisteredError) ErrorCode() string {
+	return "RepositoryNotRegistered"
+}
@@ -15,3 +15,7 @@ func (e RequiredArgumentError) Translate(translate func(string, ...interface{})
 		"ArgumentName": e.ArgumentName,
 	})
 }
+
+func (RequiredArgumentError) ErrorCode() string {
+	return "RequiredArgument"
+}
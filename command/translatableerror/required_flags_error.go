@@ -19,3 +19,7 @@ func (e RequiredFlagsError) Translate(translate func(string, ...interface{}) str
 		"Arg2": e.Arg2,
 	})
 }
+
+func (RequiredFlagsError) ErrorCode() string {
+	return "RequiredFlags"
+}
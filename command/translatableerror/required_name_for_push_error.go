@@ -12,3 +12,7 @@ func (RequiredNameForPushError) Error() string {
 func (e RequiredNameForPushError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error())
 }
+
+func (RequiredNameForPushError) ErrorCode() string {
+	return "RequiredNameForPush"
+}
@@ -0,0 +1,21 @@
+package translatableerror
+
+// RevisionNotDeployableError is returned when rolling back to a revision
+// whose droplet no longer exists.
+type RevisionNotDeployableError struct {
+	Version int
+}
+
+func (RevisionNotDeployableError) Error() string {
+	return "Revision {{.Version}} cannot be deployed because its droplet no longer exists."
+}
+
+func (e RevisionNotDeployableError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Version": e.Version,
+	})
+}
+
+func (RevisionNotDeployableError) ErrorCode() string {
+	return "RevisionNotDeployable"
+}
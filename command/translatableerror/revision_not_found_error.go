@@ -0,0 +1,21 @@
+package translatableerror
+
+// RevisionNotFoundError is returned when an application has no revision with
+// the requested version.
+type RevisionNotFoundError struct {
+	Version int
+}
+
+func (RevisionNotFoundError) Error() string {
+	return "Revision {{.Version}} not found."
+}
+
+func (e RevisionNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Version": e.Version,
+	})
+}
+
+func (RevisionNotFoundError) ErrorCode() string {
+	return "RevisionNotFound"
+}
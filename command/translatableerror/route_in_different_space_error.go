@@ -13,3 +13,7 @@ func (e RouteInDifferentSpaceError) Translate(translate func(string, ...interfac
 		"Route": e.Route,
 	})
 }
+
+func (RouteInDifferentSpaceError) ErrorCode() string {
+	return "RouteInDifferentSpace"
+}
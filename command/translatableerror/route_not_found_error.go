@@ -0,0 +1,20 @@
+package translatableerror
+
+// RouteNotFoundError is returned when a route cannot be found.
+type RouteNotFoundError struct {
+	URL string
+}
+
+func (RouteNotFoundError) Error() string {
+	return "Route {{.URL}} not found."
+}
+
+func (e RouteNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"URL": e.URL,
+	})
+}
+
+func (RouteNotFoundError) ErrorCode() string {
+	return "RouteNotFound"
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+type RouterGroupNotFoundError struct {
+	Name string
+}
+
+func (RouterGroupNotFoundError) Error() string {
+	return "Router group {{.RouterGroup}} not found"
+}
+
+func (e RouterGroupNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"RouterGroup": e.Name,
+	})
+}
+
+func (RouterGroupNotFoundError) ErrorCode() string {
+	return "RouterGroupNotFound"
+}
@@ -0,0 +1,16 @@
+package translatableerror
+
+type RoutingAPIEndpointNotFoundError struct {
+}
+
+func (RoutingAPIEndpointNotFoundError) Error() string {
+	return "This command requires the Routing API. Your targeted endpoint reports it is not enabled."
+}
+
+func (e RoutingAPIEndpointNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+func (RoutingAPIEndpointNotFoundError) ErrorCode() string {
+	return "RoutingAPIEndpointNotFound"
+}
@@ -13,3 +13,7 @@ func (e RunTaskError) Translate(translate func(string, ...interface{}) string) s
 		"CloudControllerMessage": e.Message,
 	})
 }
+
+func (RunTaskError) ErrorCode() string {
+	return "RunTask"
+}
@@ -0,0 +1,21 @@
+package translatableerror
+
+// SecurityGroupRuleViolationsError is returned when problems were found in
+// the security group rules being checked.
+type SecurityGroupRuleViolationsError struct {
+	Count int
+}
+
+func (SecurityGroupRuleViolationsError) Error() string {
+	return "Found {{.Count}} problem(s) in the security group rules."
+}
+
+func (e SecurityGroupRuleViolationsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Count": e.Count,
+	})
+}
+
+func (SecurityGroupRuleViolationsError) ErrorCode() string {
+	return "SecurityGroupRuleViolations"
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+type ServiceBindingFailedError struct {
+	Description string
+}
+
+func (ServiceBindingFailedError) Error() string {
+	return "Service binding failed: {{.Description}}"
+}
+
+func (e ServiceBindingFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Description": e.Description,
+	})
+}
+
+func (ServiceBindingFailedError) ErrorCode() string {
+	return "ServiceBindingFailed"
+}
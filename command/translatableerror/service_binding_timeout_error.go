@@ -0,0 +1,23 @@
+package translatableerror
+
+import "time"
+
+type ServiceBindingTimeoutError struct {
+	GUID    string
+	Timeout time.Duration
+}
+
+func (ServiceBindingTimeoutError) Error() string {
+	return "Timed out after {{.Timeout}} waiting for service binding {{.GUID}} to complete. The operation may still be running."
+}
+
+func (e ServiceBindingTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Timeout": e.Timeout,
+		"GUID":    e.GUID,
+	})
+}
+
+func (ServiceBindingTimeoutError) ErrorCode() string {
+	return "ServiceBindingTimeout"
+}
@@ -18,3 +18,7 @@ func (e ServiceInstanceNotFoundError) Translate(translate func(string, ...interf
 		"ServiceInstance": e.Name,
 	})
 }
+
+func (ServiceInstanceNotFoundError) ErrorCode() string {
+	return "ServiceInstanceNotFound"
+}
@@ -0,0 +1,25 @@
+package translatableerror
+
+import "time"
+
+type ServiceInstanceOperationTimeoutError struct {
+	Name      string
+	Operation string
+	Timeout   time.Duration
+}
+
+func (ServiceInstanceOperationTimeoutError) Error() string {
+	return "Timed out after {{.Timeout}} waiting for service instance {{.ServiceInstanceName}} {{.Operation}} to complete. The operation may still be running."
+}
+
+func (e ServiceInstanceOperationTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Timeout":             e.Timeout,
+		"ServiceInstanceName": e.Name,
+		"Operation":           e.Operation,
+	})
+}
+
+func (ServiceInstanceOperationTimeoutError) ErrorCode() string {
+	return "ServiceInstanceOperationTimeout"
+}
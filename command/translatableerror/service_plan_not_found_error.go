@@ -0,0 +1,19 @@
+package translatableerror
+
+type ServicePlanNotFoundError struct {
+	PlanName string
+}
+
+func (ServicePlanNotFoundError) Error() string {
+	return "Could not find plan with name {{.ServicePlanName}}"
+}
+
+func (e ServicePlanNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServicePlanName": e.PlanName,
+	})
+}
+
+func (ServicePlanNotFoundError) ErrorCode() string {
+	return "ServicePlanNotFound"
+}
@@ -13,3 +13,7 @@ func (e SpaceNotFoundError) Translate(translate func(string, ...interface{}) str
 		"Name": e.Name,
 	})
 }
+
+func (SpaceNotFoundError) ErrorCode() string {
+	return "SpaceNotFound"
+}
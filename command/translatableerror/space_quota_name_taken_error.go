@@ -0,0 +1,19 @@
+package translatableerror
+
+// SpaceQuotaNameTakenError is returned when renaming a space quota fails due
+// to a space quota already existing with the same name in the organization.
+type SpaceQuotaNameTakenError struct {
+	Name string
+}
+
+func (SpaceQuotaNameTakenError) Error() string {
+	return "Space Quota Definition {{.QuotaName}} already exists"
+}
+
+func (e SpaceQuotaNameTakenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"QuotaName": e.Name})
+}
+
+func (SpaceQuotaNameTakenError) ErrorCode() string {
+	return "SpaceQuotaNameTaken"
+}
@@ -0,0 +1,23 @@
+package translatableerror
+
+// SSHCommandFailedError is returned when a command run on an application
+// instance over SSH exits with a non-zero exit code.
+type SSHCommandFailedError struct {
+	ExitCode int
+	Stderr   string
+}
+
+func (SSHCommandFailedError) Error() string {
+	return "The command failed on the app instance with exit code {{.ExitCode}}: {{.Stderr}}"
+}
+
+func (e SSHCommandFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ExitCode": e.ExitCode,
+		"Stderr":   e.Stderr,
+	})
+}
+
+func (SSHCommandFailedError) ErrorCode() string {
+	return "SSHCommandFailed"
+}
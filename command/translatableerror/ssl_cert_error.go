@@ -13,3 +13,7 @@ func (e SSLCertError) Translate(translate func(string, ...interface{}) string) s
 		"Message": e.Message,
 	})
 }
+
+func (SSLCertError) ErrorCode() string {
+	return "SSLCert"
+}
@@ -19,3 +19,7 @@ func (e StackNotFoundError) Translate(translate func(string, ...interface{}) str
 		"Name": e.Name,
 	})
 }
+
+func (StackNotFoundError) ErrorCode() string {
+	return "StackNotFound"
+}
@@ -13,3 +13,7 @@ func (e StagingFailedError) Translate(translate func(string, ...interface{}) str
 		"Message": e.Message,
 	})
 }
+
+func (StagingFailedError) ErrorCode() string {
+	return "StagingFailed"
+}
@@ -17,3 +17,7 @@ func (e StagingFailedNoAppDetectedError) Translate(translate func(string, ...int
 		"BuildpackCommand": fmt.Sprintf("%s buildpacks", e.BinaryName),
 	})
 }
+
+func (StagingFailedNoAppDetectedError) ErrorCode() string {
+	return "StagingFailedNoAppDetected"
+}
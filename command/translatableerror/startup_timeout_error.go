@@ -15,3 +15,7 @@ func (e StartupTimeoutError) Translate(translate func(string, ...interface{}) st
 		"BinaryName": e.BinaryName,
 	})
 }
+
+func (StartupTimeoutError) ErrorCode() string {
+	return "StartupTimeout"
+}
@@ -0,0 +1,16 @@
+package translatableerror
+
+type TCPRouteOptionsNotProvidedError struct {
+}
+
+func (TCPRouteOptionsNotProvidedError) Error() string {
+	return "The route is invalid: For TCP routes you must specify a port or request a random one."
+}
+
+func (e TCPRouteOptionsNotProvidedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+func (TCPRouteOptionsNotProvidedError) ErrorCode() string {
+	return "TCPRouteOptionsNotProvided"
+}
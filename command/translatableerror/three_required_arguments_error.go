@@ -19,3 +19,7 @@ func (e ThreeRequiredArgumentsError) Translate(translate func(string, ...interfa
 		"ArgumentName3": e.ArgumentName3,
 	})
 }
+
+func (ThreeRequiredArgumentsError) ErrorCode() string {
+	return "ThreeRequiredArguments"
+}
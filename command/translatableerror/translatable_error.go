@@ -8,4 +8,20 @@ type TranslatableError interface {
 	// Returns the untranslated error string
 	Error() string
 	Translate(func(string, ...interface{}) string) string
+	// Returns a stable identifier for the error that automation can branch
+	// on; it does not change with the locale or the wording of the message
+	ErrorCode() string
+}
+
+// UnknownErrorCode is the error code reported for errors that are not
+// TranslatableErrors.
+const UnknownErrorCode = "Unknown"
+
+// ErrorCode returns the error code of err if it is a TranslatableError,
+// otherwise it returns UnknownErrorCode.
+func ErrorCode(err error) string {
+	if translatableError, ok := err.(TranslatableError); ok {
+		return translatableError.ErrorCode()
+	}
+	return UnknownErrorCode
 }
@@ -28,6 +28,15 @@ type FakeTranslatableError struct {
 	translateReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ErrorCodeStub        func() string
+	errorCodeMutex       sync.RWMutex
+	errorCodeArgsForCall []struct{}
+	errorCodeReturns     struct {
+		result1 string
+	}
+	errorCodeReturnsOnCall map[int]struct {
+		result1 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -120,6 +129,46 @@ func (fake *FakeTranslatableError) TranslateReturnsOnCall(i int, result1 string)
 	}{result1}
 }
 
+func (fake *FakeTranslatableError) ErrorCode() string {
+	fake.errorCodeMutex.Lock()
+	ret, specificReturn := fake.errorCodeReturnsOnCall[len(fake.errorCodeArgsForCall)]
+	fake.errorCodeArgsForCall = append(fake.errorCodeArgsForCall, struct{}{})
+	fake.recordInvocation("ErrorCode", []interface{}{})
+	fake.errorCodeMutex.Unlock()
+	if fake.ErrorCodeStub != nil {
+		return fake.ErrorCodeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.errorCodeReturns.result1
+}
+
+func (fake *FakeTranslatableError) ErrorCodeCallCount() int {
+	fake.errorCodeMutex.RLock()
+	defer fake.errorCodeMutex.RUnlock()
+	return len(fake.errorCodeArgsForCall)
+}
+
+func (fake *FakeTranslatableError) ErrorCodeReturns(result1 string) {
+	fake.ErrorCodeStub = nil
+	fake.errorCodeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeTranslatableError) ErrorCodeReturnsOnCall(i int, result1 string) {
+	fake.ErrorCodeStub = nil
+	if fake.errorCodeReturnsOnCall == nil {
+		fake.errorCodeReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.errorCodeReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeTranslatableError) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -127,6 +176,8 @@ func (fake *FakeTranslatableError) Invocations() map[string][][]interface{} {
 	defer fake.errorMutex.RUnlock()
 	fake.translateMutex.RLock()
 	defer fake.translateMutex.RUnlock()
+	fake.errorCodeMutex.RLock()
+	defer fake.errorCodeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
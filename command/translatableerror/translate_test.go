@@ -3,6 +3,7 @@ package translatableerror_test
 import (
 	"bytes"
 	"errors"
+	"reflect"
 	"text/template"
 
 	. "code.cloudfoundry.org/cli/command/translatableerror"
@@ -29,39 +30,72 @@ var _ = Describe("Translatable Errors", func() {
 		}
 	}
 
+	// errorCodes maps each error code seen by the table below to the type of
+	// the error that returned it, so that codes are known to be unique.
+	errorCodes := map[string]string{}
+
 	DescribeTable("translates error",
 		func(e error) {
 			err, ok := e.(TranslatableError)
 			Expect(ok).To(BeTrue())
 			err.Translate(translateFunc)
+
+			code := err.ErrorCode()
+			Expect(code).ToNot(BeEmpty())
+			errorType := reflect.TypeOf(err).Name()
+			if existingType, found := errorCodes[code]; found {
+				Expect(existingType).To(Equal(errorType), "error code %s is used by both %s and %s", code, existingType, errorType)
+			}
+			errorCodes[code] = errorType
 		},
 
+		Entry("ActiveDeploymentNotFoundError", ActiveDeploymentNotFoundError{}),
 		Entry("AddPluginRepositoryError", AddPluginRepositoryError{}),
 		Entry("APINotFoundError", APINotFoundError{}),
 		Entry("APIRequestError", APIRequestError{}),
 		Entry("ApplicationNotFoundError", ApplicationNotFoundError{}),
+		Entry("ApplicationNotStartedError", ApplicationNotStartedError{}),
 		Entry("AppNotFoundInManifestError", AppNotFoundInManifestError{}),
 		Entry("ArgumentCombinationError", ArgumentCombinationError{}),
 		Entry("AssignDropletError", AssignDropletError{}),
 		Entry("BadCredentialsError", BadCredentialsError{}),
+		Entry("BuildpackAlreadyExistsForStackError", BuildpackAlreadyExistsForStackError{}),
+		Entry("BuildpackNotFoundError", BuildpackNotFoundError{}),
+		Entry("BuildpackStackChangeError", BuildpackStackChangeError{}),
+		Entry("BuildpackZipInvalidError", BuildpackZipInvalidError{}),
 		Entry("CFNetworkingEndpointNotFoundError", CFNetworkingEndpointNotFoundError{}),
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
+		Entry("CorruptConfigError", CorruptConfigError{}),
+		Entry("DeploymentCanceledError", DeploymentCanceledError{}),
 		Entry("DockerPasswordNotSetError", DockerPasswordNotSetError{}),
+		Entry("DomainIsSharedError", DomainIsSharedError{}),
 		Entry("DownloadPluginHTTPError", DownloadPluginHTTPError{}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
+		Entry("FeatureFlagNotFoundError", FeatureFlagNotFoundError{}),
 		Entry("FetchingPluginInfoFromRepositoriesError", FetchingPluginInfoFromRepositoriesError{}),
 		Entry("FileChangedError", FileChangedError{}),
 		Entry("FileNotFoundError", FileNotFoundError{}),
+		Entry("ForbiddenError", ForbiddenError{}),
 		Entry("GettingPluginRepositoryError", GettingPluginRepositoryError{}),
 		Entry("HealthCheckTypeUnsupportedError", HealthCheckTypeUnsupportedError{SupportedTypes: []string{"some-type", "another-type"}}),
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
+		Entry("InvalidCACertificateError", InvalidCACertificateError{}),
+		Entry("InvalidHTTPRouteSettings", InvalidHTTPRouteSettings{}),
+		Entry("InvalidOriginError", InvalidOriginError{}),
+		Entry("InvalidPasswordError", InvalidPasswordError{}),
+		Entry("InvalidSecurityGroupRulesError", InvalidSecurityGroupRulesError{}),
+		Entry("InvalidSpaceTemplateError", InvalidSpaceTemplateError{}),
 		Entry("InvalidSSLCertError", InvalidSSLCertError{}),
+		Entry("InvalidTCPRouteSettings", InvalidTCPRouteSettings{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
 		Entry("JobFailedError", JobFailedError{}),
 		Entry("JobTimeoutError", JobTimeoutError{}),
 		Entry("JSONSyntaxError", JSONSyntaxError{Err: errors.New("some-error")}),
 		Entry("LifecycleMinimumAPIVersionNotMetError", LifecycleMinimumAPIVersionNotMetError{}),
+		Entry("LogCacheEndpointNotFoundError", LogCacheEndpointNotFoundError{}),
 		Entry("MinimumAPIVersionNotMetError", MinimumAPIVersionNotMetError{}),
+		Entry("MultipleBuildpacksFoundError", MultipleBuildpacksFoundError{}),
+		Entry("MultipleUAAUsersFoundError", MultipleUAAUsersFoundError{}),
 		Entry("NetworkPolicyProtocolOrPortNotProvidedError", NetworkPolicyProtocolOrPortNotProvidedError{}),
 		Entry("NoAPISetError", NoAPISetError{}),
 		Entry("NoCompatibleBinaryError", NoCompatibleBinaryError{}),
@@ -70,10 +104,15 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("NoPluginRepositoriesError", NoPluginRepositoriesError{}),
 		Entry("NoSpaceTargetedError", NoSpaceTargetedError{}),
 		Entry("NotLoggedInError", NotLoggedInError{}),
+		Entry("OperationCancelledError", OperationCancelledError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
+		Entry("OrganizationPartiallyDeletedError", OrganizationPartiallyDeletedError{}),
+		Entry("OrganizationQuotaNameTakenError", OrganizationQuotaNameTakenError{}),
+		Entry("OrganizationQuotaNotFoundError", OrganizationQuotaNotFoundError{}),
 		Entry("ParseArgumentError", ParseArgumentError{}),
 		Entry("PluginAlreadyInstalledError", PluginAlreadyInstalledError{}),
 		Entry("PluginBinaryRemoveFailedError", PluginBinaryRemoveFailedError{}),
+		Entry("PasswordGrantTypeLogoutRequiredError", PasswordGrantTypeLogoutRequiredError{}),
 		Entry("PluginBinaryUninstallError", PluginBinaryUninstallError{}),
 		Entry("PluginCommandsConflictError", PluginCommandsConflictError{}),
 		Entry("PluginInvalidError", PluginInvalidError{Err: errors.New("invalid error")}),
@@ -81,15 +120,33 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("PluginNotFoundError", PluginNotFoundError{}),
 		Entry("PluginNotFoundInRepositoryError", PluginNotFoundInRepositoryError{}),
 		Entry("PluginNotFoundOnDiskOrInAnyRepositoryError", PluginNotFoundOnDiskOrInAnyRepositoryError{}),
+		Entry("PropertyCombinationError", PropertyCombinationError{}),
+		Entry("ReadyPackageNotFoundError", ReadyPackageNotFoundError{}),
 		Entry("RepositoryNameTakenError", RepositoryNameTakenError{}),
 		Entry("RequiredArgumentError", RequiredArgumentError{}),
 		Entry("RequiredFlagsError", RequiredFlagsError{}),
 		Entry("RequiredNameForPushError", RequiredNameForPushError{}),
+		Entry("RevisionNotDeployableError", RevisionNotDeployableError{}),
+		Entry("RevisionNotFoundError", RevisionNotFoundError{}),
 		Entry("RouteInDifferentSpaceError", RouteInDifferentSpaceError{}),
+		Entry("RouteNotFoundError", RouteNotFoundError{}),
+		Entry("RouterGroupNotFoundError", RouterGroupNotFoundError{}),
+		Entry("RoutingAPIEndpointNotFoundError", RoutingAPIEndpointNotFoundError{}),
 		Entry("RunTaskError", RunTaskError{}),
 		Entry("SecurityGroupNotFoundError", SecurityGroupNotFoundError{}),
+		Entry("SecurityGroupRuleViolationsError", SecurityGroupRuleViolationsError{}),
+		Entry("ServiceBindingFailedError", ServiceBindingFailedError{}),
+		Entry("ServiceBindingTimeoutError", ServiceBindingTimeoutError{}),
 		Entry("ServiceInstanceNotFoundError", ServiceInstanceNotFoundError{}),
+		Entry("ServiceInstanceOperationFailedError", ServiceInstanceOperationFailedError{}),
+		Entry("ServiceInstanceOperationTimeoutError", ServiceInstanceOperationTimeoutError{}),
+		Entry("ServiceKeyNotFoundError", ServiceKeyNotFoundError{}),
+		Entry("ServiceNotFoundError", ServiceNotFoundError{}),
+		Entry("ServicePlanNotFoundError", ServicePlanNotFoundError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
+		Entry("SpaceQuotaNameTakenError", SpaceQuotaNameTakenError{}),
+		Entry("SpaceQuotaNotFoundError", SpaceQuotaNotFoundError{}),
+		Entry("SSHCommandFailedError", SSHCommandFailedError{}),
 		Entry("SSLCertError", SSLCertError{}),
 		Entry("StackNotFoundError with name", SpaceNotFoundError{Name: "steve"}),
 		Entry("StackNotFoundError without name", SpaceNotFoundError{}),
@@ -97,13 +154,29 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("StagingFailedNoAppDetectedError", StagingFailedNoAppDetectedError{}),
 		Entry("StagingTimeoutError", StagingTimeoutError{}),
 		Entry("StartupTimeoutError", StartupTimeoutError{}),
+		Entry("TCPRouteOptionsNotProvidedError", TCPRouteOptionsNotProvidedError{}),
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
 		Entry("UnsuccessfulStartError", UnsuccessfulStartError{}),
 		Entry("UnsupportedURLSchemeError", UnsupportedURLSchemeError{}),
 		Entry("UploadFailedError", UploadFailedError{Err: JobFailedError{}}),
+		Entry("UserNotFoundError", UserNotFoundError{}),
 		Entry("V3APIDoesNotExistError", V3APIDoesNotExistError{}),
 	)
 
+	Describe("ErrorCode", func() {
+		Context("when the error is a TranslatableError", func() {
+			It("returns the error's code", func() {
+				Expect(ErrorCode(OrganizationNotFoundError{Name: "some-org"})).To(Equal("OrganizationNotFound"))
+			})
+		})
+
+		Context("when the error is not a TranslatableError", func() {
+			It("returns UnknownErrorCode", func() {
+				Expect(ErrorCode(errors.New("some-error"))).To(Equal(UnknownErrorCode))
+			})
+		})
+	})
+
 	Describe("PluginInvalidError", func() {
 		Context("when the wrapped error is nil", func() {
 			It("does not concatenate the nil error in the returned Error()", func() {
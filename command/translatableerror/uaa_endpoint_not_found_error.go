@@ -10,3 +10,7 @@ func (UAAEndpointNotFoundError) Error() string {
 func (e UAAEndpointNotFoundError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error())
 }
+
+func (UAAEndpointNotFoundError) ErrorCode() string {
+	return "UAAEndpointNotFound"
+}
@@ -15,3 +15,7 @@ func (e UnsuccessfulStartError) Translate(translate func(string, ...interface{})
 		"BinaryName": e.BinaryName,
 	})
 }
+
+func (UnsuccessfulStartError) ErrorCode() string {
+	return "UnsuccessfulStart"
+}
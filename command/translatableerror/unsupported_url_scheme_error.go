@@ -13,3 +13,7 @@ func (e UnsupportedURLSchemeError) Translate(translate func(string, ...interface
 		"UnsupportedURL": e.UnsupportedURL,
 	})
 }
+
+func (UnsupportedURLSchemeError) ErrorCode() string {
+	return "UnsupportedURLScheme"
+}
@@ -20,3 +20,7 @@ func (e UploadFailedError) Translate(translate func(string, ...interface{}) stri
 		"Error": message,
 	})
 }
+
+func (UploadFailedError) ErrorCode() string {
+	return "UploadFailed"
+}
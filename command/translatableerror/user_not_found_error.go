@@ -0,0 +1,26 @@
+package translatableerror
+
+// UserNotFoundError is returned when no user matches the provided username
+// and origin.
+type UserNotFoundError struct {
+	Username string
+	Origin   string
+}
+
+func (e UserNotFoundError) Error() string {
+	if e.Origin != "" {
+		return "User '{{.Username}}' with origin '{{.Origin}}' does not exist."
+	}
+	return "User '{{.Username}}' does not exist."
+}
+
+func (e UserNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Username": e.Username,
+		"Origin":   e.Origin,
+	})
+}
+
+func (UserNotFoundError) ErrorCode() string {
+	return "UserNotFound"
+}
@@ -13,3 +13,7 @@ func (e V3APIDoesNotExistError) Translate(translate func(string, ...interface{})
 		"Message": e.Message,
 	})
 }
+
+func (V3APIDoesNotExistError) ErrorCode() string {
+	return "V3APIDoesNotExist"
+}
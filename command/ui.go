@@ -21,14 +21,21 @@ type UI interface {
 	DisplayNewline()
 	DisplayNonWrappingTable(prefix string, table [][]string, padding int)
 	DisplayOK()
+	DisplayPasswordPrompt(template string, templateValues ...map[string]interface{}) (string, error)
+	DisplayProgressEvent(event ui.ProgressEvent)
 	DisplayTableWithHeader(prefix string, table [][]string, padding int)
 	DisplayText(template string, data ...map[string]interface{})
 	DisplayTextWithFlavor(text string, keys ...map[string]interface{})
 	DisplayTextWithBold(text string, keys ...map[string]interface{})
 	DisplayWarning(formattedString string, keys ...map[string]interface{})
 	DisplayWarnings(warnings []string)
+	FormatBytes(bytes uint64) string
+	FormatDuration(duration time.Duration) string
+	FormatMegabytes(megabytes uint64) string
+	NewProgressBar() *ui.ProgressBar
 	RequestLoggerFileWriter(filePaths []string) *ui.RequestLoggerFileWriter
 	RequestLoggerTerminalDisplay() *ui.RequestLoggerTerminalDisplay
+	TranslateError(err error) string
 	TranslateText(template string, data ...map[string]interface{}) string
 	UserFriendlyDate(input time.Time) string
 	Writer() io.Writer
@@ -2,11 +2,13 @@ package v2
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
@@ -18,11 +20,12 @@ type APIActor interface {
 }
 
 type ApiCommand struct {
-	OptionalArgs      flag.APITarget `positional-args:"yes"`
-	SkipSSLValidation bool           `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
-	Unset             bool           `long:"unset" description:"Remove all api endpoint targeting"`
-	usage             interface{}    `usage:"CF_NAME api [URL]"`
-	relatedCommands   interface{}    `related_commands:"auth, login, target"`
+	OptionalArgs      flag.APITarget              `positional-args:"yes"`
+	CACert            flag.PathWithExistenceCheck `long:"ca-cert" description:"Path to a PEM-encoded CA certificate bundle to trust for this API endpoint, in addition to the system trust store"`
+	SkipSSLValidation bool                        `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
+	Unset             bool                        `long:"unset" description:"Remove all api endpoint targeting"`
+	usage             interface{}                 `usage:"CF_NAME api [URL] [--skip-ssl-validation | --ca-cert PATH]"`
+	relatedCommands   interface{}                 `related_commands:"auth, login, target"`
 
 	UI     command.UI
 	Actor  APIActor
@@ -60,10 +63,18 @@ func (cmd *ApiCommand) Execute(args []string) error {
 		return nil
 	}
 
-	cmd.UI.DisplayKeyValueTable("", [][]string{
+	table := [][]string{
 		{cmd.UI.TranslateText("api endpoint:"), cmd.Config.Target()},
 		{cmd.UI.TranslateText("api version:"), cmd.Config.APIVersion()},
-	}, 3)
+	}
+	if cmd.Config.CACertFile() != "" {
+		table = append(table, []string{cmd.UI.TranslateText("ca certificate:"), cmd.Config.CACertFile()})
+	}
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+
+	if cmd.Config.SkipSSLValidation() {
+		cmd.UI.DisplayText("Warning: SSL certificate validation is disabled for this API endpoint.")
+	}
 
 	user, err := cmd.Config.CurrentUser()
 	if user.Name == "" {
@@ -82,6 +93,21 @@ func (cmd *ApiCommand) ClearTarget() error {
 }
 
 func (cmd *ApiCommand) setAPI() error {
+	if cmd.SkipSSLValidation && cmd.CACert != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--skip-ssl-validation", "--ca-cert"},
+		}
+	}
+
+	var caCertFile string
+	if cmd.CACert != "" {
+		var err error
+		caCertFile, err = filepath.Abs(string(cmd.CACert))
+		if err != nil {
+			return err
+		}
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Setting api endpoint to {{.Endpoint}}...", map[string]interface{}{
 		"Endpoint": cmd.OptionalArgs.URL,
 	})
@@ -89,9 +115,12 @@ func (cmd *ApiCommand) setAPI() error {
 	apiURL := processURL(cmd.OptionalArgs.URL)
 
 	_, err := cmd.Actor.SetTarget(cmd.Config, v2action.TargetSettings{
-		URL:               apiURL,
-		SkipSSLValidation: cmd.SkipSSLValidation,
-		DialTimeout:       cmd.Config.DialTimeout(),
+		URL:                 apiURL,
+		SkipSSLValidation:   cmd.SkipSSLValidation,
+		CACertFile:          caCertFile,
+		DialTimeout:         cmd.Config.DialTimeout(),
+		MaxIdleConnsPerHost: cmd.Config.MaxIdleConnsPerHost(),
+		TLSHandshakeTimeout: cmd.Config.TLSHandshakeTimeout(),
 	})
 	if err != nil {
 		return shared.HandleError(err)
@@ -2,6 +2,8 @@ package v2_test
 
 import (
 	"errors"
+	"path/filepath"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
@@ -69,6 +71,30 @@ var _ = Describe("api Command", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(testUI.Out).To(Say("api endpoint:\\s+some-api-target"))
 				Expect(testUI.Out).To(Say("api version:\\s+some-version"))
+				Expect(testUI.Out).ToNot(Say("ca certificate:"))
+				Expect(testUI.Out).ToNot(Say("SSL certificate validation is disabled"))
+			})
+
+			Context("when the target has a CA certificate file", func() {
+				BeforeEach(func() {
+					fakeConfig.CACertFileReturns("/some/ca.pem")
+				})
+
+				It("outputs the CA certificate file", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("ca certificate:\\s+/some/ca.pem"))
+				})
+			})
+
+			Context("when the target skips SSL validation", func() {
+				BeforeEach(func() {
+					fakeConfig.SkipSSLValidationReturns(true)
+				})
+
+				It("outputs a warning", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Warning: SSL certificate validation is disabled for this API endpoint\\."))
+				})
 			})
 		})
 
@@ -99,6 +125,9 @@ var _ = Describe("api Command", func() {
 
 					fakeConfig.TargetReturns("some-api-target")
 					fakeConfig.APIVersionReturns("some-version")
+					fakeConfig.DialTimeoutReturns(5 * time.Second)
+					fakeConfig.MaxIdleConnsPerHostReturns(20)
+					fakeConfig.TLSHandshakeTimeoutReturns(15 * time.Second)
 				})
 
 				Context("when the url has verified SSL", func() {
@@ -109,6 +138,9 @@ var _ = Describe("api Command", func() {
 						_, settings := fakeActor.SetTargetArgsForCall(0)
 						Expect(settings.URL).To(Equal("https://" + CCAPI))
 						Expect(settings.SkipSSLValidation).To(BeFalse())
+						Expect(settings.DialTimeout).To(Equal(5 * time.Second))
+						Expect(settings.MaxIdleConnsPerHost).To(Equal(20))
+						Expect(settings.TLSHandshakeTimeout).To(Equal(15 * time.Second))
 
 						Expect(testUI.Out).To(Say("Setting api endpoint to %s...", CCAPI))
 						Expect(testUI.Out).To(Say(`OK
@@ -142,6 +174,37 @@ api version:    some-version`,
 						})
 					})
 
+					Context("when --ca-cert is passed", func() {
+						BeforeEach(func() {
+							cmd.CACert = "some-dir/ca.pem"
+						})
+
+						It("sets the target with the absolute path of the CA certificate file", func() {
+							Expect(err).ToNot(HaveOccurred())
+
+							expectedPath, absErr := filepath.Abs("some-dir/ca.pem")
+							Expect(absErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+							_, settings := fakeActor.SetTargetArgsForCall(0)
+							Expect(settings.SkipSSLValidation).To(BeFalse())
+							Expect(settings.CACertFile).To(Equal(expectedPath))
+						})
+
+						Context("when --skip-ssl-validation is also passed", func() {
+							BeforeEach(func() {
+								cmd.SkipSSLValidation = true
+							})
+
+							It("returns an ArgumentCombinationError", func() {
+								Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+									Args: []string{"--skip-ssl-validation", "--ca-cert"},
+								}))
+								Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+							})
+						})
+					})
+
 					Context("when no additional flags are passed", func() {
 						BeforeEach(func() {
 							fakeActor.SetTargetReturns(nil, ccerror.UnverifiedServerError{URL: CCAPI})
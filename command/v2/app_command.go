@@ -5,7 +5,9 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/presenter"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 //go:generate counterfeiter . AppActor
@@ -66,6 +68,10 @@ func (cmd AppCommand) displayAppGUID() error {
 }
 
 func (cmd AppCommand) displayAppSummary() error {
+	if cmd.Config.OutputFormat() == configv3.OutputFormatJSON {
+		return cmd.displayAppSummaryJSON()
+	}
+
 	user, err := cmd.Config.CurrentUser()
 	if err != nil {
 		return shared.HandleError(err)
@@ -91,3 +97,13 @@ func (cmd AppCommand) displayAppSummary() error {
 
 	return nil
 }
+
+func (cmd AppCommand) displayAppSummaryJSON() error {
+	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	return presenter.Display(cmd.UI, presenter.NewAppDocument(appSummary))
+}
@@ -357,6 +357,36 @@ var _ = Describe("App Command", func() {
 					})
 				})
 			})
+
+			Context("when the output format is JSON", func() {
+				BeforeEach(func() {
+					fakeConfig.OutputFormatReturns("json")
+					fakeActor.GetApplicationSummaryByNameAndSpaceReturns(
+						v2action.ApplicationSummary{
+							Application: v2action.Application{
+								GUID:      "some-app-guid",
+								Name:      "some-app",
+								State:     ccv2.ApplicationStarted,
+								Instances: types.NullInt{IsSet: true, Value: 1},
+							},
+						},
+						v2action.Warnings{"warning-1"},
+						nil)
+				})
+
+				It("displays only the app document on stdout and warnings on stderr", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Showing health and status"))
+					Expect(testUI.Out).To(Say(`"kind": "app"`))
+					Expect(testUI.Out).To(Say(`"schema_version": 1`))
+					Expect(testUI.Out).To(Say(`"guid": "some-app-guid"`))
+					Expect(testUI.Out).To(Say(`"requested_state": "started"`))
+					Expect(testUI.Err).To(Say("warning-1"))
+
+					Expect(fakeConfig.CurrentUserCallCount()).To(Equal(0))
+				})
+			})
 		})
 	})
 })
@@ -0,0 +1,103 @@
+package v2
+
+import (
+	"encoding/json"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . AppEnvPreviewActor
+
+type AppEnvPreviewActor interface {
+	GetApplicationEnvironmentPreview(appName string, spaceGUID string, bind []string, unbind []string) (v2action.ApplicationEnvironmentPreview, v2action.Warnings, error)
+}
+
+type AppEnvPreviewCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Bind            []string     `long:"bind" description:"Service instance to preview binding to the app; can be repeated"`
+	Unbind          []string     `long:"unbind" description:"Service instance to preview unbinding from the app; can be repeated"`
+	usage           interface{}  `usage:"CF_NAME app-env-preview APP_NAME [--bind SERVICE_INSTANCE]... [--unbind SERVICE_INSTANCE]...\n\nEXAMPLES:\n   CF_NAME app-env-preview my-app --bind new-db --unbind old-db"`
+	relatedCommands interface{}  `related_commands:"bind-service, env, restage, unbind-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AppEnvPreviewActor
+}
+
+func (cmd *AppEnvPreviewCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd AppEnvPreviewCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting env preview for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	preview, warnings, err := cmd.Actor.GetApplicationEnvironmentPreview(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.Bind, cmd.Unbind)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	err = cmd.displaySection("Current VCAP_SERVICES:", preview.CurrentVCAPServices)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.displaySection("Planned VCAP_SERVICES:", preview.PlannedVCAPServices)
+	if err != nil {
+		return err
+	}
+
+	if len(preview.PendingCredentials) > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Credentials for {{.ServiceInstances}} are created by the service broker on bind and are not shown.", map[string]interface{}{
+			"ServiceInstances": strings.Join(preview.PendingCredentials, ", "),
+		})
+	}
+
+	return cmd.displaySection("VCAP_APPLICATION:", preview.VCAPApplication)
+}
+
+func (cmd AppEnvPreviewCommand) displaySection(header string, value interface{}) error {
+	valueJSON, err := json.MarshalIndent(value, "", " ")
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayHeader(header)
+	cmd.UI.DisplayText(string(valueJSON))
+	return nil
+}
@@ -0,0 +1,149 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("app-env-preview Command", func() {
+	var (
+		cmd             AppEnvPreviewCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAppEnvPreviewActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAppEnvPreviewActor)
+
+		cmd = AppEnvPreviewCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.Bind = []string{"new-db"}
+		cmd.Unbind = []string{"old-db"}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error if the check fails", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when getting the current user returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("getting current user error")
+				fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when a service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationEnvironmentPreviewReturns(
+					v2action.ApplicationEnvironmentPreview{},
+					v2action.Warnings{"preview-warning"},
+					v2action.ServiceInstanceNotFoundError{Name: "new-db"})
+			})
+
+			It("returns a ServiceInstanceNotFoundError and displays warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceNotFoundError{Name: "new-db"}))
+				Expect(testUI.Err).To(Say("preview-warning"))
+			})
+		})
+
+		Context("when the preview is computed", func() {
+			BeforeEach(func() {
+				bindingName := "primary"
+				fakeActor.GetApplicationEnvironmentPreviewReturns(
+					v2action.ApplicationEnvironmentPreview{
+						CurrentVCAPServices: v2action.VCAPServices{
+							"p-mysql": {{Name: "primary", InstanceName: "old-db", BindingName: &bindingName, Label: "p-mysql", Plan: "small"}},
+						},
+						PlannedVCAPServices: v2action.VCAPServices{
+							"p-mysql": {{Name: "new-db", InstanceName: "new-db", Label: "p-mysql", Plan: "small"}},
+						},
+						VCAPApplication: v2action.VCAPApplication{
+							ApplicationID:   "some-app-guid",
+							ApplicationName: "some-app",
+							SpaceID:         "some-space-guid",
+						},
+						PendingCredentials: []string{"new-db"},
+					},
+					v2action.Warnings{"preview-warning"},
+					nil)
+			})
+
+			It("displays the current and planned environment", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Getting env preview for app some-app in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Current VCAP_SERVICES:"))
+				Expect(testUI.Out).To(Say(`"instance_name": "old-db"`))
+				Expect(testUI.Out).To(Say("Planned VCAP_SERVICES:"))
+				Expect(testUI.Out).To(Say(`"instance_name": "new-db"`))
+				Expect(testUI.Out).To(Say("Credentials for new-db are created by the service broker on bind and are not shown."))
+				Expect(testUI.Out).To(Say("VCAP_APPLICATION:"))
+				Expect(testUI.Out).To(Say(`"application_id": "some-app-guid"`))
+				Expect(testUI.Err).To(Say("preview-warning"))
+
+				Expect(fakeActor.GetApplicationEnvironmentPreviewCallCount()).To(Equal(1))
+				appName, spaceGUID, bind, unbind := fakeActor.GetApplicationEnvironmentPreviewArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(bind).To(Equal([]string{"new-db"}))
+				Expect(unbind).To(Equal([]string{"old-db"}))
+			})
+		})
+	})
+})
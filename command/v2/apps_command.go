@@ -1,22 +1,150 @@
 package v2
 
 import (
-	"os"
+	"fmt"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/presenter"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
+//go:generate counterfeiter . AppsActor
+
+type AppsActor interface {
+	GetApplicationsWithInstancesAndRoutesBySpacePaged(spaceGUID string, handlePage func([]v2action.ApplicationWithInstancesAndRoutes) error, filters ...v2action.ApplicationFilter) (v2action.Warnings, error)
+}
+
 type AppsCommand struct {
-	usage           interface{} `usage:"CF_NAME apps"`
-	relatedCommands interface{} `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
+	Filters         []flag.AppFilter `long:"filter" description:"Only list apps matching the filter: 'name=APP_NAME[,APP_NAME...]' or 'state=(started | stopped)' (can be specified multiple times)"`
+	usage           interface{}      `usage:"CF_NAME apps [--filter FILTER]...\n\nEXAMPLES:\n   CF_NAME apps --filter name=my-app,my-other-app --filter state=started"`
+	relatedCommands interface{}      `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AppsActor
 }
 
-func (AppsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *AppsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (AppsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd AppsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Config.OutputFormat() == configv3.OutputFormatJSON {
+		return cmd.displayAppsJSON()
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	table := shared.NewTableStream(cmd.UI, []string{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("requested state"),
+		cmd.UI.TranslateText("instances"),
+		cmd.UI.TranslateText("memory"),
+		cmd.UI.TranslateText("disk"),
+		cmd.UI.TranslateText("urls"),
+	}, 3)
+
+	var appsFound bool
+	warnings, err := cmd.Actor.GetApplicationsWithInstancesAndRoutesBySpacePaged(cmd.Config.TargetedSpace().GUID, func(apps []v2action.ApplicationWithInstancesAndRoutes) error {
+		if len(apps) == 0 {
+			return nil
+		}
+
+		if !appsFound {
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayNewline()
+			appsFound = true
+		}
+
+		rows := make([][]string, 0, len(apps))
+		for _, app := range apps {
+			rows = append(rows, cmd.appRow(app))
+		}
+		table.Display(rows)
+		return nil
+	}, cmd.applicationFilters()...)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !appsFound {
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("No apps found")
+	}
+
 	return nil
 }
+
+func (cmd AppsCommand) displayAppsJSON() error {
+	var apps []v2action.ApplicationWithInstancesAndRoutes
+	warnings, err := cmd.Actor.GetApplicationsWithInstancesAndRoutesBySpacePaged(cmd.Config.TargetedSpace().GUID, func(page []v2action.ApplicationWithInstancesAndRoutes) error {
+		apps = append(apps, page...)
+		return nil
+	}, cmd.applicationFilters()...)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	return presenter.Display(cmd.UI, presenter.NewAppsDocument(apps))
+}
+
+func (cmd AppsCommand) applicationFilters() []v2action.ApplicationFilter {
+	filters := make([]v2action.ApplicationFilter, 0, len(cmd.Filters))
+	for _, filter := range cmd.Filters {
+		filters = append(filters, v2action.ApplicationFilter{
+			Names: filter.Names,
+			State: ccv2.ApplicationState(filter.State),
+		})
+	}
+	return filters
+}
+
+func (cmd AppsCommand) appRow(app v2action.ApplicationWithInstancesAndRoutes) []string {
+	urls := make([]string, 0, len(app.Routes))
+	for _, route := range app.Routes {
+		urls = append(urls, route.String())
+	}
+
+	return []string{
+		app.Name,
+		cmd.UI.TranslateText(strings.ToLower(string(app.State))),
+		fmt.Sprintf("%d/%d", app.RunningInstances, app.Instances.Value),
+		cmd.UI.FormatMegabytes(app.Memory),
+		cmd.UI.FormatMegabytes(app.DiskQuota),
+		strings.Join(urls, ", "),
+	}
+}
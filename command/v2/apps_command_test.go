@@ -0,0 +1,213 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apps Command", func() {
+	var (
+		cmd             AppsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAppsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAppsActor)
+
+		cmd = AppsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when getting the current user returns an error", func() {
+			BeforeEach(func() {
+				fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("current-user-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("current-user-error"))
+			})
+		})
+
+		Context("when there are no apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationsWithInstancesAndRoutesBySpacePagedStub = func(_ string, handlePage func([]v2action.ApplicationWithInstancesAndRoutes) error, _ ...v2action.ApplicationFilter) (v2action.Warnings, error) {
+					Expect(handlePage(nil)).To(Succeed())
+					return v2action.Warnings{"get-apps-warning"}, nil
+				}
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Getting apps in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("No apps found"))
+				Expect(testUI.Out).ToNot(Say("requested state"))
+				Expect(testUI.Err).To(Say("get-apps-warning"))
+			})
+		})
+
+		Context("when there are apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationsWithInstancesAndRoutesBySpacePagedStub = func(spaceGUID string, handlePage func([]v2action.ApplicationWithInstancesAndRoutes) error, _ ...v2action.ApplicationFilter) (v2action.Warnings, error) {
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+
+					Expect(handlePage([]v2action.ApplicationWithInstancesAndRoutes{
+						{
+							Application: v2action.Application{
+								Name:      "app-1",
+								State:     ccv2.ApplicationStarted,
+								Instances: types.NullInt{Value: 2, IsSet: true},
+								Memory:    256,
+								DiskQuota: 1024,
+							},
+							RunningInstances: 1,
+							Routes: v2action.Routes{
+								{Host: "app-1", Domain: v2action.Domain{Name: "example.com"}},
+								{Host: "www", Domain: v2action.Domain{Name: "example.com"}, Path: "/app-1"},
+							},
+						},
+					})).To(Succeed())
+					Expect(testUI.Out).To(Say(`name\s+requested state\s+instances\s+memory\s+disk\s+urls`))
+					Expect(testUI.Out).To(Say(`app-1\s+started\s+1/2\s+256M\s+1G\s+app-1.example.com, www.example.com/app-1`))
+
+					Expect(handlePage([]v2action.ApplicationWithInstancesAndRoutes{
+						{
+							Application: v2action.Application{
+								Name:      "app-2",
+								State:     ccv2.ApplicationStopped,
+								Instances: types.NullInt{Value: 1, IsSet: true},
+								Memory:    1024,
+								DiskQuota: 1024,
+							},
+						},
+					})).To(Succeed())
+					return v2action.Warnings{"get-apps-warning"}, nil
+				}
+			})
+
+			It("displays each page of apps as it is retrieved", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`app-2\s+stopped\s+0/1\s+1G\s+1G\s*\n`))
+				Expect(testUI.Out).ToNot(Say("requested state"))
+				Expect(testUI.Err).To(Say("get-apps-warning"))
+				Expect(fakeActor.GetApplicationsWithInstancesAndRoutesBySpacePagedCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when filters are provided", func() {
+			BeforeEach(func() {
+				cmd.Filters = []flag.AppFilter{
+					{Names: []string{"app-1", "app-2"}},
+					{State: "STARTED"},
+				}
+			})
+
+			It("passes them to the actor", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetApplicationsWithInstancesAndRoutesBySpacePagedCallCount()).To(Equal(1))
+				_, _, filters := fakeActor.GetApplicationsWithInstancesAndRoutesBySpacePagedArgsForCall(0)
+				Expect(filters).To(Equal([]v2action.ApplicationFilter{
+					{Names: []string{"app-1", "app-2"}},
+					{State: ccv2.ApplicationStarted},
+				}))
+			})
+		})
+
+		Context("when the output format is JSON", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns("json")
+				fakeActor.GetApplicationsWithInstancesAndRoutesBySpacePagedStub = func(_ string, handlePage func([]v2action.ApplicationWithInstancesAndRoutes) error, _ ...v2action.ApplicationFilter) (v2action.Warnings, error) {
+					Expect(handlePage([]v2action.ApplicationWithInstancesAndRoutes{
+						{Application: v2action.Application{GUID: "app-guid-1", Name: "app-1", State: ccv2.ApplicationStarted}},
+					})).To(Succeed())
+					Expect(handlePage([]v2action.ApplicationWithInstancesAndRoutes{
+						{Application: v2action.Application{GUID: "app-guid-2", Name: "app-2", State: ccv2.ApplicationStopped}},
+					})).To(Succeed())
+					return v2action.Warnings{"get-apps-warning"}, nil
+				}
+			})
+
+			It("displays one document with the apps from every page", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting apps"))
+				Expect(testUI.Out).To(Say(`"kind": "apps"`))
+				Expect(testUI.Out).To(Say(`"schema_version": 1`))
+				Expect(testUI.Out).To(Say(`"guid": "app-guid-1"`))
+				Expect(testUI.Out).To(Say(`"guid": "app-guid-2"`))
+				Expect(testUI.Err).To(Say("get-apps-warning"))
+
+				Expect(fakeConfig.CurrentUserCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationsWithInstancesAndRoutesBySpacePagedReturns(v2action.Warnings{"get-apps-warning"}, errors.New("get-apps-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("get-apps-error"))
+				Expect(testUI.Err).To(Say("get-apps-warning"))
+			})
+		})
+	})
+})
@@ -4,21 +4,25 @@ import (
 	"fmt"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
 //go:generate counterfeiter . AuthActor
 
 type AuthActor interface {
-	Authenticate(config v2action.Config, username string, password string) error
+	Authenticate(config v2action.Config, ID string, secret string, origin string, grantType uaa.GrantType) error
 }
 
 type AuthCommand struct {
-	RequiredArgs    flag.Authentication `positional-args:"yes"`
-	usage           interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
-	relatedCommands interface{}         `related_commands:"api, login, target"`
+	RequiredArgs      flag.Authentication `positional-args:"yes"`
+	ClientCredentials bool                `long:"client-credentials" description:"Use (non-user) service account (also called client credentials)"`
+	Origin            string              `long:"origin" description:"Indicates the identity provider to be used for authentication"`
+	usage             interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth USERNAME PASSWORD --origin ORIGIN\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME auth name@example.com \"my password\" --origin ldap (authenticate against the ldap identity provider)\n   CF_NAME auth my-client my-client-secret --client-credentials (authenticate as a service account)"`
+	relatedCommands   interface{}         `related_commands:"api, login, target"`
 
 	UI     command.UI
 	Config command.Config
@@ -39,6 +43,12 @@ func (cmd *AuthCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd AuthCommand) Execute(args []string) error {
+	if cmd.ClientCredentials && cmd.Origin != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--client-credentials", "--origin"},
+		}
+	}
+
 	err := command.WarnAPIVersionCheck(cmd.Config, cmd.UI)
 	if err != nil {
 		return err
@@ -51,7 +61,12 @@ func (cmd AuthCommand) Execute(args []string) error {
 		})
 	cmd.UI.DisplayText("Authenticating...")
 
-	err = cmd.Actor.Authenticate(cmd.Config, cmd.RequiredArgs.Username, cmd.RequiredArgs.Password)
+	grantType := uaa.GrantTypePassword
+	if cmd.ClientCredentials {
+		grantType = uaa.GrantTypeClientCredentials
+	}
+
+	err = cmd.Actor.Authenticate(cmd.Config, cmd.RequiredArgs.Username, cmd.RequiredArgs.Password, cmd.Origin, grantType)
 	if err != nil {
 		return shared.HandleError(err)
 	}
@@ -3,6 +3,7 @@ package v2_test
 import (
 	"errors"
 
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -69,10 +70,82 @@ var _ = Describe("auth Command", func() {
 			Expect(testUI.Out).To(Say("Use '%s target' to view or set your target org and space", binaryName))
 
 			Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
-			config, username, password := fakeActor.AuthenticateArgsForCall(0)
+			config, username, password, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
 			Expect(config).To(Equal(fakeConfig))
 			Expect(username).To(Equal(testUsername))
 			Expect(password).To(Equal(testPassword))
+			Expect(origin).To(BeEmpty())
+			Expect(grantType).To(Equal(uaa.GrantTypePassword))
+		})
+
+		Context("when --origin is provided", func() {
+			BeforeEach(func() {
+				cmd.Origin = "ldap"
+			})
+
+			It("authenticates against the origin", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+				_, _, _, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
+				Expect(origin).To(Equal("ldap"))
+				Expect(grantType).To(Equal(uaa.GrantTypePassword))
+			})
+		})
+
+		Context("when --client-credentials is provided", func() {
+			BeforeEach(func() {
+				cmd.ClientCredentials = true
+			})
+
+			It("authenticates with the client credentials grant", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+				_, clientID, clientSecret, _, grantType := fakeActor.AuthenticateArgsForCall(0)
+				Expect(clientID).To(Equal(testUsername))
+				Expect(clientSecret).To(Equal(testPassword))
+				Expect(grantType).To(Equal(uaa.GrantTypeClientCredentials))
+			})
+		})
+	})
+
+	Context("when --origin and --client-credentials are both provided", func() {
+		BeforeEach(func() {
+			cmd.Origin = "ldap"
+			cmd.ClientCredentials = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--client-credentials", "--origin"},
+			}))
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the origin does not exist", func() {
+		BeforeEach(func() {
+			cmd.Origin = "some-missing-origin"
+			fakeActor.AuthenticateReturns(uaa.InvalidOriginError{})
+		})
+
+		It("returns an InvalidOriginError", func() {
+			Expect(err).To(MatchError(translatableerror.InvalidOriginError{}))
+		})
+	})
+
+	Context("when a service account is logged in and a user tries to authenticate", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Username = "foo"
+			cmd.RequiredArgs.Password = "bar"
+
+			fakeActor.AuthenticateReturns(v2action.PasswordGrantTypeLogoutRequiredError{})
+		})
+
+		It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+			Expect(err).To(MatchError(translatableerror.PasswordGrantTypeLogoutRequiredError{}))
 		})
 	})
 
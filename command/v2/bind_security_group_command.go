@@ -8,24 +8,25 @@ import (
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/version"
 )
 
 //go:generate counterfeiter . BindSecurityGroupActor
 
 type BindSecurityGroupActor interface {
-	BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string, lifecycle ccv2.SecurityGroupLifecycle) (v2action.Warnings, error)
+	BindSecurityGroupToAllSpacesInOrg(securityGroupGUID string, orgGUID string, lifecycles []ccv2.SecurityGroupLifecycle, spaceBound func(v2action.Space)) (v2action.Warnings, error)
+	BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string, lifecycles []ccv2.SecurityGroupLifecycle) (v2action.Warnings, error)
 	CloudControllerAPIVersion() string
 	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
-	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
 	GetSecurityGroupByName(securityGroupName string) (v2action.SecurityGroup, v2action.Warnings, error)
 	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
 }
 
 type BindSecurityGroupCommand struct {
 	RequiredArgs    flag.BindSecurityGroupArgs  `positional-args:"yes"`
-	Lifecycle       flag.SecurityGroupLifecycle `long:"lifecycle" choice:"running" choice:"staging" default:"running" description:"Lifecycle phase the group applies to"`
-	usage           interface{}                 `usage:"CF_NAME bind-security-group SECURITY_GROUP ORG [SPACE] [--lifecycle (running | staging)]\n\nTIP: Changes require an app restart (for running) or restage (for staging) to apply to existing applications."`
+	Lifecycle       flag.SecurityGroupLifecycle `long:"lifecycle" choice:"running" choice:"staging" choice:"both" default:"running" description:"Lifecycle phase the group applies to"`
+	usage           interface{}                 `usage:"CF_NAME bind-security-group SECURITY_GROUP ORG [SPACE] [--lifecycle (running | staging | both)]\n\nTIP: Changes require an app restart (for running) or restage (for staging) to apply to existing applications."`
 	relatedCommands interface{}                 `related_commands:"apps, bind-running-security-group, bind-staging-security-group, restart, security-groups"`
 
 	UI          command.UI
@@ -50,7 +51,7 @@ func (cmd *BindSecurityGroupCommand) Setup(config command.Config, ui command.UI)
 
 func (cmd BindSecurityGroupCommand) Execute(args []string) error {
 	var err error
-	if ccv2.SecurityGroupLifecycle(cmd.Lifecycle) == ccv2.SecurityGroupLifecycleStaging {
+	if ccv2.SecurityGroupLifecycle(cmd.Lifecycle) == ccv2.SecurityGroupLifecycleStaging || cmd.Lifecycle == flag.SecurityGroupLifecycleBoth {
 		err = version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionLifecyleStagingV2)
 		if err != nil {
 			switch e := err.(type) {
@@ -87,34 +88,26 @@ func (cmd BindSecurityGroupCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
-	spacesToBind := []v2action.Space{}
-	if cmd.RequiredArgs.SpaceName != "" {
-		var space v2action.Space
-		space, warnings, err = cmd.Actor.GetSpaceByOrganizationAndName(org.GUID, cmd.RequiredArgs.SpaceName)
+	if cmd.RequiredArgs.SpaceName == "" {
+		warnings, err = cmd.Actor.BindSecurityGroupToAllSpacesInOrg(securityGroup.GUID, org.GUID, shared.SecurityGroupLifecycles(cmd.Lifecycle), func(space v2action.Space) {
+			cmd.displayAssigning(securityGroup, space, org, user)
+			cmd.UI.DisplayOK()
+		})
 		cmd.UI.DisplayWarnings(warnings)
 		if err != nil {
 			return shared.HandleError(err)
 		}
-		spacesToBind = append(spacesToBind, space)
 	} else {
-		var spaces []v2action.Space
-		spaces, warnings, err = cmd.Actor.GetOrganizationSpaces(org.GUID)
+		var space v2action.Space
+		space, warnings, err = cmd.Actor.GetSpaceByOrganizationAndName(org.GUID, cmd.RequiredArgs.SpaceName)
 		cmd.UI.DisplayWarnings(warnings)
 		if err != nil {
 			return shared.HandleError(err)
 		}
-		spacesToBind = append(spacesToBind, spaces...)
-	}
 
-	for _, space := range spacesToBind {
-		cmd.UI.DisplayTextWithFlavor("Assigning security group {{.security_group}} to space {{.space}} in org {{.organization}} as {{.username}}...", map[string]interface{}{
-			"security_group": securityGroup.Name,
-			"space":          space.Name,
-			"organization":   org.Name,
-			"username":       user.Name,
-		})
+		cmd.displayAssigning(securityGroup, space, org, user)
 
-		warnings, err = cmd.Actor.BindSecurityGroupToSpace(securityGroup.GUID, space.GUID, ccv2.SecurityGroupLifecycle(cmd.Lifecycle))
+		warnings, err = cmd.Actor.BindSecurityGroupToSpace(securityGroup.GUID, space.GUID, shared.SecurityGroupLifecycles(cmd.Lifecycle))
 		cmd.UI.DisplayWarnings(warnings)
 		if err != nil {
 			return shared.HandleError(err)
@@ -127,3 +120,12 @@ func (cmd BindSecurityGroupCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd BindSecurityGroupCommand) displayAssigning(securityGroup v2action.SecurityGroup, space v2action.Space, org v2action.Organization, user configv3.User) {
+	cmd.UI.DisplayTextWithFlavor("Assigning security group {{.security_group}} to space {{.space}} in org {{.organization}} as {{.username}}...", map[string]interface{}{
+		"security_group": securityGroup.Name,
+		"space":          space.Name,
+		"organization":   org.Name,
+		"username":       user.Name,
+	})
+}
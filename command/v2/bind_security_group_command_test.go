@@ -231,10 +231,10 @@ var _ = Describe("bind-security-group Command", func() {
 						Expect(spaceName).To(Equal("some-space"))
 
 						Expect(fakeActor.BindSecurityGroupToSpaceCallCount()).To(Equal(1))
-						securityGroupGUID, spaceGUID, lifecycle := fakeActor.BindSecurityGroupToSpaceArgsForCall(0)
+						securityGroupGUID, spaceGUID, lifecycles := fakeActor.BindSecurityGroupToSpaceArgsForCall(0)
 						Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
 						Expect(spaceGUID).To(Equal("some-space-guid"))
-						Expect(lifecycle).To(Equal(ccv2.SecurityGroupLifecycleRunning))
+						Expect(lifecycles).To(Equal([]ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleRunning}))
 					})
 				})
 
@@ -282,127 +282,71 @@ var _ = Describe("bind-security-group Command", func() {
 		})
 
 		Context("when a space is not provided", func() {
-			Context("when there are no spaces in the org", func() {
+			Context("when no errors are encountered binding the security group to the org's spaces", func() {
 				BeforeEach(func() {
-					fakeActor.GetOrganizationSpacesReturns(
-						[]v2action.Space{},
-						v2action.Warnings{"get org spaces warning"},
-						nil)
+					fakeActor.BindSecurityGroupToAllSpacesInOrgStub = func(_ string, _ string, _ []ccv2.SecurityGroupLifecycle, spaceBound func(v2action.Space)) (v2action.Warnings, error) {
+						spaceBound(v2action.Space{Name: "some-space-1"})
+						spaceBound(v2action.Space{Name: "some-space-2"})
+						return v2action.Warnings{"bind security group to org spaces warning"}, nil
+					}
 				})
 
-				It("does not perform any bindings and displays all warnings", func() {
+				It("binds the security group to all spaces in the org and displays all warnings", func() {
 					Expect(executeErr).NotTo(HaveOccurred())
 
-					Expect(testUI.Out).NotTo(Say("Assigning security group"))
-					Expect(testUI.Out).NotTo(Say("OK"))
+					Expect(testUI.Out).To(Say("Assigning security group some-security-group to space some-space-1 in org some-org as some-user\\.\\.\\."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("Assigning security group some-security-group to space some-space-2 in org some-org as some-user\\.\\.\\."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("TIP: Changes require an app restart \\(for running\\) or restage \\(for staging\\) to apply to existing applications\\."))
 
 					Expect(testUI.Err).To(Say("get security group warning"))
 					Expect(testUI.Err).To(Say("get org warning"))
-					Expect(testUI.Err).To(Say("get org spaces warning"))
+					Expect(testUI.Err).To(Say("bind security group to org spaces warning"))
+
+					Expect(fakeActor.GetSpaceByOrganizationAndNameCallCount()).To(Equal(0))
+					Expect(fakeActor.BindSecurityGroupToSpaceCallCount()).To(Equal(0))
+
+					Expect(fakeActor.BindSecurityGroupToAllSpacesInOrgCallCount()).To(Equal(1))
+					securityGroupGUID, orgGUID, lifecycles, _ := fakeActor.BindSecurityGroupToAllSpacesInOrgArgsForCall(0)
+					Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(lifecycles).To(Equal([]ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleRunning}))
 				})
 			})
 
-			Context("when there are spaces in the org", func() {
+			Context("when the org has no spaces", func() {
 				BeforeEach(func() {
-					fakeActor.GetOrganizationSpacesReturns(
-						[]v2action.Space{
-							{
-								GUID: "some-space-guid-1",
-								Name: "some-space-1",
-							},
-							{
-								GUID: "some-space-guid-2",
-								Name: "some-space-2",
-							},
-						},
-						v2action.Warnings{"get org spaces warning"},
-						nil)
-				})
-
-				Context("when no errors are encountered binding the security group to the spaces", func() {
-					BeforeEach(func() {
-						fakeActor.BindSecurityGroupToSpaceReturnsOnCall(
-							0,
-							v2action.Warnings{"bind security group to space warning 1"},
-							nil)
-						fakeActor.BindSecurityGroupToSpaceReturnsOnCall(
-							1,
-							v2action.Warnings{"bind security group to space warning 2"},
-							nil)
-					})
-
-					It("binds the security group to each space and displays all warnings", func() {
-						Expect(executeErr).NotTo(HaveOccurred())
-
-						Expect(testUI.Out).To(Say("Assigning security group some-security-group to space some-space-1 in org some-org as some-user\\.\\.\\."))
-						Expect(testUI.Out).To(Say("OK"))
-						Expect(testUI.Out).To(Say("Assigning security group some-security-group to space some-space-2 in org some-org as some-user\\.\\.\\."))
-						Expect(testUI.Out).To(Say("OK"))
-						Expect(testUI.Out).To(Say("TIP: Changes require an app restart \\(for running\\) or restage \\(for staging\\) to apply to existing applications\\."))
-
-						Expect(testUI.Err).To(Say("get security group warning"))
-						Expect(testUI.Err).To(Say("get org warning"))
-						Expect(testUI.Err).To(Say("get org spaces warning"))
-						Expect(testUI.Err).To(Say("bind security group to space warning 1"))
-						Expect(testUI.Err).To(Say("bind security group to space warning 2"))
-
-						Expect(fakeActor.GetSecurityGroupByNameCallCount()).To(Equal(1))
-						Expect(fakeActor.GetSecurityGroupByNameArgsForCall(0)).To(Equal("some-security-group"))
-
-						Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(1))
-						Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
-
-						Expect(fakeActor.BindSecurityGroupToSpaceCallCount()).To(Equal(2))
-						securityGroupGUID, spaceGUID, lifecycle := fakeActor.BindSecurityGroupToSpaceArgsForCall(0)
-						Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
-						Expect(spaceGUID).To(Equal("some-space-guid-1"))
-						Expect(lifecycle).To(Equal(ccv2.SecurityGroupLifecycleRunning))
-						securityGroupGUID, spaceGUID, lifecycle = fakeActor.BindSecurityGroupToSpaceArgsForCall(1)
-						Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
-						Expect(spaceGUID).To(Equal("some-space-guid-2"))
-						Expect(lifecycle).To(Equal(ccv2.SecurityGroupLifecycleRunning))
-					})
+					fakeActor.BindSecurityGroupToAllSpacesInOrgReturns(nil, nil)
 				})
 
-				Context("when an error is encountered binding the security group to a space", func() {
-					var expectedErr error
-
-					BeforeEach(func() {
-						expectedErr = errors.New("bind security group to space error")
-						fakeActor.BindSecurityGroupToSpaceReturns(
-							v2action.Warnings{"bind security group to space warning"},
-							expectedErr)
-					})
-
-					It("returns the error and displays all warnings", func() {
-						Expect(executeErr).To(MatchError(expectedErr))
-
-						Expect(testUI.Out).NotTo(Say("OK"))
+				It("does not assign the security group to any space", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
 
-						Expect(testUI.Err).To(Say("get security group warning"))
-						Expect(testUI.Err).To(Say("get org warning"))
-						Expect(testUI.Err).To(Say("get org spaces warning"))
-						Expect(testUI.Err).To(Say("bind security group to space warning"))
-					})
+					Expect(testUI.Out).NotTo(Say("Assigning security group"))
+					Expect(testUI.Out).NotTo(Say("OK"))
+					Expect(testUI.Out).To(Say("TIP: Changes require an app restart \\(for running\\) or restage \\(for staging\\) to apply to existing applications\\."))
 				})
 			})
 
-			Context("when an error is encountered getting spaces in the org", func() {
+			Context("when an error is encountered binding the security group to the org's spaces", func() {
 				var expectedErr error
 
 				BeforeEach(func() {
-					expectedErr = errors.New("get org spaces error")
-					fakeActor.GetOrganizationSpacesReturns(
-						nil,
-						v2action.Warnings{"get org spaces warning"},
+					expectedErr = errors.New("bind security group to org spaces error")
+					fakeActor.BindSecurityGroupToAllSpacesInOrgReturns(
+						v2action.Warnings{"bind security group to org spaces warning"},
 						expectedErr)
 				})
 
 				It("returns the error and displays all warnings", func() {
 					Expect(executeErr).To(MatchError(expectedErr))
+
+					Expect(testUI.Out).NotTo(Say("OK"))
+
 					Expect(testUI.Err).To(Say("get security group warning"))
 					Expect(testUI.Err).To(Say("get org warning"))
-					Expect(testUI.Err).To(Say("get org spaces warning"))
+					Expect(testUI.Err).To(Say("bind security group to org spaces warning"))
 				})
 			})
 		})
@@ -480,140 +424,114 @@ var _ = Describe("bind-security-group Command", func() {
 							Expect(spaceName).To(Equal("some-space"))
 
 							Expect(fakeActor.BindSecurityGroupToSpaceCallCount()).To(Equal(1))
-							securityGroupGUID, spaceGUID, lifecycle := fakeActor.BindSecurityGroupToSpaceArgsForCall(0)
+							securityGroupGUID, spaceGUID, lifecycles := fakeActor.BindSecurityGroupToSpaceArgsForCall(0)
 							Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
 							Expect(spaceGUID).To(Equal("some-space-guid"))
-							Expect(lifecycle).To(Equal(ccv2.SecurityGroupLifecycleStaging))
+							Expect(lifecycles).To(Equal([]ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleStaging}))
 						})
 					})
 				})
 			})
 
 			Context("when a space is not provided", func() {
-				Context("when there are no spaces in the org", func() {
+				Context("when no errors are encountered binding the security group to the org's spaces", func() {
 					BeforeEach(func() {
-						fakeActor.GetOrganizationSpacesReturns(
-							[]v2action.Space{},
-							v2action.Warnings{"get org spaces warning"},
-							nil)
+						fakeActor.BindSecurityGroupToAllSpacesInOrgStub = func(_ string, _ string, _ []ccv2.SecurityGroupLifecycle, spaceBound func(v2action.Space)) (v2action.Warnings, error) {
+							spaceBound(v2action.Space{Name: "some-space-1"})
+							spaceBound(v2action.Space{Name: "some-space-2"})
+							return v2action.Warnings{"bind security group to org spaces warning"}, nil
+						}
 					})
 
-					It("does not perform any bindings and displays all warnings", func() {
+					It("binds the security group to all spaces in the org and displays all warnings", func() {
 						Expect(executeErr).NotTo(HaveOccurred())
 
-						Expect(testUI.Out).NotTo(Say("Assigning security group"))
-						Expect(testUI.Out).NotTo(Say("OK"))
+						Expect(testUI.Out).To(Say("Assigning security group some-security-group to space some-space-1 in org some-org as some-user\\.\\.\\."))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).To(Say("Assigning security group some-security-group to space some-space-2 in org some-org as some-user\\.\\.\\."))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).To(Say("TIP: Changes require an app restart \\(for running\\) or restage \\(for staging\\) to apply to existing applications\\."))
 
 						Expect(testUI.Err).To(Say("get security group warning"))
 						Expect(testUI.Err).To(Say("get org warning"))
-						Expect(testUI.Err).To(Say("get org spaces warning"))
-					})
-				})
+						Expect(testUI.Err).To(Say("bind security group to org spaces warning"))
 
-				Context("when there are spaces in the org", func() {
-					BeforeEach(func() {
-						fakeActor.GetOrganizationSpacesReturns(
-							[]v2action.Space{
-								{
-									GUID: "some-space-guid-1",
-									Name: "some-space-1",
-								},
-								{
-									GUID: "some-space-guid-2",
-									Name: "some-space-2",
-								},
-							},
-							v2action.Warnings{"get org spaces warning"},
-							nil)
-					})
+						Expect(fakeActor.GetSpaceByOrganizationAndNameCallCount()).To(Equal(0))
+						Expect(fakeActor.BindSecurityGroupToSpaceCallCount()).To(Equal(0))
 
-					Context("when no errors are encountered binding the security group to the spaces", func() {
-						BeforeEach(func() {
-							fakeActor.BindSecurityGroupToSpaceReturnsOnCall(
-								0,
-								v2action.Warnings{"bind security group to space warning 1"},
-								nil)
-							fakeActor.BindSecurityGroupToSpaceReturnsOnCall(
-								1,
-								v2action.Warnings{"bind security group to space warning 2"},
-								nil)
-						})
-
-						It("binds the security group to each space and displays all warnings", func() {
-							Expect(executeErr).NotTo(HaveOccurred())
-
-							Expect(testUI.Out).To(Say("Assigning security group some-security-group to space some-space-1 in org some-org as some-user\\.\\.\\."))
-							Expect(testUI.Out).To(Say("OK"))
-							Expect(testUI.Out).To(Say("Assigning security group some-security-group to space some-space-2 in org some-org as some-user\\.\\.\\."))
-							Expect(testUI.Out).To(Say("OK"))
-							Expect(testUI.Out).To(Say("TIP: Changes require an app restart \\(for running\\) or restage \\(for staging\\) to apply to existing applications\\."))
-
-							Expect(testUI.Err).To(Say("get security group warning"))
-							Expect(testUI.Err).To(Say("get org warning"))
-							Expect(testUI.Err).To(Say("get org spaces warning"))
-							Expect(testUI.Err).To(Say("bind security group to space warning 1"))
-							Expect(testUI.Err).To(Say("bind security group to space warning 2"))
-
-							Expect(fakeActor.GetSecurityGroupByNameCallCount()).To(Equal(1))
-							Expect(fakeActor.GetSecurityGroupByNameArgsForCall(0)).To(Equal("some-security-group"))
-
-							Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(1))
-							Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
-
-							Expect(fakeActor.BindSecurityGroupToSpaceCallCount()).To(Equal(2))
-							securityGroupGUID, spaceGUID, lifecycle := fakeActor.BindSecurityGroupToSpaceArgsForCall(0)
-							Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
-							Expect(spaceGUID).To(Equal("some-space-guid-1"))
-							Expect(lifecycle).To(Equal(ccv2.SecurityGroupLifecycleStaging))
-							securityGroupGUID, spaceGUID, lifecycle = fakeActor.BindSecurityGroupToSpaceArgsForCall(1)
-							Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
-							Expect(spaceGUID).To(Equal("some-space-guid-2"))
-							Expect(lifecycle).To(Equal(ccv2.SecurityGroupLifecycleStaging))
-						})
-					})
-
-					Context("when an error is encountered binding the security group to a space", func() {
-						var expectedErr error
-
-						BeforeEach(func() {
-							expectedErr = errors.New("bind security group to space error")
-							fakeActor.BindSecurityGroupToSpaceReturns(
-								v2action.Warnings{"bind security group to space warning"},
-								expectedErr)
-						})
-
-						It("returns the error and displays all warnings", func() {
-							Expect(executeErr).To(MatchError(expectedErr))
-
-							Expect(testUI.Out).NotTo(Say("OK"))
-
-							Expect(testUI.Err).To(Say("get security group warning"))
-							Expect(testUI.Err).To(Say("get org warning"))
-							Expect(testUI.Err).To(Say("get org spaces warning"))
-							Expect(testUI.Err).To(Say("bind security group to space warning"))
-						})
+						Expect(fakeActor.BindSecurityGroupToAllSpacesInOrgCallCount()).To(Equal(1))
+						securityGroupGUID, orgGUID, lifecycles, _ := fakeActor.BindSecurityGroupToAllSpacesInOrgArgsForCall(0)
+						Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
+						Expect(orgGUID).To(Equal("some-org-guid"))
+						Expect(lifecycles).To(Equal([]ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleStaging}))
 					})
 				})
 
-				Context("when an error is encountered getting spaces in the org", func() {
+				Context("when an error is encountered binding the security group to the org's spaces", func() {
 					var expectedErr error
 
 					BeforeEach(func() {
-						expectedErr = errors.New("get org spaces error")
-						fakeActor.GetOrganizationSpacesReturns(
-							nil,
-							v2action.Warnings{"get org spaces warning"},
+						expectedErr = errors.New("bind security group to org spaces error")
+						fakeActor.BindSecurityGroupToAllSpacesInOrgReturns(
+							v2action.Warnings{"bind security group to org spaces warning"},
 							expectedErr)
 					})
 
 					It("returns the error and displays all warnings", func() {
 						Expect(executeErr).To(MatchError(expectedErr))
+
+						Expect(testUI.Out).NotTo(Say("OK"))
+
 						Expect(testUI.Err).To(Say("get security group warning"))
 						Expect(testUI.Err).To(Say("get org warning"))
-						Expect(testUI.Err).To(Say("get org spaces warning"))
+						Expect(testUI.Err).To(Say("bind security group to org spaces warning"))
 					})
 				})
 			})
 		})
 	})
+	Context("when lifecycle is 'both'", func() {
+		BeforeEach(func() {
+			cmd.Lifecycle = flag.SecurityGroupLifecycleBoth
+		})
+
+		Context("when the version check fails", func() {
+			BeforeEach(func() {
+				fakeActor.CloudControllerAPIVersionReturns("2.34.0")
+			})
+
+			It("returns a MinimumAPIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.LifecycleMinimumAPIVersionNotMetError{
+					CurrentVersion: "2.34.0",
+					MinimumVersion: version.MinVersionLifecyleStagingV2,
+				}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the version check succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.CloudControllerAPIVersionReturns(version.MinVersionLifecyleStagingV2)
+				cmd.RequiredArgs.SpaceName = "some-space"
+				fakeActor.GetSpaceByOrganizationAndNameReturns(
+					v2action.Space{
+						GUID: "some-space-guid",
+						Name: "some-space",
+					},
+					nil,
+					nil)
+			})
+
+			It("binds the security group to the space for the running and staging lifecycles", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(fakeActor.BindSecurityGroupToSpaceCallCount()).To(Equal(1))
+				_, _, lifecycles := fakeActor.BindSecurityGroupToSpaceArgsForCall(0)
+				Expect(lifecycles).To(Equal([]ccv2.SecurityGroupLifecycle{
+					ccv2.SecurityGroupLifecycleRunning,
+					ccv2.SecurityGroupLifecycleStaging,
+				}))
+			})
+		})
+	})
 })
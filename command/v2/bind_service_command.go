@@ -11,18 +11,22 @@ import (
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/version"
 )
 
 //go:generate counterfeiter . BindServiceActor
 
 type BindServiceActor interface {
-	BindServiceBySpace(appName string, ServiceInstanceName string, spaceGUID string, parameters map[string]interface{}) (v2action.Warnings, error)
+	BindServiceBySpace(appName string, ServiceInstanceName string, spaceGUID string, bindingName string, parameters map[string]interface{}) (v2action.ServiceBinding, v2action.Warnings, error)
+	CloudControllerAPIVersion() string
+	PollServiceBinding(serviceBinding v2action.ServiceBinding) (v2action.ServiceBinding, v2action.Warnings, error)
 }
 
 type BindServiceCommand struct {
 	RequiredArgs     flag.BindServiceArgs          `positional-args:"yes"`
 	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
-	usage            interface{}                   `usage:"CF_NAME bind-service APP_NAME SERVICE_INSTANCE [-c PARAMETERS_AS_JSON]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line:\n\n   CF_NAME bind-service APP_NAME SERVICE_INSTANCE -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. \n   The path to the parameters file can be an absolute or relative path to a file.\n   CF_NAME bind-service APP_NAME SERVICE_INSTANCE -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"permissions\": \"read-only\"\n   }\n\nEXAMPLES:\n   Linux/Mac:\n      CF_NAME bind-service myapp mydb -c '{\"permissions\":\"read-only\"}'\n\n   Windows Command Line:\n      CF_NAME bind-service myapp mydb -c \"{\\\"permissions\\\":\\\"read-only\\\"}\"\n\n   Windows PowerShell:\n      CF_NAME bind-service myapp mydb -c '{\\\"permissions\\\":\\\"read-only\\\"}'\n\n   CF_NAME bind-service myapp mydb -c ~/workspace/tmp/instance_config.json"`
+	BindingName      string                        `long:"binding-name" description:"Name to expose service instance to app process with (Default: service instance name)"`
+	usage            interface{}                   `usage:"CF_NAME bind-service APP_NAME SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [--binding-name BINDING_NAME]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line:\n\n   CF_NAME bind-service APP_NAME SERVICE_INSTANCE -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. \n   The path to the parameters file can be an absolute or relative path to a file.\n   CF_NAME bind-service APP_NAME SERVICE_INSTANCE -c PATH_TO_FILE\n\n   The path may also be prefixed with '@':\n   CF_NAME bind-service APP_NAME SERVICE_INSTANCE -c @PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"permissions\": \"read-only\"\n   }\n\nEXAMPLES:\n   Linux/Mac:\n      CF_NAME bind-service myapp mydb -c '{\"permissions\":\"read-only\"}'\n\n   Windows Command Line:\n      CF_NAME bind-service myapp mydb -c \"{\\\"permissions\\\":\\\"read-only\\\"}\"\n\n   Windows PowerShell:\n      CF_NAME bind-service myapp mydb -c '{\\\"permissions\\\":\\\"read-only\\\"}'\n\n   CF_NAME bind-service myapp mydb -c ~/workspace/tmp/instance_config.json\n\n   CF_NAME bind-service myapp mydb --binding-name BINDING_NAME"`
 	relatedCommands  interface{}                   `related_commands:"services"`
 
 	UI          command.UI
@@ -56,6 +60,13 @@ func (cmd BindServiceCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.BindingName != "" {
+		err = version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionBindingNameV2, "Option '--binding-name'")
+		if err != nil {
+			return err
+		}
+	}
+
 	user, err := cmd.Config.CurrentUser()
 	if err != nil {
 		return err
@@ -69,8 +80,13 @@ func (cmd BindServiceCommand) Execute(args []string) error {
 		"CurrentUser": user.Name,
 	})
 
-	warnings, err := cmd.Actor.BindServiceBySpace(cmd.RequiredArgs.AppName, cmd.RequiredArgs.ServiceInstanceName, cmd.Config.TargetedSpace().GUID, cmd.ParametersAsJSON)
+	serviceBinding, warnings, err := cmd.Actor.BindServiceBySpace(cmd.RequiredArgs.AppName, cmd.RequiredArgs.ServiceInstanceName, cmd.Config.TargetedSpace().GUID, cmd.BindingName, cmd.ParametersAsJSON)
 	cmd.UI.DisplayWarnings(warnings)
+	if err == nil && serviceBinding.IsInProgress() {
+		cmd.UI.DisplayText("Waiting for the service broker to complete the binding...")
+		serviceBinding, warnings, err = cmd.Actor.PollServiceBinding(serviceBinding)
+		cmd.UI.DisplayWarnings(warnings)
+	}
 	if err != nil {
 		if _, isTakenError := err.(ccerror.ServiceBindingTakenError); isTakenError {
 			cmd.UI.DisplayText("App {{.AppName}} is already bound to {{.ServiceName}}.", map[string]interface{}{
@@ -84,6 +100,7 @@ func (cmd BindServiceCommand) Execute(args []string) error {
 	}
 
 	cmd.UI.DisplayOK()
+
 	cmd.UI.DisplayText("TIP: Use '{{.CFCommand}} {{.AppName}}' to ensure your env variable changes take effect", map[string]interface{}{
 		"CFCommand": fmt.Sprintf("%s restage", cmd.Config.BinaryName()),
 		"AppName":   cmd.RequiredArgs.AppName,
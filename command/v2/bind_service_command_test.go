@@ -2,16 +2,19 @@ package v2_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
@@ -121,6 +124,7 @@ var _ = Describe("bind-service Command", func() {
 				Context("when the service was already bound", func() {
 					BeforeEach(func() {
 						fakeActor.BindServiceBySpaceReturns(
+							v2action.ServiceBinding{},
 							[]string{"foo", "bar"},
 							ccerror.ServiceBindingTakenError{})
 					})
@@ -138,6 +142,7 @@ var _ = Describe("bind-service Command", func() {
 				Context("when binding the service instance results in an error other than ServiceBindingTakenError", func() {
 					BeforeEach(func() {
 						fakeActor.BindServiceBySpaceReturns(
+							v2action.ServiceBinding{},
 							nil,
 							v2action.ApplicationNotFoundError{Name: "some-app"})
 					})
@@ -152,6 +157,7 @@ var _ = Describe("bind-service Command", func() {
 				Context("when the service binding is successful", func() {
 					BeforeEach(func() {
 						fakeActor.BindServiceBySpaceReturns(
+							v2action.ServiceBinding{GUID: "some-service-binding-guid"},
 							v2action.Warnings{"some-warning", "another-warning"},
 							nil,
 						)
@@ -166,11 +172,123 @@ var _ = Describe("bind-service Command", func() {
 						Expect(testUI.Err).To(Say("another-warning"))
 
 						Expect(fakeActor.BindServiceBySpaceCallCount()).To(Equal(1))
-						appName, serviceInstanceName, spaceGUID, parameters := fakeActor.BindServiceBySpaceArgsForCall(0)
+						appName, serviceInstanceName, spaceGUID, bindingName, parameters := fakeActor.BindServiceBySpaceArgsForCall(0)
 						Expect(appName).To(Equal("some-app"))
 						Expect(serviceInstanceName).To(Equal("some-service"))
 						Expect(spaceGUID).To(Equal("some-space-guid"))
+						Expect(bindingName).To(BeEmpty())
 						Expect(parameters).To(Equal(map[string]interface{}{"some-parameter": "some-value"}))
+
+						Expect(fakeActor.PollServiceBindingCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the service binding is created asynchronously", func() {
+					var inProgressBinding v2action.ServiceBinding
+
+					BeforeEach(func() {
+						inProgressBinding = v2action.ServiceBinding{
+							GUID:          "some-service-binding-guid",
+							LastOperation: ccv2.LastOperation{State: ccv2.LastOperationInProgress},
+						}
+						fakeActor.BindServiceBySpaceReturns(
+							inProgressBinding,
+							v2action.Warnings{"some-warning"},
+							nil,
+						)
+					})
+
+					Context("when the binding completes while polling", func() {
+						BeforeEach(func() {
+							fakeActor.PollServiceBindingReturns(
+								v2action.ServiceBinding{
+									GUID:          "some-service-binding-guid",
+									LastOperation: ccv2.LastOperation{State: ccv2.LastOperationSucceeded},
+								},
+								v2action.Warnings{"poll-warning"},
+								nil,
+							)
+						})
+
+						It("waits for the binding and displays OK and the TIP", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("Waiting for the service broker to complete the binding..."))
+							Expect(testUI.Out).To(Say("OK"))
+							Expect(testUI.Out).To(Say("TIP: Use 'faceman restage some-app' to ensure your env variable changes take effect"))
+							Expect(testUI.Err).To(Say("some-warning"))
+							Expect(testUI.Err).To(Say("poll-warning"))
+
+							Expect(fakeActor.PollServiceBindingCallCount()).To(Equal(1))
+							Expect(fakeActor.PollServiceBindingArgsForCall(0)).To(Equal(inProgressBinding))
+						})
+					})
+
+					Context("when the binding is still in progress after polling", func() {
+						BeforeEach(func() {
+							fakeActor.PollServiceBindingReturns(
+								inProgressBinding,
+								v2action.Warnings{"poll-warning"},
+								v2action.ServiceBindingTimeoutError{GUID: "some-service-binding-guid", Timeout: time.Minute},
+							)
+						})
+
+						It("returns a ServiceBindingTimeoutError and displays warnings", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ServiceBindingTimeoutError{GUID: "some-service-binding-guid", Timeout: time.Minute}))
+							Expect(testUI.Err).To(Say("poll-warning"))
+							Expect(testUI.Out).ToNot(Say("OK"))
+						})
+					})
+
+					Context("when the binding fails", func() {
+						BeforeEach(func() {
+							fakeActor.PollServiceBindingReturns(
+								v2action.ServiceBinding{},
+								v2action.Warnings{"poll-warning"},
+								v2action.ServiceBindingFailedError{Description: "some-broker-error"},
+							)
+						})
+
+						It("returns the error and displays warnings", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ServiceBindingFailedError{Description: "some-broker-error"}))
+							Expect(testUI.Err).To(Say("poll-warning"))
+							Expect(testUI.Out).ToNot(Say("OK"))
+						})
+					})
+				})
+
+				Context("when a binding name is provided", func() {
+					BeforeEach(func() {
+						cmd.BindingName = "some-binding-name"
+					})
+
+					Context("when the API version is below the minimum", func() {
+						BeforeEach(func() {
+							fakeActor.CloudControllerAPIVersionReturns("2.98.0")
+						})
+
+						It("returns a MinimumAPIVersionNotMetError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+								Command:        "Option '--binding-name'",
+								CurrentVersion: "2.98.0",
+								MinimumVersion: version.MinVersionBindingNameV2,
+							}))
+							Expect(fakeActor.BindServiceBySpaceCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the API version meets the minimum", func() {
+						BeforeEach(func() {
+							fakeActor.CloudControllerAPIVersionReturns(version.MinVersionBindingNameV2)
+						})
+
+						It("binds the service with the binding name", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.BindServiceBySpaceCallCount()).To(Equal(1))
+							_, _, _, bindingName, _ := fakeActor.BindServiceBySpaceArgsForCall(0)
+							Expect(bindingName).To(Equal("some-binding-name"))
+						})
 					})
 				})
 			})
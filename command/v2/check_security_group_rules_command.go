@@ -0,0 +1,59 @@
+package v2
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+type CheckSecurityGroupRulesCommand struct {
+	RequiredArgs    flag.SecurityGroupRulesArgs `positional-args:"yes"`
+	usage           interface{}                 `usage:"CF_NAME check-security-group-rules PATH_TO_JSON_RULES_FILE\n\n   Checks the protocol, destination, ports, ICMP type and code of each rule, and\n   reports rules that overlap an earlier rule. The file has the same format as the\n   one used by create-security-group and update-security-group."`
+	relatedCommands interface{}                 `related_commands:"create-security-group, update-security-group"`
+
+	UI command.UI
+}
+
+func (cmd *CheckSecurityGroupRulesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	return nil
+}
+
+func (cmd CheckSecurityGroupRulesCommand) Execute(args []string) error {
+	cmd.UI.DisplayTextWithFlavor("Checking security group rules in {{.Path}}...", map[string]interface{}{
+		"Path": cmd.RequiredArgs.PathToJsonRules,
+	})
+
+	rules, err := v2action.ReadSecurityGroupRules(string(cmd.RequiredArgs.PathToJsonRules))
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	violations := v2action.ValidateSecurityGroupRules(rules)
+	if len(violations) == 0 {
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("rule"),
+			cmd.UI.TranslateText("problem"),
+		},
+	}
+	for _, violation := range violations {
+		table = append(table, []string{
+			strconv.Itoa(violation.Rule),
+			violation.Reason,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	cmd.UI.DisplayNewline()
+
+	return translatableerror.SecurityGroupRuleViolationsError{Count: len(violations)}
+}
@@ -0,0 +1,87 @@
+package v2_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("check-security-group-rules Command", func() {
+	var (
+		cmd         CheckSecurityGroupRulesCommand
+		testUI      *ui.UI
+		pathToRules string
+		rawRules    string
+		executeErr  error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+	})
+
+	JustBeforeEach(func() {
+		tmpFile, err := ioutil.TempFile("", "security-group-rules")
+		Expect(err).ToNot(HaveOccurred())
+		pathToRules = tmpFile.Name()
+		_, err = tmpFile.WriteString(rawRules)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tmpFile.Close()).To(Succeed())
+
+		cmd = CheckSecurityGroupRulesCommand{
+			RequiredArgs: flag.SecurityGroupRulesArgs{PathToJsonRules: flag.PathWithExistenceCheck(pathToRules)},
+			UI:           testUI,
+		}
+		executeErr = cmd.Execute(nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(pathToRules)).To(Succeed())
+	})
+
+	Context("when the rules are valid", func() {
+		BeforeEach(func() {
+			rawRules = `[{"protocol": "tcp", "destination": "10.0.11.0/24", "ports": "80,443"}]`
+		})
+
+		It("displays OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Checking security group rules in %s\\.\\.\\.", pathToRules))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Context("when the rules have problems", func() {
+		BeforeEach(func() {
+			rawRules = `[
+				{"protocol": "tcp", "destination": "10.0.11.0/24", "ports": "80,443"},
+				{"protocol": "tcp", "destination": "10.0.11.1", "ports": "443"},
+				{"protocol": "udp", "destination": "10.0.11.1"}
+			]`
+		})
+
+		It("displays the problems and returns a SecurityGroupRuleViolationsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SecurityGroupRuleViolationsError{Count: 2}))
+			Expect(testUI.Out).To(Say("rule\\s+problem"))
+			Expect(testUI.Out).To(Say("2\\s+overlaps rule 1"))
+			Expect(testUI.Out).To(Say("3\\s+ports are required for protocol udp"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	Context("when the file is not a list of rules", func() {
+		BeforeEach(func() {
+			rawRules = `{"protocol": "tcp"}`
+		})
+
+		It("returns an InvalidSecurityGroupRulesError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.InvalidSecurityGroupRulesError{}))
+		})
+	})
+})
@@ -0,0 +1,170 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . CompareAppActor
+
+type CompareAppActor interface {
+	GetApplicationConfigurationsByNameAndSpaces(name string, spaceGUID string, otherSpaceGUID string) (v2action.ApplicationConfiguration, v2action.ApplicationConfiguration, v2action.Warnings, error)
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+}
+
+type CompareAppCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	WithSpace       string       `long:"with-space" required:"true" description:"Space containing the app to compare against"`
+	WithOrg         string       `long:"with-org" description:"Org containing the space to compare against (Default: targeted org)"`
+	usage           interface{}  `usage:"CF_NAME compare-app APP_NAME --with-space OTHER_SPACE [--with-org OTHER_ORG]"`
+	relatedCommands interface{}  `related_commands:"app, env, scale"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CompareAppActor
+}
+
+func (cmd *CompareAppCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd CompareAppCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	otherOrg, err := cmd.otherOrganization()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	otherSpace, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(otherOrg.GUID, cmd.WithSpace)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Comparing app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} with org {{.OtherOrgName}} / space {{.OtherSpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":        cmd.RequiredArgs.AppName,
+		"OrgName":        cmd.Config.TargetedOrganization().Name,
+		"SpaceName":      cmd.Config.TargetedSpace().Name,
+		"OtherOrgName":   otherOrg.Name,
+		"OtherSpaceName": otherSpace.Name,
+		"Username":       user.Name,
+	})
+
+	config, otherConfig, warnings, err := cmd.Actor.GetApplicationConfigurationsByNameAndSpaces(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, otherSpace.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("- org {{.OrgName}} / space {{.SpaceName}}", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+	})
+	cmd.UI.DisplayText("+ org {{.OrgName}} / space {{.SpaceName}}", map[string]interface{}{
+		"OrgName":   otherOrg.Name,
+		"SpaceName": otherSpace.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	return cmd.UI.DisplayChangesForPush(cmd.configurationChanges(config, otherConfig))
+}
+
+func (cmd CompareAppCommand) otherOrganization() (v2action.Organization, error) {
+	if cmd.WithOrg == "" {
+		return v2action.Organization{
+			GUID: cmd.Config.TargetedOrganization().GUID,
+			Name: cmd.Config.TargetedOrganization().Name,
+		}, nil
+	}
+
+	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.WithOrg)
+	cmd.UI.DisplayWarnings(warnings)
+	return org, err
+}
+
+func (cmd CompareAppCommand) configurationChanges(config v2action.ApplicationConfiguration, otherConfig v2action.ApplicationConfiguration) []ui.Change {
+	return []ui.Change{
+		{
+			Header:       "buildpack:",
+			CurrentValue: config.CalculatedBuildpack(),
+			NewValue:     otherConfig.CalculatedBuildpack(),
+		},
+		{
+			Header:       "stack:",
+			CurrentValue: config.Stack.Name,
+			NewValue:     otherConfig.Stack.Name,
+		},
+		{
+			Header:       "instances:",
+			CurrentValue: config.Instances,
+			NewValue:     otherConfig.Instances,
+		},
+		{
+			Header:       "memory:",
+			CurrentValue: cmd.UI.FormatMegabytes(config.Memory),
+			NewValue:     cmd.UI.FormatMegabytes(otherConfig.Memory),
+		},
+		{
+			Header:       "disk quota:",
+			CurrentValue: cmd.UI.FormatMegabytes(config.DiskQuota),
+			NewValue:     cmd.UI.FormatMegabytes(otherConfig.DiskQuota),
+		},
+		{
+			Header:       "env:",
+			CurrentValue: config.EnvironmentVariables,
+			NewValue:     otherConfig.EnvironmentVariables,
+		},
+		{
+			Header:       "services:",
+			CurrentValue: serviceInstanceNames(config),
+			NewValue:     serviceInstanceNames(otherConfig),
+		},
+		{
+			Header:       "routes:",
+			CurrentValue: routeURLs(config),
+			NewValue:     routeURLs(otherConfig),
+		},
+	}
+}
+
+func serviceInstanceNames(config v2action.ApplicationConfiguration) []string {
+	var names []string
+	for _, serviceInstance := range config.ServiceInstances {
+		names = append(names, serviceInstance.Name)
+	}
+	return names
+}
+
+func routeURLs(config v2action.ApplicationConfiguration) []string {
+	var urls []string
+	for _, route := range config.Routes {
+		urls = append(urls, route.String())
+	}
+	return urls
+}
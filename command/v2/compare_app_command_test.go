@@ -0,0 +1,264 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("compare-app Command", func() {
+	var (
+		cmd             CompareAppCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCompareAppActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCompareAppActor)
+
+		cmd = CompareAppCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.WithSpace = "other-space"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error if the check fails", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+			fakeActor.GetSpaceByOrganizationAndNameReturns(
+				v2action.Space{GUID: "other-space-guid", Name: "other-space"},
+				v2action.Warnings{"space-warning"},
+				nil)
+		})
+
+		Context("when getting the current user returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("getting current user error")
+				fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when --with-org is not provided", func() {
+			It("looks up the other space in the targeted org", func() {
+				Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+				Expect(fakeActor.GetSpaceByOrganizationAndNameCallCount()).To(Equal(1))
+				orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(spaceName).To(Equal("other-space"))
+
+				Expect(testUI.Out).To(Say("Comparing app some-app in org some-org / space some-space with org some-org / space other-space as some-user..."))
+			})
+		})
+
+		Context("when --with-org is provided", func() {
+			BeforeEach(func() {
+				cmd.WithOrg = "other-org"
+			})
+
+			Context("when the org exists", func() {
+				BeforeEach(func() {
+					fakeActor.GetOrganizationByNameReturns(
+						v2action.Organization{GUID: "other-org-guid", Name: "other-org"},
+						v2action.Warnings{"org-warning"},
+						nil)
+				})
+
+				It("looks up the other space in the given org", func() {
+					Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(1))
+					Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("other-org"))
+
+					orgGUID, _ := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+					Expect(orgGUID).To(Equal("other-org-guid"))
+
+					Expect(testUI.Out).To(Say("Comparing app some-app in org some-org / space some-space with org other-org / space other-space as some-user..."))
+					Expect(testUI.Err).To(Say("org-warning"))
+				})
+			})
+
+			Context("when the org does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.GetOrganizationByNameReturns(
+						v2action.Organization{},
+						v2action.Warnings{"org-warning"},
+						v2action.OrganizationNotFoundError{Name: "other-org"})
+				})
+
+				It("returns an OrganizationNotFoundError and the warnings", func() {
+					Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "other-org"}))
+					Expect(testUI.Err).To(Say("org-warning"))
+					Expect(fakeActor.GetSpaceByOrganizationAndNameCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the other space does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceByOrganizationAndNameReturns(
+					v2action.Space{},
+					v2action.Warnings{"space-warning"},
+					v2action.SpaceNotFoundError{Name: "other-space"})
+			})
+
+			It("returns a SpaceNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "other-space"}))
+				Expect(testUI.Err).To(Say("space-warning"))
+				Expect(fakeActor.GetApplicationConfigurationsByNameAndSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app does not exist in one of the spaces", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationConfigurationsByNameAndSpacesReturns(
+					v2action.ApplicationConfiguration{},
+					v2action.ApplicationConfiguration{},
+					v2action.Warnings{"app-warning"},
+					v2action.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns an ApplicationNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(testUI.Err).To(Say("app-warning"))
+			})
+		})
+
+		Context("when the app exists in both spaces", func() {
+			BeforeEach(func() {
+				config := v2action.ApplicationConfiguration{
+					ApplicationSummary: v2action.ApplicationSummary{
+						Application: v2action.Application{
+							Name:                 "some-app",
+							Buildpack:            types.FilteredString{IsSet: true, Value: "ruby_buildpack"},
+							Instances:            types.NullInt{IsSet: true, Value: 2},
+							Memory:               1024,
+							DiskQuota:            512,
+							EnvironmentVariables: map[string]string{"SHARED": "1", "ONLY_HERE": "2"},
+						},
+						Stack: v2action.Stack{Name: "cflinuxfs2"},
+						Routes: []v2action.Route{
+							{Host: "some-app", Domain: v2action.Domain{Name: "example.com"}},
+						},
+					},
+					ServiceInstances: []v2action.ServiceInstance{{Name: "some-db"}},
+				}
+
+				otherConfig := v2action.ApplicationConfiguration{
+					ApplicationSummary: v2action.ApplicationSummary{
+						Application: v2action.Application{
+							Name:                 "some-app",
+							Buildpack:            types.FilteredString{IsSet: true, Value: "ruby_buildpack"},
+							Instances:            types.NullInt{IsSet: true, Value: 4},
+							Memory:               2048,
+							DiskQuota:            512,
+							EnvironmentVariables: map[string]string{"SHARED": "1", "ONLY_THERE": "3"},
+						},
+						Stack: v2action.Stack{Name: "cflinuxfs2"},
+						Routes: []v2action.Route{
+							{Host: "some-app-staging", Domain: v2action.Domain{Name: "example.com"}},
+						},
+					},
+					ServiceInstances: []v2action.ServiceInstance{{Name: "some-db"}, {Name: "some-cache"}},
+				}
+
+				fakeActor.GetApplicationConfigurationsByNameAndSpacesReturns(
+					config,
+					otherConfig,
+					v2action.Warnings{"app-warning-1", "app-warning-2"},
+					nil)
+			})
+
+			It("compares the app in the targeted space with the other space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetApplicationConfigurationsByNameAndSpacesCallCount()).To(Equal(1))
+				appName, spaceGUID, otherSpaceGUID := fakeActor.GetApplicationConfigurationsByNameAndSpacesArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(otherSpaceGUID).To(Equal("other-space-guid"))
+
+				Expect(testUI.Err).To(Say("space-warning"))
+				Expect(testUI.Err).To(Say("app-warning-1"))
+				Expect(testUI.Err).To(Say("app-warning-2"))
+			})
+
+			It("displays the differences between the two apps", func() {
+				Expect(testUI.Out).To(Say(`- org some-org / space some-space`))
+				Expect(testUI.Out).To(Say(`\+ org some-org / space other-space`))
+				Expect(testUI.Out).To(Say(`\s+buildpack:\s+ruby_buildpack`))
+				Expect(testUI.Out).To(Say(`\s+stack:\s+cflinuxfs2`))
+				Expect(testUI.Out).To(Say(`- instances:\s+2`))
+				Expect(testUI.Out).To(Say(`\+ instances:\s+4`))
+				Expect(testUI.Out).To(Say(`- memory:\s+1G`))
+				Expect(testUI.Out).To(Say(`\+ memory:\s+2G`))
+				Expect(testUI.Out).To(Say(`\s+disk quota:\s+512M`))
+				Expect(testUI.Out).To(Say(`\s+env:`))
+				Expect(testUI.Out).To(Say(`-\s+ONLY_HERE`))
+				Expect(testUI.Out).To(Say(`\+\s+ONLY_THERE`))
+				Expect(testUI.Out).To(Say(`\s+SHARED`))
+				Expect(testUI.Out).To(Say(`\s+services:`))
+				Expect(testUI.Out).To(Say(`\+\s+some-cache`))
+				Expect(testUI.Out).To(Say(`\s+some-db`))
+				Expect(testUI.Out).To(Say(`\s+routes:`))
+				Expect(testUI.Out).To(Say(`\+\s+some-app-staging\.example\.com`))
+				Expect(testUI.Out).To(Say(`-\s+some-app\.example\.com`))
+			})
+
+			It("does not display environment variable values", func() {
+				Expect(testUI.Out).ToNot(Say("ONLY_HERE.*2"))
+			})
+		})
+	})
+})
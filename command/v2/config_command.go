@@ -9,11 +9,13 @@ import (
 )
 
 type ConfigCommand struct {
-	AsyncTimeout int               `long:"async-timeout" description:"Timeout for async HTTP requests"`
-	Color        flag.Color        `long:"color" description:"Enable or disable color"`
-	Locale       flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
-	Trace        flag.PathWithBool `long:"trace" description:"Trace HTTP requests"`
-	usage        interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"`
+	AsyncTimeout             int               `long:"async-timeout" description:"Timeout for async HTTP requests"`
+	Color                    flag.Color        `long:"color" description:"Enable or disable color"`
+	Locale                   flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
+	Pager                    flag.Pager        `long:"pager" description:"Enable or disable piping long output through $PAGER"`
+	ResourceMatchMinFileSize int               `long:"resource-match-min-file-size" description:"Minimum size, in bytes, of files checked for previously uploaded matches"`
+	Trace                    flag.PathWithBool `long:"trace" description:"Trace HTTP requests"`
+	usage                    interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--pager (true | false)] [--resource-match-min-file-size BYTES] [--locale (LOCALE | CLEAR)]"`
 }
 
 func (ConfigCommand) Setup(config command.Config, ui command.UI) error {
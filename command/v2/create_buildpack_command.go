@@ -1,28 +1,63 @@
 package v2
 
 import (
+	"io/ioutil"
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/downloader"
 )
 
+//go:generate counterfeiter . CreateBuildpackActor
+
+type CreateBuildpackActor interface {
+	CreateBuildpack(name string, position int, enabled bool) (v2action.Buildpack, v2action.Warnings, error)
+	PrepareBuildpackBits(inputPath string, tmpDirPath string, downloader v2action.Downloader) (string, error)
+	UploadBuildpack(guid string, pathToBuildpackBits string, progressBar v2action.SimpleProgressBar) (v2action.Warnings, error)
+}
+
 type CreateBuildpackCommand struct {
 	RequiredArgs    flag.CreateBuildpackArgs `positional-args:"yes"`
 	Disable         bool                     `long:"disable" description:"Disable the buildpack from being used for staging"`
 	Enable          bool                     `long:"enable" description:"Enable the buildpack to be used for staging"`
 	usage           interface{}              `usage:"CF_NAME create-buildpack BUILDPACK PATH POSITION [--enable|--disable]\n\nTIP:\n   Path should be a zip file, a url to a zip file, or a local directory. Position is a positive integer, sets priority, and is sorted from lowest to highest."`
 	relatedCommands interface{}              `related_commands:"buildpacks, push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateBuildpackActor
+	ProgressBar ProgressBar
 }
 
-func (CreateBuildpackCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *CreateBuildpackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+	cmd.ProgressBar = ui.NewProgressBar()
+
 	return nil
 }
 
-func (c CreateBuildpackCommand) Execute(args []string) error {
-	_, err := flag.ParseStringToInt(c.RequiredArgs.Position)
+func (cmd CreateBuildpackCommand) Execute(args []string) error {
+	if cmd.Enable && cmd.Disable {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--enable", "--disable"},
+		}
+	}
+
+	position, err := flag.ParseStringToInt(cmd.RequiredArgs.Position)
 	if err != nil {
 		return translatableerror.ParseArgumentError{
 			ArgumentName: "POSITION",
@@ -30,6 +65,85 @@ func (c CreateBuildpackCommand) Execute(args []string) error {
 		}
 	}
 
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	err = cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	tmpDirPath, err := ioutil.TempDir("", "buildpack-dir-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDirPath)
+
+	pathToBuildpackBits, err := cmd.Actor.PrepareBuildpackBits(string(cmd.RequiredArgs.Path), tmpDirPath, downloader.NewDownloader(tmpDirPath))
+	if err != nil {
+		cmd.UI.DisplayWarning("Failed to create a local temporary zip file for the buildpack")
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating buildpack {{.Buildpack}} as {{.CurrentUser}}...", map[string]interface{}{
+		"Buildpack":   cmd.RequiredArgs.Buildpack,
+		"CurrentUser": user.Name,
+	})
+
+	buildpack, warnings, err := cmd.Actor.CreateBuildpack(cmd.RequiredArgs.Buildpack, position, !cmd.Disable)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.BuildpackNameTakenError); ok {
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayWarning("Buildpack {{.BuildpackName}} already exists", map[string]interface{}{
+				"BuildpackName": cmd.RequiredArgs.Buildpack,
+			})
+			cmd.UI.DisplayText("TIP: use '{{.CfUpdateBuildpackCommand}}' to update this buildpack", map[string]interface{}{
+				"CfUpdateBuildpackCommand": cmd.Config.BinaryName() + " update-buildpack",
+			})
+			return nil
+		}
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayTextWithFlavor("Uploading buildpack {{.Buildpack}} as {{.CurrentUser}}...", map[string]interface{}{
+		"Buildpack":   cmd.RequiredArgs.Buildpack,
+		"CurrentUser": user.Name,
+	})
+
+	err = uploadBuildpackBits(cmd.UI, cmd.Actor.UploadBuildpack, cmd.ProgressBar, buildpack.GUID, pathToBuildpackBits)
+	if err != nil {
+		if _, ok := err.(v2action.BuildpackAlreadyExistsForStackError); ok {
+			cmd.UI.DisplayWarning(err.Error())
+			cmd.UI.DisplayText("TIP: use '{{.CfUpdateBuildpackCommand}}' to update this buildpack", map[string]interface{}{
+				"CfUpdateBuildpackCommand": cmd.Config.BinaryName() + " update-buildpack",
+			})
+			return nil
+		}
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// uploadBuildpackBits uploads the prepared buildpack bits while displaying
+// the upload progress.
+func uploadBuildpackBits(commandUI command.UI, upload func(string, string, v2action.SimpleProgressBar) (v2action.Warnings, error), progressBar ProgressBar, guid string, pathToBuildpackBits string) error {
+	go progressBar.Ready()
+	warnings, err := upload(guid, pathToBuildpackBits, progressBar)
+	commandUI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	progressBar.Complete()
+	commandUI.DisplayNewline()
+	commandUI.DisplayText("Done uploading")
 	return nil
 }
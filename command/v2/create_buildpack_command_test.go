@@ -0,0 +1,226 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-buildpack Command", func() {
+	var (
+		cmd             CreateBuildpackCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateBuildpackActor
+		fakeProgressBar *v2fakes.FakeProgressBar
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateBuildpackActor)
+		fakeProgressBar = new(v2fakes.FakeProgressBar)
+
+		cmd = CreateBuildpackCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		cmd.RequiredArgs.Buildpack = "some-buildpack"
+		cmd.RequiredArgs.Path = "some-path"
+		cmd.RequiredArgs.Position = "3"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.PrepareBuildpackBitsReturns("some-prepared-path", nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when both --enable and --disable are provided", func() {
+		BeforeEach(func() {
+			cmd.Enable = true
+			cmd.Disable = true
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--enable", "--disable"},
+			}))
+			Expect(fakeActor.CreateBuildpackCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the position is not an integer", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Position = "not-a-number"
+		})
+
+		It("returns a parse argument error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "POSITION",
+				ExpectedType: "integer",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when preparing the buildpack bits fails", func() {
+		BeforeEach(func() {
+			fakeActor.PrepareBuildpackBitsReturns("", v2action.BuildpackZipInvalidError{Path: "some-path"})
+		})
+
+		It("displays a warning and returns the error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.BuildpackZipInvalidError{}))
+			Expect(testUI.Err).To(Say("Failed to create a local temporary zip file for the buildpack"))
+			Expect(fakeActor.CreateBuildpackCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the buildpack name is taken", func() {
+		BeforeEach(func() {
+			fakeActor.CreateBuildpackReturns(v2action.Buildpack{}, v2action.Warnings{"create-warning"}, v2action.BuildpackNameTakenError{Name: "some-buildpack"})
+		})
+
+		It("displays OK, a warning and a tip to use update-buildpack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Creating buildpack some-buildpack as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("TIP: use 'faceman update-buildpack' to update this buildpack"))
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(testUI.Err).To(Say("Buildpack some-buildpack already exists"))
+
+			Expect(fakeActor.UploadBuildpackCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when creating the buildpack fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("create failed")
+			fakeActor.CreateBuildpackReturns(v2action.Buildpack{}, nil, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when the buildpack is created", func() {
+		BeforeEach(func() {
+			fakeActor.CreateBuildpackReturns(v2action.Buildpack{GUID: "some-buildpack-guid"}, v2action.Warnings{"create-warning"}, nil)
+		})
+
+		Context("when the upload succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.UploadBuildpackReturns(v2action.Warnings{"upload-warning"}, nil)
+			})
+
+			It("creates the buildpack and uploads the prepared bits", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Creating buildpack some-buildpack as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Uploading buildpack some-buildpack as some-user..."))
+				Expect(testUI.Out).To(Say("Done uploading"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("create-warning"))
+				Expect(testUI.Err).To(Say("upload-warning"))
+
+				Expect(fakeActor.PrepareBuildpackBitsCallCount()).To(Equal(1))
+				inputPath, tmpDirPath, _ := fakeActor.PrepareBuildpackBitsArgsForCall(0)
+				Expect(inputPath).To(Equal("some-path"))
+				Expect(tmpDirPath).ToNot(BeEmpty())
+
+				Expect(fakeActor.CreateBuildpackCallCount()).To(Equal(1))
+				name, position, enabled := fakeActor.CreateBuildpackArgsForCall(0)
+				Expect(name).To(Equal("some-buildpack"))
+				Expect(position).To(Equal(3))
+				Expect(enabled).To(BeTrue())
+
+				Expect(fakeActor.UploadBuildpackCallCount()).To(Equal(1))
+				guid, path, progressBar := fakeActor.UploadBuildpackArgsForCall(0)
+				Expect(guid).To(Equal("some-buildpack-guid"))
+				Expect(path).To(Equal("some-prepared-path"))
+				Expect(progressBar).To(Equal(fakeProgressBar))
+
+				Eventually(fakeProgressBar.ReadyCallCount).Should(Equal(1))
+				Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+			})
+
+			Context("when --disable is provided", func() {
+				BeforeEach(func() {
+					cmd.Disable = true
+				})
+
+				It("creates a disabled buildpack", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					_, _, enabled := fakeActor.CreateBuildpackArgsForCall(0)
+					Expect(enabled).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when a buildpack with the same name and stack already exists", func() {
+			BeforeEach(func() {
+				fakeActor.UploadBuildpackReturns(nil, v2action.BuildpackAlreadyExistsForStackError{Message: "The buildpack name some-buildpack is already in use for the stack some-stack"})
+			})
+
+			It("displays a warning and a tip to use update-buildpack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Err).To(Say("The buildpack name some-buildpack is already in use for the stack some-stack"))
+				Expect(testUI.Out).To(Say("TIP: use 'faceman update-buildpack' to update this buildpack"))
+			})
+		})
+
+		Context("when the upload fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("upload failed")
+				fakeActor.UploadBuildpackReturns(v2action.Warnings{"upload-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("upload-warning"))
+				Expect(testUI.Out).ToNot(Say("Done uploading"))
+			})
+		})
+	})
+})
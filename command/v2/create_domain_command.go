@@ -1,24 +1,67 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . CreateDomainActor
+
+type CreateDomainActor interface {
+	CreatePrivateDomain(domainName string, orgName string) (v2action.Warnings, error)
+}
+
 type CreateDomainCommand struct {
 	RequiredArgs    flag.OrgDomain `positional-args:"yes"`
 	usage           interface{}    `usage:"CF_NAME create-domain ORG DOMAIN"`
 	relatedCommands interface{}    `related_commands:"create-shared-domain, domains, router-groups, share-private-domain"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateDomainActor
 }
 
-func (CreateDomainCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *CreateDomainCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (CreateDomainCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd CreateDomainCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating domain {{.DomainName}} for org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"DomainName": cmd.RequiredArgs.Domain,
+		"OrgName":    cmd.RequiredArgs.Organization,
+		"Username":   user.Name,
+	})
+
+	warnings, err := cmd.Actor.CreatePrivateDomain(cmd.RequiredArgs.Domain, cmd.RequiredArgs.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
 	return nil
 }
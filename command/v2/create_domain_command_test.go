@@ -0,0 +1,110 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-domain Command", func() {
+	var (
+		cmd             CreateDomainCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateDomainActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateDomainActor)
+
+		cmd = CreateDomainCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Organization = "some-org"
+		cmd.RequiredArgs.Domain = "some-domain.com"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when creating the domain succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.CreatePrivateDomainReturns(v2action.Warnings{"warning-1"}, nil)
+		})
+
+		It("creates the domain in the organization", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Creating domain some-domain\\.com for org some-org as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("warning-1"))
+
+			Expect(fakeActor.CreatePrivateDomainCallCount()).To(Equal(1))
+			domainName, orgName := fakeActor.CreatePrivateDomainArgsForCall(0)
+			Expect(domainName).To(Equal("some-domain.com"))
+			Expect(orgName).To(Equal("some-org"))
+		})
+	})
+
+	Context("when the organization does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.CreatePrivateDomainReturns(v2action.Warnings{"warning-1"}, v2action.OrganizationNotFoundError{Name: "some-org"})
+		})
+
+		It("returns an OrganizationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-org"}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	Context("when creating the domain fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.CreatePrivateDomainReturns(v2action.Warnings{"warning-1"}, expectedErr)
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Out).ToNot(Say("OK"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+})
@@ -3,23 +3,98 @@ package v2
 import (
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . CreateOrgActor
+
+type CreateOrgActor interface {
+	CreateOrganization(orgName string, quotaName string, managerUsername string) (v2action.Organization, v2action.Warnings, error)
+}
+
 type CreateOrgCommand struct {
 	RequiredArgs    flag.Organization `positional-args:"yes"`
 	Quota           string            `short:"q" description:"Quota to assign to the newly created org (excluding this option results in assignment of default quota)"`
-	usage           interface{}       `usage:"CF_NAME create-org ORG"`
+	SeedRoles       bool              `long:"seed-roles" description:"Make the current user an OrgManager of the newly created org"`
+	usage           interface{}       `usage:"CF_NAME create-org ORG [-q QUOTA] [--seed-roles]"`
 	relatedCommands interface{}       `related_commands:"create-space, orgs, quotas, set-org-role"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateOrgActor
 }
 
-func (CreateOrgCommand) Setup(config command.Config, ui command.UI) error {
+// Setup only prepares the actor when roles are seeded; creating an org
+// otherwise is still handled by the legacy implementation.
+func (cmd *CreateOrgCommand) Setup(config command.Config, ui command.UI) error {
+	if !cmd.SeedRoles {
+		return nil
+	}
+
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (CreateOrgCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd CreateOrgCommand) Execute(args []string) error {
+	if !cmd.SeedRoles {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  cmd.RequiredArgs.Organization,
+		"Username": user.Name,
+	})
+
+	org, warnings, err := cmd.Actor.CreateOrganization(cmd.RequiredArgs.Organization, cmd.Quota, user.Name)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.OrganizationNameTakenError); ok {
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayWarning("Org {{.OrgName}} already exists", map[string]interface{}{
+				"OrgName": cmd.RequiredArgs.Organization,
+			})
+			return nil
+		}
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Assigned role {{.Role}} to user {{.Username}} in org {{.OrgName}}.", map[string]interface{}{
+		"Role":     v2action.OrgRoleManager,
+		"Username": user.Name,
+		"OrgName":  org.Name,
+	})
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use '{{.Command}}' to target new org", map[string]interface{}{
+		"Command": cmd.Config.BinaryName() + ` target -o "` + org.Name + `"`,
+	})
+
 	return nil
 }
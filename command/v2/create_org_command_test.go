@@ -0,0 +1,150 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-org Command", func() {
+	var (
+		cmd             CreateOrgCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateOrgActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateOrgActor)
+
+		cmd = CreateOrgCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			SeedRoles:   true,
+		}
+		cmd.RequiredArgs.Organization = "some-org"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.CreateOrganizationReturns(
+			v2action.Organization{GUID: "some-org-guid", Name: "some-org"},
+			v2action.Warnings{"create-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			config, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(config).To(Equal(fakeConfig))
+			Expect(targetedOrganizationRequired).To(BeFalse())
+			Expect(targetedSpaceRequired).To(BeFalse())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-current-user-error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(fakeActor.CreateOrganizationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the org is created", func() {
+		BeforeEach(func() {
+			cmd.Quota = "some-quota"
+		})
+
+		It("creates the org with the quota and makes the current user an OrgManager", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.CreateOrganizationCallCount()).To(Equal(1))
+			orgName, quotaName, managerUsername := fakeActor.CreateOrganizationArgsForCall(0)
+			Expect(orgName).To(Equal("some-org"))
+			Expect(quotaName).To(Equal("some-quota"))
+			Expect(managerUsername).To(Equal("some-user"))
+
+			Expect(testUI.Out).To(Say("Creating org some-org as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Assigned role OrgManager to user some-user in org some-org\\."))
+			Expect(testUI.Out).To(Say(`TIP: Use 'faceman target -o "some-org"' to target new org`))
+			Expect(testUI.Err).To(Say("create-warning"))
+		})
+	})
+
+	Context("when the org already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateOrganizationReturns(v2action.Organization{}, v2action.Warnings{"create-warning"}, v2action.OrganizationNameTakenError{Name: "some-org"})
+		})
+
+		It("warns that the org already exists", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(testUI.Err).To(Say("Org some-org already exists"))
+		})
+	})
+
+	Context("when the quota does not exist", func() {
+		BeforeEach(func() {
+			cmd.Quota = "some-quota"
+			fakeActor.CreateOrganizationReturns(v2action.Organization{}, v2action.Warnings{"quota-warning"}, v2action.OrganizationQuotaNotFoundError{Name: "some-quota"})
+		})
+
+		It("returns an OrganizationQuotaNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.OrganizationQuotaNotFoundError{Name: "some-quota"}))
+			Expect(testUI.Err).To(Say("quota-warning"))
+		})
+	})
+
+	Context("when creating the org fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-create-error")
+			fakeActor.CreateOrganizationReturns(v2action.Organization{}, v2action.Warnings{"create-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("create-warning"))
+		})
+	})
+})
@@ -1,31 +1,132 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/version"
 )
 
+//go:generate counterfeiter . CreateQuotaActor
+
+type CreateQuotaActor interface {
+	CloudControllerAPIVersion() string
+	CreateQuota(quota v2action.OrganizationQuota) (v2action.OrganizationQuota, v2action.Warnings, error)
+}
+
 type CreateQuotaCommand struct {
 	RequiredArgs                flag.Quota               `positional-args:"yes"`
-	NumAppInstances             int                      `short:"a" description:"Total number of application instances. -1 represents an unlimited amount. (Default: unlimited)"`
+	NumAppInstances             flag.Integer             `short:"a" description:"Total number of application instances. -1 represents an unlimited amount. (Default: unlimited)"`
 	AllowPaidServicePlans       bool                     `long:"allow-paid-service-plans" description:"Can provision instances of paid service plans"`
 	IndividualAppInstanceMemory flag.MemoryWithUnlimited `short:"i" description:"Maximum amount of memory an application instance can have (e.g. 1024M, 1G, 10G). -1 represents an unlimited amount."`
-	TotalMemory                 string                   `short:"m" description:"Total amount of memory a space can have (e.g. 1024M, 1G, 10G)"`
-	NumRoutes                   int                      `short:"r" description:"Total number of routes"`
-	ReservedRoutePorts          int                      `long:"reserved-route-ports" description:"Maximum number of routes that may be created with reserved ports (Default: 0)"`
-	NumServiceInstances         int                      `short:"s" description:"Total number of service instances"`
+	TotalMemory                 flag.Megabytes           `short:"m" description:"Total amount of memory a space can have (e.g. 1024M, 1G, 10G)"`
+	NumRoutes                   flag.Integer             `short:"r" description:"Total number of routes"`
+	ReservedRoutePorts          flag.Integer             `long:"reserved-route-ports" description:"Maximum number of routes that may be created with reserved ports (Default: 0)"`
+	NumServiceInstances         flag.Integer             `short:"s" description:"Total number of service instances"`
 	usage                       interface{}              `usage:"CF_NAME create-quota QUOTA [-m TOTAL_MEMORY] [-i INSTANCE_MEMORY] [-r ROUTES] [-s SERVICE_INSTANCES] [-a APP_INSTANCES] [--allow-paid-service-plans] [--reserved-route-ports RESERVED_ROUTE_PORTS]"`
 	relatedCommands             interface{}              `related_commands:"create-org, quotas, set-quota"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateQuotaActor
 }
 
-func (CreateQuotaCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *CreateQuotaCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (CreateQuotaCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd CreateQuotaCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = checkQuotaVersions(cmd.Actor.CloudControllerAPIVersion(), cmd.NumAppInstances, version.MinVersionOrgAppInstanceLimitV2, cmd.ReservedRoutePorts)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating quota {{.QuotaName}} as {{.Username}}...", map[string]interface{}{
+		"QuotaName": cmd.RequiredArgs.Quota,
+		"Username":  user.Name,
+	})
+
+	quota := v2action.OrganizationQuota{
+		Name:                    cmd.RequiredArgs.Quota,
+		NonBasicServicesAllowed: paidServicePlansValue(cmd.AllowPaidServicePlans, false),
+		TotalServices:           cmd.NumServiceInstances.NullInt,
+		TotalRoutes:             cmd.NumRoutes.NullInt,
+		TotalReservedRoutePorts: cmd.ReservedRoutePorts.NullInt,
+		MemoryLimit:             megabytesToNullInt(cmd.TotalMemory),
+		InstanceMemoryLimit:     cmd.IndividualAppInstanceMemory.NullInt,
+		AppInstanceLimit:        cmd.NumAppInstances.NullInt,
+	}
+	_, warnings, err := cmd.Actor.CreateQuota(quota)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.OrganizationQuotaNameTakenError); ok {
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayWarning("Quota Definition {{.QuotaName}} already exists", map[string]interface{}{
+				"QuotaName": cmd.RequiredArgs.Quota,
+			})
+			return nil
+		}
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// checkQuotaVersions returns an error when the app instance limit or reserved
+// route ports are provided to an API that does not support them.
+func checkQuotaVersions(currentVersion string, appInstances flag.Integer, minAppInstancesVersion string, reservedRoutePorts flag.Integer) error {
+	if appInstances.IsSet {
+		err := version.MinimumAPIVersionCheck(currentVersion, minAppInstancesVersion, "Option '-a'")
+		if err != nil {
+			return err
+		}
+	}
+
+	if reservedRoutePorts.IsSet {
+		err := version.MinimumAPIVersionCheck(currentVersion, version.MinVersionReservedRoutePortsV2, "Option '--reserved-route-ports'")
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// megabytesToNullInt converts a memory flag into a quota memory limit.
+func megabytesToNullInt(memory flag.Megabytes) types.NullInt {
+	return types.NullInt{IsSet: memory.IsSet, Value: int(memory.Value)}
+}
+
+// paidServicePlansValue returns whether paid service plans should be allowed,
+// leaving the value unset when neither flag is provided.
+func paidServicePlansValue(allow bool, disallow bool) types.NullBool {
+	if allow || disallow {
+		return types.NullBool{IsSet: true, Value: allow}
+	}
+	return types.NullBool{}
+}
@@ -0,0 +1,156 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-quota Command", func() {
+	var (
+		cmd             CreateQuotaCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateQuotaActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateQuotaActor)
+
+		cmd = CreateQuotaCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.Quota = "some-quota"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionReservedRoutePortsV2)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+			Expect(fakeActor.CreateQuotaCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when -a is provided and the API does not support app instance limits", func() {
+		BeforeEach(func() {
+			cmd.NumAppInstances = flag.Integer{NullInt: types.NullInt{IsSet: true, Value: 10}}
+			fakeActor.CloudControllerAPIVersionReturns("2.32.0")
+		})
+
+		It("returns a minimum version error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '-a'",
+				CurrentVersion: "2.32.0",
+				MinimumVersion: version.MinVersionOrgAppInstanceLimitV2,
+			}))
+		})
+	})
+
+	Context("when --reserved-route-ports is provided and the API does not support them", func() {
+		BeforeEach(func() {
+			cmd.ReservedRoutePorts = flag.Integer{NullInt: types.NullInt{IsSet: true, Value: 5}}
+			fakeActor.CloudControllerAPIVersionReturns("2.54.0")
+		})
+
+		It("returns a minimum version error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '--reserved-route-ports'",
+				CurrentVersion: "2.54.0",
+				MinimumVersion: version.MinVersionReservedRoutePortsV2,
+			}))
+		})
+	})
+
+	Context("when the quota is created", func() {
+		BeforeEach(func() {
+			cmd.AllowPaidServicePlans = true
+			cmd.IndividualAppInstanceMemory = flag.MemoryWithUnlimited{NullInt: types.NullInt{IsSet: true, Value: -1}}
+			cmd.TotalMemory = flag.Megabytes{NullUint64: types.NullUint64{IsSet: true, Value: 2048}}
+			cmd.NumRoutes = flag.Integer{NullInt: types.NullInt{IsSet: true, Value: 10}}
+			cmd.ReservedRoutePorts = flag.Integer{NullInt: types.NullInt{IsSet: true, Value: 2}}
+			fakeActor.CreateQuotaReturns(v2action.OrganizationQuota{GUID: "some-quota-guid"}, v2action.Warnings{"create-warning"}, nil)
+		})
+
+		It("creates the quota with the provided limits", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Creating quota some-quota as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("create-warning"))
+
+			Expect(fakeActor.CreateQuotaCallCount()).To(Equal(1))
+			Expect(fakeActor.CreateQuotaArgsForCall(0)).To(Equal(v2action.OrganizationQuota{
+				Name:                    "some-quota",
+				NonBasicServicesAllowed: types.NullBool{IsSet: true, Value: true},
+				TotalRoutes:             types.NullInt{IsSet: true, Value: 10},
+				TotalReservedRoutePorts: types.NullInt{IsSet: true, Value: 2},
+				MemoryLimit:             types.NullInt{IsSet: true, Value: 2048},
+				InstanceMemoryLimit:     types.NullInt{IsSet: true, Value: -1},
+			}))
+		})
+	})
+
+	Context("when the quota already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateQuotaReturns(v2action.OrganizationQuota{}, v2action.Warnings{"create-warning"}, v2action.OrganizationQuotaNameTakenError{Name: "some-quota"})
+		})
+
+		It("displays OK and a warning", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(testUI.Err).To(Say("Quota Definition some-quota already exists"))
+		})
+	})
+
+	Context("when creating the quota fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("create failed")
+			fakeActor.CreateQuotaReturns(v2action.OrganizationQuota{}, v2action.Warnings{"create-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("create-warning"))
+		})
+	})
+})
@@ -114,38 +114,49 @@ func (cmd CreateRouteCommand) Execute(args []string) error {
 }
 
 func (cmd CreateRouteCommand) minimumFlagVersions() error {
-	ccVersion := cmd.Actor.CloudControllerAPIVersion()
-	if err := version.MinimumAPIVersionCheck(ccVersion, version.MinVersionHTTPRoutePath, "Option '--path'"); cmd.Path != "" && err != nil {
+	return checkRouteFlagVersions(cmd.Actor.CloudControllerAPIVersion(), cmd.Path, cmd.Port, cmd.RandomPort)
+}
+
+func (cmd CreateRouteCommand) validateArguments() error {
+	return validateRouteArguments(cmd.Hostname, cmd.Path, cmd.Port, cmd.RandomPort)
+}
+
+// checkRouteFlagVersions returns an error when a route option is not
+// supported by the targeted Cloud Controller.
+func checkRouteFlagVersions(ccVersion string, path string, port flag.Port, randomPort bool) error {
+	if err := version.MinimumAPIVersionCheck(ccVersion, version.MinVersionHTTPRoutePath, "Option '--path'"); path != "" && err != nil {
 		return err
 	}
-	if err := version.MinimumAPIVersionCheck(ccVersion, version.MinVersionTCPRouting, "Option '--port'"); cmd.Port.IsSet && err != nil {
+	if err := version.MinimumAPIVersionCheck(ccVersion, version.MinVersionTCPRouting, "Option '--port'"); port.IsSet && err != nil {
 		return err
 	}
-	if err := version.MinimumAPIVersionCheck(ccVersion, version.MinVersionTCPRouting, "Option '--random-port'"); cmd.RandomPort && err != nil {
+	if err := version.MinimumAPIVersionCheck(ccVersion, version.MinVersionTCPRouting, "Option '--random-port'"); randomPort && err != nil {
 		return err
 	}
 	return nil
 }
 
-func (cmd CreateRouteCommand) validateArguments() error {
+// validateRouteArguments returns an error when HTTP route options are
+// combined with TCP route options.
+func validateRouteArguments(hostname string, path string, port flag.Port, randomPort bool) error {
 	var failedArgs []string
 
-	if cmd.Hostname != "" {
+	if hostname != "" {
 		failedArgs = append(failedArgs, "--hostname")
 	}
-	if cmd.Path != "" {
+	if path != "" {
 		failedArgs = append(failedArgs, "--path")
 	}
-	if cmd.Port.IsSet {
+	if port.IsSet {
 		failedArgs = append(failedArgs, "--port")
 	}
-	if cmd.RandomPort {
+	if randomPort {
 		failedArgs = append(failedArgs, "--random-port")
 	}
 
 	switch {
-	case (cmd.Hostname != "" || cmd.Path != "") && (cmd.Port.IsSet || cmd.RandomPort),
-		cmd.Port.IsSet && cmd.RandomPort:
+	case (hostname != "" || path != "") && (port.IsSet || randomPort),
+		port.IsSet && randomPort:
 		return translatableerror.ArgumentCombinationError{Args: failedArgs}
 	}
 
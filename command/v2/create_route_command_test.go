@@ -308,6 +308,37 @@ var _ = Describe("Create Route Command", func() {
 				})
 			})
 
+			Context("when the route options do not match the domain type", func() {
+				BeforeEach(func() {
+					cmd.Hostname = "some-host"
+					fakeActor.CreateRouteWithExistenceCheckReturns(
+						v2action.Route{},
+						v2action.Warnings{"create-route-warning"},
+						v2action.InvalidHTTPRouteSettings{Domain: "some-domain"},
+					)
+				})
+
+				It("prints warnings and returns a translatable error", func() {
+					Expect(executeErr).To(MatchError(translatableerror.InvalidHTTPRouteSettings{Domain: "some-domain"}))
+					Expect(testUI.Err).To(Say("create-route-warning"))
+					Expect(testUI.Out).NotTo(Say("OK"))
+				})
+			})
+
+			Context("when a TCP domain is given neither a port nor a random port", func() {
+				BeforeEach(func() {
+					fakeActor.CreateRouteWithExistenceCheckReturns(
+						v2action.Route{},
+						nil,
+						v2action.TCPRouteOptionsNotProvidedError{},
+					)
+				})
+
+				It("returns a translatable error", func() {
+					Expect(executeErr).To(MatchError(translatableerror.TCPRouteOptionsNotProvidedError{}))
+				})
+			})
+
 			Context("when creating route returns a RouteAlreadyExistsError error", func() {
 				BeforeEach(func() {
 					cmd.Hostname = "some-host"
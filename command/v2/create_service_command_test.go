@@ -0,0 +1,237 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-service Command", func() {
+	var (
+		cmd             CreateServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateServiceActor)
+
+		cmd = CreateServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.ServiceOffering = "some-service"
+		cmd.RequiredArgs.ServicePlan = "some-plan"
+		cmd.RequiredArgs.ServiceInstance = "some-instance"
+		cmd.ConfigurationFile = map[string]interface{}{"some-parameter": "some-value"}
+		cmd.Tags = []string{"tag-1", "tag-2"}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.ExperimentalReturns(true)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and an org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		})
+
+		Context("when getting the current user fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("got bananapants??")
+				fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when the service instance is created synchronously", func() {
+			BeforeEach(func() {
+				fakeActor.CreateServiceInstanceReturns(
+					v2action.ServiceInstance{Name: "some-instance"},
+					v2action.ServicePlan{Name: "some-plan", Free: true},
+					v2action.Warnings{"create-warning"},
+					nil,
+				)
+			})
+
+			It("creates the service instance and displays OK", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Creating service instance some-instance in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("create-warning"))
+				Expect(testUI.Out).ToNot(Say("Attention"))
+
+				Expect(fakeActor.CreateServiceInstanceCallCount()).To(Equal(1))
+				spaceGUID, serviceName, planName, instanceName, parameters, tags := fakeActor.CreateServiceInstanceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(serviceName).To(Equal("some-service"))
+				Expect(planName).To(Equal("some-plan"))
+				Expect(instanceName).To(Equal("some-instance"))
+				Expect(parameters).To(Equal(map[string]interface{}{"some-parameter": "some-value"}))
+				Expect(tags).To(Equal([]string{"tag-1", "tag-2"}))
+
+				Expect(fakeActor.PollServiceInstanceLastOperationCallCount()).To(Equal(0))
+			})
+
+			Context("when the plan is not free", func() {
+				BeforeEach(func() {
+					fakeActor.CreateServiceInstanceReturns(
+						v2action.ServiceInstance{Name: "some-instance"},
+						v2action.ServicePlan{Name: "some-plan", Free: false},
+						nil,
+						nil,
+					)
+				})
+
+				It("displays a cost warning", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("Attention: The plan `some-plan` of service `some-service` is not free.  The instance `some-instance` will incur a cost.  Contact your administrator if you think this is in error."))
+				})
+			})
+		})
+
+		Context("when the service instance creation is in progress", func() {
+			var inProgressInstance v2action.ServiceInstance
+
+			BeforeEach(func() {
+				inProgressInstance = v2action.ServiceInstance{
+					Name: "some-instance",
+					LastOperation: ccv2.LastOperation{
+						Type:  "create",
+						State: ccv2.LastOperationInProgress,
+					},
+				}
+				fakeActor.CreateServiceInstanceReturns(inProgressInstance, v2action.ServicePlan{Free: true}, nil, nil)
+			})
+
+			Context("when --wait is not provided", func() {
+				It("displays OK and a hint to check the operation status", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("Create in progress. Use 'faceman services' or 'faceman service some-instance' to check operation status."))
+					Expect(fakeActor.PollServiceInstanceLastOperationCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when --wait is provided", func() {
+				BeforeEach(func() {
+					cmd.Wait = true
+				})
+
+				Context("when the operation succeeds", func() {
+					BeforeEach(func() {
+						fakeActor.PollServiceInstanceLastOperationReturns(
+							v2action.ServiceInstance{Name: "some-instance"},
+							v2action.Warnings{"poll-warning"},
+							nil,
+						)
+					})
+
+					It("waits for the operation and displays OK", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Waiting for the operation to complete..."))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Err).To(Say("poll-warning"))
+
+						Expect(fakeActor.PollServiceInstanceLastOperationCallCount()).To(Equal(1))
+						Expect(fakeActor.PollServiceInstanceLastOperationArgsForCall(0)).To(Equal(inProgressInstance))
+					})
+				})
+
+				Context("when the operation fails", func() {
+					BeforeEach(func() {
+						fakeActor.PollServiceInstanceLastOperationReturns(
+							v2action.ServiceInstance{},
+							nil,
+							v2action.ServiceInstanceOperationFailedError{Name: "some-instance", Operation: "create", Description: "broker exploded"},
+						)
+					})
+
+					It("returns a translatable error", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceOperationFailedError{
+							Name:        "some-instance",
+							Operation:   "create",
+							Description: "broker exploded",
+						}))
+					})
+				})
+			})
+		})
+
+		Context("when the service instance name is taken", func() {
+			BeforeEach(func() {
+				fakeActor.CreateServiceInstanceReturns(v2action.ServiceInstance{}, v2action.ServicePlan{}, v2action.Warnings{"create-warning"}, ccerror.ServiceInstanceNameTakenError{Message: "taken"})
+			})
+
+			It("displays OK and a warning that the instance already exists", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("create-warning"))
+				Expect(testUI.Err).To(Say("Service instance some-instance already exists"))
+			})
+		})
+
+		Context("when the plan cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.CreateServiceInstanceReturns(v2action.ServiceInstance{}, v2action.ServicePlan{}, nil, v2action.ServicePlanNotFoundError{PlanName: "some-plan"})
+			})
+
+			It("returns a translatable error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServicePlanNotFoundError{PlanName: "some-plan"}))
+			})
+		})
+	})
+})
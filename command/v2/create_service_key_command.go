@@ -1,25 +1,76 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . CreateServiceKeyActor
+
+type CreateServiceKeyActor interface {
+	CreateServiceKey(serviceInstanceName string, keyName string, spaceGUID string, parameters map[string]interface{}) (v2action.ServiceKey, v2action.Warnings, error)
+}
+
 type CreateServiceKeyCommand struct {
-	RequiredArgs     flag.ServiceInstanceKey `positional-args:"yes"`
-	ParametersAsJSON flag.Path               `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
-	usage            interface{}             `usage:"CF_NAME create-service-key SERVICE_INSTANCE SERVICE_KEY [-c PARAMETERS_AS_JSON]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line.\n   CF_NAME create-service-key SERVICE_INSTANCE SERVICE_KEY -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. The path to the parameters file can be an absolute or relative path to a file.\n   CF_NAME create-service-key SERVICE_INSTANCE SERVICE_KEY -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"permissions\": \"read-only\"\n   }\n\nEXAMPLES:\n   CF_NAME create-service-key mydb mykey -c '{\"permissions\":\"read-only\"}'\n   CF_NAME create-service-key mydb mykey -c ~/workspace/tmp/instance_config.json"`
-	relatedCommands  interface{}             `related_commands:"service-key"`
+	RequiredArgs     flag.ServiceInstanceKey       `positional-args:"yes"`
+	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
+	usage            interface{}                   `usage:"CF_NAME create-service-key SERVICE_INSTANCE SERVICE_KEY [-c PARAMETERS_AS_JSON]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line.\n   CF_NAME create-service-key SERVICE_INSTANCE SERVICE_KEY -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. The path to the parameters file can be an absolute or relative path to a file.\n   CF_NAME create-service-key SERVICE_INSTANCE SERVICE_KEY -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"permissions\": \"read-only\"\n   }\n\nEXAMPLES:\n   CF_NAME create-service-key mydb mykey -c '{\"permissions\":\"read-only\"}'\n   CF_NAME create-service-key mydb mykey -c ~/workspace/tmp/instance_config.json"`
+	relatedCommands  interface{}                   `related_commands:"service-key"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateServiceKeyActor
 }
 
-func (CreateServiceKeyCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *CreateServiceKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (CreateServiceKeyCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd CreateServiceKeyCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating service key {{.ServiceKeyName}} for service instance {{.ServiceInstanceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ServiceKeyName":      cmd.RequiredArgs.ServiceKey,
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"CurrentUser":         user.Name,
+	})
+
+	_, warnings, err := cmd.Actor.CreateServiceKey(cmd.RequiredArgs.ServiceInstance, cmd.RequiredArgs.ServiceKey, cmd.Config.TargetedSpace().GUID, cmd.ParametersAsJSON)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, isTakenError := err.(v2action.ServiceKeyNameTakenError); isTakenError {
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayWarning("Service key {{.ServiceKeyName}} already exists", map[string]interface{}{
+				"ServiceKeyName": cmd.RequiredArgs.ServiceKey,
+			})
+			return nil
+		}
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
 	return nil
 }
@@ -0,0 +1,130 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-service-key Command", func() {
+	var (
+		cmd             CreateServiceKeyCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateServiceKeyActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateServiceKeyActor)
+
+		cmd = CreateServiceKeyCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+		cmd.RequiredArgs.ServiceKey = "some-key"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when creating the key succeeds", func() {
+		BeforeEach(func() {
+			cmd.ParametersAsJSON = map[string]interface{}{"some-parameter": "some-value"}
+			fakeActor.CreateServiceKeyReturns(v2action.ServiceKey{}, v2action.Warnings{"create-key-warning"}, nil)
+		})
+
+		It("creates the key with the parameters and displays OK and warnings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Creating service key some-key for service instance some-service-instance as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("create-key-warning"))
+
+			Expect(fakeActor.CreateServiceKeyCallCount()).To(Equal(1))
+			serviceInstanceName, keyName, spaceGUID, parameters := fakeActor.CreateServiceKeyArgsForCall(0)
+			Expect(serviceInstanceName).To(Equal("some-service-instance"))
+			Expect(keyName).To(Equal("some-key"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(parameters).To(Equal(map[string]interface{}{"some-parameter": "some-value"}))
+		})
+	})
+
+	Context("when the key already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateServiceKeyReturns(v2action.ServiceKey{}, v2action.Warnings{"create-key-warning"}, v2action.ServiceKeyNameTakenError{Name: "some-key"})
+		})
+
+		It("displays OK and a warning that the key exists", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("create-key-warning"))
+			Expect(testUI.Err).To(Say("Service key some-key already exists"))
+		})
+	})
+
+	Context("when the service instance does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.CreateServiceKeyReturns(v2action.ServiceKey{}, v2action.Warnings{"get-instance-warning"}, v2action.ServiceInstanceNotFoundError{Name: "some-service-instance"})
+		})
+
+		It("returns a translatable error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+			Expect(testUI.Err).To(Say("get-instance-warning"))
+		})
+	})
+})
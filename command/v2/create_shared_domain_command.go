@@ -1,25 +1,102 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/version"
 )
 
+//go:generate counterfeiter . CreateSharedDomainActor
+
+type CreateSharedDomainActor interface {
+	CloudControllerAPIVersion() string
+	CreateSharedDomain(domainName string, routerGroup v2action.RouterGroup, isInternal bool) (v2action.Warnings, error)
+	GetRouterGroupByName(name string) (v2action.RouterGroup, error)
+}
+
 type CreateSharedDomainCommand struct {
 	RequiredArgs    flag.Domain `positional-args:"yes"`
 	RouterGroup     string      `long:"router-group" description:"Routes for this domain will be configured only on the specified router group"`
-	usage           interface{} `usage:"CF_NAME create-shared-domain DOMAIN [--router-group ROUTER_GROUP]"`
+	Internal        bool        `long:"internal" description:"Applications that use internal routes communicate directly on the container network"`
+	usage           interface{} `usage:"CF_NAME create-shared-domain DOMAIN [--router-group ROUTER_GROUP | --internal]"`
 	relatedCommands interface{} `related_commands:"create-domain, domains, router-groups"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateSharedDomainActor
 }
 
-func (CreateSharedDomainCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *CreateSharedDomainCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	actor := v2action.NewActor(ccClient, uaaClient, config)
+
+	if cmd.RouterGroup != "" {
+		routerClient, err := shared.NewRouterClient(ccClient.RoutingEndpoint(), config, uaaClient, ui)
+		if err != nil {
+			return err
+		}
+		actor.RouterClient = routerClient
+	}
+	cmd.Actor = actor
+
 	return nil
 }
 
-func (CreateSharedDomainCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd CreateSharedDomainCommand) Execute(args []string) error {
+	if cmd.RouterGroup != "" && cmd.Internal {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--router-group", "--internal"},
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Internal {
+		err = version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionInternalDomainV2, "Option '--internal'")
+		if err != nil {
+			return err
+		}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var routerGroup v2action.RouterGroup
+	if cmd.RouterGroup != "" {
+		routerGroup, err = cmd.Actor.GetRouterGroupByName(cmd.RouterGroup)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating shared domain {{.DomainName}} as {{.Username}}...", map[string]interface{}{
+		"DomainName": cmd.RequiredArgs.Domain,
+		"Username":   user.Name,
+	})
+
+	warnings, err := cmd.Actor.CreateSharedDomain(cmd.RequiredArgs.Domain, routerGroup, cmd.Internal)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
 	return nil
 }
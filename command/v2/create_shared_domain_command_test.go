@@ -0,0 +1,174 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-shared-domain Command", func() {
+	var (
+		cmd             CreateSharedDomainCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateSharedDomainActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateSharedDomainActor)
+
+		cmd = CreateSharedDomainCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Domain = "some-domain.com"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionInternalDomainV2)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when both --router-group and --internal are provided", func() {
+		BeforeEach(func() {
+			cmd.RouterGroup = "some-router-group"
+			cmd.Internal = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--router-group", "--internal"},
+			}))
+			Expect(fakeActor.CreateSharedDomainCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when no flags are provided", func() {
+		BeforeEach(func() {
+			fakeActor.CreateSharedDomainReturns(v2action.Warnings{"warning-1"}, nil)
+		})
+
+		It("creates the shared domain", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Creating shared domain some-domain\\.com as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("warning-1"))
+
+			Expect(fakeActor.GetRouterGroupByNameCallCount()).To(Equal(0))
+			Expect(fakeActor.CreateSharedDomainCallCount()).To(Equal(1))
+			domainName, routerGroup, isInternal := fakeActor.CreateSharedDomainArgsForCall(0)
+			Expect(domainName).To(Equal("some-domain.com"))
+			Expect(routerGroup).To(Equal(v2action.RouterGroup{}))
+			Expect(isInternal).To(BeFalse())
+		})
+	})
+
+	Context("when --router-group is provided", func() {
+		BeforeEach(func() {
+			cmd.RouterGroup = "some-router-group"
+		})
+
+		Context("when the router group exists", func() {
+			BeforeEach(func() {
+				fakeActor.GetRouterGroupByNameReturns(v2action.RouterGroup{GUID: "some-router-group-guid", Name: "some-router-group"}, nil)
+			})
+
+			It("creates the shared domain on the router group", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetRouterGroupByNameArgsForCall(0)).To(Equal("some-router-group"))
+				_, routerGroup, _ := fakeActor.CreateSharedDomainArgsForCall(0)
+				Expect(routerGroup).To(Equal(v2action.RouterGroup{GUID: "some-router-group-guid", Name: "some-router-group"}))
+			})
+		})
+
+		Context("when the router group does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetRouterGroupByNameReturns(v2action.RouterGroup{}, v2action.RouterGroupNotFoundError{Name: "some-router-group"})
+			})
+
+			It("returns a RouterGroupNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RouterGroupNotFoundError{Name: "some-router-group"}))
+				Expect(fakeActor.CreateSharedDomainCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when --internal is provided", func() {
+		BeforeEach(func() {
+			cmd.Internal = true
+		})
+
+		It("creates an internal shared domain", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, _, isInternal := fakeActor.CreateSharedDomainArgsForCall(0)
+			Expect(isInternal).To(BeTrue())
+		})
+
+		Context("when the API does not support internal domains", func() {
+			BeforeEach(func() {
+				fakeActor.CloudControllerAPIVersionReturns("2.114.0")
+			})
+
+			It("returns a minimum version error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+					Command:        "Option '--internal'",
+					CurrentVersion: "2.114.0",
+					MinimumVersion: version.MinVersionInternalDomainV2,
+				}))
+				Expect(fakeActor.CreateSharedDomainCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when creating the domain fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.CreateSharedDomainReturns(v2action.Warnings{"warning-1"}, expectedErr)
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+})
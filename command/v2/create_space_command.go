@@ -1,26 +1,236 @@
 package v2
 
 import (
+	"fmt"
 	"os"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
 )
 
+//go:generate counterfeiter . CreateSpaceActor
+
+type CreateSpaceActor interface {
+	CloudControllerAPIVersion() string
+	CreateSpaceFromTemplate(spaceName string, orgGUID string, template v2action.SpaceTemplate) (v2action.Space, v2action.Warnings, error)
+	DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (v2action.Warnings, error)
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . CreateSpaceActorV3
+
+type CreateSpaceActorV3 interface {
+	AssignIsolationSegmentToSpaceByNameAndSpace(isolationSegmentName string, spaceGUID string) (v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+}
+
 type CreateSpaceCommand struct {
-	RequiredArgs    flag.Space  `positional-args:"yes"`
-	Organization    string      `short:"o" description:"Organization"`
-	Quota           string      `short:"q" description:"Quota to assign to the newly created space"`
-	usage           interface{} `usage:"CF_NAME create-space SPACE [-o ORG] [-q SPACE_QUOTA]"`
-	relatedCommands interface{} `related_commands:"set-space-isolation-segment, space-quotas, spaces, target"`
+	RequiredArgs    flag.Space                  `positional-args:"yes"`
+	Organization    string                      `short:"o" description:"Organization"`
+	Quota           string                      `short:"q" long:"quota" description:"Quota to assign to the newly created space"`
+	FromTemplate    flag.PathWithExistenceCheck `long:"from-template" description:"Path to a YAML space template declaring the quota, isolation segment, security groups and roles to apply"`
+	SeedRoles       bool                        `long:"seed-roles" description:"Make the current user a SpaceManager and SpaceDeveloper of the newly created space"`
+	usage           interface{}                 `usage:"CF_NAME create-space SPACE [-o ORG] [-q SPACE_QUOTA] [--seed-roles] [--from-template TEMPLATE_FILE]\n\nTEMPLATE FILE:\n   quota: small\n   isolation_segment: segment-1\n   security_groups:\n   - name: public-networks\n     lifecycle: both\n   roles:\n   - username: alice@example.com\n     role: SpaceDeveloper"`
+	relatedCommands interface{}                 `related_commands:"set-space-isolation-segment, space-quotas, spaces, target"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateSpaceActor
+	ActorV3     CreateSpaceActorV3
 }
 
-func (CreateSpaceCommand) Setup(config command.Config, ui command.UI) error {
+// Setup only prepares the actors when a template is given or roles are
+// seeded; creating a space otherwise is still handled by the legacy
+// implementation.
+func (cmd *CreateSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	if !cmd.refactored() {
+		return nil
+	}
+
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, nil, config)
+	}
+
 	return nil
 }
 
-func (CreateSpaceCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd CreateSpaceCommand) Execute(args []string) error {
+	if !cmd.refactored() {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.createSpace()
+	if err != nil {
+		return shared.HandleError(err)
+	}
 	return nil
 }
+
+func (cmd CreateSpaceCommand) refactored() bool {
+	return cmd.FromTemplate != "" || cmd.SeedRoles
+}
+
+func (cmd CreateSpaceCommand) createSpace() error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, cmd.Organization == "", false)
+	if err != nil {
+		return err
+	}
+
+	var template v2action.SpaceTemplate
+	if cmd.FromTemplate != "" {
+		template, err = v2action.ReadSpaceTemplate(string(cmd.FromTemplate))
+		if err != nil {
+			return err
+		}
+	}
+	if cmd.Quota != "" {
+		template.Quota = cmd.Quota
+	}
+
+	if template.IsolationSegment != "" {
+		err = cmd.checkIsolationSegmentSupport()
+		if err != nil {
+			return err
+		}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.SeedRoles {
+		template = template.WithSeededRoles(user.Name)
+	}
+
+	orgName := cmd.Config.TargetedOrganization().Name
+	orgGUID := cmd.Config.TargetedOrganization().GUID
+	if cmd.Organization != "" {
+		org, warnings, orgErr := cmd.Actor.GetOrganizationByName(cmd.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if orgErr != nil {
+			return orgErr
+		}
+		orgName = org.Name
+		orgGUID = org.GUID
+	}
+
+	if cmd.FromTemplate != "" {
+		cmd.UI.DisplayTextWithFlavor("Creating space {{.SpaceName}} in org {{.OrgName}} from template {{.TemplatePath}} as {{.CurrentUser}}...", map[string]interface{}{
+			"SpaceName":    cmd.RequiredArgs.Space,
+			"OrgName":      orgName,
+			"TemplatePath": cmd.FromTemplate,
+			"CurrentUser":  user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Creating space {{.SpaceName}} in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"SpaceName":   cmd.RequiredArgs.Space,
+			"OrgName":     orgName,
+			"CurrentUser": user.Name,
+		})
+	}
+
+	space, warnings, err := cmd.Actor.CreateSpaceFromTemplate(cmd.RequiredArgs.Space, orgGUID, template)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.SpaceNameTakenError); ok {
+			if cmd.FromTemplate != "" {
+				cmd.UI.DisplayWarning("Space {{.SpaceName}} already exists; the template was not applied.", map[string]interface{}{
+					"SpaceName": cmd.RequiredArgs.Space,
+				})
+			} else {
+				cmd.UI.DisplayWarning("Space {{.SpaceName}} already exists", map[string]interface{}{
+					"SpaceName": cmd.RequiredArgs.Space,
+				})
+			}
+			return nil
+		}
+		return err
+	}
+
+	if template.IsolationSegment != "" {
+		v3Warnings, assignErr := cmd.ActorV3.AssignIsolationSegmentToSpaceByNameAndSpace(template.IsolationSegment, space.GUID)
+		cmd.UI.DisplayWarnings(v3Warnings)
+		if assignErr != nil {
+			warnings, rollbackErr := cmd.Actor.DeleteSpaceByNameAndOrganizationName(space.Name, orgName)
+			cmd.UI.DisplayWarnings(warnings)
+			if rollbackErr != nil {
+				cmd.UI.DisplayWarning("Unable to delete space {{.SpaceName}} after the template failed to apply: {{.Error}}", map[string]interface{}{
+					"SpaceName": space.Name,
+					"Error":     rollbackErr,
+				})
+			}
+			return sharedV3.HandleError(assignErr)
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.displaySummary(space, template)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use '{{.CFTargetCommand}}' to target new space", map[string]interface{}{
+		"CFTargetCommand": fmt.Sprintf(`%s target -o "%s" -s "%s"`, cmd.Config.BinaryName(), orgName, space.Name),
+	})
+
+	return nil
+}
+
+func (cmd CreateSpaceCommand) checkIsolationSegmentSupport() error {
+	if cmd.ActorV3 == nil {
+		return translatableerror.MinimumAPIVersionNotMetError{
+			CurrentVersion: cmd.Actor.CloudControllerAPIVersion(),
+			MinimumVersion: version.MinVersionIsolationSegmentV3,
+		}
+	}
+	return version.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), version.MinVersionIsolationSegmentV3)
+}
+
+func (cmd CreateSpaceCommand) displaySummary(space v2action.Space, template v2action.SpaceTemplate) {
+	var securityGroups []string
+	for _, securityGroup := range template.SecurityGroups {
+		var lifecycles []string
+		for _, lifecycle := range securityGroup.Lifecycles() {
+			lifecycles = append(lifecycles, string(lifecycle))
+		}
+		securityGroups = append(securityGroups, fmt.Sprintf("%s (%s)", securityGroup.Name, strings.Join(lifecycles, ", ")))
+	}
+
+	var roles []string
+	for _, role := range template.Roles {
+		roles = append(roles, fmt.Sprintf("%s (%s)", role.Username, role.Role))
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("space:"), space.Name},
+		{cmd.UI.TranslateText("quota:"), template.Quota},
+		{cmd.UI.TranslateText("isolation segment:"), template.IsolationSegment},
+		{cmd.UI.TranslateText("security groups:"), strings.Join(securityGroups, ", ")},
+		{cmd.UI.TranslateText("roles:"), strings.Join(roles, ", ")},
+	}, 3)
+}
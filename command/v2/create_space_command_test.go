@@ -0,0 +1,320 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-space Command", func() {
+	var (
+		cmd             CreateSpaceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateSpaceActor
+		fakeActorV3     *v2fakes.FakeCreateSpaceActorV3
+		binaryName      string
+		templatePath    string
+		rawTemplate     string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateSpaceActor)
+		fakeActorV3 = new(v2fakes.FakeCreateSpaceActorV3)
+
+		tmpFile, err := ioutil.TempFile("", "space-template")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tmpFile.Close()).To(Succeed())
+		templatePath = tmpFile.Name()
+
+		cmd = CreateSpaceCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+			ActorV3:      fakeActorV3,
+			FromTemplate: flag.PathWithExistenceCheck(templatePath),
+		}
+		cmd.RequiredArgs.Space = "some-space"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			GUID: "some-org-guid",
+			Name: "some-org",
+		})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActorV3.CloudControllerAPIVersionReturns(version.MinVersionIsolationSegmentV3)
+
+		rawTemplate = `---
+quota: some-quota
+security_groups:
+- name: sg-1
+- name: sg-2
+  lifecycle: both
+roles:
+- username: alice
+  role: SpaceDeveloper
+`
+		fakeActor.CreateSpaceFromTemplateReturns(
+			v2action.Space{GUID: "some-space-guid", Name: "some-space"},
+			v2action.Warnings{"create-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		Expect(ioutil.WriteFile(templatePath, []byte(rawTemplate), 0600)).To(Succeed())
+		executeErr = cmd.Execute(nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(templatePath)).To(Succeed())
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			config, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(config).To(Equal(fakeConfig))
+			Expect(targetedOrganizationRequired).To(BeTrue())
+			Expect(targetedSpaceRequired).To(BeFalse())
+		})
+	})
+
+	Context("when the template is invalid", func() {
+		BeforeEach(func() {
+			rawTemplate = "roles:\n- username: alice\n  role: Owner\n"
+		})
+
+		It("returns an InvalidSpaceTemplateError without creating the space", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidSpaceTemplateError{Reason: "user 'alice' has invalid role 'Owner'"}))
+			Expect(fakeActor.CreateSpaceFromTemplateCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the template is applied", func() {
+		It("creates the space in the targeted org and displays a summary", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.CreateSpaceFromTemplateCallCount()).To(Equal(1))
+			spaceName, orgGUID, template := fakeActor.CreateSpaceFromTemplateArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(template.Quota).To(Equal("some-quota"))
+
+			Expect(testUI.Out).To(Say("Creating space some-space in org some-org from template %s as some-user\\.\\.\\.", templatePath))
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`space:\s+some-space`))
+			Expect(testUI.Out).To(Say(`quota:\s+some-quota`))
+			Expect(testUI.Out).To(Say(`security groups:\s+sg-1 \(running\), sg-2 \(running, staging\)`))
+			Expect(testUI.Out).To(Say(`roles:\s+alice \(SpaceDeveloper\)`))
+			Expect(testUI.Out).To(Say(`TIP: Use 'faceman target -o "some-org" -s "some-space"' to target new space`))
+
+			Expect(fakeActorV3.AssignIsolationSegmentToSpaceByNameAndSpaceCallCount()).To(Equal(0))
+		})
+
+		Context("when a quota is provided with -q", func() {
+			BeforeEach(func() {
+				cmd.Quota = "other-quota"
+			})
+
+			It("overrides the quota in the template", func() {
+				_, _, template := fakeActor.CreateSpaceFromTemplateArgsForCall(0)
+				Expect(template.Quota).To(Equal("other-quota"))
+			})
+		})
+
+		Context("when an org is provided with -o", func() {
+			BeforeEach(func() {
+				cmd.Organization = "other-org"
+				fakeActor.GetOrganizationByNameReturns(
+					v2action.Organization{GUID: "other-org-guid", Name: "other-org"},
+					v2action.Warnings{"org-warning"},
+					nil,
+				)
+			})
+
+			It("creates the space in that org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, targetedOrganizationRequired, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(targetedOrganizationRequired).To(BeFalse())
+
+				Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("other-org"))
+				_, orgGUID, _ := fakeActor.CreateSpaceFromTemplateArgsForCall(0)
+				Expect(orgGUID).To(Equal("other-org-guid"))
+				Expect(testUI.Err).To(Say("org-warning"))
+				Expect(testUI.Out).To(Say("Creating space some-space in org other-org"))
+			})
+		})
+	})
+
+	Context("when --seed-roles is provided", func() {
+		BeforeEach(func() {
+			cmd.SeedRoles = true
+		})
+
+		It("also grants the current user the SpaceManager and SpaceDeveloper roles", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, _, template := fakeActor.CreateSpaceFromTemplateArgsForCall(0)
+			Expect(template.Roles).To(Equal([]v2action.SpaceTemplateRole{
+				{Username: "alice", Role: v2action.SpaceRoleDeveloper},
+				{Username: "some-user", Role: v2action.SpaceRoleManager},
+				{Username: "some-user", Role: v2action.SpaceRoleDeveloper},
+			}))
+		})
+
+		Context("when no template is provided", func() {
+			BeforeEach(func() {
+				cmd.FromTemplate = ""
+				cmd.Quota = "some-quota"
+			})
+
+			It("creates the space with the quota and seeded roles", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.CreateSpaceFromTemplateCallCount()).To(Equal(1))
+				spaceName, orgGUID, template := fakeActor.CreateSpaceFromTemplateArgsForCall(0)
+				Expect(spaceName).To(Equal("some-space"))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(template).To(Equal(v2action.SpaceTemplate{
+					Quota: "some-quota",
+					Roles: []v2action.SpaceTemplateRole{
+						{Username: "some-user", Role: v2action.SpaceRoleManager},
+						{Username: "some-user", Role: v2action.SpaceRoleDeveloper},
+					},
+				}))
+
+				Expect(testUI.Out).To(Say("Creating space some-space in org some-org as some-user\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`quota:\s+some-quota`))
+				Expect(testUI.Out).To(Say(`roles:\s+some-user \(SpaceManager\), some-user \(SpaceDeveloper\)`))
+				Expect(testUI.Out).To(Say(`TIP: Use 'faceman target -o "some-org" -s "some-space"' to target new space`))
+			})
+
+			Context("when the space already exists", func() {
+				BeforeEach(func() {
+					fakeActor.CreateSpaceFromTemplateReturns(v2action.Space{}, nil, v2action.SpaceNameTakenError{Name: "some-space"})
+				})
+
+				It("warns that the space already exists", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("Space some-space already exists"))
+					Expect(testUI.Err).ToNot(Say("the template was not applied"))
+				})
+			})
+		})
+	})
+
+	Context("when the space already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateSpaceFromTemplateReturns(v2action.Space{}, v2action.Warnings{"create-warning"}, v2action.SpaceNameTakenError{Name: "some-space"})
+		})
+
+		It("warns that the template was not applied", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(testUI.Err).To(Say("Space some-space already exists; the template was not applied."))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	Context("when applying the template fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateSpaceFromTemplateReturns(v2action.Space{}, v2action.Warnings{"create-warning"}, v2action.SpaceQuotaNotFoundError{Name: "some-quota"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SpaceQuotaNotFoundError{Name: "some-quota"}))
+			Expect(testUI.Err).To(Say("create-warning"))
+		})
+	})
+
+	Context("when the template declares an isolation segment", func() {
+		BeforeEach(func() {
+			rawTemplate = "isolation_segment: some-iso-seg\n"
+		})
+
+		It("assigns the isolation segment to the new space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActorV3.AssignIsolationSegmentToSpaceByNameAndSpaceCallCount()).To(Equal(1))
+			isoSegName, spaceGUID := fakeActorV3.AssignIsolationSegmentToSpaceByNameAndSpaceArgsForCall(0)
+			Expect(isoSegName).To(Equal("some-iso-seg"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(testUI.Out).To(Say(`isolation segment:\s+some-iso-seg`))
+		})
+
+		Context("when the API does not support isolation segments", func() {
+			BeforeEach(func() {
+				fakeActorV3.CloudControllerAPIVersionReturns("3.0.0")
+			})
+
+			It("returns a MinimumAPIVersionNotMetError without creating the space", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+					CurrentVersion: "3.0.0",
+					MinimumVersion: version.MinVersionIsolationSegmentV3,
+				}))
+				Expect(fakeActor.CreateSpaceFromTemplateCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when assigning the isolation segment fails", func() {
+			BeforeEach(func() {
+				fakeActorV3.AssignIsolationSegmentToSpaceByNameAndSpaceReturns(v3action.Warnings{"iso-warning"}, v3action.IsolationSegmentNotFoundError{Name: "some-iso-seg"})
+				fakeActor.DeleteSpaceByNameAndOrganizationNameReturns(v2action.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the space and returns the error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.IsolationSegmentNotFoundError{Name: "some-iso-seg"}))
+				Expect(testUI.Err).To(Say("iso-warning"))
+				Expect(testUI.Err).To(Say("delete-warning"))
+
+				Expect(fakeActor.DeleteSpaceByNameAndOrganizationNameCallCount()).To(Equal(1))
+				spaceName, orgName := fakeActor.DeleteSpaceByNameAndOrganizationNameArgsForCall(0)
+				Expect(spaceName).To(Equal("some-space"))
+				Expect(orgName).To(Equal("some-org"))
+			})
+
+			Context("when deleting the space also fails", func() {
+				BeforeEach(func() {
+					fakeActor.DeleteSpaceByNameAndOrganizationNameReturns(nil, errors.New("delete failed"))
+				})
+
+				It("warns that the space was left behind", func() {
+					Expect(executeErr).To(MatchError(translatableerror.IsolationSegmentNotFoundError{Name: "some-iso-seg"}))
+					Expect(testUI.Err).To(Say("Unable to delete space some-space after the template failed to apply: delete failed"))
+				})
+			})
+		})
+	})
+})
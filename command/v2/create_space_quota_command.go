@@ -1,31 +1,98 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/version"
 )
 
+//go:generate counterfeiter . CreateSpaceQuotaActor
+
+type CreateSpaceQuotaActor interface {
+	CloudControllerAPIVersion() string
+	CreateSpaceQuota(orgGUID string, quota v2action.SpaceQuota) (v2action.SpaceQuota, v2action.Warnings, error)
+}
+
 type CreateSpaceQuotaCommand struct {
 	RequiredArgs                flag.SpaceQuota          `positional-args:"yes"`
-	NumAppInstances             int                      `short:"a" description:"Total number of application instances. -1 represents an unlimited amount. (Default: unlimited)"`
+	NumAppInstances             flag.Integer             `short:"a" description:"Total number of application instances. -1 represents an unlimited amount. (Default: unlimited)"`
 	AllowPaidServicePlans       bool                     `long:"allow-paid-service-plans" description:"Can provision instances of paid service plans (Default: disallowed)"`
 	IndividualAppInstanceMemory flag.MemoryWithUnlimited `short:"i" description:"Maximum amount of memory an application instance can have (e.g. 1024M, 1G, 10G). -1 represents an unlimited amount. (Default: unlimited)"`
-	TotalMemory                 string                   `short:"m" description:"Total amount of memory a space can have (e.g. 1024M, 1G, 10G)"`
-	NumRoutes                   int                      `short:"r" description:"Total number of routes"`
-	ReservedRoutePorts          int                      `long:"reserved-route-ports" description:"Maximum number of routes that may be created with reserved ports (Default: 0)"`
-	NumServiceInstances         int                      `short:"s" description:"Total number of service instances"`
+	TotalMemory                 flag.Megabytes           `short:"m" description:"Total amount of memory a space can have (e.g. 1024M, 1G, 10G)"`
+	NumRoutes                   flag.Integer             `short:"r" description:"Total number of routes"`
+	ReservedRoutePorts          flag.Integer             `long:"reserved-route-ports" description:"Maximum number of routes that may be created with reserved ports (Default: 0)"`
+	NumServiceInstances         flag.Integer             `short:"s" description:"Total number of service instances"`
 	usage                       interface{}              `usage:"CF_NAME create-space-quota QUOTA [-i INSTANCE_MEMORY] [-m MEMORY] [-r ROUTES] [-s SERVICE_INSTANCES] [-a APP_INSTANCES] [--allow-paid-service-plans] [--reserved-route-ports RESERVED_ROUTE_PORTS]"`
 	relatedCommands             interface{}              `related_commands:"quotas, space-quotas"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateSpaceQuotaActor
 }
 
-func (CreateSpaceQuotaCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *CreateSpaceQuotaCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (CreateSpaceQuotaCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd CreateSpaceQuotaCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = checkQuotaVersions(cmd.Actor.CloudControllerAPIVersion(), cmd.NumAppInstances, version.MinVersionSpaceAppInstanceLimitV2, cmd.ReservedRoutePorts)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	org := cmd.Config.TargetedOrganization()
+	cmd.UI.DisplayTextWithFlavor("Creating space quota {{.QuotaName}} for org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"QuotaName": cmd.RequiredArgs.SpaceQuota,
+		"OrgName":   org.Name,
+		"Username":  user.Name,
+	})
+
+	_, warnings, err := cmd.Actor.CreateSpaceQuota(org.GUID, v2action.SpaceQuota{
+		Name:                    cmd.RequiredArgs.SpaceQuota,
+		NonBasicServicesAllowed: paidServicePlansValue(cmd.AllowPaidServicePlans, false),
+		TotalServices:           cmd.NumServiceInstances.NullInt,
+		TotalRoutes:             cmd.NumRoutes.NullInt,
+		TotalReservedRoutePorts: cmd.ReservedRoutePorts.NullInt,
+		MemoryLimit:             megabytesToNullInt(cmd.TotalMemory),
+		InstanceMemoryLimit:     cmd.IndividualAppInstanceMemory.NullInt,
+		AppInstanceLimit:        cmd.NumAppInstances.NullInt,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.SpaceQuotaNameTakenError); ok {
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayWarning("Space Quota Definition {{.QuotaName}} already exists", map[string]interface{}{
+				"QuotaName": cmd.RequiredArgs.SpaceQuota,
+			})
+			return nil
+		}
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
 	return nil
 }
@@ -0,0 +1,120 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-space-quota Command", func() {
+	var (
+		cmd             CreateSpaceQuotaCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateSpaceQuotaActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateSpaceQuotaActor)
+
+		cmd = CreateSpaceQuotaCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.SpaceQuota = "some-space-quota"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionReservedRoutePortsV2)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when -a is provided and the API does not support space app instance limits", func() {
+		BeforeEach(func() {
+			cmd.NumAppInstances = flag.Integer{NullInt: types.NullInt{IsSet: true, Value: 10}}
+			fakeActor.CloudControllerAPIVersionReturns("2.39.0")
+		})
+
+		It("returns a minimum version error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '-a'",
+				CurrentVersion: "2.39.0",
+				MinimumVersion: version.MinVersionSpaceAppInstanceLimitV2,
+			}))
+		})
+	})
+
+	Context("when the space quota is created", func() {
+		BeforeEach(func() {
+			cmd.NumAppInstances = flag.Integer{NullInt: types.NullInt{IsSet: true, Value: 10}}
+			cmd.IndividualAppInstanceMemory = flag.MemoryWithUnlimited{NullInt: types.NullInt{IsSet: true, Value: 512}}
+			fakeActor.CreateSpaceQuotaReturns(v2action.SpaceQuota{}, v2action.Warnings{"create-warning"}, nil)
+		})
+
+		It("creates the space quota in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Creating space quota some-space-quota for org some-org as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("create-warning"))
+
+			Expect(fakeActor.CreateSpaceQuotaCallCount()).To(Equal(1))
+			orgGUID, quota := fakeActor.CreateSpaceQuotaArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(quota).To(Equal(v2action.SpaceQuota{
+				Name:                "some-space-quota",
+				InstanceMemoryLimit: types.NullInt{IsSet: true, Value: 512},
+				AppInstanceLimit:    types.NullInt{IsSet: true, Value: 10},
+			}))
+		})
+	})
+
+	Context("when the space quota already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateSpaceQuotaReturns(v2action.SpaceQuota{}, nil, v2action.SpaceQuotaNameTakenError{Name: "some-space-quota"})
+		})
+
+		It("displays OK and a warning", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("Space Quota Definition some-space-quota already exists"))
+		})
+	})
+})
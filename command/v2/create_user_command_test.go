@@ -163,6 +163,22 @@ var _ = Describe("create-user Command", func() {
 				})
 			})
 
+			Context("when the password violates the password policy", func() {
+				BeforeEach(func() {
+					fakeActor.CreateUserReturns(
+						v2action.User{},
+						nil,
+						uaa.InvalidPasswordError{Message: "Password must be at least 8 characters in length."})
+				})
+
+				It("returns an InvalidPasswordError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.InvalidPasswordError{
+						Message: "Password must be at least 8 characters in length.",
+					}))
+					Expect(testUI.Out).To(Say("Error creating user some-user\\."))
+				})
+			})
+
 			Context("when the error is a uaa.ConflictError", func() {
 				var returnedErr error
 
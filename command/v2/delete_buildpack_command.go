@@ -1,25 +1,106 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/version"
 )
 
+//go:generate counterfeiter . DeleteBuildpackActor
+
+type DeleteBuildpackActor interface {
+	CloudControllerAPIVersion() string
+	DeleteBuildpackByNameAndStack(name string, stack string) (v2action.Warnings, error)
+}
+
 type DeleteBuildpackCommand struct {
 	RequiredArgs    flag.BuildpackName `positional-args:"yes"`
 	Force           bool               `short:"f" description:"Force deletion without confirmation"`
-	usage           interface{}        `usage:"CF_NAME delete-buildpack BUILDPACK [-f]"`
+	Stack           string             `short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
+	usage           interface{}        `usage:"CF_NAME delete-buildpack BUILDPACK [-f] [-s STACK]"`
 	relatedCommands interface{}        `related_commands:"buildpacks"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DeleteBuildpackActor
 }
 
-func (DeleteBuildpackCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *DeleteBuildpackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (DeleteBuildpackCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd DeleteBuildpackCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Stack != "" {
+		err = version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionBuildpackStackAssociationV2, "Option '-s'")
+		if err != nil {
+			return err
+		}
+	}
+
+	if !cmd.Force {
+		deleteBuildpack, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the buildpack {{.BuildpackName}}?", map[string]interface{}{
+			"BuildpackName": cmd.RequiredArgs.Buildpack,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteBuildpack {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Stack == "" {
+		cmd.UI.DisplayTextWithFlavor("Deleting buildpack {{.BuildpackName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"BuildpackName": cmd.RequiredArgs.Buildpack,
+			"CurrentUser":   user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Deleting buildpack {{.BuildpackName}} with stack {{.Stack}} as {{.CurrentUser}}...", map[string]interface{}{
+			"BuildpackName": cmd.RequiredArgs.Buildpack,
+			"Stack":         cmd.Stack,
+			"CurrentUser":   user.Name,
+		})
+	}
+
+	warnings, err := cmd.Actor.DeleteBuildpackByNameAndStack(cmd.RequiredArgs.Buildpack, cmd.Stack)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.BuildpackNotFoundError); ok {
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayWarning("Buildpack {{.BuildpackName}} does not exist.", map[string]interface{}{
+				"BuildpackName": cmd.RequiredArgs.Buildpack,
+			})
+			return nil
+		}
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
 	return nil
 }
@@ -0,0 +1,176 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-buildpack Command", func() {
+	var (
+		cmd             DeleteBuildpackCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDeleteBuildpackActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDeleteBuildpackActor)
+
+		cmd = DeleteBuildpackCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.Buildpack = "some-buildpack"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionBuildpackStackAssociationV2)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when -s is provided and the API does not support stack association", func() {
+		BeforeEach(func() {
+			cmd.Stack = "some-stack"
+			fakeActor.CloudControllerAPIVersionReturns("2.111.0")
+		})
+
+		It("returns a minimum version error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '-s'",
+				CurrentVersion: "2.111.0",
+				MinimumVersion: version.MinVersionBuildpackStackAssociationV2,
+			}))
+		})
+	})
+
+	Context("when the user declines the prompt", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not delete the buildpack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Really delete the buildpack some-buildpack\?`))
+			Expect(testUI.Out).To(Say("Delete cancelled"))
+			Expect(fakeActor.DeleteBuildpackByNameAndStackCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the user confirms the prompt", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+			fakeActor.DeleteBuildpackByNameAndStackReturns(v2action.Warnings{"delete-warning"}, nil)
+		})
+
+		It("deletes the buildpack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Deleting buildpack some-buildpack as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("delete-warning"))
+
+			Expect(fakeActor.DeleteBuildpackByNameAndStackCallCount()).To(Equal(1))
+			name, stack := fakeActor.DeleteBuildpackByNameAndStackArgsForCall(0)
+			Expect(name).To(Equal("some-buildpack"))
+			Expect(stack).To(BeEmpty())
+		})
+	})
+
+	Context("when -f is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		Context("when -s is provided", func() {
+			BeforeEach(func() {
+				cmd.Stack = "some-stack"
+			})
+
+			It("deletes the buildpack with that stack without prompting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Really delete"))
+				Expect(testUI.Out).To(Say("Deleting buildpack some-buildpack with stack some-stack as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+
+				_, stack := fakeActor.DeleteBuildpackByNameAndStackArgsForCall(0)
+				Expect(stack).To(Equal("some-stack"))
+			})
+		})
+
+		Context("when the buildpack does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteBuildpackByNameAndStackReturns(v2action.Warnings{"delete-warning"}, v2action.BuildpackNotFoundError{BuildpackName: "some-buildpack"})
+			})
+
+			It("displays OK and a warning", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("delete-warning"))
+				Expect(testUI.Err).To(Say("Buildpack some-buildpack does not exist."))
+			})
+		})
+
+		Context("when several buildpacks share the name", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteBuildpackByNameAndStackReturns(nil, v2action.MultipleBuildpacksFoundError{BuildpackName: "some-buildpack"})
+			})
+
+			It("returns a translatable error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MultipleBuildpacksFoundError{BuildpackName: "some-buildpack"}))
+			})
+		})
+
+		Context("when the delete fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete failed")
+				fakeActor.DeleteBuildpackByNameAndStackReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
@@ -1,25 +1,88 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . DeleteDomainActor
+
+type DeleteDomainActor interface {
+	DeleteDomain(domainName string, orgGUID string) (v2action.Warnings, error)
+}
+
 type DeleteDomainCommand struct {
 	RequiredArgs    flag.Domain `positional-args:"yes"`
 	Force           bool        `short:"f" description:"Force deletion without confirmation"`
 	usage           interface{} `usage:"CF_NAME delete-domain DOMAIN [-f]"`
 	relatedCommands interface{} `related_commands:"delete-shared-domain, domains, unshare-private-domain"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DeleteDomainActor
 }
 
-func (DeleteDomainCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *DeleteDomainCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (DeleteDomainCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd DeleteDomainCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !cmd.Force {
+		deleteDomain, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the domain {{.DomainName}}?", map[string]interface{}{
+			"DomainName": cmd.RequiredArgs.Domain,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteDomain {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting domain {{.DomainName}} as {{.Username}}...", map[string]interface{}{
+		"DomainName": cmd.RequiredArgs.Domain,
+		"Username":   user.Name,
+	})
+
+	warnings, err := cmd.Actor.DeleteDomain(cmd.RequiredArgs.Domain, cmd.Config.TargetedOrganization().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.DomainNotFoundError); ok {
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayWarning("Domain {{.DomainName}} does not exist.", map[string]interface{}{
+				"DomainName": cmd.RequiredArgs.Domain,
+			})
+			return nil
+		}
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
 	return nil
 }
@@ -0,0 +1,151 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-domain Command", func() {
+	var (
+		cmd             DeleteDomainCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDeleteDomainActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDeleteDomainActor)
+
+		cmd = DeleteDomainCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Domain = "some-domain.com"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the user declines the prompt", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not delete the domain", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Really delete the domain some-domain\\.com\\?"))
+			Expect(testUI.Out).To(Say("Delete cancelled"))
+			Expect(fakeActor.DeleteDomainCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the user confirms the prompt", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+			fakeActor.DeleteDomainReturns(v2action.Warnings{"warning-1"}, nil)
+		})
+
+		It("deletes the domain from the targeted organization", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Deleting domain some-domain\\.com as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("warning-1"))
+
+			Expect(fakeActor.DeleteDomainCallCount()).To(Equal(1))
+			domainName, orgGUID := fakeActor.DeleteDomainArgsForCall(0)
+			Expect(domainName).To(Equal("some-domain.com"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
+
+	Context("when -f is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("deletes the domain without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Really delete"))
+			Expect(fakeActor.DeleteDomainCallCount()).To(Equal(1))
+		})
+
+		Context("when the domain does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteDomainReturns(v2action.Warnings{"warning-1"}, v2action.DomainNotFoundError{Name: "some-domain.com"})
+			})
+
+			It("displays OK and warns that the domain does not exist", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("Domain some-domain\\.com does not exist\\."))
+			})
+		})
+
+		Context("when the domain is a shared domain", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteDomainReturns(nil, v2action.DomainIsSharedError{Name: "some-domain.com"})
+			})
+
+			It("returns a DomainIsSharedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.DomainIsSharedError{Name: "some-domain.com"}))
+			})
+		})
+
+		Context("when deleting the domain fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeActor.DeleteDomainReturns(v2action.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+	})
+})
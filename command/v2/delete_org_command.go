@@ -11,14 +11,16 @@ import (
 //go:generate counterfeiter . DeleteOrganizationActor
 
 type DeleteOrganizationActor interface {
-	DeleteOrganization(orgName string) (v2action.Warnings, error)
+	DeleteOrganizationRecursively(orgName string, progress func(v2action.DeleteOrganizationProgress)) (v2action.Warnings, error)
+	StartDeleteOrganization(orgName string) (v2action.Job, v2action.Warnings, error)
 	ClearOrganizationAndSpace(config v2action.Config)
 }
 
 type DeleteOrgCommand struct {
 	RequiredArgs flag.Organization `positional-args:"yes"`
 	Force        bool              `short:"f" description:"Force deletion without confirmation"`
-	usage        interface{}       `usage:"CF_NAME delete-org ORG [-f]"`
+	Detach       bool              `long:"detach" description:"Return once the deletion has started instead of waiting for it to finish"`
+	usage        interface{}       `usage:"CF_NAME delete-org ORG [-f] [--detach]"`
 
 	Config      command.Config
 	UI          command.UI
@@ -70,7 +72,13 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 		"Username": user.Name,
 	})
 
-	warnings, err := cmd.Actor.DeleteOrganization(cmd.RequiredArgs.Organization)
+	var job v2action.Job
+	var warnings v2action.Warnings
+	if cmd.Detach {
+		job, warnings, err = cmd.Actor.StartDeleteOrganization(cmd.RequiredArgs.Organization)
+	} else {
+		warnings, err = cmd.Actor.DeleteOrganizationRecursively(cmd.RequiredArgs.Organization, cmd.displayProgress)
+	}
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		switch err.(type) {
@@ -89,5 +97,25 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 
 	cmd.UI.DisplayOK()
 
+	if job.GUID != "" {
+		shared.RecordDetachedJob(cmd.UI, cmd.Config, job.GUID, "delete-org", cmd.RequiredArgs.Organization)
+	}
+
 	return nil
 }
+
+func (cmd DeleteOrgCommand) displayProgress(progress v2action.DeleteOrganizationProgress) {
+	if progress.Err != nil {
+		cmd.UI.DisplayWarning("Failed to delete {{.Type}} {{.Name}}: {{.Error}}", map[string]interface{}{
+			"Type":  progress.Type,
+			"Name":  progress.Name,
+			"Error": cmd.UI.TranslateError(progress.Err),
+		})
+		return
+	}
+
+	cmd.UI.DisplayText("Deleted {{.Type}} {{.Name}}", map[string]interface{}{
+		"Type": progress.Type,
+		"Name": progress.Name,
+	})
+}
@@ -99,7 +99,7 @@ var _ = Describe("delete-org Command", func() {
 
 					Context("when no errors are encountered", func() {
 						BeforeEach(func() {
-							fakeActor.DeleteOrganizationReturns(v2action.Warnings{"warning-1", "warning-2"}, nil)
+							fakeActor.DeleteOrganizationRecursivelyReturns(v2action.Warnings{"warning-1", "warning-2"}, nil)
 						})
 
 						It("does not prompt for user confirmation, displays warnings, and deletes the org", func() {
@@ -108,8 +108,8 @@ var _ = Describe("delete-org Command", func() {
 							Expect(testUI.Out).ToNot(Say("Really delete the org some-org, including its spaces, apps, service instances, routes, private domains and space-scoped service brokers\\? \\[yN\\]:"))
 							Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
 
-							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(1))
-							orgName := fakeActor.DeleteOrganizationArgsForCall(0)
+							Expect(fakeActor.DeleteOrganizationRecursivelyCallCount()).To(Equal(1))
+							orgName, _ := fakeActor.DeleteOrganizationRecursivelyArgsForCall(0)
 							Expect(orgName).To(Equal("some-org"))
 
 							Expect(testUI.Err).To(Say("warning-1"))
@@ -118,10 +118,77 @@ var _ = Describe("delete-org Command", func() {
 						})
 					})
 
+					Context("when the '--detach' flag is provided", func() {
+						BeforeEach(func() {
+							cmd.Detach = true
+							fakeConfig.BinaryNameReturns("faceman")
+							fakeActor.StartDeleteOrganizationReturns(
+								v2action.Job{GUID: "some-job-guid"},
+								v2action.Warnings{"warning-1"},
+								nil)
+						})
+
+						It("starts the deletion, records the job and returns", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.DeleteOrganizationRecursivelyCallCount()).To(Equal(0))
+							Expect(fakeActor.StartDeleteOrganizationCallCount()).To(Equal(1))
+							Expect(fakeActor.StartDeleteOrganizationArgsForCall(0)).To(Equal("some-org"))
+
+							Expect(fakeConfig.AddDetachedJobCallCount()).To(Equal(1))
+							job := fakeConfig.AddDetachedJobArgsForCall(0)
+							Expect(job.GUID).To(Equal("some-job-guid"))
+							Expect(job.Operation).To(Equal("delete-org"))
+							Expect(job.Resource).To(Equal("some-org"))
+
+							Expect(testUI.Err).To(Say("warning-1"))
+							Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
+							Expect(testUI.Out).To(Say("OK"))
+							Expect(testUI.Out).To(Say("Job some-job-guid is running in the background. Use 'faceman jobs' to check its status."))
+						})
+
+						Context("when the organization does not exist", func() {
+							BeforeEach(func() {
+								fakeActor.StartDeleteOrganizationReturns(v2action.Job{}, nil, v2action.OrganizationNotFoundError{Name: "some-org"})
+							})
+
+							It("does not record a job", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say("Org some-org does not exist."))
+								Expect(fakeConfig.AddDetachedJobCallCount()).To(Equal(0))
+							})
+						})
+					})
+
+					Context("when resources in the org are deleted", func() {
+						BeforeEach(func() {
+							fakeActor.DeleteOrganizationRecursivelyStub = func(_ string, progress func(v2action.DeleteOrganizationProgress)) (v2action.Warnings, error) {
+								progress(v2action.DeleteOrganizationProgress{Type: v2action.DeletedApplication, Name: "some-app"})
+								progress(v2action.DeleteOrganizationProgress{Type: v2action.DeletedRoute, Name: "some-route", Err: errors.New("some-route-error")})
+								return v2action.Warnings{"warning-1"}, v2action.OrganizationPartiallyDeletedError{
+									Name: "some-org",
+									Failures: []v2action.DeleteOrganizationProgress{
+										{Type: v2action.DeletedRoute, Name: "some-route", Err: errors.New("some-route-error")},
+									},
+								}
+							}
+						})
+
+						It("displays the progress of each resource and returns an OrganizationPartiallyDeletedError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.OrganizationPartiallyDeletedError{Name: "some-org", FailureCount: 1}))
+
+							Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
+							Expect(testUI.Out).To(Say("Deleted app some-app"))
+							Expect(testUI.Err).To(Say("Failed to delete route some-route: some-route-error"))
+							Expect(testUI.Err).To(Say("warning-1"))
+							Expect(testUI.Out).ToNot(Say("OK"))
+						})
+					})
+
 					Context("when an error is encountered deleting the org", func() {
 						Context("when the organization does not exist", func() {
 							BeforeEach(func() {
-								fakeActor.DeleteOrganizationReturns(
+								fakeActor.DeleteOrganizationRecursivelyReturns(
 									v2action.Warnings{"warning-1", "warning-2"},
 									v2action.OrganizationNotFoundError{
 										Name: "some-org",
@@ -134,8 +201,8 @@ var _ = Describe("delete-org Command", func() {
 
 								Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
 
-								Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(1))
-								orgName := fakeActor.DeleteOrganizationArgsForCall(0)
+								Expect(fakeActor.DeleteOrganizationRecursivelyCallCount()).To(Equal(1))
+								orgName, _ := fakeActor.DeleteOrganizationRecursivelyArgsForCall(0)
 								Expect(orgName).To(Equal("some-org"))
 
 								Expect(testUI.Err).To(Say("warning-1"))
@@ -151,7 +218,7 @@ var _ = Describe("delete-org Command", func() {
 
 							BeforeEach(func() {
 								returnedErr = errors.New("some error")
-								fakeActor.DeleteOrganizationReturns(v2action.Warnings{"warning-1", "warning-2"}, returnedErr)
+								fakeActor.DeleteOrganizationRecursivelyReturns(v2action.Warnings{"warning-1", "warning-2"}, returnedErr)
 							})
 
 							It("returns the error, displays all warnings, and does not delete the org", func() {
@@ -159,8 +226,8 @@ var _ = Describe("delete-org Command", func() {
 
 								Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
 
-								Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(1))
-								orgName := fakeActor.DeleteOrganizationArgsForCall(0)
+								Expect(fakeActor.DeleteOrganizationRecursivelyCallCount()).To(Equal(1))
+								orgName, _ := fakeActor.DeleteOrganizationRecursivelyArgsForCall(0)
 								Expect(orgName).To(Equal("some-org"))
 
 								Expect(testUI.Err).To(Say("warning-1"))
@@ -182,7 +249,7 @@ var _ = Describe("delete-org Command", func() {
 
 							Expect(testUI.Out).To(Say("Delete cancelled"))
 
-							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+							Expect(fakeActor.DeleteOrganizationRecursivelyCallCount()).To(Equal(0))
 						})
 					})
 
@@ -196,7 +263,7 @@ var _ = Describe("delete-org Command", func() {
 
 							Expect(testUI.Out).To(Say("Delete cancelled"))
 
-							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+							Expect(fakeActor.DeleteOrganizationRecursivelyCallCount()).To(Equal(0))
 						})
 					})
 
@@ -211,8 +278,8 @@ var _ = Describe("delete-org Command", func() {
 							Expect(testUI.Out).To(Say("Really delete the org some-org, including its spaces, apps, service instances, routes, private domains and space-scoped service brokers\\? \\[yN\\]:"))
 							Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
 
-							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(1))
-							orgName := fakeActor.DeleteOrganizationArgsForCall(0)
+							Expect(fakeActor.DeleteOrganizationRecursivelyCallCount()).To(Equal(1))
+							orgName, _ := fakeActor.DeleteOrganizationRecursivelyArgsForCall(0)
 							Expect(orgName).To(Equal("some-org"))
 
 							Expect(testUI.Out).To(Say("OK"))
@@ -231,7 +298,7 @@ var _ = Describe("delete-org Command", func() {
 							Expect(testUI.Out).To(Say("invalid input \\(not y, n, yes, or no\\)"))
 							Expect(testUI.Out).To(Say("Really delete the org some-org, including its spaces, apps, service instances, routes, private domains and space-scoped service brokers\\? \\[yN\\]:"))
 
-							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+							Expect(fakeActor.DeleteOrganizationRecursivelyCallCount()).To(Equal(0))
 						})
 					})
 
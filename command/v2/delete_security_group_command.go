@@ -1,25 +1,128 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . DeleteSecurityGroupActor
+
+type DeleteSecurityGroupActor interface {
+	DeleteSecurityGroupByName(securityGroupName string, confirm func(bindings []v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) (bool, error)) (v2action.Warnings, error)
+}
+
 type DeleteSecurityGroupCommand struct {
 	RequiredArgs    flag.SecurityGroup `positional-args:"yes"`
 	Force           bool               `short:"f" description:"Force deletion without confirmation"`
 	usage           interface{}        `usage:"CF_NAME delete-security-group SECURITY_GROUP [-f]"`
 	relatedCommands interface{}        `related_commands:"security-groups"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DeleteSecurityGroupActor
 }
 
-func (DeleteSecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *DeleteSecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (DeleteSecurityGroupCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd *DeleteSecurityGroupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var cancelled bool
+	confirm := func(bindings []v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) (bool, error) {
+		cmd.displayBindings(bindings)
+
+		if !cmd.Force {
+			deleteSecurityGroup, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the security group {{.SecurityGroupName}}?", map[string]interface{}{
+				"SecurityGroupName": cmd.RequiredArgs.SecurityGroup,
+			})
+			if promptErr != nil {
+				return false, promptErr
+			}
+
+			if !deleteSecurityGroup {
+				cancelled = true
+				return false, nil
+			}
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Deleting security group {{.SecurityGroupName}} as {{.Username}}...", map[string]interface{}{
+			"SecurityGroupName": cmd.RequiredArgs.SecurityGroup,
+			"Username":          user.Name,
+		})
+		return true, nil
+	}
+
+	warnings, err := cmd.Actor.DeleteSecurityGroupByName(cmd.RequiredArgs.SecurityGroup, confirm)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		switch err.(type) {
+		case v2action.SecurityGroupNotFoundError:
+			cmd.UI.DisplayWarning("Security group {{.SecurityGroupName}} does not exist", map[string]interface{}{
+				"SecurityGroupName": cmd.RequiredArgs.SecurityGroup,
+			})
+		default:
+			return shared.HandleError(err)
+		}
+	}
+
+	if cancelled {
+		cmd.UI.DisplayText("Delete cancelled")
+		return nil
+	}
+
+	cmd.UI.DisplayOK()
+
 	return nil
 }
+
+func (cmd DeleteSecurityGroupCommand) displayBindings(bindings []v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) {
+	if len(bindings) == 0 {
+		return
+	}
+
+	cmd.UI.DisplayText("Security group {{.SecurityGroupName}} is bound to the following spaces and will be unbound from them:", map[string]interface{}{
+		"SecurityGroupName": cmd.RequiredArgs.SecurityGroup,
+	})
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("organization"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("lifecycle"),
+		},
+	}
+	for _, binding := range bindings {
+		table = append(table, []string{
+			binding.Organization.Name,
+			binding.Space.Name,
+			string(binding.Lifecycle),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	cmd.UI.DisplayNewline()
+}
@@ -0,0 +1,243 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-security-group Command", func() {
+	var (
+		cmd             DeleteSecurityGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDeleteSecurityGroupActor
+		input           *Buffer
+		binaryName      string
+		executeErr      error
+
+		bindings      []v2action.SecurityGroupWithOrganizationSpaceAndLifecycle
+		actorDeleted  bool
+		actorWarnings v2action.Warnings
+		actorErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDeleteSecurityGroupActor)
+
+		cmd = DeleteSecurityGroupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.SecurityGroup = "some-security-group"
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		bindings = nil
+		actorDeleted = false
+		actorWarnings = v2action.Warnings{"warning-1", "warning-2"}
+		actorErr = nil
+
+		fakeActor.DeleteSecurityGroupByNameStub = func(_ string, confirm func([]v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) (bool, error)) (v2action.Warnings, error) {
+			if actorErr != nil {
+				return actorWarnings, actorErr
+			}
+			confirmed, err := confirm(bindings)
+			if err != nil {
+				return actorWarnings, err
+			}
+			actorDeleted = confirmed
+			return actorWarnings, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(fakeActor.DeleteSecurityGroupByNameCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the security group is bound to spaces", func() {
+		BeforeEach(func() {
+			bindings = []v2action.SecurityGroupWithOrganizationSpaceAndLifecycle{
+				{
+					SecurityGroup: &v2action.SecurityGroup{Name: "some-security-group"},
+					Organization:  &v2action.Organization{Name: "org-1"},
+					Space:         &v2action.Space{Name: "space-1"},
+					Lifecycle:     ccv2.SecurityGroupLifecycleRunning,
+				},
+				{
+					SecurityGroup: &v2action.SecurityGroup{Name: "some-security-group"},
+					Organization:  &v2action.Organization{Name: "org-2"},
+					Space:         &v2action.Space{Name: "space-2"},
+					Lifecycle:     ccv2.SecurityGroupLifecycleStaging,
+				},
+			}
+		})
+
+		Context("when the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("displays the bound spaces before prompting and deletes the security group", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Security group some-security-group is bound to the following spaces and will be unbound from them:"))
+				Expect(testUI.Out).To(Say(`organization\s+space\s+lifecycle`))
+				Expect(testUI.Out).To(Say(`org-1\s+space-1\s+running`))
+				Expect(testUI.Out).To(Say(`org-2\s+space-2\s+staging`))
+				Expect(testUI.Out).To(Say(`Really delete the security group some-security-group\? \[yN\]:`))
+				Expect(testUI.Out).To(Say("Deleting security group some-security-group as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("warning-2"))
+
+				Expect(fakeActor.DeleteSecurityGroupByNameCallCount()).To(Equal(1))
+				securityGroupName, _ := fakeActor.DeleteSecurityGroupByNameArgsForCall(0)
+				Expect(securityGroupName).To(Equal("some-security-group"))
+				Expect(actorDeleted).To(BeTrue())
+			})
+		})
+
+		Context("when the user declines", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not delete the security group", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`org-1\s+space-1\s+running`))
+				Expect(testUI.Out).To(Say("Delete cancelled"))
+				Expect(testUI.Out).ToNot(Say("Deleting security group"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+				Expect(actorDeleted).To(BeFalse())
+			})
+		})
+
+		Context("when the user chooses the default", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not delete the security group", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Delete cancelled"))
+				Expect(actorDeleted).To(BeFalse())
+			})
+		})
+
+		Context("when the prompt fails", func() {
+			It("returns the error", func() {
+				Expect(executeErr).To(HaveOccurred())
+				Expect(actorDeleted).To(BeFalse())
+			})
+		})
+
+		Context("when the '-f' flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			It("displays the bound spaces without prompting and deletes the security group", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`org-1\s+space-1\s+running`))
+				Expect(testUI.Out).ToNot(Say("Really delete"))
+				Expect(testUI.Out).To(Say("Deleting security group some-security-group as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(actorDeleted).To(BeTrue())
+			})
+		})
+	})
+
+	Context("when the security group is not bound to any spaces", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("does not display a bindings table", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("is bound to the following spaces"))
+			Expect(testUI.Out).To(Say("Deleting security group some-security-group as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Context("when the security group does not exist", func() {
+		BeforeEach(func() {
+			actorErr = v2action.SecurityGroupNotFoundError{Name: "some-security-group"}
+		})
+
+		It("displays a warning and OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("Security group some-security-group does not exist"))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Context("when the actor returns an error", func() {
+		BeforeEach(func() {
+			actorErr = errors.New("some error")
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some error"))
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+		})
+	})
+})
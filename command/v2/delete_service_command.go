@@ -3,23 +3,97 @@ package v2
 import (
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . DeleteServiceActor
+
+type DeleteServiceActor interface {
+	DeleteServiceInstanceByNameAndSpace(serviceInstanceName string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	PollServiceInstanceLastOperation(instance v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error)
+}
+
 type DeleteServiceCommand struct {
 	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
 	Force           bool                 `short:"f" description:"Force deletion without confirmation"`
-	usage           interface{}          `usage:"CF_NAME delete-service SERVICE_INSTANCE [-f]"`
+	Wait            bool                 `long:"wait" description:"Wait for the service broker to finish deleting the service instance before returning"`
+	usage           interface{}          `usage:"CF_NAME delete-service SERVICE_INSTANCE [-f] [--wait]"`
 	relatedCommands interface{}          `related_commands:"unbind-service, services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DeleteServiceActor
 }
 
-func (DeleteServiceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *DeleteServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (DeleteServiceCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
-	return nil
+func (cmd DeleteServiceCommand) Execute(args []string) error {
+	if !cmd.Config.Experimental() && !cmd.Wait {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !cmd.Force {
+		deleteService, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the service {{.ServiceName}}?", map[string]interface{}{
+			"ServiceName": cmd.RequiredArgs.ServiceInstance,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteService {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting service {{.ServiceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ServiceName": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"CurrentUser": user.Name,
+	})
+
+	instance, warnings, err := cmd.Actor.DeleteServiceInstanceByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.ServiceInstanceNotFoundError); ok {
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayWarning("Service {{.ServiceName}} does not exist.", map[string]interface{}{
+				"ServiceName": cmd.RequiredArgs.ServiceInstance,
+			})
+			return nil
+		}
+		return shared.HandleError(err)
+	}
+
+	return shared.FinishServiceInstanceOperation(cmd.UI, cmd.Config, instance, cmd.Wait, cmd.Actor.PollServiceInstanceLastOperation)
 }
@@ -0,0 +1,190 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-service Command", func() {
+	var (
+		cmd             DeleteServiceCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDeleteServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDeleteServiceActor)
+
+		cmd = DeleteServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.ServiceInstance = "some-instance"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.ExperimentalReturns(true)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and an org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		})
+
+		Context("when the user declines the prompt", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not delete the service instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Really delete the service some-instance\?`))
+				Expect(testUI.Out).To(Say("Delete cancelled"))
+				Expect(fakeActor.DeleteServiceInstanceByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the -f flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			Context("when getting the current user fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("got bananapants??")
+					fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+				})
+			})
+
+			Context("when the service instance is deleted synchronously", func() {
+				BeforeEach(func() {
+					fakeActor.DeleteServiceInstanceByNameAndSpaceReturns(
+						v2action.ServiceInstance{
+							Name:          "some-instance",
+							LastOperation: ccv2.LastOperation{Type: "delete", State: ccv2.LastOperationSucceeded},
+						},
+						v2action.Warnings{"delete-warning"},
+						nil,
+					)
+				})
+
+				It("deletes the service instance and displays OK", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Really delete"))
+					Expect(testUI.Out).To(Say("Deleting service some-instance in org some-org / space some-space as some-user..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("delete-warning"))
+
+					Expect(fakeActor.DeleteServiceInstanceByNameAndSpaceCallCount()).To(Equal(1))
+					instanceName, spaceGUID := fakeActor.DeleteServiceInstanceByNameAndSpaceArgsForCall(0)
+					Expect(instanceName).To(Equal("some-instance"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+				})
+			})
+
+			Context("when the deletion is in progress", func() {
+				BeforeEach(func() {
+					fakeActor.DeleteServiceInstanceByNameAndSpaceReturns(
+						v2action.ServiceInstance{
+							Name:          "some-instance",
+							LastOperation: ccv2.LastOperation{Type: "delete", State: ccv2.LastOperationInProgress},
+						},
+						nil,
+						nil,
+					)
+				})
+
+				It("displays a hint to check the operation status", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("Delete in progress. Use 'faceman services' or 'faceman service some-instance' to check operation status."))
+				})
+
+				Context("when --wait is provided", func() {
+					BeforeEach(func() {
+						cmd.Wait = true
+						fakeActor.PollServiceInstanceLastOperationReturns(v2action.ServiceInstance{}, v2action.Warnings{"poll-warning"}, nil)
+					})
+
+					It("waits for the deletion to complete", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Waiting for the operation to complete..."))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Err).To(Say("poll-warning"))
+						Expect(fakeActor.PollServiceInstanceLastOperationCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when the service instance does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.DeleteServiceInstanceByNameAndSpaceReturns(v2action.ServiceInstance{}, v2action.Warnings{"delete-warning"}, v2action.ServiceInstanceNotFoundError{Name: "some-instance"})
+				})
+
+				It("displays OK and a warning", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("delete-warning"))
+					Expect(testUI.Err).To(Say("Service some-instance does not exist."))
+				})
+			})
+		})
+	})
+})
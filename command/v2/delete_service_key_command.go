@@ -1,25 +1,99 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . DeleteServiceKeyActor
+
+type DeleteServiceKeyActor interface {
+	DeleteServiceKeyByNameAndServiceInstance(keyName string, serviceInstanceName string, spaceGUID string) (v2action.Warnings, error)
+}
+
 type DeleteServiceKeyCommand struct {
 	RequiredArgs    flag.ServiceInstanceKey `positional-args:"yes"`
 	Force           bool                    `short:"f" description:"Force deletion without confirmation"`
 	usage           interface{}             `usage:"CF_NAME delete-service-key SERVICE_INSTANCE SERVICE_KEY [-f]\n\nEXAMPLES:\n   CF_NAME delete-service-key mydb mykey"`
 	relatedCommands interface{}             `related_commands:"service-keys"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DeleteServiceKeyActor
 }
 
-func (DeleteServiceKeyCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *DeleteServiceKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (DeleteServiceKeyCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd DeleteServiceKeyCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		deleteKey, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the service key {{.ServiceKeyName}}?", map[string]interface{}{
+			"ServiceKeyName": cmd.RequiredArgs.ServiceKey,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteKey {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting key {{.ServiceKeyName}} for service instance {{.ServiceInstanceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ServiceKeyName":      cmd.RequiredArgs.ServiceKey,
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"CurrentUser":         user.Name,
+	})
+
+	warnings, err := cmd.Actor.DeleteServiceKeyByNameAndServiceInstance(cmd.RequiredArgs.ServiceKey, cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		switch err.(type) {
+		case v2action.ServiceInstanceNotFoundError:
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayWarning("Service instance {{.ServiceInstanceName}} does not exist.", map[string]interface{}{
+				"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			})
+			return nil
+		case v2action.ServiceKeyNotFoundError:
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayWarning("Service key {{.ServiceKeyName}} does not exist for service instance {{.ServiceInstanceName}}.", map[string]interface{}{
+				"ServiceKeyName":      cmd.RequiredArgs.ServiceKey,
+				"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			})
+			return nil
+		default:
+			return shared.HandleError(err)
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
 	return nil
 }
@@ -0,0 +1,165 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-service-key Command", func() {
+	var (
+		cmd             DeleteServiceKeyCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDeleteServiceKeyActor
+		input           *Buffer
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDeleteServiceKeyActor)
+
+		cmd = DeleteServiceKeyCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+		cmd.RequiredArgs.ServiceKey = "some-key"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the -f flag is not provided", func() {
+		Context("when the user declines the deletion", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("cancels the deletion", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Really delete the service key some-key\\?"))
+				Expect(testUI.Out).To(Say("Delete cancelled"))
+				Expect(fakeActor.DeleteServiceKeyByNameAndServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user confirms the deletion", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("deletes the key", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.DeleteServiceKeyByNameAndServiceInstanceCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		Context("when deleting the key succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceKeyByNameAndServiceInstanceReturns(v2action.Warnings{"delete-key-warning"}, nil)
+			})
+
+			It("deletes the key without prompting and displays OK and warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(testUI.Out).NotTo(Say("Really delete"))
+				Expect(testUI.Out).To(Say("Deleting key some-key for service instance some-service-instance as some-user\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("delete-key-warning"))
+
+				Expect(fakeActor.DeleteServiceKeyByNameAndServiceInstanceCallCount()).To(Equal(1))
+				keyName, serviceInstanceName, spaceGUID := fakeActor.DeleteServiceKeyByNameAndServiceInstanceArgsForCall(0)
+				Expect(keyName).To(Equal("some-key"))
+				Expect(serviceInstanceName).To(Equal("some-service-instance"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceKeyByNameAndServiceInstanceReturns(nil, v2action.ServiceInstanceNotFoundError{Name: "some-service-instance"})
+			})
+
+			It("displays OK and a warning that the instance does not exist", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("Service instance some-service-instance does not exist\\."))
+			})
+		})
+
+		Context("when the key does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceKeyByNameAndServiceInstanceReturns(nil, v2action.ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"})
+			})
+
+			It("displays OK and a warning that the key does not exist", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("Service key some-key does not exist for service instance some-service-instance\\."))
+			})
+		})
+
+		Context("when deleting the key fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete key error")
+				fakeActor.DeleteServiceKeyByNameAndServiceInstanceReturns(v2action.Warnings{"delete-key-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("delete-key-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,161 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-user Command", func() {
+	var (
+		cmd             DeleteUserCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDeleteUserActor
+		input           *Buffer
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDeleteUserActor)
+
+		cmd = DeleteUserCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.Username = "some-target-user"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the -f flag is not provided", func() {
+		Context("when the user declines the deletion", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("cancels the deletion", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Really delete the user some-target-user\\?"))
+				Expect(testUI.Out).To(Say("Delete cancelled"))
+				Expect(fakeActor.DeleteUserCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user confirms the deletion", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("deletes the user", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.DeleteUserCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+			cmd.Origin = "ldap"
+		})
+
+		Context("when deleting the user succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteUserReturns(v2action.Warnings{"delete-user-warning"}, nil)
+			})
+
+			It("deletes the user without prompting and displays OK and warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(testUI.Out).NotTo(Say("Really delete"))
+				Expect(testUI.Out).To(Say("Deleting user some-target-user as some-user\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("delete-user-warning"))
+
+				Expect(fakeActor.DeleteUserCallCount()).To(Equal(1))
+				username, origin := fakeActor.DeleteUserArgsForCall(0)
+				Expect(username).To(Equal("some-target-user"))
+				Expect(origin).To(Equal("ldap"))
+			})
+		})
+
+		Context("when the user does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteUserReturns(nil, v2action.UserNotFoundError{Username: "some-target-user", Origin: "ldap"})
+			})
+
+			It("displays OK and a warning that the user does not exist", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("User some-target-user does not exist\\."))
+			})
+		})
+
+		Context("when the username exists in multiple origins", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteUserReturns(nil, v2action.MultipleUAAUsersFoundError{Username: "some-target-user", Origins: []string{"uaa", "ldap"}})
+			})
+
+			It("returns a MultipleUAAUsersFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MultipleUAAUsersFoundError{Username: "some-target-user", Origins: []string{"uaa", "ldap"}}))
+			})
+		})
+
+		Context("when deleting the user fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete user error")
+				fakeActor.DeleteUserReturns(v2action.Warnings{"delete-user-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("delete-user-warning"))
+			})
+		})
+	})
+})
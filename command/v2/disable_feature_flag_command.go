@@ -1,24 +1,65 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
 type DisableFeatureFlagCommand struct {
 	RequiredArgs    flag.Feature `positional-args:"yes"`
 	usage           interface{}  `usage:"CF_NAME disable-feature-flag FEATURE_NAME"`
 	relatedCommands interface{}  `related_commands:"enable-feature-flag, feature-flags"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SetFeatureFlagActor
 }
 
-func (DisableFeatureFlagCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *DisableFeatureFlagCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (DisableFeatureFlagCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd DisableFeatureFlagCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting status of {{.FeatureFlag}} as {{.Username}}...", map[string]interface{}{
+		"FeatureFlag": cmd.RequiredArgs.Feature,
+		"Username":    user.Name,
+	})
+
+	warnings, err := cmd.Actor.SetFeatureFlag(cmd.RequiredArgs.Feature, false)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Feature {{.FeatureFlag}} Disabled.", map[string]interface{}{
+		"FeatureFlag": cmd.RequiredArgs.Feature,
+	})
+
 	return nil
 }
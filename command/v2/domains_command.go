@@ -1,22 +1,101 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . DomainsActor
+
+type DomainsActor interface {
+	GetDomains(orgGUID string) ([]v2action.Domain, []v2action.Domain, v2action.Warnings, error)
+}
+
 type DomainsCommand struct {
 	usage           interface{} `usage:"CF_NAME domains"`
 	relatedCommands interface{} `related_commands:"router-groups, create-route, routes"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DomainsActor
 }
 
-func (DomainsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *DomainsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (DomainsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd DomainsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting domains in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  cmd.Config.TargetedOrganization().Name,
+		"Username": user.Name,
+	})
+
+	sharedDomains, privateDomains, warnings, err := cmd.Actor.GetDomains(cmd.Config.TargetedOrganization().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(sharedDomains) == 0 && len(privateDomains) == 0 {
+		cmd.UI.DisplayText("No domains found")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("status"),
+			cmd.UI.TranslateText("type"),
+			cmd.UI.TranslateText("details"),
+		},
+	}
+	for _, domain := range sharedDomains {
+		table = append(table, cmd.domainRow(domain, "shared"))
+	}
+	for _, domain := range privateDomains {
+		table = append(table, cmd.domainRow(domain, "owned"))
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
 	return nil
 }
+
+func (cmd DomainsCommand) domainRow(domain v2action.Domain, status string) []string {
+	var details string
+	if domain.Internal {
+		details = cmd.UI.TranslateText("internal")
+	}
+
+	return []string{
+		domain.Name,
+		cmd.UI.TranslateText(status),
+		domain.RouterGroupType,
+		details,
+	}
+}
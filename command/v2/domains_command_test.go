@@ -0,0 +1,123 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("domains Command", func() {
+	var (
+		cmd             DomainsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDomainsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDomainsActor)
+
+		cmd = DomainsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when getting the domains succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetDomainsReturns(
+				[]v2action.Domain{
+					{Name: "shared.com"},
+					{Name: "tcp.com", RouterGroupType: "tcp"},
+					{Name: "apps.internal", Internal: true},
+				},
+				[]v2action.Domain{
+					{Name: "private.com"},
+				},
+				v2action.Warnings{"warning-1", "warning-2"},
+				nil,
+			)
+		})
+
+		It("displays the shared domains followed by the private domains", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting domains in org some-org as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("name\\s+status\\s+type\\s+details"))
+			Expect(testUI.Out).To(Say("shared\\.com\\s+shared"))
+			Expect(testUI.Out).To(Say("tcp\\.com\\s+shared\\s+tcp"))
+			Expect(testUI.Out).To(Say("apps\\.internal\\s+shared\\s+internal"))
+			Expect(testUI.Out).To(Say("private\\.com\\s+owned"))
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+
+			Expect(fakeActor.GetDomainsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetDomainsArgsForCall(0)).To(Equal("some-org-guid"))
+		})
+	})
+
+	Context("when there are no domains", func() {
+		BeforeEach(func() {
+			fakeActor.GetDomainsReturns(nil, nil, nil, nil)
+		})
+
+		It("displays that no domains were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No domains found"))
+			Expect(testUI.Out).ToNot(Say("name\\s+status"))
+		})
+	})
+
+	Context("when getting the domains fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.GetDomainsReturns(nil, nil, v2action.Warnings{"warning-1"}, expectedErr)
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+})
@@ -0,0 +1,114 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("enable-feature-flag Command", func() {
+	var (
+		cmd             EnableFeatureFlagCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSetFeatureFlagActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSetFeatureFlagActor)
+
+		cmd = EnableFeatureFlagCommand{
+			RequiredArgs: flag.Feature{Feature: "user_org_creation"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when the feature flag does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.SetFeatureFlagReturns(
+				v2action.Warnings{"set flag warning"},
+				v2action.FeatureFlagNotFoundError{Name: "user_org_creation"})
+		})
+
+		It("returns a FeatureFlagNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.FeatureFlagNotFoundError{Name: "user_org_creation"}))
+			Expect(testUI.Err).To(Say("set flag warning"))
+		})
+	})
+
+	Context("when setting the feature flag succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.SetFeatureFlagReturns(v2action.Warnings{"set flag warning"}, nil)
+		})
+
+		It("enables the feature flag and displays all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Setting status of user_org_creation as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Feature user_org_creation Enabled."))
+			Expect(testUI.Err).To(Say("set flag warning"))
+
+			Expect(fakeActor.SetFeatureFlagCallCount()).To(Equal(1))
+			name, enabled := fakeActor.SetFeatureFlagArgsForCall(0)
+			Expect(name).To(Equal("user_org_creation"))
+			Expect(enabled).To(BeTrue())
+		})
+	})
+})
@@ -1,23 +1,229 @@
 package v2
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
+	"sort"
+	"strings"
+	"time"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
 )
 
+// maxEvents is the number of the most recent matching events displayed.
+const maxEvents = 50
+
+// errEnoughEvents stops the pagination of events once maxEvents have been
+// retrieved.
+var errEnoughEvents = errors.New("enough events retrieved")
+
+//go:generate counterfeiter . EventsActor
+
+type EventsActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetEventsByApplication(appGUID string, filter v3action.EventFilter, handlePage func([]v3action.Event) error) (v3action.Warnings, error)
+}
+
 type EventsCommand struct {
-	RequiredArgs flag.AppName `positional-args:"yes"`
-	usage        interface{}  `usage:"CF_NAME events APP_NAME"`
+	RequiredArgs flag.AppName   `positional-args:"yes"`
+	Types        []string       `long:"type" description:"Only show events of this type, such as audit.app.update (can be specified multiple times)"`
+	ActorName    string         `long:"actor" description:"Only show events caused by the user or client with this name or GUID"`
+	Since        flag.Timestamp `long:"since" description:"Only show events at or after this time, such as 2017-08-14T21:16:42Z"`
+	Until        flag.Timestamp `long:"until" description:"Only show events at or before this time, such as 2017-08-14T21:16:42Z"`
+	JSON         bool           `long:"json" description:"Print each event as a line of JSON"`
+	usage        interface{}    `usage:"CF_NAME events APP_NAME [--type EVENT_TYPE]... [--actor ACTOR] [--since TIME] [--until TIME] [--json]\n\n   Shows the 50 most recent events that match the filters.\n\nEXAMPLES:\n   CF_NAME events my-app --type audit.app.update --since 2017-08-14T00:00:00Z"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       EventsActor
 }
 
-func (EventsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *EventsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); ok && !cmd.usesAuditEventOptions() {
+			return nil
+		}
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
 	return nil
 }
 
-func (EventsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+// Execute displays the app's events from the V3 audit events. Against APIs
+// without V3 audit events, the legacy implementation is used unless an
+// option that requires them was given.
+func (cmd EventsCommand) Execute(args []string) error {
+	if cmd.Actor == nil {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionAuditEventsV3)
+	if err != nil {
+		if !cmd.usesAuditEventOptions() {
+			oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+			return nil
+		}
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !cmd.JSON {
+		cmd.UI.DisplayTextWithFlavor("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	filter := v3action.EventFilter{
+		Types: cmd.Types,
+		Actor: cmd.ActorName,
+		Since: cmd.Since.Time,
+		Until: cmd.Until.Time,
+	}
+
+	var events []v3action.Event
+	warnings, err = cmd.Actor.GetEventsByApplication(app.GUID, filter, func(page []v3action.Event) error {
+		if len(events)+len(page) >= maxEvents {
+			page = page[:maxEvents-len(events)]
+		}
+		events = append(events, page...)
+
+		if cmd.JSON {
+			jsonErr := cmd.displayEventsAsJSON(page)
+			if jsonErr != nil {
+				return jsonErr
+			}
+		}
+
+		if len(events) == maxEvents {
+			return errEnoughEvents
+		}
+		return nil
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil && err != errEnoughEvents {
+		return sharedV3.HandleError(err)
+	}
+
+	if cmd.JSON {
+		return nil
+	}
+
+	if len(events) == 0 {
+		cmd.UI.DisplayText("No events for app {{.AppName}}", map[string]interface{}{
+			"AppName": cmd.RequiredArgs.AppName,
+		})
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("event"),
+			cmd.UI.TranslateText("actor"),
+			cmd.UI.TranslateText("description"),
+		},
+	}
+	for _, event := range events {
+		table = append(table, []string{
+			event.Time.Local().Format("2006-01-02T15:04:05.00-0700"),
+			event.Type,
+			event.ActorName,
+			eventDescription(event),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
 	return nil
 }
+
+// usesAuditEventOptions returns true when an option that the legacy
+// implementation does not support was given.
+func (cmd EventsCommand) usesAuditEventOptions() bool {
+	return len(cmd.Types) > 0 || cmd.ActorName != "" || !cmd.Since.IsZero() || !cmd.Until.IsZero() || cmd.JSON
+}
+
+func (cmd EventsCommand) displayEventsAsJSON(events []v3action.Event) error {
+	for _, event := range events {
+		raw, err := json.Marshal(struct {
+			GUID      string                 `json:"guid"`
+			Time      string                 `json:"time"`
+			Type      string                 `json:"type"`
+			Actor     string                 `json:"actor"`
+			ActorType string                 `json:"actor_type"`
+			Data      map[string]interface{} `json:"data,omitempty"`
+		}{
+			GUID:      event.GUID,
+			Time:      event.Time.UTC().Format(time.RFC3339),
+			Type:      event.Type,
+			Actor:     event.ActorName,
+			ActorType: event.ActorType,
+			Data:      event.Data,
+		})
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayText("{{.Event}}", map[string]interface{}{
+			"Event": string(raw),
+		})
+	}
+	return nil
+}
+
+// eventDescription summarizes the simple values in the event's request, or
+// in its data when it has no request, as a sorted list of key: value pairs.
+func eventDescription(event v3action.Event) string {
+	values := event.Data
+	if request, ok := event.Data["request"].(map[string]interface{}); ok {
+		values = request
+	}
+
+	var pairs []string
+	for key, value := range values {
+		switch value.(type) {
+		case string, float64, bool:
+			pairs = append(pairs, fmt.Sprintf("%s: %v", key, value))
+		}
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ", ")
+}
@@ -0,0 +1,235 @@
+package v2_test
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("events Command", func() {
+	var (
+		cmd             EventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeEventsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeEventsActor)
+
+		cmd = EventsCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionAuditEventsV3)
+		fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid"}, v3action.Warnings{"get-app-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API does not support audit events and filters are given", func() {
+		BeforeEach(func() {
+			cmd.Types = []string{"audit.app.update"}
+			fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "3.0.0",
+				MinimumVersion: version.MinVersionAuditEventsV3,
+			}))
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"get-app-warning"}, v3action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(fakeActor.GetEventsByApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when getting the events returns an error", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.GetEventsByApplicationReturns(v3action.Warnings{"get-events-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+		})
+	})
+
+	Context("when the app has no events", func() {
+		It("displays a message", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting events for app some-app in org some-org / space some-space as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("No events for app some-app"))
+		})
+	})
+
+	Context("when the app has events", func() {
+		var pages [][]v3action.Event
+
+		BeforeEach(func() {
+			pages = [][]v3action.Event{
+				{
+					{
+						GUID:      "event-1",
+						Type:      "audit.app.update",
+						Time:      time.Date(2017, 8, 14, 21, 16, 42, 0, time.UTC),
+						ActorName: "some-user",
+						ActorType: "user",
+						Data: map[string]interface{}{
+							"request": map[string]interface{}{
+								"instances": float64(2),
+								"memory":    float64(256),
+								"env":       map[string]interface{}{"hidden": "value"},
+							},
+						},
+					},
+				},
+				{
+					{
+						GUID:      "event-2",
+						Type:      "audit.app.create",
+						Time:      time.Date(2017, 8, 14, 21, 10, 0, 0, time.UTC),
+						ActorName: "other-user",
+						ActorType: "user",
+					},
+				},
+			}
+
+			fakeActor.GetEventsByApplicationStub = func(_ string, _ v3action.EventFilter, handlePage func([]v3action.Event) error) (v3action.Warnings, error) {
+				for _, page := range pages {
+					err := handlePage(page)
+					if err != nil {
+						return v3action.Warnings{"get-events-warning"}, err
+					}
+				}
+				return v3action.Warnings{"get-events-warning"}, nil
+			}
+		})
+
+		It("displays the events in a table", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("time\\s+event\\s+actor\\s+description"))
+			Expect(testUI.Out).To(Say("2017-08-14T\\d\\d:\\d6:42\\.00[+-]\\d{4}\\s+audit\\.app\\.update\\s+some-user\\s+instances: 2, memory: 256\n"))
+			Expect(testUI.Out).To(Say("2017-08-14T\\d\\d:\\d0:00\\.00[+-]\\d{4}\\s+audit\\.app\\.create\\s+other-user"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.GetEventsByApplicationCallCount()).To(Equal(1))
+			appGUID, filter, _ := fakeActor.GetEventsByApplicationArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(filter).To(Equal(v3action.EventFilter{}))
+		})
+
+		Context("when filters are given", func() {
+			BeforeEach(func() {
+				cmd.Types = []string{"audit.app.update", "audit.app.create"}
+				cmd.ActorName = "some-user"
+				cmd.Since = flag.Timestamp{Time: time.Date(2017, 8, 14, 0, 0, 0, 0, time.UTC)}
+				cmd.Until = flag.Timestamp{Time: time.Date(2017, 8, 15, 0, 0, 0, 0, time.UTC)}
+			})
+
+			It("passes them to the actor", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, filter, _ := fakeActor.GetEventsByApplicationArgsForCall(0)
+				Expect(filter).To(Equal(v3action.EventFilter{
+					Types: []string{"audit.app.update", "audit.app.create"},
+					Actor: "some-user",
+					Since: time.Date(2017, 8, 14, 0, 0, 0, 0, time.UTC),
+					Until: time.Date(2017, 8, 15, 0, 0, 0, 0, time.UTC),
+				}))
+			})
+		})
+
+		Context("when there are more events than are displayed", func() {
+			BeforeEach(func() {
+				var manyEvents []v3action.Event
+				for i := 0; i < 30; i++ {
+					manyEvents = append(manyEvents, v3action.Event{Type: "audit.app.update"})
+				}
+				pages = [][]v3action.Event{manyEvents, manyEvents, manyEvents}
+			})
+
+			It("stops retrieving events once 50 have been retrieved", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(strings.Count(string(testUI.Out.(*Buffer).Contents()), "audit.app.update")).To(Equal(50))
+			})
+		})
+
+		Context("when --json is given", func() {
+			BeforeEach(func() {
+				cmd.JSON = true
+			})
+
+			It("displays each event as a line of JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Getting events"))
+				Expect(testUI.Out).To(Say(`{"guid":"event-1","time":"2017-08-14T21:16:42Z","type":"audit.app.update","actor":"some-user","actor_type":"user","data":{"request":{"env":{"hidden":"value"},"instances":2,"memory":256}}}\n`))
+				Expect(testUI.Out).To(Say(`{"guid":"event-2","time":"2017-08-14T21:10:00Z","type":"audit.app.create","actor":"other-user","actor_type":"user"}\n`))
+			})
+		})
+	})
+})
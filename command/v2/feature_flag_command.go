@@ -1,24 +1,68 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . FeatureFlagActor
+
+type FeatureFlagActor interface {
+	GetFeatureFlag(name string) (v2action.FeatureFlag, v2action.Warnings, error)
+}
+
 type FeatureFlagCommand struct {
 	RequiredArgs    flag.Feature `positional-args:"yes"`
 	usage           interface{}  `usage:"CF_NAME feature-flag FEATURE_NAME"`
 	relatedCommands interface{}  `related_commands:"disable-feature-flag, enable-feature-flag, feature-flags"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       FeatureFlagActor
 }
 
-func (FeatureFlagCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *FeatureFlagCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (FeatureFlagCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd FeatureFlagCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Retrieving status of {{.FeatureFlag}} as {{.Username}}...", map[string]interface{}{
+		"FeatureFlag": cmd.RequiredArgs.Feature,
+		"Username":    user.Name,
+	})
+
+	featureFlag, warnings, err := cmd.Actor.GetFeatureFlag(cmd.RequiredArgs.Feature)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	displayFeatureFlags(cmd.UI, []v2action.FeatureFlag{featureFlag})
+
 	return nil
 }
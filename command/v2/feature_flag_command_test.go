@@ -0,0 +1,118 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("feature-flag Command", func() {
+	var (
+		cmd             FeatureFlagCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeFeatureFlagActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeFeatureFlagActor)
+
+		cmd = FeatureFlagCommand{
+			RequiredArgs: flag.Feature{Feature: "user_org_creation"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when the feature flag does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetFeatureFlagReturns(
+				v2action.FeatureFlag{},
+				v2action.Warnings{"get flag warning"},
+				v2action.FeatureFlagNotFoundError{Name: "user_org_creation"})
+		})
+
+		It("returns a FeatureFlagNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.FeatureFlagNotFoundError{Name: "user_org_creation"}))
+			Expect(testUI.Err).To(Say("get flag warning"))
+		})
+	})
+
+	Context("when getting the feature flag succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetFeatureFlagReturns(
+				v2action.FeatureFlag{Name: "user_org_creation", Enabled: true, Default: types.NullBool{Value: false, IsSet: true}},
+				v2action.Warnings{"get flag warning"},
+				nil)
+		})
+
+		It("displays the state and default of the feature flag and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Retrieving status of user_org_creation as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`Features\s+State\s+Default`))
+			Expect(testUI.Out).To(Say(`user_org_creation\s+enabled\s+disabled`))
+			Expect(testUI.Err).To(Say("get flag warning"))
+
+			Expect(fakeActor.GetFeatureFlagCallCount()).To(Equal(1))
+			Expect(fakeActor.GetFeatureFlagArgsForCall(0)).To(Equal("user_org_creation"))
+		})
+	})
+})
@@ -1,22 +1,101 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . FeatureFlagsActor
+
+type FeatureFlagsActor interface {
+	GetFeatureFlags() ([]v2action.FeatureFlag, v2action.Warnings, error)
+}
+
 type FeatureFlagsCommand struct {
 	usage           interface{} `usage:"CF_NAME feature-flags"`
 	relatedCommands interface{} `related_commands:"disable-feature-flag, enable-feature-flag"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       FeatureFlagsActor
 }
 
-func (FeatureFlagsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *FeatureFlagsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (FeatureFlagsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd FeatureFlagsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Retrieving status of all flagged features as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+
+	featureFlags, warnings, err := cmd.Actor.GetFeatureFlags()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	displayFeatureFlags(cmd.UI, featureFlags)
+
 	return nil
 }
+
+// displayFeatureFlags displays the current state of each feature flag
+// alongside the state it has when it is not set. The default is left blank
+// for feature flags unknown to the CLI.
+func displayFeatureFlags(ui command.UI, featureFlags []v2action.FeatureFlag) {
+	table := [][]string{
+		{
+			ui.TranslateText("Features"),
+			ui.TranslateText("State"),
+			ui.TranslateText("Default"),
+		},
+	}
+
+	for _, featureFlag := range featureFlags {
+		var defaultState string
+		if featureFlag.Default.IsSet {
+			defaultState = featureFlagState(featureFlag.Default.Value)
+		}
+
+		table = append(table, []string{
+			featureFlag.Name,
+			featureFlagState(featureFlag.Enabled),
+			defaultState,
+		})
+	}
+
+	ui.DisplayNewline()
+	ui.DisplayTableWithHeader("", table, 3)
+}
+
+func featureFlagState(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
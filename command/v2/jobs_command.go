@@ -0,0 +1,118 @@
+package v2
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+//go:generate counterfeiter . JobsActor
+
+type JobsActor interface {
+	GetJob(jobGUID string) (v2action.Job, v2action.Warnings, error)
+}
+
+type JobsCommand struct {
+	usage           interface{} `usage:"CF_NAME jobs\n\nFinished, failed and expired jobs are removed from the list once they have been shown."`
+	relatedCommands interface{} `related_commands:"delete-org, purge-service-offering, v2-push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       JobsActor
+}
+
+func (cmd *JobsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd JobsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting background jobs as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	detachedJobs := cmd.Config.DetachedJobs()
+	if len(detachedJobs) == 0 {
+		cmd.UI.DisplayText("No background jobs found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("job"),
+			cmd.UI.TranslateText("operation"),
+			cmd.UI.TranslateText("resource"),
+			cmd.UI.TranslateText("started"),
+			cmd.UI.TranslateText("status"),
+			cmd.UI.TranslateText("details"),
+		},
+	}
+
+	var done []string
+	for _, detachedJob := range detachedJobs {
+		job, warnings, err := cmd.Actor.GetJob(detachedJob.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+
+		var status, details string
+		switch err.(type) {
+		case nil:
+			status = string(job.Status)
+			details = job.ErrorDetails.Description
+			if job.Status == ccv2.JobStatusFinished || job.Status == ccv2.JobStatusFailed {
+				done = append(done, detachedJob.GUID)
+			}
+		case v2action.JobNotFoundError:
+			status = "expired"
+			done = append(done, detachedJob.GUID)
+		default:
+			return shared.HandleError(err)
+		}
+
+		table = append(table, cmd.jobRow(detachedJob, status, details))
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	for _, jobGUID := range done {
+		cmd.Config.RemoveDetachedJob(jobGUID)
+	}
+
+	return nil
+}
+
+func (cmd JobsCommand) jobRow(detachedJob configv3.DetachedJob, status string, details string) []string {
+	return []string{
+		detachedJob.GUID,
+		detachedJob.Operation,
+		detachedJob.Resource,
+		detachedJob.StartedAt.Local().Format(time.RFC1123),
+		cmd.UI.TranslateText(status),
+		details,
+	}
+}
@@ -0,0 +1,138 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("jobs Command", func() {
+	var (
+		cmd             JobsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeJobsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeJobsActor)
+
+		cmd = JobsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the user is logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when there are no background jobs", func() {
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Getting background jobs as some-user..."))
+				Expect(testUI.Out).To(Say("No background jobs found."))
+				Expect(fakeActor.GetJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when there are background jobs", func() {
+			BeforeEach(func() {
+				startedAt := time.Date(2017, 11, 1, 10, 0, 0, 0, time.UTC)
+				fakeConfig.DetachedJobsReturns([]configv3.DetachedJob{
+					{GUID: "running-job-guid", Operation: "delete-org", Resource: "some-org", StartedAt: startedAt},
+					{GUID: "failed-job-guid", Operation: "v2-push", Resource: "some-app", StartedAt: startedAt},
+					{GUID: "expired-job-guid", Operation: "purge-service-offering", Resource: "some-service", StartedAt: startedAt},
+				})
+
+				fakeActor.GetJobStub = func(jobGUID string) (v2action.Job, v2action.Warnings, error) {
+					switch jobGUID {
+					case "running-job-guid":
+						return v2action.Job{GUID: jobGUID, Status: ccv2.JobStatusRunning}, v2action.Warnings{"get-job-warning"}, nil
+					case "failed-job-guid":
+						job := v2action.Job{GUID: jobGUID, Status: ccv2.JobStatusFailed}
+						job.ErrorDetails.Description = "some-failure"
+						return job, nil, nil
+					default:
+						return v2action.Job{}, nil, v2action.JobNotFoundError{GUID: jobGUID}
+					}
+				}
+			})
+
+			It("displays the status of each job", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Getting background jobs as some-user..."))
+				Expect(testUI.Out).To(Say(`job\s+operation\s+resource\s+started\s+status\s+details`))
+				Expect(testUI.Out).To(Say(`running-job-guid\s+delete-org\s+some-org\s+.*2017.*\s+running`))
+				Expect(testUI.Out).To(Say(`failed-job-guid\s+v2-push\s+some-app\s+.*2017.*\s+failed\s+some-failure`))
+				Expect(testUI.Out).To(Say(`expired-job-guid\s+purge-service-offering\s+some-service\s+.*2017.*\s+expired`))
+				Expect(testUI.Err).To(Say("get-job-warning"))
+			})
+
+			It("forgets the jobs that are done", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeConfig.RemoveDetachedJobCallCount()).To(Equal(2))
+				Expect(fakeConfig.RemoveDetachedJobArgsForCall(0)).To(Equal("failed-job-guid"))
+				Expect(fakeConfig.RemoveDetachedJobArgsForCall(1)).To(Equal("expired-job-guid"))
+			})
+
+			Context("when getting a job fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetJobStub = nil
+					fakeActor.GetJobReturns(v2action.Job{}, v2action.Warnings{"get-job-warning"}, errors.New("get-job-error"))
+				})
+
+				It("returns the error and keeps the jobs", func() {
+					Expect(executeErr).To(MatchError("get-job-error"))
+					Expect(testUI.Err).To(Say("get-job-warning"))
+					Expect(fakeConfig.RemoveDetachedJobCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
@@ -1,12 +1,26 @@
 package v2
 
 import (
+	"fmt"
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . LoginActor
+
+type LoginActor interface {
+	Authenticate(config v2action.Config, ID string, secret string, origin string, grantType uaa.GrantType) error
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	PasscodeURL() string
+}
+
 type LoginCommand struct {
 	APIEndpoint       string      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
 	Organization      string      `short:"o" description:"Org"`
@@ -18,13 +32,115 @@ type LoginCommand struct {
 	Username          string      `short:"u" description:"Username"`
 	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)"`
 	relatedCommands   interface{} `related_commands:"api, auth, target"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  LoginActor
 }
 
-func (LoginCommand) Setup(config command.Config, ui command.UI) error {
+// Setup only prepares the actor for a one-time passcode login against the
+// currently targeted API; every other login is still handled by the legacy
+// implementation.
+func (cmd *LoginCommand) Setup(config command.Config, ui command.UI) error {
+	if !cmd.isPasscodeLogin() {
+		return nil
+	}
+
+	cmd.UI = ui
+	cmd.Config = config
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (LoginCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd LoginCommand) Execute(args []string) error {
+	if !cmd.isPasscodeLogin() {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	if cmd.SSO && cmd.SSOPasscode != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--sso", "--sso-passcode"},
+		}
+	}
+
+	err := command.WarnAPIVersionCheck(cmd.Config, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor(
+		"API endpoint: {{.Endpoint}}",
+		map[string]interface{}{
+			"Endpoint": cmd.Config.Target(),
+		})
+	cmd.UI.DisplayNewline()
+
+	passcode := cmd.SSOPasscode
+	if passcode == "" {
+		passcode, err = cmd.UI.DisplayPasswordPrompt("One-time code (Get one at {{.PasscodeURL}})", map[string]interface{}{
+			"PasscodeURL": cmd.Actor.PasscodeURL(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayText("Authenticating...")
+	err = cmd.Actor.Authenticate(cmd.Config, "", passcode, "", uaa.GrantTypePasscode)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.UI.DisplayOK()
+
+	if cmd.Organization == "" {
+		cmd.UI.DisplayTextWithFlavor(
+			"Use '{{.Command}}' to view or set your target org and space.",
+			map[string]interface{}{
+				"Command": fmt.Sprintf("%s target", cmd.Config.BinaryName()),
+			})
+		return nil
+	}
+
+	return cmd.targetOrgAndSpace()
+}
+
+// isPasscodeLogin returns true when a one-time passcode login is requested
+// without changing the API endpoint.
+func (cmd LoginCommand) isPasscodeLogin() bool {
+	return (cmd.SSO || cmd.SSOPasscode != "") && cmd.APIEndpoint == ""
+}
+
+func (cmd LoginCommand) targetOrgAndSpace() error {
+	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.Config.SetOrganizationInformation(org.GUID, org.Name)
+	cmd.UI.DisplayTextWithFlavor("Targeted org {{.OrgName}}", map[string]interface{}{
+		"OrgName": org.Name,
+	})
+
+	if cmd.Space == "" {
+		return nil
+	}
+
+	space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(org.GUID, cmd.Space)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.Config.SetSpaceInformation(space.GUID, space.Name, space.AllowSSH)
+	cmd.UI.DisplayTextWithFlavor("Targeted space {{.SpaceName}}", map[string]interface{}{
+		"SpaceName": space.Name,
+	})
+
 	return nil
 }
@@ -0,0 +1,189 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("login Command", func() {
+	var (
+		cmd        LoginCommand
+		input      *Buffer
+		testUI     *ui.UI
+		fakeActor  *v2fakes.FakeLoginActor
+		fakeConfig *commandfakes.FakeConfig
+		binaryName string
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeActor = new(v2fakes.FakeLoginActor)
+		fakeConfig = new(commandfakes.FakeConfig)
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetReturns("some-api-target")
+
+		cmd = LoginCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --sso and --sso-passcode are both provided", func() {
+		BeforeEach(func() {
+			cmd.SSO = true
+			cmd.SSOPasscode = "some-passcode"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--sso", "--sso-passcode"},
+			}))
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --sso is provided", func() {
+		BeforeEach(func() {
+			cmd.SSO = true
+			fakeActor.PasscodeURLReturns("https://login.example.com/passcode")
+			_, err := input.Write([]byte("some-prompted-passcode\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("prompts for a one-time code and authenticates with it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("API endpoint: some-api-target"))
+			Expect(testUI.Out).To(Say(`One-time code \(Get one at https://login\.example\.com/passcode\):`))
+			Expect(testUI.Out).To(Say(`Authenticating\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Use 'faceman target' to view or set your target org and space."))
+
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			config, ID, secret, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
+			Expect(config).To(Equal(fakeConfig))
+			Expect(ID).To(BeEmpty())
+			Expect(secret).To(Equal("some-prompted-passcode"))
+			Expect(origin).To(BeEmpty())
+			Expect(grantType).To(Equal(uaa.GrantTypePasscode))
+		})
+	})
+
+	Context("when --sso-passcode is provided", func() {
+		BeforeEach(func() {
+			cmd.SSOPasscode = "some-passcode"
+		})
+
+		It("authenticates with the passcode without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("One-time code"))
+			Expect(fakeActor.PasscodeURLCallCount()).To(Equal(0))
+
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			_, _, secret, _, grantType := fakeActor.AuthenticateArgsForCall(0)
+			Expect(secret).To(Equal("some-passcode"))
+			Expect(grantType).To(Equal(uaa.GrantTypePasscode))
+		})
+
+		Context("when authentication fails", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateReturns(uaa.BadCredentialsError{Message: "some message"})
+			})
+
+			It("returns the translated error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.BadCredentialsError{}))
+				Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an org and space are provided", func() {
+			BeforeEach(func() {
+				cmd.Organization = "some-org"
+				cmd.Space = "some-space"
+				fakeActor.GetOrganizationByNameReturns(
+					v2action.Organization{GUID: "some-org-guid", Name: "some-org"},
+					v2action.Warnings{"get-org-warning"},
+					nil)
+				fakeActor.GetSpaceByOrganizationAndNameReturns(
+					v2action.Space{GUID: "some-space-guid", Name: "some-space", AllowSSH: true},
+					v2action.Warnings{"get-space-warning"},
+					nil)
+			})
+
+			It("targets the org and space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Targeted org some-org"))
+				Expect(testUI.Out).To(Say("Targeted space some-space"))
+				Expect(testUI.Err).To(Say("get-org-warning"))
+				Expect(testUI.Err).To(Say("get-space-warning"))
+
+				Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+				orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(spaceName).To(Equal("some-space"))
+
+				Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
+				guid, name := fakeConfig.SetOrganizationInformationArgsForCall(0)
+				Expect(guid).To(Equal("some-org-guid"))
+				Expect(name).To(Equal("some-org"))
+
+				Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(1))
+				guid, name, allowSSH := fakeConfig.SetSpaceInformationArgsForCall(0)
+				Expect(guid).To(Equal("some-space-guid"))
+				Expect(name).To(Equal("some-space"))
+				Expect(allowSSH).To(BeTrue())
+			})
+
+			Context("when the org cannot be found", func() {
+				BeforeEach(func() {
+					fakeActor.GetOrganizationByNameReturns(
+						v2action.Organization{},
+						v2action.Warnings{"get-org-warning"},
+						v2action.OrganizationNotFoundError{Name: "some-org"})
+				})
+
+				It("returns an OrganizationNotFoundError and does not target anything", func() {
+					Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-org"}))
+					Expect(testUI.Err).To(Say("get-org-warning"))
+					Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+					Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when getting the space fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get space error")
+					fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{}, nil, expectedErr)
+				})
+
+				It("returns the error and only targets the org", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
+					Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
@@ -3,26 +3,131 @@ package v2
 import (
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . MapRouteActor
+
+type MapRouteActor interface {
+	BindRouteToApplication(routeGUID string, appGUID string) (v2action.Warnings, error)
+	CloudControllerAPIVersion() string
+	CreateRouteWithExistenceCheck(orgGUID string, spaceName string, route v2action.Route, generatePort bool) (v2action.Route, v2action.Warnings, error)
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+}
+
 type MapRouteCommand struct {
 	RequiredArgs    flag.AppDomain `positional-args:"yes"`
 	Hostname        string         `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
 	Path            string         `long:"path" description:"Path for the HTTP route"`
-	Port            int            `long:"port" description:"Port for the TCP route"`
+	Port            flag.Port      `long:"port" description:"Port for the TCP route"`
 	RandomPort      bool           `long:"random-port" description:"Create a random port for the TCP route"`
 	usage           interface{}    `usage:"Map an HTTP route:\n      CF_NAME map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH]\n\n   Map a TCP route:\n      CF_NAME map-route APP_NAME DOMAIN (--port PORT | --random-port)\n\nEXAMPLES:\n   CF_NAME map-route my-app example.com                              # example.com\n   CF_NAME map-route my-app example.com --hostname myhost            # myhost.example.com\n   CF_NAME map-route my-app example.com --hostname myhost --path foo # myhost.example.com/foo\n   CF_NAME map-route my-app example.com --port 5000                  # example.com:5000"`
 	relatedCommands interface{}    `related_commands:"create-route, routes"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       MapRouteActor
 }
 
-func (MapRouteCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *MapRouteCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (MapRouteCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd MapRouteCommand) Execute(args []string) error {
+	if !cmd.Config.Experimental() {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+	err := validateRouteArguments(cmd.Hostname, cmd.Path, cmd.Port, cmd.RandomPort)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = checkRouteFlagVersions(cmd.Actor.CloudControllerAPIVersion(), cmd.Path, cmd.Port, cmd.RandomPort)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.App, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	route := v2action.Route{
+		Domain: v2action.Domain{Name: cmd.RequiredArgs.Domain},
+		Host:   cmd.Hostname,
+		Path:   cmd.Path,
+		Port:   cmd.Port.NullInt,
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating route {{.Route}} for org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Route":     route,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	mappedRoute, warnings, err := cmd.Actor.CreateRouteWithExistenceCheck(cmd.Config.TargetedOrganization().GUID, cmd.Config.TargetedSpace().Name, route, cmd.RandomPort)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		alreadyExistsErr, ok := err.(v2action.RouteAlreadyExistsError)
+		if !ok {
+			return shared.HandleError(err)
+		}
+
+		cmd.UI.DisplayWarning("Route {{.Route}} already exists.", map[string]interface{}{
+			"Route": route,
+		})
+		mappedRoute = alreadyExistsErr.Route
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayTextWithFlavor("Adding route {{.Route}} to app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Route":     mappedRoute,
+		"AppName":   app.Name,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	warnings, err = cmd.Actor.BindRouteToApplication(mappedRoute.GUID, app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
 	return nil
 }
@@ -0,0 +1,194 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("map-route Command", func() {
+	var (
+		cmd             MapRouteCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeMapRouteActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeMapRouteActor)
+
+		cmd = MapRouteCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.App = "some-app"
+		cmd.RequiredArgs.Domain = "some-domain"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.ExperimentalReturns(true)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionTCPRouting)
+		fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid", Name: "some-app"}, v2action.Warnings{"get-app-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --port and --random-port are both provided", func() {
+		BeforeEach(func() {
+			cmd.Port = flag.Port{NullInt: types.NullInt{IsSet: true, Value: 1024}}
+			cmd.RandomPort = true
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--port", "--random-port"}}))
+		})
+	})
+
+	Context("when --random-port is provided and the API does not support TCP routing", func() {
+		BeforeEach(func() {
+			cmd.RandomPort = true
+			fakeActor.CloudControllerAPIVersionReturns("2.50.0")
+		})
+
+		It("returns a minimum version error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '--random-port'",
+				CurrentVersion: "2.50.0",
+				MinimumVersion: version.MinVersionTCPRouting,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"get-app-warning"}, v2action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns a translatable error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(fakeActor.CreateRouteWithExistenceCheckCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a random port is requested for a TCP domain", func() {
+		BeforeEach(func() {
+			cmd.RandomPort = true
+			fakeActor.CreateRouteWithExistenceCheckReturns(v2action.Route{
+				GUID:   "some-route-guid",
+				Domain: v2action.Domain{Name: "some-domain", RouterGroupType: "tcp"},
+				Port:   types.NullInt{IsSet: true, Value: 1034},
+			}, v2action.Warnings{"create-route-warning"}, nil)
+			fakeActor.BindRouteToApplicationReturns(v2action.Warnings{"bind-warning"}, nil)
+		})
+
+		It("creates the route with a generated port and maps it to the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Creating route some-domain for org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`Adding route some-domain:1034 to app some-app in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("create-route-warning"))
+			Expect(testUI.Err).To(Say("bind-warning"))
+
+			orgGUID, spaceName, route, generatePort := fakeActor.CreateRouteWithExistenceCheckArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(route.Domain.Name).To(Equal("some-domain"))
+			Expect(route.Port.IsSet).To(BeFalse())
+			Expect(generatePort).To(BeTrue())
+
+			routeGUID, appGUID := fakeActor.BindRouteToApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("some-route-guid"))
+			Expect(appGUID).To(Equal("some-app-guid"))
+		})
+	})
+
+	Context("when the route already exists", func() {
+		BeforeEach(func() {
+			cmd.Port = flag.Port{NullInt: types.NullInt{IsSet: true, Value: 1024}}
+			fakeActor.CreateRouteWithExistenceCheckReturns(v2action.Route{}, nil, v2action.RouteAlreadyExistsError{
+				Route: v2action.Route{
+					GUID:   "existing-route-guid",
+					Domain: v2action.Domain{Name: "some-domain"},
+					Port:   types.NullInt{IsSet: true, Value: 1024},
+				},
+			})
+		})
+
+		It("maps the existing route to the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say(`Route some-domain:1024 already exists\.`))
+			Expect(testUI.Out).To(Say(`Adding route some-domain:1024 to app some-app`))
+
+			routeGUID, _ := fakeActor.BindRouteToApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("existing-route-guid"))
+		})
+	})
+
+	Context("when HTTP options are used with a TCP domain", func() {
+		BeforeEach(func() {
+			cmd.Hostname = "some-host"
+			fakeActor.CreateRouteWithExistenceCheckReturns(v2action.Route{}, nil, v2action.InvalidHTTPRouteSettings{Domain: "some-domain"})
+		})
+
+		It("returns a translatable error without mapping", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidHTTPRouteSettings{Domain: "some-domain"}))
+			Expect(fakeActor.BindRouteToApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when binding the route fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("bind failed")
+			fakeActor.BindRouteToApplicationReturns(nil, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+})
@@ -1,23 +1,128 @@
 package v2
 
 import (
+	"encoding/json"
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . MarketplaceActor
+
+type MarketplaceActor interface {
+	GetServicePlansByServiceLabel(label string) ([]v2action.ServicePlan, v2action.Warnings, error)
+}
+
 type MarketplaceCommand struct {
 	ServicePlanInfo string      `short:"s" description:"Show plan details for a particular service offering"`
-	usage           interface{} `usage:"CF_NAME marketplace [-s SERVICE]"`
+	ShowSchemas     bool        `long:"show-schemas" description:"Show the JSON schemas of the configuration parameters accepted by each plan (requires -s)"`
+	usage           interface{} `usage:"CF_NAME marketplace [-s SERVICE [--show-schemas]]"`
 	relatedCommands interface{} `related_commands:"create-service, services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       MarketplaceActor
 }
 
-func (MarketplaceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *MarketplaceCommand) Setup(config command.Config, ui command.UI) error {
+	if !cmd.ShowSchemas {
+		return nil
+	}
+
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (MarketplaceCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd MarketplaceCommand) Execute(args []string) error {
+	if !cmd.ShowSchemas {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	if cmd.ServicePlanInfo == "" {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--show-schemas",
+			Arg2: "-s",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting plan schemas for service offering {{.ServiceName}} as {{.Username}}...", map[string]interface{}{
+		"ServiceName": cmd.ServicePlanInfo,
+		"Username":    user.Name,
+	})
+
+	servicePlans, warnings, err := cmd.Actor.GetServicePlansByServiceLabel(cmd.ServicePlanInfo)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	for _, plan := range servicePlans {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("plan: {{.PlanName}}", map[string]interface{}{
+			"PlanName": plan.Name,
+		})
+
+		err = cmd.displaySchema("service instance create parameters:", plan.Schemas.ServiceInstanceCreate)
+		if err != nil {
+			return err
+		}
+		err = cmd.displaySchema("service instance update parameters:", plan.Schemas.ServiceInstanceUpdate)
+		if err != nil {
+			return err
+		}
+		err = cmd.displaySchema("service binding create parameters:", plan.Schemas.ServiceBindingCreate)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd MarketplaceCommand) displaySchema(header string, schema map[string]interface{}) error {
+	cmd.UI.DisplayText(header)
+
+	if len(schema) == 0 {
+		cmd.UI.DisplayText("none")
+		return nil
+	}
+
+	prettySchema, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("{{.Schema}}", map[string]interface{}{
+		"Schema": string(prettySchema),
+	})
+
 	return nil
 }
@@ -0,0 +1,166 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("marketplace Command", func() {
+	var (
+		cmd             MarketplaceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeMarketplaceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeMarketplaceActor)
+
+		cmd = MarketplaceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ShowSchemas: true,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --show-schemas is provided without -s", func() {
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--show-schemas",
+				Arg2: "-s",
+			}))
+			Expect(fakeActor.GetServicePlansByServiceLabelCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --show-schemas and -s are provided", func() {
+		BeforeEach(func() {
+			cmd.ServicePlanInfo = "some-service"
+		})
+
+		Context("when checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeFalse())
+				Expect(checkTargetedSpace).To(BeFalse())
+			})
+		})
+
+		Context("when getting the current user fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("getting user failed")
+				fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when the service offering does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetServicePlansByServiceLabelReturns(
+					nil,
+					v2action.Warnings{"warning-1", "warning-2"},
+					v2action.ServiceNotFoundError{Label: "some-service"})
+			})
+
+			It("returns a translatable error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceNotFoundError{Label: "some-service"}))
+
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("warning-2"))
+			})
+		})
+
+		Context("when the plans are returned", func() {
+			BeforeEach(func() {
+				fakeActor.GetServicePlansByServiceLabelReturns(
+					[]v2action.ServicePlan{
+						{
+							Name: "plan-1",
+							Schemas: ccv2.ServicePlanSchemas{
+								ServiceInstanceCreate: map[string]interface{}{
+									"type": "object",
+									"properties": map[string]interface{}{
+										"size": map[string]interface{}{"type": "integer"},
+									},
+								},
+								ServiceBindingCreate: map[string]interface{}{
+									"type": "object",
+								},
+							},
+						},
+						{
+							Name: "plan-2",
+						},
+					},
+					v2action.Warnings{"warning-1", "warning-2"},
+					nil)
+			})
+
+			It("displays the schemas of each plan and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetServicePlansByServiceLabelCallCount()).To(Equal(1))
+				Expect(fakeActor.GetServicePlansByServiceLabelArgsForCall(0)).To(Equal("some-service"))
+
+				Expect(testUI.Out).To(Say("Getting plan schemas for service offering some-service as some-user\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(testUI.Out).To(Say("plan: plan-1"))
+				Expect(testUI.Out).To(Say("service instance create parameters:"))
+				Expect(testUI.Out).To(Say(`\{\n  "properties": \{\n    "size": \{\n      "type": "integer"\n    \}\n  \},\n  "type": "object"\n\}`))
+				Expect(testUI.Out).To(Say("service instance update parameters:"))
+				Expect(testUI.Out).To(Say("none"))
+				Expect(testUI.Out).To(Say("service binding create parameters:"))
+				Expect(testUI.Out).To(Say(`\{\n  "type": "object"\n\}`))
+
+				Expect(testUI.Out).To(Say("plan: plan-2"))
+				Expect(testUI.Out).To(Say("service instance create parameters:\nnone"))
+				Expect(testUI.Out).To(Say("service instance update parameters:\nnone"))
+				Expect(testUI.Out).To(Say("service binding create parameters:\nnone"))
+
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("warning-2"))
+			})
+		})
+	})
+})
@@ -1,20 +1,29 @@
 package v2
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
 //go:generate counterfeiter . OauthTokenActor
 
 type OauthTokenActor interface {
+	DecodeAccessToken(accessToken string) (v2action.TokenClaims, error)
+	IntrospectAccessToken(accessToken string) (v2action.TokenClaims, error)
 	RefreshAccessToken(refreshToken string) (string, error)
 }
 
 type OauthTokenCommand struct {
-	usage           interface{} `usage:"CF_NAME oauth-token"`
+	Decode          bool        `long:"decode" description:"Display the user, client, scopes and expiry of the token instead of the token itself"`
+	Introspect      bool        `long:"introspect" description:"Ask UAA whether the token is active and display the claims it reports (requires the uaa.resource scope)"`
+	usage           interface{} `usage:"CF_NAME oauth-token [--decode | --introspect]"`
 	relatedCommands interface{} `related_commands:"curl"`
 
 	UI          command.UI
@@ -38,6 +47,12 @@ func (cmd *OauthTokenCommand) Setup(config command.Config, ui command.UI) error
 }
 
 func (cmd OauthTokenCommand) Execute(_ []string) error {
+	if cmd.Decode && cmd.Introspect {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--decode", "--introspect"},
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
 	if err != nil {
 		return shared.HandleError(err)
@@ -48,6 +63,47 @@ func (cmd OauthTokenCommand) Execute(_ []string) error {
 		return shared.HandleError(err)
 	}
 
-	cmd.UI.DisplayText(accessToken)
+	switch {
+	case cmd.Decode:
+		claims, err := cmd.Actor.DecodeAccessToken(accessToken)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		cmd.displayClaims(claims, false)
+	case cmd.Introspect:
+		claims, err := cmd.Actor.IntrospectAccessToken(accessToken)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		cmd.displayClaims(claims, true)
+	default:
+		cmd.UI.DisplayText(accessToken)
+	}
+
 	return nil
 }
+
+func (cmd OauthTokenCommand) displayClaims(claims v2action.TokenClaims, introspected bool) {
+	var table [][]string
+	if introspected {
+		table = append(table, []string{cmd.UI.TranslateText("active:"), strconv.FormatBool(claims.Active)})
+	}
+	table = append(table,
+		[]string{cmd.UI.TranslateText("user name:"), claims.UserName},
+		[]string{cmd.UI.TranslateText("user id:"), claims.UserID},
+		[]string{cmd.UI.TranslateText("client id:"), claims.ClientID},
+		[]string{cmd.UI.TranslateText("issuer:"), claims.Issuer},
+		[]string{cmd.UI.TranslateText("issued at:"), formatClaimTime(claims.IssuedAt)},
+		[]string{cmd.UI.TranslateText("expires at:"), formatClaimTime(claims.ExpiresAt)},
+		[]string{cmd.UI.TranslateText("scopes:"), strings.Join(claims.Scopes, ", ")},
+	)
+
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+}
+
+func formatClaimTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Local().Format(time.RFC1123)
+}
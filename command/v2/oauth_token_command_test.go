@@ -2,8 +2,10 @@ package v2_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
@@ -46,6 +48,20 @@ var _ = Describe("oauth-token command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when both --decode and --introspect are passed", func() {
+		BeforeEach(func() {
+			cmd.Decode = true
+			cmd.Introspect = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--decode", "--introspect"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when checking the target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
@@ -98,5 +114,101 @@ var _ = Describe("oauth-token command", func() {
 				Expect(fakeActor.RefreshAccessTokenArgsForCall(0)).To(Equal("existing-refresh-token"))
 			})
 		})
+
+		Context("when --decode is passed", func() {
+			BeforeEach(func() {
+				cmd.Decode = true
+				fakeActor.RefreshAccessTokenReturns("new-access-token", nil)
+			})
+
+			Context("when the token can be decoded", func() {
+				BeforeEach(func() {
+					fakeActor.DecodeAccessTokenReturns(v2action.TokenClaims{
+						ClientID:  "cf",
+						ExpiresAt: time.Unix(1500000600, 0),
+						IssuedAt:  time.Unix(1500000000, 0),
+						Issuer:    "https://uaa.example.com/oauth/token",
+						Scopes:    []string{"cloud_controller.read", "openid"},
+						UserID:    "some-user-guid",
+						UserName:  "some-user",
+					}, nil)
+				})
+
+				It("displays the claims of the refreshed token", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeActor.DecodeAccessTokenCallCount()).To(Equal(1))
+					Expect(fakeActor.DecodeAccessTokenArgsForCall(0)).To(Equal("new-access-token"))
+					Expect(fakeActor.IntrospectAccessTokenCallCount()).To(Equal(0))
+
+					Expect(testUI.Out).ToNot(Say("new-access-token"))
+					Expect(testUI.Out).ToNot(Say("active:"))
+					Expect(testUI.Out).To(Say(`user name:\s+some-user`))
+					Expect(testUI.Out).To(Say(`user id:\s+some-user-guid`))
+					Expect(testUI.Out).To(Say(`client id:\s+cf`))
+					Expect(testUI.Out).To(Say(`issuer:\s+https://uaa.example.com/oauth/token`))
+					Expect(testUI.Out).To(Say(`issued at:\s+%s`, time.Unix(1500000000, 0).Local().Format(time.RFC1123)))
+					Expect(testUI.Out).To(Say(`expires at:\s+%s`, time.Unix(1500000600, 0).Local().Format(time.RFC1123)))
+					Expect(testUI.Out).To(Say(`scopes:\s+cloud_controller.read, openid`))
+				})
+			})
+
+			Context("when the token cannot be decoded", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("decode error")
+					fakeActor.DecodeAccessTokenReturns(v2action.TokenClaims{}, expectedErr)
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+				})
+			})
+		})
+
+		Context("when --introspect is passed", func() {
+			BeforeEach(func() {
+				cmd.Introspect = true
+				fakeActor.RefreshAccessTokenReturns("new-access-token", nil)
+			})
+
+			Context("when UAA reports on the token", func() {
+				BeforeEach(func() {
+					fakeActor.IntrospectAccessTokenReturns(v2action.TokenClaims{
+						Active:   true,
+						ClientID: "cf",
+						Scopes:   []string{"uaa.resource"},
+						UserName: "some-user",
+					}, nil)
+				})
+
+				It("displays whether the token is active and the claims UAA reports", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeActor.IntrospectAccessTokenCallCount()).To(Equal(1))
+					Expect(fakeActor.IntrospectAccessTokenArgsForCall(0)).To(Equal("new-access-token"))
+					Expect(fakeActor.DecodeAccessTokenCallCount()).To(Equal(0))
+
+					Expect(testUI.Out).To(Say(`active:\s+true`))
+					Expect(testUI.Out).To(Say(`user name:\s+some-user`))
+					Expect(testUI.Out).To(Say(`client id:\s+cf`))
+					Expect(testUI.Out).To(Say(`scopes:\s+uaa.resource`))
+				})
+			})
+
+			Context("when UAA returns an error", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("introspect error")
+					fakeActor.IntrospectAccessTokenReturns(v2action.TokenClaims{}, expectedErr)
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+				})
+			})
+		})
 	})
 })
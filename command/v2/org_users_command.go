@@ -1,25 +1,119 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . OrgUsersActor
+
+type OrgUsersActor interface {
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetOrgUsers(orgGUID string) ([]v2action.User, v2action.Warnings, error)
+	GetOrgUsersByRole(orgGUID string) (map[v2action.OrgRole][]v2action.User, v2action.Warnings, error)
+}
+
 type OrgUsersCommand struct {
 	RequiredArgs    flag.Organization `positional-args:"yes"`
 	AllUsers        bool              `short:"a" description:"List all users in the org"`
 	usage           interface{}       `usage:"CF_NAME org-users ORG"`
 	relatedCommands interface{}       `related_commands:"orgs"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       OrgUsersActor
 }
 
-func (OrgUsersCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *OrgUsersCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (OrgUsersCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd OrgUsersCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting users in org {{.TargetOrg}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TargetOrg":   cmd.RequiredArgs.Organization,
+		"CurrentUser": user.Name,
+	})
+
+	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.RequiredArgs.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.AllUsers {
+		users, warnings, err := cmd.Actor.GetOrgUsers(org.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		displayRoleUsers(cmd.UI, "USERS", users)
+		return nil
+	}
+
+	usersByRole, warnings, err := cmd.Actor.GetOrgUsersByRole(org.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	displayRoleUsers(cmd.UI, "ORG MANAGER", usersByRole[v2action.OrgRoleManager])
+	displayRoleUsers(cmd.UI, "BILLING MANAGER", usersByRole[v2action.OrgRoleBillingManager])
+	displayRoleUsers(cmd.UI, "ORG AUDITOR", usersByRole[v2action.OrgRoleAuditor])
+
 	return nil
 }
+
+// displayRoleUsers displays the users holding a role under the role's
+// heading. A user's origin is shown when it is needed to tell them apart from
+// another user with the same username.
+func displayRoleUsers(ui command.UI, roleName string, users []v2action.User) {
+	ui.DisplayNewline()
+	ui.DisplayHeader(ui.TranslateText(roleName))
+
+	if len(users) == 0 {
+		ui.DisplayText("  {{.Message}}", map[string]interface{}{
+			"Message": ui.TranslateText("No {{.Role}} found", map[string]interface{}{
+				"Role": ui.TranslateText(roleName),
+			}),
+		})
+		return
+	}
+
+	for _, user := range users {
+		if user.Origin != "" {
+			ui.DisplayText("  {{.Username}} ({{.Origin}})", map[string]interface{}{
+				"Username": user.Username,
+				"Origin":   user.Origin,
+			})
+		} else {
+			ui.DisplayText("  {{.Username}}", map[string]interface{}{
+				"Username": user.Username,
+			})
+		}
+	}
+}
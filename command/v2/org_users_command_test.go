@@ -0,0 +1,151 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("org-users Command", func() {
+	var (
+		cmd             OrgUsersCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeOrgUsersActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeOrgUsersActor)
+
+		cmd = OrgUsersCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Organization = "some-org"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetOrganizationByNameReturns(v2action.Organization{GUID: "some-org-guid"}, v2action.Warnings{"get-org-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the organization does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationByNameReturns(v2action.Organization{}, v2action.Warnings{"get-org-warning"}, v2action.OrganizationNotFoundError{Name: "some-org"})
+		})
+
+		It("returns an OrganizationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-org"}))
+			Expect(testUI.Err).To(Say("get-org-warning"))
+		})
+	})
+
+	Context("when listing users by role", func() {
+		Context("when getting the users succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrgUsersByRoleReturns(map[v2action.OrgRole][]v2action.User{
+					v2action.OrgRoleManager: {
+						{GUID: "manager-guid-1", Username: "some-manager", Origin: "uaa"},
+						{GUID: "manager-guid-2", Username: "some-manager", Origin: "ldap"},
+					},
+					v2action.OrgRoleAuditor: {
+						{GUID: "auditor-guid", Username: "some-auditor"},
+					},
+				}, v2action.Warnings{"get-users-warning"}, nil)
+			})
+
+			It("displays the users of each role with their origins when needed", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Getting users in org some-org as some-user\\.\\.\\."))
+				Expect(testUI.Out).To(Say("ORG MANAGER"))
+				Expect(testUI.Out).To(Say("  some-manager \\(uaa\\)"))
+				Expect(testUI.Out).To(Say("  some-manager \\(ldap\\)"))
+				Expect(testUI.Out).To(Say("BILLING MANAGER"))
+				Expect(testUI.Out).To(Say("  No BILLING MANAGER found"))
+				Expect(testUI.Out).To(Say("ORG AUDITOR"))
+				Expect(testUI.Out).To(Say("  some-auditor\n"))
+
+				Expect(testUI.Err).To(Say("get-org-warning"))
+				Expect(testUI.Err).To(Say("get-users-warning"))
+
+				Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+				Expect(fakeActor.GetOrgUsersByRoleArgsForCall(0)).To(Equal("some-org-guid"))
+				Expect(fakeActor.GetOrgUsersCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the users fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get users error")
+				fakeActor.GetOrgUsersByRoleReturns(nil, v2action.Warnings{"get-users-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("get-users-warning"))
+			})
+		})
+	})
+
+	Context("when the -a flag is provided", func() {
+		BeforeEach(func() {
+			cmd.AllUsers = true
+			fakeActor.GetOrgUsersReturns([]v2action.User{
+				{GUID: "user-guid-1", Username: "some-user-1"},
+				{GUID: "user-guid-2", Username: "some-user-2"},
+			}, v2action.Warnings{"get-users-warning"}, nil)
+		})
+
+		It("displays every user in the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("USERS"))
+			Expect(testUI.Out).To(Say("  some-user-1"))
+			Expect(testUI.Out).To(Say("  some-user-2"))
+			Expect(testUI.Out).NotTo(Say("ORG MANAGER"))
+			Expect(testUI.Err).To(Say("get-users-warning"))
+
+			Expect(fakeActor.GetOrgUsersArgsForCall(0)).To(Equal("some-org-guid"))
+			Expect(fakeActor.GetOrgUsersByRoleCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -3,19 +3,66 @@ package v2
 import (
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/presenter"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
+//go:generate counterfeiter . OrgsActor
+
+type OrgsActor interface {
+	GetOrganizations() ([]v2action.Organization, v2action.Warnings, error)
+}
+
 type OrgsCommand struct {
 	usage interface{} `usage:"CF_NAME orgs"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       OrgsActor
 }
 
-func (OrgsCommand) Setup(config command.Config, ui command.UI) error {
+// Setup only creates an actor when the output format is JSON; text output is
+// displayed by the legacy implementation.
+func (cmd *OrgsCommand) Setup(config command.Config, ui command.UI) error {
+	if config.OutputFormat() != configv3.OutputFormatJSON {
+		return nil
+	}
+
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (OrgsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
-	return nil
+func (cmd OrgsCommand) Execute(args []string) error {
+	if cmd.Actor == nil {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	orgs, warnings, err := cmd.Actor.GetOrganizations()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	return presenter.Display(cmd.UI, presenter.NewOrganizationsDocument(orgs))
 }
@@ -3,24 +3,99 @@ package v2
 import (
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . PurgeServiceOfferingActor
+
+type PurgeServiceOfferingActor interface {
+	GetServiceByLabelAndProvider(label string, provider string) (v2action.Service, v2action.Warnings, error)
+	StartPurgeService(serviceGUID string) (v2action.Job, v2action.Warnings, error)
+}
+
 type PurgeServiceOfferingCommand struct {
 	RequiredArgs    flag.Service `positional-args:"yes"`
 	Force           bool         `short:"f" description:"Force deletion without confirmation"`
 	Provider        string       `short:"p" description:"Provider"`
-	usage           interface{}  `usage:"CF_NAME purge-service-offering SERVICE [-p PROVIDER] [-f]\n\nWARNING: This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup."`
+	Detach          bool         `long:"detach" description:"Return once the purge has started instead of waiting for it to finish"`
+	usage           interface{}  `usage:"CF_NAME purge-service-offering SERVICE [-p PROVIDER] [-f] [--detach]\n\nWARNING: This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup."`
 	relatedCommands interface{}  `related_commands:"marketplace, purge-service-instance, service-brokers"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       PurgeServiceOfferingActor
 }
 
-func (PurgeServiceOfferingCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *PurgeServiceOfferingCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (PurgeServiceOfferingCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd PurgeServiceOfferingCommand) Execute(args []string) error {
+	if !cmd.Detach {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	service, warnings, err := cmd.Actor.GetServiceByLabelAndProvider(cmd.RequiredArgs.Service, cmd.Provider)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.ServiceNotFoundError); ok {
+			cmd.UI.DisplayWarning("Service offering does not exist\nTIP: If you are trying to purge a v1 service offering, you must set the -p flag.")
+			return nil
+		}
+		return shared.HandleError(err)
+	}
+
+	if !cmd.Force {
+		cmd.UI.DisplayWarning("WARNING: This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup.")
+		purge, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really purge service offering {{.ServiceName}} from Cloud Foundry?", map[string]interface{}{
+			"ServiceName": cmd.RequiredArgs.Service,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !purge {
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayText("Purging service {{.ServiceName}}...", map[string]interface{}{
+		"ServiceName": cmd.RequiredArgs.Service,
+	})
+
+	job, warnings, err := cmd.Actor.StartPurgeService(service.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	if job.GUID != "" {
+		shared.RecordDetachedJob(cmd.UI, cmd.Config, job.GUID, "purge-service-offering", cmd.RequiredArgs.Service)
+	}
+
 	return nil
 }
@@ -0,0 +1,160 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("purge-service-offering Command", func() {
+	var (
+		cmd             PurgeServiceOfferingCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakePurgeServiceOfferingActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakePurgeServiceOfferingActor)
+
+		cmd = PurgeServiceOfferingCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.Service = "some-service"
+		cmd.Detach = true
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the user is logged in", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceByLabelAndProviderReturns(
+				v2action.Service{GUID: "some-service-guid", Label: "some-service"},
+				v2action.Warnings{"get-service-warning"},
+				nil)
+			fakeActor.StartPurgeServiceReturns(
+				v2action.Job{GUID: "some-job-guid"},
+				v2action.Warnings{"purge-warning"},
+				nil)
+		})
+
+		Context("when the service offering does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceByLabelAndProviderReturns(v2action.Service{}, v2action.Warnings{"get-service-warning"}, v2action.ServiceNotFoundError{Label: "some-service"})
+			})
+
+			It("displays a warning and does not purge anything", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("get-service-warning"))
+				Expect(testUI.Err).To(Say("Service offering does not exist"))
+				Expect(fakeActor.StartPurgeServiceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user declines the prompt", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not purge the service offering", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("WARNING: This operation assumes that the service broker responsible for this service offering is no longer available"))
+				Expect(testUI.Out).To(Say(`Really purge service offering some-service from Cloud Foundry\? \[yN\]`))
+				Expect(fakeActor.StartPurgeServiceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the '-f' flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+				cmd.Provider = "some-provider"
+			})
+
+			It("starts the purge and records the job", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				label, provider := fakeActor.GetServiceByLabelAndProviderArgsForCall(0)
+				Expect(label).To(Equal("some-service"))
+				Expect(provider).To(Equal("some-provider"))
+				Expect(fakeActor.StartPurgeServiceArgsForCall(0)).To(Equal("some-service-guid"))
+
+				Expect(testUI.Out).To(Say("Purging service some-service..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Job some-job-guid is running in the background. Use 'faceman jobs' to check its status."))
+				Expect(testUI.Err).To(Say("get-service-warning"))
+				Expect(testUI.Err).To(Say("purge-warning"))
+
+				Expect(fakeConfig.AddDetachedJobCallCount()).To(Equal(1))
+				job := fakeConfig.AddDetachedJobArgsForCall(0)
+				Expect(job.GUID).To(Equal("some-job-guid"))
+				Expect(job.Operation).To(Equal("purge-service-offering"))
+				Expect(job.Resource).To(Equal("some-service"))
+			})
+
+			Context("when the Cloud Controller purges the service right away", func() {
+				BeforeEach(func() {
+					fakeActor.StartPurgeServiceReturns(v2action.Job{}, nil, nil)
+				})
+
+				It("does not record a job", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(fakeConfig.AddDetachedJobCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the purge fails", func() {
+				BeforeEach(func() {
+					fakeActor.StartPurgeServiceReturns(v2action.Job{}, v2action.Warnings{"purge-warning"}, errors.New("purge-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("purge-error"))
+					Expect(testUI.Err).To(Say("purge-warning"))
+				})
+			})
+		})
+	})
+})
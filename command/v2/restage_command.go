@@ -21,6 +21,7 @@ type RestageCommand struct {
 	RequiredArgs        flag.AppName `positional-args:"yes"`
 	usage               interface{}  `usage:"CF_NAME restage APP_NAME"`
 	relatedCommands     interface{}  `related_commands:"restart"`
+	envCFStagingRetries interface{}  `environmentName:"CF_STAGING_RETRIES" environmentDescription:"Number of times to retry staging when no cell has the resources to place it" environmentDefault:"0"`
 	envCFStagingTimeout interface{}  `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
@@ -42,7 +43,10 @@ func (cmd *RestageCommand) Setup(config command.Config, ui command.UI) error {
 	}
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
 
-	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
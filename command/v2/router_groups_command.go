@@ -1,22 +1,94 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . RouterGroupsActor
+
+type RouterGroupsActor interface {
+	GetRouterGroups() ([]v2action.RouterGroup, error)
+}
+
 type RouterGroupsCommand struct {
 	usage           interface{} `usage:"CF_NAME router-groups"`
-	relatedCommands interface{} `related_commands:"create-domain, domains"`
+	relatedCommands interface{} `related_commands:"create-domain, domains, update-router-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RouterGroupsActor
 }
 
-func (RouterGroupsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RouterGroupsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	routerClient, err := shared.NewRouterClient(ccClient.RoutingEndpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+
+	actor := v2action.NewActor(ccClient, uaaClient, config)
+	actor.RouterClient = routerClient
+	cmd.Actor = actor
+
 	return nil
 }
 
-func (RouterGroupsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd RouterGroupsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting router groups as {{.CurrentUser}}...", map[string]interface{}{
+		"CurrentUser": user.Name,
+	})
+
+	routerGroups, err := cmd.Actor.GetRouterGroups()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if len(routerGroups) == 0 {
+		cmd.UI.DisplayText("No router groups found")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("type"),
+			cmd.UI.TranslateText("reservable ports"),
+		},
+	}
+	for _, routerGroup := range routerGroups {
+		table = append(table, []string{
+			routerGroup.Name,
+			routerGroup.Type,
+			routerGroup.ReservablePorts,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
 	return nil
 }
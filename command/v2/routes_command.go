@@ -1,23 +1,152 @@
 package v2
 
 import (
-	"os"
+	"fmt"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/presenter"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
+//go:generate counterfeiter . RoutesActor
+
+type RoutesActor interface {
+	GetSpaceRouteSummariesPaged(spaceGUID string, spaceName string, handlePage func([]v2action.RouteSummary) error) (v2action.Warnings, error)
+	GetOrganizationRouteSummariesPaged(orgGUID string, handlePage func([]v2action.RouteSummary) error) (v2action.Warnings, error)
+}
+
 type RoutesCommand struct {
 	OrgLevel        bool        `long:"orglevel" description:"List all the routes for all spaces of current organization"`
 	usage           interface{} `usage:"CF_NAME routes [--orglevel]"`
 	relatedCommands interface{} `related_commands:"check-route, domains, map-route, unmap-route"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RoutesActor
 }
 
-func (RoutesCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RoutesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (RoutesCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd RoutesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, !cmd.OrgLevel)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Config.OutputFormat() == configv3.OutputFormatJSON {
+		return cmd.displayRoutesJSON()
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.OrgLevel {
+		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.OrgName}} as {{.Username}} ...", map[string]interface{}{
+			"OrgName":  cmd.Config.TargetedOrganization().Name,
+			"Username": user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.OrgName}} / space {{.SpaceName}} as {{.Username}} ...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	table := shared.NewTableStream(cmd.UI, []string{
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("host"),
+		cmd.UI.TranslateText("domain"),
+		cmd.UI.TranslateText("port"),
+		cmd.UI.TranslateText("path"),
+		cmd.UI.TranslateText("type"),
+		cmd.UI.TranslateText("apps"),
+		cmd.UI.TranslateText("service"),
+	}, 3)
+
+	var routesFound bool
+	handlePage := func(routes []v2action.RouteSummary) error {
+		if len(routes) == 0 {
+			return nil
+		}
+		routesFound = true
+
+		rows := make([][]string, 0, len(routes))
+		for _, route := range routes {
+			rows = append(rows, routeRow(route))
+		}
+		table.Display(rows)
+		return nil
+	}
+
+	warnings, err := cmd.getRouteSummaries(handlePage)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !routesFound {
+		cmd.UI.DisplayText("No routes found")
+	}
+
 	return nil
 }
+
+func (cmd RoutesCommand) displayRoutesJSON() error {
+	var routes []v2action.RouteSummary
+	warnings, err := cmd.getRouteSummaries(func(page []v2action.RouteSummary) error {
+		routes = append(routes, page...)
+		return nil
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	return presenter.Display(cmd.UI, presenter.NewRoutesDocument(routes))
+}
+
+func (cmd RoutesCommand) getRouteSummaries(handlePage func([]v2action.RouteSummary) error) (v2action.Warnings, error) {
+	if cmd.OrgLevel {
+		return cmd.Actor.GetOrganizationRouteSummariesPaged(cmd.Config.TargetedOrganization().GUID, handlePage)
+	}
+	return cmd.Actor.GetSpaceRouteSummariesPaged(cmd.Config.TargetedSpace().GUID, cmd.Config.TargetedSpace().Name, handlePage)
+}
+
+func routeRow(route v2action.RouteSummary) []string {
+	var port string
+	if route.Port.IsSet {
+		port = fmt.Sprintf("%d", route.Port.Value)
+	}
+
+	return []string{
+		route.SpaceName,
+		route.Host,
+		route.Domain.Name,
+		port,
+		route.Path,
+		route.Domain.RouterGroupType,
+		strings.Join(route.ApplicationNames, ","),
+		route.ServiceInstanceName,
+	}
+}
@@ -0,0 +1,211 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("routes Command", func() {
+	var (
+		cmd             RoutesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRoutesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRoutesActor)
+
+		cmd = RoutesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+
+		Context("when --orglevel is provided", func() {
+			BeforeEach(func() {
+				cmd.OrgLevel = true
+			})
+
+			It("does not require a targeted space", func() {
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeFalse())
+			})
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when getting the current user returns an error", func() {
+			BeforeEach(func() {
+				fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("current-user-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("current-user-error"))
+			})
+		})
+
+		Context("when there are no routes", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceRouteSummariesPagedReturns(v2action.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Getting routes for org some-org / space some-space as some-user \.\.\.`))
+				Expect(testUI.Out).To(Say("No routes found"))
+				Expect(testUI.Err).To(Say("get-routes-warning"))
+			})
+		})
+
+		Context("when there are routes in the space", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceRouteSummariesPagedStub = func(spaceGUID string, spaceName string, handlePage func([]v2action.RouteSummary) error) (v2action.Warnings, error) {
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(spaceName).To(Equal("some-space"))
+
+					Expect(handlePage([]v2action.RouteSummary{
+						{
+							Route:               v2action.Route{Host: "host-1", Domain: v2action.Domain{Name: "example.com"}, Path: "/path"},
+							SpaceName:           "some-space",
+							ApplicationNames:    []string{"app-1", "app-2"},
+							ServiceInstanceName: "some-service",
+						},
+					})).To(Succeed())
+					Expect(testUI.Out).To(Say(`space\s+host\s+domain\s+port\s+path\s+type\s+apps\s+service`))
+					Expect(testUI.Out).To(Say(`some-space\s+host-1\s+example.com\s+/path\s+app-1,app-2\s+some-service`))
+
+					Expect(handlePage([]v2action.RouteSummary{
+						{
+							Route:     v2action.Route{Domain: v2action.Domain{Name: "tcp.example.com", RouterGroupType: v2action.TCPRouterGroupType}, Port: types.NullInt{Value: 1024, IsSet: true}},
+							SpaceName: "some-space",
+						},
+					})).To(Succeed())
+					return v2action.Warnings{"get-routes-warning"}, nil
+				}
+			})
+
+			It("displays each page of routes as it is retrieved", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`some-space\s+tcp.example.com\s+1024\s+tcp`))
+				Expect(testUI.Out).ToNot(Say("No routes found"))
+				Expect(testUI.Err).To(Say("get-routes-warning"))
+				Expect(fakeActor.GetOrganizationRouteSummariesPagedCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when --orglevel is provided", func() {
+			BeforeEach(func() {
+				cmd.OrgLevel = true
+				fakeActor.GetOrganizationRouteSummariesPagedStub = func(orgGUID string, handlePage func([]v2action.RouteSummary) error) (v2action.Warnings, error) {
+					Expect(orgGUID).To(Equal("some-org-guid"))
+
+					Expect(handlePage([]v2action.RouteSummary{
+						{Route: v2action.Route{Host: "host-1", Domain: v2action.Domain{Name: "example.com"}}, SpaceName: "space-1"},
+						{Route: v2action.Route{Host: "host-2", Domain: v2action.Domain{Name: "example.com"}}, SpaceName: "space-2"},
+					})).To(Succeed())
+					return v2action.Warnings{"get-routes-warning"}, nil
+				}
+			})
+
+			It("displays the routes in every space of the organization", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Getting routes for org some-org as some-user \.\.\.`))
+				Expect(testUI.Out).To(Say(`space-1\s+host-1\s+example.com`))
+				Expect(testUI.Out).To(Say(`space-2\s+host-2\s+example.com`))
+				Expect(testUI.Err).To(Say("get-routes-warning"))
+				Expect(fakeActor.GetSpaceRouteSummariesPagedCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the output format is JSON", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns("json")
+				fakeActor.GetSpaceRouteSummariesPagedStub = func(_ string, _ string, handlePage func([]v2action.RouteSummary) error) (v2action.Warnings, error) {
+					Expect(handlePage([]v2action.RouteSummary{
+						{Route: v2action.Route{GUID: "route-guid-1", Host: "host-1"}, SpaceName: "some-space"},
+					})).To(Succeed())
+					Expect(handlePage([]v2action.RouteSummary{
+						{Route: v2action.Route{GUID: "route-guid-2", Host: "host-2"}, SpaceName: "some-space"},
+					})).To(Succeed())
+					return v2action.Warnings{"get-routes-warning"}, nil
+				}
+			})
+
+			It("displays one document with the routes from every page", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting routes"))
+				Expect(testUI.Out).To(Say(`"kind": "routes"`))
+				Expect(testUI.Out).To(Say(`"guid": "route-guid-1"`))
+				Expect(testUI.Out).To(Say(`"guid": "route-guid-2"`))
+				Expect(testUI.Err).To(Say("get-routes-warning"))
+
+				Expect(fakeConfig.CurrentUserCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceRouteSummariesPagedReturns(v2action.Warnings{"get-routes-warning"}, errors.New("get-routes-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("get-routes-error"))
+				Expect(testUI.Err).To(Say("get-routes-warning"))
+			})
+		})
+	})
+})
@@ -1,22 +1,102 @@
 package v2
 
 import (
-	"os"
+	"encoding/json"
+	"sort"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . EnvironmentVariableGroupActor
+
+type EnvironmentVariableGroupActor interface {
+	GetEnvironmentVariableGroup(name ccv2.EnvironmentVariableGroupName) (v2action.EnvironmentVariableGroup, v2action.Warnings, error)
+}
+
 type RunningEnvironmentVariableGroupCommand struct {
 	usage           interface{} `usage:"CF_NAME running-environment-variable-group"`
 	relatedCommands interface{} `related_commands:"env, staging-environment-variable-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       EnvironmentVariableGroupActor
 }
 
-func (RunningEnvironmentVariableGroupCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RunningEnvironmentVariableGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (RunningEnvironmentVariableGroupCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd RunningEnvironmentVariableGroupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Retrieving the contents of the running environment variable group as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+
+	group, warnings, err := cmd.Actor.GetEnvironmentVariableGroup(ccv2.EnvironmentVariableGroupRunning)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	displayEnvironmentVariableGroup(cmd.UI, group)
+
 	return nil
 }
+
+// displayEnvironmentVariableGroup displays the variables of an environment
+// variable group sorted by name. Values that are not strings are displayed as
+// JSON.
+func displayEnvironmentVariableGroup(ui command.UI, group v2action.EnvironmentVariableGroup) {
+	names := make([]string, 0, len(group))
+	for name := range group {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	table := [][]string{
+		{
+			ui.TranslateText("Variable Name"),
+			ui.TranslateText("Assigned Value"),
+		},
+	}
+
+	for _, name := range names {
+		value, isString := group[name].(string)
+		if !isString {
+			valueJSON, err := json.Marshal(group[name])
+			if err != nil {
+				continue
+			}
+			value = string(valueJSON)
+		}
+		table = append(table, []string{name, value})
+	}
+
+	ui.DisplayNewline()
+	ui.DisplayTableWithHeader("", table, 3)
+}
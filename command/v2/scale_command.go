@@ -3,26 +3,48 @@ package v2
 import (
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3"
 )
 
 type ScaleCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	ForceRestart    bool         `short:"f" description:"Force restart of app without prompt"`
-	NumInstances    int          `short:"i" description:"Number of instances"`
-	DiskLimit       string       `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	MemoryLimit     string       `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	usage           interface{}  `usage:"CF_NAME scale APP_NAME [-i INSTANCES] [-k DISK] [-m MEMORY] [-f]"`
-	relatedCommands interface{}  `related_commands:"push"`
+	RequiredArgs    flag.AppName   `positional-args:"yes"`
+	ForceRestart    bool           `short:"f" description:"Force restart of app without prompt"`
+	NumInstances    flag.Instances `short:"i" description:"Number of instances"`
+	DiskLimit       flag.Megabytes `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	MemoryLimit     flag.Megabytes `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	ProcessType     string         `long:"process" description:"App process to scale (requires the v3 Cloud Controller API)"`
+	usage           interface{}    `usage:"CF_NAME scale APP_NAME [--process PROCESS] [-i INSTANCES] [-k DISK] [-m MEMORY] [-f]"`
+	relatedCommands interface{}    `related_commands:"push"`
+
+	processScale *v3.V3ScaleCommand
 }
 
-func (ScaleCommand) Setup(config command.Config, ui command.UI) error {
-	return nil
+// Setup prepares the v3 implementation when a process type is given, since
+// the v2 API has no notion of processes.
+func (cmd *ScaleCommand) Setup(config command.Config, ui command.UI) error {
+	if cmd.ProcessType == "" {
+		return nil
+	}
+
+	cmd.processScale = &v3.V3ScaleCommand{
+		RequiredArgs: cmd.RequiredArgs,
+		Force:        cmd.ForceRestart,
+		ProcessType:  cmd.ProcessType,
+		Instances:    cmd.NumInstances,
+		DiskLimit:    cmd.DiskLimit,
+		MemoryLimit:  cmd.MemoryLimit,
+	}
+	return cmd.processScale.Setup(config, ui)
 }
 
-func (ScaleCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd ScaleCommand) Execute(args []string) error {
+	if cmd.processScale != nil {
+		return cmd.processScale.Execute(args)
+	}
+
+	oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
 	return nil
 }
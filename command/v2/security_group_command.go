@@ -1,24 +1,142 @@
 package v2
 
 import (
-	"os"
+	"fmt"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . SecurityGroupActor
+
+type SecurityGroupActor interface {
+	GetSecurityGroupByName(securityGroupName string) (v2action.SecurityGroup, v2action.Warnings, error)
+	GetSecurityGroupOrganizationsAndSpaces(securityGroup v2action.SecurityGroup, includeStaging bool) ([]v2action.SecurityGroupWithOrganizationSpaceAndLifecycle, v2action.Warnings, error)
+}
+
 type SecurityGroupCommand struct {
 	RequiredArgs    flag.SecurityGroup `positional-args:"yes"`
 	usage           interface{}        `usage:"CF_NAME security-group SECURITY_GROUP"`
 	relatedCommands interface{}        `related_commands:"bind-security-group, bind-running-security-group, bind-staging-security-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SecurityGroupActor
 }
 
-func (SecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *SecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (SecurityGroupCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd SecurityGroupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting info for security group {{.SecurityGroupName}} as {{.Username}}...", map[string]interface{}{
+		"SecurityGroupName": cmd.RequiredArgs.SecurityGroup,
+		"Username":          user.Name,
+	})
+
+	securityGroup, warnings, err := cmd.Actor.GetSecurityGroupByName(cmd.RequiredArgs.SecurityGroup)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("name:"), securityGroup.Name},
+	}, 3)
+	cmd.UI.DisplayNewline()
+
+	cmd.displayRules(securityGroup.Rules)
+	cmd.UI.DisplayNewline()
+
+	secGroupOrgSpaces, warnings, err := cmd.Actor.GetSecurityGroupOrganizationsAndSpaces(securityGroup, false)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.displaySpaces(secGroupOrgSpaces)
+
 	return nil
 }
+
+func (cmd SecurityGroupCommand) displayRules(rules []ccv2.SecurityGroupRule) {
+	if len(rules) == 0 {
+		cmd.UI.DisplayText("No rules found.")
+		return
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("protocol"),
+			cmd.UI.TranslateText("destination"),
+			cmd.UI.TranslateText("ports"),
+			cmd.UI.TranslateText("description"),
+		},
+	}
+	for _, rule := range rules {
+		table = append(table, []string{
+			rule.Protocol,
+			rule.Destination,
+			rule.Ports,
+			rule.Description,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
+
+func (cmd SecurityGroupCommand) displaySpaces(secGroupOrgSpaces []v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) {
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("organization"),
+			cmd.UI.TranslateText("space"),
+		},
+	}
+	for _, secGroupOrgSpace := range secGroupOrgSpaces {
+		// Default groups and unbound groups have no space.
+		if secGroupOrgSpace.Space.Name == "" {
+			continue
+		}
+		table = append(table, []string{
+			fmt.Sprintf("#%d", len(table)-1),
+			secGroupOrgSpace.Organization.Name,
+			secGroupOrgSpace.Space.Name,
+		})
+	}
+
+	if len(table) == 1 {
+		cmd.UI.DisplayText("No spaces assigned")
+		return
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
@@ -0,0 +1,210 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("security-group Command", func() {
+	var (
+		cmd             SecurityGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSecurityGroupActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSecurityGroupActor)
+
+		cmd = SecurityGroupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.SecurityGroup = "some-security-group"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("getting user failed")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when the security group does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecurityGroupByNameReturns(
+				v2action.SecurityGroup{},
+				v2action.Warnings{"warning-1", "warning-2"},
+				v2action.SecurityGroupNotFoundError{Name: "some-security-group"})
+		})
+
+		It("returns a translatable error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SecurityGroupNotFoundError{Name: "some-security-group"}))
+
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+		})
+	})
+
+	Context("when the security group has rules", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecurityGroupByNameReturns(
+				v2action.SecurityGroup{
+					Name: "some-security-group",
+					Rules: []ccv2.SecurityGroupRule{
+						{
+							Protocol:    "tcp",
+							Destination: "10.0.0.0/8",
+							Ports:       "80,443",
+							Description: "web traffic",
+						},
+						{
+							Protocol:    "all",
+							Destination: "0.0.0.0-9.255.255.255",
+						},
+					},
+				},
+				v2action.Warnings{"warning-1", "warning-2"},
+				nil)
+		})
+
+		It("displays the security group and its rules in a table", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetSecurityGroupByNameCallCount()).To(Equal(1))
+			Expect(fakeActor.GetSecurityGroupByNameArgsForCall(0)).To(Equal("some-security-group"))
+
+			Expect(testUI.Out).To(Say("Getting info for security group some-security-group as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK\\n\\n"))
+			Expect(testUI.Out).To(Say("name:\\s+some-security-group"))
+			Expect(testUI.Out).To(Say("protocol\\s+destination\\s+ports\\s+description"))
+			Expect(testUI.Out).To(Say("tcp\\s+10\\.0\\.0\\.0/8\\s+80,443\\s+web traffic"))
+			Expect(testUI.Out).To(Say("all\\s+0\\.0\\.0\\.0-9\\.255\\.255\\.255"))
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+		})
+	})
+
+	Context("when the security group is bound to spaces", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecurityGroupByNameReturns(
+				v2action.SecurityGroup{GUID: "some-security-group-guid", Name: "some-security-group"},
+				nil,
+				nil)
+			fakeActor.GetSecurityGroupOrganizationsAndSpacesReturns(
+				[]v2action.SecurityGroupWithOrganizationSpaceAndLifecycle{
+					{
+						Organization: &v2action.Organization{Name: "org-1"},
+						Space:        &v2action.Space{Name: "space-1"},
+						Lifecycle:    ccv2.SecurityGroupLifecycleRunning,
+					},
+					{
+						Organization: &v2action.Organization{Name: "org-2"},
+						Space:        &v2action.Space{Name: "space-2"},
+						Lifecycle:    ccv2.SecurityGroupLifecycleRunning,
+					},
+				},
+				v2action.Warnings{"spaces-warning"},
+				nil)
+		})
+
+		It("displays the orgs and spaces the security group is bound to", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetSecurityGroupOrganizationsAndSpacesCallCount()).To(Equal(1))
+			securityGroup, includeStaging := fakeActor.GetSecurityGroupOrganizationsAndSpacesArgsForCall(0)
+			Expect(securityGroup.GUID).To(Equal("some-security-group-guid"))
+			Expect(includeStaging).To(BeFalse())
+
+			Expect(testUI.Out).To(Say("No rules found\\."))
+			Expect(testUI.Out).To(Say("organization\\s+space"))
+			Expect(testUI.Out).To(Say("#0\\s+org-1\\s+space-1"))
+			Expect(testUI.Out).To(Say("#1\\s+org-2\\s+space-2"))
+			Expect(testUI.Out).ToNot(Say("No spaces assigned"))
+			Expect(testUI.Err).To(Say("spaces-warning"))
+		})
+	})
+
+	Context("when getting the bound spaces fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("get spaces error")
+			fakeActor.GetSecurityGroupByNameReturns(v2action.SecurityGroup{Name: "some-security-group"}, nil, nil)
+			fakeActor.GetSecurityGroupOrganizationsAndSpacesReturns(nil, v2action.Warnings{"spaces-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("spaces-warning"))
+		})
+	})
+
+	Context("when the security group has no rules", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecurityGroupByNameReturns(
+				v2action.SecurityGroup{Name: "some-security-group"},
+				nil,
+				nil)
+		})
+
+		It("displays that no rules were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("name:\\s+some-security-group"))
+			Expect(testUI.Out).To(Say("No rules found\\."))
+			Expect(testUI.Out).ToNot(Say("protocol"))
+			Expect(testUI.Out).To(Say("No spaces assigned"))
+		})
+	})
+})
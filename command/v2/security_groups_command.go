@@ -2,12 +2,17 @@ package v2
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/presenter"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -16,10 +21,12 @@ import (
 type SecurityGroupsActor interface {
 	CloudControllerAPIVersion() string
 	GetSecurityGroupsWithOrganizationSpaceAndLifecycle(includeStaging bool) ([]v2action.SecurityGroupWithOrganizationSpaceAndLifecycle, v2action.Warnings, error)
+	GetSecurityGroupsWithOrganizationSpaceAndLifecyclePaged(includeStaging bool, handlePage func([]v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) error) (v2action.Warnings, error)
 }
 
 type SecurityGroupsCommand struct {
-	usage           interface{} `usage:"CF_NAME security-groups"`
+	Rules           bool        `long:"rules" description:"Show the rules of each security group instead of the number of rules"`
+	usage           interface{} `usage:"CF_NAME security-groups [--rules]"`
 	relatedCommands interface{} `related_commands:"bind-security-group, bind-running-security-group, bind-staging-security-group, security-group"`
 
 	SharedActor command.SharedActor
@@ -66,62 +73,103 @@ func (cmd SecurityGroupsCommand) Execute(args []string) error {
 		}
 	}
 
+	if cmd.Config.OutputFormat() == configv3.OutputFormatJSON {
+		secGroupOrgSpaces, warnings, err := cmd.Actor.GetSecurityGroupsWithOrganizationSpaceAndLifecycle(includeStaging)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		return presenter.Display(cmd.UI, presenter.NewSecurityGroupsDocument(secGroupOrgSpaces))
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Getting security groups as {{.UserName}}...",
 		map[string]interface{}{"UserName": user.Name})
 
-	secGroupOrgSpaces, warnings, err := cmd.Actor.GetSecurityGroupsWithOrganizationSpaceAndLifecycle(includeStaging)
+	table := shared.NewTableStream(cmd.UI, []string{
+		cmd.UI.TranslateText(""),
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("organization"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("lifecycle"),
+		cmd.UI.TranslateText("rules"),
+	}, 3)
+
+	// Rows are displayed a page at a time, so group numbering carries over
+	// from one page to the next.
+	currentGroupIndex := -1
+	var (
+		currentGroupName string
+		displayedOK      bool
+	)
+	warnings, err := cmd.Actor.GetSecurityGroupsWithOrganizationSpaceAndLifecyclePaged(includeStaging, func(secGroupOrgSpaces []v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) error {
+		var rows [][]string
+		for _, secGroupOrgSpace := range secGroupOrgSpaces {
+			var currentGroupIndexString, rules string
+
+			if secGroupOrgSpace.SecurityGroup.Name != currentGroupName {
+				currentGroupIndex += 1
+				currentGroupIndexString = fmt.Sprintf("#%d", currentGroupIndex)
+				currentGroupName = secGroupOrgSpace.SecurityGroup.Name
+				rules = cmd.rulesSummary(secGroupOrgSpace.SecurityGroup.Rules)
+			}
+
+			switch {
+			case secGroupOrgSpace.Organization.Name == "" && secGroupOrgSpace.Space.Name == "" &&
+				(secGroupOrgSpace.SecurityGroup.RunningDefault ||
+					secGroupOrgSpace.SecurityGroup.StagingDefault):
+				rows = append(rows, []string{
+					currentGroupIndexString,
+					secGroupOrgSpace.SecurityGroup.Name,
+					cmd.UI.TranslateText("<all>"),
+					cmd.UI.TranslateText("<all>"),
+					string(secGroupOrgSpace.Lifecycle),
+					rules,
+				})
+			default:
+				rows = append(rows, []string{
+					currentGroupIndexString,
+					secGroupOrgSpace.SecurityGroup.Name,
+					secGroupOrgSpace.Organization.Name,
+					secGroupOrgSpace.Space.Name,
+					string(secGroupOrgSpace.Lifecycle),
+					rules,
+				})
+			}
+		}
+
+		if !displayedOK {
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayNewline()
+			displayedOK = true
+		}
+		table.Display(rows)
+		return nil
+	})
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
-	cmd.UI.DisplayOK()
-	cmd.UI.DisplayNewline()
-
-	table := [][]string{
-		{
-			cmd.UI.TranslateText(""),
-			cmd.UI.TranslateText("name"),
-			cmd.UI.TranslateText("organization"),
-			cmd.UI.TranslateText("space"),
-			cmd.UI.TranslateText("lifecycle"),
-		},
+	if !displayedOK {
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayNewline()
+		table.Display(nil)
 	}
 
-	currentGroupIndex := -1
-	var currentGroupName string
-	for _, secGroupOrgSpace := range secGroupOrgSpaces {
-		var currentGroupIndexString string
-
-		if secGroupOrgSpace.SecurityGroup.Name != currentGroupName {
-			currentGroupIndex += 1
-			currentGroupIndexString = fmt.Sprintf("#%d", currentGroupIndex)
-			currentGroupName = secGroupOrgSpace.SecurityGroup.Name
-		}
+	return nil
+}
 
-		switch {
-		case secGroupOrgSpace.Organization.Name == "" && secGroupOrgSpace.Space.Name == "" &&
-			(secGroupOrgSpace.SecurityGroup.RunningDefault ||
-				secGroupOrgSpace.SecurityGroup.StagingDefault):
-			table = append(table, []string{
-				currentGroupIndexString,
-				secGroupOrgSpace.SecurityGroup.Name,
-				cmd.UI.TranslateText("<all>"),
-				cmd.UI.TranslateText("<all>"),
-				string(secGroupOrgSpace.Lifecycle),
-			})
-		default:
-			table = append(table, []string{
-				currentGroupIndexString,
-				secGroupOrgSpace.SecurityGroup.Name,
-				secGroupOrgSpace.Organization.Name,
-				secGroupOrgSpace.Space.Name,
-				string(secGroupOrgSpace.Lifecycle),
-			})
-		}
+// rulesSummary returns the number of rules, or the rules themselves when
+// --rules is provided.
+func (cmd SecurityGroupsCommand) rulesSummary(rules []ccv2.SecurityGroupRule) string {
+	if !cmd.Rules {
+		return strconv.Itoa(len(rules))
 	}
 
-	cmd.UI.DisplayTableWithHeader("", table, 3)
-
-	return nil
+	var summaries []string
+	for _, rule := range rules {
+		summaries = append(summaries, strings.TrimSpace(fmt.Sprintf("%s %s %s", rule.Protocol, rule.Destination, rule.Ports)))
+	}
+	return strings.Join(summaries, ", ")
 }
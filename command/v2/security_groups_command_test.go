@@ -90,10 +90,9 @@ var _ = Describe("security-groups Command", func() {
 			Expect(executeErr).NotTo(HaveOccurred())
 
 			Expect(fakeActor.CloudControllerAPIVersionCallCount()).To(Equal(1))
-			Expect(fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecycleCallCount()).To(Equal(1))
-			Expect(fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecycleArgsForCall(0)).To(BeFalse())
-
-			Expect(fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecycleCallCount()).To(Equal(1))
+			Expect(fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecyclePagedCallCount()).To(Equal(1))
+			includeStaging, _ := fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecyclePagedArgsForCall(0)
+			Expect(includeStaging).To(BeFalse())
 		})
 	})
 
@@ -131,10 +130,16 @@ var _ = Describe("security-groups Command", func() {
 						Space:         &v2action.Space{},
 					},
 					{
-						SecurityGroup: &v2action.SecurityGroup{Name: "seg-group-3"},
-						Organization:  &v2action.Organization{Name: "org-31"},
-						Space:         &v2action.Space{Name: "space-311"},
-						Lifecycle:     ccv2.SecurityGroupLifecycleRunning,
+						SecurityGroup: &v2action.SecurityGroup{
+							Name: "seg-group-3",
+							Rules: []ccv2.SecurityGroupRule{
+								{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443"},
+								{Protocol: "all", Destination: "0.0.0.0/0"},
+							},
+						},
+						Organization: &v2action.Organization{Name: "org-31"},
+						Space:        &v2action.Space{Name: "space-311"},
+						Lifecycle:    ccv2.SecurityGroupLifecycleRunning,
 					},
 					{
 						SecurityGroup: &v2action.SecurityGroup{
@@ -155,36 +160,92 @@ var _ = Describe("security-groups Command", func() {
 						Lifecycle:    ccv2.SecurityGroupLifecycleStaging,
 					},
 				}
-				fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecycleReturns(secGroups, v2action.Warnings{"warning-1", "warning-2"}, nil)
+				fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecyclePagedStub = func(_ bool, handlePage func([]v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) error) (v2action.Warnings, error) {
+					Expect(testUI.Out).To(Say("Getting security groups as some-user\\.\\.\\."))
+
+					Expect(handlePage(secGroups[:3])).To(Succeed())
+					Expect(testUI.Out).To(Say("OK\\n\\n"))
+					Expect(testUI.Out).To(Say("\\s+name\\s+organization\\s+space\\s+lifecycle\\s+rules"))
+					Expect(testUI.Out).To(Say("#0\\s+seg-group-1\\s+org-11\\s+space-111\\s+running"))
+
+					Expect(handlePage(secGroups[3:])).To(Succeed())
+					return v2action.Warnings{"warning-1", "warning-2"}, nil
+				}
 			})
 
 			It("displays a table containing the security groups, the spaces to which they are bound, the spaces' orgs, and the lifecycle of the app they were assigned to", func() {
 				Expect(executeErr).To(BeNil())
 
 				Expect(fakeActor.CloudControllerAPIVersionCallCount()).To(Equal(1))
-				Expect(fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecycleCallCount()).To(Equal(1))
-				Expect(fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecycleArgsForCall(0)).To(BeTrue())
+				Expect(fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecyclePagedCallCount()).To(Equal(1))
+				includeStaging, _ := fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecyclePagedArgsForCall(0)
+				Expect(includeStaging).To(BeTrue())
+				Expect(fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecycleCallCount()).To(Equal(0))
 
-				Expect(fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecycleCallCount()).To(Equal(1))
-
-				Expect(testUI.Out).To(Say("Getting security groups as some-user\\.\\.\\."))
-				Expect(testUI.Out).To(Say("OK\\n\\n"))
-				Expect(testUI.Out).To(Say("\\s+name\\s+organization\\s+space\\s+lifecycle"))
-				Expect(testUI.Out).To(Say("#0\\s+seg-group-1\\s+org-11\\s+space-111\\s+running"))
-				Expect(testUI.Out).To(Say("(?m)\\s+seg-group-1\\s+org-12\\s+space-121\\s+running"))
+				Expect(testUI.Out).To(Say("(?m)\\s+seg-group-1\\s+org-12\\s+space-121\\s+running\\s*\\n"))
 				Expect(testUI.Out).To(Say("(?m)\\s+seg-group-1\\s+org-12\\s+space-122\\s+staging"))
 				Expect(testUI.Out).To(Say("#1\\s+seg-group-2\\s+"))
-				Expect(testUI.Out).To(Say("#2\\s+seg-group-3\\s+org-31\\s+space-311\\s+running"))
+				Expect(testUI.Out).To(Say("#2\\s+seg-group-3\\s+org-31\\s+space-311\\s+running\\s+2"))
 				Expect(testUI.Out).To(Say("#3\\s+seg-group-4\\s+<all>\\s+<all>\\s+running"))
 				Expect(testUI.Out).To(Say("(?m)\\s+seg-group-4\\s+<all>\\s+<all>\\s+staging"))
 				Expect(testUI.Err).To(Say("warning-1"))
 				Expect(testUI.Err).To(Say("warning-2"))
 			})
+
+			Context("when the --rules flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Rules = true
+				})
+
+				It("displays the rules of each security group", func() {
+					Expect(executeErr).To(BeNil())
+
+					Expect(testUI.Out).To(Say("#2\\s+seg-group-3\\s+org-31\\s+space-311\\s+running\\s+tcp 10\\.0\\.0\\.0/8 443, all 0\\.0\\.0\\.0/0\\n"))
+				})
+			})
+		})
+
+		Context("when there are no security groups", func() {
+			It("displays an empty table", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("OK\\n\\n"))
+				Expect(testUI.Out).To(Say("\\s+name\\s+organization\\s+space\\s+lifecycle"))
+			})
+		})
+
+		Context("when the output format is JSON", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns("json")
+				fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecycleReturns(
+					[]v2action.SecurityGroupWithOrganizationSpaceAndLifecycle{
+						{
+							SecurityGroup: &v2action.SecurityGroup{GUID: "seg-group-1-guid", Name: "seg-group-1"},
+							Organization:  &v2action.Organization{Name: "org-11"},
+							Space:         &v2action.Space{Name: "space-111"},
+							Lifecycle:     ccv2.SecurityGroupLifecycleRunning,
+						},
+					},
+					v2action.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("displays only the security groups document on stdout and warnings on stderr", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting security groups"))
+				Expect(testUI.Out).To(Say(`"kind": "security_groups"`))
+				Expect(testUI.Out).To(Say(`"schema_version": 1`))
+				Expect(testUI.Out).To(Say(`"name": "seg-group-1"`))
+				Expect(testUI.Out).To(Say(`"space": "space-111"`))
+				Expect(testUI.Out).ToNot(Say("OK"))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
 		})
 
 		Context("when an error is encountered fetching the security groups", func() {
 			BeforeEach(func() {
-				fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecycleReturns(nil, v2action.Warnings{"warning-1", "warning-2"}, errors.New("generic"))
+				fakeActor.GetSecurityGroupsWithOrganizationSpaceAndLifecyclePagedReturns(v2action.Warnings{"warning-1", "warning-2"}, errors.New("generic"))
 			})
 
 			It("returns the error", func() {
@@ -1,24 +1,92 @@
 package v2
 
 import (
-	"os"
+	"encoding/json"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . ServiceKeyActor
+
+type ServiceKeyActor interface {
+	GetServiceKeyByNameAndServiceInstance(keyName string, serviceInstanceName string, spaceGUID string) (v2action.ServiceKey, v2action.Warnings, error)
+	GetServiceKeyCredentials(keyName string, serviceInstanceName string, spaceGUID string) (map[string]interface{}, v2action.Warnings, error)
+}
+
 type ServiceKeyCommand struct {
 	RequiredArgs flag.ServiceInstanceKey `positional-args:"yes"`
 	GUID         bool                    `long:"guid" description:"Retrieve and display the given service-key's guid.  All other output for the service is suppressed."`
 	usage        interface{}             `usage:"CF_NAME service-key SERVICE_INSTANCE SERVICE_KEY\n\nEXAMPLES:\n   CF_NAME service-key mydb mykey"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServiceKeyActor
 }
 
-func (ServiceKeyCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *ServiceKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (ServiceKeyCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd ServiceKeyCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.GUID {
+		return cmd.displayServiceKeyGUID()
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting key {{.ServiceKeyName}} for service instance {{.ServiceInstanceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ServiceKeyName":      cmd.RequiredArgs.ServiceKey,
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"CurrentUser":         user.Name,
+	})
+
+	credentials, warnings, err := cmd.Actor.GetServiceKeyCredentials(cmd.RequiredArgs.ServiceKey, cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	credentialsJSON, err := json.MarshalIndent(credentials, "", " ")
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText(string(credentialsJSON))
+
+	return nil
+}
+
+func (cmd ServiceKeyCommand) displayServiceKeyGUID() error {
+	serviceKey, warnings, err := cmd.Actor.GetServiceKeyByNameAndServiceInstance(cmd.RequiredArgs.ServiceKey, cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayText(serviceKey.GUID)
 	return nil
 }
@@ -0,0 +1,114 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-key Command", func() {
+	var (
+		cmd             ServiceKeyCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeServiceKeyActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeServiceKeyActor)
+
+		cmd = ServiceKeyCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+		cmd.RequiredArgs.ServiceKey = "some-key"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the key exists", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceKeyCredentialsReturns(
+				map[string]interface{}{"username": "some-username"},
+				v2action.Warnings{"get-key-warning"},
+				nil)
+		})
+
+		It("displays the credentials of the key as JSON", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting key some-key for service instance some-service-instance as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("\n\n"))
+			Expect(testUI.Out).To(Say(`\{\n "username": "some-username"\n\}`))
+			Expect(testUI.Err).To(Say("get-key-warning"))
+
+			Expect(fakeActor.GetServiceKeyCredentialsCallCount()).To(Equal(1))
+			keyName, serviceInstanceName, spaceGUID := fakeActor.GetServiceKeyCredentialsArgsForCall(0)
+			Expect(keyName).To(Equal("some-key"))
+			Expect(serviceInstanceName).To(Equal("some-service-instance"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	Context("when the key does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceKeyCredentialsReturns(nil, v2action.Warnings{"get-key-warning"}, v2action.ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"})
+		})
+
+		It("returns a translatable error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"}))
+			Expect(testUI.Err).To(Say("get-key-warning"))
+		})
+	})
+
+	Context("when the --guid flag is provided", func() {
+		BeforeEach(func() {
+			cmd.GUID = true
+			fakeActor.GetServiceKeyByNameAndServiceInstanceReturns(v2action.ServiceKey{GUID: "some-key-guid"}, v2action.Warnings{"get-key-warning"}, nil)
+		})
+
+		It("only displays the GUID of the key", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).NotTo(Say("Getting key"))
+			Expect(testUI.Out).To(Say("some-key-guid"))
+			Expect(testUI.Err).To(Say("get-key-warning"))
+			Expect(fakeActor.GetServiceKeyCredentialsCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -1,24 +1,79 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . ServiceKeysActor
+
+type ServiceKeysActor interface {
+	GetServiceKeysByServiceInstance(serviceInstanceName string, spaceGUID string) ([]v2action.ServiceKey, v2action.Warnings, error)
+}
+
 type ServiceKeysCommand struct {
 	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
 	usage           interface{}          `usage:"CF_NAME service-keys SERVICE_INSTANCE\n\nEXAMPLES:\n   CF_NAME service-keys mydb"`
 	relatedCommands interface{}          `related_commands:"delete-service-key"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServiceKeysActor
 }
 
-func (ServiceKeysCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *ServiceKeysCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (ServiceKeysCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd ServiceKeysCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting keys for service instance {{.ServiceInstanceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"CurrentUser":         user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	serviceKeys, warnings, err := cmd.Actor.GetServiceKeysByServiceInstance(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(serviceKeys) == 0 {
+		cmd.UI.DisplayText("No service key for service instance {{.ServiceInstanceName}}", map[string]interface{}{
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		})
+		return nil
+	}
+
+	table := [][]string{{cmd.UI.TranslateText("name")}}
+	for _, serviceKey := range serviceKeys {
+		table = append(table, []string{serviceKey.Name})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
 	return nil
 }
@@ -0,0 +1,108 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-keys Command", func() {
+	var (
+		cmd             ServiceKeysCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeServiceKeysActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeServiceKeysActor)
+
+		cmd = ServiceKeysCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the service instance has keys", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceKeysByServiceInstanceReturns(
+				[]v2action.ServiceKey{{Name: "key-1"}, {Name: "key-2"}},
+				v2action.Warnings{"get-keys-warning"},
+				nil)
+		})
+
+		It("displays a table of the keys", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting keys for service instance some-service-instance as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("name"))
+			Expect(testUI.Out).To(Say("key-1"))
+			Expect(testUI.Out).To(Say("key-2"))
+			Expect(testUI.Err).To(Say("get-keys-warning"))
+
+			Expect(fakeActor.GetServiceKeysByServiceInstanceCallCount()).To(Equal(1))
+			serviceInstanceName, spaceGUID := fakeActor.GetServiceKeysByServiceInstanceArgsForCall(0)
+			Expect(serviceInstanceName).To(Equal("some-service-instance"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	Context("when the service instance has no keys", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceKeysByServiceInstanceReturns(nil, nil, nil)
+		})
+
+		It("displays that there are no keys", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No service key for service instance some-service-instance"))
+		})
+	})
+
+	Context("when the service instance does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceKeysByServiceInstanceReturns(nil, v2action.Warnings{"get-instance-warning"}, v2action.ServiceInstanceNotFoundError{Name: "some-service-instance"})
+		})
+
+		It("returns a translatable error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+			Expect(testUI.Err).To(Say("get-instance-warning"))
+		})
+	})
+})
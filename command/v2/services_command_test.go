@@ -0,0 +1,101 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("services Command", func() {
+	var (
+		cmd             ServicesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeServicesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeServicesActor)
+
+		cmd = ServicesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.OutputFormatReturns("json")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the output format is JSON", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstancesSummaryBySpaceReturns(
+				[]v2action.ServiceInstanceSummary{{ServiceInstance: v2action.ServiceInstance{GUID: "instance-guid-1", Name: "instance-1"}}},
+				v2action.Warnings{"some-warning"},
+				nil)
+		})
+
+		It("displays only the document on stdout and warnings on stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`"kind": "service_instances"`))
+			Expect(testUI.Out).To(Say(`"schema_version": 1`))
+			Expect(testUI.Out).To(Say(`"guid": "instance-guid-1"`))
+			Expect(testUI.Err).To(Say("some-warning"))
+
+			Expect(fakeActor.GetServiceInstancesSummaryBySpaceCallCount()).To(Equal(1))
+			Expect(fakeActor.GetServiceInstancesSummaryBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+		})
+
+		Context("when the actor returns an error", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstancesSummaryBySpaceReturns(nil, v2action.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("some-warning"))
+				Expect(testUI.Out).ToNot(Say("kind"))
+			})
+		})
+	})
+})
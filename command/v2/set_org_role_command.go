@@ -1,24 +1,77 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . SetOrgRoleActor
+
+type SetOrgRoleActor interface {
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GrantOrgRoleByUsername(orgGUID string, username string, isClient bool, role v2action.OrgRole) (v2action.Warnings, error)
+}
+
 type SetOrgRoleCommand struct {
 	RequiredArgs    flag.SetOrgRoleArgs `positional-args:"yes"`
-	usage           interface{}         `usage:"CF_NAME set-org-role USERNAME ORG ROLE\n\nROLES:\n   'OrgManager' - Invite and manage users, select and change plans, and set spending limits\n   'BillingManager' - Create and manage the billing account and payment info\n   'OrgAuditor' - Read-only access to org info and reports"`
+	IsClient        bool                `long:"client" description:"Assign the org role to a client-id of a (non-user) service account"`
+	usage           interface{}         `usage:"CF_NAME set-org-role USERNAME ORG ROLE\n   CF_NAME set-org-role CLIENT_ID ORG ROLE --client\n\nROLES:\n   'OrgManager' - Invite and manage users, select and change plans, and set spending limits\n   'BillingManager' - Create and manage the billing account and payment info\n   'OrgAuditor' - Read-only access to org info and reports"`
 	relatedCommands interface{}         `related_commands:"org-users, set-space-role"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SetOrgRoleActor
 }
 
-func (SetOrgRoleCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *SetOrgRoleCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (SetOrgRoleCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd SetOrgRoleCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Assigning role {{.Role}} to user {{.TargetUser}} in org {{.TargetOrg}} as {{.CurrentUser}}...", map[string]interface{}{
+		"Role":        cmd.RequiredArgs.Role.Role,
+		"TargetUser":  cmd.RequiredArgs.Username,
+		"TargetOrg":   cmd.RequiredArgs.Organization,
+		"CurrentUser": user.Name,
+	})
+
+	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.RequiredArgs.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	warnings, err = cmd.Actor.GrantOrgRoleByUsername(org.GUID, cmd.RequiredArgs.Username, cmd.IsClient, v2action.OrgRole(cmd.RequiredArgs.Role.Role))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
 	return nil
 }
@@ -0,0 +1,152 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-org-role Command", func() {
+	var (
+		cmd             SetOrgRoleCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSetOrgRoleActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSetOrgRoleActor)
+
+		cmd = SetOrgRoleCommand{
+			RequiredArgs: flag.SetOrgRoleArgs{
+				Username:     "some-user",
+				Organization: "some-org",
+				Role:         flag.OrgRole{Role: "OrgManager"},
+			},
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "current-user"}, nil)
+
+		fakeActor.GetOrganizationByNameReturns(
+			v2action.Organization{Name: "some-org", GUID: "some-org-guid"},
+			v2action.Warnings{"get org warning"},
+			nil)
+		fakeActor.GrantOrgRoleByUsernameReturns(v2action.Warnings{"set role warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when the org does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationByNameReturns(
+				v2action.Organization{},
+				v2action.Warnings{"get org warning"},
+				v2action.OrganizationNotFoundError{Name: "some-org"})
+		})
+
+		It("returns an OrganizationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-org"}))
+			Expect(testUI.Err).To(Say("get org warning"))
+			Expect(fakeActor.GrantOrgRoleByUsernameCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when setting the role fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("set role error")
+			fakeActor.GrantOrgRoleByUsernameReturns(v2action.Warnings{"set role warning"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("set role warning"))
+		})
+	})
+
+	Context("when the role is set successfully", func() {
+		It("displays the assignment, warnings and OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Assigning role OrgManager to user some-user in org some-org as current-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("get org warning"))
+			Expect(testUI.Err).To(Say("set role warning"))
+
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+
+			Expect(fakeActor.GrantOrgRoleByUsernameCallCount()).To(Equal(1))
+			orgGUID, username, isClient, role := fakeActor.GrantOrgRoleByUsernameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(username).To(Equal("some-user"))
+			Expect(isClient).To(BeFalse())
+			Expect(role).To(Equal(v2action.OrgRoleManager))
+		})
+
+		Context("when --client is provided", func() {
+			BeforeEach(func() {
+				cmd.IsClient = true
+			})
+
+			It("sets the role for the client", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, _, isClient, _ := fakeActor.GrantOrgRoleByUsernameArgsForCall(0)
+				Expect(isClient).To(BeTrue())
+			})
+		})
+	})
+})
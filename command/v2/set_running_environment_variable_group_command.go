@@ -1,24 +1,67 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . SetEnvironmentVariableGroupActor
+
+type SetEnvironmentVariableGroupActor interface {
+	SetEnvironmentVariableGroup(name ccv2.EnvironmentVariableGroupName, group v2action.EnvironmentVariableGroup) (v2action.Warnings, error)
+}
+
 type SetRunningEnvironmentVariableGroupCommand struct {
 	RequiredArgs    flag.ParamsAsJSON `positional-args:"yes"`
-	usage           interface{}       `usage:"CF_NAME set-running-environment-variable-group '{\"name\":\"value\",\"name\":\"value\"}'"`
+	usage           interface{}       `usage:"CF_NAME set-running-environment-variable-group '{\"name\":\"value\",\"name\":\"value\"}'\n   CF_NAME set-running-environment-variable-group PATH_TO_JSON_FILE"`
 	relatedCommands interface{}       `related_commands:"set-env, running-environment-variable-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SetEnvironmentVariableGroupActor
 }
 
-func (SetRunningEnvironmentVariableGroupCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *SetRunningEnvironmentVariableGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (SetRunningEnvironmentVariableGroupCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd SetRunningEnvironmentVariableGroupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting the contents of the running environment variable group as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+
+	warnings, err := cmd.Actor.SetEnvironmentVariableGroup(ccv2.EnvironmentVariableGroupRunning, v2action.EnvironmentVariableGroup(cmd.RequiredArgs.JSON))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
 	return nil
 }
@@ -0,0 +1,202 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-space-role Command", func() {
+	var (
+		cmd             SetSpaceRoleCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSetSpaceRoleActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSetSpaceRoleActor)
+
+		cmd = SetSpaceRoleCommand{
+			RequiredArgs: flag.SetSpaceRoleArgs{
+				Username:     "some-user",
+				Organization: "some-org",
+				Space:        "some-space",
+				Role:         flag.SpaceRole{Role: "SpaceDeveloper"},
+			},
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "current-user"}, nil)
+
+		fakeActor.GetOrganizationByNameReturns(
+			v2action.Organization{Name: "some-org", GUID: "some-org-guid"},
+			v2action.Warnings{"get org warning"},
+			nil)
+		fakeActor.GetSpaceByOrganizationAndNameReturns(
+			v2action.Space{Name: "some-space", GUID: "some-space-guid"},
+			v2action.Warnings{"get space warning"},
+			nil)
+		fakeActor.SetSpaceRoleReturns(v2action.Warnings{"set role warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when both --client and --origin are provided", func() {
+		BeforeEach(func() {
+			cmd.IsClient = true
+			cmd.Origin = "some-origin"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--client", "--origin"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when the org does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationByNameReturns(
+				v2action.Organization{},
+				v2action.Warnings{"get org warning"},
+				v2action.OrganizationNotFoundError{Name: "some-org"})
+		})
+
+		It("returns an OrganizationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-org"}))
+			Expect(testUI.Err).To(Say("get org warning"))
+		})
+	})
+
+	Context("when the space does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceByOrganizationAndNameReturns(
+				v2action.Space{},
+				v2action.Warnings{"get space warning"},
+				v2action.SpaceNotFoundError{Name: "some-space"})
+		})
+
+		It("returns a SpaceNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "some-space"}))
+			Expect(testUI.Err).To(Say("get org warning"))
+			Expect(testUI.Err).To(Say("get space warning"))
+		})
+	})
+
+	Context("when the user does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.SetSpaceRoleReturns(
+				v2action.Warnings{"set role warning"},
+				v2action.UserNotFoundError{Username: "some-user"})
+		})
+
+		It("returns a UserNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UserNotFoundError{Username: "some-user"}))
+			Expect(testUI.Err).To(Say("set role warning"))
+		})
+	})
+
+	Context("when the role is set successfully", func() {
+		It("displays the assignment, warnings and OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Assigning role SpaceDeveloper to user some-user in org some-org / space some-space as current-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("get org warning"))
+			Expect(testUI.Err).To(Say("get space warning"))
+			Expect(testUI.Err).To(Say("set role warning"))
+
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+			orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("some-space"))
+
+			Expect(fakeActor.SetSpaceRoleCallCount()).To(Equal(1))
+			orgGUID, spaceGUID, username, origin, isClient, role := fakeActor.SetSpaceRoleArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(username).To(Equal("some-user"))
+			Expect(origin).To(BeEmpty())
+			Expect(isClient).To(BeFalse())
+			Expect(role).To(Equal(v2action.SpaceRoleDeveloper))
+		})
+
+		Context("when an origin is provided", func() {
+			BeforeEach(func() {
+				cmd.Origin = "some-origin"
+			})
+
+			It("passes the origin to the actor", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, _, _, origin, _, _ := fakeActor.SetSpaceRoleArgsForCall(0)
+				Expect(origin).To(Equal("some-origin"))
+			})
+		})
+
+		Context("when --client is provided", func() {
+			BeforeEach(func() {
+				cmd.IsClient = true
+			})
+
+			It("sets the role for the client", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, _, _, _, isClient, _ := fakeActor.SetSpaceRoleArgsForCall(0)
+				Expect(isClient).To(BeTrue())
+			})
+		})
+	})
+})
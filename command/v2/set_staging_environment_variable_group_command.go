@@ -1,24 +1,61 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
 type SetStagingEnvironmentVariableGroupCommand struct {
 	RequiredArgs    flag.ParamsAsJSON `positional-args:"yes"`
-	usage           interface{}       `usage:"CF_NAME set-staging-environment-variable-group '{\"name\":\"value\",\"name\":\"value\"}'"`
+	usage           interface{}       `usage:"CF_NAME set-staging-environment-variable-group '{\"name\":\"value\",\"name\":\"value\"}'\n   CF_NAME set-staging-environment-variable-group PATH_TO_JSON_FILE"`
 	relatedCommands interface{}       `related_commands:"set-env, staging-environment-variable-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SetEnvironmentVariableGroupActor
 }
 
-func (SetStagingEnvironmentVariableGroupCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *SetStagingEnvironmentVariableGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (SetStagingEnvironmentVariableGroupCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd SetStagingEnvironmentVariableGroupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting the contents of the staging environment variable group as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+
+	warnings, err := cmd.Actor.SetEnvironmentVariableGroup(ccv2.EnvironmentVariableGroupStaging, v2action.EnvironmentVariableGroup(cmd.RequiredArgs.JSON))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
 	return nil
 }
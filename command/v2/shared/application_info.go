@@ -7,7 +7,6 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
-	"github.com/cloudfoundry/bytefmt"
 )
 
 // DisplayAppSummary displays the application summary to the UI, and optionally
@@ -18,7 +17,7 @@ func DisplayAppSummary(ui command.UI, appSummary v2action.ApplicationSummary, di
 	usage := ui.TranslateText(
 		"{{.MemorySize}} x {{.NumInstances}} instances",
 		map[string]interface{}{
-			"MemorySize":   bytefmt.ByteSize(uint64(appSummary.Memory) * bytefmt.MEGABYTE),
+			"MemorySize":   ui.FormatMegabytes(uint64(appSummary.Memory)),
 			"NumInstances": appSummary.Instances.Value,
 		})
 
@@ -80,8 +79,8 @@ func displayAppInstances(ui command.UI, instances []v2action.ApplicationInstance
 				ui.TranslateText(strings.ToLower(string(instance.State))),
 				zuluDate(instance.TimeSinceCreation()),
 				fmt.Sprintf("%.1f%%", instance.CPU*100),
-				fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(instance.Memory)), bytefmt.ByteSize(uint64(instance.MemoryQuota))),
-				fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(instance.Disk)), bytefmt.ByteSize(uint64(instance.DiskQuota))),
+				fmt.Sprintf("%s of %s", ui.FormatBytes(uint64(instance.Memory)), ui.FormatBytes(uint64(instance.MemoryQuota))),
+				fmt.Sprintf("%s of %s", ui.FormatBytes(uint64(instance.Disk)), ui.FormatBytes(uint64(instance.DiskQuota))),
 				instance.Details,
 			})
 	}
@@ -0,0 +1,26 @@
+package shared
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// RecordDetachedJob saves a Cloud Controller job started with --detach in the
+// config, so that the jobs command can report on it, and tells the user how
+// to check its status.
+func RecordDetachedJob(ui command.UI, config command.Config, jobGUID string, operation string, resource string) {
+	config.AddDetachedJob(configv3.DetachedJob{
+		GUID:      jobGUID,
+		Operation: operation,
+		Resource:  resource,
+		StartedAt: time.Now(),
+	})
+
+	ui.DisplayNewline()
+	ui.DisplayText("Job {{.JobGUID}} is running in the background. Use '{{.JobsCommand}}' to check its status.", map[string]interface{}{
+		"JobGUID":     jobGUID,
+		"JobsCommand": config.BinaryName() + " jobs",
+	})
+}
@@ -0,0 +1,39 @@
+package shared_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("RecordDetachedJob", func() {
+	var (
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+	})
+
+	It("saves the job in the config and tells the user how to check on it", func() {
+		RecordDetachedJob(testUI, fakeConfig, "some-job-guid", "delete-org", "some-org")
+
+		Expect(fakeConfig.AddDetachedJobCallCount()).To(Equal(1))
+		job := fakeConfig.AddDetachedJobArgsForCall(0)
+		Expect(job.GUID).To(Equal("some-job-guid"))
+		Expect(job.Operation).To(Equal("delete-org"))
+		Expect(job.Resource).To(Equal("some-org"))
+		Expect(job.StartedAt).To(BeTemporally("~", time.Now(), time.Minute))
+
+		Expect(testUI.Out).To(Say("Job some-job-guid is running in the background. Use 'faceman jobs' to check its status."))
+	})
+})
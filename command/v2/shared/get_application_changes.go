@@ -3,7 +3,6 @@ package shared
 import (
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/util/ui"
-	"github.com/cloudfoundry/bytefmt"
 )
 
 func GetApplicationChanges(appConfig pushaction.ApplicationConfig) []ui.Change {
@@ -188,6 +187,8 @@ func SelectNonBlankValue(str ...string) string {
 	return ""
 }
 
+// MegabytesToString formats value in binary units so that the displayed
+// changes match the values accepted by manifests and flags.
 func MegabytesToString(value uint64) string {
-	return bytefmt.ByteSize(bytefmt.MEGABYTE * uint64(value))
+	return ui.FormatMegabytes(value, ui.BinaryUnits)
 }
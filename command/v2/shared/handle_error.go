@@ -5,8 +5,11 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/router/routererror"
+	"code.cloudfoundry.org/cli/api/transport"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/interrupt"
 )
 
 func HandleError(err error) error {
@@ -15,11 +18,20 @@ func HandleError(err error) error {
 		return translatableerror.APINotFoundError(e)
 	case ccerror.RequestError:
 		return translatableerror.APIRequestError(e)
+	case ccerror.RequestCancelledError,
+		routererror.RequestCancelledError,
+		uaa.RequestCancelledError,
+		interrupt.InterruptedError:
+		return translatableerror.OperationCancelledError{}
 	case ccerror.SSLValidationHostnameError:
 		return translatableerror.SSLCertError(e)
 	case ccerror.UnverifiedServerError:
 		return translatableerror.InvalidSSLCertError{API: e.URL}
+	case transport.InvalidCACertificateError:
+		return translatableerror.InvalidCACertificateError{Path: e.Path}
 
+	case ccerror.ForbiddenError:
+		return translatableerror.ForbiddenError{Message: e.Message}
 	case ccerror.JobFailedError:
 		return translatableerror.JobFailedError(e)
 	case ccerror.JobTimeoutError:
@@ -29,6 +41,10 @@ func HandleError(err error) error {
 		return translatableerror.BadCredentialsError{}
 	case uaa.InvalidAuthTokenError:
 		return translatableerror.InvalidRefreshTokenError{}
+	case uaa.InvalidOriginError:
+		return translatableerror.InvalidOriginError{}
+	case uaa.InvalidPasswordError:
+		return translatableerror.InvalidPasswordError(e)
 
 	case sharedaction.NotLoggedInError:
 		return translatableerror.NotLoggedInError(e)
@@ -39,37 +55,97 @@ func HandleError(err error) error {
 
 	case v2action.ApplicationNotFoundError:
 		return translatableerror.ApplicationNotFoundError{Name: e.Name}
+	case v2action.BuildpackAlreadyExistsForStackError:
+		return translatableerror.BuildpackAlreadyExistsForStackError(e)
+	case v2action.BuildpackNotFoundError:
+		return translatableerror.BuildpackNotFoundError(e)
+	case v2action.BuildpackStackChangeError:
+		return translatableerror.BuildpackStackChangeError(e)
+	case v2action.BuildpackZipInvalidError:
+		return translatableerror.BuildpackZipInvalidError{}
+	case v2action.MultipleBuildpacksFoundError:
+		return translatableerror.MultipleBuildpacksFoundError(e)
 	case v2action.OrganizationNotFoundError:
 		return translatableerror.OrganizationNotFoundError{Name: e.Name}
+	case v2action.OrganizationPartiallyDeletedError:
+		return translatableerror.OrganizationPartiallyDeletedError{Name: e.Name, FailureCount: len(e.Failures)}
 	case v2action.SecurityGroupNotFoundError:
 		return translatableerror.SecurityGroupNotFoundError(e)
+	case v2action.ServiceBindingFailedError:
+		return translatableerror.ServiceBindingFailedError(e)
+	case v2action.ServiceBindingTimeoutError:
+		return translatableerror.ServiceBindingTimeoutError(e)
 	case v2action.ServiceInstanceNotFoundError:
 		return translatableerror.ServiceInstanceNotFoundError(e)
+	case v2action.ServiceInstanceOperationFailedError:
+		return translatableerror.ServiceInstanceOperationFailedError(e)
+	case v2action.ServiceInstanceOperationTimeoutError:
+		return translatableerror.ServiceInstanceOperationTimeoutError(e)
+	case v2action.ServiceKeyNotFoundError:
+		return translatableerror.ServiceKeyNotFoundError(e)
+	case v2action.ServiceNotFoundError:
+		return translatableerror.ServiceNotFoundError(e)
+	case v2action.ServicePlanNotFoundError:
+		return translatableerror.ServicePlanNotFoundError(e)
 	case v2action.SpaceNotFoundError:
 		return translatableerror.SpaceNotFoundError{Name: e.Name}
+	case v2action.SpaceQuotaNotFoundError:
+		return translatableerror.SpaceQuotaNotFoundError{Name: e.Name}
+	case v2action.SpaceQuotaNameTakenError:
+		return translatableerror.SpaceQuotaNameTakenError(e)
+	case v2action.OrganizationQuotaNotFoundError:
+		return translatableerror.OrganizationQuotaNotFoundError{Name: e.Name}
+	case v2action.OrganizationQuotaNameTakenError:
+		return translatableerror.OrganizationQuotaNameTakenError(e)
+	case v2action.InvalidSpaceTemplateError:
+		return translatableerror.InvalidSpaceTemplateError(e)
+	case v2action.InvalidSecurityGroupRulesError:
+		return translatableerror.InvalidSecurityGroupRulesError(e)
 	case v2action.StackNotFoundError:
 		return translatableerror.StackNotFoundError(e)
 	case v2action.HTTPHealthCheckInvalidError:
 		return translatableerror.HTTPHealthCheckInvalidError{}
 	case v2action.RouteInDifferentSpaceError:
 		return translatableerror.RouteInDifferentSpaceError(e)
+	case v2action.InvalidHTTPRouteSettings:
+		return translatableerror.InvalidHTTPRouteSettings(e)
+	case v2action.InvalidTCPRouteSettings:
+		return translatableerror.InvalidTCPRouteSettings(e)
+	case v2action.TCPRouteOptionsNotProvidedError:
+		return translatableerror.TCPRouteOptionsNotProvidedError{}
 	case v2action.FileChangedError:
 		return translatableerror.FileChangedError(e)
 	case v2action.EmptyDirectoryError:
 		return translatableerror.EmptyDirectoryError(e)
 	case v2action.DomainNotFoundError:
 		return translatableerror.DomainNotFoundError(e)
+	case v2action.DomainIsSharedError:
+		return translatableerror.DomainIsSharedError(e)
+	case v2action.FeatureFlagNotFoundError:
+		return translatableerror.FeatureFlagNotFoundError(e)
+	case v2action.RouterGroupNotFoundError:
+		return translatableerror.RouterGroupNotFoundError(e)
+	case v2action.PasswordGrantTypeLogoutRequiredError:
+		return translatableerror.PasswordGrantTypeLogoutRequiredError{}
+	case v2action.UserNotFoundError:
+		return translatableerror.UserNotFoundError(e)
+	case v2action.MultipleUAAUsersFoundError:
+		return translatableerror.MultipleUAAUsersFoundError(e)
 
 	case pushaction.AppNotFoundInManifestError:
 		return translatableerror.AppNotFoundInManifestError(e)
 	case pushaction.CommandLineOptionsWithMultipleAppsError:
 		return translatableerror.CommandLineArgsWithMultipleAppsError{}
+	case pushaction.DockerPasswordNotSetError:
+		return translatableerror.DockerPasswordNotSetError{}
 	case pushaction.NoDomainsFoundError:
 		return translatableerror.NoDomainsFoundError{}
 	case pushaction.NonexistentAppPathError:
 		return translatableerror.FileNotFoundError(e)
 	case pushaction.MissingNameError:
 		return translatableerror.RequiredNameForPushError{}
+	case pushaction.PropertyCombinationError:
+		return translatableerror.PropertyCombinationError(e)
 	case pushaction.UploadFailedError:
 		return translatableerror.UploadFailedError{Err: HandleError(e.Err)}
 	}
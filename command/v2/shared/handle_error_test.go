@@ -2,14 +2,18 @@ package shared_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/router/routererror"
+	"code.cloudfoundry.org/cli/api/transport"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/interrupt"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -28,10 +32,30 @@ var _ = Describe("HandleError", func() {
 			ccerror.RequestError{Err: err},
 			translatableerror.APIRequestError{Err: err}),
 
+		Entry("ccerror.RequestCancelledError -> OperationCancelledError",
+			ccerror.RequestCancelledError{},
+			translatableerror.OperationCancelledError{}),
+
+		Entry("routererror.RequestCancelledError -> OperationCancelledError",
+			routererror.RequestCancelledError{},
+			translatableerror.OperationCancelledError{}),
+
+		Entry("uaa.RequestCancelledError -> OperationCancelledError",
+			uaa.RequestCancelledError{},
+			translatableerror.OperationCancelledError{}),
+
+		Entry("interrupt.InterruptedError -> OperationCancelledError",
+			interrupt.InterruptedError{},
+			translatableerror.OperationCancelledError{}),
+
 		Entry("ccerror.UnverifiedServerError -> InvalidSSLCertError",
 			ccerror.UnverifiedServerError{URL: "some-url"},
 			translatableerror.InvalidSSLCertError{API: "some-url"}),
 
+		Entry("transport.InvalidCACertificateError -> InvalidCACertificateError",
+			transport.InvalidCACertificateError{Path: "some-path"},
+			translatableerror.InvalidCACertificateError{Path: "some-path"}),
+
 		Entry("ccerror.SSLValidationHostnameError -> SSLCertErrorError",
 			ccerror.SSLValidationHostnameError{Message: "some-message"},
 			translatableerror.SSLCertError{Message: "some-message"}),
@@ -44,18 +68,70 @@ var _ = Describe("HandleError", func() {
 			v2action.ApplicationNotFoundError{Name: "some-app"},
 			translatableerror.ApplicationNotFoundError{Name: "some-app"}),
 
+		Entry("v2action.BuildpackAlreadyExistsForStackError -> BuildpackAlreadyExistsForStackError",
+			v2action.BuildpackAlreadyExistsForStackError{Message: "some-message"},
+			translatableerror.BuildpackAlreadyExistsForStackError{Message: "some-message"}),
+
+		Entry("v2action.BuildpackNotFoundError -> BuildpackNotFoundError",
+			v2action.BuildpackNotFoundError{BuildpackName: "some-buildpack", StackName: "some-stack"},
+			translatableerror.BuildpackNotFoundError{BuildpackName: "some-buildpack", StackName: "some-stack"}),
+
+		Entry("v2action.BuildpackStackChangeError -> BuildpackStackChangeError",
+			v2action.BuildpackStackChangeError{BuildpackName: "some-buildpack"},
+			translatableerror.BuildpackStackChangeError{BuildpackName: "some-buildpack"}),
+
+		Entry("v2action.BuildpackZipInvalidError -> BuildpackZipInvalidError",
+			v2action.BuildpackZipInvalidError{Path: "some-path"},
+			translatableerror.BuildpackZipInvalidError{}),
+
+		Entry("v2action.MultipleBuildpacksFoundError -> MultipleBuildpacksFoundError",
+			v2action.MultipleBuildpacksFoundError{BuildpackName: "some-buildpack"},
+			translatableerror.MultipleBuildpacksFoundError{BuildpackName: "some-buildpack"}),
+
 		Entry("v2action.SecurityGroupNotFoundError -> SecurityGroupNotFoundError",
 			v2action.SecurityGroupNotFoundError{Name: "some-security-group"},
 			translatableerror.SecurityGroupNotFoundError{Name: "some-security-group"}),
 
+		Entry("v2action.ServiceBindingFailedError -> ServiceBindingFailedError",
+			v2action.ServiceBindingFailedError{Description: "some-broker-error"},
+			translatableerror.ServiceBindingFailedError{Description: "some-broker-error"}),
+
+		Entry("v2action.ServiceBindingTimeoutError -> ServiceBindingTimeoutError",
+			v2action.ServiceBindingTimeoutError{GUID: "some-binding-guid", Timeout: time.Minute},
+			translatableerror.ServiceBindingTimeoutError{GUID: "some-binding-guid", Timeout: time.Minute}),
+
 		Entry("v2action.ServiceInstanceNotFoundError -> ServiceInstanceNotFoundError",
 			v2action.ServiceInstanceNotFoundError{Name: "some-service-instance"},
 			translatableerror.ServiceInstanceNotFoundError{Name: "some-service-instance"}),
 
+		Entry("v2action.ServiceInstanceOperationFailedError -> ServiceInstanceOperationFailedError",
+			v2action.ServiceInstanceOperationFailedError{Name: "some-service-instance", Operation: "create", Description: "some-description"},
+			translatableerror.ServiceInstanceOperationFailedError{Name: "some-service-instance", Operation: "create", Description: "some-description"}),
+
+		Entry("v2action.ServiceInstanceOperationTimeoutError -> ServiceInstanceOperationTimeoutError",
+			v2action.ServiceInstanceOperationTimeoutError{Name: "some-service-instance", Operation: "create", Timeout: time.Minute},
+			translatableerror.ServiceInstanceOperationTimeoutError{Name: "some-service-instance", Operation: "create", Timeout: time.Minute}),
+
+		Entry("v2action.ServiceKeyNotFoundError -> ServiceKeyNotFoundError",
+			v2action.ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"},
+			translatableerror.ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"}),
+
+		Entry("v2action.ServiceNotFoundError -> ServiceNotFoundError",
+			v2action.ServiceNotFoundError{Label: "some-service"},
+			translatableerror.ServiceNotFoundError{Label: "some-service"}),
+
+		Entry("v2action.ServicePlanNotFoundError -> ServicePlanNotFoundError",
+			v2action.ServicePlanNotFoundError{PlanName: "some-plan"},
+			translatableerror.ServicePlanNotFoundError{PlanName: "some-plan"}),
+
 		Entry("v2action.StackNotFoundError -> StackNotFoundError",
 			v2action.StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"},
 			translatableerror.StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"}),
 
+		Entry("ccerror.ForbiddenError -> ForbiddenError",
+			ccerror.ForbiddenError{Message: "some-message"},
+			translatableerror.ForbiddenError{Message: "some-message"}),
+
 		Entry("ccerror.JobFailedError -> JobFailedError",
 			ccerror.JobFailedError{JobGUID: "some-job-guid", Message: "some-message"},
 			translatableerror.JobFailedError{JobGUID: "some-job-guid", Message: "some-message"}),
@@ -68,6 +144,15 @@ var _ = Describe("HandleError", func() {
 			v2action.OrganizationNotFoundError{Name: "some-org"},
 			translatableerror.OrganizationNotFoundError{Name: "some-org"}),
 
+		Entry("v2action.OrganizationPartiallyDeletedError -> OrganizationPartiallyDeletedError",
+			v2action.OrganizationPartiallyDeletedError{
+				Name: "some-org",
+				Failures: []v2action.DeleteOrganizationProgress{
+					{Type: v2action.DeletedApplication, Name: "some-app", Err: errors.New("some-error")},
+				},
+			},
+			translatableerror.OrganizationPartiallyDeletedError{Name: "some-org", FailureCount: 1}),
+
 		Entry("v2action.SpaceNotFoundError -> SpaceNotFoundError",
 			v2action.SpaceNotFoundError{Name: "some-space"},
 			translatableerror.SpaceNotFoundError{Name: "some-space"}),
@@ -104,11 +189,72 @@ var _ = Describe("HandleError", func() {
 			translatableerror.EmptyDirectoryError{Path: "some-filename"},
 		),
 
+		Entry("v2action.SpaceQuotaNotFoundError -> SpaceQuotaNotFoundError",
+			v2action.SpaceQuotaNotFoundError{Name: "some-space-quota"},
+			translatableerror.SpaceQuotaNotFoundError{Name: "some-space-quota"}),
+
+		Entry("v2action.SpaceQuotaNameTakenError -> SpaceQuotaNameTakenError",
+			v2action.SpaceQuotaNameTakenError{Name: "some-space-quota"},
+			translatableerror.SpaceQuotaNameTakenError{Name: "some-space-quota"}),
+
+		Entry("v2action.InvalidHTTPRouteSettings -> InvalidHTTPRouteSettings",
+			v2action.InvalidHTTPRouteSettings{Domain: "some-domain"},
+			translatableerror.InvalidHTTPRouteSettings{Domain: "some-domain"}),
+
+		Entry("v2action.InvalidTCPRouteSettings -> InvalidTCPRouteSettings",
+			v2action.InvalidTCPRouteSettings{Domain: "some-domain"},
+			translatableerror.InvalidTCPRouteSettings{Domain: "some-domain"}),
+
+		Entry("v2action.TCPRouteOptionsNotProvidedError -> TCPRouteOptionsNotProvidedError",
+			v2action.TCPRouteOptionsNotProvidedError{},
+			translatableerror.TCPRouteOptionsNotProvidedError{}),
+
+		Entry("v2action.RouterGroupNotFoundError -> RouterGroupNotFoundError",
+			v2action.RouterGroupNotFoundError{Name: "some-router-group"},
+			translatableerror.RouterGroupNotFoundError{Name: "some-router-group"}),
+
+		Entry("v2action.PasswordGrantTypeLogoutRequiredError -> PasswordGrantTypeLogoutRequiredError",
+			v2action.PasswordGrantTypeLogoutRequiredError{},
+			translatableerror.PasswordGrantTypeLogoutRequiredError{}),
+
+		Entry("v2action.UserNotFoundError -> UserNotFoundError",
+			v2action.UserNotFoundError{Username: "some-user", Origin: "some-origin"},
+			translatableerror.UserNotFoundError{Username: "some-user", Origin: "some-origin"}),
+
+		Entry("v2action.MultipleUAAUsersFoundError -> MultipleUAAUsersFoundError",
+			v2action.MultipleUAAUsersFoundError{Username: "some-user", Origins: []string{"uaa", "ldap"}},
+			translatableerror.MultipleUAAUsersFoundError{Username: "some-user", Origins: []string{"uaa", "ldap"}}),
+
+		Entry("v2action.OrganizationQuotaNotFoundError -> OrganizationQuotaNotFoundError",
+			v2action.OrganizationQuotaNotFoundError{Name: "some-quota"},
+			translatableerror.OrganizationQuotaNotFoundError{Name: "some-quota"}),
+
+		Entry("v2action.OrganizationQuotaNameTakenError -> OrganizationQuotaNameTakenError",
+			v2action.OrganizationQuotaNameTakenError{Name: "some-quota"},
+			translatableerror.OrganizationQuotaNameTakenError{Name: "some-quota"}),
+
+		Entry("v2action.InvalidSpaceTemplateError -> InvalidSpaceTemplateError",
+			v2action.InvalidSpaceTemplateError{Reason: "some reason"},
+			translatableerror.InvalidSpaceTemplateError{Reason: "some reason"}),
+
+		Entry("v2action.InvalidSecurityGroupRulesError -> InvalidSecurityGroupRulesError",
+			v2action.InvalidSecurityGroupRulesError{Reason: "some reason"},
+			translatableerror.InvalidSecurityGroupRulesError{Reason: "some reason"}),
+
+		Entry("v2action.DomainIsSharedError -> DomainIsSharedError",
+			v2action.DomainIsSharedError{Name: "some-domain-name"},
+			translatableerror.DomainIsSharedError{Name: "some-domain-name"},
+		),
+
 		Entry("v2action.DomainNotFoundError -> DomainNotFoundError",
 			v2action.DomainNotFoundError{Name: "some-domain-name", GUID: "some-domain-guid"},
 			translatableerror.DomainNotFoundError{Name: "some-domain-name", GUID: "some-domain-guid"},
 		),
 
+		Entry("v2action.FeatureFlagNotFoundError -> FeatureFlagNotFoundError",
+			v2action.FeatureFlagNotFoundError{Name: "some-flag"},
+			translatableerror.FeatureFlagNotFoundError{Name: "some-flag"}),
+
 		Entry("uaa.BadCredentialsError -> BadCredentialsError",
 			uaa.BadCredentialsError{},
 			translatableerror.BadCredentialsError{},
@@ -119,6 +265,16 @@ var _ = Describe("HandleError", func() {
 			translatableerror.InvalidRefreshTokenError{},
 		),
 
+		Entry("uaa.InvalidOriginError -> InvalidOriginError",
+			uaa.InvalidOriginError{},
+			translatableerror.InvalidOriginError{},
+		),
+
+		Entry("uaa.InvalidPasswordError -> InvalidPasswordError",
+			uaa.InvalidPasswordError{Message: "some-policy-message"},
+			translatableerror.InvalidPasswordError{Message: "some-policy-message"},
+		),
+
 		Entry("pushaction.AppNotFoundInManifestError -> AppNotFoundInManifestError",
 			pushaction.AppNotFoundInManifestError{Name: "some-app"},
 			translatableerror.AppNotFoundInManifestError{Name: "some-app"},
@@ -149,6 +305,16 @@ var _ = Describe("HandleError", func() {
 			translatableerror.CommandLineArgsWithMultipleAppsError{},
 		),
 
+		Entry("pushaction.DockerPasswordNotSetError -> DockerPasswordNotSetError",
+			pushaction.DockerPasswordNotSetError{},
+			translatableerror.DockerPasswordNotSetError{},
+		),
+
+		Entry("pushaction.PropertyCombinationError -> PropertyCombinationError",
+			pushaction.PropertyCombinationError{AppName: "some-app", Properties: []string{"docker", "path"}},
+			translatableerror.PropertyCombinationError{AppName: "some-app", Properties: []string{"docker", "path"}},
+		),
+
 		Entry("default case -> original error",
 			err,
 			err),
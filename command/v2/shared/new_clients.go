@@ -7,6 +7,7 @@ import (
 	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/interrupt"
 )
 
 // NewClients creates a new V2 Cloud Controller client and UAA client using the
@@ -15,6 +16,9 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 	ccWrappers := []ccv2.ConnectionWrapper{}
 
 	verbose, location := config.Verbose()
+	if verbose || location != nil {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestMetrics(ccWrapper.DefaultRequestSummary))
+	}
 	if verbose {
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
 	}
@@ -23,17 +27,20 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
-	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
+	refresher := TokenRefresher(config)
+	authWrapper := ccWrapper.NewUAAAuthenticationWithRefresher(nil, refresher)
 
 	ccWrappers = append(ccWrappers, authWrapper)
 	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(2))
+	ccWrappers = append(ccWrappers, ccWrapper.NewCancelRequest(interrupt.DefaultHandler))
 
 	ccClient := ccv2.NewClient(ccv2.Config{
-		AppName:            config.BinaryName(),
-		AppVersion:         config.BinaryVersion(),
-		JobPollingTimeout:  config.OverallPollingTimeout(),
-		JobPollingInterval: config.PollingInterval(),
-		Wrappers:           ccWrappers,
+		AppName:             config.BinaryName(),
+		AppVersion:          config.BinaryVersion(),
+		JobPollingTimeout:   config.OverallPollingTimeout(),
+		JobPollingInterval:  config.PollingInterval(),
+		JobPollingCanceller: interrupt.DefaultHandler,
+		Wrappers:            ccWrappers,
 	})
 
 	if !targetCF {
@@ -47,9 +54,12 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 	}
 
 	_, err := ccClient.TargetCF(ccv2.TargetSettings{
-		URL:               config.Target(),
-		SkipSSLValidation: config.SkipSSLValidation(),
-		DialTimeout:       config.DialTimeout(),
+		URL:                 config.Target(),
+		SkipSSLValidation:   config.SkipSSLValidation(),
+		CACertFile:          config.CACertFile(),
+		DialTimeout:         config.DialTimeout(),
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost(),
+		TLSHandshakeTimeout: config.TLSHandshakeTimeout(),
 	})
 	if err != nil {
 		return nil, nil, HandleError(err)
@@ -60,12 +70,16 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 	}
 
 	uaaClient := uaa.NewClient(uaa.Config{
-		AppName:           config.BinaryName(),
-		AppVersion:        config.BinaryVersion(),
-		ClientID:          config.UAAOAuthClient(),
-		ClientSecret:      config.UAAOAuthClientSecret(),
-		DialTimeout:       config.DialTimeout(),
-		SkipSSLValidation: config.SkipSSLValidation(),
+		AppName:             config.BinaryName(),
+		AppVersion:          config.BinaryVersion(),
+		ClientID:            config.UAAOAuthClient(),
+		ClientSecret:        config.UAAOAuthClientSecret(),
+		GrantType:           uaa.GrantType(config.UAAGrantType()),
+		CACertFile:          config.CACertFile(),
+		DialTimeout:         config.DialTimeout(),
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost(),
+		SkipSSLValidation:   config.SkipSSLValidation(),
+		TLSHandshakeTimeout: config.TLSHandshakeTimeout(),
 	})
 
 	if verbose {
@@ -75,9 +89,10 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
-	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(nil, config)
+	uaaAuthWrapper := uaaWrapper.NewUAAAuthenticationWithRefresher(nil, refresher)
 	uaaClient.WrapConnection(uaaAuthWrapper)
 	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(2))
+	uaaClient.WrapConnection(uaaWrapper.NewCancelRequest(interrupt.DefaultHandler))
 
 	err = uaaClient.SetupResources(config, ccClient.AuthorizationEndpoint())
 	if err != nil {
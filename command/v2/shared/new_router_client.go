@@ -0,0 +1,45 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/api/router"
+	"code.cloudfoundry.org/cli/api/router/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/interrupt"
+)
+
+// NewRouterClient creates a new Routing API client.
+func NewRouterClient(routingURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) (*router.Client, error) {
+	if routingURL == "" {
+		return nil, translatableerror.RoutingAPIEndpointNotFoundError{}
+	}
+
+	wrappers := []router.ConnectionWrapper{}
+
+	verbose, location := config.Verbose()
+	if verbose {
+		wrappers = append(wrappers, wrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
+	}
+	if location != nil {
+		wrappers = append(wrappers, wrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+	}
+
+	authWrapper := wrapper.NewUAAAuthentication(uaaClient, config)
+	wrappers = append(wrappers, authWrapper)
+
+	wrappers = append(wrappers, wrapper.NewRetryRequest(2))
+	wrappers = append(wrappers, wrapper.NewCancelRequest(interrupt.DefaultHandler))
+
+	return router.NewClient(router.ClientConfig{
+		AppName:             config.BinaryName(),
+		AppVersion:          config.BinaryVersion(),
+		CACertFile:          config.CACertFile(),
+		DialTimeout:         config.DialTimeout(),
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost(),
+		SkipSSLValidation:   config.SkipSSLValidation(),
+		TLSHandshakeTimeout: config.TLSHandshakeTimeout(),
+		URL:                 routingURL,
+		Wrappers:            wrappers,
+	}), nil
+}
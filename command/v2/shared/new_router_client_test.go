@@ -0,0 +1,41 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("NewRouterClient", func() {
+	var (
+		fakeConfig    *commandfakes.FakeConfig
+		testUI        *ui.UI
+		fakeUAAClient *uaa.Client
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+	})
+
+	It("returns a router client", func() {
+		client, err := NewRouterClient("some-url", fakeConfig, fakeUAAClient, testUI)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client).NotTo(BeNil())
+	})
+
+	Context("when the routing api endpoint is not set", func() {
+		It("returns a RoutingAPIEndpointNotFoundError", func() {
+			_, err := NewRouterClient("", fakeConfig, fakeUAAClient, testUI)
+			Expect(err).To(MatchError(translatableerror.RoutingAPIEndpointNotFoundError{}))
+		})
+	})
+})
@@ -4,9 +4,10 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
-func PollStart(ui command.UI, config command.Config, messages <-chan *v2action.LogMessage, logErrs <-chan error, appState <-chan v2action.ApplicationStateChange, apiWarnings <-chan string, apiErrs <-chan error) error {
+func PollStart(commandUI command.UI, config command.Config, messages <-chan *v2action.LogMessage, logErrs <-chan error, appState <-chan v2action.ApplicationStateChange, apiWarnings <-chan string, apiErrs <-chan error) error {
 	var breakAppState, breakWarnings, breakAPIErrs bool
 	for {
 		select {
@@ -16,7 +17,7 @@ func PollStart(ui command.UI, config command.Config, messages <-chan *v2action.L
 			}
 
 			if message.Staging() {
-				ui.DisplayLogMessage(message, false)
+				commandUI.DisplayLogMessage(message, false)
 			}
 		case state, ok := <-appState:
 			if !ok {
@@ -26,16 +27,19 @@ func PollStart(ui command.UI, config command.Config, messages <-chan *v2action.L
 
 			switch state {
 			case v2action.ApplicationStateStopping:
-				ui.DisplayNewline()
-				ui.DisplayText("Stopping app...")
+				commandUI.DisplayNewline()
+				commandUI.DisplayText("Stopping app...")
+				commandUI.DisplayProgressEvent(ui.ProgressEvent{Phase: ui.ProgressPhaseStopping, Message: "Stopping app"})
 
 			case v2action.ApplicationStateStaging:
-				ui.DisplayNewline()
-				ui.DisplayText("Staging app and tracing logs...")
+				commandUI.DisplayNewline()
+				commandUI.DisplayText("Staging app and tracing logs...")
+				commandUI.DisplayProgressEvent(ui.ProgressEvent{Phase: ui.ProgressPhaseStaging, Message: "Staging app"})
 
 			case v2action.ApplicationStateStarting:
-				ui.DisplayNewline()
-				ui.DisplayText("Waiting for app to start...")
+				commandUI.DisplayNewline()
+				commandUI.DisplayText("Waiting for app to start...")
+				commandUI.DisplayProgressEvent(ui.ProgressEvent{Phase: ui.ProgressPhaseStarting, Message: "Waiting for app to start"})
 			}
 		case warning, ok := <-apiWarnings:
 			if !ok {
@@ -43,7 +47,7 @@ func PollStart(ui command.UI, config command.Config, messages <-chan *v2action.L
 				break
 			}
 
-			ui.DisplayWarning(warning)
+			commandUI.DisplayWarning(warning)
 		case logErr, ok := <-logErrs:
 			if !ok {
 				break
@@ -51,9 +55,9 @@ func PollStart(ui command.UI, config command.Config, messages <-chan *v2action.L
 
 			switch logErr.(type) {
 			case v2action.NOAATimeoutError:
-				ui.DisplayWarning("timeout connecting to log server, no log will be shown")
+				commandUI.DisplayWarning("timeout connecting to log server, no log will be shown")
 			default:
-				ui.DisplayWarning(logErr.Error())
+				commandUI.DisplayWarning(logErr.Error())
 			}
 		case apiErr, ok := <-apiErrs:
 			if !ok {
@@ -90,6 +90,30 @@ var _ = Describe("Poll Start", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		Context("when progress events are enabled", func() {
+			var events *Buffer
+
+			BeforeEach(func() {
+				events = NewBuffer()
+				testUI.ProgressEvents = events
+			})
+
+			It("reports the app state changes as events", func() {
+				appState <- v2action.ApplicationStateStopping
+				appState <- v2action.ApplicationStateStaging
+				appState <- v2action.ApplicationStateStarting
+				close(appState)
+				close(apiWarnings)
+				close(apiErrs)
+
+				Eventually(block).Should(BeClosed())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(events).To(Say(`{"phase":"stopping","message":"Stopping app","percent":null}\n`))
+				Expect(events).To(Say(`{"phase":"staging","message":"Staging app","percent":null}\n`))
+				Expect(events).To(Say(`{"phase":"starting","message":"Waiting for app to start","percent":null}\n`))
+			})
+		})
+
 		Context("when state channel is not set", func() {
 			BeforeEach(func() {
 				appState = nil
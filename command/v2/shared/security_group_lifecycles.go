@@ -0,0 +1,19 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+// SecurityGroupLifecycles returns the lifecycle phases selected by the
+// --lifecycle flag, expanding 'both' into running and staging.
+func SecurityGroupLifecycles(lifecycle flag.SecurityGroupLifecycle) []ccv2.SecurityGroupLifecycle {
+	if lifecycle == flag.SecurityGroupLifecycleBoth {
+		return []ccv2.SecurityGroupLifecycle{
+			ccv2.SecurityGroupLifecycleRunning,
+			ccv2.SecurityGroupLifecycleStaging,
+		}
+	}
+
+	return []ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycle(lifecycle)}
+}
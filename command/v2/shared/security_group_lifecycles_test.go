@@ -0,0 +1,26 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SecurityGroupLifecycles", func() {
+	DescribeTable("converts the flag value into lifecycle phases",
+		func(lifecycle flag.SecurityGroupLifecycle, expected []ccv2.SecurityGroupLifecycle) {
+			Expect(SecurityGroupLifecycles(lifecycle)).To(Equal(expected))
+		},
+
+		Entry("running", flag.SecurityGroupLifecycle("running"),
+			[]ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleRunning}),
+		Entry("staging", flag.SecurityGroupLifecycle("staging"),
+			[]ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleStaging}),
+		Entry("both", flag.SecurityGroupLifecycleBoth,
+			[]ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleRunning, ccv2.SecurityGroupLifecycleStaging}),
+	)
+})
@@ -0,0 +1,45 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// ServiceInstanceOperationPoller polls a service instance until the service
+// broker completes its last operation.
+type ServiceInstanceOperationPoller func(instance v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error)
+
+// FinishServiceInstanceOperation reports the outcome of a create, update or
+// delete of a service instance. When the broker performs the operation
+// asynchronously and wait is set, it polls until the operation completes;
+// otherwise it tells the user how to check the operation status.
+func FinishServiceInstanceOperation(ui command.UI, config command.Config, instance v2action.ServiceInstance, wait bool, poll ServiceInstanceOperationPoller) error {
+	if !instance.IsInProgress() {
+		ui.DisplayOK()
+		return nil
+	}
+
+	if wait {
+		ui.DisplayText("Waiting for the operation to complete...")
+		_, warnings, err := poll(instance)
+		ui.DisplayWarnings(warnings)
+		if err != nil {
+			return HandleError(err)
+		}
+
+		ui.DisplayOK()
+		return nil
+	}
+
+	ui.DisplayOK()
+	ui.DisplayNewline()
+	ui.DisplayText("{{.State}} in progress. Use '{{.ServicesCommand}}' or '{{.ServiceCommand}}' to check operation status.", map[string]interface{}{
+		"State":           strings.Title(instance.LastOperation.Type),
+		"ServicesCommand": fmt.Sprintf("%s services", config.BinaryName()),
+		"ServiceCommand":  fmt.Sprintf("%s service %s", config.BinaryName(), instance.Name),
+	})
+	return nil
+}
@@ -0,0 +1,110 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("FinishServiceInstanceOperation", func() {
+	var (
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+
+		instance  v2action.ServiceInstance
+		wait      bool
+		pollCalls int
+		pollErr   error
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		instance = v2action.ServiceInstance{
+			Name:          "some-service-instance",
+			LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationInProgress},
+		}
+		wait = false
+		pollCalls = 0
+		pollErr = nil
+	})
+
+	JustBeforeEach(func() {
+		poll := func(polled v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error) {
+			pollCalls++
+			Expect(polled).To(Equal(instance))
+			return polled, v2action.Warnings{"poll-warning"}, pollErr
+		}
+		executeErr = FinishServiceInstanceOperation(testUI, fakeConfig, instance, wait, poll)
+	})
+
+	Context("when the operation is complete", func() {
+		BeforeEach(func() {
+			instance.LastOperation.State = ccv2.LastOperationSucceeded
+			wait = true
+		})
+
+		It("displays OK without polling", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(pollCalls).To(Equal(0))
+		})
+	})
+
+	Context("when the operation is in progress", func() {
+		Context("when not waiting", func() {
+			It("displays OK and how to check the operation status", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Create in progress. Use 'faceman services' or 'faceman service some-service-instance' to check operation status."))
+				Expect(pollCalls).To(Equal(0))
+			})
+		})
+
+		Context("when waiting", func() {
+			BeforeEach(func() {
+				wait = true
+			})
+
+			It("polls until the operation completes and displays OK", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Waiting for the operation to complete..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).ToNot(Say("in progress"))
+				Expect(testUI.Err).To(Say("poll-warning"))
+				Expect(pollCalls).To(Equal(1))
+			})
+
+			Context("when the operation fails", func() {
+				BeforeEach(func() {
+					pollErr = v2action.ServiceInstanceOperationFailedError{
+						Name:        "some-service-instance",
+						Operation:   "create",
+						Description: "some-broker-error",
+					}
+				})
+
+				It("returns a translatable error", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceOperationFailedError{
+						Name:        "some-service-instance",
+						Operation:   "create",
+						Description: "some-broker-error",
+					}))
+					Expect(testUI.Err).To(Say("poll-warning"))
+					Expect(testUI.Out).ToNot(Say("OK"))
+				})
+			})
+		})
+	})
+})
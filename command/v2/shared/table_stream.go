@@ -0,0 +1,73 @@
+package shared
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/command"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+// TableStream displays a table whose rows arrive in batches, such as one page
+// of Cloud Controller results at a time. The header is displayed with the
+// first batch. Cells are padded to the widest cell seen so far, so a batch
+// lines up with the ones before it unless it holds a wider cell.
+type TableStream struct {
+	ui      command.UI
+	header  []string
+	padding int
+	widths  []int
+	started bool
+}
+
+// NewTableStream returns a TableStream with the provided header.
+func NewTableStream(ui command.UI, header []string, padding int) *TableStream {
+	widths := make([]int, len(header))
+	for i, cell := range header {
+		widths[i] = runewidth.StringWidth(cell)
+	}
+
+	return &TableStream{
+		ui:      ui,
+		header:  header,
+		padding: padding,
+		widths:  widths,
+	}
+}
+
+// Display displays rows, preceded by the header on the first call.
+func (stream *TableStream) Display(rows [][]string) {
+	for _, row := range rows {
+		for i, cell := range row {
+			if width := runewidth.StringWidth(cell); width > stream.widths[i] {
+				stream.widths[i] = width
+			}
+		}
+	}
+
+	var table [][]string
+	if !stream.started {
+		table = append(table, stream.pad(stream.header))
+	}
+	for _, row := range rows {
+		table = append(table, stream.pad(row))
+	}
+
+	if stream.started {
+		stream.ui.DisplayNonWrappingTable("", table, stream.padding)
+	} else {
+		stream.ui.DisplayTableWithHeader("", table, stream.padding)
+	}
+	stream.started = true
+}
+
+func (stream *TableStream) pad(row []string) []string {
+	padded := make([]string, len(row))
+	for i, cell := range row {
+		if i == len(row)-1 {
+			padded[i] = cell
+			continue
+		}
+		padded[i] = cell + strings.Repeat(" ", stream.widths[i]-runewidth.StringWidth(cell))
+	}
+	return padded
+}
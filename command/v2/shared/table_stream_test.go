@@ -0,0 +1,38 @@
+package shared_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("TableStream", func() {
+	var (
+		testUI *ui.UI
+		stream *TableStream
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		stream = NewTableStream(testUI, []string{"name", "state", "urls"}, 3)
+	})
+
+	It("displays the header once and keeps the columns aligned across batches", func() {
+		stream.Display([][]string{{"app-1", "started", "app-1.com"}})
+		stream.Display([][]string{{"app-2", "stopped", "app-2.com"}})
+
+		Expect(testUI.Out).To(Say("name    state     urls\n"))
+		Expect(testUI.Out).To(Say("app-1   started   app-1.com\n"))
+		Expect(testUI.Out).To(Say("app-2   stopped   app-2.com\n"))
+		Expect(testUI.Out).ToNot(Say("name"))
+	})
+
+	It("displays the header when the first batch is empty", func() {
+		stream.Display(nil)
+
+		Expect(testUI.Out).To(Say("name   state   urls\n"))
+	})
+})
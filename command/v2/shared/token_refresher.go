@@ -0,0 +1,28 @@
+package shared
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+)
+
+var (
+	tokenRefreshersLock sync.Mutex
+	tokenRefreshers     = map[command.Config]*uaa.TokenRefresher{}
+)
+
+// TokenRefresher returns the token refresher for the tokens stored in config.
+// The CC, UAA and NOAA clients created from the same config all share it, so
+// an expired token is only refreshed once no matter which client notices.
+func TokenRefresher(config command.Config) *uaa.TokenRefresher {
+	tokenRefreshersLock.Lock()
+	defer tokenRefreshersLock.Unlock()
+
+	refresher, ok := tokenRefreshers[config]
+	if !ok {
+		refresher = uaa.NewTokenRefresher(nil, config)
+		tokenRefreshers[config] = refresher
+	}
+	return refresher
+}
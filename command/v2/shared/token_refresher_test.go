@@ -0,0 +1,25 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TokenRefresher", func() {
+	var fakeConfig *commandfakes.FakeConfig
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+	})
+
+	It("returns the same refresher for the same config", func() {
+		Expect(TokenRefresher(fakeConfig)).To(BeIdenticalTo(TokenRefresher(fakeConfig)))
+	})
+
+	It("returns a different refresher for a different config", func() {
+		Expect(TokenRefresher(fakeConfig)).ToNot(BeIdenticalTo(TokenRefresher(new(commandfakes.FakeConfig))))
+	})
+})
@@ -61,7 +61,7 @@ func (cmd *SpaceCommand) Setup(config command.Config, ui command.UI) error {
 			return err
 		}
 	} else {
-		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, nil, config)
 	}
 
 	return nil
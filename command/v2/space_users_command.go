@@ -1,24 +1,84 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . SpaceUsersActor
+
+type SpaceUsersActor interface {
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	GetSpaceUsersByRole(spaceGUID string) (map[v2action.SpaceRole][]v2action.User, v2action.Warnings, error)
+}
+
 type SpaceUsersCommand struct {
 	RequiredArgs    flag.OrgSpace `positional-args:"yes"`
 	usage           interface{}   `usage:"CF_NAME space-users ORG SPACE"`
 	relatedCommands interface{}   `related_commands:"org-users, set-space-role, unset-space-role, orgs, spaces"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SpaceUsersActor
 }
 
-func (SpaceUsersCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *SpaceUsersCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (SpaceUsersCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd SpaceUsersCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting users in org {{.TargetOrg}} / space {{.TargetSpace}} as {{.CurrentUser}}", map[string]interface{}{
+		"TargetOrg":   cmd.RequiredArgs.Organization,
+		"TargetSpace": cmd.RequiredArgs.Space,
+		"CurrentUser": user.Name,
+	})
+
+	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.RequiredArgs.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(org.GUID, cmd.RequiredArgs.Space)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	usersByRole, warnings, err := cmd.Actor.GetSpaceUsersByRole(space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	displayRoleUsers(cmd.UI, "SPACE MANAGER", usersByRole[v2action.SpaceRoleManager])
+	displayRoleUsers(cmd.UI, "SPACE DEVELOPER", usersByRole[v2action.SpaceRoleDeveloper])
+	displayRoleUsers(cmd.UI, "SPACE AUDITOR", usersByRole[v2action.SpaceRoleAuditor])
+
 	return nil
 }
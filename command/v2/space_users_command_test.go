@@ -0,0 +1,128 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("space-users Command", func() {
+	var (
+		cmd             SpaceUsersCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSpaceUsersActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSpaceUsersActor)
+
+		cmd = SpaceUsersCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Organization = "some-org"
+		cmd.RequiredArgs.Space = "some-space"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetOrganizationByNameReturns(v2action.Organization{GUID: "some-org-guid"}, v2action.Warnings{"get-org-warning"}, nil)
+		fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "some-space-guid"}, v2action.Warnings{"get-space-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the space does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{}, v2action.Warnings{"get-space-warning"}, v2action.SpaceNotFoundError{Name: "some-space"})
+		})
+
+		It("returns a SpaceNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "some-space"}))
+			Expect(testUI.Err).To(Say("get-org-warning"))
+			Expect(testUI.Err).To(Say("get-space-warning"))
+			Expect(fakeActor.GetSpaceUsersByRoleCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when getting the users succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceUsersByRoleReturns(map[v2action.SpaceRole][]v2action.User{
+				v2action.SpaceRoleManager: {
+					{GUID: "manager-guid", Username: "some-manager"},
+				},
+				v2action.SpaceRoleDeveloper: {
+					{GUID: "developer-guid-1", Username: "some-developer", Origin: "uaa"},
+					{GUID: "developer-guid-2", Username: "some-developer", Origin: "ldap"},
+				},
+			}, v2action.Warnings{"get-users-warning"}, nil)
+		})
+
+		It("displays the users of each role", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting users in org some-org / space some-space as some-user"))
+			Expect(testUI.Out).To(Say("SPACE MANAGER"))
+			Expect(testUI.Out).To(Say("  some-manager\n"))
+			Expect(testUI.Out).To(Say("SPACE DEVELOPER"))
+			Expect(testUI.Out).To(Say("  some-developer \\(uaa\\)"))
+			Expect(testUI.Out).To(Say("  some-developer \\(ldap\\)"))
+			Expect(testUI.Out).To(Say("SPACE AUDITOR"))
+			Expect(testUI.Out).To(Say("  No SPACE AUDITOR found"))
+
+			Expect(testUI.Err).To(Say("get-org-warning"))
+			Expect(testUI.Err).To(Say("get-space-warning"))
+			Expect(testUI.Err).To(Say("get-users-warning"))
+
+			orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(fakeActor.GetSpaceUsersByRoleArgsForCall(0)).To(Equal("some-space-guid"))
+		})
+	})
+
+	Context("when getting the users fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("get users error")
+			fakeActor.GetSpaceUsersByRoleReturns(nil, v2action.Warnings{"get-users-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("get-users-warning"))
+		})
+	})
+})
@@ -1,22 +1,60 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
 type StagingEnvironmentVariableGroupCommand struct {
 	usage           interface{} `usage:"CF_NAME staging-environment-variable-group"`
 	relatedCommands interface{} `related_commands:"env, running-environment-variable-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       EnvironmentVariableGroupActor
 }
 
-func (StagingEnvironmentVariableGroupCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *StagingEnvironmentVariableGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (StagingEnvironmentVariableGroupCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd StagingEnvironmentVariableGroupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Retrieving the contents of the staging environment variable group as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+
+	group, warnings, err := cmd.Actor.GetEnvironmentVariableGroup(ccv2.EnvironmentVariableGroupStaging)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	displayEnvironmentVariableGroup(cmd.UI, group)
+
 	return nil
 }
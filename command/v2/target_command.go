@@ -13,6 +13,7 @@ import (
 
 //go:generate counterfeiter . TargetActor
 type TargetActor interface {
+	GetOrganizationAndSpaceByName(orgName string, spaceName string) (v2action.Organization, v2action.Space, v2action.Warnings, error)
 	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
 	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
 	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
@@ -21,7 +22,8 @@ type TargetActor interface {
 type TargetCommand struct {
 	Organization    string      `short:"o" description:"Organization"`
 	Space           string      `short:"s" description:"Space"`
-	usage           interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE]"`
+	GUID            bool        `long:"guid" description:"Retrieve and display the guids of the targeted org and space, one per line.  All other output is suppressed."`
+	usage           interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE] [--guid]"`
 	relatedCommands interface{} `related_commands:"create-org, create-space, login, orgs, spaces"`
 
 	UI          command.UI
@@ -87,6 +89,10 @@ func (cmd *TargetCommand) Execute(args []string) error {
 		}
 	}
 
+	if cmd.GUID {
+		return cmd.displayTargetGUIDs()
+	}
+
 	cmd.displayTargetTable(user)
 
 	if !cmd.Config.HasTargetedOrganization() {
@@ -118,13 +124,7 @@ func (cmd TargetCommand) clearTargets() {
 
 // setOrgAndSpace sets organization and space
 func (cmd *TargetCommand) setOrgAndSpace() error {
-	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
-	cmd.UI.DisplayWarnings(warnings)
-	if err != nil {
-		return shared.HandleError(err)
-	}
-
-	space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(org.GUID, cmd.Space)
+	org, space, warnings, err := cmd.Actor.GetOrganizationAndSpaceByName(cmd.Organization, cmd.Space)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
@@ -184,6 +184,21 @@ func (cmd *TargetCommand) setSpace() error {
 	return nil
 }
 
+// displayTargetGUIDs displays the targeted org GUID followed by the targeted
+// space GUID, if a space is targeted, so that scripts can capture them.
+func (cmd *TargetCommand) displayTargetGUIDs() error {
+	if !cmd.Config.HasTargetedOrganization() {
+		return translatableerror.NoOrganizationTargetedError{BinaryName: cmd.Config.BinaryName()}
+	}
+
+	cmd.UI.DisplayText(cmd.Config.TargetedOrganization().GUID)
+	if cmd.Config.HasTargetedSpace() {
+		cmd.UI.DisplayText(cmd.Config.TargetedSpace().GUID)
+	}
+
+	return nil
+}
+
 // displayTargetTable neatly displays target information.
 func (cmd *TargetCommand) displayTargetTable(user configv3.User) {
 	table := [][]string{
@@ -148,6 +148,45 @@ var _ = Describe("target Command", func() {
 						nil)
 				})
 
+				Context("when --guid is provided", func() {
+					BeforeEach(func() {
+						cmd.GUID = true
+					})
+
+					Context("when no org is targeted", func() {
+						It("returns a NoOrganizationTargetedError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+							Expect(testUI.Out).ToNot(Say("api endpoint:"))
+						})
+					})
+
+					Context("when an org but no space is targeted", func() {
+						BeforeEach(func() {
+							fakeConfig.HasTargetedOrganizationReturns(true)
+							fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+						})
+
+						It("displays only the org guid", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say("^some-org-guid\\n$"))
+						})
+					})
+
+					Context("when an org and space are targeted", func() {
+						BeforeEach(func() {
+							fakeConfig.HasTargetedOrganizationReturns(true)
+							fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+							fakeConfig.HasTargetedSpaceReturns(true)
+							fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+						})
+
+						It("displays the org guid followed by the space guid", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say("^some-org-guid\\nsome-space-guid\\n$"))
+						})
+					})
+				})
+
 				Context("when no arguments are provided", func() {
 					Context("when no org or space are targeted", func() {
 						It("displays how to target an org and space", func() {
@@ -468,75 +507,75 @@ var _ = Describe("target Command", func() {
 						cmd.Organization = "some-org"
 					})
 
-					Context("when the org exists", func() {
+					Context("when the org and space exist", func() {
 						BeforeEach(func() {
-							fakeActor.GetOrganizationByNameReturns(
+							fakeActor.GetOrganizationAndSpaceByNameReturns(
 								v2action.Organization{
 									GUID: "some-org-guid",
 									Name: "some-org",
 								},
+								v2action.Space{
+									GUID: "some-space-guid",
+									Name: "some-space",
+								},
 								v2action.Warnings{
 									"warning-1",
+									"warning-2",
 								},
 								nil)
 						})
 
-						Context("when the space exists", func() {
-							BeforeEach(func() {
-								fakeActor.GetSpaceByOrganizationAndNameReturns(
-									v2action.Space{
-										GUID: "some-space-guid",
-										Name: "some-space",
-									},
-									v2action.Warnings{
-										"warning-2",
-									},
-									nil)
-							})
-
-							It("sets the target org and space", func() {
-								Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
-								orgGUID, orgName := fakeConfig.SetOrganizationInformationArgsForCall(0)
-								Expect(orgGUID).To(Equal("some-org-guid"))
-								Expect(orgName).To(Equal("some-org"))
+						It("looks up the org and space in a single call", func() {
+							Expect(fakeActor.GetOrganizationAndSpaceByNameCallCount()).To(Equal(1))
+							orgName, spaceName := fakeActor.GetOrganizationAndSpaceByNameArgsForCall(0)
+							Expect(orgName).To(Equal("some-org"))
+							Expect(spaceName).To(Equal("some-space"))
+						})
 
-								Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(1))
-								spaceGUID, spaceName, allowSSH := fakeConfig.SetSpaceInformationArgsForCall(0)
-								Expect(spaceGUID).To(Equal("some-space-guid"))
-								Expect(spaceName).To(Equal("some-space"))
-								Expect(allowSSH).To(BeFalse())
-							})
+						It("sets the target org and space", func() {
+							Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
+							orgGUID, orgName := fakeConfig.SetOrganizationInformationArgsForCall(0)
+							Expect(orgGUID).To(Equal("some-org-guid"))
+							Expect(orgName).To(Equal("some-org"))
+
+							Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(1))
+							spaceGUID, spaceName, allowSSH := fakeConfig.SetSpaceInformationArgsForCall(0)
+							Expect(spaceGUID).To(Equal("some-space-guid"))
+							Expect(spaceName).To(Equal("some-space"))
+							Expect(allowSSH).To(BeFalse())
+						})
 
-							It("displays all warnings", func() {
-								Expect(testUI.Err).To(Say("warning-1"))
-								Expect(testUI.Err).To(Say("warning-2"))
-							})
+						It("displays all warnings", func() {
+							Expect(testUI.Err).To(Say("warning-1"))
+							Expect(testUI.Err).To(Say("warning-2"))
 						})
+					})
 
-						Context("when the space does not exist", func() {
-							BeforeEach(func() {
-								fakeActor.GetSpaceByOrganizationAndNameReturns(
-									v2action.Space{},
-									nil,
-									v2action.SpaceNotFoundError{Name: "some-space"})
-							})
+					Context("when the space does not exist", func() {
+						BeforeEach(func() {
+							fakeActor.GetOrganizationAndSpaceByNameReturns(
+								v2action.Organization{},
+								v2action.Space{},
+								nil,
+								v2action.SpaceNotFoundError{Name: "some-space"})
+						})
 
-							It("returns an error and clears existing targets", func() {
-								Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "some-space"}))
+						It("returns an error and clears existing targets", func() {
+							Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "some-space"}))
 
-								Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
-								Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
+							Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+							Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
 
-								Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
-								Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
-							})
+							Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
+							Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
 						})
 					})
 
 					Context("when the org does not exist", func() {
 						BeforeEach(func() {
-							fakeActor.GetOrganizationByNameReturns(
+							fakeActor.GetOrganizationAndSpaceByNameReturns(
 								v2action.Organization{},
+								v2action.Space{},
 								nil,
 								v2action.OrganizationNotFoundError{Name: "some-org"})
 						})
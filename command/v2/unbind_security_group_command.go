@@ -15,14 +15,14 @@ import (
 
 type UnbindSecurityGroupActor interface {
 	CloudControllerAPIVersion() string
-	UnbindSecurityGroupByNameAndSpace(securityGroupName string, spaceGUID string, lifecycle ccv2.SecurityGroupLifecycle) (v2action.Warnings, error)
-	UnbindSecurityGroupByNameOrganizationNameAndSpaceName(securityGroupName string, orgName string, spaceName string, lifecycle ccv2.SecurityGroupLifecycle) (v2action.Warnings, error)
+	UnbindSecurityGroupByNameAndSpace(securityGroupName string, spaceGUID string, lifecycles []ccv2.SecurityGroupLifecycle) (v2action.Warnings, error)
+	UnbindSecurityGroupByNameOrganizationNameAndSpaceName(securityGroupName string, orgName string, spaceName string, lifecycles []ccv2.SecurityGroupLifecycle) (v2action.Warnings, error)
 }
 
 type UnbindSecurityGroupCommand struct {
 	RequiredArgs    flag.UnbindSecurityGroupArgs `positional-args:"yes"`
-	Lifecycle       flag.SecurityGroupLifecycle  `long:"lifecycle" choice:"running" choice:"staging" default:"running" description:"Lifecycle phase the group applies to"`
-	usage           interface{}                  `usage:"CF_NAME unbind-security-group SECURITY_GROUP ORG SPACE [--lifecycle (running | staging)]\n\nTIP: Changes require an app restart (for running) or restage (for staging) to apply to existing applications."`
+	Lifecycle       flag.SecurityGroupLifecycle  `long:"lifecycle" choice:"running" choice:"staging" choice:"both" default:"running" description:"Lifecycle phase the group applies to"`
+	usage           interface{}                  `usage:"CF_NAME unbind-security-group SECURITY_GROUP ORG SPACE [--lifecycle (running | staging | both)]\n\nTIP: Changes require an app restart (for running) or restage (for staging) to apply to existing applications."`
 	relatedCommands interface{}                  `related_commands:"apps, restart, security-groups"`
 
 	UI          command.UI
@@ -47,7 +47,7 @@ func (cmd *UnbindSecurityGroupCommand) Setup(config command.Config, ui command.U
 
 func (cmd UnbindSecurityGroupCommand) Execute(args []string) error {
 	var err error
-	if ccv2.SecurityGroupLifecycle(cmd.Lifecycle) == ccv2.SecurityGroupLifecycleStaging {
+	if ccv2.SecurityGroupLifecycle(cmd.Lifecycle) == ccv2.SecurityGroupLifecycleStaging || cmd.Lifecycle == flag.SecurityGroupLifecycleBoth {
 		err = version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionLifecyleStagingV2)
 		if err != nil {
 			switch e := err.(type) {
@@ -83,7 +83,7 @@ func (cmd UnbindSecurityGroupCommand) Execute(args []string) error {
 			"SpaceName":         space.Name,
 			"Username":          user.Name,
 		})
-		warnings, err = cmd.Actor.UnbindSecurityGroupByNameAndSpace(cmd.RequiredArgs.SecurityGroupName, space.GUID, ccv2.SecurityGroupLifecycle(cmd.Lifecycle))
+		warnings, err = cmd.Actor.UnbindSecurityGroupByNameAndSpace(cmd.RequiredArgs.SecurityGroupName, space.GUID, shared.SecurityGroupLifecycles(cmd.Lifecycle))
 
 	case cmd.RequiredArgs.OrganizationName != "" && cmd.RequiredArgs.SpaceName != "":
 		err = cmd.SharedActor.CheckTarget(cmd.Config, false, false)
@@ -97,7 +97,7 @@ func (cmd UnbindSecurityGroupCommand) Execute(args []string) error {
 			"SpaceName":         cmd.RequiredArgs.SpaceName,
 			"Username":          user.Name,
 		})
-		warnings, err = cmd.Actor.UnbindSecurityGroupByNameOrganizationNameAndSpaceName(cmd.RequiredArgs.SecurityGroupName, cmd.RequiredArgs.OrganizationName, cmd.RequiredArgs.SpaceName, ccv2.SecurityGroupLifecycle(cmd.Lifecycle))
+		warnings, err = cmd.Actor.UnbindSecurityGroupByNameOrganizationNameAndSpaceName(cmd.RequiredArgs.SecurityGroupName, cmd.RequiredArgs.OrganizationName, cmd.RequiredArgs.SpaceName, shared.SecurityGroupLifecycles(cmd.Lifecycle))
 
 	default:
 		return translatableerror.ThreeRequiredArgumentsError{
@@ -117,10 +117,10 @@ var _ = Describe("unbind-security-group Command", func() {
 					Expect(fakeConfig.TargetedOrganizationCallCount()).To(Equal(1))
 					Expect(fakeConfig.TargetedSpaceCallCount()).To(Equal(1))
 					Expect(fakeActor.UnbindSecurityGroupByNameAndSpaceCallCount()).To(Equal(1))
-					securityGroupName, spaceGUID, lifecycle := fakeActor.UnbindSecurityGroupByNameAndSpaceArgsForCall(0)
+					securityGroupName, spaceGUID, lifecycles := fakeActor.UnbindSecurityGroupByNameAndSpaceArgsForCall(0)
 					Expect(securityGroupName).To(Equal("some-security-group"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
-					Expect(lifecycle).To(Equal(ccv2.SecurityGroupLifecycle("some-lifecycle")))
+					Expect(lifecycles).To(Equal([]ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycle("some-lifecycle")}))
 				})
 
 				Context("when the actor returns a security group not found error", func() {
@@ -215,11 +215,11 @@ var _ = Describe("unbind-security-group Command", func() {
 					Expect(testUI.Err).To(Say("unbind warning"))
 
 					Expect(fakeActor.UnbindSecurityGroupByNameOrganizationNameAndSpaceNameCallCount()).To(Equal(1))
-					securityGroupName, orgName, spaceName, lifecycle := fakeActor.UnbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall(0)
+					securityGroupName, orgName, spaceName, lifecycles := fakeActor.UnbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall(0)
 					Expect(securityGroupName).To(Equal("some-security-group"))
 					Expect(orgName).To(Equal("some-org"))
 					Expect(spaceName).To(Equal("some-space"))
-					Expect(lifecycle).To(Equal(ccv2.SecurityGroupLifecycle("some-lifecycle")))
+					Expect(lifecycles).To(Equal([]ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycle("some-lifecycle")}))
 				})
 			})
 
@@ -328,4 +328,43 @@ var _ = Describe("unbind-security-group Command", func() {
 			})
 		})
 	})
+	Context("when lifecycle is 'both'", func() {
+		BeforeEach(func() {
+			cmd.Lifecycle = flag.SecurityGroupLifecycleBoth
+		})
+
+		Context("when the version check fails", func() {
+			BeforeEach(func() {
+				fakeActor.CloudControllerAPIVersionReturns("2.34.0")
+			})
+
+			It("returns a MinimumAPIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.LifecycleMinimumAPIVersionNotMetError{
+					CurrentVersion: "2.34.0",
+					MinimumVersion: version.MinVersionLifecyleStagingV2,
+				}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the version check succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.CloudControllerAPIVersionReturns(version.MinVersionLifecyleStagingV2)
+				cmd.RequiredArgs.SecurityGroupName = "some-security-group"
+				cmd.RequiredArgs.OrganizationName = "some-org"
+				cmd.RequiredArgs.SpaceName = "some-space"
+			})
+
+			It("unbinds the security group from the space for the running and staging lifecycles", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(fakeActor.UnbindSecurityGroupByNameOrganizationNameAndSpaceNameCallCount()).To(Equal(1))
+				_, _, _, lifecycles := fakeActor.UnbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall(0)
+				Expect(lifecycles).To(Equal([]ccv2.SecurityGroupLifecycle{
+					ccv2.SecurityGroupLifecycleRunning,
+					ccv2.SecurityGroupLifecycleStaging,
+				}))
+			})
+		})
+	})
 })
@@ -1,24 +1,93 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . UnsetSpaceRoleActor
+
+type UnsetSpaceRoleActor interface {
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	UnsetSpaceRole(spaceGUID string, username string, origin string, isClient bool, role v2action.SpaceRole) (v2action.Warnings, error)
+}
+
 type UnsetSpaceRoleCommand struct {
 	RequiredArgs    flag.SetSpaceRoleArgs `positional-args:"yes"`
-	usage           interface{}           `usage:"CF_NAME unset-space-role USERNAME ORG SPACE ROLE\n\nROLES:\n   'SpaceManager' - Invite and manage users, and enable features for a given space\n   'SpaceDeveloper' - Create and manage apps and services, and see logs and reports\n   'SpaceAuditor' - View logs, reports, and settings on this space"`
+	IsClient        bool                  `long:"client" description:"Remove the space role from a client-id of a (non-user) service account"`
+	Origin          string                `long:"origin" description:"Indicates the identity provider to be used for authentication"`
+	usage           interface{}           `usage:"CF_NAME unset-space-role USERNAME ORG SPACE ROLE [--origin ORIGIN]\n   CF_NAME unset-space-role CLIENT_ID ORG SPACE ROLE --client\n\nROLES:\n   'SpaceManager' - Invite and manage users, and enable features for a given space\n   'SpaceDeveloper' - Create and manage apps and services, and see logs and reports\n   'SpaceAuditor' - View logs, reports, and settings on this space"`
 	relatedCommands interface{}           `related_commands:"space-users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UnsetSpaceRoleActor
 }
 
-func (UnsetSpaceRoleCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *UnsetSpaceRoleCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (UnsetSpaceRoleCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd UnsetSpaceRoleCommand) Execute(args []string) error {
+	if cmd.IsClient && cmd.Origin != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--client", "--origin"},
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Removing role {{.Role}} from user {{.TargetUser}} in org {{.TargetOrg}} / space {{.TargetSpace}} as {{.CurrentUser}}...", map[string]interface{}{
+		"Role":        cmd.RequiredArgs.Role.Role,
+		"TargetUser":  cmd.RequiredArgs.Username,
+		"TargetOrg":   cmd.RequiredArgs.Organization,
+		"TargetSpace": cmd.RequiredArgs.Space,
+		"CurrentUser": user.Name,
+	})
+
+	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.RequiredArgs.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(org.GUID, cmd.RequiredArgs.Space)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	warnings, err = cmd.Actor.UnsetSpaceRole(space.GUID, cmd.RequiredArgs.Username, cmd.Origin, cmd.IsClient, v2action.SpaceRole(cmd.RequiredArgs.Role.Role))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
 	return nil
 }
@@ -1,30 +1,195 @@
 package v2
 
 import (
+	"io/ioutil"
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/downloader"
+	"code.cloudfoundry.org/cli/version"
 )
 
+//go:generate counterfeiter . UpdateBuildpackActor
+
+type UpdateBuildpackActor interface {
+	CloudControllerAPIVersion() string
+	PrepareBuildpackBits(inputPath string, tmpDirPath string, downloader v2action.Downloader) (string, error)
+	UpdateBuildpackByNameAndStack(name string, stack string, position types.NullInt, locked types.NullBool, enabled types.NullBool, newStack string) (string, v2action.Warnings, error)
+	UploadBuildpack(guid string, pathToBuildpackBits string, progressBar v2action.SimpleProgressBar) (v2action.Warnings, error)
+}
+
 type UpdateBuildpackCommand struct {
 	RequiredArgs    flag.BuildpackName               `positional-args:"yes"`
+	NewStack        string                           `long:"assign-stack" description:"Assign a stack to a buildpack that does not have a stack association"`
 	Disable         bool                             `long:"disable" description:"Disable the buildpack from being used for staging"`
 	Enable          bool                             `long:"enable" description:"Enable the buildpack to be used for staging"`
-	Order           int                              `short:"i" description:"The order in which the buildpacks are checked during buildpack auto-detection"`
+	Order           flag.Integer                     `short:"i" description:"The order in which the buildpacks are checked during buildpack auto-detection"`
 	Lock            bool                             `long:"lock" description:"Lock the buildpack to prevent updates"`
 	Path            flag.PathWithExistenceCheckOrURL `short:"p" description:"Path to directory or zip file"`
+	CurrentStack    string                           `short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
 	Unlock          bool                             `long:"unlock" description:"Unlock the buildpack to enable updates"`
-	usage           interface{}                      `usage:"CF_NAME update-buildpack BUILDPACK [-p PATH] [-i POSITION] [--enable|--disable] [--lock|--unlock]\n\nTIP:\n   Path should be a zip file, a url to a zip file, or a local directory. Position is a positive integer, sets priority, and is sorted from lowest to highest."`
+	usage           interface{}                      `usage:"CF_NAME update-buildpack BUILDPACK [-p PATH | -s STACK | --assign-stack NEW_STACK] [-i POSITION] [--enable|--disable] [--lock|--unlock]\n\nTIP:\n   Path should be a zip file, a url to a zip file, or a local directory. Position is a positive integer, sets priority, and is sorted from lowest to highest.\n\n   Use '--assign-stack' with caution. Associating a buildpack with a stack that it does not support may result in undefined behavior. Additionally, changing this association once made may require a local copy of the buildpack."`
 	relatedCommands interface{}                      `related_commands:"buildpacks, rename-buildpack"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UpdateBuildpackActor
+	ProgressBar ProgressBar
+}
+
+func (cmd *UpdateBuildpackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+	cmd.ProgressBar = ui.NewProgressBar()
+
+	return nil
 }
 
-func (UpdateBuildpackCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd UpdateBuildpackCommand) Execute(args []string) error {
+	err := cmd.validateFlagCombinations()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.CurrentStack != "" {
+		err = version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionBuildpackStackAssociationV2, "Option '-s'")
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.NewStack != "" {
+		err = version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionBuildpackStackAssociationV2, "Option '--assign-stack'")
+		if err != nil {
+			return err
+		}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var pathToBuildpackBits string
+	if cmd.Path != "" {
+		tmpDirPath, tmpErr := ioutil.TempDir("", "buildpack-dir-")
+		if tmpErr != nil {
+			return tmpErr
+		}
+		defer os.RemoveAll(tmpDirPath)
+
+		pathToBuildpackBits, err = cmd.Actor.PrepareBuildpackBits(string(cmd.Path), tmpDirPath, downloader.NewDownloader(tmpDirPath))
+		if err != nil {
+			cmd.UI.DisplayWarning("Failed to create a local temporary zip file for the buildpack")
+			return shared.HandleError(err)
+		}
+	}
+
+	cmd.displayUpdatingMessage(user.Name)
+
+	buildpackGUID, warnings, err := cmd.Actor.UpdateBuildpackByNameAndStack(
+		cmd.RequiredArgs.Buildpack,
+		cmd.CurrentStack,
+		cmd.Order.NullInt,
+		cmd.lockedValue(),
+		cmd.enabledValue(),
+		cmd.NewStack,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	if pathToBuildpackBits == "" {
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Uploading buildpack {{.Buildpack}} as {{.CurrentUser}}...", map[string]interface{}{
+		"Buildpack":   cmd.RequiredArgs.Buildpack,
+		"CurrentUser": user.Name,
+	})
+
+	err = uploadBuildpackBits(cmd.UI, cmd.Actor.UploadBuildpack, cmd.ProgressBar, buildpackGUID, pathToBuildpackBits)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
 	return nil
 }
 
-func (UpdateBuildpackCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd UpdateBuildpackCommand) validateFlagCombinations() error {
+	switch {
+	case cmd.Enable && cmd.Disable:
+		return translatableerror.ArgumentCombinationError{Args: []string{"--enable", "--disable"}}
+	case cmd.Lock && cmd.Unlock:
+		return translatableerror.ArgumentCombinationError{Args: []string{"--lock", "--unlock"}}
+	case cmd.Path != "" && cmd.Lock:
+		return translatableerror.ArgumentCombinationError{Args: []string{"-p", "--lock"}}
+	case cmd.Path != "" && cmd.Unlock:
+		return translatableerror.ArgumentCombinationError{Args: []string{"-p", "--unlock"}}
+	case cmd.Path != "" && cmd.NewStack != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"-p", "--assign-stack"}}
+	case cmd.CurrentStack != "" && cmd.NewStack != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"-s", "--assign-stack"}}
+	}
 	return nil
 }
+
+func (cmd UpdateBuildpackCommand) displayUpdatingMessage(userName string) {
+	switch {
+	case cmd.NewStack != "":
+		cmd.UI.DisplayTextWithFlavor("Assigning stack {{.Stack}} to buildpack {{.Buildpack}} as {{.CurrentUser}}...", map[string]interface{}{
+			"Stack":       cmd.NewStack,
+			"Buildpack":   cmd.RequiredArgs.Buildpack,
+			"CurrentUser": userName,
+		})
+	case cmd.CurrentStack != "":
+		cmd.UI.DisplayTextWithFlavor("Updating buildpack {{.Buildpack}} with stack {{.Stack}} as {{.CurrentUser}}...", map[string]interface{}{
+			"Buildpack":   cmd.RequiredArgs.Buildpack,
+			"Stack":       cmd.CurrentStack,
+			"CurrentUser": userName,
+		})
+	default:
+		cmd.UI.DisplayTextWithFlavor("Updating buildpack {{.Buildpack}} as {{.CurrentUser}}...", map[string]interface{}{
+			"Buildpack":   cmd.RequiredArgs.Buildpack,
+			"CurrentUser": userName,
+		})
+	}
+}
+
+func (cmd UpdateBuildpackCommand) enabledValue() types.NullBool {
+	if cmd.Enable || cmd.Disable {
+		return types.NullBool{IsSet: true, Value: cmd.Enable}
+	}
+	return types.NullBool{}
+}
+
+func (cmd UpdateBuildpackCommand) lockedValue() types.NullBool {
+	if cmd.Lock || cmd.Unlock {
+		return types.NullBool{IsSet: true, Value: cmd.Lock}
+	}
+	return types.NullBool{}
+}
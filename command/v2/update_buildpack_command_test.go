@@ -0,0 +1,261 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-buildpack Command", func() {
+	var (
+		cmd             UpdateBuildpackCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUpdateBuildpackActor
+		fakeProgressBar *v2fakes.FakeProgressBar
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUpdateBuildpackActor)
+		fakeProgressBar = new(v2fakes.FakeProgressBar)
+
+		cmd = UpdateBuildpackCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		cmd.RequiredArgs.Buildpack = "some-buildpack"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionBuildpackStackAssociationV2)
+		fakeActor.UpdateBuildpackByNameAndStackReturns("some-buildpack-guid", v2action.Warnings{"update-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	DescribeTable("invalid flag combinations",
+		func(setup func(*UpdateBuildpackCommand), args []string) {
+			setup(&cmd)
+			Expect(cmd.Execute(nil)).To(MatchError(translatableerror.ArgumentCombinationError{Args: args}))
+		},
+
+		Entry("--enable and --disable", func(c *UpdateBuildpackCommand) { c.Enable, c.Disable = true, true }, []string{"--enable", "--disable"}),
+		Entry("--lock and --unlock", func(c *UpdateBuildpackCommand) { c.Lock, c.Unlock = true, true }, []string{"--lock", "--unlock"}),
+		Entry("-p and --lock", func(c *UpdateBuildpackCommand) { c.Path, c.Lock = "some-path", true }, []string{"-p", "--lock"}),
+		Entry("-p and --unlock", func(c *UpdateBuildpackCommand) { c.Path, c.Unlock = "some-path", true }, []string{"-p", "--unlock"}),
+		Entry("-p and --assign-stack", func(c *UpdateBuildpackCommand) { c.Path, c.NewStack = "some-path", "some-stack" }, []string{"-p", "--assign-stack"}),
+		Entry("-s and --assign-stack", func(c *UpdateBuildpackCommand) { c.CurrentStack, c.NewStack = "some-stack", "other-stack" }, []string{"-s", "--assign-stack"}),
+	)
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.UpdateBuildpackByNameAndStackCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when -s is provided and the API does not support stack association", func() {
+		BeforeEach(func() {
+			cmd.CurrentStack = "some-stack"
+			fakeActor.CloudControllerAPIVersionReturns("2.111.0")
+		})
+
+		It("returns a minimum version error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '-s'",
+				CurrentVersion: "2.111.0",
+				MinimumVersion: version.MinVersionBuildpackStackAssociationV2,
+			}))
+		})
+	})
+
+	Context("when --assign-stack is provided and the API does not support stack association", func() {
+		BeforeEach(func() {
+			cmd.NewStack = "some-stack"
+			fakeActor.CloudControllerAPIVersionReturns("2.111.0")
+		})
+
+		It("returns a minimum version error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '--assign-stack'",
+				CurrentVersion: "2.111.0",
+				MinimumVersion: version.MinVersionBuildpackStackAssociationV2,
+			}))
+		})
+	})
+
+	Context("when updating the buildpack properties", func() {
+		BeforeEach(func() {
+			cmd.Order = flag.Integer{NullInt: types.NullInt{IsSet: true, Value: 2}}
+			cmd.Disable = true
+			cmd.Lock = true
+		})
+
+		It("updates only the provided properties", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Updating buildpack some-buildpack as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("update-warning"))
+			Expect(testUI.Out).ToNot(Say("Uploading"))
+
+			Expect(fakeActor.UpdateBuildpackByNameAndStackCallCount()).To(Equal(1))
+			name, stack, position, locked, enabled, newStack := fakeActor.UpdateBuildpackByNameAndStackArgsForCall(0)
+			Expect(name).To(Equal("some-buildpack"))
+			Expect(stack).To(BeEmpty())
+			Expect(position).To(Equal(types.NullInt{IsSet: true, Value: 2}))
+			Expect(locked).To(Equal(types.NullBool{IsSet: true, Value: true}))
+			Expect(enabled).To(Equal(types.NullBool{IsSet: true, Value: false}))
+			Expect(newStack).To(BeEmpty())
+
+			Expect(fakeActor.PrepareBuildpackBitsCallCount()).To(Equal(0))
+			Expect(fakeActor.UploadBuildpackCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when -s is provided", func() {
+		BeforeEach(func() {
+			cmd.CurrentStack = "some-stack"
+		})
+
+		It("updates the buildpack with that stack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Updating buildpack some-buildpack with stack some-stack as some-user..."))
+
+			_, stack, position, locked, enabled, _ := fakeActor.UpdateBuildpackByNameAndStackArgsForCall(0)
+			Expect(stack).To(Equal("some-stack"))
+			Expect(position.IsSet).To(BeFalse())
+			Expect(locked.IsSet).To(BeFalse())
+			Expect(enabled.IsSet).To(BeFalse())
+		})
+	})
+
+	Context("when --assign-stack is provided", func() {
+		BeforeEach(func() {
+			cmd.NewStack = "some-stack"
+		})
+
+		It("assigns the stack to the buildpack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Assigning stack some-stack to buildpack some-buildpack as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			_, _, _, _, _, newStack := fakeActor.UpdateBuildpackByNameAndStackArgsForCall(0)
+			Expect(newStack).To(Equal("some-stack"))
+		})
+
+		Context("when the buildpack already has a stack", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateBuildpackByNameAndStackReturns("", nil, v2action.BuildpackStackChangeError{BuildpackName: "some-buildpack"})
+			})
+
+			It("returns a translatable error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.BuildpackStackChangeError{BuildpackName: "some-buildpack"}))
+			})
+		})
+	})
+
+	Context("when the buildpack cannot be found", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateBuildpackByNameAndStackReturns("", v2action.Warnings{"update-warning"}, v2action.BuildpackNotFoundError{BuildpackName: "some-buildpack"})
+		})
+
+		It("returns a translatable error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.BuildpackNotFoundError{BuildpackName: "some-buildpack"}))
+			Expect(testUI.Err).To(Say("update-warning"))
+		})
+	})
+
+	Context("when -p is provided", func() {
+		BeforeEach(func() {
+			cmd.Path = "some-path"
+			fakeActor.PrepareBuildpackBitsReturns("some-prepared-path", nil)
+		})
+
+		Context("when the upload succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.UploadBuildpackReturns(v2action.Warnings{"upload-warning"}, nil)
+			})
+
+			It("updates the buildpack and uploads the prepared bits", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Updating buildpack some-buildpack as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Uploading buildpack some-buildpack as some-user..."))
+				Expect(testUI.Out).To(Say("Done uploading"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("update-warning"))
+				Expect(testUI.Err).To(Say("upload-warning"))
+
+				inputPath, _, _ := fakeActor.PrepareBuildpackBitsArgsForCall(0)
+				Expect(inputPath).To(Equal("some-path"))
+
+				Expect(fakeActor.UploadBuildpackCallCount()).To(Equal(1))
+				guid, path, _ := fakeActor.UploadBuildpackArgsForCall(0)
+				Expect(guid).To(Equal("some-buildpack-guid"))
+				Expect(path).To(Equal("some-prepared-path"))
+
+				Eventually(fakeProgressBar.ReadyCallCount).Should(Equal(1))
+				Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when preparing the bits fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("prepare failed")
+				fakeActor.PrepareBuildpackBitsReturns("", expectedErr)
+			})
+
+			It("displays a warning and does not update the buildpack", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("Failed to create a local temporary zip file for the buildpack"))
+				Expect(fakeActor.UpdateBuildpackByNameAndStackCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the upload fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("upload failed")
+				fakeActor.UploadBuildpackReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
@@ -0,0 +1,128 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-quota Command", func() {
+	var (
+		cmd             UpdateQuotaCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUpdateQuotaActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUpdateQuotaActor)
+
+		cmd = UpdateQuotaCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.Quota = "some-quota"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionReservedRoutePortsV2)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when both --allow-paid-service-plans and --disallow-paid-service-plans are provided", func() {
+		BeforeEach(func() {
+			cmd.AllowPaidServicePlans = true
+			cmd.DisallowPaidServicePlans = true
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--allow-paid-service-plans", "--disallow-paid-service-plans"},
+			}))
+			Expect(fakeActor.UpdateQuotaCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.UpdateQuotaCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the quota is updated", func() {
+		BeforeEach(func() {
+			cmd.DisallowPaidServicePlans = true
+			cmd.NewName = "new-quota"
+			cmd.NumAppInstances = flag.Integer{NullInt: types.NullInt{IsSet: true, Value: -1}}
+			cmd.NumServiceInstances = flag.Integer{NullInt: types.NullInt{IsSet: true, Value: 0}}
+			fakeActor.UpdateQuotaReturns(v2action.OrganizationQuota{}, v2action.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates only the provided limits", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Updating quota some-quota as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("update-warning"))
+
+			Expect(fakeActor.UpdateQuotaCallCount()).To(Equal(1))
+			name, quota := fakeActor.UpdateQuotaArgsForCall(0)
+			Expect(name).To(Equal("some-quota"))
+			Expect(quota).To(Equal(v2action.OrganizationQuota{
+				Name:                    "new-quota",
+				NonBasicServicesAllowed: types.NullBool{IsSet: true, Value: false},
+				TotalServices:           types.NullInt{IsSet: true, Value: 0},
+				AppInstanceLimit:        types.NullInt{IsSet: true, Value: -1},
+			}))
+		})
+	})
+
+	Context("when the quota does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateQuotaReturns(v2action.OrganizationQuota{}, v2action.Warnings{"get-warning"}, v2action.OrganizationQuotaNotFoundError{Name: "some-quota"})
+		})
+
+		It("returns a translatable error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.OrganizationQuotaNotFoundError{Name: "some-quota"}))
+			Expect(testUI.Err).To(Say("get-warning"))
+		})
+	})
+
+	Context("when the new name is taken", func() {
+		BeforeEach(func() {
+			cmd.NewName = "new-quota"
+			fakeActor.UpdateQuotaReturns(v2action.OrganizationQuota{}, nil, v2action.OrganizationQuotaNameTakenError{Name: "new-quota"})
+		})
+
+		It("returns a translatable error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.OrganizationQuotaNameTakenError{Name: "new-quota"}))
+		})
+	})
+})
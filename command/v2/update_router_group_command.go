@@ -0,0 +1,74 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . UpdateRouterGroupActor
+
+type UpdateRouterGroupActor interface {
+	UpdateRouterGroupReservablePorts(name string, ports string) (v2action.RouterGroup, error)
+}
+
+type UpdateRouterGroupCommand struct {
+	RequiredArgs    flag.RouterGroupName `positional-args:"yes"`
+	ReservablePorts string               `short:"r" long:"reservable-ports" required:"true" description:"Comma-separated list of ports or port ranges that may be reserved for TCP routes, e.g. 1024-1033,1100"`
+	usage           interface{}          `usage:"CF_NAME update-router-group ROUTER_GROUP_NAME -r RESERVABLE_PORTS\n\nEXAMPLES:\n   CF_NAME update-router-group default-tcp -r 1024-1033,1100"`
+	relatedCommands interface{}          `related_commands:"router-groups"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UpdateRouterGroupActor
+}
+
+func (cmd *UpdateRouterGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	routerClient, err := shared.NewRouterClient(ccClient.RoutingEndpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+
+	actor := v2action.NewActor(ccClient, uaaClient, config)
+	actor.RouterClient = routerClient
+	cmd.Actor = actor
+
+	return nil
+}
+
+func (cmd UpdateRouterGroupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating router group {{.RouterGroup}} as {{.CurrentUser}}...", map[string]interface{}{
+		"RouterGroup": cmd.RequiredArgs.RouterGroup,
+		"CurrentUser": user.Name,
+	})
+
+	_, err = cmd.Actor.UpdateRouterGroupReservablePorts(cmd.RequiredArgs.RouterGroup, cmd.ReservablePorts)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,101 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-router-group Command", func() {
+	var (
+		cmd             UpdateRouterGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUpdateRouterGroupActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUpdateRouterGroupActor)
+
+		cmd = UpdateRouterGroupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.RouterGroup = "default-tcp"
+		cmd.ReservablePorts = "1024-1033,1100"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.UpdateRouterGroupReservablePortsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the update succeeds", func() {
+		It("updates the reservable ports and displays OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Updating router group default-tcp as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.UpdateRouterGroupReservablePortsCallCount()).To(Equal(1))
+			name, ports := fakeActor.UpdateRouterGroupReservablePortsArgsForCall(0)
+			Expect(name).To(Equal("default-tcp"))
+			Expect(ports).To(Equal("1024-1033,1100"))
+		})
+	})
+
+	Context("when the router group does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateRouterGroupReservablePortsReturns(v2action.RouterGroup{}, v2action.RouterGroupNotFoundError{Name: "default-tcp"})
+		})
+
+		It("returns a translatable error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RouterGroupNotFoundError{Name: "default-tcp"}))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	Context("when the update fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("update failed")
+			fakeActor.UpdateRouterGroupReservablePortsReturns(v2action.RouterGroup{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+})
@@ -0,0 +1,159 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-service Command", func() {
+	var (
+		cmd             UpdateServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUpdateServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUpdateServiceActor)
+
+		cmd = UpdateServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.ServiceInstance = "some-instance"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.ExperimentalReturns(true)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and an org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		})
+
+		Context("when no changes are requested", func() {
+			It("displays that no changes were made", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("No changes were made"))
+				Expect(fakeActor.UpdateServiceInstanceByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a new plan, parameters and tags are provided", func() {
+			BeforeEach(func() {
+				cmd.Plan = "some-plan"
+				cmd.ParametersAsJSON = map[string]interface{}{"some-parameter": "some-value"}
+				cmd.Tags = []string{}
+				fakeActor.UpdateServiceInstanceByNameAndSpaceReturns(
+					v2action.ServiceInstance{Name: "some-instance"},
+					v2action.Warnings{"update-warning"},
+					nil,
+				)
+			})
+
+			It("updates the service instance and displays OK", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Updating service instance some-instance as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("update-warning"))
+
+				Expect(fakeActor.UpdateServiceInstanceByNameAndSpaceCallCount()).To(Equal(1))
+				instanceName, spaceGUID, planName, parameters, tags := fakeActor.UpdateServiceInstanceByNameAndSpaceArgsForCall(0)
+				Expect(instanceName).To(Equal("some-instance"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(planName).To(Equal("some-plan"))
+				Expect(parameters).To(Equal(map[string]interface{}{"some-parameter": "some-value"}))
+				Expect(tags).To(BeEmpty())
+				Expect(tags).ToNot(BeNil())
+			})
+
+			Context("when the update is in progress and --wait is provided", func() {
+				BeforeEach(func() {
+					cmd.Wait = true
+					fakeActor.UpdateServiceInstanceByNameAndSpaceReturns(
+						v2action.ServiceInstance{
+							Name:          "some-instance",
+							LastOperation: ccv2.LastOperation{Type: "update", State: ccv2.LastOperationInProgress},
+						},
+						nil,
+						nil,
+					)
+				})
+
+				Context("when the operation times out", func() {
+					BeforeEach(func() {
+						fakeActor.PollServiceInstanceLastOperationReturns(
+							v2action.ServiceInstance{},
+							v2action.Warnings{"poll-warning"},
+							v2action.ServiceInstanceOperationTimeoutError{Name: "some-instance", Operation: "update"},
+						)
+					})
+
+					It("returns a translatable error", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceOperationTimeoutError{
+							Name:      "some-instance",
+							Operation: "update",
+						}))
+
+						Expect(testUI.Out).To(Say("Waiting for the operation to complete..."))
+						Expect(testUI.Err).To(Say("poll-warning"))
+					})
+				})
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				cmd.Plan = "some-plan"
+				fakeActor.UpdateServiceInstanceByNameAndSpaceReturns(v2action.ServiceInstance{}, nil, v2action.ServiceInstanceNotFoundError{Name: "some-instance"})
+			})
+
+			It("returns a translatable error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceNotFoundError{Name: "some-instance"}))
+			})
+		})
+	})
+})
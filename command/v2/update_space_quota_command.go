@@ -1,33 +1,98 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/version"
 )
 
+//go:generate counterfeiter . UpdateSpaceQuotaActor
+
+type UpdateSpaceQuotaActor interface {
+	CloudControllerAPIVersion() string
+	UpdateSpaceQuota(orgGUID string, name string, quota v2action.SpaceQuota) (v2action.SpaceQuota, v2action.Warnings, error)
+}
+
 type UpdateSpaceQuotaCommand struct {
 	RequiredArgs             flag.SpaceQuota          `positional-args:"yes"`
-	NumAppInstances          int                      `short:"a" description:"Total number of application instances. -1 represents an unlimited amount."`
+	NumAppInstances          flag.Integer             `short:"a" description:"Total number of application instances. -1 represents an unlimited amount."`
 	AllowPaidServicePlans    bool                     `long:"allow-paid-service-plans" description:"Can provision instances of paid service plans"`
 	DisallowPaidServicePlans bool                     `long:"disallow-paid-service-plans" description:"Can not provision instances of paid service plans"`
 	AppInstanceMemory        flag.MemoryWithUnlimited `short:"i" description:"Maximum amount of memory an application instance can have (e.g. 1024M, 1G, 10G). -1 represents an unlimited amount."`
-	TotalMemory              string                   `short:"m" description:"Total amount of memory a space can have (e.g. 1024M, 1G, 10G)"`
+	TotalMemory              flag.Megabytes           `short:"m" description:"Total amount of memory a space can have (e.g. 1024M, 1G, 10G)"`
 	Name                     string                   `short:"n" description:"New name"`
-	NumRoutes                int                      `short:"r" description:"Total number of routes"`
-	ReservedRoutePorts       int                      `long:"reserved-route-ports" description:"Maximum number of routes that may be created with reserved ports"`
-	NumServiceInstances      int                      `short:"s" description:"Total number of service instances"`
+	NumRoutes                flag.Integer             `short:"r" description:"Total number of routes"`
+	ReservedRoutePorts       flag.Integer             `long:"reserved-route-ports" description:"Maximum number of routes that may be created with reserved ports"`
+	NumServiceInstances      flag.Integer             `short:"s" description:"Total number of service instances"`
 	usage                    interface{}              `usage:"CF_NAME update-space-quota SPACE_QUOTA [-i INSTANCE_MEMORY] [-m MEMORY] [-n NAME] [-r ROUTES] [-s SERVICE_INSTANCES] [-a APP_INSTANCES] [--allow-paid-service-plans | --disallow-paid-service-plans] [--reserved-route-ports RESERVED_ROUTE_PORTS]"`
 	relatedCommands          interface{}              `related_commands:"space-quota, space-quotas"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UpdateSpaceQuotaActor
 }
 
-func (UpdateSpaceQuotaCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *UpdateSpaceQuotaCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (UpdateSpaceQuotaCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd UpdateSpaceQuotaCommand) Execute(args []string) error {
+	if cmd.AllowPaidServicePlans && cmd.DisallowPaidServicePlans {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--allow-paid-service-plans", "--disallow-paid-service-plans"},
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = checkQuotaVersions(cmd.Actor.CloudControllerAPIVersion(), cmd.NumAppInstances, version.MinVersionSpaceAppInstanceLimitV2, cmd.ReservedRoutePorts)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating space quota {{.Quota}} as {{.Username}}...", map[string]interface{}{
+		"Quota":    cmd.RequiredArgs.SpaceQuota,
+		"Username": user.Name,
+	})
+
+	_, warnings, err := cmd.Actor.UpdateSpaceQuota(cmd.Config.TargetedOrganization().GUID, cmd.RequiredArgs.SpaceQuota, v2action.SpaceQuota{
+		Name:                    cmd.Name,
+		NonBasicServicesAllowed: paidServicePlansValue(cmd.AllowPaidServicePlans, cmd.DisallowPaidServicePlans),
+		TotalServices:           cmd.NumServiceInstances.NullInt,
+		TotalRoutes:             cmd.NumRoutes.NullInt,
+		TotalReservedRoutePorts: cmd.ReservedRoutePorts.NullInt,
+		MemoryLimit:             megabytesToNullInt(cmd.TotalMemory),
+		InstanceMemoryLimit:     cmd.AppInstanceMemory.NullInt,
+		AppInstanceLimit:        cmd.NumAppInstances.NullInt,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
 	return nil
 }
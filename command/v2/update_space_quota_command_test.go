@@ -0,0 +1,134 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-space-quota Command", func() {
+	var (
+		cmd             UpdateSpaceQuotaCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUpdateSpaceQuotaActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUpdateSpaceQuotaActor)
+
+		cmd = UpdateSpaceQuotaCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.SpaceQuota = "some-space-quota"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionReservedRoutePortsV2)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when both --allow-paid-service-plans and --disallow-paid-service-plans are provided", func() {
+		BeforeEach(func() {
+			cmd.AllowPaidServicePlans = true
+			cmd.DisallowPaidServicePlans = true
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--allow-paid-service-plans", "--disallow-paid-service-plans"},
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when --reserved-route-ports is provided and the API does not support them", func() {
+		BeforeEach(func() {
+			cmd.ReservedRoutePorts = flag.Integer{NullInt: types.NullInt{IsSet: true, Value: 5}}
+			fakeActor.CloudControllerAPIVersionReturns("2.54.0")
+		})
+
+		It("returns a minimum version error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '--reserved-route-ports'",
+				CurrentVersion: "2.54.0",
+				MinimumVersion: version.MinVersionReservedRoutePortsV2,
+			}))
+		})
+	})
+
+	Context("when the space quota is updated", func() {
+		BeforeEach(func() {
+			cmd.AllowPaidServicePlans = true
+			cmd.Name = "new-space-quota"
+			cmd.ReservedRoutePorts = flag.Integer{NullInt: types.NullInt{IsSet: true, Value: 5}}
+			fakeActor.UpdateSpaceQuotaReturns(v2action.SpaceQuota{}, v2action.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates only the provided limits", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Updating space quota some-space-quota as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("update-warning"))
+
+			Expect(fakeActor.UpdateSpaceQuotaCallCount()).To(Equal(1))
+			orgGUID, name, quota := fakeActor.UpdateSpaceQuotaArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(name).To(Equal("some-space-quota"))
+			Expect(quota).To(Equal(v2action.SpaceQuota{
+				Name:                    "new-space-quota",
+				NonBasicServicesAllowed: types.NullBool{IsSet: true, Value: true},
+				TotalReservedRoutePorts: types.NullInt{IsSet: true, Value: 5},
+			}))
+		})
+	})
+
+	Context("when the space quota does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateSpaceQuotaReturns(v2action.SpaceQuota{}, v2action.Warnings{"get-warning"}, v2action.SpaceQuotaNotFoundError{Name: "some-space-quota"})
+		})
+
+		It("returns a translatable error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SpaceQuotaNotFoundError{Name: "some-space-quota"}))
+			Expect(testUI.Err).To(Say("get-warning"))
+		})
+	})
+})
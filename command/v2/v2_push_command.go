@@ -13,7 +13,6 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/util/configv3"
-	"code.cloudfoundry.org/cli/util/progressbar"
 	"github.com/cloudfoundry/noaa/consumer"
 	log "github.com/sirupsen/logrus"
 )
@@ -32,6 +31,7 @@ type V2PushActor interface {
 	Apply(config pushaction.ApplicationConfig, progressBar pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
 	ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
 	MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
+	PlanApply(config pushaction.ApplicationConfig) pushaction.ApplyPlan
 	ReadManifest(pathToManifest string) ([]manifest.Application, error)
 }
 
@@ -40,6 +40,8 @@ type V2PushCommand struct {
 	Buildpack    flag.Buildpack       `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
 	Command      flag.Command         `short:"c" description:"Startup command, set to null to reset to default start command"`
 	// Domain               string                      `short:"d" description:"Domain (e.g. example.com)"`
+	DryRun          bool                        `long:"dry-run" description:"Display the changes push would make without making them"`
+	Detach          bool                        `long:"detach" description:"Return once the app files are uploaded instead of waiting for them to be processed; implies --no-start"`
 	DockerImage     flag.DockerImage            `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
 	DockerUsername  string                      `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
 	PathToManifest  flag.PathWithExistenceCheck `short:"f" description:"Path to manifest"`
@@ -49,19 +51,22 @@ type V2PushCommand struct {
 	DiskQuota flag.Megabytes `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	Memory    flag.Megabytes `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	// NoHostname           bool                        `long:"no-hostname" description:"Map the root domain to this app"`
-	NoManifest bool `long:"no-manifest" description:"Ignore manifest file"`
+	NoManifest         bool `long:"no-manifest" description:"Ignore manifest file"`
+	NoResourceMatching bool `long:"no-resource-matching" description:"Upload all app files without checking for previously uploaded matches"`
 	// NoRoute              bool                        `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
 	NoStart bool                        `long:"no-start" description:"Do not start an app after pushing"`
 	AppPath flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
 	// RandomRoute          bool                        `long:"random-route" description:"Create a random route for this app"`
 	// RoutePath            string                      `long:"route-path" description:"Path for the route"`
-	StackName           string      `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
-	HealthCheckTimeout  int         `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
-	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
-	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
-	dockerPassword      interface{} `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-
-	usage           interface{} `usage:"cf v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"`
+	StackName                     string      `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	HealthCheckTimeout            int         `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
+	envCFStagingRetries           interface{} `environmentName:"CF_STAGING_RETRIES" environmentDescription:"Number of times to retry staging when no cell has the resources to place it" environmentDefault:"0"`
+	envCFStagingTimeout           interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout           interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	dockerPassword                interface{} `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
+	envCFResourceMatchMinFileSize interface{} `environmentName:"CF_RESOURCE_MATCH_MIN_FILE_SIZE" environmentDescription:"Minimum size, in bytes, of files checked for previously uploaded matches" environmentDefault:"0"`
+
+	usage           interface{} `usage:"cf v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--dry-run] [--detach]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--dry-run]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start] [--dry-run] [--detach]"`
 	relatedCommands interface{} `related_commands:"apps, create-app-manifest, logs, ssh, start"`
 
 	UI          command.UI
@@ -87,9 +92,12 @@ func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.RestartActor = v2Actor
 	cmd.Actor = pushaction.NewActor(v2Actor)
 
-	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
 
-	cmd.ProgressBar = progressbar.NewProgressBar()
+	cmd.ProgressBar = ui.NewProgressBar()
 	return nil
 }
 
@@ -133,7 +141,7 @@ func (cmd V2PushCommand) Execute(args []string) error {
 	appConfigs, warnings, err := cmd.Actor.ConvertToApplicationConfigs(
 		cmd.Config.TargetedOrganization().GUID,
 		cmd.Config.TargetedSpace().GUID,
-		cmd.NoStart,
+		cmd.NoStart || cmd.Detach,
 		manifestApplications,
 	)
 	cmd.UI.DisplayWarnings(warnings)
@@ -158,6 +166,24 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		cmd.UI.DisplayNewline()
 	}
 
+	if cmd.DryRun {
+		cmd.displayApplyPlans(appConfigs)
+		return nil
+	}
+
+	if cmd.Config.IsTTY() && hasDestructiveChanges(appConfigs) {
+		log.Info("prompting for destructive changes")
+		continuePush, promptErr := cmd.UI.DisplayBoolPrompt(false, "Some of these changes reduce or overwrite the current settings of an app. Do you want to continue?")
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !continuePush {
+			cmd.UI.DisplayText("Push cancelled")
+			return nil
+		}
+	}
+
 	for appNumber, appConfig := range appConfigs {
 		if appConfig.CreatingApplication() {
 			cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}}...", map[string]interface{}{
@@ -169,6 +195,8 @@ func (cmd V2PushCommand) Execute(args []string) error {
 			})
 		}
 
+		appConfig.NoResourceMatching = cmd.NoResourceMatching
+		appConfig.Detach = cmd.Detach
 		configStream, eventStream, warningsStream, errorStream := cmd.Actor.Apply(appConfig, cmd.ProgressBar)
 		updatedConfig, err := cmd.processApplyStreams(user, appConfig, configStream, eventStream, warningsStream, errorStream)
 		if err != nil {
@@ -176,7 +204,15 @@ func (cmd V2PushCommand) Execute(args []string) error {
 			return shared.HandleError(err)
 		}
 
-		if !cmd.NoStart {
+		if updatedConfig.UploadJobGUID != "" {
+			shared.RecordDetachedJob(cmd.UI, cmd.Config, updatedConfig.UploadJobGUID, "v2-push", appConfig.DesiredApplication.Name)
+			if appNumber+1 < len(appConfigs) {
+				cmd.UI.DisplayNewline()
+			}
+			continue
+		}
+
+		if !cmd.NoStart && !cmd.Detach {
 			messages, logErrs, appState, apiWarnings, errs := cmd.RestartActor.RestartApplication(updatedConfig.CurrentApplication.Application, cmd.NOAAClient, cmd.Config)
 			err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
 			if err != nil {
@@ -201,6 +237,48 @@ func (cmd V2PushCommand) Execute(args []string) error {
 	return nil
 }
 
+func hasDestructiveChanges(appConfigs []pushaction.ApplicationConfig) bool {
+	for _, appConfig := range appConfigs {
+		if appConfig.HasDestructiveChanges() {
+			return true
+		}
+	}
+	return false
+}
+
+func (cmd V2PushCommand) displayApplyPlans(appConfigs []pushaction.ApplicationConfig) {
+	for _, appConfig := range appConfigs {
+		log.Infoln("planning create/update:", appConfig.DesiredApplication.Name)
+		plan := cmd.Actor.PlanApply(appConfig)
+
+		if plan.CreatingApplication {
+			cmd.UI.DisplayText("+ create app {{.AppName}}", map[string]interface{}{"AppName": plan.AppName})
+		} else {
+			cmd.UI.DisplayText("~ update app {{.AppName}}", map[string]interface{}{"AppName": plan.AppName})
+		}
+
+		for _, route := range plan.RoutesToCreate {
+			cmd.UI.DisplayText("+ create route {{.Route}}", map[string]interface{}{"Route": route})
+		}
+
+		for _, route := range plan.RoutesToMap {
+			cmd.UI.DisplayText("+ map route {{.Route}}", map[string]interface{}{"Route": route})
+		}
+
+		for _, service := range plan.ServicesToBind {
+			cmd.UI.DisplayText("+ bind service {{.ServiceInstance}}", map[string]interface{}{"ServiceInstance": service})
+		}
+
+		if plan.UploadingFiles {
+			cmd.UI.DisplayText("+ upload app files")
+		}
+
+		cmd.UI.DisplayNewline()
+	}
+
+	cmd.UI.DisplayText("Dry run complete. No changes were made.")
+}
+
 func (cmd V2PushCommand) GetCommandLineSettings() (pushaction.CommandLineSettings, error) {
 	err := cmd.validateArgs()
 	if err != nil {
@@ -341,6 +419,8 @@ func (cmd V2PushCommand) processEvent(user configv3.User, appConfig pushaction.A
 		cmd.ProgressBar.Complete()
 		cmd.UI.DisplayNewline()
 		cmd.UI.DisplayText("Waiting for API to complete processing files...")
+	case pushaction.UploadDetached:
+		cmd.ProgressBar.Complete()
 	case pushaction.Complete:
 		return true
 	default:
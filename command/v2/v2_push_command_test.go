@@ -135,6 +135,58 @@ var _ = Describe("v2-push Command", func() {
 					fakeActor.ConvertToApplicationConfigsReturns(appConfigs, pushaction.Warnings{"some-config-warnings"}, nil)
 				})
 
+				Context("when the push is detached", func() {
+					BeforeEach(func() {
+						cmd.Detach = true
+
+						fakeActor.ApplyStub = func(config pushaction.ApplicationConfig, _ pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+							configStream := make(chan pushaction.ApplicationConfig, 1)
+							eventStream := make(chan pushaction.Event)
+							warningsStream := make(chan pushaction.Warnings)
+							errorStream := make(chan error)
+
+							config.UploadJobGUID = "some-job-guid"
+
+							go func() {
+								defer GinkgoRecover()
+
+								Eventually(eventStream).Should(BeSent(pushaction.UploadingApplication))
+								Eventually(eventStream).Should(BeSent(pushaction.UploadDetached))
+								Eventually(configStream).Should(BeSent(config))
+								Eventually(eventStream).Should(BeSent(pushaction.Complete))
+								close(configStream)
+								close(eventStream)
+								close(warningsStream)
+								close(errorStream)
+							}()
+
+							return configStream, eventStream, warningsStream, errorStream
+						}
+					})
+
+					It("uploads the files without starting the app and records the processing job", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						_, _, noStart, _ := fakeActor.ConvertToApplicationConfigsArgsForCall(0)
+						Expect(noStart).To(BeTrue())
+						config, _ := fakeActor.ApplyArgsForCall(0)
+						Expect(config.Detach).To(BeTrue())
+
+						Expect(testUI.Out).To(Say("Uploading files..."))
+						Expect(testUI.Out).ToNot(Say("Waiting for API to complete processing files..."))
+						Expect(testUI.Out).To(Say("Job some-job-guid is running in the background. Use 'faceman jobs' to check its status."))
+
+						Expect(fakeConfig.AddDetachedJobCallCount()).To(Equal(1))
+						job := fakeConfig.AddDetachedJobArgsForCall(0)
+						Expect(job.GUID).To(Equal("some-job-guid"))
+						Expect(job.Operation).To(Equal("v2-push"))
+						Expect(job.Resource).To(Equal(appName))
+
+						Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(0))
+						Expect(fakeRestartActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+					})
+				})
+
 				Context("when the apply is successful", func() {
 					var updatedConfig pushaction.ApplicationConfig
 
@@ -409,6 +461,20 @@ var _ = Describe("v2-push Command", func() {
 						Expect(progressBar).To(Equal(fakeProgressBar))
 					})
 
+					Context("when --no-resource-matching is provided", func() {
+						BeforeEach(func() {
+							cmd.NoResourceMatching = true
+						})
+
+						It("disables resource matching on each application configuration", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.ApplyCallCount()).To(Equal(1))
+							config, _ := fakeActor.ApplyArgsForCall(0)
+							Expect(config.NoResourceMatching).To(BeTrue())
+						})
+					})
+
 					It("display diff of changes", func() {
 						Expect(executeErr).ToNot(HaveOccurred())
 
@@ -603,6 +669,146 @@ var _ = Describe("v2-push Command", func() {
 						Expect(testUI.Err).To(Say("apply-2"))
 					})
 				})
+
+				Context("when the --dry-run flag is provided", func() {
+					BeforeEach(func() {
+						cmd.DryRun = true
+						fakeActor.PlanApplyReturns(pushaction.ApplyPlan{
+							AppName:        appName,
+							RoutesToCreate: []string{"route3.example.com"},
+							RoutesToMap:    []string{"route3.example.com", "route4.example.com"},
+							ServicesToBind: []string{"some-service-instance"},
+							UploadingFiles: true,
+						})
+					})
+
+					It("displays the planned changes without applying them", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Creating app with these attributes\\.\\.\\."))
+						Expect(testUI.Out).To(Say("~ update app %s", appName))
+						Expect(testUI.Out).To(Say("\\+ create route route3.example.com"))
+						Expect(testUI.Out).To(Say("\\+ map route route3.example.com"))
+						Expect(testUI.Out).To(Say("\\+ map route route4.example.com"))
+						Expect(testUI.Out).To(Say("\\+ bind service some-service-instance"))
+						Expect(testUI.Out).To(Say("\\+ upload app files"))
+						Expect(testUI.Out).To(Say("Dry run complete\\. No changes were made\\."))
+
+						Expect(fakeActor.PlanApplyCallCount()).To(Equal(1))
+						Expect(fakeActor.PlanApplyArgsForCall(0)).To(Equal(appConfigs[0]))
+						Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+						Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(0))
+					})
+
+					Context("when the app is being created", func() {
+						BeforeEach(func() {
+							fakeActor.PlanApplyReturns(pushaction.ApplyPlan{
+								AppName:             appName,
+								CreatingApplication: true,
+							})
+						})
+
+						It("displays that the app will be created", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("\\+ create app %s", appName))
+							Expect(testUI.Out).ToNot(Say("upload app files"))
+						})
+					})
+				})
+
+				Context("when the changes reduce the app's current settings", func() {
+					BeforeEach(func() {
+						appConfigs[0].CurrentApplication.GUID = "some-app-guid"
+						appConfigs[0].CurrentApplication.Memory = 512
+						appConfigs[0].DesiredApplication.GUID = "some-app-guid"
+						appConfigs[0].DesiredApplication.Memory = 256
+						fakeActor.ConvertToApplicationConfigsReturns(appConfigs, nil, nil)
+
+						fakeActor.ApplyStub = func(_ pushaction.ApplicationConfig, _ pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+							configStream := make(chan pushaction.ApplicationConfig)
+							eventStream := make(chan pushaction.Event)
+							warningsStream := make(chan pushaction.Warnings)
+							errorStream := make(chan error)
+
+							go func() {
+								defer GinkgoRecover()
+
+								Eventually(errorStream).Should(BeSent(errors.New("apply-error")))
+								close(configStream)
+								close(eventStream)
+								close(warningsStream)
+								close(errorStream)
+							}()
+
+							return configStream, eventStream, warningsStream, errorStream
+						}
+					})
+
+					Context("when the terminal is interactive", func() {
+						BeforeEach(func() {
+							fakeConfig.IsTTYReturns(true)
+						})
+
+						Context("when the user confirms the changes", func() {
+							BeforeEach(func() {
+								_, err := input.Write([]byte("y\n"))
+								Expect(err).ToNot(HaveOccurred())
+							})
+
+							It("displays the changes and applies them", func() {
+								Expect(executeErr).To(MatchError("apply-error"))
+
+								Expect(testUI.Out).To(Say("Updating app with these attributes\\.\\.\\."))
+								Expect(testUI.Out).To(Say("\\-\\s+memory:\\s+512M"))
+								Expect(testUI.Out).To(Say("\\+\\s+memory:\\s+256M"))
+								Expect(testUI.Out).To(Say("Some of these changes reduce or overwrite the current settings of an app\\. Do you want to continue\\?"))
+								Expect(fakeActor.ApplyCallCount()).To(Equal(1))
+							})
+						})
+
+						Context("when the user declines the changes", func() {
+							BeforeEach(func() {
+								_, err := input.Write([]byte("n\n"))
+								Expect(err).ToNot(HaveOccurred())
+							})
+
+							It("cancels the push without applying any changes", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out).To(Say("Push cancelled"))
+								Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when the user does not respond", func() {
+							BeforeEach(func() {
+								_, err := input.Write([]byte("\n"))
+								Expect(err).ToNot(HaveOccurred())
+							})
+
+							It("defaults to cancelling the push", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out).To(Say("Push cancelled"))
+								Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+							})
+						})
+					})
+
+					Context("when the terminal is not interactive", func() {
+						BeforeEach(func() {
+							fakeConfig.IsTTYReturns(false)
+						})
+
+						It("applies the changes without prompting", func() {
+							Expect(executeErr).To(MatchError("apply-error"))
+
+							Expect(testUI.Out).ToNot(Say("Do you want to continue"))
+							Expect(fakeActor.ApplyCallCount()).To(Equal(1))
+						})
+					})
+				})
 			})
 
 			Context("when there is an error converting the app setting into a config", func() {
@@ -5,16 +5,19 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/v2"
 )
 
 type FakeAuthActor struct {
-	AuthenticateStub        func(config v2action.Config, username string, password string) error
+	AuthenticateStub        func(config v2action.Config, ID string, secret string, origin string, grantType uaa.GrantType) error
 	authenticateMutex       sync.RWMutex
 	authenticateArgsForCall []struct {
-		config   v2action.Config
-		username string
-		password string
+		config    v2action.Config
+		ID        string
+		secret    string
+		origin    string
+		grantType uaa.GrantType
 	}
 	authenticateReturns struct {
 		result1 error
@@ -26,18 +29,20 @@ type FakeAuthActor struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAuthActor) Authenticate(config v2action.Config, username string, password string) error {
+func (fake *FakeAuthActor) Authenticate(config v2action.Config, ID string, secret string, origin string, grantType uaa.GrantType) error {
 	fake.authenticateMutex.Lock()
 	ret, specificReturn := fake.authenticateReturnsOnCall[len(fake.authenticateArgsForCall)]
 	fake.authenticateArgsForCall = append(fake.authenticateArgsForCall, struct {
-		config   v2action.Config
-		username string
-		password string
-	}{config, username, password})
-	fake.recordInvocation("Authenticate", []interface{}{config, username, password})
+		config    v2action.Config
+		ID        string
+		secret    string
+		origin    string
+		grantType uaa.GrantType
+	}{config, ID, secret, origin, grantType})
+	fake.recordInvocation("Authenticate", []interface{}{config, ID, secret, origin, grantType})
 	fake.authenticateMutex.Unlock()
 	if fake.AuthenticateStub != nil {
-		return fake.AuthenticateStub(config, username, password)
+		return fake.AuthenticateStub(config, ID, secret, origin, grantType)
 	}
 	if specificReturn {
 		return ret.result1
@@ -51,10 +56,10 @@ func (fake *FakeAuthActor) AuthenticateCallCount() int {
 	return len(fake.authenticateArgsForCall)
 }
 
-func (fake *FakeAuthActor) AuthenticateArgsForCall(i int) (v2action.Config, string, string) {
+func (fake *FakeAuthActor) AuthenticateArgsForCall(i int) (v2action.Config, string, string, string, uaa.GrantType) {
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
-	return fake.authenticateArgsForCall[i].config, fake.authenticateArgsForCall[i].username, fake.authenticateArgsForCall[i].password
+	return fake.authenticateArgsForCall[i].config, fake.authenticateArgsForCall[i].ID, fake.authenticateArgsForCall[i].secret, fake.authenticateArgsForCall[i].origin, fake.authenticateArgsForCall[i].grantType
 }
 
 func (fake *FakeAuthActor) AuthenticateReturns(result1 error) {
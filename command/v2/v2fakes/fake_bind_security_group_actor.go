@@ -10,12 +10,28 @@ import (
 )
 
 type FakeBindSecurityGroupActor struct {
-	BindSecurityGroupToSpaceStub        func(securityGroupGUID string, spaceGUID string, lifecycle ccv2.SecurityGroupLifecycle) (v2action.Warnings, error)
+	BindSecurityGroupToAllSpacesInOrgStub        func(securityGroupGUID string, orgGUID string, lifecycles []ccv2.SecurityGroupLifecycle, spaceBound func(v2action.Space)) (v2action.Warnings, error)
+	bindSecurityGroupToAllSpacesInOrgMutex       sync.RWMutex
+	bindSecurityGroupToAllSpacesInOrgArgsForCall []struct {
+		securityGroupGUID string
+		orgGUID           string
+		lifecycles        []ccv2.SecurityGroupLifecycle
+		spaceBound        func(v2action.Space)
+	}
+	bindSecurityGroupToAllSpacesInOrgReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	bindSecurityGroupToAllSpacesInOrgReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	BindSecurityGroupToSpaceStub        func(securityGroupGUID string, spaceGUID string, lifecycles []ccv2.SecurityGroupLifecycle) (v2action.Warnings, error)
 	bindSecurityGroupToSpaceMutex       sync.RWMutex
 	bindSecurityGroupToSpaceArgsForCall []struct {
 		securityGroupGUID string
 		spaceGUID         string
-		lifecycle         ccv2.SecurityGroupLifecycle
+		lifecycles        []ccv2.SecurityGroupLifecycle
 	}
 	bindSecurityGroupToSpaceReturns struct {
 		result1 v2action.Warnings
@@ -49,21 +65,6 @@ type FakeBindSecurityGroupActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
-	GetOrganizationSpacesStub        func(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
-	getOrganizationSpacesMutex       sync.RWMutex
-	getOrganizationSpacesArgsForCall []struct {
-		orgGUID string
-	}
-	getOrganizationSpacesReturns struct {
-		result1 []v2action.Space
-		result2 v2action.Warnings
-		result3 error
-	}
-	getOrganizationSpacesReturnsOnCall map[int]struct {
-		result1 []v2action.Space
-		result2 v2action.Warnings
-		result3 error
-	}
 	GetSecurityGroupByNameStub        func(securityGroupName string) (v2action.SecurityGroup, v2action.Warnings, error)
 	getSecurityGroupByNameMutex       sync.RWMutex
 	getSecurityGroupByNameArgsForCall []struct {
@@ -99,18 +100,82 @@ type FakeBindSecurityGroupActor struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string, lifecycle ccv2.SecurityGroupLifecycle) (v2action.Warnings, error) {
+func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToAllSpacesInOrg(securityGroupGUID string, orgGUID string, lifecycles []ccv2.SecurityGroupLifecycle, spaceBound func(v2action.Space)) (v2action.Warnings, error) {
+	var lifecyclesCopy []ccv2.SecurityGroupLifecycle
+	if lifecycles != nil {
+		lifecyclesCopy = make([]ccv2.SecurityGroupLifecycle, len(lifecycles))
+		copy(lifecyclesCopy, lifecycles)
+	}
+	fake.bindSecurityGroupToAllSpacesInOrgMutex.Lock()
+	ret, specificReturn := fake.bindSecurityGroupToAllSpacesInOrgReturnsOnCall[len(fake.bindSecurityGroupToAllSpacesInOrgArgsForCall)]
+	fake.bindSecurityGroupToAllSpacesInOrgArgsForCall = append(fake.bindSecurityGroupToAllSpacesInOrgArgsForCall, struct {
+		securityGroupGUID string
+		orgGUID           string
+		lifecycles        []ccv2.SecurityGroupLifecycle
+		spaceBound        func(v2action.Space)
+	}{securityGroupGUID, orgGUID, lifecyclesCopy, spaceBound})
+	fake.recordInvocation("BindSecurityGroupToAllSpacesInOrg", []interface{}{securityGroupGUID, orgGUID, lifecyclesCopy, spaceBound})
+	fake.bindSecurityGroupToAllSpacesInOrgMutex.Unlock()
+	if fake.BindSecurityGroupToAllSpacesInOrgStub != nil {
+		return fake.BindSecurityGroupToAllSpacesInOrgStub(securityGroupGUID, orgGUID, lifecycles, spaceBound)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.bindSecurityGroupToAllSpacesInOrgReturns.result1, fake.bindSecurityGroupToAllSpacesInOrgReturns.result2
+}
+
+func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToAllSpacesInOrgCallCount() int {
+	fake.bindSecurityGroupToAllSpacesInOrgMutex.RLock()
+	defer fake.bindSecurityGroupToAllSpacesInOrgMutex.RUnlock()
+	return len(fake.bindSecurityGroupToAllSpacesInOrgArgsForCall)
+}
+
+func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToAllSpacesInOrgArgsForCall(i int) (string, string, []ccv2.SecurityGroupLifecycle, func(v2action.Space)) {
+	fake.bindSecurityGroupToAllSpacesInOrgMutex.RLock()
+	defer fake.bindSecurityGroupToAllSpacesInOrgMutex.RUnlock()
+	return fake.bindSecurityGroupToAllSpacesInOrgArgsForCall[i].securityGroupGUID, fake.bindSecurityGroupToAllSpacesInOrgArgsForCall[i].orgGUID, fake.bindSecurityGroupToAllSpacesInOrgArgsForCall[i].lifecycles, fake.bindSecurityGroupToAllSpacesInOrgArgsForCall[i].spaceBound
+}
+
+func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToAllSpacesInOrgReturns(result1 v2action.Warnings, result2 error) {
+	fake.BindSecurityGroupToAllSpacesInOrgStub = nil
+	fake.bindSecurityGroupToAllSpacesInOrgReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToAllSpacesInOrgReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.BindSecurityGroupToAllSpacesInOrgStub = nil
+	if fake.bindSecurityGroupToAllSpacesInOrgReturnsOnCall == nil {
+		fake.bindSecurityGroupToAllSpacesInOrgReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.bindSecurityGroupToAllSpacesInOrgReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string, lifecycles []ccv2.SecurityGroupLifecycle) (v2action.Warnings, error) {
+	var lifecyclesCopy []ccv2.SecurityGroupLifecycle
+	if lifecycles != nil {
+		lifecyclesCopy = make([]ccv2.SecurityGroupLifecycle, len(lifecycles))
+		copy(lifecyclesCopy, lifecycles)
+	}
 	fake.bindSecurityGroupToSpaceMutex.Lock()
 	ret, specificReturn := fake.bindSecurityGroupToSpaceReturnsOnCall[len(fake.bindSecurityGroupToSpaceArgsForCall)]
 	fake.bindSecurityGroupToSpaceArgsForCall = append(fake.bindSecurityGroupToSpaceArgsForCall, struct {
 		securityGroupGUID string
 		spaceGUID         string
-		lifecycle         ccv2.SecurityGroupLifecycle
-	}{securityGroupGUID, spaceGUID, lifecycle})
-	fake.recordInvocation("BindSecurityGroupToSpace", []interface{}{securityGroupGUID, spaceGUID, lifecycle})
+		lifecycles        []ccv2.SecurityGroupLifecycle
+	}{securityGroupGUID, spaceGUID, lifecyclesCopy})
+	fake.recordInvocation("BindSecurityGroupToSpace", []interface{}{securityGroupGUID, spaceGUID, lifecyclesCopy})
 	fake.bindSecurityGroupToSpaceMutex.Unlock()
 	if fake.BindSecurityGroupToSpaceStub != nil {
-		return fake.BindSecurityGroupToSpaceStub(securityGroupGUID, spaceGUID, lifecycle)
+		return fake.BindSecurityGroupToSpaceStub(securityGroupGUID, spaceGUID, lifecycles)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -124,10 +189,10 @@ func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToSpaceCallCount() int
 	return len(fake.bindSecurityGroupToSpaceArgsForCall)
 }
 
-func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToSpaceArgsForCall(i int) (string, string, ccv2.SecurityGroupLifecycle) {
+func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToSpaceArgsForCall(i int) (string, string, []ccv2.SecurityGroupLifecycle) {
 	fake.bindSecurityGroupToSpaceMutex.RLock()
 	defer fake.bindSecurityGroupToSpaceMutex.RUnlock()
-	return fake.bindSecurityGroupToSpaceArgsForCall[i].securityGroupGUID, fake.bindSecurityGroupToSpaceArgsForCall[i].spaceGUID, fake.bindSecurityGroupToSpaceArgsForCall[i].lifecycle
+	return fake.bindSecurityGroupToSpaceArgsForCall[i].securityGroupGUID, fake.bindSecurityGroupToSpaceArgsForCall[i].spaceGUID, fake.bindSecurityGroupToSpaceArgsForCall[i].lifecycles
 }
 
 func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToSpaceReturns(result1 v2action.Warnings, result2 error) {
@@ -246,60 +311,6 @@ func (fake *FakeBindSecurityGroupActor) GetOrganizationByNameReturnsOnCall(i int
 	}{result1, result2, result3}
 }
 
-func (fake *FakeBindSecurityGroupActor) GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error) {
-	fake.getOrganizationSpacesMutex.Lock()
-	ret, specificReturn := fake.getOrganizationSpacesReturnsOnCall[len(fake.getOrganizationSpacesArgsForCall)]
-	fake.getOrganizationSpacesArgsForCall = append(fake.getOrganizationSpacesArgsForCall, struct {
-		orgGUID string
-	}{orgGUID})
-	fake.recordInvocation("GetOrganizationSpaces", []interface{}{orgGUID})
-	fake.getOrganizationSpacesMutex.Unlock()
-	if fake.GetOrganizationSpacesStub != nil {
-		return fake.GetOrganizationSpacesStub(orgGUID)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
-	}
-	return fake.getOrganizationSpacesReturns.result1, fake.getOrganizationSpacesReturns.result2, fake.getOrganizationSpacesReturns.result3
-}
-
-func (fake *FakeBindSecurityGroupActor) GetOrganizationSpacesCallCount() int {
-	fake.getOrganizationSpacesMutex.RLock()
-	defer fake.getOrganizationSpacesMutex.RUnlock()
-	return len(fake.getOrganizationSpacesArgsForCall)
-}
-
-func (fake *FakeBindSecurityGroupActor) GetOrganizationSpacesArgsForCall(i int) string {
-	fake.getOrganizationSpacesMutex.RLock()
-	defer fake.getOrganizationSpacesMutex.RUnlock()
-	return fake.getOrganizationSpacesArgsForCall[i].orgGUID
-}
-
-func (fake *FakeBindSecurityGroupActor) GetOrganizationSpacesReturns(result1 []v2action.Space, result2 v2action.Warnings, result3 error) {
-	fake.GetOrganizationSpacesStub = nil
-	fake.getOrganizationSpacesReturns = struct {
-		result1 []v2action.Space
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeBindSecurityGroupActor) GetOrganizationSpacesReturnsOnCall(i int, result1 []v2action.Space, result2 v2action.Warnings, result3 error) {
-	fake.GetOrganizationSpacesStub = nil
-	if fake.getOrganizationSpacesReturnsOnCall == nil {
-		fake.getOrganizationSpacesReturnsOnCall = make(map[int]struct {
-			result1 []v2action.Space
-			result2 v2action.Warnings
-			result3 error
-		})
-	}
-	fake.getOrganizationSpacesReturnsOnCall[i] = struct {
-		result1 []v2action.Space
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
 func (fake *FakeBindSecurityGroupActor) GetSecurityGroupByName(securityGroupName string) (v2action.SecurityGroup, v2action.Warnings, error) {
 	fake.getSecurityGroupByNameMutex.Lock()
 	ret, specificReturn := fake.getSecurityGroupByNameReturnsOnCall[len(fake.getSecurityGroupByNameArgsForCall)]
@@ -412,14 +423,14 @@ func (fake *FakeBindSecurityGroupActor) GetSpaceByOrganizationAndNameReturnsOnCa
 func (fake *FakeBindSecurityGroupActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.bindSecurityGroupToAllSpacesInOrgMutex.RLock()
+	defer fake.bindSecurityGroupToAllSpacesInOrgMutex.RUnlock()
 	fake.bindSecurityGroupToSpaceMutex.RLock()
 	defer fake.bindSecurityGroupToSpaceMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.getOrganizationByNameMutex.RLock()
 	defer fake.getOrganizationByNameMutex.RUnlock()
-	fake.getOrganizationSpacesMutex.RLock()
-	defer fake.getOrganizationSpacesMutex.RUnlock()
 	fake.getSecurityGroupByNameMutex.RLock()
 	defer fake.getSecurityGroupByNameMutex.RUnlock()
 	fake.getSpaceByOrganizationAndNameMutex.RLock()
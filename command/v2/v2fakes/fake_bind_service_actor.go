@@ -9,44 +9,72 @@ import (
 )
 
 type FakeBindServiceActor struct {
-	BindServiceBySpaceStub        func(appName string, ServiceInstanceName string, spaceGUID string, parameters map[string]interface{}) (v2action.Warnings, error)
+	BindServiceBySpaceStub        func(appName string, ServiceInstanceName string, spaceGUID string, bindingName string, parameters map[string]interface{}) (v2action.ServiceBinding, v2action.Warnings, error)
 	bindServiceBySpaceMutex       sync.RWMutex
 	bindServiceBySpaceArgsForCall []struct {
 		appName             string
 		ServiceInstanceName string
 		spaceGUID           string
+		bindingName         string
 		parameters          map[string]interface{}
 	}
 	bindServiceBySpaceReturns struct {
-		result1 v2action.Warnings
-		result2 error
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
 	}
 	bindServiceBySpaceReturnsOnCall map[int]struct {
-		result1 v2action.Warnings
-		result2 error
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct{}
+	cloudControllerAPIVersionReturns     struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	PollServiceBindingStub        func(serviceBinding v2action.ServiceBinding) (v2action.ServiceBinding, v2action.Warnings, error)
+	pollServiceBindingMutex       sync.RWMutex
+	pollServiceBindingArgsForCall []struct {
+		serviceBinding v2action.ServiceBinding
+	}
+	pollServiceBindingReturns struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}
+	pollServiceBindingReturnsOnCall map[int]struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeBindServiceActor) BindServiceBySpace(appName string, ServiceInstanceName string, spaceGUID string, parameters map[string]interface{}) (v2action.Warnings, error) {
+func (fake *FakeBindServiceActor) BindServiceBySpace(appName string, ServiceInstanceName string, spaceGUID string, bindingName string, parameters map[string]interface{}) (v2action.ServiceBinding, v2action.Warnings, error) {
 	fake.bindServiceBySpaceMutex.Lock()
 	ret, specificReturn := fake.bindServiceBySpaceReturnsOnCall[len(fake.bindServiceBySpaceArgsForCall)]
 	fake.bindServiceBySpaceArgsForCall = append(fake.bindServiceBySpaceArgsForCall, struct {
 		appName             string
 		ServiceInstanceName string
 		spaceGUID           string
+		bindingName         string
 		parameters          map[string]interface{}
-	}{appName, ServiceInstanceName, spaceGUID, parameters})
-	fake.recordInvocation("BindServiceBySpace", []interface{}{appName, ServiceInstanceName, spaceGUID, parameters})
+	}{appName, ServiceInstanceName, spaceGUID, bindingName, parameters})
+	fake.recordInvocation("BindServiceBySpace", []interface{}{appName, ServiceInstanceName, spaceGUID, bindingName, parameters})
 	fake.bindServiceBySpaceMutex.Unlock()
 	if fake.BindServiceBySpaceStub != nil {
-		return fake.BindServiceBySpaceStub(appName, ServiceInstanceName, spaceGUID, parameters)
+		return fake.BindServiceBySpaceStub(appName, ServiceInstanceName, spaceGUID, bindingName, parameters)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.bindServiceBySpaceReturns.result1, fake.bindServiceBySpaceReturns.result2
+	return fake.bindServiceBySpaceReturns.result1, fake.bindServiceBySpaceReturns.result2, fake.bindServiceBySpaceReturns.result3
 }
 
 func (fake *FakeBindServiceActor) BindServiceBySpaceCallCount() int {
@@ -55,32 +83,129 @@ func (fake *FakeBindServiceActor) BindServiceBySpaceCallCount() int {
 	return len(fake.bindServiceBySpaceArgsForCall)
 }
 
-func (fake *FakeBindServiceActor) BindServiceBySpaceArgsForCall(i int) (string, string, string, map[string]interface{}) {
+func (fake *FakeBindServiceActor) BindServiceBySpaceArgsForCall(i int) (string, string, string, string, map[string]interface{}) {
 	fake.bindServiceBySpaceMutex.RLock()
 	defer fake.bindServiceBySpaceMutex.RUnlock()
-	return fake.bindServiceBySpaceArgsForCall[i].appName, fake.bindServiceBySpaceArgsForCall[i].ServiceInstanceName, fake.bindServiceBySpaceArgsForCall[i].spaceGUID, fake.bindServiceBySpaceArgsForCall[i].parameters
+	return fake.bindServiceBySpaceArgsForCall[i].appName, fake.bindServiceBySpaceArgsForCall[i].ServiceInstanceName, fake.bindServiceBySpaceArgsForCall[i].spaceGUID, fake.bindServiceBySpaceArgsForCall[i].bindingName, fake.bindServiceBySpaceArgsForCall[i].parameters
 }
 
-func (fake *FakeBindServiceActor) BindServiceBySpaceReturns(result1 v2action.Warnings, result2 error) {
+func (fake *FakeBindServiceActor) BindServiceBySpaceReturns(result1 v2action.ServiceBinding, result2 v2action.Warnings, result3 error) {
 	fake.BindServiceBySpaceStub = nil
 	fake.bindServiceBySpaceReturns = struct {
-		result1 v2action.Warnings
-		result2 error
-	}{result1, result2}
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeBindServiceActor) BindServiceBySpaceReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+func (fake *FakeBindServiceActor) BindServiceBySpaceReturnsOnCall(i int, result1 v2action.ServiceBinding, result2 v2action.Warnings, result3 error) {
 	fake.BindServiceBySpaceStub = nil
 	if fake.bindServiceBySpaceReturnsOnCall == nil {
 		fake.bindServiceBySpaceReturnsOnCall = make(map[int]struct {
-			result1 v2action.Warnings
-			result2 error
+			result1 v2action.ServiceBinding
+			result2 v2action.Warnings
+			result3 error
 		})
 	}
 	fake.bindServiceBySpaceReturnsOnCall[i] = struct {
-		result1 v2action.Warnings
-		result2 error
-	}{result1, result2}
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBindServiceActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cloudControllerAPIVersionReturns.result1
+}
+
+func (fake *FakeBindServiceActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeBindServiceActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBindServiceActor) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBindServiceActor) PollServiceBinding(serviceBinding v2action.ServiceBinding) (v2action.ServiceBinding, v2action.Warnings, error) {
+	fake.pollServiceBindingMutex.Lock()
+	ret, specificReturn := fake.pollServiceBindingReturnsOnCall[len(fake.pollServiceBindingArgsForCall)]
+	fake.pollServiceBindingArgsForCall = append(fake.pollServiceBindingArgsForCall, struct {
+		serviceBinding v2action.ServiceBinding
+	}{serviceBinding})
+	fake.recordInvocation("PollServiceBinding", []interface{}{serviceBinding})
+	fake.pollServiceBindingMutex.Unlock()
+	if fake.PollServiceBindingStub != nil {
+		return fake.PollServiceBindingStub(serviceBinding)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.pollServiceBindingReturns.result1, fake.pollServiceBindingReturns.result2, fake.pollServiceBindingReturns.result3
+}
+
+func (fake *FakeBindServiceActor) PollServiceBindingCallCount() int {
+	fake.pollServiceBindingMutex.RLock()
+	defer fake.pollServiceBindingMutex.RUnlock()
+	return len(fake.pollServiceBindingArgsForCall)
+}
+
+func (fake *FakeBindServiceActor) PollServiceBindingArgsForCall(i int) v2action.ServiceBinding {
+	fake.pollServiceBindingMutex.RLock()
+	defer fake.pollServiceBindingMutex.RUnlock()
+	return fake.pollServiceBindingArgsForCall[i].serviceBinding
+}
+
+func (fake *FakeBindServiceActor) PollServiceBindingReturns(result1 v2action.ServiceBinding, result2 v2action.Warnings, result3 error) {
+	fake.PollServiceBindingStub = nil
+	fake.pollServiceBindingReturns = struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBindServiceActor) PollServiceBindingReturnsOnCall(i int, result1 v2action.ServiceBinding, result2 v2action.Warnings, result3 error) {
+	fake.PollServiceBindingStub = nil
+	if fake.pollServiceBindingReturnsOnCall == nil {
+		fake.pollServiceBindingReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceBinding
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.pollServiceBindingReturnsOnCall[i] = struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
 func (fake *FakeBindServiceActor) Invocations() map[string][][]interface{} {
@@ -88,6 +213,10 @@ func (fake *FakeBindServiceActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.bindServiceBySpaceMutex.RLock()
 	defer fake.bindServiceBySpaceMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.pollServiceBindingMutex.RLock()
+	defer fake.pollServiceBindingMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -9,16 +9,17 @@ import (
 )
 
 type FakeDeleteOrganizationActor struct {
-	DeleteOrganizationStub        func(orgName string) (v2action.Warnings, error)
-	deleteOrganizationMutex       sync.RWMutex
-	deleteOrganizationArgsForCall []struct {
-		orgName string
+	DeleteOrganizationRecursivelyStub        func(orgName string, progress func(v2action.DeleteOrganizationProgress)) (v2action.Warnings, error)
+	deleteOrganizationRecursivelyMutex       sync.RWMutex
+	deleteOrganizationRecursivelyArgsForCall []struct {
+		orgName  string
+		progress func(v2action.DeleteOrganizationProgress)
 	}
-	deleteOrganizationReturns struct {
+	deleteOrganizationRecursivelyReturns struct {
 		result1 v2action.Warnings
 		result2 error
 	}
-	deleteOrganizationReturnsOnCall map[int]struct {
+	deleteOrganizationRecursivelyReturnsOnCall map[int]struct {
 		result1 v2action.Warnings
 		result2 error
 	}
@@ -27,56 +28,72 @@ type FakeDeleteOrganizationActor struct {
 	clearOrganizationAndSpaceArgsForCall []struct {
 		config v2action.Config
 	}
+	StartDeleteOrganizationStub        func(orgName string) (v2action.Job, v2action.Warnings, error)
+	startDeleteOrganizationMutex       sync.RWMutex
+	startDeleteOrganizationArgsForCall []struct {
+		orgName string
+	}
+	startDeleteOrganizationReturns struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}
+	startDeleteOrganizationReturnsOnCall map[int]struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganization(orgName string) (v2action.Warnings, error) {
-	fake.deleteOrganizationMutex.Lock()
-	ret, specificReturn := fake.deleteOrganizationReturnsOnCall[len(fake.deleteOrganizationArgsForCall)]
-	fake.deleteOrganizationArgsForCall = append(fake.deleteOrganizationArgsForCall, struct {
-		orgName string
-	}{orgName})
-	fake.recordInvocation("DeleteOrganization", []interface{}{orgName})
-	fake.deleteOrganizationMutex.Unlock()
-	if fake.DeleteOrganizationStub != nil {
-		return fake.DeleteOrganizationStub(orgName)
+func (fake *FakeDeleteOrganizationActor) DeleteOrganizationRecursively(orgName string, progress func(v2action.DeleteOrganizationProgress)) (v2action.Warnings, error) {
+	fake.deleteOrganizationRecursivelyMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationRecursivelyReturnsOnCall[len(fake.deleteOrganizationRecursivelyArgsForCall)]
+	fake.deleteOrganizationRecursivelyArgsForCall = append(fake.deleteOrganizationRecursivelyArgsForCall, struct {
+		orgName  string
+		progress func(v2action.DeleteOrganizationProgress)
+	}{orgName, progress})
+	fake.recordInvocation("DeleteOrganizationRecursively", []interface{}{orgName, progress})
+	fake.deleteOrganizationRecursivelyMutex.Unlock()
+	if fake.DeleteOrganizationRecursivelyStub != nil {
+		return fake.DeleteOrganizationRecursivelyStub(orgName, progress)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.deleteOrganizationReturns.result1, fake.deleteOrganizationReturns.result2
+	return fake.deleteOrganizationRecursivelyReturns.result1, fake.deleteOrganizationRecursivelyReturns.result2
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganizationCallCount() int {
-	fake.deleteOrganizationMutex.RLock()
-	defer fake.deleteOrganizationMutex.RUnlock()
-	return len(fake.deleteOrganizationArgsForCall)
+func (fake *FakeDeleteOrganizationActor) DeleteOrganizationRecursivelyCallCount() int {
+	fake.deleteOrganizationRecursivelyMutex.RLock()
+	defer fake.deleteOrganizationRecursivelyMutex.RUnlock()
+	return len(fake.deleteOrganizationRecursivelyArgsForCall)
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganizationArgsForCall(i int) string {
-	fake.deleteOrganizationMutex.RLock()
-	defer fake.deleteOrganizationMutex.RUnlock()
-	return fake.deleteOrganizationArgsForCall[i].orgName
+func (fake *FakeDeleteOrganizationActor) DeleteOrganizationRecursivelyArgsForCall(i int) (string, func(v2action.DeleteOrganizationProgress)) {
+	fake.deleteOrganizationRecursivelyMutex.RLock()
+	defer fake.deleteOrganizationRecursivelyMutex.RUnlock()
+	return fake.deleteOrganizationRecursivelyArgsForCall[i].orgName, fake.deleteOrganizationRecursivelyArgsForCall[i].progress
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganizationReturns(result1 v2action.Warnings, result2 error) {
-	fake.DeleteOrganizationStub = nil
-	fake.deleteOrganizationReturns = struct {
+func (fake *FakeDeleteOrganizationActor) DeleteOrganizationRecursivelyReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteOrganizationRecursivelyStub = nil
+	fake.deleteOrganizationRecursivelyReturns = struct {
 		result1 v2action.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganizationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
-	fake.DeleteOrganizationStub = nil
-	if fake.deleteOrganizationReturnsOnCall == nil {
-		fake.deleteOrganizationReturnsOnCall = make(map[int]struct {
+func (fake *FakeDeleteOrganizationActor) DeleteOrganizationRecursivelyReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DeleteOrganizationRecursivelyStub = nil
+	if fake.deleteOrganizationRecursivelyReturnsOnCall == nil {
+		fake.deleteOrganizationRecursivelyReturnsOnCall = make(map[int]struct {
 			result1 v2action.Warnings
 			result2 error
 		})
 	}
-	fake.deleteOrganizationReturnsOnCall[i] = struct {
+	fake.deleteOrganizationRecursivelyReturnsOnCall[i] = struct {
 		result1 v2action.Warnings
 		result2 error
 	}{result1, result2}
@@ -106,13 +123,69 @@ func (fake *FakeDeleteOrganizationActor) ClearOrganizationAndSpaceArgsForCall(i
 	return fake.clearOrganizationAndSpaceArgsForCall[i].config
 }
 
+func (fake *FakeDeleteOrganizationActor) StartDeleteOrganization(orgName string) (v2action.Job, v2action.Warnings, error) {
+	fake.startDeleteOrganizationMutex.Lock()
+	ret, specificReturn := fake.startDeleteOrganizationReturnsOnCall[len(fake.startDeleteOrganizationArgsForCall)]
+	fake.startDeleteOrganizationArgsForCall = append(fake.startDeleteOrganizationArgsForCall, struct {
+		orgName string
+	}{orgName})
+	fake.recordInvocation("StartDeleteOrganization", []interface{}{orgName})
+	fake.startDeleteOrganizationMutex.Unlock()
+	if fake.StartDeleteOrganizationStub != nil {
+		return fake.StartDeleteOrganizationStub(orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.startDeleteOrganizationReturns.result1, fake.startDeleteOrganizationReturns.result2, fake.startDeleteOrganizationReturns.result3
+}
+
+func (fake *FakeDeleteOrganizationActor) StartDeleteOrganizationCallCount() int {
+	fake.startDeleteOrganizationMutex.RLock()
+	defer fake.startDeleteOrganizationMutex.RUnlock()
+	return len(fake.startDeleteOrganizationArgsForCall)
+}
+
+func (fake *FakeDeleteOrganizationActor) StartDeleteOrganizationArgsForCall(i int) string {
+	fake.startDeleteOrganizationMutex.RLock()
+	defer fake.startDeleteOrganizationMutex.RUnlock()
+	return fake.startDeleteOrganizationArgsForCall[i].orgName
+}
+
+func (fake *FakeDeleteOrganizationActor) StartDeleteOrganizationReturns(result1 v2action.Job, result2 v2action.Warnings, result3 error) {
+	fake.StartDeleteOrganizationStub = nil
+	fake.startDeleteOrganizationReturns = struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeleteOrganizationActor) StartDeleteOrganizationReturnsOnCall(i int, result1 v2action.Job, result2 v2action.Warnings, result3 error) {
+	fake.StartDeleteOrganizationStub = nil
+	if fake.startDeleteOrganizationReturnsOnCall == nil {
+		fake.startDeleteOrganizationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Job
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.startDeleteOrganizationReturnsOnCall[i] = struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDeleteOrganizationActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.deleteOrganizationMutex.RLock()
-	defer fake.deleteOrganizationMutex.RUnlock()
+	fake.deleteOrganizationRecursivelyMutex.RLock()
+	defer fake.deleteOrganizationRecursivelyMutex.RUnlock()
 	fake.clearOrganizationAndSpaceMutex.RLock()
 	defer fake.clearOrganizationAndSpaceMutex.RUnlock()
+	fake.startDeleteOrganizationMutex.RLock()
+	defer fake.startDeleteOrganizationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -33,6 +33,20 @@ type FakeSecurityGroupsActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetSecurityGroupsWithOrganizationSpaceAndLifecyclePagedStub        func(includeStaging bool, handlePage func([]v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) error) (v2action.Warnings, error)
+	getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedMutex       sync.RWMutex
+	getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedArgsForCall []struct {
+		includeStaging bool
+		handlePage     func([]v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) error
+	}
+	getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -131,6 +145,58 @@ func (fake *FakeSecurityGroupsActor) GetSecurityGroupsWithOrganizationSpaceAndLi
 	}{result1, result2, result3}
 }
 
+func (fake *FakeSecurityGroupsActor) GetSecurityGroupsWithOrganizationSpaceAndLifecyclePaged(includeStaging bool, handlePage func([]v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) error) (v2action.Warnings, error) {
+	fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedMutex.Lock()
+	ret, specificReturn := fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedReturnsOnCall[len(fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedArgsForCall)]
+	fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedArgsForCall = append(fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedArgsForCall, struct {
+		includeStaging bool
+		handlePage     func([]v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) error
+	}{includeStaging, handlePage})
+	fake.recordInvocation("GetSecurityGroupsWithOrganizationSpaceAndLifecyclePaged", []interface{}{includeStaging, handlePage})
+	fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedMutex.Unlock()
+	if fake.GetSecurityGroupsWithOrganizationSpaceAndLifecyclePagedStub != nil {
+		return fake.GetSecurityGroupsWithOrganizationSpaceAndLifecyclePagedStub(includeStaging, handlePage)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedReturns.result1, fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedReturns.result2
+}
+
+func (fake *FakeSecurityGroupsActor) GetSecurityGroupsWithOrganizationSpaceAndLifecyclePagedCallCount() int {
+	fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedMutex.RLock()
+	defer fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedMutex.RUnlock()
+	return len(fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedArgsForCall)
+}
+
+func (fake *FakeSecurityGroupsActor) GetSecurityGroupsWithOrganizationSpaceAndLifecyclePagedArgsForCall(i int) (bool, func([]v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) error) {
+	fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedMutex.RLock()
+	defer fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedMutex.RUnlock()
+	return fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedArgsForCall[i].includeStaging, fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedArgsForCall[i].handlePage
+}
+
+func (fake *FakeSecurityGroupsActor) GetSecurityGroupsWithOrganizationSpaceAndLifecyclePagedReturns(result1 v2action.Warnings, result2 error) {
+	fake.GetSecurityGroupsWithOrganizationSpaceAndLifecyclePagedStub = nil
+	fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecurityGroupsActor) GetSecurityGroupsWithOrganizationSpaceAndLifecyclePagedReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.GetSecurityGroupsWithOrganizationSpaceAndLifecyclePagedStub = nil
+	if fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedReturnsOnCall == nil {
+		fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSecurityGroupsActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -138,6 +204,8 @@ func (fake *FakeSecurityGroupsActor) Invocations() map[string][][]interface{} {
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.getSecurityGroupsWithOrganizationSpaceAndLifecycleMutex.RLock()
 	defer fake.getSecurityGroupsWithOrganizationSpaceAndLifecycleMutex.RUnlock()
+	fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedMutex.RLock()
+	defer fake.getSecurityGroupsWithOrganizationSpaceAndLifecyclePagedMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -9,6 +9,24 @@ import (
 )
 
 type FakeTargetActor struct {
+	GetOrganizationAndSpaceByNameStub        func(orgName string, spaceName string) (v2action.Organization, v2action.Space, v2action.Warnings, error)
+	getOrganizationAndSpaceByNameMutex       sync.RWMutex
+	getOrganizationAndSpaceByNameArgsForCall []struct {
+		orgName   string
+		spaceName string
+	}
+	getOrganizationAndSpaceByNameReturns struct {
+		result1 v2action.Organization
+		result2 v2action.Space
+		result3 v2action.Warnings
+		result4 error
+	}
+	getOrganizationAndSpaceByNameReturnsOnCall map[int]struct {
+		result1 v2action.Organization
+		result2 v2action.Space
+		result3 v2action.Warnings
+		result4 error
+	}
 	GetOrganizationByNameStub        func(orgName string) (v2action.Organization, v2action.Warnings, error)
 	getOrganizationByNameMutex       sync.RWMutex
 	getOrganizationByNameArgsForCall []struct {
@@ -59,6 +77,64 @@ type FakeTargetActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTargetActor) GetOrganizationAndSpaceByName(orgName string, spaceName string) (v2action.Organization, v2action.Space, v2action.Warnings, error) {
+	fake.getOrganizationAndSpaceByNameMutex.Lock()
+	ret, specificReturn := fake.getOrganizationAndSpaceByNameReturnsOnCall[len(fake.getOrganizationAndSpaceByNameArgsForCall)]
+	fake.getOrganizationAndSpaceByNameArgsForCall = append(fake.getOrganizationAndSpaceByNameArgsForCall, struct {
+		orgName   string
+		spaceName string
+	}{orgName, spaceName})
+	fake.recordInvocation("GetOrganizationAndSpaceByName", []interface{}{orgName, spaceName})
+	fake.getOrganizationAndSpaceByNameMutex.Unlock()
+	if fake.GetOrganizationAndSpaceByNameStub != nil {
+		return fake.GetOrganizationAndSpaceByNameStub(orgName, spaceName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.getOrganizationAndSpaceByNameReturns.result1, fake.getOrganizationAndSpaceByNameReturns.result2, fake.getOrganizationAndSpaceByNameReturns.result3, fake.getOrganizationAndSpaceByNameReturns.result4
+}
+
+func (fake *FakeTargetActor) GetOrganizationAndSpaceByNameCallCount() int {
+	fake.getOrganizationAndSpaceByNameMutex.RLock()
+	defer fake.getOrganizationAndSpaceByNameMutex.RUnlock()
+	return len(fake.getOrganizationAndSpaceByNameArgsForCall)
+}
+
+func (fake *FakeTargetActor) GetOrganizationAndSpaceByNameArgsForCall(i int) (string, string) {
+	fake.getOrganizationAndSpaceByNameMutex.RLock()
+	defer fake.getOrganizationAndSpaceByNameMutex.RUnlock()
+	return fake.getOrganizationAndSpaceByNameArgsForCall[i].orgName, fake.getOrganizationAndSpaceByNameArgsForCall[i].spaceName
+}
+
+func (fake *FakeTargetActor) GetOrganizationAndSpaceByNameReturns(result1 v2action.Organization, result2 v2action.Space, result3 v2action.Warnings, result4 error) {
+	fake.GetOrganizationAndSpaceByNameStub = nil
+	fake.getOrganizationAndSpaceByNameReturns = struct {
+		result1 v2action.Organization
+		result2 v2action.Space
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeTargetActor) GetOrganizationAndSpaceByNameReturnsOnCall(i int, result1 v2action.Organization, result2 v2action.Space, result3 v2action.Warnings, result4 error) {
+	fake.GetOrganizationAndSpaceByNameStub = nil
+	if fake.getOrganizationAndSpaceByNameReturnsOnCall == nil {
+		fake.getOrganizationAndSpaceByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Organization
+			result2 v2action.Space
+			result3 v2action.Warnings
+			result4 error
+		})
+	}
+	fake.getOrganizationAndSpaceByNameReturnsOnCall[i] = struct {
+		result1 v2action.Organization
+		result2 v2action.Space
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeTargetActor) GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error) {
 	fake.getOrganizationByNameMutex.Lock()
 	ret, specificReturn := fake.getOrganizationByNameReturnsOnCall[len(fake.getOrganizationByNameArgsForCall)]
@@ -225,6 +301,8 @@ func (fake *FakeTargetActor) GetSpaceByOrganizationAndNameReturnsOnCall(i int, r
 func (fake *FakeTargetActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getOrganizationAndSpaceByNameMutex.RLock()
+	defer fake.getOrganizationAndSpaceByNameMutex.RUnlock()
 	fake.getOrganizationByNameMutex.RLock()
 	defer fake.getOrganizationByNameMutex.RUnlock()
 	fake.getOrganizationSpacesMutex.RLock()
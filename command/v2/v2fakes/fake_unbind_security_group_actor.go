@@ -19,12 +19,12 @@ type FakeUnbindSecurityGroupActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
-	UnbindSecurityGroupByNameAndSpaceStub        func(securityGroupName string, spaceGUID string, lifecycle ccv2.SecurityGroupLifecycle) (v2action.Warnings, error)
+	UnbindSecurityGroupByNameAndSpaceStub        func(securityGroupName string, spaceGUID string, lifecycles []ccv2.SecurityGroupLifecycle) (v2action.Warnings, error)
 	unbindSecurityGroupByNameAndSpaceMutex       sync.RWMutex
 	unbindSecurityGroupByNameAndSpaceArgsForCall []struct {
 		securityGroupName string
 		spaceGUID         string
-		lifecycle         ccv2.SecurityGroupLifecycle
+		lifecycles        []ccv2.SecurityGroupLifecycle
 	}
 	unbindSecurityGroupByNameAndSpaceReturns struct {
 		result1 v2action.Warnings
@@ -34,13 +34,13 @@ type FakeUnbindSecurityGroupActor struct {
 		result1 v2action.Warnings
 		result2 error
 	}
-	UnbindSecurityGroupByNameOrganizationNameAndSpaceNameStub        func(securityGroupName string, orgName string, spaceName string, lifecycle ccv2.SecurityGroupLifecycle) (v2action.Warnings, error)
+	UnbindSecurityGroupByNameOrganizationNameAndSpaceNameStub        func(securityGroupName string, orgName string, spaceName string, lifecycles []ccv2.SecurityGroupLifecycle) (v2action.Warnings, error)
 	unbindSecurityGroupByNameOrganizationNameAndSpaceNameMutex       sync.RWMutex
 	unbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall []struct {
 		securityGroupName string
 		orgName           string
 		spaceName         string
-		lifecycle         ccv2.SecurityGroupLifecycle
+		lifecycles        []ccv2.SecurityGroupLifecycle
 	}
 	unbindSecurityGroupByNameOrganizationNameAndSpaceNameReturns struct {
 		result1 v2action.Warnings
@@ -94,18 +94,23 @@ func (fake *FakeUnbindSecurityGroupActor) CloudControllerAPIVersionReturnsOnCall
 	}{result1}
 }
 
-func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupByNameAndSpace(securityGroupName string, spaceGUID string, lifecycle ccv2.SecurityGroupLifecycle) (v2action.Warnings, error) {
+func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupByNameAndSpace(securityGroupName string, spaceGUID string, lifecycles []ccv2.SecurityGroupLifecycle) (v2action.Warnings, error) {
+	var lifecyclesCopy []ccv2.SecurityGroupLifecycle
+	if lifecycles != nil {
+		lifecyclesCopy = make([]ccv2.SecurityGroupLifecycle, len(lifecycles))
+		copy(lifecyclesCopy, lifecycles)
+	}
 	fake.unbindSecurityGroupByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.unbindSecurityGroupByNameAndSpaceReturnsOnCall[len(fake.unbindSecurityGroupByNameAndSpaceArgsForCall)]
 	fake.unbindSecurityGroupByNameAndSpaceArgsForCall = append(fake.unbindSecurityGroupByNameAndSpaceArgsForCall, struct {
 		securityGroupName string
 		spaceGUID         string
-		lifecycle         ccv2.SecurityGroupLifecycle
-	}{securityGroupName, spaceGUID, lifecycle})
-	fake.recordInvocation("UnbindSecurityGroupByNameAndSpace", []interface{}{securityGroupName, spaceGUID, lifecycle})
+		lifecycles        []ccv2.SecurityGroupLifecycle
+	}{securityGroupName, spaceGUID, lifecyclesCopy})
+	fake.recordInvocation("UnbindSecurityGroupByNameAndSpace", []interface{}{securityGroupName, spaceGUID, lifecyclesCopy})
 	fake.unbindSecurityGroupByNameAndSpaceMutex.Unlock()
 	if fake.UnbindSecurityGroupByNameAndSpaceStub != nil {
-		return fake.UnbindSecurityGroupByNameAndSpaceStub(securityGroupName, spaceGUID, lifecycle)
+		return fake.UnbindSecurityGroupByNameAndSpaceStub(securityGroupName, spaceGUID, lifecycles)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -119,10 +124,10 @@ func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupByNameAndSpaceCallC
 	return len(fake.unbindSecurityGroupByNameAndSpaceArgsForCall)
 }
 
-func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupByNameAndSpaceArgsForCall(i int) (string, string, ccv2.SecurityGroupLifecycle) {
+func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupByNameAndSpaceArgsForCall(i int) (string, string, []ccv2.SecurityGroupLifecycle) {
 	fake.unbindSecurityGroupByNameAndSpaceMutex.RLock()
 	defer fake.unbindSecurityGroupByNameAndSpaceMutex.RUnlock()
-	return fake.unbindSecurityGroupByNameAndSpaceArgsForCall[i].securityGroupName, fake.unbindSecurityGroupByNameAndSpaceArgsForCall[i].spaceGUID, fake.unbindSecurityGroupByNameAndSpaceArgsForCall[i].lifecycle
+	return fake.unbindSecurityGroupByNameAndSpaceArgsForCall[i].securityGroupName, fake.unbindSecurityGroupByNameAndSpaceArgsForCall[i].spaceGUID, fake.unbindSecurityGroupByNameAndSpaceArgsForCall[i].lifecycles
 }
 
 func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupByNameAndSpaceReturns(result1 v2action.Warnings, result2 error) {
@@ -147,19 +152,24 @@ func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupByNameAndSpaceRetur
 	}{result1, result2}
 }
 
-func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupByNameOrganizationNameAndSpaceName(securityGroupName string, orgName string, spaceName string, lifecycle ccv2.SecurityGroupLifecycle) (v2action.Warnings, error) {
+func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupByNameOrganizationNameAndSpaceName(securityGroupName string, orgName string, spaceName string, lifecycles []ccv2.SecurityGroupLifecycle) (v2action.Warnings, error) {
+	var lifecyclesCopy []ccv2.SecurityGroupLifecycle
+	if lifecycles != nil {
+		lifecyclesCopy = make([]ccv2.SecurityGroupLifecycle, len(lifecycles))
+		copy(lifecyclesCopy, lifecycles)
+	}
 	fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameMutex.Lock()
 	ret, specificReturn := fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameReturnsOnCall[len(fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall)]
 	fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall = append(fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall, struct {
 		securityGroupName string
 		orgName           string
 		spaceName         string
-		lifecycle         ccv2.SecurityGroupLifecycle
-	}{securityGroupName, orgName, spaceName, lifecycle})
-	fake.recordInvocation("UnbindSecurityGroupByNameOrganizationNameAndSpaceName", []interface{}{securityGroupName, orgName, spaceName, lifecycle})
+		lifecycles        []ccv2.SecurityGroupLifecycle
+	}{securityGroupName, orgName, spaceName, lifecyclesCopy})
+	fake.recordInvocation("UnbindSecurityGroupByNameOrganizationNameAndSpaceName", []interface{}{securityGroupName, orgName, spaceName, lifecyclesCopy})
 	fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameMutex.Unlock()
 	if fake.UnbindSecurityGroupByNameOrganizationNameAndSpaceNameStub != nil {
-		return fake.UnbindSecurityGroupByNameOrganizationNameAndSpaceNameStub(securityGroupName, orgName, spaceName, lifecycle)
+		return fake.UnbindSecurityGroupByNameOrganizationNameAndSpaceNameStub(securityGroupName, orgName, spaceName, lifecycles)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -173,10 +183,10 @@ func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupByNameOrganizationN
 	return len(fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall)
 }
 
-func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall(i int) (string, string, string, ccv2.SecurityGroupLifecycle) {
+func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall(i int) (string, string, string, []ccv2.SecurityGroupLifecycle) {
 	fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameMutex.RLock()
 	defer fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameMutex.RUnlock()
-	return fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall[i].securityGroupName, fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall[i].orgName, fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall[i].spaceName, fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall[i].lifecycle
+	return fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall[i].securityGroupName, fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall[i].orgName, fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall[i].spaceName, fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameArgsForCall[i].lifecycles
 }
 
 func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupByNameOrganizationNameAndSpaceNameReturns(result1 v2action.Warnings, result2 error) {
@@ -60,6 +60,17 @@ type FakeV2PushActor struct {
 		result1 []manifest.Application
 		result2 error
 	}
+	PlanApplyStub        func(config pushaction.ApplicationConfig) pushaction.ApplyPlan
+	planApplyMutex       sync.RWMutex
+	planApplyArgsForCall []struct {
+		config pushaction.ApplicationConfig
+	}
+	planApplyReturns struct {
+		result1 pushaction.ApplyPlan
+	}
+	planApplyReturnsOnCall map[int]struct {
+		result1 pushaction.ApplyPlan
+	}
 	ReadManifestStub        func(pathToManifest string) ([]manifest.Application, error)
 	readManifestMutex       sync.RWMutex
 	readManifestArgsForCall []struct {
@@ -254,6 +265,54 @@ func (fake *FakeV2PushActor) MergeAndValidateSettingsAndManifestsReturnsOnCall(i
 	}{result1, result2}
 }
 
+func (fake *FakeV2PushActor) PlanApply(config pushaction.ApplicationConfig) pushaction.ApplyPlan {
+	fake.planApplyMutex.Lock()
+	ret, specificReturn := fake.planApplyReturnsOnCall[len(fake.planApplyArgsForCall)]
+	fake.planApplyArgsForCall = append(fake.planApplyArgsForCall, struct {
+		config pushaction.ApplicationConfig
+	}{config})
+	fake.recordInvocation("PlanApply", []interface{}{config})
+	fake.planApplyMutex.Unlock()
+	if fake.PlanApplyStub != nil {
+		return fake.PlanApplyStub(config)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.planApplyReturns.result1
+}
+
+func (fake *FakeV2PushActor) PlanApplyCallCount() int {
+	fake.planApplyMutex.RLock()
+	defer fake.planApplyMutex.RUnlock()
+	return len(fake.planApplyArgsForCall)
+}
+
+func (fake *FakeV2PushActor) PlanApplyArgsForCall(i int) pushaction.ApplicationConfig {
+	fake.planApplyMutex.RLock()
+	defer fake.planApplyMutex.RUnlock()
+	return fake.planApplyArgsForCall[i].config
+}
+
+func (fake *FakeV2PushActor) PlanApplyReturns(result1 pushaction.ApplyPlan) {
+	fake.PlanApplyStub = nil
+	fake.planApplyReturns = struct {
+		result1 pushaction.ApplyPlan
+	}{result1}
+}
+
+func (fake *FakeV2PushActor) PlanApplyReturnsOnCall(i int, result1 pushaction.ApplyPlan) {
+	fake.PlanApplyStub = nil
+	if fake.planApplyReturnsOnCall == nil {
+		fake.planApplyReturnsOnCall = make(map[int]struct {
+			result1 pushaction.ApplyPlan
+		})
+	}
+	fake.planApplyReturnsOnCall[i] = struct {
+		result1 pushaction.ApplyPlan
+	}{result1}
+}
+
 func (fake *FakeV2PushActor) ReadManifest(pathToManifest string) ([]manifest.Application, error) {
 	fake.readManifestMutex.Lock()
 	ret, specificReturn := fake.readManifestReturnsOnCall[len(fake.readManifestArgsForCall)]
@@ -314,6 +373,8 @@ func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	defer fake.convertToApplicationConfigsMutex.RUnlock()
 	fake.mergeAndValidateSettingsAndManifestsMutex.RLock()
 	defer fake.mergeAndValidateSettingsAndManifestsMutex.RUnlock()
+	fake.planApplyMutex.RLock()
+	defer fake.planApplyMutex.RUnlock()
 	fake.readManifestMutex.RLock()
 	defer fake.readManifestMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
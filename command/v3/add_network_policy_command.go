@@ -44,7 +44,7 @@ func (cmd *AddNetworkPolicyCommand) Setup(config command.Config, ui command.UI)
 		return err
 	}
 
-	v3Actor := v3action.NewActor(client, config)
+	v3Actor := v3action.NewActor(client, nil, config)
 	networkingClient, err := shared.NewNetworkingClient(client.NetworkPolicyV1(), config, uaa, ui)
 	if err != nil {
 		return err
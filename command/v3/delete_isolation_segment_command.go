@@ -37,7 +37,7 @@ func (cmd *DeleteIsolationSegmentCommand) Setup(config command.Config, ui comman
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(client, config)
+	cmd.Actor = v3action.NewActor(client, nil, config)
 
 	return nil
 }
@@ -35,7 +35,7 @@ func (cmd *DisableOrgIsolationCommand) Setup(config command.Config, ui command.U
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(client, config)
+	cmd.Actor = v3action.NewActor(client, nil, config)
 
 	return nil
 }
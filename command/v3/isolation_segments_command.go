@@ -36,7 +36,7 @@ func (cmd *IsolationSegmentsCommand) Setup(config command.Config, ui command.UI)
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(client, config)
+	cmd.Actor = v3action.NewActor(client, nil, config)
 
 	return nil
 }
@@ -0,0 +1,158 @@
+package v3
+
+import (
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/version"
+)
+
+const (
+	appLabelResource   = "app"
+	orgLabelResource   = "org"
+	spaceLabelResource = "space"
+)
+
+// parseLabelResource returns the lower cased resource type if labels can be
+// attached to it.
+func parseLabelResource(resourceType string) (string, error) {
+	resource := strings.ToLower(resourceType)
+	switch resource {
+	case appLabelResource, orgLabelResource, spaceLabelResource:
+		return resource, nil
+	default:
+		return "", translatableerror.ParseArgumentError{
+			ArgumentName: "RESOURCE",
+			ExpectedType: "one of app, org or space",
+		}
+	}
+}
+
+// checkLabelResourceTarget checks that the user is logged in and targets
+// what is needed to look up the resource by name.
+func checkLabelResourceTarget(sharedActor command.SharedActor, config command.Config, resource string) error {
+	return sharedActor.CheckTarget(config, resource != orgLabelResource, resource == appLabelResource)
+}
+
+//go:generate counterfeiter . LabelsActor
+
+type LabelsActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, v3action.Warnings, error)
+	GetOrganizationLabels(orgName string) (map[string]types.NullString, v3action.Warnings, error)
+	GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, v3action.Warnings, error)
+}
+
+type LabelsCommand struct {
+	RequiredArgs    flag.LabelsArgs `positional-args:"yes"`
+	usage           interface{}     `usage:"CF_NAME labels RESOURCE RESOURCE_NAME\n\nEXAMPLES:\n   CF_NAME labels app dora\n\nRESOURCES:\n   app\n   org\n   space"`
+	relatedCommands interface{}     `related_commands:"set-label, unset-label"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       LabelsActor
+}
+
+func (cmd *LabelsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd LabelsCommand) Execute(args []string) error {
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionMetadataV3)
+	if err != nil {
+		return err
+	}
+
+	resource, err := parseLabelResource(cmd.RequiredArgs.ResourceType)
+	if err != nil {
+		return err
+	}
+
+	err = checkLabelResourceTarget(cmd.SharedActor, cmd.Config, resource)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var (
+		labels   map[string]types.NullString
+		warnings v3action.Warnings
+	)
+
+	switch resource {
+	case appLabelResource:
+		cmd.UI.DisplayTextWithFlavor("Getting labels for app {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"ResourceName": cmd.RequiredArgs.ResourceName,
+			"OrgName":      cmd.Config.TargetedOrganization().Name,
+			"SpaceName":    cmd.Config.TargetedSpace().Name,
+			"Username":     user.Name,
+		})
+		labels, warnings, err = cmd.Actor.GetApplicationLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
+	case orgLabelResource:
+		cmd.UI.DisplayTextWithFlavor("Getting labels for org {{.ResourceName}} as {{.Username}}...", map[string]interface{}{
+			"ResourceName": cmd.RequiredArgs.ResourceName,
+			"Username":     user.Name,
+		})
+		labels, warnings, err = cmd.Actor.GetOrganizationLabels(cmd.RequiredArgs.ResourceName)
+	case spaceLabelResource:
+		cmd.UI.DisplayTextWithFlavor("Getting labels for space {{.ResourceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"ResourceName": cmd.RequiredArgs.ResourceName,
+			"OrgName":      cmd.Config.TargetedOrganization().Name,
+			"Username":     user.Name,
+		})
+		labels, warnings, err = cmd.Actor.GetSpaceLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedOrganization().GUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(labels) == 0 {
+		cmd.UI.DisplayText("No labels found.")
+		return nil
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("key"),
+			cmd.UI.TranslateText("value"),
+		},
+	}
+	for _, key := range keys {
+		table = append(table, []string{key, labels[key].Value})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
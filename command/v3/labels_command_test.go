@@ -0,0 +1,186 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("labels Command", func() {
+	var (
+		cmd             v3.LabelsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeLabelsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeLabelsActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.LabelsCommand{
+			RequiredArgs: flag.LabelsArgs{ResourceType: "app", ResourceName: "some-app"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionMetadataV3)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: version.MinVersionV3,
+				MinimumVersion: version.MinVersionMetadataV3,
+			}))
+		})
+	})
+
+	Context("when the resource type is not supported", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "route"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "RESOURCE",
+				ExpectedType: "one of app, org or space",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when listing app labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "App"
+		})
+
+		Context("when the app has labels", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationLabelsReturns(map[string]types.NullString{
+					"tier": types.NewNullString("backend"),
+					"env":  types.NewNullString("prod"),
+				}, v3action.Warnings{"some-warning"}, nil)
+			})
+
+			It("displays the labels sorted by key", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkOrg).To(BeTrue())
+				Expect(checkSpace).To(BeTrue())
+
+				Expect(testUI.Out).To(Say(`Getting labels for app some-app in org some-org / space some-space as steve\.\.\.`))
+				Expect(testUI.Out).To(Say(`key\s+value`))
+				Expect(testUI.Out).To(Say(`env\s+prod`))
+				Expect(testUI.Out).To(Say(`tier\s+backend`))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				appName, spaceGUID := fakeActor.GetApplicationLabelsArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when the app has no labels", func() {
+			It("displays that no labels were found", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No labels found."))
+			})
+		})
+
+		Context("when getting the labels fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationLabelsReturns(nil, v3action.Warnings{"some-warning"}, v3action.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns the translated error and warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+	})
+
+	Context("when listing org labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.LabelsArgs{ResourceType: "org", ResourceName: "some-other-org"}
+			fakeActor.GetOrganizationLabelsReturns(map[string]types.NullString{"pci": types.NewNullString("true")}, nil, nil)
+		})
+
+		It("displays the labels of the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeFalse())
+			Expect(checkSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Getting labels for org some-other-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`pci\s+true`))
+			Expect(fakeActor.GetOrganizationLabelsArgsForCall(0)).To(Equal("some-other-org"))
+		})
+	})
+
+	Context("when listing space labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.LabelsArgs{ResourceType: "space", ResourceName: "some-other-space"}
+			fakeActor.GetSpaceLabelsReturns(nil, nil, errors.New("some-error"))
+		})
+
+		It("looks up the space in the targeted org", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+
+			_, checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeTrue())
+			Expect(checkSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Getting labels for space some-other-space in org some-org as steve\.\.\.`))
+			spaceName, orgGUID := fakeActor.GetSpaceLabelsArgsForCall(0)
+			Expect(spaceName).To(Equal("some-other-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
+})
@@ -45,7 +45,7 @@ func (cmd *ResetOrgDefaultIsolationSegmentCommand) Setup(config command.Config,
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(client, config)
+	cmd.Actor = v3action.NewActor(client, nil, config)
 
 	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
 	if err != nil {
@@ -45,7 +45,7 @@ func (cmd *ResetSpaceIsolationSegmentCommand) Setup(config command.Config, ui co
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(ccClient, config)
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
 
 	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
 	if err != nil {
@@ -0,0 +1,116 @@
+package v3
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . SetLabelActor
+
+type SetLabelActor interface {
+	CloudControllerAPIVersion() string
+	UpdateApplicationLabelsByApplicationName(appName string, spaceGUID string, labels map[string]types.NullString) (v3action.Warnings, error)
+	UpdateOrganizationLabelsByOrganizationName(orgName string, labels map[string]types.NullString) (v3action.Warnings, error)
+	UpdateSpaceLabelsBySpaceName(spaceName string, orgGUID string, labels map[string]types.NullString) (v3action.Warnings, error)
+}
+
+type SetLabelCommand struct {
+	RequiredArgs    flag.SetLabelArgs `positional-args:"yes"`
+	usage           interface{}       `usage:"CF_NAME set-label RESOURCE RESOURCE_NAME KEY=VALUE...\n\nEXAMPLES:\n   CF_NAME set-label app dora env=production\n   CF_NAME set-label org business pci=true public-facing=false\n\nRESOURCES:\n   app\n   org\n   space"`
+	relatedCommands interface{}       `related_commands:"labels, unset-label"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SetLabelActor
+}
+
+func (cmd *SetLabelCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd SetLabelCommand) Execute(args []string) error {
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionMetadataV3)
+	if err != nil {
+		return err
+	}
+
+	resource, err := parseLabelResource(cmd.RequiredArgs.ResourceType)
+	if err != nil {
+		return err
+	}
+
+	labels := map[string]types.NullString{}
+	for _, label := range cmd.RequiredArgs.Labels {
+		parts := strings.SplitN(label, "=", 2)
+		if len(parts) < 2 || parts[0] == "" {
+			return translatableerror.ParseArgumentError{
+				ArgumentName: label,
+				ExpectedType: "a label in the form KEY=VALUE",
+			}
+		}
+		labels[parts[0]] = types.NewNullString(parts[1])
+	}
+
+	err = checkLabelResourceTarget(cmd.SharedActor, cmd.Config, resource)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var warnings v3action.Warnings
+
+	switch resource {
+	case appLabelResource:
+		cmd.UI.DisplayTextWithFlavor("Setting label(s) for app {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"ResourceName": cmd.RequiredArgs.ResourceName,
+			"OrgName":      cmd.Config.TargetedOrganization().Name,
+			"SpaceName":    cmd.Config.TargetedSpace().Name,
+			"Username":     user.Name,
+		})
+		warnings, err = cmd.Actor.UpdateApplicationLabelsByApplicationName(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID, labels)
+	case orgLabelResource:
+		cmd.UI.DisplayTextWithFlavor("Setting label(s) for org {{.ResourceName}} as {{.Username}}...", map[string]interface{}{
+			"ResourceName": cmd.RequiredArgs.ResourceName,
+			"Username":     user.Name,
+		})
+		warnings, err = cmd.Actor.UpdateOrganizationLabelsByOrganizationName(cmd.RequiredArgs.ResourceName, labels)
+	case spaceLabelResource:
+		cmd.UI.DisplayTextWithFlavor("Setting label(s) for space {{.ResourceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"ResourceName": cmd.RequiredArgs.ResourceName,
+			"OrgName":      cmd.Config.TargetedOrganization().Name,
+			"Username":     user.Name,
+		})
+		warnings, err = cmd.Actor.UpdateSpaceLabelsBySpaceName(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedOrganization().GUID, labels)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package v3_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-label Command", func() {
+	var (
+		cmd             v3.SetLabelCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeSetLabelActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeSetLabelActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.SetLabelCommand{
+			RequiredArgs: flag.SetLabelArgs{
+				ResourceType: "app",
+				ResourceName: "some-app",
+				Labels:       []string{"env=prod", "selector=a=b"},
+			},
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionMetadataV3)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: version.MinVersionV3,
+				MinimumVersion: version.MinVersionMetadataV3,
+			}))
+		})
+	})
+
+	Context("when the resource type is not supported", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "route"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "RESOURCE",
+				ExpectedType: "one of app, org or space",
+			}))
+		})
+	})
+
+	Context("when a label is not a KEY=VALUE pair", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Labels = []string{"env=prod", "invalid"}
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "invalid",
+				ExpectedType: "a label in the form KEY=VALUE",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.UpdateApplicationLabelsByApplicationNameCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when setting app labels", func() {
+		Context("when the update succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationLabelsByApplicationNameReturns(v3action.Warnings{"some-warning"}, nil)
+			})
+
+			It("sets the labels on the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkOrg).To(BeTrue())
+				Expect(checkSpace).To(BeTrue())
+
+				Expect(testUI.Out).To(Say(`Setting label\(s\) for app some-app in org some-org / space some-space as steve\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				Expect(fakeActor.UpdateApplicationLabelsByApplicationNameCallCount()).To(Equal(1))
+				appName, spaceGUID, labels := fakeActor.UpdateApplicationLabelsByApplicationNameArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(labels).To(Equal(map[string]types.NullString{
+					"env":      types.NewNullString("prod"),
+					"selector": types.NewNullString("a=b"),
+				}))
+			})
+		})
+
+		Context("when the update fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationLabelsByApplicationNameReturns(v3action.Warnings{"some-warning"}, v3action.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns the translated error and warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(testUI.Err).To(Say("some-warning"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+
+	Context("when setting org labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "org"
+			cmd.RequiredArgs.ResourceName = "some-other-org"
+		})
+
+		It("sets the labels on the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeFalse())
+			Expect(checkSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Setting label\(s\) for org some-other-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			orgName, labels := fakeActor.UpdateOrganizationLabelsByOrganizationNameArgsForCall(0)
+			Expect(orgName).To(Equal("some-other-org"))
+			Expect(labels).To(HaveKeyWithValue("env", types.NewNullString("prod")))
+		})
+	})
+
+	Context("when setting space labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "space"
+			cmd.RequiredArgs.ResourceName = "some-other-space"
+		})
+
+		It("sets the labels on the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeTrue())
+			Expect(checkSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Setting label\(s\) for space some-other-space in org some-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			spaceName, orgGUID, _ := fakeActor.UpdateSpaceLabelsBySpaceNameArgsForCall(0)
+			Expect(spaceName).To(Equal("some-other-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
+})
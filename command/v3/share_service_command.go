@@ -0,0 +1,123 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . ShareServiceActor
+
+type ShareServiceActor interface {
+	CloudControllerAPIVersion() string
+	GetOrganizationByName(orgName string) (v3action.Organization, v3action.Warnings, error)
+	ShareServiceInstanceToSpace(serviceInstanceName string, sourceSpaceGUID string, sharedToOrgGUID string, sharedToSpaceName string) (v3action.Warnings, error)
+}
+
+type ShareServiceCommand struct {
+	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
+	SpaceName       string               `short:"s" required:"true" description:"Space to share the service instance into"`
+	OrgName         string               `short:"o" required:"false" description:"Org of the other space (Default: targeted org)"`
+	Force           bool                 `short:"f" description:"Force share without confirmation"`
+	usage           interface{}          `usage:"CF_NAME share-service SERVICE_INSTANCE -s OTHER_SPACE [-o OTHER_ORG] [-f]"`
+	relatedCommands interface{}          `related_commands:"bind-service, service, services, unshare-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ShareServiceActor
+}
+
+func (cmd *ShareServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd ShareServiceCommand) Execute(args []string) error {
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionShareServiceV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	orgName, orgGUID, err := sharedToOrg(cmd.OrgName, cmd.Config, cmd.UI, cmd.Actor)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		share, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really share the service instance {{.ServiceInstanceName}} into org {{.OrgName}} / space {{.SpaceName}}?", map[string]interface{}{
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			"OrgName":             orgName,
+			"SpaceName":           cmd.SpaceName,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !share {
+			cmd.UI.DisplayText("Share cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Sharing service instance {{.ServiceInstanceName}} into org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":             orgName,
+		"SpaceName":           cmd.SpaceName,
+		"Username":            user.Name,
+	})
+
+	warnings, err := cmd.Actor.ShareServiceInstanceToSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID, orgGUID, cmd.SpaceName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+type organizationGetter interface {
+	GetOrganizationByName(orgName string) (v3action.Organization, v3action.Warnings, error)
+}
+
+// sharedToOrg returns the name and GUID of the organization given by the -o
+// flag, falling back to the targeted organization when the flag is not
+// provided.
+func sharedToOrg(orgName string, config command.Config, ui command.UI, actor organizationGetter) (string, string, error) {
+	if orgName == "" {
+		targetedOrg := config.TargetedOrganization()
+		return targetedOrg.Name, targetedOrg.GUID, nil
+	}
+
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		return "", "", shared.HandleError(err)
+	}
+
+	return org.Name, org.GUID, nil
+}
@@ -0,0 +1,196 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("share-service Command", func() {
+	var (
+		cmd             v3.ShareServiceCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeShareServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeShareServiceActor)
+
+		cmd = v3.ShareServiceCommand{
+			SpaceName: "some-other-space",
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionShareServiceV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: version.MinVersionShareServiceV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user declines the prompt", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not share the service instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Really share the service instance some-service-instance into org some-org / space some-other-space\\?"))
+			Expect(testUI.Out).To(Say("Share cancelled"))
+			Expect(fakeActor.ShareServiceInstanceToSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the user confirms the prompt", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+			fakeActor.ShareServiceInstanceToSpaceReturns(v3action.Warnings{"share-warning"}, nil)
+		})
+
+		It("shares the service instance into the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Sharing service instance some-service-instance into org some-org / space some-other-space as banana..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("share-warning"))
+
+			Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+			Expect(fakeActor.ShareServiceInstanceToSpaceCallCount()).To(Equal(1))
+			serviceInstanceName, sourceSpaceGUID, orgGUID, spaceName := fakeActor.ShareServiceInstanceToSpaceArgsForCall(0)
+			Expect(serviceInstanceName).To(Equal("some-service-instance"))
+			Expect(sourceSpaceGUID).To(Equal("some-space-guid"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("some-other-space"))
+		})
+	})
+
+	Context("when the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("shares without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Really share"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.ShareServiceInstanceToSpaceCallCount()).To(Equal(1))
+		})
+
+		Context("when the -o flag is provided", func() {
+			BeforeEach(func() {
+				cmd.OrgName = "some-other-org"
+				fakeActor.GetOrganizationByNameReturns(
+					v3action.Organization{GUID: "some-other-org-guid", Name: "some-other-org"},
+					v3action.Warnings{"get-org-warning"},
+					nil,
+				)
+			})
+
+			It("shares the service instance into the space in the given org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Sharing service instance some-service-instance into org some-other-org / space some-other-space as banana..."))
+				Expect(testUI.Err).To(Say("get-org-warning"))
+
+				Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-other-org"))
+				_, _, orgGUID, _ := fakeActor.ShareServiceInstanceToSpaceArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-other-org-guid"))
+			})
+
+			Context("when the org does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.GetOrganizationByNameReturns(v3action.Organization{}, v3action.Warnings{"get-org-warning"}, v3action.OrganizationNotFoundError{Name: "some-other-org"})
+				})
+
+				It("returns an OrganizationNotFoundError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-other-org"}))
+					Expect(testUI.Err).To(Say("get-org-warning"))
+					Expect(fakeActor.ShareServiceInstanceToSpaceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.ShareServiceInstanceToSpaceReturns(v3action.Warnings{"share-warning"}, v3action.ServiceInstanceNotFoundError{Name: "some-service-instance"})
+			})
+
+			It("returns a ServiceInstanceNotFoundError and displays warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(testUI.Err).To(Say("share-warning"))
+			})
+		})
+
+		Context("when sharing fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("share error")
+				fakeActor.ShareServiceInstanceToSpaceReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
@@ -9,7 +9,6 @@ import (
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
-	"github.com/cloudfoundry/bytefmt"
 )
 
 type AppSummaryDisplayer struct {
@@ -33,9 +32,16 @@ type V3AppSummaryActor interface {
 }
 
 func (display AppSummaryDisplayer) DisplayAppInfo() error {
+	_, _, err := display.DisplayAndGetAppInfo()
+	return err
+}
+
+// DisplayAndGetAppInfo displays the application summary and returns the
+// summary and routes that were displayed.
+func (display AppSummaryDisplayer) DisplayAndGetAppInfo() (v3action.ApplicationSummary, v2action.Routes, error) {
 	user, err := display.Config.CurrentUser()
 	if err != nil {
-		return HandleError(err)
+		return v3action.ApplicationSummary{}, nil, HandleError(err)
 	}
 
 	display.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
@@ -49,7 +55,7 @@ func (display AppSummaryDisplayer) DisplayAppInfo() error {
 	summary, warnings, err := display.Actor.GetApplicationSummaryByNameAndSpace(display.AppName, display.Config.TargetedSpace().GUID)
 	display.UI.DisplayWarnings(warnings)
 	if err != nil {
-		return HandleError(err)
+		return v3action.ApplicationSummary{}, nil, HandleError(err)
 	}
 
 	var routes v2action.Routes
@@ -58,13 +64,22 @@ func (display AppSummaryDisplayer) DisplayAppInfo() error {
 		routes, routeWarnings, err = display.V2AppRouteActor.GetApplicationRoutes(summary.Application.GUID)
 		display.UI.DisplayWarnings(routeWarnings)
 		if err != nil {
-			return sharedV2.HandleError(err)
+			return v3action.ApplicationSummary{}, nil, sharedV2.HandleError(err)
 		}
 	}
 
 	display.displayAppTable(summary, routes)
 
-	return nil
+	return summary, routes, nil
+}
+
+// stackName returns the stack the current droplet was staged on, falling back
+// to the stack requested in the app's lifecycle when it has not been staged.
+func (AppSummaryDisplayer) stackName(summary v3action.ApplicationSummary) string {
+	if summary.CurrentDroplet.Stack != "" {
+		return summary.CurrentDroplet.Stack
+	}
+	return summary.Lifecycle.Data.Stack
 }
 
 // Sort processes alphabetically and put web first.
@@ -77,7 +92,7 @@ func (display AppSummaryDisplayer) displayAppTable(summary v3action.ApplicationS
 		{display.UI.TranslateText("processes:"), summary.ProcessSummaries.String()},
 		{display.UI.TranslateText("memory usage:"), display.usageSummary(summary.ProcessSummaries)},
 		{display.UI.TranslateText("routes:"), routes.Summary()},
-		{display.UI.TranslateText("stack:"), summary.CurrentDroplet.Stack},
+		{display.UI.TranslateText("stack:"), display.stackName(summary)},
 		{display.UI.TranslateText("buildpacks:"), display.buildpackNames(summary.CurrentDroplet.Buildpacks)},
 	}
 
@@ -118,6 +133,7 @@ func (display AppSummaryDisplayer) DisplayAppInstancesTable(processSummary v3act
 		"HealthyInstanceCount": processSummary.HealthyInstanceCount(),
 		"TotalInstanceCount":   processSummary.TotalInstanceCount(),
 	})
+	display.displayHealthCheck(processSummary)
 
 	if !display.processHasAnInstance(&processSummary) {
 		return
@@ -141,12 +157,12 @@ func (display AppSummaryDisplayer) DisplayAppInstancesTable(processSummary v3act
 			display.appInstanceDate(instance.StartTime()),
 			fmt.Sprintf("%.1f%%", instance.CPU*100),
 			display.UI.TranslateText("{{.MemUsage}} of {{.MemQuota}}", map[string]interface{}{
-				"MemUsage": bytefmt.ByteSize(instance.MemoryUsage),
-				"MemQuota": bytefmt.ByteSize(instance.MemoryQuota),
+				"MemUsage": display.UI.FormatBytes(instance.MemoryUsage),
+				"MemQuota": display.UI.FormatBytes(instance.MemoryQuota),
 			}),
 			display.UI.TranslateText("{{.DiskUsage}} of {{.DiskQuota}}", map[string]interface{}{
-				"DiskUsage": bytefmt.ByteSize(instance.DiskUsage),
-				"DiskQuota": bytefmt.ByteSize(instance.DiskQuota),
+				"DiskUsage": display.UI.FormatBytes(instance.DiskUsage),
+				"DiskQuota": display.UI.FormatBytes(instance.DiskQuota),
 			}),
 		})
 	}
@@ -154,11 +170,33 @@ func (display AppSummaryDisplayer) DisplayAppInstancesTable(processSummary v3act
 	display.UI.DisplayInstancesTableForApp(table)
 }
 
-func (AppSummaryDisplayer) usageSummary(processSummaries v3action.ProcessSummaries) string {
+func (display AppSummaryDisplayer) displayHealthCheck(processSummary v3action.ProcessSummary) {
+	healthCheck := processSummary.HealthCheck
+	if healthCheck.Type == "" {
+		return
+	}
+
+	summary := healthCheck.Type
+	if healthCheck.Type == "http" && healthCheck.Data.Endpoint != "" {
+		summary = fmt.Sprintf("%s %s", summary, healthCheck.Data.Endpoint)
+	}
+	if healthCheck.Data.InvocationTimeout.IsSet {
+		summary = display.UI.TranslateText("{{.HealthCheck}} (invocation timeout: {{.InvocationTimeout}}s)", map[string]interface{}{
+			"HealthCheck":       summary,
+			"InvocationTimeout": healthCheck.Data.InvocationTimeout.Value,
+		})
+	}
+
+	display.UI.DisplayText("health check: {{.HealthCheck}}", map[string]interface{}{
+		"HealthCheck": summary,
+	})
+}
+
+func (display AppSummaryDisplayer) usageSummary(processSummaries v3action.ProcessSummaries) string {
 	var usageStrings []string
 	for _, summary := range processSummaries {
 		if summary.TotalInstanceCount() > 0 {
-			usageStrings = append(usageStrings, fmt.Sprintf("%dM x %d", summary.MemoryInMB.Value, summary.TotalInstanceCount()))
+			usageStrings = append(usageStrings, fmt.Sprintf("%s x %d", display.UI.FormatMegabytes(summary.MemoryInMB.Value), summary.TotalInstanceCount()))
 		}
 	}
 
@@ -6,15 +6,30 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+	"code.cloudfoundry.org/cli/api/transport"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/interrupt"
 )
 
 func HandleError(err error) error {
 	switch e := err.(type) {
 	case ccerror.APINotFoundError:
 		return translatableerror.APINotFoundError(e)
+	case ccerror.ForbiddenError:
+		return translatableerror.ForbiddenError{Message: e.Message}
+	case ccerror.JobFailedError:
+		return translatableerror.JobFailedError(e)
+	case ccerror.JobTimeoutError:
+		return translatableerror.JobTimeoutError{JobGUID: e.JobGUID}
 	case ccerror.RequestError:
 		return translatableerror.APIRequestError(e)
+	case ccerror.RequestCancelledError,
+		logcacheerror.RequestCancelledError,
+		uaa.RequestCancelledError,
+		interrupt.InterruptedError:
+		return translatableerror.OperationCancelledError{}
 	case ccerror.SSLValidationHostnameError:
 		return translatableerror.SSLCertError(e)
 	case ccerror.UnprocessableEntityError:
@@ -24,6 +39,8 @@ func HandleError(err error) error {
 		}
 	case ccerror.UnverifiedServerError:
 		return translatableerror.InvalidSSLCertError{API: e.URL}
+	case transport.InvalidCACertificateError:
+		return translatableerror.InvalidCACertificateError{Path: e.Path}
 
 	case sharedaction.NotLoggedInError:
 		return translatableerror.NotLoggedInError(e)
@@ -32,10 +49,18 @@ func HandleError(err error) error {
 	case sharedaction.NoSpaceTargetedError:
 		return translatableerror.NoSpaceTargetedError(e)
 
+	case v3action.ActiveDeploymentNotFoundError:
+		return translatableerror.ActiveDeploymentNotFoundError(e)
 	case v3action.ApplicationNotFoundError:
 		return translatableerror.ApplicationNotFoundError(e)
+	case v3action.ApplicationNotStartedError:
+		return translatableerror.ApplicationNotStartedError(e)
 	case v3action.AssignDropletError:
 		return translatableerror.AssignDropletError(e)
+	case v3action.DeploymentCanceledError:
+		return translatableerror.DeploymentCanceledError(e)
+	case v3action.DomainNotFoundError:
+		return translatableerror.DomainNotFoundError{Name: e.Name}
 	case v3action.EmptyDirectoryError:
 		return translatableerror.EmptyDirectoryError(e)
 	case v3action.IsolationSegmentNotFoundError:
@@ -46,6 +71,22 @@ func HandleError(err error) error {
 		return translatableerror.ProcessNotFoundError(e)
 	case v3action.ProcessInstanceNotFoundError:
 		return translatableerror.ProcessInstanceNotFoundError(e)
+	case v3action.ReadyPackageNotFoundError:
+		return translatableerror.ReadyPackageNotFoundError(e)
+	case v3action.RevisionNotDeployableError:
+		return translatableerror.RevisionNotDeployableError(e)
+	case v3action.RevisionNotFoundError:
+		return translatableerror.RevisionNotFoundError(e)
+	case v3action.RouteNotFoundError:
+		return translatableerror.RouteNotFoundError{URL: v3action.RouteURL(e.Host, e.DomainName, e.Path)}
+	case v3action.ServiceInstanceNotFoundError:
+		return translatableerror.ServiceInstanceNotFoundError{Name: e.Name}
+	case v3action.SSHCommandFailedError:
+		return translatableerror.SSHCommandFailedError(e)
+	case v3action.SpaceNotFoundError:
+		return translatableerror.SpaceNotFoundError{Name: e.Name}
+	case v3action.StackNotFoundError:
+		return translatableerror.StackNotFoundError{Name: e.Name}
 	case v3action.StagingTimeoutError:
 		return translatableerror.StagingTimeoutError(e)
 	case v3action.TaskWorkersUnavailableError:
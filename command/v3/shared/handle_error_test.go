@@ -7,8 +7,12 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+	"code.cloudfoundry.org/cli/api/transport"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/interrupt"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -28,6 +32,22 @@ var _ = Describe("HandleError", func() {
 			ccerror.RequestError{Err: err},
 			translatableerror.APIRequestError{Err: err}),
 
+		Entry("ccerror.RequestCancelledError -> OperationCancelledError",
+			ccerror.RequestCancelledError{},
+			translatableerror.OperationCancelledError{}),
+
+		Entry("logcacheerror.RequestCancelledError -> OperationCancelledError",
+			logcacheerror.RequestCancelledError{},
+			translatableerror.OperationCancelledError{}),
+
+		Entry("uaa.RequestCancelledError -> OperationCancelledError",
+			uaa.RequestCancelledError{},
+			translatableerror.OperationCancelledError{}),
+
+		Entry("interrupt.InterruptedError -> OperationCancelledError",
+			interrupt.InterruptedError{},
+			translatableerror.OperationCancelledError{}),
+
 		Entry("ccerror.UnverifiedServerError -> InvalidSSLCertError",
 			ccerror.UnverifiedServerError{URL: "some-url"},
 			translatableerror.InvalidSSLCertError{API: "some-url"}),
@@ -36,6 +56,10 @@ var _ = Describe("HandleError", func() {
 			ccerror.SSLValidationHostnameError{Message: "some-message"},
 			translatableerror.SSLCertError{Message: "some-message"}),
 
+		Entry("transport.InvalidCACertificateError -> InvalidCACertificateError",
+			transport.InvalidCACertificateError{Path: "some-path"},
+			translatableerror.InvalidCACertificateError{Path: "some-path"}),
+
 		Entry("ccerror.UnprocessableEntityError with droplet message -> RunTaskError",
 			ccerror.UnprocessableEntityError{Message: "The request is semantically invalid: Task must have a droplet. Specify droplet or assign current droplet to app."},
 			translatableerror.RunTaskError{Message: "App is not staged."}),
@@ -53,10 +77,38 @@ var _ = Describe("HandleError", func() {
 			ccerror.APINotFoundError{URL: "some-url"},
 			translatableerror.APINotFoundError{URL: "some-url"}),
 
+		Entry("ccerror.ForbiddenError -> ForbiddenError",
+			ccerror.ForbiddenError{Message: "some-message"},
+			translatableerror.ForbiddenError{Message: "some-message"}),
+
+		Entry("ccerror.JobFailedError -> JobFailedError",
+			ccerror.JobFailedError{JobGUID: "some-job-guid", Message: "some-message"},
+			translatableerror.JobFailedError{JobGUID: "some-job-guid", Message: "some-message"}),
+
+		Entry("ccerror.JobTimeoutError -> JobTimeoutError",
+			ccerror.JobTimeoutError{JobGUID: "some-job-guid"},
+			translatableerror.JobTimeoutError{JobGUID: "some-job-guid"}),
+
+		Entry("v3action.DomainNotFoundError -> DomainNotFoundError",
+			v3action.DomainNotFoundError{Name: "some-domain.com"},
+			translatableerror.DomainNotFoundError{Name: "some-domain.com"}),
+
+		Entry("v3action.RouteNotFoundError -> RouteNotFoundError",
+			v3action.RouteNotFoundError{Host: "some-host", DomainName: "some-domain.com", Path: "some-path"},
+			translatableerror.RouteNotFoundError{URL: "some-host.some-domain.com/some-path"}),
+
+		Entry("v3action.ActiveDeploymentNotFoundError -> ActiveDeploymentNotFoundError",
+			v3action.ActiveDeploymentNotFoundError{AppName: "some-app"},
+			translatableerror.ActiveDeploymentNotFoundError{AppName: "some-app"}),
+
 		Entry("v3action.ApplicationNotFoundError -> ApplicationNotFoundError",
 			v3action.ApplicationNotFoundError{Name: "some-app"},
 			translatableerror.ApplicationNotFoundError{Name: "some-app"}),
 
+		Entry("v3action.ApplicationNotStartedError -> ApplicationNotStartedError",
+			v3action.ApplicationNotStartedError{Name: "some-app"},
+			translatableerror.ApplicationNotStartedError{Name: "some-app"}),
+
 		Entry("v3action.TaskWorkersUnavailableError -> RunTaskError",
 			v3action.TaskWorkersUnavailableError{Message: "fooo: Banana Pants"},
 			translatableerror.RunTaskError{Message: "Task workers are unavailable."}),
@@ -89,6 +141,38 @@ var _ = Describe("HandleError", func() {
 			v3action.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42},
 			translatableerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42}),
 
+		Entry("v3action.ReadyPackageNotFoundError -> ReadyPackageNotFoundError",
+			v3action.ReadyPackageNotFoundError{AppName: "some-app"},
+			translatableerror.ReadyPackageNotFoundError{AppName: "some-app"}),
+
+		Entry("v3action.RevisionNotDeployableError -> RevisionNotDeployableError",
+			v3action.RevisionNotDeployableError{Version: 3},
+			translatableerror.RevisionNotDeployableError{Version: 3}),
+
+		Entry("v3action.RevisionNotFoundError -> RevisionNotFoundError",
+			v3action.RevisionNotFoundError{Version: 3},
+			translatableerror.RevisionNotFoundError{Version: 3}),
+
+		Entry("v3action.ServiceInstanceNotFoundError -> ServiceInstanceNotFoundError",
+			v3action.ServiceInstanceNotFoundError{Name: "some-service-instance"},
+			translatableerror.ServiceInstanceNotFoundError{Name: "some-service-instance"}),
+
+		Entry("v3action.SSHCommandFailedError -> SSHCommandFailedError",
+			v3action.SSHCommandFailedError{ExitCode: 2, Stderr: "some-stderr"},
+			translatableerror.SSHCommandFailedError{ExitCode: 2, Stderr: "some-stderr"}),
+
+		Entry("v3action.SpaceNotFoundError -> SpaceNotFoundError",
+			v3action.SpaceNotFoundError{Name: "some-space"},
+			translatableerror.SpaceNotFoundError{Name: "some-space"}),
+
+		Entry("v3action.DeploymentCanceledError -> DeploymentCanceledError",
+			v3action.DeploymentCanceledError{},
+			translatableerror.DeploymentCanceledError{}),
+
+		Entry("v3action.StackNotFoundError -> StackNotFoundError",
+			v3action.StackNotFoundError{Name: "some-stack"},
+			translatableerror.StackNotFoundError{Name: "some-stack"}),
+
 		Entry("v3action.StagingTimeoutError -> StagingTimeoutError",
 			v3action.StagingTimeoutError{AppName: "some-app", Timeout: time.Nanosecond},
 			translatableerror.StagingTimeoutError{AppName: "some-app", Timeout: time.Nanosecond}),
@@ -10,6 +10,8 @@ import (
 	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/interrupt"
 )
 
 // NewClients creates a new V3 Cloud Controller client and UAA client using the
@@ -18,6 +20,9 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 	ccWrappers := []ccv3.ConnectionWrapper{}
 
 	verbose, location := config.Verbose()
+	if verbose || location != nil {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestMetrics(ccWrapper.DefaultRequestSummary))
+	}
 	if verbose {
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
 	}
@@ -25,17 +30,20 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
-	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
+	refresher := sharedV2.TokenRefresher(config)
+	authWrapper := ccWrapper.NewUAAAuthenticationWithRefresher(nil, refresher)
 
 	ccWrappers = append(ccWrappers, authWrapper)
 	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(2))
+	ccWrappers = append(ccWrappers, ccWrapper.NewCancelRequest(interrupt.DefaultHandler))
 
 	ccClient := ccv3.NewClient(ccv3.Config{
-		AppName:            config.BinaryName(),
-		AppVersion:         config.BinaryVersion(),
-		JobPollingTimeout:  config.OverallPollingTimeout(),
-		JobPollingInterval: config.PollingInterval(),
-		Wrappers:           ccWrappers,
+		AppName:             config.BinaryName(),
+		AppVersion:          config.BinaryVersion(),
+		JobPollingTimeout:   config.OverallPollingTimeout(),
+		JobPollingInterval:  config.PollingInterval(),
+		JobPollingCanceller: interrupt.DefaultHandler,
+		Wrappers:            ccWrappers,
 	})
 
 	if !targetCF {
@@ -49,9 +57,12 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 	}
 
 	_, err := ccClient.TargetCF(ccv3.TargetSettings{
-		URL:               config.Target(),
-		SkipSSLValidation: config.SkipSSLValidation(),
-		DialTimeout:       config.DialTimeout(),
+		URL:                 config.Target(),
+		SkipSSLValidation:   config.SkipSSLValidation(),
+		CACertFile:          config.CACertFile(),
+		DialTimeout:         config.DialTimeout(),
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost(),
+		TLSHandshakeTimeout: config.TLSHandshakeTimeout(),
 	})
 	if err != nil {
 		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
@@ -66,12 +77,16 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 	}
 
 	uaaClient := uaa.NewClient(uaa.Config{
-		AppName:           config.BinaryName(),
-		AppVersion:        config.BinaryVersion(),
-		ClientID:          config.UAAOAuthClient(),
-		ClientSecret:      config.UAAOAuthClientSecret(),
-		DialTimeout:       config.DialTimeout(),
-		SkipSSLValidation: config.SkipSSLValidation(),
+		AppName:             config.BinaryName(),
+		AppVersion:          config.BinaryVersion(),
+		ClientID:            config.UAAOAuthClient(),
+		ClientSecret:        config.UAAOAuthClientSecret(),
+		GrantType:           uaa.GrantType(config.UAAGrantType()),
+		CACertFile:          config.CACertFile(),
+		DialTimeout:         config.DialTimeout(),
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost(),
+		SkipSSLValidation:   config.SkipSSLValidation(),
+		TLSHandshakeTimeout: config.TLSHandshakeTimeout(),
 	})
 
 	if verbose {
@@ -81,9 +96,10 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
-	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(uaaClient, config)
+	uaaAuthWrapper := uaaWrapper.NewUAAAuthenticationWithRefresher(uaaClient, refresher)
 	uaaClient.WrapConnection(uaaAuthWrapper)
 	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(2))
+	uaaClient.WrapConnection(uaaWrapper.NewCancelRequest(interrupt.DefaultHandler))
 
 	err = uaaClient.SetupResources(config, ccClient.UAA())
 	if err != nil {
@@ -0,0 +1,45 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/logcache/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/interrupt"
+)
+
+// NewLogCacheClient creates a new Log Cache client.
+func NewLogCacheClient(logCacheURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) (*logcache.Client, error) {
+	if logCacheURL == "" {
+		return nil, translatableerror.LogCacheEndpointNotFoundError{}
+	}
+
+	wrappers := []logcache.ConnectionWrapper{}
+
+	verbose, location := config.Verbose()
+	if verbose {
+		wrappers = append(wrappers, wrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
+	}
+	if location != nil {
+		wrappers = append(wrappers, wrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+	}
+
+	authWrapper := wrapper.NewUAAAuthentication(uaaClient, config)
+	wrappers = append(wrappers, authWrapper)
+
+	wrappers = append(wrappers, wrapper.NewRetryRequest(2))
+	wrappers = append(wrappers, wrapper.NewCancelRequest(interrupt.DefaultHandler))
+
+	return logcache.NewClient(logcache.ClientConfig{
+		AppName:             config.BinaryName(),
+		AppVersion:          config.BinaryVersion(),
+		CACertFile:          config.CACertFile(),
+		DialTimeout:         config.DialTimeout(),
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost(),
+		SkipSSLValidation:   config.SkipSSLValidation(),
+		TLSHandshakeTimeout: config.TLSHandshakeTimeout(),
+		URL:                 logCacheURL,
+		Wrappers:            wrappers,
+	}), nil
+}
@@ -0,0 +1,44 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("NewLogCacheClient", func() {
+	var (
+		binaryName    string
+		fakeConfig    *commandfakes.FakeConfig
+		testUI        *ui.UI
+		fakeUAAClient *uaa.Client
+	)
+
+	BeforeEach(func() {
+		binaryName = "faceman"
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+	})
+
+	It("returns a log cache client", func() {
+		client, err := NewLogCacheClient("some-url", fakeConfig, fakeUAAClient, testUI)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client).NotTo(BeNil())
+	})
+
+	Context("when the log cache endpoint is not set", func() {
+		It("returns a LogCacheEndpointNotFoundError", func() {
+			_, err := NewLogCacheClient("", fakeConfig, fakeUAAClient, testUI)
+			Expect(err).To(MatchError(translatableerror.LogCacheEndpointNotFoundError{}))
+		})
+	})
+})
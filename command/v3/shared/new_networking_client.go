@@ -30,11 +30,14 @@ func NewNetworkingClient(apiURL string, config command.Config, uaaClient *uaa.Cl
 	wrappers = append(wrappers, wrapper.NewRetryRequest(2))
 
 	return cfnetv1.NewClient(cfnetv1.Config{
-		AppName:           config.BinaryName(),
-		AppVersion:        config.BinaryVersion(),
-		DialTimeout:       config.DialTimeout(),
-		SkipSSLValidation: config.SkipSSLValidation(),
-		URL:               apiURL,
-		Wrappers:          wrappers,
+		AppName:             config.BinaryName(),
+		AppVersion:          config.BinaryVersion(),
+		CACertFile:          config.CACertFile(),
+		DialTimeout:         config.DialTimeout(),
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost(),
+		SkipSSLValidation:   config.SkipSSLValidation(),
+		TLSHandshakeTimeout: config.TLSHandshakeTimeout(),
+		URL:                 apiURL,
+		Wrappers:            wrappers,
 	}), nil
 }
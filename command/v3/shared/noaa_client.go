@@ -1,13 +1,14 @@
 package shared
 
 import (
-	"crypto/tls"
 	"net/http"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/transport"
 	"code.cloudfoundry.org/cli/api/uaa"
-	"code.cloudfoundry.org/cli/api/uaa/noaabridge"
 	"code.cloudfoundry.org/cli/command"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/interrupt"
 	"github.com/cloudfoundry/noaa/consumer"
 )
 
@@ -38,17 +39,25 @@ func (p DebugPrinter) Print(title string, dump string) {
 }
 
 // NewNOAAClient returns back a configured NOAA Client.
-func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) *consumer.Consumer {
-	client := consumer.New(
-		apiURL,
-		&tls.Config{
-			InsecureSkipVerify: config.SkipSSLValidation(),
-		},
-		http.ProxyFromEnvironment,
-	)
-	client.RefreshTokenFrom(noaabridge.NewTokenRefresher(uaaClient, config))
+func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) (*consumer.Consumer, error) {
+	tlsConfig, err := transport.NewTLSConfig(config.SkipSSLValidation(), config.CACertFile())
+	if err != nil {
+		return nil, HandleError(err)
+	}
+
+	client := consumer.New(apiURL, tlsConfig, http.ProxyFromEnvironment)
+	refresher := sharedV2.TokenRefresher(config)
+	refresher.SetClient(uaaClient)
+	client.RefreshTokenFrom(refresher)
 	client.SetMaxRetryCount(5)
 
+	// The consumer's websocket cannot be given a context, so it is closed
+	// instead once an interrupt cancels the other clients' requests.
+	go func() {
+		<-interrupt.DefaultHandler.Context().Done()
+		_ = client.Close()
+	}()
+
 	noaaDebugPrinter := DebugPrinter{}
 
 	// if verbose, set debug printer on noaa client
@@ -63,5 +72,5 @@ func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client,
 		noaaDebugPrinter.addOutput(ui.RequestLoggerFileWriter(location))
 	}
 
-	return client
+	return client, nil
 }
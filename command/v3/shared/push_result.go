@@ -0,0 +1,84 @@
+package shared
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+)
+
+// PushResultStatus is the outcome of a push.
+type PushResultStatus string
+
+const (
+	PushSucceeded PushResultStatus = "succeeded"
+	PushFailed    PushResultStatus = "failed"
+)
+
+// PushResult is the structured summary of a push that is written to the
+// result file, so that later pipeline stages do not have to look the app up
+// again.
+type PushResult struct {
+	AppName string           `json:"app_name"`
+	AppGUID string           `json:"app_guid,omitempty"`
+	Status  PushResultStatus `json:"status"`
+	Error   string           `json:"error,omitempty"`
+
+	Routes      []string `json:"routes"`
+	DropletGUID string   `json:"droplet_guid,omitempty"`
+
+	// Revision identifies the pushed source, which is the GUID of the package
+	// the droplet was staged from.
+	Revision string `json:"revision,omitempty"`
+
+	StartDurationSeconds float64             `json:"start_duration_seconds"`
+	Processes            []PushResultProcess `json:"processes"`
+}
+
+// PushResultProcess is the instance health of a single process type.
+type PushResultProcess struct {
+	Type             string `json:"type"`
+	HealthyInstances int    `json:"healthy_instances"`
+	TotalInstances   int    `json:"total_instances"`
+}
+
+// SetStartDuration records how long the app took to start.
+func (result *PushResult) SetStartDuration(duration time.Duration) {
+	result.StartDurationSeconds = duration.Round(time.Second).Seconds()
+}
+
+// SetAppInfo records the routes and the instance health of the pushed app.
+func (result *PushResult) SetAppInfo(summary v3action.ApplicationSummary, routes v2action.Routes) {
+	for _, route := range routes {
+		result.Routes = append(result.Routes, route.String())
+	}
+
+	summary.ProcessSummaries.Sort()
+	for _, process := range summary.ProcessSummaries {
+		result.Processes = append(result.Processes, PushResultProcess{
+			Type:             process.Type,
+			HealthyInstances: process.HealthyInstanceCount(),
+			TotalInstances:   process.TotalInstanceCount(),
+		})
+	}
+}
+
+// WritePushResult writes the result to path as JSON. Empty route and process
+// lists are written as empty arrays rather than null.
+func WritePushResult(path string, result PushResult) error {
+	if result.Routes == nil {
+		result.Routes = []string{}
+	}
+	if result.Processes == nil {
+		result.Processes = []PushResultProcess{}
+	}
+
+	raw, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(raw, '\n'), 0644)
+}
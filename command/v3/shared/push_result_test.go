@@ -0,0 +1,91 @@
+package shared_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	. "code.cloudfoundry.org/cli/command/v3/shared"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PushResult", func() {
+	var result PushResult
+
+	BeforeEach(func() {
+		result = PushResult{AppName: "some-app"}
+	})
+
+	Describe("SetStartDuration", func() {
+		It("records the duration rounded to the second", func() {
+			result.SetStartDuration(2*time.Second + 600*time.Millisecond)
+			Expect(result.StartDurationSeconds).To(Equal(float64(3)))
+		})
+	})
+
+	Describe("SetAppInfo", func() {
+		It("records the routes and the instance health of each process, web first", func() {
+			result.SetAppInfo(
+				v3action.ApplicationSummary{
+					ProcessSummaries: v3action.ProcessSummaries{
+						{
+							Process:         v3action.Process{Type: "worker"},
+							InstanceDetails: []v3action.Instance{{State: "CRASHED"}},
+						},
+						{
+							Process:         v3action.Process{Type: "web"},
+							InstanceDetails: []v3action.Instance{{State: "RUNNING"}, {State: "STARTING"}},
+						},
+					},
+				},
+				v2action.Routes{
+					{Host: "some-host", Domain: v2action.Domain{Name: "some-domain"}},
+				},
+			)
+
+			Expect(result.Routes).To(Equal([]string{"some-host.some-domain"}))
+			Expect(result.Processes).To(Equal([]PushResultProcess{
+				{Type: "web", HealthyInstances: 1, TotalInstances: 2},
+				{Type: "worker", HealthyInstances: 0, TotalInstances: 1},
+			}))
+		})
+	})
+
+	Describe("WritePushResult", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "push-result")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("writes empty routes and processes as empty arrays", func() {
+			path := filepath.Join(dir, "result.json")
+			result.Status = PushFailed
+			result.Error = "some-error"
+
+			Expect(WritePushResult(path, result)).To(Succeed())
+
+			raw, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(raw).To(MatchJSON(`{
+				"app_name": "some-app",
+				"status": "failed",
+				"error": "some-error",
+				"routes": [],
+				"start_duration_seconds": 0,
+				"processes": []
+			}`))
+		})
+	})
+})
@@ -0,0 +1,98 @@
+package shared
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/cf/models"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	sshTerminal "code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"github.com/docker/docker/pkg/term"
+	"golang.org/x/crypto/ssh"
+)
+
+// NewSecureShell builds a secure shell to the process instance described by
+// details, authenticated with passcode. The process is presented as a started
+// Diego app so that the shared SSH helpers connect as the process rather than
+// the app.
+func NewSecureShell(details v3action.SSHDetails, passcode string, terminalHelper sshTerminal.TerminalHelper) sshCmd.SecureShell {
+	return sshCmd.NewSecureShell(
+		sshCmd.DefaultSecureDialer(),
+		terminalHelper,
+		sshCmd.DefaultListenerFactory(),
+		30*time.Second,
+		models.Application{
+			ApplicationFields: models.ApplicationFields{
+				GUID:  details.ProcessGUID,
+				State: models.ApplicationStateStarted,
+				Diego: true,
+			},
+		},
+		details.HostKeyFingerprint,
+		details.Endpoint,
+		passcode,
+	)
+}
+
+// SSHClient runs commands on process instances over SSH without allocating a
+// terminal, capturing their output.
+type SSHClient struct {
+	SkipHostValidation bool
+}
+
+// RunCommand runs command on the process instance and returns its exit code.
+func (client SSHClient) RunCommand(details v3action.SSHDetails, passcode string, command string, stdout io.Writer, stderr io.Writer) (int, error) {
+	secureShell := NewSecureShell(details, passcode, capturingTerminalHelper{stdout: stdout, stderr: stderr})
+
+	err := secureShell.Connect(&options.SSHOptions{
+		Command:            []string{command},
+		Index:              uint(details.ProcessIndex),
+		SkipHostValidation: client.SkipHostValidation,
+		TerminalRequest:    options.RequestTTYNo,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer secureShell.Close()
+
+	err = secureShell.InteractiveSession()
+	if exitError, ok := err.(*ssh.ExitError); ok {
+		return exitError.ExitStatus(), nil
+	}
+	return 0, err
+}
+
+// capturingTerminalHelper connects SSH sessions to the given writers instead
+// of the user's terminal, with nothing on standard input.
+type capturingTerminalHelper struct {
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func (helper capturingTerminalHelper) StdStreams() (io.ReadCloser, io.Writer, io.Writer) {
+	return ioutil.NopCloser(strings.NewReader("")), helper.stdout, helper.stderr
+}
+
+func (capturingTerminalHelper) GetFdInfo(interface{}) (uintptr, bool) {
+	return 0, false
+}
+
+func (capturingTerminalHelper) SetRawTerminal(uintptr) (*term.State, error) {
+	return nil, nil
+}
+
+func (capturingTerminalHelper) RestoreTerminal(uintptr, *term.State) error {
+	return nil
+}
+
+func (capturingTerminalHelper) IsTerminal(uintptr) bool {
+	return false
+}
+
+func (capturingTerminalHelper) GetWinsize(uintptr) (*term.Winsize, error) {
+	return nil, nil
+}
@@ -3,12 +3,16 @@ package shared
 import (
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
-func PollStage(dropletStream <-chan v3action.Droplet, warningsStream <-chan v3action.Warnings, errStream <-chan error, logStream <-chan *v3action.LogMessage, logErrStream <-chan error, ui command.UI) (v3action.Droplet, error) {
+func PollStage(dropletStream <-chan v3action.Droplet, warningsStream <-chan v3action.Warnings, errStream <-chan error, logStream <-chan *v3action.LogMessage, logErrStream <-chan error, commandUI command.UI) (v3action.Droplet, error) {
 	var closedBuildStream, closedWarningsStream, closedErrStream bool
 	var droplet v3action.Droplet
 
+	commandUI.DisplayProgressEvent(ui.ProgressEvent{Phase: ui.ProgressPhaseStaging, Message: "Staging package"})
+
 	for {
 		select {
 		case d, ok := <-dropletStream:
@@ -22,19 +26,19 @@ func PollStage(dropletStream <-chan v3action.Droplet, warningsStream <-chan v3ac
 				break
 			}
 			if log.Staging() {
-				ui.DisplayLogMessage(log, false)
+				commandUI.DisplayLogMessage(log, false)
 			}
 		case warnings, ok := <-warningsStream:
 			if !ok {
 				closedWarningsStream = true
 				break
 			}
-			ui.DisplayWarnings(warnings)
+			commandUI.DisplayWarnings(warnings)
 		case logErr, ok := <-logErrStream:
 			if !ok {
 				break
 			}
-			ui.DisplayWarning(logErr.Error())
+			commandUI.DisplayWarning(logErr.Error())
 		case err, ok := <-errStream:
 			if !ok {
 				closedErrStream = true
@@ -43,6 +47,11 @@ func PollStage(dropletStream <-chan v3action.Droplet, warningsStream <-chan v3ac
 			return v3action.Droplet{}, HandleError(err)
 		}
 		if closedBuildStream && closedWarningsStream && closedErrStream {
+			commandUI.DisplayProgressEvent(ui.ProgressEvent{
+				Phase:   ui.ProgressPhaseStaging,
+				Message: "Staging complete",
+				Percent: types.NullInt{Value: 100, IsSet: true},
+			})
 			return droplet, nil
 		}
 	}
@@ -95,6 +95,18 @@ var _ = Describe("V3PollStage", func() {
 				Expect(returnedDroplet.GUID).To(Equal("droplet-guid"))
 			})
 		})
+
+		It("reports staging progress events", func() {
+			events := NewBuffer()
+			testUI.ProgressEvents = events
+
+			executePollStage(func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+			})
+
+			Expect(events).To(Say(`{"phase":"staging","message":"Staging package","percent":null}\n`))
+			Expect(events).To(Say(`{"phase":"staging","message":"Staging complete","percent":100}\n`))
+		})
 	})
 
 	Context("when the warnings stream contains warnings", func() {
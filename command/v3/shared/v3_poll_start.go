@@ -0,0 +1,47 @@
+package shared
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+)
+
+//go:generate counterfeiter . V3PollStartActor
+
+type V3PollStartActor interface {
+	PollStart(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error
+}
+
+// PollStart waits for the application's processes to start, displaying the
+// application's logs and any polling warnings as they arrive. A zero
+// startupTimeout uses the configured startup timeout.
+func PollStart(actor V3PollStartActor, appGUID string, startupTimeout time.Duration, logStream <-chan *v3action.LogMessage, logErrStream <-chan error, ui command.UI) error {
+	warningsStream := make(chan v3action.Warnings)
+	errStream := make(chan error, 1)
+
+	go func() {
+		errStream <- actor.PollStart(appGUID, startupTimeout, warningsStream)
+	}()
+
+	for {
+		select {
+		case warnings := <-warningsStream:
+			ui.DisplayWarnings(warnings)
+		case log, ok := <-logStream:
+			if !ok {
+				logStream = nil
+				break
+			}
+			ui.DisplayLogMessage(log, false)
+		case logErr, ok := <-logErrStream:
+			if !ok {
+				logErrStream = nil
+				break
+			}
+			ui.DisplayWarning(logErr.Error())
+		case err := <-errStream:
+			return err
+		}
+	}
+}
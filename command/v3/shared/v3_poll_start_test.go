@@ -0,0 +1,91 @@
+package shared_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("V3PollStart", func() {
+	var (
+		executeErr   error
+		testUI       *ui.UI
+		fakeActor    *sharedfakes.FakeV3PollStartActor
+		logStream    chan *v3action.LogMessage
+		logErrStream chan error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(sharedfakes.FakeV3PollStartActor)
+		logStream = make(chan *v3action.LogMessage)
+		logErrStream = make(chan error)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = PollStart(fakeActor, "some-app-guid", 2*time.Minute, logStream, logErrStream, testUI)
+	})
+
+	Context("while the application is starting", func() {
+		BeforeEach(func() {
+			fakeActor.PollStartStub = func(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error {
+				logStream <- v3action.NewLogMessage("some-log-message", 1, time.Now(), "APP", "0")
+				logErrStream <- errors.New("some-log-error")
+				warnings <- v3action.Warnings{"some-poll-warning"}
+				return nil
+			}
+		})
+
+		It("polls the given application", func() {
+			Expect(fakeActor.PollStartCallCount()).To(Equal(1))
+			appGUID, startupTimeout, _ := fakeActor.PollStartArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(startupTimeout).To(Equal(2 * time.Minute))
+		})
+
+		It("displays log messages, log errors and warnings as they arrive", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("some-log-message"))
+			Expect(testUI.Err).To(Say("some-log-error"))
+			Expect(testUI.Err).To(Say("some-poll-warning"))
+		})
+	})
+
+	Context("when the log streams are closed before the application starts", func() {
+		BeforeEach(func() {
+			fakeActor.PollStartStub = func(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error {
+				close(logStream)
+				close(logErrStream)
+				warnings <- v3action.Warnings{"some-poll-warning"}
+				return nil
+			}
+		})
+
+		It("keeps waiting for the application to start", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("some-poll-warning"))
+		})
+	})
+
+	Context("when polling fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = v3action.StartupTimeoutError{}
+			fakeActor.PollStartReturns(expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+})
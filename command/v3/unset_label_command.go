@@ -0,0 +1,107 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . UnsetLabelActor
+
+type UnsetLabelActor interface {
+	CloudControllerAPIVersion() string
+	UpdateApplicationLabelsByApplicationName(appName string, spaceGUID string, labels map[string]types.NullString) (v3action.Warnings, error)
+	UpdateOrganizationLabelsByOrganizationName(orgName string, labels map[string]types.NullString) (v3action.Warnings, error)
+	UpdateSpaceLabelsBySpaceName(spaceName string, orgGUID string, labels map[string]types.NullString) (v3action.Warnings, error)
+}
+
+type UnsetLabelCommand struct {
+	RequiredArgs    flag.UnsetLabelArgs `positional-args:"yes"`
+	usage           interface{}         `usage:"CF_NAME unset-label RESOURCE RESOURCE_NAME KEY...\n\nEXAMPLES:\n   CF_NAME unset-label app dora ci_signature_sha2\n   CF_NAME unset-label org business pci public-facing\n\nRESOURCES:\n   app\n   org\n   space"`
+	relatedCommands interface{}         `related_commands:"labels, set-label"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UnsetLabelActor
+}
+
+func (cmd *UnsetLabelCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd UnsetLabelCommand) Execute(args []string) error {
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionMetadataV3)
+	if err != nil {
+		return err
+	}
+
+	resource, err := parseLabelResource(cmd.RequiredArgs.ResourceType)
+	if err != nil {
+		return err
+	}
+
+	// Labels with unset values are removed by the Cloud Controller.
+	labels := map[string]types.NullString{}
+	for _, key := range cmd.RequiredArgs.LabelKeys {
+		labels[key] = types.NullString{}
+	}
+
+	err = checkLabelResourceTarget(cmd.SharedActor, cmd.Config, resource)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var warnings v3action.Warnings
+
+	switch resource {
+	case appLabelResource:
+		cmd.UI.DisplayTextWithFlavor("Removing label(s) for app {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"ResourceName": cmd.RequiredArgs.ResourceName,
+			"OrgName":      cmd.Config.TargetedOrganization().Name,
+			"SpaceName":    cmd.Config.TargetedSpace().Name,
+			"Username":     user.Name,
+		})
+		warnings, err = cmd.Actor.UpdateApplicationLabelsByApplicationName(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID, labels)
+	case orgLabelResource:
+		cmd.UI.DisplayTextWithFlavor("Removing label(s) for org {{.ResourceName}} as {{.Username}}...", map[string]interface{}{
+			"ResourceName": cmd.RequiredArgs.ResourceName,
+			"Username":     user.Name,
+		})
+		warnings, err = cmd.Actor.UpdateOrganizationLabelsByOrganizationName(cmd.RequiredArgs.ResourceName, labels)
+	case spaceLabelResource:
+		cmd.UI.DisplayTextWithFlavor("Removing label(s) for space {{.ResourceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"ResourceName": cmd.RequiredArgs.ResourceName,
+			"OrgName":      cmd.Config.TargetedOrganization().Name,
+			"Username":     user.Name,
+		})
+		warnings, err = cmd.Actor.UpdateSpaceLabelsBySpaceName(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedOrganization().GUID, labels)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package v3_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unset-label Command", func() {
+	var (
+		cmd             v3.UnsetLabelCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeUnsetLabelActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeUnsetLabelActor)
+
+		cmd = v3.UnsetLabelCommand{
+			RequiredArgs: flag.UnsetLabelArgs{
+				ResourceType: "app",
+				ResourceName: "some-app",
+				LabelKeys:    []string{"env", "tier"},
+			},
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionMetadataV3)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: version.MinVersionV3,
+				MinimumVersion: version.MinVersionMetadataV3,
+			}))
+		})
+	})
+
+	Context("when the resource type is not supported", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "route"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "RESOURCE",
+				ExpectedType: "one of app, org or space",
+			}))
+		})
+	})
+
+	Context("when removing app labels", func() {
+		Context("when the update succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationLabelsByApplicationNameReturns(v3action.Warnings{"some-warning"}, nil)
+			})
+
+			It("removes the labels from the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Removing label\(s\) for app some-app in org some-org / space some-space as steve\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				appName, spaceGUID, labels := fakeActor.UpdateApplicationLabelsByApplicationNameArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(labels).To(Equal(map[string]types.NullString{
+					"env":  {},
+					"tier": {},
+				}))
+			})
+		})
+
+		Context("when the update fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationLabelsByApplicationNameReturns(v3action.Warnings{"some-warning"}, v3action.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns the translated error and warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+	})
+
+	Context("when removing org labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "org"
+			cmd.RequiredArgs.ResourceName = "some-other-org"
+		})
+
+		It("removes the labels from the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Removing label\(s\) for org some-other-org as steve\.\.\.`))
+
+			orgName, labels := fakeActor.UpdateOrganizationLabelsByOrganizationNameArgsForCall(0)
+			Expect(orgName).To(Equal("some-other-org"))
+			Expect(labels).To(HaveKeyWithValue("env", types.NullString{}))
+		})
+	})
+
+	Context("when removing space labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "space"
+			cmd.RequiredArgs.ResourceName = "some-other-space"
+		})
+
+		It("removes the labels from the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Removing label\(s\) for space some-other-space in org some-org as steve\.\.\.`))
+
+			spaceName, orgGUID, _ := fakeActor.UpdateSpaceLabelsBySpaceNameArgsForCall(0)
+			Expect(spaceName).To(Equal("some-other-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
+})
@@ -0,0 +1,104 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . UnshareServiceActor
+
+type UnshareServiceActor interface {
+	CloudControllerAPIVersion() string
+	GetOrganizationByName(orgName string) (v3action.Organization, v3action.Warnings, error)
+	UnshareServiceInstanceFromSpace(serviceInstanceName string, sourceSpaceGUID string, sharedToOrgGUID string, sharedToSpaceName string) (v3action.Warnings, error)
+}
+
+type UnshareServiceCommand struct {
+	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
+	SpaceName       string               `short:"s" required:"true" description:"Space to unshare the service instance from"`
+	OrgName         string               `short:"o" required:"false" description:"Org of the other space (Default: targeted org)"`
+	Force           bool                 `short:"f" description:"Force unshare without confirmation"`
+	usage           interface{}          `usage:"CF_NAME unshare-service SERVICE_INSTANCE -s OTHER_SPACE [-o OTHER_ORG] [-f]"`
+	relatedCommands interface{}          `related_commands:"delete-service, service, services, share-service, unbind-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UnshareServiceActor
+}
+
+func (cmd *UnshareServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd UnshareServiceCommand) Execute(args []string) error {
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionShareServiceV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	orgName, orgGUID, err := sharedToOrg(cmd.OrgName, cmd.Config, cmd.UI, cmd.Actor)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		cmd.UI.DisplayWarning("WARNING: Unsharing this service instance will remove any service bindings that exist in any spaces that this instance is shared into. This could cause applications to stop working.")
+		cmd.UI.DisplayNewline()
+
+		unshare, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really unshare the service instance {{.ServiceInstanceName}} from org {{.OrgName}} / space {{.SpaceName}}?", map[string]interface{}{
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			"OrgName":             orgName,
+			"SpaceName":           cmd.SpaceName,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !unshare {
+			cmd.UI.DisplayText("Unshare cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Unsharing service instance {{.ServiceInstanceName}} from org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":             orgName,
+		"SpaceName":           cmd.SpaceName,
+		"Username":            user.Name,
+	})
+
+	warnings, err := cmd.Actor.UnshareServiceInstanceFromSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID, orgGUID, cmd.SpaceName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unshare-service Command", func() {
+	var (
+		cmd             v3.UnshareServiceCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeUnshareServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeUnshareServiceActor)
+
+		cmd = v3.UnshareServiceCommand{
+			SpaceName: "some-other-space",
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionShareServiceV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: version.MinVersionShareServiceV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user declines the prompt", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not unshare the service instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("WARNING: Unsharing this service instance will remove any service bindings that exist in any spaces that this instance is shared into. This could cause applications to stop working."))
+			Expect(testUI.Out).To(Say("Really unshare the service instance some-service-instance from org some-org / space some-other-space\\?"))
+			Expect(testUI.Out).To(Say("Unshare cancelled"))
+			Expect(fakeActor.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the user confirms the prompt", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+			fakeActor.UnshareServiceInstanceFromSpaceReturns(v3action.Warnings{"unshare-warning"}, nil)
+		})
+
+		It("unshares the service instance from the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Unsharing service instance some-service-instance from org some-org / space some-other-space as banana..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("unshare-warning"))
+
+			Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+			Expect(fakeActor.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(1))
+			serviceInstanceName, sourceSpaceGUID, orgGUID, spaceName := fakeActor.UnshareServiceInstanceFromSpaceArgsForCall(0)
+			Expect(serviceInstanceName).To(Equal("some-service-instance"))
+			Expect(sourceSpaceGUID).To(Equal("some-space-guid"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("some-other-space"))
+		})
+	})
+
+	Context("when the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("unshares without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Really unshare"))
+			Expect(testUI.Err).ToNot(Say("WARNING"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(1))
+		})
+
+		Context("when the -o flag is provided", func() {
+			BeforeEach(func() {
+				cmd.OrgName = "some-other-org"
+				fakeActor.GetOrganizationByNameReturns(
+					v3action.Organization{GUID: "some-other-org-guid", Name: "some-other-org"},
+					v3action.Warnings{"get-org-warning"},
+					nil,
+				)
+			})
+
+			It("unshares the service instance from the space in the given org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Unsharing service instance some-service-instance from org some-other-org / space some-other-space as banana..."))
+				Expect(testUI.Err).To(Say("get-org-warning"))
+
+				Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-other-org"))
+				_, _, orgGUID, _ := fakeActor.UnshareServiceInstanceFromSpaceArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-other-org-guid"))
+			})
+
+			Context("when the org does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.GetOrganizationByNameReturns(v3action.Organization{}, v3action.Warnings{"get-org-warning"}, v3action.OrganizationNotFoundError{Name: "some-other-org"})
+				})
+
+				It("returns an OrganizationNotFoundError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-other-org"}))
+					Expect(testUI.Err).To(Say("get-org-warning"))
+					Expect(fakeActor.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.UnshareServiceInstanceFromSpaceReturns(v3action.Warnings{"unshare-warning"}, v3action.ServiceInstanceNotFoundError{Name: "some-service-instance"})
+			})
+
+			It("returns a ServiceInstanceNotFoundError and displays warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(testUI.Err).To(Say("unshare-warning"))
+			})
+		})
+
+		Context("when unsharing fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("unshare error")
+				fakeActor.UnshareServiceInstanceFromSpaceReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
@@ -40,7 +40,7 @@ func (cmd *V3AppCommand) Setup(config command.Config, ui command.UI) error {
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(ccClient, config)
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
 
 	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -246,6 +247,30 @@ var _ = Describe("v3-app Command", func() {
 		})
 	})
 
+	Context("when app has not been staged but has a stack in its lifecycle", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSummaryByNameAndSpaceReturns(
+				v3action.ApplicationSummary{
+					Application: v3action.Application{
+						GUID:  "some-guid",
+						Name:  "some-app",
+						State: "STOPPED",
+						Lifecycle: v3action.AppLifecycle{
+							Type: v3action.BuildpackAppLifecycleType,
+							Data: v3action.AppLifecycleData{Stack: "cflinuxfs3"},
+						},
+					},
+				},
+				nil,
+				nil)
+		})
+
+		It("displays the stack from the lifecycle", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("stack:\\s+cflinuxfs3"))
+		})
+	})
+
 	Context("when app has processes", func() {
 		Context("when getting routes returns an error", func() {
 			var expectedErr error
@@ -440,7 +465,10 @@ var _ = Describe("v3-app Command", func() {
 							{
 								Process: v3action.Process{
 									Type:       "worker",
-									MemoryInMB: types.NullUint64{Value: 64, IsSet: true},
+									MemoryInMB: types.NullUint64{Value: 1024, IsSet: true},
+									HealthCheck: ccv3.ProcessHealthCheck{
+										Type: "process",
+									},
 								},
 								InstanceDetails: []v3action.Instance{
 									v3action.Instance{
@@ -458,6 +486,13 @@ var _ = Describe("v3-app Command", func() {
 								Process: v3action.Process{
 									Type:       "web",
 									MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
+									HealthCheck: ccv3.ProcessHealthCheck{
+										Type: "http",
+										Data: ccv3.ProcessHealthCheckData{
+											Endpoint:          "/health",
+											InvocationTimeout: types.NullInt{Value: 10, IsSet: true},
+										},
+									},
 								},
 								InstanceDetails: []v3action.Instance{
 									v3action.Instance{
@@ -501,11 +536,12 @@ var _ = Describe("v3-app Command", func() {
 					Expect(testUI.Out).To(Say("name:\\s+some-app"))
 					Expect(testUI.Out).To(Say("requested state:\\s+started"))
 					Expect(testUI.Out).To(Say("processes:\\s+web:3/3, console:0/0, worker:0/1"))
-					Expect(testUI.Out).To(Say("memory usage:\\s+32M x 3, 64M x 1"))
+					Expect(testUI.Out).To(Say("memory usage:\\s+32M x 3, 1G x 1"))
 					Expect(testUI.Out).To(Say("routes:\\s+some-other-domain, some-domain"))
 					Expect(testUI.Out).To(Say("stack:\\s+cflinuxfs2"))
 					Expect(testUI.Out).To(Say("(?m)buildpacks:\\s+some-detect-output, some-buildpack\n\n"))
 					Expect(testUI.Out).To(Say("web:3/3"))
+					Expect(testUI.Out).To(Say("health check: http /health \\(invocation timeout: 10s\\)"))
 					Expect(testUI.Out).To(Say("\\s+state\\s+since\\s+cpu\\s+memory\\s+disk"))
 					Expect(testUI.Out).To(Say("#0\\s+running\\s+1978-\\d{2}-\\d{2} \\d{2}:\\d{2}:\\d{2} [AP]M\\s+0.0%\\s+976.6K of 32M\\s+976.6K of 1.9M"))
 					Expect(testUI.Out).To(Say("#1\\s+running\\s+1980-\\d{2}-\\d{2} \\d{2}:\\d{2}:\\d{2} [AP]M\\s+0.0%\\s+1.9M of 32M\\s+1.9M of 3.8M"))
@@ -514,6 +550,7 @@ var _ = Describe("v3-app Command", func() {
 					Expect(testUI.Out).To(Say("console:0/0"))
 
 					Expect(testUI.Out).To(Say("worker:0/1"))
+					Expect(testUI.Out).To(Say("health check: process"))
 
 					Expect(testUI.Err).To(Say("warning-1"))
 					Expect(testUI.Err).To(Say("warning-2"))
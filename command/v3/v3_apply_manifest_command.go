@@ -0,0 +1,79 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3ApplyManifestActor
+
+type V3ApplyManifestActor interface {
+	CloudControllerAPIVersion() string
+	ApplyManifest(spaceGUID string, manifestPath string) (v3action.Warnings, error)
+}
+
+type V3ApplyManifestCommand struct {
+	PathToManifest  flag.PathWithExistenceCheck `short:"f" description:"Path to app manifest" required:"true"`
+	usage           interface{}                 `usage:"CF_NAME v3-apply-manifest -f APP_MANIFEST_PATH"`
+	relatedCommands interface{}                 `related_commands:"v3-push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3ApplyManifestActor
+}
+
+func (cmd *V3ApplyManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd V3ApplyManifestCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying manifest {{.ManifestPath}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ManifestPath": string(cmd.PathToManifest),
+		"OrgName":      cmd.Config.TargetedOrganization().Name,
+		"SpaceName":    cmd.Config.TargetedSpace().Name,
+		"Username":     user.Name,
+	})
+
+	warnings, err := cmd.Actor.ApplyManifest(cmd.Config.TargetedSpace().GUID, string(cmd.PathToManifest))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
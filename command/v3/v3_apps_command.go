@@ -16,11 +16,12 @@ import (
 
 type V3AppsActor interface {
 	CloudControllerAPIVersion() string
-	GetApplicationSummariesBySpace(spaceGUID string) ([]v3action.ApplicationSummary, v3action.Warnings, error)
+	GetApplicationSummariesBySpace(spaceGUID string, labelSelector string) ([]v3action.ApplicationSummary, v3action.Warnings, error)
 }
 
 type V3AppsCommand struct {
-	usage interface{} `usage:"CF_NAME v3-apps"`
+	Labels string      `long:"labels" description:"Selector to filter apps by labels, e.g. env=prod,tier!=backend"`
+	usage  interface{} `usage:"CF_NAME v3-apps [--labels SELECTOR]"`
 
 	UI              command.UI
 	Config          command.Config
@@ -38,7 +39,7 @@ func (cmd *V3AppsCommand) Setup(config command.Config, ui command.UI) error {
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(ccClient, config)
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
 
 	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
 	if err != nil {
@@ -56,6 +57,13 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Labels != "" {
+		err = version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionMetadataV3, "Option '--labels'")
+		if err != nil {
+			return err
+		}
+	}
+
 	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -73,7 +81,7 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	summaries, warnings, err := cmd.Actor.GetApplicationSummariesBySpace(cmd.Config.TargetedSpace().GUID)
+	summaries, warnings, err := cmd.Actor.GetApplicationSummariesBySpace(cmd.Config.TargetedSpace().GUID, cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
@@ -80,6 +80,21 @@ var _ = Describe("v3-apps Command", func() {
 		})
 	})
 
+	Context("when the --labels option is provided and the API version is below the minimum for labels", func() {
+		BeforeEach(func() {
+			cmd.Labels = "env=prod"
+			fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '--labels'",
+				CurrentVersion: version.MinVersionV3,
+				MinimumVersion: version.MinVersionMetadataV3,
+			}))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: binaryName})
@@ -279,8 +294,9 @@ var _ = Describe("v3-apps Command", func() {
 				Expect(testUI.Err).To(Say("route-warning-4"))
 
 				Expect(fakeActor.GetApplicationSummariesBySpaceCallCount()).To(Equal(1))
-				spaceGUID := fakeActor.GetApplicationSummariesBySpaceArgsForCall(0)
+				spaceGUID, labelSelector := fakeActor.GetApplicationSummariesBySpaceArgsForCall(0)
 				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(labelSelector).To(BeEmpty())
 
 				Expect(fakeV2Actor.GetApplicationRoutesCallCount()).To(Equal(2))
 				appGUID := fakeV2Actor.GetApplicationRoutesArgsForCall(0)
@@ -315,13 +331,31 @@ var _ = Describe("v3-apps Command", func() {
 				Expect(testUI.Err).To(Say("warning"))
 
 				Expect(fakeActor.GetApplicationSummariesBySpaceCallCount()).To(Equal(1))
-				spaceGUID := fakeActor.GetApplicationSummariesBySpaceArgsForCall(0)
+				spaceGUID, labelSelector := fakeActor.GetApplicationSummariesBySpaceArgsForCall(0)
 				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(labelSelector).To(BeEmpty())
 
 				Expect(fakeV2Actor.GetApplicationRoutesCallCount()).To(Equal(0))
 			})
 		})
 
+		Context("when the --labels option is provided", func() {
+			BeforeEach(func() {
+				cmd.Labels = "env=prod"
+				fakeActor.CloudControllerAPIVersionReturns(version.MinVersionMetadataV3)
+				fakeActor.GetApplicationSummariesBySpaceReturns([]v3action.ApplicationSummary{}, nil, nil)
+			})
+
+			It("filters the apps by the label selector", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetApplicationSummariesBySpaceCallCount()).To(Equal(1))
+				spaceGUID, labelSelector := fakeActor.GetApplicationSummariesBySpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(labelSelector).To(Equal("env=prod"))
+			})
+		})
+
 		Context("with no apps", func() {
 			BeforeEach(func() {
 				fakeActor.GetApplicationSummariesBySpaceReturns([]v3action.ApplicationSummary{}, v3action.Warnings{"warning-1", "warning-2"}, nil)
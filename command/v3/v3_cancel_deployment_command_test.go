@@ -0,0 +1,148 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-cancel-deployment Command", func() {
+	var (
+		cmd             v3.V3CancelDeploymentCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3CancelDeploymentActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3CancelDeploymentActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.V3CancelDeploymentCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionDeploymentsV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Out).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: version.MinVersionV3,
+				MinimumVersion: version.MinVersionDeploymentsV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		})
+
+		Context("when the app has an active deployment", func() {
+			BeforeEach(func() {
+				fakeActor.GetActiveDeploymentByApplicationNameAndSpaceReturns(v3action.Deployment{GUID: "some-deployment-guid"}, v3action.Warnings{"get-warning"}, nil)
+				fakeActor.CancelDeploymentReturns(v3action.Warnings{"cancel-warning"}, nil)
+			})
+
+			It("cancels the deployment and displays all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Canceling deployment for app some-app in org some-org / space some-space as steve\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("get-warning"))
+				Expect(testUI.Err).To(Say("cancel-warning"))
+
+				Expect(fakeActor.GetActiveDeploymentByApplicationNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID := fakeActor.GetActiveDeploymentByApplicationNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeActor.CancelDeploymentCallCount()).To(Equal(1))
+				Expect(fakeActor.CancelDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		Context("when the app has no active deployment", func() {
+			BeforeEach(func() {
+				fakeActor.GetActiveDeploymentByApplicationNameAndSpaceReturns(v3action.Deployment{}, v3action.Warnings{"get-warning"}, v3action.ActiveDeploymentNotFoundError{AppName: "some-app"})
+			})
+
+			It("returns an ActiveDeploymentNotFoundError and displays warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ActiveDeploymentNotFoundError{AppName: "some-app"}))
+				Expect(testUI.Err).To(Say("get-warning"))
+				Expect(fakeActor.CancelDeploymentCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when canceling the deployment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("cancel error")
+				fakeActor.GetActiveDeploymentByApplicationNameAndSpaceReturns(v3action.Deployment{GUID: "some-deployment-guid"}, v3action.Warnings{"get-warning"}, nil)
+				fakeActor.CancelDeploymentReturns(v3action.Warnings{"cancel-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("get-warning"))
+				Expect(testUI.Err).To(Say("cancel-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,112 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3CopySourceActor
+
+type V3CopySourceActor interface {
+	CloudControllerAPIVersion() string
+	CopyPackage(sourceAppName string, targetAppName string, spaceGUID string) (v3action.Package, v3action.Warnings, error)
+	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error)
+	RestageApplication(appName string, spaceGUID string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error)
+}
+
+type V3CopySourceCommand struct {
+	RequiredArgs        flag.CopySourceArgs `positional-args:"yes"`
+	NoRestart           bool                `long:"no-restart" description:"Do not stage and restart the target app after copying the source"`
+	usage               interface{}         `usage:"CF_NAME v3-copy-source SOURCE_APP TARGET_APP [--no-restart]"`
+	relatedCommands     interface{}         `related_commands:"v3-apps, v3-packages, v3-push, v3-restage"`
+	envCFStagingTimeout interface{}         `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+
+	UI          command.UI
+	Config      command.Config
+	NOAAClient  v3action.NOAAClient
+	SharedActor command.SharedActor
+	Actor       V3CopySourceActor
+}
+
+func (cmd *V3CopySourceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.APIInfo.Logging(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cmd V3CopySourceCommand) Execute(args []string) error {
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Copying source from app {{.SourceApp}} to target app {{.TargetApp}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"SourceApp": cmd.RequiredArgs.SourceAppName,
+		"TargetApp": cmd.RequiredArgs.TargetAppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	_, warnings, err := cmd.Actor.CopyPackage(cmd.RequiredArgs.SourceAppName, cmd.RequiredArgs.TargetAppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	if cmd.NoRestart {
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Restaging app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.TargetAppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	logStream, logErrStream, logWarnings, logErr := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(cmd.RequiredArgs.TargetAppName, cmd.Config.TargetedSpace().GUID, cmd.NOAAClient)
+	cmd.UI.DisplayWarnings(logWarnings)
+	if logErr != nil {
+		return shared.HandleError(logErr)
+	}
+
+	dropletStream, warningsStream, errStream := cmd.Actor.RestageApplication(cmd.RequiredArgs.TargetAppName, cmd.Config.TargetedSpace().GUID)
+	_, err = shared.PollStage(dropletStream, warningsStream, errStream, logStream, logErrStream, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
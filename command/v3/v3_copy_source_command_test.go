@@ -0,0 +1,199 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-copy-source Command", func() {
+	var (
+		cmd             v3.V3CopySourceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3CopySourceActor
+		fakeNOAAClient  *v3actionfakes.FakeNOAAClient
+
+		binaryName     string
+		executeErr     error
+		allLogsWritten chan bool
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3CopySourceActor)
+		fakeNOAAClient = new(v3actionfakes.FakeNOAAClient)
+		allLogsWritten = make(chan bool)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.V3CopySourceCommand{
+			RequiredArgs: flag.CopySourceArgs{SourceAppName: "source-app", TargetAppName: "target-app"},
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			NOAAClient:  fakeNOAAClient,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.CopyPackageReturns(
+			v3action.Package{GUID: "copied-package-guid"},
+			v3action.Warnings{"copy-warning"},
+			nil)
+		fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(appName string, spaceGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error) {
+			logStream := make(chan *v3action.LogMessage)
+			errorStream := make(chan error)
+
+			go func() {
+				logStream <- v3action.NewLogMessage("Here are some staging logs!", 1, time.Now(), v3action.StagingLog, "sourceInstance")
+				allLogsWritten <- true
+			}()
+
+			return logStream, errorStream, v3action.Warnings{"log-warning"}, nil
+		}
+		fakeActor.RestageApplicationStub = func(_ string, _ string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+			dropletStream := make(chan v3action.Droplet)
+			warningsStream := make(chan v3action.Warnings)
+			errorStream := make(chan error)
+
+			go func() {
+				<-allLogsWritten
+				defer close(dropletStream)
+				defer close(warningsStream)
+				defer close(errorStream)
+				warningsStream <- v3action.Warnings{"restage-warning"}
+				dropletStream <- v3action.Droplet{GUID: "some-droplet-guid", State: v3action.DropletStateStaged}
+			}()
+
+			return dropletStream, warningsStream, errorStream
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: version.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when copying the package fails", func() {
+		BeforeEach(func() {
+			fakeActor.CopyPackageReturns(
+				v3action.Package{},
+				v3action.Warnings{"copy-warning"},
+				v3action.ReadyPackageNotFoundError{AppName: "source-app"})
+		})
+
+		It("returns the translated error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ReadyPackageNotFoundError{AppName: "source-app"}))
+			Expect(testUI.Err).To(Say("copy-warning"))
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the copy succeeds", func() {
+		It("copies the source, restages the target app and displays warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Copying source from app source-app to target app target-app in org some-org / space some-space as steve\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Restaging app target-app in org some-org / space some-space as steve\\.\\.\\."))
+			Expect(testUI.Out).To(Say("Here are some staging logs!"))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(testUI.Err).To(Say("copy-warning"))
+			Expect(testUI.Err).To(Say("log-warning"))
+			Expect(testUI.Err).To(Say("restage-warning"))
+
+			Expect(fakeActor.CopyPackageCallCount()).To(Equal(1))
+			sourceAppName, targetAppName, spaceGUID := fakeActor.CopyPackageArgsForCall(0)
+			Expect(sourceAppName).To(Equal("source-app"))
+			Expect(targetAppName).To(Equal("target-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.RestageApplicationArgsForCall(0)
+			Expect(appName).To(Equal("target-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+
+		Context("when --no-restart is provided", func() {
+			BeforeEach(func() {
+				cmd.NoRestart = true
+			})
+
+			It("does not restage the target app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Copying source from app source-app to target app target-app"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).ToNot(Say("Restaging app"))
+
+				Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				Expect(fakeActor.RestageApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,95 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3DeleteDropletActor
+
+type V3DeleteDropletActor interface {
+	CloudControllerAPIVersion() string
+	DeleteDroplet(dropletGUID string) (v3action.Warnings, error)
+}
+
+type V3DeleteDropletCommand struct {
+	RequiredArgs    flag.DropletGUID `positional-args:"yes"`
+	Force           bool             `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}      `usage:"CF_NAME v3-delete-droplet DROPLET_GUID [-f]"`
+	relatedCommands interface{}      `related_commands:"v3-droplets, v3-set-droplet"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3DeleteDropletActor
+}
+
+func (cmd *V3DeleteDropletCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd V3DeleteDropletCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !cmd.Force {
+		deleteDroplet, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the droplet {{.DropletGUID}}?", map[string]interface{}{
+			"DropletGUID": cmd.RequiredArgs.DropletGUID,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteDroplet {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting droplet {{.DropletGUID}} as {{.Username}}...", map[string]interface{}{
+		"DropletGUID": cmd.RequiredArgs.DropletGUID,
+		"Username":    user.Name,
+	})
+
+	warnings, err := cmd.Actor.DeleteDroplet(cmd.RequiredArgs.DropletGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(v3action.DropletNotFoundError); ok {
+		cmd.UI.DisplayWarning("Droplet {{.DropletGUID}} does not exist.", map[string]interface{}{
+			"DropletGUID": cmd.RequiredArgs.DropletGUID,
+		})
+	} else if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
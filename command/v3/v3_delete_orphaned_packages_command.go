@@ -0,0 +1,186 @@
+package v3
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3DeleteOrphanedPackagesActor
+
+type V3DeleteOrphanedPackagesActor interface {
+	CloudControllerAPIVersion() string
+	DeleteDroplet(dropletGUID string) (v3action.Warnings, error)
+	DeletePackage(packageGUID string) (v3action.Warnings, error)
+	GetOrphanedPackagesAndDropletsBySpace(spaceGUID string) (v3action.OrphanedPackagesAndDroplets, v3action.Warnings, error)
+}
+
+type V3DeleteOrphanedPackagesCommand struct {
+	DryRun          bool        `long:"dry-run" description:"List the orphaned packages and droplets without deleting them"`
+	Force           bool        `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{} `usage:"CF_NAME v3-delete-orphaned-packages [--dry-run] [-f]\n\nTIP: A droplet is in use when it is the current droplet of an app, and a package is in use when the current droplet of an app was staged from it. Packages and droplets that are still being uploaded or staged are never deleted."`
+	relatedCommands interface{} `related_commands:"v3-delete-droplet, v3-droplets, v3-packages"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3DeleteOrphanedPackagesActor
+}
+
+func (cmd *V3DeleteOrphanedPackagesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd V3DeleteOrphanedPackagesCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting orphaned packages and droplets in org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...", map[string]interface{}{
+		"CurrentSpace": cmd.Config.TargetedSpace().Name,
+		"CurrentOrg":   cmd.Config.TargetedOrganization().Name,
+		"CurrentUser":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	orphaned, warnings, err := cmd.Actor.GetOrphanedPackagesAndDropletsBySpace(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(orphaned.Packages) == 0 && len(orphaned.Droplets) == 0 {
+		cmd.UI.DisplayText("No orphaned packages or droplets found")
+		return nil
+	}
+
+	err = cmd.displayOrphanedTable(orphaned)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+
+	if cmd.DryRun {
+		return nil
+	}
+
+	if !cmd.Force {
+		deleteOrphaned, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete these orphaned packages and droplets?")
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteOrphaned {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	for _, droplet := range orphaned.Droplets {
+		cmd.UI.DisplayText("Deleting droplet {{.DropletGUID}}...", map[string]interface{}{
+			"DropletGUID": droplet.GUID,
+		})
+
+		warnings, err = cmd.Actor.DeleteDroplet(droplet.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if _, ok := err.(v3action.DropletNotFoundError); ok {
+			cmd.UI.DisplayWarning("Droplet {{.DropletGUID}} does not exist.", map[string]interface{}{
+				"DropletGUID": droplet.GUID,
+			})
+		} else if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	for _, pkg := range orphaned.Packages {
+		cmd.UI.DisplayText("Deleting package {{.PackageGUID}}...", map[string]interface{}{
+			"PackageGUID": pkg.GUID,
+		})
+
+		warnings, err = cmd.Actor.DeletePackage(pkg.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if _, ok := err.(v3action.PackageNotFoundError); ok {
+			cmd.UI.DisplayWarning("Package {{.PackageGUID}} does not exist.", map[string]interface{}{
+				"PackageGUID": pkg.GUID,
+			})
+		} else if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd V3DeleteOrphanedPackagesCommand) displayOrphanedTable(orphaned v3action.OrphanedPackagesAndDroplets) error {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("type"),
+			cmd.UI.TranslateText("guid"),
+			cmd.UI.TranslateText("state"),
+			cmd.UI.TranslateText("created"),
+		},
+	}
+
+	for _, droplet := range orphaned.Droplets {
+		t, err := time.Parse(time.RFC3339, droplet.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		table = append(table, []string{
+			cmd.UI.TranslateText("droplet"),
+			droplet.GUID,
+			cmd.UI.TranslateText(strings.ToLower(string(droplet.State))),
+			cmd.UI.UserFriendlyDate(t),
+		})
+	}
+
+	for _, pkg := range orphaned.Packages {
+		t, err := time.Parse(time.RFC3339, pkg.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		table = append(table, []string{
+			cmd.UI.TranslateText("package"),
+			pkg.GUID,
+			cmd.UI.TranslateText(strings.ToLower(string(pkg.State))),
+			cmd.UI.UserFriendlyDate(t),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
@@ -0,0 +1,251 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-delete-orphaned-packages Command", func() {
+	var (
+		cmd             v3.V3DeleteOrphanedPackagesCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3DeleteOrphanedPackagesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3DeleteOrphanedPackagesActor)
+
+		cmd = v3.V3DeleteOrphanedPackagesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Out).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: version.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when getting the orphaned packages and droplets fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.GetOrphanedPackagesAndDropletsBySpaceReturns(v3action.OrphanedPackagesAndDroplets{}, v3action.Warnings{"get-warning"}, expectedErr)
+		})
+
+		It("displays the warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("get-warning"))
+		})
+	})
+
+	Context("when there are no orphaned packages or droplets", func() {
+		It("displays a message and deletes nothing", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting orphaned packages and droplets in org some-org / space some-space as banana..."))
+			Expect(testUI.Out).To(Say("No orphaned packages or droplets found"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+
+			Expect(fakeActor.GetOrphanedPackagesAndDropletsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			Expect(fakeActor.DeleteDropletCallCount()).To(Equal(0))
+			Expect(fakeActor.DeletePackageCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when there are orphaned packages and droplets", func() {
+		var (
+			dropletCreatedAt time.Time
+			packageCreatedAt time.Time
+		)
+
+		BeforeEach(func() {
+			var err error
+			dropletCreatedAt, err = time.Parse(time.RFC3339, "2017-08-16T00:18:24Z")
+			Expect(err).ToNot(HaveOccurred())
+			packageCreatedAt, err = time.Parse(time.RFC3339, "2017-08-14T21:16:12Z")
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeActor.GetOrphanedPackagesAndDropletsBySpaceReturns(
+				v3action.OrphanedPackagesAndDroplets{
+					Droplets: []v3action.Droplet{
+						{GUID: "some-droplet-guid", State: v3action.DropletStateStaged, CreatedAt: "2017-08-16T00:18:24Z"},
+					},
+					Packages: []v3action.Package{
+						{GUID: "some-package-guid", State: "READY", CreatedAt: "2017-08-14T21:16:12Z"},
+					},
+				},
+				v3action.Warnings{"get-warning"},
+				nil,
+			)
+			fakeActor.DeleteDropletReturns(v3action.Warnings{"delete-droplet-warning"}, nil)
+			fakeActor.DeletePackageReturns(v3action.Warnings{"delete-package-warning"}, nil)
+		})
+
+		Context("when --dry-run is provided", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("displays the orphaned packages and droplets without deleting them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("type\\s+guid\\s+state\\s+created"))
+				Expect(testUI.Out).To(Say("droplet\\s+some-droplet-guid\\s+staged\\s+%s", testUI.UserFriendlyDate(dropletCreatedAt)))
+				Expect(testUI.Out).To(Say("package\\s+some-package-guid\\s+ready\\s+%s", testUI.UserFriendlyDate(packageCreatedAt)))
+				Expect(testUI.Out).ToNot(Say("Really delete"))
+				Expect(testUI.Err).To(Say("get-warning"))
+
+				Expect(fakeActor.DeleteDropletCallCount()).To(Equal(0))
+				Expect(fakeActor.DeletePackageCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user declines the prompt", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("displays the report and cancels the delete", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("droplet\\s+some-droplet-guid"))
+				Expect(testUI.Out).To(Say("Really delete these orphaned packages and droplets\\?"))
+				Expect(testUI.Out).To(Say("Delete cancelled"))
+
+				Expect(fakeActor.DeleteDropletCallCount()).To(Equal(0))
+				Expect(fakeActor.DeletePackageCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user confirms the prompt", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("deletes the droplets, then the packages", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Deleting droplet some-droplet-guid..."))
+				Expect(testUI.Out).To(Say("Deleting package some-package-guid..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("get-warning"))
+				Expect(testUI.Err).To(Say("delete-droplet-warning"))
+				Expect(testUI.Err).To(Say("delete-package-warning"))
+
+				Expect(fakeActor.DeleteDropletArgsForCall(0)).To(Equal("some-droplet-guid"))
+				Expect(fakeActor.DeletePackageArgsForCall(0)).To(Equal("some-package-guid"))
+			})
+		})
+
+		Context("when the -f flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			It("deletes without prompting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Really delete"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(fakeActor.DeleteDropletCallCount()).To(Equal(1))
+				Expect(fakeActor.DeletePackageCallCount()).To(Equal(1))
+			})
+
+			Context("when a droplet or package no longer exists", func() {
+				BeforeEach(func() {
+					fakeActor.DeleteDropletReturns(nil, v3action.DropletNotFoundError{GUID: "some-droplet-guid"})
+					fakeActor.DeletePackageReturns(nil, v3action.PackageNotFoundError{GUID: "some-package-guid"})
+				})
+
+				It("displays does not exist warnings and continues", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Err).To(Say("Droplet some-droplet-guid does not exist."))
+					Expect(testUI.Err).To(Say("Package some-package-guid does not exist."))
+					Expect(testUI.Out).To(Say("OK"))
+				})
+			})
+
+			Context("when deleting a droplet fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("delete-droplet-error")
+					fakeActor.DeleteDropletReturns(v3action.Warnings{"delete-droplet-warning"}, expectedErr)
+				})
+
+				It("returns the error without deleting the packages", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("delete-droplet-warning"))
+					Expect(fakeActor.DeletePackageCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,95 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3DeletePackageActor
+
+type V3DeletePackageActor interface {
+	CloudControllerAPIVersion() string
+	DeletePackage(packageGUID string) (v3action.Warnings, error)
+}
+
+type V3DeletePackageCommand struct {
+	RequiredArgs    flag.PackageGUID `positional-args:"yes"`
+	Force           bool             `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}      `usage:"CF_NAME v3-delete-package PACKAGE_GUID [-f]"`
+	relatedCommands interface{}      `related_commands:"v3-packages, v3-delete-orphaned-packages"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3DeletePackageActor
+}
+
+func (cmd *V3DeletePackageCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd V3DeletePackageCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !cmd.Force {
+		deletePackage, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the package {{.PackageGUID}}?", map[string]interface{}{
+			"PackageGUID": cmd.RequiredArgs.PackageGUID,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deletePackage {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting package {{.PackageGUID}} as {{.Username}}...", map[string]interface{}{
+		"PackageGUID": cmd.RequiredArgs.PackageGUID,
+		"Username":    user.Name,
+	})
+
+	warnings, err := cmd.Actor.DeletePackage(cmd.RequiredArgs.PackageGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(v3action.PackageNotFoundError); ok {
+		cmd.UI.DisplayWarning("Package {{.PackageGUID}} does not exist.", map[string]interface{}{
+			"PackageGUID": cmd.RequiredArgs.PackageGUID,
+		})
+	} else if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-delete-package Command", func() {
+	var (
+		cmd             v3.V3DeletePackageCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3DeletePackageActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3DeletePackageActor)
+
+		cmd = v3.V3DeletePackageCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.PackageGUID = "some-package-guid"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Out).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: version.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		Context("when the delete is successful", func() {
+			BeforeEach(func() {
+				fakeActor.DeletePackageReturns(v3action.Warnings{"I am a warning", "I am also a warning"}, nil)
+			})
+
+			It("displays the header and ok", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Deleting package some-package-guid as banana..."))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(testUI.Err).To(Say("I am a warning"))
+				Expect(testUI.Err).To(Say("I am also a warning"))
+
+				Expect(fakeActor.DeletePackageCallCount()).To(Equal(1))
+				Expect(fakeActor.DeletePackageArgsForCall(0)).To(Equal("some-package-guid"))
+			})
+		})
+
+		Context("when the delete is unsuccessful", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("I am an error")
+				fakeActor.DeletePackageReturns(v3action.Warnings{"I am a warning", "I am also a warning"}, expectedErr)
+			})
+
+			It("displays the header and returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+
+				Expect(testUI.Out).To(Say("Deleting package some-package-guid as banana..."))
+
+				Expect(testUI.Err).To(Say("I am a warning"))
+				Expect(testUI.Err).To(Say("I am also a warning"))
+			})
+		})
+
+		Context("when the package does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeletePackageReturns(v3action.Warnings{"I am a warning"}, v3action.PackageNotFoundError{GUID: "some-package-guid"})
+			})
+
+			It("displays a does not exist warning and ok", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("I am a warning"))
+				Expect(testUI.Err).To(Say("Package some-package-guid does not exist."))
+			})
+		})
+	})
+
+	Context("when the -f flag is not provided", func() {
+		Context("when the user chooses the default", func() {
+			BeforeEach(func() {
+				input.Write([]byte("\n"))
+			})
+
+			It("cancels the deletion", func() {
+				Expect(testUI.Out).To(Say("Really delete the package some-package-guid?"))
+				Expect(testUI.Out).To(Say("Delete cancelled"))
+				Expect(fakeActor.DeletePackageCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user inputs yes", func() {
+			BeforeEach(func() {
+				input.Write([]byte("yes\n"))
+			})
+
+			It("deletes the package", func() {
+				Expect(testUI.Out).To(Say("Really delete the package some-package-guid?"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(fakeActor.DeletePackageCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
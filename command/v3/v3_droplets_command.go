@@ -38,7 +38,7 @@ func (cmd *V3DropletsCommand) Setup(config command.Config, ui command.UI) error
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(ccClient, config)
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
 
 	return nil
 }
@@ -0,0 +1,215 @@
+package v3
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3EnvActor
+
+type V3EnvActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationEnvironment(appName string, spaceGUID string) (v3action.Environment, v3action.Warnings, error)
+}
+
+type V3EnvCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Redact          bool         `long:"redact" description:"Replace each value with a SHA-256 hash so the environment can be shared and compared safely"`
+	usage           interface{}  `usage:"CF_NAME v3-env APP_NAME [--redact]"`
+	relatedCommands interface{}  `related_commands:"v3-app, v3-set-env, v3-unset-env, running-environment-variable-group, staging-environment-variable-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3EnvActor
+}
+
+func (cmd *V3EnvCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd V3EnvCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting env variables for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	environment, warnings, err := cmd.Actor.GetApplicationEnvironment(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Redact {
+		environment, err = redactEnvironment(environment)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	err = cmd.displaySystemProvidedEnvironment(environment.System, environment.Application)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+
+	err = cmd.displayEnvironmentGroup("User-Provided:", "No user-defined env variables have been set", environment.EnvironmentVariables)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+
+	err = cmd.displayEnvironmentGroup("Running Environment Variable Groups:", "No running env variables have been set", environment.Running)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+
+	return cmd.displayEnvironmentGroup("Staging Environment Variable Groups:", "No staging env variables have been set", environment.Staging)
+}
+
+func (cmd V3EnvCommand) displaySystemProvidedEnvironment(system map[string]interface{}, application map[string]interface{}) error {
+	if len(system) == 0 && len(application) == 0 {
+		cmd.UI.DisplayText("No system-provided env variables have been set")
+		return nil
+	}
+
+	cmd.UI.DisplayHeader("System-Provided:")
+	for _, env := range []map[string]interface{}{system, application} {
+		if len(env) == 0 {
+			continue
+		}
+
+		jsonEnv, err := json.MarshalIndent(env, "", " ")
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayText("{{.Environment}}", map[string]interface{}{
+			"Environment": string(jsonEnv),
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	return nil
+}
+
+func (cmd V3EnvCommand) displayEnvironmentGroup(header string, emptyMessage string, env map[string]interface{}) error {
+	if len(env) == 0 {
+		cmd.UI.DisplayText(emptyMessage)
+		return nil
+	}
+
+	keys := make([]string, 0, len(env))
+	for key := range env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	cmd.UI.DisplayHeader(header)
+	for _, key := range keys {
+		value, err := formatEnvironmentValue(env[key])
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayText("{{.Name}}: {{.Value}}", map[string]interface{}{
+			"Name":  key,
+			"Value": value,
+		})
+	}
+
+	return nil
+}
+
+// formatEnvironmentValue displays strings as they are and any other JSON
+// type as JSON.
+func formatEnvironmentValue(value interface{}) (string, error) {
+	if str, ok := value.(string); ok {
+		return str, nil
+	}
+
+	jsonValue, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonValue), nil
+}
+
+// redactEnvironment replaces the value of every variable in the environment
+// with the hash of its formatted value. Equal values have equal hashes, so
+// redacted environments can still be compared.
+func redactEnvironment(environment v3action.Environment) (v3action.Environment, error) {
+	var err error
+	for _, env := range []*map[string]interface{}{
+		&environment.System,
+		&environment.Application,
+		&environment.EnvironmentVariables,
+		&environment.Running,
+		&environment.Staging,
+	} {
+		*env, err = redactEnvironmentGroup(*env)
+		if err != nil {
+			return v3action.Environment{}, err
+		}
+	}
+
+	return environment, nil
+}
+
+func redactEnvironmentGroup(env map[string]interface{}) (map[string]interface{}, error) {
+	if env == nil {
+		return nil, nil
+	}
+
+	redacted := make(map[string]interface{}, len(env))
+	for key, value := range env {
+		formattedValue, err := formatEnvironmentValue(value)
+		if err != nil {
+			return nil, err
+		}
+		redacted[key] = fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(formattedValue)))
+	}
+
+	return redacted, nil
+}
@@ -0,0 +1,217 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-env Command", func() {
+	var (
+		cmd             v3.V3EnvCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3EnvActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3EnvActor)
+
+		cmd = v3.V3EnvCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Out).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: version.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when the application does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationEnvironmentReturns(
+				v3action.Environment{},
+				v3action.Warnings{"warning-1", "warning-2"},
+				v3action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns a translatable error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+		})
+	})
+
+	Context("when the environment has variables in every group", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationEnvironmentReturns(
+				v3action.Environment{
+					System: map[string]interface{}{
+						"VCAP_SERVICES": map[string]interface{}{"some-service": []interface{}{}},
+					},
+					Application: map[string]interface{}{
+						"VCAP_APPLICATION": map[string]interface{}{"application_name": "some-app"},
+					},
+					EnvironmentVariables: map[string]interface{}{
+						"SOME_VAR":  "some-value",
+						"JSON_VAR":  map[string]interface{}{"key": []interface{}{"value"}},
+						"FLAG_VAR":  true,
+						"COUNT_VAR": float64(3),
+					},
+					Running: map[string]interface{}{"running-var": "running-value"},
+					Staging: map[string]interface{}{"staging-var": "staging-value"},
+				},
+				v3action.Warnings{"warning-1", "warning-2"},
+				nil)
+		})
+
+		It("displays each group separately and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetApplicationEnvironmentCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationEnvironmentArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say("Getting env variables for app some-app in org some-org / space some-space as steve\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("System-Provided:"))
+			Expect(testUI.Out).To(Say(`"VCAP_SERVICES": {`))
+			Expect(testUI.Out).To(Say(`"VCAP_APPLICATION": {`))
+			Expect(testUI.Out).To(Say(`"application_name": "some-app"`))
+			Expect(testUI.Out).To(Say("User-Provided:"))
+			Expect(testUI.Out).To(Say("COUNT_VAR: 3"))
+			Expect(testUI.Out).To(Say("FLAG_VAR: true"))
+			Expect(testUI.Out).To(Say(`JSON_VAR: {"key":\["value"\]}`))
+			Expect(testUI.Out).To(Say("SOME_VAR: some-value"))
+			Expect(testUI.Out).To(Say("Running Environment Variable Groups:"))
+			Expect(testUI.Out).To(Say("running-var: running-value"))
+			Expect(testUI.Out).To(Say("Staging Environment Variable Groups:"))
+			Expect(testUI.Out).To(Say("staging-var: staging-value"))
+
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+		})
+	})
+
+	Context("when the --redact flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Redact = true
+			fakeActor.GetApplicationEnvironmentReturns(
+				v3action.Environment{
+					System: map[string]interface{}{
+						"VCAP_SERVICES": map[string]interface{}{"some-service": []interface{}{}},
+					},
+					EnvironmentVariables: map[string]interface{}{
+						"SOME_VAR":  "some-value",
+						"COUNT_VAR": float64(3),
+					},
+					Running: map[string]interface{}{"running-var": "some-value"},
+				},
+				nil,
+				nil)
+		})
+
+		It("displays the hash of each value instead of the value", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("System-Provided:"))
+			Expect(testUI.Out).To(Say(`"VCAP_SERVICES": "sha256:a22ffd43e677b009eeea76a79c52864b21b518324b3eb3f6d0b262185402095f"`))
+			Expect(testUI.Out).To(Say("User-Provided:"))
+			Expect(testUI.Out).To(Say("COUNT_VAR: sha256:4e07408562bedb8b60ce05c1decfe3ad16b72230967de01f640b7e4729b49fce"))
+			Expect(testUI.Out).To(Say("SOME_VAR: sha256:700f3c597d9a0db5fc2dcc41c8d9b650d64ba0ed979dc00f1e3dea17fca07a1f"))
+			Expect(testUI.Out).To(Say("Running Environment Variable Groups:"))
+			Expect(testUI.Out).To(Say("running-var: sha256:700f3c597d9a0db5fc2dcc41c8d9b650d64ba0ed979dc00f1e3dea17fca07a1f"))
+			Expect(testUI.Out).To(Say("No staging env variables have been set"))
+			Expect(testUI.Out).ToNot(Say("some-value"))
+		})
+	})
+
+	Context("when the environment is empty", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationEnvironmentReturns(v3action.Environment{}, nil, nil)
+		})
+
+		It("displays that no variables have been set", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("No system-provided env variables have been set"))
+			Expect(testUI.Out).To(Say("No user-defined env variables have been set"))
+			Expect(testUI.Out).To(Say("No running env variables have been set"))
+			Expect(testUI.Out).To(Say("No staging env variables have been set"))
+		})
+	})
+})
@@ -0,0 +1,154 @@
+package v3
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3FilesActor
+
+type V3FilesActor interface {
+	CloudControllerAPIVersion() string
+	RunSSHCommand(client v3action.SSHClient, appName string, spaceGUID string, processType string, processIndex int, command string) ([]byte, v3action.Warnings, error)
+}
+
+type V3FilesCommand struct {
+	RequiredArgs       flag.FilesArgs `positional-args:"yes"`
+	ProcessType        string         `long:"process" default:"web" description:"Process to read the files of"`
+	ProcessIndex       int            `long:"app-instance-index" short:"i" description:"Process instance index (Default: 0)"`
+	Download           string         `long:"download" short:"d" description:"Download the file at PATH to LOCAL_FILE instead of listing it"`
+	SkipHostValidation bool           `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
+	usage              interface{}    `usage:"CF_NAME v3-files APP_NAME [PATH] [--process PROCESS] [-i INDEX] [--download LOCAL_FILE] [--skip-host-validation]\n\nEXAMPLES:\n   CF_NAME v3-files my-app app/logs\n   CF_NAME v3-files my-app app/config.yml --download config.yml"`
+	relatedCommands    interface{}    `related_commands:"v3-ssh, allow-space-ssh, enable-ssh, ssh-enabled"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3FilesActor
+	SSHClient   v3action.SSHClient
+}
+
+func (cmd *V3FilesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, uaaClient, config)
+	cmd.SSHClient = shared.SSHClient{SkipHostValidation: cmd.SkipHostValidation}
+
+	return nil
+}
+
+func (cmd V3FilesCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Download != "" && cmd.RequiredArgs.Path == "" {
+		return translatableerror.RequiredArgumentError{ArgumentName: "PATH"}
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Download != "" {
+		return cmd.downloadFile(user.Name)
+	}
+	return cmd.listFiles(user.Name)
+}
+
+func (cmd V3FilesCommand) listFiles(username string) error {
+	cmd.UI.DisplayTextWithFlavor("Getting files for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  username,
+	})
+
+	remoteCommand := "ls -la"
+	if cmd.RequiredArgs.Path != "" {
+		remoteCommand += " -- " + quoteShellArgument(cmd.RequiredArgs.Path)
+	}
+
+	output, err := cmd.runCommand(remoteCommand)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText(strings.TrimRight(string(output), "\n"))
+
+	return nil
+}
+
+func (cmd V3FilesCommand) downloadFile(username string) error {
+	cmd.UI.DisplayTextWithFlavor("Downloading file {{.Path}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}} to {{.LocalFile}}...", map[string]interface{}{
+		"Path":      cmd.RequiredArgs.Path,
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  username,
+		"LocalFile": cmd.Download,
+	})
+
+	output, err := cmd.runCommand("cat -- " + quoteShellArgument(cmd.RequiredArgs.Path))
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(cmd.Download, output, 0600)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd V3FilesCommand) runCommand(remoteCommand string) ([]byte, error) {
+	output, warnings, err := cmd.Actor.RunSSHCommand(
+		cmd.SSHClient,
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.ProcessIndex,
+		remoteCommand,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return nil, shared.HandleError(err)
+	}
+
+	return output, nil
+}
+
+// quoteShellArgument single quotes arg so that the remote shell passes it to
+// the command unchanged.
+func quoteShellArgument(arg string) string {
+	return "'" + strings.Replace(arg, "'", `'\''`, -1) + "'"
+}
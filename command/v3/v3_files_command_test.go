@@ -0,0 +1,228 @@
+package v3_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-files Command", func() {
+	var (
+		cmd             v3.V3FilesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3FilesActor
+		fakeSSHClient   *v3actionfakes.FakeSSHClient
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3FilesActor)
+		fakeSSHClient = new(v3actionfakes.FakeSSHClient)
+
+		cmd = v3.V3FilesCommand{
+			ProcessType:  "web",
+			ProcessIndex: 1,
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			SSHClient:   fakeSSHClient,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Out).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: version.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when listing files", func() {
+		BeforeEach(func() {
+			fakeActor.RunSSHCommandReturns([]byte("total 4\ndrwxr-xr-x 2 vcap vcap 4096 app\n"), v3action.Warnings{"warning-1", "warning-2"}, nil)
+		})
+
+		Context("when no path is provided", func() {
+			It("lists the home directory of the instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Getting files for app some-app in org some-org / space some-space as steve\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("total 4\ndrwxr-xr-x 2 vcap vcap 4096 app"))
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("warning-2"))
+
+				Expect(fakeActor.RunSSHCommandCallCount()).To(Equal(1))
+				client, appName, spaceGUID, processType, processIndex, command := fakeActor.RunSSHCommandArgsForCall(0)
+				Expect(client).To(Equal(fakeSSHClient))
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(processType).To(Equal("web"))
+				Expect(processIndex).To(Equal(1))
+				Expect(command).To(Equal("ls -la"))
+			})
+		})
+
+		Context("when a path is provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Path = "app/it's here"
+			})
+
+			It("lists the quoted path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, _, _, _, _, command := fakeActor.RunSSHCommandArgsForCall(0)
+				Expect(command).To(Equal(`ls -la -- 'app/it'\''s here'`))
+			})
+		})
+
+		Context("when the command fails on the instance", func() {
+			BeforeEach(func() {
+				fakeActor.RunSSHCommandReturns(nil, v3action.Warnings{"warning-1"}, v3action.SSHCommandFailedError{ExitCode: 2, Stderr: "No such file or directory"})
+			})
+
+			It("returns a translatable error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedError{ExitCode: 2, Stderr: "No such file or directory"}))
+				Expect(testUI.Out).ToNot(Say("OK"))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+
+		Context("when the application is not started", func() {
+			BeforeEach(func() {
+				fakeActor.RunSSHCommandReturns(nil, nil, v3action.ApplicationNotStartedError{Name: "some-app"})
+			})
+
+			It("returns a translatable error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationNotStartedError{Name: "some-app"}))
+			})
+		})
+	})
+
+	Context("when downloading a file", func() {
+		var (
+			tmpDir    string
+			localFile string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "v3-files")
+			Expect(err).ToNot(HaveOccurred())
+			localFile = filepath.Join(tmpDir, "config.yml")
+
+			cmd.Download = localFile
+			cmd.RequiredArgs.Path = "app/config.yml"
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		Context("when reading the file succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.RunSSHCommandReturns([]byte("some: config\n"), v3action.Warnings{"warning-1"}, nil)
+			})
+
+			It("writes the file contents to the local file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Downloading file app/config.yml of app some-app in org some-org / space some-space as steve to %s\\.\\.\\.", localFile))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("warning-1"))
+
+				_, _, _, _, _, command := fakeActor.RunSSHCommandArgsForCall(0)
+				Expect(command).To(Equal("cat -- 'app/config.yml'"))
+
+				contents, err := ioutil.ReadFile(localFile)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("some: config\n"))
+			})
+		})
+
+		Context("when reading the file fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeActor.RunSSHCommandReturns(nil, nil, expectedErr)
+			})
+
+			It("returns the error without creating the local file", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				_, err := os.Stat(localFile)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when no path is provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Path = ""
+			})
+
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "PATH"}))
+				Expect(fakeActor.RunSSHCommandCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -1,11 +1,14 @@
 package v3
 
 import (
+	"strconv"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -35,7 +38,7 @@ func (cmd *V3GetHealthCheckCommand) Setup(config command.Config, ui command.UI)
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(ccClient, config)
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
 
 	return nil
 }
@@ -84,6 +87,8 @@ func (cmd V3GetHealthCheckCommand) Execute(args []string) error {
 			cmd.UI.TranslateText("process"),
 			cmd.UI.TranslateText("health check"),
 			cmd.UI.TranslateText("endpoint (for http)"),
+			cmd.UI.TranslateText("timeout"),
+			cmd.UI.TranslateText("invocation timeout"),
 		},
 	}
 
@@ -92,6 +97,8 @@ func (cmd V3GetHealthCheckCommand) Execute(args []string) error {
 			healthCheck.ProcessType,
 			healthCheck.HealthCheckType,
 			healthCheck.Endpoint,
+			formatTimeout(healthCheck.Timeout),
+			formatTimeout(healthCheck.InvocationTimeout),
 		})
 	}
 
@@ -99,3 +106,10 @@ func (cmd V3GetHealthCheckCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func formatTimeout(timeout types.NullInt) string {
+	if !timeout.IsSet {
+		return ""
+	}
+	return strconv.Itoa(timeout.Value)
+}
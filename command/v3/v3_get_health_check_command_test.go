@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/cli/version"
@@ -153,8 +154,8 @@ var _ = Describe("v3-get-health-check Command", func() {
 	Context("when app has processes", func() {
 		BeforeEach(func() {
 			appProcessHealthChecks := []v3action.ProcessHealthCheck{
-				{ProcessType: "web", HealthCheckType: "http", Endpoint: "/foo"},
-				{ProcessType: "queue", HealthCheckType: "port", Endpoint: ""},
+				{ProcessType: "web", HealthCheckType: "http", Endpoint: "/foo", Timeout: types.NullInt{Value: 60, IsSet: true}, InvocationTimeout: types.NullInt{Value: 10, IsSet: true}},
+				{ProcessType: "queue", HealthCheckType: "port", Endpoint: "", Timeout: types.NullInt{Value: 120, IsSet: true}},
 				{ProcessType: "timer", HealthCheckType: "process", Endpoint: ""},
 			}
 			fakeActor.GetApplicationProcessHealthChecksByNameAndSpaceReturns(appProcessHealthChecks, v3action.Warnings{"warning-1", "warning-2"}, nil)
@@ -165,9 +166,9 @@ var _ = Describe("v3-get-health-check Command", func() {
 
 			Expect(testUI.Out).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
 			Expect(testUI.Out).To(Say("Getting process health check types for app some-app in org some-org / space some-space as steve\\.\\.\\."))
-			Expect(testUI.Out).To(Say(`process\s+health check\s+endpoint\s+\(for http\)\n`))
-			Expect(testUI.Out).To(Say(`web\s+http\s+/foo\n`))
-			Expect(testUI.Out).To(Say(`queue\s+port\s+\n`))
+			Expect(testUI.Out).To(Say(`process\s+health check\s+endpoint\s+\(for http\)\s+timeout\s+invocation timeout\n`))
+			Expect(testUI.Out).To(Say(`web\s+http\s+/foo\s+60\s+10\n`))
+			Expect(testUI.Out).To(Say(`queue\s+port\s+120\s+\n`))
 			Expect(testUI.Out).To(Say(`timer\s+process\s+\n`))
 
 			Expect(fakeActor.GetApplicationProcessHealthChecksByNameAndSpaceCallCount()).To(Equal(1))
@@ -0,0 +1,142 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3LogsActor
+
+type V3LogsActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetRecentLogs(appGUID string, client v3action.LogCacheClient, envelopeTypes []logcache.EnvelopeType) ([]v3action.Envelope, error)
+	StreamLogs(appGUID string, client v3action.LogCacheClient, envelopeTypes []logcache.EnvelopeType) (<-chan v3action.Envelope, <-chan error, func())
+}
+
+type V3LogsCommand struct {
+	RequiredArgs    flag.AppName        `positional-args:"yes"`
+	Recent          bool                `long:"recent" description:"Dump recent logs instead of tailing"`
+	EnvelopeTypes   []flag.EnvelopeType `long:"type" description:"Only display envelopes of this type: log, counter, gauge, timer or event (Default: log). This flag can be defined more than once."`
+	usage           interface{}         `usage:"CF_NAME v3-logs APP_NAME [--recent] [--type (log | counter | gauge | timer | event)]..."`
+	relatedCommands interface{}         `related_commands:"logs, v3-app, v3-ssh"`
+
+	UI             command.UI
+	Config         command.Config
+	SharedActor    command.SharedActor
+	Actor          V3LogsActor
+	LogCacheClient v3action.LogCacheClient
+}
+
+func (cmd *V3LogsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, uaaClient, config)
+
+	logCacheClient, err := shared.NewLogCacheClient(ccClient.LogCache(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.LogCacheClient = logCacheClient
+
+	return nil
+}
+
+func (cmd V3LogsCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Recent {
+		return cmd.displayRecentLogs(app.GUID)
+	}
+
+	return cmd.streamLogs(app.GUID)
+}
+
+func (cmd V3LogsCommand) envelopeTypes() []logcache.EnvelopeType {
+	if len(cmd.EnvelopeTypes) == 0 {
+		return []logcache.EnvelopeType{logcache.LogEnvelopeType}
+	}
+
+	var envelopeTypes []logcache.EnvelopeType
+	for _, envelopeType := range cmd.EnvelopeTypes {
+		envelopeTypes = append(envelopeTypes, logcache.EnvelopeType(envelopeType.Type))
+	}
+	return envelopeTypes
+}
+
+func (cmd V3LogsCommand) displayRecentLogs(appGUID string) error {
+	envelopes, err := cmd.Actor.GetRecentLogs(appGUID, cmd.LogCacheClient, cmd.envelopeTypes())
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	for _, envelope := range envelopes {
+		cmd.UI.DisplayLogMessage(envelope, true)
+	}
+
+	return nil
+}
+
+func (cmd V3LogsCommand) streamLogs(appGUID string) error {
+	envelopes, logErrs, stop := cmd.Actor.StreamLogs(appGUID, cmd.LogCacheClient, cmd.envelopeTypes())
+	defer stop()
+
+	for {
+		select {
+		case envelope, ok := <-envelopes:
+			if !ok {
+				return nil
+			}
+
+			cmd.UI.DisplayLogMessage(envelope, true)
+		case logErr, ok := <-logErrs:
+			if !ok {
+				logErrs = nil
+				break
+			}
+
+			return shared.HandleError(logErr)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-logs Command", func() {
+	var (
+		cmd                v3.V3LogsCommand
+		testUI             *ui.UI
+		fakeConfig         *commandfakes.FakeConfig
+		fakeSharedActor    *commandfakes.FakeSharedActor
+		fakeActor          *v3fakes.FakeV3LogsActor
+		fakeLogCacheClient *v3actionfakes.FakeLogCacheClient
+		binaryName         string
+		executeErr         error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3LogsActor)
+		fakeLogCacheClient = new(v3actionfakes.FakeLogCacheClient)
+
+		cmd = v3.V3LogsCommand{
+			UI:             testUI,
+			Config:         fakeConfig,
+			SharedActor:    fakeSharedActor,
+			Actor:          fakeActor,
+			LogCacheClient: fakeLogCacheClient,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
+		fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid"}, v3action.Warnings{"app-warning"}, nil)
+
+		closedEnvelopes := make(chan v3action.Envelope)
+		close(closedEnvelopes)
+		closedErrs := make(chan error)
+		close(closedErrs)
+		fakeActor.StreamLogsReturns(closedEnvelopes, closedErrs, func() {})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Out).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: version.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when the application does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"app-warning"}, v3action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns a translatable error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(fakeActor.GetRecentLogsCallCount()).To(Equal(0))
+			Expect(fakeActor.StreamLogsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the --recent flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Recent = true
+		})
+
+		Context("when getting the recent logs succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.GetRecentLogsReturns([]v3action.Envelope{
+					v3action.NewEnvelope(logcache.Envelope{
+						Timestamp:  time.Unix(0, 0),
+						InstanceID: "0",
+						Tags:       map[string]string{"source_type": "APP/PROC/WEB"},
+						Log:        &logcache.Log{Payload: []byte("message-1"), Type: logcache.OutLogType},
+					}),
+					v3action.NewEnvelope(logcache.Envelope{
+						Timestamp:  time.Unix(1, 0),
+						InstanceID: "1",
+						Tags:       map[string]string{"source_type": "APP/PROC/WEB"},
+						Log:        &logcache.Log{Payload: []byte("message-2"), Type: logcache.ErrLogType},
+					}),
+				}, nil)
+			})
+
+			It("displays the recent logs", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Retrieving logs for app some-app in org some-org / space some-space as steve\\.\\.\\."))
+				Expect(testUI.Out).To(Say("\\[APP/PROC/WEB/0\\] OUT message-1"))
+				Expect(testUI.Out).To(Say("\\[APP/PROC/WEB/1\\] ERR message-2"))
+				Expect(testUI.Err).To(Say("app-warning"))
+
+				Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeActor.GetRecentLogsCallCount()).To(Equal(1))
+				appGUID, client, envelopeTypes := fakeActor.GetRecentLogsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(client).To(Equal(fakeLogCacheClient))
+				Expect(envelopeTypes).To(Equal([]logcache.EnvelopeType{logcache.LogEnvelopeType}))
+
+				Expect(fakeActor.StreamLogsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when envelope types are provided", func() {
+			BeforeEach(func() {
+				cmd.EnvelopeTypes = []flag.EnvelopeType{{Type: "GAUGE"}, {Type: "COUNTER"}}
+			})
+
+			It("only requests envelopes of those types", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, _, envelopeTypes := fakeActor.GetRecentLogsArgsForCall(0)
+				Expect(envelopeTypes).To(Equal([]logcache.EnvelopeType{logcache.GaugeEnvelopeType, logcache.CounterEnvelopeType}))
+			})
+		})
+
+		Context("when getting the recent logs fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeActor.GetRecentLogsReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+
+	Context("when following the logs", func() {
+		var (
+			envelopes   chan v3action.Envelope
+			errs        chan error
+			stopCalled  bool
+			streamedErr error
+		)
+
+		BeforeEach(func() {
+			envelopes = make(chan v3action.Envelope)
+			errs = make(chan error)
+			stopCalled = false
+			streamedErr = nil
+
+			fakeActor.StreamLogsStub = func(_ string, _ v3action.LogCacheClient, _ []logcache.EnvelopeType) (<-chan v3action.Envelope, <-chan error, func()) {
+				go func() {
+					envelopes <- v3action.NewEnvelope(logcache.Envelope{
+						Timestamp:  time.Unix(0, 0),
+						InstanceID: "0",
+						Tags:       map[string]string{"source_type": "APP/PROC/WEB"},
+						Log:        &logcache.Log{Payload: []byte("streamed-message"), Type: logcache.OutLogType},
+					})
+					envelopes <- v3action.NewEnvelope(logcache.Envelope{
+						Timestamp:  time.Unix(1, 0),
+						InstanceID: "0",
+						Gauge:      &logcache.Gauge{Metrics: map[string]logcache.GaugeValue{"cpu": {Unit: "percentage", Value: 2}}},
+					})
+					if streamedErr != nil {
+						errs <- streamedErr
+					}
+					close(errs)
+					close(envelopes)
+				}()
+
+				return envelopes, errs, func() { stopCalled = true }
+			}
+		})
+
+		It("displays the streamed envelopes until the stream ends", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Retrieving logs for app some-app in org some-org / space some-space as steve\\.\\.\\."))
+			Expect(testUI.Out).To(Say("\\[APP/PROC/WEB/0\\] OUT streamed-message"))
+			Expect(testUI.Out).To(Say("\\[APP/0\\] GAUGE cpu: 2 percentage"))
+			Expect(stopCalled).To(BeTrue())
+
+			Expect(fakeActor.StreamLogsCallCount()).To(Equal(1))
+			appGUID, client, envelopeTypes := fakeActor.StreamLogsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(client).To(Equal(fakeLogCacheClient))
+			Expect(envelopeTypes).To(Equal([]logcache.EnvelopeType{logcache.LogEnvelopeType}))
+
+			Expect(fakeActor.GetRecentLogsCallCount()).To(Equal(0))
+		})
+
+		Context("when streaming fails", func() {
+			BeforeEach(func() {
+				streamedErr = errors.New("some-stream-error")
+			})
+
+			It("stops streaming and returns the error", func() {
+				Expect(executeErr).To(MatchError(streamedErr))
+				Expect(stopCalled).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3MapRouteActor
+
+type V3MapRouteActor interface {
+	CloudControllerAPIVersion() string
+	MapRouteToApplication(appName string, spaceGUID string, domainName string, host string, path string, processType string, weight types.NullInt) (v3action.Warnings, error)
+}
+
+type V3MapRouteCommand struct {
+	RequiredArgs    flag.AppDomain `positional-args:"yes"`
+	Hostname        string         `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
+	Path            string         `long:"path" description:"Path for the HTTP route"`
+	ProcessType     string         `long:"process" default:"web" description:"App process to send the route's traffic to"`
+	Weight          flag.Weight    `long:"weight" description:"Share of the route's traffic sent to the process, relative to the weights of the route's other destinations"`
+	usage           interface{}    `usage:"CF_NAME v3-map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] [--process PROCESS] [--weight WEIGHT]\n\nEXAMPLES:\n   CF_NAME v3-map-route my-app example.com --hostname myhost                       # myhost.example.com\n   CF_NAME v3-map-route my-app-canary example.com --hostname myhost --weight 10    # send a tenth of myhost.example.com to the canary"`
+	relatedCommands interface{}    `related_commands:"v3-unmap-route, routes"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3MapRouteActor
+}
+
+func (cmd *V3MapRouteCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd V3MapRouteCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Adding route {{.URL}} to app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"URL":       v3action.RouteURL(cmd.Hostname, cmd.RequiredArgs.Domain, cmd.Path),
+		"AppName":   cmd.RequiredArgs.App,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	warnings, err := cmd.Actor.MapRouteToApplication(
+		cmd.RequiredArgs.App,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.RequiredArgs.Domain,
+		cmd.Hostname,
+		cmd.Path,
+		cmd.ProcessType,
+		cmd.Weight.NullInt,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
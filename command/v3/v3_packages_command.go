@@ -20,8 +20,9 @@ type V3PackagesActor interface {
 }
 
 type V3PackagesCommand struct {
-	RequiredArgs flag.AppName `positional-args:"yes"`
-	usage        interface{}  `usage:"CF_NAME v3-packages APP_NAME"`
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME v3-packages APP_NAME"`
+	relatedCommands interface{}  `related_commands:"v3-create-package, v3-delete-package"`
 
 	UI          command.UI
 	Config      command.Config
@@ -38,7 +39,7 @@ func (cmd *V3PackagesCommand) Setup(config command.Config, ui command.UI) error
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(ccClient, config)
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
 
 	return nil
 }
@@ -86,6 +87,7 @@ func (cmd V3PackagesCommand) Execute(args []string) error {
 			cmd.UI.TranslateText("guid"),
 			cmd.UI.TranslateText("state"),
 			cmd.UI.TranslateText("created"),
+			cmd.UI.TranslateText("checksum"),
 		},
 	}
 
@@ -99,6 +101,7 @@ func (cmd V3PackagesCommand) Execute(args []string) error {
 			pkg.GUID,
 			cmd.UI.TranslateText(strings.ToLower(string(pkg.State))),
 			cmd.UI.UserFriendlyDate(t),
+			pkg.Checksum,
 		})
 	}
 
@@ -134,6 +134,7 @@ var _ = Describe("v3-packages Command", func() {
 			packages := []v3action.Package{
 				{
 					GUID:      "some-package-guid-1",
+					Checksum:  "some-checksum",
 					State:     "READY",
 					CreatedAt: package1UTC,
 				},
@@ -151,12 +152,12 @@ var _ = Describe("v3-packages Command", func() {
 
 			Expect(testUI.Out).To(Say("Listing packages of app some-app in org some-org / space some-space as steve\\.\\.\\."))
 
-			Expect(testUI.Out).To(Say("guid\\s+state\\s+created"))
+			Expect(testUI.Out).To(Say("guid\\s+state\\s+created\\s+checksum"))
 			package1UTCTime, err := time.Parse(time.RFC3339, package1UTC)
 			Expect(err).ToNot(HaveOccurred())
 			package2UTCTime, err := time.Parse(time.RFC3339, package2UTC)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(testUI.Out).To(Say("some-package-guid-1\\s+ready\\s+%s", testUI.UserFriendlyDate(package1UTCTime)))
+			Expect(testUI.Out).To(Say("some-package-guid-1\\s+ready\\s+%s\\s+some-checksum", testUI.UserFriendlyDate(package1UTCTime)))
 			Expect(testUI.Out).To(Say("some-package-guid-2\\s+failed\\s+%s", testUI.UserFriendlyDate(package2UTCTime)))
 
 			Expect(testUI.Err).To(Say("warning-1"))
@@ -1,6 +1,8 @@
 package v3
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
@@ -10,6 +12,8 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -23,14 +27,18 @@ type V2PushActor interface {
 
 type V3PushActor interface {
 	CloudControllerAPIVersion() string
-	CreatePackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string, dockerImage string) (v3action.Package, v3action.Warnings, error)
+	CreateDeployment(appGUID string, dropletGUID string) (v3action.Deployment, v3action.Warnings, error)
+	CreatePackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string, dockerImageCredentials v3action.DockerImageCredentials) (v3action.Package, v3action.Warnings, error)
 	CreateApplicationInSpace(app v3action.Application, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string) (v3action.ApplicationSummary, v3action.Warnings, error)
+	GetStackByName(stackName string) (v3action.Stack, v3action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error)
-	PollStart(appGUID string, warnings chan<- v3action.Warnings) error
+	PollDeployment(deploymentGUID string, warnings chan<- v3action.Warnings) error
+	PollStart(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error
 	SetApplicationDroplet(appName string, spaceGUID string, dropletGUID string) (v3action.Warnings, error)
-	StagePackage(packageGUID string, appName string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error)
+	SetProcessCommand(appGUID string, processType string, command types.FilteredString) (v3action.Warnings, error)
+	StagePackage(packageGUID string, appName string, buildpacks []string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error)
 	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
 	StopApplication(appGUID string) (v3action.Warnings, error)
 	UpdateApplication(app v3action.Application) (v3action.Application, v3action.Warnings, error)
@@ -42,9 +50,16 @@ type V3PushCommand struct {
 	Buildpacks          []string                    `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
 	AppPath             flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
 	DockerImage         flag.DockerImage            `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
-	usage               interface{}                 `usage:"cf v3-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [--no-route]\n   cf v3-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG]"`
+	DockerUsername      string                      `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
+	StackName           string                      `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	StartCommand        flag.Command                `short:"c" long:"start-command" description:"Startup command for the web process, set to null to reset to default start command"`
+	ResultFile          flag.Path                   `long:"result-file" description:"Write a JSON summary of the push result to this file, even when the push fails"`
+	Strategy            string                      `long:"strategy" choice:"rolling" description:"Deployment strategy; rolling replaces the instances of a running app one at a time instead of stopping and starting it"`
+	StartTimeout        flag.AppStartTimeout        `short:"t" long:"app-start-timeout" description:"Max wait time for app instance startup, in minutes; overrides CF_STARTUP_TIMEOUT"`
+	usage               interface{}                 `usage:"cf v3-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [-s STACK] [-c COMMAND] [-t TIMEOUT] [--no-route] [--strategy rolling] [--result-file PATH]\n   cf v3-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [-c COMMAND] [-t TIMEOUT] [--strategy rolling] [--result-file PATH]"`
 	envCFStagingTimeout interface{}                 `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}                 `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	dockerPassword      interface{}                 `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
 
 	UI                  command.UI
 	Config              command.Config
@@ -64,7 +79,7 @@ func (cmd *V3PushCommand) Setup(config command.Config, ui command.UI) error {
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(ccClient, config)
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
 
 	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
 	if err != nil {
@@ -74,7 +89,10 @@ func (cmd *V3PushCommand) Setup(config command.Config, ui command.UI) error {
 	v2Actor := v2action.NewActor(ccClientV2, uaaClientV2, config)
 	cmd.V2PushActor = pushaction.NewActor(v2Actor)
 	v2AppActor := v2action.NewActor(ccClientV2, uaaClientV2, config)
-	cmd.NOAAClient = shared.NewNOAAClient(ccClient.APIInfo.Logging(), config, uaaClient, ui)
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.APIInfo.Logging(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
 
 	cmd.AppSummaryDisplayer = shared.AppSummaryDisplayer{
 		UI:              cmd.UI,
@@ -100,6 +118,20 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Strategy != "" {
+		err = version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionDeploymentsV3, "Option '--strategy'")
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.StackName != "" {
+		err = version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionStacksV3, "Option '-s'")
+		if err != nil {
+			return err
+		}
+	}
+
 	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -114,42 +146,80 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		return translatableerror.ConflictingBuildpacksError{}
 	}
 
+	result := shared.PushResult{AppName: cmd.RequiredArgs.AppName}
+	err = cmd.push(user.Name, &result)
+
+	if cmd.ResultFile != "" {
+		writeErr := cmd.writeResult(result, err)
+		if err == nil {
+			err = writeErr
+		}
+	}
+
+	return err
+}
+
+func (cmd V3PushCommand) push(userName string, result *shared.PushResult) error {
+	if cmd.StackName != "" {
+		_, warnings, err := cmd.Actor.GetStackByName(cmd.StackName)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
 	var app v3action.Application
-	app, err = cmd.getApplication()
+	app, err := cmd.getApplication()
 	if _, ok := err.(v3action.ApplicationNotFoundError); ok {
-		app, err = cmd.createApplication(user.Name)
+		app, err = cmd.createApplication(userName)
 		if err != nil {
 			return shared.HandleError(err)
 		}
 	} else if err != nil {
 		return shared.HandleError(err)
 	} else {
-		app, err = cmd.updateApplication(user.Name, app.GUID)
+		app, err = cmd.updateApplication(userName, app.GUID)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+	result.AppGUID = app.GUID
+
+	if cmd.StartCommand.IsSet {
+		err = cmd.setStartCommand(app.GUID, userName)
 		if err != nil {
 			return shared.HandleError(err)
 		}
 	}
 
-	pkg, err := cmd.uploadPackage(user.Name)
+	pkg, err := cmd.uploadPackage(userName)
 	if err != nil {
 		return shared.HandleError(err)
 	}
+	result.Revision = pkg.GUID
 
-	dropletGUID, err := cmd.stagePackage(pkg, user.Name)
+	dropletGUID, err := cmd.stagePackage(pkg, userName)
 	if err != nil {
 		return shared.HandleError(err)
 	}
+	result.DropletGUID = dropletGUID
 
-	if app.Started() {
-		err = cmd.stopApplication(app.GUID, user.Name)
+	// A rolling deployment replaces the instances of a running app with the
+	// new droplet, so the app is neither stopped nor restarted.
+	rolling := cmd.Strategy != "" && app.Started()
+
+	if app.Started() && !rolling {
+		err = cmd.stopApplication(app.GUID, userName)
 		if err != nil {
 			return shared.HandleError(err)
 		}
 	}
 
-	err = cmd.setApplicationDroplet(dropletGUID, user.Name)
-	if err != nil {
-		return shared.HandleError(err)
+	if !rolling {
+		err = cmd.setApplicationDroplet(dropletGUID, userName)
+		if err != nil {
+			return shared.HandleError(err)
+		}
 	}
 
 	if !cmd.NoRoute {
@@ -159,28 +229,32 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		}
 	}
 
-	err = cmd.startApplication(app.GUID, user.Name)
-	if err != nil {
-		return shared.HandleError(err)
-	}
-
-	cmd.UI.DisplayText("Waiting for app to start...")
+	startTime := time.Now()
+	if rolling {
+		var deploymentGUID string
+		deploymentGUID, err = cmd.createDeployment(app.GUID, dropletGUID, userName)
+		if err != nil {
+			return shared.HandleError(err)
+		}
 
-	warnings := make(chan v3action.Warnings)
-	done := make(chan bool)
-	go func() {
-		for {
-			select {
-			case message := <-warnings:
-				cmd.UI.DisplayWarnings(message)
-			case <-done:
-				return
-			}
+		cmd.UI.DisplayText("Waiting for app to deploy...")
+		cmd.UI.DisplayProgressEvent(ui.ProgressEvent{Phase: ui.ProgressPhaseDeploying, Message: "Waiting for app to deploy"})
+		err = cmd.pollWithWarnings(func(warnings chan<- v3action.Warnings) error {
+			return cmd.Actor.PollDeployment(deploymentGUID, warnings)
+		})
+	} else {
+		err = cmd.startApplication(app.GUID, userName)
+		if err != nil {
+			return shared.HandleError(err)
 		}
-	}()
 
-	err = cmd.Actor.PollStart(app.GUID, warnings)
-	done <- true
+		cmd.UI.DisplayText("Waiting for app to start...")
+		cmd.UI.DisplayProgressEvent(ui.ProgressEvent{Phase: ui.ProgressPhaseStarting, Message: "Waiting for app to start"})
+		err = cmd.pollWithWarnings(func(warnings chan<- v3action.Warnings) error {
+			return cmd.Actor.PollStart(app.GUID, cmd.StartTimeout.Duration(), warnings)
+		})
+	}
+	result.SetStartDuration(time.Since(startTime))
 
 	if err != nil {
 		if _, ok := err.(v3action.StartupTimeoutError); ok {
@@ -193,16 +267,60 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
-	return cmd.AppSummaryDisplayer.DisplayAppInfo()
+	summary, routes, err := cmd.AppSummaryDisplayer.DisplayAndGetAppInfo()
+	if err != nil {
+		return err
+	}
+	result.SetAppInfo(summary, routes)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("app guid:"), result.AppGUID},
+		{cmd.UI.TranslateText("droplet guid:"), result.DropletGUID},
+		{cmd.UI.TranslateText("revision:"), result.Revision},
+		{cmd.UI.TranslateText("start duration:"), cmd.UI.FormatDuration(time.Duration(result.StartDurationSeconds) * time.Second)},
+	}, 3)
+
+	return nil
+}
+
+// writeResult writes the push result to the result file, recording the error
+// that caused the push to fail, if any.
+func (cmd V3PushCommand) writeResult(result shared.PushResult, pushErr error) error {
+	result.Status = shared.PushSucceeded
+	if pushErr != nil {
+		result.Status = shared.PushFailed
+		result.Error = cmd.UI.TranslateError(pushErr)
+	}
+
+	err := shared.WritePushResult(string(cmd.ResultFile), result)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Push result written to {{.Path}}", map[string]interface{}{
+		"Path": cmd.ResultFile,
+	})
+	return nil
 }
 
 func (cmd V3PushCommand) validateArgs() error {
 	switch {
 	case cmd.DockerImage.Path != "" && cmd.AppPath != "":
 		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--docker-image, -o", "-p"},
+		}
+	case cmd.DockerImage.Path != "" && cmd.StackName != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--docker-image, -o", "-s"},
+		}
+	case cmd.DockerUsername != "" && cmd.DockerImage.Path == "":
+		return translatableerror.RequiredFlagsError{
 			Arg1: "--docker-image, -o",
-			Arg2: "-p",
+			Arg2: "--docker-username",
 		}
+	case cmd.DockerUsername != "" && cmd.Config.DockerPassword() == "":
+		return translatableerror.DockerPasswordNotSetError{}
 	}
 	return nil
 }
@@ -221,6 +339,7 @@ func (cmd V3PushCommand) createApplication(userName string) (v3action.Applicatio
 			Type: v3action.BuildpackAppLifecycleType,
 			Data: v3action.AppLifecycleData{
 				Buildpacks: cmd.Buildpacks,
+				Stack:      cmd.StackName,
 			},
 		}
 	}
@@ -277,6 +396,7 @@ func (cmd V3PushCommand) updateApplication(userName string, appGUID string) (v3a
 			Type: v3action.BuildpackAppLifecycleType,
 			Data: v3action.AppLifecycleData{
 				Buildpacks: cmd.Buildpacks,
+				Stack:      cmd.StackName,
 			},
 		}
 	}
@@ -292,6 +412,25 @@ func (cmd V3PushCommand) updateApplication(userName string, appGUID string) (v3a
 	return app, nil
 }
 
+func (cmd V3PushCommand) setStartCommand(appGUID string, userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Setting start command for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  userName,
+	})
+
+	warnings, err := cmd.Actor.SetProcessCommand(appGUID, "web", cmd.StartCommand.FilteredString)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
 func (cmd V3PushCommand) createAndBindRoutes(app v3action.Application) error {
 	cmd.UI.DisplayText("Mapping routes...")
 	routeWarnings, err := cmd.V2PushActor.CreateAndBindApplicationRoutes(cmd.Config.TargetedOrganization().GUID, cmd.Config.TargetedSpace().GUID, v2action.Application{Name: app.Name, GUID: app.GUID})
@@ -320,7 +459,11 @@ func (cmd V3PushCommand) uploadPackage(userName string) (v3action.Package, error
 		"CurrentUser":  userName,
 	})
 
-	pkg, warnings, err := cmd.Actor.CreatePackageByApplicationNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, string(cmd.AppPath), cmd.DockerImage.Path)
+	pkg, warnings, err := cmd.Actor.CreatePackageByApplicationNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, string(cmd.AppPath), v3action.DockerImageCredentials{
+		Path:     cmd.DockerImage.Path,
+		Username: cmd.DockerUsername,
+		Password: cmd.Config.DockerPassword(),
+	})
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return v3action.Package{}, err
@@ -345,7 +488,12 @@ func (cmd V3PushCommand) stagePackage(pkg v3action.Package, userName string) (st
 		return "", logErr
 	}
 
-	buildStream, warningsStream, errStream := cmd.Actor.StagePackage(pkg.GUID, cmd.RequiredArgs.AppName)
+	var buildpacks []string
+	if cmd.DockerImage.Path == "" {
+		buildpacks = cmd.Buildpacks
+	}
+
+	buildStream, warningsStream, errStream := cmd.Actor.StagePackage(pkg.GUID, cmd.RequiredArgs.AppName, buildpacks)
 	droplet, err := shared.PollStage(buildStream, warningsStream, errStream, logStream, logErrStream, cmd.UI)
 	if err != nil {
 		return "", err
@@ -394,6 +542,46 @@ func (cmd V3PushCommand) startApplication(appGUID string, userName string) error
 	return nil
 }
 
+func (cmd V3PushCommand) createDeployment(appGUID string, dropletGUID string, userName string) (string, error) {
+	cmd.UI.DisplayTextWithFlavor("Starting rolling deployment of droplet {{.DropletGUID}} to app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"DropletGUID": dropletGUID,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    userName,
+	})
+
+	deployment, warnings, err := cmd.Actor.CreateDeployment(appGUID, dropletGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return "", err
+	}
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return deployment.GUID, nil
+}
+
+// pollWithWarnings calls poll, displaying the warnings it sends while it
+// runs.
+func (cmd V3PushCommand) pollWithWarnings(poll func(warnings chan<- v3action.Warnings) error) error {
+	warnings := make(chan v3action.Warnings)
+	done := make(chan bool)
+	go func() {
+		for {
+			select {
+			case message := <-warnings:
+				cmd.UI.DisplayWarnings(message)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err := poll(warnings)
+	done <- true
+	return err
+}
+
 func (cmd V3PushCommand) stopApplication(appGUID string, userName string) error {
 	cmd.UI.DisplayTextWithFlavor("Stopping app {{.AppName}} in org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...", map[string]interface{}{
 		"AppName":      cmd.RequiredArgs.AppName,
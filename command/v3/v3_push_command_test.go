@@ -1,7 +1,10 @@
 package v3_test
 
 import (
+	"encoding/json"
 	"errors"
+	"io/ioutil"
+	"os"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
@@ -101,6 +104,34 @@ var _ = Describe("v3-push Command", func() {
 		})
 	})
 
+	Context("when the --strategy flag is provided and the API version is below the minimum for deployments", func() {
+		BeforeEach(func() {
+			cmd.Strategy = "rolling"
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '--strategy'",
+				CurrentVersion: version.MinVersionV3,
+				MinimumVersion: version.MinVersionDeploymentsV3,
+			}))
+		})
+	})
+
+	Context("when the -s flag is provided and the API version is below the minimum for stacks", func() {
+		BeforeEach(func() {
+			cmd.StackName = "cflinuxfs3"
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '-s'",
+				CurrentVersion: version.MinVersionV3,
+				MinimumVersion: version.MinVersionStacksV3,
+			}))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
@@ -123,7 +154,7 @@ var _ = Describe("v3-push Command", func() {
 			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: orgName, GUID: "some-org-guid"})
 
 			// we stub out StagePackage out here so the happy paths below don't hang
-			fakeActor.StagePackageStub = func(_ string, _ string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+			fakeActor.StagePackageStub = func(_ string, _ string, _ []string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
 				dropletStream := make(chan v3action.Droplet)
 				warningsStream := make(chan v3action.Warnings)
 				errorStream := make(chan error)
@@ -138,6 +169,68 @@ var _ = Describe("v3-push Command", func() {
 			}
 		})
 
+		Context("when the -s flag is provided", func() {
+			BeforeEach(func() {
+				cmd.StackName = "cflinuxfs3"
+				fakeActor.CloudControllerAPIVersionReturns(version.MinVersionStacksV3)
+			})
+
+			Context("when the stack does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.GetStackByNameReturns(v3action.Stack{}, v3action.Warnings{"get-stack-warning"}, v3action.StackNotFoundError{Name: "cflinuxfs3"})
+				})
+
+				It("returns a StackNotFoundError without creating or updating the app", func() {
+					Expect(executeErr).To(MatchError(translatableerror.StackNotFoundError{Name: "cflinuxfs3"}))
+					Expect(testUI.Err).To(Say("get-stack-warning"))
+
+					Expect(fakeActor.GetStackByNameCallCount()).To(Equal(1))
+					Expect(fakeActor.GetStackByNameArgsForCall(0)).To(Equal("cflinuxfs3"))
+					Expect(fakeActor.CreateApplicationInSpaceCallCount()).To(Equal(0))
+					Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the stack exists", func() {
+				BeforeEach(func() {
+					fakeActor.GetStackByNameReturns(v3action.Stack{Name: "cflinuxfs3"}, v3action.Warnings{"get-stack-warning"}, nil)
+				})
+
+				Context("when the app does not exist", func() {
+					BeforeEach(func() {
+						fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, nil, v3action.ApplicationNotFoundError{Name: app})
+						fakeActor.CreateApplicationInSpaceReturns(v3action.Application{}, nil, errors.New("stop here"))
+					})
+
+					It("creates the app with the stack in its lifecycle", func() {
+						Expect(testUI.Err).To(Say("get-stack-warning"))
+
+						Expect(fakeActor.CreateApplicationInSpaceCallCount()).To(Equal(1))
+						createApp, _ := fakeActor.CreateApplicationInSpaceArgsForCall(0)
+						Expect(createApp.Lifecycle).To(Equal(v3action.AppLifecycle{
+							Type: v3action.BuildpackAppLifecycleType,
+							Data: v3action.AppLifecycleData{Stack: "cflinuxfs3"},
+						}))
+					})
+				})
+
+				Context("when the app exists", func() {
+					BeforeEach(func() {
+						fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid"}, nil, nil)
+						fakeActor.UpdateApplicationReturns(v3action.Application{}, nil, errors.New("stop here"))
+					})
+
+					It("updates the app with the stack in its lifecycle", func() {
+						Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(1))
+						Expect(fakeActor.UpdateApplicationArgsForCall(0).Lifecycle).To(Equal(v3action.AppLifecycle{
+							Type: v3action.BuildpackAppLifecycleType,
+							Data: v3action.AppLifecycleData{Stack: "cflinuxfs3"},
+						}))
+					})
+				})
+			})
+		})
+
 		Context("when looking up the application returns some api error", func() {
 			BeforeEach(func() {
 				fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"get-warning"}, errors.New("some-error"))
@@ -189,6 +282,50 @@ var _ = Describe("v3-push Command", func() {
 					Expect(createSpaceGUID).To(Equal("some-space-guid"))
 				})
 
+				It("does not change the start command", func() {
+					Expect(fakeActor.SetProcessCommandCallCount()).To(Equal(0))
+				})
+
+				Context("when the start command is provided", func() {
+					BeforeEach(func() {
+						cmd.StartCommand = flag.Command{FilteredString: types.FilteredString{IsSet: true, Value: "some-command"}}
+					})
+
+					Context("when setting the start command succeeds", func() {
+						BeforeEach(func() {
+							fakeActor.SetProcessCommandReturns(v3action.Warnings{"set-command-warning"}, nil)
+						})
+
+						It("sets the command of the web process before uploading the package", func() {
+							Expect(testUI.Out).To(Say("Setting start command for app some-app in org some-org / space some-space as banana..."))
+							Expect(testUI.Err).To(Say("set-command-warning"))
+							Expect(testUI.Out).To(Say("OK"))
+							Expect(testUI.Out).To(Say("Uploading and creating bits package for app some-app"))
+
+							Expect(fakeActor.SetProcessCommandCallCount()).To(Equal(1))
+							appGUID, processType, command := fakeActor.SetProcessCommandArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(processType).To(Equal("web"))
+							Expect(command).To(Equal(types.FilteredString{IsSet: true, Value: "some-command"}))
+						})
+					})
+
+					Context("when setting the start command fails", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("set command error")
+							fakeActor.SetProcessCommandReturns(v3action.Warnings{"set-command-warning"}, expectedErr)
+						})
+
+						It("returns the error and does not upload the package", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+							Expect(testUI.Err).To(Say("set-command-warning"))
+							Expect(fakeActor.CreatePackageByApplicationNameAndSpaceCallCount()).To(Equal(0))
+						})
+					})
+				})
+
 				Context("when creating the package fails", func() {
 					var expectedErr error
 
@@ -227,10 +364,10 @@ var _ = Describe("v3-push Command", func() {
 							Expect(testUI.Out).To(Say("Staging package for app %s in org some-org / space some-space as banana...", app))
 
 							Expect(fakeActor.CreatePackageByApplicationNameAndSpaceCallCount()).To(Equal(1))
-							_, _, appPath, dockerImage := fakeActor.CreatePackageByApplicationNameAndSpaceArgsForCall(0)
+							_, _, appPath, dockerImageCredentials := fakeActor.CreatePackageByApplicationNameAndSpaceArgsForCall(0)
 
 							Expect(appPath).To(Equal("some-app-path"))
-							Expect(dockerImage).To(BeEmpty())
+							Expect(dockerImageCredentials.Path).To(BeEmpty())
 						})
 					})
 
@@ -248,10 +385,55 @@ var _ = Describe("v3-push Command", func() {
 							Expect(testUI.Out).To(Say("Staging package for app %s in org some-org / space some-space as banana...", app))
 
 							Expect(fakeActor.CreatePackageByApplicationNameAndSpaceCallCount()).To(Equal(1))
-							_, _, bitsPath, dockerImage := fakeActor.CreatePackageByApplicationNameAndSpaceArgsForCall(0)
+							_, _, bitsPath, dockerImageCredentials := fakeActor.CreatePackageByApplicationNameAndSpaceArgsForCall(0)
 
 							Expect(bitsPath).To(BeEmpty())
-							Expect(dockerImage).To(Equal("example.com/docker/docker/docker:docker"))
+							Expect(dockerImageCredentials.Path).To(Equal("example.com/docker/docker/docker:docker"))
+						})
+					})
+
+					Context("when the -o and --docker-username flags are provided", func() {
+						BeforeEach(func() {
+							cmd.DockerImage.Path = "example.com/docker/docker/docker:docker"
+							cmd.DockerUsername = "some-docker-username"
+							fakeConfig.DockerPasswordReturns("some-docker-password")
+						})
+
+						It("creates a docker package with the provided credentials", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.CreatePackageByApplicationNameAndSpaceCallCount()).To(Equal(1))
+							_, _, _, dockerImageCredentials := fakeActor.CreatePackageByApplicationNameAndSpaceArgsForCall(0)
+							Expect(dockerImageCredentials).To(Equal(v3action.DockerImageCredentials{
+								Path:     "example.com/docker/docker/docker:docker",
+								Username: "some-docker-username",
+								Password: "some-docker-password",
+							}))
+						})
+
+						Context("when CF_DOCKER_PASSWORD is not set", func() {
+							BeforeEach(func() {
+								fakeConfig.DockerPasswordReturns("")
+							})
+
+							It("returns a DockerPasswordNotSetError", func() {
+								Expect(executeErr).To(MatchError(translatableerror.DockerPasswordNotSetError{}))
+								Expect(fakeActor.CreatePackageByApplicationNameAndSpaceCallCount()).To(Equal(0))
+							})
+						})
+					})
+
+					Context("when the --docker-username flag is provided without -o", func() {
+						BeforeEach(func() {
+							cmd.DockerUsername = "some-docker-username"
+							fakeConfig.DockerPasswordReturns("some-docker-password")
+						})
+
+						It("returns a RequiredFlagsError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+								Arg1: "--docker-image, -o",
+								Arg2: "--docker-username",
+							}))
 						})
 					})
 
@@ -262,8 +444,20 @@ var _ = Describe("v3-push Command", func() {
 						})
 						It("returns an error", func() {
 							Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
-								Arg1: "--docker-image, -o",
-								Arg2: "-p",
+								Args: []string{"--docker-image, -o", "-p"},
+							}))
+						})
+					})
+
+					Context("when both the -o and -s flags are provided", func() {
+						BeforeEach(func() {
+							cmd.DockerImage.Path = "example.com/docker/docker/docker:docker"
+							cmd.StackName = "cflinuxfs3"
+						})
+
+						It("returns an error", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+								Args: []string{"--docker-image, -o", "-s"},
 							}))
 						})
 					})
@@ -272,10 +466,10 @@ var _ = Describe("v3-push Command", func() {
 						It("passes empty strings for both dockerImage and bitsPath", func() {
 							Expect(testUI.Out).To(Say("Uploading and creating bits package for app %s in org %s / space %s as %s", app, orgName, spaceName, userName))
 							Expect(fakeActor.CreatePackageByApplicationNameAndSpaceCallCount()).To(Equal(1))
-							_, _, appPath, dockerImage := fakeActor.CreatePackageByApplicationNameAndSpaceArgsForCall(0)
+							_, _, appPath, dockerImageCredentials := fakeActor.CreatePackageByApplicationNameAndSpaceArgsForCall(0)
 
 							Expect(appPath).To(BeEmpty())
-							Expect(dockerImage).To(BeEmpty())
+							Expect(dockerImageCredentials.Path).To(BeEmpty())
 						})
 					})
 
@@ -322,7 +516,7 @@ var _ = Describe("v3-push Command", func() {
 
 							BeforeEach(func() {
 								expectedErr = errors.New("any gibberish")
-								fakeActor.StagePackageStub = func(packageGUID string, _ string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+								fakeActor.StagePackageStub = func(packageGUID string, _ string, _ []string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
 									dropletStream := make(chan v3action.Droplet)
 									warningsStream := make(chan v3action.Warnings)
 									errorStream := make(chan error)
@@ -354,7 +548,7 @@ var _ = Describe("v3-push Command", func() {
 
 						Context("when the staging is successful", func() {
 							BeforeEach(func() {
-								fakeActor.StagePackageStub = func(packageGUID string, _ string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+								fakeActor.StagePackageStub = func(packageGUID string, _ string, _ []string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
 									dropletStream := make(chan v3action.Droplet)
 									warningsStream := make(chan v3action.Warnings)
 									errorStream := make(chan error)
@@ -385,7 +579,7 @@ var _ = Describe("v3-push Command", func() {
 							It("stages the package", func() {
 								Expect(executeErr).ToNot(HaveOccurred())
 								Expect(fakeActor.StagePackageCallCount()).To(Equal(1))
-								guidArg, _ := fakeActor.StagePackageArgsForCall(0)
+								guidArg, _, _ := fakeActor.StagePackageArgsForCall(0)
 								Expect(guidArg).To(Equal("some-guid"))
 							})
 
@@ -402,7 +596,7 @@ var _ = Describe("v3-push Command", func() {
 								Expect(spaceGUID).To(Equal("some-space-guid"))
 								Expect(noaaClient).To(Equal(fakeNOAAClient))
 
-								guidArg, _ := fakeActor.StagePackageArgsForCall(0)
+								guidArg, _, _ := fakeActor.StagePackageArgsForCall(0)
 								Expect(guidArg).To(Equal("some-guid"))
 							})
 
@@ -478,6 +672,24 @@ var _ = Describe("v3-push Command", func() {
 										}))
 										Expect(createSpaceGUID).To(Equal("some-space-guid"))
 									})
+
+									It("stages the package with the specified buildpack", func() {
+										Expect(fakeActor.StagePackageCallCount()).To(Equal(1))
+										_, _, buildpacks := fakeActor.StagePackageArgsForCall(0)
+										Expect(buildpacks).To(Equal([]string{"some-buildpack"}))
+									})
+
+									Context("when multiple buildpacks are provided", func() {
+										BeforeEach(func() {
+											cmd.Buildpacks = []string{"some-buildpack-1", "some-buildpack-2"}
+										})
+
+										It("stages the package with the buildpacks in the given order", func() {
+											Expect(fakeActor.StagePackageCallCount()).To(Equal(1))
+											_, _, buildpacks := fakeActor.StagePackageArgsForCall(0)
+											Expect(buildpacks).To(Equal([]string{"some-buildpack-1", "some-buildpack-2"}))
+										})
+									})
 								})
 
 								Context("when a docker image is specified", func() {
@@ -497,6 +709,12 @@ var _ = Describe("v3-push Command", func() {
 										}))
 										Expect(createSpaceGUID).To(Equal("some-space-guid"))
 									})
+
+									It("stages the package without buildpacks", func() {
+										Expect(fakeActor.StagePackageCallCount()).To(Equal(1))
+										_, _, buildpacks := fakeActor.StagePackageArgsForCall(0)
+										Expect(buildpacks).To(BeEmpty())
+									})
 								})
 
 								Context("when mapping routes fails", func() {
@@ -567,9 +785,24 @@ var _ = Describe("v3-push Command", func() {
 										})
 									})
 
+									Context("when an app start timeout is provided", func() {
+										BeforeEach(func() {
+											cmd.StartTimeout = flag.AppStartTimeout{NullInt: types.NullInt{Value: 7, IsSet: true}}
+										})
+
+										It("polls the start with the provided timeout", func() {
+											Expect(executeErr).ToNot(HaveOccurred())
+
+											Expect(fakeActor.PollStartCallCount()).To(Equal(1))
+											appGUID, startupTimeout, _ := fakeActor.PollStartArgsForCall(0)
+											Expect(appGUID).To(Equal("some-app-guid"))
+											Expect(startupTimeout).To(Equal(7 * time.Minute))
+										})
+									})
+
 									Context("when polling the start fails", func() {
 										BeforeEach(func() {
-											fakeActor.PollStartStub = func(appGUID string, warnings chan<- v3action.Warnings) error {
+											fakeActor.PollStartStub = func(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error {
 												warnings <- v3action.Warnings{"some-poll-warning-1", "some-poll-warning-2"}
 												return errors.New("some-error")
 											}
@@ -596,11 +829,48 @@ var _ = Describe("v3-push Command", func() {
 												BinaryName: binaryName,
 											}))
 										})
+
+										Context("when --result-file is provided", func() {
+											var resultFile string
+
+											BeforeEach(func() {
+												tmpFile, err := ioutil.TempFile("", "push-result")
+												Expect(err).ToNot(HaveOccurred())
+												Expect(tmpFile.Close()).To(Succeed())
+												resultFile = tmpFile.Name()
+												cmd.ResultFile = flag.Path(resultFile)
+											})
+
+											AfterEach(func() {
+												Expect(os.Remove(resultFile)).To(Succeed())
+											})
+
+											It("writes a failed result and still returns the StartupTimeoutError", func() {
+												Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{
+													AppName:    "some-app",
+													BinaryName: binaryName,
+												}))
+												Expect(testUI.Out).To(Say("Push result written to %s", resultFile))
+
+												raw, err := ioutil.ReadFile(resultFile)
+												Expect(err).ToNot(HaveOccurred())
+
+												var result shared.PushResult
+												Expect(json.Unmarshal(raw, &result)).To(Succeed())
+												Expect(result.Status).To(Equal(shared.PushFailed))
+												Expect(result.Error).To(ContainSubstring("Start app timeout"))
+												Expect(result.Error).To(ContainSubstring("faceman logs some-app --recent"))
+												Expect(result.AppGUID).To(Equal("some-app-guid"))
+												Expect(result.DropletGUID).To(Equal("some-droplet-guid"))
+												Expect(result.Revision).To(Equal("some-guid"))
+												Expect(result.Routes).To(BeEmpty())
+											})
+										})
 									})
 
 									Context("when polling the start succeeds", func() {
 										BeforeEach(func() {
-											fakeActor.PollStartStub = func(appGUID string, warnings chan<- v3action.Warnings) error {
+											fakeActor.PollStartStub = func(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error {
 												warnings <- v3action.Warnings{"some-poll-warning-1", "some-poll-warning-2"}
 												return nil
 											}
@@ -732,6 +1002,65 @@ var _ = Describe("v3-push Command", func() {
 													Expect(fakeV2AppActor.GetApplicationRoutesCallCount()).To(Equal(1))
 													Expect(fakeV2AppActor.GetApplicationRoutesArgsForCall(0)).To(Equal("some-app-guid"))
 												})
+
+												It("prints the push result", func() {
+													Expect(executeErr).ToNot(HaveOccurred())
+
+													Expect(testUI.Out).To(Say("app guid:\\s+some-app-guid"))
+													Expect(testUI.Out).To(Say("droplet guid:\\s+some-droplet-guid"))
+													Expect(testUI.Out).To(Say("revision:\\s+some-guid"))
+													Expect(testUI.Out).To(Say("start duration:\\s+\\d+"))
+													Expect(testUI.Out).ToNot(Say("Push result written to"))
+												})
+
+												Context("when --result-file is provided", func() {
+													var resultFile string
+
+													BeforeEach(func() {
+														tmpFile, err := ioutil.TempFile("", "push-result")
+														Expect(err).ToNot(HaveOccurred())
+														Expect(tmpFile.Close()).To(Succeed())
+														resultFile = tmpFile.Name()
+														cmd.ResultFile = flag.Path(resultFile)
+													})
+
+													AfterEach(func() {
+														Expect(os.Remove(resultFile)).To(Succeed())
+													})
+
+													It("writes the push result to the file", func() {
+														Expect(executeErr).ToNot(HaveOccurred())
+														Expect(testUI.Out).To(Say("Push result written to %s", resultFile))
+
+														raw, err := ioutil.ReadFile(resultFile)
+														Expect(err).ToNot(HaveOccurred())
+
+														var result shared.PushResult
+														Expect(json.Unmarshal(raw, &result)).To(Succeed())
+														Expect(result).To(Equal(shared.PushResult{
+															AppName:     "some-app",
+															AppGUID:     "some-app-guid",
+															Status:      shared.PushSucceeded,
+															Routes:      []string{"some-other-domain", "some-domain"},
+															DropletGUID: "some-droplet-guid",
+															Revision:    "some-guid",
+															Processes: []shared.PushResultProcess{
+																{Type: "worker", HealthyInstances: 1, TotalInstances: 1},
+															},
+														}))
+													})
+
+													Context("when the result file cannot be written", func() {
+														BeforeEach(func() {
+															cmd.ResultFile = flag.Path(resultFile + "/not-a-directory/result.json")
+														})
+
+														It("returns the error", func() {
+															Expect(executeErr).To(HaveOccurred())
+															Expect(testUI.Out).ToNot(Say("Push result written to"))
+														})
+													})
+												})
 											})
 										})
 									})
@@ -901,6 +1230,120 @@ var _ = Describe("v3-push Command", func() {
 						Expect(fakeActor.StartApplicationCallCount()).To(Equal(1), "Expected StartApplication to be called")
 					})
 				})
+
+				Context("when the rolling strategy is provided", func() {
+					BeforeEach(func() {
+						cmd.Strategy = "rolling"
+						fakeActor.CloudControllerAPIVersionReturns(version.MinVersionDeploymentsV3)
+					})
+
+					Context("when the application is stopped", func() {
+						BeforeEach(func() {
+							fakeActor.UpdateApplicationReturns(v3action.Application{GUID: "some-app-guid", State: "STOPPED"}, nil, nil)
+						})
+
+						It("starts the application instead of deploying it", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(0))
+							Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(1))
+							Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when the application is started", func() {
+						BeforeEach(func() {
+							fakeActor.UpdateApplicationReturns(v3action.Application{GUID: "some-app-guid", State: "STARTED"}, nil, nil)
+							fakeActor.StagePackageStub = func(_ string, _ string, _ []string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+								dropletStream := make(chan v3action.Droplet)
+								warningsStream := make(chan v3action.Warnings)
+								errorStream := make(chan error)
+
+								go func() {
+									defer close(dropletStream)
+									defer close(warningsStream)
+									defer close(errorStream)
+									dropletStream <- v3action.Droplet{GUID: "some-droplet-guid"}
+								}()
+
+								return dropletStream, warningsStream, errorStream
+							}
+							fakeActor.CreateDeploymentReturns(v3action.Deployment{GUID: "some-deployment-guid"}, v3action.Warnings{"create-deployment-warning"}, nil)
+						})
+
+						Context("when the deployment succeeds", func() {
+							BeforeEach(func() {
+								fakeActor.PollDeploymentStub = func(_ string, warnings chan<- v3action.Warnings) error {
+									warnings <- v3action.Warnings{"poll-deployment-warning"}
+									return nil
+								}
+							})
+
+							It("deploys the new droplet without stopping the application", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out).ToNot(Say("Stopping"))
+								Expect(testUI.Out).To(Say("Starting rolling deployment of droplet some-droplet-guid to app some-app in org some-org / space some-space as banana..."))
+								Expect(testUI.Out).To(Say("OK"))
+								Expect(testUI.Out).To(Say("Waiting for app to deploy..."))
+								Expect(testUI.Err).To(Say("create-deployment-warning"))
+								Expect(testUI.Err).To(Say("poll-deployment-warning"))
+
+								Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+								Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+								Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+								Expect(fakeActor.PollStartCallCount()).To(Equal(0))
+
+								Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(1))
+								appGUID, dropletGUID := fakeActor.CreateDeploymentArgsForCall(0)
+								Expect(appGUID).To(Equal("some-app-guid"))
+								Expect(dropletGUID).To(Equal("some-droplet-guid"))
+
+								Expect(fakeActor.PollDeploymentCallCount()).To(Equal(1))
+								deploymentGUID, _ := fakeActor.PollDeploymentArgsForCall(0)
+								Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+							})
+						})
+
+						Context("when creating the deployment fails", func() {
+							var expectedErr error
+
+							BeforeEach(func() {
+								expectedErr = errors.New("create deployment error")
+								fakeActor.CreateDeploymentReturns(v3action.Deployment{}, v3action.Warnings{"create-deployment-warning"}, expectedErr)
+							})
+
+							It("returns the error and displays warnings", func() {
+								Expect(executeErr).To(MatchError(expectedErr))
+								Expect(testUI.Err).To(Say("create-deployment-warning"))
+								Expect(fakeActor.PollDeploymentCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when the deployment is canceled", func() {
+							BeforeEach(func() {
+								fakeActor.PollDeploymentReturns(v3action.DeploymentCanceledError{})
+							})
+
+							It("returns a DeploymentCanceledError", func() {
+								Expect(executeErr).To(MatchError(translatableerror.DeploymentCanceledError{}))
+							})
+						})
+
+						Context("when the deployment times out", func() {
+							BeforeEach(func() {
+								fakeActor.PollDeploymentReturns(v3action.StartupTimeoutError{})
+							})
+
+							It("returns a StartupTimeoutError", func() {
+								Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{
+									AppName:    "some-app",
+									BinaryName: binaryName,
+								}))
+							})
+						})
+					})
+				})
 			})
 		})
 	})
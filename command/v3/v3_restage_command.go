@@ -0,0 +1,88 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3RestageActor
+
+type V3RestageActor interface {
+	CloudControllerAPIVersion() string
+	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error)
+	RestageApplication(appName string, spaceGUID string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error)
+}
+
+type V3RestageCommand struct {
+	RequiredArgs        flag.AppName `positional-args:"yes"`
+	usage               interface{}  `usage:"CF_NAME v3-restage APP_NAME"`
+	envCFStagingTimeout interface{}  `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+
+	UI          command.UI
+	Config      command.Config
+	NOAAClient  v3action.NOAAClient
+	SharedActor command.SharedActor
+	Actor       V3RestageActor
+}
+
+func (cmd *V3RestageCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.APIInfo.Logging(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cmd V3RestageCommand) Execute(args []string) error {
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Restaging app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	logStream, logErrStream, logWarnings, logErr := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.NOAAClient)
+	cmd.UI.DisplayWarnings(logWarnings)
+	if logErr != nil {
+		return shared.HandleError(logErr)
+	}
+
+	dropletStream, warningsStream, errStream := cmd.Actor.RestageApplication(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	_, err = shared.PollStage(dropletStream, warningsStream, errStream, logStream, logErrStream, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
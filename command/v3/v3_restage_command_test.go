@@ -0,0 +1,212 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-restage Command", func() {
+	var (
+		cmd             v3.V3RestageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3RestageActor
+		fakeNOAAClient  *v3actionfakes.FakeNOAAClient
+
+		binaryName string
+		executeErr error
+		app        string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3RestageActor)
+		fakeNOAAClient = new(v3actionfakes.FakeNOAAClient)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		app = "some-app"
+
+		cmd = v3.V3RestageCommand{
+			RequiredArgs: flag.AppName{AppName: app},
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			NOAAClient:  fakeNOAAClient,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: version.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+				GUID: "some-org-guid",
+				Name: "some-org",
+			})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{
+				GUID: "some-space-guid",
+				Name: "some-space",
+			})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		})
+
+		Context("when the logging does not error", func() {
+			var allLogsWritten chan bool
+
+			BeforeEach(func() {
+				allLogsWritten = make(chan bool)
+				fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(appName string, spaceGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error) {
+					logStream := make(chan *v3action.LogMessage)
+					errorStream := make(chan error)
+
+					go func() {
+						logStream <- v3action.NewLogMessage("Here are some staging logs!", 1, time.Now(), v3action.StagingLog, "sourceInstance")
+						allLogsWritten <- true
+					}()
+
+					return logStream, errorStream, v3action.Warnings{"log-warning"}, nil
+				}
+			})
+
+			Context("when the restage is successful", func() {
+				BeforeEach(func() {
+					fakeActor.RestageApplicationStub = func(_ string, _ string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+						dropletStream := make(chan v3action.Droplet)
+						warningsStream := make(chan v3action.Warnings)
+						errorStream := make(chan error)
+
+						go func() {
+							<-allLogsWritten
+							defer close(dropletStream)
+							defer close(warningsStream)
+							defer close(errorStream)
+							warningsStream <- v3action.Warnings{"some-warning", "some-other-warning"}
+							dropletStream <- v3action.Droplet{GUID: "some-droplet-guid", State: v3action.DropletStateStaged}
+						}()
+
+						return dropletStream, warningsStream, errorStream
+					}
+				})
+
+				It("restages the app and displays staging logs and warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Restaging app some-app in org some-org / space some-space as steve..."))
+					Expect(testUI.Out).To(Say("Here are some staging logs!"))
+					Expect(testUI.Out).To(Say("OK"))
+
+					Expect(testUI.Err).To(Say("log-warning"))
+					Expect(testUI.Err).To(Say("some-warning"))
+					Expect(testUI.Err).To(Say("some-other-warning"))
+
+					Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					appName, spaceGUID, noaaClient := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(appName).To(Equal(app))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(noaaClient).To(Equal(fakeNOAAClient))
+
+					Expect(fakeActor.RestageApplicationCallCount()).To(Equal(1))
+					appName, spaceGUID = fakeActor.RestageApplicationArgsForCall(0)
+					Expect(appName).To(Equal(app))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+				})
+			})
+
+			Context("when the restage returns an error", func() {
+				BeforeEach(func() {
+					fakeActor.RestageApplicationStub = func(_ string, _ string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+						dropletStream := make(chan v3action.Droplet)
+						warningsStream := make(chan v3action.Warnings)
+						errorStream := make(chan error)
+
+						go func() {
+							<-allLogsWritten
+							defer close(dropletStream)
+							defer close(warningsStream)
+							defer close(errorStream)
+							warningsStream <- v3action.Warnings{"some-warning"}
+							errorStream <- v3action.ReadyPackageNotFoundError{AppName: app}
+						}()
+
+						return dropletStream, warningsStream, errorStream
+					}
+				})
+
+				It("returns the translated error and displays warnings", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ReadyPackageNotFoundError{AppName: app}))
+
+					Expect(testUI.Out).ToNot(Say("OK"))
+					Expect(testUI.Err).To(Say("some-warning"))
+				})
+			})
+		})
+
+		Context("when the logging returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("something is wrong!")
+				fakeActor.GetStreamingLogsForApplicationByNameAndSpaceReturns(nil, nil, v3action.Warnings{"some-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(Equal(expectedErr))
+				Expect(testUI.Err).To(Say("some-warning"))
+				Expect(fakeActor.RestageApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
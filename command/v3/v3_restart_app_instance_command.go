@@ -5,6 +5,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/version"
 )
@@ -14,13 +15,17 @@ import (
 type V3RestartAppInstanceActor interface {
 	CloudControllerAPIVersion() string
 	DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, instanceIndex int) (v3action.Warnings, error)
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetProcessInstancesByApplicationAndType(appGUID string, processType string) ([]v3action.Instance, v3action.Warnings, error)
+	PollProcessInstance(appGUID string, processType string, replacedInstance v3action.Instance) (v3action.Warnings, error)
 }
 
 type V3RestartAppInstanceCommand struct {
-	RequiredArgs    flag.AppInstance `positional-args:"yes"`
-	ProcessType     string           `long:"process" default:"web" description:"Process to restart"`
-	usage           interface{}      `usage:"CF_NAME v3-restart-app-instance APP_NAME INDEX [--process PROCESS]"`
-	relatedCommands interface{}      `related_commands:"v3-restart"`
+	RequiredArgs    flag.AppInstanceOptionalIndex `positional-args:"yes"`
+	ProcessType     string                        `long:"process" default:"web" description:"Process to restart"`
+	All             bool                          `long:"all" description:"Restart all instances of the process one at a time, waiting for each to be running before restarting the next"`
+	usage           interface{}                   `usage:"CF_NAME v3-restart-app-instance APP_NAME INDEX [--process PROCESS]\n   CF_NAME v3-restart-app-instance APP_NAME --all [--process PROCESS]"`
+	relatedCommands interface{}                   `related_commands:"v3-restart"`
 
 	UI          command.UI
 	Config      command.Config
@@ -37,13 +42,18 @@ func (cmd *V3RestartAppInstanceCommand) Setup(config command.Config, ui command.
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(ccClient, config)
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
 
 	return nil
 }
 
 func (cmd V3RestartAppInstanceCommand) Execute(args []string) error {
-	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	err := cmd.validateArgs()
+	if err != nil {
+		return err
+	}
+
+	err = version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
 	if err != nil {
 		return err
 	}
@@ -58,8 +68,12 @@ func (cmd V3RestartAppInstanceCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.All {
+		return cmd.restartAllInstances(user.Name)
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Restarting instance {{.InstanceIndex}} of process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"InstanceIndex": cmd.RequiredArgs.Index,
+		"InstanceIndex": *cmd.RequiredArgs.Index,
 		"ProcessType":   cmd.ProcessType,
 		"AppName":       cmd.RequiredArgs.AppName,
 		"Username":      user.Name,
@@ -67,7 +81,7 @@ func (cmd V3RestartAppInstanceCommand) Execute(args []string) error {
 		"SpaceName":     cmd.Config.TargetedSpace().Name,
 	})
 
-	warnings, err := cmd.Actor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.ProcessType, cmd.RequiredArgs.Index)
+	warnings, err := cmd.Actor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.ProcessType, *cmd.RequiredArgs.Index)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
@@ -76,3 +90,75 @@ func (cmd V3RestartAppInstanceCommand) Execute(args []string) error {
 	cmd.UI.DisplayOK()
 	return nil
 }
+
+func (cmd V3RestartAppInstanceCommand) validateArgs() error {
+	switch {
+	case cmd.All && cmd.RequiredArgs.Index != nil:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"INDEX", "--all"},
+		}
+	case !cmd.All && cmd.RequiredArgs.Index == nil:
+		return translatableerror.RequiredArgumentError{
+			ArgumentName: "INDEX",
+		}
+	}
+	return nil
+}
+
+// restartAllInstances restarts every instance of the process in index order,
+// waiting for each replacement instance to be running before moving on to
+// the next one.
+func (cmd V3RestartAppInstanceCommand) restartAllInstances(userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Restarting all instances of process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ProcessType": cmd.ProcessType,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"Username":    userName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+	})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	instances, warnings, err := cmd.Actor.GetProcessInstancesByApplicationAndType(app.GUID, cmd.ProcessType)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	for _, instance := range instances {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Restarting instance {{.InstanceIndex}}...", map[string]interface{}{
+			"InstanceIndex": instance.Index,
+		})
+
+		warnings, err = cmd.Actor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.ProcessType, instance.Index)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		cmd.UI.DisplayText("Waiting for instance {{.InstanceIndex}} to start...", map[string]interface{}{
+			"InstanceIndex": instance.Index,
+		})
+
+		warnings, err = cmd.Actor.PollProcessInstance(app.GUID, cmd.ProcessType, instance)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			if _, ok := err.(v3action.StartupTimeoutError); ok {
+				return translatableerror.StartupTimeoutError{
+					AppName:    cmd.RequiredArgs.AppName,
+					BinaryName: cmd.Config.BinaryName(),
+				}
+			}
+			return shared.HandleError(err)
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -29,6 +29,7 @@ var _ = Describe("v3-restart-app-instance Command", func() {
 		processType     string
 		executeErr      error
 		app             string
+		index           int
 	)
 
 	BeforeEach(func() {
@@ -41,9 +42,10 @@ var _ = Describe("v3-restart-app-instance Command", func() {
 		fakeConfig.BinaryNameReturns(binaryName)
 		app = "some-app"
 		processType = "some-special-type"
+		index = 6
 
 		cmd = v3.V3RestartAppInstanceCommand{
-			RequiredArgs: flag.AppInstance{AppName: app, Index: 6},
+			RequiredArgs: flag.AppInstanceOptionalIndex{AppName: app, Index: &index},
 			ProcessType:  processType,
 
 			UI:          testUI,
@@ -59,6 +61,30 @@ var _ = Describe("v3-restart-app-instance Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when neither INDEX nor --all is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Index = nil
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{
+				ArgumentName: "INDEX",
+			}))
+		})
+	})
+
+	Context("when both INDEX and --all are provided", func() {
+		BeforeEach(func() {
+			cmd.All = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"INDEX", "--all"},
+			}))
+		})
+	})
+
 	Context("when the API version is below the minimum", func() {
 		BeforeEach(func() {
 			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
@@ -147,5 +173,122 @@ var _ = Describe("v3-restart-app-instance Command", func() {
 				Expect(testUI.Err).To(Say("some-warning"))
 			})
 		})
+
+		Context("when --all is provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Index = nil
+				cmd.All = true
+				fakeConfig.BinaryNameReturns(binaryName)
+			})
+
+			Context("when getting the application returns an error", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"get-app-warning"}, v3action.ApplicationNotFoundError{Name: app})
+				})
+
+				It("displays all warnings and returns the error", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: app}))
+
+					Expect(testUI.Out).To(Say("Restarting all instances of process some-special-type of app some-app in org some-org / space some-space as steve"))
+					Expect(testUI.Err).To(Say("get-app-warning"))
+				})
+			})
+
+			Context("when getting the application succeeds", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid"}, v3action.Warnings{"get-app-warning"}, nil)
+				})
+
+				Context("when getting the process instances returns an error", func() {
+					BeforeEach(func() {
+						fakeActor.GetProcessInstancesByApplicationAndTypeReturns(nil, v3action.Warnings{"get-instances-warning"}, v3action.ProcessNotFoundError{ProcessType: processType})
+					})
+
+					It("displays all warnings and returns the error", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ProcessNotFoundError{ProcessType: processType}))
+
+						Expect(testUI.Err).To(Say("get-app-warning"))
+						Expect(testUI.Err).To(Say("get-instances-warning"))
+						Expect(fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when getting the process instances succeeds", func() {
+					BeforeEach(func() {
+						fakeActor.GetProcessInstancesByApplicationAndTypeReturns(
+							[]v3action.Instance{{Index: 0, Uptime: 100}, {Index: 1, Uptime: 200}},
+							v3action.Warnings{"get-instances-warning"},
+							nil)
+						fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns(v3action.Warnings{"delete-warning"}, nil)
+						fakeActor.PollProcessInstanceReturns(v3action.Warnings{"poll-warning"}, nil)
+					})
+
+					It("restarts each instance and waits for it to start before restarting the next", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.GetProcessInstancesByApplicationAndTypeCallCount()).To(Equal(1))
+						appGUID, pType := fakeActor.GetProcessInstancesByApplicationAndTypeArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(pType).To(Equal(processType))
+
+						Expect(fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(2))
+						Expect(fakeActor.PollProcessInstanceCallCount()).To(Equal(2))
+						for i := 0; i < 2; i++ {
+							appName, spaceGUID, pType, instanceIndex := fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall(i)
+							Expect(appName).To(Equal(app))
+							Expect(spaceGUID).To(Equal("some-space-guid"))
+							Expect(pType).To(Equal(processType))
+							Expect(instanceIndex).To(Equal(i))
+
+							appGUID, pType, replacedInstance := fakeActor.PollProcessInstanceArgsForCall(i)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(pType).To(Equal(processType))
+							Expect(replacedInstance).To(Equal(v3action.Instance{Index: i, Uptime: 100 * (i + 1)}))
+						}
+					})
+
+					It("displays progress, all warnings and OK", func() {
+						Expect(testUI.Out).To(Say("Restarting all instances of process some-special-type of app some-app in org some-org / space some-space as steve"))
+						Expect(testUI.Out).To(Say("Restarting instance 0..."))
+						Expect(testUI.Out).To(Say("Waiting for instance 0 to start..."))
+						Expect(testUI.Out).To(Say("Restarting instance 1..."))
+						Expect(testUI.Out).To(Say("Waiting for instance 1 to start..."))
+						Expect(testUI.Out).To(Say("OK"))
+
+						Expect(testUI.Err).To(Say("get-app-warning"))
+						Expect(testUI.Err).To(Say("get-instances-warning"))
+						Expect(testUI.Err).To(Say("delete-warning"))
+						Expect(testUI.Err).To(Say("poll-warning"))
+					})
+
+					Context("when deleting an instance returns an error", func() {
+						BeforeEach(func() {
+							fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns(v3action.Warnings{"delete-warning"}, errors.New("some-delete-error"))
+						})
+
+						It("stops restarting instances and returns the error", func() {
+							Expect(executeErr).To(MatchError("some-delete-error"))
+							Expect(fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(1))
+							Expect(fakeActor.PollProcessInstanceCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when waiting for an instance times out", func() {
+						BeforeEach(func() {
+							fakeActor.PollProcessInstanceReturns(v3action.Warnings{"poll-warning"}, v3action.StartupTimeoutError{})
+						})
+
+						It("stops restarting instances and returns a StartupTimeoutError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{
+								AppName:    app,
+								BinaryName: binaryName,
+							}))
+							Expect(fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(1))
+							Expect(testUI.Err).To(Say("poll-warning"))
+						})
+					})
+				})
+			})
+		})
 	})
 })
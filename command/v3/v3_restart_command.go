@@ -1,11 +1,15 @@
 package v3
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -13,20 +17,31 @@ import (
 
 type V3RestartActor interface {
 	CloudControllerAPIVersion() string
+	CreateDeployment(appGUID string, dropletGUID string) (v3action.Deployment, v3action.Warnings, error)
+	DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, instanceIndex int) (v3action.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetProcessInstancesByApplicationAndType(appGUID string, processType string) ([]v3action.Instance, v3action.Warnings, error)
+	GetStreamingLogs(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error)
+	PollDeployment(deploymentGUID string, warnings chan<- v3action.Warnings) error
+	PollProcessInstance(appGUID string, processType string, replacedInstance v3action.Instance) (v3action.Warnings, error)
+	PollStart(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error
 	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
 	StopApplication(appGUID string) (v3action.Warnings, error)
 }
 
 type V3RestartCommand struct {
-	RequiredArgs        flag.AppName `positional-args:"yes"`
-	usage               interface{}  `usage:"CF_NAME v3-restart APP_NAME"`
-	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	RequiredArgs        flag.AppName         `positional-args:"yes"`
+	StartTimeout        flag.AppStartTimeout `short:"t" long:"app-start-timeout" description:"Max wait time for app instance startup, in minutes; overrides CF_STARTUP_TIMEOUT"`
+	Strategy            string               `long:"strategy" choice:"rolling" description:"Restart strategy; rolling replaces the instances of a running app a few at a time instead of stopping and starting it"`
+	MaxInFlight         flag.MaxInFlight     `long:"max-in-flight" description:"Maximum number of instances restarted at the same time by the rolling strategy (Default: 1)"`
+	usage               interface{}          `usage:"CF_NAME v3-restart APP_NAME [-t TIMEOUT] [--strategy rolling [--max-in-flight N]]"`
+	envCFStartupTimeout interface{}          `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       V3RestartActor
+	NOAAClient  v3action.NOAAClient
 }
 
 func (cmd *V3RestartCommand) Setup(config command.Config, ui command.UI) error {
@@ -34,16 +49,27 @@ func (cmd *V3RestartCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Config = config
 	cmd.SharedActor = sharedaction.NewActor()
 
-	ccClient, _, err := shared.NewClients(config, ui, true)
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.APIInfo.Logging(), config, uaaClient, ui)
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(ccClient, config)
 
 	return nil
 }
 
 func (cmd V3RestartCommand) Execute(args []string) error {
+	if cmd.MaxInFlight.IsSet && cmd.Strategy == "" {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--max-in-flight",
+			Arg2: "--strategy",
+		}
+	}
+
 	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
 	if err != nil {
 		return err
@@ -65,6 +91,10 @@ func (cmd V3RestartCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if app.Started() && cmd.Strategy != "" {
+		return cmd.rollingRestart(app, user.Name)
+	}
+
 	if app.Started() {
 		cmd.UI.DisplayTextWithFlavor("Stopping app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 			"AppName":   cmd.RequiredArgs.AppName,
@@ -96,7 +126,149 @@ func (cmd V3RestartCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	cmd.UI.DisplayText("Waiting for app to start...")
+	cmd.UI.DisplayProgressEvent(ui.ProgressEvent{Phase: ui.ProgressPhaseStarting, Message: "Waiting for app to start"})
+
+	logStream, logErrStream := cmd.Actor.GetStreamingLogs(app.GUID, cmd.NOAAClient)
+	err = shared.PollStart(cmd.Actor, app.GUID, cmd.StartTimeout.Duration(), logStream, logErrStream, cmd.UI)
+	cmd.NOAAClient.Close()
+	if err != nil {
+		if _, ok := err.(v3action.StartupTimeoutError); ok {
+			return translatableerror.StartupTimeoutError{
+				AppName:    cmd.RequiredArgs.AppName,
+				BinaryName: cmd.Config.BinaryName(),
+			}
+		}
+
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// rollingRestart replaces the instances of the started app without stopping
+// it. A deployment of the app's current droplet is used when the API supports
+// deployments, since deployments replace instances one at a time; otherwise,
+// or when more instances may be restarted at once, the web process instances
+// are restarted in batches of --max-in-flight.
+func (cmd V3RestartCommand) rollingRestart(app v3action.Application, userName string) error {
+	maxInFlight := 1
+	if cmd.MaxInFlight.IsSet {
+		maxInFlight = cmd.MaxInFlight.Value
+	}
+
+	var err error
+	if maxInFlight == 1 && version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionDeploymentsV3) == nil {
+		err = cmd.restartWithDeployment(app, userName)
+	} else {
+		err = cmd.restartInBatches(app, userName, maxInFlight)
+	}
+
+	if err != nil {
+		if _, ok := err.(v3action.StartupTimeoutError); ok {
+			return translatableerror.StartupTimeoutError{
+				AppName:    cmd.RequiredArgs.AppName,
+				BinaryName: cmd.Config.BinaryName(),
+			}
+		}
+
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd V3RestartCommand) restartWithDeployment(app v3action.Application, userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Restarting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}} with a rolling deployment...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  userName,
+	})
+
+	deployment, warnings, err := cmd.Actor.CreateDeployment(app.GUID, "")
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
 	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("Waiting for app to deploy...")
+	cmd.UI.DisplayProgressEvent(ui.ProgressEvent{Phase: ui.ProgressPhaseDeploying, Message: "Waiting for app to deploy"})
+
+	pollWarnings := make(chan v3action.Warnings)
+	done := make(chan bool)
+	go func() {
+		for {
+			select {
+			case message := <-pollWarnings:
+				cmd.UI.DisplayWarnings(message)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err = cmd.Actor.PollDeployment(deployment.GUID, pollWarnings)
+	done <- true
+
+	return err
+}
+
+func (cmd V3RestartCommand) restartInBatches(app v3action.Application, userName string, maxInFlight int) error {
+	cmd.UI.DisplayTextWithFlavor("Restarting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}, {{.MaxInFlight}} instance(s) at a time...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    userName,
+		"MaxInFlight": maxInFlight,
+	})
+
+	instances, warnings, err := cmd.Actor.GetProcessInstancesByApplicationAndType(app.GUID, "web")
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(instances); start += maxInFlight {
+		end := start + maxInFlight
+		if end > len(instances) {
+			end = len(instances)
+		}
+		batch := instances[start:end]
+
+		cmd.UI.DisplayNewline()
+		for _, instance := range batch {
+			cmd.UI.DisplayText("Restarting instance {{.InstanceIndex}}...", map[string]interface{}{
+				"InstanceIndex": instance.Index,
+			})
+
+			warnings, err = cmd.Actor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, "web", instance.Index)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, instance := range batch {
+			cmd.UI.DisplayText("Waiting for instance {{.InstanceIndex}} to start...", map[string]interface{}{
+				"InstanceIndex": instance.Index,
+			})
+
+			warnings, err = cmd.Actor.PollProcessInstance(app.GUID, "web", instance)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	cmd.UI.DisplayNewline()
 
 	return nil
 }
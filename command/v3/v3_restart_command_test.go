@@ -2,14 +2,18 @@ package v3_test
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/cli/version"
@@ -25,6 +29,7 @@ var _ = Describe("v3-restart Command", func() {
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v3fakes.FakeV3RestartActor
+		fakeNOAAClient  *v3actionfakes.FakeNOAAClient
 		binaryName      string
 		executeErr      error
 		app             string
@@ -35,6 +40,7 @@ var _ = Describe("v3-restart Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v3fakes.FakeV3RestartActor)
+		fakeNOAAClient = new(v3actionfakes.FakeNOAAClient)
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
@@ -47,6 +53,7 @@ var _ = Describe("v3-restart Command", func() {
 			Config:      fakeConfig,
 			SharedActor: fakeSharedActor,
 			Actor:       fakeActor,
+			NOAAClient:  fakeNOAAClient,
 		}
 
 		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
@@ -69,6 +76,20 @@ var _ = Describe("v3-restart Command", func() {
 		})
 	})
 
+	Context("when --max-in-flight is provided without --strategy", func() {
+		BeforeEach(func() {
+			cmd.MaxInFlight = flag.MaxInFlight{NullInt: types.NullInt{Value: 2, IsSet: true}}
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--max-in-flight",
+				Arg2: "--strategy",
+			}))
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: binaryName})
@@ -186,6 +207,68 @@ var _ = Describe("v3-restart Command", func() {
 							appGUID := fakeActor.StartApplicationArgsForCall(0)
 							Expect(appGUID).To(Equal("some-app-guid"))
 						})
+
+						Context("while waiting for the app to start", func() {
+							BeforeEach(func() {
+								logStream := make(chan *v3action.LogMessage)
+								fakeActor.GetStreamingLogsReturns(logStream, make(chan error))
+								fakeActor.PollStartStub = func(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error {
+									logStream <- v3action.NewLogMessage("some-app-log", 1, time.Now(), "APP", "0")
+									warnings <- v3action.Warnings{"poll-warning-1", "poll-warning-2"}
+									return nil
+								}
+							})
+
+							It("streams the app logs and polling warnings before displaying OK", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out).To(Say("Waiting for app to start\\.\\.\\."))
+								Expect(testUI.Out).To(Say("some-app-log"))
+								Expect(testUI.Out).To(Say("OK"))
+								Expect(testUI.Err).To(Say("poll-warning-1"))
+								Expect(testUI.Err).To(Say("poll-warning-2"))
+
+								Expect(fakeActor.GetStreamingLogsCallCount()).To(Equal(1))
+								appGUID, noaaClient := fakeActor.GetStreamingLogsArgsForCall(0)
+								Expect(appGUID).To(Equal("some-app-guid"))
+								Expect(noaaClient).To(Equal(fakeNOAAClient))
+
+								Expect(fakeActor.PollStartCallCount()).To(Equal(1))
+								appGUID, startupTimeout, _ := fakeActor.PollStartArgsForCall(0)
+								Expect(appGUID).To(Equal("some-app-guid"))
+								Expect(startupTimeout).To(BeZero())
+
+								Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+							})
+						})
+
+						Context("when an app start timeout is provided", func() {
+							BeforeEach(func() {
+								cmd.StartTimeout = flag.AppStartTimeout{NullInt: types.NullInt{Value: 10, IsSet: true}}
+							})
+
+							It("polls with the provided timeout", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(fakeActor.PollStartCallCount()).To(Equal(1))
+								_, startupTimeout, _ := fakeActor.PollStartArgsForCall(0)
+								Expect(startupTimeout).To(Equal(10 * time.Minute))
+							})
+						})
+
+						Context("when polling times out", func() {
+							BeforeEach(func() {
+								fakeActor.PollStartReturns(v3action.StartupTimeoutError{})
+							})
+
+							It("returns the StartupTimeoutError", func() {
+								Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{
+									AppName:    "some-app",
+									BinaryName: binaryName,
+								}))
+								Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+							})
+						})
 					})
 				})
 
@@ -317,5 +400,153 @@ var _ = Describe("v3-restart Command", func() {
 				})
 			})
 		})
+
+		Context("when the rolling strategy is used", func() {
+			BeforeEach(func() {
+				cmd.Strategy = "rolling"
+				fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid", State: "STARTED"}, v3action.Warnings{"get-warning"}, nil)
+			})
+
+			Context("when the API supports deployments", func() {
+				BeforeEach(func() {
+					fakeActor.CloudControllerAPIVersionReturns(version.MinVersionDeploymentsV3)
+					fakeActor.CreateDeploymentReturns(v3action.Deployment{GUID: "some-deployment-guid"}, v3action.Warnings{"deployment-warning"}, nil)
+					fakeActor.PollDeploymentStub = func(_ string, warnings chan<- v3action.Warnings) error {
+						warnings <- v3action.Warnings{"poll-warning"}
+						return nil
+					}
+				})
+
+				It("deploys the current droplet without stopping the app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Restarting app some-app in org some-org / space some-space as steve with a rolling deployment\\.\\.\\."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("Waiting for app to deploy\\.\\.\\."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("get-warning"))
+					Expect(testUI.Err).To(Say("deployment-warning"))
+					Expect(testUI.Err).To(Say("poll-warning"))
+
+					Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(1))
+					appGUID, dropletGUID := fakeActor.CreateDeploymentArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+					Expect(dropletGUID).To(BeEmpty())
+
+					Expect(fakeActor.PollDeploymentCallCount()).To(Equal(1))
+					deploymentGUID, _ := fakeActor.PollDeploymentArgsForCall(0)
+					Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+
+					Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+					Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+					Expect(fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(0))
+				})
+
+				Context("when the deployment times out", func() {
+					BeforeEach(func() {
+						fakeActor.PollDeploymentReturns(v3action.StartupTimeoutError{})
+						fakeActor.PollDeploymentStub = nil
+					})
+
+					It("returns a StartupTimeoutError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{
+							AppName:    "some-app",
+							BinaryName: binaryName,
+						}))
+					})
+				})
+			})
+
+			Context("when the API does not support deployments", func() {
+				BeforeEach(func() {
+					fakeActor.GetProcessInstancesByApplicationAndTypeReturns([]v3action.Instance{{Index: 0}, {Index: 1}, {Index: 2}}, v3action.Warnings{"instances-warning"}, nil)
+					fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns(v3action.Warnings{"delete-warning"}, nil)
+					fakeActor.PollProcessInstanceReturns(v3action.Warnings{"poll-warning"}, nil)
+				})
+
+				It("restarts the web process instances one at a time", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Restarting app some-app in org some-org / space some-space as steve, 1 instance\\(s\\) at a time\\.\\.\\."))
+					Expect(testUI.Out).To(Say("Restarting instance 0\\.\\.\\."))
+					Expect(testUI.Out).To(Say("Waiting for instance 0 to start\\.\\.\\."))
+					Expect(testUI.Out).To(Say("Restarting instance 1\\.\\.\\."))
+					Expect(testUI.Out).To(Say("Waiting for instance 1 to start\\.\\.\\."))
+					Expect(testUI.Out).To(Say("Restarting instance 2\\.\\.\\."))
+					Expect(testUI.Out).To(Say("Waiting for instance 2 to start\\.\\.\\."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("instances-warning"))
+					Expect(testUI.Err).To(Say("delete-warning"))
+					Expect(testUI.Err).To(Say("poll-warning"))
+
+					Expect(fakeActor.GetProcessInstancesByApplicationAndTypeCallCount()).To(Equal(1))
+					appGUID, processType := fakeActor.GetProcessInstancesByApplicationAndTypeArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+					Expect(processType).To(Equal("web"))
+
+					Expect(fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(3))
+					appName, spaceGUID, processType, index := fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall(2)
+					Expect(appName).To(Equal("some-app"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(processType).To(Equal("web"))
+					Expect(index).To(Equal(2))
+
+					Expect(fakeActor.PollProcessInstanceCallCount()).To(Equal(3))
+					Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(0))
+					Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+				})
+
+				Context("when restarting an instance fails", func() {
+					BeforeEach(func() {
+						fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns(nil, v3action.ProcessInstanceNotFoundError{ProcessType: "web", InstanceIndex: 0})
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ProcessInstanceNotFoundError{ProcessType: "web", InstanceIndex: 0}))
+						Expect(fakeActor.PollProcessInstanceCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when --max-in-flight is greater than 1", func() {
+				var calls []string
+
+				BeforeEach(func() {
+					calls = nil
+					cmd.MaxInFlight = flag.MaxInFlight{NullInt: types.NullInt{Value: 2, IsSet: true}}
+					fakeActor.CloudControllerAPIVersionReturns(version.MinVersionDeploymentsV3)
+					fakeActor.GetProcessInstancesByApplicationAndTypeReturns([]v3action.Instance{{Index: 0}, {Index: 1}, {Index: 2}}, nil, nil)
+
+					fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexStub = func(_ string, _ string, _ string, index int) (v3action.Warnings, error) {
+						calls = append(calls, fmt.Sprintf("delete-%d", index))
+						return nil, nil
+					}
+					fakeActor.PollProcessInstanceStub = func(_ string, _ string, instance v3action.Instance) (v3action.Warnings, error) {
+						calls = append(calls, fmt.Sprintf("poll-%d", instance.Index))
+						return nil, nil
+					}
+				})
+
+				It("restarts the instances in batches instead of deploying", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("2 instance\\(s\\) at a time"))
+					Expect(calls).To(Equal([]string{"delete-0", "delete-1", "poll-0", "poll-1", "delete-2", "poll-2"}))
+					Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the app is stopped", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid", State: "STOPPED"}, nil, nil)
+				})
+
+				It("starts the app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+					Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(0))
+					Expect(fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 })
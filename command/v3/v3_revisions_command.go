@@ -0,0 +1,125 @@
+package v3
+
+import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3RevisionsActor
+
+type V3RevisionsActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetRevisionsByApplication(appGUID string) ([]v3action.Revision, v3action.Warnings, error)
+}
+
+type V3RevisionsCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME v3-revisions APP_NAME"`
+	relatedCommands interface{}  `related_commands:"v3-rollback"`
+
+	UI          command.UI
+	Config      command.Config
+	Actor       V3RevisionsActor
+	SharedActor command.SharedActor
+}
+
+func (cmd *V3RevisionsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd V3RevisionsCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionRevisionsV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting revisions for app {{.AppName}} in org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":      cmd.RequiredArgs.AppName,
+		"CurrentSpace": cmd.Config.TargetedSpace().Name,
+		"CurrentOrg":   cmd.Config.TargetedOrganization().Name,
+		"CurrentUser":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	revisions, warnings, err := cmd.Actor.GetRevisionsByApplication(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(revisions) == 0 {
+		cmd.UI.DisplayText("No revisions found")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("revision"),
+			cmd.UI.TranslateText("description"),
+			cmd.UI.TranslateText("deployed"),
+			cmd.UI.TranslateText("droplet"),
+			cmd.UI.TranslateText("created"),
+		},
+	}
+
+	for _, revision := range revisions {
+		t, err := time.Parse(time.RFC3339, revision.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		var deployed string
+		if revision.Deployed {
+			deployed = cmd.UI.TranslateText("yes")
+		}
+
+		table = append(table, []string{
+			strconv.Itoa(revision.Version),
+			revision.Description,
+			deployed,
+			revision.DropletGUID,
+			cmd.UI.UserFriendlyDate(t),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-revisions Command", func() {
+	var (
+		cmd             v3.V3RevisionsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3RevisionsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3RevisionsActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.V3RevisionsCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			Actor:        fakeActor,
+			SharedActor:  fakeSharedActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionRevisionsV3)
+		fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid"}, v3action.Warnings{"get-app-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.64.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "3.64.0",
+				MinimumVersion: version.MinVersionRevisionsV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is not logged in", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("return an error", func() {
+			Expect(executeErr).To(Equal(expectedErr))
+		})
+	})
+
+	Context("when the application does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"get-app-warning"}, v3action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError and prints warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(fakeActor.GetRevisionsByApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when getting the revisions returns an error", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("get revisions error")
+			fakeActor.GetRevisionsByApplicationReturns(nil, v3action.Warnings{"get-revisions-warning"}, expectedErr)
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+
+			Expect(testUI.Out).To(Say("Getting revisions for app some-app in org some-org / space some-space as steve\\.\\.\\."))
+
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-revisions-warning"))
+		})
+	})
+
+	Context("when the application has revisions", func() {
+		var createdAtOne, createdAtTwo string
+
+		BeforeEach(func() {
+			createdAtOne = "2018-10-01T21:16:42Z"
+			createdAtTwo = "2018-10-02T00:18:24Z"
+			fakeActor.GetRevisionsByApplicationReturns(
+				[]v3action.Revision{
+					{Version: 2, Description: "New droplet deployed.", DropletGUID: "some-droplet-guid-2", CreatedAt: createdAtTwo, Deployed: true},
+					{Version: 1, Description: "Initial revision.", DropletGUID: "some-droplet-guid-1", CreatedAt: createdAtOne},
+				},
+				v3action.Warnings{"get-revisions-warning"},
+				nil)
+		})
+
+		It("displays the revisions and warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting revisions for app some-app in org some-org / space some-space as steve\\.\\.\\.\n"))
+			Expect(testUI.Out).To(Say("\n"))
+
+			createdAtOneParsed, err := time.Parse(time.RFC3339, createdAtOne)
+			Expect(err).ToNot(HaveOccurred())
+			createdAtTwoParsed, err := time.Parse(time.RFC3339, createdAtTwo)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("revision\\s+description\\s+deployed\\s+droplet\\s+created\n"))
+			Expect(testUI.Out).To(Say("2\\s+New droplet deployed\\.\\s+yes\\s+some-droplet-guid-2\\s+%s\n", testUI.UserFriendlyDate(createdAtTwoParsed)))
+			Expect(testUI.Out).To(Say("1\\s+Initial revision\\.\\s+some-droplet-guid-1\\s+%s\n", testUI.UserFriendlyDate(createdAtOneParsed)))
+
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-revisions-warning"))
+
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.GetRevisionsByApplicationCallCount()).To(Equal(1))
+			Expect(fakeActor.GetRevisionsByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+		})
+	})
+
+	Context("when the application has no revisions", func() {
+		BeforeEach(func() {
+			fakeActor.GetRevisionsByApplicationReturns(nil, v3action.Warnings{"get-revisions-warning"}, nil)
+		})
+
+		It("displays there are no revisions", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No revisions found"))
+			Expect(testUI.Err).To(Say("get-revisions-warning"))
+		})
+	})
+})
@@ -0,0 +1,130 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3RollbackActor
+
+type V3RollbackActor interface {
+	CloudControllerAPIVersion() string
+	CreateDeploymentByRevision(appGUID string, revision v3action.Revision) (v3action.Deployment, v3action.Warnings, error)
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetRevisionByApplicationAndVersion(appGUID string, version int) (v3action.Revision, v3action.Warnings, error)
+	PollDeployment(deploymentGUID string, warnings chan<- v3action.Warnings) error
+}
+
+type V3RollbackCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Version         int          `long:"version" required:"true" description:"Revision number to roll back to, as listed by v3-revisions"`
+	usage           interface{}  `usage:"CF_NAME v3-rollback APP_NAME --version REVISION"`
+	relatedCommands interface{}  `related_commands:"v3-revisions"`
+
+	UI          command.UI
+	Config      command.Config
+	Actor       V3RollbackActor
+	SharedActor command.SharedActor
+}
+
+func (cmd *V3RollbackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd V3RollbackCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionRevisionsV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Rolling back app {{.AppName}} to revision {{.Version}} in org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":      cmd.RequiredArgs.AppName,
+		"Version":      cmd.Version,
+		"CurrentSpace": cmd.Config.TargetedSpace().Name,
+		"CurrentOrg":   cmd.Config.TargetedOrganization().Name,
+		"CurrentUser":  user.Name,
+	})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	revision, warnings, err := cmd.Actor.GetRevisionByApplicationAndVersion(app.GUID, cmd.Version)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	deployment, warnings, err := cmd.Actor.CreateDeploymentByRevision(app.GUID, revision)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("Waiting for app to deploy...")
+	cmd.UI.DisplayProgressEvent(ui.ProgressEvent{Phase: ui.ProgressPhaseDeploying, Message: "Waiting for app to deploy"})
+
+	pollWarnings := make(chan v3action.Warnings)
+	done := make(chan bool)
+	go func() {
+		for {
+			select {
+			case message := <-pollWarnings:
+				cmd.UI.DisplayWarnings(message)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err = cmd.Actor.PollDeployment(deployment.GUID, pollWarnings)
+	done <- true
+
+	if err != nil {
+		if _, ok := err.(v3action.StartupTimeoutError); ok {
+			return translatableerror.StartupTimeoutError{
+				AppName:    cmd.RequiredArgs.AppName,
+				BinaryName: cmd.Config.BinaryName(),
+			}
+		}
+
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
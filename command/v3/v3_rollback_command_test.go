@@ -0,0 +1,181 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-rollback Command", func() {
+	var (
+		cmd             v3.V3RollbackCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3RollbackActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3RollbackActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.V3RollbackCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			Version:      3,
+			UI:           testUI,
+			Config:       fakeConfig,
+			Actor:        fakeActor,
+			SharedActor:  fakeSharedActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionRevisionsV3)
+		fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid"}, v3action.Warnings{"get-app-warning"}, nil)
+		fakeActor.GetRevisionByApplicationAndVersionReturns(v3action.Revision{GUID: "some-revision-guid", Version: 3, Deployable: true}, v3action.Warnings{"get-revision-warning"}, nil)
+		fakeActor.CreateDeploymentByRevisionReturns(v3action.Deployment{GUID: "some-deployment-guid"}, v3action.Warnings{"create-deployment-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.64.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "3.64.0",
+				MinimumVersion: version.MinVersionRevisionsV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the revision does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetRevisionByApplicationAndVersionReturns(v3action.Revision{}, v3action.Warnings{"get-revision-warning"}, v3action.RevisionNotFoundError{Version: 3})
+		})
+
+		It("returns a RevisionNotFoundError and prints warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RevisionNotFoundError{Version: 3}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-revision-warning"))
+			Expect(fakeActor.CreateDeploymentByRevisionCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the revision cannot be deployed", func() {
+		BeforeEach(func() {
+			fakeActor.CreateDeploymentByRevisionReturns(v3action.Deployment{}, nil, v3action.RevisionNotDeployableError{Version: 3})
+		})
+
+		It("returns a RevisionNotDeployableError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RevisionNotDeployableError{Version: 3}))
+			Expect(fakeActor.PollDeploymentCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the deployment succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.PollDeploymentStub = func(_ string, warnings chan<- v3action.Warnings) error {
+				warnings <- v3action.Warnings{"poll-warning"}
+				return nil
+			}
+		})
+
+		It("deploys the revision and waits for the deployment", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Rolling back app some-app to revision 3 in org some-org / space some-space as steve\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Waiting for app to deploy\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-revision-warning"))
+			Expect(testUI.Err).To(Say("create-deployment-warning"))
+			Expect(testUI.Err).To(Say("poll-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			appGUID, revisionVersion := fakeActor.GetRevisionByApplicationAndVersionArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(revisionVersion).To(Equal(3))
+
+			appGUID, revision := fakeActor.CreateDeploymentByRevisionArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(revision).To(Equal(v3action.Revision{GUID: "some-revision-guid", Version: 3, Deployable: true}))
+
+			Expect(fakeActor.PollDeploymentCallCount()).To(Equal(1))
+			deploymentGUID, _ := fakeActor.PollDeploymentArgsForCall(0)
+			Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+		})
+	})
+
+	Context("when the deployment times out", func() {
+		BeforeEach(func() {
+			fakeActor.PollDeploymentReturns(v3action.StartupTimeoutError{})
+		})
+
+		It("returns a StartupTimeoutError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{
+				AppName:    "some-app",
+				BinaryName: binaryName,
+			}))
+		})
+	})
+
+	Context("when polling the deployment fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("poll error")
+			fakeActor.PollDeploymentReturns(expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+})
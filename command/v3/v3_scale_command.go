@@ -2,8 +2,7 @@ package v3
 
 import (
 	"strconv"
-
-	"github.com/cloudfoundry/bytefmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
@@ -21,28 +20,31 @@ type V3ScaleActor interface {
 
 	CloudControllerAPIVersion() string
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetStreamingLogs(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error)
 	GetProcessByApplicationAndProcessType(appGUID string, processType string) (v3action.Process, v3action.Warnings, error)
 	ScaleProcessByApplication(appGUID string, process v3action.Process) (v3action.Warnings, error)
 	StopApplication(appGUID string) (v3action.Warnings, error)
 	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
-	PollStart(appGUID string, warnings chan<- v3action.Warnings) error
+	PollStart(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error
 }
 
 type V3ScaleCommand struct {
-	RequiredArgs        flag.AppName   `positional-args:"yes"`
-	Force               bool           `short:"f" description:"Force restart of app without prompt"`
-	ProcessType         string         `long:"process" default:"web" description:"App process to scale"`
-	Instances           flag.Instances `short:"i" required:"false" description:"Number of instances"`
-	DiskLimit           flag.Megabytes `short:"k" required:"false" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	MemoryLimit         flag.Megabytes `short:"m" required:"false" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	usage               interface{}    `usage:"CF_NAME v3-scale APP_NAME [--process PROCESS] [-i INSTANCES] [-k DISK] [-m MEMORY]"`
-	relatedCommands     interface{}    `related_commands:"v3-push"`
-	envCFStartupTimeout interface{}    `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	RequiredArgs        flag.AppName         `positional-args:"yes"`
+	Force               bool                 `short:"f" description:"Force restart of app without prompt"`
+	ProcessType         string               `long:"process" default:"web" description:"App process to scale"`
+	Instances           flag.Instances       `short:"i" required:"false" description:"Number of instances"`
+	DiskLimit           flag.Megabytes       `short:"k" required:"false" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	MemoryLimit         flag.Megabytes       `short:"m" required:"false" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	StartTimeout        flag.AppStartTimeout `short:"t" long:"app-start-timeout" description:"Max wait time for app instance startup, in minutes; overrides CF_STARTUP_TIMEOUT"`
+	usage               interface{}          `usage:"CF_NAME v3-scale APP_NAME [--process PROCESS] [-i INSTANCES] [-k DISK] [-m MEMORY] [-t TIMEOUT]"`
+	relatedCommands     interface{}          `related_commands:"v3-push"`
+	envCFStartupTimeout interface{}          `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
 	UI          command.UI
 	Config      command.Config
 	Actor       V3ScaleActor
 	SharedActor command.SharedActor
+	NOAAClient  v3action.NOAAClient
 }
 
 func (cmd *V3ScaleCommand) Setup(config command.Config, ui command.UI) error {
@@ -50,11 +52,15 @@ func (cmd *V3ScaleCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Config = config
 	cmd.SharedActor = sharedaction.NewActor()
 
-	ccClient, _, err := shared.NewClients(config, ui, true)
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.APIInfo.Logging(), config, uaaClient, ui)
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(ccClient, config)
 
 	return nil
 }
@@ -101,21 +107,9 @@ func (cmd V3ScaleCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
-	pollWarnings := make(chan v3action.Warnings)
-	done := make(chan bool)
-	go func() {
-		for {
-			select {
-			case message := <-pollWarnings:
-				cmd.UI.DisplayWarnings(message)
-			case <-done:
-				return
-			}
-		}
-	}()
-
-	err = cmd.Actor.PollStart(app.GUID, pollWarnings)
-	done <- true
+	logStream, logErrStream := cmd.Actor.GetStreamingLogs(app.GUID, cmd.NOAAClient)
+	err = shared.PollStart(cmd.Actor, app.GUID, cmd.StartTimeout.Duration(), logStream, logErrStream, cmd.UI)
+	cmd.NOAAClient.Close()
 
 	if err != nil {
 		if _, ok := err.(v3action.StartupTimeoutError); ok {
@@ -140,8 +134,8 @@ func (cmd V3ScaleCommand) getAndDisplayProcess(appGUID string) error {
 	}
 
 	keyValueTable := [][]string{
-		{cmd.UI.TranslateText("memory:"), bytefmt.ByteSize(process.MemoryInMB.Value * bytefmt.MEGABYTE)},
-		{cmd.UI.TranslateText("disk:"), bytefmt.ByteSize(process.DiskInMB.Value * bytefmt.MEGABYTE)},
+		{cmd.UI.TranslateText("memory:"), cmd.UI.FormatMegabytes(process.MemoryInMB.Value)},
+		{cmd.UI.TranslateText("disk:"), cmd.UI.FormatMegabytes(process.DiskInMB.Value)},
 		{cmd.UI.TranslateText("instances:"), strconv.Itoa(process.Instances.Value)},
 	}
 
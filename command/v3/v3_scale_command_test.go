@@ -2,9 +2,11 @@ package v3_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3"
@@ -27,6 +29,7 @@ var _ = Describe("v3-scale Command", func() {
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v3fakes.FakeV3ScaleActor
+		fakeNOAAClient  *v3actionfakes.FakeNOAAClient
 		appName         string
 		binaryName      string
 		executeErr      error
@@ -39,6 +42,7 @@ var _ = Describe("v3-scale Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v3fakes.FakeV3ScaleActor)
+		fakeNOAAClient = new(v3actionfakes.FakeNOAAClient)
 		appName = "some-app"
 
 		cmd = v3.V3ScaleCommand{
@@ -46,6 +50,7 @@ var _ = Describe("v3-scale Command", func() {
 			Config:      fakeConfig,
 			SharedActor: fakeSharedActor,
 			Actor:       fakeActor,
+			NOAAClient:  fakeNOAAClient,
 		}
 
 		binaryName = "faceman"
@@ -310,12 +315,45 @@ var _ = Describe("v3-scale Command", func() {
 
 							Context("when polling succeeds", func() {
 								BeforeEach(func() {
-									fakeActor.PollStartStub = func(appGUID string, warnings chan<- v3action.Warnings) error {
+									logStream := make(chan *v3action.LogMessage)
+									fakeActor.GetStreamingLogsReturns(logStream, make(chan error))
+									fakeActor.PollStartStub = func(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error {
+										logStream <- v3action.NewLogMessage("some-app-log", 1, time.Now(), "APP", "0")
 										warnings <- v3action.Warnings{"some-poll-warning-1", "some-poll-warning-2"}
 										return nil
 									}
 								})
 
+								It("streams the app logs while the app starts and closes the log client", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+
+									Expect(testUI.Out).To(Say("Starting app some-app"))
+									Expect(testUI.Out).To(Say("some-app-log"))
+
+									Expect(fakeActor.GetStreamingLogsCallCount()).To(Equal(1))
+									appGUID, noaaClient := fakeActor.GetStreamingLogsArgsForCall(0)
+									Expect(appGUID).To(Equal("some-app-guid"))
+									Expect(noaaClient).To(Equal(fakeNOAAClient))
+
+									Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+								})
+
+								Context("when an app start timeout is provided", func() {
+									BeforeEach(func() {
+										cmd.StartTimeout.Value = 3
+										cmd.StartTimeout.IsSet = true
+									})
+
+									It("polls with the provided timeout", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+
+										Expect(fakeActor.PollStartCallCount()).To(Equal(1))
+										appGUID, startupTimeout, _ := fakeActor.PollStartArgsForCall(0)
+										Expect(appGUID).To(Equal("some-app-guid"))
+										Expect(startupTimeout).To(Equal(3 * time.Minute))
+									})
+								})
+
 								It("scales, restarts, and displays scale properties", func() {
 									Expect(executeErr).ToNot(HaveOccurred())
 
@@ -365,7 +403,7 @@ var _ = Describe("v3-scale Command", func() {
 
 							Context("when polling the start fails", func() {
 								BeforeEach(func() {
-									fakeActor.PollStartStub = func(appGUID string, warnings chan<- v3action.Warnings) error {
+									fakeActor.PollStartStub = func(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error {
 										warnings <- v3action.Warnings{"some-poll-warning-1", "some-poll-warning-2"}
 										return errors.New("some-error")
 									}
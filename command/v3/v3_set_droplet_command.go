@@ -36,7 +36,7 @@ func (cmd *V3SetDropletCommand) Setup(config command.Config, ui command.UI) erro
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(ccClient, config)
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
 
 	return nil
 }
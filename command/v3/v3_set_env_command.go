@@ -0,0 +1,103 @@
+package v3
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3SetEnvActor
+
+type V3SetEnvActor interface {
+	CloudControllerAPIVersion() string
+	SetApplicationEnvironmentVariable(appName string, spaceGUID string, name string, value interface{}) (v3action.Warnings, error)
+}
+
+type V3SetEnvCommand struct {
+	RequiredArgs    flag.SetEnvironmentArgs `positional-args:"yes"`
+	JSON            bool                    `long:"json" description:"Parse ENV_VAR_VALUE as JSON, allowing objects, arrays, numbers and booleans"`
+	usage           interface{}             `usage:"CF_NAME v3-set-env APP_NAME ENV_VAR_NAME ENV_VAR_VALUE [--json]\n\nEXAMPLES:\n   CF_NAME v3-set-env my-app LOG_LEVEL debug\n   CF_NAME v3-set-env my-app FEATURES '{\"beta\": true}' --json"`
+	relatedCommands interface{}             `related_commands:"v3-env, v3-restart, v3-stage"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3SetEnvActor
+}
+
+func (cmd *V3SetEnvCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd V3SetEnvCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	var value interface{} = string(cmd.RequiredArgs.EnvironmentVariableValue)
+	if cmd.JSON {
+		err = json.Unmarshal([]byte(cmd.RequiredArgs.EnvironmentVariableValue), &value)
+		if err != nil {
+			return translatableerror.ParseArgumentError{
+				ArgumentName: "ENV_VAR_VALUE",
+				ExpectedType: "valid JSON",
+			}
+		}
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting env variable {{.EnvVarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"EnvVarName": cmd.RequiredArgs.EnvironmentVariableName,
+		"AppName":    cmd.RequiredArgs.AppName,
+		"OrgName":    cmd.Config.TargetedOrganization().Name,
+		"SpaceName":  cmd.Config.TargetedSpace().Name,
+		"Username":   user.Name,
+	})
+
+	warnings, err := cmd.Actor.SetApplicationEnvironmentVariable(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.RequiredArgs.EnvironmentVariableName,
+		value,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use '{{.Command}}' to ensure your env variable changes take effect.", map[string]interface{}{
+		"Command": cmd.Config.BinaryName() + " v3-restart " + cmd.RequiredArgs.AppName,
+	})
+
+	return nil
+}
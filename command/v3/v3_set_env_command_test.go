@@ -0,0 +1,162 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-set-env Command", func() {
+	var (
+		cmd             v3.V3SetEnvCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3SetEnvActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3SetEnvActor)
+
+		cmd = v3.V3SetEnvCommand{
+			RequiredArgs: flag.SetEnvironmentArgs{
+				AppName:                  "some-app",
+				EnvironmentVariableName:  "SOME_VAR",
+				EnvironmentVariableValue: `{"key": ["value"]}`,
+			},
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Out).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: version.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the --json flag is not provided", func() {
+		It("sets the value as a string", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.SetApplicationEnvironmentVariableCallCount()).To(Equal(1))
+			appName, spaceGUID, name, value := fakeActor.SetApplicationEnvironmentVariableArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(name).To(Equal("SOME_VAR"))
+			Expect(value).To(Equal(`{"key": ["value"]}`))
+		})
+	})
+
+	Context("when the --json flag is provided", func() {
+		BeforeEach(func() {
+			cmd.JSON = true
+		})
+
+		Context("when the value is valid JSON", func() {
+			BeforeEach(func() {
+				fakeActor.SetApplicationEnvironmentVariableReturns(v3action.Warnings{"warning-1", "warning-2"}, nil)
+			})
+
+			It("sets the parsed value and displays the tip and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Setting env variable SOME_VAR for app some-app in org some-org / space some-space as steve\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("TIP: Use 'faceman v3-restart some-app' to ensure your env variable changes take effect\\."))
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("warning-2"))
+
+				Expect(fakeActor.SetApplicationEnvironmentVariableCallCount()).To(Equal(1))
+				_, _, _, value := fakeActor.SetApplicationEnvironmentVariableArgsForCall(0)
+				Expect(value).To(Equal(map[string]interface{}{"key": []interface{}{"value"}}))
+			})
+		})
+
+		Context("when the value is not valid JSON", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.EnvironmentVariableValue = "not-json"
+			})
+
+			It("returns a ParseArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+					ArgumentName: "ENV_VAR_VALUE",
+					ExpectedType: "valid JSON",
+				}))
+				Expect(fakeActor.SetApplicationEnvironmentVariableCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when setting the variable fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.SetApplicationEnvironmentVariableReturns(v3action.Warnings{"warning-1"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Out).ToNot(Say("OK"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+})
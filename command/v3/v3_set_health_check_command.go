@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -13,14 +14,15 @@ import (
 
 type V3SetHealthCheckActor interface {
 	CloudControllerAPIVersion() string
-	SetApplicationProcessHealthCheckTypeByNameAndSpace(appName string, spaceGUID string, healthCheckType string, httpEndpoint string, processType string) (v3action.Application, v3action.Warnings, error)
+	SetApplicationProcessHealthCheckTypeByNameAndSpace(appName string, spaceGUID string, healthCheckType string, httpEndpoint string, processType string, invocationTimeout types.NullInt) (v3action.Application, v3action.Warnings, error)
 }
 
 type V3SetHealthCheckCommand struct {
-	RequiredArgs flag.SetHealthCheckArgs `positional-args:"yes"`
-	HTTPEndpoint string                  `long:"endpoint" default:"/" description:"Path on the app"`
-	ProcessType  string                  `long:"process" default:"web" description:"App process to update"`
-	usage        interface{}             `usage:"CF_NAME v3-set-health-check APP_NAME (process | port | http [--endpoint PATH]) [--process PROCESS]\n\nEXAMPLES:\n   cf v3-set-health-check worker-app process --process worker\n   cf v3-set-health-check my-web-app http --endpoint /foo"`
+	RequiredArgs      flag.SetHealthCheckArgs `positional-args:"yes"`
+	HTTPEndpoint      string                  `long:"endpoint" default:"/" description:"Path on the app"`
+	InvocationTimeout flag.InvocationTimeout  `long:"invocation-timeout" description:"Time (in seconds) that controls individual health check invocations"`
+	ProcessType       string                  `long:"process" default:"web" description:"App process to update"`
+	usage             interface{}             `usage:"CF_NAME v3-set-health-check APP_NAME (process | port | http [--endpoint PATH]) [--process PROCESS] [--invocation-timeout INVOCATION_TIMEOUT]\n\nEXAMPLES:\n   cf v3-set-health-check worker-app process --process worker\n   cf v3-set-health-check my-web-app http --endpoint /foo\n   cf v3-set-health-check my-web-app http --invocation-timeout 10"`
 
 	UI          command.UI
 	Config      command.Config
@@ -37,7 +39,7 @@ func (cmd *V3SetHealthCheckCommand) Setup(config command.Config, ui command.UI)
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(ccClient, config)
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
 
 	return nil
 }
@@ -76,6 +78,7 @@ func (cmd V3SetHealthCheckCommand) Execute(args []string) error {
 		cmd.RequiredArgs.HealthCheck.Type,
 		cmd.HTTPEndpoint,
 		cmd.ProcessType,
+		cmd.InvocationTimeout.NullInt,
 	)
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/cli/version"
@@ -43,9 +44,10 @@ var _ = Describe("v3-set-health-check Command", func() {
 		healthCheckType = "some-health-check-type"
 
 		cmd = v3.V3SetHealthCheckCommand{
-			RequiredArgs: flag.SetHealthCheckArgs{AppName: app, HealthCheck: flag.HealthCheckType{Type: healthCheckType}},
-			HTTPEndpoint: "some-http-endpoint",
-			ProcessType:  "some-process-type",
+			RequiredArgs:      flag.SetHealthCheckArgs{AppName: app, HealthCheck: flag.HealthCheckType{Type: healthCheckType}},
+			HTTPEndpoint:      "some-http-endpoint",
+			ProcessType:       "some-process-type",
+			InvocationTimeout: flag.InvocationTimeout{NullInt: types.NullInt{Value: 42, IsSet: true}},
 
 			UI:          testUI,
 			Config:      fakeConfig,
@@ -150,12 +152,13 @@ var _ = Describe("v3-set-health-check Command", func() {
 			Expect(testUI.Out).To(Say("TIP: An app restart is required for the change to take effect\\."))
 
 			Expect(fakeActor.SetApplicationProcessHealthCheckTypeByNameAndSpaceCallCount()).To(Equal(1))
-			appName, spaceGUID, healthCheckType, httpEndpoint, processType := fakeActor.SetApplicationProcessHealthCheckTypeByNameAndSpaceArgsForCall(0)
+			appName, spaceGUID, healthCheckType, httpEndpoint, processType, invocationTimeout := fakeActor.SetApplicationProcessHealthCheckTypeByNameAndSpaceArgsForCall(0)
 			Expect(appName).To(Equal("some-app"))
 			Expect(spaceGUID).To(Equal("some-space-guid"))
 			Expect(healthCheckType).To(Equal("some-health-check-type"))
 			Expect(httpEndpoint).To(Equal("some-http-endpoint"))
 			Expect(processType).To(Equal("some-process-type"))
+			Expect(invocationTimeout).To(Equal(types.NullInt{Value: 42, IsSet: true}))
 
 			Expect(testUI.Err).To(Say("warning-1"))
 			Expect(testUI.Err).To(Say("warning-2"))
@@ -0,0 +1,99 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3SetProcessCommandActor
+
+type V3SetProcessCommandActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	SetProcessCommand(appGUID string, processType string, command types.FilteredString) (v3action.Warnings, error)
+}
+
+type V3SetProcessCommandCommand struct {
+	RequiredArgs flag.AppName `positional-args:"yes"`
+	StartCommand flag.Command `short:"c" long:"start-command" required:"true" description:"Startup command, set to null to reset to the start command detected during staging"`
+	ProcessType  string       `long:"process" default:"web" description:"App process to update"`
+	usage        interface{}  `usage:"CF_NAME v3-set-process-command APP_NAME -c COMMAND [--process PROCESS]\n\nEXAMPLES:\n   cf v3-set-process-command my-app -c \"bundle exec rackup\"\n   cf v3-set-process-command my-app -c \"./worker\" --process worker\n   cf v3-set-process-command my-app -c null"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3SetProcessCommandActor
+}
+
+func (cmd *V3SetProcessCommandCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd V3SetProcessCommandCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	template := "Updating start command for app {{.AppName}} process {{.ProcessType}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}..."
+	if cmd.StartCommand.Value == "" {
+		template = "Resetting start command for app {{.AppName}} process {{.ProcessType}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}..."
+	}
+	cmd.UI.DisplayTextWithFlavor(template, map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"ProcessType": cmd.ProcessType,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	warnings, err = cmd.Actor.SetProcessCommand(app.GUID, cmd.ProcessType, cmd.StartCommand.FilteredString)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	if app.Started() {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("TIP: An app restart is required for the change to take effect.")
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-set-process-command Command", func() {
+	var (
+		cmd             v3.V3SetProcessCommandCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3SetProcessCommandActor
+		binaryName      string
+		executeErr      error
+		app             string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3SetProcessCommandActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		app = "some-app"
+
+		cmd = v3.V3SetProcessCommandCommand{
+			RequiredArgs: flag.AppName{AppName: app},
+			StartCommand: flag.Command{FilteredString: types.FilteredString{IsSet: true, Value: "some-command"}},
+			ProcessType:  "some-process-type",
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: version.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is not logged in", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("return an error", func() {
+			Expect(executeErr).To(Equal(expectedErr))
+		})
+	})
+
+	Context("when getting the application returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"get-app-warning"}, v3action.ApplicationNotFoundError{Name: app})
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(Equal(translatableerror.ApplicationNotFoundError{Name: app}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+
+			Expect(fakeActor.SetProcessCommandCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the application exists", func() {
+		var appState string
+
+		BeforeEach(func() {
+			appState = "STOPPED"
+			fakeActor.GetApplicationByNameAndSpaceStub = func(string, string) (v3action.Application, v3action.Warnings, error) {
+				return v3action.Application{GUID: "some-app-guid", State: appState}, v3action.Warnings{"get-app-warning"}, nil
+			}
+		})
+
+		Context("when setting the process command returns an error", func() {
+			BeforeEach(func() {
+				fakeActor.SetProcessCommandReturns(v3action.Warnings{"set-command-warning"}, v3action.ProcessNotFoundError{ProcessType: "some-process-type"})
+			})
+
+			It("returns the error and prints warnings", func() {
+				Expect(executeErr).To(Equal(translatableerror.ProcessNotFoundError{ProcessType: "some-process-type"}))
+
+				Expect(testUI.Err).To(Say("get-app-warning"))
+				Expect(testUI.Err).To(Say("set-command-warning"))
+			})
+		})
+
+		Context("when setting the process command succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.SetProcessCommandReturns(v3action.Warnings{"set-command-warning"}, nil)
+			})
+
+			It("updates the command of the process", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+				Expect(testUI.Out).To(Say("Updating start command for app some-app process some-process-type in org some-org / space some-space as steve\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).NotTo(Say("TIP: An app restart is required for the change to take effect\\."))
+
+				Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeActor.SetProcessCommandCallCount()).To(Equal(1))
+				appGUID, processType, command := fakeActor.SetProcessCommandArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(processType).To(Equal("some-process-type"))
+				Expect(command).To(Equal(types.FilteredString{IsSet: true, Value: "some-command"}))
+
+				Expect(testUI.Err).To(Say("get-app-warning"))
+				Expect(testUI.Err).To(Say("set-command-warning"))
+			})
+
+			Context("when the command is reset to the default", func() {
+				BeforeEach(func() {
+					cmd.StartCommand = flag.Command{FilteredString: types.FilteredString{IsSet: true}}
+				})
+
+				It("resets the command of the process", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Resetting start command for app some-app process some-process-type in org some-org / space some-space as steve\\.\\.\\."))
+
+					_, _, command := fakeActor.SetProcessCommandArgsForCall(0)
+					Expect(command).To(Equal(types.FilteredString{IsSet: true}))
+				})
+			})
+
+			Context("when the application is started", func() {
+				BeforeEach(func() {
+					appState = "STARTED"
+				})
+
+				It("displays a message to restart the application", func() {
+					Expect(testUI.Out).To(Say("TIP: An app restart is required for the change to take effect\\."))
+				})
+			})
+		})
+	})
+})
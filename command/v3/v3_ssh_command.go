@@ -0,0 +1,147 @@
+package v3
+
+import (
+	"os"
+
+	"golang.org/x/crypto/ssh"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	sshTerminal "code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3SSHActor
+
+type V3SSHActor interface {
+	CloudControllerAPIVersion() string
+	GetProcessSSHDetailsByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex int) (v3action.SSHDetails, v3action.Warnings, error)
+	GetSSHPasscode() (string, error)
+}
+
+type V3SSHCommand struct {
+	RequiredArgs        flag.AppName `positional-args:"yes"`
+	ProcessType         string       `long:"process" default:"web" description:"Process to SSH into"`
+	ProcessIndex        int          `long:"app-instance-index" short:"i" description:"Process instance index (Default: 0)"`
+	Command             []string     `long:"command" short:"c" description:"Command to run. This flag can be defined more than once."`
+	LocalPort           []string     `short:"L" description:"Local port forward specification. This flag can be defined more than once."`
+	SkipHostValidation  bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
+	SkipRemoteExecution bool         `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
+	usage               interface{}  `usage:"CF_NAME v3-ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]... [-L [BIND_ADDRESS:]PORT:HOST:HOST_PORT] [--skip-host-validation] [--skip-remote-execution]"`
+	relatedCommands     interface{}  `related_commands:"v3-files, allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3SSHActor
+
+	// NewSecureShell builds the SSH session for the process instance described
+	// by details, authenticated with passcode.
+	NewSecureShell func(details v3action.SSHDetails, passcode string) sshCmd.SecureShell
+}
+
+func (cmd *V3SSHCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, uaaClient, config)
+	cmd.NewSecureShell = func(details v3action.SSHDetails, passcode string) sshCmd.SecureShell {
+		return shared.NewSecureShell(details, passcode, sshTerminal.DefaultHelper())
+	}
+
+	return nil
+}
+
+func (cmd V3SSHCommand) Execute(args []string) error {
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	sshOptions, err := cmd.sshOptions()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	details, warnings, err := cmd.Actor.GetProcessSSHDetailsByApplicationNameSpaceProcessTypeAndIndex(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.ProcessType, cmd.ProcessIndex)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	passcode, err := cmd.Actor.GetSSHPasscode()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	secureShell := cmd.NewSecureShell(details, passcode)
+
+	err = secureShell.Connect(sshOptions)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	defer secureShell.Close()
+
+	err = secureShell.LocalPortForward()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.SkipRemoteExecution {
+		err = secureShell.Wait()
+	} else {
+		err = secureShell.InteractiveSession()
+	}
+
+	if exitError, ok := err.(*ssh.ExitError); ok {
+		exitStatus := exitError.ExitStatus()
+		if sig := exitError.Signal(); sig != "" {
+			cmd.UI.DisplayText("Process terminated by signal: {{.Signal}}. Exited with {{.ExitCode}}", map[string]interface{}{
+				"Signal":   sig,
+				"ExitCode": exitStatus,
+			})
+		}
+		os.Exit(exitStatus)
+	}
+
+	return shared.HandleError(err)
+}
+
+func (cmd V3SSHCommand) sshOptions() (*options.SSHOptions, error) {
+	sshOptions := &options.SSHOptions{
+		AppName:             cmd.RequiredArgs.AppName,
+		Command:             cmd.Command,
+		Index:               uint(cmd.ProcessIndex),
+		SkipHostValidation:  cmd.SkipHostValidation,
+		SkipRemoteExecution: cmd.SkipRemoteExecution,
+	}
+
+	for _, localPort := range cmd.LocalPort {
+		forwardSpec, err := options.ParseLocalForwardingSpec(localPort)
+		if err != nil {
+			return nil, translatableerror.ParseArgumentError{
+				ArgumentName: "-L",
+				ExpectedType: "[BIND_ADDRESS:]PORT:HOST:HOST_PORT",
+			}
+		}
+		sshOptions.ForwardSpecs = append(sshOptions.ForwardSpecs, *forwardSpec)
+	}
+
+	return sshOptions, nil
+}
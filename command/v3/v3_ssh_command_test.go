@@ -0,0 +1,256 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/version"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-ssh Command", func() {
+	var (
+		cmd             v3.V3SSHCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3SSHActor
+		fakeSecureShell *sshfakes.FakeSecureShell
+		binaryName      string
+		executeErr      error
+
+		shellDetails  v3action.SSHDetails
+		shellPasscode string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3SSHActor)
+		fakeSecureShell = new(sshfakes.FakeSecureShell)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.V3SSHCommand{
+			ProcessType: "web",
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			NewSecureShell: func(details v3action.SSHDetails, passcode string) sshCmd.SecureShell {
+				shellDetails = details
+				shellPasscode = passcode
+				return fakeSecureShell
+			},
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: version.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when a local port forward specification is invalid", func() {
+		BeforeEach(func() {
+			cmd.LocalPort = []string{"8080"}
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "-L",
+				ExpectedType: "[BIND_ADDRESS:]PORT:HOST:HOST_PORT",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in and targeted", func() {
+		BeforeEach(func() {
+			cmd.ProcessType = "worker"
+			cmd.ProcessIndex = 2
+
+			fakeActor.GetProcessSSHDetailsByApplicationNameSpaceProcessTypeAndIndexReturns(
+				v3action.SSHDetails{
+					Endpoint:           "ssh.example.com:2222",
+					HostKeyFingerprint: "some-fingerprint",
+					ProcessGUID:        "some-process-guid",
+					ProcessIndex:       2,
+				},
+				v3action.Warnings{"ssh-details-warning"},
+				nil,
+			)
+			fakeActor.GetSSHPasscodeReturns("some-passcode", nil)
+		})
+
+		Context("when getting the ssh details fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetProcessSSHDetailsByApplicationNameSpaceProcessTypeAndIndexReturns(
+					v3action.SSHDetails{},
+					v3action.Warnings{"ssh-details-warning"},
+					v3action.ApplicationNotStartedError{Name: "some-app"},
+				)
+			})
+
+			It("returns the translated error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationNotStartedError{Name: "some-app"}))
+				Expect(testUI.Err).To(Say("ssh-details-warning"))
+				Expect(fakeActor.GetSSHPasscodeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the passcode fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("passcode error")
+				fakeActor.GetSSHPasscodeReturns("", expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeSecureShell.ConnectCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when connecting fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("connect error")
+				fakeSecureShell.ConnectReturns(expectedErr)
+			})
+
+			It("returns the error without forwarding ports", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeSecureShell.LocalPortForwardCallCount()).To(Equal(0))
+				Expect(fakeSecureShell.CloseCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when forwarding ports fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("forward error")
+				fakeSecureShell.LocalPortForwardReturns(expectedErr)
+			})
+
+			It("returns the error and closes the connection", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeSecureShell.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		It("opens an interactive session to the process instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("ssh-details-warning"))
+
+			appName, spaceGUID, processType, processIndex := fakeActor.GetProcessSSHDetailsByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(processType).To(Equal("worker"))
+			Expect(processIndex).To(Equal(2))
+
+			Expect(shellDetails.ProcessGUID).To(Equal("some-process-guid"))
+			Expect(shellPasscode).To(Equal("some-passcode"))
+
+			Expect(fakeSecureShell.ConnectCallCount()).To(Equal(1))
+			Expect(fakeSecureShell.ConnectArgsForCall(0)).To(Equal(&options.SSHOptions{
+				AppName: "some-app",
+				Index:   2,
+			}))
+			Expect(fakeSecureShell.LocalPortForwardCallCount()).To(Equal(1))
+			Expect(fakeSecureShell.InteractiveSessionCallCount()).To(Equal(1))
+			Expect(fakeSecureShell.WaitCallCount()).To(Equal(0))
+			Expect(fakeSecureShell.CloseCallCount()).To(Equal(1))
+		})
+
+		Context("when a command is provided", func() {
+			BeforeEach(func() {
+				cmd.Command = []string{"ls", "-la"}
+			})
+
+			It("runs the command in the session", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeSecureShell.ConnectArgsForCall(0)).To(Equal(&options.SSHOptions{
+					AppName: "some-app",
+					Command: []string{"ls", "-la"},
+					Index:   2,
+				}))
+				Expect(fakeSecureShell.InteractiveSessionCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when local ports are forwarded and remote execution is skipped", func() {
+			BeforeEach(func() {
+				cmd.LocalPort = []string{"8080:localhost:8080", "*:9090:example.com:80"}
+				cmd.SkipRemoteExecution = true
+				cmd.SkipHostValidation = true
+			})
+
+			It("forwards the ports and waits without opening a session", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeSecureShell.ConnectArgsForCall(0)).To(Equal(&options.SSHOptions{
+					AppName:             "some-app",
+					Index:               2,
+					SkipHostValidation:  true,
+					SkipRemoteExecution: true,
+					ForwardSpecs: []options.ForwardSpec{
+						{ListenAddress: "localhost:8080", ConnectAddress: "localhost:8080"},
+						{ListenAddress: ":9090", ConnectAddress: "example.com:80"},
+					},
+				}))
+				Expect(fakeSecureShell.WaitCallCount()).To(Equal(1))
+				Expect(fakeSecureShell.InteractiveSessionCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -17,7 +17,7 @@ import (
 type V3StageActor interface {
 	CloudControllerAPIVersion() string
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error)
-	StagePackage(packageGUID string, appName string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error)
+	StagePackage(packageGUID string, appName string, buildpacks []string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error)
 }
 
 type V3StageCommand struct {
@@ -43,8 +43,11 @@ func (cmd *V3StageCommand) Setup(config command.Config, ui command.UI) error {
 		return err
 	}
 
-	cmd.Actor = v3action.NewActor(ccClient, config)
-	cmd.NOAAClient = shared.NewNOAAClient(ccClient.APIInfo.Logging(), config, uaaClient, ui)
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.APIInfo.Logging(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -78,7 +81,7 @@ func (cmd V3StageCommand) Execute(args []string) error {
 		return shared.HandleError(logErr)
 	}
 
-	dropletStream, warningsStream, errStream := cmd.Actor.StagePackage(cmd.PackageGUID, cmd.RequiredArgs.AppName)
+	dropletStream, warningsStream, errStream := cmd.Actor.StagePackage(cmd.PackageGUID, cmd.RequiredArgs.AppName, nil)
 	var droplet v3action.Droplet
 	droplet, err = shared.PollStage(dropletStream, warningsStream, errStream, logStream, logErrStream, cmd.UI)
 	if err != nil {
@@ -133,7 +133,7 @@ var _ = Describe("v3-stage Command", func() {
 				const dropletCreateTime = "2017-08-14T21:16:42Z"
 
 				BeforeEach(func() {
-					fakeActor.StagePackageStub = func(packageGUID string, _ string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+					fakeActor.StagePackageStub = func(packageGUID string, _ string, _ []string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
 						dropletStream := make(chan v3action.Droplet)
 						warningsStream := make(chan v3action.Warnings)
 						errorStream := make(chan error)
@@ -175,7 +175,7 @@ var _ = Describe("v3-stage Command", func() {
 				It("stages the package", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
 					Expect(fakeActor.StagePackageCallCount()).To(Equal(1))
-					guidArg, _ := fakeActor.StagePackageArgsForCall(0)
+					guidArg, _, _ := fakeActor.StagePackageArgsForCall(0)
 					Expect(guidArg).To(Equal(packageGUID))
 				})
 
@@ -192,7 +192,7 @@ var _ = Describe("v3-stage Command", func() {
 					Expect(noaaClient).To(Equal(fakeNOAAClient))
 
 					Expect(fakeActor.StagePackageCallCount()).To(Equal(1))
-					guidArg, _ := fakeActor.StagePackageArgsForCall(0)
+					guidArg, _, _ := fakeActor.StagePackageArgsForCall(0)
 					Expect(guidArg).To(Equal(packageGUID))
 				})
 			})
@@ -202,7 +202,7 @@ var _ = Describe("v3-stage Command", func() {
 
 				BeforeEach(func() {
 					expectedErr = errors.New("any gibberish")
-					fakeActor.StagePackageStub = func(packageGUID string, _ string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+					fakeActor.StagePackageStub = func(packageGUID string, _ string, _ []string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
 						dropletStream := make(chan v3action.Droplet)
 						warningsStream := make(chan v3action.Warnings)
 						errorStream := make(chan error)
@@ -254,7 +254,7 @@ var _ = Describe("v3-stage Command", func() {
 					return logStream, errorStream, v3action.Warnings{"steve for all I care"}, nil
 				}
 
-				fakeActor.StagePackageStub = func(packageGUID string, _ string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+				fakeActor.StagePackageStub = func(packageGUID string, _ string, _ []string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
 					dropletStream := make(chan v3action.Droplet)
 					warningsStream := make(chan v3action.Warnings)
 					errorStream := make(chan error)
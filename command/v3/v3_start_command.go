@@ -1,11 +1,15 @@
 package v3
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -14,17 +18,22 @@ import (
 type V3StartActor interface {
 	CloudControllerAPIVersion() string
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetStreamingLogs(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error)
+	PollStart(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error
 	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
 }
 
 type V3StartCommand struct {
-	RequiredArgs flag.AppName `positional-args:"yes"`
-	usage        interface{}  `usage:"CF_NAME v3-start APP_NAME"`
+	RequiredArgs        flag.AppName         `positional-args:"yes"`
+	StartTimeout        flag.AppStartTimeout `short:"t" long:"app-start-timeout" description:"Max wait time for app instance startup, in minutes; overrides CF_STARTUP_TIMEOUT"`
+	usage               interface{}          `usage:"CF_NAME v3-start APP_NAME [-t TIMEOUT]"`
+	envCFStartupTimeout interface{}          `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       V3StartActor
+	NOAAClient  v3action.NOAAClient
 }
 
 func (cmd *V3StartCommand) Setup(config command.Config, ui command.UI) error {
@@ -32,11 +41,15 @@ func (cmd *V3StartCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Config = config
 	cmd.SharedActor = sharedaction.NewActor()
 
-	ccClient, _, err := shared.NewClients(config, ui, true)
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.APIInfo.Logging(), config, uaaClient, ui)
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(ccClient, config)
 
 	return nil
 }
@@ -86,6 +99,23 @@ func (cmd V3StartCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	cmd.UI.DisplayText("Waiting for app to start...")
+	cmd.UI.DisplayProgressEvent(ui.ProgressEvent{Phase: ui.ProgressPhaseStarting, Message: "Waiting for app to start"})
+
+	logStream, logErrStream := cmd.Actor.GetStreamingLogs(app.GUID, cmd.NOAAClient)
+	err = shared.PollStart(cmd.Actor, app.GUID, cmd.StartTimeout.Duration(), logStream, logErrStream, cmd.UI)
+	cmd.NOAAClient.Close()
+	if err != nil {
+		if _, ok := err.(v3action.StartupTimeoutError); ok {
+			return translatableerror.StartupTimeoutError{
+				AppName:    cmd.RequiredArgs.AppName,
+				BinaryName: cmd.Config.BinaryName(),
+			}
+		}
+
+		return shared.HandleError(err)
+	}
+
 	cmd.UI.DisplayOK()
 
 	return nil
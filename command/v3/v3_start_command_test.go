@@ -2,14 +2,17 @@ package v3_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/cli/version"
@@ -25,6 +28,7 @@ var _ = Describe("v3-start Command", func() {
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v3fakes.FakeV3StartActor
+		fakeNOAAClient  *v3actionfakes.FakeNOAAClient
 		binaryName      string
 		executeErr      error
 		app             string
@@ -35,6 +39,7 @@ var _ = Describe("v3-start Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v3fakes.FakeV3StartActor)
+		fakeNOAAClient = new(v3actionfakes.FakeNOAAClient)
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
@@ -47,6 +52,7 @@ var _ = Describe("v3-start Command", func() {
 			Config:      fakeConfig,
 			SharedActor: fakeSharedActor,
 			Actor:       fakeActor,
+			NOAAClient:  fakeNOAAClient,
 		}
 
 		fakeActor.CloudControllerAPIVersionReturns(version.MinVersionV3)
@@ -126,6 +132,78 @@ var _ = Describe("v3-start Command", func() {
 			appName := fakeActor.StartApplicationArgsForCall(0)
 			Expect(appName).To(Equal("some-app-guid"))
 		})
+
+		Context("while waiting for the app to start", func() {
+			BeforeEach(func() {
+				logStream := make(chan *v3action.LogMessage)
+				fakeActor.GetStreamingLogsReturns(logStream, make(chan error))
+				fakeActor.PollStartStub = func(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error {
+					logStream <- v3action.NewLogMessage("some-app-log", 1, time.Now(), "APP", "0")
+					warnings <- v3action.Warnings{"poll-warning-1", "poll-warning-2"}
+					return nil
+				}
+			})
+
+			It("streams the app logs and polling warnings before displaying OK", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Waiting for app to start\\.\\.\\."))
+				Expect(testUI.Out).To(Say("some-app-log"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("poll-warning-1"))
+				Expect(testUI.Err).To(Say("poll-warning-2"))
+
+				Expect(fakeActor.GetStreamingLogsCallCount()).To(Equal(1))
+				appGUID, noaaClient := fakeActor.GetStreamingLogsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(noaaClient).To(Equal(fakeNOAAClient))
+
+				Expect(fakeActor.PollStartCallCount()).To(Equal(1))
+				appGUID, startupTimeout, _ := fakeActor.PollStartArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(startupTimeout).To(BeZero())
+
+				Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when an app start timeout is provided", func() {
+			BeforeEach(func() {
+				cmd.StartTimeout = flag.AppStartTimeout{NullInt: types.NullInt{Value: 10, IsSet: true}}
+			})
+
+			It("polls with the provided timeout", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.PollStartCallCount()).To(Equal(1))
+				_, startupTimeout, _ := fakeActor.PollStartArgsForCall(0)
+				Expect(startupTimeout).To(Equal(10 * time.Minute))
+			})
+		})
+
+		Context("when polling the start fails", func() {
+			BeforeEach(func() {
+				fakeActor.PollStartReturns(errors.New("some-poll-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-poll-error"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+
+		Context("when polling times out", func() {
+			BeforeEach(func() {
+				fakeActor.PollStartReturns(v3action.StartupTimeoutError{})
+			})
+
+			It("returns the StartupTimeoutError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{
+					AppName:    "some-app",
+					BinaryName: binaryName,
+				}))
+			})
+		})
 	})
 
 	Context("when the get app call returns a ApplicationNotFoundError", func() {
@@ -0,0 +1,90 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3UnmapRouteActor
+
+type V3UnmapRouteActor interface {
+	CloudControllerAPIVersion() string
+	UnmapRouteFromApplication(appName string, spaceGUID string, domainName string, host string, path string, processType string) (v3action.Warnings, error)
+}
+
+type V3UnmapRouteCommand struct {
+	RequiredArgs    flag.AppDomain `positional-args:"yes"`
+	Hostname        string         `long:"hostname" short:"n" description:"Hostname used to identify the HTTP route"`
+	Path            string         `long:"path" description:"Path used to identify the HTTP route"`
+	ProcessType     string         `long:"process" default:"web" description:"App process to stop sending the route's traffic to"`
+	usage           interface{}    `usage:"CF_NAME v3-unmap-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] [--process PROCESS]"`
+	relatedCommands interface{}    `related_commands:"v3-map-route, routes"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3UnmapRouteActor
+}
+
+func (cmd *V3UnmapRouteCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd V3UnmapRouteCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Removing route {{.URL}} from app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"URL":       v3action.RouteURL(cmd.Hostname, cmd.RequiredArgs.Domain, cmd.Path),
+		"AppName":   cmd.RequiredArgs.App,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	warnings, err := cmd.Actor.UnmapRouteFromApplication(
+		cmd.RequiredArgs.App,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.RequiredArgs.Domain,
+		cmd.Hostname,
+		cmd.Path,
+		cmd.ProcessType,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . V3UnsetEnvActor
+
+type V3UnsetEnvActor interface {
+	CloudControllerAPIVersion() string
+	UnsetApplicationEnvironmentVariable(appName string, spaceGUID string, name string) (v3action.Warnings, error)
+}
+
+type V3UnsetEnvCommand struct {
+	RequiredArgs    flag.UnsetEnvironmentArgs `positional-args:"yes"`
+	usage           interface{}               `usage:"CF_NAME v3-unset-env APP_NAME ENV_VAR_NAME"`
+	relatedCommands interface{}               `related_commands:"v3-env, v3-set-env, v3-restart"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3UnsetEnvActor
+}
+
+func (cmd *V3UnsetEnvCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, nil, config)
+
+	return nil
+}
+
+func (cmd V3UnsetEnvCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := version.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), version.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Removing env variable {{.VarName}} from app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"VarName":     cmd.RequiredArgs.EnvironmentVariableName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"CurrentUser": user.Name,
+	})
+
+	warnings, err := cmd.Actor.UnsetApplicationEnvironmentVariable(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.RequiredArgs.EnvironmentVariableName,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v3action.EnvironmentVariableNotSetError); ok {
+			cmd.UI.DisplayText("Env variable {{.VarName}} was not set.", map[string]interface{}{
+				"VarName": cmd.RequiredArgs.EnvironmentVariableName,
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		}
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use '{{.Command}}' to ensure your env variable changes take effect.", map[string]interface{}{
+		"Command": cmd.Config.BinaryName() + " v3-restart " + cmd.RequiredArgs.AppName,
+	})
+
+	return nil
+}
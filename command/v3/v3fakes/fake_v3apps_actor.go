@@ -18,10 +18,11 @@ type FakeV3AppsActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
-	GetApplicationSummariesBySpaceStub        func(spaceGUID string) ([]v3action.ApplicationSummary, v3action.Warnings, error)
+	GetApplicationSummariesBySpaceStub        func(spaceGUID string, labelSelector string) ([]v3action.ApplicationSummary, v3action.Warnings, error)
 	getApplicationSummariesBySpaceMutex       sync.RWMutex
 	getApplicationSummariesBySpaceArgsForCall []struct {
-		spaceGUID string
+		spaceGUID     string
+		labelSelector string
 	}
 	getApplicationSummariesBySpaceReturns struct {
 		result1 []v3action.ApplicationSummary
@@ -77,16 +78,17 @@ func (fake *FakeV3AppsActor) CloudControllerAPIVersionReturnsOnCall(i int, resul
 	}{result1}
 }
 
-func (fake *FakeV3AppsActor) GetApplicationSummariesBySpace(spaceGUID string) ([]v3action.ApplicationSummary, v3action.Warnings, error) {
+func (fake *FakeV3AppsActor) GetApplicationSummariesBySpace(spaceGUID string, labelSelector string) ([]v3action.ApplicationSummary, v3action.Warnings, error) {
 	fake.getApplicationSummariesBySpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationSummariesBySpaceReturnsOnCall[len(fake.getApplicationSummariesBySpaceArgsForCall)]
 	fake.getApplicationSummariesBySpaceArgsForCall = append(fake.getApplicationSummariesBySpaceArgsForCall, struct {
-		spaceGUID string
-	}{spaceGUID})
-	fake.recordInvocation("GetApplicationSummariesBySpace", []interface{}{spaceGUID})
+		spaceGUID     string
+		labelSelector string
+	}{spaceGUID, labelSelector})
+	fake.recordInvocation("GetApplicationSummariesBySpace", []interface{}{spaceGUID, labelSelector})
 	fake.getApplicationSummariesBySpaceMutex.Unlock()
 	if fake.GetApplicationSummariesBySpaceStub != nil {
-		return fake.GetApplicationSummariesBySpaceStub(spaceGUID)
+		return fake.GetApplicationSummariesBySpaceStub(spaceGUID, labelSelector)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -100,10 +102,10 @@ func (fake *FakeV3AppsActor) GetApplicationSummariesBySpaceCallCount() int {
 	return len(fake.getApplicationSummariesBySpaceArgsForCall)
 }
 
-func (fake *FakeV3AppsActor) GetApplicationSummariesBySpaceArgsForCall(i int) string {
+func (fake *FakeV3AppsActor) GetApplicationSummariesBySpaceArgsForCall(i int) (string, string) {
 	fake.getApplicationSummariesBySpaceMutex.RLock()
 	defer fake.getApplicationSummariesBySpaceMutex.RUnlock()
-	return fake.getApplicationSummariesBySpaceArgsForCall[i].spaceGUID
+	return fake.getApplicationSummariesBySpaceArgsForCall[i].spaceGUID, fake.getApplicationSummariesBySpaceArgsForCall[i].labelSelector
 }
 
 func (fake *FakeV3AppsActor) GetApplicationSummariesBySpaceReturns(result1 []v3action.ApplicationSummary, result2 v3action.Warnings, result3 error) {
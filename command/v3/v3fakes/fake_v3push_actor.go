@@ -3,9 +3,11 @@ package v3fakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/types"
 )
 
 type FakeV3PushActor struct {
@@ -18,13 +20,29 @@ type FakeV3PushActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
-	CreatePackageByApplicationNameAndSpaceStub        func(appName string, spaceGUID string, bitsPath string, dockerImage string) (v3action.Package, v3action.Warnings, error)
+	CreateDeploymentStub        func(appGUID string, dropletGUID string) (v3action.Deployment, v3action.Warnings, error)
+	createDeploymentMutex       sync.RWMutex
+	createDeploymentArgsForCall []struct {
+		appGUID     string
+		dropletGUID string
+	}
+	createDeploymentReturns struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
+	createDeploymentReturnsOnCall map[int]struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
+	CreatePackageByApplicationNameAndSpaceStub        func(appName string, spaceGUID string, bitsPath string, dockerImageCredentials v3action.DockerImageCredentials) (v3action.Package, v3action.Warnings, error)
 	createPackageByApplicationNameAndSpaceMutex       sync.RWMutex
 	createPackageByApplicationNameAndSpaceArgsForCall []struct {
-		appName     string
-		spaceGUID   string
-		bitsPath    string
-		dockerImage string
+		appName                string
+		spaceGUID              string
+		bitsPath               string
+		dockerImageCredentials v3action.DockerImageCredentials
 	}
 	createPackageByApplicationNameAndSpaceReturns struct {
 		result1 v3action.Package
@@ -84,6 +102,21 @@ type FakeV3PushActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetStackByNameStub        func(stackName string) (v3action.Stack, v3action.Warnings, error)
+	getStackByNameMutex       sync.RWMutex
+	getStackByNameArgsForCall []struct {
+		stackName string
+	}
+	getStackByNameReturns struct {
+		result1 v3action.Stack
+		result2 v3action.Warnings
+		result3 error
+	}
+	getStackByNameReturnsOnCall map[int]struct {
+		result1 v3action.Stack
+		result2 v3action.Warnings
+		result3 error
+	}
 	GetStreamingLogsForApplicationByNameAndSpaceStub        func(appName string, spaceGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error)
 	getStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
@@ -103,11 +136,24 @@ type FakeV3PushActor struct {
 		result3 v3action.Warnings
 		result4 error
 	}
-	PollStartStub        func(appGUID string, warnings chan<- v3action.Warnings) error
+	PollDeploymentStub        func(deploymentGUID string, warnings chan<- v3action.Warnings) error
+	pollDeploymentMutex       sync.RWMutex
+	pollDeploymentArgsForCall []struct {
+		deploymentGUID string
+		warnings       chan<- v3action.Warnings
+	}
+	pollDeploymentReturns struct {
+		result1 error
+	}
+	pollDeploymentReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PollStartStub        func(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error
 	pollStartMutex       sync.RWMutex
 	pollStartArgsForCall []struct {
-		appGUID  string
-		warnings chan<- v3action.Warnings
+		appGUID        string
+		startupTimeout time.Duration
+		warnings       chan<- v3action.Warnings
 	}
 	pollStartReturns struct {
 		result1 error
@@ -130,11 +176,27 @@ type FakeV3PushActor struct {
 		result1 v3action.Warnings
 		result2 error
 	}
-	StagePackageStub        func(packageGUID string, appName string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error)
+	SetProcessCommandStub        func(appGUID string, processType string, command types.FilteredString) (v3action.Warnings, error)
+	setProcessCommandMutex       sync.RWMutex
+	setProcessCommandArgsForCall []struct {
+		appGUID     string
+		processType string
+		command     types.FilteredString
+	}
+	setProcessCommandReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	setProcessCommandReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	StagePackageStub        func(packageGUID string, appName string, buildpacks []string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error)
 	stagePackageMutex       sync.RWMutex
 	stagePackageArgsForCall []struct {
 		packageGUID string
 		appName     string
+		buildpacks  []string
 	}
 	stagePackageReturns struct {
 		result1 <-chan v3action.Droplet
@@ -233,19 +295,74 @@ func (fake *FakeV3PushActor) CloudControllerAPIVersionReturnsOnCall(i int, resul
 	}{result1}
 }
 
-func (fake *FakeV3PushActor) CreatePackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string, dockerImage string) (v3action.Package, v3action.Warnings, error) {
+func (fake *FakeV3PushActor) CreateDeployment(appGUID string, dropletGUID string) (v3action.Deployment, v3action.Warnings, error) {
+	fake.createDeploymentMutex.Lock()
+	ret, specificReturn := fake.createDeploymentReturnsOnCall[len(fake.createDeploymentArgsForCall)]
+	fake.createDeploymentArgsForCall = append(fake.createDeploymentArgsForCall, struct {
+		appGUID     string
+		dropletGUID string
+	}{appGUID, dropletGUID})
+	fake.recordInvocation("CreateDeployment", []interface{}{appGUID, dropletGUID})
+	fake.createDeploymentMutex.Unlock()
+	if fake.CreateDeploymentStub != nil {
+		return fake.CreateDeploymentStub(appGUID, dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createDeploymentReturns.result1, fake.createDeploymentReturns.result2, fake.createDeploymentReturns.result3
+}
+
+func (fake *FakeV3PushActor) CreateDeploymentCallCount() int {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	return len(fake.createDeploymentArgsForCall)
+}
+
+func (fake *FakeV3PushActor) CreateDeploymentArgsForCall(i int) (string, string) {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	return fake.createDeploymentArgsForCall[i].appGUID, fake.createDeploymentArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeV3PushActor) CreateDeploymentReturns(result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.CreateDeploymentStub = nil
+	fake.createDeploymentReturns = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3PushActor) CreateDeploymentReturnsOnCall(i int, result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.CreateDeploymentStub = nil
+	if fake.createDeploymentReturnsOnCall == nil {
+		fake.createDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Deployment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.createDeploymentReturnsOnCall[i] = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3PushActor) CreatePackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string, dockerImageCredentials v3action.DockerImageCredentials) (v3action.Package, v3action.Warnings, error) {
 	fake.createPackageByApplicationNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.createPackageByApplicationNameAndSpaceReturnsOnCall[len(fake.createPackageByApplicationNameAndSpaceArgsForCall)]
 	fake.createPackageByApplicationNameAndSpaceArgsForCall = append(fake.createPackageByApplicationNameAndSpaceArgsForCall, struct {
-		appName     string
-		spaceGUID   string
-		bitsPath    string
-		dockerImage string
-	}{appName, spaceGUID, bitsPath, dockerImage})
-	fake.recordInvocation("CreatePackageByApplicationNameAndSpace", []interface{}{appName, spaceGUID, bitsPath, dockerImage})
+		appName                string
+		spaceGUID              string
+		bitsPath               string
+		dockerImageCredentials v3action.DockerImageCredentials
+	}{appName, spaceGUID, bitsPath, dockerImageCredentials})
+	fake.recordInvocation("CreatePackageByApplicationNameAndSpace", []interface{}{appName, spaceGUID, bitsPath, dockerImageCredentials})
 	fake.createPackageByApplicationNameAndSpaceMutex.Unlock()
 	if fake.CreatePackageByApplicationNameAndSpaceStub != nil {
-		return fake.CreatePackageByApplicationNameAndSpaceStub(appName, spaceGUID, bitsPath, dockerImage)
+		return fake.CreatePackageByApplicationNameAndSpaceStub(appName, spaceGUID, bitsPath, dockerImageCredentials)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -259,10 +376,10 @@ func (fake *FakeV3PushActor) CreatePackageByApplicationNameAndSpaceCallCount() i
 	return len(fake.createPackageByApplicationNameAndSpaceArgsForCall)
 }
 
-func (fake *FakeV3PushActor) CreatePackageByApplicationNameAndSpaceArgsForCall(i int) (string, string, string, string) {
+func (fake *FakeV3PushActor) CreatePackageByApplicationNameAndSpaceArgsForCall(i int) (string, string, string, v3action.DockerImageCredentials) {
 	fake.createPackageByApplicationNameAndSpaceMutex.RLock()
 	defer fake.createPackageByApplicationNameAndSpaceMutex.RUnlock()
-	return fake.createPackageByApplicationNameAndSpaceArgsForCall[i].appName, fake.createPackageByApplicationNameAndSpaceArgsForCall[i].spaceGUID, fake.createPackageByApplicationNameAndSpaceArgsForCall[i].bitsPath, fake.createPackageByApplicationNameAndSpaceArgsForCall[i].dockerImage
+	return fake.createPackageByApplicationNameAndSpaceArgsForCall[i].appName, fake.createPackageByApplicationNameAndSpaceArgsForCall[i].spaceGUID, fake.createPackageByApplicationNameAndSpaceArgsForCall[i].bitsPath, fake.createPackageByApplicationNameAndSpaceArgsForCall[i].dockerImageCredentials
 }
 
 func (fake *FakeV3PushActor) CreatePackageByApplicationNameAndSpaceReturns(result1 v3action.Package, result2 v3action.Warnings, result3 error) {
@@ -455,6 +572,60 @@ func (fake *FakeV3PushActor) GetApplicationSummaryByNameAndSpaceReturnsOnCall(i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3PushActor) GetStackByName(stackName string) (v3action.Stack, v3action.Warnings, error) {
+	fake.getStackByNameMutex.Lock()
+	ret, specificReturn := fake.getStackByNameReturnsOnCall[len(fake.getStackByNameArgsForCall)]
+	fake.getStackByNameArgsForCall = append(fake.getStackByNameArgsForCall, struct {
+		stackName string
+	}{stackName})
+	fake.recordInvocation("GetStackByName", []interface{}{stackName})
+	fake.getStackByNameMutex.Unlock()
+	if fake.GetStackByNameStub != nil {
+		return fake.GetStackByNameStub(stackName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStackByNameReturns.result1, fake.getStackByNameReturns.result2, fake.getStackByNameReturns.result3
+}
+
+func (fake *FakeV3PushActor) GetStackByNameCallCount() int {
+	fake.getStackByNameMutex.RLock()
+	defer fake.getStackByNameMutex.RUnlock()
+	return len(fake.getStackByNameArgsForCall)
+}
+
+func (fake *FakeV3PushActor) GetStackByNameArgsForCall(i int) string {
+	fake.getStackByNameMutex.RLock()
+	defer fake.getStackByNameMutex.RUnlock()
+	return fake.getStackByNameArgsForCall[i].stackName
+}
+
+func (fake *FakeV3PushActor) GetStackByNameReturns(result1 v3action.Stack, result2 v3action.Warnings, result3 error) {
+	fake.GetStackByNameStub = nil
+	fake.getStackByNameReturns = struct {
+		result1 v3action.Stack
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3PushActor) GetStackByNameReturnsOnCall(i int, result1 v3action.Stack, result2 v3action.Warnings, result3 error) {
+	fake.GetStackByNameStub = nil
+	if fake.getStackByNameReturnsOnCall == nil {
+		fake.getStackByNameReturnsOnCall = make(map[int]struct {
+			result1 v3action.Stack
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getStackByNameReturnsOnCall[i] = struct {
+		result1 v3action.Stack
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV3PushActor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error) {
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
@@ -514,17 +685,67 @@ func (fake *FakeV3PushActor) GetStreamingLogsForApplicationByNameAndSpaceReturns
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeV3PushActor) PollStart(appGUID string, warnings chan<- v3action.Warnings) error {
+func (fake *FakeV3PushActor) PollDeployment(deploymentGUID string, warnings chan<- v3action.Warnings) error {
+	fake.pollDeploymentMutex.Lock()
+	ret, specificReturn := fake.pollDeploymentReturnsOnCall[len(fake.pollDeploymentArgsForCall)]
+	fake.pollDeploymentArgsForCall = append(fake.pollDeploymentArgsForCall, struct {
+		deploymentGUID string
+		warnings       chan<- v3action.Warnings
+	}{deploymentGUID, warnings})
+	fake.recordInvocation("PollDeployment", []interface{}{deploymentGUID, warnings})
+	fake.pollDeploymentMutex.Unlock()
+	if fake.PollDeploymentStub != nil {
+		return fake.PollDeploymentStub(deploymentGUID, warnings)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pollDeploymentReturns.result1
+}
+
+func (fake *FakeV3PushActor) PollDeploymentCallCount() int {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return len(fake.pollDeploymentArgsForCall)
+}
+
+func (fake *FakeV3PushActor) PollDeploymentArgsForCall(i int) (string, chan<- v3action.Warnings) {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return fake.pollDeploymentArgsForCall[i].deploymentGUID, fake.pollDeploymentArgsForCall[i].warnings
+}
+
+func (fake *FakeV3PushActor) PollDeploymentReturns(result1 error) {
+	fake.PollDeploymentStub = nil
+	fake.pollDeploymentReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV3PushActor) PollDeploymentReturnsOnCall(i int, result1 error) {
+	fake.PollDeploymentStub = nil
+	if fake.pollDeploymentReturnsOnCall == nil {
+		fake.pollDeploymentReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pollDeploymentReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV3PushActor) PollStart(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error {
 	fake.pollStartMutex.Lock()
 	ret, specificReturn := fake.pollStartReturnsOnCall[len(fake.pollStartArgsForCall)]
 	fake.pollStartArgsForCall = append(fake.pollStartArgsForCall, struct {
-		appGUID  string
-		warnings chan<- v3action.Warnings
-	}{appGUID, warnings})
-	fake.recordInvocation("PollStart", []interface{}{appGUID, warnings})
+		appGUID        string
+		startupTimeout time.Duration
+		warnings       chan<- v3action.Warnings
+	}{appGUID, startupTimeout, warnings})
+	fake.recordInvocation("PollStart", []interface{}{appGUID, startupTimeout, warnings})
 	fake.pollStartMutex.Unlock()
 	if fake.PollStartStub != nil {
-		return fake.PollStartStub(appGUID, warnings)
+		return fake.PollStartStub(appGUID, startupTimeout, warnings)
 	}
 	if specificReturn {
 		return ret.result1
@@ -538,10 +759,10 @@ func (fake *FakeV3PushActor) PollStartCallCount() int {
 	return len(fake.pollStartArgsForCall)
 }
 
-func (fake *FakeV3PushActor) PollStartArgsForCall(i int) (string, chan<- v3action.Warnings) {
+func (fake *FakeV3PushActor) PollStartArgsForCall(i int) (string, time.Duration, chan<- v3action.Warnings) {
 	fake.pollStartMutex.RLock()
 	defer fake.pollStartMutex.RUnlock()
-	return fake.pollStartArgsForCall[i].appGUID, fake.pollStartArgsForCall[i].warnings
+	return fake.pollStartArgsForCall[i].appGUID, fake.pollStartArgsForCall[i].startupTimeout, fake.pollStartArgsForCall[i].warnings
 }
 
 func (fake *FakeV3PushActor) PollStartReturns(result1 error) {
@@ -616,17 +837,76 @@ func (fake *FakeV3PushActor) SetApplicationDropletReturnsOnCall(i int, result1 v
 	}{result1, result2}
 }
 
-func (fake *FakeV3PushActor) StagePackage(packageGUID string, appName string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+func (fake *FakeV3PushActor) SetProcessCommand(appGUID string, processType string, command types.FilteredString) (v3action.Warnings, error) {
+	fake.setProcessCommandMutex.Lock()
+	ret, specificReturn := fake.setProcessCommandReturnsOnCall[len(fake.setProcessCommandArgsForCall)]
+	fake.setProcessCommandArgsForCall = append(fake.setProcessCommandArgsForCall, struct {
+		appGUID     string
+		processType string
+		command     types.FilteredString
+	}{appGUID, processType, command})
+	fake.recordInvocation("SetProcessCommand", []interface{}{appGUID, processType, command})
+	fake.setProcessCommandMutex.Unlock()
+	if fake.SetProcessCommandStub != nil {
+		return fake.SetProcessCommandStub(appGUID, processType, command)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setProcessCommandReturns.result1, fake.setProcessCommandReturns.result2
+}
+
+func (fake *FakeV3PushActor) SetProcessCommandCallCount() int {
+	fake.setProcessCommandMutex.RLock()
+	defer fake.setProcessCommandMutex.RUnlock()
+	return len(fake.setProcessCommandArgsForCall)
+}
+
+func (fake *FakeV3PushActor) SetProcessCommandArgsForCall(i int) (string, string, types.FilteredString) {
+	fake.setProcessCommandMutex.RLock()
+	defer fake.setProcessCommandMutex.RUnlock()
+	return fake.setProcessCommandArgsForCall[i].appGUID, fake.setProcessCommandArgsForCall[i].processType, fake.setProcessCommandArgsForCall[i].command
+}
+
+func (fake *FakeV3PushActor) SetProcessCommandReturns(result1 v3action.Warnings, result2 error) {
+	fake.SetProcessCommandStub = nil
+	fake.setProcessCommandReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) SetProcessCommandReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.SetProcessCommandStub = nil
+	if fake.setProcessCommandReturnsOnCall == nil {
+		fake.setProcessCommandReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.setProcessCommandReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) StagePackage(packageGUID string, appName string, buildpacks []string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+	var buildpacksCopy []string
+	if buildpacks != nil {
+		buildpacksCopy = make([]string, len(buildpacks))
+		copy(buildpacksCopy, buildpacks)
+	}
 	fake.stagePackageMutex.Lock()
 	ret, specificReturn := fake.stagePackageReturnsOnCall[len(fake.stagePackageArgsForCall)]
 	fake.stagePackageArgsForCall = append(fake.stagePackageArgsForCall, struct {
 		packageGUID string
 		appName     string
-	}{packageGUID, appName})
-	fake.recordInvocation("StagePackage", []interface{}{packageGUID, appName})
+		buildpacks  []string
+	}{packageGUID, appName, buildpacksCopy})
+	fake.recordInvocation("StagePackage", []interface{}{packageGUID, appName, buildpacksCopy})
 	fake.stagePackageMutex.Unlock()
 	if fake.StagePackageStub != nil {
-		return fake.StagePackageStub(packageGUID, appName)
+		return fake.StagePackageStub(packageGUID, appName, buildpacks)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -640,10 +920,10 @@ func (fake *FakeV3PushActor) StagePackageCallCount() int {
 	return len(fake.stagePackageArgsForCall)
 }
 
-func (fake *FakeV3PushActor) StagePackageArgsForCall(i int) (string, string) {
+func (fake *FakeV3PushActor) StagePackageArgsForCall(i int) (string, string, []string) {
 	fake.stagePackageMutex.RLock()
 	defer fake.stagePackageMutex.RUnlock()
-	return fake.stagePackageArgsForCall[i].packageGUID, fake.stagePackageArgsForCall[i].appName
+	return fake.stagePackageArgsForCall[i].packageGUID, fake.stagePackageArgsForCall[i].appName, fake.stagePackageArgsForCall[i].buildpacks
 }
 
 func (fake *FakeV3PushActor) StagePackageReturns(result1 <-chan v3action.Droplet, result2 <-chan v3action.Warnings, result3 <-chan error) {
@@ -835,6 +1115,8 @@ func (fake *FakeV3PushActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
 	fake.createPackageByApplicationNameAndSpaceMutex.RLock()
 	defer fake.createPackageByApplicationNameAndSpaceMutex.RUnlock()
 	fake.createApplicationInSpaceMutex.RLock()
@@ -843,12 +1125,18 @@ func (fake *FakeV3PushActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	fake.getStackByNameMutex.RLock()
+	defer fake.getStackByNameMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
 	fake.pollStartMutex.RLock()
 	defer fake.pollStartMutex.RUnlock()
 	fake.setApplicationDropletMutex.RLock()
 	defer fake.setApplicationDropletMutex.RUnlock()
+	fake.setProcessCommandMutex.RLock()
+	defer fake.setProcessCommandMutex.RUnlock()
 	fake.stagePackageMutex.RLock()
 	defer fake.stagePackageMutex.RUnlock()
 	fake.startApplicationMutex.RLock()
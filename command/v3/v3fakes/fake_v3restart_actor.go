@@ -3,6 +3,7 @@ package v3fakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command/v3"
@@ -18,6 +19,38 @@ type FakeV3RestartActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CreateDeploymentStub        func(appGUID string, dropletGUID string) (v3action.Deployment, v3action.Warnings, error)
+	createDeploymentMutex       sync.RWMutex
+	createDeploymentArgsForCall []struct {
+		appGUID     string
+		dropletGUID string
+	}
+	createDeploymentReturns struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
+	createDeploymentReturnsOnCall map[int]struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
+	DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexStub        func(appName string, spaceGUID string, processType string, instanceIndex int) (v3action.Warnings, error)
+	deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex       sync.RWMutex
+	deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall []struct {
+		appName       string
+		spaceGUID     string
+		processType   string
+		instanceIndex int
+	}
+	deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	GetApplicationByNameAndSpaceStub        func(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -34,6 +67,76 @@ type FakeV3RestartActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetProcessInstancesByApplicationAndTypeStub        func(appGUID string, processType string) ([]v3action.Instance, v3action.Warnings, error)
+	getProcessInstancesByApplicationAndTypeMutex       sync.RWMutex
+	getProcessInstancesByApplicationAndTypeArgsForCall []struct {
+		appGUID     string
+		processType string
+	}
+	getProcessInstancesByApplicationAndTypeReturns struct {
+		result1 []v3action.Instance
+		result2 v3action.Warnings
+		result3 error
+	}
+	getProcessInstancesByApplicationAndTypeReturnsOnCall map[int]struct {
+		result1 []v3action.Instance
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetStreamingLogsStub        func(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error)
+	getStreamingLogsMutex       sync.RWMutex
+	getStreamingLogsArgsForCall []struct {
+		appGUID string
+		client  v3action.NOAAClient
+	}
+	getStreamingLogsReturns struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}
+	getStreamingLogsReturnsOnCall map[int]struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}
+	PollDeploymentStub        func(deploymentGUID string, warnings chan<- v3action.Warnings) error
+	pollDeploymentMutex       sync.RWMutex
+	pollDeploymentArgsForCall []struct {
+		deploymentGUID string
+		warnings       chan<- v3action.Warnings
+	}
+	pollDeploymentReturns struct {
+		result1 error
+	}
+	pollDeploymentReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PollProcessInstanceStub        func(appGUID string, processType string, replacedInstance v3action.Instance) (v3action.Warnings, error)
+	pollProcessInstanceMutex       sync.RWMutex
+	pollProcessInstanceArgsForCall []struct {
+		appGUID          string
+		processType      string
+		replacedInstance v3action.Instance
+	}
+	pollProcessInstanceReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	pollProcessInstanceReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	PollStartStub        func(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error
+	pollStartMutex       sync.RWMutex
+	pollStartArgsForCall []struct {
+		appGUID        string
+		startupTimeout time.Duration
+		warnings       chan<- v3action.Warnings
+	}
+	pollStartReturns struct {
+		result1 error
+	}
+	pollStartReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StartApplicationStub        func(appGUID string) (v3action.Application, v3action.Warnings, error)
 	startApplicationMutex       sync.RWMutex
 	startApplicationArgsForCall []struct {
@@ -106,6 +209,115 @@ func (fake *FakeV3RestartActor) CloudControllerAPIVersionReturnsOnCall(i int, re
 	}{result1}
 }
 
+func (fake *FakeV3RestartActor) CreateDeployment(appGUID string, dropletGUID string) (v3action.Deployment, v3action.Warnings, error) {
+	fake.createDeploymentMutex.Lock()
+	ret, specificReturn := fake.createDeploymentReturnsOnCall[len(fake.createDeploymentArgsForCall)]
+	fake.createDeploymentArgsForCall = append(fake.createDeploymentArgsForCall, struct {
+		appGUID     string
+		dropletGUID string
+	}{appGUID, dropletGUID})
+	fake.recordInvocation("CreateDeployment", []interface{}{appGUID, dropletGUID})
+	fake.createDeploymentMutex.Unlock()
+	if fake.CreateDeploymentStub != nil {
+		return fake.CreateDeploymentStub(appGUID, dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createDeploymentReturns.result1, fake.createDeploymentReturns.result2, fake.createDeploymentReturns.result3
+}
+
+func (fake *FakeV3RestartActor) CreateDeploymentCallCount() int {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	return len(fake.createDeploymentArgsForCall)
+}
+
+func (fake *FakeV3RestartActor) CreateDeploymentArgsForCall(i int) (string, string) {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	return fake.createDeploymentArgsForCall[i].appGUID, fake.createDeploymentArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeV3RestartActor) CreateDeploymentReturns(result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.CreateDeploymentStub = nil
+	fake.createDeploymentReturns = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3RestartActor) CreateDeploymentReturnsOnCall(i int, result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.CreateDeploymentStub = nil
+	if fake.createDeploymentReturnsOnCall == nil {
+		fake.createDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Deployment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.createDeploymentReturnsOnCall[i] = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3RestartActor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, instanceIndex int) (v3action.Warnings, error) {
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.Lock()
+	ret, specificReturn := fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall[len(fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall)]
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall = append(fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall, struct {
+		appName       string
+		spaceGUID     string
+		processType   string
+		instanceIndex int
+	}{appName, spaceGUID, processType, instanceIndex})
+	fake.recordInvocation("DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex", []interface{}{appName, spaceGUID, processType, instanceIndex})
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.Unlock()
+	if fake.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexStub != nil {
+		return fake.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexStub(appName, spaceGUID, processType, instanceIndex)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns.result1, fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns.result2
+}
+
+func (fake *FakeV3RestartActor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexCallCount() int {
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
+	defer fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	return len(fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall)
+}
+
+func (fake *FakeV3RestartActor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall(i int) (string, string, string, int) {
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
+	defer fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	return fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall[i].appName, fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall[i].spaceGUID, fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall[i].processType, fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall[i].instanceIndex
+}
+
+func (fake *FakeV3RestartActor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns(result1 v3action.Warnings, result2 error) {
+	fake.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexStub = nil
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3RestartActor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexStub = nil
+	if fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall == nil {
+		fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3RestartActor) GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -161,6 +373,265 @@ func (fake *FakeV3RestartActor) GetApplicationByNameAndSpaceReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3RestartActor) GetProcessInstancesByApplicationAndType(appGUID string, processType string) ([]v3action.Instance, v3action.Warnings, error) {
+	fake.getProcessInstancesByApplicationAndTypeMutex.Lock()
+	ret, specificReturn := fake.getProcessInstancesByApplicationAndTypeReturnsOnCall[len(fake.getProcessInstancesByApplicationAndTypeArgsForCall)]
+	fake.getProcessInstancesByApplicationAndTypeArgsForCall = append(fake.getProcessInstancesByApplicationAndTypeArgsForCall, struct {
+		appGUID     string
+		processType string
+	}{appGUID, processType})
+	fake.recordInvocation("GetProcessInstancesByApplicationAndType", []interface{}{appGUID, processType})
+	fake.getProcessInstancesByApplicationAndTypeMutex.Unlock()
+	if fake.GetProcessInstancesByApplicationAndTypeStub != nil {
+		return fake.GetProcessInstancesByApplicationAndTypeStub(appGUID, processType)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getProcessInstancesByApplicationAndTypeReturns.result1, fake.getProcessInstancesByApplicationAndTypeReturns.result2, fake.getProcessInstancesByApplicationAndTypeReturns.result3
+}
+
+func (fake *FakeV3RestartActor) GetProcessInstancesByApplicationAndTypeCallCount() int {
+	fake.getProcessInstancesByApplicationAndTypeMutex.RLock()
+	defer fake.getProcessInstancesByApplicationAndTypeMutex.RUnlock()
+	return len(fake.getProcessInstancesByApplicationAndTypeArgsForCall)
+}
+
+func (fake *FakeV3RestartActor) GetProcessInstancesByApplicationAndTypeArgsForCall(i int) (string, string) {
+	fake.getProcessInstancesByApplicationAndTypeMutex.RLock()
+	defer fake.getProcessInstancesByApplicationAndTypeMutex.RUnlock()
+	return fake.getProcessInstancesByApplicationAndTypeArgsForCall[i].appGUID, fake.getProcessInstancesByApplicationAndTypeArgsForCall[i].processType
+}
+
+func (fake *FakeV3RestartActor) GetProcessInstancesByApplicationAndTypeReturns(result1 []v3action.Instance, result2 v3action.Warnings, result3 error) {
+	fake.GetProcessInstancesByApplicationAndTypeStub = nil
+	fake.getProcessInstancesByApplicationAndTypeReturns = struct {
+		result1 []v3action.Instance
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3RestartActor) GetProcessInstancesByApplicationAndTypeReturnsOnCall(i int, result1 []v3action.Instance, result2 v3action.Warnings, result3 error) {
+	fake.GetProcessInstancesByApplicationAndTypeStub = nil
+	if fake.getProcessInstancesByApplicationAndTypeReturnsOnCall == nil {
+		fake.getProcessInstancesByApplicationAndTypeReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Instance
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getProcessInstancesByApplicationAndTypeReturnsOnCall[i] = struct {
+		result1 []v3action.Instance
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3RestartActor) GetStreamingLogs(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error) {
+	fake.getStreamingLogsMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsReturnsOnCall[len(fake.getStreamingLogsArgsForCall)]
+	fake.getStreamingLogsArgsForCall = append(fake.getStreamingLogsArgsForCall, struct {
+		appGUID string
+		client  v3action.NOAAClient
+	}{appGUID, client})
+	fake.recordInvocation("GetStreamingLogs", []interface{}{appGUID, client})
+	fake.getStreamingLogsMutex.Unlock()
+	if fake.GetStreamingLogsStub != nil {
+		return fake.GetStreamingLogsStub(appGUID, client)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getStreamingLogsReturns.result1, fake.getStreamingLogsReturns.result2
+}
+
+func (fake *FakeV3RestartActor) GetStreamingLogsCallCount() int {
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	return len(fake.getStreamingLogsArgsForCall)
+}
+
+func (fake *FakeV3RestartActor) GetStreamingLogsArgsForCall(i int) (string, v3action.NOAAClient) {
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	return fake.getStreamingLogsArgsForCall[i].appGUID, fake.getStreamingLogsArgsForCall[i].client
+}
+
+func (fake *FakeV3RestartActor) GetStreamingLogsReturns(result1 <-chan *v3action.LogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsStub = nil
+	fake.getStreamingLogsReturns = struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeV3RestartActor) GetStreamingLogsReturnsOnCall(i int, result1 <-chan *v3action.LogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsStub = nil
+	if fake.getStreamingLogsReturnsOnCall == nil {
+		fake.getStreamingLogsReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v3action.LogMessage
+			result2 <-chan error
+		})
+	}
+	fake.getStreamingLogsReturnsOnCall[i] = struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeV3RestartActor) PollDeployment(deploymentGUID string, warnings chan<- v3action.Warnings) error {
+	fake.pollDeploymentMutex.Lock()
+	ret, specificReturn := fake.pollDeploymentReturnsOnCall[len(fake.pollDeploymentArgsForCall)]
+	fake.pollDeploymentArgsForCall = append(fake.pollDeploymentArgsForCall, struct {
+		deploymentGUID string
+		warnings       chan<- v3action.Warnings
+	}{deploymentGUID, warnings})
+	fake.recordInvocation("PollDeployment", []interface{}{deploymentGUID, warnings})
+	fake.pollDeploymentMutex.Unlock()
+	if fake.PollDeploymentStub != nil {
+		return fake.PollDeploymentStub(deploymentGUID, warnings)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pollDeploymentReturns.result1
+}
+
+func (fake *FakeV3RestartActor) PollDeploymentCallCount() int {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return len(fake.pollDeploymentArgsForCall)
+}
+
+func (fake *FakeV3RestartActor) PollDeploymentArgsForCall(i int) (string, chan<- v3action.Warnings) {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return fake.pollDeploymentArgsForCall[i].deploymentGUID, fake.pollDeploymentArgsForCall[i].warnings
+}
+
+func (fake *FakeV3RestartActor) PollDeploymentReturns(result1 error) {
+	fake.PollDeploymentStub = nil
+	fake.pollDeploymentReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV3RestartActor) PollDeploymentReturnsOnCall(i int, result1 error) {
+	fake.PollDeploymentStub = nil
+	if fake.pollDeploymentReturnsOnCall == nil {
+		fake.pollDeploymentReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pollDeploymentReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV3RestartActor) PollProcessInstance(appGUID string, processType string, replacedInstance v3action.Instance) (v3action.Warnings, error) {
+	fake.pollProcessInstanceMutex.Lock()
+	ret, specificReturn := fake.pollProcessInstanceReturnsOnCall[len(fake.pollProcessInstanceArgsForCall)]
+	fake.pollProcessInstanceArgsForCall = append(fake.pollProcessInstanceArgsForCall, struct {
+		appGUID          string
+		processType      string
+		replacedInstance v3action.Instance
+	}{appGUID, processType, replacedInstance})
+	fake.recordInvocation("PollProcessInstance", []interface{}{appGUID, processType, replacedInstance})
+	fake.pollProcessInstanceMutex.Unlock()
+	if fake.PollProcessInstanceStub != nil {
+		return fake.PollProcessInstanceStub(appGUID, processType, replacedInstance)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.pollProcessInstanceReturns.result1, fake.pollProcessInstanceReturns.result2
+}
+
+func (fake *FakeV3RestartActor) PollProcessInstanceCallCount() int {
+	fake.pollProcessInstanceMutex.RLock()
+	defer fake.pollProcessInstanceMutex.RUnlock()
+	return len(fake.pollProcessInstanceArgsForCall)
+}
+
+func (fake *FakeV3RestartActor) PollProcessInstanceArgsForCall(i int) (string, string, v3action.Instance) {
+	fake.pollProcessInstanceMutex.RLock()
+	defer fake.pollProcessInstanceMutex.RUnlock()
+	return fake.pollProcessInstanceArgsForCall[i].appGUID, fake.pollProcessInstanceArgsForCall[i].processType, fake.pollProcessInstanceArgsForCall[i].replacedInstance
+}
+
+func (fake *FakeV3RestartActor) PollProcessInstanceReturns(result1 v3action.Warnings, result2 error) {
+	fake.PollProcessInstanceStub = nil
+	fake.pollProcessInstanceReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3RestartActor) PollProcessInstanceReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.PollProcessInstanceStub = nil
+	if fake.pollProcessInstanceReturnsOnCall == nil {
+		fake.pollProcessInstanceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.pollProcessInstanceReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3RestartActor) PollStart(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error {
+	fake.pollStartMutex.Lock()
+	ret, specificReturn := fake.pollStartReturnsOnCall[len(fake.pollStartArgsForCall)]
+	fake.pollStartArgsForCall = append(fake.pollStartArgsForCall, struct {
+		appGUID        string
+		startupTimeout time.Duration
+		warnings       chan<- v3action.Warnings
+	}{appGUID, startupTimeout, warnings})
+	fake.recordInvocation("PollStart", []interface{}{appGUID, startupTimeout, warnings})
+	fake.pollStartMutex.Unlock()
+	if fake.PollStartStub != nil {
+		return fake.PollStartStub(appGUID, startupTimeout, warnings)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pollStartReturns.result1
+}
+
+func (fake *FakeV3RestartActor) PollStartCallCount() int {
+	fake.pollStartMutex.RLock()
+	defer fake.pollStartMutex.RUnlock()
+	return len(fake.pollStartArgsForCall)
+}
+
+func (fake *FakeV3RestartActor) PollStartArgsForCall(i int) (string, time.Duration, chan<- v3action.Warnings) {
+	fake.pollStartMutex.RLock()
+	defer fake.pollStartMutex.RUnlock()
+	return fake.pollStartArgsForCall[i].appGUID, fake.pollStartArgsForCall[i].startupTimeout, fake.pollStartArgsForCall[i].warnings
+}
+
+func (fake *FakeV3RestartActor) PollStartReturns(result1 error) {
+	fake.PollStartStub = nil
+	fake.pollStartReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV3RestartActor) PollStartReturnsOnCall(i int, result1 error) {
+	fake.PollStartStub = nil
+	if fake.pollStartReturnsOnCall == nil {
+		fake.pollStartReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pollStartReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeV3RestartActor) StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error) {
 	fake.startApplicationMutex.Lock()
 	ret, specificReturn := fake.startApplicationReturnsOnCall[len(fake.startApplicationArgsForCall)]
@@ -271,8 +742,22 @@ func (fake *FakeV3RestartActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
+	defer fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getProcessInstancesByApplicationAndTypeMutex.RLock()
+	defer fake.getProcessInstancesByApplicationAndTypeMutex.RUnlock()
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	fake.pollProcessInstanceMutex.RLock()
+	defer fake.pollProcessInstanceMutex.RUnlock()
+	fake.pollStartMutex.RLock()
+	defer fake.pollStartMutex.RUnlock()
 	fake.startApplicationMutex.RLock()
 	defer fake.startApplicationMutex.RUnlock()
 	fake.stopApplicationMutex.RLock()
@@ -34,6 +34,53 @@ type FakeV3RestartAppInstanceActor struct {
 		result1 v3action.Warnings
 		result2 error
 	}
+	GetApplicationByNameAndSpaceStub        func(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		appName   string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetProcessInstancesByApplicationAndTypeStub        func(appGUID string, processType string) ([]v3action.Instance, v3action.Warnings, error)
+	getProcessInstancesByApplicationAndTypeMutex       sync.RWMutex
+	getProcessInstancesByApplicationAndTypeArgsForCall []struct {
+		appGUID     string
+		processType string
+	}
+	getProcessInstancesByApplicationAndTypeReturns struct {
+		result1 []v3action.Instance
+		result2 v3action.Warnings
+		result3 error
+	}
+	getProcessInstancesByApplicationAndTypeReturnsOnCall map[int]struct {
+		result1 []v3action.Instance
+		result2 v3action.Warnings
+		result3 error
+	}
+	PollProcessInstanceStub        func(appGUID string, processType string, replacedInstance v3action.Instance) (v3action.Warnings, error)
+	pollProcessInstanceMutex       sync.RWMutex
+	pollProcessInstanceArgsForCall []struct {
+		appGUID          string
+		processType      string
+		replacedInstance v3action.Instance
+	}
+	pollProcessInstanceReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	pollProcessInstanceReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -132,6 +179,169 @@ func (fake *FakeV3RestartAppInstanceActor) DeleteInstanceByApplicationNameSpaceP
 	}{result1, result2}
 }
 
+func (fake *FakeV3RestartAppInstanceActor) GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		appName   string
+		spaceGUID string
+	}{appName, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{appName, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(appName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeV3RestartAppInstanceActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3RestartAppInstanceActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].appName, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV3RestartAppInstanceActor) GetApplicationByNameAndSpaceReturns(result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3RestartAppInstanceActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Application
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3RestartAppInstanceActor) GetProcessInstancesByApplicationAndType(appGUID string, processType string) ([]v3action.Instance, v3action.Warnings, error) {
+	fake.getProcessInstancesByApplicationAndTypeMutex.Lock()
+	ret, specificReturn := fake.getProcessInstancesByApplicationAndTypeReturnsOnCall[len(fake.getProcessInstancesByApplicationAndTypeArgsForCall)]
+	fake.getProcessInstancesByApplicationAndTypeArgsForCall = append(fake.getProcessInstancesByApplicationAndTypeArgsForCall, struct {
+		appGUID     string
+		processType string
+	}{appGUID, processType})
+	fake.recordInvocation("GetProcessInstancesByApplicationAndType", []interface{}{appGUID, processType})
+	fake.getProcessInstancesByApplicationAndTypeMutex.Unlock()
+	if fake.GetProcessInstancesByApplicationAndTypeStub != nil {
+		return fake.GetProcessInstancesByApplicationAndTypeStub(appGUID, processType)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getProcessInstancesByApplicationAndTypeReturns.result1, fake.getProcessInstancesByApplicationAndTypeReturns.result2, fake.getProcessInstancesByApplicationAndTypeReturns.result3
+}
+
+func (fake *FakeV3RestartAppInstanceActor) GetProcessInstancesByApplicationAndTypeCallCount() int {
+	fake.getProcessInstancesByApplicationAndTypeMutex.RLock()
+	defer fake.getProcessInstancesByApplicationAndTypeMutex.RUnlock()
+	return len(fake.getProcessInstancesByApplicationAndTypeArgsForCall)
+}
+
+func (fake *FakeV3RestartAppInstanceActor) GetProcessInstancesByApplicationAndTypeArgsForCall(i int) (string, string) {
+	fake.getProcessInstancesByApplicationAndTypeMutex.RLock()
+	defer fake.getProcessInstancesByApplicationAndTypeMutex.RUnlock()
+	return fake.getProcessInstancesByApplicationAndTypeArgsForCall[i].appGUID, fake.getProcessInstancesByApplicationAndTypeArgsForCall[i].processType
+}
+
+func (fake *FakeV3RestartAppInstanceActor) GetProcessInstancesByApplicationAndTypeReturns(result1 []v3action.Instance, result2 v3action.Warnings, result3 error) {
+	fake.GetProcessInstancesByApplicationAndTypeStub = nil
+	fake.getProcessInstancesByApplicationAndTypeReturns = struct {
+		result1 []v3action.Instance
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3RestartAppInstanceActor) GetProcessInstancesByApplicationAndTypeReturnsOnCall(i int, result1 []v3action.Instance, result2 v3action.Warnings, result3 error) {
+	fake.GetProcessInstancesByApplicationAndTypeStub = nil
+	if fake.getProcessInstancesByApplicationAndTypeReturnsOnCall == nil {
+		fake.getProcessInstancesByApplicationAndTypeReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Instance
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getProcessInstancesByApplicationAndTypeReturnsOnCall[i] = struct {
+		result1 []v3action.Instance
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3RestartAppInstanceActor) PollProcessInstance(appGUID string, processType string, replacedInstance v3action.Instance) (v3action.Warnings, error) {
+	fake.pollProcessInstanceMutex.Lock()
+	ret, specificReturn := fake.pollProcessInstanceReturnsOnCall[len(fake.pollProcessInstanceArgsForCall)]
+	fake.pollProcessInstanceArgsForCall = append(fake.pollProcessInstanceArgsForCall, struct {
+		appGUID          string
+		processType      string
+		replacedInstance v3action.Instance
+	}{appGUID, processType, replacedInstance})
+	fake.recordInvocation("PollProcessInstance", []interface{}{appGUID, processType, replacedInstance})
+	fake.pollProcessInstanceMutex.Unlock()
+	if fake.PollProcessInstanceStub != nil {
+		return fake.PollProcessInstanceStub(appGUID, processType, replacedInstance)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.pollProcessInstanceReturns.result1, fake.pollProcessInstanceReturns.result2
+}
+
+func (fake *FakeV3RestartAppInstanceActor) PollProcessInstanceCallCount() int {
+	fake.pollProcessInstanceMutex.RLock()
+	defer fake.pollProcessInstanceMutex.RUnlock()
+	return len(fake.pollProcessInstanceArgsForCall)
+}
+
+func (fake *FakeV3RestartAppInstanceActor) PollProcessInstanceArgsForCall(i int) (string, string, v3action.Instance) {
+	fake.pollProcessInstanceMutex.RLock()
+	defer fake.pollProcessInstanceMutex.RUnlock()
+	return fake.pollProcessInstanceArgsForCall[i].appGUID, fake.pollProcessInstanceArgsForCall[i].processType, fake.pollProcessInstanceArgsForCall[i].replacedInstance
+}
+
+func (fake *FakeV3RestartAppInstanceActor) PollProcessInstanceReturns(result1 v3action.Warnings, result2 error) {
+	fake.PollProcessInstanceStub = nil
+	fake.pollProcessInstanceReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3RestartAppInstanceActor) PollProcessInstanceReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.PollProcessInstanceStub = nil
+	if fake.pollProcessInstanceReturnsOnCall == nil {
+		fake.pollProcessInstanceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.pollProcessInstanceReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3RestartAppInstanceActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -139,6 +349,12 @@ func (fake *FakeV3RestartAppInstanceActor) Invocations() map[string][][]interfac
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
 	defer fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getProcessInstancesByApplicationAndTypeMutex.RLock()
+	defer fake.getProcessInstancesByApplicationAndTypeMutex.RUnlock()
+	fake.pollProcessInstanceMutex.RLock()
+	defer fake.pollProcessInstanceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
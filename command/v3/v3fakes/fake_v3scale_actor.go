@@ -3,6 +3,7 @@ package v3fakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command/v3"
@@ -50,6 +51,20 @@ type FakeV3ScaleActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetStreamingLogsStub        func(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error)
+	getStreamingLogsMutex       sync.RWMutex
+	getStreamingLogsArgsForCall []struct {
+		appGUID string
+		client  v3action.NOAAClient
+	}
+	getStreamingLogsReturns struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}
+	getStreamingLogsReturnsOnCall map[int]struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}
 	GetProcessByApplicationAndProcessTypeStub        func(appGUID string, processType string) (v3action.Process, v3action.Warnings, error)
 	getProcessByApplicationAndProcessTypeMutex       sync.RWMutex
 	getProcessByApplicationAndProcessTypeArgsForCall []struct {
@@ -108,11 +123,12 @@ type FakeV3ScaleActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
-	PollStartStub        func(appGUID string, warnings chan<- v3action.Warnings) error
+	PollStartStub        func(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error
 	pollStartMutex       sync.RWMutex
 	pollStartArgsForCall []struct {
-		appGUID  string
-		warnings chan<- v3action.Warnings
+		appGUID        string
+		startupTimeout time.Duration
+		warnings       chan<- v3action.Warnings
 	}
 	pollStartReturns struct {
 		result1 error
@@ -274,6 +290,58 @@ func (fake *FakeV3ScaleActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3ScaleActor) GetStreamingLogs(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error) {
+	fake.getStreamingLogsMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsReturnsOnCall[len(fake.getStreamingLogsArgsForCall)]
+	fake.getStreamingLogsArgsForCall = append(fake.getStreamingLogsArgsForCall, struct {
+		appGUID string
+		client  v3action.NOAAClient
+	}{appGUID, client})
+	fake.recordInvocation("GetStreamingLogs", []interface{}{appGUID, client})
+	fake.getStreamingLogsMutex.Unlock()
+	if fake.GetStreamingLogsStub != nil {
+		return fake.GetStreamingLogsStub(appGUID, client)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getStreamingLogsReturns.result1, fake.getStreamingLogsReturns.result2
+}
+
+func (fake *FakeV3ScaleActor) GetStreamingLogsCallCount() int {
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	return len(fake.getStreamingLogsArgsForCall)
+}
+
+func (fake *FakeV3ScaleActor) GetStreamingLogsArgsForCall(i int) (string, v3action.NOAAClient) {
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	return fake.getStreamingLogsArgsForCall[i].appGUID, fake.getStreamingLogsArgsForCall[i].client
+}
+
+func (fake *FakeV3ScaleActor) GetStreamingLogsReturns(result1 <-chan *v3action.LogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsStub = nil
+	fake.getStreamingLogsReturns = struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeV3ScaleActor) GetStreamingLogsReturnsOnCall(i int, result1 <-chan *v3action.LogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsStub = nil
+	if fake.getStreamingLogsReturnsOnCall == nil {
+		fake.getStreamingLogsReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v3action.LogMessage
+			result2 <-chan error
+		})
+	}
+	fake.getStreamingLogsReturnsOnCall[i] = struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
 func (fake *FakeV3ScaleActor) GetProcessByApplicationAndProcessType(appGUID string, processType string) (v3action.Process, v3action.Warnings, error) {
 	fake.getProcessByApplicationAndProcessTypeMutex.Lock()
 	ret, specificReturn := fake.getProcessByApplicationAndProcessTypeReturnsOnCall[len(fake.getProcessByApplicationAndProcessTypeArgsForCall)]
@@ -486,17 +554,18 @@ func (fake *FakeV3ScaleActor) StartApplicationReturnsOnCall(i int, result1 v3act
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV3ScaleActor) PollStart(appGUID string, warnings chan<- v3action.Warnings) error {
+func (fake *FakeV3ScaleActor) PollStart(appGUID string, startupTimeout time.Duration, warnings chan<- v3action.Warnings) error {
 	fake.pollStartMutex.Lock()
 	ret, specificReturn := fake.pollStartReturnsOnCall[len(fake.pollStartArgsForCall)]
 	fake.pollStartArgsForCall = append(fake.pollStartArgsForCall, struct {
-		appGUID  string
-		warnings chan<- v3action.Warnings
-	}{appGUID, warnings})
-	fake.recordInvocation("PollStart", []interface{}{appGUID, warnings})
+		appGUID        string
+		startupTimeout time.Duration
+		warnings       chan<- v3action.Warnings
+	}{appGUID, startupTimeout, warnings})
+	fake.recordInvocation("PollStart", []interface{}{appGUID, startupTimeout, warnings})
 	fake.pollStartMutex.Unlock()
 	if fake.PollStartStub != nil {
-		return fake.PollStartStub(appGUID, warnings)
+		return fake.PollStartStub(appGUID, startupTimeout, warnings)
 	}
 	if specificReturn {
 		return ret.result1
@@ -510,10 +579,10 @@ func (fake *FakeV3ScaleActor) PollStartCallCount() int {
 	return len(fake.pollStartArgsForCall)
 }
 
-func (fake *FakeV3ScaleActor) PollStartArgsForCall(i int) (string, chan<- v3action.Warnings) {
+func (fake *FakeV3ScaleActor) PollStartArgsForCall(i int) (string, time.Duration, chan<- v3action.Warnings) {
 	fake.pollStartMutex.RLock()
 	defer fake.pollStartMutex.RUnlock()
-	return fake.pollStartArgsForCall[i].appGUID, fake.pollStartArgsForCall[i].warnings
+	return fake.pollStartArgsForCall[i].appGUID, fake.pollStartArgsForCall[i].startupTimeout, fake.pollStartArgsForCall[i].warnings
 }
 
 func (fake *FakeV3ScaleActor) PollStartReturns(result1 error) {
@@ -544,6 +613,8 @@ func (fake *FakeV3ScaleActor) Invocations() map[string][][]interface{} {
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
 	fake.getProcessByApplicationAndProcessTypeMutex.RLock()
 	defer fake.getProcessByApplicationAndProcessTypeMutex.RUnlock()
 	fake.scaleProcessByApplicationMutex.RLock()
@@ -37,11 +37,12 @@ type FakeV3StageActor struct {
 		result3 v3action.Warnings
 		result4 error
 	}
-	StagePackageStub        func(packageGUID string, appName string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error)
+	StagePackageStub        func(packageGUID string, appName string, buildpacks []string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error)
 	stagePackageMutex       sync.RWMutex
 	stagePackageArgsForCall []struct {
 		packageGUID string
 		appName     string
+		buildpacks  []string
 	}
 	stagePackageReturns struct {
 		result1 <-chan v3action.Droplet
@@ -156,17 +157,23 @@ func (fake *FakeV3StageActor) GetStreamingLogsForApplicationByNameAndSpaceReturn
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeV3StageActor) StagePackage(packageGUID string, appName string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+func (fake *FakeV3StageActor) StagePackage(packageGUID string, appName string, buildpacks []string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+	var buildpacksCopy []string
+	if buildpacks != nil {
+		buildpacksCopy = make([]string, len(buildpacks))
+		copy(buildpacksCopy, buildpacks)
+	}
 	fake.stagePackageMutex.Lock()
 	ret, specificReturn := fake.stagePackageReturnsOnCall[len(fake.stagePackageArgsForCall)]
 	fake.stagePackageArgsForCall = append(fake.stagePackageArgsForCall, struct {
 		packageGUID string
 		appName     string
-	}{packageGUID, appName})
-	fake.recordInvocation("StagePackage", []interface{}{packageGUID, appName})
+		buildpacks  []string
+	}{packageGUID, appName, buildpacksCopy})
+	fake.recordInvocation("StagePackage", []interface{}{packageGUID, appName, buildpacksCopy})
 	fake.stagePackageMutex.Unlock()
 	if fake.StagePackageStub != nil {
-		return fake.StagePackageStub(packageGUID, appName)
+		return fake.StagePackageStub(packageGUID, appName, buildpacks)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -180,10 +187,10 @@ func (fake *FakeV3StageActor) StagePackageCallCount() int {
 	return len(fake.stagePackageArgsForCall)
 }
 
-func (fake *FakeV3StageActor) StagePackageArgsForCall(i int) (string, string) {
+func (fake *FakeV3StageActor) StagePackageArgsForCall(i int) (string, string, []string) {
 	fake.stagePackageMutex.RLock()
 	defer fake.stagePackageMutex.RUnlock()
-	return fake.stagePackageArgsForCall[i].packageGUID, fake.stagePackageArgsForCall[i].appName
+	return fake.stagePackageArgsForCall[i].packageGUID, fake.stagePackageArgsForCall[i].appName, fake.stagePackageArgsForCall[i].buildpacks
 }
 
 func (fake *FakeV3StageActor) StagePackageReturns(result1 <-chan v3action.Droplet, result2 <-chan v3action.Warnings, result3 <-chan error) {
@@ -0,0 +1,124 @@
+package experimental
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("v3-apply-manifest command", func() {
+	var (
+		orgName      string
+		spaceName    string
+		appName      string
+		manifestPath string
+		appDir       string
+	)
+
+	BeforeEach(func() {
+		orgName = helpers.NewOrgName()
+		spaceName = helpers.NewSpaceName()
+		appName = helpers.PrefixedRandomName("app")
+
+		var err error
+		appDir, err = ioutil.TempDir("", "simple-app")
+		Expect(err).ToNot(HaveOccurred())
+		manifestPath = filepath.Join(appDir, "manifest.yml")
+		// Ensure the file exists at the minimum
+		helpers.WriteManifest(manifestPath, map[string]interface{}{})
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(appDir)).ToNot(HaveOccurred())
+	})
+
+	Describe("help", func() {
+		Context("when --help flag is set", func() {
+			It("displays command usage to output", func() {
+				session := helpers.CF("v3-apply-manifest", "--help")
+
+				Eventually(session.Out).Should(Say("NAME:"))
+				Eventually(session.Out).Should(Say("v3-apply-manifest - \\*\\*EXPERIMENTAL\\*\\* Applies manifest properties to an application"))
+				Eventually(session.Out).Should(Say("USAGE:"))
+				Eventually(session.Out).Should(Say("cf v3-apply-manifest -f APP_MANIFEST_PATH"))
+				Eventually(session.Out).Should(Say("SEE ALSO:"))
+				Eventually(session.Out).Should(Say("v3-push"))
+
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	Context("when the -f flag is not given", func() {
+		It("displays incorrect usage", func() {
+			session := helpers.CF("v3-apply-manifest")
+
+			Eventually(session.Err).Should(Say("Incorrect Usage: the required flag `-f' was not specified"))
+			Eventually(session.Out).Should(Say("NAME:"))
+
+			Eventually(session).Should(Exit(1))
+		})
+	})
+
+	Context("when the -f flag path does not exist", func() {
+		It("displays incorrect usage", func() {
+			session := helpers.CF("v3-apply-manifest", "-f", "path/that/does/not/exist")
+
+			Eventually(session.Err).Should(Say("Incorrect Usage: The specified path 'path/that/does/not/exist' does not exist."))
+			Eventually(session.Out).Should(Say("NAME:"))
+
+			Eventually(session).Should(Exit(1))
+		})
+	})
+
+	Context("when the environment is set up correctly", func() {
+		BeforeEach(func() {
+			setupCF(orgName, spaceName)
+		})
+
+		AfterEach(func() {
+			helpers.QuickDeleteOrg(orgName)
+		})
+
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				helpers.WithHelloWorldApp(func(appDir string) {
+					Eventually(helpers.CustomCF(helpers.CFEnv{WorkingDirectory: appDir}, "v3-push", appName)).Should(Exit(0))
+				})
+			})
+
+			Context("when the app name in the manifest is present", func() {
+				BeforeEach(func() {
+					helpers.WriteManifest(manifestPath, map[string]interface{}{
+						"applications": []map[string]interface{}{
+							{
+								"name":      appName,
+								"instances": 3,
+							},
+						},
+					})
+				})
+
+				It("rescales the app", func() {
+					userName, _ := helpers.GetCredentials()
+
+					session := helpers.CF("v3-apply-manifest", "-f", manifestPath)
+					Eventually(session.Out).Should(Say("Applying manifest %s in org %s / space %s as %s\\.\\.\\.", regexp.QuoteMeta(manifestPath), orgName, spaceName, userName))
+					Eventually(session.Out).Should(Say("OK"))
+					Eventually(session).Should(Exit(0))
+
+					session = helpers.CF("v3-app", appName)
+					Eventually(session.Out).Should(Say(`instances:\s+\d/3`))
+					Eventually(session).Should(Exit(0))
+				})
+			})
+		})
+	})
+})
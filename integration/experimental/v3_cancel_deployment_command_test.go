@@ -0,0 +1,75 @@
+package experimental
+
+import (
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("v3-cancel-deployment command", func() {
+	var (
+		orgName   string
+		spaceName string
+		appName   string
+	)
+
+	BeforeEach(func() {
+		orgName = helpers.NewOrgName()
+		spaceName = helpers.NewSpaceName()
+		appName = helpers.PrefixedRandomName("app")
+	})
+
+	Describe("help", func() {
+		Context("when --help flag is set", func() {
+			It("Displays command usage to output", func() {
+				session := helpers.CF("v3-cancel-deployment", "--help")
+
+				Eventually(session.Out).Should(Say("NAME:"))
+				Eventually(session.Out).Should(Say("v3-cancel-deployment - \\*\\*EXPERIMENTAL\\*\\* Cancel the active deployment of an app and roll it back to its previous droplet"))
+				Eventually(session.Out).Should(Say("USAGE:"))
+				Eventually(session.Out).Should(Say("cf v3-cancel-deployment APP_NAME"))
+				Eventually(session.Out).Should(Say("SEE ALSO:"))
+				Eventually(session.Out).Should(Say("v3-push"))
+
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	Context("when the app name is not provided", func() {
+		It("tells the user that the app name is required, prints help text, and exits 1", func() {
+			session := helpers.CF("v3-cancel-deployment")
+
+			Eventually(session.Err).Should(Say("Incorrect Usage: the required argument `APP_NAME` was not provided"))
+			Eventually(session.Out).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+
+	Context("when the environment is set up correctly", func() {
+		BeforeEach(func() {
+			setupCF(orgName, spaceName)
+		})
+
+		Context("when the app has no active deployment", func() {
+			BeforeEach(func() {
+				helpers.WithHelloWorldApp(func(appDir string) {
+					Eventually(helpers.CustomCF(helpers.CFEnv{WorkingDirectory: appDir}, "v3-push", appName)).Should(Exit(0))
+				})
+			})
+
+			It("displays that there is no active deployment and exits 1", func() {
+				userName, _ := helpers.GetCredentials()
+
+				session := helpers.CF("v3-cancel-deployment", appName)
+				Eventually(session.Out).Should(Say("Canceling deployment for app %s in org %s / space %s as %s\\.\\.\\.", appName, orgName, spaceName, userName))
+				Eventually(session.Err).Should(Say("No active deployment found for app %s\\.", appName))
+				Eventually(session.Out).Should(Say("FAILED"))
+
+				Eventually(session).Should(Exit(1))
+			})
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package experimental
 
 import (
+	"fmt"
+
 	"code.cloudfoundry.org/cli/integration/helpers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -28,7 +30,9 @@ var _ = Describe("v3-create-app command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("v3-create-app - \\*\\*EXPERIMENTAL\\*\\* Create a V3 App"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say("cf v3-create-app APP_NAME"))
+				Eventually(session).Should(Say("cf v3-create-app APP_NAME \\[--app-type \\(buildpack \\| docker\\)\\]"))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say("--app-type\\s+App lifecycle type to stage and run the app \\(Default: buildpack\\)"))
 				Eventually(session).Should(Exit(0))
 			})
 		})
@@ -114,6 +118,20 @@ var _ = Describe("v3-create-app command", func() {
 				Eventually(session).Should(Say("OK"))
 				Eventually(session).Should(Exit(0))
 			})
+
+			Context("when app type is docker", func() {
+				It("creates an app with the docker lifecycle", func() {
+					session := helpers.CF("v3-create-app", appName, "--app-type", "docker")
+					userName, _ := helpers.GetCredentials()
+					Eventually(session).Should(Say("Creating V3 app %s in org %s / space %s as %s...", appName, orgName, spaceName, userName))
+					Eventually(session).Should(Say("OK"))
+					Eventually(session).Should(Exit(0))
+
+					session = helpers.CF("curl", fmt.Sprintf("/v3/apps?names=%s", appName))
+					Eventually(session).Should(Say(`"type": "docker"`))
+					Eventually(session).Should(Exit(0))
+				})
+			})
 		})
 
 		Context("when the app already exists", func() {
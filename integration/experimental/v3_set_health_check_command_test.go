@@ -29,14 +29,16 @@ var _ = Describe("v3-set-health-check command", func() {
 				Eventually(session.Out).Should(Say("NAME:"))
 				Eventually(session.Out).Should(Say("v3-set-health-check - \\*\\*EXPERIMENTAL\\*\\* Change type of health check performed on an app's process"))
 				Eventually(session.Out).Should(Say("USAGE:"))
-				Eventually(session.Out).Should(Say(`cf v3-set-health-check APP_NAME \(process \| port \| http \[--endpoint PATH\]\) \[--process PROCESS\]`))
+				Eventually(session.Out).Should(Say(`cf v3-set-health-check APP_NAME \(process \| port \| http \[--endpoint PATH\]\) \[--process PROCESS\] \[--invocation-timeout INVOCATION_TIMEOUT\]`))
 
 				Eventually(session.Out).Should(Say("EXAMPLES:"))
 				Eventually(session.Out).Should(Say("cf v3-set-health-check worker-app process --process worker"))
 				Eventually(session.Out).Should(Say("cf v3-set-health-check my-web-app http --endpoint /foo"))
+				Eventually(session.Out).Should(Say("cf v3-set-health-check my-web-app http --invocation-timeout 10"))
 
 				Eventually(session.Out).Should(Say("OPTIONS:"))
 				Eventually(session.Out).Should(Say(`--endpoint\s+Path on the app \(Default: /\)`))
+				Eventually(session.Out).Should(Say(`--invocation-timeout\s+Time \(in seconds\) that controls individual health check invocations`))
 				Eventually(session.Out).Should(Say(`--process\s+App process to update \(Default: web\)`))
 
 				Eventually(session).Should(Exit(0))
@@ -2,7 +2,9 @@ package global
 
 import (
 	"fmt"
+	"io/ioutil"
 	"math/rand"
+	"os"
 
 	"code.cloudfoundry.org/cli/integration/helpers"
 	. "github.com/onsi/ginkgo"
@@ -45,4 +47,34 @@ var _ = Describe("set-staging-environment-variable-group command", func() {
 		Eventually(session).Should(Say("%s\\s+%d", key2, val2))
 		Eventually(session).Should(Exit(0))
 	})
+
+	Context("when the path to a JSON file is provided", func() {
+		var jsonFilePath string
+
+		BeforeEach(func() {
+			jsonFile, err := ioutil.TempFile("", "env-group")
+			Expect(err).ToNot(HaveOccurred())
+			jsonFilePath = jsonFile.Name()
+
+			_, err = jsonFile.WriteString(fmt.Sprintf(`{"%s":"%s", "%s":%d}`, key1, val1, key2, val2))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jsonFile.Close()).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(jsonFilePath)).To(Succeed())
+		})
+
+		It("sets staging environment variables from the file", func() {
+			session := helpers.CF("set-staging-environment-variable-group", jsonFilePath)
+			Eventually(session).Should(Say("Setting the contents of the staging environment variable group as"))
+			Eventually(session).Should(Say("OK"))
+			Eventually(session).Should(Exit(0))
+
+			session = helpers.CF("staging-environment-variable-group")
+			Eventually(session).Should(Say("%s\\s+%s", key1, val1))
+			Eventually(session).Should(Say("%s\\s+%d", key2, val2))
+			Eventually(session).Should(Exit(0))
+		})
+	})
 })
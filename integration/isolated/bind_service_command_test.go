@@ -25,12 +25,13 @@ var _ = Describe("bind-service command", func() {
 				Eventually(session.Out).Should(Say("bind-service - Bind a service instance to an app"))
 
 				Eventually(session.Out).Should(Say("USAGE:"))
-				Eventually(session.Out).Should(Say("cf bind-service APP_NAME SERVICE_INSTANCE \\[-c PARAMETERS_AS_JSON\\]"))
+				Eventually(session.Out).Should(Say("cf bind-service APP_NAME SERVICE_INSTANCE \\[-c PARAMETERS_AS_JSON\\] \\[--binding-name BINDING_NAME\\]"))
 				Eventually(session.Out).Should(Say("Optionally provide service-specific configuration parameters in a valid JSON object in-line:"))
 				Eventually(session.Out).Should(Say("cf bind-service APP_NAME SERVICE_INSTANCE -c '{\"name\":\"value\",\"name\":\"value\"}'"))
 				Eventually(session.Out).Should(Say("Optionally provide a file containing service-specific configuration parameters in a valid JSON object."))
 				Eventually(session.Out).Should(Say("The path to the parameters file can be an absolute or relative path to a file."))
 				Eventually(session.Out).Should(Say("cf bind-service APP_NAME SERVICE_INSTANCE -c PATH_TO_FILE"))
+				Eventually(session.Out).Should(Say("cf bind-service APP_NAME SERVICE_INSTANCE -c @PATH_TO_FILE"))
 				Eventually(session.Out).Should(Say("Example of valid JSON object:"))
 				Eventually(session.Out).Should(Say("{"))
 				Eventually(session.Out).Should(Say("\"permissions\": \"read-only\""))
@@ -43,10 +44,12 @@ var _ = Describe("bind-service command", func() {
 				Eventually(session.Out).Should(Say("Windows PowerShell:"))
 				Eventually(session.Out).Should(Say("cf bind-service myapp mydb -c '{\\\\\"permissions\\\\\":\\\\\"read-only\\\\\"}'"))
 				Eventually(session.Out).Should(Say("cf bind-service myapp mydb -c ~/workspace/tmp/instance_config.json"))
+				Eventually(session.Out).Should(Say("cf bind-service myapp mydb --binding-name BINDING_NAME"))
 				Eventually(session.Out).Should(Say("ALIAS:"))
 				Eventually(session.Out).Should(Say("bs"))
 				Eventually(session.Out).Should(Say("OPTIONS:"))
-				Eventually(session.Out).Should(Say("-c      Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."))
+				Eventually(session.Out).Should(Say("-c\\s+Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."))
+				Eventually(session.Out).Should(Say("--binding-name\\s+Name to expose service instance to app process with \\(Default: service instance name\\)"))
 				Eventually(session.Out).Should(Say("SEE ALSO:"))
 				Eventually(session.Out).Should(Say("services"))
 			})
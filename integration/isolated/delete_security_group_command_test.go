@@ -0,0 +1,105 @@
+package isolated
+
+import (
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("delete-security-group command", func() {
+	var (
+		orgName           string
+		securityGroupName string
+		spaceName         string
+	)
+
+	BeforeEach(func() {
+		orgName = helpers.NewOrgName()
+		securityGroupName = helpers.NewSecurityGroupName()
+		spaceName = helpers.NewSpaceName()
+
+		helpers.LoginCF()
+	})
+
+	Describe("help", func() {
+		Context("when --help flag is set", func() {
+			It("Displays command usage to output", func() {
+				session := helpers.CF("delete-security-group", "--help")
+				Eventually(session.Out).Should(Say("NAME:"))
+				Eventually(session.Out).Should(Say("\\s+delete-security-group - Deletes a security group"))
+				Eventually(session.Out).Should(Say("USAGE:"))
+				Eventually(session.Out).Should(Say("\\s+cf delete-security-group SECURITY_GROUP \\[-f\\]"))
+				Eventually(session.Out).Should(Say("OPTIONS:"))
+				Eventually(session.Out).Should(Say("\\s+-f\\s+Force deletion without confirmation"))
+				Eventually(session.Out).Should(Say("SEE ALSO:"))
+				Eventually(session.Out).Should(Say("\\s+security-groups"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	Context("when the environment is not setup correctly", func() {
+		Context("when not logged in", func() {
+			BeforeEach(func() {
+				helpers.LogoutCF()
+			})
+
+			It("fails with not logged in message", func() {
+				session := helpers.CF("delete-security-group", securityGroupName, "-f")
+				Eventually(session.Out).Should(Say("FAILED"))
+				Eventually(session.Err).Should(Say("Not logged in. Use 'cf login' to log in."))
+				Eventually(session).Should(Exit(1))
+			})
+		})
+	})
+
+	Context("when the security group doesn't exist", func() {
+		It("warns that the security group does not exist and exits 0", func() {
+			session := helpers.CF("delete-security-group", securityGroupName, "-f")
+			Eventually(session.Err).Should(Say("Security group %s does not exist", securityGroupName))
+			Eventually(session.Out).Should(Say("OK"))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+
+	Context("when the security group is bound to a space", func() {
+		BeforeEach(func() {
+			helpers.CreateOrgAndSpace(orgName, spaceName)
+			someSecurityGroup := helpers.NewSecurityGroup(securityGroupName, "tcp", "127.0.0.1", "8443", "some-description")
+			someSecurityGroup.Create()
+			Eventually(helpers.CF("bind-security-group", securityGroupName, orgName, spaceName)).Should(Exit(0))
+		})
+
+		AfterEach(func() {
+			helpers.QuickDeleteOrg(orgName)
+		})
+
+		It("lists the bound spaces, unbinds and deletes the security group", func() {
+			username, _ := helpers.GetCredentials()
+			session := helpers.CF("delete-security-group", securityGroupName, "-f")
+			Eventually(session.Out).Should(Say("Security group %s is bound to the following spaces and will be unbound from them:", securityGroupName))
+			Eventually(session.Out).Should(Say("%s\\s+%s\\s+running", orgName, spaceName))
+			Eventually(session.Out).Should(Say("Deleting security group %s as %s\\.\\.\\.", securityGroupName, username))
+			Eventually(session.Out).Should(Say("OK"))
+			Eventually(session).Should(Exit(0))
+
+			Eventually(helpers.CF("security-groups")).ShouldNot(Say(securityGroupName))
+		})
+
+		It("does not delete the security group when the user declines", func() {
+			buffer := NewBuffer()
+			_, err := buffer.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			session := helpers.CFWithStdin(buffer, "delete-security-group", securityGroupName)
+			Eventually(session.Out).Should(Say("%s\\s+%s\\s+running", orgName, spaceName))
+			Eventually(session.Out).Should(Say("Really delete the security group %s\\?", securityGroupName))
+			Eventually(session.Out).Should(Say("Delete cancelled"))
+			Eventually(session).Should(Exit(0))
+
+			Eventually(helpers.CF("security-groups")).Should(Say(securityGroupName))
+		})
+	})
+})
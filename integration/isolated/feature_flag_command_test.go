@@ -19,4 +19,13 @@ var _ = Describe("feature-flag command", func() {
 		Eventually(session).Should(Say("user_org_creation\\s+(dis|en)abled"))
 		Eventually(session).Should(Exit(0))
 	})
+
+	Context("when the feature flag does not exist", func() {
+		It("displays a not found error", func() {
+			session := helpers.CF("feature-flag", "some-fake-flag")
+			Eventually(session.Err).Should(Say("Feature flag 'some-fake-flag' not found."))
+			Eventually(session).Should(Say("FAILED"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
 })
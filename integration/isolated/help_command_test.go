@@ -45,6 +45,7 @@ var _ = Describe("help command", func() {
 			Eventually(session.Out).Should(Say("CLI plugin management:"))
 			Eventually(session.Out).Should(Say("  install-plugin    list-plugin-repos"))
 			Eventually(session.Out).Should(Say("Global options:"))
+			Eventually(session.Out).Should(Say("  --error-format=json                Output errors to stderr as JSON objects with a code, message and details"))
 			Eventually(session.Out).Should(Say("  --help, -h                         Show help"))
 			Eventually(session.Out).Should(Say("  -v                                 Print API request diagnostics to stdout"))
 
@@ -65,9 +65,9 @@ var _ = Describe("update-buildpack command", func() {
 
 		It("outputs an error message to the user, provides help text, and exits 1", func() {
 			session := CF("update-buildpack", "some-buildpack", "-p", "https://example.com/bogus.tgz")
-			Eventually(session.Out).Should(Say("Failed to create a local temporary zip file for the buildpack"))
+			Eventually(session.Err).Should(Say("Failed to create a local temporary zip file for the buildpack"))
 			Eventually(session.Out).Should(Say("FAILED"))
-			Eventually(session.Out).Should(Say("Couldn't write zip file: zip: not a valid zip file"))
+			Eventually(session.Err).Should(Say("Error downloading file from https://example.com/bogus.tgz"))
 			Eventually(session).Should(Exit(1))
 		})
 	})
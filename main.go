@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strings"
 
+	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
 	"code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/common"
@@ -143,10 +144,15 @@ func isOption(s string) bool {
 
 func executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig, configErr := configv3.LoadConfig(configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		NoPager:     common.Commands.NoPager,
+		Verbose:     common.Commands.VerboseOrVersion,
+		ErrorFormat: common.Commands.ErrorFormat,
+		Output:      common.Commands.Output,
 	})
 	if configErr != nil {
-		if _, ok := configErr.(translatableerror.EmptyConfigError); !ok {
+		switch configErr.(type) {
+		case translatableerror.EmptyConfigError, translatableerror.CorruptConfigError:
+		default:
 			return configErr
 		}
 	}
@@ -195,12 +201,32 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		if err != nil {
 			return handleError(err, commandUI)
 		}
-		return handleError(extendedCmd.Execute(args), commandUI)
+		err = extendedCmd.Execute(args)
+		displayRequestSummary(cfConfig, commandUI)
+		return handleError(err, commandUI)
 	}
 
 	return fmt.Errorf("command does not conform to ExtendedCommander")
 }
 
+// displayRequestSummary displays the Cloud Controller requests made by the
+// command when requests are being logged.
+func displayRequestSummary(cfConfig *configv3.Config, commandUI *ui.UI) {
+	verbose, location := cfConfig.Verbose()
+	if verbose {
+		err := ccWrapper.DefaultRequestSummary.Display(commandUI.RequestLoggerTerminalDisplay())
+		if err != nil {
+			commandUI.DisplayWarning(err.Error())
+		}
+	}
+	if location != nil {
+		err := ccWrapper.DefaultRequestSummary.Display(commandUI.RequestLoggerFileWriter(location))
+		if err != nil {
+			commandUI.DisplayWarning(err.Error())
+		}
+	}
+}
+
 func handleError(err error, commandUI UI) error {
 	if err == nil {
 		return nil
@@ -381,3 +381,49 @@ func (c *cliConnection) GetService(serviceInstance string) (plugin_models.GetSer
 
 	return result, err
 }
+
+func (c *cliConnection) GetV3Apps() ([]plugin_models.GetV3AppsModel, error) {
+	var result []plugin_models.GetV3AppsModel
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetV3Apps", "", &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3AppProcesses(appName string) ([]plugin_models.GetV3AppProcessesModel, error) {
+	var result []plugin_models.GetV3AppProcessesModel
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetV3AppProcesses", appName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetOrgGUID(orgName string) (string, error) {
+	return c.callGUIDResolver("CliRpcCmd.GetOrgGUID", orgName)
+}
+
+func (c *cliConnection) GetSpaceGUID(spaceName string) (string, error) {
+	return c.callGUIDResolver("CliRpcCmd.GetSpaceGUID", spaceName)
+}
+
+func (c *cliConnection) GetAppGUID(appName string) (string, error) {
+	return c.callGUIDResolver("CliRpcCmd.GetAppGUID", appName)
+}
+
+func (c *cliConnection) GetServiceInstanceGUID(serviceInstanceName string) (string, error) {
+	return c.callGUIDResolver("CliRpcCmd.GetServiceInstanceGUID", serviceInstanceName)
+}
+
+func (c *cliConnection) callGUIDResolver(method string, name string) (string, error) {
+	var result string
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call(method, name, &result)
+	})
+
+	return result, err
+}
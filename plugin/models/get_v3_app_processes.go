@@ -0,0 +1,27 @@
+package plugin_models
+
+// GetV3AppProcessesModel is a process of an application as reported by the
+// v3 Cloud Controller API. Memory and DiskQuota are in megabytes.
+type GetV3AppProcessesModel struct {
+	Guid             string
+	Type             string
+	HealthCheckType  string
+	TotalInstances   int
+	RunningInstances int
+	Memory           uint64
+	DiskQuota        uint64
+	Instances        []GetV3AppProcessInstance
+}
+
+// GetV3AppProcessInstance is a single instance of a v3 process. Memory and
+// disk values are in bytes.
+type GetV3AppProcessInstance struct {
+	Index       int
+	State       string
+	Uptime      int
+	CpuUsage    float64
+	MemoryUsage uint64
+	MemoryQuota uint64
+	DiskUsage   uint64
+	DiskQuota   uint64
+}
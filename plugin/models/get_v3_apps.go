@@ -0,0 +1,10 @@
+package plugin_models
+
+// GetV3AppsModel is an application as reported by the v3 Cloud Controller
+// API.
+type GetV3AppsModel struct {
+	Name          string
+	Guid          string
+	State         string
+	LifecycleType string
+}
@@ -42,6 +42,21 @@ type CliConnection interface {
 	GetService(string) (plugin_models.GetService_Model, error)
 	GetOrg(string) (plugin_models.GetOrg_Model, error)
 	GetSpace(string) (plugin_models.GetSpace_Model, error)
+	GetV3Apps() ([]plugin_models.GetV3AppsModel, error)
+	GetV3AppProcesses(string) ([]plugin_models.GetV3AppProcessesModel, error)
+}
+
+//go:generate counterfeiter . GUIDResolver
+/**
+	Optional name to GUID lookups, cached for the duration of the plugin
+	command. Check for support with a type assertion on the CliConnection:
+	resolver, ok := cliConnection.(plugin.GUIDResolver)
+**/
+type GUIDResolver interface {
+	GetOrgGUID(orgName string) (string, error)
+	GetSpaceGUID(spaceName string) (string, error)
+	GetAppGUID(appName string) (string, error)
+	GetServiceInstanceGUID(serviceInstanceName string) (string, error)
 }
 
 type VersionType struct {
@@ -1,15 +1,24 @@
 package rpc
 
 import (
+	"errors"
 	"os"
 	"strings"
 
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/plugin"
 	"code.cloudfoundry.org/cli/plugin/models"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/cli/version"
 	"github.com/blang/semver"
 
@@ -39,6 +48,8 @@ type CliRpcService struct {
 type CliRpcCmd struct {
 	PluginMetadata       *plugin.PluginMetadata
 	MetadataMutex        *sync.RWMutex
+	V2Actor              V2Actor
+	V3Actor              V3Actor
 	outputCapture        OutputCapture
 	terminalOutputSwitch TerminalOutputSwitch
 	cliConfig            coreconfig.Repository
@@ -49,6 +60,26 @@ type CliRpcCmd struct {
 	stdout               io.Writer
 }
 
+//go:generate counterfeiter . V2Actor
+
+// V2Actor resolves names to GUIDs for the plugin API. The actor is kept for
+// the whole plugin command, so each name is only looked up once.
+type V2Actor interface {
+	GetOrganizationGUIDByName(orgName string) (string, v2action.Warnings, error)
+	GetSpaceGUIDByOrganizationAndName(orgGUID string, spaceName string) (string, v2action.Warnings, error)
+	GetApplicationGUIDByNameAndSpace(appName string, spaceGUID string) (string, v2action.Warnings, error)
+	GetServiceInstanceGUIDByNameAndSpace(serviceInstanceName string, spaceGUID string) (string, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . V3Actor
+
+// V3Actor serves the parts of the plugin API that are backed by the v3 Cloud
+// Controller API.
+type V3Actor interface {
+	GetApplicationsBySpace(spaceGUID string) ([]v3action.Application, v3action.Warnings, error)
+	GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string) (v3action.ApplicationSummary, v3action.Warnings, error)
+}
+
 //go:generate counterfeiter . TerminalOutputSwitch
 
 type TerminalOutputSwitch interface {
@@ -444,3 +475,207 @@ func (cmd *CliRpcCmd) GetService(serviceInstance string, retVal *plugin_models.G
 
 	return cmd.newCmdRunner.Command([]string{"service", serviceInstance}, deps, true)
 }
+
+func (cmd *CliRpcCmd) GetV3Apps(_ string, retVal *[]plugin_models.GetV3AppsModel) error {
+	actor, translate, err := cmd.v3Actor()
+	if err != nil {
+		return err
+	}
+
+	spaceGUID := cmd.cliConfig.SpaceFields().GUID
+	if spaceGUID == "" {
+		return translateError(translate, translatableerror.NoSpaceTargetedError{BinaryName: cf.Name})
+	}
+
+	apps, _, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return translateError(translate, sharedV3.HandleError(err))
+	}
+
+	*retVal = make([]plugin_models.GetV3AppsModel, len(apps))
+	for i, app := range apps {
+		(*retVal)[i] = plugin_models.GetV3AppsModel{
+			Name:          app.Name,
+			Guid:          app.GUID,
+			State:         app.State,
+			LifecycleType: string(app.Lifecycle.Type),
+		}
+	}
+
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetV3AppProcesses(appName string, retVal *[]plugin_models.GetV3AppProcessesModel) error {
+	actor, translate, err := cmd.v3Actor()
+	if err != nil {
+		return err
+	}
+
+	spaceGUID := cmd.cliConfig.SpaceFields().GUID
+	if spaceGUID == "" {
+		return translateError(translate, translatableerror.NoSpaceTargetedError{BinaryName: cf.Name})
+	}
+
+	summary, _, err := actor.GetApplicationSummaryByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return translateError(translate, sharedV3.HandleError(err))
+	}
+
+	*retVal = make([]plugin_models.GetV3AppProcessesModel, len(summary.ProcessSummaries))
+	for i, process := range summary.ProcessSummaries {
+		instances := make([]plugin_models.GetV3AppProcessInstance, len(process.InstanceDetails))
+		for j, instance := range process.InstanceDetails {
+			instances[j] = plugin_models.GetV3AppProcessInstance{
+				Index:       instance.Index,
+				State:       instance.State,
+				Uptime:      instance.Uptime,
+				CpuUsage:    instance.CPU,
+				MemoryUsage: instance.MemoryUsage,
+				MemoryQuota: instance.MemoryQuota,
+				DiskUsage:   instance.DiskUsage,
+				DiskQuota:   instance.DiskQuota,
+			}
+		}
+
+		(*retVal)[i] = plugin_models.GetV3AppProcessesModel{
+			Guid:             process.GUID,
+			Type:             process.Type,
+			HealthCheckType:  process.HealthCheck.Type,
+			TotalInstances:   process.Instances.Value,
+			RunningInstances: process.HealthyInstanceCount(),
+			Memory:           process.MemoryInMB.Value,
+			DiskQuota:        process.DiskInMB.Value,
+			Instances:        instances,
+		}
+	}
+
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetOrgGUID(orgName string, retVal *string) error {
+	actor, translate, err := cmd.v2Actor()
+	if err != nil {
+		return err
+	}
+
+	*retVal, _, err = actor.GetOrganizationGUIDByName(orgName)
+	return translateError(translate, sharedV2.HandleError(err))
+}
+
+func (cmd *CliRpcCmd) GetSpaceGUID(spaceName string, retVal *string) error {
+	actor, translate, err := cmd.v2Actor()
+	if err != nil {
+		return err
+	}
+
+	orgGUID := cmd.cliConfig.OrganizationFields().GUID
+	if orgGUID == "" {
+		return translateError(translate, translatableerror.NoOrganizationTargetedError{BinaryName: cf.Name})
+	}
+
+	*retVal, _, err = actor.GetSpaceGUIDByOrganizationAndName(orgGUID, spaceName)
+	return translateError(translate, sharedV2.HandleError(err))
+}
+
+func (cmd *CliRpcCmd) GetAppGUID(appName string, retVal *string) error {
+	actor, translate, err := cmd.v2Actor()
+	if err != nil {
+		return err
+	}
+
+	spaceGUID := cmd.cliConfig.SpaceFields().GUID
+	if spaceGUID == "" {
+		return translateError(translate, translatableerror.NoSpaceTargetedError{BinaryName: cf.Name})
+	}
+
+	*retVal, _, err = actor.GetApplicationGUIDByNameAndSpace(appName, spaceGUID)
+	return translateError(translate, sharedV2.HandleError(err))
+}
+
+func (cmd *CliRpcCmd) GetServiceInstanceGUID(serviceInstanceName string, retVal *string) error {
+	actor, translate, err := cmd.v2Actor()
+	if err != nil {
+		return err
+	}
+
+	spaceGUID := cmd.cliConfig.SpaceFields().GUID
+	if spaceGUID == "" {
+		return translateError(translate, translatableerror.NoSpaceTargetedError{BinaryName: cf.Name})
+	}
+
+	*retVal, _, err = actor.GetServiceInstanceGUIDByNameAndSpace(serviceInstanceName, spaceGUID)
+	return translateError(translate, sharedV2.HandleError(err))
+}
+
+// v2Actor returns the actor resolving names to GUIDs along with the
+// translation function for its errors. Unless one was provided, the actor is
+// built from the CLI config the first time it is needed and then reused, so
+// its name cache lasts for the whole plugin command.
+func (cmd *CliRpcCmd) v2Actor() (V2Actor, ui.TranslateFunc, error) {
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	translate, err := ui.GetTranslationFunc(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cmd.V2Actor != nil {
+		return cmd.V2Actor, translate, nil
+	}
+
+	commandUI, err := ui.NewUI(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ccClient, uaaClient, err := sharedV2.NewClients(config, commandUI, true)
+	if err != nil {
+		return nil, nil, translateError(translate, err)
+	}
+
+	cmd.V2Actor = v2action.NewActor(ccClient, uaaClient, config)
+	return cmd.V2Actor, translate, nil
+}
+
+// v3Actor returns the actor for the v3 plugin API along with the translation
+// function for its errors. Unless one was provided, the actor is built from
+// the CLI config the first time it is needed, since setting up the v3
+// client requires a round trip to the Cloud Controller.
+func (cmd *CliRpcCmd) v3Actor() (V3Actor, ui.TranslateFunc, error) {
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	translate, err := ui.GetTranslationFunc(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cmd.V3Actor != nil {
+		return cmd.V3Actor, translate, nil
+	}
+
+	commandUI, err := ui.NewUI(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ccClient, _, err := sharedV3.NewClients(config, commandUI, true)
+	if err != nil {
+		return nil, nil, translateError(translate, err)
+	}
+
+	cmd.V3Actor = v3action.NewActor(ccClient, nil, config)
+	return cmd.V3Actor, translate, nil
+}
+
+func translateError(translate ui.TranslateFunc, err error) error {
+	if translatableError, ok := err.(translatableerror.TranslatableError); ok {
+		return errors.New(translatableError.Translate(translate))
+	}
+	return err
+}
@@ -7,6 +7,9 @@ import (
 	"os"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/api/authentication/authenticationfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -18,6 +21,7 @@ import (
 	cmdRunner "code.cloudfoundry.org/cli/plugin/rpc"
 	. "code.cloudfoundry.org/cli/plugin/rpc/fakecommand"
 	"code.cloudfoundry.org/cli/plugin/rpc/rpcfakes"
+	"code.cloudfoundry.org/cli/types"
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -539,6 +543,199 @@ var _ = Describe("Server", func() {
 				})
 			})
 
+			Context(".GetV3Apps and .GetV3AppProcesses", func() {
+				var fakeV3Actor *rpcfakes.FakeV3Actor
+
+				BeforeEach(func() {
+					config.SetSpaceFields(models.SpaceFields{
+						GUID: "space-guid",
+						Name: "space-name",
+					})
+
+					fakeV3Actor = new(rpcfakes.FakeV3Actor)
+					rpcService, err = NewRpcService(nil, nil, config, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+					Expect(err).ToNot(HaveOccurred())
+					rpcService.RpcCmd.V3Actor = fakeV3Actor
+
+					err := rpcService.Start()
+					Expect(err).ToNot(HaveOccurred())
+
+					pingCli(rpcService.Port())
+
+					client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns the v3 apps in the targeted space", func() {
+					fakeV3Actor.GetApplicationsBySpaceReturns(
+						[]v3action.Application{
+							{
+								Name:      "app-1",
+								GUID:      "app-guid-1",
+								State:     "STARTED",
+								Lifecycle: v3action.AppLifecycle{Type: v3action.BuildpackAppLifecycleType},
+							},
+						},
+						v3action.Warnings{"some-warning"},
+						nil,
+					)
+
+					var apps []plugin_models.GetV3AppsModel
+					err = client.Call("CliRpcCmd.GetV3Apps", "", &apps)
+
+					Expect(err).ToNot(HaveOccurred())
+					Expect(apps).To(Equal([]plugin_models.GetV3AppsModel{
+						{Name: "app-1", Guid: "app-guid-1", State: "STARTED", LifecycleType: "buildpack"},
+					}))
+					Expect(fakeV3Actor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("space-guid"))
+				})
+
+				It("returns the processes of the named app in the targeted space", func() {
+					fakeV3Actor.GetApplicationSummaryByNameAndSpaceReturns(
+						v3action.ApplicationSummary{
+							ProcessSummaries: v3action.ProcessSummaries{
+								{
+									Process: v3action.Process{
+										GUID:        "process-guid",
+										Type:        "web",
+										HealthCheck: ccv3.ProcessHealthCheck{Type: "port"},
+										Instances:   types.NullInt{Value: 2, IsSet: true},
+										MemoryInMB:  types.NullUint64{Value: 32, IsSet: true},
+										DiskInMB:    types.NullUint64{Value: 64, IsSet: true},
+									},
+									InstanceDetails: []v3action.Instance{
+										{Index: 0, State: "RUNNING", Uptime: 10, CPU: 0.5, MemoryUsage: 1, MemoryQuota: 2, DiskUsage: 3, DiskQuota: 4},
+										{Index: 1, State: "CRASHED"},
+									},
+								},
+							},
+						},
+						nil,
+						nil,
+					)
+
+					var processes []plugin_models.GetV3AppProcessesModel
+					err = client.Call("CliRpcCmd.GetV3AppProcesses", "some-app", &processes)
+
+					Expect(err).ToNot(HaveOccurred())
+					Expect(processes).To(Equal([]plugin_models.GetV3AppProcessesModel{
+						{
+							Guid:             "process-guid",
+							Type:             "web",
+							HealthCheckType:  "port",
+							TotalInstances:   2,
+							RunningInstances: 1,
+							Memory:           32,
+							DiskQuota:        64,
+							Instances: []plugin_models.GetV3AppProcessInstance{
+								{Index: 0, State: "RUNNING", Uptime: 10, CpuUsage: 0.5, MemoryUsage: 1, MemoryQuota: 2, DiskUsage: 3, DiskQuota: 4},
+								{Index: 1, State: "CRASHED"},
+							},
+						},
+					}))
+
+					appName, spaceGUID := fakeV3Actor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
+					Expect(appName).To(Equal("some-app"))
+					Expect(spaceGUID).To(Equal("space-guid"))
+				})
+
+				It("returns a translated error when the app does not exist", func() {
+					fakeV3Actor.GetApplicationSummaryByNameAndSpaceReturns(v3action.ApplicationSummary{}, nil, v3action.ApplicationNotFoundError{Name: "some-app"})
+
+					var processes []plugin_models.GetV3AppProcessesModel
+					err = client.Call("CliRpcCmd.GetV3AppProcesses", "some-app", &processes)
+
+					Expect(err).To(MatchError("App some-app not found"))
+				})
+			})
+
+			Context(".GetOrgGUID, .GetSpaceGUID, .GetAppGUID and .GetServiceInstanceGUID", func() {
+				var fakeV2Actor *rpcfakes.FakeV2Actor
+
+				BeforeEach(func() {
+					config.SetOrganizationFields(models.OrganizationFields{
+						GUID: "org-guid",
+						Name: "org-name",
+					})
+					config.SetSpaceFields(models.SpaceFields{
+						GUID: "space-guid",
+						Name: "space-name",
+					})
+
+					fakeV2Actor = new(rpcfakes.FakeV2Actor)
+					rpcService, err = NewRpcService(nil, nil, config, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+					Expect(err).ToNot(HaveOccurred())
+					rpcService.RpcCmd.V2Actor = fakeV2Actor
+
+					err := rpcService.Start()
+					Expect(err).ToNot(HaveOccurred())
+
+					pingCli(rpcService.Port())
+
+					client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("resolves the org by name", func() {
+					fakeV2Actor.GetOrganizationGUIDByNameReturns("some-org-guid", v2action.Warnings{"some-warning"}, nil)
+
+					var guid string
+					err = client.Call("CliRpcCmd.GetOrgGUID", "some-org", &guid)
+
+					Expect(err).ToNot(HaveOccurred())
+					Expect(guid).To(Equal("some-org-guid"))
+					Expect(fakeV2Actor.GetOrganizationGUIDByNameArgsForCall(0)).To(Equal("some-org"))
+				})
+
+				It("resolves the space in the targeted org", func() {
+					fakeV2Actor.GetSpaceGUIDByOrganizationAndNameReturns("some-space-guid", nil, nil)
+
+					var guid string
+					err = client.Call("CliRpcCmd.GetSpaceGUID", "some-space", &guid)
+
+					Expect(err).ToNot(HaveOccurred())
+					Expect(guid).To(Equal("some-space-guid"))
+					orgGUID, spaceName := fakeV2Actor.GetSpaceGUIDByOrganizationAndNameArgsForCall(0)
+					Expect(orgGUID).To(Equal("org-guid"))
+					Expect(spaceName).To(Equal("some-space"))
+				})
+
+				It("resolves the app in the targeted space", func() {
+					fakeV2Actor.GetApplicationGUIDByNameAndSpaceReturns("some-app-guid", nil, nil)
+
+					var guid string
+					err = client.Call("CliRpcCmd.GetAppGUID", "some-app", &guid)
+
+					Expect(err).ToNot(HaveOccurred())
+					Expect(guid).To(Equal("some-app-guid"))
+					appName, spaceGUID := fakeV2Actor.GetApplicationGUIDByNameAndSpaceArgsForCall(0)
+					Expect(appName).To(Equal("some-app"))
+					Expect(spaceGUID).To(Equal("space-guid"))
+				})
+
+				It("resolves the service instance in the targeted space", func() {
+					fakeV2Actor.GetServiceInstanceGUIDByNameAndSpaceReturns("some-service-instance-guid", nil, nil)
+
+					var guid string
+					err = client.Call("CliRpcCmd.GetServiceInstanceGUID", "some-service-instance", &guid)
+
+					Expect(err).ToNot(HaveOccurred())
+					Expect(guid).To(Equal("some-service-instance-guid"))
+					serviceInstanceName, spaceGUID := fakeV2Actor.GetServiceInstanceGUIDByNameAndSpaceArgsForCall(0)
+					Expect(serviceInstanceName).To(Equal("some-service-instance"))
+					Expect(spaceGUID).To(Equal("space-guid"))
+				})
+
+				It("returns a translated error when the app does not exist", func() {
+					fakeV2Actor.GetApplicationGUIDByNameAndSpaceReturns("", nil, v2action.ApplicationNotFoundError{Name: "some-app"})
+
+					var guid string
+					err = client.Call("CliRpcCmd.GetAppGUID", "some-app", &guid)
+
+					Expect(err).To(MatchError("App some-app not found"))
+				})
+			})
+
 			Context(".Username, .UserGuid, .UserEmail", func() {
 				BeforeEach(func() {
 					rpcService, err = NewRpcService(nil, nil, config, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
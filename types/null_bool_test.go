@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	. "code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NullBool", func() {
+	var nullBool NullBool
+
+	BeforeEach(func() {
+		nullBool = NullBool{}
+	})
+
+	Describe("UnmarshalJSON", func() {
+		Context("when true is provided", func() {
+			It("stores true and sets IsSet to true", func() {
+				err := nullBool.UnmarshalJSON([]byte("true"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nullBool).To(Equal(NullBool{Value: true, IsSet: true}))
+			})
+		})
+
+		Context("when false is provided", func() {
+			It("stores false and sets IsSet to true", func() {
+				err := nullBool.UnmarshalJSON([]byte("false"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nullBool).To(Equal(NullBool{Value: false, IsSet: true}))
+			})
+		})
+
+		Context("when null is provided", func() {
+			It("returns an unset NullBool", func() {
+				err := nullBool.UnmarshalJSON([]byte("null"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nullBool).To(Equal(NullBool{Value: false, IsSet: false}))
+			})
+		})
+
+		Context("when a non-boolean is provided", func() {
+			It("returns an error", func() {
+				err := nullBool.UnmarshalJSON([]byte(`"yes"`))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	DescribeTable("MarshalJSON",
+		func(nullBool NullBool, expectedBytes []byte) {
+			bytes, err := nullBool.MarshalJSON()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytes).To(Equal(expectedBytes))
+		},
+		Entry("true", NullBool{IsSet: true, Value: true}, []byte("true")),
+		Entry("false", NullBool{IsSet: true, Value: false}, []byte("false")),
+		Entry("no value", NullBool{IsSet: false}, []byte("null")),
+	)
+})
@@ -3,6 +3,7 @@ package configv3
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"math"
 	"os"
@@ -40,6 +41,13 @@ const (
 	DefaultStartupTimeout = 5 * time.Minute
 	// DefaultPingerThrottle = 5 * time.Second
 
+	// DefaultMaxIdleConnsPerHost is the default number of idle keep-alive
+	// connections kept per host.
+	DefaultMaxIdleConnsPerHost = 10
+
+	// DefaultTLSHandshakeTimeout is the default timeout for the TLS handshake.
+	DefaultTLSHandshakeTimeout = 10 * time.Second
+
 	// DefaultTarget is the default CFConfig value for Target.
 	DefaultTarget = ""
 
@@ -80,15 +88,7 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	configFilePath := ConfigFilePath()
 
 	config := Config{
-		ConfigFile: CFConfig{
-			ConfigVersion: 3,
-			Target:        DefaultTarget,
-			ColorEnabled:  DefaultColorEnabled,
-			PluginRepositories: []PluginRepository{{
-				Name: DefaultPluginRepoName,
-				URL:  DefaultPluginRepoURL,
-			}},
-		},
+		ConfigFile: defaultCFConfig(),
 	}
 
 	var jsonError error
@@ -105,38 +105,25 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		} else {
 			var configFile CFConfig
 			err = json.Unmarshal(file, &configFile)
-			if err != nil {
-				return nil, err
+			if err == nil {
+				config.ConfigFile = configFile
+			} else {
+				var backupPath string
+				configFile, backupPath, err = repairConfig(configFilePath, config.ConfigFile)
+				if err != nil {
+					return nil, err
+				}
+				config.ConfigFile = configFile
+
+				if backupPath != "" {
+					jsonError = translatableerror.CorruptConfigError{FilePath: configFilePath, BackupPath: backupPath}
+				}
 			}
-			config.ConfigFile = configFile
 		}
 	}
 
-	if config.ConfigFile.SSHOAuthClient == "" {
-		config.ConfigFile.SSHOAuthClient = DefaultSSHOAuthClient
-	}
-
-	if config.ConfigFile.UAAOAuthClient == "" {
-		config.ConfigFile.UAAOAuthClient = DefaultUAAOAuthClient
-		config.ConfigFile.UAAOAuthClientSecret = DefaultUAAOAuthClientSecret
-	}
-
-	config.ENV = EnvOverride{
-		BinaryName:       filepath.Base(os.Args[0]),
-		CFColor:          os.Getenv("CF_COLOR"),
-		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
-		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
-		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:          os.Getenv("CF_TRACE"),
-		DockerPassword:   os.Getenv("CF_DOCKER_PASSWORD"),
-		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
-		ForceTTY:         os.Getenv("FORCE_TTY"),
-		HTTPSProxy:       os.Getenv("https_proxy"),
-		Lang:             os.Getenv("LANG"),
-		LCAll:            os.Getenv("LC_ALL"),
-	}
+	config.applyOAuthClientDefaults()
+	config.ENV = loadEnvOverride()
 
 	pluginFilePath := filepath.Join(config.PluginHome(), "config.json")
 	if _, err = os.Stat(pluginFilePath); os.IsNotExist(err) {
@@ -173,10 +160,11 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	// Developer Note: The following is untested! Change at your own risk.
 	isTTY := terminal.IsTerminal(int(os.Stdout.Fd()))
 	terminalWidth := math.MaxInt32
+	var terminalHeight int
 
 	if isTTY {
 		var err error
-		terminalWidth, _, err = terminal.GetSize(int(os.Stdout.Fd()))
+		terminalWidth, terminalHeight, err = terminal.GetSize(int(os.Stdout.Fd()))
 		if err != nil {
 			return nil, err
 		}
@@ -184,6 +172,7 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 
 	config.detectedSettings = detectedSettings{
 		currentDirectory: pwd,
+		terminalHeight:   terminalHeight,
 		terminalWidth:    terminalWidth,
 		tty:              isTTY,
 	}
@@ -191,12 +180,129 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	return &config, jsonError
 }
 
+// LoadConfigFromBytes loads the config from rawConfig, the contents of a
+// config.json, and os.ENV. Unlike LoadConfig, nothing is read from or written
+// to the .cf directory and no plugins are loaded, which allows other Go
+// programs and tests to run commands against an in-memory config; the
+// resulting ConfigFile can be marshalled to persist any changes. An empty
+// rawConfig results in the default config. Takes in an optional FlagOverride,
+// will only use the first one passed, that can override the given flag values.
+func LoadConfigFromBytes(rawConfig []byte, flags ...FlagOverride) (*Config, error) {
+	config := Config{
+		ConfigFile: defaultCFConfig(),
+	}
+
+	if len(rawConfig) > 0 {
+		var configFile CFConfig
+		err := json.Unmarshal(rawConfig, &configFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ConfigFile = configFile
+	}
+
+	config.applyOAuthClientDefaults()
+	config.ENV = loadEnvOverride()
+	config.pluginsConfig = PluginsConfig{
+		Plugins: make(map[string]Plugin),
+	}
+
+	if len(flags) > 0 {
+		config.Flags = flags[0]
+	}
+
+	pwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	config.detectedSettings = detectedSettings{
+		currentDirectory: pwd,
+		terminalWidth:    math.MaxInt32,
+	}
+
+	return &config, nil
+}
+
+// defaultCFConfig returns the config used when no config.json exists.
+func defaultCFConfig() CFConfig {
+	return CFConfig{
+		ConfigVersion: 3,
+		Target:        DefaultTarget,
+		ColorEnabled:  DefaultColorEnabled,
+		PluginRepositories: []PluginRepository{{
+			Name: DefaultPluginRepoName,
+			URL:  DefaultPluginRepoURL,
+		}},
+	}
+}
+
+// applyOAuthClientDefaults fills in the OAuth clients missing from the config
+// file.
+func (config *Config) applyOAuthClientDefaults() {
+	if config.ConfigFile.SSHOAuthClient == "" {
+		config.ConfigFile.SSHOAuthClient = DefaultSSHOAuthClient
+	}
+
+	if config.ConfigFile.UAAOAuthClient == "" {
+		config.ConfigFile.UAAOAuthClient = DefaultUAAOAuthClient
+		config.ConfigFile.UAAOAuthClientSecret = DefaultUAAOAuthClientSecret
+	}
+}
+
+// loadEnvOverride reads the environment variables used by the CLI.
+func loadEnvOverride() EnvOverride {
+	return EnvOverride{
+		BinaryName:                 filepath.Base(os.Args[0]),
+		CFColor:                    os.Getenv("CF_COLOR"),
+		CFDialTimeout:              os.Getenv("CF_DIAL_TIMEOUT"),
+		CFLocaleDir:                os.Getenv("CF_LOCALE_DIR"),
+		CFLogLevel:                 os.Getenv("CF_LOG_LEVEL"),
+		CFMaxIdleConnsPerHost:      os.Getenv("CF_MAX_IDLE_CONNS_PER_HOST"),
+		CFOutput:                   os.Getenv("CF_OUTPUT"),
+		CFOutputWidth:              os.Getenv("CF_OUTPUT_WIDTH"),
+		CFPager:                    os.Getenv("CF_PAGER"),
+		CFPluginHome:               os.Getenv("CF_PLUGIN_HOME"),
+		CFProgress:                 os.Getenv("CF_PROGRESS"),
+		CFResourceMatchMinFileSize: os.Getenv("CF_RESOURCE_MATCH_MIN_FILE_SIZE"),
+		CFStagingRetries:           os.Getenv("CF_STAGING_RETRIES"),
+		CFStagingTimeout:           os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:           os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTLSHandshakeTimeout:      os.Getenv("CF_TLS_HANDSHAKE_TIMEOUT"),
+		CFTrace:                    os.Getenv("CF_TRACE"),
+		CFTraceMaxSize:             os.Getenv("CF_TRACE_MAX_SIZE"),
+		DockerPassword:             os.Getenv("CF_DOCKER_PASSWORD"),
+		Experimental:               os.Getenv("CF_CLI_EXPERIMENTAL"),
+		ForceTTY:                   os.Getenv("FORCE_TTY"),
+		HTTPSProxy:                 os.Getenv("https_proxy"),
+		Lang:                       os.Getenv("LANG"),
+		LCAll:                      os.Getenv("LC_ALL"),
+		Pager:                      os.Getenv("PAGER"),
+	}
+}
+
+// removeOldTempConfigFiles removes temp-config* files left behind by writes
+// that were interrupted. When another cf process is writing the config, its
+// temp file is still in use and nothing is removed.
 func removeOldTempConfigFiles() error {
 	oldTempFileNames, err := filepath.Glob(filepath.Join(configDirectory(), "temp-config?*"))
 	if err != nil {
 		return err
 	}
 
+	if len(oldTempFileNames) == 0 {
+		return nil
+	}
+
+	lock, err := lockConfig(false)
+	if err != nil {
+		return err
+	}
+	if lock == nil {
+		return nil
+	}
+	defer unlockConfig(lock)
+
 	for _, oldTempFileName := range oldTempFileNames {
 		err = os.Remove(oldTempFileName)
 		if err != nil {
@@ -207,17 +313,72 @@ func removeOldTempConfigFiles() error {
 	return nil
 }
 
+// repairConfig is called when the config file cannot be parsed. Once no other
+// cf process is writing the config, the file is read again; if it is still
+// corrupt, it is moved aside and defaultConfig is written in its place. The
+// returned backup path is empty when the file was valid on the second read.
+func repairConfig(configFilePath string, defaultConfig CFConfig) (CFConfig, string, error) {
+	lock, err := lockConfig(true)
+	if err != nil {
+		return CFConfig{}, "", err
+	}
+	defer unlockConfig(lock)
+
+	file, err := ioutil.ReadFile(configFilePath)
+	if err == nil {
+		var configFile CFConfig
+		if json.Unmarshal(file, &configFile) == nil {
+			return configFile, "", nil
+		}
+	} else if !os.IsNotExist(err) {
+		return CFConfig{}, "", err
+	}
+
+	backupPath := fmt.Sprintf("%s.corrupt-%s", configFilePath, time.Now().Format("20060102150405"))
+	err = os.Rename(configFilePath, backupPath)
+	if err != nil && !os.IsNotExist(err) {
+		return CFConfig{}, "", err
+	}
+
+	rawConfig, err := json.MarshalIndent(defaultConfig, "", "  ")
+	if err != nil {
+		return CFConfig{}, "", err
+	}
+
+	err = writeConfigFile(rawConfig)
+	if err != nil {
+		return CFConfig{}, "", err
+	}
+
+	return defaultConfig, backupPath, nil
+}
+
 // WriteConfig creates the .cf directory and then writes the config.json. The
 // location of .cf directory is written in the same way LoadConfig reads .cf
 // directory.
+//
+// The config is written to a temp file which is then renamed over
+// config.json, so readers never see a partially written file. Concurrent
+// writers from other cf processes are serialized with an advisory lock.
 func WriteConfig(c *Config) error {
 	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
 	if err != nil {
 		return err
 	}
 
+	lock, err := lockConfig(true)
+	if err != nil {
+		return err
+	}
+	defer unlockConfig(lock)
+
+	return writeConfigFile(rawConfig)
+}
+
+// writeConfigFile must be called with the config lock held.
+func writeConfigFile(rawConfig []byte) error {
 	dir := configDirectory()
-	err = os.MkdirAll(dir, 0700)
+	err := os.MkdirAll(dir, 0700)
 	if err != nil {
 		return err
 	}
@@ -237,11 +398,15 @@ func WriteConfig(c *Config) error {
 	go catchSignal(sig, tempConfigFile)
 
 	tempConfigFileName := tempConfigFile.Name()
-	err = ioutil.WriteFile(tempConfigFileName, rawConfig, 0600)
+	_, err = tempConfigFile.Write(rawConfig)
+	if err == nil {
+		err = tempConfigFile.Sync()
+	}
+	tempConfigFile.Close()
 	if err != nil {
+		_ = os.Remove(tempConfigFileName)
 		return err
 	}
-	tempConfigFile.Close()
 
 	return os.Rename(tempConfigFileName, ConfigFilePath())
 }
@@ -290,17 +455,22 @@ type CFConfig struct {
 	SSHOAuthClient           string             `json:"SSHOAuthClient"`
 	UAAOAuthClient           string             `json:"UAAOAuthClient"`
 	UAAOAuthClientSecret     string             `json:"UAAOAuthClientSecret"`
+	UAAGrantType             string             `json:"UAAGrantType"`
 	RefreshToken             string             `json:"RefreshToken"`
 	TargetedOrganization     Organization       `json:"OrganizationFields"`
 	TargetedSpace            Space              `json:"SpaceFields"`
 	SkipSSLValidation        bool               `json:"SSLDisabled"`
+	CACertFile               string             `json:"CACertFile,omitempty"`
 	AsyncTimeout             int                `json:"AsyncTimeout"`
+	ResourceMatchMinFileSize int64              `json:"ResourceMatchMinFileSize"`
 	Trace                    string             `json:"Trace"`
 	ColorEnabled             string             `json:"ColorEnabled"`
+	PagerEnabled             string             `json:"PagerEnabled"`
 	Locale                   string             `json:"Locale"`
 	PluginRepositories       []PluginRepository `json:"PluginRepos"`
 	MinCLIVersion            string             `json:"MinCLIVersion"`
 	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
+	DetachedJobs             []DetachedJob      `json:"DetachedJobs,omitempty"`
 }
 
 // Organization contains basic information about the targeted organization
@@ -332,31 +502,46 @@ type Space struct {
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFDialTimeout    string
-	CFHome           string
-	CFLogLevel       string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	DockerPassword   string
-	Experimental     string
-	ForceTTY         string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
+	BinaryName                 string
+	CFColor                    string
+	CFDialTimeout              string
+	CFHome                     string
+	CFLocaleDir                string
+	CFLogLevel                 string
+	CFMaxIdleConnsPerHost      string
+	CFOutput                   string
+	CFOutputWidth              string
+	CFPager                    string
+	CFPluginHome               string
+	CFProgress                 string
+	CFResourceMatchMinFileSize string
+	CFStagingRetries           string
+	CFStagingTimeout           string
+	CFStartupTimeout           string
+	CFTLSHandshakeTimeout      string
+	CFTrace                    string
+	CFTraceMaxSize             string
+	DockerPassword             string
+	Experimental               string
+	ForceTTY                   string
+	HTTPSProxy                 string
+	Lang                       string
+	LCAll                      string
+	Pager                      string
 }
 
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
-	Verbose bool
+	NoPager     bool
+	Verbose     bool
+	ErrorFormat string
+	Output      string
 }
 
 // detectedSettings are automatically detected settings determined by the CLI.
 type detectedSettings struct {
 	currentDirectory string
+	terminalHeight   int
 	terminalWidth    int
 	tty              bool
 }
@@ -388,6 +573,12 @@ func (config *Config) SkipSSLValidation() bool {
 	return config.ConfigFile.SkipSSLValidation
 }
 
+// CACertFile returns the path to the CA certificate bundle trusted when
+// connecting to the targeted API endpoint
+func (config *Config) CACertFile() string {
+	return config.ConfigFile.CACertFile
+}
+
 // AccessToken returns the access token for making authenticated API calls
 func (config *Config) AccessToken() string {
 	return config.ConfigFile.AccessToken
@@ -414,6 +605,12 @@ func (config *Config) UAAOAuthClientSecret() string {
 	return config.ConfigFile.UAAOAuthClientSecret
 }
 
+// UAAGrantType returns the grant type used to authenticate with UAA. It is
+// empty when a user logged in with their username and password.
+func (config *Config) UAAGrantType() string {
+	return config.ConfigFile.UAAGrantType
+}
+
 // APIVersion returns the CC API Version
 func (config *Config) APIVersion() string {
 	return config.ConfigFile.APIVersion
@@ -434,6 +631,22 @@ func (config *Config) TargetedSpace() Space {
 	return config.ConfigFile.TargetedSpace
 }
 
+// StagingRetries returns the number of times staging is retried when it fails
+// because no cell could place the staging task. The number is based off of:
+//   1. The $CF_STAGING_RETRIES environment variable if set to a non-negative
+//      integer
+//   2. Defaults to 0, which does not retry staging
+func (config *Config) StagingRetries() int {
+	if config.ENV.CFStagingRetries != "" {
+		val, err := strconv.Atoi(config.ENV.CFStagingRetries)
+		if err == nil && val >= 0 {
+			return val
+		}
+	}
+
+	return 0
+}
+
 // StagingTimeout returns the max time an application staging should take. The
 // time is based off of:
 //   1. The $CF_STAGING_TIMEOUT environment variable if set
@@ -464,6 +677,38 @@ func (config *Config) StartupTimeout() time.Duration {
 	return DefaultStartupTimeout
 }
 
+// ResourceMatchMinFileSize returns the minimum size, in bytes, a file must be
+// for it to be checked against previously uploaded resources. The size is
+// based off of:
+//   1. The $CF_RESOURCE_MATCH_MIN_FILE_SIZE environment variable if set
+//   2. The config file's ResourceMatchMinFileSize value
+//   3. Defaults to 0, which checks all non-empty files
+func (config *Config) ResourceMatchMinFileSize() int64 {
+	if config.ENV.CFResourceMatchMinFileSize != "" {
+		val, err := strconv.ParseInt(config.ENV.CFResourceMatchMinFileSize, 10, 64)
+		if err == nil {
+			return val
+		}
+	}
+
+	return config.ConfigFile.ResourceMatchMinFileSize
+}
+
+// TraceMaxSize returns the size in bytes a trace file may reach before it is
+// rotated. The size is based off of:
+//   1. The $CF_TRACE_MAX_SIZE environment variable if set
+//   2. Defaults to 0, which never rotates trace files
+func (config *Config) TraceMaxSize() int64 {
+	if config.ENV.CFTraceMaxSize != "" {
+		val, err := strconv.ParseInt(config.ENV.CFTraceMaxSize, 10, 64)
+		if err == nil && val > 0 {
+			return val
+		}
+	}
+
+	return 0
+}
+
 // HTTPSProxy returns the proxy url that the CLI should use. The url is based
 // off of:
 //   1. The $https_proxy environment variable if set
@@ -580,13 +825,29 @@ func (config *Config) LogLevel() int {
 	return 0
 }
 
-// TerminalWidth returns the width of the terminal from when the config
-// was loaded. If the terminal width has changed since the config has loaded,
-// it will **not** return the new width.
+// TerminalWidth returns the width output should be wrapped to. This value is
+// based off of:
+//   1. The $CF_OUTPUT_WIDTH environment variable if set to a positive integer
+//   2. The width of the terminal from when the config was loaded. If the
+//      terminal width has changed since the config has loaded, it will **not**
+//      return the new width.
 func (config *Config) TerminalWidth() int {
+	if config.ENV.CFOutputWidth != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFOutputWidth)
+		if err == nil && envVal > 0 {
+			return envVal
+		}
+	}
+
 	return config.detectedSettings.terminalWidth
 }
 
+// TerminalHeight returns the height of the terminal from when the config was
+// loaded, or 0 when the CLI is not attached to a TTY.
+func (config *Config) TerminalHeight() int {
+	return config.detectedSettings.terminalHeight
+}
+
 // DialTimeout returns the timeout to use when dialing. This is based off of:
 //   1. The $CF_DIAL_TIMEOUT environment variable if set
 //   2. Defaults to 5 seconds
@@ -601,6 +862,38 @@ func (config *Config) DialTimeout() time.Duration {
 	return DefaultDialTimeout
 }
 
+// MaxIdleConnsPerHost returns the number of idle keep-alive connections kept
+// per host. This is based off of:
+//   1. The $CF_MAX_IDLE_CONNS_PER_HOST environment variable if set to a
+//      positive integer
+//   2. Defaults to DefaultMaxIdleConnsPerHost
+func (config *Config) MaxIdleConnsPerHost() int {
+	if config.ENV.CFMaxIdleConnsPerHost != "" {
+		val, err := strconv.Atoi(config.ENV.CFMaxIdleConnsPerHost)
+		if err == nil && val > 0 {
+			return val
+		}
+	}
+
+	return DefaultMaxIdleConnsPerHost
+}
+
+// TLSHandshakeTimeout returns the timeout to use for the TLS handshake. This
+// is based off of:
+//   1. The $CF_TLS_HANDSHAKE_TIMEOUT environment variable, in seconds, if set
+//      to a positive integer
+//   2. Defaults to DefaultTLSHandshakeTimeout
+func (config *Config) TLSHandshakeTimeout() time.Duration {
+	if config.ENV.CFTLSHandshakeTimeout != "" {
+		val, err := strconv.ParseInt(config.ENV.CFTLSHandshakeTimeout, 10, 64)
+		if err == nil && val > 0 {
+			return time.Duration(val) * time.Second
+		}
+	}
+
+	return DefaultTLSHandshakeTimeout
+}
+
 func (config *Config) BinaryVersion() string {
 	return version.VersionString()
 }
@@ -644,11 +937,19 @@ func (config *Config) SetTargetInformation(api string, apiVersion string, auth s
 	config.ConfigFile.DopplerEndpoint = doppler
 	config.ConfigFile.RoutingEndpoint = routing
 	config.ConfigFile.SkipSSLValidation = skipSSLValidation
+	config.ConfigFile.CACertFile = ""
 
 	config.UnsetOrganizationInformation()
 	config.UnsetSpaceInformation()
 }
 
+// SetCACertFile sets the path to the CA certificate bundle trusted when
+// connecting to the targeted API endpoint. It is cleared whenever the target
+// information is set, so it must be set after SetTargetInformation.
+func (config *Config) SetCACertFile(caCertFile string) {
+	config.ConfigFile.CACertFile = caCertFile
+}
+
 // SetTokenInformation sets the current token/user information
 func (config *Config) SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string) {
 	config.ConfigFile.AccessToken = accessToken
@@ -666,6 +967,18 @@ func (config *Config) SetRefreshToken(refreshToken string) {
 	config.ConfigFile.RefreshToken = refreshToken
 }
 
+// SetUAAGrantType sets the grant type used to authenticate with UAA
+func (config *Config) SetUAAGrantType(uaaGrantType string) {
+	config.ConfigFile.UAAGrantType = uaaGrantType
+}
+
+// SetUAAClientCredentials sets the client ID and secret used to authenticate
+// with UAA
+func (config *Config) SetUAAClientCredentials(client string, clientSecret string) {
+	config.ConfigFile.UAAOAuthClient = client
+	config.ConfigFile.UAAOAuthClientSecret = clientSecret
+}
+
 // SetUAAEndpoint sets the UAA endpoint that is obtained from hitting
 // <AuthorizationEndpoint>/login
 func (config *Config) SetUAAEndpoint(uaaEndpoint string) {
@@ -0,0 +1,40 @@
+package configv3
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// configLockFilePath returns the location of the file used to serialize
+// writes to the .cf directory between cf processes.
+func configLockFilePath() string {
+	return filepath.Join(configDirectory(), "config.lock")
+}
+
+// lockConfig takes an exclusive advisory lock on the .cf directory. When wait
+// is false and another process holds the lock, lockConfig returns a nil file
+// instead of waiting. The returned file must be passed to unlockConfig.
+func lockConfig(wait bool) (*os.File, error) {
+	err := os.MkdirAll(configDirectory(), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(configLockFilePath(), os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	locked, err := acquireFileLock(file, wait)
+	if err != nil || !locked {
+		file.Close()
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func unlockConfig(file *os.File) {
+	_ = releaseFileLock(file)
+	file.Close()
+}
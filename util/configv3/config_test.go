@@ -2,11 +2,14 @@ package configv3_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
+	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/util/configv3"
 
@@ -62,6 +65,7 @@ var _ = Describe("Config", func() {
 				Expect(config.SkipSSLValidation()).To(BeFalse())
 				Expect(config.ColorEnabled()).To(Equal(ColorEnabled))
 				Expect(config.PluginHome()).To(Equal(filepath.Join(homeDir, ".cf", "plugins")))
+				Expect(config.StagingRetries()).To(Equal(0))
 				Expect(config.StagingTimeout()).To(Equal(DefaultStagingTimeout))
 				Expect(config.StartupTimeout()).To(Equal(DefaultStartupTimeout))
 				Expect(config.Locale()).To(BeEmpty())
@@ -161,6 +165,44 @@ var _ = Describe("Config", func() {
 				})
 			})
 
+			Context("but it is not valid JSON", func() {
+				BeforeEach(func() {
+					setConfig(homeDir, `{"Target": "https://api.foo.com",`)
+				})
+
+				It("backs up the file, regenerates a default config and returns a CorruptConfigError", func() {
+					config, err = LoadConfig()
+					Expect(err).To(BeAssignableToTypeOf(translatableerror.CorruptConfigError{}))
+
+					configFilePath := filepath.Join(homeDir, ".cf", "config.json")
+					corruptErr := err.(translatableerror.CorruptConfigError)
+					Expect(corruptErr.FilePath).To(Equal(configFilePath))
+					Expect(corruptErr.BackupPath).To(HavePrefix(configFilePath + ".corrupt-"))
+
+					backup, readErr := ioutil.ReadFile(corruptErr.BackupPath)
+					Expect(readErr).ToNot(HaveOccurred())
+					Expect(string(backup)).To(Equal(`{"Target": "https://api.foo.com",`))
+
+					file, readErr := ioutil.ReadFile(configFilePath)
+					Expect(readErr).ToNot(HaveOccurred())
+					var writtenCFConfig CFConfig
+					Expect(json.Unmarshal(file, &writtenCFConfig)).To(Succeed())
+					Expect(writtenCFConfig.ConfigVersion).To(Equal(3))
+
+					Expect(config).ToNot(BeNil())
+					Expect(config.Target()).To(Equal(DefaultTarget))
+				})
+
+				It("loads the regenerated config without error the next time", func() {
+					_, err = LoadConfig()
+					Expect(err).To(HaveOccurred())
+
+					config, err = LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(config.Target()).To(Equal(DefaultTarget))
+				})
+			})
+
 			Context("and there are old temp-config* files lingering from previous failed attempts to write the config", func() {
 				var (
 					oldLang  string
@@ -271,6 +313,63 @@ var _ = Describe("Config", func() {
 		})
 	})
 
+	Describe("LoadConfigFromBytes", func() {
+		It("returns a default config when no config is provided", func() {
+			config, err := LoadConfigFromBytes(nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.ConfigFile.ConfigVersion).To(Equal(3))
+			Expect(config.Target()).To(Equal(DefaultTarget))
+			Expect(config.SSHOAuthClient()).To(Equal(DefaultSSHOAuthClient))
+			Expect(config.UAAOAuthClient()).To(Equal(DefaultUAAOAuthClient))
+			Expect(config.PluginRepositories()).To(Equal([]PluginRepository{{
+				Name: DefaultPluginRepoName,
+				URL:  DefaultPluginRepoURL,
+			}}))
+			Expect(config.Plugins()).To(BeEmpty())
+		})
+
+		It("loads the provided config without reading or writing the .cf directory", func() {
+			setConfig(homeDir, `{"Target": "https://api.on-disk.com"}`)
+
+			config, err := LoadConfigFromBytes([]byte(`{
+				"ConfigVersion": 3,
+				"Target": "https://api.in-memory.com",
+				"AccessToken": "some-access-token",
+				"OrganizationFields": {
+					"GUID": "some-org-guid",
+					"Name": "some-org"
+				}
+			}`), FlagOverride{Verbose: true})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.Target()).To(Equal("https://api.in-memory.com"))
+			Expect(config.AccessToken()).To(Equal("some-access-token"))
+			Expect(config.TargetedOrganization()).To(Equal(Organization{GUID: "some-org-guid", Name: "some-org"}))
+			Expect(config.SSHOAuthClient()).To(Equal(DefaultSSHOAuthClient))
+			Expect(config.Flags.Verbose).To(BeTrue())
+
+			config.SetTargetInformation("https://api.changed.com", "", "", "", "", "", false)
+
+			rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rawConfig)).To(Equal(`{"Target": "https://api.on-disk.com"}`))
+		})
+
+		It("satisfies command.Config", func() {
+			config, err := LoadConfigFromBytes(nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			var commandConfig command.Config = config
+			Expect(commandConfig.BinaryName()).ToNot(BeEmpty())
+		})
+
+		It("returns an error when the provided config is invalid JSON", func() {
+			_, err := LoadConfigFromBytes([]byte("not-json"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Describe("check functions", func() {
 		Describe("HasTargetedOrganization", func() {
 			Context("when an organization is targeted", func() {
@@ -364,6 +463,24 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("CACertFile", func() {
+			var config *Config
+
+			BeforeEach(func() {
+				rawConfig := `{ "CACertFile":"/some/ca.pem" }`
+				setConfig(homeDir, rawConfig)
+
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config).ToNot(BeNil())
+			})
+
+			It("returns fields directly from config", func() {
+				Expect(config.CACertFile()).To(Equal("/some/ca.pem"))
+			})
+		})
+
 		Describe("AccessToken", func() {
 			var config *Config
 
@@ -458,6 +575,34 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("ResourceMatchMinFileSize", func() {
+			var config *Config
+
+			BeforeEach(func() {
+				rawConfig := `{ "ResourceMatchMinFileSize":2048 }`
+				setConfig(homeDir, rawConfig)
+
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config).ToNot(BeNil())
+			})
+
+			It("returns the size from the config file", func() {
+				Expect(config.ResourceMatchMinFileSize()).To(BeEquivalentTo(2048))
+			})
+
+			Context("when $CF_RESOURCE_MATCH_MIN_FILE_SIZE is set", func() {
+				BeforeEach(func() {
+					config.ENV.CFResourceMatchMinFileSize = "4096"
+				})
+
+				It("returns the size from the environment", func() {
+					Expect(config.ResourceMatchMinFileSize()).To(BeEquivalentTo(4096))
+				})
+			})
+		})
+
 		DescribeTable("Experimental",
 			func(envVal string, expected bool) {
 				setConfig(homeDir, `{}`)
@@ -495,26 +640,35 @@ var _ = Describe("Config", func() {
 
 		Context("when there are environment variables", func() {
 			var (
+				originalCFStagingRetries string
 				originalCFStagingTimeout string
 				originalCFStartupTimeout string
 				originalHTTPSProxy       string
 				originalForceTTY         string
 				originalDockerPassword   string
+				originalMinFileSize      string
+				originalOutputWidth      string
 
 				config *Config
 			)
 
 			BeforeEach(func() {
+				originalCFStagingRetries = os.Getenv("CF_STAGING_RETRIES")
 				originalCFStagingTimeout = os.Getenv("CF_STAGING_TIMEOUT")
 				originalCFStartupTimeout = os.Getenv("CF_STARTUP_TIMEOUT")
 				originalHTTPSProxy = os.Getenv("https_proxy")
 				originalForceTTY = os.Getenv("FORCE_TTY")
 				originalDockerPassword = os.Getenv("CF_DOCKER_PASSWORD")
+				originalMinFileSize = os.Getenv("CF_RESOURCE_MATCH_MIN_FILE_SIZE")
+				originalOutputWidth = os.Getenv("CF_OUTPUT_WIDTH")
+				Expect(os.Setenv("CF_STAGING_RETRIES", "3")).ToNot(HaveOccurred())
 				Expect(os.Setenv("CF_STAGING_TIMEOUT", "8675")).ToNot(HaveOccurred())
 				Expect(os.Setenv("CF_STARTUP_TIMEOUT", "309")).ToNot(HaveOccurred())
 				Expect(os.Setenv("https_proxy", "proxy.com")).ToNot(HaveOccurred())
 				Expect(os.Setenv("FORCE_TTY", "true")).ToNot(HaveOccurred())
 				Expect(os.Setenv("CF_DOCKER_PASSWORD", "banana")).ToNot(HaveOccurred())
+				Expect(os.Setenv("CF_RESOURCE_MATCH_MIN_FILE_SIZE", "4096")).ToNot(HaveOccurred())
+				Expect(os.Setenv("CF_OUTPUT_WIDTH", "120")).ToNot(HaveOccurred())
 
 				var err error
 				config, err = LoadConfig()
@@ -523,19 +677,25 @@ var _ = Describe("Config", func() {
 			})
 
 			AfterEach(func() {
+				Expect(os.Setenv("CF_STAGING_RETRIES", originalCFStagingRetries)).ToNot(HaveOccurred())
 				Expect(os.Setenv("CF_STAGING_TIMEOUT", originalCFStagingTimeout)).ToNot(HaveOccurred())
 				Expect(os.Setenv("CF_STARTUP_TIMEOUT", originalCFStartupTimeout)).ToNot(HaveOccurred())
 				Expect(os.Setenv("https_proxy", originalHTTPSProxy)).ToNot(HaveOccurred())
 				Expect(os.Setenv("FORCE_TTY", originalForceTTY)).ToNot(HaveOccurred())
 				Expect(os.Setenv("CF_DOCKER_PASSWORD", originalDockerPassword)).ToNot(HaveOccurred())
+				Expect(os.Setenv("CF_RESOURCE_MATCH_MIN_FILE_SIZE", originalMinFileSize)).ToNot(HaveOccurred())
+				Expect(os.Setenv("CF_OUTPUT_WIDTH", originalOutputWidth)).ToNot(HaveOccurred())
 			})
 
 			It("overrides specific config values", func() {
+				Expect(config.StagingRetries()).To(Equal(3))
 				Expect(config.StagingTimeout()).To(Equal(time.Duration(8675) * time.Minute))
 				Expect(config.StartupTimeout()).To(Equal(time.Duration(309) * time.Minute))
 				Expect(config.HTTPSProxy()).To(Equal("proxy.com"))
 				Expect(config.IsTTY()).To(BeTrue())
 				Expect(config.DockerPassword()).To(Equal("banana"))
+				Expect(config.ResourceMatchMinFileSize()).To(BeEquivalentTo(4096))
+				Expect(config.TerminalWidth()).To(Equal(120))
 			})
 		})
 
@@ -621,6 +781,42 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		DescribeTable("MaxIdleConnsPerHost",
+			func(envVal string, expected int) {
+				config := Config{ENV: EnvOverride{CFMaxIdleConnsPerHost: envVal}}
+				Expect(config.MaxIdleConnsPerHost()).To(Equal(expected))
+			},
+
+			Entry("defaults to DefaultMaxIdleConnsPerHost when unset", "", DefaultMaxIdleConnsPerHost),
+			Entry("returns the value when set", "50", 50),
+			Entry("returns the default when not positive", "0", DefaultMaxIdleConnsPerHost),
+			Entry("returns the default when not a number", "many", DefaultMaxIdleConnsPerHost),
+		)
+
+		DescribeTable("TLSHandshakeTimeout",
+			func(envVal string, expected time.Duration) {
+				config := Config{ENV: EnvOverride{CFTLSHandshakeTimeout: envVal}}
+				Expect(config.TLSHandshakeTimeout()).To(Equal(expected))
+			},
+
+			Entry("defaults to DefaultTLSHandshakeTimeout when unset", "", DefaultTLSHandshakeTimeout),
+			Entry("returns the value in seconds when set", "30", 30*time.Second),
+			Entry("returns the default when not positive", "-1", DefaultTLSHandshakeTimeout),
+			Entry("returns the default when not a number", "soon", DefaultTLSHandshakeTimeout),
+		)
+
+		DescribeTable("TraceMaxSize",
+			func(envVal string, expectedSize int64) {
+				config := Config{ENV: EnvOverride{CFTraceMaxSize: envVal}}
+				Expect(config.TraceMaxSize()).To(Equal(expectedSize))
+			},
+
+			Entry("defaults to 0 when unset", "", int64(0)),
+			Entry("returns the size when set", "1048576", int64(1048576)),
+			Entry("returns 0 when the size is negative", "-5", int64(0)),
+			Entry("returns 0 when the size is not a number", "big", int64(0)),
+		)
+
 		Describe("BinaryVersion", func() {
 			It("returns back version.BinaryVersion", func() {
 				conf := Config{}
@@ -677,6 +873,46 @@ var _ = Describe("Config", func() {
 				Expect(writtenCFConfig.Target).To(Equal(config.ConfigFile.Target))
 				Expect(writtenCFConfig.ColorEnabled).To(Equal(config.ConfigFile.ColorEnabled))
 			})
+
+			It("does not leave temp-config* files behind", func() {
+				Expect(WriteConfig(config)).To(Succeed())
+
+				tempFileNames, err := filepath.Glob(filepath.Join(homeDir, ".cf", "temp-config?*"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tempFileNames).To(BeEmpty())
+			})
+		})
+
+		Context("when the config is written and read concurrently", func() {
+			It("never exposes a partially written config", func() {
+				configFilePath := filepath.Join(homeDir, ".cf", "config.json")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				var wg sync.WaitGroup
+				for i := 0; i < 10; i++ {
+					wg.Add(1)
+					go func(i int) {
+						defer GinkgoRecover()
+						defer wg.Done()
+
+						writeConfig := *config
+						writeConfig.ConfigFile.Target = fmt.Sprintf("https://api.%d.com", i)
+						for j := 0; j < 10; j++ {
+							Expect(WriteConfig(&writeConfig)).To(Succeed())
+
+							file, err := ioutil.ReadFile(configFilePath)
+							Expect(err).ToNot(HaveOccurred())
+							var readCFConfig CFConfig
+							Expect(json.Unmarshal(file, &readCFConfig)).To(Succeed())
+						}
+					}(i)
+				}
+				wg.Wait()
+
+				tempFileNames, err := filepath.Glob(filepath.Join(homeDir, ".cf", "temp-config?*"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tempFileNames).To(BeEmpty())
+			})
 		})
 	})
 
@@ -694,6 +930,7 @@ var _ = Describe("Config", func() {
 							Name:     "jo bobo jim boo",
 							AllowSSH: true,
 						},
+						CACertFile: "/some/ca.pem",
 					},
 				}
 				config.SetTargetInformation(
@@ -713,6 +950,7 @@ var _ = Describe("Config", func() {
 				Expect(config.ConfigFile.DopplerEndpoint).To(Equal("wws://doppler.foo.com:443"))
 				Expect(config.ConfigFile.RoutingEndpoint).To(Equal("https://api.foo.com/routing"))
 				Expect(config.ConfigFile.SkipSSLValidation).To(BeTrue())
+				Expect(config.ConfigFile.CACertFile).To(BeEmpty())
 
 				Expect(config.ConfigFile.TargetedOrganization.GUID).To(BeEmpty())
 				Expect(config.ConfigFile.TargetedOrganization.Name).To(BeEmpty())
@@ -722,6 +960,15 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("SetCACertFile", func() {
+			It("sets the CA certificate file", func() {
+				var config Config
+				config.SetCACertFile("/some/ca.pem")
+
+				Expect(config.ConfigFile.CACertFile).To(Equal("/some/ca.pem"))
+			})
+		})
+
 		Describe("SetTokenInformation", func() {
 			It("sets the authentication token information", func() {
 				var config Config
@@ -778,6 +1025,23 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("SetUAAGrantType", func() {
+			It("sets the UAA grant type", func() {
+				var config Config
+				config.SetUAAGrantType("client_credentials")
+				Expect(config.UAAGrantType()).To(Equal("client_credentials"))
+			})
+		})
+
+		Describe("SetUAAClientCredentials", func() {
+			It("sets the UAA client ID and secret", func() {
+				var config Config
+				config.SetUAAClientCredentials("some-client", "some-secret")
+				Expect(config.UAAOAuthClient()).To(Equal("some-client"))
+				Expect(config.UAAOAuthClientSecret()).To(Equal("some-secret"))
+			})
+		})
+
 		Describe("UnsetOrganizationInformation", func() {
 			config := Config{}
 			BeforeEach(func() {
@@ -4,8 +4,10 @@ package configv3_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"syscall"
 
 	. "code.cloudfoundry.org/cli/util/configv3"
 
@@ -93,3 +95,38 @@ var _ = Describe("Verbose", func() {
 		})
 	})
 })
+
+var _ = Describe("LoadConfig while another process is writing the config", func() {
+	var (
+		homeDir  string
+		lockFile *os.File
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+		setConfig(homeDir, `{}`)
+
+		var err error
+		lockFile, err = os.OpenFile(filepath.Join(homeDir, ".cf", "config.lock"), os.O_RDWR|os.O_CREATE, 0600)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(syscall.Flock(int(lockFile.Fd()), syscall.LOCK_EX)).To(Succeed())
+
+		tempFile, err := ioutil.TempFile(filepath.Join(homeDir, ".cf"), "temp-config")
+		Expect(err).ToNot(HaveOccurred())
+		tempFile.Close()
+	})
+
+	AfterEach(func() {
+		lockFile.Close()
+		teardown(homeDir)
+	})
+
+	It("does not remove the temp-config* file being written", func() {
+		_, err := LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+
+		tempFileNames, err := filepath.Glob(filepath.Join(homeDir, ".cf", "temp-config?*"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tempFileNames).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,43 @@
+package configv3
+
+import "time"
+
+// DetachedJob is a Cloud Controller job started by a command run with
+// --detach. It is kept in the .cf/config.json until its status has been shown
+// as finished or failed.
+type DetachedJob struct {
+	GUID      string    `json:"GUID"`
+	Operation string    `json:"Operation"`
+	Resource  string    `json:"Resource"`
+	Target    string    `json:"Target"`
+	StartedAt time.Time `json:"StartedAt"`
+}
+
+// DetachedJobs returns the detached jobs started against the current target,
+// oldest first.
+func (config *Config) DetachedJobs() []DetachedJob {
+	var jobs []DetachedJob
+	for _, job := range config.ConfigFile.DetachedJobs {
+		if job.Target == config.ConfigFile.Target {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs
+}
+
+// AddDetachedJob records a detached job started against the current target.
+func (config *Config) AddDetachedJob(job DetachedJob) {
+	job.Target = config.ConfigFile.Target
+	config.ConfigFile.DetachedJobs = append(config.ConfigFile.DetachedJobs, job)
+}
+
+// RemoveDetachedJob removes the detached job with the provided GUID.
+func (config *Config) RemoveDetachedJob(guid string) {
+	jobs := config.ConfigFile.DetachedJobs[:0]
+	for _, job := range config.ConfigFile.DetachedJobs {
+		if job.GUID != guid {
+			jobs = append(jobs, job)
+		}
+	}
+	config.ConfigFile.DetachedJobs = jobs
+}
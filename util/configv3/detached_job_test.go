@@ -0,0 +1,49 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DetachedJob", func() {
+	var config Config
+
+	BeforeEach(func() {
+		config = Config{
+			ConfigFile: CFConfig{
+				Target: "https://api.some-target.com",
+				DetachedJobs: []DetachedJob{
+					{GUID: "job-1", Operation: "delete-org", Resource: "org-1", Target: "https://api.some-target.com"},
+					{GUID: "job-2", Operation: "delete-org", Resource: "org-2", Target: "https://api.other-target.com"},
+				},
+			},
+		}
+	})
+
+	Describe("DetachedJobs", func() {
+		It("returns the jobs of the current target", func() {
+			Expect(config.DetachedJobs()).To(Equal([]DetachedJob{
+				{GUID: "job-1", Operation: "delete-org", Resource: "org-1", Target: "https://api.some-target.com"},
+			}))
+		})
+	})
+
+	Describe("AddDetachedJob", func() {
+		It("records the job against the current target", func() {
+			config.AddDetachedJob(DetachedJob{GUID: "job-3", Operation: "v2-push", Resource: "some-app"})
+			Expect(config.DetachedJobs()).To(ContainElement(
+				DetachedJob{GUID: "job-3", Operation: "v2-push", Resource: "some-app", Target: "https://api.some-target.com"},
+			))
+		})
+	})
+
+	Describe("RemoveDetachedJob", func() {
+		It("removes the job with the GUID", func() {
+			config.RemoveDetachedJob("job-1")
+			Expect(config.DetachedJobs()).To(BeEmpty())
+			Expect(config.ConfigFile.DetachedJobs).To(HaveLen(1))
+		})
+	})
+})
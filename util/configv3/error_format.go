@@ -0,0 +1,16 @@
+package configv3
+
+// DefaultErrorFormat is the format errors are displayed in when the
+// --error-format global flag is not provided.
+const DefaultErrorFormat = "text"
+
+// ErrorFormat returns the format errors should be displayed in. This value is
+// based off of:
+//   1. The --error-format global flag
+//   2. Defaults to DefaultErrorFormat
+func (config *Config) ErrorFormat() string {
+	if config.Flags.ErrorFormat != "" {
+		return config.Flags.ErrorFormat
+	}
+	return DefaultErrorFormat
+}
@@ -0,0 +1,33 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	DescribeTable("ErrorFormat",
+		func(flagVal string, expected string) {
+			config, err := LoadConfig(FlagOverride{ErrorFormat: flagVal})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).ToNot(BeNil())
+
+			Expect(config.ErrorFormat()).To(Equal(expected))
+		},
+		Entry("--error-format=json", "json", "json"),
+		Entry("--error-format=text", "text", "text"),
+		Entry("flag unset falls back to default", "", "text"),
+	)
+})
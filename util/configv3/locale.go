@@ -29,6 +29,13 @@ func (config *Config) Locale() string {
 	return DefaultLocale
 }
 
+// LocaleDir returns the directory that supplemental translation files are
+// loaded from. This value is based off of the $CF_LOCALE_DIR environment
+// variable, and is empty when it is not set.
+func (config *Config) LocaleDir() string {
+	return config.ENV.CFLocaleDir
+}
+
 func (config *Config) convertLocale(local string) string {
 	lang := strings.Split(local, ".")[0]
 	return strings.Replace(lang, "_", "-", -1)
@@ -54,4 +54,30 @@ var _ = Describe("Config", func() {
 
 		Entry("config=empty LANG=empty       LC_ALL=empty       default", "", "", "", DefaultLocale),
 	)
+
+	Describe("LocaleDir", func() {
+		AfterEach(func() {
+			os.Unsetenv("CF_LOCALE_DIR")
+		})
+
+		Context("when CF_LOCALE_DIR is set", func() {
+			BeforeEach(func() {
+				os.Setenv("CF_LOCALE_DIR", "/some/locale/dir")
+			})
+
+			It("returns the directory", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.LocaleDir()).To(Equal("/some/locale/dir"))
+			})
+		})
+
+		Context("when CF_LOCALE_DIR is not set", func() {
+			It("returns an empty string", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.LocaleDir()).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -0,0 +1,29 @@
+package configv3
+
+const (
+	// DefaultOutputFormat is the format command results are displayed in when
+	// neither the --output global flag nor $CF_OUTPUT is provided.
+	DefaultOutputFormat = "text"
+
+	// OutputFormatJSON is the format that displays the results of read-only
+	// commands as JSON documents.
+	OutputFormatJSON = "json"
+)
+
+// OutputFormat returns the format command results should be displayed in.
+// This value is based off of:
+//   1. The --output global flag
+//   2. The $CF_OUTPUT environment variable if set to text or json
+//   3. Defaults to DefaultOutputFormat
+func (config *Config) OutputFormat() string {
+	if config.Flags.Output != "" {
+		return config.Flags.Output
+	}
+
+	switch config.ENV.CFOutput {
+	case DefaultOutputFormat, OutputFormatJSON:
+		return config.ENV.CFOutput
+	}
+
+	return DefaultOutputFormat
+}
@@ -0,0 +1,44 @@
+package configv3_test
+
+import (
+	"os"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	DescribeTable("OutputFormat",
+		func(envVal string, flagVal string, expected string) {
+			defer os.Unsetenv("CF_OUTPUT")
+			if envVal == "" {
+				os.Unsetenv("CF_OUTPUT")
+			} else {
+				os.Setenv("CF_OUTPUT", envVal)
+			}
+
+			config, err := LoadConfig(FlagOverride{Output: flagVal})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).ToNot(BeNil())
+
+			Expect(config.OutputFormat()).To(Equal(expected))
+		},
+		Entry("env=unset --output=json", "", "json", "json"),
+		Entry("env=json  flag=unset", "json", "", "json"),
+		Entry("env=json  --output=text", "json", "text", "text"),
+		Entry("env=yaml  flag=unset falls back to default", "yaml", "", "text"),
+		Entry("env=unset flag=unset falls back to default", "", "", "text"),
+	)
+})
@@ -0,0 +1,46 @@
+package configv3
+
+import (
+	"runtime"
+	"strconv"
+)
+
+// PagerEnabled returns true if long output should be piped through a pager.
+// This value is based off of:
+//   1. The --no-pager global flag, which always disables paging
+//   2. The $CF_PAGER environment variable if set (0/1/t/f/true/false)
+//   3. The 'PagerEnabled' value in the .cf/config.json if set
+//   4. Defaults to false
+func (config *Config) PagerEnabled() bool {
+	if config.Flags.NoPager {
+		return false
+	}
+
+	if config.ENV.CFPager != "" {
+		val, err := strconv.ParseBool(config.ENV.CFPager)
+		if err == nil {
+			return val
+		}
+	}
+
+	val, err := strconv.ParseBool(config.ConfigFile.PagerEnabled)
+	if err != nil {
+		return false
+	}
+	return val
+}
+
+// Pager returns the command used to page long output. This value is based off
+// of:
+//   1. The $PAGER environment variable if set
+//   2. Defaults to 'more' on Windows and 'less -R' everywhere else
+func (config *Config) Pager() string {
+	if config.ENV.Pager != "" {
+		return config.ENV.Pager
+	}
+
+	if runtime.GOOS == "windows" {
+		return "more"
+	}
+	return "less -R"
+}
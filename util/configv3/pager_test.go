@@ -0,0 +1,86 @@
+package configv3_test
+
+import (
+	"fmt"
+	"os"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	DescribeTable("PagerEnabled",
+		func(configVal string, envVal string, noPager bool, expected bool) {
+			rawConfig := fmt.Sprintf(`{"PagerEnabled":"%s"}`, configVal)
+			setConfig(homeDir, rawConfig)
+
+			defer os.Unsetenv("CF_PAGER")
+			if envVal == "" {
+				os.Unsetenv("CF_PAGER")
+			} else {
+				os.Setenv("CF_PAGER", envVal)
+			}
+
+			config, err := LoadConfig(FlagOverride{NoPager: noPager})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).ToNot(BeNil())
+
+			Expect(config.PagerEnabled()).To(Equal(expected))
+		},
+		Entry("config=true  env=unset enabled", "true", "", false, true),
+		Entry("config=true  env=false disabled", "true", "false", false, false),
+		Entry("config=false env=true  enabled", "false", "true", false, true),
+		Entry("config=unset env=true  enabled", "", "true", false, true),
+		Entry("config=true  env=true  --no-pager disabled", "true", "true", true, false),
+
+		Entry("config=unset env=unset falls back to default", "", "", false, false),
+	)
+
+	Describe("Pager", func() {
+		var originalPager string
+
+		BeforeEach(func() {
+			originalPager = os.Getenv("PAGER")
+		})
+
+		AfterEach(func() {
+			Expect(os.Setenv("PAGER", originalPager)).ToNot(HaveOccurred())
+		})
+
+		Context("when $PAGER is set", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("PAGER", "most -s")).ToNot(HaveOccurred())
+			})
+
+			It("returns $PAGER", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.Pager()).To(Equal("most -s"))
+			})
+		})
+
+		Context("when $PAGER is not set", func() {
+			BeforeEach(func() {
+				Expect(os.Unsetenv("PAGER")).ToNot(HaveOccurred())
+			})
+
+			It("returns a default pager", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.Pager()).ToNot(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,24 @@
+package configv3
+
+const (
+	// DefaultProgressFormat is the format progress is reported in when
+	// $CF_PROGRESS is not provided.
+	DefaultProgressFormat = "text"
+
+	// ProgressFormatJSON is the format that additionally reports the progress
+	// of long running commands as newline-delimited JSON events.
+	ProgressFormatJSON = "json"
+)
+
+// ProgressFormat returns the format the progress of long running commands
+// should be reported in. This value is based off of:
+//   1. The $CF_PROGRESS environment variable if set to text or json
+//   2. Defaults to DefaultProgressFormat
+func (config *Config) ProgressFormat() string {
+	switch config.ENV.CFProgress {
+	case DefaultProgressFormat, ProgressFormatJSON:
+		return config.ENV.CFProgress
+	}
+
+	return DefaultProgressFormat
+}
@@ -0,0 +1,43 @@
+package configv3_test
+
+import (
+	"os"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	DescribeTable("ProgressFormat",
+		func(envVal string, expected string) {
+			defer os.Unsetenv("CF_PROGRESS")
+			if envVal == "" {
+				os.Unsetenv("CF_PROGRESS")
+			} else {
+				os.Setenv("CF_PROGRESS", envVal)
+			}
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).ToNot(BeNil())
+
+			Expect(config.ProgressFormat()).To(Equal(expected))
+		},
+		Entry("env=json", "json", "json"),
+		Entry("env=text", "text", "text"),
+		Entry("env=yaml falls back to default", "yaml", "text"),
+		Entry("env=unset falls back to default", "", "text"),
+	)
+})
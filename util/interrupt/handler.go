@@ -0,0 +1,115 @@
+// Package interrupt turns an interrupt (Ctrl-C) that arrives while the CLI is
+// waiting on an API, or sleeping between polls, into context cancellation, so the request
+// is aborted and the command can report it, instead of the CLI exiting
+// midway through an operation.
+package interrupt
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+)
+
+// DefaultHandler catches os.Interrupt.
+var DefaultHandler = NewHandler(os.Interrupt)
+
+// InterruptedError is returned by Sleep when the handler's context is
+// cancelled before the sleep is over.
+type InterruptedError struct {
+}
+
+func (InterruptedError) Error() string {
+	return "interrupted"
+}
+
+// Sleep pauses for duration on the DefaultHandler.
+func Sleep(duration time.Duration) error {
+	return DefaultHandler.Sleep(duration)
+}
+
+// Handler cancels its context when one of its signals is received while it
+// is catching. Outside of Catch, the signals keep their default behavior.
+type Handler struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	signals []os.Signal
+
+	mutex    sync.Mutex
+	catching int
+	sig      chan os.Signal
+}
+
+// NewHandler returns a Handler for the given signals.
+func NewHandler(signals ...os.Signal) *Handler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Handler{
+		ctx:     ctx,
+		cancel:  cancel,
+		signals: signals,
+	}
+}
+
+// Context returns the context that is cancelled when a signal is caught.
+func (handler *Handler) Context() context.Context {
+	return handler.ctx
+}
+
+// Catch starts catching the handler's signals and returns a function that
+// stops catching them. Catch may be called concurrently; signals are caught
+// until every returned function has been called.
+func (handler *Handler) Catch() func() {
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+
+	if handler.catching == 0 {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, handler.signals...)
+		go func() {
+			if _, ok := <-sig; ok {
+				handler.cancel()
+			}
+		}()
+		handler.sig = sig
+	}
+	handler.catching++
+
+	var once sync.Once
+	return func() {
+		once.Do(handler.release)
+	}
+}
+
+func (handler *Handler) release() {
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+
+	handler.catching--
+	if handler.catching == 0 {
+		signal.Stop(handler.sig)
+		close(handler.sig)
+	}
+}
+
+// Sleep pauses for duration while catching the handler's signals, so that a
+// polling loop can be interrupted between requests. It returns an
+// InterruptedError if the context is, or becomes, cancelled.
+func (handler *Handler) Sleep(duration time.Duration) error {
+	if handler.ctx.Err() != nil {
+		return InterruptedError{}
+	}
+
+	release := handler.Catch()
+	defer release()
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-handler.ctx.Done():
+		return InterruptedError{}
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,79 @@
+// +build !windows
+
+package interrupt_test
+
+import (
+	"context"
+	"syscall"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/interrupt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Handler", func() {
+	It("cancels the context when the signal is received while catching", func() {
+		handler := NewHandler(syscall.SIGUSR1)
+		release := handler.Catch()
+		defer release()
+
+		Consistently(handler.Context().Done()).ShouldNot(BeClosed())
+
+		Expect(syscall.Kill(syscall.Getpid(), syscall.SIGUSR1)).To(Succeed())
+		Eventually(handler.Context().Done()).Should(BeClosed())
+		Expect(handler.Context().Err()).To(Equal(context.Canceled))
+	})
+
+	It("keeps catching until every Catch is released", func() {
+		handler := NewHandler(syscall.SIGUSR2)
+		releaseFirst := handler.Catch()
+		releaseSecond := handler.Catch()
+		defer releaseSecond()
+
+		releaseFirst()
+		releaseFirst()
+
+		Expect(syscall.Kill(syscall.Getpid(), syscall.SIGUSR2)).To(Succeed())
+		Eventually(handler.Context().Done()).Should(BeClosed())
+	})
+
+	It("does not cancel the context when released without a signal", func() {
+		handler := NewHandler(syscall.SIGUSR1)
+		handler.Catch()()
+
+		Consistently(handler.Context().Done()).ShouldNot(BeClosed())
+	})
+
+	Describe("Sleep", func() {
+		It("sleeps for the duration when no signal is received", func() {
+			handler := NewHandler(syscall.SIGUSR1)
+			Expect(handler.Sleep(time.Millisecond)).To(Succeed())
+		})
+
+		It("returns an InterruptedError when the signal is received while sleeping", func() {
+			handler := NewHandler(syscall.SIGUSR2)
+			release := handler.Catch()
+			defer release()
+
+			errs := make(chan error)
+			go func() {
+				errs <- handler.Sleep(time.Minute)
+			}()
+
+			Expect(syscall.Kill(syscall.Getpid(), syscall.SIGUSR2)).To(Succeed())
+			Eventually(errs).Should(Receive(Equal(InterruptedError{})))
+		})
+
+		It("returns an InterruptedError immediately when the context is already cancelled", func() {
+			handler := NewHandler(syscall.SIGUSR1)
+			release := handler.Catch()
+			Expect(syscall.Kill(syscall.Getpid(), syscall.SIGUSR1)).To(Succeed())
+			Eventually(handler.Context().Done()).Should(BeClosed())
+			release()
+
+			Expect(handler.Sleep(time.Minute)).To(MatchError(InterruptedError{}))
+		})
+	})
+})
@@ -0,0 +1,175 @@
+package pluginhelpers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/onsi/gomega/ghttp"
+)
+
+const (
+	// DefaultV2APIVersion is the v2 API version reported by a
+	// FakeCloudController unless V2APIVersion is changed.
+	DefaultV2APIVersion = "2.92.0"
+
+	// DefaultV3APIVersion is the v3 API version reported by a
+	// FakeCloudController unless V3APIVersion is changed.
+	DefaultV3APIVersion = "3.27.0"
+)
+
+// V2Resource is a single resource served from a v2 collection endpoint.
+type V2Resource struct {
+	// GUID is served as the resource's metadata GUID.
+	GUID string
+
+	// Entity is JSON encoded as the resource's entity.
+	Entity interface{}
+}
+
+// FakeCloudController is a Cloud Controller backed by a ghttp server. It
+// answers the root, /v2/info and /v3 endpoints and points the CLI at its own
+// FakeUAA. Any other endpoint can be added with AddV2Resources,
+// AddV3Resources or the embedded ghttp.Server.
+type FakeCloudController struct {
+	*ghttp.Server
+
+	// UAA is the fake UAA advertised by the Cloud Controller.
+	UAA *FakeUAA
+
+	// V2APIVersion is the version returned by /v2/info.
+	V2APIVersion string
+
+	// V3APIVersion is the version returned by the root endpoint.
+	V3APIVersion string
+}
+
+// NewFakeCloudController starts a FakeCloudController, and the FakeUAA it
+// advertises, reporting the default API versions.
+func NewFakeCloudController() *FakeCloudController {
+	cc := &FakeCloudController{
+		Server:       ghttp.NewServer(),
+		UAA:          NewFakeUAA(),
+		V2APIVersion: DefaultV2APIVersion,
+		V3APIVersion: DefaultV3APIVersion,
+	}
+
+	cc.RouteToHandler(http.MethodGet, "/", cc.rootHandler)
+	cc.RouteToHandler(http.MethodGet, "/v2/info", cc.v2InfoHandler)
+	cc.RouteToHandler(http.MethodGet, "/v3", cc.v3Handler)
+
+	return cc
+}
+
+// Close shuts down both the Cloud Controller and its UAA.
+func (cc *FakeCloudController) Close() {
+	cc.Server.Close()
+	cc.UAA.Close()
+}
+
+// AddV2Resources serves the resources as a single page from the given v2
+// collection path (e.g. /v2/apps), and each resource individually from
+// path/GUID.
+func (cc *FakeCloudController) AddV2Resources(path string, resources ...V2Resource) {
+	path = strings.TrimRight(path, "/")
+
+	var serialized []interface{}
+	for _, resource := range resources {
+		v2Resource := map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"guid": resource.GUID,
+				"url":  fmt.Sprintf("%s/%s", path, resource.GUID),
+			},
+			"entity": resource.Entity,
+		}
+		serialized = append(serialized, v2Resource)
+
+		cc.RouteToHandler(http.MethodGet, fmt.Sprintf("%s/%s", path, resource.GUID),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, v2Resource))
+	}
+	if serialized == nil {
+		serialized = []interface{}{}
+	}
+
+	cc.RouteToHandler(http.MethodGet, path, ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+		"total_results": len(resources),
+		"total_pages":   1,
+		"prev_url":      nil,
+		"next_url":      nil,
+		"resources":     serialized,
+	}))
+}
+
+// AddV3Resources serves the resources as a single page from the given v3
+// collection path (e.g. /v3/apps).
+func (cc *FakeCloudController) AddV3Resources(path string, resources ...interface{}) {
+	path = strings.TrimRight(path, "/")
+	if resources == nil {
+		resources = []interface{}{}
+	}
+
+	pageLink := map[string]string{"href": fmt.Sprintf("%s%s?page=1", cc.URL(), path)}
+	cc.RouteToHandler(http.MethodGet, path, ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+		"pagination": map[string]interface{}{
+			"total_results": len(resources),
+			"total_pages":   1,
+			"first":         pageLink,
+			"last":          pageLink,
+			"next":          nil,
+			"previous":      nil,
+		},
+		"resources": resources,
+	}))
+}
+
+func (cc *FakeCloudController) rootHandler(w http.ResponseWriter, r *http.Request) {
+	ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+		"links": map[string]interface{}{
+			"self": map[string]string{"href": cc.URL()},
+			"cloud_controller_v2": map[string]interface{}{
+				"href": cc.URL() + "/v2",
+				"meta": map[string]string{"version": cc.V2APIVersion},
+			},
+			"cloud_controller_v3": map[string]interface{}{
+				"href": cc.URL() + "/v3",
+				"meta": map[string]string{"version": cc.V3APIVersion},
+			},
+			"uaa":   map[string]string{"href": cc.UAA.URL()},
+			"login": map[string]string{"href": cc.UAA.URL()},
+		},
+	})(w, r)
+}
+
+func (cc *FakeCloudController) v2InfoHandler(w http.ResponseWriter, r *http.Request) {
+	ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+		"name":                         "",
+		"build":                        "",
+		"support":                      "",
+		"version":                      0,
+		"description":                  "",
+		"authorization_endpoint":       cc.UAA.URL(),
+		"token_endpoint":               cc.UAA.URL(),
+		"min_cli_version":              nil,
+		"min_recommended_cli_version":  nil,
+		"api_version":                  cc.V2APIVersion,
+		"app_ssh_endpoint":             "",
+		"app_ssh_host_key_fingerprint": "",
+		"app_ssh_oauth_client":         "ssh-proxy",
+		"doppler_logging_endpoint":     "",
+		"routing_endpoint":             "",
+	})(w, r)
+}
+
+func (cc *FakeCloudController) v3Handler(w http.ResponseWriter, r *http.Request) {
+	ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+		"links": map[string]interface{}{
+			"self":               map[string]string{"href": cc.URL() + "/v3"},
+			"apps":               map[string]string{"href": cc.URL() + "/v3/apps"},
+			"isolation_segments": map[string]string{"href": cc.URL() + "/v3/isolation_segments"},
+			"organizations":      map[string]string{"href": cc.URL() + "/v3/organizations"},
+			"processes":          map[string]string{"href": cc.URL() + "/v3/processes"},
+			"spaces":             map[string]string{"href": cc.URL() + "/v3/spaces"},
+			"tasks":              map[string]string{"href": cc.URL() + "/v3/tasks"},
+		},
+	})(w, r)
+}
@@ -0,0 +1,105 @@
+package pluginhelpers_test
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/util/testhelpers/pluginhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FakeCloudController", func() {
+	var cc *FakeCloudController
+
+	BeforeEach(func() {
+		cc = NewFakeCloudController()
+	})
+
+	AfterEach(func() {
+		cc.Close()
+	})
+
+	Describe("v2", func() {
+		var client *ccv2.Client
+
+		BeforeEach(func() {
+			cc.V2APIVersion = "2.100.0"
+
+			client = ccv2.NewClient(ccv2.Config{AppName: "some-app", AppVersion: "some-version"})
+			_, err := client.TargetCF(ccv2.TargetSettings{URL: cc.URL()})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("serves /v2/info pointing at its UAA", func() {
+			Expect(client.APIVersion()).To(Equal("2.100.0"))
+			Expect(client.AuthorizationEndpoint()).To(Equal(cc.UAA.URL()))
+		})
+
+		It("serves the added resources as a collection and individually", func() {
+			cc.AddV2Resources("/v2/organizations",
+				V2Resource{GUID: "org-guid-1", Entity: map[string]interface{}{"name": "org-1"}},
+				V2Resource{GUID: "org-guid-2", Entity: map[string]interface{}{"name": "org-2"}},
+			)
+
+			orgs, _, err := client.GetOrganizations()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(orgs).To(ConsistOf(
+				ccv2.Organization{GUID: "org-guid-1", Name: "org-1"},
+				ccv2.Organization{GUID: "org-guid-2", Name: "org-2"},
+			))
+
+			org, _, err := client.GetOrganization("org-guid-2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(org).To(Equal(ccv2.Organization{GUID: "org-guid-2", Name: "org-2"}))
+		})
+	})
+
+	Describe("v3", func() {
+		var client *ccv3.Client
+
+		BeforeEach(func() {
+			client = ccv3.NewClient(ccv3.Config{AppName: "some-app", AppVersion: "some-version"})
+			_, err := client.TargetCF(ccv3.TargetSettings{URL: cc.URL()})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("reports the v3 API version and UAA", func() {
+			info, _, _, err := client.Info()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.CloudControllerAPIVersion()).To(Equal(DefaultV3APIVersion))
+			Expect(info.UAA()).To(Equal(cc.UAA.URL()))
+		})
+
+		It("serves the added resources as a collection", func() {
+			cc.AddV3Resources("/v3/apps",
+				map[string]interface{}{"guid": "app-guid-1", "name": "app-1", "state": "STARTED"},
+			)
+
+			apps, _, err := client.GetApplications(url.Values{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apps).To(HaveLen(1))
+			Expect(apps[0].GUID).To(Equal("app-guid-1"))
+			Expect(apps[0].Name).To(Equal("app-1"))
+		})
+	})
+
+	Describe("UAA", func() {
+		It("issues access tokens for the configured user", func() {
+			cc.UAA.Username = "some-other-user"
+
+			client := uaa.NewClient(uaa.Config{AppName: "some-app", AppVersion: "some-version", ClientID: "cf"})
+			err := client.SetupResources(new(uaafakes.FakeUAAEndpointStore), cc.UAA.URL())
+			Expect(err).ToNot(HaveOccurred())
+
+			tokens, err := client.RefreshAccessToken("some-refresh-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tokens.AccessToken).To(Equal(NewAccessToken("some-other-user", DefaultUserGUID)))
+			Expect(tokens.RefreshToken).To(Equal(DefaultRefreshToken))
+		})
+	})
+})
@@ -0,0 +1,81 @@
+package pluginhelpers
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// ConfigBuilder builds the config.json the CLI reads from $CF_HOME/.cf.
+type ConfigBuilder struct {
+	config configv3.CFConfig
+}
+
+// NewConfigBuilder returns a builder for an untargeted, logged out config
+// with colors disabled.
+func NewConfigBuilder() *ConfigBuilder {
+	return &ConfigBuilder{
+		config: configv3.CFConfig{
+			ConfigVersion:  3,
+			ColorEnabled:   "false",
+			SSHOAuthClient: configv3.DefaultSSHOAuthClient,
+			UAAOAuthClient: configv3.DefaultUAAOAuthClient,
+		},
+	}
+}
+
+// WithTarget targets the Cloud Controller and logs in as the user its UAA
+// issues tokens for.
+func (builder *ConfigBuilder) WithTarget(cc *FakeCloudController) *ConfigBuilder {
+	builder.config.Target = cc.URL()
+	builder.config.APIVersion = cc.V2APIVersion
+	builder.config.AuthorizationEndpoint = cc.UAA.URL()
+	builder.config.UAAEndpoint = cc.UAA.URL()
+	builder.config.AccessToken = "bearer " + cc.UAA.AccessToken()
+	builder.config.RefreshToken = DefaultRefreshToken
+	return builder
+}
+
+// WithOrganization targets the given organization.
+func (builder *ConfigBuilder) WithOrganization(name string, guid string) *ConfigBuilder {
+	builder.config.TargetedOrganization = configv3.Organization{
+		Name: name,
+		GUID: guid,
+	}
+	return builder
+}
+
+// WithSpace targets the given space.
+func (builder *ConfigBuilder) WithSpace(name string, guid string) *ConfigBuilder {
+	builder.config.TargetedSpace = configv3.Space{
+		Name:     name,
+		GUID:     guid,
+		AllowSSH: true,
+	}
+	return builder
+}
+
+// Config returns the built config.
+func (builder *ConfigBuilder) Config() configv3.CFConfig {
+	return builder.config
+}
+
+// Write writes the built config to cfHome/.cf/config.json, creating the
+// directory if needed. Point CF_HOME at cfHome to have the CLI use it.
+func (builder *ConfigBuilder) Write(cfHome string) error {
+	rawConfig, err := json.MarshalIndent(builder.config, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	configDir := filepath.Join(cfHome, ".cf")
+	err = os.MkdirAll(configDir, 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(configDir, "config.json"), rawConfig, 0600)
+}
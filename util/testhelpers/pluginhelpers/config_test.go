@@ -0,0 +1,62 @@
+package pluginhelpers_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/testhelpers/pluginhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConfigBuilder", func() {
+	var (
+		cc         *FakeCloudController
+		cfHome     string
+		oldHomeDir string
+		oldHomeSet bool
+	)
+
+	BeforeEach(func() {
+		cc = NewFakeCloudController()
+
+		var err error
+		cfHome, err = ioutil.TempDir("", "cf-home")
+		Expect(err).ToNot(HaveOccurred())
+
+		oldHomeDir, oldHomeSet = os.LookupEnv("CF_HOME")
+		Expect(os.Setenv("CF_HOME", cfHome)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		if oldHomeSet {
+			Expect(os.Setenv("CF_HOME", oldHomeDir)).To(Succeed())
+		} else {
+			Expect(os.Unsetenv("CF_HOME")).To(Succeed())
+		}
+		Expect(os.RemoveAll(cfHome)).To(Succeed())
+		cc.Close()
+	})
+
+	It("writes a config the CLI loads as targeted and logged in", func() {
+		err := NewConfigBuilder().
+			WithTarget(cc).
+			WithOrganization("some-org", "some-org-guid").
+			WithSpace("some-space", "some-space-guid").
+			Write(cfHome)
+		Expect(err).ToNot(HaveOccurred())
+
+		config, err := configv3.LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.Target()).To(Equal(cc.URL()))
+		Expect(config.APIVersion()).To(Equal(DefaultV2APIVersion))
+		Expect(config.TargetedOrganization().GUID).To(Equal("some-org-guid"))
+		Expect(config.TargetedSpace().Name).To(Equal("some-space"))
+
+		user, err := config.CurrentUser()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(user.Name).To(Equal(DefaultUsername))
+	})
+})
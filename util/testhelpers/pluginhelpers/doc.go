@@ -0,0 +1,24 @@
+// Package pluginhelpers provides test doubles for plugin and wrapper authors
+// who want to exercise the CLI without a real Cloud Foundry.
+//
+// It contains a fake Cloud Controller and UAA that answer the bootstrap
+// endpoints the CLI hits on every command and can be loaded with canned v2
+// and v3 resources, a builder for the CLI's config.json, and a UI backed by
+// in-memory buffers.
+//
+// A typical test starts the fake Cloud Controller, writes a config targeting
+// it into a temporary CF_HOME, and runs either the cf binary or a plugin
+// against it:
+//
+//	cc := pluginhelpers.NewFakeCloudController()
+//	defer cc.Close()
+//	cc.AddV2Resources("/v2/organizations", pluginhelpers.V2Resource{
+//		GUID:   "some-org-guid",
+//		Entity: map[string]interface{}{"name": "some-org"},
+//	})
+//
+//	err := pluginhelpers.NewConfigBuilder().
+//		WithTarget(cc).
+//		WithOrganization("some-org", "some-org-guid").
+//		Write(cfHome)
+package pluginhelpers
@@ -0,0 +1,13 @@
+package pluginhelpers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestPluginHelpers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Plugin Helpers Suite")
+}
@@ -0,0 +1,92 @@
+package pluginhelpers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+)
+
+const (
+	// DefaultUsername is the user the FakeUAA issues tokens for unless
+	// Username is changed.
+	DefaultUsername = "some-user"
+
+	// DefaultUserGUID is the user GUID the FakeUAA issues tokens for unless
+	// UserGUID is changed.
+	DefaultUserGUID = "some-user-guid"
+
+	// DefaultRefreshToken is the refresh token issued by the FakeUAA.
+	DefaultRefreshToken = "some-refresh-token"
+)
+
+// FakeUAA is a UAA backed by a ghttp server. It answers /login and issues
+// tokens for Username from /oauth/token, for any grant type.
+type FakeUAA struct {
+	*ghttp.Server
+
+	// Username is the user_name claim of issued access tokens.
+	Username string
+
+	// UserGUID is the user_id claim of issued access tokens.
+	UserGUID string
+}
+
+// NewFakeUAA starts a FakeUAA that issues tokens for the default user.
+func NewFakeUAA() *FakeUAA {
+	uaa := &FakeUAA{
+		Server:   ghttp.NewServer(),
+		Username: DefaultUsername,
+		UserGUID: DefaultUserGUID,
+	}
+
+	uaa.RouteToHandler(http.MethodGet, "/login", uaa.loginHandler)
+	uaa.RouteToHandler(http.MethodPost, "/oauth/token", uaa.tokenHandler)
+
+	return uaa
+}
+
+// AccessToken returns a JWT for Username, without the 'bearer ' prefix. The
+// token is not signed with a real key; the CLI only decodes its claims.
+func (uaa *FakeUAA) AccessToken() string {
+	return NewAccessToken(uaa.Username, uaa.UserGUID)
+}
+
+// NewAccessToken returns an unsigned JWT carrying the given user's claims,
+// expiring in an hour.
+func NewAccessToken(username string, userGUID string) string {
+	header, _ := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"user_name": username,
+		"user_id":   userGUID,
+		"email":     username,
+		"exp":       time.Now().Add(time.Hour).Unix(),
+	})
+
+	return fmt.Sprintf("%s.%s.%s",
+		base64.RawURLEncoding.EncodeToString(header),
+		base64.RawURLEncoding.EncodeToString(claims),
+		base64.RawURLEncoding.EncodeToString([]byte("some-signature")),
+	)
+}
+
+func (uaa *FakeUAA) loginHandler(w http.ResponseWriter, r *http.Request) {
+	ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+		"links": map[string]string{
+			"uaa":   uaa.URL(),
+			"login": uaa.URL(),
+		},
+	})(w, r)
+}
+
+func (uaa *FakeUAA) tokenHandler(w http.ResponseWriter, r *http.Request) {
+	ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+		"access_token":  uaa.AccessToken(),
+		"refresh_token": DefaultRefreshToken,
+		"token_type":    "bearer",
+		"expires_in":    3599,
+	})(w, r)
+}
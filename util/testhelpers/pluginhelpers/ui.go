@@ -0,0 +1,35 @@
+package pluginhelpers
+
+import (
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/onsi/gomega/gbytes"
+)
+
+// TestUI is a ui.UI whose input and output are in-memory buffers, for use
+// with gbytes.Say.
+type TestUI struct {
+	*ui.UI
+
+	// InBuffer is read as the user's input.
+	InBuffer *gbytes.Buffer
+
+	// OutBuffer collects everything written to standard out.
+	OutBuffer *gbytes.Buffer
+
+	// ErrBuffer collects everything written to standard error.
+	ErrBuffer *gbytes.Buffer
+}
+
+// NewTestUI returns a TestUI with empty buffers and colors disabled.
+func NewTestUI() *TestUI {
+	in := gbytes.NewBuffer()
+	out := gbytes.NewBuffer()
+	err := gbytes.NewBuffer()
+
+	return &TestUI{
+		UI:        ui.NewTestUI(in, out, err),
+		InBuffer:  in,
+		OutBuffer: out,
+		ErrBuffer: err,
+	}
+}
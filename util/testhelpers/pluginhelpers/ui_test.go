@@ -0,0 +1,31 @@
+package pluginhelpers_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/testhelpers/pluginhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("TestUI", func() {
+	It("writes output and warnings to the buffers", func() {
+		testUI := NewTestUI()
+
+		testUI.DisplayText("some {{.Thing}}", map[string]interface{}{"Thing": "text"})
+		testUI.DisplayWarning("some warning")
+
+		Expect(testUI.OutBuffer).To(Say("some text"))
+		Expect(testUI.ErrBuffer).To(Say("some warning"))
+	})
+
+	It("reads input from the input buffer", func() {
+		testUI := NewTestUI()
+		_, err := testUI.InBuffer.Write([]byte("y\n"))
+		Expect(err).ToNot(HaveOccurred())
+
+		response, err := testUI.DisplayBoolPrompt(false, "Really?")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response).To(BeTrue())
+	})
+})
@@ -262,6 +262,18 @@ type FakeHandlers struct {
 	getAppReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GetAppGUIDStub        func(appName string, retVal *string) error
+	getAppGUIDMutex       sync.RWMutex
+	getAppGUIDArgsForCall []struct {
+		appName string
+		retVal  *string
+	}
+	getAppGUIDReturns struct {
+		result1 error
+	}
+	getAppGUIDReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetAppsStub        func(args string, retVal *[]plugin_models.GetAppsModel) error
 	getAppsMutex       sync.RWMutex
 	getAppsArgsForCall []struct {
@@ -298,6 +310,18 @@ type FakeHandlers struct {
 	getSpacesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GetServiceInstanceGUIDStub        func(serviceInstanceName string, retVal *string) error
+	getServiceInstanceGUIDMutex       sync.RWMutex
+	getServiceInstanceGUIDArgsForCall []struct {
+		serviceInstanceName string
+		retVal              *string
+	}
+	getServiceInstanceGUIDReturns struct {
+		result1 error
+	}
+	getServiceInstanceGUIDReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetServicesStub        func(args string, retVal *[]plugin_models.GetServices_Model) error
 	getServicesMutex       sync.RWMutex
 	getServicesArgsForCall []struct {
@@ -310,6 +334,18 @@ type FakeHandlers struct {
 	getServicesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GetOrgGUIDStub        func(orgName string, retVal *string) error
+	getOrgGUIDMutex       sync.RWMutex
+	getOrgGUIDArgsForCall []struct {
+		orgName string
+		retVal  *string
+	}
+	getOrgGUIDReturns struct {
+		result1 error
+	}
+	getOrgGUIDReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetOrgUsersStub        func(args []string, retVal *[]plugin_models.GetOrgUsers_Model) error
 	getOrgUsersMutex       sync.RWMutex
 	getOrgUsersArgsForCall []struct {
@@ -322,6 +358,18 @@ type FakeHandlers struct {
 	getOrgUsersReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GetSpaceGUIDStub        func(spaceName string, retVal *string) error
+	getSpaceGUIDMutex       sync.RWMutex
+	getSpaceGUIDArgsForCall []struct {
+		spaceName string
+		retVal    *string
+	}
+	getSpaceGUIDReturns struct {
+		result1 error
+	}
+	getSpaceGUIDReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetSpaceUsersStub        func(args []string, retVal *[]plugin_models.GetSpaceUsers_Model) error
 	getSpaceUsersMutex       sync.RWMutex
 	getSpaceUsersArgsForCall []struct {
@@ -370,6 +418,30 @@ type FakeHandlers struct {
 	getServiceReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GetV3AppsStub        func(args string, retVal *[]plugin_models.GetV3AppsModel) error
+	getV3AppsMutex       sync.RWMutex
+	getV3AppsArgsForCall []struct {
+		args   string
+		retVal *[]plugin_models.GetV3AppsModel
+	}
+	getV3AppsReturns struct {
+		result1 error
+	}
+	getV3AppsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetV3AppProcessesStub        func(appName string, retVal *[]plugin_models.GetV3AppProcessesModel) error
+	getV3AppProcessesMutex       sync.RWMutex
+	getV3AppProcessesArgsForCall []struct {
+		appName string
+		retVal  *[]plugin_models.GetV3AppProcessesModel
+	}
+	getV3AppProcessesReturns struct {
+		result1 error
+	}
+	getV3AppProcessesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1408,6 +1480,55 @@ func (fake *FakeHandlers) GetAppReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetAppGUID(appName string, retVal *string) error {
+	fake.getAppGUIDMutex.Lock()
+	ret, specificReturn := fake.getAppGUIDReturnsOnCall[len(fake.getAppGUIDArgsForCall)]
+	fake.getAppGUIDArgsForCall = append(fake.getAppGUIDArgsForCall, struct {
+		appName string
+		retVal  *string
+	}{appName, retVal})
+	fake.recordInvocation("GetAppGUID", []interface{}{appName, retVal})
+	fake.getAppGUIDMutex.Unlock()
+	if fake.GetAppGUIDStub != nil {
+		return fake.GetAppGUIDStub(appName, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getAppGUIDReturns.result1
+}
+
+func (fake *FakeHandlers) GetAppGUIDCallCount() int {
+	fake.getAppGUIDMutex.RLock()
+	defer fake.getAppGUIDMutex.RUnlock()
+	return len(fake.getAppGUIDArgsForCall)
+}
+
+func (fake *FakeHandlers) GetAppGUIDArgsForCall(i int) (string, *string) {
+	fake.getAppGUIDMutex.RLock()
+	defer fake.getAppGUIDMutex.RUnlock()
+	return fake.getAppGUIDArgsForCall[i].appName, fake.getAppGUIDArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetAppGUIDReturns(result1 error) {
+	fake.GetAppGUIDStub = nil
+	fake.getAppGUIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetAppGUIDReturnsOnCall(i int, result1 error) {
+	fake.GetAppGUIDStub = nil
+	if fake.getAppGUIDReturnsOnCall == nil {
+		fake.getAppGUIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getAppGUIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetApps(args string, retVal *[]plugin_models.GetAppsModel) error {
 	fake.getAppsMutex.Lock()
 	ret, specificReturn := fake.getAppsReturnsOnCall[len(fake.getAppsArgsForCall)]
@@ -1555,6 +1676,55 @@ func (fake *FakeHandlers) GetSpacesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetServiceInstanceGUID(serviceInstanceName string, retVal *string) error {
+	fake.getServiceInstanceGUIDMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceGUIDReturnsOnCall[len(fake.getServiceInstanceGUIDArgsForCall)]
+	fake.getServiceInstanceGUIDArgsForCall = append(fake.getServiceInstanceGUIDArgsForCall, struct {
+		serviceInstanceName string
+		retVal              *string
+	}{serviceInstanceName, retVal})
+	fake.recordInvocation("GetServiceInstanceGUID", []interface{}{serviceInstanceName, retVal})
+	fake.getServiceInstanceGUIDMutex.Unlock()
+	if fake.GetServiceInstanceGUIDStub != nil {
+		return fake.GetServiceInstanceGUIDStub(serviceInstanceName, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getServiceInstanceGUIDReturns.result1
+}
+
+func (fake *FakeHandlers) GetServiceInstanceGUIDCallCount() int {
+	fake.getServiceInstanceGUIDMutex.RLock()
+	defer fake.getServiceInstanceGUIDMutex.RUnlock()
+	return len(fake.getServiceInstanceGUIDArgsForCall)
+}
+
+func (fake *FakeHandlers) GetServiceInstanceGUIDArgsForCall(i int) (string, *string) {
+	fake.getServiceInstanceGUIDMutex.RLock()
+	defer fake.getServiceInstanceGUIDMutex.RUnlock()
+	return fake.getServiceInstanceGUIDArgsForCall[i].serviceInstanceName, fake.getServiceInstanceGUIDArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetServiceInstanceGUIDReturns(result1 error) {
+	fake.GetServiceInstanceGUIDStub = nil
+	fake.getServiceInstanceGUIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetServiceInstanceGUIDReturnsOnCall(i int, result1 error) {
+	fake.GetServiceInstanceGUIDStub = nil
+	if fake.getServiceInstanceGUIDReturnsOnCall == nil {
+		fake.getServiceInstanceGUIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getServiceInstanceGUIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetServices(args string, retVal *[]plugin_models.GetServices_Model) error {
 	fake.getServicesMutex.Lock()
 	ret, specificReturn := fake.getServicesReturnsOnCall[len(fake.getServicesArgsForCall)]
@@ -1604,6 +1774,55 @@ func (fake *FakeHandlers) GetServicesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetOrgGUID(orgName string, retVal *string) error {
+	fake.getOrgGUIDMutex.Lock()
+	ret, specificReturn := fake.getOrgGUIDReturnsOnCall[len(fake.getOrgGUIDArgsForCall)]
+	fake.getOrgGUIDArgsForCall = append(fake.getOrgGUIDArgsForCall, struct {
+		orgName string
+		retVal  *string
+	}{orgName, retVal})
+	fake.recordInvocation("GetOrgGUID", []interface{}{orgName, retVal})
+	fake.getOrgGUIDMutex.Unlock()
+	if fake.GetOrgGUIDStub != nil {
+		return fake.GetOrgGUIDStub(orgName, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getOrgGUIDReturns.result1
+}
+
+func (fake *FakeHandlers) GetOrgGUIDCallCount() int {
+	fake.getOrgGUIDMutex.RLock()
+	defer fake.getOrgGUIDMutex.RUnlock()
+	return len(fake.getOrgGUIDArgsForCall)
+}
+
+func (fake *FakeHandlers) GetOrgGUIDArgsForCall(i int) (string, *string) {
+	fake.getOrgGUIDMutex.RLock()
+	defer fake.getOrgGUIDMutex.RUnlock()
+	return fake.getOrgGUIDArgsForCall[i].orgName, fake.getOrgGUIDArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetOrgGUIDReturns(result1 error) {
+	fake.GetOrgGUIDStub = nil
+	fake.getOrgGUIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetOrgGUIDReturnsOnCall(i int, result1 error) {
+	fake.GetOrgGUIDStub = nil
+	if fake.getOrgGUIDReturnsOnCall == nil {
+		fake.getOrgGUIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getOrgGUIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetOrgUsers(args []string, retVal *[]plugin_models.GetOrgUsers_Model) error {
 	var argsCopy []string
 	if args != nil {
@@ -1658,6 +1877,55 @@ func (fake *FakeHandlers) GetOrgUsersReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetSpaceGUID(spaceName string, retVal *string) error {
+	fake.getSpaceGUIDMutex.Lock()
+	ret, specificReturn := fake.getSpaceGUIDReturnsOnCall[len(fake.getSpaceGUIDArgsForCall)]
+	fake.getSpaceGUIDArgsForCall = append(fake.getSpaceGUIDArgsForCall, struct {
+		spaceName string
+		retVal    *string
+	}{spaceName, retVal})
+	fake.recordInvocation("GetSpaceGUID", []interface{}{spaceName, retVal})
+	fake.getSpaceGUIDMutex.Unlock()
+	if fake.GetSpaceGUIDStub != nil {
+		return fake.GetSpaceGUIDStub(spaceName, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getSpaceGUIDReturns.result1
+}
+
+func (fake *FakeHandlers) GetSpaceGUIDCallCount() int {
+	fake.getSpaceGUIDMutex.RLock()
+	defer fake.getSpaceGUIDMutex.RUnlock()
+	return len(fake.getSpaceGUIDArgsForCall)
+}
+
+func (fake *FakeHandlers) GetSpaceGUIDArgsForCall(i int) (string, *string) {
+	fake.getSpaceGUIDMutex.RLock()
+	defer fake.getSpaceGUIDMutex.RUnlock()
+	return fake.getSpaceGUIDArgsForCall[i].spaceName, fake.getSpaceGUIDArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetSpaceGUIDReturns(result1 error) {
+	fake.GetSpaceGUIDStub = nil
+	fake.getSpaceGUIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetSpaceGUIDReturnsOnCall(i int, result1 error) {
+	fake.GetSpaceGUIDStub = nil
+	if fake.getSpaceGUIDReturnsOnCall == nil {
+		fake.getSpaceGUIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getSpaceGUIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetSpaceUsers(args []string, retVal *[]plugin_models.GetSpaceUsers_Model) error {
 	var argsCopy []string
 	if args != nil {
@@ -1859,6 +2127,104 @@ func (fake *FakeHandlers) GetServiceReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetV3Apps(args string, retVal *[]plugin_models.GetV3AppsModel) error {
+	fake.getV3AppsMutex.Lock()
+	ret, specificReturn := fake.getV3AppsReturnsOnCall[len(fake.getV3AppsArgsForCall)]
+	fake.getV3AppsArgsForCall = append(fake.getV3AppsArgsForCall, struct {
+		args   string
+		retVal *[]plugin_models.GetV3AppsModel
+	}{args, retVal})
+	fake.recordInvocation("GetV3Apps", []interface{}{args, retVal})
+	fake.getV3AppsMutex.Unlock()
+	if fake.GetV3AppsStub != nil {
+		return fake.GetV3AppsStub(args, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getV3AppsReturns.result1
+}
+
+func (fake *FakeHandlers) GetV3AppsCallCount() int {
+	fake.getV3AppsMutex.RLock()
+	defer fake.getV3AppsMutex.RUnlock()
+	return len(fake.getV3AppsArgsForCall)
+}
+
+func (fake *FakeHandlers) GetV3AppsArgsForCall(i int) (string, *[]plugin_models.GetV3AppsModel) {
+	fake.getV3AppsMutex.RLock()
+	defer fake.getV3AppsMutex.RUnlock()
+	return fake.getV3AppsArgsForCall[i].args, fake.getV3AppsArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetV3AppsReturns(result1 error) {
+	fake.GetV3AppsStub = nil
+	fake.getV3AppsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetV3AppsReturnsOnCall(i int, result1 error) {
+	fake.GetV3AppsStub = nil
+	if fake.getV3AppsReturnsOnCall == nil {
+		fake.getV3AppsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getV3AppsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetV3AppProcesses(appName string, retVal *[]plugin_models.GetV3AppProcessesModel) error {
+	fake.getV3AppProcessesMutex.Lock()
+	ret, specificReturn := fake.getV3AppProcessesReturnsOnCall[len(fake.getV3AppProcessesArgsForCall)]
+	fake.getV3AppProcessesArgsForCall = append(fake.getV3AppProcessesArgsForCall, struct {
+		appName string
+		retVal  *[]plugin_models.GetV3AppProcessesModel
+	}{appName, retVal})
+	fake.recordInvocation("GetV3AppProcesses", []interface{}{appName, retVal})
+	fake.getV3AppProcessesMutex.Unlock()
+	if fake.GetV3AppProcessesStub != nil {
+		return fake.GetV3AppProcessesStub(appName, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getV3AppProcessesReturns.result1
+}
+
+func (fake *FakeHandlers) GetV3AppProcessesCallCount() int {
+	fake.getV3AppProcessesMutex.RLock()
+	defer fake.getV3AppProcessesMutex.RUnlock()
+	return len(fake.getV3AppProcessesArgsForCall)
+}
+
+func (fake *FakeHandlers) GetV3AppProcessesArgsForCall(i int) (string, *[]plugin_models.GetV3AppProcessesModel) {
+	fake.getV3AppProcessesMutex.RLock()
+	defer fake.getV3AppProcessesMutex.RUnlock()
+	return fake.getV3AppProcessesArgsForCall[i].appName, fake.getV3AppProcessesArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetV3AppProcessesReturns(result1 error) {
+	fake.GetV3AppProcessesStub = nil
+	fake.getV3AppProcessesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetV3AppProcessesReturnsOnCall(i int, result1 error) {
+	fake.GetV3AppProcessesStub = nil
+	if fake.getV3AppProcessesReturnsOnCall == nil {
+		fake.getV3AppProcessesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getV3AppProcessesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1904,16 +2270,24 @@ func (fake *FakeHandlers) Invocations() map[string][][]interface{} {
 	defer fake.accessTokenMutex.RUnlock()
 	fake.getAppMutex.RLock()
 	defer fake.getAppMutex.RUnlock()
+	fake.getAppGUIDMutex.RLock()
+	defer fake.getAppGUIDMutex.RUnlock()
 	fake.getAppsMutex.RLock()
 	defer fake.getAppsMutex.RUnlock()
 	fake.getOrgsMutex.RLock()
 	defer fake.getOrgsMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
 	defer fake.getSpacesMutex.RUnlock()
+	fake.getServiceInstanceGUIDMutex.RLock()
+	defer fake.getServiceInstanceGUIDMutex.RUnlock()
 	fake.getServicesMutex.RLock()
 	defer fake.getServicesMutex.RUnlock()
+	fake.getOrgGUIDMutex.RLock()
+	defer fake.getOrgGUIDMutex.RUnlock()
 	fake.getOrgUsersMutex.RLock()
 	defer fake.getOrgUsersMutex.RUnlock()
+	fake.getSpaceGUIDMutex.RLock()
+	defer fake.getSpaceGUIDMutex.RUnlock()
 	fake.getSpaceUsersMutex.RLock()
 	defer fake.getSpaceUsersMutex.RUnlock()
 	fake.getOrgMutex.RLock()
@@ -1922,6 +2296,10 @@ func (fake *FakeHandlers) Invocations() map[string][][]interface{} {
 	defer fake.getSpaceMutex.RUnlock()
 	fake.getServiceMutex.RLock()
 	defer fake.getServiceMutex.RUnlock()
+	fake.getV3AppsMutex.RLock()
+	defer fake.getV3AppsMutex.RUnlock()
+	fake.getV3AppProcessesMutex.RLock()
+	defer fake.getV3AppProcessesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
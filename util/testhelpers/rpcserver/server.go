@@ -46,6 +46,12 @@ type Handlers interface {
 	GetOrg(orgName string, retVal *plugin_models.GetOrg_Model) error
 	GetSpace(spaceName string, retVal *plugin_models.GetSpace_Model) error
 	GetService(serviceInstance string, retVal *plugin_models.GetService_Model) error
+	GetV3Apps(args string, retVal *[]plugin_models.GetV3AppsModel) error
+	GetV3AppProcesses(appName string, retVal *[]plugin_models.GetV3AppProcessesModel) error
+	GetOrgGUID(orgName string, retVal *string) error
+	GetSpaceGUID(spaceName string, retVal *string) error
+	GetAppGUID(appName string, retVal *string) error
+	GetServiceInstanceGUID(serviceInstanceName string, retVal *string) error
 }
 
 type TestServer struct {
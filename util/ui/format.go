@@ -0,0 +1,117 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SizeUnits is the unit system used to display byte sizes.
+type SizeUnits int
+
+const (
+	// BinaryUnits displays sizes in multiples of 1024 using the single letter
+	// suffixes accepted by manifests and flags (e.g. 512M, 1G).
+	BinaryUnits SizeUnits = iota
+	// DecimalUnits displays sizes in multiples of 1000 using SI suffixes
+	// (e.g. 537MB, 1.1GB).
+	DecimalUnits
+)
+
+const megabyte = 1024 * 1024
+
+type sizeUnit struct {
+	size   float64
+	suffix string
+}
+
+var (
+	binarySizeUnits = []sizeUnit{
+		{1 << 40, "T"},
+		{1 << 30, "G"},
+		{1 << 20, "M"},
+		{1 << 10, "K"},
+		{1, "B"},
+	}
+
+	decimalSizeUnits = []sizeUnit{
+		{1e12, "TB"},
+		{1e9, "GB"},
+		{1e6, "MB"},
+		{1e3, "KB"},
+		{1, "B"},
+	}
+)
+
+// FormatBytes returns bytes as a human readable size in the given unit
+// system. The largest unit that results in a value of at least 1 is chosen
+// and the value is displayed with at most one decimal place.
+func FormatBytes(bytes uint64, units SizeUnits) string {
+	if bytes == 0 {
+		return "0"
+	}
+
+	table := binarySizeUnits
+	if units == DecimalUnits {
+		table = decimalSizeUnits
+	}
+
+	for _, unit := range table {
+		if float64(bytes) >= unit.size {
+			value := fmt.Sprintf("%.1f", float64(bytes)/unit.size)
+			return strings.TrimSuffix(value, ".0") + unit.suffix
+		}
+	}
+
+	return fmt.Sprintf("%dB", bytes)
+}
+
+// FormatMegabytes returns megabytes as a human readable size in the given
+// unit system.
+func FormatMegabytes(megabytes uint64, units SizeUnits) string {
+	return FormatBytes(megabytes*megabyte, units)
+}
+
+// FormatDuration returns duration rounded to the nearest second and broken
+// into hours, minutes and seconds, omitting the parts that are zero (e.g.
+// 1h 30m, 45s).
+func FormatDuration(duration time.Duration) string {
+	duration = duration.Round(time.Second)
+	if duration <= 0 {
+		return "0s"
+	}
+
+	hours := duration / time.Hour
+	minutes := (duration % time.Hour) / time.Minute
+	seconds := (duration % time.Minute) / time.Second
+
+	var parts []string
+	if hours > 0 {
+		parts = append(parts, fmt.Sprintf("%dh", hours))
+	}
+	if minutes > 0 {
+		parts = append(parts, fmt.Sprintf("%dm", minutes))
+	}
+	if seconds > 0 {
+		parts = append(parts, fmt.Sprintf("%ds", seconds))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// FormatBytes returns bytes as a human readable size in the UI's configured
+// SizeUnits.
+func (ui *UI) FormatBytes(bytes uint64) string {
+	return FormatBytes(bytes, ui.SizeUnits)
+}
+
+// FormatMegabytes returns megabytes as a human readable size in the UI's
+// configured SizeUnits.
+func (ui *UI) FormatMegabytes(megabytes uint64) string {
+	return FormatMegabytes(megabytes, ui.SizeUnits)
+}
+
+// FormatDuration returns duration in a human readable form.
+func (ui *UI) FormatDuration(duration time.Duration) string {
+	return FormatDuration(duration)
+}
@@ -0,0 +1,87 @@
+package ui_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Format", func() {
+	DescribeTable("FormatBytes",
+		func(bytes uint64, units SizeUnits, expected string) {
+			Expect(FormatBytes(bytes, units)).To(Equal(expected))
+		},
+
+		Entry("zero bytes", uint64(0), BinaryUnits, "0"),
+		Entry("binary bytes", uint64(512), BinaryUnits, "512B"),
+		Entry("binary kilobytes", uint64(1536), BinaryUnits, "1.5K"),
+		Entry("binary megabytes", uint64(1024*1024), BinaryUnits, "1M"),
+		Entry("binary gigabytes", uint64(1024*1024*1024), BinaryUnits, "1G"),
+		Entry("binary terabytes", uint64(2*1024*1024*1024*1024), BinaryUnits, "2T"),
+		Entry("decimal bytes", uint64(999), DecimalUnits, "999B"),
+		Entry("decimal kilobytes", uint64(1500), DecimalUnits, "1.5KB"),
+		Entry("decimal megabytes", uint64(1024*1024), DecimalUnits, "1MB"),
+		Entry("decimal gigabytes", uint64(1024*1024*1024), DecimalUnits, "1.1GB"),
+		Entry("decimal terabytes", uint64(2000000000000), DecimalUnits, "2TB"),
+	)
+
+	DescribeTable("FormatMegabytes",
+		func(megabytes uint64, units SizeUnits, expected string) {
+			Expect(FormatMegabytes(megabytes, units)).To(Equal(expected))
+		},
+
+		Entry("zero", uint64(0), BinaryUnits, "0"),
+		Entry("less than a gigabyte", uint64(256), BinaryUnits, "256M"),
+		Entry("exactly a gigabyte", uint64(1024), BinaryUnits, "1G"),
+		Entry("a fraction of a gigabyte", uint64(1536), BinaryUnits, "1.5G"),
+		Entry("decimal gigabyte", uint64(1024), DecimalUnits, "1.1GB"),
+	)
+
+	DescribeTable("FormatDuration",
+		func(duration time.Duration, expected string) {
+			Expect(FormatDuration(duration)).To(Equal(expected))
+		},
+
+		Entry("zero", time.Duration(0), "0s"),
+		Entry("negative", -time.Minute, "0s"),
+		Entry("sub second", 400*time.Millisecond, "0s"),
+		Entry("rounds to the nearest second", 1500*time.Millisecond, "2s"),
+		Entry("seconds", 45*time.Second, "45s"),
+		Entry("minutes", 15*time.Minute, "15m"),
+		Entry("hours and minutes", 90*time.Minute, "1h 30m"),
+		Entry("hours, minutes and seconds", time.Hour+2*time.Minute+3*time.Second, "1h 2m 3s"),
+		Entry("more than a day", 26*time.Hour, "26h"),
+	)
+
+	Describe("UI", func() {
+		var ui *UI
+
+		BeforeEach(func() {
+			ui = NewTestUI(nil, NewBuffer(), NewBuffer())
+		})
+
+		It("defaults to binary units", func() {
+			Expect(ui.FormatMegabytes(1024)).To(Equal("1G"))
+			Expect(ui.FormatBytes(1024)).To(Equal("1K"))
+		})
+
+		Context("when SizeUnits is set to decimal", func() {
+			BeforeEach(func() {
+				ui.SizeUnits = DecimalUnits
+			})
+
+			It("formats sizes in decimal units", func() {
+				Expect(ui.FormatMegabytes(1024)).To(Equal("1.1GB"))
+				Expect(ui.FormatBytes(1000)).To(Equal("1KB"))
+			})
+		})
+
+		It("formats durations", func() {
+			Expect(ui.FormatDuration(5 * time.Minute)).To(Equal("5m"))
+		})
+	})
+})
@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"text/template"
 
@@ -15,6 +18,9 @@ import (
 const (
 	// assetPath is the path of the translation file inside the asset loader.
 	assetPath = "cf/i18n/resources/%s.all.json"
+	// localeFileName is the name of a translation file inside a locale
+	// directory.
+	localeFileName = "%s.all.json"
 	// chineseBase is the language code for Chinese.
 	chineseBase = "zh"
 	// defaultLocale is the default locale used when one is not configured.
@@ -28,6 +34,12 @@ type LocaleReader interface {
 	Locale() string
 }
 
+// LocaleDirReader is implemented by LocaleReaders that can point to a
+// directory of supplemental translation files.
+type LocaleDirReader interface {
+	LocaleDir() string
+}
+
 // TranslationEntry is the expected format of the translation file.
 type TranslationEntry struct {
 	// ID is the original English string.
@@ -45,21 +57,28 @@ type TranslateFunc func(translationID string, args ...interface{}) string
 
 // GetTranslationFunc will return back a function that can be used to translate
 // strings into the currently set locale.
+//
+// Translations missing from the locale fall back to the default locale. If the
+// reader is a LocaleDirReader with a locale directory set, the translation
+// files in that directory take precedence over the embedded ones, and can
+// provide locales that are not embedded at all.
 func GetTranslationFunc(reader LocaleReader) (TranslateFunc, error) {
 	locale, err := determineLocale(reader)
 	if err != nil {
 		locale = defaultLocale
 	}
 
-	rawTranslation, err := loadAssetFromResources(locale)
+	var localeDir string
+	if dirReader, ok := reader.(LocaleDirReader); ok {
+		localeDir = dirReader.LocaleDir()
+	}
+
+	translations, err := loadTranslations(locale, localeDir)
 	if err != nil {
-		rawTranslation, err = loadAssetFromResources(defaultLocale)
-		if err != nil {
-			return nil, err
-		}
+		return nil, err
 	}
 
-	return generateTranslationFunc(rawTranslation)
+	return generateTranslationFunc(translations), nil
 }
 
 // ParseLocale will return a locale formatted as "<language code>-<region
@@ -92,18 +111,67 @@ func determineLocale(reader LocaleReader) (string, error) {
 	return ParseLocale(locale)
 }
 
-func generateTranslationFunc(rawTranslation []byte) (TranslateFunc, error) {
+// loadTranslations returns the translations for the locale layered on top of
+// the default locale. For each locale the embedded resources are loaded first
+// and then overridden by the file in localeDir, if there is one.
+func loadTranslations(locale string, localeDir string) (map[string]string, error) {
+	chain := []string{defaultLocale}
+	if locale != defaultLocale {
+		chain = append(chain, locale)
+	}
+
+	translations := map[string]string{}
+	for _, chainLocale := range chain {
+		rawTranslation, err := loadAssetFromResources(chainLocale)
+		if err == nil {
+			err = mergeTranslations(translations, rawTranslation)
+			if err != nil {
+				return nil, err
+			}
+		} else if chainLocale == defaultLocale {
+			return nil, err
+		}
+
+		if localeDir == "" {
+			continue
+		}
+
+		localeFile := filepath.Join(localeDir, fmt.Sprintf(localeFileName, chainLocale))
+		rawTranslation, err = ioutil.ReadFile(localeFile)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err == nil {
+			err = mergeTranslations(translations, rawTranslation)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Could not load translation file '%s': %s", localeFile, err.Error())
+		}
+	}
+
+	return translations, nil
+}
+
+// mergeTranslations adds the translated entries in rawTranslation to
+// translations. Entries without a translation are skipped so that they keep
+// falling back to a previously loaded locale.
+func mergeTranslations(translations map[string]string, rawTranslation []byte) error {
 	var entries []TranslationEntry
 	err := json.Unmarshal(rawTranslation, &entries)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	translations := map[string]string{}
 	for _, entry := range entries {
-		translations[entry.ID] = entry.Translation
+		if entry.Translation != "" {
+			translations[entry.ID] = entry.Translation
+		}
 	}
 
+	return nil
+}
+
+func generateTranslationFunc(translations map[string]string) TranslateFunc {
 	return func(translationID string, args ...interface{}) string {
 		translated := translations[translationID]
 		if translated == "" {
@@ -120,7 +188,7 @@ func generateTranslationFunc(rawTranslation []byte) (TranslateFunc, error) {
 		formattedTemplate.Execute(&buffer, keys)
 
 		return buffer.String()
-	}, nil
+	}
 }
 
 func loadAssetFromResources(locale string) ([]byte, error) {
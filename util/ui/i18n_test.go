@@ -1,6 +1,10 @@
 package ui_test
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
 	. "code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/cli/util/ui/uifakes"
 
@@ -84,6 +88,86 @@ var _ = Describe("i18n", func() {
 				Expect(translated).To(Equal("api version:"))
 			})
 		})
+
+		Context("when a locale directory is set", func() {
+			var localeDir string
+
+			writeLocaleFile := func(locale string, contents string) {
+				err := ioutil.WriteFile(filepath.Join(localeDir, locale+".all.json"), []byte(contents), 0600)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			BeforeEach(func() {
+				var err error
+				localeDir, err = ioutil.TempDir("", "cf-locale-dir")
+				Expect(err).ToNot(HaveOccurred())
+				fakeConfig.LocaleDirReturns(localeDir)
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(localeDir)).To(Succeed())
+			})
+
+			Context("when the directory does not have a file for the locale", func() {
+				BeforeEach(func() {
+					fakeConfig.LocaleReturns("fr-FR")
+				})
+
+				It("uses the embedded translations", func() {
+					translationFunc, err := GetTranslationFunc(fakeConfig)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(translationFunc("\nApp started\n")).To(Equal("\nApplication démarrée\n"))
+				})
+			})
+
+			Context("when the directory has a file for an embedded locale", func() {
+				BeforeEach(func() {
+					fakeConfig.LocaleReturns("fr-FR")
+					writeLocaleFile("fr-fr", `[{"id": "\nApp started\n", "translation": "\nApp démarrée\n"}]`)
+				})
+
+				It("overrides the embedded translations", func() {
+					translationFunc, err := GetTranslationFunc(fakeConfig)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(translationFunc("\nApp started\n")).To(Equal("\nApp démarrée\n"))
+					Expect(translationFunc("\nApp {{.AppName}} was started using this command `{{.Command}}`\n", map[string]interface{}{
+						"AppName": "some-app-name",
+						"Command": "some-command-name",
+					})).To(Equal("\nL'application some-app-name a été démarrée avec la commande `some-command-name`\n"))
+				})
+			})
+
+			Context("when the directory has a file for a locale that is not embedded", func() {
+				BeforeEach(func() {
+					fakeConfig.LocaleReturns("pt-PT")
+					writeLocaleFile("pt-pt", `[
+						{"id": "\nApp started\n", "translation": "\nAplicação iniciada\n"},
+						{"id": "api version:", "translation": ""}
+					]`)
+					writeLocaleFile("en-us", `[{"id": "api version:", "translation": "API version:"}]`)
+				})
+
+				It("uses the translations from the file and falls back to the default locale", func() {
+					translationFunc, err := GetTranslationFunc(fakeConfig)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(translationFunc("\nApp started\n")).To(Equal("\nAplicação iniciada\n"))
+					Expect(translationFunc("api version:")).To(Equal("API version:"))
+					Expect(translationFunc("some untranslated text")).To(Equal("some untranslated text"))
+				})
+			})
+
+			Context("when a file in the directory is not valid JSON", func() {
+				BeforeEach(func() {
+					fakeConfig.LocaleReturns("fr-FR")
+					writeLocaleFile("fr-fr", `not json`)
+				})
+
+				It("returns an error", func() {
+					_, err := GetTranslationFunc(fakeConfig)
+					Expect(err).To(MatchError(ContainSubstring(filepath.Join(localeDir, "fr-fr.all.json"))))
+				})
+			})
+		})
 	})
 
 	Describe("ParseLocale", func() {
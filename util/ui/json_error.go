@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// ErrorFormatJSON is the ErrorFormat that outputs errors as JSON objects.
+const ErrorFormatJSON = "json"
+
+// JSONError is the JSON representation of an error output by DisplayError when
+// ErrorFormat is ErrorFormatJSON.
+type JSONError struct {
+	// Code is the stable identifier of the error.
+	Code string `json:"code"`
+	// Message is the translated error message.
+	Message string `json:"message"`
+	// Details are the fields of a TranslatableError, or null for any other
+	// error.
+	Details interface{} `json:"details"`
+}
+
+func (ui *UI) displayJSONError(err error) {
+	jsonErr := JSONError{
+		Code:    translatableerror.ErrorCode(err),
+		Message: ui.TranslateError(err),
+	}
+
+	if _, ok := err.(translatableerror.TranslatableError); ok {
+		if _, marshalErr := json.Marshal(err); marshalErr == nil {
+			jsonErr.Details = err
+		}
+	}
+
+	output, marshalErr := json.Marshal(jsonErr)
+	if marshalErr != nil {
+		fmt.Fprintf(ui.Err, "%s\n", jsonErr.Message)
+		return
+	}
+
+	fmt.Fprintf(ui.Err, "%s\n", output)
+}
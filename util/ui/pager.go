@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+)
+
+// displayPaged writes output to UI.Out. When paging is enabled and the output
+// has more lines than fit on the terminal, it is piped through UI.Pager
+// instead. If the pager cannot be started, the output is written directly.
+func (ui *UI) displayPaged(output []byte) {
+	if ui.shouldPage(output) && ui.runPager(output) {
+		return
+	}
+
+	_, _ = ui.Out.Write(output)
+}
+
+func (ui *UI) shouldPage(output []byte) bool {
+	if !ui.PagerEnabled || ui.TerminalHeight <= 0 {
+		return false
+	}
+
+	return bytes.Count(output, []byte("\n")) >= ui.TerminalHeight
+}
+
+// runPager pipes output through the pager and returns false if the pager
+// could not be started.
+func (ui *UI) runPager(output []byte) bool {
+	args := strings.Fields(ui.Pager)
+	if len(args) == 0 {
+		return false
+	}
+
+	pager := exec.Command(args[0], args[1:]...)
+	pager.Stdin = bytes.NewReader(output)
+	pager.Stdout = ui.Out
+	pager.Stderr = ui.Err
+
+	if err := pager.Start(); err != nil {
+		return false
+	}
+
+	// The user quitting the pager early is not an error worth reporting.
+	_ = pager.Wait()
+	return true
+}
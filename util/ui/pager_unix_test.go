@@ -0,0 +1,84 @@
+// +build !windows
+
+package ui_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Pager", func() {
+	var (
+		ui    *UI
+		table [][]string
+	)
+
+	BeforeEach(func() {
+		ui = NewTestUI(nil, NewBuffer(), NewBuffer())
+		ui.Pager = "tr a-z A-Z"
+		ui.PagerEnabled = true
+		ui.TerminalHeight = 3
+
+		table = [][]string{
+			{"name", "state"},
+			{"app-1", "started"},
+			{"app-2", "stopped"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		ui.DisplayNonWrappingTable("", table, 2)
+	})
+
+	Context("when the table does not fit on the terminal", func() {
+		It("pipes the table through the pager", func() {
+			Expect(ui.Out).To(Say("NAME   STATE"))
+			Expect(ui.Out).To(Say("APP-1  STARTED"))
+			Expect(ui.Out).To(Say("APP-2  STOPPED"))
+		})
+
+		Context("when the pager cannot be started", func() {
+			BeforeEach(func() {
+				ui.Pager = "some-pager-that-does-not-exist"
+			})
+
+			It("displays the table directly", func() {
+				Expect(ui.Out).To(Say("name   state"))
+				Expect(ui.Out).To(Say("app-2  stopped"))
+			})
+		})
+	})
+
+	Context("when the table fits on the terminal", func() {
+		BeforeEach(func() {
+			ui.TerminalHeight = 10
+		})
+
+		It("displays the table directly", func() {
+			Expect(ui.Out).To(Say("name   state"))
+			Expect(ui.Out).To(Say("app-2  stopped"))
+		})
+	})
+
+	Context("when paging is disabled", func() {
+		BeforeEach(func() {
+			ui.PagerEnabled = false
+		})
+
+		It("displays the table directly", func() {
+			Expect(ui.Out).To(Say("name   state"))
+		})
+	})
+
+	Context("when the terminal height is unknown", func() {
+		BeforeEach(func() {
+			ui.TerminalHeight = 0
+		})
+
+		It("displays the table directly", func() {
+			Expect(ui.Out).To(Say("name   state"))
+		})
+	})
+})
@@ -0,0 +1,199 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/types"
+)
+
+// progressBarRefreshInterval is the minimum amount of time between redraws
+// of a progress bar.
+const progressBarRefreshInterval = 200 * time.Millisecond
+
+// ProgressBar displays the progress of an upload as the number of bytes
+// sent, the percentage complete and the estimated time remaining. The bar is
+// drawn on a single line that is redrawn as progress is made; when the UI is
+// not a TTY only the final state is drawn.
+//
+// Start and NewProgressBarWrapper block until Ready is called, so that the
+// bar is not drawn before the text that precedes it.
+//
+// The progress is also reported as upload progress events.
+type ProgressBar struct {
+	ui           *UI
+	terminalLock *sync.Mutex
+	ready        chan bool
+
+	mutex          sync.Mutex
+	total          int64
+	sent           int64
+	startTime      time.Time
+	lastDrawTime   time.Time
+	lastLineLength int
+
+	lastEventTime    time.Time
+	lastEventPercent int64
+}
+
+// progressBarReader advances a ProgressBar as it is read.
+type progressBarReader struct {
+	reader io.Reader
+	bar    *ProgressBar
+}
+
+func (reader progressBarReader) Read(p []byte) (int, error) {
+	n, err := reader.reader.Read(p)
+	reader.bar.Add(int64(n))
+	return n, err
+}
+
+func newProgressBar(ui *UI, terminalLock *sync.Mutex) *ProgressBar {
+	return &ProgressBar{
+		ui:           ui,
+		terminalLock: terminalLock,
+		ready:        make(chan bool),
+	}
+}
+
+// NewProgressBar returns a ProgressBar that draws on the UI's Out without
+// overwriting other output.
+func (ui *UI) NewProgressBar() *ProgressBar {
+	return newProgressBar(ui, ui.terminalLock)
+}
+
+// Ready allows a pending Start or NewProgressBarWrapper to display the bar.
+func (bar *ProgressBar) Ready() {
+	bar.ready <- true
+}
+
+// Start waits for Ready and then displays the bar for an upload of
+// sizeOfFile bytes. Progress is reported with Add.
+func (bar *ProgressBar) Start(sizeOfFile int64) {
+	<-bar.ready
+
+	bar.mutex.Lock()
+	defer bar.mutex.Unlock()
+
+	bar.total = sizeOfFile
+	bar.sent = 0
+	bar.startTime = time.Now()
+	bar.lastDrawTime = time.Time{}
+	bar.lastLineLength = 0
+	bar.lastEventTime = time.Time{}
+	bar.lastEventPercent = -1
+
+	bar.displayEvent()
+}
+
+// NewProgressBarWrapper starts the bar for sizeOfFile bytes and returns a
+// reader that advances it as reader is read.
+func (bar *ProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader {
+	bar.Start(sizeOfFile)
+	return progressBarReader{reader: reader, bar: bar}
+}
+
+// Add advances the bar by the given number of bytes. It is safe to call from
+// multiple goroutines.
+func (bar *ProgressBar) Add(bytes int64) {
+	bar.mutex.Lock()
+	defer bar.mutex.Unlock()
+
+	bar.sent += bytes
+	if bar.sent > bar.total {
+		bar.sent = bar.total
+	}
+
+	if bar.ui.IsTTY && (bar.sent == bar.total || time.Since(bar.lastDrawTime) >= progressBarRefreshInterval) {
+		bar.draw()
+	}
+
+	if bar.sent == bar.total || time.Since(bar.lastEventTime) >= progressBarRefreshInterval {
+		bar.displayEvent()
+	}
+}
+
+// Complete draws the final state of the bar. The line is left open so that
+// the caller decides what follows it.
+func (bar *ProgressBar) Complete() {
+	bar.mutex.Lock()
+	defer bar.mutex.Unlock()
+
+	bar.sent = bar.total
+	bar.draw()
+	bar.displayEvent()
+}
+
+// displayEvent reports the progress of the upload unless the percentage has
+// not changed since it was last reported. It must be called with bar.mutex
+// held.
+func (bar *ProgressBar) displayEvent() {
+	percent := bar.percent()
+	if percent == bar.lastEventPercent {
+		return
+	}
+	bar.lastEventTime = time.Now()
+	bar.lastEventPercent = percent
+
+	bar.ui.DisplayProgressEvent(ProgressEvent{
+		Phase:   ProgressPhaseUpload,
+		Message: fmt.Sprintf("%s / %s", bar.ui.FormatBytes(uint64(bar.sent)), bar.ui.FormatBytes(uint64(bar.total))),
+		Percent: types.NullInt{Value: int(percent), IsSet: true},
+	})
+}
+
+// draw must be called with bar.mutex held.
+func (bar *ProgressBar) draw() {
+	bar.lastDrawTime = time.Now()
+
+	var line string
+	if bar.sent < bar.total {
+		line = bar.ui.TranslateText("{{.Sent}} / {{.Total}} ({{.Percent}}%), {{.TimeLeft}} remaining", map[string]interface{}{
+			"Sent":     bar.ui.FormatBytes(uint64(bar.sent)),
+			"Total":    bar.ui.FormatBytes(uint64(bar.total)),
+			"Percent":  bar.percent(),
+			"TimeLeft": bar.ui.FormatDuration(bar.timeLeft()),
+		})
+	} else {
+		line = bar.ui.TranslateText("{{.Sent}} / {{.Total}} ({{.Percent}}%)", map[string]interface{}{
+			"Sent":    bar.ui.FormatBytes(uint64(bar.sent)),
+			"Total":   bar.ui.FormatBytes(uint64(bar.total)),
+			"Percent": bar.percent(),
+		})
+	}
+
+	padding := ""
+	if len(line) < bar.lastLineLength {
+		padding = strings.Repeat(" ", bar.lastLineLength-len(line))
+	}
+	bar.lastLineLength = len(line)
+
+	bar.terminalLock.Lock()
+	defer bar.terminalLock.Unlock()
+
+	if bar.ui.IsTTY {
+		fmt.Fprintf(bar.ui.Out, "\r%s%s", line, padding)
+	} else {
+		fmt.Fprint(bar.ui.Out, line)
+	}
+}
+
+func (bar *ProgressBar) percent() int64 {
+	if bar.total == 0 {
+		return 100
+	}
+	return bar.sent * 100 / bar.total
+}
+
+// timeLeft estimates the time remaining assuming the rest of the upload
+// proceeds at the average rate seen so far.
+func (bar *ProgressBar) timeLeft() time.Duration {
+	if bar.sent == 0 {
+		return 0
+	}
+	elapsed := time.Since(bar.startTime)
+	return time.Duration(float64(elapsed) * float64(bar.total-bar.sent) / float64(bar.sent))
+}
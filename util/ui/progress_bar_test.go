@@ -0,0 +1,133 @@
+package ui_test
+
+import (
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ProgressBar", func() {
+	var (
+		ui  *UI
+		out *Buffer
+		bar *ProgressBar
+	)
+
+	BeforeEach(func() {
+		out = NewBuffer()
+		ui = NewTestUI(nil, out, NewBuffer())
+		bar = ui.NewProgressBar()
+	})
+
+	Describe("Start", func() {
+		It("waits for Ready before starting", func() {
+			started := make(chan bool)
+			go func() {
+				bar.Start(2048)
+				close(started)
+			}()
+
+			Consistently(started).ShouldNot(BeClosed())
+			bar.Ready()
+			Eventually(started).Should(BeClosed())
+		})
+	})
+
+	Context("when the UI is a TTY", func() {
+		BeforeEach(func() {
+			ui.IsTTY = true
+			go bar.Ready()
+			bar.Start(2048)
+		})
+
+		It("displays the bytes sent, percentage and time remaining", func() {
+			bar.Add(1024)
+			Expect(out).To(Say(`\r1K / 2K \(%d%%\), \d+s remaining`, 50))
+		})
+
+		It("redraws the line when completed", func() {
+			bar.Add(1024)
+			bar.Complete()
+			Expect(out).To(Say(`\r1K / 2K \(%d%%\), \d+s remaining`, 50))
+			Expect(out).To(Say(`\r2K / 2K \(%d%%\)\s+$`, 100))
+		})
+
+		It("can be advanced from multiple goroutines", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					bar.Add(256)
+				}()
+			}
+			wg.Wait()
+
+			bar.Complete()
+			Expect(out).To(Say(`2K / 2K \(%d%%\)`, 100))
+		})
+	})
+
+	Context("when the UI is not a TTY", func() {
+		BeforeEach(func() {
+			go bar.Ready()
+			bar.Start(2048)
+		})
+
+		It("only displays the final state", func() {
+			bar.Add(1024)
+			Expect(out.Contents()).To(BeEmpty())
+
+			bar.Complete()
+			Expect(string(out.Contents())).To(Equal("2K / 2K (100%)"))
+		})
+	})
+
+	Context("when progress events are enabled", func() {
+		var events *Buffer
+
+		BeforeEach(func() {
+			events = NewBuffer()
+			ui.ProgressEvents = events
+			go bar.Ready()
+			bar.Start(2048)
+		})
+
+		It("reports the start and the end of the upload as events", func() {
+			bar.Add(1024)
+			bar.Complete()
+
+			Expect(events).To(Say(`{"phase":"upload","message":"0 / 2K","percent":0}\n`))
+			Expect(events).To(Say(`{"phase":"upload","message":"2K / 2K","percent":100}\n`))
+		})
+
+		It("does not report progress more often than the bar is redrawn", func() {
+			bar.Add(1024)
+
+			Expect(string(events.Contents())).ToNot(ContainSubstring(`"percent":50`))
+		})
+
+		It("does not repeat an event when the percentage has not changed", func() {
+			bar.Complete()
+			bar.Complete()
+
+			Expect(strings.Count(string(events.Contents()), `"percent":100`)).To(Equal(1))
+		})
+	})
+
+	Describe("NewProgressBarWrapper", func() {
+		It("advances the bar as the returned reader is read", func() {
+			ui.IsTTY = true
+			go bar.Ready()
+			reader := bar.NewProgressBarWrapper(strings.NewReader(strings.Repeat("a", 2048)), 2048)
+
+			Expect(ioutil.ReadAll(reader)).To(HaveLen(2048))
+			Expect(out).To(Say(`\r2K / 2K \(%d%%\)`, 100))
+		})
+	})
+})
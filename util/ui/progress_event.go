@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Phases of long running commands that progress events are reported for.
+const (
+	ProgressPhaseUpload    = "upload"
+	ProgressPhaseStaging   = "staging"
+	ProgressPhaseStopping  = "stopping"
+	ProgressPhaseStarting  = "starting"
+	ProgressPhaseDeploying = "deploying"
+)
+
+// ProgressEvent is a machine-readable report of the progress of a long
+// running command.
+type ProgressEvent struct {
+	// Phase is the part of the command that is in progress.
+	Phase string `json:"phase"`
+	// Message is an untranslated description of the progress.
+	Message string `json:"message"`
+	// Percent is how much of the phase is complete. It is null when this is
+	// not known.
+	Percent types.NullInt `json:"percent"`
+}
+
+// DisplayProgressEvent writes the event to ProgressEvents as a single line of
+// JSON. Nothing is written when ProgressEvents is nil.
+func (ui *UI) DisplayProgressEvent(event ProgressEvent) {
+	if ui.ProgressEvents == nil {
+		return
+	}
+
+	rawEvent, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	fmt.Fprintf(ui.ProgressEvents, "%s\n", rawEvent)
+}
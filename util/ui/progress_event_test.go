@@ -0,0 +1,56 @@
+package ui_test
+
+import (
+	"code.cloudfoundry.org/cli/types"
+	. "code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("DisplayProgressEvent", func() {
+	var (
+		ui  *UI
+		out *Buffer
+	)
+
+	BeforeEach(func() {
+		out = NewBuffer()
+		ui = NewTestUI(nil, out, NewBuffer())
+	})
+
+	Context("when progress events are enabled", func() {
+		var events *Buffer
+
+		BeforeEach(func() {
+			events = NewBuffer()
+			ui.ProgressEvents = events
+		})
+
+		It("writes the event as a line of JSON", func() {
+			ui.DisplayProgressEvent(ProgressEvent{
+				Phase:   ProgressPhaseUpload,
+				Message: "1K / 2K",
+				Percent: types.NullInt{Value: 50, IsSet: true},
+			})
+			ui.DisplayProgressEvent(ProgressEvent{
+				Phase:   ProgressPhaseStaging,
+				Message: "Staging app",
+			})
+
+			Expect(string(events.Contents())).To(Equal(
+				`{"phase":"upload","message":"1K / 2K","percent":50}` + "\n" +
+					`{"phase":"staging","message":"Staging app","percent":null}` + "\n",
+			))
+			Expect(out.Contents()).To(BeEmpty())
+		})
+	})
+
+	Context("when progress events are disabled", func() {
+		It("does not write anything", func() {
+			ui.DisplayProgressEvent(ProgressEvent{Phase: ProgressPhaseStarting, Message: "Waiting for app to start"})
+			Expect(out.Contents()).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,25 @@
+// +build !windows
+
+package ui
+
+import (
+	"io"
+	"os"
+	"syscall"
+)
+
+// progressEventsFD is the file descriptor progress events are written to when
+// the CLI was started with it open.
+const progressEventsFD = 3
+
+// progressEventsOutput returns file descriptor 3 when it was inherited from
+// the parent process, so that progress events can be read separately from the
+// rest of the output, and stderr otherwise. Descriptors opened by the Go
+// runtime itself are close-on-exec and are therefore never picked up.
+func progressEventsOutput() io.Writer {
+	flags, _, errno := syscall.Syscall(syscall.SYS_FCNTL, progressEventsFD, syscall.F_GETFD, 0)
+	if errno != 0 || flags&syscall.FD_CLOEXEC != 0 {
+		return os.Stderr
+	}
+	return os.NewFile(progressEventsFD, "progress-events")
+}
@@ -0,0 +1,14 @@
+// +build windows
+
+package ui
+
+import (
+	"io"
+	"os"
+)
+
+// progressEventsOutput returns stderr; inherited file descriptors other than
+// the standard streams are not available on Windows.
+func progressEventsOutput() io.Writer {
+	return os.Stderr
+}
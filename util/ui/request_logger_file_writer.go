@@ -7,25 +7,32 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"sync"
 	"time"
 )
 
+// MaxTraceFileBackups is the number of rotated trace files that are kept
+// alongside the active trace file.
+const MaxTraceFileBackups = 3
+
 type RequestLoggerFileWriter struct {
 	ui            *UI
 	lock          *sync.Mutex
 	filePaths     []string
 	logFiles      []*os.File
 	dumpSanitizer *regexp.Regexp
+	maxSize       int64
 }
 
-func newRequestLoggerFileWriter(ui *UI, lock *sync.Mutex, filePaths []string) *RequestLoggerFileWriter {
+func newRequestLoggerFileWriter(ui *UI, lock *sync.Mutex, filePaths []string, maxSize int64) *RequestLoggerFileWriter {
 	return &RequestLoggerFileWriter{
 		ui:            ui,
 		lock:          lock,
 		filePaths:     filePaths,
 		logFiles:      []*os.File{},
 		dumpSanitizer: regexp.MustCompile(tokenRegexp),
+		maxSize:       maxSize,
 	}
 }
 
@@ -120,6 +127,11 @@ func (display *RequestLoggerFileWriter) Start() error {
 			return err
 		}
 
+		err = display.rotate(filePath)
+		if err != nil {
+			return err
+		}
+
 		logFile, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
 		if err != nil {
 			return err
@@ -147,3 +159,35 @@ func (display *RequestLoggerFileWriter) Stop() error {
 	display.lock.Unlock()
 	return err
 }
+
+// rotate moves filePath to filePath.1, shifting older backups up by one and
+// discarding the oldest, once filePath has reached the maximum trace size.
+func (display *RequestLoggerFileWriter) rotate(filePath string) error {
+	if display.maxSize <= 0 {
+		return nil
+	}
+
+	info, err := os.Stat(filePath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if info.Size() < display.maxSize {
+		return nil
+	}
+
+	for i := MaxTraceFileBackups - 1; i > 0; i-- {
+		err = os.Rename(backupPath(filePath, i), backupPath(filePath, i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return os.Rename(filePath, backupPath(filePath, 1))
+}
+
+func backupPath(filePath string, n int) string {
+	return filePath + "." + strconv.Itoa(n)
+}
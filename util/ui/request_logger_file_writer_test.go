@@ -10,6 +10,7 @@ import (
 	"time"
 
 	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -318,6 +319,71 @@ Origin: wss://doppler.bosh-lite.com:443`
 		})
 	})
 
+	Describe("rotation", func() {
+		var logFile string
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "request_logger")
+			Expect(err).ToNot(HaveOccurred())
+			logFile = filepath.Join(tmpdir, "trace")
+
+			fakeConfig := new(uifakes.FakeConfig)
+			fakeConfig.TraceMaxSizeReturns(10)
+			testUI, err = NewUI(fakeConfig)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpdir)).NotTo(HaveOccurred())
+		})
+
+		writeTrace := func(msg string) {
+			display = testUI.RequestLoggerFileWriter([]string{logFile})
+			Expect(display.Start()).ToNot(HaveOccurred())
+			Expect(display.DisplayMessage(msg)).ToNot(HaveOccurred())
+			Expect(display.Stop()).ToNot(HaveOccurred())
+		}
+
+		readTrace := func(path string) string {
+			contents, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			return string(contents)
+		}
+
+		Context("when the trace file is smaller than the max size", func() {
+			It("appends to the trace file", func() {
+				writeTrace("a")
+				writeTrace("b")
+
+				Expect(readTrace(logFile)).To(Equal("a\n\nb\n\n"))
+				Expect(logFile + ".1").ToNot(BeAnExistingFile())
+			})
+		})
+
+		Context("when the trace file has reached the max size", func() {
+			It("moves it to a backup before writing", func() {
+				writeTrace("first trace")
+				writeTrace("second")
+
+				Expect(readTrace(logFile)).To(Equal("second\n\n"))
+				Expect(readTrace(logFile + ".1")).To(Equal("first trace\n\n"))
+			})
+
+			It("keeps at most MaxTraceFileBackups backups", func() {
+				for i := 0; i <= MaxTraceFileBackups+1; i++ {
+					writeTrace(fmt.Sprintf("trace number %d", i))
+				}
+
+				Expect(readTrace(logFile)).To(Equal(fmt.Sprintf("trace number %d\n\n", MaxTraceFileBackups+1)))
+				for i := 1; i <= MaxTraceFileBackups; i++ {
+					Expect(readTrace(fmt.Sprintf("%s.%d", logFile, i))).To(Equal(fmt.Sprintf("trace number %d\n\n", MaxTraceFileBackups+1-i)))
+				}
+				Expect(fmt.Sprintf("%s.%d", logFile, MaxTraceFileBackups+1)).ToNot(BeAnExistingFile())
+			})
+		})
+	})
+
 	Describe("when the log file path is invalid", func() {
 		var pathName string
 
@@ -6,6 +6,7 @@
 package ui
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"os"
@@ -32,10 +33,29 @@ type Config interface {
 	ColorEnabled() configv3.ColorSetting
 	// Locale is the language to translate the output to
 	Locale() string
+	// LocaleDir is the directory supplemental translation files are loaded
+	// from
+	LocaleDir() string
 	// IsTTY returns true when the ui has a TTY
 	IsTTY() bool
+	// Pager is the command long output is piped through
+	Pager() string
+	// PagerEnabled enables or disables piping long output through the pager
+	PagerEnabled() bool
+	// ProgressFormat is the format the progress of long running commands is
+	// reported in
+	ProgressFormat() string
+	// TerminalHeight returns the height of the terminal
+	TerminalHeight() int
 	// TerminalWidth returns the width of the terminal
 	TerminalWidth() int
+	// TraceMaxSize is the size in bytes at which trace files are rotated
+	TraceMaxSize() int64
+	// ErrorFormat is the format errors are displayed in
+	ErrorFormat() string
+	// Verbose returns true if verbose output is enabled, along with the files
+	// verbose output is written to
+	Verbose() (bool, []string)
 }
 
 //go:generate counterfeiter . LogMessage
@@ -58,17 +78,39 @@ type UI struct {
 	Out io.Writer
 	// Err is the error buffer
 	Err io.Writer
+	// ProgressEvents is where progress events are written to as
+	// newline-delimited JSON. No events are written when it is nil.
+	ProgressEvents io.Writer
 
 	colorEnabled configv3.ColorSetting
 	translate    TranslateFunc
 
 	terminalLock *sync.Mutex
 	fileLock     *sync.Mutex
+	traceMaxSize int64
 
-	IsTTY         bool
-	TerminalWidth int
+	IsTTY          bool
+	TerminalHeight int
+	TerminalWidth  int
+
+	// PagerEnabled pipes tables that do not fit on the terminal through
+	// Pager.
+	PagerEnabled bool
+	// Pager is the command, with arguments, that long output is piped
+	// through.
+	Pager string
 
 	TimezoneLocation *time.Location
+
+	// SizeUnits is the unit system used when displaying byte sizes. Defaults
+	// to BinaryUnits.
+	SizeUnits SizeUnits
+
+	// ErrorFormat is the format DisplayError outputs errors in. Errors are
+	// output as text unless it is ErrorFormatJSON.
+	ErrorFormat string
+	// DisplayErrorCodes outputs the error code after text formatted errors.
+	DisplayErrorCodes bool
 }
 
 // NewUI will return a UI object where Out is set to STDOUT, In is set to
@@ -81,7 +123,7 @@ func NewUI(config Config) (*UI, error) {
 
 	location := time.Now().Location()
 
-	return &UI{
+	ui := &UI{
 		In:               os.Stdin,
 		Out:              color.Output,
 		Err:              os.Stderr,
@@ -90,18 +132,28 @@ func NewUI(config Config) (*UI, error) {
 		terminalLock:     &sync.Mutex{},
 		fileLock:         &sync.Mutex{},
 		IsTTY:            config.IsTTY(),
+		TerminalHeight:   config.TerminalHeight(),
 		TerminalWidth:    config.TerminalWidth(),
+		PagerEnabled:     config.IsTTY() && config.PagerEnabled(),
+		Pager:            config.Pager(),
 		TimezoneLocation: location,
-	}, nil
+		traceMaxSize:     config.TraceMaxSize(),
+	}
+
+	ui.ErrorFormat = config.ErrorFormat()
+	ui.DisplayErrorCodes, _ = config.Verbose()
+
+	if config.ProgressFormat() == configv3.ProgressFormatJSON {
+		ui.ProgressEvents = progressEventsOutput()
+	}
+
+	return ui, nil
 }
 
 // NewTestUI will return a UI object where Out, In, and Err are customizable,
 // and colors are disabled
 func NewTestUI(in io.Reader, out io.Writer, err io.Writer) *UI {
-	translationFunc, translateErr := generateTranslationFunc([]byte("[]"))
-	if translateErr != nil {
-		panic(translateErr)
-	}
+	translationFunc := generateTranslationFunc(map[string]string{})
 
 	return &UI{
 		In:               in,
@@ -130,17 +182,36 @@ func (ui *UI) DisplayBoolPrompt(defaultResponse bool, template string, templateV
 	return response, err
 }
 
+// DisplayPasswordPrompt outputs the prompt and waits for user input. Hides
+// user's response from the screen.
+func (ui *UI) DisplayPasswordPrompt(template string, templateValues ...map[string]interface{}) (string, error) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	var password interact.Password
+	interactivePrompt := interact.NewInteraction(ui.TranslateText(template, templateValues...))
+	interactivePrompt.Input = ui.In
+	interactivePrompt.Output = ui.Out
+	err := interactivePrompt.Resolve(interact.Required(&password))
+	return string(password), err
+}
+
 // DisplayError outputs the translated error message to ui.Err if the error
 // satisfies TranslatableError, otherwise it outputs the original error message
-// to ui.Err. It also outputs "FAILED" in bold red to ui.Out.
+// to ui.Err. When ErrorFormat is ErrorFormatJSON the error is output as a JSON
+// object containing its code, message and details instead. It also outputs
+// "FAILED" in bold red to ui.Out.
 func (ui *UI) DisplayError(err error) {
-	var errMsg string
-	if translatableError, ok := err.(translatableerror.TranslatableError); ok {
-		errMsg = translatableError.Translate(ui.translate)
+	if ui.ErrorFormat == ErrorFormatJSON {
+		ui.displayJSONError(err)
 	} else {
-		errMsg = err.Error()
+		fmt.Fprintf(ui.Err, "%s\n", ui.TranslateError(err))
+		if ui.DisplayErrorCodes {
+			fmt.Fprintf(ui.Err, "%s\n", ui.TranslateText("Error code: {{.ErrorCode}}", map[string]interface{}{
+				"ErrorCode": translatableerror.ErrorCode(err),
+			}))
+		}
 	}
-	fmt.Fprintf(ui.Err, "%s\n", errMsg)
 
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
@@ -214,7 +285,8 @@ func (ui *UI) DisplayNewline() {
 
 // DisplayNonWrappingTable outputs a matrix of strings as a table to UI.Out. Prefix will
 // be prepended to each row and padding adds the specified number of spaces
-// between columns.
+// between columns. Tables that do not fit on the terminal are piped through
+// the pager when paging is enabled.
 func (ui *UI) DisplayNonWrappingTable(prefix string, table [][]string, padding int) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
@@ -237,18 +309,21 @@ func (ui *UI) DisplayNonWrappingTable(prefix string, table [][]string, padding i
 		columnPadding = append(columnPadding, max+padding)
 	}
 
+	var output bytes.Buffer
 	for row := 0; row < rows; row++ {
-		fmt.Fprintf(ui.Out, prefix)
+		fmt.Fprint(&output, prefix)
 		for col := 0; col < columns; col++ {
 			data := table[row][col]
 			var addedPadding int
 			if col+1 != columns {
 				addedPadding = columnPadding[col] - wordSize(data)
 			}
-			fmt.Fprintf(ui.Out, "%s%s", data, strings.Repeat(" ", addedPadding))
+			fmt.Fprintf(&output, "%s%s", data, strings.Repeat(" ", addedPadding))
 		}
-		fmt.Fprintf(ui.Out, "\n")
+		fmt.Fprintf(&output, "\n")
 	}
+
+	ui.displayPaged(output.Bytes())
 }
 
 // DisplayOK outputs a bold green translated "OK" to UI.Out.
@@ -323,7 +398,7 @@ func (ui *UI) DisplayWarnings(warnings []string) {
 // RequestLoggerFileWriter returns a RequestLoggerFileWriter that cannot
 // overwrite another RequestLoggerFileWriter.
 func (ui *UI) RequestLoggerFileWriter(filePaths []string) *RequestLoggerFileWriter {
-	return newRequestLoggerFileWriter(ui, ui.fileLock, filePaths)
+	return newRequestLoggerFileWriter(ui, ui.fileLock, filePaths, ui.traceMaxSize)
 }
 
 // RequestLoggerTerminalDisplay returns a RequestLoggerTerminalDisplay that
@@ -333,6 +408,15 @@ func (ui *UI) RequestLoggerTerminalDisplay() *RequestLoggerTerminalDisplay {
 	return newRequestLoggerTerminalDisplay(ui, ui.terminalLock)
 }
 
+// TranslateError returns the translated error message if the error satisfies
+// TranslatableError, otherwise it returns the original error message.
+func (ui *UI) TranslateError(err error) string {
+	if translatableError, ok := err.(translatableerror.TranslatableError); ok {
+		return translatableError.Translate(ui.translate)
+	}
+	return err.Error()
+}
+
 // TranslateText passes the template through an internationalization function
 // to translate it to a pre-configured language, and returns the template with
 // templateValues substituted in. Only the first map in templateValues is used.
@@ -1,10 +1,12 @@
 package ui_test
 
 import (
+	"encoding/json"
 	"errors"
 	"strings"
 	"time"
 
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/translatableerror/translatableerrorfakes"
 	"code.cloudfoundry.org/cli/util/configv3"
 	. "code.cloudfoundry.org/cli/util/ui"
@@ -39,6 +41,74 @@ var _ = Describe("UI", func() {
 		Expect(ui.TimezoneLocation).To(Equal(location))
 	})
 
+	Describe("paging", func() {
+		BeforeEach(func() {
+			fakeConfig.PagerEnabledReturns(true)
+			fakeConfig.PagerReturns("some-pager")
+			fakeConfig.TerminalHeightReturns(40)
+		})
+
+		Context("when the UI has a TTY", func() {
+			BeforeEach(func() {
+				fakeConfig.IsTTYReturns(true)
+			})
+
+			It("enables paging with the configured pager", func() {
+				var err error
+				ui, err = NewUI(fakeConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(ui.PagerEnabled).To(BeTrue())
+				Expect(ui.Pager).To(Equal("some-pager"))
+				Expect(ui.TerminalHeight).To(Equal(40))
+			})
+		})
+
+		Context("when the UI does not have a TTY", func() {
+			BeforeEach(func() {
+				fakeConfig.IsTTYReturns(false)
+			})
+
+			It("disables paging", func() {
+				var err error
+				ui, err = NewUI(fakeConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(ui.PagerEnabled).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("progress events", func() {
+		Context("when the progress format is json", func() {
+			BeforeEach(func() {
+				fakeConfig.ProgressFormatReturns("json")
+			})
+
+			It("writes progress events", func() {
+				var err error
+				ui, err = NewUI(fakeConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(ui.ProgressEvents).ToNot(BeNil())
+			})
+		})
+
+		Context("when the progress format is text", func() {
+			BeforeEach(func() {
+				fakeConfig.ProgressFormatReturns("text")
+			})
+
+			It("does not write progress events", func() {
+				var err error
+				ui, err = NewUI(fakeConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(ui.ProgressEvents).To(BeNil())
+			})
+		})
+	})
+
 	Describe("DisplayBoolPrompt", func() {
 		var inBuffer *Buffer
 
@@ -111,6 +181,36 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayPasswordPrompt", func() {
+		var inBuffer *Buffer
+
+		BeforeEach(func() {
+			inBuffer = NewBuffer()
+			ui.In = inBuffer
+		})
+
+		It("displays the passed in string and returns the user's response", func() {
+			_, err := inBuffer.Write([]byte("some-password\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			password, err := ui.DisplayPasswordPrompt("some-prompt")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(password).To(Equal("some-password"))
+			Expect(ui.Out).To(Say("some-prompt:"))
+		})
+
+		It("translates the prompt", func() {
+			_, err := inBuffer.Write([]byte("some-password\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = ui.DisplayPasswordPrompt("some-prompt {{.SomeMapValue}}", map[string]interface{}{
+				"SomeMapValue": "prompt-value",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ui.Out).To(Say("some-prompt prompt-value:"))
+		})
+	})
+
 	Describe("DisplayError", func() {
 		Context("when passed a TranslatableError", func() {
 			var fakeTranslateErr *translatableerrorfakes.FakeTranslatableError
@@ -142,6 +242,79 @@ var _ = Describe("UI", func() {
 				Expect(ui.Out).To(Say("\x1b\\[31;1mFAILED\x1b\\[0m\n"))
 			})
 		})
+
+		Context("when verbose output is enabled", func() {
+			BeforeEach(func() {
+				fakeConfig.VerboseReturns(true, nil)
+
+				var err error
+				ui, err = NewUI(fakeConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				ui.Out = NewBuffer()
+				ui.Err = NewBuffer()
+			})
+
+			It("displays the error code after the error", func() {
+				ui.DisplayError(translatableerror.OrganizationNotFoundError{Name: "some-org"})
+				Expect(ui.Err).To(Say("Organization 'some-org' not found.\n"))
+				Expect(ui.Err).To(Say("Error code: OrganizationNotFound\n"))
+			})
+
+			Context("when the error is not a TranslatableError", func() {
+				It("displays the unknown error code", func() {
+					ui.DisplayError(errors.New("I am a BANANA!"))
+					Expect(ui.Err).To(Say("I am a BANANA!\n"))
+					Expect(ui.Err).To(Say("Error code: Unknown\n"))
+				})
+			})
+		})
+
+		Context("when the error format is json", func() {
+			var jsonErr map[string]interface{}
+
+			BeforeEach(func() {
+				fakeConfig.ErrorFormatReturns("json")
+
+				var err error
+				ui, err = NewUI(fakeConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				ui.Out = NewBuffer()
+				ui.Err = NewBuffer()
+			})
+
+			Context("when passed a TranslatableError", func() {
+				BeforeEach(func() {
+					ui.DisplayError(translatableerror.OrganizationNotFoundError{Name: "some-org"})
+					Expect(json.Unmarshal(ui.Err.(*Buffer).Contents(), &jsonErr)).To(Succeed())
+				})
+
+				It("displays the code, message and details as JSON to ui.Err and displays FAILED to ui.Out", func() {
+					Expect(jsonErr).To(Equal(map[string]interface{}{
+						"code":    "OrganizationNotFound",
+						"message": "Organization 'some-org' not found.",
+						"details": map[string]interface{}{"Name": "some-org"},
+					}))
+					Expect(ui.Out).To(Say("\x1b\\[31;1mFAILED\x1b\\[0m\n"))
+				})
+			})
+
+			Context("when passed a generic error", func() {
+				BeforeEach(func() {
+					ui.DisplayError(errors.New("I am a BANANA!"))
+					Expect(json.Unmarshal(ui.Err.(*Buffer).Contents(), &jsonErr)).To(Succeed())
+				})
+
+				It("displays the unknown error code and no details", func() {
+					Expect(jsonErr).To(Equal(map[string]interface{}{
+						"code":    "Unknown",
+						"message": "I am a BANANA!",
+						"details": nil,
+					}))
+				})
+			})
+		})
 	})
 
 	Describe("DisplayHeader", func() {
@@ -541,6 +714,24 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("TranslateError", func() {
+		Context("when passed a TranslatableError", func() {
+			It("returns the translated error text", func() {
+				fakeTranslateErr := new(translatableerrorfakes.FakeTranslatableError)
+				fakeTranslateErr.TranslateReturns("I am an error")
+
+				Expect(ui.TranslateError(fakeTranslateErr)).To(Equal("I am an error"))
+				Expect(fakeTranslateErr.TranslateCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when passed a generic error", func() {
+			It("returns the error text", func() {
+				Expect(ui.TranslateError(errors.New("I am a BANANA!"))).To(Equal("I am a BANANA!"))
+			})
+		})
+	})
+
 	Describe("TranslateText", func() {
 		It("returns the template", func() {
 			Expect(ui.TranslateText("some-template")).To(Equal("some-template"))
@@ -36,6 +36,51 @@ type FakeConfig struct {
 	isTTYReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	LocaleDirStub        func() string
+	localeDirMutex       sync.RWMutex
+	localeDirArgsForCall []struct{}
+	localeDirReturns     struct {
+		result1 string
+	}
+	localeDirReturnsOnCall map[int]struct {
+		result1 string
+	}
+	PagerStub        func() string
+	pagerMutex       sync.RWMutex
+	pagerArgsForCall []struct{}
+	pagerReturns     struct {
+		result1 string
+	}
+	pagerReturnsOnCall map[int]struct {
+		result1 string
+	}
+	PagerEnabledStub        func() bool
+	pagerEnabledMutex       sync.RWMutex
+	pagerEnabledArgsForCall []struct{}
+	pagerEnabledReturns     struct {
+		result1 bool
+	}
+	pagerEnabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	ProgressFormatStub        func() string
+	progressFormatMutex       sync.RWMutex
+	progressFormatArgsForCall []struct{}
+	progressFormatReturns     struct {
+		result1 string
+	}
+	progressFormatReturnsOnCall map[int]struct {
+		result1 string
+	}
+	TerminalHeightStub        func() int
+	terminalHeightMutex       sync.RWMutex
+	terminalHeightArgsForCall []struct{}
+	terminalHeightReturns     struct {
+		result1 int
+	}
+	terminalHeightReturnsOnCall map[int]struct {
+		result1 int
+	}
 	TerminalWidthStub        func() int
 	terminalWidthMutex       sync.RWMutex
 	terminalWidthArgsForCall []struct{}
@@ -45,6 +90,35 @@ type FakeConfig struct {
 	terminalWidthReturnsOnCall map[int]struct {
 		result1 int
 	}
+	TraceMaxSizeStub        func() int64
+	traceMaxSizeMutex       sync.RWMutex
+	traceMaxSizeArgsForCall []struct{}
+	traceMaxSizeReturns     struct {
+		result1 int64
+	}
+	traceMaxSizeReturnsOnCall map[int]struct {
+		result1 int64
+	}
+	ErrorFormatStub        func() string
+	errorFormatMutex       sync.RWMutex
+	errorFormatArgsForCall []struct{}
+	errorFormatReturns     struct {
+		result1 string
+	}
+	errorFormatReturnsOnCall map[int]struct {
+		result1 string
+	}
+	VerboseStub        func() (bool, []string)
+	verboseMutex       sync.RWMutex
+	verboseArgsForCall []struct{}
+	verboseReturns     struct {
+		result1 bool
+		result2 []string
+	}
+	verboseReturnsOnCall map[int]struct {
+		result1 bool
+		result2 []string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -169,6 +243,206 @@ func (fake *FakeConfig) IsTTYReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) LocaleDir() string {
+	fake.localeDirMutex.Lock()
+	ret, specificReturn := fake.localeDirReturnsOnCall[len(fake.localeDirArgsForCall)]
+	fake.localeDirArgsForCall = append(fake.localeDirArgsForCall, struct{}{})
+	fake.recordInvocation("LocaleDir", []interface{}{})
+	fake.localeDirMutex.Unlock()
+	if fake.LocaleDirStub != nil {
+		return fake.LocaleDirStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.localeDirReturns.result1
+}
+
+func (fake *FakeConfig) LocaleDirCallCount() int {
+	fake.localeDirMutex.RLock()
+	defer fake.localeDirMutex.RUnlock()
+	return len(fake.localeDirArgsForCall)
+}
+
+func (fake *FakeConfig) LocaleDirReturns(result1 string) {
+	fake.LocaleDirStub = nil
+	fake.localeDirReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) LocaleDirReturnsOnCall(i int, result1 string) {
+	fake.LocaleDirStub = nil
+	if fake.localeDirReturnsOnCall == nil {
+		fake.localeDirReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.localeDirReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) Pager() string {
+	fake.pagerMutex.Lock()
+	ret, specificReturn := fake.pagerReturnsOnCall[len(fake.pagerArgsForCall)]
+	fake.pagerArgsForCall = append(fake.pagerArgsForCall, struct{}{})
+	fake.recordInvocation("Pager", []interface{}{})
+	fake.pagerMutex.Unlock()
+	if fake.PagerStub != nil {
+		return fake.PagerStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pagerReturns.result1
+}
+
+func (fake *FakeConfig) PagerCallCount() int {
+	fake.pagerMutex.RLock()
+	defer fake.pagerMutex.RUnlock()
+	return len(fake.pagerArgsForCall)
+}
+
+func (fake *FakeConfig) PagerReturns(result1 string) {
+	fake.PagerStub = nil
+	fake.pagerReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) PagerReturnsOnCall(i int, result1 string) {
+	fake.PagerStub = nil
+	if fake.pagerReturnsOnCall == nil {
+		fake.pagerReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pagerReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) PagerEnabled() bool {
+	fake.pagerEnabledMutex.Lock()
+	ret, specificReturn := fake.pagerEnabledReturnsOnCall[len(fake.pagerEnabledArgsForCall)]
+	fake.pagerEnabledArgsForCall = append(fake.pagerEnabledArgsForCall, struct{}{})
+	fake.recordInvocation("PagerEnabled", []interface{}{})
+	fake.pagerEnabledMutex.Unlock()
+	if fake.PagerEnabledStub != nil {
+		return fake.PagerEnabledStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pagerEnabledReturns.result1
+}
+
+func (fake *FakeConfig) PagerEnabledCallCount() int {
+	fake.pagerEnabledMutex.RLock()
+	defer fake.pagerEnabledMutex.RUnlock()
+	return len(fake.pagerEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) PagerEnabledReturns(result1 bool) {
+	fake.PagerEnabledStub = nil
+	fake.pagerEnabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) PagerEnabledReturnsOnCall(i int, result1 bool) {
+	fake.PagerEnabledStub = nil
+	if fake.pagerEnabledReturnsOnCall == nil {
+		fake.pagerEnabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.pagerEnabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) ProgressFormat() string {
+	fake.progressFormatMutex.Lock()
+	ret, specificReturn := fake.progressFormatReturnsOnCall[len(fake.progressFormatArgsForCall)]
+	fake.progressFormatArgsForCall = append(fake.progressFormatArgsForCall, struct{}{})
+	fake.recordInvocation("ProgressFormat", []interface{}{})
+	fake.progressFormatMutex.Unlock()
+	if fake.ProgressFormatStub != nil {
+		return fake.ProgressFormatStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.progressFormatReturns.result1
+}
+
+func (fake *FakeConfig) ProgressFormatCallCount() int {
+	fake.progressFormatMutex.RLock()
+	defer fake.progressFormatMutex.RUnlock()
+	return len(fake.progressFormatArgsForCall)
+}
+
+func (fake *FakeConfig) ProgressFormatReturns(result1 string) {
+	fake.ProgressFormatStub = nil
+	fake.progressFormatReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ProgressFormatReturnsOnCall(i int, result1 string) {
+	fake.ProgressFormatStub = nil
+	if fake.progressFormatReturnsOnCall == nil {
+		fake.progressFormatReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.progressFormatReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) TerminalHeight() int {
+	fake.terminalHeightMutex.Lock()
+	ret, specificReturn := fake.terminalHeightReturnsOnCall[len(fake.terminalHeightArgsForCall)]
+	fake.terminalHeightArgsForCall = append(fake.terminalHeightArgsForCall, struct{}{})
+	fake.recordInvocation("TerminalHeight", []interface{}{})
+	fake.terminalHeightMutex.Unlock()
+	if fake.TerminalHeightStub != nil {
+		return fake.TerminalHeightStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.terminalHeightReturns.result1
+}
+
+func (fake *FakeConfig) TerminalHeightCallCount() int {
+	fake.terminalHeightMutex.RLock()
+	defer fake.terminalHeightMutex.RUnlock()
+	return len(fake.terminalHeightArgsForCall)
+}
+
+func (fake *FakeConfig) TerminalHeightReturns(result1 int) {
+	fake.TerminalHeightStub = nil
+	fake.terminalHeightReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) TerminalHeightReturnsOnCall(i int, result1 int) {
+	fake.TerminalHeightStub = nil
+	if fake.terminalHeightReturnsOnCall == nil {
+		fake.terminalHeightReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.terminalHeightReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeConfig) TerminalWidth() int {
 	fake.terminalWidthMutex.Lock()
 	ret, specificReturn := fake.terminalWidthReturnsOnCall[len(fake.terminalWidthArgsForCall)]
@@ -209,6 +483,129 @@ func (fake *FakeConfig) TerminalWidthReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) TraceMaxSize() int64 {
+	fake.traceMaxSizeMutex.Lock()
+	ret, specificReturn := fake.traceMaxSizeReturnsOnCall[len(fake.traceMaxSizeArgsForCall)]
+	fake.traceMaxSizeArgsForCall = append(fake.traceMaxSizeArgsForCall, struct{}{})
+	fake.recordInvocation("TraceMaxSize", []interface{}{})
+	fake.traceMaxSizeMutex.Unlock()
+	if fake.TraceMaxSizeStub != nil {
+		return fake.TraceMaxSizeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.traceMaxSizeReturns.result1
+}
+
+func (fake *FakeConfig) TraceMaxSizeCallCount() int {
+	fake.traceMaxSizeMutex.RLock()
+	defer fake.traceMaxSizeMutex.RUnlock()
+	return len(fake.traceMaxSizeArgsForCall)
+}
+
+func (fake *FakeConfig) TraceMaxSizeReturns(result1 int64) {
+	fake.TraceMaxSizeStub = nil
+	fake.traceMaxSizeReturns = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeConfig) TraceMaxSizeReturnsOnCall(i int, result1 int64) {
+	fake.TraceMaxSizeStub = nil
+	if fake.traceMaxSizeReturnsOnCall == nil {
+		fake.traceMaxSizeReturnsOnCall = make(map[int]struct {
+			result1 int64
+		})
+	}
+	fake.traceMaxSizeReturnsOnCall[i] = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeConfig) ErrorFormat() string {
+	fake.errorFormatMutex.Lock()
+	ret, specificReturn := fake.errorFormatReturnsOnCall[len(fake.errorFormatArgsForCall)]
+	fake.errorFormatArgsForCall = append(fake.errorFormatArgsForCall, struct{}{})
+	fake.recordInvocation("ErrorFormat", []interface{}{})
+	fake.errorFormatMutex.Unlock()
+	if fake.ErrorFormatStub != nil {
+		return fake.ErrorFormatStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.errorFormatReturns.result1
+}
+
+func (fake *FakeConfig) ErrorFormatCallCount() int {
+	fake.errorFormatMutex.RLock()
+	defer fake.errorFormatMutex.RUnlock()
+	return len(fake.errorFormatArgsForCall)
+}
+
+func (fake *FakeConfig) ErrorFormatReturns(result1 string) {
+	fake.ErrorFormatStub = nil
+	fake.errorFormatReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ErrorFormatReturnsOnCall(i int, result1 string) {
+	fake.ErrorFormatStub = nil
+	if fake.errorFormatReturnsOnCall == nil {
+		fake.errorFormatReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.errorFormatReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) Verbose() (bool, []string) {
+	fake.verboseMutex.Lock()
+	ret, specificReturn := fake.verboseReturnsOnCall[len(fake.verboseArgsForCall)]
+	fake.verboseArgsForCall = append(fake.verboseArgsForCall, struct{}{})
+	fake.recordInvocation("Verbose", []interface{}{})
+	fake.verboseMutex.Unlock()
+	if fake.VerboseStub != nil {
+		return fake.VerboseStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.verboseReturns.result1, fake.verboseReturns.result2
+}
+
+func (fake *FakeConfig) VerboseCallCount() int {
+	fake.verboseMutex.RLock()
+	defer fake.verboseMutex.RUnlock()
+	return len(fake.verboseArgsForCall)
+}
+
+func (fake *FakeConfig) VerboseReturns(result1 bool, result2 []string) {
+	fake.VerboseStub = nil
+	fake.verboseReturns = struct {
+		result1 bool
+		result2 []string
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) VerboseReturnsOnCall(i int, result1 bool, result2 []string) {
+	fake.VerboseStub = nil
+	if fake.verboseReturnsOnCall == nil {
+		fake.verboseReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 []string
+		})
+	}
+	fake.verboseReturnsOnCall[i] = struct {
+		result1 bool
+		result2 []string
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -218,8 +615,24 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.localeMutex.RUnlock()
 	fake.isTTYMutex.RLock()
 	defer fake.isTTYMutex.RUnlock()
+	fake.localeDirMutex.RLock()
+	defer fake.localeDirMutex.RUnlock()
+	fake.pagerMutex.RLock()
+	defer fake.pagerMutex.RUnlock()
+	fake.pagerEnabledMutex.RLock()
+	defer fake.pagerEnabledMutex.RUnlock()
+	fake.progressFormatMutex.RLock()
+	defer fake.progressFormatMutex.RUnlock()
+	fake.terminalHeightMutex.RLock()
+	defer fake.terminalHeightMutex.RUnlock()
 	fake.terminalWidthMutex.RLock()
 	defer fake.terminalWidthMutex.RUnlock()
+	fake.traceMaxSizeMutex.RLock()
+	defer fake.traceMaxSizeMutex.RUnlock()
+	fake.errorFormatMutex.RLock()
+	defer fake.errorFormatMutex.RUnlock()
+	fake.verboseMutex.RLock()
+	defer fake.verboseMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
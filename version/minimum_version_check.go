@@ -6,9 +6,15 @@ import (
 )
 
 const (
-	MinVersionLifecyleStagingV2         = "2.68.0"
-	MinVersionHTTPEndpointHealthCheckV2 = "2.68.0"
-	MinVersionProcessHealthCheckV2      = "2.47.0"
+	MinVersionLifecyleStagingV2           = "2.68.0"
+	MinVersionHTTPEndpointHealthCheckV2   = "2.68.0"
+	MinVersionProcessHealthCheckV2        = "2.47.0"
+	MinVersionBindingNameV2               = "2.99.0"
+	MinVersionBuildpackStackAssociationV2 = "2.112.0"
+	MinVersionOrgAppInstanceLimitV2       = "2.33.0"
+	MinVersionSpaceAppInstanceLimitV2     = "2.40.0"
+	MinVersionReservedRoutePortsV2        = "2.55.0"
+	MinVersionInternalDomainV2            = "2.115.0"
 
 	MinVersionHTTPRoutePath                 = "2.36.0"
 	MinVersionTCPRouting                    = "2.53.0"
@@ -17,6 +23,12 @@ const (
 	MinVersionV3                 = "3.27.0"
 	MinVersionRunTaskV3          = "3.0.0"
 	MinVersionIsolationSegmentV3 = "3.11.0"
+	MinVersionShareServiceV3     = "3.36.0"
+	MinVersionStacksV3           = "3.35.0"
+	MinVersionDeploymentsV3      = "3.55.0"
+	MinVersionAuditEventsV3      = "3.57.0"
+	MinVersionMetadataV3         = "3.63.0"
+	MinVersionRevisionsV3        = "3.65.0"
 )
 
 func MinimumAPIVersionCheck(current string, minimum string, customCommand ...string) error {
@@ -4,6 +4,18 @@ import "github.com/blang/semver"
 
 const DefaultVersion = "0.0.0-unknown-version"
 
+const (
+	// MaxVersionV2 and MaxVersionV3 are the newest Cloud Controller API
+	// versions whose features this CLI release knows about. Newer APIs are
+	// expected to work, but the features they add are not used.
+	MaxVersionV2 = "2.115.0"
+	MaxVersionV3 = "3.65.0"
+
+	// PluginAPIVersion is the CLI release that last changed the plugin RPC API,
+	// as recorded in plugin/plugin_examples/CHANGELOG.md.
+	PluginAPIVersion = "6.30.0"
+)
+
 var (
 	binaryVersion   string
 	binarySHA       string
@@ -29,3 +41,15 @@ func VersionString() string {
 
 	return versionString.String()
 }
+
+// BinarySHA returns the git SHA the binary was built from, or the empty
+// string if it was not provided at build time.
+func BinarySHA() string {
+	return binarySHA
+}
+
+// BinaryBuildDate returns the date the binary was built, or the empty string
+// if it was not provided at build time.
+func BinaryBuildDate() string {
+	return binaryBuildDate
+}
@@ -2,7 +2,9 @@ package version_test
 
 import (
 	"code.cloudfoundry.org/cli/version"
+	"github.com/blang/semver"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -14,4 +16,37 @@ var _ = Describe("Version", func() {
 			})
 		})
 	})
+
+	Describe("BinarySHA", func() {
+		Context("when passed no ldflags", func() {
+			It("returns the empty string", func() {
+				Expect(version.BinarySHA()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("BinaryBuildDate", func() {
+		Context("when passed no ldflags", func() {
+			It("returns the empty string", func() {
+				Expect(version.BinaryBuildDate()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("MaxVersionV2 and MaxVersionV3", func() {
+		DescribeTable("are at least every minimum version the CLI checks for",
+			func(max string, minimum string) {
+				Expect(semver.MustParse(max).GTE(semver.MustParse(minimum))).To(BeTrue())
+			},
+			Entry("lifecycle staging", version.MaxVersionV2, version.MinVersionLifecyleStagingV2),
+			Entry("binding name", version.MaxVersionV2, version.MinVersionBindingNameV2),
+			Entry("buildpack stack association", version.MaxVersionV2, version.MinVersionBuildpackStackAssociationV2),
+			Entry("internal domain", version.MaxVersionV2, version.MinVersionInternalDomainV2),
+			Entry("v3", version.MaxVersionV3, version.MinVersionV3),
+			Entry("deployments", version.MaxVersionV3, version.MinVersionDeploymentsV3),
+			Entry("audit events", version.MaxVersionV3, version.MinVersionAuditEventsV3),
+			Entry("metadata", version.MaxVersionV3, version.MinVersionMetadataV3),
+			Entry("revisions", version.MaxVersionV3, version.MinVersionRevisionsV3),
+		)
+	})
 })